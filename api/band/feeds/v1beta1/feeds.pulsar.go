@@ -1041,29 +1041,29 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Feed           protoreflect.MessageDescriptor
-	fd_Feed_signal_id protoreflect.FieldDescriptor
-	fd_Feed_power     protoreflect.FieldDescriptor
-	fd_Feed_interval  protoreflect.FieldDescriptor
+	md_SignalVoteDelegation           protoreflect.MessageDescriptor
+	fd_SignalVoteDelegation_delegator protoreflect.FieldDescriptor
+	fd_SignalVoteDelegation_curator   protoreflect.FieldDescriptor
+	fd_SignalVoteDelegation_power     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_feeds_proto_init()
-	md_Feed = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("Feed")
-	fd_Feed_signal_id = md_Feed.Fields().ByName("signal_id")
-	fd_Feed_power = md_Feed.Fields().ByName("power")
-	fd_Feed_interval = md_Feed.Fields().ByName("interval")
+	md_SignalVoteDelegation = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("SignalVoteDelegation")
+	fd_SignalVoteDelegation_delegator = md_SignalVoteDelegation.Fields().ByName("delegator")
+	fd_SignalVoteDelegation_curator = md_SignalVoteDelegation.Fields().ByName("curator")
+	fd_SignalVoteDelegation_power = md_SignalVoteDelegation.Fields().ByName("power")
 }
 
-var _ protoreflect.Message = (*fastReflection_Feed)(nil)
+var _ protoreflect.Message = (*fastReflection_SignalVoteDelegation)(nil)
 
-type fastReflection_Feed Feed
+type fastReflection_SignalVoteDelegation SignalVoteDelegation
 
-func (x *Feed) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Feed)(x)
+func (x *SignalVoteDelegation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignalVoteDelegation)(x)
 }
 
-func (x *Feed) slowProtoReflect() protoreflect.Message {
+func (x *SignalVoteDelegation) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1075,43 +1075,43 @@ func (x *Feed) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Feed_messageType fastReflection_Feed_messageType
-var _ protoreflect.MessageType = fastReflection_Feed_messageType{}
+var _fastReflection_SignalVoteDelegation_messageType fastReflection_SignalVoteDelegation_messageType
+var _ protoreflect.MessageType = fastReflection_SignalVoteDelegation_messageType{}
 
-type fastReflection_Feed_messageType struct{}
+type fastReflection_SignalVoteDelegation_messageType struct{}
 
-func (x fastReflection_Feed_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Feed)(nil)
+func (x fastReflection_SignalVoteDelegation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignalVoteDelegation)(nil)
 }
-func (x fastReflection_Feed_messageType) New() protoreflect.Message {
-	return new(fastReflection_Feed)
+func (x fastReflection_SignalVoteDelegation_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignalVoteDelegation)
 }
-func (x fastReflection_Feed_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Feed
+func (x fastReflection_SignalVoteDelegation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalVoteDelegation
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Feed) Descriptor() protoreflect.MessageDescriptor {
-	return md_Feed
+func (x *fastReflection_SignalVoteDelegation) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalVoteDelegation
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Feed) Type() protoreflect.MessageType {
-	return _fastReflection_Feed_messageType
+func (x *fastReflection_SignalVoteDelegation) Type() protoreflect.MessageType {
+	return _fastReflection_SignalVoteDelegation_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Feed) New() protoreflect.Message {
-	return new(fastReflection_Feed)
+func (x *fastReflection_SignalVoteDelegation) New() protoreflect.Message {
+	return new(fastReflection_SignalVoteDelegation)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Feed) Interface() protoreflect.ProtoMessage {
-	return (*Feed)(x)
+func (x *fastReflection_SignalVoteDelegation) Interface() protoreflect.ProtoMessage {
+	return (*SignalVoteDelegation)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1119,22 +1119,22 @@ func (x *fastReflection_Feed) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Feed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.SignalId != "" {
-		value := protoreflect.ValueOfString(x.SignalId)
-		if !f(fd_Feed_signal_id, value) {
+func (x *fastReflection_SignalVoteDelegation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Delegator != "" {
+		value := protoreflect.ValueOfString(x.Delegator)
+		if !f(fd_SignalVoteDelegation_delegator, value) {
 			return
 		}
 	}
-	if x.Power != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Power)
-		if !f(fd_Feed_power, value) {
+	if x.Curator != "" {
+		value := protoreflect.ValueOfString(x.Curator)
+		if !f(fd_SignalVoteDelegation_curator, value) {
 			return
 		}
 	}
-	if x.Interval != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Interval)
-		if !f(fd_Feed_interval, value) {
+	if x.Power != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Power)
+		if !f(fd_SignalVoteDelegation_power, value) {
 			return
 		}
 	}
@@ -1151,19 +1151,19 @@ func (x *fastReflection_Feed) Range(f func(protoreflect.FieldDescriptor, protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Feed) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SignalVoteDelegation) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.Feed.signal_id":
-		return x.SignalId != ""
-	case "band.feeds.v1beta1.Feed.power":
+	case "band.feeds.v1beta1.SignalVoteDelegation.delegator":
+		return x.Delegator != ""
+	case "band.feeds.v1beta1.SignalVoteDelegation.curator":
+		return x.Curator != ""
+	case "band.feeds.v1beta1.SignalVoteDelegation.power":
 		return x.Power != int64(0)
-	case "band.feeds.v1beta1.Feed.interval":
-		return x.Interval != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalVoteDelegation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalVoteDelegation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1173,19 +1173,19 @@ func (x *fastReflection_Feed) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Feed) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SignalVoteDelegation) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.Feed.signal_id":
-		x.SignalId = ""
-	case "band.feeds.v1beta1.Feed.power":
+	case "band.feeds.v1beta1.SignalVoteDelegation.delegator":
+		x.Delegator = ""
+	case "band.feeds.v1beta1.SignalVoteDelegation.curator":
+		x.Curator = ""
+	case "band.feeds.v1beta1.SignalVoteDelegation.power":
 		x.Power = int64(0)
-	case "band.feeds.v1beta1.Feed.interval":
-		x.Interval = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalVoteDelegation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalVoteDelegation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1195,22 +1195,22 @@ func (x *fastReflection_Feed) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Feed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SignalVoteDelegation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.Feed.signal_id":
-		value := x.SignalId
+	case "band.feeds.v1beta1.SignalVoteDelegation.delegator":
+		value := x.Delegator
 		return protoreflect.ValueOfString(value)
-	case "band.feeds.v1beta1.Feed.power":
+	case "band.feeds.v1beta1.SignalVoteDelegation.curator":
+		value := x.Curator
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.SignalVoteDelegation.power":
 		value := x.Power
 		return protoreflect.ValueOfInt64(value)
-	case "band.feeds.v1beta1.Feed.interval":
-		value := x.Interval
-		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalVoteDelegation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalVoteDelegation does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1224,19 +1224,19 @@ func (x *fastReflection_Feed) Get(descriptor protoreflect.FieldDescriptor) proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Feed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SignalVoteDelegation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.Feed.signal_id":
-		x.SignalId = value.Interface().(string)
-	case "band.feeds.v1beta1.Feed.power":
+	case "band.feeds.v1beta1.SignalVoteDelegation.delegator":
+		x.Delegator = value.Interface().(string)
+	case "band.feeds.v1beta1.SignalVoteDelegation.curator":
+		x.Curator = value.Interface().(string)
+	case "band.feeds.v1beta1.SignalVoteDelegation.power":
 		x.Power = value.Int()
-	case "band.feeds.v1beta1.Feed.interval":
-		x.Interval = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalVoteDelegation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalVoteDelegation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1250,48 +1250,48 @@ func (x *fastReflection_Feed) Set(fd protoreflect.FieldDescriptor, value protore
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Feed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SignalVoteDelegation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.Feed.signal_id":
-		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.Feed is not mutable"))
-	case "band.feeds.v1beta1.Feed.power":
-		panic(fmt.Errorf("field power of message band.feeds.v1beta1.Feed is not mutable"))
-	case "band.feeds.v1beta1.Feed.interval":
-		panic(fmt.Errorf("field interval of message band.feeds.v1beta1.Feed is not mutable"))
+	case "band.feeds.v1beta1.SignalVoteDelegation.delegator":
+		panic(fmt.Errorf("field delegator of message band.feeds.v1beta1.SignalVoteDelegation is not mutable"))
+	case "band.feeds.v1beta1.SignalVoteDelegation.curator":
+		panic(fmt.Errorf("field curator of message band.feeds.v1beta1.SignalVoteDelegation is not mutable"))
+	case "band.feeds.v1beta1.SignalVoteDelegation.power":
+		panic(fmt.Errorf("field power of message band.feeds.v1beta1.SignalVoteDelegation is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalVoteDelegation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalVoteDelegation does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Feed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SignalVoteDelegation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.Feed.signal_id":
+	case "band.feeds.v1beta1.SignalVoteDelegation.delegator":
 		return protoreflect.ValueOfString("")
-	case "band.feeds.v1beta1.Feed.power":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.feeds.v1beta1.Feed.interval":
+	case "band.feeds.v1beta1.SignalVoteDelegation.curator":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.SignalVoteDelegation.power":
 		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalVoteDelegation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalVoteDelegation does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Feed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SignalVoteDelegation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.Feed", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.SignalVoteDelegation", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1299,7 +1299,7 @@ func (x *fastReflection_Feed) WhichOneof(d protoreflect.OneofDescriptor) protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Feed) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SignalVoteDelegation) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1310,7 +1310,7 @@ func (x *fastReflection_Feed) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Feed) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SignalVoteDelegation) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1322,7 +1322,7 @@ func (x *fastReflection_Feed) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Feed) IsValid() bool {
+func (x *fastReflection_SignalVoteDelegation) IsValid() bool {
 	return x != nil
 }
 
@@ -1332,9 +1332,9 @@ func (x *fastReflection_Feed) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SignalVoteDelegation) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Feed)
+		x := input.Message.Interface().(*SignalVoteDelegation)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1346,16 +1346,17 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.SignalId)
+		l = len(x.Delegator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Curator)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.Power != 0 {
 			n += 1 + runtime.Sov(uint64(x.Power))
 		}
-		if x.Interval != 0 {
-			n += 1 + runtime.Sov(uint64(x.Interval))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1366,7 +1367,7 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Feed)
+		x := input.Message.Interface().(*SignalVoteDelegation)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1385,20 +1386,22 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Interval != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
+		if x.Power != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
 			i--
 			dAtA[i] = 0x18
 		}
-		if x.Power != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
+		if len(x.Curator) > 0 {
+			i -= len(x.Curator)
+			copy(dAtA[i:], x.Curator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Curator)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if len(x.SignalId) > 0 {
-			i -= len(x.SignalId)
-			copy(dAtA[i:], x.SignalId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+		if len(x.Delegator) > 0 {
+			i -= len(x.Delegator)
+			copy(dAtA[i:], x.Delegator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegator)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1413,7 +1416,7 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Feed)
+		x := input.Message.Interface().(*SignalVoteDelegation)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1445,15 +1448,15 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Feed: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalVoteDelegation: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Feed: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalVoteDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1481,13 +1484,13 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SignalId = string(dAtA[iNdEx:postIndex])
+				x.Delegator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
 				}
-				x.Power = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1497,16 +1500,29 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Power |= int64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Curator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 				}
-				x.Interval = 0
+				x.Power = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1516,7 +1532,7 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Interval |= int64(b&0x7F) << shift
+					x.Power |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -1557,31 +1573,27 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_FeedWithDeviation                       protoreflect.MessageDescriptor
-	fd_FeedWithDeviation_signal_id             protoreflect.FieldDescriptor
-	fd_FeedWithDeviation_power                 protoreflect.FieldDescriptor
-	fd_FeedWithDeviation_interval              protoreflect.FieldDescriptor
-	fd_FeedWithDeviation_deviation_basis_point protoreflect.FieldDescriptor
+	md_CuratorDelegatedPower         protoreflect.MessageDescriptor
+	fd_CuratorDelegatedPower_curator protoreflect.FieldDescriptor
+	fd_CuratorDelegatedPower_power   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_feeds_proto_init()
-	md_FeedWithDeviation = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("FeedWithDeviation")
-	fd_FeedWithDeviation_signal_id = md_FeedWithDeviation.Fields().ByName("signal_id")
-	fd_FeedWithDeviation_power = md_FeedWithDeviation.Fields().ByName("power")
-	fd_FeedWithDeviation_interval = md_FeedWithDeviation.Fields().ByName("interval")
-	fd_FeedWithDeviation_deviation_basis_point = md_FeedWithDeviation.Fields().ByName("deviation_basis_point")
+	md_CuratorDelegatedPower = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("CuratorDelegatedPower")
+	fd_CuratorDelegatedPower_curator = md_CuratorDelegatedPower.Fields().ByName("curator")
+	fd_CuratorDelegatedPower_power = md_CuratorDelegatedPower.Fields().ByName("power")
 }
 
-var _ protoreflect.Message = (*fastReflection_FeedWithDeviation)(nil)
+var _ protoreflect.Message = (*fastReflection_CuratorDelegatedPower)(nil)
 
-type fastReflection_FeedWithDeviation FeedWithDeviation
+type fastReflection_CuratorDelegatedPower CuratorDelegatedPower
 
-func (x *FeedWithDeviation) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_FeedWithDeviation)(x)
+func (x *CuratorDelegatedPower) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CuratorDelegatedPower)(x)
 }
 
-func (x *FeedWithDeviation) slowProtoReflect() protoreflect.Message {
+func (x *CuratorDelegatedPower) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1593,43 +1605,43 @@ func (x *FeedWithDeviation) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_FeedWithDeviation_messageType fastReflection_FeedWithDeviation_messageType
-var _ protoreflect.MessageType = fastReflection_FeedWithDeviation_messageType{}
+var _fastReflection_CuratorDelegatedPower_messageType fastReflection_CuratorDelegatedPower_messageType
+var _ protoreflect.MessageType = fastReflection_CuratorDelegatedPower_messageType{}
 
-type fastReflection_FeedWithDeviation_messageType struct{}
+type fastReflection_CuratorDelegatedPower_messageType struct{}
 
-func (x fastReflection_FeedWithDeviation_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_FeedWithDeviation)(nil)
+func (x fastReflection_CuratorDelegatedPower_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CuratorDelegatedPower)(nil)
 }
-func (x fastReflection_FeedWithDeviation_messageType) New() protoreflect.Message {
-	return new(fastReflection_FeedWithDeviation)
+func (x fastReflection_CuratorDelegatedPower_messageType) New() protoreflect.Message {
+	return new(fastReflection_CuratorDelegatedPower)
 }
-func (x fastReflection_FeedWithDeviation_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_FeedWithDeviation
+func (x fastReflection_CuratorDelegatedPower_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CuratorDelegatedPower
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_FeedWithDeviation) Descriptor() protoreflect.MessageDescriptor {
-	return md_FeedWithDeviation
+func (x *fastReflection_CuratorDelegatedPower) Descriptor() protoreflect.MessageDescriptor {
+	return md_CuratorDelegatedPower
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_FeedWithDeviation) Type() protoreflect.MessageType {
-	return _fastReflection_FeedWithDeviation_messageType
+func (x *fastReflection_CuratorDelegatedPower) Type() protoreflect.MessageType {
+	return _fastReflection_CuratorDelegatedPower_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_FeedWithDeviation) New() protoreflect.Message {
-	return new(fastReflection_FeedWithDeviation)
+func (x *fastReflection_CuratorDelegatedPower) New() protoreflect.Message {
+	return new(fastReflection_CuratorDelegatedPower)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_FeedWithDeviation) Interface() protoreflect.ProtoMessage {
-	return (*FeedWithDeviation)(x)
+func (x *fastReflection_CuratorDelegatedPower) Interface() protoreflect.ProtoMessage {
+	return (*CuratorDelegatedPower)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1637,28 +1649,16 @@ func (x *fastReflection_FeedWithDeviation) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_FeedWithDeviation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.SignalId != "" {
-		value := protoreflect.ValueOfString(x.SignalId)
-		if !f(fd_FeedWithDeviation_signal_id, value) {
+func (x *fastReflection_CuratorDelegatedPower) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Curator != "" {
+		value := protoreflect.ValueOfString(x.Curator)
+		if !f(fd_CuratorDelegatedPower_curator, value) {
 			return
 		}
 	}
 	if x.Power != int64(0) {
 		value := protoreflect.ValueOfInt64(x.Power)
-		if !f(fd_FeedWithDeviation_power, value) {
-			return
-		}
-	}
-	if x.Interval != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Interval)
-		if !f(fd_FeedWithDeviation_interval, value) {
-			return
-		}
-	}
-	if x.DeviationBasisPoint != int64(0) {
-		value := protoreflect.ValueOfInt64(x.DeviationBasisPoint)
-		if !f(fd_FeedWithDeviation_deviation_basis_point, value) {
+		if !f(fd_CuratorDelegatedPower_power, value) {
 			return
 		}
 	}
@@ -1675,21 +1675,17 @@ func (x *fastReflection_FeedWithDeviation) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_FeedWithDeviation) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CuratorDelegatedPower) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
-		return x.SignalId != ""
-	case "band.feeds.v1beta1.FeedWithDeviation.power":
+	case "band.feeds.v1beta1.CuratorDelegatedPower.curator":
+		return x.Curator != ""
+	case "band.feeds.v1beta1.CuratorDelegatedPower.power":
 		return x.Power != int64(0)
-	case "band.feeds.v1beta1.FeedWithDeviation.interval":
-		return x.Interval != int64(0)
-	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
-		return x.DeviationBasisPoint != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CuratorDelegatedPower"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CuratorDelegatedPower does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1699,21 +1695,17 @@ func (x *fastReflection_FeedWithDeviation) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeedWithDeviation) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CuratorDelegatedPower) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
-		x.SignalId = ""
-	case "band.feeds.v1beta1.FeedWithDeviation.power":
+	case "band.feeds.v1beta1.CuratorDelegatedPower.curator":
+		x.Curator = ""
+	case "band.feeds.v1beta1.CuratorDelegatedPower.power":
 		x.Power = int64(0)
-	case "band.feeds.v1beta1.FeedWithDeviation.interval":
-		x.Interval = int64(0)
-	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
-		x.DeviationBasisPoint = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CuratorDelegatedPower"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CuratorDelegatedPower does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1723,25 +1715,19 @@ func (x *fastReflection_FeedWithDeviation) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_FeedWithDeviation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CuratorDelegatedPower) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
-		value := x.SignalId
+	case "band.feeds.v1beta1.CuratorDelegatedPower.curator":
+		value := x.Curator
 		return protoreflect.ValueOfString(value)
-	case "band.feeds.v1beta1.FeedWithDeviation.power":
+	case "band.feeds.v1beta1.CuratorDelegatedPower.power":
 		value := x.Power
 		return protoreflect.ValueOfInt64(value)
-	case "band.feeds.v1beta1.FeedWithDeviation.interval":
-		value := x.Interval
-		return protoreflect.ValueOfInt64(value)
-	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
-		value := x.DeviationBasisPoint
-		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CuratorDelegatedPower"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CuratorDelegatedPower does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1755,21 +1741,17 @@ func (x *fastReflection_FeedWithDeviation) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeedWithDeviation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CuratorDelegatedPower) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
-		x.SignalId = value.Interface().(string)
-	case "band.feeds.v1beta1.FeedWithDeviation.power":
+	case "band.feeds.v1beta1.CuratorDelegatedPower.curator":
+		x.Curator = value.Interface().(string)
+	case "band.feeds.v1beta1.CuratorDelegatedPower.power":
 		x.Power = value.Int()
-	case "band.feeds.v1beta1.FeedWithDeviation.interval":
-		x.Interval = value.Int()
-	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
-		x.DeviationBasisPoint = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CuratorDelegatedPower"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CuratorDelegatedPower does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1783,52 +1765,44 @@ func (x *fastReflection_FeedWithDeviation) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeedWithDeviation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CuratorDelegatedPower) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
-		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.FeedWithDeviation is not mutable"))
-	case "band.feeds.v1beta1.FeedWithDeviation.power":
-		panic(fmt.Errorf("field power of message band.feeds.v1beta1.FeedWithDeviation is not mutable"))
-	case "band.feeds.v1beta1.FeedWithDeviation.interval":
-		panic(fmt.Errorf("field interval of message band.feeds.v1beta1.FeedWithDeviation is not mutable"))
-	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
-		panic(fmt.Errorf("field deviation_basis_point of message band.feeds.v1beta1.FeedWithDeviation is not mutable"))
+	case "band.feeds.v1beta1.CuratorDelegatedPower.curator":
+		panic(fmt.Errorf("field curator of message band.feeds.v1beta1.CuratorDelegatedPower is not mutable"))
+	case "band.feeds.v1beta1.CuratorDelegatedPower.power":
+		panic(fmt.Errorf("field power of message band.feeds.v1beta1.CuratorDelegatedPower is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CuratorDelegatedPower"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CuratorDelegatedPower does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_FeedWithDeviation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CuratorDelegatedPower) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
+	case "band.feeds.v1beta1.CuratorDelegatedPower.curator":
 		return protoreflect.ValueOfString("")
-	case "band.feeds.v1beta1.FeedWithDeviation.power":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.feeds.v1beta1.FeedWithDeviation.interval":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
+	case "band.feeds.v1beta1.CuratorDelegatedPower.power":
 		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CuratorDelegatedPower"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CuratorDelegatedPower does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_FeedWithDeviation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CuratorDelegatedPower) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.FeedWithDeviation", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.CuratorDelegatedPower", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1836,7 +1810,7 @@ func (x *fastReflection_FeedWithDeviation) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_FeedWithDeviation) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CuratorDelegatedPower) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1847,7 +1821,7 @@ func (x *fastReflection_FeedWithDeviation) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeedWithDeviation) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CuratorDelegatedPower) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1859,7 +1833,7 @@ func (x *fastReflection_FeedWithDeviation) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_FeedWithDeviation) IsValid() bool {
+func (x *fastReflection_CuratorDelegatedPower) IsValid() bool {
 	return x != nil
 }
 
@@ -1869,9 +1843,9 @@ func (x *fastReflection_FeedWithDeviation) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CuratorDelegatedPower) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*FeedWithDeviation)
+		x := input.Message.Interface().(*CuratorDelegatedPower)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1883,19 +1857,13 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.SignalId)
+		l = len(x.Curator)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.Power != 0 {
 			n += 1 + runtime.Sov(uint64(x.Power))
 		}
-		if x.Interval != 0 {
-			n += 1 + runtime.Sov(uint64(x.Interval))
-		}
-		if x.DeviationBasisPoint != 0 {
-			n += 1 + runtime.Sov(uint64(x.DeviationBasisPoint))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1906,7 +1874,7 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*FeedWithDeviation)
+		x := input.Message.Interface().(*CuratorDelegatedPower)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1925,25 +1893,15 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.DeviationBasisPoint != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeviationBasisPoint))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.Interval != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
-			i--
-			dAtA[i] = 0x18
-		}
 		if x.Power != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.SignalId) > 0 {
-			i -= len(x.SignalId)
-			copy(dAtA[i:], x.SignalId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+		if len(x.Curator) > 0 {
+			i -= len(x.Curator)
+			copy(dAtA[i:], x.Curator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Curator)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1958,7 +1916,7 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*FeedWithDeviation)
+		x := input.Message.Interface().(*CuratorDelegatedPower)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1990,15 +1948,15 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeedWithDeviation: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CuratorDelegatedPower: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeedWithDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CuratorDelegatedPower: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2026,7 +1984,7 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SignalId = string(dAtA[iNdEx:postIndex])
+				x.Curator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
@@ -2047,44 +2005,6 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
-				}
-				x.Interval = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Interval |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeviationBasisPoint", wireType)
-				}
-				x.DeviationBasisPoint = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.DeviationBasisPoint |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2120,81 +2040,30 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_CurrentFeeds_1_list)(nil)
-
-type _CurrentFeeds_1_list struct {
-	list *[]*Feed
-}
-
-func (x *_CurrentFeeds_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_CurrentFeeds_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_CurrentFeeds_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Feed)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_CurrentFeeds_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Feed)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_CurrentFeeds_1_list) AppendMutable() protoreflect.Value {
-	v := new(Feed)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_CurrentFeeds_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_CurrentFeeds_1_list) NewElement() protoreflect.Value {
-	v := new(Feed)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_CurrentFeeds_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_CurrentFeeds                       protoreflect.MessageDescriptor
-	fd_CurrentFeeds_feeds                 protoreflect.FieldDescriptor
-	fd_CurrentFeeds_last_update_timestamp protoreflect.FieldDescriptor
-	fd_CurrentFeeds_last_update_block     protoreflect.FieldDescriptor
+	md_Feed           protoreflect.MessageDescriptor
+	fd_Feed_signal_id protoreflect.FieldDescriptor
+	fd_Feed_power     protoreflect.FieldDescriptor
+	fd_Feed_interval  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_feeds_proto_init()
-	md_CurrentFeeds = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("CurrentFeeds")
-	fd_CurrentFeeds_feeds = md_CurrentFeeds.Fields().ByName("feeds")
-	fd_CurrentFeeds_last_update_timestamp = md_CurrentFeeds.Fields().ByName("last_update_timestamp")
-	fd_CurrentFeeds_last_update_block = md_CurrentFeeds.Fields().ByName("last_update_block")
+	md_Feed = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("Feed")
+	fd_Feed_signal_id = md_Feed.Fields().ByName("signal_id")
+	fd_Feed_power = md_Feed.Fields().ByName("power")
+	fd_Feed_interval = md_Feed.Fields().ByName("interval")
 }
 
-var _ protoreflect.Message = (*fastReflection_CurrentFeeds)(nil)
+var _ protoreflect.Message = (*fastReflection_Feed)(nil)
 
-type fastReflection_CurrentFeeds CurrentFeeds
+type fastReflection_Feed Feed
 
-func (x *CurrentFeeds) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CurrentFeeds)(x)
+func (x *Feed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Feed)(x)
 }
 
-func (x *CurrentFeeds) slowProtoReflect() protoreflect.Message {
+func (x *Feed) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2206,43 +2075,43 @@ func (x *CurrentFeeds) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CurrentFeeds_messageType fastReflection_CurrentFeeds_messageType
-var _ protoreflect.MessageType = fastReflection_CurrentFeeds_messageType{}
+var _fastReflection_Feed_messageType fastReflection_Feed_messageType
+var _ protoreflect.MessageType = fastReflection_Feed_messageType{}
 
-type fastReflection_CurrentFeeds_messageType struct{}
+type fastReflection_Feed_messageType struct{}
 
-func (x fastReflection_CurrentFeeds_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CurrentFeeds)(nil)
+func (x fastReflection_Feed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Feed)(nil)
 }
-func (x fastReflection_CurrentFeeds_messageType) New() protoreflect.Message {
-	return new(fastReflection_CurrentFeeds)
+func (x fastReflection_Feed_messageType) New() protoreflect.Message {
+	return new(fastReflection_Feed)
 }
-func (x fastReflection_CurrentFeeds_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrentFeeds
+func (x fastReflection_Feed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Feed
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CurrentFeeds) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrentFeeds
+func (x *fastReflection_Feed) Descriptor() protoreflect.MessageDescriptor {
+	return md_Feed
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CurrentFeeds) Type() protoreflect.MessageType {
-	return _fastReflection_CurrentFeeds_messageType
+func (x *fastReflection_Feed) Type() protoreflect.MessageType {
+	return _fastReflection_Feed_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CurrentFeeds) New() protoreflect.Message {
-	return new(fastReflection_CurrentFeeds)
+func (x *fastReflection_Feed) New() protoreflect.Message {
+	return new(fastReflection_Feed)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CurrentFeeds) Interface() protoreflect.ProtoMessage {
-	return (*CurrentFeeds)(x)
+func (x *fastReflection_Feed) Interface() protoreflect.ProtoMessage {
+	return (*Feed)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2250,22 +2119,22 @@ func (x *fastReflection_CurrentFeeds) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CurrentFeeds) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Feeds) != 0 {
-		value := protoreflect.ValueOfList(&_CurrentFeeds_1_list{list: &x.Feeds})
-		if !f(fd_CurrentFeeds_feeds, value) {
+func (x *fastReflection_Feed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_Feed_signal_id, value) {
 			return
 		}
 	}
-	if x.LastUpdateTimestamp != int64(0) {
-		value := protoreflect.ValueOfInt64(x.LastUpdateTimestamp)
-		if !f(fd_CurrentFeeds_last_update_timestamp, value) {
+	if x.Power != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Power)
+		if !f(fd_Feed_power, value) {
 			return
 		}
 	}
-	if x.LastUpdateBlock != int64(0) {
-		value := protoreflect.ValueOfInt64(x.LastUpdateBlock)
-		if !f(fd_CurrentFeeds_last_update_block, value) {
+	if x.Interval != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Interval)
+		if !f(fd_Feed_interval, value) {
 			return
 		}
 	}
@@ -2282,19 +2151,19 @@ func (x *fastReflection_CurrentFeeds) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CurrentFeeds) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Feed) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.CurrentFeeds.feeds":
-		return len(x.Feeds) != 0
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
-		return x.LastUpdateTimestamp != int64(0)
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
-		return x.LastUpdateBlock != int64(0)
+	case "band.feeds.v1beta1.Feed.signal_id":
+		return x.SignalId != ""
+	case "band.feeds.v1beta1.Feed.power":
+		return x.Power != int64(0)
+	case "band.feeds.v1beta1.Feed.interval":
+		return x.Interval != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2304,19 +2173,19 @@ func (x *fastReflection_CurrentFeeds) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrentFeeds) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Feed) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.CurrentFeeds.feeds":
-		x.Feeds = nil
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
-		x.LastUpdateTimestamp = int64(0)
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
-		x.LastUpdateBlock = int64(0)
+	case "band.feeds.v1beta1.Feed.signal_id":
+		x.SignalId = ""
+	case "band.feeds.v1beta1.Feed.power":
+		x.Power = int64(0)
+	case "band.feeds.v1beta1.Feed.interval":
+		x.Interval = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2326,25 +2195,22 @@ func (x *fastReflection_CurrentFeeds) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CurrentFeeds) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Feed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.CurrentFeeds.feeds":
-		if len(x.Feeds) == 0 {
-			return protoreflect.ValueOfList(&_CurrentFeeds_1_list{})
-		}
-		listValue := &_CurrentFeeds_1_list{list: &x.Feeds}
-		return protoreflect.ValueOfList(listValue)
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
-		value := x.LastUpdateTimestamp
-		return protoreflect.ValueOfInt64(value)
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
-		value := x.LastUpdateBlock
+	case "band.feeds.v1beta1.Feed.signal_id":
+		value := x.SignalId
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.Feed.power":
+		value := x.Power
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.Feed.interval":
+		value := x.Interval
 		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2358,21 +2224,19 @@ func (x *fastReflection_CurrentFeeds) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrentFeeds) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Feed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.CurrentFeeds.feeds":
-		lv := value.List()
-		clv := lv.(*_CurrentFeeds_1_list)
-		x.Feeds = *clv.list
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
-		x.LastUpdateTimestamp = value.Int()
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
-		x.LastUpdateBlock = value.Int()
+	case "band.feeds.v1beta1.Feed.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.feeds.v1beta1.Feed.power":
+		x.Power = value.Int()
+	case "band.feeds.v1beta1.Feed.interval":
+		x.Interval = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2386,53 +2250,48 @@ func (x *fastReflection_CurrentFeeds) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrentFeeds) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Feed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.CurrentFeeds.feeds":
-		if x.Feeds == nil {
-			x.Feeds = []*Feed{}
-		}
-		value := &_CurrentFeeds_1_list{list: &x.Feeds}
-		return protoreflect.ValueOfList(value)
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
-		panic(fmt.Errorf("field last_update_timestamp of message band.feeds.v1beta1.CurrentFeeds is not mutable"))
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
-		panic(fmt.Errorf("field last_update_block of message band.feeds.v1beta1.CurrentFeeds is not mutable"))
+	case "band.feeds.v1beta1.Feed.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.Feed is not mutable"))
+	case "band.feeds.v1beta1.Feed.power":
+		panic(fmt.Errorf("field power of message band.feeds.v1beta1.Feed is not mutable"))
+	case "band.feeds.v1beta1.Feed.interval":
+		panic(fmt.Errorf("field interval of message band.feeds.v1beta1.Feed is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CurrentFeeds) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Feed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.CurrentFeeds.feeds":
-		list := []*Feed{}
-		return protoreflect.ValueOfList(&_CurrentFeeds_1_list{list: &list})
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
+	case "band.feeds.v1beta1.Feed.signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.Feed.power":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
+	case "band.feeds.v1beta1.Feed.interval":
 		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CurrentFeeds) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Feed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.CurrentFeeds", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.Feed", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2440,7 +2299,7 @@ func (x *fastReflection_CurrentFeeds) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CurrentFeeds) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Feed) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2451,7 +2310,7 @@ func (x *fastReflection_CurrentFeeds) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrentFeeds) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Feed) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2463,7 +2322,7 @@ func (x *fastReflection_CurrentFeeds) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CurrentFeeds) IsValid() bool {
+func (x *fastReflection_Feed) IsValid() bool {
 	return x != nil
 }
 
@@ -2473,9 +2332,9 @@ func (x *fastReflection_CurrentFeeds) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CurrentFeeds)
+		x := input.Message.Interface().(*Feed)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2487,17 +2346,15 @@ func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Feeds) > 0 {
-			for _, e := range x.Feeds {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.SignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.LastUpdateTimestamp != 0 {
-			n += 1 + runtime.Sov(uint64(x.LastUpdateTimestamp))
+		if x.Power != 0 {
+			n += 1 + runtime.Sov(uint64(x.Power))
 		}
-		if x.LastUpdateBlock != 0 {
-			n += 1 + runtime.Sov(uint64(x.LastUpdateBlock))
+		if x.Interval != 0 {
+			n += 1 + runtime.Sov(uint64(x.Interval))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2509,7 +2366,7 @@ func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CurrentFeeds)
+		x := input.Message.Interface().(*Feed)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2528,31 +2385,22 @@ func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.LastUpdateBlock != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdateBlock))
+		if x.Interval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
 			i--
 			dAtA[i] = 0x18
 		}
-		if x.LastUpdateTimestamp != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdateTimestamp))
+		if x.Power != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.Feeds) > 0 {
-			for iNdEx := len(x.Feeds) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Feeds[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2565,7 +2413,7 @@ func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CurrentFeeds)
+		x := input.Message.Interface().(*Feed)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2597,17 +2445,17 @@ func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrentFeeds: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Feed: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrentFeeds: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Feed: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2617,31 +2465,29 @@ func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Feeds = append(x.Feeds, &Feed{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Feeds[len(x.Feeds)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.SignalId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 				}
-				x.LastUpdateTimestamp = 0
+				x.Power = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2651,16 +2497,16 @@ func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.LastUpdateTimestamp |= int64(b&0x7F) << shift
+					x.Power |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 				}
-				x.LastUpdateBlock = 0
+				x.Interval = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2670,7 +2516,7 @@ func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.LastUpdateBlock |= int64(b&0x7F) << shift
+					x.Interval |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -2710,81 +2556,32 @@ func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_CurrentFeedWithDeviations_1_list)(nil)
-
-type _CurrentFeedWithDeviations_1_list struct {
-	list *[]*FeedWithDeviation
-}
-
-func (x *_CurrentFeedWithDeviations_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_CurrentFeedWithDeviations_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_CurrentFeedWithDeviations_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*FeedWithDeviation)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_CurrentFeedWithDeviations_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*FeedWithDeviation)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_CurrentFeedWithDeviations_1_list) AppendMutable() protoreflect.Value {
-	v := new(FeedWithDeviation)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_CurrentFeedWithDeviations_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_CurrentFeedWithDeviations_1_list) NewElement() protoreflect.Value {
-	v := new(FeedWithDeviation)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_CurrentFeedWithDeviations_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_CurrentFeedWithDeviations                       protoreflect.MessageDescriptor
-	fd_CurrentFeedWithDeviations_feeds                 protoreflect.FieldDescriptor
-	fd_CurrentFeedWithDeviations_last_update_timestamp protoreflect.FieldDescriptor
-	fd_CurrentFeedWithDeviations_last_update_block     protoreflect.FieldDescriptor
+	md_FeedWithDeviation                       protoreflect.MessageDescriptor
+	fd_FeedWithDeviation_signal_id             protoreflect.FieldDescriptor
+	fd_FeedWithDeviation_power                 protoreflect.FieldDescriptor
+	fd_FeedWithDeviation_interval              protoreflect.FieldDescriptor
+	fd_FeedWithDeviation_deviation_basis_point protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_feeds_proto_init()
-	md_CurrentFeedWithDeviations = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("CurrentFeedWithDeviations")
-	fd_CurrentFeedWithDeviations_feeds = md_CurrentFeedWithDeviations.Fields().ByName("feeds")
-	fd_CurrentFeedWithDeviations_last_update_timestamp = md_CurrentFeedWithDeviations.Fields().ByName("last_update_timestamp")
-	fd_CurrentFeedWithDeviations_last_update_block = md_CurrentFeedWithDeviations.Fields().ByName("last_update_block")
+	md_FeedWithDeviation = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("FeedWithDeviation")
+	fd_FeedWithDeviation_signal_id = md_FeedWithDeviation.Fields().ByName("signal_id")
+	fd_FeedWithDeviation_power = md_FeedWithDeviation.Fields().ByName("power")
+	fd_FeedWithDeviation_interval = md_FeedWithDeviation.Fields().ByName("interval")
+	fd_FeedWithDeviation_deviation_basis_point = md_FeedWithDeviation.Fields().ByName("deviation_basis_point")
 }
 
-var _ protoreflect.Message = (*fastReflection_CurrentFeedWithDeviations)(nil)
+var _ protoreflect.Message = (*fastReflection_FeedWithDeviation)(nil)
 
-type fastReflection_CurrentFeedWithDeviations CurrentFeedWithDeviations
+type fastReflection_FeedWithDeviation FeedWithDeviation
 
-func (x *CurrentFeedWithDeviations) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CurrentFeedWithDeviations)(x)
+func (x *FeedWithDeviation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeedWithDeviation)(x)
 }
 
-func (x *CurrentFeedWithDeviations) slowProtoReflect() protoreflect.Message {
+func (x *FeedWithDeviation) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2796,43 +2593,43 @@ func (x *CurrentFeedWithDeviations) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CurrentFeedWithDeviations_messageType fastReflection_CurrentFeedWithDeviations_messageType
-var _ protoreflect.MessageType = fastReflection_CurrentFeedWithDeviations_messageType{}
+var _fastReflection_FeedWithDeviation_messageType fastReflection_FeedWithDeviation_messageType
+var _ protoreflect.MessageType = fastReflection_FeedWithDeviation_messageType{}
 
-type fastReflection_CurrentFeedWithDeviations_messageType struct{}
+type fastReflection_FeedWithDeviation_messageType struct{}
 
-func (x fastReflection_CurrentFeedWithDeviations_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CurrentFeedWithDeviations)(nil)
+func (x fastReflection_FeedWithDeviation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeedWithDeviation)(nil)
 }
-func (x fastReflection_CurrentFeedWithDeviations_messageType) New() protoreflect.Message {
-	return new(fastReflection_CurrentFeedWithDeviations)
+func (x fastReflection_FeedWithDeviation_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeedWithDeviation)
 }
-func (x fastReflection_CurrentFeedWithDeviations_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrentFeedWithDeviations
+func (x fastReflection_FeedWithDeviation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeedWithDeviation
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CurrentFeedWithDeviations) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrentFeedWithDeviations
+func (x *fastReflection_FeedWithDeviation) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeedWithDeviation
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CurrentFeedWithDeviations) Type() protoreflect.MessageType {
-	return _fastReflection_CurrentFeedWithDeviations_messageType
+func (x *fastReflection_FeedWithDeviation) Type() protoreflect.MessageType {
+	return _fastReflection_FeedWithDeviation_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CurrentFeedWithDeviations) New() protoreflect.Message {
-	return new(fastReflection_CurrentFeedWithDeviations)
+func (x *fastReflection_FeedWithDeviation) New() protoreflect.Message {
+	return new(fastReflection_FeedWithDeviation)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CurrentFeedWithDeviations) Interface() protoreflect.ProtoMessage {
-	return (*CurrentFeedWithDeviations)(x)
+func (x *fastReflection_FeedWithDeviation) Interface() protoreflect.ProtoMessage {
+	return (*FeedWithDeviation)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2840,22 +2637,28 @@ func (x *fastReflection_CurrentFeedWithDeviations) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CurrentFeedWithDeviations) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Feeds) != 0 {
-		value := protoreflect.ValueOfList(&_CurrentFeedWithDeviations_1_list{list: &x.Feeds})
-		if !f(fd_CurrentFeedWithDeviations_feeds, value) {
+func (x *fastReflection_FeedWithDeviation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_FeedWithDeviation_signal_id, value) {
 			return
 		}
 	}
-	if x.LastUpdateTimestamp != int64(0) {
-		value := protoreflect.ValueOfInt64(x.LastUpdateTimestamp)
-		if !f(fd_CurrentFeedWithDeviations_last_update_timestamp, value) {
+	if x.Power != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Power)
+		if !f(fd_FeedWithDeviation_power, value) {
 			return
 		}
 	}
-	if x.LastUpdateBlock != int64(0) {
-		value := protoreflect.ValueOfInt64(x.LastUpdateBlock)
-		if !f(fd_CurrentFeedWithDeviations_last_update_block, value) {
+	if x.Interval != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Interval)
+		if !f(fd_FeedWithDeviation_interval, value) {
+			return
+		}
+	}
+	if x.DeviationBasisPoint != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DeviationBasisPoint)
+		if !f(fd_FeedWithDeviation_deviation_basis_point, value) {
 			return
 		}
 	}
@@ -2872,19 +2675,21 @@ func (x *fastReflection_CurrentFeedWithDeviations) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CurrentFeedWithDeviations) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_FeedWithDeviation) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
-		return len(x.Feeds) != 0
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
-		return x.LastUpdateTimestamp != int64(0)
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
-		return x.LastUpdateBlock != int64(0)
+	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
+		return x.SignalId != ""
+	case "band.feeds.v1beta1.FeedWithDeviation.power":
+		return x.Power != int64(0)
+	case "band.feeds.v1beta1.FeedWithDeviation.interval":
+		return x.Interval != int64(0)
+	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
+		return x.DeviationBasisPoint != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2894,19 +2699,21 @@ func (x *fastReflection_CurrentFeedWithDeviations) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrentFeedWithDeviations) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_FeedWithDeviation) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
-		x.Feeds = nil
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
-		x.LastUpdateTimestamp = int64(0)
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
-		x.LastUpdateBlock = int64(0)
+	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
+		x.SignalId = ""
+	case "band.feeds.v1beta1.FeedWithDeviation.power":
+		x.Power = int64(0)
+	case "band.feeds.v1beta1.FeedWithDeviation.interval":
+		x.Interval = int64(0)
+	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
+		x.DeviationBasisPoint = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2916,25 +2723,25 @@ func (x *fastReflection_CurrentFeedWithDeviations) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CurrentFeedWithDeviations) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeedWithDeviation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
-		if len(x.Feeds) == 0 {
-			return protoreflect.ValueOfList(&_CurrentFeedWithDeviations_1_list{})
-		}
-		listValue := &_CurrentFeedWithDeviations_1_list{list: &x.Feeds}
-		return protoreflect.ValueOfList(listValue)
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
-		value := x.LastUpdateTimestamp
+	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
+		value := x.SignalId
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.FeedWithDeviation.power":
+		value := x.Power
 		return protoreflect.ValueOfInt64(value)
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
-		value := x.LastUpdateBlock
+	case "band.feeds.v1beta1.FeedWithDeviation.interval":
+		value := x.Interval
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
+		value := x.DeviationBasisPoint
 		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2948,21 +2755,21 @@ func (x *fastReflection_CurrentFeedWithDeviations) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrentFeedWithDeviations) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_FeedWithDeviation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
-		lv := value.List()
-		clv := lv.(*_CurrentFeedWithDeviations_1_list)
-		x.Feeds = *clv.list
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
-		x.LastUpdateTimestamp = value.Int()
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
-		x.LastUpdateBlock = value.Int()
+	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.feeds.v1beta1.FeedWithDeviation.power":
+		x.Power = value.Int()
+	case "band.feeds.v1beta1.FeedWithDeviation.interval":
+		x.Interval = value.Int()
+	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
+		x.DeviationBasisPoint = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2976,53 +2783,52 @@ func (x *fastReflection_CurrentFeedWithDeviations) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrentFeedWithDeviations) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeedWithDeviation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
-		if x.Feeds == nil {
-			x.Feeds = []*FeedWithDeviation{}
-		}
-		value := &_CurrentFeedWithDeviations_1_list{list: &x.Feeds}
-		return protoreflect.ValueOfList(value)
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
-		panic(fmt.Errorf("field last_update_timestamp of message band.feeds.v1beta1.CurrentFeedWithDeviations is not mutable"))
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
-		panic(fmt.Errorf("field last_update_block of message band.feeds.v1beta1.CurrentFeedWithDeviations is not mutable"))
+	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.FeedWithDeviation is not mutable"))
+	case "band.feeds.v1beta1.FeedWithDeviation.power":
+		panic(fmt.Errorf("field power of message band.feeds.v1beta1.FeedWithDeviation is not mutable"))
+	case "band.feeds.v1beta1.FeedWithDeviation.interval":
+		panic(fmt.Errorf("field interval of message band.feeds.v1beta1.FeedWithDeviation is not mutable"))
+	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
+		panic(fmt.Errorf("field deviation_basis_point of message band.feeds.v1beta1.FeedWithDeviation is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CurrentFeedWithDeviations) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeedWithDeviation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
-		list := []*FeedWithDeviation{}
-		return protoreflect.ValueOfList(&_CurrentFeedWithDeviations_1_list{list: &list})
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
+	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.FeedWithDeviation.power":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
+	case "band.feeds.v1beta1.FeedWithDeviation.interval":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
 		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CurrentFeedWithDeviations) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_FeedWithDeviation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.CurrentFeedWithDeviations", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.FeedWithDeviation", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3030,7 +2836,7 @@ func (x *fastReflection_CurrentFeedWithDeviations) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CurrentFeedWithDeviations) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_FeedWithDeviation) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3041,7 +2847,7 @@ func (x *fastReflection_CurrentFeedWithDeviations) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrentFeedWithDeviations) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_FeedWithDeviation) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3053,7 +2859,7 @@ func (x *fastReflection_CurrentFeedWithDeviations) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CurrentFeedWithDeviations) IsValid() bool {
+func (x *fastReflection_FeedWithDeviation) IsValid() bool {
 	return x != nil
 }
 
@@ -3063,9 +2869,9 @@ func (x *fastReflection_CurrentFeedWithDeviations) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CurrentFeedWithDeviations)
+		x := input.Message.Interface().(*FeedWithDeviation)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3077,17 +2883,18 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if len(x.Feeds) > 0 {
-			for _, e := range x.Feeds {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.SignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.LastUpdateTimestamp != 0 {
-			n += 1 + runtime.Sov(uint64(x.LastUpdateTimestamp))
+		if x.Power != 0 {
+			n += 1 + runtime.Sov(uint64(x.Power))
 		}
-		if x.LastUpdateBlock != 0 {
-			n += 1 + runtime.Sov(uint64(x.LastUpdateBlock))
+		if x.Interval != 0 {
+			n += 1 + runtime.Sov(uint64(x.Interval))
+		}
+		if x.DeviationBasisPoint != 0 {
+			n += 1 + runtime.Sov(uint64(x.DeviationBasisPoint))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3099,7 +2906,7 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CurrentFeedWithDeviations)
+		x := input.Message.Interface().(*FeedWithDeviation)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3118,31 +2925,27 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.LastUpdateBlock != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdateBlock))
+		if x.DeviationBasisPoint != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeviationBasisPoint))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Interval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
 			i--
 			dAtA[i] = 0x18
 		}
-		if x.LastUpdateTimestamp != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdateTimestamp))
+		if x.Power != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.Feeds) > 0 {
-			for iNdEx := len(x.Feeds) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Feeds[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3155,7 +2958,7 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CurrentFeedWithDeviations)
+		x := input.Message.Interface().(*FeedWithDeviation)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3187,17 +2990,17 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrentFeedWithDeviations: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeedWithDeviation: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrentFeedWithDeviations: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeedWithDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3207,31 +3010,29 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Feeds = append(x.Feeds, &FeedWithDeviation{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Feeds[len(x.Feeds)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.SignalId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 				}
-				x.LastUpdateTimestamp = 0
+				x.Power = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3241,16 +3042,16 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.LastUpdateTimestamp |= int64(b&0x7F) << shift
+					x.Power |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 				}
-				x.LastUpdateBlock = 0
+				x.Interval = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3260,7 +3061,26 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.LastUpdateBlock |= int64(b&0x7F) << shift
+					x.Interval |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeviationBasisPoint", wireType)
+				}
+				x.DeviationBasisPoint = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DeviationBasisPoint |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -3300,32 +3120,81 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 	}
 }
 
+var _ protoreflect.List = (*_CurrentFeeds_1_list)(nil)
+
+type _CurrentFeeds_1_list struct {
+	list *[]*Feed
+}
+
+func (x *_CurrentFeeds_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_CurrentFeeds_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_CurrentFeeds_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Feed)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_CurrentFeeds_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Feed)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_CurrentFeeds_1_list) AppendMutable() protoreflect.Value {
+	v := new(Feed)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CurrentFeeds_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CurrentFeeds_1_list) NewElement() protoreflect.Value {
+	v := new(Feed)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CurrentFeeds_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Price           protoreflect.MessageDescriptor
-	fd_Price_status    protoreflect.FieldDescriptor
-	fd_Price_signal_id protoreflect.FieldDescriptor
-	fd_Price_price     protoreflect.FieldDescriptor
-	fd_Price_timestamp protoreflect.FieldDescriptor
+	md_CurrentFeeds                       protoreflect.MessageDescriptor
+	fd_CurrentFeeds_feeds                 protoreflect.FieldDescriptor
+	fd_CurrentFeeds_last_update_timestamp protoreflect.FieldDescriptor
+	fd_CurrentFeeds_last_update_block     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_feeds_proto_init()
-	md_Price = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("Price")
-	fd_Price_status = md_Price.Fields().ByName("status")
-	fd_Price_signal_id = md_Price.Fields().ByName("signal_id")
-	fd_Price_price = md_Price.Fields().ByName("price")
-	fd_Price_timestamp = md_Price.Fields().ByName("timestamp")
+	md_CurrentFeeds = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("CurrentFeeds")
+	fd_CurrentFeeds_feeds = md_CurrentFeeds.Fields().ByName("feeds")
+	fd_CurrentFeeds_last_update_timestamp = md_CurrentFeeds.Fields().ByName("last_update_timestamp")
+	fd_CurrentFeeds_last_update_block = md_CurrentFeeds.Fields().ByName("last_update_block")
 }
 
-var _ protoreflect.Message = (*fastReflection_Price)(nil)
+var _ protoreflect.Message = (*fastReflection_CurrentFeeds)(nil)
 
-type fastReflection_Price Price
+type fastReflection_CurrentFeeds CurrentFeeds
 
-func (x *Price) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Price)(x)
+func (x *CurrentFeeds) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CurrentFeeds)(x)
 }
 
-func (x *Price) slowProtoReflect() protoreflect.Message {
+func (x *CurrentFeeds) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3337,43 +3206,43 @@ func (x *Price) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Price_messageType fastReflection_Price_messageType
-var _ protoreflect.MessageType = fastReflection_Price_messageType{}
+var _fastReflection_CurrentFeeds_messageType fastReflection_CurrentFeeds_messageType
+var _ protoreflect.MessageType = fastReflection_CurrentFeeds_messageType{}
 
-type fastReflection_Price_messageType struct{}
+type fastReflection_CurrentFeeds_messageType struct{}
 
-func (x fastReflection_Price_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Price)(nil)
+func (x fastReflection_CurrentFeeds_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CurrentFeeds)(nil)
 }
-func (x fastReflection_Price_messageType) New() protoreflect.Message {
-	return new(fastReflection_Price)
+func (x fastReflection_CurrentFeeds_messageType) New() protoreflect.Message {
+	return new(fastReflection_CurrentFeeds)
 }
-func (x fastReflection_Price_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Price
+func (x fastReflection_CurrentFeeds_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrentFeeds
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Price) Descriptor() protoreflect.MessageDescriptor {
-	return md_Price
+func (x *fastReflection_CurrentFeeds) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrentFeeds
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Price) Type() protoreflect.MessageType {
-	return _fastReflection_Price_messageType
+func (x *fastReflection_CurrentFeeds) Type() protoreflect.MessageType {
+	return _fastReflection_CurrentFeeds_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Price) New() protoreflect.Message {
-	return new(fastReflection_Price)
+func (x *fastReflection_CurrentFeeds) New() protoreflect.Message {
+	return new(fastReflection_CurrentFeeds)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Price) Interface() protoreflect.ProtoMessage {
-	return (*Price)(x)
+func (x *fastReflection_CurrentFeeds) Interface() protoreflect.ProtoMessage {
+	return (*CurrentFeeds)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3381,28 +3250,22 @@ func (x *fastReflection_Price) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Price) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Status != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
-		if !f(fd_Price_status, value) {
-			return
-		}
-	}
-	if x.SignalId != "" {
-		value := protoreflect.ValueOfString(x.SignalId)
-		if !f(fd_Price_signal_id, value) {
+func (x *fastReflection_CurrentFeeds) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Feeds) != 0 {
+		value := protoreflect.ValueOfList(&_CurrentFeeds_1_list{list: &x.Feeds})
+		if !f(fd_CurrentFeeds_feeds, value) {
 			return
 		}
 	}
-	if x.Price != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Price)
-		if !f(fd_Price_price, value) {
+	if x.LastUpdateTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastUpdateTimestamp)
+		if !f(fd_CurrentFeeds_last_update_timestamp, value) {
 			return
 		}
 	}
-	if x.Timestamp != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Timestamp)
-		if !f(fd_Price_timestamp, value) {
+	if x.LastUpdateBlock != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastUpdateBlock)
+		if !f(fd_CurrentFeeds_last_update_block, value) {
 			return
 		}
 	}
@@ -3419,21 +3282,19 @@ func (x *fastReflection_Price) Range(f func(protoreflect.FieldDescriptor, protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Price) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CurrentFeeds) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.Price.status":
-		return x.Status != 0
-	case "band.feeds.v1beta1.Price.signal_id":
-		return x.SignalId != ""
-	case "band.feeds.v1beta1.Price.price":
-		return x.Price != uint64(0)
-	case "band.feeds.v1beta1.Price.timestamp":
-		return x.Timestamp != int64(0)
+	case "band.feeds.v1beta1.CurrentFeeds.feeds":
+		return len(x.Feeds) != 0
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
+		return x.LastUpdateTimestamp != int64(0)
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
+		return x.LastUpdateBlock != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3443,21 +3304,19 @@ func (x *fastReflection_Price) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Price) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CurrentFeeds) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.Price.status":
-		x.Status = 0
-	case "band.feeds.v1beta1.Price.signal_id":
-		x.SignalId = ""
-	case "band.feeds.v1beta1.Price.price":
-		x.Price = uint64(0)
-	case "band.feeds.v1beta1.Price.timestamp":
-		x.Timestamp = int64(0)
+	case "band.feeds.v1beta1.CurrentFeeds.feeds":
+		x.Feeds = nil
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
+		x.LastUpdateTimestamp = int64(0)
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
+		x.LastUpdateBlock = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3467,25 +3326,25 @@ func (x *fastReflection_Price) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Price) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CurrentFeeds) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.Price.status":
-		value := x.Status
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "band.feeds.v1beta1.Price.signal_id":
-		value := x.SignalId
-		return protoreflect.ValueOfString(value)
-	case "band.feeds.v1beta1.Price.price":
-		value := x.Price
-		return protoreflect.ValueOfUint64(value)
-	case "band.feeds.v1beta1.Price.timestamp":
-		value := x.Timestamp
+	case "band.feeds.v1beta1.CurrentFeeds.feeds":
+		if len(x.Feeds) == 0 {
+			return protoreflect.ValueOfList(&_CurrentFeeds_1_list{})
+		}
+		listValue := &_CurrentFeeds_1_list{list: &x.Feeds}
+		return protoreflect.ValueOfList(listValue)
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
+		value := x.LastUpdateTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
+		value := x.LastUpdateBlock
 		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3499,21 +3358,21 @@ func (x *fastReflection_Price) Get(descriptor protoreflect.FieldDescriptor) prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Price) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CurrentFeeds) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.Price.status":
-		x.Status = (PriceStatus)(value.Enum())
-	case "band.feeds.v1beta1.Price.signal_id":
-		x.SignalId = value.Interface().(string)
-	case "band.feeds.v1beta1.Price.price":
-		x.Price = value.Uint()
-	case "band.feeds.v1beta1.Price.timestamp":
-		x.Timestamp = value.Int()
-	default:
+	case "band.feeds.v1beta1.CurrentFeeds.feeds":
+		lv := value.List()
+		clv := lv.(*_CurrentFeeds_1_list)
+		x.Feeds = *clv.list
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
+		x.LastUpdateTimestamp = value.Int()
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
+		x.LastUpdateBlock = value.Int()
+	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3527,52 +3386,53 @@ func (x *fastReflection_Price) Set(fd protoreflect.FieldDescriptor, value protor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Price) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CurrentFeeds) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.Price.status":
-		panic(fmt.Errorf("field status of message band.feeds.v1beta1.Price is not mutable"))
-	case "band.feeds.v1beta1.Price.signal_id":
-		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.Price is not mutable"))
-	case "band.feeds.v1beta1.Price.price":
-		panic(fmt.Errorf("field price of message band.feeds.v1beta1.Price is not mutable"))
-	case "band.feeds.v1beta1.Price.timestamp":
-		panic(fmt.Errorf("field timestamp of message band.feeds.v1beta1.Price is not mutable"))
+	case "band.feeds.v1beta1.CurrentFeeds.feeds":
+		if x.Feeds == nil {
+			x.Feeds = []*Feed{}
+		}
+		value := &_CurrentFeeds_1_list{list: &x.Feeds}
+		return protoreflect.ValueOfList(value)
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
+		panic(fmt.Errorf("field last_update_timestamp of message band.feeds.v1beta1.CurrentFeeds is not mutable"))
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
+		panic(fmt.Errorf("field last_update_block of message band.feeds.v1beta1.CurrentFeeds is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Price) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CurrentFeeds) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.Price.status":
-		return protoreflect.ValueOfEnum(0)
-	case "band.feeds.v1beta1.Price.signal_id":
-		return protoreflect.ValueOfString("")
-	case "band.feeds.v1beta1.Price.price":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.feeds.v1beta1.Price.timestamp":
+	case "band.feeds.v1beta1.CurrentFeeds.feeds":
+		list := []*Feed{}
+		return protoreflect.ValueOfList(&_CurrentFeeds_1_list{list: &list})
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
 		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Price) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CurrentFeeds) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.Price", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.CurrentFeeds", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3580,7 +3440,7 @@ func (x *fastReflection_Price) WhichOneof(d protoreflect.OneofDescriptor) protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Price) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CurrentFeeds) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3591,7 +3451,7 @@ func (x *fastReflection_Price) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Price) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CurrentFeeds) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3603,7 +3463,7 @@ func (x *fastReflection_Price) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Price) IsValid() bool {
+func (x *fastReflection_CurrentFeeds) IsValid() bool {
 	return x != nil
 }
 
@@ -3613,9 +3473,9 @@ func (x *fastReflection_Price) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Price)
+		x := input.Message.Interface().(*CurrentFeeds)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3627,18 +3487,17 @@ func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Status != 0 {
-			n += 1 + runtime.Sov(uint64(x.Status))
-		}
-		l = len(x.SignalId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Feeds) > 0 {
+			for _, e := range x.Feeds {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		if x.Price != 0 {
-			n += 1 + runtime.Sov(uint64(x.Price))
+		if x.LastUpdateTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastUpdateTimestamp))
 		}
-		if x.Timestamp != 0 {
-			n += 1 + runtime.Sov(uint64(x.Timestamp))
+		if x.LastUpdateBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastUpdateBlock))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3650,7 +3509,7 @@ func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Price)
+		x := input.Message.Interface().(*CurrentFeeds)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3669,27 +3528,31 @@ func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Timestamp != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timestamp))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.Price != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Price))
+		if x.LastUpdateBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdateBlock))
 			i--
 			dAtA[i] = 0x18
 		}
-		if len(x.SignalId) > 0 {
-			i -= len(x.SignalId)
-			copy(dAtA[i:], x.SignalId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+		if x.LastUpdateTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdateTimestamp))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if x.Status != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
-			i--
-			dAtA[i] = 0x8
+		if len(x.Feeds) > 0 {
+			for iNdEx := len(x.Feeds) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Feeds[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3702,7 +3565,7 @@ func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Price)
+		x := input.Message.Interface().(*CurrentFeeds)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3734,36 +3597,17 @@ func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Price: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrentFeeds: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Price: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrentFeeds: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-				}
-				x.Status = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Status |= PriceStatus(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3773,29 +3617,31 @@ func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SignalId = string(dAtA[iNdEx:postIndex])
+				x.Feeds = append(x.Feeds, &Feed{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Feeds[len(x.Feeds)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 3:
+			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
 				}
-				x.Price = 0
+				x.LastUpdateTimestamp = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3805,16 +3651,16 @@ func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Price |= uint64(b&0x7F) << shift
+					x.LastUpdateTimestamp |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 4:
+			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlock", wireType)
 				}
-				x.Timestamp = 0
+				x.LastUpdateBlock = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3824,7 +3670,7 @@ func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Timestamp |= int64(b&0x7F) << shift
+					x.LastUpdateBlock |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -3864,78 +3710,129 @@ func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var (
-	md_SignalPrice           protoreflect.MessageDescriptor
-	fd_SignalPrice_status    protoreflect.FieldDescriptor
-	fd_SignalPrice_signal_id protoreflect.FieldDescriptor
-	fd_SignalPrice_price     protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_band_feeds_v1beta1_feeds_proto_init()
-	md_SignalPrice = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("SignalPrice")
-	fd_SignalPrice_status = md_SignalPrice.Fields().ByName("status")
-	fd_SignalPrice_signal_id = md_SignalPrice.Fields().ByName("signal_id")
-	fd_SignalPrice_price = md_SignalPrice.Fields().ByName("price")
-}
-
-var _ protoreflect.Message = (*fastReflection_SignalPrice)(nil)
-
-type fastReflection_SignalPrice SignalPrice
+var _ protoreflect.List = (*_CurrentFeedWithDeviations_1_list)(nil)
 
-func (x *SignalPrice) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SignalPrice)(x)
+type _CurrentFeedWithDeviations_1_list struct {
+	list *[]*FeedWithDeviation
 }
 
-func (x *SignalPrice) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *_CurrentFeedWithDeviations_1_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	return mi.MessageOf(x)
+	return len(*x.list)
 }
 
-var _fastReflection_SignalPrice_messageType fastReflection_SignalPrice_messageType
-var _ protoreflect.MessageType = fastReflection_SignalPrice_messageType{}
-
-type fastReflection_SignalPrice_messageType struct{}
-
-func (x fastReflection_SignalPrice_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SignalPrice)(nil)
-}
-func (x fastReflection_SignalPrice_messageType) New() protoreflect.Message {
-	return new(fastReflection_SignalPrice)
+func (x *_CurrentFeedWithDeviations_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
-func (x fastReflection_SignalPrice_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SignalPrice
+
+func (x *_CurrentFeedWithDeviations_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeedWithDeviation)
+	(*x.list)[i] = concreteValue
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_SignalPrice) Descriptor() protoreflect.MessageDescriptor {
-	return md_SignalPrice
+func (x *_CurrentFeedWithDeviations_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeedWithDeviation)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SignalPrice) Type() protoreflect.MessageType {
-	return _fastReflection_SignalPrice_messageType
+func (x *_CurrentFeedWithDeviations_1_list) AppendMutable() protoreflect.Value {
+	v := new(FeedWithDeviation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CurrentFeedWithDeviations_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CurrentFeedWithDeviations_1_list) NewElement() protoreflect.Value {
+	v := new(FeedWithDeviation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CurrentFeedWithDeviations_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_CurrentFeedWithDeviations                       protoreflect.MessageDescriptor
+	fd_CurrentFeedWithDeviations_feeds                 protoreflect.FieldDescriptor
+	fd_CurrentFeedWithDeviations_last_update_timestamp protoreflect.FieldDescriptor
+	fd_CurrentFeedWithDeviations_last_update_block     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_feeds_proto_init()
+	md_CurrentFeedWithDeviations = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("CurrentFeedWithDeviations")
+	fd_CurrentFeedWithDeviations_feeds = md_CurrentFeedWithDeviations.Fields().ByName("feeds")
+	fd_CurrentFeedWithDeviations_last_update_timestamp = md_CurrentFeedWithDeviations.Fields().ByName("last_update_timestamp")
+	fd_CurrentFeedWithDeviations_last_update_block = md_CurrentFeedWithDeviations.Fields().ByName("last_update_block")
+}
+
+var _ protoreflect.Message = (*fastReflection_CurrentFeedWithDeviations)(nil)
+
+type fastReflection_CurrentFeedWithDeviations CurrentFeedWithDeviations
+
+func (x *CurrentFeedWithDeviations) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CurrentFeedWithDeviations)(x)
+}
+
+func (x *CurrentFeedWithDeviations) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CurrentFeedWithDeviations_messageType fastReflection_CurrentFeedWithDeviations_messageType
+var _ protoreflect.MessageType = fastReflection_CurrentFeedWithDeviations_messageType{}
+
+type fastReflection_CurrentFeedWithDeviations_messageType struct{}
+
+func (x fastReflection_CurrentFeedWithDeviations_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CurrentFeedWithDeviations)(nil)
+}
+func (x fastReflection_CurrentFeedWithDeviations_messageType) New() protoreflect.Message {
+	return new(fastReflection_CurrentFeedWithDeviations)
+}
+func (x fastReflection_CurrentFeedWithDeviations_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrentFeedWithDeviations
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CurrentFeedWithDeviations) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrentFeedWithDeviations
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CurrentFeedWithDeviations) Type() protoreflect.MessageType {
+	return _fastReflection_CurrentFeedWithDeviations_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SignalPrice) New() protoreflect.Message {
-	return new(fastReflection_SignalPrice)
+func (x *fastReflection_CurrentFeedWithDeviations) New() protoreflect.Message {
+	return new(fastReflection_CurrentFeedWithDeviations)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SignalPrice) Interface() protoreflect.ProtoMessage {
-	return (*SignalPrice)(x)
+func (x *fastReflection_CurrentFeedWithDeviations) Interface() protoreflect.ProtoMessage {
+	return (*CurrentFeedWithDeviations)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3943,22 +3840,22 @@ func (x *fastReflection_SignalPrice) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SignalPrice) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Status != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
-		if !f(fd_SignalPrice_status, value) {
+func (x *fastReflection_CurrentFeedWithDeviations) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Feeds) != 0 {
+		value := protoreflect.ValueOfList(&_CurrentFeedWithDeviations_1_list{list: &x.Feeds})
+		if !f(fd_CurrentFeedWithDeviations_feeds, value) {
 			return
 		}
 	}
-	if x.SignalId != "" {
-		value := protoreflect.ValueOfString(x.SignalId)
-		if !f(fd_SignalPrice_signal_id, value) {
+	if x.LastUpdateTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastUpdateTimestamp)
+		if !f(fd_CurrentFeedWithDeviations_last_update_timestamp, value) {
 			return
 		}
 	}
-	if x.Price != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Price)
-		if !f(fd_SignalPrice_price, value) {
+	if x.LastUpdateBlock != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastUpdateBlock)
+		if !f(fd_CurrentFeedWithDeviations_last_update_block, value) {
 			return
 		}
 	}
@@ -3975,19 +3872,19 @@ func (x *fastReflection_SignalPrice) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SignalPrice) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CurrentFeedWithDeviations) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.SignalPrice.status":
-		return x.Status != 0
-	case "band.feeds.v1beta1.SignalPrice.signal_id":
-		return x.SignalId != ""
-	case "band.feeds.v1beta1.SignalPrice.price":
-		return x.Price != uint64(0)
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
+		return len(x.Feeds) != 0
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
+		return x.LastUpdateTimestamp != int64(0)
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
+		return x.LastUpdateBlock != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3997,19 +3894,19 @@ func (x *fastReflection_SignalPrice) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignalPrice) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CurrentFeedWithDeviations) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.SignalPrice.status":
-		x.Status = 0
-	case "band.feeds.v1beta1.SignalPrice.signal_id":
-		x.SignalId = ""
-	case "band.feeds.v1beta1.SignalPrice.price":
-		x.Price = uint64(0)
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
+		x.Feeds = nil
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
+		x.LastUpdateTimestamp = int64(0)
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
+		x.LastUpdateBlock = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4019,22 +3916,25 @@ func (x *fastReflection_SignalPrice) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SignalPrice) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CurrentFeedWithDeviations) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.SignalPrice.status":
-		value := x.Status
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "band.feeds.v1beta1.SignalPrice.signal_id":
-		value := x.SignalId
-		return protoreflect.ValueOfString(value)
-	case "band.feeds.v1beta1.SignalPrice.price":
-		value := x.Price
-		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
+		if len(x.Feeds) == 0 {
+			return protoreflect.ValueOfList(&_CurrentFeedWithDeviations_1_list{})
+		}
+		listValue := &_CurrentFeedWithDeviations_1_list{list: &x.Feeds}
+		return protoreflect.ValueOfList(listValue)
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
+		value := x.LastUpdateTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
+		value := x.LastUpdateBlock
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4048,19 +3948,21 @@ func (x *fastReflection_SignalPrice) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignalPrice) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CurrentFeedWithDeviations) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.SignalPrice.status":
-		x.Status = (SignalPriceStatus)(value.Enum())
-	case "band.feeds.v1beta1.SignalPrice.signal_id":
-		x.SignalId = value.Interface().(string)
-	case "band.feeds.v1beta1.SignalPrice.price":
-		x.Price = value.Uint()
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
+		lv := value.List()
+		clv := lv.(*_CurrentFeedWithDeviations_1_list)
+		x.Feeds = *clv.list
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
+		x.LastUpdateTimestamp = value.Int()
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
+		x.LastUpdateBlock = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4074,48 +3976,53 @@ func (x *fastReflection_SignalPrice) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignalPrice) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CurrentFeedWithDeviations) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.SignalPrice.status":
-		panic(fmt.Errorf("field status of message band.feeds.v1beta1.SignalPrice is not mutable"))
-	case "band.feeds.v1beta1.SignalPrice.signal_id":
-		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.SignalPrice is not mutable"))
-	case "band.feeds.v1beta1.SignalPrice.price":
-		panic(fmt.Errorf("field price of message band.feeds.v1beta1.SignalPrice is not mutable"))
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
+		if x.Feeds == nil {
+			x.Feeds = []*FeedWithDeviation{}
+		}
+		value := &_CurrentFeedWithDeviations_1_list{list: &x.Feeds}
+		return protoreflect.ValueOfList(value)
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
+		panic(fmt.Errorf("field last_update_timestamp of message band.feeds.v1beta1.CurrentFeedWithDeviations is not mutable"))
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
+		panic(fmt.Errorf("field last_update_block of message band.feeds.v1beta1.CurrentFeedWithDeviations is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SignalPrice) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CurrentFeedWithDeviations) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.SignalPrice.status":
-		return protoreflect.ValueOfEnum(0)
-	case "band.feeds.v1beta1.SignalPrice.signal_id":
-		return protoreflect.ValueOfString("")
-	case "band.feeds.v1beta1.SignalPrice.price":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
+		list := []*FeedWithDeviation{}
+		return protoreflect.ValueOfList(&_CurrentFeedWithDeviations_1_list{list: &list})
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SignalPrice) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CurrentFeedWithDeviations) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.SignalPrice", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.CurrentFeedWithDeviations", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4123,7 +4030,7 @@ func (x *fastReflection_SignalPrice) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SignalPrice) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CurrentFeedWithDeviations) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4134,7 +4041,7 @@ func (x *fastReflection_SignalPrice) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignalPrice) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CurrentFeedWithDeviations) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4146,7 +4053,7 @@ func (x *fastReflection_SignalPrice) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SignalPrice) IsValid() bool {
+func (x *fastReflection_CurrentFeedWithDeviations) IsValid() bool {
 	return x != nil
 }
 
@@ -4156,9 +4063,9 @@ func (x *fastReflection_SignalPrice) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SignalPrice)
+		x := input.Message.Interface().(*CurrentFeedWithDeviations)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4170,15 +4077,17 @@ func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Status != 0 {
-			n += 1 + runtime.Sov(uint64(x.Status))
-		}
-		l = len(x.SignalId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Feeds) > 0 {
+			for _, e := range x.Feeds {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		if x.Price != 0 {
-			n += 1 + runtime.Sov(uint64(x.Price))
+		if x.LastUpdateTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastUpdateTimestamp))
+		}
+		if x.LastUpdateBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastUpdateBlock))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4190,7 +4099,7 @@ func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SignalPrice)
+		x := input.Message.Interface().(*CurrentFeedWithDeviations)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4209,22 +4118,31 @@ func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Price != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Price))
+		if x.LastUpdateBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdateBlock))
 			i--
 			dAtA[i] = 0x18
 		}
-		if len(x.SignalId) > 0 {
-			i -= len(x.SignalId)
-			copy(dAtA[i:], x.SignalId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+		if x.LastUpdateTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdateTimestamp))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if x.Status != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
-			i--
-			dAtA[i] = 0x8
+		if len(x.Feeds) > 0 {
+			for iNdEx := len(x.Feeds) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Feeds[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4237,7 +4155,7 @@ func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SignalPrice)
+		x := input.Message.Interface().(*CurrentFeedWithDeviations)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4269,17 +4187,17 @@ func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalPrice: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrentFeedWithDeviations: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrentFeedWithDeviations: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
 				}
-				x.Status = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4289,16 +4207,31 @@ func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Status |= SignalPriceStatus(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Feeds = append(x.Feeds, &FeedWithDeviation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Feeds[len(x.Feeds)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
 				}
-				var stringLen uint64
+				x.LastUpdateTimestamp = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4308,29 +4241,16 @@ func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.LastUpdateTimestamp |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.SignalId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlock", wireType)
 				}
-				x.Price = 0
+				x.LastUpdateBlock = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4340,7 +4260,7 @@ func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Price |= uint64(b&0x7F) << shift
+					x.LastUpdateBlock |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -4381,33 +4301,37 @@ func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_ValidatorPrice                     protoreflect.MessageDescriptor
-	fd_ValidatorPrice_signal_price_status protoreflect.FieldDescriptor
-	fd_ValidatorPrice_signal_id           protoreflect.FieldDescriptor
-	fd_ValidatorPrice_price               protoreflect.FieldDescriptor
-	fd_ValidatorPrice_timestamp           protoreflect.FieldDescriptor
-	fd_ValidatorPrice_block_height        protoreflect.FieldDescriptor
+	md_Price                           protoreflect.MessageDescriptor
+	fd_Price_status                    protoreflect.FieldDescriptor
+	fd_Price_signal_id                 protoreflect.FieldDescriptor
+	fd_Price_price                     protoreflect.FieldDescriptor
+	fd_Price_timestamp                 protoreflect.FieldDescriptor
+	fd_Price_interquartile_range       protoreflect.FieldDescriptor
+	fd_Price_participation_basis_point protoreflect.FieldDescriptor
+	fd_Price_reporter_count            protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_feeds_proto_init()
-	md_ValidatorPrice = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("ValidatorPrice")
-	fd_ValidatorPrice_signal_price_status = md_ValidatorPrice.Fields().ByName("signal_price_status")
-	fd_ValidatorPrice_signal_id = md_ValidatorPrice.Fields().ByName("signal_id")
-	fd_ValidatorPrice_price = md_ValidatorPrice.Fields().ByName("price")
-	fd_ValidatorPrice_timestamp = md_ValidatorPrice.Fields().ByName("timestamp")
-	fd_ValidatorPrice_block_height = md_ValidatorPrice.Fields().ByName("block_height")
+	md_Price = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("Price")
+	fd_Price_status = md_Price.Fields().ByName("status")
+	fd_Price_signal_id = md_Price.Fields().ByName("signal_id")
+	fd_Price_price = md_Price.Fields().ByName("price")
+	fd_Price_timestamp = md_Price.Fields().ByName("timestamp")
+	fd_Price_interquartile_range = md_Price.Fields().ByName("interquartile_range")
+	fd_Price_participation_basis_point = md_Price.Fields().ByName("participation_basis_point")
+	fd_Price_reporter_count = md_Price.Fields().ByName("reporter_count")
 }
 
-var _ protoreflect.Message = (*fastReflection_ValidatorPrice)(nil)
+var _ protoreflect.Message = (*fastReflection_Price)(nil)
 
-type fastReflection_ValidatorPrice ValidatorPrice
+type fastReflection_Price Price
 
-func (x *ValidatorPrice) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorPrice)(x)
+func (x *Price) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Price)(x)
 }
 
-func (x *ValidatorPrice) slowProtoReflect() protoreflect.Message {
+func (x *Price) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4419,43 +4343,43 @@ func (x *ValidatorPrice) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ValidatorPrice_messageType fastReflection_ValidatorPrice_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorPrice_messageType{}
+var _fastReflection_Price_messageType fastReflection_Price_messageType
+var _ protoreflect.MessageType = fastReflection_Price_messageType{}
 
-type fastReflection_ValidatorPrice_messageType struct{}
+type fastReflection_Price_messageType struct{}
 
-func (x fastReflection_ValidatorPrice_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorPrice)(nil)
+func (x fastReflection_Price_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Price)(nil)
 }
-func (x fastReflection_ValidatorPrice_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorPrice)
+func (x fastReflection_Price_messageType) New() protoreflect.Message {
+	return new(fastReflection_Price)
 }
-func (x fastReflection_ValidatorPrice_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorPrice
+func (x fastReflection_Price_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Price
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValidatorPrice) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorPrice
+func (x *fastReflection_Price) Descriptor() protoreflect.MessageDescriptor {
+	return md_Price
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorPrice) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorPrice_messageType
+func (x *fastReflection_Price) Type() protoreflect.MessageType {
+	return _fastReflection_Price_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorPrice) New() protoreflect.Message {
-	return new(fastReflection_ValidatorPrice)
+func (x *fastReflection_Price) New() protoreflect.Message {
+	return new(fastReflection_Price)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorPrice) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorPrice)(x)
+func (x *fastReflection_Price) Interface() protoreflect.ProtoMessage {
+	return (*Price)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4463,34 +4387,46 @@ func (x *fastReflection_ValidatorPrice) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValidatorPrice) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.SignalPriceStatus != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.SignalPriceStatus))
-		if !f(fd_ValidatorPrice_signal_price_status, value) {
+func (x *fastReflection_Price) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_Price_status, value) {
 			return
 		}
 	}
 	if x.SignalId != "" {
 		value := protoreflect.ValueOfString(x.SignalId)
-		if !f(fd_ValidatorPrice_signal_id, value) {
+		if !f(fd_Price_signal_id, value) {
 			return
 		}
 	}
 	if x.Price != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.Price)
-		if !f(fd_ValidatorPrice_price, value) {
+		if !f(fd_Price_price, value) {
 			return
 		}
 	}
 	if x.Timestamp != int64(0) {
 		value := protoreflect.ValueOfInt64(x.Timestamp)
-		if !f(fd_ValidatorPrice_timestamp, value) {
+		if !f(fd_Price_timestamp, value) {
 			return
 		}
 	}
-	if x.BlockHeight != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockHeight)
-		if !f(fd_ValidatorPrice_block_height, value) {
+	if x.InterquartileRange != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InterquartileRange)
+		if !f(fd_Price_interquartile_range, value) {
+			return
+		}
+	}
+	if x.ParticipationBasisPoint != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ParticipationBasisPoint)
+		if !f(fd_Price_participation_basis_point, value) {
+			return
+		}
+	}
+	if x.ReporterCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReporterCount)
+		if !f(fd_Price_reporter_count, value) {
 			return
 		}
 	}
@@ -4507,23 +4443,27 @@ func (x *fastReflection_ValidatorPrice) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorPrice) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Price) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
-		return x.SignalPriceStatus != 0
-	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
+	case "band.feeds.v1beta1.Price.status":
+		return x.Status != 0
+	case "band.feeds.v1beta1.Price.signal_id":
 		return x.SignalId != ""
-	case "band.feeds.v1beta1.ValidatorPrice.price":
+	case "band.feeds.v1beta1.Price.price":
 		return x.Price != uint64(0)
-	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
+	case "band.feeds.v1beta1.Price.timestamp":
 		return x.Timestamp != int64(0)
-	case "band.feeds.v1beta1.ValidatorPrice.block_height":
-		return x.BlockHeight != int64(0)
+	case "band.feeds.v1beta1.Price.interquartile_range":
+		return x.InterquartileRange != uint64(0)
+	case "band.feeds.v1beta1.Price.participation_basis_point":
+		return x.ParticipationBasisPoint != uint64(0)
+	case "band.feeds.v1beta1.Price.reporter_count":
+		return x.ReporterCount != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4533,23 +4473,27 @@ func (x *fastReflection_ValidatorPrice) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorPrice) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Price) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
-		x.SignalPriceStatus = 0
-	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
+	case "band.feeds.v1beta1.Price.status":
+		x.Status = 0
+	case "band.feeds.v1beta1.Price.signal_id":
 		x.SignalId = ""
-	case "band.feeds.v1beta1.ValidatorPrice.price":
+	case "band.feeds.v1beta1.Price.price":
 		x.Price = uint64(0)
-	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
+	case "band.feeds.v1beta1.Price.timestamp":
 		x.Timestamp = int64(0)
-	case "band.feeds.v1beta1.ValidatorPrice.block_height":
-		x.BlockHeight = int64(0)
+	case "band.feeds.v1beta1.Price.interquartile_range":
+		x.InterquartileRange = uint64(0)
+	case "band.feeds.v1beta1.Price.participation_basis_point":
+		x.ParticipationBasisPoint = uint64(0)
+	case "band.feeds.v1beta1.Price.reporter_count":
+		x.ReporterCount = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4559,28 +4503,34 @@ func (x *fastReflection_ValidatorPrice) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorPrice) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Price) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
-		value := x.SignalPriceStatus
+	case "band.feeds.v1beta1.Price.status":
+		value := x.Status
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
+	case "band.feeds.v1beta1.Price.signal_id":
 		value := x.SignalId
 		return protoreflect.ValueOfString(value)
-	case "band.feeds.v1beta1.ValidatorPrice.price":
+	case "band.feeds.v1beta1.Price.price":
 		value := x.Price
 		return protoreflect.ValueOfUint64(value)
-	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
+	case "band.feeds.v1beta1.Price.timestamp":
 		value := x.Timestamp
 		return protoreflect.ValueOfInt64(value)
-	case "band.feeds.v1beta1.ValidatorPrice.block_height":
-		value := x.BlockHeight
-		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.Price.interquartile_range":
+		value := x.InterquartileRange
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.Price.participation_basis_point":
+		value := x.ParticipationBasisPoint
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.Price.reporter_count":
+		value := x.ReporterCount
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4594,23 +4544,27 @@ func (x *fastReflection_ValidatorPrice) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorPrice) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Price) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
-		x.SignalPriceStatus = (SignalPriceStatus)(value.Enum())
-	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
+	case "band.feeds.v1beta1.Price.status":
+		x.Status = (PriceStatus)(value.Enum())
+	case "band.feeds.v1beta1.Price.signal_id":
 		x.SignalId = value.Interface().(string)
-	case "band.feeds.v1beta1.ValidatorPrice.price":
+	case "band.feeds.v1beta1.Price.price":
 		x.Price = value.Uint()
-	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
+	case "band.feeds.v1beta1.Price.timestamp":
 		x.Timestamp = value.Int()
-	case "band.feeds.v1beta1.ValidatorPrice.block_height":
-		x.BlockHeight = value.Int()
+	case "band.feeds.v1beta1.Price.interquartile_range":
+		x.InterquartileRange = value.Uint()
+	case "band.feeds.v1beta1.Price.participation_basis_point":
+		x.ParticipationBasisPoint = value.Uint()
+	case "band.feeds.v1beta1.Price.reporter_count":
+		x.ReporterCount = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4624,56 +4578,64 @@ func (x *fastReflection_ValidatorPrice) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorPrice) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Price) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
-		panic(fmt.Errorf("field signal_price_status of message band.feeds.v1beta1.ValidatorPrice is not mutable"))
-	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
-		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.ValidatorPrice is not mutable"))
-	case "band.feeds.v1beta1.ValidatorPrice.price":
-		panic(fmt.Errorf("field price of message band.feeds.v1beta1.ValidatorPrice is not mutable"))
-	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
-		panic(fmt.Errorf("field timestamp of message band.feeds.v1beta1.ValidatorPrice is not mutable"))
-	case "band.feeds.v1beta1.ValidatorPrice.block_height":
-		panic(fmt.Errorf("field block_height of message band.feeds.v1beta1.ValidatorPrice is not mutable"))
+	case "band.feeds.v1beta1.Price.status":
+		panic(fmt.Errorf("field status of message band.feeds.v1beta1.Price is not mutable"))
+	case "band.feeds.v1beta1.Price.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.Price is not mutable"))
+	case "band.feeds.v1beta1.Price.price":
+		panic(fmt.Errorf("field price of message band.feeds.v1beta1.Price is not mutable"))
+	case "band.feeds.v1beta1.Price.timestamp":
+		panic(fmt.Errorf("field timestamp of message band.feeds.v1beta1.Price is not mutable"))
+	case "band.feeds.v1beta1.Price.interquartile_range":
+		panic(fmt.Errorf("field interquartile_range of message band.feeds.v1beta1.Price is not mutable"))
+	case "band.feeds.v1beta1.Price.participation_basis_point":
+		panic(fmt.Errorf("field participation_basis_point of message band.feeds.v1beta1.Price is not mutable"))
+	case "band.feeds.v1beta1.Price.reporter_count":
+		panic(fmt.Errorf("field reporter_count of message band.feeds.v1beta1.Price is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorPrice) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Price) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
+	case "band.feeds.v1beta1.Price.status":
 		return protoreflect.ValueOfEnum(0)
-	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
+	case "band.feeds.v1beta1.Price.signal_id":
 		return protoreflect.ValueOfString("")
-	case "band.feeds.v1beta1.ValidatorPrice.price":
+	case "band.feeds.v1beta1.Price.price":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.feeds.v1beta1.ValidatorPrice.block_height":
+	case "band.feeds.v1beta1.Price.timestamp":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.Price.interquartile_range":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.Price.participation_basis_point":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.Price.reporter_count":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorPrice) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Price) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.ValidatorPrice", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.Price", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4681,7 +4643,7 @@ func (x *fastReflection_ValidatorPrice) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorPrice) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Price) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4692,7 +4654,7 @@ func (x *fastReflection_ValidatorPrice) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorPrice) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Price) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4704,7 +4666,7 @@ func (x *fastReflection_ValidatorPrice) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorPrice) IsValid() bool {
+func (x *fastReflection_Price) IsValid() bool {
 	return x != nil
 }
 
@@ -4714,9 +4676,9 @@ func (x *fastReflection_ValidatorPrice) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorPrice)
+		x := input.Message.Interface().(*Price)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4728,8 +4690,8 @@ func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.SignalPriceStatus != 0 {
-			n += 1 + runtime.Sov(uint64(x.SignalPriceStatus))
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
 		}
 		l = len(x.SignalId)
 		if l > 0 {
@@ -4741,8 +4703,14 @@ func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
 		if x.Timestamp != 0 {
 			n += 1 + runtime.Sov(uint64(x.Timestamp))
 		}
-		if x.BlockHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		if x.InterquartileRange != 0 {
+			n += 1 + runtime.Sov(uint64(x.InterquartileRange))
+		}
+		if x.ParticipationBasisPoint != 0 {
+			n += 1 + runtime.Sov(uint64(x.ParticipationBasisPoint))
+		}
+		if x.ReporterCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReporterCount))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4754,7 +4722,7 @@ func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorPrice)
+		x := input.Message.Interface().(*Price)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4773,8 +4741,18 @@ func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+		if x.ReporterCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReporterCount))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.ParticipationBasisPoint != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ParticipationBasisPoint))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.InterquartileRange != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InterquartileRange))
 			i--
 			dAtA[i] = 0x28
 		}
@@ -4795,8 +4773,8 @@ func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.SignalPriceStatus != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SignalPriceStatus))
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -4811,7 +4789,7 @@ func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorPrice)
+		x := input.Message.Interface().(*Price)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4843,17 +4821,17 @@ func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPrice: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Price: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Price: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalPriceStatus", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 				}
-				x.SignalPriceStatus = 0
+				x.Status = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4863,7 +4841,7 @@ func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.SignalPriceStatus |= SignalPriceStatus(b&0x7F) << shift
+					x.Status |= PriceStatus(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -4940,9 +4918,9 @@ func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
 				}
 			case 5:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InterquartileRange", wireType)
 				}
-				x.BlockHeight = 0
+				x.InterquartileRange = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4952,7 +4930,45 @@ func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BlockHeight |= int64(b&0x7F) << shift
+					x.InterquartileRange |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipationBasisPoint", wireType)
+				}
+				x.ParticipationBasisPoint = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ParticipationBasisPoint |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReporterCount", wireType)
+				}
+				x.ReporterCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReporterCount |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -4992,79 +5008,30 @@ func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_ValidatorPriceList_2_list)(nil)
-
-type _ValidatorPriceList_2_list struct {
-	list *[]*ValidatorPrice
-}
-
-func (x *_ValidatorPriceList_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_ValidatorPriceList_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_ValidatorPriceList_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ValidatorPrice)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_ValidatorPriceList_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ValidatorPrice)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_ValidatorPriceList_2_list) AppendMutable() protoreflect.Value {
-	v := new(ValidatorPrice)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_ValidatorPriceList_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_ValidatorPriceList_2_list) NewElement() protoreflect.Value {
-	v := new(ValidatorPrice)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_ValidatorPriceList_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_ValidatorPriceList                  protoreflect.MessageDescriptor
-	fd_ValidatorPriceList_validator        protoreflect.FieldDescriptor
-	fd_ValidatorPriceList_validator_prices protoreflect.FieldDescriptor
+	md_SignalPrice           protoreflect.MessageDescriptor
+	fd_SignalPrice_status    protoreflect.FieldDescriptor
+	fd_SignalPrice_signal_id protoreflect.FieldDescriptor
+	fd_SignalPrice_price     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_feeds_proto_init()
-	md_ValidatorPriceList = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("ValidatorPriceList")
-	fd_ValidatorPriceList_validator = md_ValidatorPriceList.Fields().ByName("validator")
-	fd_ValidatorPriceList_validator_prices = md_ValidatorPriceList.Fields().ByName("validator_prices")
+	md_SignalPrice = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("SignalPrice")
+	fd_SignalPrice_status = md_SignalPrice.Fields().ByName("status")
+	fd_SignalPrice_signal_id = md_SignalPrice.Fields().ByName("signal_id")
+	fd_SignalPrice_price = md_SignalPrice.Fields().ByName("price")
 }
 
-var _ protoreflect.Message = (*fastReflection_ValidatorPriceList)(nil)
+var _ protoreflect.Message = (*fastReflection_SignalPrice)(nil)
 
-type fastReflection_ValidatorPriceList ValidatorPriceList
+type fastReflection_SignalPrice SignalPrice
 
-func (x *ValidatorPriceList) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorPriceList)(x)
+func (x *SignalPrice) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignalPrice)(x)
 }
 
-func (x *ValidatorPriceList) slowProtoReflect() protoreflect.Message {
+func (x *SignalPrice) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5076,43 +5043,43 @@ func (x *ValidatorPriceList) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ValidatorPriceList_messageType fastReflection_ValidatorPriceList_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorPriceList_messageType{}
+var _fastReflection_SignalPrice_messageType fastReflection_SignalPrice_messageType
+var _ protoreflect.MessageType = fastReflection_SignalPrice_messageType{}
 
-type fastReflection_ValidatorPriceList_messageType struct{}
+type fastReflection_SignalPrice_messageType struct{}
 
-func (x fastReflection_ValidatorPriceList_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorPriceList)(nil)
+func (x fastReflection_SignalPrice_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignalPrice)(nil)
 }
-func (x fastReflection_ValidatorPriceList_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorPriceList)
+func (x fastReflection_SignalPrice_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignalPrice)
 }
-func (x fastReflection_ValidatorPriceList_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorPriceList
+func (x fastReflection_SignalPrice_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalPrice
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValidatorPriceList) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorPriceList
+func (x *fastReflection_SignalPrice) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalPrice
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorPriceList) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorPriceList_messageType
+func (x *fastReflection_SignalPrice) Type() protoreflect.MessageType {
+	return _fastReflection_SignalPrice_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorPriceList) New() protoreflect.Message {
-	return new(fastReflection_ValidatorPriceList)
+func (x *fastReflection_SignalPrice) New() protoreflect.Message {
+	return new(fastReflection_SignalPrice)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorPriceList) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorPriceList)(x)
+func (x *fastReflection_SignalPrice) Interface() protoreflect.ProtoMessage {
+	return (*SignalPrice)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5120,16 +5087,22 @@ func (x *fastReflection_ValidatorPriceList) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValidatorPriceList) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Validator != "" {
-		value := protoreflect.ValueOfString(x.Validator)
-		if !f(fd_ValidatorPriceList_validator, value) {
+func (x *fastReflection_SignalPrice) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_SignalPrice_status, value) {
 			return
 		}
 	}
-	if len(x.ValidatorPrices) != 0 {
-		value := protoreflect.ValueOfList(&_ValidatorPriceList_2_list{list: &x.ValidatorPrices})
-		if !f(fd_ValidatorPriceList_validator_prices, value) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_SignalPrice_signal_id, value) {
+			return
+		}
+	}
+	if x.Price != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Price)
+		if !f(fd_SignalPrice_price, value) {
 			return
 		}
 	}
@@ -5146,17 +5119,19 @@ func (x *fastReflection_ValidatorPriceList) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorPriceList) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SignalPrice) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ValidatorPriceList.validator":
-		return x.Validator != ""
-	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
-		return len(x.ValidatorPrices) != 0
+	case "band.feeds.v1beta1.SignalPrice.status":
+		return x.Status != 0
+	case "band.feeds.v1beta1.SignalPrice.signal_id":
+		return x.SignalId != ""
+	case "band.feeds.v1beta1.SignalPrice.price":
+		return x.Price != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5166,17 +5141,19 @@ func (x *fastReflection_ValidatorPriceList) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorPriceList) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SignalPrice) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ValidatorPriceList.validator":
-		x.Validator = ""
-	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
-		x.ValidatorPrices = nil
+	case "band.feeds.v1beta1.SignalPrice.status":
+		x.Status = 0
+	case "band.feeds.v1beta1.SignalPrice.signal_id":
+		x.SignalId = ""
+	case "band.feeds.v1beta1.SignalPrice.price":
+		x.Price = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5186,22 +5163,22 @@ func (x *fastReflection_ValidatorPriceList) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorPriceList) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SignalPrice) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.ValidatorPriceList.validator":
-		value := x.Validator
+	case "band.feeds.v1beta1.SignalPrice.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.feeds.v1beta1.SignalPrice.signal_id":
+		value := x.SignalId
 		return protoreflect.ValueOfString(value)
-	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
-		if len(x.ValidatorPrices) == 0 {
-			return protoreflect.ValueOfList(&_ValidatorPriceList_2_list{})
-		}
-		listValue := &_ValidatorPriceList_2_list{list: &x.ValidatorPrices}
-		return protoreflect.ValueOfList(listValue)
+	case "band.feeds.v1beta1.SignalPrice.price":
+		value := x.Price
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5215,19 +5192,19 @@ func (x *fastReflection_ValidatorPriceList) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorPriceList) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SignalPrice) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ValidatorPriceList.validator":
-		x.Validator = value.Interface().(string)
-	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
-		lv := value.List()
-		clv := lv.(*_ValidatorPriceList_2_list)
-		x.ValidatorPrices = *clv.list
+	case "band.feeds.v1beta1.SignalPrice.status":
+		x.Status = (SignalPriceStatus)(value.Enum())
+	case "band.feeds.v1beta1.SignalPrice.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.feeds.v1beta1.SignalPrice.price":
+		x.Price = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5241,49 +5218,48 @@ func (x *fastReflection_ValidatorPriceList) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorPriceList) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SignalPrice) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
-		if x.ValidatorPrices == nil {
-			x.ValidatorPrices = []*ValidatorPrice{}
-		}
-		value := &_ValidatorPriceList_2_list{list: &x.ValidatorPrices}
-		return protoreflect.ValueOfList(value)
-	case "band.feeds.v1beta1.ValidatorPriceList.validator":
-		panic(fmt.Errorf("field validator of message band.feeds.v1beta1.ValidatorPriceList is not mutable"))
+	case "band.feeds.v1beta1.SignalPrice.status":
+		panic(fmt.Errorf("field status of message band.feeds.v1beta1.SignalPrice is not mutable"))
+	case "band.feeds.v1beta1.SignalPrice.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.SignalPrice is not mutable"))
+	case "band.feeds.v1beta1.SignalPrice.price":
+		panic(fmt.Errorf("field price of message band.feeds.v1beta1.SignalPrice is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorPriceList) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SignalPrice) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ValidatorPriceList.validator":
+	case "band.feeds.v1beta1.SignalPrice.status":
+		return protoreflect.ValueOfEnum(0)
+	case "band.feeds.v1beta1.SignalPrice.signal_id":
 		return protoreflect.ValueOfString("")
-	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
-		list := []*ValidatorPrice{}
-		return protoreflect.ValueOfList(&_ValidatorPriceList_2_list{list: &list})
+	case "band.feeds.v1beta1.SignalPrice.price":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorPriceList) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SignalPrice) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.ValidatorPriceList", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.SignalPrice", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5291,7 +5267,7 @@ func (x *fastReflection_ValidatorPriceList) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorPriceList) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SignalPrice) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5302,7 +5278,7 @@ func (x *fastReflection_ValidatorPriceList) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorPriceList) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SignalPrice) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5314,7 +5290,7 @@ func (x *fastReflection_ValidatorPriceList) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorPriceList) IsValid() bool {
+func (x *fastReflection_SignalPrice) IsValid() bool {
 	return x != nil
 }
 
@@ -5324,9 +5300,9 @@ func (x *fastReflection_ValidatorPriceList) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorPriceList) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorPriceList)
+		x := input.Message.Interface().(*SignalPrice)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5338,15 +5314,15 @@ func (x *fastReflection_ValidatorPriceList) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Validator)
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.SignalId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.ValidatorPrices) > 0 {
-			for _, e := range x.ValidatorPrices {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Price != 0 {
+			n += 1 + runtime.Sov(uint64(x.Price))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5358,7 +5334,7 @@ func (x *fastReflection_ValidatorPriceList) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorPriceList)
+		x := input.Message.Interface().(*SignalPrice)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5377,28 +5353,22 @@ func (x *fastReflection_ValidatorPriceList) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ValidatorPrices) > 0 {
-			for iNdEx := len(x.ValidatorPrices) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ValidatorPrices[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
+		if x.Price != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Price))
+			i--
+			dAtA[i] = 0x18
 		}
-		if len(x.Validator) > 0 {
-			i -= len(x.Validator)
-			copy(dAtA[i:], x.Validator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5411,7 +5381,7 @@ func (x *fastReflection_ValidatorPriceList) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorPriceList)
+		x := input.Message.Interface().(*SignalPrice)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5443,15 +5413,34 @@ func (x *fastReflection_ValidatorPriceList) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPriceList: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalPrice: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPriceList: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= SignalPriceStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5479,13 +5468,13 @@ func (x *fastReflection_ValidatorPriceList) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Validator = string(dAtA[iNdEx:postIndex])
+				x.SignalId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorPrices", wireType)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 				}
-				var msglen int
+				x.Price = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5495,26 +5484,11 @@ func (x *fastReflection_ValidatorPriceList) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Price |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ValidatorPrices = append(x.ValidatorPrices, &ValidatorPrice{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorPrices[len(x.ValidatorPrices)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5551,27 +5525,33 @@ func (x *fastReflection_ValidatorPriceList) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_ReferenceSourceConfig                    protoreflect.MessageDescriptor
-	fd_ReferenceSourceConfig_registry_ipfs_hash protoreflect.FieldDescriptor
-	fd_ReferenceSourceConfig_registry_version   protoreflect.FieldDescriptor
+	md_ValidatorPrice                     protoreflect.MessageDescriptor
+	fd_ValidatorPrice_signal_price_status protoreflect.FieldDescriptor
+	fd_ValidatorPrice_signal_id           protoreflect.FieldDescriptor
+	fd_ValidatorPrice_price               protoreflect.FieldDescriptor
+	fd_ValidatorPrice_timestamp           protoreflect.FieldDescriptor
+	fd_ValidatorPrice_block_height        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_feeds_proto_init()
-	md_ReferenceSourceConfig = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("ReferenceSourceConfig")
-	fd_ReferenceSourceConfig_registry_ipfs_hash = md_ReferenceSourceConfig.Fields().ByName("registry_ipfs_hash")
-	fd_ReferenceSourceConfig_registry_version = md_ReferenceSourceConfig.Fields().ByName("registry_version")
+	md_ValidatorPrice = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("ValidatorPrice")
+	fd_ValidatorPrice_signal_price_status = md_ValidatorPrice.Fields().ByName("signal_price_status")
+	fd_ValidatorPrice_signal_id = md_ValidatorPrice.Fields().ByName("signal_id")
+	fd_ValidatorPrice_price = md_ValidatorPrice.Fields().ByName("price")
+	fd_ValidatorPrice_timestamp = md_ValidatorPrice.Fields().ByName("timestamp")
+	fd_ValidatorPrice_block_height = md_ValidatorPrice.Fields().ByName("block_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_ReferenceSourceConfig)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorPrice)(nil)
 
-type fastReflection_ReferenceSourceConfig ReferenceSourceConfig
+type fastReflection_ValidatorPrice ValidatorPrice
 
-func (x *ReferenceSourceConfig) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ReferenceSourceConfig)(x)
+func (x *ValidatorPrice) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorPrice)(x)
 }
 
-func (x *ReferenceSourceConfig) slowProtoReflect() protoreflect.Message {
+func (x *ValidatorPrice) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5583,43 +5563,43 @@ func (x *ReferenceSourceConfig) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ReferenceSourceConfig_messageType fastReflection_ReferenceSourceConfig_messageType
-var _ protoreflect.MessageType = fastReflection_ReferenceSourceConfig_messageType{}
+var _fastReflection_ValidatorPrice_messageType fastReflection_ValidatorPrice_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorPrice_messageType{}
 
-type fastReflection_ReferenceSourceConfig_messageType struct{}
+type fastReflection_ValidatorPrice_messageType struct{}
 
-func (x fastReflection_ReferenceSourceConfig_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ReferenceSourceConfig)(nil)
+func (x fastReflection_ValidatorPrice_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorPrice)(nil)
 }
-func (x fastReflection_ReferenceSourceConfig_messageType) New() protoreflect.Message {
-	return new(fastReflection_ReferenceSourceConfig)
+func (x fastReflection_ValidatorPrice_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPrice)
 }
-func (x fastReflection_ReferenceSourceConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ReferenceSourceConfig
+func (x fastReflection_ValidatorPrice_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPrice
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ReferenceSourceConfig) Descriptor() protoreflect.MessageDescriptor {
-	return md_ReferenceSourceConfig
+func (x *fastReflection_ValidatorPrice) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPrice
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ReferenceSourceConfig) Type() protoreflect.MessageType {
-	return _fastReflection_ReferenceSourceConfig_messageType
+func (x *fastReflection_ValidatorPrice) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorPrice_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ReferenceSourceConfig) New() protoreflect.Message {
-	return new(fastReflection_ReferenceSourceConfig)
+func (x *fastReflection_ValidatorPrice) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPrice)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ReferenceSourceConfig) Interface() protoreflect.ProtoMessage {
-	return (*ReferenceSourceConfig)(x)
+func (x *fastReflection_ValidatorPrice) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorPrice)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5627,16 +5607,34 @@ func (x *fastReflection_ReferenceSourceConfig) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ReferenceSourceConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.RegistryIpfsHash != "" {
-		value := protoreflect.ValueOfString(x.RegistryIpfsHash)
-		if !f(fd_ReferenceSourceConfig_registry_ipfs_hash, value) {
+func (x *fastReflection_ValidatorPrice) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalPriceStatus != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.SignalPriceStatus))
+		if !f(fd_ValidatorPrice_signal_price_status, value) {
 			return
 		}
 	}
-	if x.RegistryVersion != "" {
-		value := protoreflect.ValueOfString(x.RegistryVersion)
-		if !f(fd_ReferenceSourceConfig_registry_version, value) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_ValidatorPrice_signal_id, value) {
+			return
+		}
+	}
+	if x.Price != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Price)
+		if !f(fd_ValidatorPrice_price, value) {
+			return
+		}
+	}
+	if x.Timestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Timestamp)
+		if !f(fd_ValidatorPrice_timestamp, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_ValidatorPrice_block_height, value) {
 			return
 		}
 	}
@@ -5653,17 +5651,23 @@ func (x *fastReflection_ReferenceSourceConfig) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ReferenceSourceConfig) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorPrice) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_ipfs_hash":
-		return x.RegistryIpfsHash != ""
-	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_version":
-		return x.RegistryVersion != ""
+	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
+		return x.SignalPriceStatus != 0
+	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
+		return x.SignalId != ""
+	case "band.feeds.v1beta1.ValidatorPrice.price":
+		return x.Price != uint64(0)
+	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
+		return x.Timestamp != int64(0)
+	case "band.feeds.v1beta1.ValidatorPrice.block_height":
+		return x.BlockHeight != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ReferenceSourceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ReferenceSourceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5673,17 +5677,23 @@ func (x *fastReflection_ReferenceSourceConfig) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ReferenceSourceConfig) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorPrice) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_ipfs_hash":
-		x.RegistryIpfsHash = ""
-	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_version":
-		x.RegistryVersion = ""
+	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
+		x.SignalPriceStatus = 0
+	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
+		x.SignalId = ""
+	case "band.feeds.v1beta1.ValidatorPrice.price":
+		x.Price = uint64(0)
+	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
+		x.Timestamp = int64(0)
+	case "band.feeds.v1beta1.ValidatorPrice.block_height":
+		x.BlockHeight = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ReferenceSourceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ReferenceSourceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5693,19 +5703,28 @@ func (x *fastReflection_ReferenceSourceConfig) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ReferenceSourceConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorPrice) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_ipfs_hash":
-		value := x.RegistryIpfsHash
-		return protoreflect.ValueOfString(value)
-	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_version":
-		value := x.RegistryVersion
+	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
+		value := x.SignalPriceStatus
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
+		value := x.SignalId
 		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.ValidatorPrice.price":
+		value := x.Price
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
+		value := x.Timestamp
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.ValidatorPrice.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ReferenceSourceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ReferenceSourceConfig does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5719,17 +5738,23 @@ func (x *fastReflection_ReferenceSourceConfig) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ReferenceSourceConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorPrice) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_ipfs_hash":
-		x.RegistryIpfsHash = value.Interface().(string)
-	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_version":
-		x.RegistryVersion = value.Interface().(string)
+	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
+		x.SignalPriceStatus = (SignalPriceStatus)(value.Enum())
+	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.feeds.v1beta1.ValidatorPrice.price":
+		x.Price = value.Uint()
+	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
+		x.Timestamp = value.Int()
+	case "band.feeds.v1beta1.ValidatorPrice.block_height":
+		x.BlockHeight = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ReferenceSourceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ReferenceSourceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5743,44 +5768,56 @@ func (x *fastReflection_ReferenceSourceConfig) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ReferenceSourceConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorPrice) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_ipfs_hash":
-		panic(fmt.Errorf("field registry_ipfs_hash of message band.feeds.v1beta1.ReferenceSourceConfig is not mutable"))
-	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_version":
-		panic(fmt.Errorf("field registry_version of message band.feeds.v1beta1.ReferenceSourceConfig is not mutable"))
+	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
+		panic(fmt.Errorf("field signal_price_status of message band.feeds.v1beta1.ValidatorPrice is not mutable"))
+	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.ValidatorPrice is not mutable"))
+	case "band.feeds.v1beta1.ValidatorPrice.price":
+		panic(fmt.Errorf("field price of message band.feeds.v1beta1.ValidatorPrice is not mutable"))
+	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
+		panic(fmt.Errorf("field timestamp of message band.feeds.v1beta1.ValidatorPrice is not mutable"))
+	case "band.feeds.v1beta1.ValidatorPrice.block_height":
+		panic(fmt.Errorf("field block_height of message band.feeds.v1beta1.ValidatorPrice is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ReferenceSourceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ReferenceSourceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ReferenceSourceConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorPrice) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_ipfs_hash":
-		return protoreflect.ValueOfString("")
-	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_version":
+	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
+		return protoreflect.ValueOfEnum(0)
+	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
 		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.ValidatorPrice.price":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.ValidatorPrice.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ReferenceSourceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ReferenceSourceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ReferenceSourceConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorPrice) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.ReferenceSourceConfig", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.ValidatorPrice", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5788,7 +5825,7 @@ func (x *fastReflection_ReferenceSourceConfig) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ReferenceSourceConfig) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorPrice) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5799,7 +5836,7 @@ func (x *fastReflection_ReferenceSourceConfig) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ReferenceSourceConfig) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorPrice) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5811,7 +5848,7 @@ func (x *fastReflection_ReferenceSourceConfig) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ReferenceSourceConfig) IsValid() bool {
+func (x *fastReflection_ValidatorPrice) IsValid() bool {
 	return x != nil
 }
 
@@ -5821,9 +5858,9 @@ func (x *fastReflection_ReferenceSourceConfig) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ReferenceSourceConfig) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ReferenceSourceConfig)
+		x := input.Message.Interface().(*ValidatorPrice)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5835,14 +5872,22 @@ func (x *fastReflection_ReferenceSourceConfig) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.RegistryIpfsHash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.SignalPriceStatus != 0 {
+			n += 1 + runtime.Sov(uint64(x.SignalPriceStatus))
 		}
-		l = len(x.RegistryVersion)
+		l = len(x.SignalId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Price != 0 {
+			n += 1 + runtime.Sov(uint64(x.Price))
+		}
+		if x.Timestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.Timestamp))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5853,7 +5898,7 @@ func (x *fastReflection_ReferenceSourceConfig) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ReferenceSourceConfig)
+		x := input.Message.Interface().(*ValidatorPrice)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5872,19 +5917,32 @@ func (x *fastReflection_ReferenceSourceConfig) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.RegistryVersion) > 0 {
-			i -= len(x.RegistryVersion)
-			copy(dAtA[i:], x.RegistryVersion)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RegistryVersion)))
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Timestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timestamp))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Price != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Price))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.RegistryIpfsHash) > 0 {
-			i -= len(x.RegistryIpfsHash)
-			copy(dAtA[i:], x.RegistryIpfsHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RegistryIpfsHash)))
+		if x.SignalPriceStatus != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SignalPriceStatus))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5897,7 +5955,7 @@ func (x *fastReflection_ReferenceSourceConfig) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ReferenceSourceConfig)
+		x := input.Message.Interface().(*ValidatorPrice)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5929,17 +5987,17 @@ func (x *fastReflection_ReferenceSourceConfig) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReferenceSourceConfig: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPrice: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReferenceSourceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RegistryIpfsHash", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalPriceStatus", wireType)
 				}
-				var stringLen uint64
+				x.SignalPriceStatus = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5949,27 +6007,14 @@ func (x *fastReflection_ReferenceSourceConfig) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.SignalPriceStatus |= SignalPriceStatus(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.RegistryIpfsHash = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RegistryVersion", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5997,8 +6042,65 @@ func (x *fastReflection_ReferenceSourceConfig) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RegistryVersion = string(dAtA[iNdEx:postIndex])
+				x.SignalId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				x.Price = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Price |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				}
+				x.Timestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Timestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6034,74 +6136,79 @@ func (x *fastReflection_ReferenceSourceConfig) ProtoMethods() *protoiface.Method
 	}
 }
 
-var _ protoreflect.List = (*_FeedsSignatureOrder_1_list)(nil)
+var _ protoreflect.List = (*_ValidatorPriceList_2_list)(nil)
 
-type _FeedsSignatureOrder_1_list struct {
-	list *[]string
+type _ValidatorPriceList_2_list struct {
+	list *[]*ValidatorPrice
 }
 
-func (x *_FeedsSignatureOrder_1_list) Len() int {
+func (x *_ValidatorPriceList_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_FeedsSignatureOrder_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+func (x *_ValidatorPriceList_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_FeedsSignatureOrder_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_ValidatorPriceList_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorPrice)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_FeedsSignatureOrder_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_ValidatorPriceList_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorPrice)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_FeedsSignatureOrder_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message FeedsSignatureOrder at list field SignalIds as it is not of Message kind"))
+func (x *_ValidatorPriceList_2_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorPrice)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_FeedsSignatureOrder_1_list) Truncate(n int) {
+func (x *_ValidatorPriceList_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_FeedsSignatureOrder_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *_ValidatorPriceList_2_list) NewElement() protoreflect.Value {
+	v := new(ValidatorPrice)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_FeedsSignatureOrder_1_list) IsValid() bool {
+func (x *_ValidatorPriceList_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_FeedsSignatureOrder            protoreflect.MessageDescriptor
-	fd_FeedsSignatureOrder_signal_ids protoreflect.FieldDescriptor
-	fd_FeedsSignatureOrder_encoder    protoreflect.FieldDescriptor
+	md_ValidatorPriceList                  protoreflect.MessageDescriptor
+	fd_ValidatorPriceList_validator        protoreflect.FieldDescriptor
+	fd_ValidatorPriceList_validator_prices protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_feeds_proto_init()
-	md_FeedsSignatureOrder = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("FeedsSignatureOrder")
-	fd_FeedsSignatureOrder_signal_ids = md_FeedsSignatureOrder.Fields().ByName("signal_ids")
-	fd_FeedsSignatureOrder_encoder = md_FeedsSignatureOrder.Fields().ByName("encoder")
+	md_ValidatorPriceList = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("ValidatorPriceList")
+	fd_ValidatorPriceList_validator = md_ValidatorPriceList.Fields().ByName("validator")
+	fd_ValidatorPriceList_validator_prices = md_ValidatorPriceList.Fields().ByName("validator_prices")
 }
 
-var _ protoreflect.Message = (*fastReflection_FeedsSignatureOrder)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorPriceList)(nil)
 
-type fastReflection_FeedsSignatureOrder FeedsSignatureOrder
+type fastReflection_ValidatorPriceList ValidatorPriceList
 
-func (x *FeedsSignatureOrder) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_FeedsSignatureOrder)(x)
+func (x *ValidatorPriceList) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorPriceList)(x)
 }
 
-func (x *FeedsSignatureOrder) slowProtoReflect() protoreflect.Message {
+func (x *ValidatorPriceList) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6113,43 +6220,43 @@ func (x *FeedsSignatureOrder) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_FeedsSignatureOrder_messageType fastReflection_FeedsSignatureOrder_messageType
-var _ protoreflect.MessageType = fastReflection_FeedsSignatureOrder_messageType{}
+var _fastReflection_ValidatorPriceList_messageType fastReflection_ValidatorPriceList_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorPriceList_messageType{}
 
-type fastReflection_FeedsSignatureOrder_messageType struct{}
+type fastReflection_ValidatorPriceList_messageType struct{}
 
-func (x fastReflection_FeedsSignatureOrder_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_FeedsSignatureOrder)(nil)
+func (x fastReflection_ValidatorPriceList_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorPriceList)(nil)
 }
-func (x fastReflection_FeedsSignatureOrder_messageType) New() protoreflect.Message {
-	return new(fastReflection_FeedsSignatureOrder)
+func (x fastReflection_ValidatorPriceList_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPriceList)
 }
-func (x fastReflection_FeedsSignatureOrder_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_FeedsSignatureOrder
+func (x fastReflection_ValidatorPriceList_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPriceList
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_FeedsSignatureOrder) Descriptor() protoreflect.MessageDescriptor {
-	return md_FeedsSignatureOrder
+func (x *fastReflection_ValidatorPriceList) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPriceList
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_FeedsSignatureOrder) Type() protoreflect.MessageType {
-	return _fastReflection_FeedsSignatureOrder_messageType
+func (x *fastReflection_ValidatorPriceList) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorPriceList_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_FeedsSignatureOrder) New() protoreflect.Message {
-	return new(fastReflection_FeedsSignatureOrder)
+func (x *fastReflection_ValidatorPriceList) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPriceList)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_FeedsSignatureOrder) Interface() protoreflect.ProtoMessage {
-	return (*FeedsSignatureOrder)(x)
+func (x *fastReflection_ValidatorPriceList) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorPriceList)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6157,16 +6264,16 @@ func (x *fastReflection_FeedsSignatureOrder) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_FeedsSignatureOrder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.SignalIds) != 0 {
-		value := protoreflect.ValueOfList(&_FeedsSignatureOrder_1_list{list: &x.SignalIds})
-		if !f(fd_FeedsSignatureOrder_signal_ids, value) {
+func (x *fastReflection_ValidatorPriceList) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_ValidatorPriceList_validator, value) {
 			return
 		}
 	}
-	if x.Encoder != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Encoder))
-		if !f(fd_FeedsSignatureOrder_encoder, value) {
+	if len(x.ValidatorPrices) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorPriceList_2_list{list: &x.ValidatorPrices})
+		if !f(fd_ValidatorPriceList_validator_prices, value) {
 			return
 		}
 	}
@@ -6183,17 +6290,17 @@ func (x *fastReflection_FeedsSignatureOrder) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_FeedsSignatureOrder) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorPriceList) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.FeedsSignatureOrder.signal_ids":
-		return len(x.SignalIds) != 0
-	case "band.feeds.v1beta1.FeedsSignatureOrder.encoder":
-		return x.Encoder != 0
+	case "band.feeds.v1beta1.ValidatorPriceList.validator":
+		return x.Validator != ""
+	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
+		return len(x.ValidatorPrices) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedsSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.FeedsSignatureOrder does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6203,17 +6310,17 @@ func (x *fastReflection_FeedsSignatureOrder) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeedsSignatureOrder) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorPriceList) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.FeedsSignatureOrder.signal_ids":
-		x.SignalIds = nil
-	case "band.feeds.v1beta1.FeedsSignatureOrder.encoder":
-		x.Encoder = 0
+	case "band.feeds.v1beta1.ValidatorPriceList.validator":
+		x.Validator = ""
+	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
+		x.ValidatorPrices = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedsSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.FeedsSignatureOrder does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6223,22 +6330,22 @@ func (x *fastReflection_FeedsSignatureOrder) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_FeedsSignatureOrder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorPriceList) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.FeedsSignatureOrder.signal_ids":
-		if len(x.SignalIds) == 0 {
-			return protoreflect.ValueOfList(&_FeedsSignatureOrder_1_list{})
+	case "band.feeds.v1beta1.ValidatorPriceList.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
+		if len(x.ValidatorPrices) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorPriceList_2_list{})
 		}
-		listValue := &_FeedsSignatureOrder_1_list{list: &x.SignalIds}
+		listValue := &_ValidatorPriceList_2_list{list: &x.ValidatorPrices}
 		return protoreflect.ValueOfList(listValue)
-	case "band.feeds.v1beta1.FeedsSignatureOrder.encoder":
-		value := x.Encoder
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedsSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.FeedsSignatureOrder does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6252,19 +6359,19 @@ func (x *fastReflection_FeedsSignatureOrder) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeedsSignatureOrder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorPriceList) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.FeedsSignatureOrder.signal_ids":
+	case "band.feeds.v1beta1.ValidatorPriceList.validator":
+		x.Validator = value.Interface().(string)
+	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
 		lv := value.List()
-		clv := lv.(*_FeedsSignatureOrder_1_list)
-		x.SignalIds = *clv.list
-	case "band.feeds.v1beta1.FeedsSignatureOrder.encoder":
-		x.Encoder = (Encoder)(value.Enum())
+		clv := lv.(*_ValidatorPriceList_2_list)
+		x.ValidatorPrices = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedsSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.FeedsSignatureOrder does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6278,49 +6385,49 @@ func (x *fastReflection_FeedsSignatureOrder) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeedsSignatureOrder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorPriceList) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.FeedsSignatureOrder.signal_ids":
-		if x.SignalIds == nil {
-			x.SignalIds = []string{}
+	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
+		if x.ValidatorPrices == nil {
+			x.ValidatorPrices = []*ValidatorPrice{}
 		}
-		value := &_FeedsSignatureOrder_1_list{list: &x.SignalIds}
+		value := &_ValidatorPriceList_2_list{list: &x.ValidatorPrices}
 		return protoreflect.ValueOfList(value)
-	case "band.feeds.v1beta1.FeedsSignatureOrder.encoder":
-		panic(fmt.Errorf("field encoder of message band.feeds.v1beta1.FeedsSignatureOrder is not mutable"))
+	case "band.feeds.v1beta1.ValidatorPriceList.validator":
+		panic(fmt.Errorf("field validator of message band.feeds.v1beta1.ValidatorPriceList is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedsSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.FeedsSignatureOrder does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_FeedsSignatureOrder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorPriceList) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.FeedsSignatureOrder.signal_ids":
-		list := []string{}
-		return protoreflect.ValueOfList(&_FeedsSignatureOrder_1_list{list: &list})
-	case "band.feeds.v1beta1.FeedsSignatureOrder.encoder":
-		return protoreflect.ValueOfEnum(0)
+	case "band.feeds.v1beta1.ValidatorPriceList.validator":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
+		list := []*ValidatorPrice{}
+		return protoreflect.ValueOfList(&_ValidatorPriceList_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedsSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.FeedsSignatureOrder does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_FeedsSignatureOrder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorPriceList) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.FeedsSignatureOrder", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.ValidatorPriceList", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6328,7 +6435,7 @@ func (x *fastReflection_FeedsSignatureOrder) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_FeedsSignatureOrder) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorPriceList) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6339,7 +6446,7 @@ func (x *fastReflection_FeedsSignatureOrder) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeedsSignatureOrder) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorPriceList) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6351,7 +6458,7 @@ func (x *fastReflection_FeedsSignatureOrder) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_FeedsSignatureOrder) IsValid() bool {
+func (x *fastReflection_ValidatorPriceList) IsValid() bool {
 	return x != nil
 }
 
@@ -6361,9 +6468,9 @@ func (x *fastReflection_FeedsSignatureOrder) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_FeedsSignatureOrder) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorPriceList) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*FeedsSignatureOrder)
+		x := input.Message.Interface().(*ValidatorPriceList)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6375,15 +6482,16 @@ func (x *fastReflection_FeedsSignatureOrder) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if len(x.SignalIds) > 0 {
-			for _, s := range x.SignalIds {
-				l = len(s)
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ValidatorPrices) > 0 {
+			for _, e := range x.ValidatorPrices {
+				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.Encoder != 0 {
-			n += 1 + runtime.Sov(uint64(x.Encoder))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6394,7 +6502,7 @@ func (x *fastReflection_FeedsSignatureOrder) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*FeedsSignatureOrder)
+		x := input.Message.Interface().(*ValidatorPriceList)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6413,20 +6521,29 @@ func (x *fastReflection_FeedsSignatureOrder) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Encoder != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Encoder))
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.SignalIds) > 0 {
-			for iNdEx := len(x.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.SignalIds[iNdEx])
-				copy(dAtA[i:], x.SignalIds[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalIds[iNdEx])))
+		if len(x.ValidatorPrices) > 0 {
+			for iNdEx := len(x.ValidatorPrices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ValidatorPrices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0xa
+				dAtA[i] = 0x12
 			}
 		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -6438,7 +6555,7 @@ func (x *fastReflection_FeedsSignatureOrder) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*FeedsSignatureOrder)
+		x := input.Message.Interface().(*ValidatorPriceList)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6470,15 +6587,15 @@ func (x *fastReflection_FeedsSignatureOrder) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeedsSignatureOrder: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPriceList: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeedsSignatureOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPriceList: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6506,13 +6623,13 @@ func (x *fastReflection_FeedsSignatureOrder) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SignalIds = append(x.SignalIds, string(dAtA[iNdEx:postIndex]))
+				x.Validator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorPrices", wireType)
 				}
-				x.Encoder = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6522,11 +6639,26 @@ func (x *fastReflection_FeedsSignatureOrder) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Encoder |= Encoder(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorPrices = append(x.ValidatorPrices, &ValidatorPrice{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorPrices[len(x.ValidatorPrices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6562,742 +6694,5762 @@ func (x *fastReflection_FeedsSignatureOrder) ProtoMethods() *protoiface.Methods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: band/feeds/v1beta1/feeds.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// PriceStatus is a structure that defines the price status of a price.
-type PriceStatus int32
-
-const (
-	// PRICE_STATUS_UNSPECIFIED is an unspecified price status.
-	PriceStatus_PRICE_STATUS_UNSPECIFIED PriceStatus = 0
-	// PRICE_STATUS_UNKNOWN_SIGNAL_ID is an unknown signal id price status.
-	PriceStatus_PRICE_STATUS_UNKNOWN_SIGNAL_ID PriceStatus = 1
-	// PRICE_STATUS_NOT_READY is a not ready price status.
-	PriceStatus_PRICE_STATUS_NOT_READY PriceStatus = 2
-	// PRICE_STATUS_AVAILABLE is an available price status.
-	PriceStatus_PRICE_STATUS_AVAILABLE PriceStatus = 3
-	// PRICE_STATUS_NOT_IN_CURRENT_FEEDS is a not in current feed price status.
-	PriceStatus_PRICE_STATUS_NOT_IN_CURRENT_FEEDS PriceStatus = 4
-)
-
-// Enum value maps for PriceStatus.
 var (
-	PriceStatus_name = map[int32]string{
-		0: "PRICE_STATUS_UNSPECIFIED",
-		1: "PRICE_STATUS_UNKNOWN_SIGNAL_ID",
-		2: "PRICE_STATUS_NOT_READY",
-		3: "PRICE_STATUS_AVAILABLE",
-		4: "PRICE_STATUS_NOT_IN_CURRENT_FEEDS",
-	}
-	PriceStatus_value = map[string]int32{
-		"PRICE_STATUS_UNSPECIFIED":          0,
-		"PRICE_STATUS_UNKNOWN_SIGNAL_ID":    1,
-		"PRICE_STATUS_NOT_READY":            2,
-		"PRICE_STATUS_AVAILABLE":            3,
-		"PRICE_STATUS_NOT_IN_CURRENT_FEEDS": 4,
-	}
+	md_SignalPricesCommitment           protoreflect.MessageDescriptor
+	fd_SignalPricesCommitment_validator protoreflect.FieldDescriptor
+	fd_SignalPricesCommitment_hash      protoreflect.FieldDescriptor
+	fd_SignalPricesCommitment_round     protoreflect.FieldDescriptor
 )
 
-func (x PriceStatus) Enum() *PriceStatus {
-	p := new(PriceStatus)
-	*p = x
-	return p
-}
-
-func (x PriceStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func init() {
+	file_band_feeds_v1beta1_feeds_proto_init()
+	md_SignalPricesCommitment = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("SignalPricesCommitment")
+	fd_SignalPricesCommitment_validator = md_SignalPricesCommitment.Fields().ByName("validator")
+	fd_SignalPricesCommitment_hash = md_SignalPricesCommitment.Fields().ByName("hash")
+	fd_SignalPricesCommitment_round = md_SignalPricesCommitment.Fields().ByName("round")
 }
 
-func (PriceStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_band_feeds_v1beta1_feeds_proto_enumTypes[0].Descriptor()
-}
+var _ protoreflect.Message = (*fastReflection_SignalPricesCommitment)(nil)
 
-func (PriceStatus) Type() protoreflect.EnumType {
-	return &file_band_feeds_v1beta1_feeds_proto_enumTypes[0]
-}
+type fastReflection_SignalPricesCommitment SignalPricesCommitment
 
-func (x PriceStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *SignalPricesCommitment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignalPricesCommitment)(x)
 }
 
-// Deprecated: Use PriceStatus.Descriptor instead.
-func (PriceStatus) EnumDescriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{0}
+func (x *SignalPricesCommitment) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-// SignalPriceStatus is a structure that defines the price status of a signal id.
-type SignalPriceStatus int32
-
-const (
-	// SIGNAL_PRICE_STATUS_UNSPECIFIED is an unspecified signal price status.
-	SignalPriceStatus_SIGNAL_PRICE_STATUS_UNSPECIFIED SignalPriceStatus = 0
-	// SIGNAL_PRICE_STATUS_UNSUPPORTED is an unsupported signal price status.
-	SignalPriceStatus_SIGNAL_PRICE_STATUS_UNSUPPORTED SignalPriceStatus = 1
-	// SIGNAL_PRICE_STATUS_UNAVAILABLE is an unavailable signal price status.
-	SignalPriceStatus_SIGNAL_PRICE_STATUS_UNAVAILABLE SignalPriceStatus = 2
-	// SIGNAL_PRICE_STATUS_AVAILABLE is an available signal price status.
-	SignalPriceStatus_SIGNAL_PRICE_STATUS_AVAILABLE SignalPriceStatus = 3
-)
+var _fastReflection_SignalPricesCommitment_messageType fastReflection_SignalPricesCommitment_messageType
+var _ protoreflect.MessageType = fastReflection_SignalPricesCommitment_messageType{}
 
-// Enum value maps for SignalPriceStatus.
-var (
-	SignalPriceStatus_name = map[int32]string{
-		0: "SIGNAL_PRICE_STATUS_UNSPECIFIED",
-		1: "SIGNAL_PRICE_STATUS_UNSUPPORTED",
-		2: "SIGNAL_PRICE_STATUS_UNAVAILABLE",
-		3: "SIGNAL_PRICE_STATUS_AVAILABLE",
-	}
-	SignalPriceStatus_value = map[string]int32{
-		"SIGNAL_PRICE_STATUS_UNSPECIFIED": 0,
-		"SIGNAL_PRICE_STATUS_UNSUPPORTED": 1,
-		"SIGNAL_PRICE_STATUS_UNAVAILABLE": 2,
-		"SIGNAL_PRICE_STATUS_AVAILABLE":   3,
-	}
-)
+type fastReflection_SignalPricesCommitment_messageType struct{}
 
-func (x SignalPriceStatus) Enum() *SignalPriceStatus {
-	p := new(SignalPriceStatus)
-	*p = x
-	return p
+func (x fastReflection_SignalPricesCommitment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignalPricesCommitment)(nil)
 }
-
-func (x SignalPriceStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x fastReflection_SignalPricesCommitment_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignalPricesCommitment)
 }
-
-func (SignalPriceStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_band_feeds_v1beta1_feeds_proto_enumTypes[1].Descriptor()
+func (x fastReflection_SignalPricesCommitment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalPricesCommitment
 }
 
-func (SignalPriceStatus) Type() protoreflect.EnumType {
-	return &file_band_feeds_v1beta1_feeds_proto_enumTypes[1]
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SignalPricesCommitment) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalPricesCommitment
 }
 
-func (x SignalPriceStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SignalPricesCommitment) Type() protoreflect.MessageType {
+	return _fastReflection_SignalPricesCommitment_messageType
 }
 
-// Deprecated: Use SignalPriceStatus.Descriptor instead.
-func (SignalPriceStatus) EnumDescriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{1}
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SignalPricesCommitment) New() protoreflect.Message {
+	return new(fastReflection_SignalPricesCommitment)
 }
 
-// Signal is the data structure that contains signal id and power of that signal.
-type Signal struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SignalPricesCommitment) Interface() protoreflect.ProtoMessage {
+	return (*SignalPricesCommitment)(x)
+}
 
-	// id is the id of the signal.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SignalPricesCommitment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_SignalPricesCommitment_validator, value) {
+			return
+		}
+	}
+	if len(x.Hash) != 0 {
+		value := protoreflect.ValueOfBytes(x.Hash)
+		if !f(fd_SignalPricesCommitment_hash, value) {
+			return
+		}
+	}
+	if x.Round != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Round)
+		if !f(fd_SignalPricesCommitment_round, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SignalPricesCommitment) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.SignalPricesCommitment.validator":
+		return x.Validator != ""
+	case "band.feeds.v1beta1.SignalPricesCommitment.hash":
+		return len(x.Hash) != 0
+	case "band.feeds.v1beta1.SignalPricesCommitment.round":
+		return x.Round != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPricesCommitment"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPricesCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalPricesCommitment) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.SignalPricesCommitment.validator":
+		x.Validator = ""
+	case "band.feeds.v1beta1.SignalPricesCommitment.hash":
+		x.Hash = nil
+	case "band.feeds.v1beta1.SignalPricesCommitment.round":
+		x.Round = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPricesCommitment"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPricesCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SignalPricesCommitment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.SignalPricesCommitment.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.SignalPricesCommitment.hash":
+		value := x.Hash
+		return protoreflect.ValueOfBytes(value)
+	case "band.feeds.v1beta1.SignalPricesCommitment.round":
+		value := x.Round
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPricesCommitment"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPricesCommitment does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalPricesCommitment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.SignalPricesCommitment.validator":
+		x.Validator = value.Interface().(string)
+	case "band.feeds.v1beta1.SignalPricesCommitment.hash":
+		x.Hash = value.Bytes()
+	case "band.feeds.v1beta1.SignalPricesCommitment.round":
+		x.Round = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPricesCommitment"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPricesCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalPricesCommitment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.SignalPricesCommitment.validator":
+		panic(fmt.Errorf("field validator of message band.feeds.v1beta1.SignalPricesCommitment is not mutable"))
+	case "band.feeds.v1beta1.SignalPricesCommitment.hash":
+		panic(fmt.Errorf("field hash of message band.feeds.v1beta1.SignalPricesCommitment is not mutable"))
+	case "band.feeds.v1beta1.SignalPricesCommitment.round":
+		panic(fmt.Errorf("field round of message band.feeds.v1beta1.SignalPricesCommitment is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPricesCommitment"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPricesCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SignalPricesCommitment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.SignalPricesCommitment.validator":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.SignalPricesCommitment.hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.feeds.v1beta1.SignalPricesCommitment.round":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPricesCommitment"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPricesCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SignalPricesCommitment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.SignalPricesCommitment", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SignalPricesCommitment) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalPricesCommitment) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SignalPricesCommitment) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SignalPricesCommitment) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SignalPricesCommitment)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Round != 0 {
+			n += 1 + runtime.Sov(uint64(x.Round))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SignalPricesCommitment)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Round != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Round))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SignalPricesCommitment)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalPricesCommitment: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalPricesCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hash = append(x.Hash[:0], dAtA[iNdEx:postIndex]...)
+				if x.Hash == nil {
+					x.Hash = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+				}
+				x.Round = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Round |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ValidatorAccuracy                             protoreflect.MessageDescriptor
+	fd_ValidatorAccuracy_validator                   protoreflect.FieldDescriptor
+	fd_ValidatorAccuracy_evaluated_count             protoreflect.FieldDescriptor
+	fd_ValidatorAccuracy_outlier_count               protoreflect.FieldDescriptor
+	fd_ValidatorAccuracy_total_deviation_basis_point protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_feeds_proto_init()
+	md_ValidatorAccuracy = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("ValidatorAccuracy")
+	fd_ValidatorAccuracy_validator = md_ValidatorAccuracy.Fields().ByName("validator")
+	fd_ValidatorAccuracy_evaluated_count = md_ValidatorAccuracy.Fields().ByName("evaluated_count")
+	fd_ValidatorAccuracy_outlier_count = md_ValidatorAccuracy.Fields().ByName("outlier_count")
+	fd_ValidatorAccuracy_total_deviation_basis_point = md_ValidatorAccuracy.Fields().ByName("total_deviation_basis_point")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorAccuracy)(nil)
+
+type fastReflection_ValidatorAccuracy ValidatorAccuracy
+
+func (x *ValidatorAccuracy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorAccuracy)(x)
+}
+
+func (x *ValidatorAccuracy) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorAccuracy_messageType fastReflection_ValidatorAccuracy_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorAccuracy_messageType{}
+
+type fastReflection_ValidatorAccuracy_messageType struct{}
+
+func (x fastReflection_ValidatorAccuracy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorAccuracy)(nil)
+}
+func (x fastReflection_ValidatorAccuracy_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorAccuracy)
+}
+func (x fastReflection_ValidatorAccuracy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorAccuracy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorAccuracy) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorAccuracy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorAccuracy) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorAccuracy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorAccuracy) New() protoreflect.Message {
+	return new(fastReflection_ValidatorAccuracy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorAccuracy) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorAccuracy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorAccuracy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_ValidatorAccuracy_validator, value) {
+			return
+		}
+	}
+	if x.EvaluatedCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EvaluatedCount)
+		if !f(fd_ValidatorAccuracy_evaluated_count, value) {
+			return
+		}
+	}
+	if x.OutlierCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OutlierCount)
+		if !f(fd_ValidatorAccuracy_outlier_count, value) {
+			return
+		}
+	}
+	if x.TotalDeviationBasisPoint != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalDeviationBasisPoint)
+		if !f(fd_ValidatorAccuracy_total_deviation_basis_point, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorAccuracy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.ValidatorAccuracy.validator":
+		return x.Validator != ""
+	case "band.feeds.v1beta1.ValidatorAccuracy.evaluated_count":
+		return x.EvaluatedCount != uint64(0)
+	case "band.feeds.v1beta1.ValidatorAccuracy.outlier_count":
+		return x.OutlierCount != uint64(0)
+	case "band.feeds.v1beta1.ValidatorAccuracy.total_deviation_basis_point":
+		return x.TotalDeviationBasisPoint != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorAccuracy"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorAccuracy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorAccuracy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.ValidatorAccuracy.validator":
+		x.Validator = ""
+	case "band.feeds.v1beta1.ValidatorAccuracy.evaluated_count":
+		x.EvaluatedCount = uint64(0)
+	case "band.feeds.v1beta1.ValidatorAccuracy.outlier_count":
+		x.OutlierCount = uint64(0)
+	case "band.feeds.v1beta1.ValidatorAccuracy.total_deviation_basis_point":
+		x.TotalDeviationBasisPoint = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorAccuracy"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorAccuracy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorAccuracy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.ValidatorAccuracy.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.ValidatorAccuracy.evaluated_count":
+		value := x.EvaluatedCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.ValidatorAccuracy.outlier_count":
+		value := x.OutlierCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.ValidatorAccuracy.total_deviation_basis_point":
+		value := x.TotalDeviationBasisPoint
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorAccuracy"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorAccuracy does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorAccuracy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.ValidatorAccuracy.validator":
+		x.Validator = value.Interface().(string)
+	case "band.feeds.v1beta1.ValidatorAccuracy.evaluated_count":
+		x.EvaluatedCount = value.Uint()
+	case "band.feeds.v1beta1.ValidatorAccuracy.outlier_count":
+		x.OutlierCount = value.Uint()
+	case "band.feeds.v1beta1.ValidatorAccuracy.total_deviation_basis_point":
+		x.TotalDeviationBasisPoint = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorAccuracy"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorAccuracy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorAccuracy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.ValidatorAccuracy.validator":
+		panic(fmt.Errorf("field validator of message band.feeds.v1beta1.ValidatorAccuracy is not mutable"))
+	case "band.feeds.v1beta1.ValidatorAccuracy.evaluated_count":
+		panic(fmt.Errorf("field evaluated_count of message band.feeds.v1beta1.ValidatorAccuracy is not mutable"))
+	case "band.feeds.v1beta1.ValidatorAccuracy.outlier_count":
+		panic(fmt.Errorf("field outlier_count of message band.feeds.v1beta1.ValidatorAccuracy is not mutable"))
+	case "band.feeds.v1beta1.ValidatorAccuracy.total_deviation_basis_point":
+		panic(fmt.Errorf("field total_deviation_basis_point of message band.feeds.v1beta1.ValidatorAccuracy is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorAccuracy"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorAccuracy does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorAccuracy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.ValidatorAccuracy.validator":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.ValidatorAccuracy.evaluated_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.ValidatorAccuracy.outlier_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.ValidatorAccuracy.total_deviation_basis_point":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorAccuracy"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorAccuracy does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorAccuracy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.ValidatorAccuracy", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorAccuracy) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorAccuracy) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorAccuracy) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorAccuracy) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorAccuracy)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EvaluatedCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.EvaluatedCount))
+		}
+		if x.OutlierCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutlierCount))
+		}
+		if x.TotalDeviationBasisPoint != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalDeviationBasisPoint))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorAccuracy)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TotalDeviationBasisPoint != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalDeviationBasisPoint))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.OutlierCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutlierCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.EvaluatedCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EvaluatedCount))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorAccuracy)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorAccuracy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorAccuracy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvaluatedCount", wireType)
+				}
+				x.EvaluatedCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EvaluatedCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutlierCount", wireType)
+				}
+				x.OutlierCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutlierCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalDeviationBasisPoint", wireType)
+				}
+				x.TotalDeviationBasisPoint = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalDeviationBasisPoint |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ValidatorFeedReport                              protoreflect.MessageDescriptor
+	fd_ValidatorFeedReport_signal_id                    protoreflect.FieldDescriptor
+	fd_ValidatorFeedReport_validator_price              protoreflect.FieldDescriptor
+	fd_ValidatorFeedReport_aggregated_price             protoreflect.FieldDescriptor
+	fd_ValidatorFeedReport_deviation_basis_point        protoreflect.FieldDescriptor
+	fd_ValidatorFeedReport_counted_in_median            protoreflect.FieldDescriptor
+	fd_ValidatorFeedReport_report_deadline_timestamp    protoreflect.FieldDescriptor
+	fd_ValidatorFeedReport_report_deadline_block_height protoreflect.FieldDescriptor
+	fd_ValidatorFeedReport_time_until_miss_report       protoreflect.FieldDescriptor
+	fd_ValidatorFeedReport_cooldown_end_timestamp       protoreflect.FieldDescriptor
+	fd_ValidatorFeedReport_in_cooldown                  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_feeds_proto_init()
+	md_ValidatorFeedReport = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("ValidatorFeedReport")
+	fd_ValidatorFeedReport_signal_id = md_ValidatorFeedReport.Fields().ByName("signal_id")
+	fd_ValidatorFeedReport_validator_price = md_ValidatorFeedReport.Fields().ByName("validator_price")
+	fd_ValidatorFeedReport_aggregated_price = md_ValidatorFeedReport.Fields().ByName("aggregated_price")
+	fd_ValidatorFeedReport_deviation_basis_point = md_ValidatorFeedReport.Fields().ByName("deviation_basis_point")
+	fd_ValidatorFeedReport_counted_in_median = md_ValidatorFeedReport.Fields().ByName("counted_in_median")
+	fd_ValidatorFeedReport_report_deadline_timestamp = md_ValidatorFeedReport.Fields().ByName("report_deadline_timestamp")
+	fd_ValidatorFeedReport_report_deadline_block_height = md_ValidatorFeedReport.Fields().ByName("report_deadline_block_height")
+	fd_ValidatorFeedReport_time_until_miss_report = md_ValidatorFeedReport.Fields().ByName("time_until_miss_report")
+	fd_ValidatorFeedReport_cooldown_end_timestamp = md_ValidatorFeedReport.Fields().ByName("cooldown_end_timestamp")
+	fd_ValidatorFeedReport_in_cooldown = md_ValidatorFeedReport.Fields().ByName("in_cooldown")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorFeedReport)(nil)
+
+type fastReflection_ValidatorFeedReport ValidatorFeedReport
+
+func (x *ValidatorFeedReport) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorFeedReport)(x)
+}
+
+func (x *ValidatorFeedReport) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorFeedReport_messageType fastReflection_ValidatorFeedReport_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorFeedReport_messageType{}
+
+type fastReflection_ValidatorFeedReport_messageType struct{}
+
+func (x fastReflection_ValidatorFeedReport_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorFeedReport)(nil)
+}
+func (x fastReflection_ValidatorFeedReport_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorFeedReport)
+}
+func (x fastReflection_ValidatorFeedReport_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorFeedReport
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorFeedReport) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorFeedReport
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorFeedReport) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorFeedReport_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorFeedReport) New() protoreflect.Message {
+	return new(fastReflection_ValidatorFeedReport)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorFeedReport) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorFeedReport)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorFeedReport) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_ValidatorFeedReport_signal_id, value) {
+			return
+		}
+	}
+	if x.ValidatorPrice != nil {
+		value := protoreflect.ValueOfMessage(x.ValidatorPrice.ProtoReflect())
+		if !f(fd_ValidatorFeedReport_validator_price, value) {
+			return
+		}
+	}
+	if x.AggregatedPrice != nil {
+		value := protoreflect.ValueOfMessage(x.AggregatedPrice.ProtoReflect())
+		if !f(fd_ValidatorFeedReport_aggregated_price, value) {
+			return
+		}
+	}
+	if x.DeviationBasisPoint != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DeviationBasisPoint)
+		if !f(fd_ValidatorFeedReport_deviation_basis_point, value) {
+			return
+		}
+	}
+	if x.CountedInMedian != false {
+		value := protoreflect.ValueOfBool(x.CountedInMedian)
+		if !f(fd_ValidatorFeedReport_counted_in_median, value) {
+			return
+		}
+	}
+	if x.ReportDeadlineTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ReportDeadlineTimestamp)
+		if !f(fd_ValidatorFeedReport_report_deadline_timestamp, value) {
+			return
+		}
+	}
+	if x.ReportDeadlineBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ReportDeadlineBlockHeight)
+		if !f(fd_ValidatorFeedReport_report_deadline_block_height, value) {
+			return
+		}
+	}
+	if x.TimeUntilMissReport != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TimeUntilMissReport)
+		if !f(fd_ValidatorFeedReport_time_until_miss_report, value) {
+			return
+		}
+	}
+	if x.CooldownEndTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CooldownEndTimestamp)
+		if !f(fd_ValidatorFeedReport_cooldown_end_timestamp, value) {
+			return
+		}
+	}
+	if x.InCooldown != false {
+		value := protoreflect.ValueOfBool(x.InCooldown)
+		if !f(fd_ValidatorFeedReport_in_cooldown, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorFeedReport) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.ValidatorFeedReport.signal_id":
+		return x.SignalId != ""
+	case "band.feeds.v1beta1.ValidatorFeedReport.validator_price":
+		return x.ValidatorPrice != nil
+	case "band.feeds.v1beta1.ValidatorFeedReport.aggregated_price":
+		return x.AggregatedPrice != nil
+	case "band.feeds.v1beta1.ValidatorFeedReport.deviation_basis_point":
+		return x.DeviationBasisPoint != uint64(0)
+	case "band.feeds.v1beta1.ValidatorFeedReport.counted_in_median":
+		return x.CountedInMedian != false
+	case "band.feeds.v1beta1.ValidatorFeedReport.report_deadline_timestamp":
+		return x.ReportDeadlineTimestamp != int64(0)
+	case "band.feeds.v1beta1.ValidatorFeedReport.report_deadline_block_height":
+		return x.ReportDeadlineBlockHeight != int64(0)
+	case "band.feeds.v1beta1.ValidatorFeedReport.time_until_miss_report":
+		return x.TimeUntilMissReport != int64(0)
+	case "band.feeds.v1beta1.ValidatorFeedReport.cooldown_end_timestamp":
+		return x.CooldownEndTimestamp != int64(0)
+	case "band.feeds.v1beta1.ValidatorFeedReport.in_cooldown":
+		return x.InCooldown != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorFeedReport"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorFeedReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorFeedReport) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.ValidatorFeedReport.signal_id":
+		x.SignalId = ""
+	case "band.feeds.v1beta1.ValidatorFeedReport.validator_price":
+		x.ValidatorPrice = nil
+	case "band.feeds.v1beta1.ValidatorFeedReport.aggregated_price":
+		x.AggregatedPrice = nil
+	case "band.feeds.v1beta1.ValidatorFeedReport.deviation_basis_point":
+		x.DeviationBasisPoint = uint64(0)
+	case "band.feeds.v1beta1.ValidatorFeedReport.counted_in_median":
+		x.CountedInMedian = false
+	case "band.feeds.v1beta1.ValidatorFeedReport.report_deadline_timestamp":
+		x.ReportDeadlineTimestamp = int64(0)
+	case "band.feeds.v1beta1.ValidatorFeedReport.report_deadline_block_height":
+		x.ReportDeadlineBlockHeight = int64(0)
+	case "band.feeds.v1beta1.ValidatorFeedReport.time_until_miss_report":
+		x.TimeUntilMissReport = int64(0)
+	case "band.feeds.v1beta1.ValidatorFeedReport.cooldown_end_timestamp":
+		x.CooldownEndTimestamp = int64(0)
+	case "band.feeds.v1beta1.ValidatorFeedReport.in_cooldown":
+		x.InCooldown = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorFeedReport"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorFeedReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorFeedReport) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.ValidatorFeedReport.signal_id":
+		value := x.SignalId
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.ValidatorFeedReport.validator_price":
+		value := x.ValidatorPrice
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.feeds.v1beta1.ValidatorFeedReport.aggregated_price":
+		value := x.AggregatedPrice
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.feeds.v1beta1.ValidatorFeedReport.deviation_basis_point":
+		value := x.DeviationBasisPoint
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.ValidatorFeedReport.counted_in_median":
+		value := x.CountedInMedian
+		return protoreflect.ValueOfBool(value)
+	case "band.feeds.v1beta1.ValidatorFeedReport.report_deadline_timestamp":
+		value := x.ReportDeadlineTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.ValidatorFeedReport.report_deadline_block_height":
+		value := x.ReportDeadlineBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.ValidatorFeedReport.time_until_miss_report":
+		value := x.TimeUntilMissReport
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.ValidatorFeedReport.cooldown_end_timestamp":
+		value := x.CooldownEndTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.ValidatorFeedReport.in_cooldown":
+		value := x.InCooldown
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorFeedReport"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorFeedReport does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorFeedReport) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.ValidatorFeedReport.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.feeds.v1beta1.ValidatorFeedReport.validator_price":
+		x.ValidatorPrice = value.Message().Interface().(*ValidatorPrice)
+	case "band.feeds.v1beta1.ValidatorFeedReport.aggregated_price":
+		x.AggregatedPrice = value.Message().Interface().(*Price)
+	case "band.feeds.v1beta1.ValidatorFeedReport.deviation_basis_point":
+		x.DeviationBasisPoint = value.Uint()
+	case "band.feeds.v1beta1.ValidatorFeedReport.counted_in_median":
+		x.CountedInMedian = value.Bool()
+	case "band.feeds.v1beta1.ValidatorFeedReport.report_deadline_timestamp":
+		x.ReportDeadlineTimestamp = value.Int()
+	case "band.feeds.v1beta1.ValidatorFeedReport.report_deadline_block_height":
+		x.ReportDeadlineBlockHeight = value.Int()
+	case "band.feeds.v1beta1.ValidatorFeedReport.time_until_miss_report":
+		x.TimeUntilMissReport = value.Int()
+	case "band.feeds.v1beta1.ValidatorFeedReport.cooldown_end_timestamp":
+		x.CooldownEndTimestamp = value.Int()
+	case "band.feeds.v1beta1.ValidatorFeedReport.in_cooldown":
+		x.InCooldown = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorFeedReport"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorFeedReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorFeedReport) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.ValidatorFeedReport.validator_price":
+		if x.ValidatorPrice == nil {
+			x.ValidatorPrice = new(ValidatorPrice)
+		}
+		return protoreflect.ValueOfMessage(x.ValidatorPrice.ProtoReflect())
+	case "band.feeds.v1beta1.ValidatorFeedReport.aggregated_price":
+		if x.AggregatedPrice == nil {
+			x.AggregatedPrice = new(Price)
+		}
+		return protoreflect.ValueOfMessage(x.AggregatedPrice.ProtoReflect())
+	case "band.feeds.v1beta1.ValidatorFeedReport.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.ValidatorFeedReport is not mutable"))
+	case "band.feeds.v1beta1.ValidatorFeedReport.deviation_basis_point":
+		panic(fmt.Errorf("field deviation_basis_point of message band.feeds.v1beta1.ValidatorFeedReport is not mutable"))
+	case "band.feeds.v1beta1.ValidatorFeedReport.counted_in_median":
+		panic(fmt.Errorf("field counted_in_median of message band.feeds.v1beta1.ValidatorFeedReport is not mutable"))
+	case "band.feeds.v1beta1.ValidatorFeedReport.report_deadline_timestamp":
+		panic(fmt.Errorf("field report_deadline_timestamp of message band.feeds.v1beta1.ValidatorFeedReport is not mutable"))
+	case "band.feeds.v1beta1.ValidatorFeedReport.report_deadline_block_height":
+		panic(fmt.Errorf("field report_deadline_block_height of message band.feeds.v1beta1.ValidatorFeedReport is not mutable"))
+	case "band.feeds.v1beta1.ValidatorFeedReport.time_until_miss_report":
+		panic(fmt.Errorf("field time_until_miss_report of message band.feeds.v1beta1.ValidatorFeedReport is not mutable"))
+	case "band.feeds.v1beta1.ValidatorFeedReport.cooldown_end_timestamp":
+		panic(fmt.Errorf("field cooldown_end_timestamp of message band.feeds.v1beta1.ValidatorFeedReport is not mutable"))
+	case "band.feeds.v1beta1.ValidatorFeedReport.in_cooldown":
+		panic(fmt.Errorf("field in_cooldown of message band.feeds.v1beta1.ValidatorFeedReport is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorFeedReport"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorFeedReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorFeedReport) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.ValidatorFeedReport.signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.ValidatorFeedReport.validator_price":
+		m := new(ValidatorPrice)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.feeds.v1beta1.ValidatorFeedReport.aggregated_price":
+		m := new(Price)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.feeds.v1beta1.ValidatorFeedReport.deviation_basis_point":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.ValidatorFeedReport.counted_in_median":
+		return protoreflect.ValueOfBool(false)
+	case "band.feeds.v1beta1.ValidatorFeedReport.report_deadline_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.ValidatorFeedReport.report_deadline_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.ValidatorFeedReport.time_until_miss_report":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.ValidatorFeedReport.cooldown_end_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.ValidatorFeedReport.in_cooldown":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorFeedReport"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorFeedReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorFeedReport) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.ValidatorFeedReport", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorFeedReport) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorFeedReport) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorFeedReport) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorFeedReport) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorFeedReport)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ValidatorPrice != nil {
+			l = options.Size(x.ValidatorPrice)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AggregatedPrice != nil {
+			l = options.Size(x.AggregatedPrice)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DeviationBasisPoint != 0 {
+			n += 1 + runtime.Sov(uint64(x.DeviationBasisPoint))
+		}
+		if x.CountedInMedian {
+			n += 2
+		}
+		if x.ReportDeadlineTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReportDeadlineTimestamp))
+		}
+		if x.ReportDeadlineBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReportDeadlineBlockHeight))
+		}
+		if x.TimeUntilMissReport != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeUntilMissReport))
+		}
+		if x.CooldownEndTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.CooldownEndTimestamp))
+		}
+		if x.InCooldown {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorFeedReport)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.InCooldown {
+			i--
+			if x.InCooldown {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.CooldownEndTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CooldownEndTimestamp))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.TimeUntilMissReport != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeUntilMissReport))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.ReportDeadlineBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReportDeadlineBlockHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.ReportDeadlineTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReportDeadlineTimestamp))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.CountedInMedian {
+			i--
+			if x.CountedInMedian {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.DeviationBasisPoint != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeviationBasisPoint))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.AggregatedPrice != nil {
+			encoded, err := options.Marshal(x.AggregatedPrice)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ValidatorPrice != nil {
+			encoded, err := options.Marshal(x.ValidatorPrice)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorFeedReport)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorFeedReport: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorFeedReport: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorPrice", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ValidatorPrice == nil {
+					x.ValidatorPrice = &ValidatorPrice{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorPrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregatedPrice", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AggregatedPrice == nil {
+					x.AggregatedPrice = &Price{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AggregatedPrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeviationBasisPoint", wireType)
+				}
+				x.DeviationBasisPoint = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DeviationBasisPoint |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CountedInMedian", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.CountedInMedian = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportDeadlineTimestamp", wireType)
+				}
+				x.ReportDeadlineTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReportDeadlineTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportDeadlineBlockHeight", wireType)
+				}
+				x.ReportDeadlineBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReportDeadlineBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeUntilMissReport", wireType)
+				}
+				x.TimeUntilMissReport = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeUntilMissReport |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CooldownEndTimestamp", wireType)
+				}
+				x.CooldownEndTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CooldownEndTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InCooldown", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.InCooldown = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ReferenceSourceConfig                    protoreflect.MessageDescriptor
+	fd_ReferenceSourceConfig_registry_ipfs_hash protoreflect.FieldDescriptor
+	fd_ReferenceSourceConfig_registry_version   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_feeds_proto_init()
+	md_ReferenceSourceConfig = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("ReferenceSourceConfig")
+	fd_ReferenceSourceConfig_registry_ipfs_hash = md_ReferenceSourceConfig.Fields().ByName("registry_ipfs_hash")
+	fd_ReferenceSourceConfig_registry_version = md_ReferenceSourceConfig.Fields().ByName("registry_version")
+}
+
+var _ protoreflect.Message = (*fastReflection_ReferenceSourceConfig)(nil)
+
+type fastReflection_ReferenceSourceConfig ReferenceSourceConfig
+
+func (x *ReferenceSourceConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ReferenceSourceConfig)(x)
+}
+
+func (x *ReferenceSourceConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ReferenceSourceConfig_messageType fastReflection_ReferenceSourceConfig_messageType
+var _ protoreflect.MessageType = fastReflection_ReferenceSourceConfig_messageType{}
+
+type fastReflection_ReferenceSourceConfig_messageType struct{}
+
+func (x fastReflection_ReferenceSourceConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ReferenceSourceConfig)(nil)
+}
+func (x fastReflection_ReferenceSourceConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_ReferenceSourceConfig)
+}
+func (x fastReflection_ReferenceSourceConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReferenceSourceConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ReferenceSourceConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReferenceSourceConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ReferenceSourceConfig) Type() protoreflect.MessageType {
+	return _fastReflection_ReferenceSourceConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ReferenceSourceConfig) New() protoreflect.Message {
+	return new(fastReflection_ReferenceSourceConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ReferenceSourceConfig) Interface() protoreflect.ProtoMessage {
+	return (*ReferenceSourceConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ReferenceSourceConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RegistryIpfsHash != "" {
+		value := protoreflect.ValueOfString(x.RegistryIpfsHash)
+		if !f(fd_ReferenceSourceConfig_registry_ipfs_hash, value) {
+			return
+		}
+	}
+	if x.RegistryVersion != "" {
+		value := protoreflect.ValueOfString(x.RegistryVersion)
+		if !f(fd_ReferenceSourceConfig_registry_version, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ReferenceSourceConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_ipfs_hash":
+		return x.RegistryIpfsHash != ""
+	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_version":
+		return x.RegistryVersion != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ReferenceSourceConfig"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ReferenceSourceConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReferenceSourceConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_ipfs_hash":
+		x.RegistryIpfsHash = ""
+	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_version":
+		x.RegistryVersion = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ReferenceSourceConfig"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ReferenceSourceConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ReferenceSourceConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_ipfs_hash":
+		value := x.RegistryIpfsHash
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_version":
+		value := x.RegistryVersion
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ReferenceSourceConfig"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ReferenceSourceConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReferenceSourceConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_ipfs_hash":
+		x.RegistryIpfsHash = value.Interface().(string)
+	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_version":
+		x.RegistryVersion = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ReferenceSourceConfig"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ReferenceSourceConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReferenceSourceConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_ipfs_hash":
+		panic(fmt.Errorf("field registry_ipfs_hash of message band.feeds.v1beta1.ReferenceSourceConfig is not mutable"))
+	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_version":
+		panic(fmt.Errorf("field registry_version of message band.feeds.v1beta1.ReferenceSourceConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ReferenceSourceConfig"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ReferenceSourceConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ReferenceSourceConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_ipfs_hash":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.ReferenceSourceConfig.registry_version":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ReferenceSourceConfig"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ReferenceSourceConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ReferenceSourceConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.ReferenceSourceConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ReferenceSourceConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReferenceSourceConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ReferenceSourceConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ReferenceSourceConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ReferenceSourceConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.RegistryIpfsHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RegistryVersion)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ReferenceSourceConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RegistryVersion) > 0 {
+			i -= len(x.RegistryVersion)
+			copy(dAtA[i:], x.RegistryVersion)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RegistryVersion)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.RegistryIpfsHash) > 0 {
+			i -= len(x.RegistryIpfsHash)
+			copy(dAtA[i:], x.RegistryIpfsHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RegistryIpfsHash)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ReferenceSourceConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReferenceSourceConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReferenceSourceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RegistryIpfsHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RegistryIpfsHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RegistryVersion", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RegistryVersion = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SignalInfo                protoreflect.MessageDescriptor
+	fd_SignalInfo_signal_id      protoreflect.FieldDescriptor
+	fd_SignalInfo_decimals       protoreflect.FieldDescriptor
+	fd_SignalInfo_quote_currency protoreflect.FieldDescriptor
+	fd_SignalInfo_asset_class    protoreflect.FieldDescriptor
+	fd_SignalInfo_description    protoreflect.FieldDescriptor
+	fd_SignalInfo_source         protoreflect.FieldDescriptor
+	fd_SignalInfo_deprecated     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_feeds_proto_init()
+	md_SignalInfo = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("SignalInfo")
+	fd_SignalInfo_signal_id = md_SignalInfo.Fields().ByName("signal_id")
+	fd_SignalInfo_decimals = md_SignalInfo.Fields().ByName("decimals")
+	fd_SignalInfo_quote_currency = md_SignalInfo.Fields().ByName("quote_currency")
+	fd_SignalInfo_asset_class = md_SignalInfo.Fields().ByName("asset_class")
+	fd_SignalInfo_description = md_SignalInfo.Fields().ByName("description")
+	fd_SignalInfo_source = md_SignalInfo.Fields().ByName("source")
+	fd_SignalInfo_deprecated = md_SignalInfo.Fields().ByName("deprecated")
+}
+
+var _ protoreflect.Message = (*fastReflection_SignalInfo)(nil)
+
+type fastReflection_SignalInfo SignalInfo
+
+func (x *SignalInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignalInfo)(x)
+}
+
+func (x *SignalInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SignalInfo_messageType fastReflection_SignalInfo_messageType
+var _ protoreflect.MessageType = fastReflection_SignalInfo_messageType{}
+
+type fastReflection_SignalInfo_messageType struct{}
+
+func (x fastReflection_SignalInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignalInfo)(nil)
+}
+func (x fastReflection_SignalInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignalInfo)
+}
+func (x fastReflection_SignalInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalInfo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SignalInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalInfo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SignalInfo) Type() protoreflect.MessageType {
+	return _fastReflection_SignalInfo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SignalInfo) New() protoreflect.Message {
+	return new(fastReflection_SignalInfo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SignalInfo) Interface() protoreflect.ProtoMessage {
+	return (*SignalInfo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SignalInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_SignalInfo_signal_id, value) {
+			return
+		}
+	}
+	if x.Decimals != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Decimals)
+		if !f(fd_SignalInfo_decimals, value) {
+			return
+		}
+	}
+	if x.QuoteCurrency != "" {
+		value := protoreflect.ValueOfString(x.QuoteCurrency)
+		if !f(fd_SignalInfo_quote_currency, value) {
+			return
+		}
+	}
+	if x.AssetClass != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AssetClass))
+		if !f(fd_SignalInfo_asset_class, value) {
+			return
+		}
+	}
+	if x.Description != "" {
+		value := protoreflect.ValueOfString(x.Description)
+		if !f(fd_SignalInfo_description, value) {
+			return
+		}
+	}
+	if x.Source != "" {
+		value := protoreflect.ValueOfString(x.Source)
+		if !f(fd_SignalInfo_source, value) {
+			return
+		}
+	}
+	if x.Deprecated != false {
+		value := protoreflect.ValueOfBool(x.Deprecated)
+		if !f(fd_SignalInfo_deprecated, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SignalInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.SignalInfo.signal_id":
+		return x.SignalId != ""
+	case "band.feeds.v1beta1.SignalInfo.decimals":
+		return x.Decimals != uint32(0)
+	case "band.feeds.v1beta1.SignalInfo.quote_currency":
+		return x.QuoteCurrency != ""
+	case "band.feeds.v1beta1.SignalInfo.asset_class":
+		return x.AssetClass != 0
+	case "band.feeds.v1beta1.SignalInfo.description":
+		return x.Description != ""
+	case "band.feeds.v1beta1.SignalInfo.source":
+		return x.Source != ""
+	case "band.feeds.v1beta1.SignalInfo.deprecated":
+		return x.Deprecated != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalInfo"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.SignalInfo.signal_id":
+		x.SignalId = ""
+	case "band.feeds.v1beta1.SignalInfo.decimals":
+		x.Decimals = uint32(0)
+	case "band.feeds.v1beta1.SignalInfo.quote_currency":
+		x.QuoteCurrency = ""
+	case "band.feeds.v1beta1.SignalInfo.asset_class":
+		x.AssetClass = 0
+	case "band.feeds.v1beta1.SignalInfo.description":
+		x.Description = ""
+	case "band.feeds.v1beta1.SignalInfo.source":
+		x.Source = ""
+	case "band.feeds.v1beta1.SignalInfo.deprecated":
+		x.Deprecated = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalInfo"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SignalInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.SignalInfo.signal_id":
+		value := x.SignalId
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.SignalInfo.decimals":
+		value := x.Decimals
+		return protoreflect.ValueOfUint32(value)
+	case "band.feeds.v1beta1.SignalInfo.quote_currency":
+		value := x.QuoteCurrency
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.SignalInfo.asset_class":
+		value := x.AssetClass
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.feeds.v1beta1.SignalInfo.description":
+		value := x.Description
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.SignalInfo.source":
+		value := x.Source
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.SignalInfo.deprecated":
+		value := x.Deprecated
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalInfo"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalInfo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.SignalInfo.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.feeds.v1beta1.SignalInfo.decimals":
+		x.Decimals = uint32(value.Uint())
+	case "band.feeds.v1beta1.SignalInfo.quote_currency":
+		x.QuoteCurrency = value.Interface().(string)
+	case "band.feeds.v1beta1.SignalInfo.asset_class":
+		x.AssetClass = (AssetClass)(value.Enum())
+	case "band.feeds.v1beta1.SignalInfo.description":
+		x.Description = value.Interface().(string)
+	case "band.feeds.v1beta1.SignalInfo.source":
+		x.Source = value.Interface().(string)
+	case "band.feeds.v1beta1.SignalInfo.deprecated":
+		x.Deprecated = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalInfo"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.SignalInfo.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.SignalInfo is not mutable"))
+	case "band.feeds.v1beta1.SignalInfo.decimals":
+		panic(fmt.Errorf("field decimals of message band.feeds.v1beta1.SignalInfo is not mutable"))
+	case "band.feeds.v1beta1.SignalInfo.quote_currency":
+		panic(fmt.Errorf("field quote_currency of message band.feeds.v1beta1.SignalInfo is not mutable"))
+	case "band.feeds.v1beta1.SignalInfo.asset_class":
+		panic(fmt.Errorf("field asset_class of message band.feeds.v1beta1.SignalInfo is not mutable"))
+	case "band.feeds.v1beta1.SignalInfo.description":
+		panic(fmt.Errorf("field description of message band.feeds.v1beta1.SignalInfo is not mutable"))
+	case "band.feeds.v1beta1.SignalInfo.source":
+		panic(fmt.Errorf("field source of message band.feeds.v1beta1.SignalInfo is not mutable"))
+	case "band.feeds.v1beta1.SignalInfo.deprecated":
+		panic(fmt.Errorf("field deprecated of message band.feeds.v1beta1.SignalInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalInfo"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SignalInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.SignalInfo.signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.SignalInfo.decimals":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "band.feeds.v1beta1.SignalInfo.quote_currency":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.SignalInfo.asset_class":
+		return protoreflect.ValueOfEnum(0)
+	case "band.feeds.v1beta1.SignalInfo.description":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.SignalInfo.source":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.SignalInfo.deprecated":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalInfo"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SignalInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.SignalInfo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SignalInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SignalInfo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SignalInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SignalInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Decimals != 0 {
+			n += 1 + runtime.Sov(uint64(x.Decimals))
+		}
+		l = len(x.QuoteCurrency)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AssetClass != 0 {
+			n += 1 + runtime.Sov(uint64(x.AssetClass))
+		}
+		l = len(x.Description)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Source)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Deprecated {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SignalInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Deprecated {
+			i--
+			if x.Deprecated {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Source) > 0 {
+			i -= len(x.Source)
+			copy(dAtA[i:], x.Source)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Source)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.AssetClass != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AssetClass))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.QuoteCurrency) > 0 {
+			i -= len(x.QuoteCurrency)
+			copy(dAtA[i:], x.QuoteCurrency)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.QuoteCurrency)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Decimals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SignalInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				}
+				x.Decimals = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Decimals |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuoteCurrency", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.QuoteCurrency = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AssetClass", wireType)
+				}
+				x.AssetClass = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AssetClass |= AssetClass(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Description = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Source = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Deprecated = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PriceGuard                        protoreflect.MessageDescriptor
+	fd_PriceGuard_signal_id              protoreflect.FieldDescriptor
+	fd_PriceGuard_max_change_basis_point protoreflect.FieldDescriptor
+	fd_PriceGuard_min_price              protoreflect.FieldDescriptor
+	fd_PriceGuard_max_price              protoreflect.FieldDescriptor
+	fd_PriceGuard_max_staleness          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_feeds_proto_init()
+	md_PriceGuard = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("PriceGuard")
+	fd_PriceGuard_signal_id = md_PriceGuard.Fields().ByName("signal_id")
+	fd_PriceGuard_max_change_basis_point = md_PriceGuard.Fields().ByName("max_change_basis_point")
+	fd_PriceGuard_min_price = md_PriceGuard.Fields().ByName("min_price")
+	fd_PriceGuard_max_price = md_PriceGuard.Fields().ByName("max_price")
+	fd_PriceGuard_max_staleness = md_PriceGuard.Fields().ByName("max_staleness")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceGuard)(nil)
+
+type fastReflection_PriceGuard PriceGuard
+
+func (x *PriceGuard) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceGuard)(x)
+}
+
+func (x *PriceGuard) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceGuard_messageType fastReflection_PriceGuard_messageType
+var _ protoreflect.MessageType = fastReflection_PriceGuard_messageType{}
+
+type fastReflection_PriceGuard_messageType struct{}
+
+func (x fastReflection_PriceGuard_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceGuard)(nil)
+}
+func (x fastReflection_PriceGuard_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceGuard)
+}
+func (x fastReflection_PriceGuard_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceGuard
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceGuard) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceGuard
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceGuard) Type() protoreflect.MessageType {
+	return _fastReflection_PriceGuard_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceGuard) New() protoreflect.Message {
+	return new(fastReflection_PriceGuard)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceGuard) Interface() protoreflect.ProtoMessage {
+	return (*PriceGuard)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceGuard) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_PriceGuard_signal_id, value) {
+			return
+		}
+	}
+	if x.MaxChangeBasisPoint != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxChangeBasisPoint)
+		if !f(fd_PriceGuard_max_change_basis_point, value) {
+			return
+		}
+	}
+	if x.MinPrice != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinPrice)
+		if !f(fd_PriceGuard_min_price, value) {
+			return
+		}
+	}
+	if x.MaxPrice != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPrice)
+		if !f(fd_PriceGuard_max_price, value) {
+			return
+		}
+	}
+	if x.MaxStaleness != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxStaleness)
+		if !f(fd_PriceGuard_max_staleness, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceGuard) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.PriceGuard.signal_id":
+		return x.SignalId != ""
+	case "band.feeds.v1beta1.PriceGuard.max_change_basis_point":
+		return x.MaxChangeBasisPoint != uint64(0)
+	case "band.feeds.v1beta1.PriceGuard.min_price":
+		return x.MinPrice != uint64(0)
+	case "band.feeds.v1beta1.PriceGuard.max_price":
+		return x.MaxPrice != uint64(0)
+	case "band.feeds.v1beta1.PriceGuard.max_staleness":
+		return x.MaxStaleness != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.PriceGuard"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.PriceGuard does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceGuard) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.PriceGuard.signal_id":
+		x.SignalId = ""
+	case "band.feeds.v1beta1.PriceGuard.max_change_basis_point":
+		x.MaxChangeBasisPoint = uint64(0)
+	case "band.feeds.v1beta1.PriceGuard.min_price":
+		x.MinPrice = uint64(0)
+	case "band.feeds.v1beta1.PriceGuard.max_price":
+		x.MaxPrice = uint64(0)
+	case "band.feeds.v1beta1.PriceGuard.max_staleness":
+		x.MaxStaleness = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.PriceGuard"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.PriceGuard does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceGuard) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.PriceGuard.signal_id":
+		value := x.SignalId
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.PriceGuard.max_change_basis_point":
+		value := x.MaxChangeBasisPoint
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.PriceGuard.min_price":
+		value := x.MinPrice
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.PriceGuard.max_price":
+		value := x.MaxPrice
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.PriceGuard.max_staleness":
+		value := x.MaxStaleness
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.PriceGuard"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.PriceGuard does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceGuard) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.PriceGuard.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.feeds.v1beta1.PriceGuard.max_change_basis_point":
+		x.MaxChangeBasisPoint = value.Uint()
+	case "band.feeds.v1beta1.PriceGuard.min_price":
+		x.MinPrice = value.Uint()
+	case "band.feeds.v1beta1.PriceGuard.max_price":
+		x.MaxPrice = value.Uint()
+	case "band.feeds.v1beta1.PriceGuard.max_staleness":
+		x.MaxStaleness = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.PriceGuard"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.PriceGuard does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceGuard) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.PriceGuard.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.PriceGuard is not mutable"))
+	case "band.feeds.v1beta1.PriceGuard.max_change_basis_point":
+		panic(fmt.Errorf("field max_change_basis_point of message band.feeds.v1beta1.PriceGuard is not mutable"))
+	case "band.feeds.v1beta1.PriceGuard.min_price":
+		panic(fmt.Errorf("field min_price of message band.feeds.v1beta1.PriceGuard is not mutable"))
+	case "band.feeds.v1beta1.PriceGuard.max_price":
+		panic(fmt.Errorf("field max_price of message band.feeds.v1beta1.PriceGuard is not mutable"))
+	case "band.feeds.v1beta1.PriceGuard.max_staleness":
+		panic(fmt.Errorf("field max_staleness of message band.feeds.v1beta1.PriceGuard is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.PriceGuard"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.PriceGuard does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceGuard) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.PriceGuard.signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.PriceGuard.max_change_basis_point":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.PriceGuard.min_price":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.PriceGuard.max_price":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.PriceGuard.max_staleness":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.PriceGuard"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.PriceGuard does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceGuard) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.PriceGuard", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceGuard) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceGuard) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceGuard) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceGuard) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceGuard)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxChangeBasisPoint != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxChangeBasisPoint))
+		}
+		if x.MinPrice != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinPrice))
+		}
+		if x.MaxPrice != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPrice))
+		}
+		if x.MaxStaleness != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxStaleness))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceGuard)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxStaleness != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxStaleness))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MaxPrice != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPrice))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MinPrice != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinPrice))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxChangeBasisPoint != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxChangeBasisPoint))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceGuard)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceGuard: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceGuard: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxChangeBasisPoint", wireType)
+				}
+				x.MaxChangeBasisPoint = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxChangeBasisPoint |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+				}
+				x.MinPrice = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinPrice |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+				}
+				x.MaxPrice = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPrice |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxStaleness", wireType)
+				}
+				x.MaxStaleness = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxStaleness |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_FeedsSignatureOrder_1_list)(nil)
+
+type _FeedsSignatureOrder_1_list struct {
+	list *[]string
+}
+
+func (x *_FeedsSignatureOrder_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeedsSignatureOrder_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_FeedsSignatureOrder_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeedsSignatureOrder_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeedsSignatureOrder_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message FeedsSignatureOrder at list field SignalIds as it is not of Message kind"))
+}
+
+func (x *_FeedsSignatureOrder_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeedsSignatureOrder_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_FeedsSignatureOrder_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_FeedsSignatureOrder            protoreflect.MessageDescriptor
+	fd_FeedsSignatureOrder_signal_ids protoreflect.FieldDescriptor
+	fd_FeedsSignatureOrder_encoder    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_feeds_proto_init()
+	md_FeedsSignatureOrder = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("FeedsSignatureOrder")
+	fd_FeedsSignatureOrder_signal_ids = md_FeedsSignatureOrder.Fields().ByName("signal_ids")
+	fd_FeedsSignatureOrder_encoder = md_FeedsSignatureOrder.Fields().ByName("encoder")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeedsSignatureOrder)(nil)
+
+type fastReflection_FeedsSignatureOrder FeedsSignatureOrder
+
+func (x *FeedsSignatureOrder) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeedsSignatureOrder)(x)
+}
+
+func (x *FeedsSignatureOrder) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeedsSignatureOrder_messageType fastReflection_FeedsSignatureOrder_messageType
+var _ protoreflect.MessageType = fastReflection_FeedsSignatureOrder_messageType{}
+
+type fastReflection_FeedsSignatureOrder_messageType struct{}
+
+func (x fastReflection_FeedsSignatureOrder_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeedsSignatureOrder)(nil)
+}
+func (x fastReflection_FeedsSignatureOrder_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeedsSignatureOrder)
+}
+func (x fastReflection_FeedsSignatureOrder_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeedsSignatureOrder
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeedsSignatureOrder) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeedsSignatureOrder
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeedsSignatureOrder) Type() protoreflect.MessageType {
+	return _fastReflection_FeedsSignatureOrder_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeedsSignatureOrder) New() protoreflect.Message {
+	return new(fastReflection_FeedsSignatureOrder)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeedsSignatureOrder) Interface() protoreflect.ProtoMessage {
+	return (*FeedsSignatureOrder)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeedsSignatureOrder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.SignalIds) != 0 {
+		value := protoreflect.ValueOfList(&_FeedsSignatureOrder_1_list{list: &x.SignalIds})
+		if !f(fd_FeedsSignatureOrder_signal_ids, value) {
+			return
+		}
+	}
+	if x.Encoder != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Encoder))
+		if !f(fd_FeedsSignatureOrder_encoder, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeedsSignatureOrder) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.FeedsSignatureOrder.signal_ids":
+		return len(x.SignalIds) != 0
+	case "band.feeds.v1beta1.FeedsSignatureOrder.encoder":
+		return x.Encoder != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedsSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedsSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeedsSignatureOrder) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.FeedsSignatureOrder.signal_ids":
+		x.SignalIds = nil
+	case "band.feeds.v1beta1.FeedsSignatureOrder.encoder":
+		x.Encoder = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedsSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedsSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeedsSignatureOrder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.FeedsSignatureOrder.signal_ids":
+		if len(x.SignalIds) == 0 {
+			return protoreflect.ValueOfList(&_FeedsSignatureOrder_1_list{})
+		}
+		listValue := &_FeedsSignatureOrder_1_list{list: &x.SignalIds}
+		return protoreflect.ValueOfList(listValue)
+	case "band.feeds.v1beta1.FeedsSignatureOrder.encoder":
+		value := x.Encoder
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedsSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedsSignatureOrder does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeedsSignatureOrder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.FeedsSignatureOrder.signal_ids":
+		lv := value.List()
+		clv := lv.(*_FeedsSignatureOrder_1_list)
+		x.SignalIds = *clv.list
+	case "band.feeds.v1beta1.FeedsSignatureOrder.encoder":
+		x.Encoder = (Encoder)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedsSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedsSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeedsSignatureOrder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.FeedsSignatureOrder.signal_ids":
+		if x.SignalIds == nil {
+			x.SignalIds = []string{}
+		}
+		value := &_FeedsSignatureOrder_1_list{list: &x.SignalIds}
+		return protoreflect.ValueOfList(value)
+	case "band.feeds.v1beta1.FeedsSignatureOrder.encoder":
+		panic(fmt.Errorf("field encoder of message band.feeds.v1beta1.FeedsSignatureOrder is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedsSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedsSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeedsSignatureOrder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.FeedsSignatureOrder.signal_ids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_FeedsSignatureOrder_1_list{list: &list})
+	case "band.feeds.v1beta1.FeedsSignatureOrder.encoder":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedsSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedsSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeedsSignatureOrder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.FeedsSignatureOrder", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeedsSignatureOrder) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeedsSignatureOrder) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeedsSignatureOrder) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeedsSignatureOrder) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeedsSignatureOrder)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.SignalIds) > 0 {
+			for _, s := range x.SignalIds {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Encoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.Encoder))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeedsSignatureOrder)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Encoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Encoder))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.SignalIds) > 0 {
+			for iNdEx := len(x.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.SignalIds[iNdEx])
+				copy(dAtA[i:], x.SignalIds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalIds[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeedsSignatureOrder)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeedsSignatureOrder: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeedsSignatureOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalIds = append(x.SignalIds, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+				}
+				x.Encoder = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Encoder |= Encoder(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: band/feeds/v1beta1/feeds.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PriceStatus is a structure that defines the price status of a price.
+type PriceStatus int32
+
+const (
+	// PRICE_STATUS_UNSPECIFIED is an unspecified price status.
+	PriceStatus_PRICE_STATUS_UNSPECIFIED PriceStatus = 0
+	// PRICE_STATUS_UNKNOWN_SIGNAL_ID is an unknown signal id price status.
+	PriceStatus_PRICE_STATUS_UNKNOWN_SIGNAL_ID PriceStatus = 1
+	// PRICE_STATUS_NOT_READY is a not ready price status.
+	PriceStatus_PRICE_STATUS_NOT_READY PriceStatus = 2
+	// PRICE_STATUS_AVAILABLE is an available price status.
+	PriceStatus_PRICE_STATUS_AVAILABLE PriceStatus = 3
+	// PRICE_STATUS_NOT_IN_CURRENT_FEEDS is a not in current feed price status.
+	PriceStatus_PRICE_STATUS_NOT_IN_CURRENT_FEEDS PriceStatus = 4
+	// PRICE_STATUS_HALTED is a halted price status, i.e. the calculated price breaches the price guard of the signal
+	// id and the last accepted price is kept instead.
+	PriceStatus_PRICE_STATUS_HALTED PriceStatus = 5
+)
+
+// Enum value maps for PriceStatus.
+var (
+	PriceStatus_name = map[int32]string{
+		0: "PRICE_STATUS_UNSPECIFIED",
+		1: "PRICE_STATUS_UNKNOWN_SIGNAL_ID",
+		2: "PRICE_STATUS_NOT_READY",
+		3: "PRICE_STATUS_AVAILABLE",
+		4: "PRICE_STATUS_NOT_IN_CURRENT_FEEDS",
+		5: "PRICE_STATUS_HALTED",
+	}
+	PriceStatus_value = map[string]int32{
+		"PRICE_STATUS_UNSPECIFIED":          0,
+		"PRICE_STATUS_UNKNOWN_SIGNAL_ID":    1,
+		"PRICE_STATUS_NOT_READY":            2,
+		"PRICE_STATUS_AVAILABLE":            3,
+		"PRICE_STATUS_NOT_IN_CURRENT_FEEDS": 4,
+		"PRICE_STATUS_HALTED":               5,
+	}
+)
+
+func (x PriceStatus) Enum() *PriceStatus {
+	p := new(PriceStatus)
+	*p = x
+	return p
+}
+
+func (x PriceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PriceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_feeds_v1beta1_feeds_proto_enumTypes[0].Descriptor()
+}
+
+func (PriceStatus) Type() protoreflect.EnumType {
+	return &file_band_feeds_v1beta1_feeds_proto_enumTypes[0]
+}
+
+func (x PriceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PriceStatus.Descriptor instead.
+func (PriceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{0}
+}
+
+// SignalPriceStatus is a structure that defines the price status of a signal id.
+type SignalPriceStatus int32
+
+const (
+	// SIGNAL_PRICE_STATUS_UNSPECIFIED is an unspecified signal price status.
+	SignalPriceStatus_SIGNAL_PRICE_STATUS_UNSPECIFIED SignalPriceStatus = 0
+	// SIGNAL_PRICE_STATUS_UNSUPPORTED is an unsupported signal price status.
+	SignalPriceStatus_SIGNAL_PRICE_STATUS_UNSUPPORTED SignalPriceStatus = 1
+	// SIGNAL_PRICE_STATUS_UNAVAILABLE is an unavailable signal price status.
+	SignalPriceStatus_SIGNAL_PRICE_STATUS_UNAVAILABLE SignalPriceStatus = 2
+	// SIGNAL_PRICE_STATUS_AVAILABLE is an available signal price status.
+	SignalPriceStatus_SIGNAL_PRICE_STATUS_AVAILABLE SignalPriceStatus = 3
+)
+
+// Enum value maps for SignalPriceStatus.
+var (
+	SignalPriceStatus_name = map[int32]string{
+		0: "SIGNAL_PRICE_STATUS_UNSPECIFIED",
+		1: "SIGNAL_PRICE_STATUS_UNSUPPORTED",
+		2: "SIGNAL_PRICE_STATUS_UNAVAILABLE",
+		3: "SIGNAL_PRICE_STATUS_AVAILABLE",
+	}
+	SignalPriceStatus_value = map[string]int32{
+		"SIGNAL_PRICE_STATUS_UNSPECIFIED": 0,
+		"SIGNAL_PRICE_STATUS_UNSUPPORTED": 1,
+		"SIGNAL_PRICE_STATUS_UNAVAILABLE": 2,
+		"SIGNAL_PRICE_STATUS_AVAILABLE":   3,
+	}
+)
+
+func (x SignalPriceStatus) Enum() *SignalPriceStatus {
+	p := new(SignalPriceStatus)
+	*p = x
+	return p
+}
+
+func (x SignalPriceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SignalPriceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_feeds_v1beta1_feeds_proto_enumTypes[1].Descriptor()
+}
+
+func (SignalPriceStatus) Type() protoreflect.EnumType {
+	return &file_band_feeds_v1beta1_feeds_proto_enumTypes[1]
+}
+
+func (x SignalPriceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SignalPriceStatus.Descriptor instead.
+func (SignalPriceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{1}
+}
+
+// AssetClass is an enumerator that defines the asset class of a signal.
+type AssetClass int32
+
+const (
+	// ASSET_CLASS_UNSPECIFIED is an unspecified asset class.
+	AssetClass_ASSET_CLASS_UNSPECIFIED AssetClass = 0
+	// ASSET_CLASS_CRYPTO is a cryptocurrency asset class.
+	AssetClass_ASSET_CLASS_CRYPTO AssetClass = 1
+	// ASSET_CLASS_FOREX is a foreign exchange asset class.
+	AssetClass_ASSET_CLASS_FOREX AssetClass = 2
+	// ASSET_CLASS_COMMODITY is a commodity asset class.
+	AssetClass_ASSET_CLASS_COMMODITY AssetClass = 3
+	// ASSET_CLASS_EQUITY is an equity asset class.
+	AssetClass_ASSET_CLASS_EQUITY AssetClass = 4
+)
+
+// Enum value maps for AssetClass.
+var (
+	AssetClass_name = map[int32]string{
+		0: "ASSET_CLASS_UNSPECIFIED",
+		1: "ASSET_CLASS_CRYPTO",
+		2: "ASSET_CLASS_FOREX",
+		3: "ASSET_CLASS_COMMODITY",
+		4: "ASSET_CLASS_EQUITY",
+	}
+	AssetClass_value = map[string]int32{
+		"ASSET_CLASS_UNSPECIFIED": 0,
+		"ASSET_CLASS_CRYPTO":      1,
+		"ASSET_CLASS_FOREX":       2,
+		"ASSET_CLASS_COMMODITY":   3,
+		"ASSET_CLASS_EQUITY":      4,
+	}
+)
+
+func (x AssetClass) Enum() *AssetClass {
+	p := new(AssetClass)
+	*p = x
+	return p
+}
+
+func (x AssetClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssetClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_feeds_v1beta1_feeds_proto_enumTypes[2].Descriptor()
+}
+
+func (AssetClass) Type() protoreflect.EnumType {
+	return &file_band_feeds_v1beta1_feeds_proto_enumTypes[2]
+}
+
+func (x AssetClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssetClass.Descriptor instead.
+func (AssetClass) EnumDescriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{2}
+}
+
+// Signal is the data structure that contains signal id and power of that signal.
+type Signal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the id of the signal.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// power is the power of the corresponding signal id.
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (x *Signal) Reset() {
+	*x = Signal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Signal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Signal) ProtoMessage() {}
+
+// Deprecated: Use Signal.ProtoReflect.Descriptor instead.
+func (*Signal) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Signal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Signal) GetPower() int64 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+// Vote is the data structure that contains array of signals of a voter.
+type Vote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// voter is the address of the voter of this signals.
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	// signals is a list of signals submit by the voter.
+	Signals []*Signal `protobuf:"bytes,2,rep,name=signals,proto3" json:"signals,omitempty"`
+}
+
+func (x *Vote) Reset() {
+	*x = Vote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Vote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vote) ProtoMessage() {}
+
+// Deprecated: Use Vote.ProtoReflect.Descriptor instead.
+func (*Vote) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Vote) GetVoter() string {
+	if x != nil {
+		return x.Voter
+	}
+	return ""
+}
+
+func (x *Vote) GetSignals() []*Signal {
+	if x != nil {
+		return x.Signals
+	}
+	return nil
+}
+
+// SignalVoteDelegation is the data structure that contains the feeds voting power delegated by a delegator to a
+// curator.
+type SignalVoteDelegation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delegator is the address of the delegator.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// curator is the address of the curator voting signals with the delegated power.
+	Curator string `protobuf:"bytes,2,opt,name=curator,proto3" json:"curator,omitempty"`
+	// power is the power delegated to the curator.
+	Power int64 `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (x *SignalVoteDelegation) Reset() {
+	*x = SignalVoteDelegation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignalVoteDelegation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalVoteDelegation) ProtoMessage() {}
+
+// Deprecated: Use SignalVoteDelegation.ProtoReflect.Descriptor instead.
+func (*SignalVoteDelegation) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SignalVoteDelegation) GetDelegator() string {
+	if x != nil {
+		return x.Delegator
+	}
+	return ""
+}
+
+func (x *SignalVoteDelegation) GetCurator() string {
+	if x != nil {
+		return x.Curator
+	}
+	return ""
+}
+
+func (x *SignalVoteDelegation) GetPower() int64 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+// CuratorDelegatedPower is the data structure that contains the total power delegated to a curator.
+type CuratorDelegatedPower struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// curator is the address of the curator.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// power is the total power delegated to the curator.
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (x *CuratorDelegatedPower) Reset() {
+	*x = CuratorDelegatedPower{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CuratorDelegatedPower) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CuratorDelegatedPower) ProtoMessage() {}
+
+// Deprecated: Use CuratorDelegatedPower.ProtoReflect.Descriptor instead.
+func (*CuratorDelegatedPower) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CuratorDelegatedPower) GetCurator() string {
+	if x != nil {
+		return x.Curator
+	}
+	return ""
+}
+
+func (x *CuratorDelegatedPower) GetPower() int64 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+// Feed is a structure that holds a signal id, its total power, and its calculated interval.
+type Feed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signal_id is the unique string that identifies the unit of feed.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// power is the power of the corresponding signal id.
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	// interval is the interval of the price feed.
+	Interval int64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (x *Feed) Reset() {
+	*x = Feed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Feed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feed) ProtoMessage() {}
+
+// Deprecated: Use Feed.ProtoReflect.Descriptor instead.
+func (*Feed) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Feed) GetSignalId() string {
+	if x != nil {
+		return x.SignalId
+	}
+	return ""
+}
+
+func (x *Feed) GetPower() int64 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+func (x *Feed) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+// FeedWithDeviation is a structure that holds a signal id, its total power, and its calculated interval and deviation.
+type FeedWithDeviation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signal_id is the unique string that identifies the unit of feed.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
 	// power is the power of the corresponding signal id.
 	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	// interval is the interval of the price feed.
+	Interval int64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// deviation_basis_point is the maximum deviation value the feed can tolerate, expressed in basis points.
+	DeviationBasisPoint int64 `protobuf:"varint,4,opt,name=deviation_basis_point,json=deviationBasisPoint,proto3" json:"deviation_basis_point,omitempty"`
+}
+
+func (x *FeedWithDeviation) Reset() {
+	*x = FeedWithDeviation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedWithDeviation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedWithDeviation) ProtoMessage() {}
+
+// Deprecated: Use FeedWithDeviation.ProtoReflect.Descriptor instead.
+func (*FeedWithDeviation) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FeedWithDeviation) GetSignalId() string {
+	if x != nil {
+		return x.SignalId
+	}
+	return ""
+}
+
+func (x *FeedWithDeviation) GetPower() int64 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+func (x *FeedWithDeviation) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *FeedWithDeviation) GetDeviationBasisPoint() int64 {
+	if x != nil {
+		return x.DeviationBasisPoint
+	}
+	return 0
+}
+
+// CurrentFeeds is a structure that holds a list of currently supported feeds, and its last update time and block.
+type CurrentFeeds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// feeds is a list of currently supported feeds.
+	Feeds []*Feed `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds,omitempty"`
+	// last_update_timestamp is the timestamp of the last time supported feeds list is updated.
+	LastUpdateTimestamp int64 `protobuf:"varint,2,opt,name=last_update_timestamp,json=lastUpdateTimestamp,proto3" json:"last_update_timestamp,omitempty"`
+	// last_update_block is the number of blocks of the last time supported feeds list is updated.
+	LastUpdateBlock int64 `protobuf:"varint,3,opt,name=last_update_block,json=lastUpdateBlock,proto3" json:"last_update_block,omitempty"`
 }
 
-func (x *Signal) Reset() {
-	*x = Signal{}
+func (x *CurrentFeeds) Reset() {
+	*x = CurrentFeeds{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[0]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrentFeeds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrentFeeds) ProtoMessage() {}
+
+// Deprecated: Use CurrentFeeds.ProtoReflect.Descriptor instead.
+func (*CurrentFeeds) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CurrentFeeds) GetFeeds() []*Feed {
+	if x != nil {
+		return x.Feeds
+	}
+	return nil
+}
+
+func (x *CurrentFeeds) GetLastUpdateTimestamp() int64 {
+	if x != nil {
+		return x.LastUpdateTimestamp
+	}
+	return 0
+}
+
+func (x *CurrentFeeds) GetLastUpdateBlock() int64 {
+	if x != nil {
+		return x.LastUpdateBlock
+	}
+	return 0
+}
+
+// CurrentFeedWithDeviations is a structure that holds a list of currently supported feed-with-deviations, and its
+// last update time and block.
+type CurrentFeedWithDeviations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// feeds is a list of currently supported feed-with-deviations.
+	Feeds []*FeedWithDeviation `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds,omitempty"`
+	// last_update_timestamp is the timestamp of the last time supported feeds list is updated.
+	LastUpdateTimestamp int64 `protobuf:"varint,2,opt,name=last_update_timestamp,json=lastUpdateTimestamp,proto3" json:"last_update_timestamp,omitempty"`
+	// last_update_block is the number of blocks of the last time supported feeds list is updated.
+	LastUpdateBlock int64 `protobuf:"varint,3,opt,name=last_update_block,json=lastUpdateBlock,proto3" json:"last_update_block,omitempty"`
+}
+
+func (x *CurrentFeedWithDeviations) Reset() {
+	*x = CurrentFeedWithDeviations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrentFeedWithDeviations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrentFeedWithDeviations) ProtoMessage() {}
+
+// Deprecated: Use CurrentFeedWithDeviations.ProtoReflect.Descriptor instead.
+func (*CurrentFeedWithDeviations) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CurrentFeedWithDeviations) GetFeeds() []*FeedWithDeviation {
+	if x != nil {
+		return x.Feeds
+	}
+	return nil
+}
+
+func (x *CurrentFeedWithDeviations) GetLastUpdateTimestamp() int64 {
+	if x != nil {
+		return x.LastUpdateTimestamp
+	}
+	return 0
+}
+
+func (x *CurrentFeedWithDeviations) GetLastUpdateBlock() int64 {
+	if x != nil {
+		return x.LastUpdateBlock
+	}
+	return 0
+}
+
+// Price is a structure that defines the price of a signal id.
+type Price struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// status is the status of a the price.
+	Status PriceStatus `protobuf:"varint,1,opt,name=status,proto3,enum=band.feeds.v1beta1.PriceStatus" json:"status,omitempty"`
+	// signal_id is the signal id of the price.
+	SignalId string `protobuf:"bytes,2,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// price is the price of the signal id.
+	Price uint64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	// timestamp is the timestamp at which the price was aggregated.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// interquartile_range is the power-weighted interquartile range of the validator prices used in the aggregation.
+	InterquartileRange uint64 `protobuf:"varint,5,opt,name=interquartile_range,json=interquartileRange,proto3" json:"interquartile_range,omitempty"`
+	// participation_basis_point is the power of validators reporting available prices relative to the total bonded
+	// power (in basis point).
+	ParticipationBasisPoint uint64 `protobuf:"varint,6,opt,name=participation_basis_point,json=participationBasisPoint,proto3" json:"participation_basis_point,omitempty"`
+	// reporter_count is the number of validators reporting available prices.
+	ReporterCount uint64 `protobuf:"varint,7,opt,name=reporter_count,json=reporterCount,proto3" json:"reporter_count,omitempty"`
+}
+
+func (x *Price) Reset() {
+	*x = Price{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Price) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Price) ProtoMessage() {}
+
+// Deprecated: Use Price.ProtoReflect.Descriptor instead.
+func (*Price) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Price) GetStatus() PriceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PriceStatus_PRICE_STATUS_UNSPECIFIED
+}
+
+func (x *Price) GetSignalId() string {
+	if x != nil {
+		return x.SignalId
+	}
+	return ""
+}
+
+func (x *Price) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Price) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Price) GetInterquartileRange() uint64 {
+	if x != nil {
+		return x.InterquartileRange
+	}
+	return 0
+}
+
+func (x *Price) GetParticipationBasisPoint() uint64 {
+	if x != nil {
+		return x.ParticipationBasisPoint
+	}
+	return 0
+}
+
+func (x *Price) GetReporterCount() uint64 {
+	if x != nil {
+		return x.ReporterCount
+	}
+	return 0
+}
+
+// SignalPrice is a structure that defines the signaled price of a signal id.
+type SignalPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// status is the status of the signal price.
+	Status SignalPriceStatus `protobuf:"varint,1,opt,name=status,proto3,enum=band.feeds.v1beta1.SignalPriceStatus" json:"status,omitempty"`
+	// signal_id is the signal id of the price.
+	SignalId string `protobuf:"bytes,2,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// price is the price submitted by the validator.
+	Price uint64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *SignalPrice) Reset() {
+	*x = SignalPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignalPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalPrice) ProtoMessage() {}
+
+// Deprecated: Use SignalPrice.ProtoReflect.Descriptor instead.
+func (*SignalPrice) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SignalPrice) GetStatus() SignalPriceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SignalPriceStatus_SIGNAL_PRICE_STATUS_UNSPECIFIED
+}
+
+func (x *SignalPrice) GetSignalId() string {
+	if x != nil {
+		return x.SignalId
+	}
+	return ""
+}
+
+func (x *SignalPrice) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+// ValidatorPrice is a structure that defines the price submitted by a validator for a signal id.
+type ValidatorPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signal_price_status is the status of a signal price submitted.
+	SignalPriceStatus SignalPriceStatus `protobuf:"varint,1,opt,name=signal_price_status,json=signalPriceStatus,proto3,enum=band.feeds.v1beta1.SignalPriceStatus" json:"signal_price_status,omitempty"`
+	// signal_id is the signal id of the price.
+	SignalId string `protobuf:"bytes,2,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// price is the price submitted by the validator.
+	Price uint64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	// timestamp is the timestamp at which the price was submitted.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// block_height is the block height at which the price was submitted.
+	BlockHeight int64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *ValidatorPrice) Reset() {
+	*x = ValidatorPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Signal) String() string {
+func (x *ValidatorPrice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Signal) ProtoMessage() {}
+func (*ValidatorPrice) ProtoMessage() {}
 
-// Deprecated: Use Signal.ProtoReflect.Descriptor instead.
-func (*Signal) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ValidatorPrice.ProtoReflect.Descriptor instead.
+func (*ValidatorPrice) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Signal) GetId() string {
+func (x *ValidatorPrice) GetSignalPriceStatus() SignalPriceStatus {
 	if x != nil {
-		return x.Id
+		return x.SignalPriceStatus
+	}
+	return SignalPriceStatus_SIGNAL_PRICE_STATUS_UNSPECIFIED
+}
+
+func (x *ValidatorPrice) GetSignalId() string {
+	if x != nil {
+		return x.SignalId
 	}
 	return ""
 }
 
-func (x *Signal) GetPower() int64 {
+func (x *ValidatorPrice) GetPrice() uint64 {
 	if x != nil {
-		return x.Power
+		return x.Price
 	}
 	return 0
 }
 
-// Vote is the data structure that contains array of signals of a voter.
-type Vote struct {
+func (x *ValidatorPrice) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ValidatorPrice) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+// ValidatorPriceList is a structure that holds a list of validator prices of
+// a validator and its address.
+type ValidatorPriceList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// voter is the address of the voter of this signals.
-	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
-	// signals is a list of signals submit by the voter.
-	Signals []*Signal `protobuf:"bytes,2,rep,name=signals,proto3" json:"signals,omitempty"`
+	// validator is the validator address.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// validators_prices is a list of validator prices.
+	ValidatorPrices []*ValidatorPrice `protobuf:"bytes,2,rep,name=validator_prices,json=validatorPrices,proto3" json:"validator_prices,omitempty"`
 }
 
-func (x *Vote) Reset() {
-	*x = Vote{}
+func (x *ValidatorPriceList) Reset() {
+	*x = ValidatorPriceList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[1]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Vote) String() string {
+func (x *ValidatorPriceList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Vote) ProtoMessage() {}
+func (*ValidatorPriceList) ProtoMessage() {}
 
-// Deprecated: Use Vote.ProtoReflect.Descriptor instead.
-func (*Vote) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ValidatorPriceList.ProtoReflect.Descriptor instead.
+func (*ValidatorPriceList) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Vote) GetVoter() string {
+func (x *ValidatorPriceList) GetValidator() string {
 	if x != nil {
-		return x.Voter
+		return x.Validator
 	}
 	return ""
 }
 
-func (x *Vote) GetSignals() []*Signal {
+func (x *ValidatorPriceList) GetValidatorPrices() []*ValidatorPrice {
 	if x != nil {
-		return x.Signals
+		return x.ValidatorPrices
 	}
 	return nil
 }
 
-// Feed is a structure that holds a signal id, its total power, and its calculated interval.
-type Feed struct {
+// SignalPricesCommitment is a structure that defines the commitment of signal prices of a validator, which must be
+// revealed in the reveal phase of the same commit-reveal round.
+type SignalPricesCommitment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// signal_id is the unique string that identifies the unit of feed.
-	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
-	// power is the power of the corresponding signal id.
-	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
-	// interval is the interval of the price feed.
-	Interval int64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// validator is the validator address.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// hash is the hash of the committed signal prices.
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// round is the commit-reveal round in which the signal prices were committed.
+	Round int64 `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
 }
 
-func (x *Feed) Reset() {
-	*x = Feed{}
+func (x *SignalPricesCommitment) Reset() {
+	*x = SignalPricesCommitment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[2]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Feed) String() string {
+func (x *SignalPricesCommitment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Feed) ProtoMessage() {}
+func (*SignalPricesCommitment) ProtoMessage() {}
 
-// Deprecated: Use Feed.ProtoReflect.Descriptor instead.
-func (*Feed) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use SignalPricesCommitment.ProtoReflect.Descriptor instead.
+func (*SignalPricesCommitment) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Feed) GetSignalId() string {
+func (x *SignalPricesCommitment) GetValidator() string {
 	if x != nil {
-		return x.SignalId
+		return x.Validator
 	}
 	return ""
 }
 
-func (x *Feed) GetPower() int64 {
+func (x *SignalPricesCommitment) GetHash() []byte {
 	if x != nil {
-		return x.Power
+		return x.Hash
 	}
-	return 0
+	return nil
 }
 
-func (x *Feed) GetInterval() int64 {
+func (x *SignalPricesCommitment) GetRound() int64 {
 	if x != nil {
-		return x.Interval
+		return x.Round
 	}
 	return 0
 }
 
-// FeedWithDeviation is a structure that holds a signal id, its total power, and its calculated interval and deviation.
-type FeedWithDeviation struct {
+// ValidatorAccuracy is a structure that defines the accuracy of prices submitted by a validator within the current
+// accuracy window.
+type ValidatorAccuracy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// signal_id is the unique string that identifies the unit of feed.
-	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
-	// power is the power of the corresponding signal id.
-	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
-	// interval is the interval of the price feed.
-	Interval int64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
-	// deviation_basis_point is the maximum deviation value the feed can tolerate, expressed in basis points.
-	DeviationBasisPoint int64 `protobuf:"varint,4,opt,name=deviation_basis_point,json=deviationBasisPoint,proto3" json:"deviation_basis_point,omitempty"`
+	// validator is the validator address.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// evaluated_count is the number of submitted prices compared against the aggregated prices.
+	EvaluatedCount uint64 `protobuf:"varint,2,opt,name=evaluated_count,json=evaluatedCount,proto3" json:"evaluated_count,omitempty"`
+	// outlier_count is the number of submitted prices deviating from the aggregated prices by more than the outlier
+	// threshold.
+	OutlierCount uint64 `protobuf:"varint,3,opt,name=outlier_count,json=outlierCount,proto3" json:"outlier_count,omitempty"`
+	// total_deviation_basis_point is the sum of deviations (in basis point) of the evaluated prices.
+	TotalDeviationBasisPoint uint64 `protobuf:"varint,4,opt,name=total_deviation_basis_point,json=totalDeviationBasisPoint,proto3" json:"total_deviation_basis_point,omitempty"`
 }
 
-func (x *FeedWithDeviation) Reset() {
-	*x = FeedWithDeviation{}
+func (x *ValidatorAccuracy) Reset() {
+	*x = ValidatorAccuracy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[3]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FeedWithDeviation) String() string {
+func (x *ValidatorAccuracy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeedWithDeviation) ProtoMessage() {}
+func (*ValidatorAccuracy) ProtoMessage() {}
 
-// Deprecated: Use FeedWithDeviation.ProtoReflect.Descriptor instead.
-func (*FeedWithDeviation) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ValidatorAccuracy.ProtoReflect.Descriptor instead.
+func (*ValidatorAccuracy) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *FeedWithDeviation) GetSignalId() string {
+func (x *ValidatorAccuracy) GetValidator() string {
 	if x != nil {
-		return x.SignalId
+		return x.Validator
 	}
 	return ""
 }
 
-func (x *FeedWithDeviation) GetPower() int64 {
+func (x *ValidatorAccuracy) GetEvaluatedCount() uint64 {
 	if x != nil {
-		return x.Power
+		return x.EvaluatedCount
 	}
 	return 0
 }
 
-func (x *FeedWithDeviation) GetInterval() int64 {
+func (x *ValidatorAccuracy) GetOutlierCount() uint64 {
 	if x != nil {
-		return x.Interval
+		return x.OutlierCount
 	}
 	return 0
 }
 
-func (x *FeedWithDeviation) GetDeviationBasisPoint() int64 {
+func (x *ValidatorAccuracy) GetTotalDeviationBasisPoint() uint64 {
 	if x != nil {
-		return x.DeviationBasisPoint
+		return x.TotalDeviationBasisPoint
 	}
 	return 0
 }
 
-// CurrentFeeds is a structure that holds a list of currently supported feeds, and its last update time and block.
-type CurrentFeeds struct {
+// ValidatorFeedReport is a structure that reports the price submission of a validator for a signal id in the
+// current feeds.
+type ValidatorFeedReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// feeds is a list of currently supported feeds.
-	Feeds []*Feed `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds,omitempty"`
-	// last_update_timestamp is the timestamp of the last time supported feeds list is updated.
-	LastUpdateTimestamp int64 `protobuf:"varint,2,opt,name=last_update_timestamp,json=lastUpdateTimestamp,proto3" json:"last_update_timestamp,omitempty"`
-	// last_update_block is the number of blocks of the last time supported feeds list is updated.
-	LastUpdateBlock int64 `protobuf:"varint,3,opt,name=last_update_block,json=lastUpdateBlock,proto3" json:"last_update_block,omitempty"`
-}
-
-func (x *CurrentFeeds) Reset() {
-	*x = CurrentFeeds{}
+	// signal_id is the signal id of the current feed.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// validator_price is the latest price submitted by the validator. Its status is unspecified if the validator has
+	// not submitted a price since the signal id was added to the current feeds.
+	ValidatorPrice *ValidatorPrice `protobuf:"bytes,2,opt,name=validator_price,json=validatorPrice,proto3" json:"validator_price,omitempty"`
+	// aggregated_price is the first aggregated price at or after the submission of the validator price within the
+	// retained price history, or the current price if no such price is retained.
+	AggregatedPrice *Price `protobuf:"bytes,3,opt,name=aggregated_price,json=aggregatedPrice,proto3" json:"aggregated_price,omitempty"`
+	// deviation_basis_point is the deviation (in basis point) of the validator price from the aggregated price. It is
+	// zero unless both prices are available.
+	DeviationBasisPoint uint64 `protobuf:"varint,4,opt,name=deviation_basis_point,json=deviationBasisPoint,proto3" json:"deviation_basis_point,omitempty"`
+	// counted_in_median is a flag indicating whether the validator price was used in the median of the aggregated
+	// price.
+	CountedInMedian bool `protobuf:"varint,5,opt,name=counted_in_median,json=countedInMedian,proto3" json:"counted_in_median,omitempty"`
+	// report_deadline_timestamp is the timestamp after which the validator misses the report if it has not submitted a
+	// new price.
+	ReportDeadlineTimestamp int64 `protobuf:"varint,6,opt,name=report_deadline_timestamp,json=reportDeadlineTimestamp,proto3" json:"report_deadline_timestamp,omitempty"`
+	// report_deadline_block_height is the block height after which the validator misses the report if it has not
+	// submitted a new price.
+	ReportDeadlineBlockHeight int64 `protobuf:"varint,7,opt,name=report_deadline_block_height,json=reportDeadlineBlockHeight,proto3" json:"report_deadline_block_height,omitempty"`
+	// time_until_miss_report is the number of seconds remaining until the report deadline timestamp, or zero if the
+	// deadline has passed.
+	TimeUntilMissReport int64 `protobuf:"varint,8,opt,name=time_until_miss_report,json=timeUntilMissReport,proto3" json:"time_until_miss_report,omitempty"`
+	// cooldown_end_timestamp is the timestamp before which a new price of the validator is rejected.
+	CooldownEndTimestamp int64 `protobuf:"varint,9,opt,name=cooldown_end_timestamp,json=cooldownEndTimestamp,proto3" json:"cooldown_end_timestamp,omitempty"`
+	// in_cooldown is a flag indicating whether a new price of the validator is currently rejected.
+	InCooldown bool `protobuf:"varint,10,opt,name=in_cooldown,json=inCooldown,proto3" json:"in_cooldown,omitempty"`
+}
+
+func (x *ValidatorFeedReport) Reset() {
+	*x = ValidatorFeedReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[4]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CurrentFeeds) String() string {
+func (x *ValidatorFeedReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrentFeeds) ProtoMessage() {}
-
-// Deprecated: Use CurrentFeeds.ProtoReflect.Descriptor instead.
-func (*CurrentFeeds) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{4}
-}
+func (*ValidatorFeedReport) ProtoMessage() {}
 
-func (x *CurrentFeeds) GetFeeds() []*Feed {
-	if x != nil {
-		return x.Feeds
-	}
-	return nil
+// Deprecated: Use ValidatorFeedReport.ProtoReflect.Descriptor instead.
+func (*ValidatorFeedReport) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CurrentFeeds) GetLastUpdateTimestamp() int64 {
+func (x *ValidatorFeedReport) GetSignalId() string {
 	if x != nil {
-		return x.LastUpdateTimestamp
+		return x.SignalId
 	}
-	return 0
+	return ""
 }
 
-func (x *CurrentFeeds) GetLastUpdateBlock() int64 {
+func (x *ValidatorFeedReport) GetValidatorPrice() *ValidatorPrice {
 	if x != nil {
-		return x.LastUpdateBlock
-	}
-	return 0
-}
-
-// CurrentFeedWithDeviations is a structure that holds a list of currently supported feed-with-deviations, and its
-// last update time and block.
-type CurrentFeedWithDeviations struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// feeds is a list of currently supported feed-with-deviations.
-	Feeds []*FeedWithDeviation `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds,omitempty"`
-	// last_update_timestamp is the timestamp of the last time supported feeds list is updated.
-	LastUpdateTimestamp int64 `protobuf:"varint,2,opt,name=last_update_timestamp,json=lastUpdateTimestamp,proto3" json:"last_update_timestamp,omitempty"`
-	// last_update_block is the number of blocks of the last time supported feeds list is updated.
-	LastUpdateBlock int64 `protobuf:"varint,3,opt,name=last_update_block,json=lastUpdateBlock,proto3" json:"last_update_block,omitempty"`
-}
-
-func (x *CurrentFeedWithDeviations) Reset() {
-	*x = CurrentFeedWithDeviations{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		return x.ValidatorPrice
 	}
+	return nil
 }
 
-func (x *CurrentFeedWithDeviations) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CurrentFeedWithDeviations) ProtoMessage() {}
-
-// Deprecated: Use CurrentFeedWithDeviations.ProtoReflect.Descriptor instead.
-func (*CurrentFeedWithDeviations) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *CurrentFeedWithDeviations) GetFeeds() []*FeedWithDeviation {
+func (x *ValidatorFeedReport) GetAggregatedPrice() *Price {
 	if x != nil {
-		return x.Feeds
+		return x.AggregatedPrice
 	}
 	return nil
 }
 
-func (x *CurrentFeedWithDeviations) GetLastUpdateTimestamp() int64 {
+func (x *ValidatorFeedReport) GetDeviationBasisPoint() uint64 {
 	if x != nil {
-		return x.LastUpdateTimestamp
+		return x.DeviationBasisPoint
 	}
 	return 0
 }
 
-func (x *CurrentFeedWithDeviations) GetLastUpdateBlock() int64 {
+func (x *ValidatorFeedReport) GetCountedInMedian() bool {
 	if x != nil {
-		return x.LastUpdateBlock
+		return x.CountedInMedian
 	}
-	return 0
-}
-
-// Price is a structure that defines the price of a signal id.
-type Price struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// status is the status of a the price.
-	Status PriceStatus `protobuf:"varint,1,opt,name=status,proto3,enum=band.feeds.v1beta1.PriceStatus" json:"status,omitempty"`
-	// signal_id is the signal id of the price.
-	SignalId string `protobuf:"bytes,2,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
-	// price is the price of the signal id.
-	Price uint64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
-	// timestamp is the timestamp at which the price was aggregated.
-	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	return false
 }
 
-func (x *Price) Reset() {
-	*x = Price{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ValidatorFeedReport) GetReportDeadlineTimestamp() int64 {
+	if x != nil {
+		return x.ReportDeadlineTimestamp
 	}
+	return 0
 }
 
-func (x *Price) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Price) ProtoMessage() {}
-
-// Deprecated: Use Price.ProtoReflect.Descriptor instead.
-func (*Price) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *Price) GetStatus() PriceStatus {
+func (x *ValidatorFeedReport) GetReportDeadlineBlockHeight() int64 {
 	if x != nil {
-		return x.Status
+		return x.ReportDeadlineBlockHeight
 	}
-	return PriceStatus_PRICE_STATUS_UNSPECIFIED
+	return 0
 }
 
-func (x *Price) GetSignalId() string {
+func (x *ValidatorFeedReport) GetTimeUntilMissReport() int64 {
 	if x != nil {
-		return x.SignalId
+		return x.TimeUntilMissReport
 	}
-	return ""
+	return 0
 }
 
-func (x *Price) GetPrice() uint64 {
+func (x *ValidatorFeedReport) GetCooldownEndTimestamp() int64 {
 	if x != nil {
-		return x.Price
+		return x.CooldownEndTimestamp
 	}
 	return 0
 }
 
-func (x *Price) GetTimestamp() int64 {
+func (x *ValidatorFeedReport) GetInCooldown() bool {
 	if x != nil {
-		return x.Timestamp
+		return x.InCooldown
 	}
-	return 0
+	return false
 }
 
-// SignalPrice is a structure that defines the signaled price of a signal id.
-type SignalPrice struct {
+// ReferenceSourceConfig is a structure that defines the information of reference price source.
+type ReferenceSourceConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// status is the status of the signal price.
-	Status SignalPriceStatus `protobuf:"varint,1,opt,name=status,proto3,enum=band.feeds.v1beta1.SignalPriceStatus" json:"status,omitempty"`
-	// signal_id is the signal id of the price.
-	SignalId string `protobuf:"bytes,2,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
-	// price is the price submitted by the validator.
-	Price uint64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	// registry_ipfs_hash is the hash of the reference registry.
+	RegistryIpfsHash string `protobuf:"bytes,1,opt,name=registry_ipfs_hash,json=registryIpfsHash,proto3" json:"registry_ipfs_hash,omitempty"`
+	// registry_version is the version of the reference registry.
+	RegistryVersion string `protobuf:"bytes,2,opt,name=registry_version,json=registryVersion,proto3" json:"registry_version,omitempty"`
 }
 
-func (x *SignalPrice) Reset() {
-	*x = SignalPrice{}
+func (x *ReferenceSourceConfig) Reset() {
+	*x = ReferenceSourceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[7]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SignalPrice) String() string {
+func (x *ReferenceSourceConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignalPrice) ProtoMessage() {}
-
-// Deprecated: Use SignalPrice.ProtoReflect.Descriptor instead.
-func (*SignalPrice) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{7}
-}
+func (*ReferenceSourceConfig) ProtoMessage() {}
 
-func (x *SignalPrice) GetStatus() SignalPriceStatus {
-	if x != nil {
-		return x.Status
-	}
-	return SignalPriceStatus_SIGNAL_PRICE_STATUS_UNSPECIFIED
+// Deprecated: Use ReferenceSourceConfig.ProtoReflect.Descriptor instead.
+func (*ReferenceSourceConfig) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *SignalPrice) GetSignalId() string {
+func (x *ReferenceSourceConfig) GetRegistryIpfsHash() string {
 	if x != nil {
-		return x.SignalId
+		return x.RegistryIpfsHash
 	}
 	return ""
 }
 
-func (x *SignalPrice) GetPrice() uint64 {
+func (x *ReferenceSourceConfig) GetRegistryVersion() string {
 	if x != nil {
-		return x.Price
+		return x.RegistryVersion
 	}
-	return 0
+	return ""
 }
 
-// ValidatorPrice is a structure that defines the price submitted by a validator for a signal id.
-type ValidatorPrice struct {
+// SignalInfo is a structure that defines the registered metadata of a signal id.
+type SignalInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// signal_price_status is the status of a signal price submitted.
-	SignalPriceStatus SignalPriceStatus `protobuf:"varint,1,opt,name=signal_price_status,json=signalPriceStatus,proto3,enum=band.feeds.v1beta1.SignalPriceStatus" json:"signal_price_status,omitempty"`
-	// signal_id is the signal id of the price.
-	SignalId string `protobuf:"bytes,2,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
-	// price is the price submitted by the validator.
-	Price uint64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
-	// timestamp is the timestamp at which the price was submitted.
-	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// block_height is the block height at which the price was submitted.
-	BlockHeight int64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-}
-
-func (x *ValidatorPrice) Reset() {
-	*x = ValidatorPrice{}
+	// signal_id is the signal id of the metadata.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// decimals is the number of decimals used to display the price of the signal id. It is display-only metadata, as
+	// prices are always stored with a fixed precision of 9 decimals (i.e. 1000000000 is 1.0) regardless of it.
+	Decimals uint32 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// quote_currency is the currency in which the price of the signal id is quoted.
+	QuoteCurrency string `protobuf:"bytes,3,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
+	// asset_class is the asset class of the signal id.
+	AssetClass AssetClass `protobuf:"varint,4,opt,name=asset_class,json=assetClass,proto3,enum=band.feeds.v1beta1.AssetClass" json:"asset_class,omitempty"`
+	// description is the description of the signal id.
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// source is the description of the source of the price of the signal id.
+	Source string `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	// deprecated is the flag to show if the signal id is deprecated.
+	Deprecated bool `protobuf:"varint,7,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+}
+
+func (x *SignalInfo) Reset() {
+	*x = SignalInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[8]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidatorPrice) String() string {
+func (x *SignalInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorPrice) ProtoMessage() {}
+func (*SignalInfo) ProtoMessage() {}
 
-// Deprecated: Use ValidatorPrice.ProtoReflect.Descriptor instead.
-func (*ValidatorPrice) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SignalInfo.ProtoReflect.Descriptor instead.
+func (*SignalInfo) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ValidatorPrice) GetSignalPriceStatus() SignalPriceStatus {
+func (x *SignalInfo) GetSignalId() string {
 	if x != nil {
-		return x.SignalPriceStatus
+		return x.SignalId
 	}
-	return SignalPriceStatus_SIGNAL_PRICE_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *ValidatorPrice) GetSignalId() string {
+func (x *SignalInfo) GetDecimals() uint32 {
 	if x != nil {
-		return x.SignalId
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *SignalInfo) GetQuoteCurrency() string {
+	if x != nil {
+		return x.QuoteCurrency
 	}
 	return ""
 }
 
-func (x *ValidatorPrice) GetPrice() uint64 {
+func (x *SignalInfo) GetAssetClass() AssetClass {
 	if x != nil {
-		return x.Price
+		return x.AssetClass
 	}
-	return 0
+	return AssetClass_ASSET_CLASS_UNSPECIFIED
 }
 
-func (x *ValidatorPrice) GetTimestamp() int64 {
+func (x *SignalInfo) GetDescription() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *ValidatorPrice) GetBlockHeight() int64 {
+func (x *SignalInfo) GetSource() string {
 	if x != nil {
-		return x.BlockHeight
+		return x.Source
 	}
-	return 0
+	return ""
 }
 
-// ValidatorPriceList is a structure that holds a list of validator prices of
-// a validator and its address.
-type ValidatorPriceList struct {
+func (x *SignalInfo) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+// PriceGuard is a structure that defines the guard rails of the price of a signal id. A calculated price that
+// breaches any of the limits is halted. A zero limit is disabled.
+type PriceGuard struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// validator is the validator address.
-	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
-	// validators_prices is a list of validator prices.
-	ValidatorPrices []*ValidatorPrice `protobuf:"bytes,2,rep,name=validator_prices,json=validatorPrices,proto3" json:"validator_prices,omitempty"`
-}
-
-func (x *ValidatorPriceList) Reset() {
-	*x = ValidatorPriceList{}
+	// signal_id is the signal id of the price guard.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// max_change_basis_point is the maximum change of the price from the last accepted price in basis point.
+	MaxChangeBasisPoint uint64 `protobuf:"varint,2,opt,name=max_change_basis_point,json=maxChangeBasisPoint,proto3" json:"max_change_basis_point,omitempty"`
+	// min_price is the minimum sane price of the signal id.
+	MinPrice uint64 `protobuf:"varint,3,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	// max_price is the maximum sane price of the signal id.
+	MaxPrice uint64 `protobuf:"varint,4,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	// max_staleness is the maximum age (in seconds) of the latest validator price used to calculate the price.
+	MaxStaleness int64 `protobuf:"varint,5,opt,name=max_staleness,json=maxStaleness,proto3" json:"max_staleness,omitempty"`
+}
+
+func (x *PriceGuard) Reset() {
+	*x = PriceGuard{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[9]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidatorPriceList) String() string {
+func (x *PriceGuard) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorPriceList) ProtoMessage() {}
+func (*PriceGuard) ProtoMessage() {}
 
-// Deprecated: Use ValidatorPriceList.ProtoReflect.Descriptor instead.
-func (*ValidatorPriceList) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use PriceGuard.ProtoReflect.Descriptor instead.
+func (*PriceGuard) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ValidatorPriceList) GetValidator() string {
+func (x *PriceGuard) GetSignalId() string {
 	if x != nil {
-		return x.Validator
+		return x.SignalId
 	}
 	return ""
 }
 
-func (x *ValidatorPriceList) GetValidatorPrices() []*ValidatorPrice {
+func (x *PriceGuard) GetMaxChangeBasisPoint() uint64 {
 	if x != nil {
-		return x.ValidatorPrices
+		return x.MaxChangeBasisPoint
 	}
-	return nil
-}
-
-// ReferenceSourceConfig is a structure that defines the information of reference price source.
-type ReferenceSourceConfig struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// registry_ipfs_hash is the hash of the reference registry.
-	RegistryIpfsHash string `protobuf:"bytes,1,opt,name=registry_ipfs_hash,json=registryIpfsHash,proto3" json:"registry_ipfs_hash,omitempty"`
-	// registry_version is the version of the reference registry.
-	RegistryVersion string `protobuf:"bytes,2,opt,name=registry_version,json=registryVersion,proto3" json:"registry_version,omitempty"`
+	return 0
 }
 
-func (x *ReferenceSourceConfig) Reset() {
-	*x = ReferenceSourceConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PriceGuard) GetMinPrice() uint64 {
+	if x != nil {
+		return x.MinPrice
 	}
+	return 0
 }
 
-func (x *ReferenceSourceConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ReferenceSourceConfig) ProtoMessage() {}
-
-// Deprecated: Use ReferenceSourceConfig.ProtoReflect.Descriptor instead.
-func (*ReferenceSourceConfig) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *ReferenceSourceConfig) GetRegistryIpfsHash() string {
+func (x *PriceGuard) GetMaxPrice() uint64 {
 	if x != nil {
-		return x.RegistryIpfsHash
+		return x.MaxPrice
 	}
-	return ""
+	return 0
 }
 
-func (x *ReferenceSourceConfig) GetRegistryVersion() string {
+func (x *PriceGuard) GetMaxStaleness() int64 {
 	if x != nil {
-		return x.RegistryVersion
+		return x.MaxStaleness
 	}
-	return ""
+	return 0
 }
 
 // FeedsSignatureOrder defines a general signature order for feed data.
@@ -7315,7 +12467,7 @@ type FeedsSignatureOrder struct {
 func (x *FeedsSignatureOrder) Reset() {
 	*x = FeedsSignatureOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[11]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7329,7 +12481,7 @@ func (*FeedsSignatureOrder) ProtoMessage() {}
 
 // Deprecated: Use FeedsSignatureOrder.ProtoReflect.Descriptor instead.
 func (*FeedsSignatureOrder) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{11}
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *FeedsSignatureOrder) GetSignalIds() []string {
@@ -7369,122 +12521,241 @@ var file_band_feeds_v1beta1_feeds_proto_rawDesc = []byte{
 	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
 	0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
 	0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0x69, 0x0a, 0x04, 0x46, 0x65, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
-	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xaa, 0x01, 0x0a, 0x11, 0x46,
-	0x65, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x9e, 0x01, 0x0a, 0x14, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x32, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x75, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
+	0x22, 0x67, 0x0a, 0x15, 0x43, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x75, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x69, 0x0a, 0x04, 0x46, 0x65, 0x65,
+	0x64, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x3a, 0x04,
+	0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xaa, 0x01, 0x0a, 0x11, 0x46, 0x65, 0x65, 0x64, 0x57, 0x69, 0x74,
+	0x68, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
+	0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x76, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
+	0x01, 0x22, 0xaa, 0x01, 0x0a, 0x0c, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65,
+	0x64, 0x73, 0x12, 0x34, 0x0a, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x11,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc4,
+	0x01, 0x0a, 0x19, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x57, 0x69,
+	0x74, 0x68, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x41, 0x0a, 0x05,
+	0x66, 0x65, 0x65, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x46, 0x65, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x12,
+	0x32, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13,
+	0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a,
+	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb9, 0x02, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f,
+	0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x71, 0x75, 0x61, 0x72, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x71, 0x75, 0x61, 0x72, 0x74,
+	0x69, 0x6c, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
+	0x01, 0x22, 0x93, 0x01, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49,
 	0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70,
-	0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65,
-	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x32, 0x0a,
-	0x15, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73,
-	0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x65,
-	0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xaa, 0x01, 0x0a, 0x0c, 0x43, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x05, 0x66, 0x65, 0x65, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66,
-	0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x65, 0x65,
-	0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x12, 0x32,
-	0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c,
-	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c,
-	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x04,
-	0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc4, 0x01, 0x0a, 0x19, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x46, 0x65, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x41, 0x0a, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x44,
-	0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05,
-	0x66, 0x65, 0x65, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa5, 0x01, 0x0a, 0x05,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65,
-	0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52,
-	0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3a, 0x04, 0xe8,
-	0xa0, 0x1f, 0x01, 0x22, 0x93, 0x01, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xef, 0x01, 0x0a, 0x0e, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x13,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb0, 0x01, 0x0a, 0x12,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x53, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x8c,
-	0x01, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x42, 0x0a, 0x12, 0x72, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x70, 0x66, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x79, 0x49, 0x50, 0x46, 0x53, 0x48, 0x61, 0x73, 0x68, 0x52, 0x10, 0x72, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x72, 0x79, 0x49, 0x70, 0x66, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x10,
-	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x80, 0x01,
-	0x0a, 0x13, 0x46, 0x65, 0x65, 0x64, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x73, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
-	0x49, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64,
-	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00,
-	0x2a, 0xb4, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22,
-	0x0a, 0x1e, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x49, 0x44,
-	0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x02, 0x12, 0x1a,
-	0x0a, 0x16, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41,
-	0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x52,
-	0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49,
-	0x4e, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x45, 0x45, 0x44, 0x53, 0x10,
-	0x04, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xab, 0x01, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xef, 0x01, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x13, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66,
+	0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x11,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb0, 0x01, 0x0a, 0x12, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x53, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x89, 0x01, 0x0a,
+	0x16, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xe7, 0x01, 0x0a, 0x11, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x3f,
+	0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x27, 0x0a, 0x0f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x6c,
+	0x69, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a,
+	0x1b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x18, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x22, 0xce, 0x04, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
+	0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x51, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x10, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x13, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61,
+	0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x4d, 0x65,
+	0x64, 0x69, 0x61, 0x6e, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x3f, 0x0a, 0x1c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x33, 0x0a, 0x16, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f,
+	0x6d, 0x69, 0x73, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x13, 0x74, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x4d, 0x69, 0x73, 0x73,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f,
+	0x77, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e,
+	0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x0b,
+	0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x69, 0x6e, 0x43, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x3a, 0x04, 0xe8,
+	0xa0, 0x1f, 0x01, 0x22, 0x8c, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x42, 0x0a,
+	0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x70, 0x66, 0x73, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x49, 0x50, 0x46, 0x53, 0x48, 0x61, 0x73, 0x68, 0x52,
+	0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x49, 0x70, 0x66, 0x73, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x22, 0x9b, 0x02, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x3f, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
+	0x22, 0xd1, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x12,
+	0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44,
+	0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x61,
+	0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x3a, 0x04,
+	0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x80, 0x01, 0x0a, 0x13, 0x46, 0x65, 0x65, 0x64, 0x73, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x0a,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x73, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x72, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x2a, 0xcd, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x49, 0x43, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x49,
+	0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x49,
+	0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45,
+	0x41, 0x44, 0x59, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10,
+	0x03, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54,
+	0x5f, 0x46, 0x45, 0x45, 0x44, 0x53, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x49, 0x43,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x48, 0x41, 0x4c, 0x54, 0x45, 0x44, 0x10,
+	0x05, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xab, 0x01, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e,
 	0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a,
 	0x1f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54,
 	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
@@ -7495,21 +12766,30 @@ var file_band_feeds_v1beta1_feeds_proto_rawDesc = []byte{
 	0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d,
 	0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41,
 	0x54, 0x55, 0x53, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x1a,
-	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xd4, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x42, 0x0a, 0x46, 0x65, 0x65, 0x64, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x64, 0x73, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x46, 0x58, 0xaa, 0x02, 0x12, 0x42, 0x61, 0x6e,
-	0x64, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca,
-	0x02, 0x12, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64,
-	0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x46, 0x65,
-	0x65, 0x64, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x91, 0x01, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x65, 0x74, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4c,
+	0x41, 0x53, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53,
+	0x5f, 0x43, 0x52, 0x59, 0x50, 0x54, 0x4f, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x53, 0x53,
+	0x45, 0x54, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x45, 0x58, 0x10, 0x02,
+	0x12, 0x19, 0x0a, 0x15, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f,
+	0x43, 0x4f, 0x4d, 0x4d, 0x4f, 0x44, 0x49, 0x54, 0x59, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x41,
+	0x53, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x45, 0x51, 0x55, 0x49, 0x54,
+	0x59, 0x10, 0x04, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xd4, 0x01, 0x0a, 0x16, 0x63, 0x6f,
+	0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x42, 0x0a, 0x46, 0x65, 0x65, 0x64, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
+	0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66, 0x65,
+	0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x64,
+	0x73, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x46, 0x58, 0xaa, 0x02,
+	0x12, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0xca, 0x02, 0x12, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73,
+	0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1e, 0x42, 0x61, 0x6e, 0x64, 0x5c,
+	0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x42, 0x61, 0x6e, 0x64,
+	0x3a, 0x3a, 0x46, 0x65, 0x65, 0x64, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7524,39 +12804,50 @@ func file_band_feeds_v1beta1_feeds_proto_rawDescGZIP() []byte {
 	return file_band_feeds_v1beta1_feeds_proto_rawDescData
 }
 
-var file_band_feeds_v1beta1_feeds_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_band_feeds_v1beta1_feeds_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_band_feeds_v1beta1_feeds_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_band_feeds_v1beta1_feeds_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_band_feeds_v1beta1_feeds_proto_goTypes = []interface{}{
 	(PriceStatus)(0),                  // 0: band.feeds.v1beta1.PriceStatus
 	(SignalPriceStatus)(0),            // 1: band.feeds.v1beta1.SignalPriceStatus
-	(*Signal)(nil),                    // 2: band.feeds.v1beta1.Signal
-	(*Vote)(nil),                      // 3: band.feeds.v1beta1.Vote
-	(*Feed)(nil),                      // 4: band.feeds.v1beta1.Feed
-	(*FeedWithDeviation)(nil),         // 5: band.feeds.v1beta1.FeedWithDeviation
-	(*CurrentFeeds)(nil),              // 6: band.feeds.v1beta1.CurrentFeeds
-	(*CurrentFeedWithDeviations)(nil), // 7: band.feeds.v1beta1.CurrentFeedWithDeviations
-	(*Price)(nil),                     // 8: band.feeds.v1beta1.Price
-	(*SignalPrice)(nil),               // 9: band.feeds.v1beta1.SignalPrice
-	(*ValidatorPrice)(nil),            // 10: band.feeds.v1beta1.ValidatorPrice
-	(*ValidatorPriceList)(nil),        // 11: band.feeds.v1beta1.ValidatorPriceList
-	(*ReferenceSourceConfig)(nil),     // 12: band.feeds.v1beta1.ReferenceSourceConfig
-	(*FeedsSignatureOrder)(nil),       // 13: band.feeds.v1beta1.FeedsSignatureOrder
-	(Encoder)(0),                      // 14: band.feeds.v1beta1.Encoder
+	(AssetClass)(0),                   // 2: band.feeds.v1beta1.AssetClass
+	(*Signal)(nil),                    // 3: band.feeds.v1beta1.Signal
+	(*Vote)(nil),                      // 4: band.feeds.v1beta1.Vote
+	(*SignalVoteDelegation)(nil),      // 5: band.feeds.v1beta1.SignalVoteDelegation
+	(*CuratorDelegatedPower)(nil),     // 6: band.feeds.v1beta1.CuratorDelegatedPower
+	(*Feed)(nil),                      // 7: band.feeds.v1beta1.Feed
+	(*FeedWithDeviation)(nil),         // 8: band.feeds.v1beta1.FeedWithDeviation
+	(*CurrentFeeds)(nil),              // 9: band.feeds.v1beta1.CurrentFeeds
+	(*CurrentFeedWithDeviations)(nil), // 10: band.feeds.v1beta1.CurrentFeedWithDeviations
+	(*Price)(nil),                     // 11: band.feeds.v1beta1.Price
+	(*SignalPrice)(nil),               // 12: band.feeds.v1beta1.SignalPrice
+	(*ValidatorPrice)(nil),            // 13: band.feeds.v1beta1.ValidatorPrice
+	(*ValidatorPriceList)(nil),        // 14: band.feeds.v1beta1.ValidatorPriceList
+	(*SignalPricesCommitment)(nil),    // 15: band.feeds.v1beta1.SignalPricesCommitment
+	(*ValidatorAccuracy)(nil),         // 16: band.feeds.v1beta1.ValidatorAccuracy
+	(*ValidatorFeedReport)(nil),       // 17: band.feeds.v1beta1.ValidatorFeedReport
+	(*ReferenceSourceConfig)(nil),     // 18: band.feeds.v1beta1.ReferenceSourceConfig
+	(*SignalInfo)(nil),                // 19: band.feeds.v1beta1.SignalInfo
+	(*PriceGuard)(nil),                // 20: band.feeds.v1beta1.PriceGuard
+	(*FeedsSignatureOrder)(nil),       // 21: band.feeds.v1beta1.FeedsSignatureOrder
+	(Encoder)(0),                      // 22: band.feeds.v1beta1.Encoder
 }
 var file_band_feeds_v1beta1_feeds_proto_depIdxs = []int32{
-	2,  // 0: band.feeds.v1beta1.Vote.signals:type_name -> band.feeds.v1beta1.Signal
-	4,  // 1: band.feeds.v1beta1.CurrentFeeds.feeds:type_name -> band.feeds.v1beta1.Feed
-	5,  // 2: band.feeds.v1beta1.CurrentFeedWithDeviations.feeds:type_name -> band.feeds.v1beta1.FeedWithDeviation
+	3,  // 0: band.feeds.v1beta1.Vote.signals:type_name -> band.feeds.v1beta1.Signal
+	7,  // 1: band.feeds.v1beta1.CurrentFeeds.feeds:type_name -> band.feeds.v1beta1.Feed
+	8,  // 2: band.feeds.v1beta1.CurrentFeedWithDeviations.feeds:type_name -> band.feeds.v1beta1.FeedWithDeviation
 	0,  // 3: band.feeds.v1beta1.Price.status:type_name -> band.feeds.v1beta1.PriceStatus
 	1,  // 4: band.feeds.v1beta1.SignalPrice.status:type_name -> band.feeds.v1beta1.SignalPriceStatus
 	1,  // 5: band.feeds.v1beta1.ValidatorPrice.signal_price_status:type_name -> band.feeds.v1beta1.SignalPriceStatus
-	10, // 6: band.feeds.v1beta1.ValidatorPriceList.validator_prices:type_name -> band.feeds.v1beta1.ValidatorPrice
-	14, // 7: band.feeds.v1beta1.FeedsSignatureOrder.encoder:type_name -> band.feeds.v1beta1.Encoder
-	8,  // [8:8] is the sub-list for method output_type
-	8,  // [8:8] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	13, // 6: band.feeds.v1beta1.ValidatorPriceList.validator_prices:type_name -> band.feeds.v1beta1.ValidatorPrice
+	13, // 7: band.feeds.v1beta1.ValidatorFeedReport.validator_price:type_name -> band.feeds.v1beta1.ValidatorPrice
+	11, // 8: band.feeds.v1beta1.ValidatorFeedReport.aggregated_price:type_name -> band.feeds.v1beta1.Price
+	2,  // 9: band.feeds.v1beta1.SignalInfo.asset_class:type_name -> band.feeds.v1beta1.AssetClass
+	22, // 10: band.feeds.v1beta1.FeedsSignatureOrder.encoder:type_name -> band.feeds.v1beta1.Encoder
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_band_feeds_v1beta1_feeds_proto_init() }
@@ -7591,7 +12882,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Feed); i {
+			switch v := v.(*SignalVoteDelegation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7603,7 +12894,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeedWithDeviation); i {
+			switch v := v.(*CuratorDelegatedPower); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7615,7 +12906,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CurrentFeeds); i {
+			switch v := v.(*Feed); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7627,7 +12918,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CurrentFeedWithDeviations); i {
+			switch v := v.(*FeedWithDeviation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7639,7 +12930,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Price); i {
+			switch v := v.(*CurrentFeeds); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7651,7 +12942,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignalPrice); i {
+			switch v := v.(*CurrentFeedWithDeviations); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7663,7 +12954,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorPrice); i {
+			switch v := v.(*Price); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7675,7 +12966,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorPriceList); i {
+			switch v := v.(*SignalPrice); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7687,7 +12978,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReferenceSourceConfig); i {
+			switch v := v.(*ValidatorPrice); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7699,6 +12990,90 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPriceList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_feeds_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignalPricesCommitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_feeds_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorAccuracy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_feeds_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorFeedReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_feeds_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReferenceSourceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_feeds_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignalInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_feeds_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceGuard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_feeds_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FeedsSignatureOrder); i {
 			case 0:
 				return &v.state
@@ -7716,8 +13091,8 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_feeds_v1beta1_feeds_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   12,
+			NumEnums:      3,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -2,6 +2,7 @@
 package feedsv1beta1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -14,6 +15,103 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_20_list)(nil)
+
+type _Params_20_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_20_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_20_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_20_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_20_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_20_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_20_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_20_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_20_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_21_list)(nil)
+
+type _Params_21_list struct {
+	list *[]string
+}
+
+func (x *_Params_21_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_21_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_21_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_21_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_21_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field QueryPricesAllowedChannels as it is not of Message kind"))
+}
+
+func (x *_Params_21_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_21_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_21_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                                  protoreflect.MessageDescriptor
 	fd_Params_admin                            protoreflect.FieldDescriptor
@@ -29,6 +127,16 @@ var (
 	fd_Params_current_feeds_update_interval    protoreflect.FieldDescriptor
 	fd_Params_price_quorum                     protoreflect.FieldDescriptor
 	fd_Params_max_signal_ids_per_signing       protoreflect.FieldDescriptor
+	fd_Params_price_history_retention          protoreflect.FieldDescriptor
+	fd_Params_outlier_threshold_basis_point    protoreflect.FieldDescriptor
+	fd_Params_accuracy_window                  protoreflect.FieldDescriptor
+	fd_Params_max_outlier_ratio_basis_point    protoreflect.FieldDescriptor
+	fd_Params_outlier_penalty                  protoreflect.FieldDescriptor
+	fd_Params_registered_signals_only          protoreflect.FieldDescriptor
+	fd_Params_query_prices_fee                 protoreflect.FieldDescriptor
+	fd_Params_query_prices_allowed_channels    protoreflect.FieldDescriptor
+	fd_Params_commit_window                    protoreflect.FieldDescriptor
+	fd_Params_reveal_window                    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -47,6 +155,16 @@ func init() {
 	fd_Params_current_feeds_update_interval = md_Params.Fields().ByName("current_feeds_update_interval")
 	fd_Params_price_quorum = md_Params.Fields().ByName("price_quorum")
 	fd_Params_max_signal_ids_per_signing = md_Params.Fields().ByName("max_signal_ids_per_signing")
+	fd_Params_price_history_retention = md_Params.Fields().ByName("price_history_retention")
+	fd_Params_outlier_threshold_basis_point = md_Params.Fields().ByName("outlier_threshold_basis_point")
+	fd_Params_accuracy_window = md_Params.Fields().ByName("accuracy_window")
+	fd_Params_max_outlier_ratio_basis_point = md_Params.Fields().ByName("max_outlier_ratio_basis_point")
+	fd_Params_outlier_penalty = md_Params.Fields().ByName("outlier_penalty")
+	fd_Params_registered_signals_only = md_Params.Fields().ByName("registered_signals_only")
+	fd_Params_query_prices_fee = md_Params.Fields().ByName("query_prices_fee")
+	fd_Params_query_prices_allowed_channels = md_Params.Fields().ByName("query_prices_allowed_channels")
+	fd_Params_commit_window = md_Params.Fields().ByName("commit_window")
+	fd_Params_reveal_window = md_Params.Fields().ByName("reveal_window")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -192,6 +310,66 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.PriceHistoryRetention != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PriceHistoryRetention)
+		if !f(fd_Params_price_history_retention, value) {
+			return
+		}
+	}
+	if x.OutlierThresholdBasisPoint != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OutlierThresholdBasisPoint)
+		if !f(fd_Params_outlier_threshold_basis_point, value) {
+			return
+		}
+	}
+	if x.AccuracyWindow != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AccuracyWindow)
+		if !f(fd_Params_accuracy_window, value) {
+			return
+		}
+	}
+	if x.MaxOutlierRatioBasisPoint != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxOutlierRatioBasisPoint)
+		if !f(fd_Params_max_outlier_ratio_basis_point, value) {
+			return
+		}
+	}
+	if x.OutlierPenalty != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.OutlierPenalty))
+		if !f(fd_Params_outlier_penalty, value) {
+			return
+		}
+	}
+	if x.RegisteredSignalsOnly != false {
+		value := protoreflect.ValueOfBool(x.RegisteredSignalsOnly)
+		if !f(fd_Params_registered_signals_only, value) {
+			return
+		}
+	}
+	if len(x.QueryPricesFee) != 0 {
+		value := protoreflect.ValueOfList(&_Params_20_list{list: &x.QueryPricesFee})
+		if !f(fd_Params_query_prices_fee, value) {
+			return
+		}
+	}
+	if len(x.QueryPricesAllowedChannels) != 0 {
+		value := protoreflect.ValueOfList(&_Params_21_list{list: &x.QueryPricesAllowedChannels})
+		if !f(fd_Params_query_prices_allowed_channels, value) {
+			return
+		}
+	}
+	if x.CommitWindow != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CommitWindow)
+		if !f(fd_Params_commit_window, value) {
+			return
+		}
+	}
+	if x.RevealWindow != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RevealWindow)
+		if !f(fd_Params_reveal_window, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -233,6 +411,26 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.PriceQuorum != ""
 	case "band.feeds.v1beta1.Params.max_signal_ids_per_signing":
 		return x.MaxSignalIdsPerSigning != uint64(0)
+	case "band.feeds.v1beta1.Params.price_history_retention":
+		return x.PriceHistoryRetention != uint64(0)
+	case "band.feeds.v1beta1.Params.outlier_threshold_basis_point":
+		return x.OutlierThresholdBasisPoint != uint64(0)
+	case "band.feeds.v1beta1.Params.accuracy_window":
+		return x.AccuracyWindow != int64(0)
+	case "band.feeds.v1beta1.Params.max_outlier_ratio_basis_point":
+		return x.MaxOutlierRatioBasisPoint != uint64(0)
+	case "band.feeds.v1beta1.Params.outlier_penalty":
+		return x.OutlierPenalty != 0
+	case "band.feeds.v1beta1.Params.registered_signals_only":
+		return x.RegisteredSignalsOnly != false
+	case "band.feeds.v1beta1.Params.query_prices_fee":
+		return len(x.QueryPricesFee) != 0
+	case "band.feeds.v1beta1.Params.query_prices_allowed_channels":
+		return len(x.QueryPricesAllowedChannels) != 0
+	case "band.feeds.v1beta1.Params.commit_window":
+		return x.CommitWindow != int64(0)
+	case "band.feeds.v1beta1.Params.reveal_window":
+		return x.RevealWindow != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Params"))
@@ -275,6 +473,26 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.PriceQuorum = ""
 	case "band.feeds.v1beta1.Params.max_signal_ids_per_signing":
 		x.MaxSignalIdsPerSigning = uint64(0)
+	case "band.feeds.v1beta1.Params.price_history_retention":
+		x.PriceHistoryRetention = uint64(0)
+	case "band.feeds.v1beta1.Params.outlier_threshold_basis_point":
+		x.OutlierThresholdBasisPoint = uint64(0)
+	case "band.feeds.v1beta1.Params.accuracy_window":
+		x.AccuracyWindow = int64(0)
+	case "band.feeds.v1beta1.Params.max_outlier_ratio_basis_point":
+		x.MaxOutlierRatioBasisPoint = uint64(0)
+	case "band.feeds.v1beta1.Params.outlier_penalty":
+		x.OutlierPenalty = 0
+	case "band.feeds.v1beta1.Params.registered_signals_only":
+		x.RegisteredSignalsOnly = false
+	case "band.feeds.v1beta1.Params.query_prices_fee":
+		x.QueryPricesFee = nil
+	case "band.feeds.v1beta1.Params.query_prices_allowed_channels":
+		x.QueryPricesAllowedChannels = nil
+	case "band.feeds.v1beta1.Params.commit_window":
+		x.CommitWindow = int64(0)
+	case "band.feeds.v1beta1.Params.reveal_window":
+		x.RevealWindow = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Params"))
@@ -330,6 +548,42 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "band.feeds.v1beta1.Params.max_signal_ids_per_signing":
 		value := x.MaxSignalIdsPerSigning
 		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.Params.price_history_retention":
+		value := x.PriceHistoryRetention
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.Params.outlier_threshold_basis_point":
+		value := x.OutlierThresholdBasisPoint
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.Params.accuracy_window":
+		value := x.AccuracyWindow
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.Params.max_outlier_ratio_basis_point":
+		value := x.MaxOutlierRatioBasisPoint
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.Params.outlier_penalty":
+		value := x.OutlierPenalty
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.feeds.v1beta1.Params.registered_signals_only":
+		value := x.RegisteredSignalsOnly
+		return protoreflect.ValueOfBool(value)
+	case "band.feeds.v1beta1.Params.query_prices_fee":
+		if len(x.QueryPricesFee) == 0 {
+			return protoreflect.ValueOfList(&_Params_20_list{})
+		}
+		listValue := &_Params_20_list{list: &x.QueryPricesFee}
+		return protoreflect.ValueOfList(listValue)
+	case "band.feeds.v1beta1.Params.query_prices_allowed_channels":
+		if len(x.QueryPricesAllowedChannels) == 0 {
+			return protoreflect.ValueOfList(&_Params_21_list{})
+		}
+		listValue := &_Params_21_list{list: &x.QueryPricesAllowedChannels}
+		return protoreflect.ValueOfList(listValue)
+	case "band.feeds.v1beta1.Params.commit_window":
+		value := x.CommitWindow
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.Params.reveal_window":
+		value := x.RevealWindow
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Params"))
@@ -376,6 +630,30 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.PriceQuorum = value.Interface().(string)
 	case "band.feeds.v1beta1.Params.max_signal_ids_per_signing":
 		x.MaxSignalIdsPerSigning = value.Uint()
+	case "band.feeds.v1beta1.Params.price_history_retention":
+		x.PriceHistoryRetention = value.Uint()
+	case "band.feeds.v1beta1.Params.outlier_threshold_basis_point":
+		x.OutlierThresholdBasisPoint = value.Uint()
+	case "band.feeds.v1beta1.Params.accuracy_window":
+		x.AccuracyWindow = value.Int()
+	case "band.feeds.v1beta1.Params.max_outlier_ratio_basis_point":
+		x.MaxOutlierRatioBasisPoint = value.Uint()
+	case "band.feeds.v1beta1.Params.outlier_penalty":
+		x.OutlierPenalty = (OutlierPenalty)(value.Enum())
+	case "band.feeds.v1beta1.Params.registered_signals_only":
+		x.RegisteredSignalsOnly = value.Bool()
+	case "band.feeds.v1beta1.Params.query_prices_fee":
+		lv := value.List()
+		clv := lv.(*_Params_20_list)
+		x.QueryPricesFee = *clv.list
+	case "band.feeds.v1beta1.Params.query_prices_allowed_channels":
+		lv := value.List()
+		clv := lv.(*_Params_21_list)
+		x.QueryPricesAllowedChannels = *clv.list
+	case "band.feeds.v1beta1.Params.commit_window":
+		x.CommitWindow = value.Int()
+	case "band.feeds.v1beta1.Params.reveal_window":
+		x.RevealWindow = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Params"))
@@ -396,6 +674,18 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.feeds.v1beta1.Params.query_prices_fee":
+		if x.QueryPricesFee == nil {
+			x.QueryPricesFee = []*v1beta1.Coin{}
+		}
+		value := &_Params_20_list{list: &x.QueryPricesFee}
+		return protoreflect.ValueOfList(value)
+	case "band.feeds.v1beta1.Params.query_prices_allowed_channels":
+		if x.QueryPricesAllowedChannels == nil {
+			x.QueryPricesAllowedChannels = []string{}
+		}
+		value := &_Params_21_list{list: &x.QueryPricesAllowedChannels}
+		return protoreflect.ValueOfList(value)
 	case "band.feeds.v1beta1.Params.admin":
 		panic(fmt.Errorf("field admin of message band.feeds.v1beta1.Params is not mutable"))
 	case "band.feeds.v1beta1.Params.allowable_block_time_discrepancy":
@@ -422,6 +712,22 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field price_quorum of message band.feeds.v1beta1.Params is not mutable"))
 	case "band.feeds.v1beta1.Params.max_signal_ids_per_signing":
 		panic(fmt.Errorf("field max_signal_ids_per_signing of message band.feeds.v1beta1.Params is not mutable"))
+	case "band.feeds.v1beta1.Params.price_history_retention":
+		panic(fmt.Errorf("field price_history_retention of message band.feeds.v1beta1.Params is not mutable"))
+	case "band.feeds.v1beta1.Params.outlier_threshold_basis_point":
+		panic(fmt.Errorf("field outlier_threshold_basis_point of message band.feeds.v1beta1.Params is not mutable"))
+	case "band.feeds.v1beta1.Params.accuracy_window":
+		panic(fmt.Errorf("field accuracy_window of message band.feeds.v1beta1.Params is not mutable"))
+	case "band.feeds.v1beta1.Params.max_outlier_ratio_basis_point":
+		panic(fmt.Errorf("field max_outlier_ratio_basis_point of message band.feeds.v1beta1.Params is not mutable"))
+	case "band.feeds.v1beta1.Params.outlier_penalty":
+		panic(fmt.Errorf("field outlier_penalty of message band.feeds.v1beta1.Params is not mutable"))
+	case "band.feeds.v1beta1.Params.registered_signals_only":
+		panic(fmt.Errorf("field registered_signals_only of message band.feeds.v1beta1.Params is not mutable"))
+	case "band.feeds.v1beta1.Params.commit_window":
+		panic(fmt.Errorf("field commit_window of message band.feeds.v1beta1.Params is not mutable"))
+	case "band.feeds.v1beta1.Params.reveal_window":
+		panic(fmt.Errorf("field reveal_window of message band.feeds.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Params"))
@@ -461,6 +767,28 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "band.feeds.v1beta1.Params.max_signal_ids_per_signing":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.Params.price_history_retention":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.Params.outlier_threshold_basis_point":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.Params.accuracy_window":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.Params.max_outlier_ratio_basis_point":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.Params.outlier_penalty":
+		return protoreflect.ValueOfEnum(0)
+	case "band.feeds.v1beta1.Params.registered_signals_only":
+		return protoreflect.ValueOfBool(false)
+	case "band.feeds.v1beta1.Params.query_prices_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_20_list{list: &list})
+	case "band.feeds.v1beta1.Params.query_prices_allowed_channels":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_21_list{list: &list})
+	case "band.feeds.v1beta1.Params.commit_window":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.Params.reveal_window":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Params"))
@@ -571,6 +899,42 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.MaxSignalIdsPerSigning != 0 {
 			n += 1 + runtime.Sov(uint64(x.MaxSignalIdsPerSigning))
 		}
+		if x.PriceHistoryRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.PriceHistoryRetention))
+		}
+		if x.OutlierThresholdBasisPoint != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutlierThresholdBasisPoint))
+		}
+		if x.AccuracyWindow != 0 {
+			n += 2 + runtime.Sov(uint64(x.AccuracyWindow))
+		}
+		if x.MaxOutlierRatioBasisPoint != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxOutlierRatioBasisPoint))
+		}
+		if x.OutlierPenalty != 0 {
+			n += 2 + runtime.Sov(uint64(x.OutlierPenalty))
+		}
+		if x.RegisteredSignalsOnly {
+			n += 3
+		}
+		if len(x.QueryPricesFee) > 0 {
+			for _, e := range x.QueryPricesFee {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.QueryPricesAllowedChannels) > 0 {
+			for _, s := range x.QueryPricesAllowedChannels {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CommitWindow != 0 {
+			n += 2 + runtime.Sov(uint64(x.CommitWindow))
+		}
+		if x.RevealWindow != 0 {
+			n += 2 + runtime.Sov(uint64(x.RevealWindow))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -600,6 +964,92 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.RevealWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RevealWindow))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb8
+		}
+		if x.CommitWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommitWindow))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb0
+		}
+		if len(x.QueryPricesAllowedChannels) > 0 {
+			for iNdEx := len(x.QueryPricesAllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.QueryPricesAllowedChannels[iNdEx])
+				copy(dAtA[i:], x.QueryPricesAllowedChannels[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.QueryPricesAllowedChannels[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xaa
+			}
+		}
+		if len(x.QueryPricesFee) > 0 {
+			for iNdEx := len(x.QueryPricesFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.QueryPricesFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xa2
+			}
+		}
+		if x.RegisteredSignalsOnly {
+			i--
+			if x.RegisteredSignalsOnly {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if x.OutlierPenalty != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutlierPenalty))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.MaxOutlierRatioBasisPoint != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxOutlierRatioBasisPoint))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.AccuracyWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccuracyWindow))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.OutlierThresholdBasisPoint != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutlierThresholdBasisPoint))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.PriceHistoryRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PriceHistoryRetention))
+			i--
+			dAtA[i] = 0x70
+		}
 		if x.MaxSignalIdsPerSigning != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSignalIdsPerSigning))
 			i--
@@ -991,6 +1441,225 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryRetention", wireType)
+				}
+				x.PriceHistoryRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PriceHistoryRetention |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutlierThresholdBasisPoint", wireType)
+				}
+				x.OutlierThresholdBasisPoint = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutlierThresholdBasisPoint |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccuracyWindow", wireType)
+				}
+				x.AccuracyWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AccuracyWindow |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxOutlierRatioBasisPoint", wireType)
+				}
+				x.MaxOutlierRatioBasisPoint = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxOutlierRatioBasisPoint |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutlierPenalty", wireType)
+				}
+				x.OutlierPenalty = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutlierPenalty |= OutlierPenalty(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RegisteredSignalsOnly", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RegisteredSignalsOnly = bool(v != 0)
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueryPricesFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.QueryPricesFee = append(x.QueryPricesFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.QueryPricesFee[len(x.QueryPricesFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueryPricesAllowedChannels", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.QueryPricesAllowedChannels = append(x.QueryPricesAllowedChannels, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 22:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitWindow", wireType)
+				}
+				x.CommitWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CommitWindow |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 23:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevealWindow", wireType)
+				}
+				x.RevealWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RevealWindow |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1039,6 +1708,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// OutlierPenalty is an enumerator that defines the penalty applied to validators submitting too many outliers.
+// Reducing the rewards of a validator is not supported, as the feeds module does not distribute rewards.
+type OutlierPenalty int32
+
+const (
+	// OUTLIER_PENALTY_NONE tracks the validator accuracy without any penalty.
+	OutlierPenalty_OUTLIER_PENALTY_NONE OutlierPenalty = 0
+	// OUTLIER_PENALTY_DEACTIVATE deactivates the validator through its oracle validator status.
+	OutlierPenalty_OUTLIER_PENALTY_DEACTIVATE OutlierPenalty = 1
+)
+
+// Enum value maps for OutlierPenalty.
+var (
+	OutlierPenalty_name = map[int32]string{
+		0: "OUTLIER_PENALTY_NONE",
+		1: "OUTLIER_PENALTY_DEACTIVATE",
+	}
+	OutlierPenalty_value = map[string]int32{
+		"OUTLIER_PENALTY_NONE":       0,
+		"OUTLIER_PENALTY_DEACTIVATE": 1,
+	}
+)
+
+func (x OutlierPenalty) Enum() *OutlierPenalty {
+	p := new(OutlierPenalty)
+	*p = x
+	return p
+}
+
+func (x OutlierPenalty) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OutlierPenalty) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_feeds_v1beta1_params_proto_enumTypes[0].Descriptor()
+}
+
+func (OutlierPenalty) Type() protoreflect.EnumType {
+	return &file_band_feeds_v1beta1_params_proto_enumTypes[0]
+}
+
+func (x OutlierPenalty) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OutlierPenalty.Descriptor instead.
+func (OutlierPenalty) EnumDescriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_params_proto_rawDescGZIP(), []int{0}
+}
+
 // Params is the data structure that keeps the parameters of the feeds module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -1074,6 +1793,33 @@ type Params struct {
 	PriceQuorum string `protobuf:"bytes,12,opt,name=price_quorum,json=priceQuorum,proto3" json:"price_quorum,omitempty"`
 	// max_signal_ids_per_signing is the maximum number of signals allowed in a single tss signing request.
 	MaxSignalIdsPerSigning uint64 `protobuf:"varint,13,opt,name=max_signal_ids_per_signing,json=maxSignalIdsPerSigning,proto3" json:"max_signal_ids_per_signing,omitempty"`
+	// price_history_retention is the maximum number of calculated prices kept in the price history of each signal.
+	// If it is zero, the price history is disabled.
+	PriceHistoryRetention uint64 `protobuf:"varint,14,opt,name=price_history_retention,json=priceHistoryRetention,proto3" json:"price_history_retention,omitempty"`
+	// outlier_threshold_basis_point is the deviation (in basis point) from the aggregated price above which a
+	// validator price is counted as an outlier. If it is zero, the validator accuracy is not tracked.
+	OutlierThresholdBasisPoint uint64 `protobuf:"varint,15,opt,name=outlier_threshold_basis_point,json=outlierThresholdBasisPoint,proto3" json:"outlier_threshold_basis_point,omitempty"`
+	// accuracy_window is the number of blocks over which the validator accuracy is accumulated before the penalty is
+	// evaluated and the accuracy is reset. The windows are fixed and do not overlap, i.e. a new window starts every
+	// accuracy_window blocks. If it is zero, the validator accuracy is not tracked.
+	AccuracyWindow int64 `protobuf:"varint,16,opt,name=accuracy_window,json=accuracyWindow,proto3" json:"accuracy_window,omitempty"`
+	// max_outlier_ratio_basis_point is the maximum ratio (in basis point) of outliers to evaluated prices of a
+	// validator within an accuracy window before the validator is penalized.
+	MaxOutlierRatioBasisPoint uint64 `protobuf:"varint,17,opt,name=max_outlier_ratio_basis_point,json=maxOutlierRatioBasisPoint,proto3" json:"max_outlier_ratio_basis_point,omitempty"`
+	// outlier_penalty is the penalty applied to validators exceeding the maximum outlier ratio.
+	OutlierPenalty OutlierPenalty `protobuf:"varint,18,opt,name=outlier_penalty,json=outlierPenalty,proto3,enum=band.feeds.v1beta1.OutlierPenalty" json:"outlier_penalty,omitempty"`
+	// registered_signals_only is the flag to restrict votes to registered signal ids that are not deprecated.
+	RegisteredSignalsOnly bool `protobuf:"varint,19,opt,name=registered_signals_only,json=registeredSignalsOnly,proto3" json:"registered_signals_only,omitempty"`
+	// query_prices_fee is the fee charged to the fee payer account of the channel for each price query packet received
+	// over IBC.
+	QueryPricesFee []*v1beta1.Coin `protobuf:"bytes,20,rep,name=query_prices_fee,json=queryPricesFee,proto3" json:"query_prices_fee,omitempty"`
+	// query_prices_allowed_channels is the list of IBC channels that are allowed to query prices.
+	QueryPricesAllowedChannels []string `protobuf:"bytes,21,rep,name=query_prices_allowed_channels,json=queryPricesAllowedChannels,proto3" json:"query_prices_allowed_channels,omitempty"`
+	// commit_window is the duration (in seconds) of the commit phase of a commit-reveal round. Signal prices must be
+	// committed and revealed instead of submitted directly if both commit and reveal windows are set.
+	CommitWindow int64 `protobuf:"varint,22,opt,name=commit_window,json=commitWindow,proto3" json:"commit_window,omitempty"`
+	// reveal_window is the duration (in seconds) of the reveal phase of a commit-reveal round.
+	RevealWindow int64 `protobuf:"varint,23,opt,name=reveal_window,json=revealWindow,proto3" json:"reveal_window,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1187,6 +1933,76 @@ func (x *Params) GetMaxSignalIdsPerSigning() uint64 {
 	return 0
 }
 
+func (x *Params) GetPriceHistoryRetention() uint64 {
+	if x != nil {
+		return x.PriceHistoryRetention
+	}
+	return 0
+}
+
+func (x *Params) GetOutlierThresholdBasisPoint() uint64 {
+	if x != nil {
+		return x.OutlierThresholdBasisPoint
+	}
+	return 0
+}
+
+func (x *Params) GetAccuracyWindow() int64 {
+	if x != nil {
+		return x.AccuracyWindow
+	}
+	return 0
+}
+
+func (x *Params) GetMaxOutlierRatioBasisPoint() uint64 {
+	if x != nil {
+		return x.MaxOutlierRatioBasisPoint
+	}
+	return 0
+}
+
+func (x *Params) GetOutlierPenalty() OutlierPenalty {
+	if x != nil {
+		return x.OutlierPenalty
+	}
+	return OutlierPenalty_OUTLIER_PENALTY_NONE
+}
+
+func (x *Params) GetRegisteredSignalsOnly() bool {
+	if x != nil {
+		return x.RegisteredSignalsOnly
+	}
+	return false
+}
+
+func (x *Params) GetQueryPricesFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.QueryPricesFee
+	}
+	return nil
+}
+
+func (x *Params) GetQueryPricesAllowedChannels() []string {
+	if x != nil {
+		return x.QueryPricesAllowedChannels
+	}
+	return nil
+}
+
+func (x *Params) GetCommitWindow() int64 {
+	if x != nil {
+		return x.CommitWindow
+	}
+	return 0
+}
+
+func (x *Params) GetRevealWindow() int64 {
+	if x != nil {
+		return x.RevealWindow
+	}
+	return 0
+}
+
 var File_band_feeds_v1beta1_params_proto protoreflect.FileDescriptor
 
 var file_band_feeds_v1beta1_params_proto_rawDesc = []byte{
@@ -1196,7 +2012,9 @@ var file_band_feeds_v1beta1_params_proto_rawDesc = []byte{
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62,
+	0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x96, 0x0a, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
 	0x73, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
 	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69,
@@ -1238,21 +2056,66 @@ var file_band_feeds_v1beta1_params_proto_rawDesc = []byte{
 	0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x16, 0x4d, 0x61, 0x78, 0x53,
 	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x73, 0x50, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x69,
 	0x6e, 0x67, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73,
-	0x50, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
-	0x42, 0xd5, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65,
-	0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x64, 0x73, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0xa2, 0x02, 0x03, 0x42, 0x46, 0x58, 0xaa, 0x02, 0x12, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x46, 0x65,
-	0x65, 0x64, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x12, 0x42, 0x61,
-	0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0xe2, 0x02, 0x1e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x14, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x64, 0x73, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x50, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x1d, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1a, 0x6f, 0x75, 0x74, 0x6c, 0x69,
+	0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x61, 0x73, 0x69, 0x73,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63,
+	0x79, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x40,
+	0x0a, 0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x6d, 0x61, 0x78, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65,
+	0x72, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x4b, 0x0a, 0x0f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4f,
+	0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x52, 0x0e, 0x6f,
+	0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x36, 0x0a,
+	0x17, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x75, 0x0a, 0x10, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00,
+	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x46, 0x65, 0x65, 0x12, 0x41, 0x0a, 0x1d,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x5f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x15, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x1a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x76,
+	0x65, 0x61, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x2a,
+	0x50, 0x0a, 0x0e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x55, 0x54, 0x4c, 0x49, 0x45, 0x52, 0x5f, 0x50, 0x45, 0x4e,
+	0x41, 0x4c, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x4f,
+	0x55, 0x54, 0x4c, 0x49, 0x45, 0x52, 0x5f, 0x50, 0x45, 0x4e, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x44,
+	0x45, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x01, 0x1a, 0x04, 0x88, 0xa3, 0x1e,
+	0x00, 0x42, 0xd5, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66,
+	0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0b, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x64, 0x73, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0xa2, 0x02, 0x03, 0x42, 0x46, 0x58, 0xaa, 0x02, 0x12, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x46,
+	0x65, 0x65, 0x64, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x12, 0x42,
+	0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0xe2, 0x02, 0x1e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x14, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x64, 0x73,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1267,16 +2130,21 @@ func file_band_feeds_v1beta1_params_proto_rawDescGZIP() []byte {
 	return file_band_feeds_v1beta1_params_proto_rawDescData
 }
 
+var file_band_feeds_v1beta1_params_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_band_feeds_v1beta1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_band_feeds_v1beta1_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: band.feeds.v1beta1.Params
+	(OutlierPenalty)(0),  // 0: band.feeds.v1beta1.OutlierPenalty
+	(*Params)(nil),       // 1: band.feeds.v1beta1.Params
+	(*v1beta1.Coin)(nil), // 2: cosmos.base.v1beta1.Coin
 }
 var file_band_feeds_v1beta1_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: band.feeds.v1beta1.Params.outlier_penalty:type_name -> band.feeds.v1beta1.OutlierPenalty
+	2, // 1: band.feeds.v1beta1.Params.query_prices_fee:type_name -> cosmos.base.v1beta1.Coin
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_band_feeds_v1beta1_params_proto_init() }
@@ -1303,13 +2171,14 @@ func file_band_feeds_v1beta1_params_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_feeds_v1beta1_params_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_band_feeds_v1beta1_params_proto_goTypes,
 		DependencyIndexes: file_band_feeds_v1beta1_params_proto_depIdxs,
+		EnumInfos:         file_band_feeds_v1beta1_params_proto_enumTypes,
 		MessageInfos:      file_band_feeds_v1beta1_params_proto_msgTypes,
 	}.Build()
 	File_band_feeds_v1beta1_params_proto = out.File
@@ -19,17 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_CurrentFeeds_FullMethodName          = "/band.feeds.v1beta1.Query/CurrentFeeds"
-	Query_IsFeeder_FullMethodName              = "/band.feeds.v1beta1.Query/IsFeeder"
-	Query_Params_FullMethodName                = "/band.feeds.v1beta1.Query/Params"
-	Query_Price_FullMethodName                 = "/band.feeds.v1beta1.Query/Price"
-	Query_Prices_FullMethodName                = "/band.feeds.v1beta1.Query/Prices"
-	Query_AllPrices_FullMethodName             = "/band.feeds.v1beta1.Query/AllPrices"
-	Query_ReferenceSourceConfig_FullMethodName = "/band.feeds.v1beta1.Query/ReferenceSourceConfig"
-	Query_SignalTotalPowers_FullMethodName     = "/band.feeds.v1beta1.Query/SignalTotalPowers"
-	Query_ValidValidator_FullMethodName        = "/band.feeds.v1beta1.Query/ValidValidator"
-	Query_ValidatorPrices_FullMethodName       = "/band.feeds.v1beta1.Query/ValidatorPrices"
-	Query_Vote_FullMethodName                  = "/band.feeds.v1beta1.Query/Vote"
+	Query_CurrentFeeds_FullMethodName                 = "/band.feeds.v1beta1.Query/CurrentFeeds"
+	Query_IsFeeder_FullMethodName                     = "/band.feeds.v1beta1.Query/IsFeeder"
+	Query_Params_FullMethodName                       = "/band.feeds.v1beta1.Query/Params"
+	Query_Price_FullMethodName                        = "/band.feeds.v1beta1.Query/Price"
+	Query_Prices_FullMethodName                       = "/band.feeds.v1beta1.Query/Prices"
+	Query_AllPrices_FullMethodName                    = "/band.feeds.v1beta1.Query/AllPrices"
+	Query_PriceHistory_FullMethodName                 = "/band.feeds.v1beta1.Query/PriceHistory"
+	Query_TWAP_FullMethodName                         = "/band.feeds.v1beta1.Query/TWAP"
+	Query_ReferenceSourceConfig_FullMethodName        = "/band.feeds.v1beta1.Query/ReferenceSourceConfig"
+	Query_SignalInfo_FullMethodName                   = "/band.feeds.v1beta1.Query/SignalInfo"
+	Query_SignalInfos_FullMethodName                  = "/band.feeds.v1beta1.Query/SignalInfos"
+	Query_PriceGuard_FullMethodName                   = "/band.feeds.v1beta1.Query/PriceGuard"
+	Query_PriceGuards_FullMethodName                  = "/band.feeds.v1beta1.Query/PriceGuards"
+	Query_SignalTotalPowers_FullMethodName            = "/band.feeds.v1beta1.Query/SignalTotalPowers"
+	Query_ValidValidator_FullMethodName               = "/band.feeds.v1beta1.Query/ValidValidator"
+	Query_ValidatorAccuracy_FullMethodName            = "/band.feeds.v1beta1.Query/ValidatorAccuracy"
+	Query_ValidatorFeedsReport_FullMethodName         = "/band.feeds.v1beta1.Query/ValidatorFeedsReport"
+	Query_ValidatorPrices_FullMethodName              = "/band.feeds.v1beta1.Query/ValidatorPrices"
+	Query_Vote_FullMethodName                         = "/band.feeds.v1beta1.Query/Vote"
+	Query_SignalVoteDelegation_FullMethodName         = "/band.feeds.v1beta1.Query/SignalVoteDelegation"
+	Query_CuratorSignalVoteDelegations_FullMethodName = "/band.feeds.v1beta1.Query/CuratorSignalVoteDelegations"
+	Query_CuratorDelegatedPower_FullMethodName        = "/band.feeds.v1beta1.Query/CuratorDelegatedPower"
 )
 
 // QueryClient is the client API for Query service.
@@ -48,17 +59,40 @@ type QueryClient interface {
 	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
 	// AllPrices is an RPC method that returns all prices.
 	AllPrices(ctx context.Context, in *QueryAllPricesRequest, opts ...grpc.CallOption) (*QueryAllPricesResponse, error)
+	// PriceHistory is an RPC method that returns the price history of a signal id within a time range.
+	PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error)
+	// TWAP is an RPC method that returns the time-weighted average prices of signal ids over a time window.
+	TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error)
 	// ReferenceSourceConfig is an RPC method that returns information on the reference price source.
 	ReferenceSourceConfig(ctx context.Context, in *QueryReferenceSourceConfigRequest, opts ...grpc.CallOption) (*QueryReferenceSourceConfigResponse, error)
+	// SignalInfo is an RPC method that returns the registered metadata of a signal id.
+	SignalInfo(ctx context.Context, in *QuerySignalInfoRequest, opts ...grpc.CallOption) (*QuerySignalInfoResponse, error)
+	// SignalInfos is an RPC method that returns the registered metadata of all signal ids.
+	SignalInfos(ctx context.Context, in *QuerySignalInfosRequest, opts ...grpc.CallOption) (*QuerySignalInfosResponse, error)
+	// PriceGuard is an RPC method that returns the price guard of a signal id.
+	PriceGuard(ctx context.Context, in *QueryPriceGuardRequest, opts ...grpc.CallOption) (*QueryPriceGuardResponse, error)
+	// PriceGuards is an RPC method that returns the price guards of all signal ids.
+	PriceGuards(ctx context.Context, in *QueryPriceGuardsRequest, opts ...grpc.CallOption) (*QueryPriceGuardsResponse, error)
 	// SignalTotalPowers is an RPC method that returns all signal-total-powers or specified signal-total-power by signal
 	// id.
 	SignalTotalPowers(ctx context.Context, in *QuerySignalTotalPowersRequest, opts ...grpc.CallOption) (*QuerySignalTotalPowersResponse, error)
 	// ValidValidator is an RPC method that returns a flag to show if the validator is required to send prices.
 	ValidValidator(ctx context.Context, in *QueryValidValidatorRequest, opts ...grpc.CallOption) (*QueryValidValidatorResponse, error)
+	// ValidatorAccuracy is an RPC method that returns the accuracy of prices submitted by a validator.
+	ValidatorAccuracy(ctx context.Context, in *QueryValidatorAccuracyRequest, opts ...grpc.CallOption) (*QueryValidatorAccuracyResponse, error)
+	// ValidatorFeedsReport is an RPC method that returns the price submission report of a validator for the current
+	// feeds.
+	ValidatorFeedsReport(ctx context.Context, in *QueryValidatorFeedsReportRequest, opts ...grpc.CallOption) (*QueryValidatorFeedsReportResponse, error)
 	// ValidatorPrices is an RPC method that returns prices of a validator.
 	ValidatorPrices(ctx context.Context, in *QueryValidatorPricesRequest, opts ...grpc.CallOption) (*QueryValidatorPricesResponse, error)
 	// Vote is an RPC method that returns signals of a voter.
 	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
+	// SignalVoteDelegation is an RPC method that returns the voting power delegation of a delegator.
+	SignalVoteDelegation(ctx context.Context, in *QuerySignalVoteDelegationRequest, opts ...grpc.CallOption) (*QuerySignalVoteDelegationResponse, error)
+	// CuratorSignalVoteDelegations is an RPC method that returns the voting power delegations to a curator.
+	CuratorSignalVoteDelegations(ctx context.Context, in *QueryCuratorSignalVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryCuratorSignalVoteDelegationsResponse, error)
+	// CuratorDelegatedPower is an RPC method that returns the total power delegated to a curator.
+	CuratorDelegatedPower(ctx context.Context, in *QueryCuratorDelegatedPowerRequest, opts ...grpc.CallOption) (*QueryCuratorDelegatedPowerResponse, error)
 }
 
 type queryClient struct {
@@ -123,6 +157,24 @@ func (c *queryClient) AllPrices(ctx context.Context, in *QueryAllPricesRequest,
 	return out, nil
 }
 
+func (c *queryClient) PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error) {
+	out := new(QueryPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_PriceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error) {
+	out := new(QueryTWAPResponse)
+	err := c.cc.Invoke(ctx, Query_TWAP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ReferenceSourceConfig(ctx context.Context, in *QueryReferenceSourceConfigRequest, opts ...grpc.CallOption) (*QueryReferenceSourceConfigResponse, error) {
 	out := new(QueryReferenceSourceConfigResponse)
 	err := c.cc.Invoke(ctx, Query_ReferenceSourceConfig_FullMethodName, in, out, opts...)
@@ -132,6 +184,42 @@ func (c *queryClient) ReferenceSourceConfig(ctx context.Context, in *QueryRefere
 	return out, nil
 }
 
+func (c *queryClient) SignalInfo(ctx context.Context, in *QuerySignalInfoRequest, opts ...grpc.CallOption) (*QuerySignalInfoResponse, error) {
+	out := new(QuerySignalInfoResponse)
+	err := c.cc.Invoke(ctx, Query_SignalInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SignalInfos(ctx context.Context, in *QuerySignalInfosRequest, opts ...grpc.CallOption) (*QuerySignalInfosResponse, error) {
+	out := new(QuerySignalInfosResponse)
+	err := c.cc.Invoke(ctx, Query_SignalInfos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceGuard(ctx context.Context, in *QueryPriceGuardRequest, opts ...grpc.CallOption) (*QueryPriceGuardResponse, error) {
+	out := new(QueryPriceGuardResponse)
+	err := c.cc.Invoke(ctx, Query_PriceGuard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceGuards(ctx context.Context, in *QueryPriceGuardsRequest, opts ...grpc.CallOption) (*QueryPriceGuardsResponse, error) {
+	out := new(QueryPriceGuardsResponse)
+	err := c.cc.Invoke(ctx, Query_PriceGuards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SignalTotalPowers(ctx context.Context, in *QuerySignalTotalPowersRequest, opts ...grpc.CallOption) (*QuerySignalTotalPowersResponse, error) {
 	out := new(QuerySignalTotalPowersResponse)
 	err := c.cc.Invoke(ctx, Query_SignalTotalPowers_FullMethodName, in, out, opts...)
@@ -150,6 +238,24 @@ func (c *queryClient) ValidValidator(ctx context.Context, in *QueryValidValidato
 	return out, nil
 }
 
+func (c *queryClient) ValidatorAccuracy(ctx context.Context, in *QueryValidatorAccuracyRequest, opts ...grpc.CallOption) (*QueryValidatorAccuracyResponse, error) {
+	out := new(QueryValidatorAccuracyResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorAccuracy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorFeedsReport(ctx context.Context, in *QueryValidatorFeedsReportRequest, opts ...grpc.CallOption) (*QueryValidatorFeedsReportResponse, error) {
+	out := new(QueryValidatorFeedsReportResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorFeedsReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ValidatorPrices(ctx context.Context, in *QueryValidatorPricesRequest, opts ...grpc.CallOption) (*QueryValidatorPricesResponse, error) {
 	out := new(QueryValidatorPricesResponse)
 	err := c.cc.Invoke(ctx, Query_ValidatorPrices_FullMethodName, in, out, opts...)
@@ -168,6 +274,33 @@ func (c *queryClient) Vote(ctx context.Context, in *QueryVoteRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) SignalVoteDelegation(ctx context.Context, in *QuerySignalVoteDelegationRequest, opts ...grpc.CallOption) (*QuerySignalVoteDelegationResponse, error) {
+	out := new(QuerySignalVoteDelegationResponse)
+	err := c.cc.Invoke(ctx, Query_SignalVoteDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CuratorSignalVoteDelegations(ctx context.Context, in *QueryCuratorSignalVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryCuratorSignalVoteDelegationsResponse, error) {
+	out := new(QueryCuratorSignalVoteDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_CuratorSignalVoteDelegations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CuratorDelegatedPower(ctx context.Context, in *QueryCuratorDelegatedPowerRequest, opts ...grpc.CallOption) (*QueryCuratorDelegatedPowerResponse, error) {
+	out := new(QueryCuratorDelegatedPowerResponse)
+	err := c.cc.Invoke(ctx, Query_CuratorDelegatedPower_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -184,17 +317,40 @@ type QueryServer interface {
 	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
 	// AllPrices is an RPC method that returns all prices.
 	AllPrices(context.Context, *QueryAllPricesRequest) (*QueryAllPricesResponse, error)
+	// PriceHistory is an RPC method that returns the price history of a signal id within a time range.
+	PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error)
+	// TWAP is an RPC method that returns the time-weighted average prices of signal ids over a time window.
+	TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error)
 	// ReferenceSourceConfig is an RPC method that returns information on the reference price source.
 	ReferenceSourceConfig(context.Context, *QueryReferenceSourceConfigRequest) (*QueryReferenceSourceConfigResponse, error)
+	// SignalInfo is an RPC method that returns the registered metadata of a signal id.
+	SignalInfo(context.Context, *QuerySignalInfoRequest) (*QuerySignalInfoResponse, error)
+	// SignalInfos is an RPC method that returns the registered metadata of all signal ids.
+	SignalInfos(context.Context, *QuerySignalInfosRequest) (*QuerySignalInfosResponse, error)
+	// PriceGuard is an RPC method that returns the price guard of a signal id.
+	PriceGuard(context.Context, *QueryPriceGuardRequest) (*QueryPriceGuardResponse, error)
+	// PriceGuards is an RPC method that returns the price guards of all signal ids.
+	PriceGuards(context.Context, *QueryPriceGuardsRequest) (*QueryPriceGuardsResponse, error)
 	// SignalTotalPowers is an RPC method that returns all signal-total-powers or specified signal-total-power by signal
 	// id.
 	SignalTotalPowers(context.Context, *QuerySignalTotalPowersRequest) (*QuerySignalTotalPowersResponse, error)
 	// ValidValidator is an RPC method that returns a flag to show if the validator is required to send prices.
 	ValidValidator(context.Context, *QueryValidValidatorRequest) (*QueryValidValidatorResponse, error)
+	// ValidatorAccuracy is an RPC method that returns the accuracy of prices submitted by a validator.
+	ValidatorAccuracy(context.Context, *QueryValidatorAccuracyRequest) (*QueryValidatorAccuracyResponse, error)
+	// ValidatorFeedsReport is an RPC method that returns the price submission report of a validator for the current
+	// feeds.
+	ValidatorFeedsReport(context.Context, *QueryValidatorFeedsReportRequest) (*QueryValidatorFeedsReportResponse, error)
 	// ValidatorPrices is an RPC method that returns prices of a validator.
 	ValidatorPrices(context.Context, *QueryValidatorPricesRequest) (*QueryValidatorPricesResponse, error)
 	// Vote is an RPC method that returns signals of a voter.
 	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
+	// SignalVoteDelegation is an RPC method that returns the voting power delegation of a delegator.
+	SignalVoteDelegation(context.Context, *QuerySignalVoteDelegationRequest) (*QuerySignalVoteDelegationResponse, error)
+	// CuratorSignalVoteDelegations is an RPC method that returns the voting power delegations to a curator.
+	CuratorSignalVoteDelegations(context.Context, *QueryCuratorSignalVoteDelegationsRequest) (*QueryCuratorSignalVoteDelegationsResponse, error)
+	// CuratorDelegatedPower is an RPC method that returns the total power delegated to a curator.
+	CuratorDelegatedPower(context.Context, *QueryCuratorDelegatedPowerRequest) (*QueryCuratorDelegatedPowerResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -220,21 +376,54 @@ func (UnimplementedQueryServer) Prices(context.Context, *QueryPricesRequest) (*Q
 func (UnimplementedQueryServer) AllPrices(context.Context, *QueryAllPricesRequest) (*QueryAllPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllPrices not implemented")
 }
+func (UnimplementedQueryServer) PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceHistory not implemented")
+}
+func (UnimplementedQueryServer) TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TWAP not implemented")
+}
 func (UnimplementedQueryServer) ReferenceSourceConfig(context.Context, *QueryReferenceSourceConfigRequest) (*QueryReferenceSourceConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReferenceSourceConfig not implemented")
 }
+func (UnimplementedQueryServer) SignalInfo(context.Context, *QuerySignalInfoRequest) (*QuerySignalInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalInfo not implemented")
+}
+func (UnimplementedQueryServer) SignalInfos(context.Context, *QuerySignalInfosRequest) (*QuerySignalInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalInfos not implemented")
+}
+func (UnimplementedQueryServer) PriceGuard(context.Context, *QueryPriceGuardRequest) (*QueryPriceGuardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceGuard not implemented")
+}
+func (UnimplementedQueryServer) PriceGuards(context.Context, *QueryPriceGuardsRequest) (*QueryPriceGuardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceGuards not implemented")
+}
 func (UnimplementedQueryServer) SignalTotalPowers(context.Context, *QuerySignalTotalPowersRequest) (*QuerySignalTotalPowersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignalTotalPowers not implemented")
 }
 func (UnimplementedQueryServer) ValidValidator(context.Context, *QueryValidValidatorRequest) (*QueryValidValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidValidator not implemented")
 }
+func (UnimplementedQueryServer) ValidatorAccuracy(context.Context, *QueryValidatorAccuracyRequest) (*QueryValidatorAccuracyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorAccuracy not implemented")
+}
+func (UnimplementedQueryServer) ValidatorFeedsReport(context.Context, *QueryValidatorFeedsReportRequest) (*QueryValidatorFeedsReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorFeedsReport not implemented")
+}
 func (UnimplementedQueryServer) ValidatorPrices(context.Context, *QueryValidatorPricesRequest) (*QueryValidatorPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPrices not implemented")
 }
 func (UnimplementedQueryServer) Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
+func (UnimplementedQueryServer) SignalVoteDelegation(context.Context, *QuerySignalVoteDelegationRequest) (*QuerySignalVoteDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalVoteDelegation not implemented")
+}
+func (UnimplementedQueryServer) CuratorSignalVoteDelegations(context.Context, *QueryCuratorSignalVoteDelegationsRequest) (*QueryCuratorSignalVoteDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CuratorSignalVoteDelegations not implemented")
+}
+func (UnimplementedQueryServer) CuratorDelegatedPower(context.Context, *QueryCuratorDelegatedPowerRequest) (*QueryCuratorDelegatedPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CuratorDelegatedPower not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -356,6 +545,42 @@ func _Query_AllPrices_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceHistory(ctx, req.(*QueryPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TWAP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TWAP(ctx, req.(*QueryTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ReferenceSourceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryReferenceSourceConfigRequest)
 	if err := dec(in); err != nil {
@@ -374,6 +599,78 @@ func _Query_ReferenceSourceConfig_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SignalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignalInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignalInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SignalInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignalInfo(ctx, req.(*QuerySignalInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignalInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignalInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignalInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SignalInfos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignalInfos(ctx, req.(*QuerySignalInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceGuard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceGuardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceGuard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceGuard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceGuard(ctx, req.(*QueryPriceGuardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceGuards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceGuardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceGuards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceGuards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceGuards(ctx, req.(*QueryPriceGuardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SignalTotalPowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySignalTotalPowersRequest)
 	if err := dec(in); err != nil {
@@ -410,6 +707,42 @@ func _Query_ValidValidator_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorAccuracy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorAccuracyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorAccuracy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorAccuracy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorAccuracy(ctx, req.(*QueryValidatorAccuracyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorFeedsReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorFeedsReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorFeedsReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorFeedsReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorFeedsReport(ctx, req.(*QueryValidatorFeedsReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ValidatorPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryValidatorPricesRequest)
 	if err := dec(in); err != nil {
@@ -446,6 +779,60 @@ func _Query_Vote_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SignalVoteDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignalVoteDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignalVoteDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SignalVoteDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignalVoteDelegation(ctx, req.(*QuerySignalVoteDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CuratorSignalVoteDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCuratorSignalVoteDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CuratorSignalVoteDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CuratorSignalVoteDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CuratorSignalVoteDelegations(ctx, req.(*QueryCuratorSignalVoteDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CuratorDelegatedPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCuratorDelegatedPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CuratorDelegatedPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CuratorDelegatedPower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CuratorDelegatedPower(ctx, req.(*QueryCuratorDelegatedPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -477,10 +864,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllPrices",
 			Handler:    _Query_AllPrices_Handler,
 		},
+		{
+			MethodName: "PriceHistory",
+			Handler:    _Query_PriceHistory_Handler,
+		},
+		{
+			MethodName: "TWAP",
+			Handler:    _Query_TWAP_Handler,
+		},
 		{
 			MethodName: "ReferenceSourceConfig",
 			Handler:    _Query_ReferenceSourceConfig_Handler,
 		},
+		{
+			MethodName: "SignalInfo",
+			Handler:    _Query_SignalInfo_Handler,
+		},
+		{
+			MethodName: "SignalInfos",
+			Handler:    _Query_SignalInfos_Handler,
+		},
+		{
+			MethodName: "PriceGuard",
+			Handler:    _Query_PriceGuard_Handler,
+		},
+		{
+			MethodName: "PriceGuards",
+			Handler:    _Query_PriceGuards_Handler,
+		},
 		{
 			MethodName: "SignalTotalPowers",
 			Handler:    _Query_SignalTotalPowers_Handler,
@@ -489,6 +900,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidValidator",
 			Handler:    _Query_ValidValidator_Handler,
 		},
+		{
+			MethodName: "ValidatorAccuracy",
+			Handler:    _Query_ValidatorAccuracy_Handler,
+		},
+		{
+			MethodName: "ValidatorFeedsReport",
+			Handler:    _Query_ValidatorFeedsReport_Handler,
+		},
 		{
 			MethodName: "ValidatorPrices",
 			Handler:    _Query_ValidatorPrices_Handler,
@@ -497,6 +916,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Vote",
 			Handler:    _Query_Vote_Handler,
 		},
+		{
+			MethodName: "SignalVoteDelegation",
+			Handler:    _Query_SignalVoteDelegation_Handler,
+		},
+		{
+			MethodName: "CuratorSignalVoteDelegations",
+			Handler:    _Query_CuratorSignalVoteDelegations_Handler,
+		},
+		{
+			MethodName: "CuratorDelegatedPower",
+			Handler:    _Query_CuratorDelegatedPower_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/feeds/v1beta1/query.proto",
@@ -20,8 +20,13 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Msg_Vote_FullMethodName                        = "/band.feeds.v1beta1.Msg/Vote"
+	Msg_DelegateSignalVote_FullMethodName          = "/band.feeds.v1beta1.Msg/DelegateSignalVote"
 	Msg_SubmitSignalPrices_FullMethodName          = "/band.feeds.v1beta1.Msg/SubmitSignalPrices"
+	Msg_CommitSignalPrices_FullMethodName          = "/band.feeds.v1beta1.Msg/CommitSignalPrices"
+	Msg_RevealSignalPrices_FullMethodName          = "/band.feeds.v1beta1.Msg/RevealSignalPrices"
 	Msg_UpdateReferenceSourceConfig_FullMethodName = "/band.feeds.v1beta1.Msg/UpdateReferenceSourceConfig"
+	Msg_UpdateSignalInfos_FullMethodName           = "/band.feeds.v1beta1.Msg/UpdateSignalInfos"
+	Msg_UpdatePriceGuards_FullMethodName           = "/band.feeds.v1beta1.Msg/UpdatePriceGuards"
 	Msg_UpdateParams_FullMethodName                = "/band.feeds.v1beta1.Msg/UpdateParams"
 )
 
@@ -31,10 +36,20 @@ const (
 type MsgClient interface {
 	// Vote is an RPC method to vote signal ids and their powers.
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
+	// DelegateSignalVote is an RPC method to delegate feeds voting power to a curator.
+	DelegateSignalVote(ctx context.Context, in *MsgDelegateSignalVote, opts ...grpc.CallOption) (*MsgDelegateSignalVoteResponse, error)
 	// SubmitSignalPrices is an RPC method to submit signal prices.
 	SubmitSignalPrices(ctx context.Context, in *MsgSubmitSignalPrices, opts ...grpc.CallOption) (*MsgSubmitSignalPricesResponse, error)
+	// CommitSignalPrices is an RPC method to commit the hash of signal prices in the commit phase.
+	CommitSignalPrices(ctx context.Context, in *MsgCommitSignalPrices, opts ...grpc.CallOption) (*MsgCommitSignalPricesResponse, error)
+	// RevealSignalPrices is an RPC method to reveal committed signal prices in the reveal phase.
+	RevealSignalPrices(ctx context.Context, in *MsgRevealSignalPrices, opts ...grpc.CallOption) (*MsgRevealSignalPricesResponse, error)
 	// UpdateReferenceSourceConfig is an RPC method to update reference price source configuration.
 	UpdateReferenceSourceConfig(ctx context.Context, in *MsgUpdateReferenceSourceConfig, opts ...grpc.CallOption) (*MsgUpdateReferenceSourceConfigResponse, error)
+	// UpdateSignalInfos is an RPC method to register or update metadata of signal ids.
+	UpdateSignalInfos(ctx context.Context, in *MsgUpdateSignalInfos, opts ...grpc.CallOption) (*MsgUpdateSignalInfosResponse, error)
+	// UpdatePriceGuards is an RPC method to set or remove price guards of signal ids.
+	UpdatePriceGuards(ctx context.Context, in *MsgUpdatePriceGuards, opts ...grpc.CallOption) (*MsgUpdatePriceGuardsResponse, error)
 	// UpdateParams is an RPC method to update parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -56,6 +71,15 @@ func (c *msgClient) Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) DelegateSignalVote(ctx context.Context, in *MsgDelegateSignalVote, opts ...grpc.CallOption) (*MsgDelegateSignalVoteResponse, error) {
+	out := new(MsgDelegateSignalVoteResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateSignalVote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitSignalPrices(ctx context.Context, in *MsgSubmitSignalPrices, opts ...grpc.CallOption) (*MsgSubmitSignalPricesResponse, error) {
 	out := new(MsgSubmitSignalPricesResponse)
 	err := c.cc.Invoke(ctx, Msg_SubmitSignalPrices_FullMethodName, in, out, opts...)
@@ -65,6 +89,24 @@ func (c *msgClient) SubmitSignalPrices(ctx context.Context, in *MsgSubmitSignalP
 	return out, nil
 }
 
+func (c *msgClient) CommitSignalPrices(ctx context.Context, in *MsgCommitSignalPrices, opts ...grpc.CallOption) (*MsgCommitSignalPricesResponse, error) {
+	out := new(MsgCommitSignalPricesResponse)
+	err := c.cc.Invoke(ctx, Msg_CommitSignalPrices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealSignalPrices(ctx context.Context, in *MsgRevealSignalPrices, opts ...grpc.CallOption) (*MsgRevealSignalPricesResponse, error) {
+	out := new(MsgRevealSignalPricesResponse)
+	err := c.cc.Invoke(ctx, Msg_RevealSignalPrices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateReferenceSourceConfig(ctx context.Context, in *MsgUpdateReferenceSourceConfig, opts ...grpc.CallOption) (*MsgUpdateReferenceSourceConfigResponse, error) {
 	out := new(MsgUpdateReferenceSourceConfigResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateReferenceSourceConfig_FullMethodName, in, out, opts...)
@@ -74,6 +116,24 @@ func (c *msgClient) UpdateReferenceSourceConfig(ctx context.Context, in *MsgUpda
 	return out, nil
 }
 
+func (c *msgClient) UpdateSignalInfos(ctx context.Context, in *MsgUpdateSignalInfos, opts ...grpc.CallOption) (*MsgUpdateSignalInfosResponse, error) {
+	out := new(MsgUpdateSignalInfosResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateSignalInfos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePriceGuards(ctx context.Context, in *MsgUpdatePriceGuards, opts ...grpc.CallOption) (*MsgUpdatePriceGuardsResponse, error) {
+	out := new(MsgUpdatePriceGuardsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdatePriceGuards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -89,10 +149,20 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 type MsgServer interface {
 	// Vote is an RPC method to vote signal ids and their powers.
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
+	// DelegateSignalVote is an RPC method to delegate feeds voting power to a curator.
+	DelegateSignalVote(context.Context, *MsgDelegateSignalVote) (*MsgDelegateSignalVoteResponse, error)
 	// SubmitSignalPrices is an RPC method to submit signal prices.
 	SubmitSignalPrices(context.Context, *MsgSubmitSignalPrices) (*MsgSubmitSignalPricesResponse, error)
+	// CommitSignalPrices is an RPC method to commit the hash of signal prices in the commit phase.
+	CommitSignalPrices(context.Context, *MsgCommitSignalPrices) (*MsgCommitSignalPricesResponse, error)
+	// RevealSignalPrices is an RPC method to reveal committed signal prices in the reveal phase.
+	RevealSignalPrices(context.Context, *MsgRevealSignalPrices) (*MsgRevealSignalPricesResponse, error)
 	// UpdateReferenceSourceConfig is an RPC method to update reference price source configuration.
 	UpdateReferenceSourceConfig(context.Context, *MsgUpdateReferenceSourceConfig) (*MsgUpdateReferenceSourceConfigResponse, error)
+	// UpdateSignalInfos is an RPC method to register or update metadata of signal ids.
+	UpdateSignalInfos(context.Context, *MsgUpdateSignalInfos) (*MsgUpdateSignalInfosResponse, error)
+	// UpdatePriceGuards is an RPC method to set or remove price guards of signal ids.
+	UpdatePriceGuards(context.Context, *MsgUpdatePriceGuards) (*MsgUpdatePriceGuardsResponse, error)
 	// UpdateParams is an RPC method to update parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
@@ -105,12 +175,27 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) Vote(context.Context, *MsgVote) (*MsgVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
+func (UnimplementedMsgServer) DelegateSignalVote(context.Context, *MsgDelegateSignalVote) (*MsgDelegateSignalVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateSignalVote not implemented")
+}
 func (UnimplementedMsgServer) SubmitSignalPrices(context.Context, *MsgSubmitSignalPrices) (*MsgSubmitSignalPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignalPrices not implemented")
 }
+func (UnimplementedMsgServer) CommitSignalPrices(context.Context, *MsgCommitSignalPrices) (*MsgCommitSignalPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitSignalPrices not implemented")
+}
+func (UnimplementedMsgServer) RevealSignalPrices(context.Context, *MsgRevealSignalPrices) (*MsgRevealSignalPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealSignalPrices not implemented")
+}
 func (UnimplementedMsgServer) UpdateReferenceSourceConfig(context.Context, *MsgUpdateReferenceSourceConfig) (*MsgUpdateReferenceSourceConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateReferenceSourceConfig not implemented")
 }
+func (UnimplementedMsgServer) UpdateSignalInfos(context.Context, *MsgUpdateSignalInfos) (*MsgUpdateSignalInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSignalInfos not implemented")
+}
+func (UnimplementedMsgServer) UpdatePriceGuards(context.Context, *MsgUpdatePriceGuards) (*MsgUpdatePriceGuardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePriceGuards not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -145,6 +230,24 @@ func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateSignalVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateSignalVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateSignalVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateSignalVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateSignalVote(ctx, req.(*MsgDelegateSignalVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitSignalPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitSignalPrices)
 	if err := dec(in); err != nil {
@@ -163,6 +266,42 @@ func _Msg_SubmitSignalPrices_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CommitSignalPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitSignalPrices)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitSignalPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CommitSignalPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitSignalPrices(ctx, req.(*MsgCommitSignalPrices))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealSignalPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealSignalPrices)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealSignalPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevealSignalPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealSignalPrices(ctx, req.(*MsgRevealSignalPrices))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateReferenceSourceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateReferenceSourceConfig)
 	if err := dec(in); err != nil {
@@ -181,6 +320,42 @@ func _Msg_UpdateReferenceSourceConfig_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateSignalInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSignalInfos)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSignalInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateSignalInfos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSignalInfos(ctx, req.(*MsgUpdateSignalInfos))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePriceGuards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePriceGuards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePriceGuards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdatePriceGuards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePriceGuards(ctx, req.(*MsgUpdatePriceGuards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -210,14 +385,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Vote",
 			Handler:    _Msg_Vote_Handler,
 		},
+		{
+			MethodName: "DelegateSignalVote",
+			Handler:    _Msg_DelegateSignalVote_Handler,
+		},
 		{
 			MethodName: "SubmitSignalPrices",
 			Handler:    _Msg_SubmitSignalPrices_Handler,
 		},
+		{
+			MethodName: "CommitSignalPrices",
+			Handler:    _Msg_CommitSignalPrices_Handler,
+		},
+		{
+			MethodName: "RevealSignalPrices",
+			Handler:    _Msg_RevealSignalPrices_Handler,
+		},
 		{
 			MethodName: "UpdateReferenceSourceConfig",
 			Handler:    _Msg_UpdateReferenceSourceConfig_Handler,
 		},
+		{
+			MethodName: "UpdateSignalInfos",
+			Handler:    _Msg_UpdateSignalInfos_Handler,
+		},
+		{
+			MethodName: "UpdatePriceGuards",
+			Handler:    _Msg_UpdatePriceGuards_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -4,6 +4,7 @@ package oraclev1
 import (
 	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -74,6 +75,7 @@ var (
 	fd_DataSource_filename    protoreflect.FieldDescriptor
 	fd_DataSource_treasury    protoreflect.FieldDescriptor
 	fd_DataSource_fee         protoreflect.FieldDescriptor
+	fd_DataSource_version     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -85,6 +87,7 @@ func init() {
 	fd_DataSource_filename = md_DataSource.Fields().ByName("filename")
 	fd_DataSource_treasury = md_DataSource.Fields().ByName("treasury")
 	fd_DataSource_fee = md_DataSource.Fields().ByName("fee")
+	fd_DataSource_version = md_DataSource.Fields().ByName("version")
 }
 
 var _ protoreflect.Message = (*fastReflection_DataSource)(nil)
@@ -188,6 +191,12 @@ func (x *fastReflection_DataSource) Range(f func(protoreflect.FieldDescriptor, p
 			return
 		}
 	}
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_DataSource_version, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -215,6 +224,8 @@ func (x *fastReflection_DataSource) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Treasury != ""
 	case "band.oracle.v1.DataSource.fee":
 		return len(x.Fee) != 0
+	case "band.oracle.v1.DataSource.version":
+		return x.Version != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSource"))
@@ -243,6 +254,8 @@ func (x *fastReflection_DataSource) Clear(fd protoreflect.FieldDescriptor) {
 		x.Treasury = ""
 	case "band.oracle.v1.DataSource.fee":
 		x.Fee = nil
+	case "band.oracle.v1.DataSource.version":
+		x.Version = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSource"))
@@ -280,6 +293,9 @@ func (x *fastReflection_DataSource) Get(descriptor protoreflect.FieldDescriptor)
 		}
 		listValue := &_DataSource_6_list{list: &x.Fee}
 		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.DataSource.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSource"))
@@ -314,6 +330,8 @@ func (x *fastReflection_DataSource) Set(fd protoreflect.FieldDescriptor, value p
 		lv := value.List()
 		clv := lv.(*_DataSource_6_list)
 		x.Fee = *clv.list
+	case "band.oracle.v1.DataSource.version":
+		x.Version = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSource"))
@@ -350,6 +368,8 @@ func (x *fastReflection_DataSource) Mutable(fd protoreflect.FieldDescriptor) pro
 		panic(fmt.Errorf("field filename of message band.oracle.v1.DataSource is not mutable"))
 	case "band.oracle.v1.DataSource.treasury":
 		panic(fmt.Errorf("field treasury of message band.oracle.v1.DataSource is not mutable"))
+	case "band.oracle.v1.DataSource.version":
+		panic(fmt.Errorf("field version of message band.oracle.v1.DataSource is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSource"))
@@ -376,6 +396,8 @@ func (x *fastReflection_DataSource) NewField(fd protoreflect.FieldDescriptor) pr
 	case "band.oracle.v1.DataSource.fee":
 		list := []*v1beta1.Coin{}
 		return protoreflect.ValueOfList(&_DataSource_6_list{list: &list})
+	case "band.oracle.v1.DataSource.version":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSource"))
@@ -471,6 +493,9 @@ func (x *fastReflection_DataSource) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -500,6 +525,11 @@ func (x *fastReflection_DataSource) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x38
+		}
 		if len(x.Fee) > 0 {
 			for iNdEx := len(x.Fee) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Fee[iNdEx])
@@ -794,6 +824,25 @@ func (x *fastReflection_DataSource) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -837,6 +886,7 @@ var (
 	fd_OracleScript_filename        protoreflect.FieldDescriptor
 	fd_OracleScript_schema          protoreflect.FieldDescriptor
 	fd_OracleScript_source_code_url protoreflect.FieldDescriptor
+	fd_OracleScript_version         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -848,6 +898,7 @@ func init() {
 	fd_OracleScript_filename = md_OracleScript.Fields().ByName("filename")
 	fd_OracleScript_schema = md_OracleScript.Fields().ByName("schema")
 	fd_OracleScript_source_code_url = md_OracleScript.Fields().ByName("source_code_url")
+	fd_OracleScript_version = md_OracleScript.Fields().ByName("version")
 }
 
 var _ protoreflect.Message = (*fastReflection_OracleScript)(nil)
@@ -951,6 +1002,12 @@ func (x *fastReflection_OracleScript) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_OracleScript_version, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -978,6 +1035,8 @@ func (x *fastReflection_OracleScript) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Schema != ""
 	case "band.oracle.v1.OracleScript.source_code_url":
 		return x.SourceCodeUrl != ""
+	case "band.oracle.v1.OracleScript.version":
+		return x.Version != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
@@ -1006,6 +1065,8 @@ func (x *fastReflection_OracleScript) Clear(fd protoreflect.FieldDescriptor) {
 		x.Schema = ""
 	case "band.oracle.v1.OracleScript.source_code_url":
 		x.SourceCodeUrl = ""
+	case "band.oracle.v1.OracleScript.version":
+		x.Version = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
@@ -1040,6 +1101,9 @@ func (x *fastReflection_OracleScript) Get(descriptor protoreflect.FieldDescripto
 	case "band.oracle.v1.OracleScript.source_code_url":
 		value := x.SourceCodeUrl
 		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.OracleScript.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
@@ -1072,6 +1136,8 @@ func (x *fastReflection_OracleScript) Set(fd protoreflect.FieldDescriptor, value
 		x.Schema = value.Interface().(string)
 	case "band.oracle.v1.OracleScript.source_code_url":
 		x.SourceCodeUrl = value.Interface().(string)
+	case "band.oracle.v1.OracleScript.version":
+		x.Version = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
@@ -1104,6 +1170,8 @@ func (x *fastReflection_OracleScript) Mutable(fd protoreflect.FieldDescriptor) p
 		panic(fmt.Errorf("field schema of message band.oracle.v1.OracleScript is not mutable"))
 	case "band.oracle.v1.OracleScript.source_code_url":
 		panic(fmt.Errorf("field source_code_url of message band.oracle.v1.OracleScript is not mutable"))
+	case "band.oracle.v1.OracleScript.version":
+		panic(fmt.Errorf("field version of message band.oracle.v1.OracleScript is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
@@ -1129,6 +1197,8 @@ func (x *fastReflection_OracleScript) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfString("")
 	case "band.oracle.v1.OracleScript.source_code_url":
 		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.OracleScript.version":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
@@ -1222,6 +1292,9 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1251,6 +1324,11 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x38
+		}
 		if len(x.SourceCodeUrl) > 0 {
 			i -= len(x.SourceCodeUrl)
 			copy(dAtA[i:], x.SourceCodeUrl)
@@ -1534,6 +1612,25 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 				}
 				x.SourceCodeUrl = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1569,30 +1666,89 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_DataSourceVersion_5_list)(nil)
+
+type _DataSourceVersion_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_DataSourceVersion_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DataSourceVersion_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DataSourceVersion_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DataSourceVersion_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DataSourceVersion_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DataSourceVersion_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DataSourceVersion_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DataSourceVersion_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_RawRequest                protoreflect.MessageDescriptor
-	fd_RawRequest_external_id    protoreflect.FieldDescriptor
-	fd_RawRequest_data_source_id protoreflect.FieldDescriptor
-	fd_RawRequest_calldata       protoreflect.FieldDescriptor
+	md_DataSourceVersion                protoreflect.MessageDescriptor
+	fd_DataSourceVersion_data_source_id protoreflect.FieldDescriptor
+	fd_DataSourceVersion_version        protoreflect.FieldDescriptor
+	fd_DataSourceVersion_filename       protoreflect.FieldDescriptor
+	fd_DataSourceVersion_treasury       protoreflect.FieldDescriptor
+	fd_DataSourceVersion_fee            protoreflect.FieldDescriptor
+	fd_DataSourceVersion_height         protoreflect.FieldDescriptor
+	fd_DataSourceVersion_timestamp      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_RawRequest = File_band_oracle_v1_oracle_proto.Messages().ByName("RawRequest")
-	fd_RawRequest_external_id = md_RawRequest.Fields().ByName("external_id")
-	fd_RawRequest_data_source_id = md_RawRequest.Fields().ByName("data_source_id")
-	fd_RawRequest_calldata = md_RawRequest.Fields().ByName("calldata")
+	md_DataSourceVersion = File_band_oracle_v1_oracle_proto.Messages().ByName("DataSourceVersion")
+	fd_DataSourceVersion_data_source_id = md_DataSourceVersion.Fields().ByName("data_source_id")
+	fd_DataSourceVersion_version = md_DataSourceVersion.Fields().ByName("version")
+	fd_DataSourceVersion_filename = md_DataSourceVersion.Fields().ByName("filename")
+	fd_DataSourceVersion_treasury = md_DataSourceVersion.Fields().ByName("treasury")
+	fd_DataSourceVersion_fee = md_DataSourceVersion.Fields().ByName("fee")
+	fd_DataSourceVersion_height = md_DataSourceVersion.Fields().ByName("height")
+	fd_DataSourceVersion_timestamp = md_DataSourceVersion.Fields().ByName("timestamp")
 }
 
-var _ protoreflect.Message = (*fastReflection_RawRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_DataSourceVersion)(nil)
 
-type fastReflection_RawRequest RawRequest
+type fastReflection_DataSourceVersion DataSourceVersion
 
-func (x *RawRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RawRequest)(x)
+func (x *DataSourceVersion) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DataSourceVersion)(x)
 }
 
-func (x *RawRequest) slowProtoReflect() protoreflect.Message {
+func (x *DataSourceVersion) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1604,43 +1760,43 @@ func (x *RawRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RawRequest_messageType fastReflection_RawRequest_messageType
-var _ protoreflect.MessageType = fastReflection_RawRequest_messageType{}
+var _fastReflection_DataSourceVersion_messageType fastReflection_DataSourceVersion_messageType
+var _ protoreflect.MessageType = fastReflection_DataSourceVersion_messageType{}
 
-type fastReflection_RawRequest_messageType struct{}
+type fastReflection_DataSourceVersion_messageType struct{}
 
-func (x fastReflection_RawRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RawRequest)(nil)
+func (x fastReflection_DataSourceVersion_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DataSourceVersion)(nil)
 }
-func (x fastReflection_RawRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_RawRequest)
+func (x fastReflection_DataSourceVersion_messageType) New() protoreflect.Message {
+	return new(fastReflection_DataSourceVersion)
 }
-func (x fastReflection_RawRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RawRequest
+func (x fastReflection_DataSourceVersion_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DataSourceVersion
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RawRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_RawRequest
+func (x *fastReflection_DataSourceVersion) Descriptor() protoreflect.MessageDescriptor {
+	return md_DataSourceVersion
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RawRequest) Type() protoreflect.MessageType {
-	return _fastReflection_RawRequest_messageType
+func (x *fastReflection_DataSourceVersion) Type() protoreflect.MessageType {
+	return _fastReflection_DataSourceVersion_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RawRequest) New() protoreflect.Message {
-	return new(fastReflection_RawRequest)
+func (x *fastReflection_DataSourceVersion) New() protoreflect.Message {
+	return new(fastReflection_DataSourceVersion)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RawRequest) Interface() protoreflect.ProtoMessage {
-	return (*RawRequest)(x)
+func (x *fastReflection_DataSourceVersion) Interface() protoreflect.ProtoMessage {
+	return (*DataSourceVersion)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1648,22 +1804,46 @@ func (x *fastReflection_RawRequest) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RawRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ExternalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExternalId)
-		if !f(fd_RawRequest_external_id, value) {
+func (x *fastReflection_DataSourceVersion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_DataSourceVersion_data_source_id, value) {
 			return
 		}
 	}
-	if x.DataSourceId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.DataSourceId)
-		if !f(fd_RawRequest_data_source_id, value) {
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_DataSourceVersion_version, value) {
 			return
 		}
 	}
-	if len(x.Calldata) != 0 {
-		value := protoreflect.ValueOfBytes(x.Calldata)
-		if !f(fd_RawRequest_calldata, value) {
+	if x.Filename != "" {
+		value := protoreflect.ValueOfString(x.Filename)
+		if !f(fd_DataSourceVersion_filename, value) {
+			return
+		}
+	}
+	if x.Treasury != "" {
+		value := protoreflect.ValueOfString(x.Treasury)
+		if !f(fd_DataSourceVersion_treasury, value) {
+			return
+		}
+	}
+	if len(x.Fee) != 0 {
+		value := protoreflect.ValueOfList(&_DataSourceVersion_5_list{list: &x.Fee})
+		if !f(fd_DataSourceVersion_fee, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_DataSourceVersion_height, value) {
+			return
+		}
+	}
+	if x.Timestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Timestamp)
+		if !f(fd_DataSourceVersion_timestamp, value) {
 			return
 		}
 	}
@@ -1680,19 +1860,27 @@ func (x *fastReflection_RawRequest) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RawRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DataSourceVersion) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawRequest.external_id":
-		return x.ExternalId != uint64(0)
-	case "band.oracle.v1.RawRequest.data_source_id":
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
 		return x.DataSourceId != uint64(0)
-	case "band.oracle.v1.RawRequest.calldata":
-		return len(x.Calldata) != 0
+	case "band.oracle.v1.DataSourceVersion.version":
+		return x.Version != uint64(0)
+	case "band.oracle.v1.DataSourceVersion.filename":
+		return x.Filename != ""
+	case "band.oracle.v1.DataSourceVersion.treasury":
+		return x.Treasury != ""
+	case "band.oracle.v1.DataSourceVersion.fee":
+		return len(x.Fee) != 0
+	case "band.oracle.v1.DataSourceVersion.height":
+		return x.Height != int64(0)
+	case "band.oracle.v1.DataSourceVersion.timestamp":
+		return x.Timestamp != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1702,19 +1890,27 @@ func (x *fastReflection_RawRequest) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DataSourceVersion) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawRequest.external_id":
-		x.ExternalId = uint64(0)
-	case "band.oracle.v1.RawRequest.data_source_id":
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
 		x.DataSourceId = uint64(0)
-	case "band.oracle.v1.RawRequest.calldata":
-		x.Calldata = nil
+	case "band.oracle.v1.DataSourceVersion.version":
+		x.Version = uint64(0)
+	case "band.oracle.v1.DataSourceVersion.filename":
+		x.Filename = ""
+	case "band.oracle.v1.DataSourceVersion.treasury":
+		x.Treasury = ""
+	case "band.oracle.v1.DataSourceVersion.fee":
+		x.Fee = nil
+	case "band.oracle.v1.DataSourceVersion.height":
+		x.Height = int64(0)
+	case "band.oracle.v1.DataSourceVersion.timestamp":
+		x.Timestamp = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1724,22 +1920,37 @@ func (x *fastReflection_RawRequest) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RawRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DataSourceVersion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.RawRequest.external_id":
-		value := x.ExternalId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.RawRequest.data_source_id":
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
 		value := x.DataSourceId
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.RawRequest.calldata":
-		value := x.Calldata
-		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.DataSourceVersion.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.DataSourceVersion.filename":
+		value := x.Filename
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.DataSourceVersion.treasury":
+		value := x.Treasury
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.DataSourceVersion.fee":
+		if len(x.Fee) == 0 {
+			return protoreflect.ValueOfList(&_DataSourceVersion_5_list{})
+		}
+		listValue := &_DataSourceVersion_5_list{list: &x.Fee}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.DataSourceVersion.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.DataSourceVersion.timestamp":
+		value := x.Timestamp
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1753,19 +1964,29 @@ func (x *fastReflection_RawRequest) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DataSourceVersion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawRequest.external_id":
-		x.ExternalId = value.Uint()
-	case "band.oracle.v1.RawRequest.data_source_id":
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
 		x.DataSourceId = value.Uint()
-	case "band.oracle.v1.RawRequest.calldata":
-		x.Calldata = value.Bytes()
+	case "band.oracle.v1.DataSourceVersion.version":
+		x.Version = value.Uint()
+	case "band.oracle.v1.DataSourceVersion.filename":
+		x.Filename = value.Interface().(string)
+	case "band.oracle.v1.DataSourceVersion.treasury":
+		x.Treasury = value.Interface().(string)
+	case "band.oracle.v1.DataSourceVersion.fee":
+		lv := value.List()
+		clv := lv.(*_DataSourceVersion_5_list)
+		x.Fee = *clv.list
+	case "band.oracle.v1.DataSourceVersion.height":
+		x.Height = value.Int()
+	case "band.oracle.v1.DataSourceVersion.timestamp":
+		x.Timestamp = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1779,48 +2000,69 @@ func (x *fastReflection_RawRequest) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DataSourceVersion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawRequest.external_id":
-		panic(fmt.Errorf("field external_id of message band.oracle.v1.RawRequest is not mutable"))
-	case "band.oracle.v1.RawRequest.data_source_id":
-		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.RawRequest is not mutable"))
-	case "band.oracle.v1.RawRequest.calldata":
-		panic(fmt.Errorf("field calldata of message band.oracle.v1.RawRequest is not mutable"))
+	case "band.oracle.v1.DataSourceVersion.fee":
+		if x.Fee == nil {
+			x.Fee = []*v1beta1.Coin{}
+		}
+		value := &_DataSourceVersion_5_list{list: &x.Fee}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.DataSourceVersion is not mutable"))
+	case "band.oracle.v1.DataSourceVersion.version":
+		panic(fmt.Errorf("field version of message band.oracle.v1.DataSourceVersion is not mutable"))
+	case "band.oracle.v1.DataSourceVersion.filename":
+		panic(fmt.Errorf("field filename of message band.oracle.v1.DataSourceVersion is not mutable"))
+	case "band.oracle.v1.DataSourceVersion.treasury":
+		panic(fmt.Errorf("field treasury of message band.oracle.v1.DataSourceVersion is not mutable"))
+	case "band.oracle.v1.DataSourceVersion.height":
+		panic(fmt.Errorf("field height of message band.oracle.v1.DataSourceVersion is not mutable"))
+	case "band.oracle.v1.DataSourceVersion.timestamp":
+		panic(fmt.Errorf("field timestamp of message band.oracle.v1.DataSourceVersion is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RawRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DataSourceVersion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawRequest.external_id":
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.RawRequest.data_source_id":
+	case "band.oracle.v1.DataSourceVersion.version":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.RawRequest.calldata":
-		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.DataSourceVersion.filename":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.DataSourceVersion.treasury":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.DataSourceVersion.fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_DataSourceVersion_5_list{list: &list})
+	case "band.oracle.v1.DataSourceVersion.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.DataSourceVersion.timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RawRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DataSourceVersion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RawRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.DataSourceVersion", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1828,7 +2070,7 @@ func (x *fastReflection_RawRequest) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RawRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DataSourceVersion) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1839,7 +2081,7 @@ func (x *fastReflection_RawRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DataSourceVersion) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1851,7 +2093,7 @@ func (x *fastReflection_RawRequest) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RawRequest) IsValid() bool {
+func (x *fastReflection_DataSourceVersion) IsValid() bool {
 	return x != nil
 }
 
@@ -1861,9 +2103,9 @@ func (x *fastReflection_RawRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DataSourceVersion) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RawRequest)
+		x := input.Message.Interface().(*DataSourceVersion)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1875,16 +2117,32 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.ExternalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExternalId))
-		}
 		if x.DataSourceId != 0 {
 			n += 1 + runtime.Sov(uint64(x.DataSourceId))
 		}
-		l = len(x.Calldata)
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		l = len(x.Filename)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Treasury)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Fee) > 0 {
+			for _, e := range x.Fee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Timestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.Timestamp))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1895,7 +2153,7 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RawRequest)
+		x := input.Message.Interface().(*DataSourceVersion)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1914,20 +2172,53 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Calldata) > 0 {
-			i -= len(x.Calldata)
-			copy(dAtA[i:], x.Calldata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+		if x.Timestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timestamp))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x38
 		}
-		if x.DataSourceId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Fee) > 0 {
+			for iNdEx := len(x.Fee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Fee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Treasury) > 0 {
+			i -= len(x.Treasury)
+			copy(dAtA[i:], x.Treasury)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Treasury)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Filename) > 0 {
+			i -= len(x.Filename)
+			copy(dAtA[i:], x.Filename)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Filename)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
 			i--
 			dAtA[i] = 0x10
 		}
-		if x.ExternalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -1942,7 +2233,7 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RawRequest)
+		x := input.Message.Interface().(*DataSourceVersion)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1974,17 +2265,17 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DataSourceVersion: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DataSourceVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
 				}
-				x.ExternalId = 0
+				x.DataSourceId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1994,16 +2285,16 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ExternalId |= uint64(b&0x7F) << shift
+					x.DataSourceId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 				}
-				x.DataSourceId = 0
+				x.Version = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2013,16 +2304,16 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.DataSourceId |= uint64(b&0x7F) << shift
+					x.Version |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2032,26 +2323,128 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
-				if x.Calldata == nil {
-					x.Calldata = []byte{}
+				x.Filename = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Treasury = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fee = append(x.Fee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee[len(x.Fee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				}
+				x.Timestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Timestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2088,29 +2481,35 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_RawReport             protoreflect.MessageDescriptor
-	fd_RawReport_external_id protoreflect.FieldDescriptor
-	fd_RawReport_exit_code   protoreflect.FieldDescriptor
-	fd_RawReport_data        protoreflect.FieldDescriptor
+	md_OracleScriptVersion                  protoreflect.MessageDescriptor
+	fd_OracleScriptVersion_oracle_script_id protoreflect.FieldDescriptor
+	fd_OracleScriptVersion_version          protoreflect.FieldDescriptor
+	fd_OracleScriptVersion_filename         protoreflect.FieldDescriptor
+	fd_OracleScriptVersion_schema           protoreflect.FieldDescriptor
+	fd_OracleScriptVersion_height           protoreflect.FieldDescriptor
+	fd_OracleScriptVersion_timestamp        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_RawReport = File_band_oracle_v1_oracle_proto.Messages().ByName("RawReport")
-	fd_RawReport_external_id = md_RawReport.Fields().ByName("external_id")
-	fd_RawReport_exit_code = md_RawReport.Fields().ByName("exit_code")
-	fd_RawReport_data = md_RawReport.Fields().ByName("data")
+	md_OracleScriptVersion = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleScriptVersion")
+	fd_OracleScriptVersion_oracle_script_id = md_OracleScriptVersion.Fields().ByName("oracle_script_id")
+	fd_OracleScriptVersion_version = md_OracleScriptVersion.Fields().ByName("version")
+	fd_OracleScriptVersion_filename = md_OracleScriptVersion.Fields().ByName("filename")
+	fd_OracleScriptVersion_schema = md_OracleScriptVersion.Fields().ByName("schema")
+	fd_OracleScriptVersion_height = md_OracleScriptVersion.Fields().ByName("height")
+	fd_OracleScriptVersion_timestamp = md_OracleScriptVersion.Fields().ByName("timestamp")
 }
 
-var _ protoreflect.Message = (*fastReflection_RawReport)(nil)
+var _ protoreflect.Message = (*fastReflection_OracleScriptVersion)(nil)
 
-type fastReflection_RawReport RawReport
+type fastReflection_OracleScriptVersion OracleScriptVersion
 
-func (x *RawReport) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RawReport)(x)
+func (x *OracleScriptVersion) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OracleScriptVersion)(x)
 }
 
-func (x *RawReport) slowProtoReflect() protoreflect.Message {
+func (x *OracleScriptVersion) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2122,43 +2521,43 @@ func (x *RawReport) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RawReport_messageType fastReflection_RawReport_messageType
-var _ protoreflect.MessageType = fastReflection_RawReport_messageType{}
+var _fastReflection_OracleScriptVersion_messageType fastReflection_OracleScriptVersion_messageType
+var _ protoreflect.MessageType = fastReflection_OracleScriptVersion_messageType{}
 
-type fastReflection_RawReport_messageType struct{}
+type fastReflection_OracleScriptVersion_messageType struct{}
 
-func (x fastReflection_RawReport_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RawReport)(nil)
+func (x fastReflection_OracleScriptVersion_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OracleScriptVersion)(nil)
 }
-func (x fastReflection_RawReport_messageType) New() protoreflect.Message {
-	return new(fastReflection_RawReport)
+func (x fastReflection_OracleScriptVersion_messageType) New() protoreflect.Message {
+	return new(fastReflection_OracleScriptVersion)
 }
-func (x fastReflection_RawReport_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RawReport
+func (x fastReflection_OracleScriptVersion_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleScriptVersion
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RawReport) Descriptor() protoreflect.MessageDescriptor {
-	return md_RawReport
+func (x *fastReflection_OracleScriptVersion) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleScriptVersion
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RawReport) Type() protoreflect.MessageType {
-	return _fastReflection_RawReport_messageType
+func (x *fastReflection_OracleScriptVersion) Type() protoreflect.MessageType {
+	return _fastReflection_OracleScriptVersion_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RawReport) New() protoreflect.Message {
-	return new(fastReflection_RawReport)
+func (x *fastReflection_OracleScriptVersion) New() protoreflect.Message {
+	return new(fastReflection_OracleScriptVersion)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RawReport) Interface() protoreflect.ProtoMessage {
-	return (*RawReport)(x)
+func (x *fastReflection_OracleScriptVersion) Interface() protoreflect.ProtoMessage {
+	return (*OracleScriptVersion)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2166,22 +2565,40 @@ func (x *fastReflection_RawReport) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RawReport) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ExternalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExternalId)
-		if !f(fd_RawReport_external_id, value) {
+func (x *fastReflection_OracleScriptVersion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_OracleScriptVersion_oracle_script_id, value) {
 			return
 		}
 	}
-	if x.ExitCode != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.ExitCode)
-		if !f(fd_RawReport_exit_code, value) {
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_OracleScriptVersion_version, value) {
 			return
 		}
 	}
-	if len(x.Data) != 0 {
-		value := protoreflect.ValueOfBytes(x.Data)
-		if !f(fd_RawReport_data, value) {
+	if x.Filename != "" {
+		value := protoreflect.ValueOfString(x.Filename)
+		if !f(fd_OracleScriptVersion_filename, value) {
+			return
+		}
+	}
+	if x.Schema != "" {
+		value := protoreflect.ValueOfString(x.Schema)
+		if !f(fd_OracleScriptVersion_schema, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_OracleScriptVersion_height, value) {
+			return
+		}
+	}
+	if x.Timestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Timestamp)
+		if !f(fd_OracleScriptVersion_timestamp, value) {
 			return
 		}
 	}
@@ -2198,19 +2615,25 @@ func (x *fastReflection_RawReport) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RawReport) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_OracleScriptVersion) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawReport.external_id":
-		return x.ExternalId != uint64(0)
-	case "band.oracle.v1.RawReport.exit_code":
-		return x.ExitCode != uint32(0)
-	case "band.oracle.v1.RawReport.data":
-		return len(x.Data) != 0
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.OracleScriptVersion.version":
+		return x.Version != uint64(0)
+	case "band.oracle.v1.OracleScriptVersion.filename":
+		return x.Filename != ""
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		return x.Schema != ""
+	case "band.oracle.v1.OracleScriptVersion.height":
+		return x.Height != int64(0)
+	case "band.oracle.v1.OracleScriptVersion.timestamp":
+		return x.Timestamp != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2220,19 +2643,25 @@ func (x *fastReflection_RawReport) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawReport) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_OracleScriptVersion) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawReport.external_id":
-		x.ExternalId = uint64(0)
-	case "band.oracle.v1.RawReport.exit_code":
-		x.ExitCode = uint32(0)
-	case "band.oracle.v1.RawReport.data":
-		x.Data = nil
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.OracleScriptVersion.version":
+		x.Version = uint64(0)
+	case "band.oracle.v1.OracleScriptVersion.filename":
+		x.Filename = ""
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		x.Schema = ""
+	case "band.oracle.v1.OracleScriptVersion.height":
+		x.Height = int64(0)
+	case "band.oracle.v1.OracleScriptVersion.timestamp":
+		x.Timestamp = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2242,22 +2671,31 @@ func (x *fastReflection_RawReport) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RawReport) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleScriptVersion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.RawReport.external_id":
-		value := x.ExternalId
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		value := x.OracleScriptId
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.RawReport.exit_code":
-		value := x.ExitCode
-		return protoreflect.ValueOfUint32(value)
-	case "band.oracle.v1.RawReport.data":
-		value := x.Data
-		return protoreflect.ValueOfBytes(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
-		}
-		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", descriptor.FullName()))
+	case "band.oracle.v1.OracleScriptVersion.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleScriptVersion.filename":
+		value := x.Filename
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		value := x.Schema
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.OracleScriptVersion.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.OracleScriptVersion.timestamp":
+		value := x.Timestamp
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2271,19 +2709,25 @@ func (x *fastReflection_RawReport) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawReport) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_OracleScriptVersion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawReport.external_id":
-		x.ExternalId = value.Uint()
-	case "band.oracle.v1.RawReport.exit_code":
-		x.ExitCode = uint32(value.Uint())
-	case "band.oracle.v1.RawReport.data":
-		x.Data = value.Bytes()
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.OracleScriptVersion.version":
+		x.Version = value.Uint()
+	case "band.oracle.v1.OracleScriptVersion.filename":
+		x.Filename = value.Interface().(string)
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		x.Schema = value.Interface().(string)
+	case "band.oracle.v1.OracleScriptVersion.height":
+		x.Height = value.Int()
+	case "band.oracle.v1.OracleScriptVersion.timestamp":
+		x.Timestamp = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2297,48 +2741,60 @@ func (x *fastReflection_RawReport) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawReport) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleScriptVersion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawReport.external_id":
-		panic(fmt.Errorf("field external_id of message band.oracle.v1.RawReport is not mutable"))
-	case "band.oracle.v1.RawReport.exit_code":
-		panic(fmt.Errorf("field exit_code of message band.oracle.v1.RawReport is not mutable"))
-	case "band.oracle.v1.RawReport.data":
-		panic(fmt.Errorf("field data of message band.oracle.v1.RawReport is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.OracleScriptVersion is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.version":
+		panic(fmt.Errorf("field version of message band.oracle.v1.OracleScriptVersion is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.filename":
+		panic(fmt.Errorf("field filename of message band.oracle.v1.OracleScriptVersion is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		panic(fmt.Errorf("field schema of message band.oracle.v1.OracleScriptVersion is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.height":
+		panic(fmt.Errorf("field height of message band.oracle.v1.OracleScriptVersion is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.timestamp":
+		panic(fmt.Errorf("field timestamp of message band.oracle.v1.OracleScriptVersion is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RawReport) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleScriptVersion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawReport.external_id":
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.RawReport.exit_code":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "band.oracle.v1.RawReport.data":
-		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.OracleScriptVersion.version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.OracleScriptVersion.filename":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.OracleScriptVersion.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.OracleScriptVersion.timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RawReport) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_OracleScriptVersion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RawReport", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleScriptVersion", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2346,7 +2802,7 @@ func (x *fastReflection_RawReport) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RawReport) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_OracleScriptVersion) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2357,7 +2813,7 @@ func (x *fastReflection_RawReport) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawReport) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_OracleScriptVersion) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2369,7 +2825,7 @@ func (x *fastReflection_RawReport) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RawReport) IsValid() bool {
+func (x *fastReflection_OracleScriptVersion) IsValid() bool {
 	return x != nil
 }
 
@@ -2379,9 +2835,9 @@ func (x *fastReflection_RawReport) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_OracleScriptVersion) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RawReport)
+		x := input.Message.Interface().(*OracleScriptVersion)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2393,16 +2849,26 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.ExternalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExternalId))
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
 		}
-		if x.ExitCode != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExitCode))
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
 		}
-		l = len(x.Data)
+		l = len(x.Filename)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Schema)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Timestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.Timestamp))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2413,7 +2879,7 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RawReport)
+		x := input.Message.Interface().(*OracleScriptVersion)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2432,20 +2898,37 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Data) > 0 {
-			i -= len(x.Data)
-			copy(dAtA[i:], x.Data)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+		if x.Timestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timestamp))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Schema) > 0 {
+			i -= len(x.Schema)
+			copy(dAtA[i:], x.Schema)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Schema)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Filename) > 0 {
+			i -= len(x.Filename)
+			copy(dAtA[i:], x.Filename)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Filename)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if x.ExitCode != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExitCode))
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
 			i--
 			dAtA[i] = 0x10
 		}
-		if x.ExternalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -2460,7 +2943,7 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RawReport)
+		x := input.Message.Interface().(*OracleScriptVersion)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2492,17 +2975,17 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawReport: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleScriptVersion: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawReport: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleScriptVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
 				}
-				x.ExternalId = 0
+				x.OracleScriptId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2512,16 +2995,16 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ExternalId |= uint64(b&0x7F) << shift
+					x.OracleScriptId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 				}
-				x.ExitCode = 0
+				x.Version = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2531,16 +3014,16 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ExitCode |= uint32(b&0x7F) << shift
+					x.Version |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2550,26 +3033,94 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
-				if x.Data == nil {
-					x.Data = []byte{}
+				x.Filename = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Schema = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				}
+				x.Timestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Timestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2605,199 +3156,5108 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Request_3_list)(nil)
+var (
+	md_RawRequest                     protoreflect.MessageDescriptor
+	fd_RawRequest_external_id         protoreflect.FieldDescriptor
+	fd_RawRequest_data_source_id      protoreflect.FieldDescriptor
+	fd_RawRequest_calldata            protoreflect.FieldDescriptor
+	fd_RawRequest_data_source_version protoreflect.FieldDescriptor
+)
 
-type _Request_3_list struct {
-	list *[]string
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_RawRequest = File_band_oracle_v1_oracle_proto.Messages().ByName("RawRequest")
+	fd_RawRequest_external_id = md_RawRequest.Fields().ByName("external_id")
+	fd_RawRequest_data_source_id = md_RawRequest.Fields().ByName("data_source_id")
+	fd_RawRequest_calldata = md_RawRequest.Fields().ByName("calldata")
+	fd_RawRequest_data_source_version = md_RawRequest.Fields().ByName("data_source_version")
 }
 
-func (x *_Request_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_RawRequest)(nil)
 
-func (x *_Request_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
+type fastReflection_RawRequest RawRequest
 
-func (x *_Request_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
+func (x *RawRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RawRequest)(x)
 }
 
-func (x *_Request_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
+func (x *RawRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *_Request_3_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message Request at list field RequestedValidators as it is not of Message kind"))
-}
+var _fastReflection_RawRequest_messageType fastReflection_RawRequest_messageType
+var _ protoreflect.MessageType = fastReflection_RawRequest_messageType{}
 
-func (x *_Request_3_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
+type fastReflection_RawRequest_messageType struct{}
 
-func (x *_Request_3_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x fastReflection_RawRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RawRequest)(nil)
 }
-
-func (x *_Request_3_list) IsValid() bool {
-	return x.list != nil
+func (x fastReflection_RawRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_RawRequest)
+}
+func (x fastReflection_RawRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RawRequest
 }
 
-var _ protoreflect.List = (*_Request_8_list)(nil)
-
-type _Request_8_list struct {
-	list *[]*RawRequest
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RawRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_RawRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RawRequest) Type() protoreflect.MessageType {
+	return _fastReflection_RawRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RawRequest) New() protoreflect.Message {
+	return new(fastReflection_RawRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RawRequest) Interface() protoreflect.ProtoMessage {
+	return (*RawRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RawRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExternalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExternalId)
+		if !f(fd_RawRequest_external_id, value) {
+			return
+		}
+	}
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_RawRequest_data_source_id, value) {
+			return
+		}
+	}
+	if len(x.Calldata) != 0 {
+		value := protoreflect.ValueOfBytes(x.Calldata)
+		if !f(fd_RawRequest_calldata, value) {
+			return
+		}
+	}
+	if x.DataSourceVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceVersion)
+		if !f(fd_RawRequest_data_source_version, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RawRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.RawRequest.external_id":
+		return x.ExternalId != uint64(0)
+	case "band.oracle.v1.RawRequest.data_source_id":
+		return x.DataSourceId != uint64(0)
+	case "band.oracle.v1.RawRequest.calldata":
+		return len(x.Calldata) != 0
+	case "band.oracle.v1.RawRequest.data_source_version":
+		return x.DataSourceVersion != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RawRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.RawRequest.external_id":
+		x.ExternalId = uint64(0)
+	case "band.oracle.v1.RawRequest.data_source_id":
+		x.DataSourceId = uint64(0)
+	case "band.oracle.v1.RawRequest.calldata":
+		x.Calldata = nil
+	case "band.oracle.v1.RawRequest.data_source_version":
+		x.DataSourceVersion = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RawRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.RawRequest.external_id":
+		value := x.ExternalId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RawRequest.data_source_id":
+		value := x.DataSourceId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RawRequest.calldata":
+		value := x.Calldata
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.RawRequest.data_source_version":
+		value := x.DataSourceVersion
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RawRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.RawRequest.external_id":
+		x.ExternalId = value.Uint()
+	case "band.oracle.v1.RawRequest.data_source_id":
+		x.DataSourceId = value.Uint()
+	case "band.oracle.v1.RawRequest.calldata":
+		x.Calldata = value.Bytes()
+	case "band.oracle.v1.RawRequest.data_source_version":
+		x.DataSourceVersion = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RawRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.RawRequest.external_id":
+		panic(fmt.Errorf("field external_id of message band.oracle.v1.RawRequest is not mutable"))
+	case "band.oracle.v1.RawRequest.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.RawRequest is not mutable"))
+	case "band.oracle.v1.RawRequest.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.RawRequest is not mutable"))
+	case "band.oracle.v1.RawRequest.data_source_version":
+		panic(fmt.Errorf("field data_source_version of message band.oracle.v1.RawRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RawRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.RawRequest.external_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RawRequest.data_source_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RawRequest.calldata":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.RawRequest.data_source_version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RawRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RawRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RawRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RawRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RawRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RawRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ExternalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExternalId))
+		}
+		if x.DataSourceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceId))
+		}
+		l = len(x.Calldata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DataSourceVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceVersion))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RawRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DataSourceVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceVersion))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Calldata) > 0 {
+			i -= len(x.Calldata)
+			copy(dAtA[i:], x.Calldata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ExternalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RawRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				}
+				x.ExternalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExternalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+				}
+				x.DataSourceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DataSourceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Calldata == nil {
+					x.Calldata = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceVersion", wireType)
+				}
+				x.DataSourceVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DataSourceVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RawReport             protoreflect.MessageDescriptor
+	fd_RawReport_external_id protoreflect.FieldDescriptor
+	fd_RawReport_exit_code   protoreflect.FieldDescriptor
+	fd_RawReport_data        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_RawReport = File_band_oracle_v1_oracle_proto.Messages().ByName("RawReport")
+	fd_RawReport_external_id = md_RawReport.Fields().ByName("external_id")
+	fd_RawReport_exit_code = md_RawReport.Fields().ByName("exit_code")
+	fd_RawReport_data = md_RawReport.Fields().ByName("data")
+}
+
+var _ protoreflect.Message = (*fastReflection_RawReport)(nil)
+
+type fastReflection_RawReport RawReport
+
+func (x *RawReport) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RawReport)(x)
+}
+
+func (x *RawReport) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RawReport_messageType fastReflection_RawReport_messageType
+var _ protoreflect.MessageType = fastReflection_RawReport_messageType{}
+
+type fastReflection_RawReport_messageType struct{}
+
+func (x fastReflection_RawReport_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RawReport)(nil)
+}
+func (x fastReflection_RawReport_messageType) New() protoreflect.Message {
+	return new(fastReflection_RawReport)
+}
+func (x fastReflection_RawReport_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RawReport
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RawReport) Descriptor() protoreflect.MessageDescriptor {
+	return md_RawReport
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RawReport) Type() protoreflect.MessageType {
+	return _fastReflection_RawReport_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RawReport) New() protoreflect.Message {
+	return new(fastReflection_RawReport)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RawReport) Interface() protoreflect.ProtoMessage {
+	return (*RawReport)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RawReport) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExternalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExternalId)
+		if !f(fd_RawReport_external_id, value) {
+			return
+		}
+	}
+	if x.ExitCode != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ExitCode)
+		if !f(fd_RawReport_exit_code, value) {
+			return
+		}
+	}
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_RawReport_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RawReport) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.RawReport.external_id":
+		return x.ExternalId != uint64(0)
+	case "band.oracle.v1.RawReport.exit_code":
+		return x.ExitCode != uint32(0)
+	case "band.oracle.v1.RawReport.data":
+		return len(x.Data) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RawReport) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.RawReport.external_id":
+		x.ExternalId = uint64(0)
+	case "band.oracle.v1.RawReport.exit_code":
+		x.ExitCode = uint32(0)
+	case "band.oracle.v1.RawReport.data":
+		x.Data = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RawReport) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.RawReport.external_id":
+		value := x.ExternalId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RawReport.exit_code":
+		value := x.ExitCode
+		return protoreflect.ValueOfUint32(value)
+	case "band.oracle.v1.RawReport.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RawReport) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.RawReport.external_id":
+		x.ExternalId = value.Uint()
+	case "band.oracle.v1.RawReport.exit_code":
+		x.ExitCode = uint32(value.Uint())
+	case "band.oracle.v1.RawReport.data":
+		x.Data = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RawReport) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.RawReport.external_id":
+		panic(fmt.Errorf("field external_id of message band.oracle.v1.RawReport is not mutable"))
+	case "band.oracle.v1.RawReport.exit_code":
+		panic(fmt.Errorf("field exit_code of message band.oracle.v1.RawReport is not mutable"))
+	case "band.oracle.v1.RawReport.data":
+		panic(fmt.Errorf("field data of message band.oracle.v1.RawReport is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RawReport) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.RawReport.external_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RawReport.exit_code":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "band.oracle.v1.RawReport.data":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RawReport) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RawReport", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RawReport) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RawReport) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RawReport) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RawReport)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ExternalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExternalId))
+		}
+		if x.ExitCode != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExitCode))
+		}
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RawReport)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ExitCode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExitCode))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ExternalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RawReport)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawReport: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawReport: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				}
+				x.ExternalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExternalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+				}
+				x.ExitCode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExitCode |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Request_3_list)(nil)
+
+type _Request_3_list struct {
+	list *[]string
+}
+
+func (x *_Request_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Request_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Request_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Request_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Request_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Request at list field RequestedValidators as it is not of Message kind"))
+}
+
+func (x *_Request_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Request_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Request_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Request_8_list)(nil)
+
+type _Request_8_list struct {
+	list *[]*RawRequest
 }
 
 func (x *_Request_8_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
-	return len(*x.list)
+	return len(*x.list)
+}
+
+func (x *_Request_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Request_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawRequest)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Request_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawRequest)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Request_8_list) AppendMutable() protoreflect.Value {
+	v := new(RawRequest)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Request_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Request_8_list) NewElement() protoreflect.Value {
+	v := new(RawRequest)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Request_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Request_13_list)(nil)
+
+type _Request_13_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Request_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Request_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Request_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Request_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Request_13_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Request_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Request_13_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Request_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Request                       protoreflect.MessageDescriptor
+	fd_Request_oracle_script_id      protoreflect.FieldDescriptor
+	fd_Request_calldata              protoreflect.FieldDescriptor
+	fd_Request_requested_validators  protoreflect.FieldDescriptor
+	fd_Request_min_count             protoreflect.FieldDescriptor
+	fd_Request_request_height        protoreflect.FieldDescriptor
+	fd_Request_request_time          protoreflect.FieldDescriptor
+	fd_Request_client_id             protoreflect.FieldDescriptor
+	fd_Request_raw_requests          protoreflect.FieldDescriptor
+	fd_Request_ibc_channel           protoreflect.FieldDescriptor
+	fd_Request_execute_gas           protoreflect.FieldDescriptor
+	fd_Request_tss_encoder           protoreflect.FieldDescriptor
+	fd_Request_requester             protoreflect.FieldDescriptor
+	fd_Request_fee_limit             protoreflect.FieldDescriptor
+	fd_Request_callback              protoreflect.FieldDescriptor
+	fd_Request_oracle_script_version protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_Request = File_band_oracle_v1_oracle_proto.Messages().ByName("Request")
+	fd_Request_oracle_script_id = md_Request.Fields().ByName("oracle_script_id")
+	fd_Request_calldata = md_Request.Fields().ByName("calldata")
+	fd_Request_requested_validators = md_Request.Fields().ByName("requested_validators")
+	fd_Request_min_count = md_Request.Fields().ByName("min_count")
+	fd_Request_request_height = md_Request.Fields().ByName("request_height")
+	fd_Request_request_time = md_Request.Fields().ByName("request_time")
+	fd_Request_client_id = md_Request.Fields().ByName("client_id")
+	fd_Request_raw_requests = md_Request.Fields().ByName("raw_requests")
+	fd_Request_ibc_channel = md_Request.Fields().ByName("ibc_channel")
+	fd_Request_execute_gas = md_Request.Fields().ByName("execute_gas")
+	fd_Request_tss_encoder = md_Request.Fields().ByName("tss_encoder")
+	fd_Request_requester = md_Request.Fields().ByName("requester")
+	fd_Request_fee_limit = md_Request.Fields().ByName("fee_limit")
+	fd_Request_callback = md_Request.Fields().ByName("callback")
+	fd_Request_oracle_script_version = md_Request.Fields().ByName("oracle_script_version")
+}
+
+var _ protoreflect.Message = (*fastReflection_Request)(nil)
+
+type fastReflection_Request Request
+
+func (x *Request) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Request)(x)
+}
+
+func (x *Request) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Request_messageType fastReflection_Request_messageType
+var _ protoreflect.MessageType = fastReflection_Request_messageType{}
+
+type fastReflection_Request_messageType struct{}
+
+func (x fastReflection_Request_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Request)(nil)
+}
+func (x fastReflection_Request_messageType) New() protoreflect.Message {
+	return new(fastReflection_Request)
+}
+func (x fastReflection_Request_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Request
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Request) Descriptor() protoreflect.MessageDescriptor {
+	return md_Request
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Request) Type() protoreflect.MessageType {
+	return _fastReflection_Request_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Request) New() protoreflect.Message {
+	return new(fastReflection_Request)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Request) Interface() protoreflect.ProtoMessage {
+	return (*Request)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Request) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_Request_oracle_script_id, value) {
+			return
+		}
+	}
+	if len(x.Calldata) != 0 {
+		value := protoreflect.ValueOfBytes(x.Calldata)
+		if !f(fd_Request_calldata, value) {
+			return
+		}
+	}
+	if len(x.RequestedValidators) != 0 {
+		value := protoreflect.ValueOfList(&_Request_3_list{list: &x.RequestedValidators})
+		if !f(fd_Request_requested_validators, value) {
+			return
+		}
+	}
+	if x.MinCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinCount)
+		if !f(fd_Request_min_count, value) {
+			return
+		}
+	}
+	if x.RequestHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RequestHeight)
+		if !f(fd_Request_request_height, value) {
+			return
+		}
+	}
+	if x.RequestTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RequestTime)
+		if !f(fd_Request_request_time, value) {
+			return
+		}
+	}
+	if x.ClientId != "" {
+		value := protoreflect.ValueOfString(x.ClientId)
+		if !f(fd_Request_client_id, value) {
+			return
+		}
+	}
+	if len(x.RawRequests) != 0 {
+		value := protoreflect.ValueOfList(&_Request_8_list{list: &x.RawRequests})
+		if !f(fd_Request_raw_requests, value) {
+			return
+		}
+	}
+	if x.IbcChannel != nil {
+		value := protoreflect.ValueOfMessage(x.IbcChannel.ProtoReflect())
+		if !f(fd_Request_ibc_channel, value) {
+			return
+		}
+	}
+	if x.ExecuteGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecuteGas)
+		if !f(fd_Request_execute_gas, value) {
+			return
+		}
+	}
+	if x.TssEncoder != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TssEncoder))
+		if !f(fd_Request_tss_encoder, value) {
+			return
+		}
+	}
+	if x.Requester != "" {
+		value := protoreflect.ValueOfString(x.Requester)
+		if !f(fd_Request_requester, value) {
+			return
+		}
+	}
+	if len(x.FeeLimit) != 0 {
+		value := protoreflect.ValueOfList(&_Request_13_list{list: &x.FeeLimit})
+		if !f(fd_Request_fee_limit, value) {
+			return
+		}
+	}
+	if x.Callback != nil {
+		value := protoreflect.ValueOfMessage(x.Callback.ProtoReflect())
+		if !f(fd_Request_callback, value) {
+			return
+		}
+	}
+	if x.OracleScriptVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptVersion)
+		if !f(fd_Request_oracle_script_version, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Request) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.Request.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.Request.calldata":
+		return len(x.Calldata) != 0
+	case "band.oracle.v1.Request.requested_validators":
+		return len(x.RequestedValidators) != 0
+	case "band.oracle.v1.Request.min_count":
+		return x.MinCount != uint64(0)
+	case "band.oracle.v1.Request.request_height":
+		return x.RequestHeight != int64(0)
+	case "band.oracle.v1.Request.request_time":
+		return x.RequestTime != int64(0)
+	case "band.oracle.v1.Request.client_id":
+		return x.ClientId != ""
+	case "band.oracle.v1.Request.raw_requests":
+		return len(x.RawRequests) != 0
+	case "band.oracle.v1.Request.ibc_channel":
+		return x.IbcChannel != nil
+	case "band.oracle.v1.Request.execute_gas":
+		return x.ExecuteGas != uint64(0)
+	case "band.oracle.v1.Request.tss_encoder":
+		return x.TssEncoder != 0
+	case "band.oracle.v1.Request.requester":
+		return x.Requester != ""
+	case "band.oracle.v1.Request.fee_limit":
+		return len(x.FeeLimit) != 0
+	case "band.oracle.v1.Request.callback":
+		return x.Callback != nil
+	case "band.oracle.v1.Request.oracle_script_version":
+		return x.OracleScriptVersion != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Request) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.Request.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.Request.calldata":
+		x.Calldata = nil
+	case "band.oracle.v1.Request.requested_validators":
+		x.RequestedValidators = nil
+	case "band.oracle.v1.Request.min_count":
+		x.MinCount = uint64(0)
+	case "band.oracle.v1.Request.request_height":
+		x.RequestHeight = int64(0)
+	case "band.oracle.v1.Request.request_time":
+		x.RequestTime = int64(0)
+	case "band.oracle.v1.Request.client_id":
+		x.ClientId = ""
+	case "band.oracle.v1.Request.raw_requests":
+		x.RawRequests = nil
+	case "band.oracle.v1.Request.ibc_channel":
+		x.IbcChannel = nil
+	case "band.oracle.v1.Request.execute_gas":
+		x.ExecuteGas = uint64(0)
+	case "band.oracle.v1.Request.tss_encoder":
+		x.TssEncoder = 0
+	case "band.oracle.v1.Request.requester":
+		x.Requester = ""
+	case "band.oracle.v1.Request.fee_limit":
+		x.FeeLimit = nil
+	case "band.oracle.v1.Request.callback":
+		x.Callback = nil
+	case "band.oracle.v1.Request.oracle_script_version":
+		x.OracleScriptVersion = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Request) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.Request.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Request.calldata":
+		value := x.Calldata
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.Request.requested_validators":
+		if len(x.RequestedValidators) == 0 {
+			return protoreflect.ValueOfList(&_Request_3_list{})
+		}
+		listValue := &_Request_3_list{list: &x.RequestedValidators}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.Request.min_count":
+		value := x.MinCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Request.request_height":
+		value := x.RequestHeight
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.Request.request_time":
+		value := x.RequestTime
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.Request.client_id":
+		value := x.ClientId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.Request.raw_requests":
+		if len(x.RawRequests) == 0 {
+			return protoreflect.ValueOfList(&_Request_8_list{})
+		}
+		listValue := &_Request_8_list{list: &x.RawRequests}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.Request.ibc_channel":
+		value := x.IbcChannel
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.oracle.v1.Request.execute_gas":
+		value := x.ExecuteGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Request.tss_encoder":
+		value := x.TssEncoder
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.Request.requester":
+		value := x.Requester
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.Request.fee_limit":
+		if len(x.FeeLimit) == 0 {
+			return protoreflect.ValueOfList(&_Request_13_list{})
+		}
+		listValue := &_Request_13_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.Request.callback":
+		value := x.Callback
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.oracle.v1.Request.oracle_script_version":
+		value := x.OracleScriptVersion
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Request) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.Request.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.Request.calldata":
+		x.Calldata = value.Bytes()
+	case "band.oracle.v1.Request.requested_validators":
+		lv := value.List()
+		clv := lv.(*_Request_3_list)
+		x.RequestedValidators = *clv.list
+	case "band.oracle.v1.Request.min_count":
+		x.MinCount = value.Uint()
+	case "band.oracle.v1.Request.request_height":
+		x.RequestHeight = value.Int()
+	case "band.oracle.v1.Request.request_time":
+		x.RequestTime = value.Int()
+	case "band.oracle.v1.Request.client_id":
+		x.ClientId = value.Interface().(string)
+	case "band.oracle.v1.Request.raw_requests":
+		lv := value.List()
+		clv := lv.(*_Request_8_list)
+		x.RawRequests = *clv.list
+	case "band.oracle.v1.Request.ibc_channel":
+		x.IbcChannel = value.Message().Interface().(*IBCChannel)
+	case "band.oracle.v1.Request.execute_gas":
+		x.ExecuteGas = value.Uint()
+	case "band.oracle.v1.Request.tss_encoder":
+		x.TssEncoder = (Encoder)(value.Enum())
+	case "band.oracle.v1.Request.requester":
+		x.Requester = value.Interface().(string)
+	case "band.oracle.v1.Request.fee_limit":
+		lv := value.List()
+		clv := lv.(*_Request_13_list)
+		x.FeeLimit = *clv.list
+	case "band.oracle.v1.Request.callback":
+		x.Callback = value.Message().Interface().(*RequestCallback)
+	case "band.oracle.v1.Request.oracle_script_version":
+		x.OracleScriptVersion = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Request) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.Request.requested_validators":
+		if x.RequestedValidators == nil {
+			x.RequestedValidators = []string{}
+		}
+		value := &_Request_3_list{list: &x.RequestedValidators}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.Request.raw_requests":
+		if x.RawRequests == nil {
+			x.RawRequests = []*RawRequest{}
+		}
+		value := &_Request_8_list{list: &x.RawRequests}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.Request.ibc_channel":
+		if x.IbcChannel == nil {
+			x.IbcChannel = new(IBCChannel)
+		}
+		return protoreflect.ValueOfMessage(x.IbcChannel.ProtoReflect())
+	case "band.oracle.v1.Request.fee_limit":
+		if x.FeeLimit == nil {
+			x.FeeLimit = []*v1beta1.Coin{}
+		}
+		value := &_Request_13_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.Request.callback":
+		if x.Callback == nil {
+			x.Callback = new(RequestCallback)
+		}
+		return protoreflect.ValueOfMessage(x.Callback.ProtoReflect())
+	case "band.oracle.v1.Request.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.request_height":
+		panic(fmt.Errorf("field request_height of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.request_time":
+		panic(fmt.Errorf("field request_time of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.client_id":
+		panic(fmt.Errorf("field client_id of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.execute_gas":
+		panic(fmt.Errorf("field execute_gas of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.tss_encoder":
+		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.requester":
+		panic(fmt.Errorf("field requester of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.oracle_script_version":
+		panic(fmt.Errorf("field oracle_script_version of message band.oracle.v1.Request is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Request) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.Request.oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Request.calldata":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.Request.requested_validators":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Request_3_list{list: &list})
+	case "band.oracle.v1.Request.min_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Request.request_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.Request.request_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.Request.client_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.Request.raw_requests":
+		list := []*RawRequest{}
+		return protoreflect.ValueOfList(&_Request_8_list{list: &list})
+	case "band.oracle.v1.Request.ibc_channel":
+		m := new(IBCChannel)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.oracle.v1.Request.execute_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Request.tss_encoder":
+		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.Request.requester":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.Request.fee_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Request_13_list{list: &list})
+	case "band.oracle.v1.Request.callback":
+		m := new(RequestCallback)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.oracle.v1.Request.oracle_script_version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Request) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Request", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Request) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Request) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Request) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Request)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		}
+		l = len(x.Calldata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RequestedValidators) > 0 {
+			for _, s := range x.RequestedValidators {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MinCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinCount))
+		}
+		if x.RequestHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestHeight))
+		}
+		if x.RequestTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestTime))
+		}
+		l = len(x.ClientId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RawRequests) > 0 {
+			for _, e := range x.RawRequests {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.IbcChannel != nil {
+			l = options.Size(x.IbcChannel)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExecuteGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecuteGas))
+		}
+		if x.TssEncoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.TssEncoder))
+		}
+		l = len(x.Requester)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FeeLimit) > 0 {
+			for _, e := range x.FeeLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Callback != nil {
+			l = options.Size(x.Callback)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OracleScriptVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptVersion))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Request)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.OracleScriptVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptVersion))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.Callback != nil {
+			encoded, err := options.Marshal(x.Callback)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.FeeLimit) > 0 {
+			for iNdEx := len(x.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.Requester) > 0 {
+			i -= len(x.Requester)
+			copy(dAtA[i:], x.Requester)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Requester)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.TssEncoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TssEncoder))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.ExecuteGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGas))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.IbcChannel != nil {
+			encoded, err := options.Marshal(x.IbcChannel)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.RawRequests) > 0 {
+			for iNdEx := len(x.RawRequests) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RawRequests[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.ClientId) > 0 {
+			i -= len(x.ClientId)
+			copy(dAtA[i:], x.ClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.RequestTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestTime))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.RequestHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.RequestedValidators) > 0 {
+			for iNdEx := len(x.RequestedValidators) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RequestedValidators[iNdEx])
+				copy(dAtA[i:], x.RequestedValidators[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequestedValidators[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Calldata) > 0 {
+			i -= len(x.Calldata)
+			copy(dAtA[i:], x.Calldata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Request)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Request: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				}
+				x.OracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Calldata == nil {
+					x.Calldata = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestedValidators", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequestedValidators = append(x.RequestedValidators, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				}
+				x.MinCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
+				}
+				x.RequestHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+				}
+				x.RequestTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClientId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawRequests", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RawRequests = append(x.RawRequests, &RawRequest{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RawRequests[len(x.RawRequests)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcChannel", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.IbcChannel == nil {
+					x.IbcChannel = &IBCChannel{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IbcChannel); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+				}
+				x.ExecuteGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecuteGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssEncoder", wireType)
+				}
+				x.TssEncoder = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TssEncoder |= Encoder(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Requester = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeLimit = append(x.FeeLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeLimit[len(x.FeeLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Callback == nil {
+					x.Callback = &RequestCallback{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Callback); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
+				}
+				x.OracleScriptVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RequestCallback         protoreflect.MessageDescriptor
+	fd_RequestCallback_module  protoreflect.FieldDescriptor
+	fd_RequestCallback_payload protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_RequestCallback = File_band_oracle_v1_oracle_proto.Messages().ByName("RequestCallback")
+	fd_RequestCallback_module = md_RequestCallback.Fields().ByName("module")
+	fd_RequestCallback_payload = md_RequestCallback.Fields().ByName("payload")
+}
+
+var _ protoreflect.Message = (*fastReflection_RequestCallback)(nil)
+
+type fastReflection_RequestCallback RequestCallback
+
+func (x *RequestCallback) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RequestCallback)(x)
+}
+
+func (x *RequestCallback) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RequestCallback_messageType fastReflection_RequestCallback_messageType
+var _ protoreflect.MessageType = fastReflection_RequestCallback_messageType{}
+
+type fastReflection_RequestCallback_messageType struct{}
+
+func (x fastReflection_RequestCallback_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RequestCallback)(nil)
+}
+func (x fastReflection_RequestCallback_messageType) New() protoreflect.Message {
+	return new(fastReflection_RequestCallback)
+}
+func (x fastReflection_RequestCallback_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RequestCallback
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RequestCallback) Descriptor() protoreflect.MessageDescriptor {
+	return md_RequestCallback
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RequestCallback) Type() protoreflect.MessageType {
+	return _fastReflection_RequestCallback_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RequestCallback) New() protoreflect.Message {
+	return new(fastReflection_RequestCallback)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RequestCallback) Interface() protoreflect.ProtoMessage {
+	return (*RequestCallback)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RequestCallback) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Module != "" {
+		value := protoreflect.ValueOfString(x.Module)
+		if !f(fd_RequestCallback_module, value) {
+			return
+		}
+	}
+	if len(x.Payload) != 0 {
+		value := protoreflect.ValueOfBytes(x.Payload)
+		if !f(fd_RequestCallback_payload, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RequestCallback) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestCallback.module":
+		return x.Module != ""
+	case "band.oracle.v1.RequestCallback.payload":
+		return len(x.Payload) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestCallback"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestCallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestCallback) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestCallback.module":
+		x.Module = ""
+	case "band.oracle.v1.RequestCallback.payload":
+		x.Payload = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestCallback"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestCallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RequestCallback) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.RequestCallback.module":
+		value := x.Module
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.RequestCallback.payload":
+		value := x.Payload
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestCallback"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestCallback does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestCallback) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestCallback.module":
+		x.Module = value.Interface().(string)
+	case "band.oracle.v1.RequestCallback.payload":
+		x.Payload = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestCallback"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestCallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestCallback) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestCallback.module":
+		panic(fmt.Errorf("field module of message band.oracle.v1.RequestCallback is not mutable"))
+	case "band.oracle.v1.RequestCallback.payload":
+		panic(fmt.Errorf("field payload of message band.oracle.v1.RequestCallback is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestCallback"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestCallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RequestCallback) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestCallback.module":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.RequestCallback.payload":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestCallback"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestCallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RequestCallback) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RequestCallback", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RequestCallback) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestCallback) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RequestCallback) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RequestCallback) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RequestCallback)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Module)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Payload)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RequestCallback)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Payload) > 0 {
+			i -= len(x.Payload)
+			copy(dAtA[i:], x.Payload)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payload)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Module) > 0 {
+			i -= len(x.Module)
+			copy(dAtA[i:], x.Module)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Module)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RequestCallback)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestCallback: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Module = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Payload = append(x.Payload[:0], dAtA[iNdEx:postIndex]...)
+				if x.Payload == nil {
+					x.Payload = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Subscription_7_list)(nil)
+
+type _Subscription_7_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Subscription_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Subscription_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Subscription_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Subscription_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Subscription_7_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Subscription_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Subscription_7_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Subscription_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Subscription                     protoreflect.MessageDescriptor
+	fd_Subscription_id                  protoreflect.FieldDescriptor
+	fd_Subscription_oracle_script_id    protoreflect.FieldDescriptor
+	fd_Subscription_calldata            protoreflect.FieldDescriptor
+	fd_Subscription_ask_count           protoreflect.FieldDescriptor
+	fd_Subscription_min_count           protoreflect.FieldDescriptor
+	fd_Subscription_client_id           protoreflect.FieldDescriptor
+	fd_Subscription_fee_limit           protoreflect.FieldDescriptor
+	fd_Subscription_prepare_gas         protoreflect.FieldDescriptor
+	fd_Subscription_execute_gas         protoreflect.FieldDescriptor
+	fd_Subscription_tss_encoder         protoreflect.FieldDescriptor
+	fd_Subscription_block_interval      protoreflect.FieldDescriptor
+	fd_Subscription_time_interval       protoreflect.FieldDescriptor
+	fd_Subscription_escrow              protoreflect.FieldDescriptor
+	fd_Subscription_owner               protoreflect.FieldDescriptor
+	fd_Subscription_is_active           protoreflect.FieldDescriptor
+	fd_Subscription_last_request_id     protoreflect.FieldDescriptor
+	fd_Subscription_last_request_height protoreflect.FieldDescriptor
+	fd_Subscription_last_request_time   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_Subscription = File_band_oracle_v1_oracle_proto.Messages().ByName("Subscription")
+	fd_Subscription_id = md_Subscription.Fields().ByName("id")
+	fd_Subscription_oracle_script_id = md_Subscription.Fields().ByName("oracle_script_id")
+	fd_Subscription_calldata = md_Subscription.Fields().ByName("calldata")
+	fd_Subscription_ask_count = md_Subscription.Fields().ByName("ask_count")
+	fd_Subscription_min_count = md_Subscription.Fields().ByName("min_count")
+	fd_Subscription_client_id = md_Subscription.Fields().ByName("client_id")
+	fd_Subscription_fee_limit = md_Subscription.Fields().ByName("fee_limit")
+	fd_Subscription_prepare_gas = md_Subscription.Fields().ByName("prepare_gas")
+	fd_Subscription_execute_gas = md_Subscription.Fields().ByName("execute_gas")
+	fd_Subscription_tss_encoder = md_Subscription.Fields().ByName("tss_encoder")
+	fd_Subscription_block_interval = md_Subscription.Fields().ByName("block_interval")
+	fd_Subscription_time_interval = md_Subscription.Fields().ByName("time_interval")
+	fd_Subscription_escrow = md_Subscription.Fields().ByName("escrow")
+	fd_Subscription_owner = md_Subscription.Fields().ByName("owner")
+	fd_Subscription_is_active = md_Subscription.Fields().ByName("is_active")
+	fd_Subscription_last_request_id = md_Subscription.Fields().ByName("last_request_id")
+	fd_Subscription_last_request_height = md_Subscription.Fields().ByName("last_request_height")
+	fd_Subscription_last_request_time = md_Subscription.Fields().ByName("last_request_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_Subscription)(nil)
+
+type fastReflection_Subscription Subscription
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Subscription)(x)
+}
+
+func (x *Subscription) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Subscription_messageType fastReflection_Subscription_messageType
+var _ protoreflect.MessageType = fastReflection_Subscription_messageType{}
+
+type fastReflection_Subscription_messageType struct{}
+
+func (x fastReflection_Subscription_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Subscription)(nil)
+}
+func (x fastReflection_Subscription_messageType) New() protoreflect.Message {
+	return new(fastReflection_Subscription)
+}
+func (x fastReflection_Subscription_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Subscription
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Subscription) Descriptor() protoreflect.MessageDescriptor {
+	return md_Subscription
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Subscription) Type() protoreflect.MessageType {
+	return _fastReflection_Subscription_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Subscription) New() protoreflect.Message {
+	return new(fastReflection_Subscription)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Subscription) Interface() protoreflect.ProtoMessage {
+	return (*Subscription)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Subscription) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Subscription_id, value) {
+			return
+		}
+	}
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_Subscription_oracle_script_id, value) {
+			return
+		}
+	}
+	if len(x.Calldata) != 0 {
+		value := protoreflect.ValueOfBytes(x.Calldata)
+		if !f(fd_Subscription_calldata, value) {
+			return
+		}
+	}
+	if x.AskCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AskCount)
+		if !f(fd_Subscription_ask_count, value) {
+			return
+		}
+	}
+	if x.MinCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinCount)
+		if !f(fd_Subscription_min_count, value) {
+			return
+		}
+	}
+	if x.ClientId != "" {
+		value := protoreflect.ValueOfString(x.ClientId)
+		if !f(fd_Subscription_client_id, value) {
+			return
+		}
+	}
+	if len(x.FeeLimit) != 0 {
+		value := protoreflect.ValueOfList(&_Subscription_7_list{list: &x.FeeLimit})
+		if !f(fd_Subscription_fee_limit, value) {
+			return
+		}
+	}
+	if x.PrepareGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PrepareGas)
+		if !f(fd_Subscription_prepare_gas, value) {
+			return
+		}
+	}
+	if x.ExecuteGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecuteGas)
+		if !f(fd_Subscription_execute_gas, value) {
+			return
+		}
+	}
+	if x.TssEncoder != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TssEncoder))
+		if !f(fd_Subscription_tss_encoder, value) {
+			return
+		}
+	}
+	if x.BlockInterval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockInterval)
+		if !f(fd_Subscription_block_interval, value) {
+			return
+		}
+	}
+	if x.TimeInterval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimeInterval)
+		if !f(fd_Subscription_time_interval, value) {
+			return
+		}
+	}
+	if x.Escrow != "" {
+		value := protoreflect.ValueOfString(x.Escrow)
+		if !f(fd_Subscription_escrow, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_Subscription_owner, value) {
+			return
+		}
+	}
+	if x.IsActive != false {
+		value := protoreflect.ValueOfBool(x.IsActive)
+		if !f(fd_Subscription_is_active, value) {
+			return
+		}
+	}
+	if x.LastRequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastRequestId)
+		if !f(fd_Subscription_last_request_id, value) {
+			return
+		}
+	}
+	if x.LastRequestHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastRequestHeight)
+		if !f(fd_Subscription_last_request_height, value) {
+			return
+		}
+	}
+	if x.LastRequestTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastRequestTime)
+		if !f(fd_Subscription_last_request_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Subscription) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.Subscription.id":
+		return x.Id != uint64(0)
+	case "band.oracle.v1.Subscription.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.Subscription.calldata":
+		return len(x.Calldata) != 0
+	case "band.oracle.v1.Subscription.ask_count":
+		return x.AskCount != uint64(0)
+	case "band.oracle.v1.Subscription.min_count":
+		return x.MinCount != uint64(0)
+	case "band.oracle.v1.Subscription.client_id":
+		return x.ClientId != ""
+	case "band.oracle.v1.Subscription.fee_limit":
+		return len(x.FeeLimit) != 0
+	case "band.oracle.v1.Subscription.prepare_gas":
+		return x.PrepareGas != uint64(0)
+	case "band.oracle.v1.Subscription.execute_gas":
+		return x.ExecuteGas != uint64(0)
+	case "band.oracle.v1.Subscription.tss_encoder":
+		return x.TssEncoder != 0
+	case "band.oracle.v1.Subscription.block_interval":
+		return x.BlockInterval != uint64(0)
+	case "band.oracle.v1.Subscription.time_interval":
+		return x.TimeInterval != uint64(0)
+	case "band.oracle.v1.Subscription.escrow":
+		return x.Escrow != ""
+	case "band.oracle.v1.Subscription.owner":
+		return x.Owner != ""
+	case "band.oracle.v1.Subscription.is_active":
+		return x.IsActive != false
+	case "band.oracle.v1.Subscription.last_request_id":
+		return x.LastRequestId != uint64(0)
+	case "band.oracle.v1.Subscription.last_request_height":
+		return x.LastRequestHeight != int64(0)
+	case "band.oracle.v1.Subscription.last_request_time":
+		return x.LastRequestTime != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Subscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Subscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Subscription) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.Subscription.id":
+		x.Id = uint64(0)
+	case "band.oracle.v1.Subscription.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.Subscription.calldata":
+		x.Calldata = nil
+	case "band.oracle.v1.Subscription.ask_count":
+		x.AskCount = uint64(0)
+	case "band.oracle.v1.Subscription.min_count":
+		x.MinCount = uint64(0)
+	case "band.oracle.v1.Subscription.client_id":
+		x.ClientId = ""
+	case "band.oracle.v1.Subscription.fee_limit":
+		x.FeeLimit = nil
+	case "band.oracle.v1.Subscription.prepare_gas":
+		x.PrepareGas = uint64(0)
+	case "band.oracle.v1.Subscription.execute_gas":
+		x.ExecuteGas = uint64(0)
+	case "band.oracle.v1.Subscription.tss_encoder":
+		x.TssEncoder = 0
+	case "band.oracle.v1.Subscription.block_interval":
+		x.BlockInterval = uint64(0)
+	case "band.oracle.v1.Subscription.time_interval":
+		x.TimeInterval = uint64(0)
+	case "band.oracle.v1.Subscription.escrow":
+		x.Escrow = ""
+	case "band.oracle.v1.Subscription.owner":
+		x.Owner = ""
+	case "band.oracle.v1.Subscription.is_active":
+		x.IsActive = false
+	case "band.oracle.v1.Subscription.last_request_id":
+		x.LastRequestId = uint64(0)
+	case "band.oracle.v1.Subscription.last_request_height":
+		x.LastRequestHeight = int64(0)
+	case "band.oracle.v1.Subscription.last_request_time":
+		x.LastRequestTime = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Subscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Subscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Subscription) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.Subscription.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Subscription.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Subscription.calldata":
+		value := x.Calldata
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.Subscription.ask_count":
+		value := x.AskCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Subscription.min_count":
+		value := x.MinCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Subscription.client_id":
+		value := x.ClientId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.Subscription.fee_limit":
+		if len(x.FeeLimit) == 0 {
+			return protoreflect.ValueOfList(&_Subscription_7_list{})
+		}
+		listValue := &_Subscription_7_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.Subscription.prepare_gas":
+		value := x.PrepareGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Subscription.execute_gas":
+		value := x.ExecuteGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Subscription.tss_encoder":
+		value := x.TssEncoder
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.Subscription.block_interval":
+		value := x.BlockInterval
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Subscription.time_interval":
+		value := x.TimeInterval
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Subscription.escrow":
+		value := x.Escrow
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.Subscription.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.Subscription.is_active":
+		value := x.IsActive
+		return protoreflect.ValueOfBool(value)
+	case "band.oracle.v1.Subscription.last_request_id":
+		value := x.LastRequestId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Subscription.last_request_height":
+		value := x.LastRequestHeight
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.Subscription.last_request_time":
+		value := x.LastRequestTime
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Subscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Subscription does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Subscription) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.Subscription.id":
+		x.Id = value.Uint()
+	case "band.oracle.v1.Subscription.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.Subscription.calldata":
+		x.Calldata = value.Bytes()
+	case "band.oracle.v1.Subscription.ask_count":
+		x.AskCount = value.Uint()
+	case "band.oracle.v1.Subscription.min_count":
+		x.MinCount = value.Uint()
+	case "band.oracle.v1.Subscription.client_id":
+		x.ClientId = value.Interface().(string)
+	case "band.oracle.v1.Subscription.fee_limit":
+		lv := value.List()
+		clv := lv.(*_Subscription_7_list)
+		x.FeeLimit = *clv.list
+	case "band.oracle.v1.Subscription.prepare_gas":
+		x.PrepareGas = value.Uint()
+	case "band.oracle.v1.Subscription.execute_gas":
+		x.ExecuteGas = value.Uint()
+	case "band.oracle.v1.Subscription.tss_encoder":
+		x.TssEncoder = (Encoder)(value.Enum())
+	case "band.oracle.v1.Subscription.block_interval":
+		x.BlockInterval = value.Uint()
+	case "band.oracle.v1.Subscription.time_interval":
+		x.TimeInterval = value.Uint()
+	case "band.oracle.v1.Subscription.escrow":
+		x.Escrow = value.Interface().(string)
+	case "band.oracle.v1.Subscription.owner":
+		x.Owner = value.Interface().(string)
+	case "band.oracle.v1.Subscription.is_active":
+		x.IsActive = value.Bool()
+	case "band.oracle.v1.Subscription.last_request_id":
+		x.LastRequestId = value.Uint()
+	case "band.oracle.v1.Subscription.last_request_height":
+		x.LastRequestHeight = value.Int()
+	case "band.oracle.v1.Subscription.last_request_time":
+		x.LastRequestTime = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Subscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Subscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Subscription) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.Subscription.fee_limit":
+		if x.FeeLimit == nil {
+			x.FeeLimit = []*v1beta1.Coin{}
+		}
+		value := &_Subscription_7_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.Subscription.id":
+		panic(fmt.Errorf("field id of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.ask_count":
+		panic(fmt.Errorf("field ask_count of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.client_id":
+		panic(fmt.Errorf("field client_id of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.prepare_gas":
+		panic(fmt.Errorf("field prepare_gas of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.execute_gas":
+		panic(fmt.Errorf("field execute_gas of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.tss_encoder":
+		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.block_interval":
+		panic(fmt.Errorf("field block_interval of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.time_interval":
+		panic(fmt.Errorf("field time_interval of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.escrow":
+		panic(fmt.Errorf("field escrow of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.owner":
+		panic(fmt.Errorf("field owner of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.is_active":
+		panic(fmt.Errorf("field is_active of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.last_request_id":
+		panic(fmt.Errorf("field last_request_id of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.last_request_height":
+		panic(fmt.Errorf("field last_request_height of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.last_request_time":
+		panic(fmt.Errorf("field last_request_time of message band.oracle.v1.Subscription is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Subscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Subscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Subscription) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.Subscription.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Subscription.oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Subscription.calldata":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.Subscription.ask_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Subscription.min_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Subscription.client_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.Subscription.fee_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Subscription_7_list{list: &list})
+	case "band.oracle.v1.Subscription.prepare_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Subscription.execute_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Subscription.tss_encoder":
+		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.Subscription.block_interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Subscription.time_interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Subscription.escrow":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.Subscription.owner":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.Subscription.is_active":
+		return protoreflect.ValueOfBool(false)
+	case "band.oracle.v1.Subscription.last_request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Subscription.last_request_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.Subscription.last_request_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Subscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Subscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Subscription) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Subscription", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Subscription) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Subscription) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Subscription) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Subscription) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Subscription)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		}
+		l = len(x.Calldata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AskCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AskCount))
+		}
+		if x.MinCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinCount))
+		}
+		l = len(x.ClientId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FeeLimit) > 0 {
+			for _, e := range x.FeeLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PrepareGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrepareGas))
+		}
+		if x.ExecuteGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecuteGas))
+		}
+		if x.TssEncoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.TssEncoder))
+		}
+		if x.BlockInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockInterval))
+		}
+		if x.TimeInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeInterval))
+		}
+		l = len(x.Escrow)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IsActive {
+			n += 2
+		}
+		if x.LastRequestId != 0 {
+			n += 2 + runtime.Sov(uint64(x.LastRequestId))
+		}
+		if x.LastRequestHeight != 0 {
+			n += 2 + runtime.Sov(uint64(x.LastRequestHeight))
+		}
+		if x.LastRequestTime != 0 {
+			n += 2 + runtime.Sov(uint64(x.LastRequestTime))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Subscription)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastRequestTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastRequestTime))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.LastRequestHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastRequestHeight))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.LastRequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastRequestId))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.IsActive {
+			i--
+			if x.IsActive {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x78
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.Escrow) > 0 {
+			i -= len(x.Escrow)
+			copy(dAtA[i:], x.Escrow)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Escrow)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.TimeInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeInterval))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.BlockInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockInterval))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.TssEncoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TssEncoder))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ExecuteGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGas))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.PrepareGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrepareGas))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.FeeLimit) > 0 {
+			for iNdEx := len(x.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.ClientId) > 0 {
+			i -= len(x.ClientId)
+			copy(dAtA[i:], x.ClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.MinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.AskCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Calldata) > 0 {
+			i -= len(x.Calldata)
+			copy(dAtA[i:], x.Calldata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Subscription)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Subscription: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				}
+				x.OracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Calldata == nil {
+					x.Calldata = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				}
+				x.AskCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AskCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				}
+				x.MinCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClientId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeLimit = append(x.FeeLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeLimit[len(x.FeeLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+				}
+				x.PrepareGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrepareGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+				}
+				x.ExecuteGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecuteGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssEncoder", wireType)
+				}
+				x.TssEncoder = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TssEncoder |= Encoder(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockInterval", wireType)
+				}
+				x.BlockInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockInterval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeInterval", wireType)
+				}
+				x.TimeInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeInterval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Escrow = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsActive = bool(v != 0)
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastRequestId", wireType)
+				}
+				x.LastRequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastRequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastRequestHeight", wireType)
+				}
+				x.LastRequestHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastRequestHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastRequestTime", wireType)
+				}
+				x.LastRequestTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastRequestTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Report_3_list)(nil)
+
+type _Report_3_list struct {
+	list *[]*RawReport
+}
+
+func (x *_Report_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Report_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Report_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawReport)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Report_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawReport)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Report_3_list) AppendMutable() protoreflect.Value {
+	v := new(RawReport)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Report_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Report_3_list) NewElement() protoreflect.Value {
+	v := new(RawReport)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Report_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Report                   protoreflect.MessageDescriptor
+	fd_Report_validator         protoreflect.FieldDescriptor
+	fd_Report_in_before_resolve protoreflect.FieldDescriptor
+	fd_Report_raw_reports       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_Report = File_band_oracle_v1_oracle_proto.Messages().ByName("Report")
+	fd_Report_validator = md_Report.Fields().ByName("validator")
+	fd_Report_in_before_resolve = md_Report.Fields().ByName("in_before_resolve")
+	fd_Report_raw_reports = md_Report.Fields().ByName("raw_reports")
+}
+
+var _ protoreflect.Message = (*fastReflection_Report)(nil)
+
+type fastReflection_Report Report
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Report)(x)
+}
+
+func (x *Report) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Report_messageType fastReflection_Report_messageType
+var _ protoreflect.MessageType = fastReflection_Report_messageType{}
+
+type fastReflection_Report_messageType struct{}
+
+func (x fastReflection_Report_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Report)(nil)
+}
+func (x fastReflection_Report_messageType) New() protoreflect.Message {
+	return new(fastReflection_Report)
+}
+func (x fastReflection_Report_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Report
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Report) Descriptor() protoreflect.MessageDescriptor {
+	return md_Report
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Report) Type() protoreflect.MessageType {
+	return _fastReflection_Report_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Report) New() protoreflect.Message {
+	return new(fastReflection_Report)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Report) Interface() protoreflect.ProtoMessage {
+	return (*Report)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Report) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_Report_validator, value) {
+			return
+		}
+	}
+	if x.InBeforeResolve != false {
+		value := protoreflect.ValueOfBool(x.InBeforeResolve)
+		if !f(fd_Report_in_before_resolve, value) {
+			return
+		}
+	}
+	if len(x.RawReports) != 0 {
+		value := protoreflect.ValueOfList(&_Report_3_list{list: &x.RawReports})
+		if !f(fd_Report_raw_reports, value) {
+			return
+		}
+	}
 }
 
-func (x *_Request_8_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Report) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.Report.validator":
+		return x.Validator != ""
+	case "band.oracle.v1.Report.in_before_resolve":
+		return x.InBeforeResolve != false
+	case "band.oracle.v1.Report.raw_reports":
+		return len(x.RawReports) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+	}
 }
 
-func (x *_Request_8_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*RawRequest)
-	(*x.list)[i] = concreteValue
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Report) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.Report.validator":
+		x.Validator = ""
+	case "band.oracle.v1.Report.in_before_resolve":
+		x.InBeforeResolve = false
+	case "band.oracle.v1.Report.raw_reports":
+		x.RawReports = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+	}
 }
 
-func (x *_Request_8_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*RawRequest)
-	*x.list = append(*x.list, concreteValue)
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Report) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.Report.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.Report.in_before_resolve":
+		value := x.InBeforeResolve
+		return protoreflect.ValueOfBool(value)
+	case "band.oracle.v1.Report.raw_reports":
+		if len(x.RawReports) == 0 {
+			return protoreflect.ValueOfList(&_Report_3_list{})
+		}
+		listValue := &_Report_3_list{list: &x.RawReports}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", descriptor.FullName()))
+	}
 }
 
-func (x *_Request_8_list) AppendMutable() protoreflect.Value {
-	v := new(RawRequest)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Report) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.Report.validator":
+		x.Validator = value.Interface().(string)
+	case "band.oracle.v1.Report.in_before_resolve":
+		x.InBeforeResolve = value.Bool()
+	case "band.oracle.v1.Report.raw_reports":
+		lv := value.List()
+		clv := lv.(*_Report_3_list)
+		x.RawReports = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+	}
 }
 
-func (x *_Request_8_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Report) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.Report.raw_reports":
+		if x.RawReports == nil {
+			x.RawReports = []*RawReport{}
+		}
+		value := &_Report_3_list{list: &x.RawReports}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.Report.validator":
+		panic(fmt.Errorf("field validator of message band.oracle.v1.Report is not mutable"))
+	case "band.oracle.v1.Report.in_before_resolve":
+		panic(fmt.Errorf("field in_before_resolve of message band.oracle.v1.Report is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Report) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.Report.validator":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.Report.in_before_resolve":
+		return protoreflect.ValueOfBool(false)
+	case "band.oracle.v1.Report.raw_reports":
+		list := []*RawReport{}
+		return protoreflect.ValueOfList(&_Report_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Report) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Report", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Report) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Report) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Report) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Report)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.InBeforeResolve {
+			n += 2
+		}
+		if len(x.RawReports) > 0 {
+			for _, e := range x.RawReports {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
 	}
-	*x.list = (*x.list)[:n]
-}
 
-func (x *_Request_8_list) NewElement() protoreflect.Value {
-	v := new(RawRequest)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Report)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RawReports) > 0 {
+			for iNdEx := len(x.RawReports) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RawReports[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.InBeforeResolve {
+			i--
+			if x.InBeforeResolve {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Report)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Report: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InBeforeResolve", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.InBeforeResolve = bool(v != 0)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RawReports = append(x.RawReports, &RawReport{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RawReports[len(x.RawReports)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
 
-func (x *_Request_8_list) IsValid() bool {
-	return x.list != nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
 
-var _ protoreflect.List = (*_Request_13_list)(nil)
+var _ protoreflect.List = (*_OracleRequestPacketData_6_list)(nil)
 
-type _Request_13_list struct {
+type _OracleRequestPacketData_6_list struct {
 	list *[]*v1beta1.Coin
 }
 
-func (x *_Request_13_list) Len() int {
+func (x *_OracleRequestPacketData_6_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Request_13_list) Get(i int) protoreflect.Value {
+func (x *_OracleRequestPacketData_6_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Request_13_list) Set(i int, value protoreflect.Value) {
+func (x *_OracleRequestPacketData_6_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Request_13_list) Append(value protoreflect.Value) {
+func (x *_OracleRequestPacketData_6_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Request_13_list) AppendMutable() protoreflect.Value {
+func (x *_OracleRequestPacketData_6_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Request_13_list) Truncate(n int) {
+func (x *_OracleRequestPacketData_6_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Request_13_list) NewElement() protoreflect.Value {
+func (x *_OracleRequestPacketData_6_list) NewElement() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Request_13_list) IsValid() bool {
+func (x *_OracleRequestPacketData_6_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Request                      protoreflect.MessageDescriptor
-	fd_Request_oracle_script_id     protoreflect.FieldDescriptor
-	fd_Request_calldata             protoreflect.FieldDescriptor
-	fd_Request_requested_validators protoreflect.FieldDescriptor
-	fd_Request_min_count            protoreflect.FieldDescriptor
-	fd_Request_request_height       protoreflect.FieldDescriptor
-	fd_Request_request_time         protoreflect.FieldDescriptor
-	fd_Request_client_id            protoreflect.FieldDescriptor
-	fd_Request_raw_requests         protoreflect.FieldDescriptor
-	fd_Request_ibc_channel          protoreflect.FieldDescriptor
-	fd_Request_execute_gas          protoreflect.FieldDescriptor
-	fd_Request_tss_encoder          protoreflect.FieldDescriptor
-	fd_Request_requester            protoreflect.FieldDescriptor
-	fd_Request_fee_limit            protoreflect.FieldDescriptor
+	md_OracleRequestPacketData                  protoreflect.MessageDescriptor
+	fd_OracleRequestPacketData_client_id        protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_oracle_script_id protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_calldata         protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_ask_count        protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_min_count        protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_fee_limit        protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_prepare_gas      protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_execute_gas      protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_tss_encoder      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_Request = File_band_oracle_v1_oracle_proto.Messages().ByName("Request")
-	fd_Request_oracle_script_id = md_Request.Fields().ByName("oracle_script_id")
-	fd_Request_calldata = md_Request.Fields().ByName("calldata")
-	fd_Request_requested_validators = md_Request.Fields().ByName("requested_validators")
-	fd_Request_min_count = md_Request.Fields().ByName("min_count")
-	fd_Request_request_height = md_Request.Fields().ByName("request_height")
-	fd_Request_request_time = md_Request.Fields().ByName("request_time")
-	fd_Request_client_id = md_Request.Fields().ByName("client_id")
-	fd_Request_raw_requests = md_Request.Fields().ByName("raw_requests")
-	fd_Request_ibc_channel = md_Request.Fields().ByName("ibc_channel")
-	fd_Request_execute_gas = md_Request.Fields().ByName("execute_gas")
-	fd_Request_tss_encoder = md_Request.Fields().ByName("tss_encoder")
-	fd_Request_requester = md_Request.Fields().ByName("requester")
-	fd_Request_fee_limit = md_Request.Fields().ByName("fee_limit")
+	md_OracleRequestPacketData = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleRequestPacketData")
+	fd_OracleRequestPacketData_client_id = md_OracleRequestPacketData.Fields().ByName("client_id")
+	fd_OracleRequestPacketData_oracle_script_id = md_OracleRequestPacketData.Fields().ByName("oracle_script_id")
+	fd_OracleRequestPacketData_calldata = md_OracleRequestPacketData.Fields().ByName("calldata")
+	fd_OracleRequestPacketData_ask_count = md_OracleRequestPacketData.Fields().ByName("ask_count")
+	fd_OracleRequestPacketData_min_count = md_OracleRequestPacketData.Fields().ByName("min_count")
+	fd_OracleRequestPacketData_fee_limit = md_OracleRequestPacketData.Fields().ByName("fee_limit")
+	fd_OracleRequestPacketData_prepare_gas = md_OracleRequestPacketData.Fields().ByName("prepare_gas")
+	fd_OracleRequestPacketData_execute_gas = md_OracleRequestPacketData.Fields().ByName("execute_gas")
+	fd_OracleRequestPacketData_tss_encoder = md_OracleRequestPacketData.Fields().ByName("tss_encoder")
 }
 
-var _ protoreflect.Message = (*fastReflection_Request)(nil)
+var _ protoreflect.Message = (*fastReflection_OracleRequestPacketData)(nil)
 
-type fastReflection_Request Request
+type fastReflection_OracleRequestPacketData OracleRequestPacketData
 
-func (x *Request) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Request)(x)
+func (x *OracleRequestPacketData) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OracleRequestPacketData)(x)
 }
 
-func (x *Request) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[4]
+func (x *OracleRequestPacketData) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2808,43 +8268,43 @@ func (x *Request) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Request_messageType fastReflection_Request_messageType
-var _ protoreflect.MessageType = fastReflection_Request_messageType{}
+var _fastReflection_OracleRequestPacketData_messageType fastReflection_OracleRequestPacketData_messageType
+var _ protoreflect.MessageType = fastReflection_OracleRequestPacketData_messageType{}
 
-type fastReflection_Request_messageType struct{}
+type fastReflection_OracleRequestPacketData_messageType struct{}
 
-func (x fastReflection_Request_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Request)(nil)
+func (x fastReflection_OracleRequestPacketData_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OracleRequestPacketData)(nil)
 }
-func (x fastReflection_Request_messageType) New() protoreflect.Message {
-	return new(fastReflection_Request)
+func (x fastReflection_OracleRequestPacketData_messageType) New() protoreflect.Message {
+	return new(fastReflection_OracleRequestPacketData)
 }
-func (x fastReflection_Request_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Request
+func (x fastReflection_OracleRequestPacketData_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleRequestPacketData
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Request) Descriptor() protoreflect.MessageDescriptor {
-	return md_Request
+func (x *fastReflection_OracleRequestPacketData) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleRequestPacketData
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Request) Type() protoreflect.MessageType {
-	return _fastReflection_Request_messageType
+func (x *fastReflection_OracleRequestPacketData) Type() protoreflect.MessageType {
+	return _fastReflection_OracleRequestPacketData_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Request) New() protoreflect.Message {
-	return new(fastReflection_Request)
+func (x *fastReflection_OracleRequestPacketData) New() protoreflect.Message {
+	return new(fastReflection_OracleRequestPacketData)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Request) Interface() protoreflect.ProtoMessage {
-	return (*Request)(x)
+func (x *fastReflection_OracleRequestPacketData) Interface() protoreflect.ProtoMessage {
+	return (*OracleRequestPacketData)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2852,82 +8312,58 @@ func (x *fastReflection_Request) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Request) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_OracleRequestPacketData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClientId != "" {
+		value := protoreflect.ValueOfString(x.ClientId)
+		if !f(fd_OracleRequestPacketData_client_id, value) {
+			return
+		}
+	}
 	if x.OracleScriptId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.OracleScriptId)
-		if !f(fd_Request_oracle_script_id, value) {
+		if !f(fd_OracleRequestPacketData_oracle_script_id, value) {
 			return
 		}
 	}
 	if len(x.Calldata) != 0 {
 		value := protoreflect.ValueOfBytes(x.Calldata)
-		if !f(fd_Request_calldata, value) {
+		if !f(fd_OracleRequestPacketData_calldata, value) {
 			return
 		}
 	}
-	if len(x.RequestedValidators) != 0 {
-		value := protoreflect.ValueOfList(&_Request_3_list{list: &x.RequestedValidators})
-		if !f(fd_Request_requested_validators, value) {
+	if x.AskCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AskCount)
+		if !f(fd_OracleRequestPacketData_ask_count, value) {
 			return
 		}
 	}
 	if x.MinCount != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.MinCount)
-		if !f(fd_Request_min_count, value) {
-			return
-		}
-	}
-	if x.RequestHeight != int64(0) {
-		value := protoreflect.ValueOfInt64(x.RequestHeight)
-		if !f(fd_Request_request_height, value) {
-			return
-		}
-	}
-	if x.RequestTime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.RequestTime)
-		if !f(fd_Request_request_time, value) {
-			return
-		}
-	}
-	if x.ClientId != "" {
-		value := protoreflect.ValueOfString(x.ClientId)
-		if !f(fd_Request_client_id, value) {
+		if !f(fd_OracleRequestPacketData_min_count, value) {
 			return
 		}
 	}
-	if len(x.RawRequests) != 0 {
-		value := protoreflect.ValueOfList(&_Request_8_list{list: &x.RawRequests})
-		if !f(fd_Request_raw_requests, value) {
+	if len(x.FeeLimit) != 0 {
+		value := protoreflect.ValueOfList(&_OracleRequestPacketData_6_list{list: &x.FeeLimit})
+		if !f(fd_OracleRequestPacketData_fee_limit, value) {
 			return
 		}
 	}
-	if x.IbcChannel != nil {
-		value := protoreflect.ValueOfMessage(x.IbcChannel.ProtoReflect())
-		if !f(fd_Request_ibc_channel, value) {
+	if x.PrepareGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PrepareGas)
+		if !f(fd_OracleRequestPacketData_prepare_gas, value) {
 			return
 		}
 	}
 	if x.ExecuteGas != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.ExecuteGas)
-		if !f(fd_Request_execute_gas, value) {
+		if !f(fd_OracleRequestPacketData_execute_gas, value) {
 			return
 		}
 	}
 	if x.TssEncoder != 0 {
 		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TssEncoder))
-		if !f(fd_Request_tss_encoder, value) {
-			return
-		}
-	}
-	if x.Requester != "" {
-		value := protoreflect.ValueOfString(x.Requester)
-		if !f(fd_Request_requester, value) {
-			return
-		}
-	}
-	if len(x.FeeLimit) != 0 {
-		value := protoreflect.ValueOfList(&_Request_13_list{list: &x.FeeLimit})
-		if !f(fd_Request_fee_limit, value) {
+		if !f(fd_OracleRequestPacketData_tss_encoder, value) {
 			return
 		}
 	}
@@ -2944,39 +8380,31 @@ func (x *fastReflection_Request) Range(f func(protoreflect.FieldDescriptor, prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Request) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_OracleRequestPacketData) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.Request.oracle_script_id":
+	case "band.oracle.v1.OracleRequestPacketData.client_id":
+		return x.ClientId != ""
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
 		return x.OracleScriptId != uint64(0)
-	case "band.oracle.v1.Request.calldata":
+	case "band.oracle.v1.OracleRequestPacketData.calldata":
 		return len(x.Calldata) != 0
-	case "band.oracle.v1.Request.requested_validators":
-		return len(x.RequestedValidators) != 0
-	case "band.oracle.v1.Request.min_count":
+	case "band.oracle.v1.OracleRequestPacketData.ask_count":
+		return x.AskCount != uint64(0)
+	case "band.oracle.v1.OracleRequestPacketData.min_count":
 		return x.MinCount != uint64(0)
-	case "band.oracle.v1.Request.request_height":
-		return x.RequestHeight != int64(0)
-	case "band.oracle.v1.Request.request_time":
-		return x.RequestTime != int64(0)
-	case "band.oracle.v1.Request.client_id":
-		return x.ClientId != ""
-	case "band.oracle.v1.Request.raw_requests":
-		return len(x.RawRequests) != 0
-	case "band.oracle.v1.Request.ibc_channel":
-		return x.IbcChannel != nil
-	case "band.oracle.v1.Request.execute_gas":
+	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
+		return len(x.FeeLimit) != 0
+	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
+		return x.PrepareGas != uint64(0)
+	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
 		return x.ExecuteGas != uint64(0)
-	case "band.oracle.v1.Request.tss_encoder":
+	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
 		return x.TssEncoder != 0
-	case "band.oracle.v1.Request.requester":
-		return x.Requester != ""
-	case "band.oracle.v1.Request.fee_limit":
-		return len(x.FeeLimit) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2986,39 +8414,31 @@ func (x *fastReflection_Request) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Request) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_OracleRequestPacketData) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Request.oracle_script_id":
+	case "band.oracle.v1.OracleRequestPacketData.client_id":
+		x.ClientId = ""
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
 		x.OracleScriptId = uint64(0)
-	case "band.oracle.v1.Request.calldata":
+	case "band.oracle.v1.OracleRequestPacketData.calldata":
 		x.Calldata = nil
-	case "band.oracle.v1.Request.requested_validators":
-		x.RequestedValidators = nil
-	case "band.oracle.v1.Request.min_count":
+	case "band.oracle.v1.OracleRequestPacketData.ask_count":
+		x.AskCount = uint64(0)
+	case "band.oracle.v1.OracleRequestPacketData.min_count":
 		x.MinCount = uint64(0)
-	case "band.oracle.v1.Request.request_height":
-		x.RequestHeight = int64(0)
-	case "band.oracle.v1.Request.request_time":
-		x.RequestTime = int64(0)
-	case "band.oracle.v1.Request.client_id":
-		x.ClientId = ""
-	case "band.oracle.v1.Request.raw_requests":
-		x.RawRequests = nil
-	case "band.oracle.v1.Request.ibc_channel":
-		x.IbcChannel = nil
-	case "band.oracle.v1.Request.execute_gas":
+	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
+		x.FeeLimit = nil
+	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
+		x.PrepareGas = uint64(0)
+	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
 		x.ExecuteGas = uint64(0)
-	case "band.oracle.v1.Request.tss_encoder":
+	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
 		x.TssEncoder = 0
-	case "band.oracle.v1.Request.requester":
-		x.Requester = ""
-	case "band.oracle.v1.Request.fee_limit":
-		x.FeeLimit = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3027,62 +8447,44 @@ func (x *fastReflection_Request) Clear(fd protoreflect.FieldDescriptor) {
 // For unpopulated scalars, it returns the default value, where
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Request) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "band.oracle.v1.Request.oracle_script_id":
-		value := x.OracleScriptId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Request.calldata":
-		value := x.Calldata
-		return protoreflect.ValueOfBytes(value)
-	case "band.oracle.v1.Request.requested_validators":
-		if len(x.RequestedValidators) == 0 {
-			return protoreflect.ValueOfList(&_Request_3_list{})
-		}
-		listValue := &_Request_3_list{list: &x.RequestedValidators}
-		return protoreflect.ValueOfList(listValue)
-	case "band.oracle.v1.Request.min_count":
-		value := x.MinCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Request.request_height":
-		value := x.RequestHeight
-		return protoreflect.ValueOfInt64(value)
-	case "band.oracle.v1.Request.request_time":
-		value := x.RequestTime
-		return protoreflect.ValueOfInt64(value)
-	case "band.oracle.v1.Request.client_id":
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OracleRequestPacketData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.OracleRequestPacketData.client_id":
 		value := x.ClientId
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.Request.raw_requests":
-		if len(x.RawRequests) == 0 {
-			return protoreflect.ValueOfList(&_Request_8_list{})
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleRequestPacketData.calldata":
+		value := x.Calldata
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.OracleRequestPacketData.ask_count":
+		value := x.AskCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleRequestPacketData.min_count":
+		value := x.MinCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
+		if len(x.FeeLimit) == 0 {
+			return protoreflect.ValueOfList(&_OracleRequestPacketData_6_list{})
 		}
-		listValue := &_Request_8_list{list: &x.RawRequests}
+		listValue := &_OracleRequestPacketData_6_list{list: &x.FeeLimit}
 		return protoreflect.ValueOfList(listValue)
-	case "band.oracle.v1.Request.ibc_channel":
-		value := x.IbcChannel
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.oracle.v1.Request.execute_gas":
+	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
+		value := x.PrepareGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
 		value := x.ExecuteGas
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Request.tss_encoder":
+	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
 		value := x.TssEncoder
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "band.oracle.v1.Request.requester":
-		value := x.Requester
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.Request.fee_limit":
-		if len(x.FeeLimit) == 0 {
-			return protoreflect.ValueOfList(&_Request_13_list{})
-		}
-		listValue := &_Request_13_list{list: &x.FeeLimit}
-		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3096,45 +8498,33 @@ func (x *fastReflection_Request) Get(descriptor protoreflect.FieldDescriptor) pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Request) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_OracleRequestPacketData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Request.oracle_script_id":
+	case "band.oracle.v1.OracleRequestPacketData.client_id":
+		x.ClientId = value.Interface().(string)
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
 		x.OracleScriptId = value.Uint()
-	case "band.oracle.v1.Request.calldata":
+	case "band.oracle.v1.OracleRequestPacketData.calldata":
 		x.Calldata = value.Bytes()
-	case "band.oracle.v1.Request.requested_validators":
-		lv := value.List()
-		clv := lv.(*_Request_3_list)
-		x.RequestedValidators = *clv.list
-	case "band.oracle.v1.Request.min_count":
+	case "band.oracle.v1.OracleRequestPacketData.ask_count":
+		x.AskCount = value.Uint()
+	case "band.oracle.v1.OracleRequestPacketData.min_count":
 		x.MinCount = value.Uint()
-	case "band.oracle.v1.Request.request_height":
-		x.RequestHeight = value.Int()
-	case "band.oracle.v1.Request.request_time":
-		x.RequestTime = value.Int()
-	case "band.oracle.v1.Request.client_id":
-		x.ClientId = value.Interface().(string)
-	case "band.oracle.v1.Request.raw_requests":
+	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
 		lv := value.List()
-		clv := lv.(*_Request_8_list)
-		x.RawRequests = *clv.list
-	case "band.oracle.v1.Request.ibc_channel":
-		x.IbcChannel = value.Message().Interface().(*IBCChannel)
-	case "band.oracle.v1.Request.execute_gas":
+		clv := lv.(*_OracleRequestPacketData_6_list)
+		x.FeeLimit = *clv.list
+	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
+		x.PrepareGas = value.Uint()
+	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
 		x.ExecuteGas = value.Uint()
-	case "band.oracle.v1.Request.tss_encoder":
+	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
 		x.TssEncoder = (Encoder)(value.Enum())
-	case "band.oracle.v1.Request.requester":
-		x.Requester = value.Interface().(string)
-	case "band.oracle.v1.Request.fee_limit":
-		lv := value.List()
-		clv := lv.(*_Request_13_list)
-		x.FeeLimit = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3148,107 +8538,77 @@ func (x *fastReflection_Request) Set(fd protoreflect.FieldDescriptor, value prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Request) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleRequestPacketData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Request.requested_validators":
-		if x.RequestedValidators == nil {
-			x.RequestedValidators = []string{}
-		}
-		value := &_Request_3_list{list: &x.RequestedValidators}
-		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.Request.raw_requests":
-		if x.RawRequests == nil {
-			x.RawRequests = []*RawRequest{}
-		}
-		value := &_Request_8_list{list: &x.RawRequests}
-		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.Request.ibc_channel":
-		if x.IbcChannel == nil {
-			x.IbcChannel = new(IBCChannel)
-		}
-		return protoreflect.ValueOfMessage(x.IbcChannel.ProtoReflect())
-	case "band.oracle.v1.Request.fee_limit":
+	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
 		if x.FeeLimit == nil {
 			x.FeeLimit = []*v1beta1.Coin{}
 		}
-		value := &_Request_13_list{list: &x.FeeLimit}
+		value := &_OracleRequestPacketData_6_list{list: &x.FeeLimit}
 		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.Request.oracle_script_id":
-		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.calldata":
-		panic(fmt.Errorf("field calldata of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.min_count":
-		panic(fmt.Errorf("field min_count of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.request_height":
-		panic(fmt.Errorf("field request_height of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.request_time":
-		panic(fmt.Errorf("field request_time of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.client_id":
-		panic(fmt.Errorf("field client_id of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.execute_gas":
-		panic(fmt.Errorf("field execute_gas of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.tss_encoder":
-		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.requester":
-		panic(fmt.Errorf("field requester of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.client_id":
+		panic(fmt.Errorf("field client_id of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.ask_count":
+		panic(fmt.Errorf("field ask_count of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
+		panic(fmt.Errorf("field prepare_gas of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
+		panic(fmt.Errorf("field execute_gas of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
+		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.OracleRequestPacketData is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Request) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleRequestPacketData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Request.oracle_script_id":
+	case "band.oracle.v1.OracleRequestPacketData.client_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Request.calldata":
+	case "band.oracle.v1.OracleRequestPacketData.calldata":
 		return protoreflect.ValueOfBytes(nil)
-	case "band.oracle.v1.Request.requested_validators":
-		list := []string{}
-		return protoreflect.ValueOfList(&_Request_3_list{list: &list})
-	case "band.oracle.v1.Request.min_count":
+	case "band.oracle.v1.OracleRequestPacketData.ask_count":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Request.request_height":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.oracle.v1.Request.request_time":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.oracle.v1.Request.client_id":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.Request.raw_requests":
-		list := []*RawRequest{}
-		return protoreflect.ValueOfList(&_Request_8_list{list: &list})
-	case "band.oracle.v1.Request.ibc_channel":
-		m := new(IBCChannel)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.oracle.v1.Request.execute_gas":
+	case "band.oracle.v1.OracleRequestPacketData.min_count":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Request.tss_encoder":
-		return protoreflect.ValueOfEnum(0)
-	case "band.oracle.v1.Request.requester":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.Request.fee_limit":
+	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
 		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Request_13_list{list: &list})
+		return protoreflect.ValueOfList(&_OracleRequestPacketData_6_list{list: &list})
+	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Request) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_OracleRequestPacketData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Request", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleRequestPacketData", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3256,7 +8616,7 @@ func (x *fastReflection_Request) WhichOneof(d protoreflect.OneofDescriptor) prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Request) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_OracleRequestPacketData) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3267,7 +8627,7 @@ func (x *fastReflection_Request) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Request) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_OracleRequestPacketData) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3279,7 +8639,7 @@ func (x *fastReflection_Request) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Request) IsValid() bool {
+func (x *fastReflection_OracleRequestPacketData) IsValid() bool {
 	return x != nil
 }
 
@@ -3289,9 +8649,9 @@ func (x *fastReflection_Request) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Request)
+		x := input.Message.Interface().(*OracleRequestPacketData)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3303,51 +8663,22 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.OracleScriptId != 0 {
-			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
-		}
-		l = len(x.Calldata)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.RequestedValidators) > 0 {
-			for _, s := range x.RequestedValidators {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.MinCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.MinCount))
-		}
-		if x.RequestHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestHeight))
-		}
-		if x.RequestTime != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestTime))
-		}
 		l = len(x.ClientId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.RawRequests) > 0 {
-			for _, e := range x.RawRequests {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
 		}
-		if x.IbcChannel != nil {
-			l = options.Size(x.IbcChannel)
+		l = len(x.Calldata)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ExecuteGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExecuteGas))
-		}
-		if x.TssEncoder != 0 {
-			n += 1 + runtime.Sov(uint64(x.TssEncoder))
+		if x.AskCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AskCount))
 		}
-		l = len(x.Requester)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.MinCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinCount))
 		}
 		if len(x.FeeLimit) > 0 {
 			for _, e := range x.FeeLimit {
@@ -3355,6 +8686,15 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.PrepareGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrepareGas))
+		}
+		if x.ExecuteGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecuteGas))
+		}
+		if x.TssEncoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.TssEncoder))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3365,7 +8705,7 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Request)
+		x := input.Message.Interface().(*OracleRequestPacketData)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3384,56 +8724,24 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.FeeLimit) > 0 {
-			for iNdEx := len(x.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.FeeLimit[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x6a
-			}
-		}
-		if len(x.Requester) > 0 {
-			i -= len(x.Requester)
-			copy(dAtA[i:], x.Requester)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Requester)))
-			i--
-			dAtA[i] = 0x62
-		}
 		if x.TssEncoder != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.TssEncoder))
 			i--
-			dAtA[i] = 0x58
+			dAtA[i] = 0x48
 		}
 		if x.ExecuteGas != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGas))
 			i--
-			dAtA[i] = 0x50
+			dAtA[i] = 0x40
 		}
-		if x.IbcChannel != nil {
-			encoded, err := options.Marshal(x.IbcChannel)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.PrepareGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrepareGas))
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x38
 		}
-		if len(x.RawRequests) > 0 {
-			for iNdEx := len(x.RawRequests) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.RawRequests[iNdEx])
+		if len(x.FeeLimit) > 0 {
+			for iNdEx := len(x.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeLimit[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3444,51 +8752,37 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x42
+				dAtA[i] = 0x32
 			}
 		}
-		if len(x.ClientId) > 0 {
-			i -= len(x.ClientId)
-			copy(dAtA[i:], x.ClientId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if x.RequestTime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestTime))
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.RequestHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestHeight))
+		if x.MinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
 			i--
 			dAtA[i] = 0x28
 		}
-		if x.MinCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+		if x.AskCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
 			i--
 			dAtA[i] = 0x20
 		}
-		if len(x.RequestedValidators) > 0 {
-			for iNdEx := len(x.RequestedValidators) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.RequestedValidators[iNdEx])
-				copy(dAtA[i:], x.RequestedValidators[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequestedValidators[iNdEx])))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
 		if len(x.Calldata) > 0 {
 			i -= len(x.Calldata)
 			copy(dAtA[i:], x.Calldata)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 		if x.OracleScriptId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0x10
+		}
+		if len(x.ClientId) > 0 {
+			i -= len(x.ClientId)
+			copy(dAtA[i:], x.ClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3501,7 +8795,7 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Request)
+		x := input.Message.Interface().(*OracleRequestPacketData)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3533,155 +8827,13 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Request: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketData: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
-				}
-				x.OracleScriptId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OracleScriptId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
-				if x.Calldata == nil {
-					x.Calldata = []byte{}
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestedValidators", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.RequestedValidators = append(x.RequestedValidators, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
-				}
-				x.MinCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MinCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
-				}
-				x.RequestHeight = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestHeight |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
-				}
-				x.RequestTime = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestTime |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 7:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 				}
@@ -3713,11 +8865,11 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 				}
 				x.ClientId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawRequests", wireType)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
 				}
-				var msglen int
+				x.OracleScriptId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3727,31 +8879,16 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.OracleScriptId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.RawRequests = append(x.RawRequests, &RawRequest{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RawRequests[len(x.RawRequests)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 9:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcChannel", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3761,33 +8898,31 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.IbcChannel == nil {
-					x.IbcChannel = &IBCChannel{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IbcChannel); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Calldata == nil {
+					x.Calldata = []byte{}
 				}
 				iNdEx = postIndex
-			case 10:
+			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
 				}
-				x.ExecuteGas = 0
+				x.AskCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3797,16 +8932,16 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ExecuteGas |= uint64(b&0x7F) << shift
+					x.AskCount |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 11:
+			case 5:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssEncoder", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
 				}
-				x.TssEncoder = 0
+				x.MinCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3816,16 +8951,16 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.TssEncoder |= Encoder(b&0x7F) << shift
+					x.MinCount |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 12:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3835,29 +8970,31 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Requester = string(dAtA[iNdEx:postIndex])
+				x.FeeLimit = append(x.FeeLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeLimit[len(x.FeeLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 13:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
 				}
-				var msglen int
+				x.PrepareGas = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3867,26 +9004,49 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.PrepareGas |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.ExecuteGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecuteGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssEncoder", wireType)
 				}
-				x.FeeLimit = append(x.FeeLimit, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeLimit[len(x.FeeLimit)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.TssEncoder = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TssEncoder |= Encoder(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3922,82 +9082,27 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Report_3_list)(nil)
-
-type _Report_3_list struct {
-	list *[]*RawReport
-}
-
-func (x *_Report_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Report_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_Report_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*RawReport)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Report_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*RawReport)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Report_3_list) AppendMutable() protoreflect.Value {
-	v := new(RawReport)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Report_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Report_3_list) NewElement() protoreflect.Value {
-	v := new(RawReport)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Report_3_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_Report                   protoreflect.MessageDescriptor
-	fd_Report_validator         protoreflect.FieldDescriptor
-	fd_Report_in_before_resolve protoreflect.FieldDescriptor
-	fd_Report_raw_reports       protoreflect.FieldDescriptor
+	md_OracleRequestPacketAcknowledgement            protoreflect.MessageDescriptor
+	fd_OracleRequestPacketAcknowledgement_request_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_Report = File_band_oracle_v1_oracle_proto.Messages().ByName("Report")
-	fd_Report_validator = md_Report.Fields().ByName("validator")
-	fd_Report_in_before_resolve = md_Report.Fields().ByName("in_before_resolve")
-	fd_Report_raw_reports = md_Report.Fields().ByName("raw_reports")
+	md_OracleRequestPacketAcknowledgement = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleRequestPacketAcknowledgement")
+	fd_OracleRequestPacketAcknowledgement_request_id = md_OracleRequestPacketAcknowledgement.Fields().ByName("request_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_Report)(nil)
+var _ protoreflect.Message = (*fastReflection_OracleRequestPacketAcknowledgement)(nil)
 
-type fastReflection_Report Report
+type fastReflection_OracleRequestPacketAcknowledgement OracleRequestPacketAcknowledgement
 
-func (x *Report) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Report)(x)
+func (x *OracleRequestPacketAcknowledgement) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OracleRequestPacketAcknowledgement)(x)
 }
 
-func (x *Report) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
+func (x *OracleRequestPacketAcknowledgement) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4008,66 +9113,54 @@ func (x *Report) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Report_messageType fastReflection_Report_messageType
-var _ protoreflect.MessageType = fastReflection_Report_messageType{}
+var _fastReflection_OracleRequestPacketAcknowledgement_messageType fastReflection_OracleRequestPacketAcknowledgement_messageType
+var _ protoreflect.MessageType = fastReflection_OracleRequestPacketAcknowledgement_messageType{}
 
-type fastReflection_Report_messageType struct{}
+type fastReflection_OracleRequestPacketAcknowledgement_messageType struct{}
 
-func (x fastReflection_Report_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Report)(nil)
+func (x fastReflection_OracleRequestPacketAcknowledgement_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OracleRequestPacketAcknowledgement)(nil)
 }
-func (x fastReflection_Report_messageType) New() protoreflect.Message {
-	return new(fastReflection_Report)
+func (x fastReflection_OracleRequestPacketAcknowledgement_messageType) New() protoreflect.Message {
+	return new(fastReflection_OracleRequestPacketAcknowledgement)
 }
-func (x fastReflection_Report_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Report
+func (x fastReflection_OracleRequestPacketAcknowledgement_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleRequestPacketAcknowledgement
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Report) Descriptor() protoreflect.MessageDescriptor {
-	return md_Report
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleRequestPacketAcknowledgement
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Report) Type() protoreflect.MessageType {
-	return _fastReflection_Report_messageType
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Type() protoreflect.MessageType {
+	return _fastReflection_OracleRequestPacketAcknowledgement_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Report) New() protoreflect.Message {
-	return new(fastReflection_Report)
+func (x *fastReflection_OracleRequestPacketAcknowledgement) New() protoreflect.Message {
+	return new(fastReflection_OracleRequestPacketAcknowledgement)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Report) Interface() protoreflect.ProtoMessage {
-	return (*Report)(x)
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Interface() protoreflect.ProtoMessage {
+	return (*OracleRequestPacketAcknowledgement)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
 // calling f for each field descriptor and value encountered.
 // Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_Report) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Validator != "" {
-		value := protoreflect.ValueOfString(x.Validator)
-		if !f(fd_Report_validator, value) {
-			return
-		}
-	}
-	if x.InBeforeResolve != false {
-		value := protoreflect.ValueOfBool(x.InBeforeResolve)
-		if !f(fd_Report_in_before_resolve, value) {
-			return
-		}
-	}
-	if len(x.RawReports) != 0 {
-		value := protoreflect.ValueOfList(&_Report_3_list{list: &x.RawReports})
-		if !f(fd_Report_raw_reports, value) {
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_OracleRequestPacketAcknowledgement_request_id, value) {
 			return
 		}
 	}
@@ -4084,19 +9177,15 @@ func (x *fastReflection_Report) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Report) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.Report.validator":
-		return x.Validator != ""
-	case "band.oracle.v1.Report.in_before_resolve":
-		return x.InBeforeResolve != false
-	case "band.oracle.v1.Report.raw_reports":
-		return len(x.RawReports) != 0
+	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+		return x.RequestId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4106,19 +9195,15 @@ func (x *fastReflection_Report) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Report) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Report.validator":
-		x.Validator = ""
-	case "band.oracle.v1.Report.in_before_resolve":
-		x.InBeforeResolve = false
-	case "band.oracle.v1.Report.raw_reports":
-		x.RawReports = nil
+	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+		x.RequestId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4128,25 +9213,16 @@ func (x *fastReflection_Report) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Report) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.Report.validator":
-		value := x.Validator
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.Report.in_before_resolve":
-		value := x.InBeforeResolve
-		return protoreflect.ValueOfBool(value)
-	case "band.oracle.v1.Report.raw_reports":
-		if len(x.RawReports) == 0 {
-			return protoreflect.ValueOfList(&_Report_3_list{})
-		}
-		listValue := &_Report_3_list{list: &x.RawReports}
-		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4160,21 +9236,15 @@ func (x *fastReflection_Report) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Report) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Report.validator":
-		x.Validator = value.Interface().(string)
-	case "band.oracle.v1.Report.in_before_resolve":
-		x.InBeforeResolve = value.Bool()
-	case "band.oracle.v1.Report.raw_reports":
-		lv := value.List()
-		clv := lv.(*_Report_3_list)
-		x.RawReports = *clv.list
+	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+		x.RequestId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4188,53 +9258,40 @@ func (x *fastReflection_Report) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Report) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Report.raw_reports":
-		if x.RawReports == nil {
-			x.RawReports = []*RawReport{}
-		}
-		value := &_Report_3_list{list: &x.RawReports}
-		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.Report.validator":
-		panic(fmt.Errorf("field validator of message band.oracle.v1.Report is not mutable"))
-	case "band.oracle.v1.Report.in_before_resolve":
-		panic(fmt.Errorf("field in_before_resolve of message band.oracle.v1.Report is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.OracleRequestPacketAcknowledgement is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Report) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Report.validator":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.Report.in_before_resolve":
-		return protoreflect.ValueOfBool(false)
-	case "band.oracle.v1.Report.raw_reports":
-		list := []*RawReport{}
-		return protoreflect.ValueOfList(&_Report_3_list{list: &list})
+	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Report) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Report", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleRequestPacketAcknowledgement", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4242,7 +9299,7 @@ func (x *fastReflection_Report) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Report) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4253,7 +9310,7 @@ func (x *fastReflection_Report) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Report) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4265,7 +9322,7 @@ func (x *fastReflection_Report) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Report) IsValid() bool {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) IsValid() bool {
 	return x != nil
 }
 
@@ -4275,9 +9332,9 @@ func (x *fastReflection_Report) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Report)
+		x := input.Message.Interface().(*OracleRequestPacketAcknowledgement)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4289,18 +9346,8 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Validator)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.InBeforeResolve {
-			n += 2
-		}
-		if len(x.RawReports) > 0 {
-			for _, e := range x.RawReports {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4312,7 +9359,7 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Report)
+		x := input.Message.Interface().(*OracleRequestPacketAcknowledgement)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4331,38 +9378,10 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.RawReports) > 0 {
-			for iNdEx := len(x.RawReports) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.RawReports[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if x.InBeforeResolve {
-			i--
-			if x.InBeforeResolve {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.Validator) > 0 {
-			i -= len(x.Validator)
-			copy(dAtA[i:], x.Validator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4375,7 +9394,7 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Report)
+		x := input.Message.Interface().(*OracleRequestPacketAcknowledgement)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4407,98 +9426,31 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Report: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketAcknowledgement: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Validator = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InBeforeResolve", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.InBeforeResolve = bool(v != 0)
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 				}
-				var msglen int
+				x.RequestId = 0
 				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.RawReports = append(x.RawReports, &RawReport{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RawReports[len(x.RawReports)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4534,94 +9486,39 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_OracleRequestPacketData_6_list)(nil)
-
-type _OracleRequestPacketData_6_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_OracleRequestPacketData_6_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_OracleRequestPacketData_6_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_OracleRequestPacketData_6_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_OracleRequestPacketData_6_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_OracleRequestPacketData_6_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_OracleRequestPacketData_6_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_OracleRequestPacketData_6_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_OracleRequestPacketData_6_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_OracleRequestPacketData                  protoreflect.MessageDescriptor
-	fd_OracleRequestPacketData_client_id        protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_oracle_script_id protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_calldata         protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_ask_count        protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_min_count        protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_fee_limit        protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_prepare_gas      protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_execute_gas      protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_tss_encoder      protoreflect.FieldDescriptor
+	md_OracleResponsePacketData                protoreflect.MessageDescriptor
+	fd_OracleResponsePacketData_client_id      protoreflect.FieldDescriptor
+	fd_OracleResponsePacketData_request_id     protoreflect.FieldDescriptor
+	fd_OracleResponsePacketData_ans_count      protoreflect.FieldDescriptor
+	fd_OracleResponsePacketData_request_time   protoreflect.FieldDescriptor
+	fd_OracleResponsePacketData_resolve_time   protoreflect.FieldDescriptor
+	fd_OracleResponsePacketData_resolve_status protoreflect.FieldDescriptor
+	fd_OracleResponsePacketData_result         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_OracleRequestPacketData = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleRequestPacketData")
-	fd_OracleRequestPacketData_client_id = md_OracleRequestPacketData.Fields().ByName("client_id")
-	fd_OracleRequestPacketData_oracle_script_id = md_OracleRequestPacketData.Fields().ByName("oracle_script_id")
-	fd_OracleRequestPacketData_calldata = md_OracleRequestPacketData.Fields().ByName("calldata")
-	fd_OracleRequestPacketData_ask_count = md_OracleRequestPacketData.Fields().ByName("ask_count")
-	fd_OracleRequestPacketData_min_count = md_OracleRequestPacketData.Fields().ByName("min_count")
-	fd_OracleRequestPacketData_fee_limit = md_OracleRequestPacketData.Fields().ByName("fee_limit")
-	fd_OracleRequestPacketData_prepare_gas = md_OracleRequestPacketData.Fields().ByName("prepare_gas")
-	fd_OracleRequestPacketData_execute_gas = md_OracleRequestPacketData.Fields().ByName("execute_gas")
-	fd_OracleRequestPacketData_tss_encoder = md_OracleRequestPacketData.Fields().ByName("tss_encoder")
+	md_OracleResponsePacketData = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleResponsePacketData")
+	fd_OracleResponsePacketData_client_id = md_OracleResponsePacketData.Fields().ByName("client_id")
+	fd_OracleResponsePacketData_request_id = md_OracleResponsePacketData.Fields().ByName("request_id")
+	fd_OracleResponsePacketData_ans_count = md_OracleResponsePacketData.Fields().ByName("ans_count")
+	fd_OracleResponsePacketData_request_time = md_OracleResponsePacketData.Fields().ByName("request_time")
+	fd_OracleResponsePacketData_resolve_time = md_OracleResponsePacketData.Fields().ByName("resolve_time")
+	fd_OracleResponsePacketData_resolve_status = md_OracleResponsePacketData.Fields().ByName("resolve_status")
+	fd_OracleResponsePacketData_result = md_OracleResponsePacketData.Fields().ByName("result")
 }
 
-var _ protoreflect.Message = (*fastReflection_OracleRequestPacketData)(nil)
+var _ protoreflect.Message = (*fastReflection_OracleResponsePacketData)(nil)
 
-type fastReflection_OracleRequestPacketData OracleRequestPacketData
+type fastReflection_OracleResponsePacketData OracleResponsePacketData
 
-func (x *OracleRequestPacketData) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_OracleRequestPacketData)(x)
+func (x *OracleResponsePacketData) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OracleResponsePacketData)(x)
 }
 
-func (x *OracleRequestPacketData) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[6]
+func (x *OracleResponsePacketData) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4632,43 +9529,43 @@ func (x *OracleRequestPacketData) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_OracleRequestPacketData_messageType fastReflection_OracleRequestPacketData_messageType
-var _ protoreflect.MessageType = fastReflection_OracleRequestPacketData_messageType{}
+var _fastReflection_OracleResponsePacketData_messageType fastReflection_OracleResponsePacketData_messageType
+var _ protoreflect.MessageType = fastReflection_OracleResponsePacketData_messageType{}
 
-type fastReflection_OracleRequestPacketData_messageType struct{}
+type fastReflection_OracleResponsePacketData_messageType struct{}
 
-func (x fastReflection_OracleRequestPacketData_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_OracleRequestPacketData)(nil)
+func (x fastReflection_OracleResponsePacketData_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OracleResponsePacketData)(nil)
 }
-func (x fastReflection_OracleRequestPacketData_messageType) New() protoreflect.Message {
-	return new(fastReflection_OracleRequestPacketData)
+func (x fastReflection_OracleResponsePacketData_messageType) New() protoreflect.Message {
+	return new(fastReflection_OracleResponsePacketData)
 }
-func (x fastReflection_OracleRequestPacketData_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleRequestPacketData
+func (x fastReflection_OracleResponsePacketData_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleResponsePacketData
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_OracleRequestPacketData) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleRequestPacketData
+func (x *fastReflection_OracleResponsePacketData) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleResponsePacketData
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_OracleRequestPacketData) Type() protoreflect.MessageType {
-	return _fastReflection_OracleRequestPacketData_messageType
+func (x *fastReflection_OracleResponsePacketData) Type() protoreflect.MessageType {
+	return _fastReflection_OracleResponsePacketData_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_OracleRequestPacketData) New() protoreflect.Message {
-	return new(fastReflection_OracleRequestPacketData)
+func (x *fastReflection_OracleResponsePacketData) New() protoreflect.Message {
+	return new(fastReflection_OracleResponsePacketData)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_OracleRequestPacketData) Interface() protoreflect.ProtoMessage {
-	return (*OracleRequestPacketData)(x)
+func (x *fastReflection_OracleResponsePacketData) Interface() protoreflect.ProtoMessage {
+	return (*OracleResponsePacketData)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4676,58 +9573,46 @@ func (x *fastReflection_OracleRequestPacketData) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_OracleRequestPacketData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_OracleResponsePacketData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ClientId != "" {
 		value := protoreflect.ValueOfString(x.ClientId)
-		if !f(fd_OracleRequestPacketData_client_id, value) {
-			return
-		}
-	}
-	if x.OracleScriptId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OracleScriptId)
-		if !f(fd_OracleRequestPacketData_oracle_script_id, value) {
-			return
-		}
-	}
-	if len(x.Calldata) != 0 {
-		value := protoreflect.ValueOfBytes(x.Calldata)
-		if !f(fd_OracleRequestPacketData_calldata, value) {
+		if !f(fd_OracleResponsePacketData_client_id, value) {
 			return
 		}
 	}
-	if x.AskCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.AskCount)
-		if !f(fd_OracleRequestPacketData_ask_count, value) {
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_OracleResponsePacketData_request_id, value) {
 			return
 		}
 	}
-	if x.MinCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MinCount)
-		if !f(fd_OracleRequestPacketData_min_count, value) {
+	if x.AnsCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AnsCount)
+		if !f(fd_OracleResponsePacketData_ans_count, value) {
 			return
 		}
 	}
-	if len(x.FeeLimit) != 0 {
-		value := protoreflect.ValueOfList(&_OracleRequestPacketData_6_list{list: &x.FeeLimit})
-		if !f(fd_OracleRequestPacketData_fee_limit, value) {
+	if x.RequestTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RequestTime)
+		if !f(fd_OracleResponsePacketData_request_time, value) {
 			return
 		}
 	}
-	if x.PrepareGas != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.PrepareGas)
-		if !f(fd_OracleRequestPacketData_prepare_gas, value) {
+	if x.ResolveTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ResolveTime)
+		if !f(fd_OracleResponsePacketData_resolve_time, value) {
 			return
 		}
 	}
-	if x.ExecuteGas != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExecuteGas)
-		if !f(fd_OracleRequestPacketData_execute_gas, value) {
+	if x.ResolveStatus != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ResolveStatus))
+		if !f(fd_OracleResponsePacketData_resolve_status, value) {
 			return
 		}
 	}
-	if x.TssEncoder != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TssEncoder))
-		if !f(fd_OracleRequestPacketData_tss_encoder, value) {
+	if len(x.Result) != 0 {
+		value := protoreflect.ValueOfBytes(x.Result)
+		if !f(fd_OracleResponsePacketData_result, value) {
 			return
 		}
 	}
@@ -4744,31 +9629,27 @@ func (x *fastReflection_OracleRequestPacketData) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_OracleRequestPacketData) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_OracleResponsePacketData) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketData.client_id":
+	case "band.oracle.v1.OracleResponsePacketData.client_id":
 		return x.ClientId != ""
-	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
-		return x.OracleScriptId != uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.calldata":
-		return len(x.Calldata) != 0
-	case "band.oracle.v1.OracleRequestPacketData.ask_count":
-		return x.AskCount != uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.min_count":
-		return x.MinCount != uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
-		return len(x.FeeLimit) != 0
-	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
-		return x.PrepareGas != uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
-		return x.ExecuteGas != uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
-		return x.TssEncoder != 0
+	case "band.oracle.v1.OracleResponsePacketData.request_id":
+		return x.RequestId != uint64(0)
+	case "band.oracle.v1.OracleResponsePacketData.ans_count":
+		return x.AnsCount != uint64(0)
+	case "band.oracle.v1.OracleResponsePacketData.request_time":
+		return x.RequestTime != int64(0)
+	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
+		return x.ResolveTime != int64(0)
+	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
+		return x.ResolveStatus != 0
+	case "band.oracle.v1.OracleResponsePacketData.result":
+		return len(x.Result) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4778,31 +9659,27 @@ func (x *fastReflection_OracleRequestPacketData) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketData) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_OracleResponsePacketData) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketData.client_id":
+	case "band.oracle.v1.OracleResponsePacketData.client_id":
 		x.ClientId = ""
-	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
-		x.OracleScriptId = uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.calldata":
-		x.Calldata = nil
-	case "band.oracle.v1.OracleRequestPacketData.ask_count":
-		x.AskCount = uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.min_count":
-		x.MinCount = uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
-		x.FeeLimit = nil
-	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
-		x.PrepareGas = uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
-		x.ExecuteGas = uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
-		x.TssEncoder = 0
+	case "band.oracle.v1.OracleResponsePacketData.request_id":
+		x.RequestId = uint64(0)
+	case "band.oracle.v1.OracleResponsePacketData.ans_count":
+		x.AnsCount = uint64(0)
+	case "band.oracle.v1.OracleResponsePacketData.request_time":
+		x.RequestTime = int64(0)
+	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
+		x.ResolveTime = int64(0)
+	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
+		x.ResolveStatus = 0
+	case "band.oracle.v1.OracleResponsePacketData.result":
+		x.Result = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4812,43 +9689,34 @@ func (x *fastReflection_OracleRequestPacketData) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_OracleRequestPacketData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleResponsePacketData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.OracleRequestPacketData.client_id":
+	case "band.oracle.v1.OracleResponsePacketData.client_id":
 		value := x.ClientId
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
-		value := x.OracleScriptId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleRequestPacketData.calldata":
-		value := x.Calldata
-		return protoreflect.ValueOfBytes(value)
-	case "band.oracle.v1.OracleRequestPacketData.ask_count":
-		value := x.AskCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleRequestPacketData.min_count":
-		value := x.MinCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
-		if len(x.FeeLimit) == 0 {
-			return protoreflect.ValueOfList(&_OracleRequestPacketData_6_list{})
-		}
-		listValue := &_OracleRequestPacketData_6_list{list: &x.FeeLimit}
-		return protoreflect.ValueOfList(listValue)
-	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
-		value := x.PrepareGas
+	case "band.oracle.v1.OracleResponsePacketData.request_id":
+		value := x.RequestId
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
-		value := x.ExecuteGas
+	case "band.oracle.v1.OracleResponsePacketData.ans_count":
+		value := x.AnsCount
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
-		value := x.TssEncoder
+	case "band.oracle.v1.OracleResponsePacketData.request_time":
+		value := x.RequestTime
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
+		value := x.ResolveTime
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
+		value := x.ResolveStatus
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.OracleResponsePacketData.result":
+		value := x.Result
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4862,33 +9730,27 @@ func (x *fastReflection_OracleRequestPacketData) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_OracleResponsePacketData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketData.client_id":
+	case "band.oracle.v1.OracleResponsePacketData.client_id":
 		x.ClientId = value.Interface().(string)
-	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
-		x.OracleScriptId = value.Uint()
-	case "band.oracle.v1.OracleRequestPacketData.calldata":
-		x.Calldata = value.Bytes()
-	case "band.oracle.v1.OracleRequestPacketData.ask_count":
-		x.AskCount = value.Uint()
-	case "band.oracle.v1.OracleRequestPacketData.min_count":
-		x.MinCount = value.Uint()
-	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
-		lv := value.List()
-		clv := lv.(*_OracleRequestPacketData_6_list)
-		x.FeeLimit = *clv.list
-	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
-		x.PrepareGas = value.Uint()
-	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
-		x.ExecuteGas = value.Uint()
-	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
-		x.TssEncoder = (Encoder)(value.Enum())
+	case "band.oracle.v1.OracleResponsePacketData.request_id":
+		x.RequestId = value.Uint()
+	case "band.oracle.v1.OracleResponsePacketData.ans_count":
+		x.AnsCount = value.Uint()
+	case "band.oracle.v1.OracleResponsePacketData.request_time":
+		x.RequestTime = value.Int()
+	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
+		x.ResolveTime = value.Int()
+	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
+		x.ResolveStatus = (ResolveStatus)(value.Enum())
+	case "band.oracle.v1.OracleResponsePacketData.result":
+		x.Result = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4902,77 +9764,64 @@ func (x *fastReflection_OracleRequestPacketData) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleResponsePacketData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
-		if x.FeeLimit == nil {
-			x.FeeLimit = []*v1beta1.Coin{}
-		}
-		value := &_OracleRequestPacketData_6_list{list: &x.FeeLimit}
-		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.OracleRequestPacketData.client_id":
-		panic(fmt.Errorf("field client_id of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
-		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.calldata":
-		panic(fmt.Errorf("field calldata of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.ask_count":
-		panic(fmt.Errorf("field ask_count of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.min_count":
-		panic(fmt.Errorf("field min_count of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
-		panic(fmt.Errorf("field prepare_gas of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
-		panic(fmt.Errorf("field execute_gas of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
-		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleResponsePacketData.client_id":
+		panic(fmt.Errorf("field client_id of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.OracleResponsePacketData.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.OracleResponsePacketData.ans_count":
+		panic(fmt.Errorf("field ans_count of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.OracleResponsePacketData.request_time":
+		panic(fmt.Errorf("field request_time of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
+		panic(fmt.Errorf("field resolve_time of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
+		panic(fmt.Errorf("field resolve_status of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.OracleResponsePacketData.result":
+		panic(fmt.Errorf("field result of message band.oracle.v1.OracleResponsePacketData is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_OracleRequestPacketData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleResponsePacketData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketData.client_id":
+	case "band.oracle.v1.OracleResponsePacketData.client_id":
 		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleRequestPacketData.calldata":
-		return protoreflect.ValueOfBytes(nil)
-	case "band.oracle.v1.OracleRequestPacketData.ask_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleRequestPacketData.min_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_OracleRequestPacketData_6_list{list: &list})
-	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
+	case "band.oracle.v1.OracleResponsePacketData.request_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
+	case "band.oracle.v1.OracleResponsePacketData.ans_count":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
+	case "band.oracle.v1.OracleResponsePacketData.request_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
 		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.OracleResponsePacketData.result":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_OracleRequestPacketData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_OracleResponsePacketData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleRequestPacketData", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleResponsePacketData", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4980,7 +9829,7 @@ func (x *fastReflection_OracleRequestPacketData) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_OracleRequestPacketData) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_OracleResponsePacketData) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4991,7 +9840,7 @@ func (x *fastReflection_OracleRequestPacketData) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketData) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_OracleResponsePacketData) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5003,7 +9852,7 @@ func (x *fastReflection_OracleRequestPacketData) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_OracleRequestPacketData) IsValid() bool {
+func (x *fastReflection_OracleResponsePacketData) IsValid() bool {
 	return x != nil
 }
 
@@ -5013,9 +9862,9 @@ func (x *fastReflection_OracleRequestPacketData) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*OracleRequestPacketData)
+		x := input.Message.Interface().(*OracleResponsePacketData)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5031,33 +9880,24 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.OracleScriptId != 0 {
-			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
-		}
-		l = len(x.Calldata)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.AskCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.AskCount))
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
 		}
-		if x.MinCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.MinCount))
+		if x.AnsCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AnsCount))
 		}
-		if len(x.FeeLimit) > 0 {
-			for _, e := range x.FeeLimit {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.RequestTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestTime))
 		}
-		if x.PrepareGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.PrepareGas))
+		if x.ResolveTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResolveTime))
 		}
-		if x.ExecuteGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExecuteGas))
+		if x.ResolveStatus != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResolveStatus))
 		}
-		if x.TssEncoder != 0 {
-			n += 1 + runtime.Sov(uint64(x.TssEncoder))
+		l = len(x.Result)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5069,7 +9909,7 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*OracleRequestPacketData)
+		x := input.Message.Interface().(*OracleResponsePacketData)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5088,56 +9928,35 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.TssEncoder != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TssEncoder))
-			i--
-			dAtA[i] = 0x48
-		}
-		if x.ExecuteGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGas))
-			i--
-			dAtA[i] = 0x40
-		}
-		if x.PrepareGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrepareGas))
+		if len(x.Result) > 0 {
+			i -= len(x.Result)
+			copy(dAtA[i:], x.Result)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
 			i--
-			dAtA[i] = 0x38
-		}
-		if len(x.FeeLimit) > 0 {
-			for iNdEx := len(x.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.FeeLimit[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x32
-			}
+			dAtA[i] = 0x3a
 		}
-		if x.MinCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+		if x.ResolveStatus != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveStatus))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ResolveTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveTime))
 			i--
 			dAtA[i] = 0x28
 		}
-		if x.AskCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
+		if x.RequestTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestTime))
 			i--
 			dAtA[i] = 0x20
 		}
-		if len(x.Calldata) > 0 {
-			i -= len(x.Calldata)
-			copy(dAtA[i:], x.Calldata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+		if x.AnsCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AnsCount))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x18
 		}
-		if x.OracleScriptId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
 			i--
 			dAtA[i] = 0x10
 		}
@@ -5159,7 +9978,7 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*OracleRequestPacketData)
+		x := input.Message.Interface().(*OracleResponsePacketData)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5191,10 +10010,10 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketData: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleResponsePacketData: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleResponsePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5231,9 +10050,9 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 				}
-				x.OracleScriptId = 0
+				x.RequestId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5243,16 +10062,16 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.OracleScriptId |= uint64(b&0x7F) << shift
+					x.RequestId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
 				}
-				var byteLen int
+				x.AnsCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5262,31 +10081,16 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					x.AnsCount |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
-				if x.Calldata == nil {
-					x.Calldata = []byte{}
-				}
-				iNdEx = postIndex
 			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
 				}
-				x.AskCount = 0
+				x.RequestTime = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5296,16 +10100,16 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.AskCount |= uint64(b&0x7F) << shift
+					x.RequestTime |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 5:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
 				}
-				x.MinCount = 0
+				x.ResolveTime = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5315,16 +10119,16 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MinCount |= uint64(b&0x7F) << shift
+					x.ResolveTime |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
 				}
-				var msglen int
+				x.ResolveStatus = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5334,31 +10138,16 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.ResolveStatus |= ResolveStatus(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.FeeLimit = append(x.FeeLimit, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeLimit[len(x.FeeLimit)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			case 7:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 				}
-				x.PrepareGas = 0
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5368,49 +10157,26 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.PrepareGas |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.ExecuteGas = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ExecuteGas |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			case 9:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssEncoder", wireType)
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TssEncoder = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.TssEncoder |= Encoder(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				x.Result = append(x.Result[:0], dAtA[iNdEx:postIndex]...)
+				if x.Result == nil {
+					x.Result = []byte{}
 				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5447,26 +10213,46 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_OracleRequestPacketAcknowledgement            protoreflect.MessageDescriptor
-	fd_OracleRequestPacketAcknowledgement_request_id protoreflect.FieldDescriptor
+	md_Result                  protoreflect.MessageDescriptor
+	fd_Result_client_id        protoreflect.FieldDescriptor
+	fd_Result_oracle_script_id protoreflect.FieldDescriptor
+	fd_Result_calldata         protoreflect.FieldDescriptor
+	fd_Result_ask_count        protoreflect.FieldDescriptor
+	fd_Result_min_count        protoreflect.FieldDescriptor
+	fd_Result_request_id       protoreflect.FieldDescriptor
+	fd_Result_ans_count        protoreflect.FieldDescriptor
+	fd_Result_request_time     protoreflect.FieldDescriptor
+	fd_Result_resolve_time     protoreflect.FieldDescriptor
+	fd_Result_resolve_status   protoreflect.FieldDescriptor
+	fd_Result_result           protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_OracleRequestPacketAcknowledgement = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleRequestPacketAcknowledgement")
-	fd_OracleRequestPacketAcknowledgement_request_id = md_OracleRequestPacketAcknowledgement.Fields().ByName("request_id")
+	md_Result = File_band_oracle_v1_oracle_proto.Messages().ByName("Result")
+	fd_Result_client_id = md_Result.Fields().ByName("client_id")
+	fd_Result_oracle_script_id = md_Result.Fields().ByName("oracle_script_id")
+	fd_Result_calldata = md_Result.Fields().ByName("calldata")
+	fd_Result_ask_count = md_Result.Fields().ByName("ask_count")
+	fd_Result_min_count = md_Result.Fields().ByName("min_count")
+	fd_Result_request_id = md_Result.Fields().ByName("request_id")
+	fd_Result_ans_count = md_Result.Fields().ByName("ans_count")
+	fd_Result_request_time = md_Result.Fields().ByName("request_time")
+	fd_Result_resolve_time = md_Result.Fields().ByName("resolve_time")
+	fd_Result_resolve_status = md_Result.Fields().ByName("resolve_status")
+	fd_Result_result = md_Result.Fields().ByName("result")
 }
 
-var _ protoreflect.Message = (*fastReflection_OracleRequestPacketAcknowledgement)(nil)
+var _ protoreflect.Message = (*fastReflection_Result)(nil)
 
-type fastReflection_OracleRequestPacketAcknowledgement OracleRequestPacketAcknowledgement
+type fastReflection_Result Result
 
-func (x *OracleRequestPacketAcknowledgement) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_OracleRequestPacketAcknowledgement)(x)
+func (x *Result) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Result)(x)
 }
 
-func (x *OracleRequestPacketAcknowledgement) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[7]
+func (x *Result) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5477,43 +10263,43 @@ func (x *OracleRequestPacketAcknowledgement) slowProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_OracleRequestPacketAcknowledgement_messageType fastReflection_OracleRequestPacketAcknowledgement_messageType
-var _ protoreflect.MessageType = fastReflection_OracleRequestPacketAcknowledgement_messageType{}
+var _fastReflection_Result_messageType fastReflection_Result_messageType
+var _ protoreflect.MessageType = fastReflection_Result_messageType{}
 
-type fastReflection_OracleRequestPacketAcknowledgement_messageType struct{}
+type fastReflection_Result_messageType struct{}
 
-func (x fastReflection_OracleRequestPacketAcknowledgement_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_OracleRequestPacketAcknowledgement)(nil)
+func (x fastReflection_Result_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Result)(nil)
 }
-func (x fastReflection_OracleRequestPacketAcknowledgement_messageType) New() protoreflect.Message {
-	return new(fastReflection_OracleRequestPacketAcknowledgement)
+func (x fastReflection_Result_messageType) New() protoreflect.Message {
+	return new(fastReflection_Result)
 }
-func (x fastReflection_OracleRequestPacketAcknowledgement_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleRequestPacketAcknowledgement
+func (x fastReflection_Result_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Result
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleRequestPacketAcknowledgement
+func (x *fastReflection_Result) Descriptor() protoreflect.MessageDescriptor {
+	return md_Result
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Type() protoreflect.MessageType {
-	return _fastReflection_OracleRequestPacketAcknowledgement_messageType
+func (x *fastReflection_Result) Type() protoreflect.MessageType {
+	return _fastReflection_Result_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) New() protoreflect.Message {
-	return new(fastReflection_OracleRequestPacketAcknowledgement)
+func (x *fastReflection_Result) New() protoreflect.Message {
+	return new(fastReflection_Result)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Interface() protoreflect.ProtoMessage {
-	return (*OracleRequestPacketAcknowledgement)(x)
+func (x *fastReflection_Result) Interface() protoreflect.ProtoMessage {
+	return (*Result)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5521,10 +10307,70 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_Result) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClientId != "" {
+		value := protoreflect.ValueOfString(x.ClientId)
+		if !f(fd_Result_client_id, value) {
+			return
+		}
+	}
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_Result_oracle_script_id, value) {
+			return
+		}
+	}
+	if len(x.Calldata) != 0 {
+		value := protoreflect.ValueOfBytes(x.Calldata)
+		if !f(fd_Result_calldata, value) {
+			return
+		}
+	}
+	if x.AskCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AskCount)
+		if !f(fd_Result_ask_count, value) {
+			return
+		}
+	}
+	if x.MinCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinCount)
+		if !f(fd_Result_min_count, value) {
+			return
+		}
+	}
 	if x.RequestId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_OracleRequestPacketAcknowledgement_request_id, value) {
+		if !f(fd_Result_request_id, value) {
+			return
+		}
+	}
+	if x.AnsCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AnsCount)
+		if !f(fd_Result_ans_count, value) {
+			return
+		}
+	}
+	if x.RequestTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RequestTime)
+		if !f(fd_Result_request_time, value) {
+			return
+		}
+	}
+	if x.ResolveTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ResolveTime)
+		if !f(fd_Result_resolve_time, value) {
+			return
+		}
+	}
+	if x.ResolveStatus != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ResolveStatus))
+		if !f(fd_Result_resolve_status, value) {
+			return
+		}
+	}
+	if len(x.Result) != 0 {
+		value := protoreflect.ValueOfBytes(x.Result)
+		if !f(fd_Result_result, value) {
 			return
 		}
 	}
@@ -5541,15 +10387,35 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Result) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+	case "band.oracle.v1.Result.client_id":
+		return x.ClientId != ""
+	case "band.oracle.v1.Result.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.Result.calldata":
+		return len(x.Calldata) != 0
+	case "band.oracle.v1.Result.ask_count":
+		return x.AskCount != uint64(0)
+	case "band.oracle.v1.Result.min_count":
+		return x.MinCount != uint64(0)
+	case "band.oracle.v1.Result.request_id":
 		return x.RequestId != uint64(0)
+	case "band.oracle.v1.Result.ans_count":
+		return x.AnsCount != uint64(0)
+	case "band.oracle.v1.Result.request_time":
+		return x.RequestTime != int64(0)
+	case "band.oracle.v1.Result.resolve_time":
+		return x.ResolveTime != int64(0)
+	case "band.oracle.v1.Result.resolve_status":
+		return x.ResolveStatus != 0
+	case "band.oracle.v1.Result.result":
+		return len(x.Result) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5559,15 +10425,35 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Result) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+	case "band.oracle.v1.Result.client_id":
+		x.ClientId = ""
+	case "band.oracle.v1.Result.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.Result.calldata":
+		x.Calldata = nil
+	case "band.oracle.v1.Result.ask_count":
+		x.AskCount = uint64(0)
+	case "band.oracle.v1.Result.min_count":
+		x.MinCount = uint64(0)
+	case "band.oracle.v1.Result.request_id":
 		x.RequestId = uint64(0)
+	case "band.oracle.v1.Result.ans_count":
+		x.AnsCount = uint64(0)
+	case "band.oracle.v1.Result.request_time":
+		x.RequestTime = int64(0)
+	case "band.oracle.v1.Result.resolve_time":
+		x.ResolveTime = int64(0)
+	case "band.oracle.v1.Result.resolve_status":
+		x.ResolveStatus = 0
+	case "band.oracle.v1.Result.result":
+		x.Result = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5577,16 +10463,46 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Result) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+	case "band.oracle.v1.Result.client_id":
+		value := x.ClientId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.Result.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Result.calldata":
+		value := x.Calldata
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.Result.ask_count":
+		value := x.AskCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Result.min_count":
+		value := x.MinCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Result.request_id":
 		value := x.RequestId
 		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Result.ans_count":
+		value := x.AnsCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Result.request_time":
+		value := x.RequestTime
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.Result.resolve_time":
+		value := x.ResolveTime
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.Result.resolve_status":
+		value := x.ResolveStatus
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.Result.result":
+		value := x.Result
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5600,15 +10516,35 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Result) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+	case "band.oracle.v1.Result.client_id":
+		x.ClientId = value.Interface().(string)
+	case "band.oracle.v1.Result.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.Result.calldata":
+		x.Calldata = value.Bytes()
+	case "band.oracle.v1.Result.ask_count":
+		x.AskCount = value.Uint()
+	case "band.oracle.v1.Result.min_count":
+		x.MinCount = value.Uint()
+	case "band.oracle.v1.Result.request_id":
 		x.RequestId = value.Uint()
+	case "band.oracle.v1.Result.ans_count":
+		x.AnsCount = value.Uint()
+	case "band.oracle.v1.Result.request_time":
+		x.RequestTime = value.Int()
+	case "band.oracle.v1.Result.resolve_time":
+		x.ResolveTime = value.Int()
+	case "band.oracle.v1.Result.resolve_status":
+		x.ResolveStatus = (ResolveStatus)(value.Enum())
+	case "band.oracle.v1.Result.result":
+		x.Result = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5622,40 +10558,80 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Result) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
-		panic(fmt.Errorf("field request_id of message band.oracle.v1.OracleRequestPacketAcknowledgement is not mutable"))
+	case "band.oracle.v1.Result.client_id":
+		panic(fmt.Errorf("field client_id of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.ask_count":
+		panic(fmt.Errorf("field ask_count of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.ans_count":
+		panic(fmt.Errorf("field ans_count of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.request_time":
+		panic(fmt.Errorf("field request_time of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.resolve_time":
+		panic(fmt.Errorf("field resolve_time of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.resolve_status":
+		panic(fmt.Errorf("field resolve_status of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.result":
+		panic(fmt.Errorf("field result of message band.oracle.v1.Result is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Result) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+	case "band.oracle.v1.Result.client_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.Result.oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Result.calldata":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.Result.ask_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Result.min_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Result.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Result.ans_count":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Result.request_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.Result.resolve_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.Result.resolve_status":
+		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.Result.result":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Result) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleRequestPacketAcknowledgement", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Result", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5663,7 +10639,7 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Result) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5674,7 +10650,7 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Result) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5686,7 +10662,7 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) IsValid() bool {
+func (x *fastReflection_Result) IsValid() bool {
 	return x != nil
 }
 
@@ -5696,9 +10672,9 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*OracleRequestPacketAcknowledgement)
+		x := input.Message.Interface().(*Result)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5710,9 +10686,42 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *prot
 		var n int
 		var l int
 		_ = l
+		l = len(x.ClientId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		}
+		l = len(x.Calldata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AskCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AskCount))
+		}
+		if x.MinCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinCount))
+		}
 		if x.RequestId != 0 {
 			n += 1 + runtime.Sov(uint64(x.RequestId))
 		}
+		if x.AnsCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AnsCount))
+		}
+		if x.RequestTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestTime))
+		}
+		if x.ResolveTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResolveTime))
+		}
+		if x.ResolveStatus != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResolveStatus))
+		}
+		l = len(x.Result)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5723,7 +10732,7 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*OracleRequestPacketAcknowledgement)
+		x := input.Message.Interface().(*Result)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5742,10 +10751,66 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *prot
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Result) > 0 {
+			i -= len(x.Result)
+			copy(dAtA[i:], x.Result)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.ResolveStatus != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveStatus))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ResolveTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveTime))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.RequestTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestTime))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.AnsCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AnsCount))
+			i--
+			dAtA[i] = 0x38
+		}
 		if x.RequestId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0x30
+		}
+		if x.MinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.AskCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Calldata) > 0 {
+			i -= len(x.Calldata)
+			copy(dAtA[i:], x.Calldata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ClientId) > 0 {
+			i -= len(x.ClientId)
+			copy(dAtA[i:], x.ClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5758,7 +10823,7 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*OracleRequestPacketAcknowledgement)
+		x := input.Message.Interface().(*Result)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5777,30 +10842,229 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *prot
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Result: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClientId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				}
+				x.OracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Calldata == nil {
+					x.Calldata = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				}
+				x.AskCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AskCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				}
+				x.MinCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
+				}
+				x.AnsCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AnsCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+				}
+				x.RequestTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.ResolveTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResolveTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketAcknowledgement: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+			case 10:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
 				}
-				x.RequestId = 0
+				x.ResolveStatus = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5810,11 +11074,45 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *prot
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
+					x.ResolveStatus |= ResolveStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Result = append(x.Result[:0], dAtA[iNdEx:postIndex]...)
+				if x.Result == nil {
+					x.Result = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5850,39 +11148,80 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *prot
 	}
 }
 
+var _ protoreflect.List = (*_RequestVersions_2_list)(nil)
+
+type _RequestVersions_2_list struct {
+	list *[]*RawRequestVersion
+}
+
+func (x *_RequestVersions_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RequestVersions_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_RequestVersions_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawRequestVersion)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RequestVersions_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawRequestVersion)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RequestVersions_2_list) AppendMutable() protoreflect.Value {
+	v := new(RawRequestVersion)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RequestVersions_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RequestVersions_2_list) NewElement() protoreflect.Value {
+	v := new(RawRequestVersion)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RequestVersions_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_OracleResponsePacketData                protoreflect.MessageDescriptor
-	fd_OracleResponsePacketData_client_id      protoreflect.FieldDescriptor
-	fd_OracleResponsePacketData_request_id     protoreflect.FieldDescriptor
-	fd_OracleResponsePacketData_ans_count      protoreflect.FieldDescriptor
-	fd_OracleResponsePacketData_request_time   protoreflect.FieldDescriptor
-	fd_OracleResponsePacketData_resolve_time   protoreflect.FieldDescriptor
-	fd_OracleResponsePacketData_resolve_status protoreflect.FieldDescriptor
-	fd_OracleResponsePacketData_result         protoreflect.FieldDescriptor
+	md_RequestVersions                       protoreflect.MessageDescriptor
+	fd_RequestVersions_oracle_script_version protoreflect.FieldDescriptor
+	fd_RequestVersions_raw_request_versions  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_OracleResponsePacketData = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleResponsePacketData")
-	fd_OracleResponsePacketData_client_id = md_OracleResponsePacketData.Fields().ByName("client_id")
-	fd_OracleResponsePacketData_request_id = md_OracleResponsePacketData.Fields().ByName("request_id")
-	fd_OracleResponsePacketData_ans_count = md_OracleResponsePacketData.Fields().ByName("ans_count")
-	fd_OracleResponsePacketData_request_time = md_OracleResponsePacketData.Fields().ByName("request_time")
-	fd_OracleResponsePacketData_resolve_time = md_OracleResponsePacketData.Fields().ByName("resolve_time")
-	fd_OracleResponsePacketData_resolve_status = md_OracleResponsePacketData.Fields().ByName("resolve_status")
-	fd_OracleResponsePacketData_result = md_OracleResponsePacketData.Fields().ByName("result")
+	md_RequestVersions = File_band_oracle_v1_oracle_proto.Messages().ByName("RequestVersions")
+	fd_RequestVersions_oracle_script_version = md_RequestVersions.Fields().ByName("oracle_script_version")
+	fd_RequestVersions_raw_request_versions = md_RequestVersions.Fields().ByName("raw_request_versions")
 }
 
-var _ protoreflect.Message = (*fastReflection_OracleResponsePacketData)(nil)
+var _ protoreflect.Message = (*fastReflection_RequestVersions)(nil)
 
-type fastReflection_OracleResponsePacketData OracleResponsePacketData
+type fastReflection_RequestVersions RequestVersions
 
-func (x *OracleResponsePacketData) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_OracleResponsePacketData)(x)
+func (x *RequestVersions) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RequestVersions)(x)
 }
 
-func (x *OracleResponsePacketData) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
+func (x *RequestVersions) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5893,43 +11232,43 @@ func (x *OracleResponsePacketData) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_OracleResponsePacketData_messageType fastReflection_OracleResponsePacketData_messageType
-var _ protoreflect.MessageType = fastReflection_OracleResponsePacketData_messageType{}
+var _fastReflection_RequestVersions_messageType fastReflection_RequestVersions_messageType
+var _ protoreflect.MessageType = fastReflection_RequestVersions_messageType{}
 
-type fastReflection_OracleResponsePacketData_messageType struct{}
+type fastReflection_RequestVersions_messageType struct{}
 
-func (x fastReflection_OracleResponsePacketData_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_OracleResponsePacketData)(nil)
+func (x fastReflection_RequestVersions_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RequestVersions)(nil)
 }
-func (x fastReflection_OracleResponsePacketData_messageType) New() protoreflect.Message {
-	return new(fastReflection_OracleResponsePacketData)
+func (x fastReflection_RequestVersions_messageType) New() protoreflect.Message {
+	return new(fastReflection_RequestVersions)
 }
-func (x fastReflection_OracleResponsePacketData_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleResponsePacketData
+func (x fastReflection_RequestVersions_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RequestVersions
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_OracleResponsePacketData) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleResponsePacketData
+func (x *fastReflection_RequestVersions) Descriptor() protoreflect.MessageDescriptor {
+	return md_RequestVersions
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_OracleResponsePacketData) Type() protoreflect.MessageType {
-	return _fastReflection_OracleResponsePacketData_messageType
+func (x *fastReflection_RequestVersions) Type() protoreflect.MessageType {
+	return _fastReflection_RequestVersions_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_OracleResponsePacketData) New() protoreflect.Message {
-	return new(fastReflection_OracleResponsePacketData)
+func (x *fastReflection_RequestVersions) New() protoreflect.Message {
+	return new(fastReflection_RequestVersions)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_OracleResponsePacketData) Interface() protoreflect.ProtoMessage {
-	return (*OracleResponsePacketData)(x)
+func (x *fastReflection_RequestVersions) Interface() protoreflect.ProtoMessage {
+	return (*RequestVersions)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5937,46 +11276,16 @@ func (x *fastReflection_OracleResponsePacketData) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_OracleResponsePacketData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ClientId != "" {
-		value := protoreflect.ValueOfString(x.ClientId)
-		if !f(fd_OracleResponsePacketData_client_id, value) {
-			return
-		}
-	}
-	if x.RequestId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_OracleResponsePacketData_request_id, value) {
-			return
-		}
-	}
-	if x.AnsCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.AnsCount)
-		if !f(fd_OracleResponsePacketData_ans_count, value) {
-			return
-		}
-	}
-	if x.RequestTime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.RequestTime)
-		if !f(fd_OracleResponsePacketData_request_time, value) {
-			return
-		}
-	}
-	if x.ResolveTime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ResolveTime)
-		if !f(fd_OracleResponsePacketData_resolve_time, value) {
-			return
-		}
-	}
-	if x.ResolveStatus != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ResolveStatus))
-		if !f(fd_OracleResponsePacketData_resolve_status, value) {
+func (x *fastReflection_RequestVersions) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OracleScriptVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptVersion)
+		if !f(fd_RequestVersions_oracle_script_version, value) {
 			return
 		}
 	}
-	if len(x.Result) != 0 {
-		value := protoreflect.ValueOfBytes(x.Result)
-		if !f(fd_OracleResponsePacketData_result, value) {
+	if len(x.RawRequestVersions) != 0 {
+		value := protoreflect.ValueOfList(&_RequestVersions_2_list{list: &x.RawRequestVersions})
+		if !f(fd_RequestVersions_raw_request_versions, value) {
 			return
 		}
 	}
@@ -5993,27 +11302,17 @@ func (x *fastReflection_OracleResponsePacketData) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_OracleResponsePacketData) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RequestVersions) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleResponsePacketData.client_id":
-		return x.ClientId != ""
-	case "band.oracle.v1.OracleResponsePacketData.request_id":
-		return x.RequestId != uint64(0)
-	case "band.oracle.v1.OracleResponsePacketData.ans_count":
-		return x.AnsCount != uint64(0)
-	case "band.oracle.v1.OracleResponsePacketData.request_time":
-		return x.RequestTime != int64(0)
-	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
-		return x.ResolveTime != int64(0)
-	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
-		return x.ResolveStatus != 0
-	case "band.oracle.v1.OracleResponsePacketData.result":
-		return len(x.Result) != 0
+	case "band.oracle.v1.RequestVersions.oracle_script_version":
+		return x.OracleScriptVersion != uint64(0)
+	case "band.oracle.v1.RequestVersions.raw_request_versions":
+		return len(x.RawRequestVersions) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVersions"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RequestVersions does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6023,27 +11322,17 @@ func (x *fastReflection_OracleResponsePacketData) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleResponsePacketData) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RequestVersions) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleResponsePacketData.client_id":
-		x.ClientId = ""
-	case "band.oracle.v1.OracleResponsePacketData.request_id":
-		x.RequestId = uint64(0)
-	case "band.oracle.v1.OracleResponsePacketData.ans_count":
-		x.AnsCount = uint64(0)
-	case "band.oracle.v1.OracleResponsePacketData.request_time":
-		x.RequestTime = int64(0)
-	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
-		x.ResolveTime = int64(0)
-	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
-		x.ResolveStatus = 0
-	case "band.oracle.v1.OracleResponsePacketData.result":
-		x.Result = nil
+	case "band.oracle.v1.RequestVersions.oracle_script_version":
+		x.OracleScriptVersion = uint64(0)
+	case "band.oracle.v1.RequestVersions.raw_request_versions":
+		x.RawRequestVersions = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVersions"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RequestVersions does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6053,34 +11342,22 @@ func (x *fastReflection_OracleResponsePacketData) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_OracleResponsePacketData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RequestVersions) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.OracleResponsePacketData.client_id":
-		value := x.ClientId
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.OracleResponsePacketData.request_id":
-		value := x.RequestId
+	case "band.oracle.v1.RequestVersions.oracle_script_version":
+		value := x.OracleScriptVersion
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleResponsePacketData.ans_count":
-		value := x.AnsCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleResponsePacketData.request_time":
-		value := x.RequestTime
-		return protoreflect.ValueOfInt64(value)
-	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
-		value := x.ResolveTime
-		return protoreflect.ValueOfInt64(value)
-	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
-		value := x.ResolveStatus
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "band.oracle.v1.OracleResponsePacketData.result":
-		value := x.Result
-		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.RequestVersions.raw_request_versions":
+		if len(x.RawRequestVersions) == 0 {
+			return protoreflect.ValueOfList(&_RequestVersions_2_list{})
+		}
+		listValue := &_RequestVersions_2_list{list: &x.RawRequestVersions}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVersions"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RequestVersions does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6094,27 +11371,19 @@ func (x *fastReflection_OracleResponsePacketData) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleResponsePacketData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RequestVersions) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleResponsePacketData.client_id":
-		x.ClientId = value.Interface().(string)
-	case "band.oracle.v1.OracleResponsePacketData.request_id":
-		x.RequestId = value.Uint()
-	case "band.oracle.v1.OracleResponsePacketData.ans_count":
-		x.AnsCount = value.Uint()
-	case "band.oracle.v1.OracleResponsePacketData.request_time":
-		x.RequestTime = value.Int()
-	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
-		x.ResolveTime = value.Int()
-	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
-		x.ResolveStatus = (ResolveStatus)(value.Enum())
-	case "band.oracle.v1.OracleResponsePacketData.result":
-		x.Result = value.Bytes()
+	case "band.oracle.v1.RequestVersions.oracle_script_version":
+		x.OracleScriptVersion = value.Uint()
+	case "band.oracle.v1.RequestVersions.raw_request_versions":
+		lv := value.List()
+		clv := lv.(*_RequestVersions_2_list)
+		x.RawRequestVersions = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVersions"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RequestVersions does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6128,64 +11397,49 @@ func (x *fastReflection_OracleResponsePacketData) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleResponsePacketData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RequestVersions) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleResponsePacketData.client_id":
-		panic(fmt.Errorf("field client_id of message band.oracle.v1.OracleResponsePacketData is not mutable"))
-	case "band.oracle.v1.OracleResponsePacketData.request_id":
-		panic(fmt.Errorf("field request_id of message band.oracle.v1.OracleResponsePacketData is not mutable"))
-	case "band.oracle.v1.OracleResponsePacketData.ans_count":
-		panic(fmt.Errorf("field ans_count of message band.oracle.v1.OracleResponsePacketData is not mutable"))
-	case "band.oracle.v1.OracleResponsePacketData.request_time":
-		panic(fmt.Errorf("field request_time of message band.oracle.v1.OracleResponsePacketData is not mutable"))
-	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
-		panic(fmt.Errorf("field resolve_time of message band.oracle.v1.OracleResponsePacketData is not mutable"))
-	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
-		panic(fmt.Errorf("field resolve_status of message band.oracle.v1.OracleResponsePacketData is not mutable"))
-	case "band.oracle.v1.OracleResponsePacketData.result":
-		panic(fmt.Errorf("field result of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.RequestVersions.raw_request_versions":
+		if x.RawRequestVersions == nil {
+			x.RawRequestVersions = []*RawRequestVersion{}
+		}
+		value := &_RequestVersions_2_list{list: &x.RawRequestVersions}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.RequestVersions.oracle_script_version":
+		panic(fmt.Errorf("field oracle_script_version of message band.oracle.v1.RequestVersions is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVersions"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RequestVersions does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_OracleResponsePacketData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RequestVersions) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleResponsePacketData.client_id":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.OracleResponsePacketData.request_id":
+	case "band.oracle.v1.RequestVersions.oracle_script_version":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleResponsePacketData.ans_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleResponsePacketData.request_time":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
-		return protoreflect.ValueOfEnum(0)
-	case "band.oracle.v1.OracleResponsePacketData.result":
-		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.RequestVersions.raw_request_versions":
+		list := []*RawRequestVersion{}
+		return protoreflect.ValueOfList(&_RequestVersions_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVersions"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RequestVersions does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_OracleResponsePacketData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RequestVersions) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleResponsePacketData", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RequestVersions", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6193,7 +11447,7 @@ func (x *fastReflection_OracleResponsePacketData) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_OracleResponsePacketData) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RequestVersions) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6204,7 +11458,7 @@ func (x *fastReflection_OracleResponsePacketData) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleResponsePacketData) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RequestVersions) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6216,7 +11470,7 @@ func (x *fastReflection_OracleResponsePacketData) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_OracleResponsePacketData) IsValid() bool {
+func (x *fastReflection_RequestVersions) IsValid() bool {
 	return x != nil
 }
 
@@ -6226,9 +11480,9 @@ func (x *fastReflection_OracleResponsePacketData) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RequestVersions) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*OracleResponsePacketData)
+		x := input.Message.Interface().(*RequestVersions)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6240,28 +11494,14 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		l = len(x.ClientId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.RequestId != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestId))
-		}
-		if x.AnsCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.AnsCount))
-		}
-		if x.RequestTime != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestTime))
-		}
-		if x.ResolveTime != 0 {
-			n += 1 + runtime.Sov(uint64(x.ResolveTime))
-		}
-		if x.ResolveStatus != 0 {
-			n += 1 + runtime.Sov(uint64(x.ResolveStatus))
+		if x.OracleScriptVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptVersion))
 		}
-		l = len(x.Result)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.RawRequestVersions) > 0 {
+			for _, e := range x.RawRequestVersions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6273,7 +11513,7 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*OracleResponsePacketData)
+		x := input.Message.Interface().(*RequestVersions)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6292,44 +11532,26 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Result) > 0 {
-			i -= len(x.Result)
-			copy(dAtA[i:], x.Result)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if x.ResolveStatus != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveStatus))
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.ResolveTime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveTime))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.RequestTime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestTime))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.AnsCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AnsCount))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.RequestId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
-			i--
-			dAtA[i] = 0x10
+		if len(x.RawRequestVersions) > 0 {
+			for iNdEx := len(x.RawRequestVersions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RawRequestVersions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
 		}
-		if len(x.ClientId) > 0 {
-			i -= len(x.ClientId)
-			copy(dAtA[i:], x.ClientId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+		if x.OracleScriptVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptVersion))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6342,7 +11564,7 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*OracleResponsePacketData)
+		x := input.Message.Interface().(*RequestVersions)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6364,135 +11586,27 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleResponsePacketData: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleResponsePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ClientId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-				}
-				x.RequestId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
-				}
-				x.AnsCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.AnsCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
-				}
-				x.RequestTime = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestTime |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
-				}
-				x.ResolveTime = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ResolveTime |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			case 6:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestVersions: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestVersions: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
 				}
-				x.ResolveStatus = 0
+				x.OracleScriptVersion = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6502,16 +11616,16 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ResolveStatus |= ResolveStatus(b&0x7F) << shift
+					x.OracleScriptVersion |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 7:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawRequestVersions", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6521,24 +11635,24 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Result = append(x.Result[:0], dAtA[iNdEx:postIndex]...)
-				if x.Result == nil {
-					x.Result = []byte{}
+				x.RawRequestVersions = append(x.RawRequestVersions, &RawRequestVersion{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RawRequestVersions[len(x.RawRequestVersions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -6577,46 +11691,30 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_Result                  protoreflect.MessageDescriptor
-	fd_Result_client_id        protoreflect.FieldDescriptor
-	fd_Result_oracle_script_id protoreflect.FieldDescriptor
-	fd_Result_calldata         protoreflect.FieldDescriptor
-	fd_Result_ask_count        protoreflect.FieldDescriptor
-	fd_Result_min_count        protoreflect.FieldDescriptor
-	fd_Result_request_id       protoreflect.FieldDescriptor
-	fd_Result_ans_count        protoreflect.FieldDescriptor
-	fd_Result_request_time     protoreflect.FieldDescriptor
-	fd_Result_resolve_time     protoreflect.FieldDescriptor
-	fd_Result_resolve_status   protoreflect.FieldDescriptor
-	fd_Result_result           protoreflect.FieldDescriptor
+	md_RawRequestVersion                     protoreflect.MessageDescriptor
+	fd_RawRequestVersion_external_id         protoreflect.FieldDescriptor
+	fd_RawRequestVersion_data_source_id      protoreflect.FieldDescriptor
+	fd_RawRequestVersion_data_source_version protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_Result = File_band_oracle_v1_oracle_proto.Messages().ByName("Result")
-	fd_Result_client_id = md_Result.Fields().ByName("client_id")
-	fd_Result_oracle_script_id = md_Result.Fields().ByName("oracle_script_id")
-	fd_Result_calldata = md_Result.Fields().ByName("calldata")
-	fd_Result_ask_count = md_Result.Fields().ByName("ask_count")
-	fd_Result_min_count = md_Result.Fields().ByName("min_count")
-	fd_Result_request_id = md_Result.Fields().ByName("request_id")
-	fd_Result_ans_count = md_Result.Fields().ByName("ans_count")
-	fd_Result_request_time = md_Result.Fields().ByName("request_time")
-	fd_Result_resolve_time = md_Result.Fields().ByName("resolve_time")
-	fd_Result_resolve_status = md_Result.Fields().ByName("resolve_status")
-	fd_Result_result = md_Result.Fields().ByName("result")
+	md_RawRequestVersion = File_band_oracle_v1_oracle_proto.Messages().ByName("RawRequestVersion")
+	fd_RawRequestVersion_external_id = md_RawRequestVersion.Fields().ByName("external_id")
+	fd_RawRequestVersion_data_source_id = md_RawRequestVersion.Fields().ByName("data_source_id")
+	fd_RawRequestVersion_data_source_version = md_RawRequestVersion.Fields().ByName("data_source_version")
 }
 
-var _ protoreflect.Message = (*fastReflection_Result)(nil)
+var _ protoreflect.Message = (*fastReflection_RawRequestVersion)(nil)
 
-type fastReflection_Result Result
+type fastReflection_RawRequestVersion RawRequestVersion
 
-func (x *Result) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Result)(x)
+func (x *RawRequestVersion) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RawRequestVersion)(x)
 }
 
-func (x *Result) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
+func (x *RawRequestVersion) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6627,43 +11725,43 @@ func (x *Result) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Result_messageType fastReflection_Result_messageType
-var _ protoreflect.MessageType = fastReflection_Result_messageType{}
+var _fastReflection_RawRequestVersion_messageType fastReflection_RawRequestVersion_messageType
+var _ protoreflect.MessageType = fastReflection_RawRequestVersion_messageType{}
 
-type fastReflection_Result_messageType struct{}
+type fastReflection_RawRequestVersion_messageType struct{}
 
-func (x fastReflection_Result_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Result)(nil)
+func (x fastReflection_RawRequestVersion_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RawRequestVersion)(nil)
 }
-func (x fastReflection_Result_messageType) New() protoreflect.Message {
-	return new(fastReflection_Result)
+func (x fastReflection_RawRequestVersion_messageType) New() protoreflect.Message {
+	return new(fastReflection_RawRequestVersion)
 }
-func (x fastReflection_Result_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Result
+func (x fastReflection_RawRequestVersion_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RawRequestVersion
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Result) Descriptor() protoreflect.MessageDescriptor {
-	return md_Result
+func (x *fastReflection_RawRequestVersion) Descriptor() protoreflect.MessageDescriptor {
+	return md_RawRequestVersion
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Result) Type() protoreflect.MessageType {
-	return _fastReflection_Result_messageType
+func (x *fastReflection_RawRequestVersion) Type() protoreflect.MessageType {
+	return _fastReflection_RawRequestVersion_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Result) New() protoreflect.Message {
-	return new(fastReflection_Result)
+func (x *fastReflection_RawRequestVersion) New() protoreflect.Message {
+	return new(fastReflection_RawRequestVersion)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Result) Interface() protoreflect.ProtoMessage {
-	return (*Result)(x)
+func (x *fastReflection_RawRequestVersion) Interface() protoreflect.ProtoMessage {
+	return (*RawRequestVersion)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6671,70 +11769,22 @@ func (x *fastReflection_Result) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Result) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ClientId != "" {
-		value := protoreflect.ValueOfString(x.ClientId)
-		if !f(fd_Result_client_id, value) {
-			return
-		}
-	}
-	if x.OracleScriptId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OracleScriptId)
-		if !f(fd_Result_oracle_script_id, value) {
-			return
-		}
-	}
-	if len(x.Calldata) != 0 {
-		value := protoreflect.ValueOfBytes(x.Calldata)
-		if !f(fd_Result_calldata, value) {
-			return
-		}
-	}
-	if x.AskCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.AskCount)
-		if !f(fd_Result_ask_count, value) {
-			return
-		}
-	}
-	if x.MinCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MinCount)
-		if !f(fd_Result_min_count, value) {
-			return
-		}
-	}
-	if x.RequestId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_Result_request_id, value) {
-			return
-		}
-	}
-	if x.AnsCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.AnsCount)
-		if !f(fd_Result_ans_count, value) {
-			return
-		}
-	}
-	if x.RequestTime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.RequestTime)
-		if !f(fd_Result_request_time, value) {
-			return
-		}
-	}
-	if x.ResolveTime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ResolveTime)
-		if !f(fd_Result_resolve_time, value) {
+func (x *fastReflection_RawRequestVersion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExternalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExternalId)
+		if !f(fd_RawRequestVersion_external_id, value) {
 			return
 		}
 	}
-	if x.ResolveStatus != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ResolveStatus))
-		if !f(fd_Result_resolve_status, value) {
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_RawRequestVersion_data_source_id, value) {
 			return
 		}
 	}
-	if len(x.Result) != 0 {
-		value := protoreflect.ValueOfBytes(x.Result)
-		if !f(fd_Result_result, value) {
+	if x.DataSourceVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceVersion)
+		if !f(fd_RawRequestVersion_data_source_version, value) {
 			return
 		}
 	}
@@ -6751,35 +11801,19 @@ func (x *fastReflection_Result) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Result) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RawRequestVersion) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.Result.client_id":
-		return x.ClientId != ""
-	case "band.oracle.v1.Result.oracle_script_id":
-		return x.OracleScriptId != uint64(0)
-	case "band.oracle.v1.Result.calldata":
-		return len(x.Calldata) != 0
-	case "band.oracle.v1.Result.ask_count":
-		return x.AskCount != uint64(0)
-	case "band.oracle.v1.Result.min_count":
-		return x.MinCount != uint64(0)
-	case "band.oracle.v1.Result.request_id":
-		return x.RequestId != uint64(0)
-	case "band.oracle.v1.Result.ans_count":
-		return x.AnsCount != uint64(0)
-	case "band.oracle.v1.Result.request_time":
-		return x.RequestTime != int64(0)
-	case "band.oracle.v1.Result.resolve_time":
-		return x.ResolveTime != int64(0)
-	case "band.oracle.v1.Result.resolve_status":
-		return x.ResolveStatus != 0
-	case "band.oracle.v1.Result.result":
-		return len(x.Result) != 0
+	case "band.oracle.v1.RawRequestVersion.external_id":
+		return x.ExternalId != uint64(0)
+	case "band.oracle.v1.RawRequestVersion.data_source_id":
+		return x.DataSourceId != uint64(0)
+	case "band.oracle.v1.RawRequestVersion.data_source_version":
+		return x.DataSourceVersion != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequestVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequestVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6789,35 +11823,19 @@ func (x *fastReflection_Result) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Result) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RawRequestVersion) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Result.client_id":
-		x.ClientId = ""
-	case "band.oracle.v1.Result.oracle_script_id":
-		x.OracleScriptId = uint64(0)
-	case "band.oracle.v1.Result.calldata":
-		x.Calldata = nil
-	case "band.oracle.v1.Result.ask_count":
-		x.AskCount = uint64(0)
-	case "band.oracle.v1.Result.min_count":
-		x.MinCount = uint64(0)
-	case "band.oracle.v1.Result.request_id":
-		x.RequestId = uint64(0)
-	case "band.oracle.v1.Result.ans_count":
-		x.AnsCount = uint64(0)
-	case "band.oracle.v1.Result.request_time":
-		x.RequestTime = int64(0)
-	case "band.oracle.v1.Result.resolve_time":
-		x.ResolveTime = int64(0)
-	case "band.oracle.v1.Result.resolve_status":
-		x.ResolveStatus = 0
-	case "band.oracle.v1.Result.result":
-		x.Result = nil
+	case "band.oracle.v1.RawRequestVersion.external_id":
+		x.ExternalId = uint64(0)
+	case "band.oracle.v1.RawRequestVersion.data_source_id":
+		x.DataSourceId = uint64(0)
+	case "band.oracle.v1.RawRequestVersion.data_source_version":
+		x.DataSourceVersion = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequestVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequestVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6827,46 +11845,22 @@ func (x *fastReflection_Result) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Result) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RawRequestVersion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.Result.client_id":
-		value := x.ClientId
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.Result.oracle_script_id":
-		value := x.OracleScriptId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Result.calldata":
-		value := x.Calldata
-		return protoreflect.ValueOfBytes(value)
-	case "band.oracle.v1.Result.ask_count":
-		value := x.AskCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Result.min_count":
-		value := x.MinCount
+	case "band.oracle.v1.RawRequestVersion.external_id":
+		value := x.ExternalId
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Result.request_id":
-		value := x.RequestId
+	case "band.oracle.v1.RawRequestVersion.data_source_id":
+		value := x.DataSourceId
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Result.ans_count":
-		value := x.AnsCount
+	case "band.oracle.v1.RawRequestVersion.data_source_version":
+		value := x.DataSourceVersion
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Result.request_time":
-		value := x.RequestTime
-		return protoreflect.ValueOfInt64(value)
-	case "band.oracle.v1.Result.resolve_time":
-		value := x.ResolveTime
-		return protoreflect.ValueOfInt64(value)
-	case "band.oracle.v1.Result.resolve_status":
-		value := x.ResolveStatus
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "band.oracle.v1.Result.result":
-		value := x.Result
-		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequestVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequestVersion does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6880,35 +11874,19 @@ func (x *fastReflection_Result) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Result) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "band.oracle.v1.Result.client_id":
-		x.ClientId = value.Interface().(string)
-	case "band.oracle.v1.Result.oracle_script_id":
-		x.OracleScriptId = value.Uint()
-	case "band.oracle.v1.Result.calldata":
-		x.Calldata = value.Bytes()
-	case "band.oracle.v1.Result.ask_count":
-		x.AskCount = value.Uint()
-	case "band.oracle.v1.Result.min_count":
-		x.MinCount = value.Uint()
-	case "band.oracle.v1.Result.request_id":
-		x.RequestId = value.Uint()
-	case "band.oracle.v1.Result.ans_count":
-		x.AnsCount = value.Uint()
-	case "band.oracle.v1.Result.request_time":
-		x.RequestTime = value.Int()
-	case "band.oracle.v1.Result.resolve_time":
-		x.ResolveTime = value.Int()
-	case "band.oracle.v1.Result.resolve_status":
-		x.ResolveStatus = (ResolveStatus)(value.Enum())
-	case "band.oracle.v1.Result.result":
-		x.Result = value.Bytes()
+func (x *fastReflection_RawRequestVersion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.RawRequestVersion.external_id":
+		x.ExternalId = value.Uint()
+	case "band.oracle.v1.RawRequestVersion.data_source_id":
+		x.DataSourceId = value.Uint()
+	case "band.oracle.v1.RawRequestVersion.data_source_version":
+		x.DataSourceVersion = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequestVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequestVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6922,80 +11900,48 @@ func (x *fastReflection_Result) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Result) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RawRequestVersion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Result.client_id":
-		panic(fmt.Errorf("field client_id of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.oracle_script_id":
-		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.calldata":
-		panic(fmt.Errorf("field calldata of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.ask_count":
-		panic(fmt.Errorf("field ask_count of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.min_count":
-		panic(fmt.Errorf("field min_count of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.request_id":
-		panic(fmt.Errorf("field request_id of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.ans_count":
-		panic(fmt.Errorf("field ans_count of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.request_time":
-		panic(fmt.Errorf("field request_time of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.resolve_time":
-		panic(fmt.Errorf("field resolve_time of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.resolve_status":
-		panic(fmt.Errorf("field resolve_status of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.result":
-		panic(fmt.Errorf("field result of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.RawRequestVersion.external_id":
+		panic(fmt.Errorf("field external_id of message band.oracle.v1.RawRequestVersion is not mutable"))
+	case "band.oracle.v1.RawRequestVersion.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.RawRequestVersion is not mutable"))
+	case "band.oracle.v1.RawRequestVersion.data_source_version":
+		panic(fmt.Errorf("field data_source_version of message band.oracle.v1.RawRequestVersion is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequestVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequestVersion does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Result) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RawRequestVersion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Result.client_id":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.Result.oracle_script_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Result.calldata":
-		return protoreflect.ValueOfBytes(nil)
-	case "band.oracle.v1.Result.ask_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Result.min_count":
+	case "band.oracle.v1.RawRequestVersion.external_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Result.request_id":
+	case "band.oracle.v1.RawRequestVersion.data_source_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Result.ans_count":
+	case "band.oracle.v1.RawRequestVersion.data_source_version":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Result.request_time":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.oracle.v1.Result.resolve_time":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.oracle.v1.Result.resolve_status":
-		return protoreflect.ValueOfEnum(0)
-	case "band.oracle.v1.Result.result":
-		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequestVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequestVersion does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Result) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RawRequestVersion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Result", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RawRequestVersion", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7003,7 +11949,7 @@ func (x *fastReflection_Result) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Result) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RawRequestVersion) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7014,7 +11960,7 @@ func (x *fastReflection_Result) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Result) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RawRequestVersion) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7026,7 +11972,7 @@ func (x *fastReflection_Result) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Result) IsValid() bool {
+func (x *fastReflection_RawRequestVersion) IsValid() bool {
 	return x != nil
 }
 
@@ -7036,9 +11982,9 @@ func (x *fastReflection_Result) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RawRequestVersion) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Result)
+		x := input.Message.Interface().(*RawRequestVersion)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7050,41 +11996,14 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.ClientId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.OracleScriptId != 0 {
-			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
-		}
-		l = len(x.Calldata)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.AskCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.AskCount))
-		}
-		if x.MinCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.MinCount))
-		}
-		if x.RequestId != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestId))
-		}
-		if x.AnsCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.AnsCount))
-		}
-		if x.RequestTime != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestTime))
-		}
-		if x.ResolveTime != 0 {
-			n += 1 + runtime.Sov(uint64(x.ResolveTime))
+		if x.ExternalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExternalId))
 		}
-		if x.ResolveStatus != 0 {
-			n += 1 + runtime.Sov(uint64(x.ResolveStatus))
+		if x.DataSourceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceId))
 		}
-		l = len(x.Result)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.DataSourceVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceVersion))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7096,7 +12015,7 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Result)
+		x := input.Message.Interface().(*RawRequestVersion)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7115,66 +12034,20 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Result) > 0 {
-			i -= len(x.Result)
-			copy(dAtA[i:], x.Result)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
-			i--
-			dAtA[i] = 0x5a
-		}
-		if x.ResolveStatus != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveStatus))
-			i--
-			dAtA[i] = 0x50
-		}
-		if x.ResolveTime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveTime))
-			i--
-			dAtA[i] = 0x48
-		}
-		if x.RequestTime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestTime))
-			i--
-			dAtA[i] = 0x40
-		}
-		if x.AnsCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AnsCount))
-			i--
-			dAtA[i] = 0x38
-		}
-		if x.RequestId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.MinCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.AskCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
-			i--
-			dAtA[i] = 0x20
-		}
-		if len(x.Calldata) > 0 {
-			i -= len(x.Calldata)
-			copy(dAtA[i:], x.Calldata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+		if x.DataSourceVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceVersion))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x18
 		}
-		if x.OracleScriptId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.ClientId) > 0 {
-			i -= len(x.ClientId)
-			copy(dAtA[i:], x.ClientId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+		if x.ExternalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7187,7 +12060,7 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Result)
+		x := input.Message.Interface().(*RawRequestVersion)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7203,194 +12076,33 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 			preIndex := iNdEx
 			var wire uint64
 			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Result: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ClientId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
-				}
-				x.OracleScriptId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OracleScriptId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
-				if x.Calldata == nil {
-					x.Calldata = []byte{}
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
-				}
-				x.AskCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.AskCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
-				}
-				x.MinCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MinCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-				}
-				x.RequestId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 7:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
-				}
-				x.AnsCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.AnsCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			case 8:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawRequestVersion: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawRequestVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 				}
-				x.RequestTime = 0
+				x.ExternalId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7400,16 +12112,16 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.RequestTime |= int64(b&0x7F) << shift
+					x.ExternalId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 9:
+			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
 				}
-				x.ResolveTime = 0
+				x.DataSourceId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7419,35 +12131,16 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ResolveTime |= int64(b&0x7F) << shift
+					x.DataSourceId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 10:
+			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
-				}
-				x.ResolveStatus = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ResolveStatus |= ResolveStatus(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 11:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceVersion", wireType)
 				}
-				var byteLen int
+				x.DataSourceVersion = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7457,26 +12150,11 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					x.DataSourceVersion |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Result = append(x.Result[:0], dAtA[iNdEx:postIndex]...)
-				if x.Result == nil {
-					x.Result = []byte{}
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7536,7 +12214,7 @@ func (x *SigningResult) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SigningResult) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8050,7 +12728,7 @@ func (x *ValidatorStatus) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ValidatorStatus) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8539,7 +13217,7 @@ func (x *ActiveValidator) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ActiveValidator) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8985,19 +13663,74 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_Params_13_list)(nil)
+
+type _Params_13_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_13_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_13_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_13_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                           protoreflect.MessageDescriptor
-	fd_Params_max_raw_request_count     protoreflect.FieldDescriptor
-	fd_Params_max_ask_count             protoreflect.FieldDescriptor
-	fd_Params_max_calldata_size         protoreflect.FieldDescriptor
-	fd_Params_max_report_data_size      protoreflect.FieldDescriptor
-	fd_Params_expiration_block_count    protoreflect.FieldDescriptor
-	fd_Params_base_owasm_gas            protoreflect.FieldDescriptor
-	fd_Params_per_validator_request_gas protoreflect.FieldDescriptor
-	fd_Params_sampling_try_count        protoreflect.FieldDescriptor
-	fd_Params_oracle_reward_percentage  protoreflect.FieldDescriptor
-	fd_Params_inactive_penalty_duration protoreflect.FieldDescriptor
-	fd_Params_ibc_request_enabled       protoreflect.FieldDescriptor
+	md_Params                                  protoreflect.MessageDescriptor
+	fd_Params_max_raw_request_count            protoreflect.FieldDescriptor
+	fd_Params_max_ask_count                    protoreflect.FieldDescriptor
+	fd_Params_max_calldata_size                protoreflect.FieldDescriptor
+	fd_Params_max_report_data_size             protoreflect.FieldDescriptor
+	fd_Params_expiration_block_count           protoreflect.FieldDescriptor
+	fd_Params_base_owasm_gas                   protoreflect.FieldDescriptor
+	fd_Params_per_validator_request_gas        protoreflect.FieldDescriptor
+	fd_Params_sampling_try_count               protoreflect.FieldDescriptor
+	fd_Params_oracle_reward_percentage         protoreflect.FieldDescriptor
+	fd_Params_inactive_penalty_duration        protoreflect.FieldDescriptor
+	fd_Params_ibc_request_enabled              protoreflect.FieldDescriptor
+	fd_Params_price_reference_oracle_script_id protoreflect.FieldDescriptor
+	fd_Params_subscription_fee                 protoreflect.FieldDescriptor
+	fd_Params_max_subscription_gas             protoreflect.FieldDescriptor
+	fd_Params_max_active_subscriptions         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -9014,6 +13747,10 @@ func init() {
 	fd_Params_oracle_reward_percentage = md_Params.Fields().ByName("oracle_reward_percentage")
 	fd_Params_inactive_penalty_duration = md_Params.Fields().ByName("inactive_penalty_duration")
 	fd_Params_ibc_request_enabled = md_Params.Fields().ByName("ibc_request_enabled")
+	fd_Params_price_reference_oracle_script_id = md_Params.Fields().ByName("price_reference_oracle_script_id")
+	fd_Params_subscription_fee = md_Params.Fields().ByName("subscription_fee")
+	fd_Params_max_subscription_gas = md_Params.Fields().ByName("max_subscription_gas")
+	fd_Params_max_active_subscriptions = md_Params.Fields().ByName("max_active_subscriptions")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -9025,7 +13762,7 @@ func (x *Params) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Params) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9147,6 +13884,30 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.PriceReferenceOracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PriceReferenceOracleScriptId)
+		if !f(fd_Params_price_reference_oracle_script_id, value) {
+			return
+		}
+	}
+	if len(x.SubscriptionFee) != 0 {
+		value := protoreflect.ValueOfList(&_Params_13_list{list: &x.SubscriptionFee})
+		if !f(fd_Params_subscription_fee, value) {
+			return
+		}
+	}
+	if x.MaxSubscriptionGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxSubscriptionGas)
+		if !f(fd_Params_max_subscription_gas, value) {
+			return
+		}
+	}
+	if x.MaxActiveSubscriptions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxActiveSubscriptions)
+		if !f(fd_Params_max_active_subscriptions, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -9184,6 +13945,14 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.InactivePenaltyDuration != uint64(0)
 	case "band.oracle.v1.Params.ibc_request_enabled":
 		return x.IbcRequestEnabled != false
+	case "band.oracle.v1.Params.price_reference_oracle_script_id":
+		return x.PriceReferenceOracleScriptId != uint64(0)
+	case "band.oracle.v1.Params.subscription_fee":
+		return len(x.SubscriptionFee) != 0
+	case "band.oracle.v1.Params.max_subscription_gas":
+		return x.MaxSubscriptionGas != uint64(0)
+	case "band.oracle.v1.Params.max_active_subscriptions":
+		return x.MaxActiveSubscriptions != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
@@ -9222,6 +13991,14 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.InactivePenaltyDuration = uint64(0)
 	case "band.oracle.v1.Params.ibc_request_enabled":
 		x.IbcRequestEnabled = false
+	case "band.oracle.v1.Params.price_reference_oracle_script_id":
+		x.PriceReferenceOracleScriptId = uint64(0)
+	case "band.oracle.v1.Params.subscription_fee":
+		x.SubscriptionFee = nil
+	case "band.oracle.v1.Params.max_subscription_gas":
+		x.MaxSubscriptionGas = uint64(0)
+	case "band.oracle.v1.Params.max_active_subscriptions":
+		x.MaxActiveSubscriptions = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
@@ -9271,6 +14048,21 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "band.oracle.v1.Params.ibc_request_enabled":
 		value := x.IbcRequestEnabled
 		return protoreflect.ValueOfBool(value)
+	case "band.oracle.v1.Params.price_reference_oracle_script_id":
+		value := x.PriceReferenceOracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.subscription_fee":
+		if len(x.SubscriptionFee) == 0 {
+			return protoreflect.ValueOfList(&_Params_13_list{})
+		}
+		listValue := &_Params_13_list{list: &x.SubscriptionFee}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.Params.max_subscription_gas":
+		value := x.MaxSubscriptionGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.max_active_subscriptions":
+		value := x.MaxActiveSubscriptions
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
@@ -9313,6 +14105,16 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.InactivePenaltyDuration = value.Uint()
 	case "band.oracle.v1.Params.ibc_request_enabled":
 		x.IbcRequestEnabled = value.Bool()
+	case "band.oracle.v1.Params.price_reference_oracle_script_id":
+		x.PriceReferenceOracleScriptId = value.Uint()
+	case "band.oracle.v1.Params.subscription_fee":
+		lv := value.List()
+		clv := lv.(*_Params_13_list)
+		x.SubscriptionFee = *clv.list
+	case "band.oracle.v1.Params.max_subscription_gas":
+		x.MaxSubscriptionGas = value.Uint()
+	case "band.oracle.v1.Params.max_active_subscriptions":
+		x.MaxActiveSubscriptions = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
@@ -9333,6 +14135,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.oracle.v1.Params.subscription_fee":
+		if x.SubscriptionFee == nil {
+			x.SubscriptionFee = []*v1beta1.Coin{}
+		}
+		value := &_Params_13_list{list: &x.SubscriptionFee}
+		return protoreflect.ValueOfList(value)
 	case "band.oracle.v1.Params.max_raw_request_count":
 		panic(fmt.Errorf("field max_raw_request_count of message band.oracle.v1.Params is not mutable"))
 	case "band.oracle.v1.Params.max_ask_count":
@@ -9355,6 +14163,12 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field inactive_penalty_duration of message band.oracle.v1.Params is not mutable"))
 	case "band.oracle.v1.Params.ibc_request_enabled":
 		panic(fmt.Errorf("field ibc_request_enabled of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.price_reference_oracle_script_id":
+		panic(fmt.Errorf("field price_reference_oracle_script_id of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_subscription_gas":
+		panic(fmt.Errorf("field max_subscription_gas of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_active_subscriptions":
+		panic(fmt.Errorf("field max_active_subscriptions of message band.oracle.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
@@ -9390,6 +14204,15 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "band.oracle.v1.Params.ibc_request_enabled":
 		return protoreflect.ValueOfBool(false)
+	case "band.oracle.v1.Params.price_reference_oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.subscription_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_13_list{list: &list})
+	case "band.oracle.v1.Params.max_subscription_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.max_active_subscriptions":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
@@ -9492,6 +14315,21 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.IbcRequestEnabled {
 			n += 2
 		}
+		if x.PriceReferenceOracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PriceReferenceOracleScriptId))
+		}
+		if len(x.SubscriptionFee) > 0 {
+			for _, e := range x.SubscriptionFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxSubscriptionGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxSubscriptionGas))
+		}
+		if x.MaxActiveSubscriptions != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxActiveSubscriptions))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9509,17 +14347,48 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				Buf:               input.Buf,
 			}, nil
 		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxActiveSubscriptions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxActiveSubscriptions))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.MaxSubscriptionGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSubscriptionGas))
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.SubscriptionFee) > 0 {
+			for iNdEx := len(x.SubscriptionFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SubscriptionFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if x.PriceReferenceOracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PriceReferenceOracleScriptId))
+			i--
+			dAtA[i] = 0x60
 		}
 		if x.IbcRequestEnabled {
 			i--
@@ -9840,6 +14709,97 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.IbcRequestEnabled = bool(v != 0)
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceReferenceOracleScriptId", wireType)
+				}
+				x.PriceReferenceOracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PriceReferenceOracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubscriptionFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubscriptionFee = append(x.SubscriptionFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SubscriptionFee[len(x.SubscriptionFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSubscriptionGas", wireType)
+				}
+				x.MaxSubscriptionGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSubscriptionGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxActiveSubscriptions", wireType)
+				}
+				x.MaxActiveSubscriptions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxActiveSubscriptions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9941,7 +14901,7 @@ func (x *PendingResolveList) ProtoReflect() protoreflect.Message {
 }
 
 func (x *PendingResolveList) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10433,7 +15393,7 @@ func (x *IBCChannel) ProtoReflect() protoreflect.Message {
 }
 
 func (x *IBCChannel) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10923,7 +15883,7 @@ func (x *RequestVerification) ProtoReflect() protoreflect.Message {
 }
 
 func (x *RequestVerification) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11551,7 +16511,7 @@ func (x *PriceResult) ProtoReflect() protoreflect.Message {
 }
 
 func (x *PriceResult) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12157,7 +17117,7 @@ func (x *OracleResultSignatureOrder) ProtoReflect() protoreflect.Message {
 }
 
 func (x *OracleResultSignatureOrder) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12735,6 +17695,9 @@ type DataSource struct {
 	// Fee is the data source fee per ask_count that data provider will receive
 	// from requester.
 	Fee []*v1beta1.Coin `protobuf:"bytes,6,rep,name=fee,proto3" json:"fee,omitempty"`
+	// Version is the latest version of the data source. It is increased every
+	// time the executable, treasury or fee of the data source is changed.
+	Version uint64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *DataSource) Reset() {
@@ -12782,108 +17745,300 @@ func (x *DataSource) GetFilename() string {
 	if x != nil {
 		return x.Filename
 	}
-	return ""
+	return ""
+}
+
+func (x *DataSource) GetTreasury() string {
+	if x != nil {
+		return x.Treasury
+	}
+	return ""
+}
+
+func (x *DataSource) GetFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *DataSource) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// OracleScript is the data structure for storing oracle scripts in the storage.
+type OracleScript struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Owner is an address of the account who own the oracle script
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Name is oracle script name used for display
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Description is oracle script description used for display
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Filename is string of file name used as reference for locating
+	// compiled oracle script WASM file stored in bandchain nodes
+	Filename string `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Schema is the schema of the oracle script input/output
+	// which is formatted in OBI format e.g.
+	// "{symbol:string,multiplier:u64}/{px:u64}"
+	Schema string `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
+	// SourceCodeURL is the URL of oracle script's source code.
+	// It is recommendded to store source code on IPFS and get its URL to preserve
+	// decentralization.
+	SourceCodeUrl string `protobuf:"bytes,6,opt,name=source_code_url,json=sourceCodeUrl,proto3" json:"source_code_url,omitempty"`
+	// Version is the latest version of the oracle script. It is increased every
+	// time the code or schema of the oracle script is changed.
+	Version uint64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *OracleScript) Reset() {
+	*x = OracleScript{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OracleScript) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OracleScript) ProtoMessage() {}
+
+// Deprecated: Use OracleScript.ProtoReflect.Descriptor instead.
+func (*OracleScript) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OracleScript) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *OracleScript) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OracleScript) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OracleScript) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *OracleScript) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *OracleScript) GetSourceCodeUrl() string {
+	if x != nil {
+		return x.SourceCodeUrl
+	}
+	return ""
+}
+
+func (x *OracleScript) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// DataSourceVersion is an immutable record of the parts of a data source that
+// affect the data it produces at a specific version.
+type DataSourceVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DataSourceID is the ID of the data source
+	DataSourceId uint64 `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// Version is the version number of the data source, starting from 1
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Filename is the file name of the data source executable at this version
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Treasury is the account address who receive data source fee at this version
+	Treasury string `protobuf:"bytes,4,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	// Fee is the data source fee per ask_count at this version
+	Fee []*v1beta1.Coin `protobuf:"bytes,5,rep,name=fee,proto3" json:"fee,omitempty"`
+	// Height is the block height at which this version was created
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// Timestamp is the block time at which this version was created
+	Timestamp int64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *DataSourceVersion) Reset() {
+	*x = DataSourceVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataSourceVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataSourceVersion) ProtoMessage() {}
+
+// Deprecated: Use DataSourceVersion.ProtoReflect.Descriptor instead.
+func (*DataSourceVersion) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DataSourceVersion) GetDataSourceId() uint64 {
+	if x != nil {
+		return x.DataSourceId
+	}
+	return 0
+}
+
+func (x *DataSourceVersion) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DataSourceVersion) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *DataSourceVersion) GetTreasury() string {
+	if x != nil {
+		return x.Treasury
+	}
+	return ""
+}
+
+func (x *DataSourceVersion) GetFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
 }
 
-func (x *DataSource) GetTreasury() string {
+func (x *DataSourceVersion) GetHeight() int64 {
 	if x != nil {
-		return x.Treasury
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *DataSource) GetFee() []*v1beta1.Coin {
+func (x *DataSourceVersion) GetTimestamp() int64 {
 	if x != nil {
-		return x.Fee
+		return x.Timestamp
 	}
-	return nil
+	return 0
 }
 
-// OracleScript is the data structure for storing oracle scripts in the storage.
-type OracleScript struct {
+// OracleScriptVersion is an immutable record of the parts of an oracle script
+// that affect the result it produces at a specific version.
+type OracleScriptVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Owner is an address of the account who own the oracle script
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	// Name is oracle script name used for display
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Description is oracle script description used for display
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// Filename is string of file name used as reference for locating
-	// compiled oracle script WASM file stored in bandchain nodes
-	Filename string `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
-	// Schema is the schema of the oracle script input/output
-	// which is formatted in OBI format e.g.
-	// "{symbol:string,multiplier:u64}/{px:u64}"
-	Schema string `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
-	// SourceCodeURL is the URL of oracle script's source code.
-	// It is recommendded to store source code on IPFS and get its URL to preserve
-	// decentralization.
-	SourceCodeUrl string `protobuf:"bytes,6,opt,name=source_code_url,json=sourceCodeUrl,proto3" json:"source_code_url,omitempty"`
-}
-
-func (x *OracleScript) Reset() {
-	*x = OracleScript{}
+	// OracleScriptID is the ID of the oracle script
+	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// Version is the version number of the oracle script, starting from 1
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Filename is the file name of the compiled oracle script at this version
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Schema is the OBI schema of the oracle script at this version
+	Schema string `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	// Height is the block height at which this version was created
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// Timestamp is the block time at which this version was created
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *OracleScriptVersion) Reset() {
+	*x = OracleScriptVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[1]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OracleScript) String() string {
+func (x *OracleScriptVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OracleScript) ProtoMessage() {}
+func (*OracleScriptVersion) ProtoMessage() {}
 
-// Deprecated: Use OracleScript.ProtoReflect.Descriptor instead.
-func (*OracleScript) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use OracleScriptVersion.ProtoReflect.Descriptor instead.
+func (*OracleScriptVersion) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *OracleScript) GetOwner() string {
+func (x *OracleScriptVersion) GetOracleScriptId() uint64 {
 	if x != nil {
-		return x.Owner
+		return x.OracleScriptId
 	}
-	return ""
+	return 0
 }
 
-func (x *OracleScript) GetName() string {
+func (x *OracleScriptVersion) GetVersion() uint64 {
 	if x != nil {
-		return x.Name
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *OracleScript) GetDescription() string {
+func (x *OracleScriptVersion) GetFilename() string {
 	if x != nil {
-		return x.Description
+		return x.Filename
 	}
 	return ""
 }
 
-func (x *OracleScript) GetFilename() string {
+func (x *OracleScriptVersion) GetSchema() string {
 	if x != nil {
-		return x.Filename
+		return x.Schema
 	}
 	return ""
 }
 
-func (x *OracleScript) GetSchema() string {
+func (x *OracleScriptVersion) GetHeight() int64 {
 	if x != nil {
-		return x.Schema
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *OracleScript) GetSourceCodeUrl() string {
+func (x *OracleScriptVersion) GetTimestamp() int64 {
 	if x != nil {
-		return x.SourceCodeUrl
+		return x.Timestamp
 	}
-	return ""
+	return 0
 }
 
 // RawRequest is the data structure for storing raw requests in the storage.
@@ -12899,12 +18054,16 @@ type RawRequest struct {
 	// Calldata is the data used as argument params for executing data source
 	// script
 	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// DataSourceVersion is the version of the data source used by the raw
+	// request. Reporters execute the executable of this version rather than the
+	// current one of the data source.
+	DataSourceVersion uint64 `protobuf:"varint,4,opt,name=data_source_version,json=dataSourceVersion,proto3" json:"data_source_version,omitempty"`
 }
 
 func (x *RawRequest) Reset() {
 	*x = RawRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[2]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12918,7 +18077,7 @@ func (*RawRequest) ProtoMessage() {}
 
 // Deprecated: Use RawRequest.ProtoReflect.Descriptor instead.
 func (*RawRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RawRequest) GetExternalId() uint64 {
@@ -12942,6 +18101,13 @@ func (x *RawRequest) GetCalldata() []byte {
 	return nil
 }
 
+func (x *RawRequest) GetDataSourceVersion() uint64 {
+	if x != nil {
+		return x.DataSourceVersion
+	}
+	return 0
+}
+
 // RawRequest is the data structure for storing raw reporter in the storage.
 type RawReport struct {
 	state         protoimpl.MessageState
@@ -12963,7 +18129,7 @@ type RawReport struct {
 func (x *RawReport) Reset() {
 	*x = RawReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[3]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12977,7 +18143,7 @@ func (*RawReport) ProtoMessage() {}
 
 // Deprecated: Use RawReport.ProtoReflect.Descriptor instead.
 func (*RawReport) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{3}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RawReport) GetExternalId() uint64 {
@@ -13039,12 +18205,18 @@ type Request struct {
 	Requester string `protobuf:"bytes,12,opt,name=requester,proto3" json:"requester,omitempty"`
 	// FeeLimit is the maximum tokens that will be paid for this request.
 	FeeLimit []*v1beta1.Coin `protobuf:"bytes,13,rep,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	// Callback is the module route and payload to be notified when the request
+	// is resolved.
+	Callback *RequestCallback `protobuf:"bytes,14,opt,name=callback,proto3" json:"callback,omitempty"`
+	// OracleScriptVersion is the version of the oracle script used by the
+	// request
+	OracleScriptVersion uint64 `protobuf:"varint,15,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
 }
 
 func (x *Request) Reset() {
 	*x = Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[4]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13058,7 +18230,7 @@ func (*Request) ProtoMessage() {}
 
 // Deprecated: Use Request.ProtoReflect.Descriptor instead.
 func (*Request) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{4}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Request) GetOracleScriptId() uint64 {
@@ -13131,25 +18303,285 @@ func (x *Request) GetExecuteGas() uint64 {
 	return 0
 }
 
-func (x *Request) GetTssEncoder() Encoder {
+func (x *Request) GetTssEncoder() Encoder {
+	if x != nil {
+		return x.TssEncoder
+	}
+	return Encoder_ENCODER_UNSPECIFIED
+}
+
+func (x *Request) GetRequester() string {
+	if x != nil {
+		return x.Requester
+	}
+	return ""
+}
+
+func (x *Request) GetFeeLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.FeeLimit
+	}
+	return nil
+}
+
+func (x *Request) GetCallback() *RequestCallback {
+	if x != nil {
+		return x.Callback
+	}
+	return nil
+}
+
+func (x *Request) GetOracleScriptVersion() uint64 {
+	if x != nil {
+		return x.OracleScriptVersion
+	}
+	return 0
+}
+
+// RequestCallback is the data structure for a module callback attached to a
+// request. The module registered under the route is notified through its
+// OracleHooks once the request is resolved.
+type RequestCallback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Module is the route of the module to be notified.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// Payload is arbitrary data passed back to the module alongside the requester
+	// and the result. Modules must check the requester before trusting it.
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *RequestCallback) Reset() {
+	*x = RequestCallback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestCallback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestCallback) ProtoMessage() {}
+
+// Deprecated: Use RequestCallback.ProtoReflect.Descriptor instead.
+func (*RequestCallback) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RequestCallback) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *RequestCallback) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// Subscription is the data structure for a recurring oracle request that is
+// fired on schedule and paid from a prefunded escrow account.
+type Subscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID is the unique identifier of the subscription
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// OracleScriptID is ID of an oracle script
+	OracleScriptId uint64 `protobuf:"varint,2,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// Calldata is the data used as argument params for the oracle script
+	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is the number of validators to perform each oracle request.
+	AskCount uint64 `protobuf:"varint,4,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is the minimum number of validators sufficient to resolve each
+	// oracle request.
+	MinCount uint64 `protobuf:"varint,5,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// ClientID is arbitrary id provided by the owner. It is attached to every
+	// request made by the subscription.
+	ClientId string `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// FeeLimit is the maximum tokens that will be paid for each request.
+	FeeLimit []*v1beta1.Coin `protobuf:"bytes,7,rep,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	// PrepareGas is amount of gas to pay to prepare raw requests
+	PrepareGas uint64 `protobuf:"varint,8,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
+	// ExecuteGas is amount of gas to reserve for executing
+	ExecuteGas uint64 `protobuf:"varint,9,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	// TSSEncoder is the mode of encoding oracle result signature order.
+	TssEncoder Encoder `protobuf:"varint,10,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// BlockInterval is the number of blocks between two requests. Exactly one of
+	// BlockInterval and TimeInterval is set.
+	BlockInterval uint64 `protobuf:"varint,11,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	// TimeInterval is the number of seconds between two requests. Exactly one of
+	// BlockInterval and TimeInterval is set.
+	TimeInterval uint64 `protobuf:"varint,12,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
+	// Escrow is the account address holding the funding balance of the
+	// subscription. It is the fee payer of every request.
+	Escrow string `protobuf:"bytes,13,opt,name=escrow,proto3" json:"escrow,omitempty"`
+	// Owner is the account address that manages the subscription.
+	Owner string `protobuf:"bytes,14,opt,name=owner,proto3" json:"owner,omitempty"`
+	// IsActive is the flag indicating whether the subscription is firing
+	// requests. It is paused automatically once a request cannot be made.
+	IsActive bool `protobuf:"varint,15,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// LastRequestID is the ID of the latest request made by the subscription.
+	LastRequestId uint64 `protobuf:"varint,16,opt,name=last_request_id,json=lastRequestId,proto3" json:"last_request_id,omitempty"`
+	// LastRequestHeight is the block height of the latest request.
+	LastRequestHeight int64 `protobuf:"varint,17,opt,name=last_request_height,json=lastRequestHeight,proto3" json:"last_request_height,omitempty"`
+	// LastRequestTime is the block timestamp of the latest request.
+	LastRequestTime int64 `protobuf:"varint,18,opt,name=last_request_time,json=lastRequestTime,proto3" json:"last_request_time,omitempty"`
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Subscription) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Subscription) GetOracleScriptId() uint64 {
+	if x != nil {
+		return x.OracleScriptId
+	}
+	return 0
+}
+
+func (x *Subscription) GetCalldata() []byte {
+	if x != nil {
+		return x.Calldata
+	}
+	return nil
+}
+
+func (x *Subscription) GetAskCount() uint64 {
+	if x != nil {
+		return x.AskCount
+	}
+	return 0
+}
+
+func (x *Subscription) GetMinCount() uint64 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
+}
+
+func (x *Subscription) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *Subscription) GetFeeLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.FeeLimit
+	}
+	return nil
+}
+
+func (x *Subscription) GetPrepareGas() uint64 {
+	if x != nil {
+		return x.PrepareGas
+	}
+	return 0
+}
+
+func (x *Subscription) GetExecuteGas() uint64 {
+	if x != nil {
+		return x.ExecuteGas
+	}
+	return 0
+}
+
+func (x *Subscription) GetTssEncoder() Encoder {
+	if x != nil {
+		return x.TssEncoder
+	}
+	return Encoder_ENCODER_UNSPECIFIED
+}
+
+func (x *Subscription) GetBlockInterval() uint64 {
+	if x != nil {
+		return x.BlockInterval
+	}
+	return 0
+}
+
+func (x *Subscription) GetTimeInterval() uint64 {
+	if x != nil {
+		return x.TimeInterval
+	}
+	return 0
+}
+
+func (x *Subscription) GetEscrow() string {
+	if x != nil {
+		return x.Escrow
+	}
+	return ""
+}
+
+func (x *Subscription) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Subscription) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Subscription) GetLastRequestId() uint64 {
 	if x != nil {
-		return x.TssEncoder
+		return x.LastRequestId
 	}
-	return Encoder_ENCODER_UNSPECIFIED
+	return 0
 }
 
-func (x *Request) GetRequester() string {
+func (x *Subscription) GetLastRequestHeight() int64 {
 	if x != nil {
-		return x.Requester
+		return x.LastRequestHeight
 	}
-	return ""
+	return 0
 }
 
-func (x *Request) GetFeeLimit() []*v1beta1.Coin {
+func (x *Subscription) GetLastRequestTime() int64 {
 	if x != nil {
-		return x.FeeLimit
+		return x.LastRequestTime
 	}
-	return nil
+	return 0
 }
 
 // Report is the data structure for storing reports in the storage.
@@ -13171,7 +18603,7 @@ type Report struct {
 func (x *Report) Reset() {
 	*x = Report{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13185,7 +18617,7 @@ func (*Report) ProtoMessage() {}
 
 // Deprecated: Use Report.ProtoReflect.Descriptor instead.
 func (*Report) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{5}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Report) GetValidator() string {
@@ -13247,7 +18679,7 @@ type OracleRequestPacketData struct {
 func (x *OracleRequestPacketData) Reset() {
 	*x = OracleRequestPacketData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[6]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13261,7 +18693,7 @@ func (*OracleRequestPacketData) ProtoMessage() {}
 
 // Deprecated: Use OracleRequestPacketData.ProtoReflect.Descriptor instead.
 func (*OracleRequestPacketData) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{6}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *OracleRequestPacketData) GetClientId() string {
@@ -13341,7 +18773,7 @@ type OracleRequestPacketAcknowledgement struct {
 func (x *OracleRequestPacketAcknowledgement) Reset() {
 	*x = OracleRequestPacketAcknowledgement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[7]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13355,7 +18787,7 @@ func (*OracleRequestPacketAcknowledgement) ProtoMessage() {}
 
 // Deprecated: Use OracleRequestPacketAcknowledgement.ProtoReflect.Descriptor instead.
 func (*OracleRequestPacketAcknowledgement) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{7}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *OracleRequestPacketAcknowledgement) GetRequestId() uint64 {
@@ -13398,7 +18830,7 @@ type OracleResponsePacketData struct {
 func (x *OracleResponsePacketData) Reset() {
 	*x = OracleResponsePacketData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13412,7 +18844,7 @@ func (*OracleResponsePacketData) ProtoMessage() {}
 
 // Deprecated: Use OracleResponsePacketData.ProtoReflect.Descriptor instead.
 func (*OracleResponsePacketData) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{8}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *OracleResponsePacketData) GetClientId() string {
@@ -13508,7 +18940,7 @@ type Result struct {
 func (x *Result) Reset() {
 	*x = Result{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13522,7 +18954,7 @@ func (*Result) ProtoMessage() {}
 
 // Deprecated: Use Result.ProtoReflect.Descriptor instead.
 func (*Result) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{9}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Result) GetClientId() string {
@@ -13602,6 +19034,113 @@ func (x *Result) GetResult() []byte {
 	return nil
 }
 
+// RequestVersions is the record of the versions of the oracle script and data
+// sources used by a request. Unlike the request, it is kept after the request is
+// resolved and removed, and it is stored apart from the result so that the
+// encoding of the result is unchanged.
+type RequestVersions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OracleScriptVersion is the version of the oracle script used by the
+	// request.
+	OracleScriptVersion uint64 `protobuf:"varint,1,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
+	// RawRequestVersions is the list of versions of the data sources used by the
+	// raw requests of the request.
+	RawRequestVersions []*RawRequestVersion `protobuf:"bytes,2,rep,name=raw_request_versions,json=rawRequestVersions,proto3" json:"raw_request_versions,omitempty"`
+}
+
+func (x *RequestVersions) Reset() {
+	*x = RequestVersions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestVersions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestVersions) ProtoMessage() {}
+
+// Deprecated: Use RequestVersions.ProtoReflect.Descriptor instead.
+func (*RequestVersions) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RequestVersions) GetOracleScriptVersion() uint64 {
+	if x != nil {
+		return x.OracleScriptVersion
+	}
+	return 0
+}
+
+func (x *RequestVersions) GetRawRequestVersions() []*RawRequestVersion {
+	if x != nil {
+		return x.RawRequestVersions
+	}
+	return nil
+}
+
+// RawRequestVersion is the version of the data source used by a raw request.
+type RawRequestVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ExternalID is an ID of the raw request
+	ExternalId uint64 `protobuf:"varint,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// DataSourceID is an ID of data source script that relates to the raw request
+	DataSourceId uint64 `protobuf:"varint,2,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// DataSourceVersion is the version of the data source used by the raw
+	// request
+	DataSourceVersion uint64 `protobuf:"varint,3,opt,name=data_source_version,json=dataSourceVersion,proto3" json:"data_source_version,omitempty"`
+}
+
+func (x *RawRequestVersion) Reset() {
+	*x = RawRequestVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RawRequestVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RawRequestVersion) ProtoMessage() {}
+
+// Deprecated: Use RawRequestVersion.ProtoReflect.Descriptor instead.
+func (*RawRequestVersion) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RawRequestVersion) GetExternalId() uint64 {
+	if x != nil {
+		return x.ExternalId
+	}
+	return 0
+}
+
+func (x *RawRequestVersion) GetDataSourceId() uint64 {
+	if x != nil {
+		return x.DataSourceId
+	}
+	return 0
+}
+
+func (x *RawRequestVersion) GetDataSourceVersion() uint64 {
+	if x != nil {
+		return x.DataSourceVersion
+	}
+	return 0
+}
+
 // SigningResult encodes a result of signing of request
 type SigningResult struct {
 	state         protoimpl.MessageState
@@ -13619,7 +19158,7 @@ type SigningResult struct {
 func (x *SigningResult) Reset() {
 	*x = SigningResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13633,7 +19172,7 @@ func (*SigningResult) ProtoMessage() {}
 
 // Deprecated: Use SigningResult.ProtoReflect.Descriptor instead.
 func (*SigningResult) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{10}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SigningResult) GetSigningId() uint64 {
@@ -13674,7 +19213,7 @@ type ValidatorStatus struct {
 func (x *ValidatorStatus) Reset() {
 	*x = ValidatorStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13688,7 +19227,7 @@ func (*ValidatorStatus) ProtoMessage() {}
 
 // Deprecated: Use ValidatorStatus.ProtoReflect.Descriptor instead.
 func (*ValidatorStatus) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{11}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ValidatorStatus) GetIsActive() bool {
@@ -13720,7 +19259,7 @@ type ActiveValidator struct {
 func (x *ActiveValidator) Reset() {
 	*x = ActiveValidator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13734,7 +19273,7 @@ func (*ActiveValidator) ProtoMessage() {}
 
 // Deprecated: Use ActiveValidator.ProtoReflect.Descriptor instead.
 func (*ActiveValidator) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{12}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ActiveValidator) GetAddress() string {
@@ -13788,12 +19327,25 @@ type Params struct {
 	// IBCRequestEnabled is a flag indicating whether sending oracle request via
 	// IBC is allowed
 	IbcRequestEnabled bool `protobuf:"varint,11,opt,name=ibc_request_enabled,json=ibcRequestEnabled,proto3" json:"ibc_request_enabled,omitempty"`
+	// PriceReferenceOracleScriptID is the ID of the standard price reference
+	// oracle script whose results are indexed by symbol for the
+	// Query/RequestPrice RPC. Zero disables the price indexing.
+	PriceReferenceOracleScriptId uint64 `protobuf:"varint,12,opt,name=price_reference_oracle_script_id,json=priceReferenceOracleScriptId,proto3" json:"price_reference_oracle_script_id,omitempty"`
+	// SubscriptionFee is the fee charged from the escrow of a subscription to
+	// the fee collector every time the subscription makes a request, on top of
+	// the data source fees. A subscription that cannot pay it is paused.
+	SubscriptionFee []*v1beta1.Coin `protobuf:"bytes,13,rep,name=subscription_fee,json=subscriptionFee,proto3" json:"subscription_fee,omitempty"`
+	// MaxSubscriptionGas is the maximum amount of Cosmos-SDK gas a subscription
+	// can consume to make a request in the begin block.
+	MaxSubscriptionGas uint64 `protobuf:"varint,14,opt,name=max_subscription_gas,json=maxSubscriptionGas,proto3" json:"max_subscription_gas,omitempty"`
+	// MaxActiveSubscriptions is the maximum number of active subscriptions.
+	MaxActiveSubscriptions uint64 `protobuf:"varint,15,opt,name=max_active_subscriptions,json=maxActiveSubscriptions,proto3" json:"max_active_subscriptions,omitempty"`
 }
 
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13807,7 +19359,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{13}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *Params) GetMaxRawRequestCount() uint64 {
@@ -13887,6 +19439,34 @@ func (x *Params) GetIbcRequestEnabled() bool {
 	return false
 }
 
+func (x *Params) GetPriceReferenceOracleScriptId() uint64 {
+	if x != nil {
+		return x.PriceReferenceOracleScriptId
+	}
+	return 0
+}
+
+func (x *Params) GetSubscriptionFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.SubscriptionFee
+	}
+	return nil
+}
+
+func (x *Params) GetMaxSubscriptionGas() uint64 {
+	if x != nil {
+		return x.MaxSubscriptionGas
+	}
+	return 0
+}
+
+func (x *Params) GetMaxActiveSubscriptions() uint64 {
+	if x != nil {
+		return x.MaxActiveSubscriptions
+	}
+	return 0
+}
+
 // PendingResolveList is a list of requests that are waiting to be resolved
 type PendingResolveList struct {
 	state         protoimpl.MessageState
@@ -13900,7 +19480,7 @@ type PendingResolveList struct {
 func (x *PendingResolveList) Reset() {
 	*x = PendingResolveList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13914,7 +19494,7 @@ func (*PendingResolveList) ProtoMessage() {}
 
 // Deprecated: Use PendingResolveList.ProtoReflect.Descriptor instead.
 func (*PendingResolveList) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{14}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *PendingResolveList) GetRequestIds() []uint64 {
@@ -13942,7 +19522,7 @@ type IBCChannel struct {
 func (x *IBCChannel) Reset() {
 	*x = IBCChannel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13956,7 +19536,7 @@ func (*IBCChannel) ProtoMessage() {}
 
 // Deprecated: Use IBCChannel.ProtoReflect.Descriptor instead.
 func (*IBCChannel) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{15}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *IBCChannel) GetPortId() string {
@@ -13995,7 +19575,7 @@ type RequestVerification struct {
 func (x *RequestVerification) Reset() {
 	*x = RequestVerification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14009,7 +19589,7 @@ func (*RequestVerification) ProtoMessage() {}
 
 // Deprecated: Use RequestVerification.ProtoReflect.Descriptor instead.
 func (*RequestVerification) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{16}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *RequestVerification) GetChainId() string {
@@ -14071,7 +19651,7 @@ type PriceResult struct {
 func (x *PriceResult) Reset() {
 	*x = PriceResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14085,7 +19665,7 @@ func (*PriceResult) ProtoMessage() {}
 
 // Deprecated: Use PriceResult.ProtoReflect.Descriptor instead.
 func (*PriceResult) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{17}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *PriceResult) GetSymbol() string {
@@ -14138,7 +19718,7 @@ type OracleResultSignatureOrder struct {
 func (x *OracleResultSignatureOrder) Reset() {
 	*x = OracleResultSignatureOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14152,7 +19732,7 @@ func (*OracleResultSignatureOrder) ProtoMessage() {}
 
 // Deprecated: Use OracleResultSignatureOrder.ProtoReflect.Descriptor instead.
 func (*OracleResultSignatureOrder) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{18}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *OracleResultSignatureOrder) GetRequestId() uint64 {
@@ -14180,340 +19760,496 @@ var file_band_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
 	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf5, 0x01, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74,
-	0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
-	0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x12, 0x5d, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
-	0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x73, 0x52, 0x03, 0x66, 0x65, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xcf, 0x01, 0x0a,
-	0x0c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x39, 0x0a,
-	0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75, 0x72, 0x6c,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb5,
-	0x01, 0x0a, 0x0a, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a,
-	0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44,
-	0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0e,
-	0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61,
-	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x81, 0x01, 0x0a, 0x09, 0x52, 0x61, 0x77, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc5, 0x05, 0x0a, 0x07, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x09,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x61, 0x77, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x0b, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x0b,
-	0x69, 0x62, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x0e, 0xe2,
-	0xde, 0x1f, 0x0a, 0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x0a, 0x69,
-	0x62, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73,
-	0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53,
-	0x53, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
-	0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x65, 0x72, 0x12, 0x68, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x73, 0x52, 0x08, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x04, 0xe8, 0xa0,
-	0x1f, 0x01, 0x22, 0x9a, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x69,
-	0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
-	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x61, 0x77, 0x5f, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62,
-	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61,
-	0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72,
-	0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0xe6, 0x03, 0x0a, 0x17, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x09, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x68, 0x0a, 0x09,
-	0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x8f, 0x02, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x79, 0x12, 0x5d, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00,
 	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x66, 0x65,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72,
-	0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x65, 0x47, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73, 0x73, 0x5f,
-	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53, 0x53, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x65, 0x0a, 0x22, 0x4f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x41,
-	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39,
-	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0xc7, 0x02, 0x0a, 0x18, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x09,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f,
-	0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65,
-	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xdb, 0x03, 0x0a, 0x06, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f,
-	0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52,
-	0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61,
-	0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f,
-	0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc9, 0x01, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x6a, 0x0a, 0x0a, 0x73, 0x69, 0x67,
-	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x4b, 0xe2,
-	0xde, 0x1f, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x3a,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33,
-	0x2f, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x3a, 0x04, 0xe8,
-	0xa0, 0x1f, 0x01, 0x22, 0x70, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
-	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x3a,
-	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x41, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x22, 0xc4, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x73,
-	0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d,
-	0x61, 0x78, 0x41, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61,
-	0x78, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a,
-	0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x77, 0x61, 0x73, 0x6d, 0x5f, 0x67, 0x61, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x4f, 0x77, 0x61, 0x73, 0x6d,
-	0x47, 0x61, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x67, 0x61, 0x73,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x70, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x61, 0x73, 0x12, 0x2c,
-	0x0a, 0x12, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x61, 0x6d, 0x70,
-	0x6c, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x18,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x69, 0x6e, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x13, 0x69, 0x62, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42,
-	0x15, 0xe2, 0xde, 0x1f, 0x11, 0x49, 0x42, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x11, 0x69, 0x62, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0x3b, 0x0a, 0x12, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x64, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x4a, 0x0a, 0x0a,
-	0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
-	0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72,
-	0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa3, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x26, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0b, 0xe2, 0xde, 0x1f, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52,
-	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64,
-	0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb3,
-	0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
-	0x6c, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x78, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x70, 0x78, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x03, 0x66, 0x65,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
+	0x01, 0x22, 0xe9, 0x01, 0x0a, 0x0c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x12, 0x39, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xe2, 0xde, 0x1f,
+	0x0d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x0d,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc8, 0x02,
+	0x0a, 0x11, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f,
+	0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f,
+	0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x12, 0x5d, 0x0a,
+	0x03, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xef, 0x01, 0x0a, 0x13, 0x4f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde,
+	0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44,
+	0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xe5, 0x01, 0x0a, 0x0a, 0x52,
+	0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c,
+	0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa, 0xde,
+	0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x13,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x64, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x22, 0x81, 0x01, 0x0a, 0x09, 0x52, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb6, 0x06, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde,
+	0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44,
+	0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x31,
+	0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f,
+	0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x72, 0x61, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x0b, 0x69, 0x62, 0x63, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x42, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x49,
+	0x42, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x0a, 0x69, 0x62, 0x63, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73, 0x73, 0x5f, 0x65, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53, 0x53, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x68,
+	0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08,
+	0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x08, 0x63, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x32, 0x0a, 0x15, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
+	0x49, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xf4, 0x06, 0x0a, 0x0c, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x18, 0xe2, 0xde, 0x1f, 0x02, 0x49, 0x44, 0xfa,
+	0xde, 0x1f, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
+	0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x68, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x47, 0x61,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x47,
+	0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73, 0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
+	0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53, 0x53, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
+	0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x06, 0x65, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x06, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x46, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x1e, 0xe2, 0xde, 0x1f, 0x0d, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
+	0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x2e, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6c, 0x61,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
+	0x01, 0x22, 0x9a, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e,
+	0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x77,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72, 0x61,
+	0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xe6,
+	0x03, 0x0a, 0x17, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
+	0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x68, 0x0a, 0x09, 0x66,
+	0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x66, 0x65, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65,
+	0x5f, 0x67, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x65, 0x47, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73, 0x73, 0x5f, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53, 0x53, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x65, 0x0a, 0x22, 0x4f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
+	0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc7,
+	0x02, 0x0a, 0x18, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x09, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
+	0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d,
 	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x1a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31,
-	0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x72, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x2a, 0xfb, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f,
-	0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x1f, 0x52, 0x45, 0x53,
-	0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x17,
-	0x8a, 0x9d, 0x20, 0x13, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c,
-	0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
-	0x53, 0x10, 0x01, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x12,
-	0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20,
-	0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c,
-	0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
-	0x44, 0x10, 0x03, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x1a,
-	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x6a, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
-	0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x43,
-	0x4f, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10,
-	0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x41, 0x42, 0x49,
-	0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41,
-	0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x41, 0x42, 0x49, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e,
-	0x00, 0x42, 0xb9, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61,
-	0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x61,
-	0x6e, 0x64, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x42,
-	0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a,
-	0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61, 0x6e,
-	0x64, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xdb, 0x03, 0x0a, 0x06, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4e,
+	0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73,
+	0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61,
+	0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa6, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x59,
+	0x0a, 0x14, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
+	0xd0, 0x01, 0x0a, 0x11, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde,
+	0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde,
+	0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c,
+	0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x64, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x22, 0xc9, 0x01, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x6a, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x4b, 0xe2, 0xde, 0x1f, 0x09, 0x53, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x78, 0x2f, 0x62, 0x61,
+	0x6e, 0x64, 0x74, 0x73, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x69, 0x6e, 0x67, 0x49, 0x44, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64,
+	0x12, 0x27, 0x0a, 0x0f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x70,
+	0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x3a,
+	0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90,
+	0xdf, 0x1f, 0x01, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
+	0x22, 0x41, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x22, 0xa4, 0x07, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x31,
+	0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d,
+	0x61, 0x78, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x73, 0x6b,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x61, 0x6c,
+	0x6c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x11, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x14, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x6f, 0x77, 0x61, 0x73, 0x6d, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x4f, 0x77, 0x61, 0x73, 0x6d, 0x47, 0x61, 0x73, 0x12, 0x39,
+	0x0a, 0x19, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x16, 0x70, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x61, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x54,
+	0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x3a, 0x0a, 0x19, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a,
+	0x13, 0x69, 0x62, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x15, 0xe2, 0xde, 0x1f, 0x11,
+	0x49, 0x42, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x52, 0x11, 0x69, 0x62, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x7a, 0x0a, 0x20, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x42, 0x32,
+	0xe2, 0xde, 0x1f, 0x1c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44,
+	0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x49, 0x44, 0x52, 0x1c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64,
+	0x12, 0x76, 0x0a, 0x10, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x66, 0x65, 0x65, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x61, 0x73,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x6d, 0x61,
+	0x78, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6d, 0x61,
+	0x78, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x3b, 0x0a, 0x12, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x4a, 0x0a, 0x0a, 0x49, 0x42, 0x43, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x3a, 0x04, 0xe8,
+	0xa0, 0x1f, 0x01, 0x22, 0xa3, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xe2,
+	0xde, 0x1f, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0b,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49,
+	0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52,
+	0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb3, 0x01, 0x0a, 0x0b, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x70,
+	0x78, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x90, 0x01, 0x0a, 0x1a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x39,
+	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x65, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a, 0x04, 0x88, 0xa0,
+	0x1f, 0x00, 0x2a, 0xfb, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x1f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x17, 0x8a, 0x9d, 0x20, 0x13, 0x52,
+	0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50,
+	0x45, 0x4e, 0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x1a, 0x1a,
+	0x8a, 0x9d, 0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x12, 0x36, 0x0a, 0x16, 0x52, 0x45,
+	0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x52, 0x45, 0x53, 0x4f,
+	0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55,
+	0x52, 0x45, 0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x1a,
+	0x8a, 0x9d, 0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00,
+	0x2a, 0x6a, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x13, 0x45,
+	0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f,
+	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x4e, 0x43, 0x4f, 0x44,
+	0x45, 0x52, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x41, 0x42, 0x49, 0x10, 0x02, 0x12, 0x17, 0x0a,
+	0x13, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c,
+	0x5f, 0x41, 0x42, 0x49, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xb9, 0x01, 0x0a,
+	0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
+	0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x42, 0x61, 0x6e, 0x64, 0x5c,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -14529,50 +20265,62 @@ func file_band_oracle_v1_oracle_proto_rawDescGZIP() []byte {
 }
 
 var file_band_oracle_v1_oracle_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_band_oracle_v1_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_band_oracle_v1_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_band_oracle_v1_oracle_proto_goTypes = []interface{}{
 	(ResolveStatus)(0),                         // 0: band.oracle.v1.ResolveStatus
 	(Encoder)(0),                               // 1: band.oracle.v1.Encoder
 	(*DataSource)(nil),                         // 2: band.oracle.v1.DataSource
 	(*OracleScript)(nil),                       // 3: band.oracle.v1.OracleScript
-	(*RawRequest)(nil),                         // 4: band.oracle.v1.RawRequest
-	(*RawReport)(nil),                          // 5: band.oracle.v1.RawReport
-	(*Request)(nil),                            // 6: band.oracle.v1.Request
-	(*Report)(nil),                             // 7: band.oracle.v1.Report
-	(*OracleRequestPacketData)(nil),            // 8: band.oracle.v1.OracleRequestPacketData
-	(*OracleRequestPacketAcknowledgement)(nil), // 9: band.oracle.v1.OracleRequestPacketAcknowledgement
-	(*OracleResponsePacketData)(nil),           // 10: band.oracle.v1.OracleResponsePacketData
-	(*Result)(nil),                             // 11: band.oracle.v1.Result
-	(*SigningResult)(nil),                      // 12: band.oracle.v1.SigningResult
-	(*ValidatorStatus)(nil),                    // 13: band.oracle.v1.ValidatorStatus
-	(*ActiveValidator)(nil),                    // 14: band.oracle.v1.ActiveValidator
-	(*Params)(nil),                             // 15: band.oracle.v1.Params
-	(*PendingResolveList)(nil),                 // 16: band.oracle.v1.PendingResolveList
-	(*IBCChannel)(nil),                         // 17: band.oracle.v1.IBCChannel
-	(*RequestVerification)(nil),                // 18: band.oracle.v1.RequestVerification
-	(*PriceResult)(nil),                        // 19: band.oracle.v1.PriceResult
-	(*OracleResultSignatureOrder)(nil),         // 20: band.oracle.v1.OracleResultSignatureOrder
-	(*v1beta1.Coin)(nil),                       // 21: cosmos.base.v1beta1.Coin
-	(*timestamppb.Timestamp)(nil),              // 22: google.protobuf.Timestamp
+	(*DataSourceVersion)(nil),                  // 4: band.oracle.v1.DataSourceVersion
+	(*OracleScriptVersion)(nil),                // 5: band.oracle.v1.OracleScriptVersion
+	(*RawRequest)(nil),                         // 6: band.oracle.v1.RawRequest
+	(*RawReport)(nil),                          // 7: band.oracle.v1.RawReport
+	(*Request)(nil),                            // 8: band.oracle.v1.Request
+	(*RequestCallback)(nil),                    // 9: band.oracle.v1.RequestCallback
+	(*Subscription)(nil),                       // 10: band.oracle.v1.Subscription
+	(*Report)(nil),                             // 11: band.oracle.v1.Report
+	(*OracleRequestPacketData)(nil),            // 12: band.oracle.v1.OracleRequestPacketData
+	(*OracleRequestPacketAcknowledgement)(nil), // 13: band.oracle.v1.OracleRequestPacketAcknowledgement
+	(*OracleResponsePacketData)(nil),           // 14: band.oracle.v1.OracleResponsePacketData
+	(*Result)(nil),                             // 15: band.oracle.v1.Result
+	(*RequestVersions)(nil),                    // 16: band.oracle.v1.RequestVersions
+	(*RawRequestVersion)(nil),                  // 17: band.oracle.v1.RawRequestVersion
+	(*SigningResult)(nil),                      // 18: band.oracle.v1.SigningResult
+	(*ValidatorStatus)(nil),                    // 19: band.oracle.v1.ValidatorStatus
+	(*ActiveValidator)(nil),                    // 20: band.oracle.v1.ActiveValidator
+	(*Params)(nil),                             // 21: band.oracle.v1.Params
+	(*PendingResolveList)(nil),                 // 22: band.oracle.v1.PendingResolveList
+	(*IBCChannel)(nil),                         // 23: band.oracle.v1.IBCChannel
+	(*RequestVerification)(nil),                // 24: band.oracle.v1.RequestVerification
+	(*PriceResult)(nil),                        // 25: band.oracle.v1.PriceResult
+	(*OracleResultSignatureOrder)(nil),         // 26: band.oracle.v1.OracleResultSignatureOrder
+	(*v1beta1.Coin)(nil),                       // 27: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil),              // 28: google.protobuf.Timestamp
 }
 var file_band_oracle_v1_oracle_proto_depIdxs = []int32{
-	21, // 0: band.oracle.v1.DataSource.fee:type_name -> cosmos.base.v1beta1.Coin
-	4,  // 1: band.oracle.v1.Request.raw_requests:type_name -> band.oracle.v1.RawRequest
-	17, // 2: band.oracle.v1.Request.ibc_channel:type_name -> band.oracle.v1.IBCChannel
-	1,  // 3: band.oracle.v1.Request.tss_encoder:type_name -> band.oracle.v1.Encoder
-	21, // 4: band.oracle.v1.Request.fee_limit:type_name -> cosmos.base.v1beta1.Coin
-	5,  // 5: band.oracle.v1.Report.raw_reports:type_name -> band.oracle.v1.RawReport
-	21, // 6: band.oracle.v1.OracleRequestPacketData.fee_limit:type_name -> cosmos.base.v1beta1.Coin
-	1,  // 7: band.oracle.v1.OracleRequestPacketData.tss_encoder:type_name -> band.oracle.v1.Encoder
-	0,  // 8: band.oracle.v1.OracleResponsePacketData.resolve_status:type_name -> band.oracle.v1.ResolveStatus
-	0,  // 9: band.oracle.v1.Result.resolve_status:type_name -> band.oracle.v1.ResolveStatus
-	22, // 10: band.oracle.v1.ValidatorStatus.since:type_name -> google.protobuf.Timestamp
-	1,  // 11: band.oracle.v1.OracleResultSignatureOrder.encoder:type_name -> band.oracle.v1.Encoder
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	27, // 0: band.oracle.v1.DataSource.fee:type_name -> cosmos.base.v1beta1.Coin
+	27, // 1: band.oracle.v1.DataSourceVersion.fee:type_name -> cosmos.base.v1beta1.Coin
+	6,  // 2: band.oracle.v1.Request.raw_requests:type_name -> band.oracle.v1.RawRequest
+	23, // 3: band.oracle.v1.Request.ibc_channel:type_name -> band.oracle.v1.IBCChannel
+	1,  // 4: band.oracle.v1.Request.tss_encoder:type_name -> band.oracle.v1.Encoder
+	27, // 5: band.oracle.v1.Request.fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	9,  // 6: band.oracle.v1.Request.callback:type_name -> band.oracle.v1.RequestCallback
+	27, // 7: band.oracle.v1.Subscription.fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 8: band.oracle.v1.Subscription.tss_encoder:type_name -> band.oracle.v1.Encoder
+	7,  // 9: band.oracle.v1.Report.raw_reports:type_name -> band.oracle.v1.RawReport
+	27, // 10: band.oracle.v1.OracleRequestPacketData.fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 11: band.oracle.v1.OracleRequestPacketData.tss_encoder:type_name -> band.oracle.v1.Encoder
+	0,  // 12: band.oracle.v1.OracleResponsePacketData.resolve_status:type_name -> band.oracle.v1.ResolveStatus
+	0,  // 13: band.oracle.v1.Result.resolve_status:type_name -> band.oracle.v1.ResolveStatus
+	17, // 14: band.oracle.v1.RequestVersions.raw_request_versions:type_name -> band.oracle.v1.RawRequestVersion
+	28, // 15: band.oracle.v1.ValidatorStatus.since:type_name -> google.protobuf.Timestamp
+	27, // 16: band.oracle.v1.Params.subscription_fee:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 17: band.oracle.v1.OracleResultSignatureOrder.encoder:type_name -> band.oracle.v1.Encoder
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_band_oracle_v1_oracle_proto_init() }
@@ -14606,7 +20354,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RawRequest); i {
+			switch v := v.(*DataSourceVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14618,7 +20366,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RawReport); i {
+			switch v := v.(*OracleScriptVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14630,7 +20378,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Request); i {
+			switch v := v.(*RawRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14642,7 +20390,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Report); i {
+			switch v := v.(*RawReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14654,7 +20402,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OracleRequestPacketData); i {
+			switch v := v.(*Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14666,7 +20414,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OracleRequestPacketAcknowledgement); i {
+			switch v := v.(*RequestCallback); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14678,7 +20426,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OracleResponsePacketData); i {
+			switch v := v.(*Subscription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14690,7 +20438,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Result); i {
+			switch v := v.(*Report); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14702,7 +20450,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SigningResult); i {
+			switch v := v.(*OracleRequestPacketData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14714,7 +20462,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorStatus); i {
+			switch v := v.(*OracleRequestPacketAcknowledgement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14726,7 +20474,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActiveValidator); i {
+			switch v := v.(*OracleResponsePacketData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14738,7 +20486,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Params); i {
+			switch v := v.(*Result); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14750,7 +20498,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PendingResolveList); i {
+			switch v := v.(*RequestVersions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14762,7 +20510,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IBCChannel); i {
+			switch v := v.(*RawRequestVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14774,7 +20522,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestVerification); i {
+			switch v := v.(*SigningResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14786,7 +20534,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PriceResult); i {
+			switch v := v.(*ValidatorStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14798,6 +20546,78 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActiveValidator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingResolveList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IBCChannel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestVerification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OracleResultSignatureOrder); i {
 			case 0:
 				return &v.state
@@ -14816,7 +20636,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_oracle_v1_oracle_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   19,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -2,6 +2,8 @@
 package oraclev1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	v1beta11 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -3392,25 +3394,27 @@ func (x *fastReflection_QueryOracleScriptResponse) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_QueryRequestRequest            protoreflect.MessageDescriptor
-	fd_QueryRequestRequest_request_id protoreflect.FieldDescriptor
+	md_QueryDataSourceVersionsRequest                protoreflect.MessageDescriptor
+	fd_QueryDataSourceVersionsRequest_data_source_id protoreflect.FieldDescriptor
+	fd_QueryDataSourceVersionsRequest_pagination     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryRequestRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestRequest")
-	fd_QueryRequestRequest_request_id = md_QueryRequestRequest.Fields().ByName("request_id")
+	md_QueryDataSourceVersionsRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryDataSourceVersionsRequest")
+	fd_QueryDataSourceVersionsRequest_data_source_id = md_QueryDataSourceVersionsRequest.Fields().ByName("data_source_id")
+	fd_QueryDataSourceVersionsRequest_pagination = md_QueryDataSourceVersionsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryRequestRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryDataSourceVersionsRequest)(nil)
 
-type fastReflection_QueryRequestRequest QueryRequestRequest
+type fastReflection_QueryDataSourceVersionsRequest QueryDataSourceVersionsRequest
 
-func (x *QueryRequestRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryRequestRequest)(x)
+func (x *QueryDataSourceVersionsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDataSourceVersionsRequest)(x)
 }
 
-func (x *QueryRequestRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryDataSourceVersionsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3422,43 +3426,43 @@ func (x *QueryRequestRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryRequestRequest_messageType fastReflection_QueryRequestRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryRequestRequest_messageType{}
+var _fastReflection_QueryDataSourceVersionsRequest_messageType fastReflection_QueryDataSourceVersionsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDataSourceVersionsRequest_messageType{}
 
-type fastReflection_QueryRequestRequest_messageType struct{}
+type fastReflection_QueryDataSourceVersionsRequest_messageType struct{}
 
-func (x fastReflection_QueryRequestRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryRequestRequest)(nil)
+func (x fastReflection_QueryDataSourceVersionsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDataSourceVersionsRequest)(nil)
 }
-func (x fastReflection_QueryRequestRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestRequest)
+func (x fastReflection_QueryDataSourceVersionsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDataSourceVersionsRequest)
 }
-func (x fastReflection_QueryRequestRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestRequest
+func (x fastReflection_QueryDataSourceVersionsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDataSourceVersionsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryRequestRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestRequest
+func (x *fastReflection_QueryDataSourceVersionsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDataSourceVersionsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryRequestRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryRequestRequest_messageType
+func (x *fastReflection_QueryDataSourceVersionsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDataSourceVersionsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryRequestRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestRequest)
+func (x *fastReflection_QueryDataSourceVersionsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryDataSourceVersionsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryRequestRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryRequestRequest)(x)
+func (x *fastReflection_QueryDataSourceVersionsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryDataSourceVersionsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3466,10 +3470,16 @@ func (x *fastReflection_QueryRequestRequest) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryRequestRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.RequestId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_QueryRequestRequest_request_id, value) {
+func (x *fastReflection_QueryDataSourceVersionsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_QueryDataSourceVersionsRequest_data_source_id, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryDataSourceVersionsRequest_pagination, value) {
 			return
 		}
 	}
@@ -3486,15 +3496,17 @@ func (x *fastReflection_QueryRequestRequest) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryRequestRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryDataSourceVersionsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestRequest.request_id":
-		return x.RequestId != uint64(0)
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.data_source_id":
+		return x.DataSourceId != uint64(0)
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3504,15 +3516,17 @@ func (x *fastReflection_QueryRequestRequest) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryDataSourceVersionsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestRequest.request_id":
-		x.RequestId = uint64(0)
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.data_source_id":
+		x.DataSourceId = uint64(0)
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3522,16 +3536,19 @@ func (x *fastReflection_QueryRequestRequest) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryRequestRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryDataSourceVersionsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryRequestRequest.request_id":
-		value := x.RequestId
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.data_source_id":
+		value := x.DataSourceId
 		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3545,15 +3562,17 @@ func (x *fastReflection_QueryRequestRequest) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryDataSourceVersionsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestRequest.request_id":
-		x.RequestId = value.Uint()
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.data_source_id":
+		x.DataSourceId = value.Uint()
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3567,40 +3586,48 @@ func (x *fastReflection_QueryRequestRequest) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryDataSourceVersionsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestRequest.request_id":
-		panic(fmt.Errorf("field request_id of message band.oracle.v1.QueryRequestRequest is not mutable"))
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.QueryDataSourceVersionsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryRequestRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryDataSourceVersionsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestRequest.request_id":
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.data_source_id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryRequestRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryDataSourceVersionsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryDataSourceVersionsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3608,7 +3635,7 @@ func (x *fastReflection_QueryRequestRequest) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryRequestRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryDataSourceVersionsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3619,7 +3646,7 @@ func (x *fastReflection_QueryRequestRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryDataSourceVersionsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3631,7 +3658,7 @@ func (x *fastReflection_QueryRequestRequest) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryRequestRequest) IsValid() bool {
+func (x *fastReflection_QueryDataSourceVersionsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3641,9 +3668,9 @@ func (x *fastReflection_QueryRequestRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryRequestRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryDataSourceVersionsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryRequestRequest)
+		x := input.Message.Interface().(*QueryDataSourceVersionsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3655,8 +3682,12 @@ func (x *fastReflection_QueryRequestRequest) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.RequestId != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestId))
+		if x.DataSourceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceId))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3668,7 +3699,7 @@ func (x *fastReflection_QueryRequestRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestRequest)
+		x := input.Message.Interface().(*QueryDataSourceVersionsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3687,8 +3718,22 @@ func (x *fastReflection_QueryRequestRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.RequestId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -3703,7 +3748,7 @@ func (x *fastReflection_QueryRequestRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestRequest)
+		x := input.Message.Interface().(*QueryDataSourceVersionsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3735,17 +3780,17 @@ func (x *fastReflection_QueryRequestRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDataSourceVersionsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDataSourceVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
 				}
-				x.RequestId = 0
+				x.DataSourceId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3755,11 +3800,47 @@ func (x *fastReflection_QueryRequestRequest) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
+					x.DataSourceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3795,83 +3876,79 @@ func (x *fastReflection_QueryRequestRequest) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_QueryRequestResponse_2_list)(nil)
+var _ protoreflect.List = (*_QueryDataSourceVersionsResponse_1_list)(nil)
 
-type _QueryRequestResponse_2_list struct {
-	list *[]*Report
+type _QueryDataSourceVersionsResponse_1_list struct {
+	list *[]*DataSourceVersion
 }
 
-func (x *_QueryRequestResponse_2_list) Len() int {
+func (x *_QueryDataSourceVersionsResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryRequestResponse_2_list) Get(i int) protoreflect.Value {
+func (x *_QueryDataSourceVersionsResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryRequestResponse_2_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryDataSourceVersionsResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Report)
+	concreteValue := valueUnwrapped.Interface().(*DataSourceVersion)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryRequestResponse_2_list) Append(value protoreflect.Value) {
+func (x *_QueryDataSourceVersionsResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Report)
+	concreteValue := valueUnwrapped.Interface().(*DataSourceVersion)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryRequestResponse_2_list) AppendMutable() protoreflect.Value {
-	v := new(Report)
+func (x *_QueryDataSourceVersionsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(DataSourceVersion)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryRequestResponse_2_list) Truncate(n int) {
+func (x *_QueryDataSourceVersionsResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryRequestResponse_2_list) NewElement() protoreflect.Value {
-	v := new(Report)
+func (x *_QueryDataSourceVersionsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(DataSourceVersion)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryRequestResponse_2_list) IsValid() bool {
+func (x *_QueryDataSourceVersionsResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryRequestResponse         protoreflect.MessageDescriptor
-	fd_QueryRequestResponse_request protoreflect.FieldDescriptor
-	fd_QueryRequestResponse_reports protoreflect.FieldDescriptor
-	fd_QueryRequestResponse_result  protoreflect.FieldDescriptor
-	fd_QueryRequestResponse_signing protoreflect.FieldDescriptor
+	md_QueryDataSourceVersionsResponse            protoreflect.MessageDescriptor
+	fd_QueryDataSourceVersionsResponse_versions   protoreflect.FieldDescriptor
+	fd_QueryDataSourceVersionsResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryRequestResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestResponse")
-	fd_QueryRequestResponse_request = md_QueryRequestResponse.Fields().ByName("request")
-	fd_QueryRequestResponse_reports = md_QueryRequestResponse.Fields().ByName("reports")
-	fd_QueryRequestResponse_result = md_QueryRequestResponse.Fields().ByName("result")
-	fd_QueryRequestResponse_signing = md_QueryRequestResponse.Fields().ByName("signing")
+	md_QueryDataSourceVersionsResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryDataSourceVersionsResponse")
+	fd_QueryDataSourceVersionsResponse_versions = md_QueryDataSourceVersionsResponse.Fields().ByName("versions")
+	fd_QueryDataSourceVersionsResponse_pagination = md_QueryDataSourceVersionsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryRequestResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryDataSourceVersionsResponse)(nil)
 
-type fastReflection_QueryRequestResponse QueryRequestResponse
+type fastReflection_QueryDataSourceVersionsResponse QueryDataSourceVersionsResponse
 
-func (x *QueryRequestResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryRequestResponse)(x)
+func (x *QueryDataSourceVersionsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDataSourceVersionsResponse)(x)
 }
 
-func (x *QueryRequestResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryDataSourceVersionsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3883,43 +3960,43 @@ func (x *QueryRequestResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryRequestResponse_messageType fastReflection_QueryRequestResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryRequestResponse_messageType{}
+var _fastReflection_QueryDataSourceVersionsResponse_messageType fastReflection_QueryDataSourceVersionsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDataSourceVersionsResponse_messageType{}
 
-type fastReflection_QueryRequestResponse_messageType struct{}
+type fastReflection_QueryDataSourceVersionsResponse_messageType struct{}
 
-func (x fastReflection_QueryRequestResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryRequestResponse)(nil)
+func (x fastReflection_QueryDataSourceVersionsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDataSourceVersionsResponse)(nil)
 }
-func (x fastReflection_QueryRequestResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestResponse)
+func (x fastReflection_QueryDataSourceVersionsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDataSourceVersionsResponse)
 }
-func (x fastReflection_QueryRequestResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestResponse
+func (x fastReflection_QueryDataSourceVersionsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDataSourceVersionsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryRequestResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestResponse
+func (x *fastReflection_QueryDataSourceVersionsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDataSourceVersionsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryRequestResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryRequestResponse_messageType
+func (x *fastReflection_QueryDataSourceVersionsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDataSourceVersionsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryRequestResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestResponse)
+func (x *fastReflection_QueryDataSourceVersionsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryDataSourceVersionsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryRequestResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryRequestResponse)(x)
+func (x *fastReflection_QueryDataSourceVersionsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryDataSourceVersionsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3927,28 +4004,16 @@ func (x *fastReflection_QueryRequestResponse) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryRequestResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Request != nil {
-		value := protoreflect.ValueOfMessage(x.Request.ProtoReflect())
-		if !f(fd_QueryRequestResponse_request, value) {
-			return
-		}
-	}
-	if len(x.Reports) != 0 {
-		value := protoreflect.ValueOfList(&_QueryRequestResponse_2_list{list: &x.Reports})
-		if !f(fd_QueryRequestResponse_reports, value) {
-			return
-		}
-	}
-	if x.Result != nil {
-		value := protoreflect.ValueOfMessage(x.Result.ProtoReflect())
-		if !f(fd_QueryRequestResponse_result, value) {
+func (x *fastReflection_QueryDataSourceVersionsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Versions) != 0 {
+		value := protoreflect.ValueOfList(&_QueryDataSourceVersionsResponse_1_list{list: &x.Versions})
+		if !f(fd_QueryDataSourceVersionsResponse_versions, value) {
 			return
 		}
 	}
-	if x.Signing != nil {
-		value := protoreflect.ValueOfMessage(x.Signing.ProtoReflect())
-		if !f(fd_QueryRequestResponse_signing, value) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryDataSourceVersionsResponse_pagination, value) {
 			return
 		}
 	}
@@ -3965,21 +4030,17 @@ func (x *fastReflection_QueryRequestResponse) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryRequestResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryDataSourceVersionsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestResponse.request":
-		return x.Request != nil
-	case "band.oracle.v1.QueryRequestResponse.reports":
-		return len(x.Reports) != 0
-	case "band.oracle.v1.QueryRequestResponse.result":
-		return x.Result != nil
-	case "band.oracle.v1.QueryRequestResponse.signing":
-		return x.Signing != nil
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.versions":
+		return len(x.Versions) != 0
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3989,21 +4050,17 @@ func (x *fastReflection_QueryRequestResponse) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryDataSourceVersionsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestResponse.request":
-		x.Request = nil
-	case "band.oracle.v1.QueryRequestResponse.reports":
-		x.Reports = nil
-	case "band.oracle.v1.QueryRequestResponse.result":
-		x.Result = nil
-	case "band.oracle.v1.QueryRequestResponse.signing":
-		x.Signing = nil
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.versions":
+		x.Versions = nil
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4013,28 +4070,22 @@ func (x *fastReflection_QueryRequestResponse) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryRequestResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryDataSourceVersionsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryRequestResponse.request":
-		value := x.Request
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.oracle.v1.QueryRequestResponse.reports":
-		if len(x.Reports) == 0 {
-			return protoreflect.ValueOfList(&_QueryRequestResponse_2_list{})
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.versions":
+		if len(x.Versions) == 0 {
+			return protoreflect.ValueOfList(&_QueryDataSourceVersionsResponse_1_list{})
 		}
-		listValue := &_QueryRequestResponse_2_list{list: &x.Reports}
+		listValue := &_QueryDataSourceVersionsResponse_1_list{list: &x.Versions}
 		return protoreflect.ValueOfList(listValue)
-	case "band.oracle.v1.QueryRequestResponse.result":
-		value := x.Result
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.oracle.v1.QueryRequestResponse.signing":
-		value := x.Signing
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4048,23 +4099,19 @@ func (x *fastReflection_QueryRequestResponse) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryDataSourceVersionsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestResponse.request":
-		x.Request = value.Message().Interface().(*Request)
-	case "band.oracle.v1.QueryRequestResponse.reports":
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.versions":
 		lv := value.List()
-		clv := lv.(*_QueryRequestResponse_2_list)
-		x.Reports = *clv.list
-	case "band.oracle.v1.QueryRequestResponse.result":
-		x.Result = value.Message().Interface().(*Result)
-	case "band.oracle.v1.QueryRequestResponse.signing":
-		x.Signing = value.Message().Interface().(*SigningResult)
+		clv := lv.(*_QueryDataSourceVersionsResponse_1_list)
+		x.Versions = *clv.list
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4078,69 +4125,53 @@ func (x *fastReflection_QueryRequestResponse) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryDataSourceVersionsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestResponse.request":
-		if x.Request == nil {
-			x.Request = new(Request)
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.versions":
+		if x.Versions == nil {
+			x.Versions = []*DataSourceVersion{}
 		}
-		return protoreflect.ValueOfMessage(x.Request.ProtoReflect())
-	case "band.oracle.v1.QueryRequestResponse.reports":
-		if x.Reports == nil {
-			x.Reports = []*Report{}
-		}
-		value := &_QueryRequestResponse_2_list{list: &x.Reports}
+		value := &_QueryDataSourceVersionsResponse_1_list{list: &x.Versions}
 		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.QueryRequestResponse.result":
-		if x.Result == nil {
-			x.Result = new(Result)
-		}
-		return protoreflect.ValueOfMessage(x.Result.ProtoReflect())
-	case "band.oracle.v1.QueryRequestResponse.signing":
-		if x.Signing == nil {
-			x.Signing = new(SigningResult)
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
 		}
-		return protoreflect.ValueOfMessage(x.Signing.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryRequestResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryDataSourceVersionsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestResponse.request":
-		m := new(Request)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.oracle.v1.QueryRequestResponse.reports":
-		list := []*Report{}
-		return protoreflect.ValueOfList(&_QueryRequestResponse_2_list{list: &list})
-	case "band.oracle.v1.QueryRequestResponse.result":
-		m := new(Result)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.oracle.v1.QueryRequestResponse.signing":
-		m := new(SigningResult)
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.versions":
+		list := []*DataSourceVersion{}
+		return protoreflect.ValueOfList(&_QueryDataSourceVersionsResponse_1_list{list: &list})
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryRequestResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryDataSourceVersionsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryDataSourceVersionsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4148,7 +4179,7 @@ func (x *fastReflection_QueryRequestResponse) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryRequestResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryDataSourceVersionsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4159,7 +4190,7 @@ func (x *fastReflection_QueryRequestResponse) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryDataSourceVersionsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4171,7 +4202,7 @@ func (x *fastReflection_QueryRequestResponse) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryRequestResponse) IsValid() bool {
+func (x *fastReflection_QueryDataSourceVersionsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4181,9 +4212,9 @@ func (x *fastReflection_QueryRequestResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryRequestResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryDataSourceVersionsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryRequestResponse)
+		x := input.Message.Interface().(*QueryDataSourceVersionsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4195,22 +4226,14 @@ func (x *fastReflection_QueryRequestResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Request != nil {
-			l = options.Size(x.Request)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Reports) > 0 {
-			for _, e := range x.Reports {
+		if len(x.Versions) > 0 {
+			for _, e := range x.Versions {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.Result != nil {
-			l = options.Size(x.Result)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Signing != nil {
-			l = options.Size(x.Signing)
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4223,7 +4246,7 @@ func (x *fastReflection_QueryRequestResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestResponse)
+		x := input.Message.Interface().(*QueryDataSourceVersionsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4242,22 +4265,8 @@ func (x *fastReflection_QueryRequestResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Signing != nil {
-			encoded, err := options.Marshal(x.Signing)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.Result != nil {
-			encoded, err := options.Marshal(x.Result)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4268,11 +4277,11 @@ func (x *fastReflection_QueryRequestResponse) ProtoMethods() *protoiface.Methods
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
-		if len(x.Reports) > 0 {
-			for iNdEx := len(x.Reports) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Reports[iNdEx])
+		if len(x.Versions) > 0 {
+			for iNdEx := len(x.Versions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Versions[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4283,22 +4292,8 @@ func (x *fastReflection_QueryRequestResponse) ProtoMethods() *protoiface.Methods
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.Request != nil {
-			encoded, err := options.Marshal(x.Request)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+				dAtA[i] = 0xa
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4311,7 +4306,7 @@ func (x *fastReflection_QueryRequestResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestResponse)
+		x := input.Message.Interface().(*QueryDataSourceVersionsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4343,15 +4338,15 @@ func (x *fastReflection_QueryRequestResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDataSourceVersionsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDataSourceVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4378,86 +4373,14 @@ func (x *fastReflection_QueryRequestResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Request == nil {
-					x.Request = &Request{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Request); err != nil {
+				x.Versions = append(x.Versions, &DataSourceVersion{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Versions[len(x.Versions)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Reports = append(x.Reports, &Report{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Reports[len(x.Reports)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Result == nil {
-					x.Result = &Result{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Result); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signing", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4484,10 +4407,10 @@ func (x *fastReflection_QueryRequestResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Signing == nil {
-					x.Signing = &SigningResult{}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signing); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4527,25 +4450,27 @@ func (x *fastReflection_QueryRequestResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryPendingRequestsRequest                   protoreflect.MessageDescriptor
-	fd_QueryPendingRequestsRequest_validator_address protoreflect.FieldDescriptor
+	md_QueryOracleScriptVersionsRequest                  protoreflect.MessageDescriptor
+	fd_QueryOracleScriptVersionsRequest_oracle_script_id protoreflect.FieldDescriptor
+	fd_QueryOracleScriptVersionsRequest_pagination       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryPendingRequestsRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryPendingRequestsRequest")
-	fd_QueryPendingRequestsRequest_validator_address = md_QueryPendingRequestsRequest.Fields().ByName("validator_address")
+	md_QueryOracleScriptVersionsRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryOracleScriptVersionsRequest")
+	fd_QueryOracleScriptVersionsRequest_oracle_script_id = md_QueryOracleScriptVersionsRequest.Fields().ByName("oracle_script_id")
+	fd_QueryOracleScriptVersionsRequest_pagination = md_QueryOracleScriptVersionsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryPendingRequestsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryOracleScriptVersionsRequest)(nil)
 
-type fastReflection_QueryPendingRequestsRequest QueryPendingRequestsRequest
+type fastReflection_QueryOracleScriptVersionsRequest QueryOracleScriptVersionsRequest
 
-func (x *QueryPendingRequestsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryPendingRequestsRequest)(x)
+func (x *QueryOracleScriptVersionsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOracleScriptVersionsRequest)(x)
 }
 
-func (x *QueryPendingRequestsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryOracleScriptVersionsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4557,43 +4482,43 @@ func (x *QueryPendingRequestsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryPendingRequestsRequest_messageType fastReflection_QueryPendingRequestsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryPendingRequestsRequest_messageType{}
+var _fastReflection_QueryOracleScriptVersionsRequest_messageType fastReflection_QueryOracleScriptVersionsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOracleScriptVersionsRequest_messageType{}
 
-type fastReflection_QueryPendingRequestsRequest_messageType struct{}
+type fastReflection_QueryOracleScriptVersionsRequest_messageType struct{}
 
-func (x fastReflection_QueryPendingRequestsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryPendingRequestsRequest)(nil)
+func (x fastReflection_QueryOracleScriptVersionsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOracleScriptVersionsRequest)(nil)
 }
-func (x fastReflection_QueryPendingRequestsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryPendingRequestsRequest)
+func (x fastReflection_QueryOracleScriptVersionsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOracleScriptVersionsRequest)
 }
-func (x fastReflection_QueryPendingRequestsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPendingRequestsRequest
+func (x fastReflection_QueryOracleScriptVersionsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOracleScriptVersionsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryPendingRequestsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPendingRequestsRequest
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOracleScriptVersionsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryPendingRequestsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryPendingRequestsRequest_messageType
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOracleScriptVersionsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryPendingRequestsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryPendingRequestsRequest)
+func (x *fastReflection_QueryOracleScriptVersionsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryOracleScriptVersionsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryPendingRequestsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryPendingRequestsRequest)(x)
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryOracleScriptVersionsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4601,10 +4526,16 @@ func (x *fastReflection_QueryPendingRequestsRequest) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryPendingRequestsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ValidatorAddress != "" {
-		value := protoreflect.ValueOfString(x.ValidatorAddress)
-		if !f(fd_QueryPendingRequestsRequest_validator_address, value) {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_QueryOracleScriptVersionsRequest_oracle_script_id, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryOracleScriptVersionsRequest_pagination, value) {
 			return
 		}
 	}
@@ -4621,15 +4552,17 @@ func (x *fastReflection_QueryPendingRequestsRequest) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryPendingRequestsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryPendingRequestsRequest.validator_address":
-		return x.ValidatorAddress != ""
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4639,15 +4572,17 @@ func (x *fastReflection_QueryPendingRequestsRequest) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPendingRequestsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryPendingRequestsRequest.validator_address":
-		x.ValidatorAddress = ""
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4657,16 +4592,19 @@ func (x *fastReflection_QueryPendingRequestsRequest) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryPendingRequestsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryPendingRequestsRequest.validator_address":
-		value := x.ValidatorAddress
-		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4680,15 +4618,17 @@ func (x *fastReflection_QueryPendingRequestsRequest) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPendingRequestsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryPendingRequestsRequest.validator_address":
-		x.ValidatorAddress = value.Interface().(string)
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4702,40 +4642,48 @@ func (x *fastReflection_QueryPendingRequestsRequest) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPendingRequestsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryPendingRequestsRequest.validator_address":
-		panic(fmt.Errorf("field validator_address of message band.oracle.v1.QueryPendingRequestsRequest is not mutable"))
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.QueryOracleScriptVersionsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryPendingRequestsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryPendingRequestsRequest.validator_address":
-		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryPendingRequestsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryPendingRequestsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryOracleScriptVersionsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4743,7 +4691,7 @@ func (x *fastReflection_QueryPendingRequestsRequest) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryPendingRequestsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4754,7 +4702,7 @@ func (x *fastReflection_QueryPendingRequestsRequest) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPendingRequestsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4766,7 +4714,7 @@ func (x *fastReflection_QueryPendingRequestsRequest) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryPendingRequestsRequest) IsValid() bool {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4776,9 +4724,9 @@ func (x *fastReflection_QueryPendingRequestsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryPendingRequestsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryPendingRequestsRequest)
+		x := input.Message.Interface().(*QueryOracleScriptVersionsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4790,10 +4738,13 @@ func (x *fastReflection_QueryPendingRequestsRequest) ProtoMethods() *protoiface.
 		var n int
 		var l int
 		_ = l
-		l = len(x.ValidatorAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4804,7 +4755,7 @@ func (x *fastReflection_QueryPendingRequestsRequest) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPendingRequestsRequest)
+		x := input.Message.Interface().(*QueryOracleScriptVersionsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4823,12 +4774,24 @@ func (x *fastReflection_QueryPendingRequestsRequest) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ValidatorAddress) > 0 {
-			i -= len(x.ValidatorAddress)
-			copy(dAtA[i:], x.ValidatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4841,7 +4804,7 @@ func (x *fastReflection_QueryPendingRequestsRequest) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPendingRequestsRequest)
+		x := input.Message.Interface().(*QueryOracleScriptVersionsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4873,17 +4836,36 @@ func (x *fastReflection_QueryPendingRequestsRequest) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingRequestsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOracleScriptVersionsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOracleScriptVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				}
+				x.OracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4893,23 +4875,27 @@ func (x *fastReflection_QueryPendingRequestsRequest) ProtoMethods() *protoiface.
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4946,72 +4932,79 @@ func (x *fastReflection_QueryPendingRequestsRequest) ProtoMethods() *protoiface.
 	}
 }
 
-var _ protoreflect.List = (*_QueryPendingRequestsResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryOracleScriptVersionsResponse_1_list)(nil)
 
-type _QueryPendingRequestsResponse_1_list struct {
-	list *[]uint64
+type _QueryOracleScriptVersionsResponse_1_list struct {
+	list *[]*OracleScriptVersion
 }
 
-func (x *_QueryPendingRequestsResponse_1_list) Len() int {
+func (x *_QueryOracleScriptVersionsResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryPendingRequestsResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfUint64((*x.list)[i])
+func (x *_QueryOracleScriptVersionsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryPendingRequestsResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := valueUnwrapped
+func (x *_QueryOracleScriptVersionsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OracleScriptVersion)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryPendingRequestsResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := valueUnwrapped
+func (x *_QueryOracleScriptVersionsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OracleScriptVersion)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryPendingRequestsResponse_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message QueryPendingRequestsResponse at list field RequestIds as it is not of Message kind"))
+func (x *_QueryOracleScriptVersionsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(OracleScriptVersion)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryPendingRequestsResponse_1_list) Truncate(n int) {
+func (x *_QueryOracleScriptVersionsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryPendingRequestsResponse_1_list) NewElement() protoreflect.Value {
-	v := uint64(0)
-	return protoreflect.ValueOfUint64(v)
+func (x *_QueryOracleScriptVersionsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(OracleScriptVersion)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryPendingRequestsResponse_1_list) IsValid() bool {
+func (x *_QueryOracleScriptVersionsResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryPendingRequestsResponse             protoreflect.MessageDescriptor
-	fd_QueryPendingRequestsResponse_request_ids protoreflect.FieldDescriptor
+	md_QueryOracleScriptVersionsResponse            protoreflect.MessageDescriptor
+	fd_QueryOracleScriptVersionsResponse_versions   protoreflect.FieldDescriptor
+	fd_QueryOracleScriptVersionsResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryPendingRequestsResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryPendingRequestsResponse")
-	fd_QueryPendingRequestsResponse_request_ids = md_QueryPendingRequestsResponse.Fields().ByName("request_ids")
+	md_QueryOracleScriptVersionsResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryOracleScriptVersionsResponse")
+	fd_QueryOracleScriptVersionsResponse_versions = md_QueryOracleScriptVersionsResponse.Fields().ByName("versions")
+	fd_QueryOracleScriptVersionsResponse_pagination = md_QueryOracleScriptVersionsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryPendingRequestsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryOracleScriptVersionsResponse)(nil)
 
-type fastReflection_QueryPendingRequestsResponse QueryPendingRequestsResponse
+type fastReflection_QueryOracleScriptVersionsResponse QueryOracleScriptVersionsResponse
 
-func (x *QueryPendingRequestsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryPendingRequestsResponse)(x)
+func (x *QueryOracleScriptVersionsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOracleScriptVersionsResponse)(x)
 }
 
-func (x *QueryPendingRequestsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryOracleScriptVersionsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5023,43 +5016,43 @@ func (x *QueryPendingRequestsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryPendingRequestsResponse_messageType fastReflection_QueryPendingRequestsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryPendingRequestsResponse_messageType{}
+var _fastReflection_QueryOracleScriptVersionsResponse_messageType fastReflection_QueryOracleScriptVersionsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOracleScriptVersionsResponse_messageType{}
 
-type fastReflection_QueryPendingRequestsResponse_messageType struct{}
+type fastReflection_QueryOracleScriptVersionsResponse_messageType struct{}
 
-func (x fastReflection_QueryPendingRequestsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryPendingRequestsResponse)(nil)
+func (x fastReflection_QueryOracleScriptVersionsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOracleScriptVersionsResponse)(nil)
 }
-func (x fastReflection_QueryPendingRequestsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryPendingRequestsResponse)
+func (x fastReflection_QueryOracleScriptVersionsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOracleScriptVersionsResponse)
 }
-func (x fastReflection_QueryPendingRequestsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPendingRequestsResponse
+func (x fastReflection_QueryOracleScriptVersionsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOracleScriptVersionsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryPendingRequestsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPendingRequestsResponse
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOracleScriptVersionsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryPendingRequestsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryPendingRequestsResponse_messageType
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOracleScriptVersionsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryPendingRequestsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryPendingRequestsResponse)
+func (x *fastReflection_QueryOracleScriptVersionsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryOracleScriptVersionsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryPendingRequestsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryPendingRequestsResponse)(x)
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryOracleScriptVersionsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5067,10 +5060,16 @@ func (x *fastReflection_QueryPendingRequestsResponse) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryPendingRequestsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.RequestIds) != 0 {
-		value := protoreflect.ValueOfList(&_QueryPendingRequestsResponse_1_list{list: &x.RequestIds})
-		if !f(fd_QueryPendingRequestsResponse_request_ids, value) {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Versions) != 0 {
+		value := protoreflect.ValueOfList(&_QueryOracleScriptVersionsResponse_1_list{list: &x.Versions})
+		if !f(fd_QueryOracleScriptVersionsResponse_versions, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryOracleScriptVersionsResponse_pagination, value) {
 			return
 		}
 	}
@@ -5087,15 +5086,17 @@ func (x *fastReflection_QueryPendingRequestsResponse) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryPendingRequestsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryPendingRequestsResponse.request_ids":
-		return len(x.RequestIds) != 0
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.versions":
+		return len(x.Versions) != 0
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5105,15 +5106,17 @@ func (x *fastReflection_QueryPendingRequestsResponse) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPendingRequestsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryPendingRequestsResponse.request_ids":
-		x.RequestIds = nil
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.versions":
+		x.Versions = nil
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5123,19 +5126,22 @@ func (x *fastReflection_QueryPendingRequestsResponse) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryPendingRequestsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryPendingRequestsResponse.request_ids":
-		if len(x.RequestIds) == 0 {
-			return protoreflect.ValueOfList(&_QueryPendingRequestsResponse_1_list{})
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.versions":
+		if len(x.Versions) == 0 {
+			return protoreflect.ValueOfList(&_QueryOracleScriptVersionsResponse_1_list{})
 		}
-		listValue := &_QueryPendingRequestsResponse_1_list{list: &x.RequestIds}
+		listValue := &_QueryOracleScriptVersionsResponse_1_list{list: &x.Versions}
 		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5149,17 +5155,19 @@ func (x *fastReflection_QueryPendingRequestsResponse) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPendingRequestsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryPendingRequestsResponse.request_ids":
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.versions":
 		lv := value.List()
-		clv := lv.(*_QueryPendingRequestsResponse_1_list)
-		x.RequestIds = *clv.list
+		clv := lv.(*_QueryOracleScriptVersionsResponse_1_list)
+		x.Versions = *clv.list
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5173,45 +5181,53 @@ func (x *fastReflection_QueryPendingRequestsResponse) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPendingRequestsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryPendingRequestsResponse.request_ids":
-		if x.RequestIds == nil {
-			x.RequestIds = []uint64{}
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.versions":
+		if x.Versions == nil {
+			x.Versions = []*OracleScriptVersion{}
 		}
-		value := &_QueryPendingRequestsResponse_1_list{list: &x.RequestIds}
+		value := &_QueryOracleScriptVersionsResponse_1_list{list: &x.Versions}
 		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryPendingRequestsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryPendingRequestsResponse.request_ids":
-		list := []uint64{}
-		return protoreflect.ValueOfList(&_QueryPendingRequestsResponse_1_list{list: &list})
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.versions":
+		list := []*OracleScriptVersion{}
+		return protoreflect.ValueOfList(&_QueryOracleScriptVersionsResponse_1_list{list: &list})
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryPendingRequestsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryPendingRequestsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryOracleScriptVersionsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5219,7 +5235,7 @@ func (x *fastReflection_QueryPendingRequestsResponse) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryPendingRequestsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5230,7 +5246,7 @@ func (x *fastReflection_QueryPendingRequestsResponse) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPendingRequestsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5242,7 +5258,7 @@ func (x *fastReflection_QueryPendingRequestsResponse) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryPendingRequestsResponse) IsValid() bool {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5252,9 +5268,9 @@ func (x *fastReflection_QueryPendingRequestsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryPendingRequestsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryPendingRequestsResponse)
+		x := input.Message.Interface().(*QueryOracleScriptVersionsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5266,12 +5282,15 @@ func (x *fastReflection_QueryPendingRequestsResponse) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
-		if len(x.RequestIds) > 0 {
-			l = 0
-			for _, e := range x.RequestIds {
-				l += runtime.Sov(uint64(e))
+		if len(x.Versions) > 0 {
+			for _, e := range x.Versions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
 			}
-			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5283,7 +5302,7 @@ func (x *fastReflection_QueryPendingRequestsResponse) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPendingRequestsResponse)
+		x := input.Message.Interface().(*QueryOracleScriptVersionsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5302,25 +5321,35 @@ func (x *fastReflection_QueryPendingRequestsResponse) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.RequestIds) > 0 {
-			var pksize2 int
-			for _, num := range x.RequestIds {
-				pksize2 += runtime.Sov(uint64(num))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
-			i -= pksize2
-			j1 := i
-			for _, num := range x.RequestIds {
-				for num >= 1<<7 {
-					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j1++
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Versions) > 0 {
+			for iNdEx := len(x.Versions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Versions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
 				}
-				dAtA[j1] = uint8(num)
-				j1++
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
 			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
-			i--
-			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5333,7 +5362,7 @@ func (x *fastReflection_QueryPendingRequestsResponse) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPendingRequestsResponse)
+		x := input.Message.Interface().(*QueryOracleScriptVersionsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5365,88 +5394,82 @@ func (x *fastReflection_QueryPendingRequestsResponse) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingRequestsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOracleScriptVersionsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOracleScriptVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType == 0 {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					x.RequestIds = append(x.RequestIds, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Versions = append(x.Versions, &OracleScriptVersion{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Versions[len(x.Versions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					if postIndex > l {
+					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					var elementCount int
-					var count int
-					for _, integer := range dAtA[iNdEx:postIndex] {
-						if integer < 128 {
-							count++
-						}
-					}
-					elementCount = count
-					if elementCount != 0 && len(x.RequestIds) == 0 {
-						x.RequestIds = make([]uint64, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.RequestIds = append(x.RequestIds, v)
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestIds", wireType)
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5483,23 +5506,25 @@ func (x *fastReflection_QueryPendingRequestsResponse) ProtoMethods() *protoiface
 }
 
 var (
-	md_QueryParamsRequest protoreflect.MessageDescriptor
+	md_QueryRequestRequest            protoreflect.MessageDescriptor
+	fd_QueryRequestRequest_request_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryParamsRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryParamsRequest")
+	md_QueryRequestRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestRequest")
+	fd_QueryRequestRequest_request_id = md_QueryRequestRequest.Fields().ByName("request_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryRequestRequest)(nil)
 
-type fastReflection_QueryParamsRequest QueryParamsRequest
+type fastReflection_QueryRequestRequest QueryRequestRequest
 
-func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryParamsRequest)(x)
+func (x *QueryRequestRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRequestRequest)(x)
 }
 
-func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryRequestRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5511,43 +5536,43 @@ func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
+var _fastReflection_QueryRequestRequest_messageType fastReflection_QueryRequestRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRequestRequest_messageType{}
 
-type fastReflection_QueryParamsRequest_messageType struct{}
+type fastReflection_QueryRequestRequest_messageType struct{}
 
-func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryParamsRequest)(nil)
+func (x fastReflection_QueryRequestRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRequestRequest)(nil)
 }
-func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsRequest)
+func (x fastReflection_QueryRequestRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestRequest)
 }
-func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsRequest
+func (x fastReflection_QueryRequestRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsRequest
+func (x *fastReflection_QueryRequestRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryParamsRequest_messageType
+func (x *fastReflection_QueryRequestRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRequestRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsRequest)
+func (x *fastReflection_QueryRequestRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryParamsRequest)(x)
+func (x *fastReflection_QueryRequestRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryRequestRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5555,7 +5580,13 @@ func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryRequestRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_QueryRequestRequest_request_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -5569,13 +5600,15 @@ func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryRequestRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestRequest.request_id":
+		return x.RequestId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5585,13 +5618,15 @@ func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryRequestRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestRequest.request_id":
+		x.RequestId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5601,13 +5636,16 @@ func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryRequestRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryRequestRequest.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5621,13 +5659,15 @@ func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryRequestRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestRequest.request_id":
+		x.RequestId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5641,36 +5681,40 @@ func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryRequestRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestRequest.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.QueryRequestRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryRequestRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestRequest.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryRequestRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryParamsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5678,7 +5722,7 @@ func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryRequestRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5689,7 +5733,7 @@ func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryRequestRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5701,7 +5745,7 @@ func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryParamsRequest) IsValid() bool {
+func (x *fastReflection_QueryRequestRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5711,9 +5755,9 @@ func (x *fastReflection_QueryParamsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryRequestRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryRequestRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5725,6 +5769,9 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5735,7 +5782,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryRequestRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5754,6 +5801,11 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -5765,7 +5817,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryRequestRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5797,12 +5849,31 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5838,26 +5909,85 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var (
-	md_QueryParamsResponse        protoreflect.MessageDescriptor
-	fd_QueryParamsResponse_params protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_QueryRequestResponse_2_list)(nil)
 
-func init() {
-	file_band_oracle_v1_query_proto_init()
-	md_QueryParamsResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryParamsResponse")
-	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
+type _QueryRequestResponse_2_list struct {
+	list *[]*Report
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
-
-type fastReflection_QueryParamsResponse QueryParamsResponse
-
-func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryParamsResponse)(x)
+func (x *_QueryRequestResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
+func (x *_QueryRequestResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryRequestResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Report)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryRequestResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Report)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryRequestResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(Report)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryRequestResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryRequestResponse_2_list) NewElement() protoreflect.Value {
+	v := new(Report)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryRequestResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryRequestResponse          protoreflect.MessageDescriptor
+	fd_QueryRequestResponse_request  protoreflect.FieldDescriptor
+	fd_QueryRequestResponse_reports  protoreflect.FieldDescriptor
+	fd_QueryRequestResponse_result   protoreflect.FieldDescriptor
+	fd_QueryRequestResponse_signing  protoreflect.FieldDescriptor
+	fd_QueryRequestResponse_versions protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryRequestResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestResponse")
+	fd_QueryRequestResponse_request = md_QueryRequestResponse.Fields().ByName("request")
+	fd_QueryRequestResponse_reports = md_QueryRequestResponse.Fields().ByName("reports")
+	fd_QueryRequestResponse_result = md_QueryRequestResponse.Fields().ByName("result")
+	fd_QueryRequestResponse_signing = md_QueryRequestResponse.Fields().ByName("signing")
+	fd_QueryRequestResponse_versions = md_QueryRequestResponse.Fields().ByName("versions")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRequestResponse)(nil)
+
+type fastReflection_QueryRequestResponse QueryRequestResponse
+
+func (x *QueryRequestResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRequestResponse)(x)
+}
+
+func (x *QueryRequestResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5869,43 +5999,43 @@ func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
+var _fastReflection_QueryRequestResponse_messageType fastReflection_QueryRequestResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRequestResponse_messageType{}
 
-type fastReflection_QueryParamsResponse_messageType struct{}
+type fastReflection_QueryRequestResponse_messageType struct{}
 
-func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryParamsResponse)(nil)
+func (x fastReflection_QueryRequestResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRequestResponse)(nil)
 }
-func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsResponse)
+func (x fastReflection_QueryRequestResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestResponse)
 }
-func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsResponse
+func (x fastReflection_QueryRequestResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsResponse
+func (x *fastReflection_QueryRequestResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryParamsResponse_messageType
+func (x *fastReflection_QueryRequestResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRequestResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsResponse)
+func (x *fastReflection_QueryRequestResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryParamsResponse)(x)
+func (x *fastReflection_QueryRequestResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryRequestResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5913,10 +6043,34 @@ func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_QueryParamsResponse_params, value) {
+func (x *fastReflection_QueryRequestResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Request != nil {
+		value := protoreflect.ValueOfMessage(x.Request.ProtoReflect())
+		if !f(fd_QueryRequestResponse_request, value) {
+			return
+		}
+	}
+	if len(x.Reports) != 0 {
+		value := protoreflect.ValueOfList(&_QueryRequestResponse_2_list{list: &x.Reports})
+		if !f(fd_QueryRequestResponse_reports, value) {
+			return
+		}
+	}
+	if x.Result != nil {
+		value := protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+		if !f(fd_QueryRequestResponse_result, value) {
+			return
+		}
+	}
+	if x.Signing != nil {
+		value := protoreflect.ValueOfMessage(x.Signing.ProtoReflect())
+		if !f(fd_QueryRequestResponse_signing, value) {
+			return
+		}
+	}
+	if x.Versions != nil {
+		value := protoreflect.ValueOfMessage(x.Versions.ProtoReflect())
+		if !f(fd_QueryRequestResponse_versions, value) {
 			return
 		}
 	}
@@ -5933,15 +6087,23 @@ func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryRequestResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryParamsResponse.params":
-		return x.Params != nil
+	case "band.oracle.v1.QueryRequestResponse.request":
+		return x.Request != nil
+	case "band.oracle.v1.QueryRequestResponse.reports":
+		return len(x.Reports) != 0
+	case "band.oracle.v1.QueryRequestResponse.result":
+		return x.Result != nil
+	case "band.oracle.v1.QueryRequestResponse.signing":
+		return x.Signing != nil
+	case "band.oracle.v1.QueryRequestResponse.versions":
+		return x.Versions != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5951,15 +6113,23 @@ func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryRequestResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryParamsResponse.params":
-		x.Params = nil
+	case "band.oracle.v1.QueryRequestResponse.request":
+		x.Request = nil
+	case "band.oracle.v1.QueryRequestResponse.reports":
+		x.Reports = nil
+	case "band.oracle.v1.QueryRequestResponse.result":
+		x.Result = nil
+	case "band.oracle.v1.QueryRequestResponse.signing":
+		x.Signing = nil
+	case "band.oracle.v1.QueryRequestResponse.versions":
+		x.Versions = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5969,16 +6139,31 @@ func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryRequestResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryParamsResponse.params":
-		value := x.Params
+	case "band.oracle.v1.QueryRequestResponse.request":
+		value := x.Request
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.oracle.v1.QueryRequestResponse.reports":
+		if len(x.Reports) == 0 {
+			return protoreflect.ValueOfList(&_QueryRequestResponse_2_list{})
+		}
+		listValue := &_QueryRequestResponse_2_list{list: &x.Reports}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.QueryRequestResponse.result":
+		value := x.Result
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.oracle.v1.QueryRequestResponse.signing":
+		value := x.Signing
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.oracle.v1.QueryRequestResponse.versions":
+		value := x.Versions
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5992,15 +6177,25 @@ func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryRequestResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryParamsResponse.params":
-		x.Params = value.Message().Interface().(*Params)
+	case "band.oracle.v1.QueryRequestResponse.request":
+		x.Request = value.Message().Interface().(*Request)
+	case "band.oracle.v1.QueryRequestResponse.reports":
+		lv := value.List()
+		clv := lv.(*_QueryRequestResponse_2_list)
+		x.Reports = *clv.list
+	case "band.oracle.v1.QueryRequestResponse.result":
+		x.Result = value.Message().Interface().(*Result)
+	case "band.oracle.v1.QueryRequestResponse.signing":
+		x.Signing = value.Message().Interface().(*SigningResult)
+	case "band.oracle.v1.QueryRequestResponse.versions":
+		x.Versions = value.Message().Interface().(*RequestVersions)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6014,44 +6209,77 @@ func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryRequestResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryParamsResponse.params":
-		if x.Params == nil {
-			x.Params = new(Params)
+	case "band.oracle.v1.QueryRequestResponse.request":
+		if x.Request == nil {
+			x.Request = new(Request)
 		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Request.ProtoReflect())
+	case "band.oracle.v1.QueryRequestResponse.reports":
+		if x.Reports == nil {
+			x.Reports = []*Report{}
+		}
+		value := &_QueryRequestResponse_2_list{list: &x.Reports}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.QueryRequestResponse.result":
+		if x.Result == nil {
+			x.Result = new(Result)
+		}
+		return protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+	case "band.oracle.v1.QueryRequestResponse.signing":
+		if x.Signing == nil {
+			x.Signing = new(SigningResult)
+		}
+		return protoreflect.ValueOfMessage(x.Signing.ProtoReflect())
+	case "band.oracle.v1.QueryRequestResponse.versions":
+		if x.Versions == nil {
+			x.Versions = new(RequestVersions)
+		}
+		return protoreflect.ValueOfMessage(x.Versions.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryRequestResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryParamsResponse.params":
-		m := new(Params)
+	case "band.oracle.v1.QueryRequestResponse.request":
+		m := new(Request)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.oracle.v1.QueryRequestResponse.reports":
+		list := []*Report{}
+		return protoreflect.ValueOfList(&_QueryRequestResponse_2_list{list: &list})
+	case "band.oracle.v1.QueryRequestResponse.result":
+		m := new(Result)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.oracle.v1.QueryRequestResponse.signing":
+		m := new(SigningResult)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.oracle.v1.QueryRequestResponse.versions":
+		m := new(RequestVersions)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryRequestResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryParamsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6059,7 +6287,7 @@ func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryRequestResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6070,7 +6298,7 @@ func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryRequestResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6082,7 +6310,7 @@ func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryParamsResponse) IsValid() bool {
+func (x *fastReflection_QueryRequestResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6092,9 +6320,9 @@ func (x *fastReflection_QueryParamsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryRequestResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryRequestResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6106,8 +6334,26 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
+		if x.Request != nil {
+			l = options.Size(x.Request)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Reports) > 0 {
+			for _, e := range x.Reports {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Result != nil {
+			l = options.Size(x.Result)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Signing != nil {
+			l = options.Size(x.Signing)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Versions != nil {
+			l = options.Size(x.Versions)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6120,7 +6366,7 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryRequestResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6139,8 +6385,66 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
+		if x.Versions != nil {
+			encoded, err := options.Marshal(x.Versions)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Signing != nil {
+			encoded, err := options.Marshal(x.Signing)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Result != nil {
+			encoded, err := options.Marshal(x.Result)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Reports) > 0 {
+			for iNdEx := len(x.Reports) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Reports[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Request != nil {
+			encoded, err := options.Marshal(x.Request)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6164,7 +6468,7 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryRequestResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6196,15 +6500,15 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6231,10 +6535,152 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				if x.Request == nil {
+					x.Request = &Request{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Request); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reports = append(x.Reports, &Report{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Reports[len(x.Reports)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Result == nil {
+					x.Result = &Result{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Result); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signing", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Signing == nil {
+					x.Signing = &SigningResult{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signing); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Versions == nil {
+					x.Versions = &RequestVersions{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Versions); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -6274,25 +6720,25 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryValidatorRequest                   protoreflect.MessageDescriptor
-	fd_QueryValidatorRequest_validator_address protoreflect.FieldDescriptor
+	md_QueryPendingRequestsRequest                   protoreflect.MessageDescriptor
+	fd_QueryPendingRequestsRequest_validator_address protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryValidatorRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryValidatorRequest")
-	fd_QueryValidatorRequest_validator_address = md_QueryValidatorRequest.Fields().ByName("validator_address")
+	md_QueryPendingRequestsRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryPendingRequestsRequest")
+	fd_QueryPendingRequestsRequest_validator_address = md_QueryPendingRequestsRequest.Fields().ByName("validator_address")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryValidatorRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPendingRequestsRequest)(nil)
 
-type fastReflection_QueryValidatorRequest QueryValidatorRequest
+type fastReflection_QueryPendingRequestsRequest QueryPendingRequestsRequest
 
-func (x *QueryValidatorRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryValidatorRequest)(x)
+func (x *QueryPendingRequestsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPendingRequestsRequest)(x)
 }
 
-func (x *QueryValidatorRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryPendingRequestsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6304,43 +6750,43 @@ func (x *QueryValidatorRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryValidatorRequest_messageType fastReflection_QueryValidatorRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryValidatorRequest_messageType{}
+var _fastReflection_QueryPendingRequestsRequest_messageType fastReflection_QueryPendingRequestsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPendingRequestsRequest_messageType{}
 
-type fastReflection_QueryValidatorRequest_messageType struct{}
+type fastReflection_QueryPendingRequestsRequest_messageType struct{}
 
-func (x fastReflection_QueryValidatorRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryValidatorRequest)(nil)
+func (x fastReflection_QueryPendingRequestsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPendingRequestsRequest)(nil)
 }
-func (x fastReflection_QueryValidatorRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorRequest)
+func (x fastReflection_QueryPendingRequestsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPendingRequestsRequest)
 }
-func (x fastReflection_QueryValidatorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorRequest
+func (x fastReflection_QueryPendingRequestsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPendingRequestsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryValidatorRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorRequest
+func (x *fastReflection_QueryPendingRequestsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPendingRequestsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryValidatorRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryValidatorRequest_messageType
+func (x *fastReflection_QueryPendingRequestsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPendingRequestsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryValidatorRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorRequest)
+func (x *fastReflection_QueryPendingRequestsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPendingRequestsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryValidatorRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryValidatorRequest)(x)
+func (x *fastReflection_QueryPendingRequestsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPendingRequestsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6348,10 +6794,10 @@ func (x *fastReflection_QueryValidatorRequest) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryValidatorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryPendingRequestsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ValidatorAddress != "" {
 		value := protoreflect.ValueOfString(x.ValidatorAddress)
-		if !f(fd_QueryValidatorRequest_validator_address, value) {
+		if !f(fd_QueryPendingRequestsRequest_validator_address, value) {
 			return
 		}
 	}
@@ -6368,15 +6814,15 @@ func (x *fastReflection_QueryValidatorRequest) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryValidatorRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPendingRequestsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryValidatorRequest.validator_address":
+	case "band.oracle.v1.QueryPendingRequestsRequest.validator_address":
 		return x.ValidatorAddress != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6386,15 +6832,15 @@ func (x *fastReflection_QueryValidatorRequest) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPendingRequestsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryValidatorRequest.validator_address":
+	case "band.oracle.v1.QueryPendingRequestsRequest.validator_address":
 		x.ValidatorAddress = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6404,16 +6850,16 @@ func (x *fastReflection_QueryValidatorRequest) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryValidatorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPendingRequestsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryValidatorRequest.validator_address":
+	case "band.oracle.v1.QueryPendingRequestsRequest.validator_address":
 		value := x.ValidatorAddress
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6427,15 +6873,15 @@ func (x *fastReflection_QueryValidatorRequest) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPendingRequestsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryValidatorRequest.validator_address":
+	case "band.oracle.v1.QueryPendingRequestsRequest.validator_address":
 		x.ValidatorAddress = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6449,40 +6895,40 @@ func (x *fastReflection_QueryValidatorRequest) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPendingRequestsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryValidatorRequest.validator_address":
-		panic(fmt.Errorf("field validator_address of message band.oracle.v1.QueryValidatorRequest is not mutable"))
+	case "band.oracle.v1.QueryPendingRequestsRequest.validator_address":
+		panic(fmt.Errorf("field validator_address of message band.oracle.v1.QueryPendingRequestsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryValidatorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPendingRequestsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryValidatorRequest.validator_address":
+	case "band.oracle.v1.QueryPendingRequestsRequest.validator_address":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryValidatorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPendingRequestsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryValidatorRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryPendingRequestsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6490,7 +6936,7 @@ func (x *fastReflection_QueryValidatorRequest) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryValidatorRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPendingRequestsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6501,7 +6947,7 @@ func (x *fastReflection_QueryValidatorRequest) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPendingRequestsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6513,7 +6959,7 @@ func (x *fastReflection_QueryValidatorRequest) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryValidatorRequest) IsValid() bool {
+func (x *fastReflection_QueryPendingRequestsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6523,9 +6969,9 @@ func (x *fastReflection_QueryValidatorRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryValidatorRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPendingRequestsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryValidatorRequest)
+		x := input.Message.Interface().(*QueryPendingRequestsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6551,7 +6997,7 @@ func (x *fastReflection_QueryValidatorRequest) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorRequest)
+		x := input.Message.Interface().(*QueryPendingRequestsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6588,7 +7034,7 @@ func (x *fastReflection_QueryValidatorRequest) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorRequest)
+		x := input.Message.Interface().(*QueryPendingRequestsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6620,10 +7066,10 @@ func (x *fastReflection_QueryValidatorRequest) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingRequestsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6693,26 +7139,72 @@ func (x *fastReflection_QueryValidatorRequest) ProtoMethods() *protoiface.Method
 	}
 }
 
+var _ protoreflect.List = (*_QueryPendingRequestsResponse_1_list)(nil)
+
+type _QueryPendingRequestsResponse_1_list struct {
+	list *[]uint64
+}
+
+func (x *_QueryPendingRequestsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryPendingRequestsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_QueryPendingRequestsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryPendingRequestsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryPendingRequestsResponse_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryPendingRequestsResponse at list field RequestIds as it is not of Message kind"))
+}
+
+func (x *_QueryPendingRequestsResponse_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryPendingRequestsResponse_1_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_QueryPendingRequestsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryValidatorResponse        protoreflect.MessageDescriptor
-	fd_QueryValidatorResponse_status protoreflect.FieldDescriptor
+	md_QueryPendingRequestsResponse             protoreflect.MessageDescriptor
+	fd_QueryPendingRequestsResponse_request_ids protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryValidatorResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryValidatorResponse")
-	fd_QueryValidatorResponse_status = md_QueryValidatorResponse.Fields().ByName("status")
+	md_QueryPendingRequestsResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryPendingRequestsResponse")
+	fd_QueryPendingRequestsResponse_request_ids = md_QueryPendingRequestsResponse.Fields().ByName("request_ids")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryValidatorResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPendingRequestsResponse)(nil)
 
-type fastReflection_QueryValidatorResponse QueryValidatorResponse
+type fastReflection_QueryPendingRequestsResponse QueryPendingRequestsResponse
 
-func (x *QueryValidatorResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryValidatorResponse)(x)
+func (x *QueryPendingRequestsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPendingRequestsResponse)(x)
 }
 
-func (x *QueryValidatorResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryPendingRequestsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6724,43 +7216,43 @@ func (x *QueryValidatorResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryValidatorResponse_messageType fastReflection_QueryValidatorResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryValidatorResponse_messageType{}
+var _fastReflection_QueryPendingRequestsResponse_messageType fastReflection_QueryPendingRequestsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPendingRequestsResponse_messageType{}
 
-type fastReflection_QueryValidatorResponse_messageType struct{}
+type fastReflection_QueryPendingRequestsResponse_messageType struct{}
 
-func (x fastReflection_QueryValidatorResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryValidatorResponse)(nil)
+func (x fastReflection_QueryPendingRequestsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPendingRequestsResponse)(nil)
 }
-func (x fastReflection_QueryValidatorResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorResponse)
+func (x fastReflection_QueryPendingRequestsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPendingRequestsResponse)
 }
-func (x fastReflection_QueryValidatorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorResponse
+func (x fastReflection_QueryPendingRequestsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPendingRequestsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryValidatorResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorResponse
+func (x *fastReflection_QueryPendingRequestsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPendingRequestsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryValidatorResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryValidatorResponse_messageType
+func (x *fastReflection_QueryPendingRequestsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPendingRequestsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryValidatorResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorResponse)
+func (x *fastReflection_QueryPendingRequestsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPendingRequestsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryValidatorResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryValidatorResponse)(x)
+func (x *fastReflection_QueryPendingRequestsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPendingRequestsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6768,10 +7260,10 @@ func (x *fastReflection_QueryValidatorResponse) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryValidatorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Status != nil {
-		value := protoreflect.ValueOfMessage(x.Status.ProtoReflect())
-		if !f(fd_QueryValidatorResponse_status, value) {
+func (x *fastReflection_QueryPendingRequestsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.RequestIds) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPendingRequestsResponse_1_list{list: &x.RequestIds})
+		if !f(fd_QueryPendingRequestsResponse_request_ids, value) {
 			return
 		}
 	}
@@ -6788,15 +7280,15 @@ func (x *fastReflection_QueryValidatorResponse) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryValidatorResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPendingRequestsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryValidatorResponse.status":
-		return x.Status != nil
+	case "band.oracle.v1.QueryPendingRequestsResponse.request_ids":
+		return len(x.RequestIds) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6806,15 +7298,15 @@ func (x *fastReflection_QueryValidatorResponse) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPendingRequestsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryValidatorResponse.status":
-		x.Status = nil
+	case "band.oracle.v1.QueryPendingRequestsResponse.request_ids":
+		x.RequestIds = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6824,16 +7316,19 @@ func (x *fastReflection_QueryValidatorResponse) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryValidatorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPendingRequestsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryValidatorResponse.status":
-		value := x.Status
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.oracle.v1.QueryPendingRequestsResponse.request_ids":
+		if len(x.RequestIds) == 0 {
+			return protoreflect.ValueOfList(&_QueryPendingRequestsResponse_1_list{})
+		}
+		listValue := &_QueryPendingRequestsResponse_1_list{list: &x.RequestIds}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6847,15 +7342,17 @@ func (x *fastReflection_QueryValidatorResponse) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPendingRequestsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryValidatorResponse.status":
-		x.Status = value.Message().Interface().(*ValidatorStatus)
+	case "band.oracle.v1.QueryPendingRequestsResponse.request_ids":
+		lv := value.List()
+		clv := lv.(*_QueryPendingRequestsResponse_1_list)
+		x.RequestIds = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6869,44 +7366,45 @@ func (x *fastReflection_QueryValidatorResponse) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPendingRequestsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryValidatorResponse.status":
-		if x.Status == nil {
-			x.Status = new(ValidatorStatus)
+	case "band.oracle.v1.QueryPendingRequestsResponse.request_ids":
+		if x.RequestIds == nil {
+			x.RequestIds = []uint64{}
 		}
-		return protoreflect.ValueOfMessage(x.Status.ProtoReflect())
+		value := &_QueryPendingRequestsResponse_1_list{list: &x.RequestIds}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryValidatorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPendingRequestsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryValidatorResponse.status":
-		m := new(ValidatorStatus)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.oracle.v1.QueryPendingRequestsResponse.request_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_QueryPendingRequestsResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryPendingRequestsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryPendingRequestsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryValidatorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPendingRequestsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryValidatorResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryPendingRequestsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6914,7 +7412,7 @@ func (x *fastReflection_QueryValidatorResponse) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryValidatorResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPendingRequestsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6925,7 +7423,7 @@ func (x *fastReflection_QueryValidatorResponse) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPendingRequestsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6937,7 +7435,7 @@ func (x *fastReflection_QueryValidatorResponse) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryValidatorResponse) IsValid() bool {
+func (x *fastReflection_QueryPendingRequestsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6947,9 +7445,9 @@ func (x *fastReflection_QueryValidatorResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryValidatorResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPendingRequestsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryValidatorResponse)
+		x := input.Message.Interface().(*QueryPendingRequestsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6961,9 +7459,12 @@ func (x *fastReflection_QueryValidatorResponse) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if x.Status != nil {
-			l = options.Size(x.Status)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.RequestIds) > 0 {
+			l = 0
+			for _, e := range x.RequestIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6975,7 +7476,7 @@ func (x *fastReflection_QueryValidatorResponse) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorResponse)
+		x := input.Message.Interface().(*QueryPendingRequestsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6994,17 +7495,23 @@ func (x *fastReflection_QueryValidatorResponse) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Status != nil {
-			encoded, err := options.Marshal(x.Status)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.RequestIds) > 0 {
+			var pksize2 int
+			for _, num := range x.RequestIds {
+				pksize2 += runtime.Sov(uint64(num))
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i -= pksize2
+			j1 := i
+			for _, num := range x.RequestIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7019,7 +7526,7 @@ func (x *fastReflection_QueryValidatorResponse) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorResponse)
+		x := input.Message.Interface().(*QueryPendingRequestsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7051,48 +7558,88 @@ func (x *fastReflection_QueryValidatorResponse) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingRequestsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
-					if iNdEx >= l {
+					x.RequestIds = append(x.RequestIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
 					}
+					elementCount = count
+					if elementCount != 0 && len(x.RequestIds) == 0 {
+						x.RequestIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.RequestIds = append(x.RequestIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestIds", wireType)
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Status == nil {
-					x.Status = &ValidatorStatus{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Status); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7129,27 +7676,23 @@ func (x *fastReflection_QueryValidatorResponse) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_QueryIsReporterRequest                   protoreflect.MessageDescriptor
-	fd_QueryIsReporterRequest_validator_address protoreflect.FieldDescriptor
-	fd_QueryIsReporterRequest_reporter_address  protoreflect.FieldDescriptor
+	md_QueryParamsRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryIsReporterRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryIsReporterRequest")
-	fd_QueryIsReporterRequest_validator_address = md_QueryIsReporterRequest.Fields().ByName("validator_address")
-	fd_QueryIsReporterRequest_reporter_address = md_QueryIsReporterRequest.Fields().ByName("reporter_address")
+	md_QueryParamsRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryParamsRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryIsReporterRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
 
-type fastReflection_QueryIsReporterRequest QueryIsReporterRequest
+type fastReflection_QueryParamsRequest QueryParamsRequest
 
-func (x *QueryIsReporterRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryIsReporterRequest)(x)
+func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(x)
 }
 
-func (x *QueryIsReporterRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7161,43 +7704,43 @@ func (x *QueryIsReporterRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryIsReporterRequest_messageType fastReflection_QueryIsReporterRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryIsReporterRequest_messageType{}
+var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
 
-type fastReflection_QueryIsReporterRequest_messageType struct{}
+type fastReflection_QueryParamsRequest_messageType struct{}
 
-func (x fastReflection_QueryIsReporterRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryIsReporterRequest)(nil)
+func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(nil)
 }
-func (x fastReflection_QueryIsReporterRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryIsReporterRequest)
+func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
 }
-func (x fastReflection_QueryIsReporterRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryIsReporterRequest
+func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryIsReporterRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryIsReporterRequest
+func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryIsReporterRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryIsReporterRequest_messageType
+func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryIsReporterRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryIsReporterRequest)
+func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryIsReporterRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryIsReporterRequest)(x)
+func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7205,19 +7748,7 @@ func (x *fastReflection_QueryIsReporterRequest) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryIsReporterRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ValidatorAddress != "" {
-		value := protoreflect.ValueOfString(x.ValidatorAddress)
-		if !f(fd_QueryIsReporterRequest_validator_address, value) {
-			return
-		}
-	}
-	if x.ReporterAddress != "" {
-		value := protoreflect.ValueOfString(x.ReporterAddress)
-		if !f(fd_QueryIsReporterRequest_reporter_address, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -7231,17 +7762,13 @@ func (x *fastReflection_QueryIsReporterRequest) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryIsReporterRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
-		return x.ValidatorAddress != ""
-	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
-		return x.ReporterAddress != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7251,17 +7778,13 @@ func (x *fastReflection_QueryIsReporterRequest) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryIsReporterRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
-		x.ValidatorAddress = ""
-	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
-		x.ReporterAddress = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7271,19 +7794,13 @@ func (x *fastReflection_QueryIsReporterRequest) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryIsReporterRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
-		value := x.ValidatorAddress
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
-		value := x.ReporterAddress
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7297,17 +7814,13 @@ func (x *fastReflection_QueryIsReporterRequest) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryIsReporterRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
-		x.ValidatorAddress = value.Interface().(string)
-	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
-		x.ReporterAddress = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7321,44 +7834,36 @@ func (x *fastReflection_QueryIsReporterRequest) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryIsReporterRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
-		panic(fmt.Errorf("field validator_address of message band.oracle.v1.QueryIsReporterRequest is not mutable"))
-	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
-		panic(fmt.Errorf("field reporter_address of message band.oracle.v1.QueryIsReporterRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryIsReporterRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryIsReporterRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryIsReporterRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryParamsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7366,7 +7871,7 @@ func (x *fastReflection_QueryIsReporterRequest) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryIsReporterRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7377,7 +7882,7 @@ func (x *fastReflection_QueryIsReporterRequest) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryIsReporterRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7389,7 +7894,7 @@ func (x *fastReflection_QueryIsReporterRequest) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryIsReporterRequest) IsValid() bool {
+func (x *fastReflection_QueryParamsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7399,9 +7904,9 @@ func (x *fastReflection_QueryIsReporterRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryIsReporterRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryIsReporterRequest)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7413,14 +7918,6 @@ func (x *fastReflection_QueryIsReporterRequest) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		l = len(x.ValidatorAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ReporterAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7431,7 +7928,7 @@ func (x *fastReflection_QueryIsReporterRequest) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryIsReporterRequest)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7450,20 +7947,6 @@ func (x *fastReflection_QueryIsReporterRequest) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ReporterAddress) > 0 {
-			i -= len(x.ReporterAddress)
-			copy(dAtA[i:], x.ReporterAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReporterAddress)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.ValidatorAddress) > 0 {
-			i -= len(x.ValidatorAddress)
-			copy(dAtA[i:], x.ValidatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -7475,7 +7958,7 @@ func (x *fastReflection_QueryIsReporterRequest) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryIsReporterRequest)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7507,76 +7990,12 @@ func (x *fastReflection_QueryIsReporterRequest) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryIsReporterRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryIsReporterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReporterAddress", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ReporterAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7613,25 +8032,25 @@ func (x *fastReflection_QueryIsReporterRequest) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_QueryIsReporterResponse             protoreflect.MessageDescriptor
-	fd_QueryIsReporterResponse_is_reporter protoreflect.FieldDescriptor
+	md_QueryParamsResponse        protoreflect.MessageDescriptor
+	fd_QueryParamsResponse_params protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryIsReporterResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryIsReporterResponse")
-	fd_QueryIsReporterResponse_is_reporter = md_QueryIsReporterResponse.Fields().ByName("is_reporter")
+	md_QueryParamsResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryParamsResponse")
+	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryIsReporterResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
 
-type fastReflection_QueryIsReporterResponse QueryIsReporterResponse
+type fastReflection_QueryParamsResponse QueryParamsResponse
 
-func (x *QueryIsReporterResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryIsReporterResponse)(x)
+func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(x)
 }
 
-func (x *QueryIsReporterResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7643,43 +8062,43 @@ func (x *QueryIsReporterResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryIsReporterResponse_messageType fastReflection_QueryIsReporterResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryIsReporterResponse_messageType{}
+var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
 
-type fastReflection_QueryIsReporterResponse_messageType struct{}
+type fastReflection_QueryParamsResponse_messageType struct{}
 
-func (x fastReflection_QueryIsReporterResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryIsReporterResponse)(nil)
+func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(nil)
 }
-func (x fastReflection_QueryIsReporterResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryIsReporterResponse)
+func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
 }
-func (x fastReflection_QueryIsReporterResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryIsReporterResponse
+func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryIsReporterResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryIsReporterResponse
+func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryIsReporterResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryIsReporterResponse_messageType
+func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryIsReporterResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryIsReporterResponse)
+func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryIsReporterResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryIsReporterResponse)(x)
+func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7687,10 +8106,10 @@ func (x *fastReflection_QueryIsReporterResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryIsReporterResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.IsReporter != false {
-		value := protoreflect.ValueOfBool(x.IsReporter)
-		if !f(fd_QueryIsReporterResponse_is_reporter, value) {
+func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_QueryParamsResponse_params, value) {
 			return
 		}
 	}
@@ -7707,15 +8126,15 @@ func (x *fastReflection_QueryIsReporterResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryIsReporterResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
-		return x.IsReporter != false
+	case "band.oracle.v1.QueryParamsResponse.params":
+		return x.Params != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7725,15 +8144,15 @@ func (x *fastReflection_QueryIsReporterResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryIsReporterResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
-		x.IsReporter = false
+	case "band.oracle.v1.QueryParamsResponse.params":
+		x.Params = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7743,16 +8162,16 @@ func (x *fastReflection_QueryIsReporterResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryIsReporterResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
-		value := x.IsReporter
-		return protoreflect.ValueOfBool(value)
+	case "band.oracle.v1.QueryParamsResponse.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7766,15 +8185,15 @@ func (x *fastReflection_QueryIsReporterResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryIsReporterResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
-		x.IsReporter = value.Bool()
+	case "band.oracle.v1.QueryParamsResponse.params":
+		x.Params = value.Message().Interface().(*Params)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7788,40 +8207,44 @@ func (x *fastReflection_QueryIsReporterResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryIsReporterResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
-		panic(fmt.Errorf("field is_reporter of message band.oracle.v1.QueryIsReporterResponse is not mutable"))
+	case "band.oracle.v1.QueryParamsResponse.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryIsReporterResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
-		return protoreflect.ValueOfBool(false)
+	case "band.oracle.v1.QueryParamsResponse.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryIsReporterResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryIsReporterResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryParamsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7829,7 +8252,7 @@ func (x *fastReflection_QueryIsReporterResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryIsReporterResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7840,7 +8263,7 @@ func (x *fastReflection_QueryIsReporterResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryIsReporterResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7852,7 +8275,7 @@ func (x *fastReflection_QueryIsReporterResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryIsReporterResponse) IsValid() bool {
+func (x *fastReflection_QueryParamsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7862,9 +8285,9 @@ func (x *fastReflection_QueryIsReporterResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryIsReporterResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryIsReporterResponse)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7876,8 +8299,9 @@ func (x *fastReflection_QueryIsReporterResponse) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if x.IsReporter {
-			n += 2
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7889,7 +8313,7 @@ func (x *fastReflection_QueryIsReporterResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryIsReporterResponse)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7908,15 +8332,19 @@ func (x *fastReflection_QueryIsReporterResponse) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.IsReporter {
-			i--
-			if x.IsReporter {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7929,7 +8357,7 @@ func (x *fastReflection_QueryIsReporterResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryIsReporterResponse)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7961,17 +8389,17 @@ func (x *fastReflection_QueryIsReporterResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryIsReporterResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryIsReporterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsReporter", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 				}
-				var v int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7981,12 +8409,28 @@ func (x *fastReflection_QueryIsReporterResponse) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.IsReporter = bool(v != 0)
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8023,25 +8467,25 @@ func (x *fastReflection_QueryIsReporterResponse) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_QueryReportersRequest                   protoreflect.MessageDescriptor
-	fd_QueryReportersRequest_validator_address protoreflect.FieldDescriptor
+	md_QueryValidatorRequest                   protoreflect.MessageDescriptor
+	fd_QueryValidatorRequest_validator_address protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryReportersRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryReportersRequest")
-	fd_QueryReportersRequest_validator_address = md_QueryReportersRequest.Fields().ByName("validator_address")
+	md_QueryValidatorRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryValidatorRequest")
+	fd_QueryValidatorRequest_validator_address = md_QueryValidatorRequest.Fields().ByName("validator_address")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryReportersRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryValidatorRequest)(nil)
 
-type fastReflection_QueryReportersRequest QueryReportersRequest
+type fastReflection_QueryValidatorRequest QueryValidatorRequest
 
-func (x *QueryReportersRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryReportersRequest)(x)
+func (x *QueryValidatorRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorRequest)(x)
 }
 
-func (x *QueryReportersRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryValidatorRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8053,43 +8497,43 @@ func (x *QueryReportersRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryReportersRequest_messageType fastReflection_QueryReportersRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryReportersRequest_messageType{}
+var _fastReflection_QueryValidatorRequest_messageType fastReflection_QueryValidatorRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorRequest_messageType{}
 
-type fastReflection_QueryReportersRequest_messageType struct{}
+type fastReflection_QueryValidatorRequest_messageType struct{}
 
-func (x fastReflection_QueryReportersRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryReportersRequest)(nil)
+func (x fastReflection_QueryValidatorRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorRequest)(nil)
 }
-func (x fastReflection_QueryReportersRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryReportersRequest)
+func (x fastReflection_QueryValidatorRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorRequest)
 }
-func (x fastReflection_QueryReportersRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryReportersRequest
+func (x fastReflection_QueryValidatorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryReportersRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryReportersRequest
+func (x *fastReflection_QueryValidatorRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryReportersRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryReportersRequest_messageType
+func (x *fastReflection_QueryValidatorRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryReportersRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryReportersRequest)
+func (x *fastReflection_QueryValidatorRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryReportersRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryReportersRequest)(x)
+func (x *fastReflection_QueryValidatorRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8097,10 +8541,10 @@ func (x *fastReflection_QueryReportersRequest) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryReportersRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryValidatorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ValidatorAddress != "" {
 		value := protoreflect.ValueOfString(x.ValidatorAddress)
-		if !f(fd_QueryReportersRequest_validator_address, value) {
+		if !f(fd_QueryValidatorRequest_validator_address, value) {
 			return
 		}
 	}
@@ -8117,15 +8561,15 @@ func (x *fastReflection_QueryReportersRequest) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryReportersRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryValidatorRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryReportersRequest.validator_address":
+	case "band.oracle.v1.QueryValidatorRequest.validator_address":
 		return x.ValidatorAddress != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8135,15 +8579,15 @@ func (x *fastReflection_QueryReportersRequest) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReportersRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryValidatorRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryReportersRequest.validator_address":
+	case "band.oracle.v1.QueryValidatorRequest.validator_address":
 		x.ValidatorAddress = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8153,16 +8597,16 @@ func (x *fastReflection_QueryReportersRequest) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryReportersRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryReportersRequest.validator_address":
+	case "band.oracle.v1.QueryValidatorRequest.validator_address":
 		value := x.ValidatorAddress
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8176,15 +8620,15 @@ func (x *fastReflection_QueryReportersRequest) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReportersRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryValidatorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryReportersRequest.validator_address":
+	case "band.oracle.v1.QueryValidatorRequest.validator_address":
 		x.ValidatorAddress = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8198,40 +8642,40 @@ func (x *fastReflection_QueryReportersRequest) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReportersRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryReportersRequest.validator_address":
-		panic(fmt.Errorf("field validator_address of message band.oracle.v1.QueryReportersRequest is not mutable"))
+	case "band.oracle.v1.QueryValidatorRequest.validator_address":
+		panic(fmt.Errorf("field validator_address of message band.oracle.v1.QueryValidatorRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryReportersRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryReportersRequest.validator_address":
+	case "band.oracle.v1.QueryValidatorRequest.validator_address":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryReportersRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryValidatorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryReportersRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryValidatorRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8239,7 +8683,7 @@ func (x *fastReflection_QueryReportersRequest) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryReportersRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryValidatorRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8250,7 +8694,7 @@ func (x *fastReflection_QueryReportersRequest) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReportersRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryValidatorRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8262,7 +8706,7 @@ func (x *fastReflection_QueryReportersRequest) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryReportersRequest) IsValid() bool {
+func (x *fastReflection_QueryValidatorRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -8272,9 +8716,9 @@ func (x *fastReflection_QueryReportersRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryReportersRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryValidatorRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryReportersRequest)
+		x := input.Message.Interface().(*QueryValidatorRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8300,7 +8744,7 @@ func (x *fastReflection_QueryReportersRequest) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryReportersRequest)
+		x := input.Message.Interface().(*QueryValidatorRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8337,7 +8781,7 @@ func (x *fastReflection_QueryReportersRequest) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryReportersRequest)
+		x := input.Message.Interface().(*QueryValidatorRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8369,10 +8813,10 @@ func (x *fastReflection_QueryReportersRequest) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReportersRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReportersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -8442,72 +8886,26 @@ func (x *fastReflection_QueryReportersRequest) ProtoMethods() *protoiface.Method
 	}
 }
 
-var _ protoreflect.List = (*_QueryReportersResponse_1_list)(nil)
-
-type _QueryReportersResponse_1_list struct {
-	list *[]string
-}
-
-func (x *_QueryReportersResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryReportersResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_QueryReportersResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryReportersResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryReportersResponse_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message QueryReportersResponse at list field Reporter as it is not of Message kind"))
-}
-
-func (x *_QueryReportersResponse_1_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryReportersResponse_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_QueryReportersResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_QueryReportersResponse          protoreflect.MessageDescriptor
-	fd_QueryReportersResponse_reporter protoreflect.FieldDescriptor
-)
+var (
+	md_QueryValidatorResponse        protoreflect.MessageDescriptor
+	fd_QueryValidatorResponse_status protoreflect.FieldDescriptor
+)
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryReportersResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryReportersResponse")
-	fd_QueryReportersResponse_reporter = md_QueryReportersResponse.Fields().ByName("reporter")
+	md_QueryValidatorResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryValidatorResponse")
+	fd_QueryValidatorResponse_status = md_QueryValidatorResponse.Fields().ByName("status")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryReportersResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryValidatorResponse)(nil)
 
-type fastReflection_QueryReportersResponse QueryReportersResponse
+type fastReflection_QueryValidatorResponse QueryValidatorResponse
 
-func (x *QueryReportersResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryReportersResponse)(x)
+func (x *QueryValidatorResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorResponse)(x)
 }
 
-func (x *QueryReportersResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryValidatorResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8519,43 +8917,43 @@ func (x *QueryReportersResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryReportersResponse_messageType fastReflection_QueryReportersResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryReportersResponse_messageType{}
+var _fastReflection_QueryValidatorResponse_messageType fastReflection_QueryValidatorResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorResponse_messageType{}
 
-type fastReflection_QueryReportersResponse_messageType struct{}
+type fastReflection_QueryValidatorResponse_messageType struct{}
 
-func (x fastReflection_QueryReportersResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryReportersResponse)(nil)
+func (x fastReflection_QueryValidatorResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorResponse)(nil)
 }
-func (x fastReflection_QueryReportersResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryReportersResponse)
+func (x fastReflection_QueryValidatorResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorResponse)
 }
-func (x fastReflection_QueryReportersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryReportersResponse
+func (x fastReflection_QueryValidatorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryReportersResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryReportersResponse
+func (x *fastReflection_QueryValidatorResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryReportersResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryReportersResponse_messageType
+func (x *fastReflection_QueryValidatorResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryReportersResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryReportersResponse)
+func (x *fastReflection_QueryValidatorResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryReportersResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryReportersResponse)(x)
+func (x *fastReflection_QueryValidatorResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8563,10 +8961,10 @@ func (x *fastReflection_QueryReportersResponse) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryReportersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Reporter) != 0 {
-		value := protoreflect.ValueOfList(&_QueryReportersResponse_1_list{list: &x.Reporter})
-		if !f(fd_QueryReportersResponse_reporter, value) {
+func (x *fastReflection_QueryValidatorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Status != nil {
+		value := protoreflect.ValueOfMessage(x.Status.ProtoReflect())
+		if !f(fd_QueryValidatorResponse_status, value) {
 			return
 		}
 	}
@@ -8583,15 +8981,15 @@ func (x *fastReflection_QueryReportersResponse) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryReportersResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryValidatorResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryReportersResponse.reporter":
-		return len(x.Reporter) != 0
+	case "band.oracle.v1.QueryValidatorResponse.status":
+		return x.Status != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8601,15 +8999,15 @@ func (x *fastReflection_QueryReportersResponse) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReportersResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryValidatorResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryReportersResponse.reporter":
-		x.Reporter = nil
+	case "band.oracle.v1.QueryValidatorResponse.status":
+		x.Status = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8619,19 +9017,16 @@ func (x *fastReflection_QueryReportersResponse) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryReportersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryReportersResponse.reporter":
-		if len(x.Reporter) == 0 {
-			return protoreflect.ValueOfList(&_QueryReportersResponse_1_list{})
-		}
-		listValue := &_QueryReportersResponse_1_list{list: &x.Reporter}
-		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.QueryValidatorResponse.status":
+		value := x.Status
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8645,17 +9040,15 @@ func (x *fastReflection_QueryReportersResponse) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReportersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryValidatorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryReportersResponse.reporter":
-		lv := value.List()
-		clv := lv.(*_QueryReportersResponse_1_list)
-		x.Reporter = *clv.list
+	case "band.oracle.v1.QueryValidatorResponse.status":
+		x.Status = value.Message().Interface().(*ValidatorStatus)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8669,45 +9062,44 @@ func (x *fastReflection_QueryReportersResponse) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReportersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryReportersResponse.reporter":
-		if x.Reporter == nil {
-			x.Reporter = []string{}
+	case "band.oracle.v1.QueryValidatorResponse.status":
+		if x.Status == nil {
+			x.Status = new(ValidatorStatus)
 		}
-		value := &_QueryReportersResponse_1_list{list: &x.Reporter}
-		return protoreflect.ValueOfList(value)
+		return protoreflect.ValueOfMessage(x.Status.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryReportersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryReportersResponse.reporter":
-		list := []string{}
-		return protoreflect.ValueOfList(&_QueryReportersResponse_1_list{list: &list})
+	case "band.oracle.v1.QueryValidatorResponse.status":
+		m := new(ValidatorStatus)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryReportersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryValidatorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryReportersResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryValidatorResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8715,7 +9107,7 @@ func (x *fastReflection_QueryReportersResponse) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryReportersResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryValidatorResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8726,7 +9118,7 @@ func (x *fastReflection_QueryReportersResponse) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReportersResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryValidatorResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8738,7 +9130,7 @@ func (x *fastReflection_QueryReportersResponse) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryReportersResponse) IsValid() bool {
+func (x *fastReflection_QueryValidatorResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8748,9 +9140,9 @@ func (x *fastReflection_QueryReportersResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryReportersResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryValidatorResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryReportersResponse)
+		x := input.Message.Interface().(*QueryValidatorResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8762,11 +9154,9 @@ func (x *fastReflection_QueryReportersResponse) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if len(x.Reporter) > 0 {
-			for _, s := range x.Reporter {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Status != nil {
+			l = options.Size(x.Status)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8778,7 +9168,7 @@ func (x *fastReflection_QueryReportersResponse) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryReportersResponse)
+		x := input.Message.Interface().(*QueryValidatorResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8797,14 +9187,19 @@ func (x *fastReflection_QueryReportersResponse) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Reporter) > 0 {
-			for iNdEx := len(x.Reporter) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Reporter[iNdEx])
-				copy(dAtA[i:], x.Reporter[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reporter[iNdEx])))
-				i--
-				dAtA[i] = 0xa
+		if x.Status != nil {
+			encoded, err := options.Marshal(x.Status)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8817,7 +9212,7 @@ func (x *fastReflection_QueryReportersResponse) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryReportersResponse)
+		x := input.Message.Interface().(*QueryValidatorResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8849,17 +9244,17 @@ func (x *fastReflection_QueryReportersResponse) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReportersResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReportersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8869,23 +9264,27 @@ func (x *fastReflection_QueryReportersResponse) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Reporter = append(x.Reporter, string(dAtA[iNdEx:postIndex]))
+				if x.Status == nil {
+					x.Status = &ValidatorStatus{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Status); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8923,23 +9322,27 @@ func (x *fastReflection_QueryReportersResponse) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_QueryActiveValidatorsRequest protoreflect.MessageDescriptor
+	md_QueryIsReporterRequest                   protoreflect.MessageDescriptor
+	fd_QueryIsReporterRequest_validator_address protoreflect.FieldDescriptor
+	fd_QueryIsReporterRequest_reporter_address  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryActiveValidatorsRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryActiveValidatorsRequest")
+	md_QueryIsReporterRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryIsReporterRequest")
+	fd_QueryIsReporterRequest_validator_address = md_QueryIsReporterRequest.Fields().ByName("validator_address")
+	fd_QueryIsReporterRequest_reporter_address = md_QueryIsReporterRequest.Fields().ByName("reporter_address")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryActiveValidatorsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryIsReporterRequest)(nil)
 
-type fastReflection_QueryActiveValidatorsRequest QueryActiveValidatorsRequest
+type fastReflection_QueryIsReporterRequest QueryIsReporterRequest
 
-func (x *QueryActiveValidatorsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryActiveValidatorsRequest)(x)
-}
+func (x *QueryIsReporterRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryIsReporterRequest)(x)
+}
 
-func (x *QueryActiveValidatorsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryIsReporterRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8951,43 +9354,43 @@ func (x *QueryActiveValidatorsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryActiveValidatorsRequest_messageType fastReflection_QueryActiveValidatorsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryActiveValidatorsRequest_messageType{}
+var _fastReflection_QueryIsReporterRequest_messageType fastReflection_QueryIsReporterRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryIsReporterRequest_messageType{}
 
-type fastReflection_QueryActiveValidatorsRequest_messageType struct{}
+type fastReflection_QueryIsReporterRequest_messageType struct{}
 
-func (x fastReflection_QueryActiveValidatorsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryActiveValidatorsRequest)(nil)
+func (x fastReflection_QueryIsReporterRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryIsReporterRequest)(nil)
 }
-func (x fastReflection_QueryActiveValidatorsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryActiveValidatorsRequest)
+func (x fastReflection_QueryIsReporterRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryIsReporterRequest)
 }
-func (x fastReflection_QueryActiveValidatorsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActiveValidatorsRequest
+func (x fastReflection_QueryIsReporterRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryIsReporterRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryActiveValidatorsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActiveValidatorsRequest
+func (x *fastReflection_QueryIsReporterRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryIsReporterRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryActiveValidatorsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryActiveValidatorsRequest_messageType
+func (x *fastReflection_QueryIsReporterRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryIsReporterRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryActiveValidatorsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryActiveValidatorsRequest)
+func (x *fastReflection_QueryIsReporterRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryIsReporterRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryActiveValidatorsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryActiveValidatorsRequest)(x)
+func (x *fastReflection_QueryIsReporterRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryIsReporterRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8995,7 +9398,19 @@ func (x *fastReflection_QueryActiveValidatorsRequest) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryActiveValidatorsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryIsReporterRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_QueryIsReporterRequest_validator_address, value) {
+			return
+		}
+	}
+	if x.ReporterAddress != "" {
+		value := protoreflect.ValueOfString(x.ReporterAddress)
+		if !f(fd_QueryIsReporterRequest_reporter_address, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -9009,13 +9424,17 @@ func (x *fastReflection_QueryActiveValidatorsRequest) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryActiveValidatorsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryIsReporterRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
+		return x.ValidatorAddress != ""
+	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
+		return x.ReporterAddress != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9025,13 +9444,17 @@ func (x *fastReflection_QueryActiveValidatorsRequest) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveValidatorsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryIsReporterRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
+		x.ValidatorAddress = ""
+	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
+		x.ReporterAddress = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9041,13 +9464,19 @@ func (x *fastReflection_QueryActiveValidatorsRequest) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryActiveValidatorsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryIsReporterRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
+		value := x.ReporterAddress
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9061,13 +9490,17 @@ func (x *fastReflection_QueryActiveValidatorsRequest) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveValidatorsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryIsReporterRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
+		x.ReporterAddress = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9081,36 +9514,44 @@ func (x *fastReflection_QueryActiveValidatorsRequest) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveValidatorsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryIsReporterRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
+		panic(fmt.Errorf("field validator_address of message band.oracle.v1.QueryIsReporterRequest is not mutable"))
+	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
+		panic(fmt.Errorf("field reporter_address of message band.oracle.v1.QueryIsReporterRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryActiveValidatorsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryIsReporterRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryActiveValidatorsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryIsReporterRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryActiveValidatorsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryIsReporterRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9118,7 +9559,7 @@ func (x *fastReflection_QueryActiveValidatorsRequest) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryActiveValidatorsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryIsReporterRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9129,7 +9570,7 @@ func (x *fastReflection_QueryActiveValidatorsRequest) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveValidatorsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryIsReporterRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9141,7 +9582,7 @@ func (x *fastReflection_QueryActiveValidatorsRequest) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryActiveValidatorsRequest) IsValid() bool {
+func (x *fastReflection_QueryIsReporterRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -9151,9 +9592,9 @@ func (x *fastReflection_QueryActiveValidatorsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryActiveValidatorsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryIsReporterRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryActiveValidatorsRequest)
+		x := input.Message.Interface().(*QueryIsReporterRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9165,6 +9606,14 @@ func (x *fastReflection_QueryActiveValidatorsRequest) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReporterAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9175,7 +9624,7 @@ func (x *fastReflection_QueryActiveValidatorsRequest) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActiveValidatorsRequest)
+		x := input.Message.Interface().(*QueryIsReporterRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9194,6 +9643,20 @@ func (x *fastReflection_QueryActiveValidatorsRequest) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ReporterAddress) > 0 {
+			i -= len(x.ReporterAddress)
+			copy(dAtA[i:], x.ReporterAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReporterAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -9205,7 +9668,7 @@ func (x *fastReflection_QueryActiveValidatorsRequest) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActiveValidatorsRequest)
+		x := input.Message.Interface().(*QueryIsReporterRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9237,12 +9700,76 @@ func (x *fastReflection_QueryActiveValidatorsRequest) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveValidatorsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryIsReporterRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryIsReporterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReporterAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReporterAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9278,78 +9805,5072 @@ func (x *fastReflection_QueryActiveValidatorsRequest) ProtoMethods() *protoiface
 	}
 }
 
-var _ protoreflect.List = (*_QueryActiveValidatorsResponse_1_list)(nil)
+var (
+	md_QueryIsReporterResponse             protoreflect.MessageDescriptor
+	fd_QueryIsReporterResponse_is_reporter protoreflect.FieldDescriptor
+)
 
-type _QueryActiveValidatorsResponse_1_list struct {
-	list *[]*ActiveValidator
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryIsReporterResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryIsReporterResponse")
+	fd_QueryIsReporterResponse_is_reporter = md_QueryIsReporterResponse.Fields().ByName("is_reporter")
 }
 
-func (x *_QueryActiveValidatorsResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_QueryIsReporterResponse)(nil)
 
-func (x *_QueryActiveValidatorsResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
+type fastReflection_QueryIsReporterResponse QueryIsReporterResponse
 
-func (x *_QueryActiveValidatorsResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ActiveValidator)
-	(*x.list)[i] = concreteValue
+func (x *QueryIsReporterResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryIsReporterResponse)(x)
 }
 
-func (x *_QueryActiveValidatorsResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ActiveValidator)
-	*x.list = append(*x.list, concreteValue)
-}
+func (x *QueryIsReporterResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryIsReporterResponse_messageType fastReflection_QueryIsReporterResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryIsReporterResponse_messageType{}
+
+type fastReflection_QueryIsReporterResponse_messageType struct{}
+
+func (x fastReflection_QueryIsReporterResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryIsReporterResponse)(nil)
+}
+func (x fastReflection_QueryIsReporterResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryIsReporterResponse)
+}
+func (x fastReflection_QueryIsReporterResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryIsReporterResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryIsReporterResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryIsReporterResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryIsReporterResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryIsReporterResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryIsReporterResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryIsReporterResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryIsReporterResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryIsReporterResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryIsReporterResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.IsReporter != false {
+		value := protoreflect.ValueOfBool(x.IsReporter)
+		if !f(fd_QueryIsReporterResponse_is_reporter, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryIsReporterResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
+		return x.IsReporter != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryIsReporterResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
+		x.IsReporter = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryIsReporterResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
+		value := x.IsReporter
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryIsReporterResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
+		x.IsReporter = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryIsReporterResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
+		panic(fmt.Errorf("field is_reporter of message band.oracle.v1.QueryIsReporterResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryIsReporterResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryIsReporterResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryIsReporterResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryIsReporterResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryIsReporterResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryIsReporterResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryIsReporterResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryIsReporterResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.IsReporter {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryIsReporterResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.IsReporter {
+			i--
+			if x.IsReporter {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryIsReporterResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryIsReporterResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryIsReporterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsReporter", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsReporter = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryReportersRequest                   protoreflect.MessageDescriptor
+	fd_QueryReportersRequest_validator_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryReportersRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryReportersRequest")
+	fd_QueryReportersRequest_validator_address = md_QueryReportersRequest.Fields().ByName("validator_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryReportersRequest)(nil)
+
+type fastReflection_QueryReportersRequest QueryReportersRequest
+
+func (x *QueryReportersRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryReportersRequest)(x)
+}
+
+func (x *QueryReportersRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryReportersRequest_messageType fastReflection_QueryReportersRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryReportersRequest_messageType{}
+
+type fastReflection_QueryReportersRequest_messageType struct{}
+
+func (x fastReflection_QueryReportersRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryReportersRequest)(nil)
+}
+func (x fastReflection_QueryReportersRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryReportersRequest)
+}
+func (x fastReflection_QueryReportersRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryReportersRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryReportersRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryReportersRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryReportersRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryReportersRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryReportersRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryReportersRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryReportersRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryReportersRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryReportersRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_QueryReportersRequest_validator_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryReportersRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryReportersRequest.validator_address":
+		return x.ValidatorAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryReportersRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryReportersRequest.validator_address":
+		x.ValidatorAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryReportersRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryReportersRequest.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryReportersRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryReportersRequest.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryReportersRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryReportersRequest.validator_address":
+		panic(fmt.Errorf("field validator_address of message band.oracle.v1.QueryReportersRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryReportersRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryReportersRequest.validator_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryReportersRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryReportersRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryReportersRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryReportersRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryReportersRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryReportersRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryReportersRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryReportersRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryReportersRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReportersRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReportersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryReportersResponse_1_list)(nil)
+
+type _QueryReportersResponse_1_list struct {
+	list *[]string
+}
+
+func (x *_QueryReportersResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryReportersResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_QueryReportersResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryReportersResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryReportersResponse_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryReportersResponse at list field Reporter as it is not of Message kind"))
+}
+
+func (x *_QueryReportersResponse_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryReportersResponse_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryReportersResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryReportersResponse          protoreflect.MessageDescriptor
+	fd_QueryReportersResponse_reporter protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryReportersResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryReportersResponse")
+	fd_QueryReportersResponse_reporter = md_QueryReportersResponse.Fields().ByName("reporter")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryReportersResponse)(nil)
+
+type fastReflection_QueryReportersResponse QueryReportersResponse
+
+func (x *QueryReportersResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryReportersResponse)(x)
+}
+
+func (x *QueryReportersResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryReportersResponse_messageType fastReflection_QueryReportersResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryReportersResponse_messageType{}
+
+type fastReflection_QueryReportersResponse_messageType struct{}
+
+func (x fastReflection_QueryReportersResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryReportersResponse)(nil)
+}
+func (x fastReflection_QueryReportersResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryReportersResponse)
+}
+func (x fastReflection_QueryReportersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryReportersResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryReportersResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryReportersResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryReportersResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryReportersResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryReportersResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryReportersResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryReportersResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryReportersResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryReportersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Reporter) != 0 {
+		value := protoreflect.ValueOfList(&_QueryReportersResponse_1_list{list: &x.Reporter})
+		if !f(fd_QueryReportersResponse_reporter, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryReportersResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryReportersResponse.reporter":
+		return len(x.Reporter) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryReportersResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryReportersResponse.reporter":
+		x.Reporter = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryReportersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryReportersResponse.reporter":
+		if len(x.Reporter) == 0 {
+			return protoreflect.ValueOfList(&_QueryReportersResponse_1_list{})
+		}
+		listValue := &_QueryReportersResponse_1_list{list: &x.Reporter}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryReportersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryReportersResponse.reporter":
+		lv := value.List()
+		clv := lv.(*_QueryReportersResponse_1_list)
+		x.Reporter = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryReportersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryReportersResponse.reporter":
+		if x.Reporter == nil {
+			x.Reporter = []string{}
+		}
+		value := &_QueryReportersResponse_1_list{list: &x.Reporter}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryReportersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryReportersResponse.reporter":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryReportersResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryReportersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryReportersResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryReportersResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryReportersResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryReportersResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryReportersResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryReportersResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Reporter) > 0 {
+			for _, s := range x.Reporter {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryReportersResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reporter) > 0 {
+			for iNdEx := len(x.Reporter) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Reporter[iNdEx])
+				copy(dAtA[i:], x.Reporter[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reporter[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryReportersResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReportersResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReportersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reporter = append(x.Reporter, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryActiveValidatorsRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryActiveValidatorsRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryActiveValidatorsRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryActiveValidatorsRequest)(nil)
+
+type fastReflection_QueryActiveValidatorsRequest QueryActiveValidatorsRequest
+
+func (x *QueryActiveValidatorsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryActiveValidatorsRequest)(x)
+}
+
+func (x *QueryActiveValidatorsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryActiveValidatorsRequest_messageType fastReflection_QueryActiveValidatorsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryActiveValidatorsRequest_messageType{}
+
+type fastReflection_QueryActiveValidatorsRequest_messageType struct{}
+
+func (x fastReflection_QueryActiveValidatorsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryActiveValidatorsRequest)(nil)
+}
+func (x fastReflection_QueryActiveValidatorsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryActiveValidatorsRequest)
+}
+func (x fastReflection_QueryActiveValidatorsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActiveValidatorsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryActiveValidatorsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActiveValidatorsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryActiveValidatorsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryActiveValidatorsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryActiveValidatorsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryActiveValidatorsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryActiveValidatorsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryActiveValidatorsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryActiveValidatorsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryActiveValidatorsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActiveValidatorsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryActiveValidatorsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActiveValidatorsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActiveValidatorsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryActiveValidatorsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryActiveValidatorsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryActiveValidatorsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryActiveValidatorsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActiveValidatorsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryActiveValidatorsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryActiveValidatorsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryActiveValidatorsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryActiveValidatorsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryActiveValidatorsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveValidatorsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryActiveValidatorsResponse_1_list)(nil)
+
+type _QueryActiveValidatorsResponse_1_list struct {
+	list *[]*ActiveValidator
+}
+
+func (x *_QueryActiveValidatorsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryActiveValidatorsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryActiveValidatorsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ActiveValidator)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryActiveValidatorsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ActiveValidator)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryActiveValidatorsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(ActiveValidator)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryActiveValidatorsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryActiveValidatorsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(ActiveValidator)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryActiveValidatorsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryActiveValidatorsResponse            protoreflect.MessageDescriptor
+	fd_QueryActiveValidatorsResponse_validators protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryActiveValidatorsResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryActiveValidatorsResponse")
+	fd_QueryActiveValidatorsResponse_validators = md_QueryActiveValidatorsResponse.Fields().ByName("validators")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryActiveValidatorsResponse)(nil)
+
+type fastReflection_QueryActiveValidatorsResponse QueryActiveValidatorsResponse
+
+func (x *QueryActiveValidatorsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryActiveValidatorsResponse)(x)
+}
+
+func (x *QueryActiveValidatorsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryActiveValidatorsResponse_messageType fastReflection_QueryActiveValidatorsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryActiveValidatorsResponse_messageType{}
+
+type fastReflection_QueryActiveValidatorsResponse_messageType struct{}
+
+func (x fastReflection_QueryActiveValidatorsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryActiveValidatorsResponse)(nil)
+}
+func (x fastReflection_QueryActiveValidatorsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryActiveValidatorsResponse)
+}
+func (x fastReflection_QueryActiveValidatorsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActiveValidatorsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryActiveValidatorsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActiveValidatorsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryActiveValidatorsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryActiveValidatorsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryActiveValidatorsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryActiveValidatorsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryActiveValidatorsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryActiveValidatorsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryActiveValidatorsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Validators) != 0 {
+		value := protoreflect.ValueOfList(&_QueryActiveValidatorsResponse_1_list{list: &x.Validators})
+		if !f(fd_QueryActiveValidatorsResponse_validators, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryActiveValidatorsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
+		return len(x.Validators) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActiveValidatorsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
+		x.Validators = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryActiveValidatorsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
+		if len(x.Validators) == 0 {
+			return protoreflect.ValueOfList(&_QueryActiveValidatorsResponse_1_list{})
+		}
+		listValue := &_QueryActiveValidatorsResponse_1_list{list: &x.Validators}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActiveValidatorsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
+		lv := value.List()
+		clv := lv.(*_QueryActiveValidatorsResponse_1_list)
+		x.Validators = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActiveValidatorsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
+		if x.Validators == nil {
+			x.Validators = []*ActiveValidator{}
+		}
+		value := &_QueryActiveValidatorsResponse_1_list{list: &x.Validators}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryActiveValidatorsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
+		list := []*ActiveValidator{}
+		return protoreflect.ValueOfList(&_QueryActiveValidatorsResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryActiveValidatorsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryActiveValidatorsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryActiveValidatorsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActiveValidatorsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryActiveValidatorsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryActiveValidatorsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryActiveValidatorsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Validators) > 0 {
+			for _, e := range x.Validators {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryActiveValidatorsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Validators) > 0 {
+			for iNdEx := len(x.Validators) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Validators[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryActiveValidatorsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveValidatorsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validators = append(x.Validators, &ActiveValidator{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Validators[len(x.Validators)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryRequestSearchRequest                  protoreflect.MessageDescriptor
+	fd_QueryRequestSearchRequest_oracle_script_id protoreflect.FieldDescriptor
+	fd_QueryRequestSearchRequest_calldata         protoreflect.FieldDescriptor
+	fd_QueryRequestSearchRequest_ask_count        protoreflect.FieldDescriptor
+	fd_QueryRequestSearchRequest_min_count        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryRequestSearchRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestSearchRequest")
+	fd_QueryRequestSearchRequest_oracle_script_id = md_QueryRequestSearchRequest.Fields().ByName("oracle_script_id")
+	fd_QueryRequestSearchRequest_calldata = md_QueryRequestSearchRequest.Fields().ByName("calldata")
+	fd_QueryRequestSearchRequest_ask_count = md_QueryRequestSearchRequest.Fields().ByName("ask_count")
+	fd_QueryRequestSearchRequest_min_count = md_QueryRequestSearchRequest.Fields().ByName("min_count")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRequestSearchRequest)(nil)
+
+type fastReflection_QueryRequestSearchRequest QueryRequestSearchRequest
+
+func (x *QueryRequestSearchRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRequestSearchRequest)(x)
+}
+
+func (x *QueryRequestSearchRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRequestSearchRequest_messageType fastReflection_QueryRequestSearchRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRequestSearchRequest_messageType{}
+
+type fastReflection_QueryRequestSearchRequest_messageType struct{}
+
+func (x fastReflection_QueryRequestSearchRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRequestSearchRequest)(nil)
+}
+func (x fastReflection_QueryRequestSearchRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestSearchRequest)
+}
+func (x fastReflection_QueryRequestSearchRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestSearchRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRequestSearchRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestSearchRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRequestSearchRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRequestSearchRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRequestSearchRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestSearchRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRequestSearchRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryRequestSearchRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRequestSearchRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_QueryRequestSearchRequest_oracle_script_id, value) {
+			return
+		}
+	}
+	if x.Calldata != "" {
+		value := protoreflect.ValueOfString(x.Calldata)
+		if !f(fd_QueryRequestSearchRequest_calldata, value) {
+			return
+		}
+	}
+	if x.AskCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AskCount)
+		if !f(fd_QueryRequestSearchRequest_ask_count, value) {
+			return
+		}
+	}
+	if x.MinCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinCount)
+		if !f(fd_QueryRequestSearchRequest_min_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRequestSearchRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
+		return x.Calldata != ""
+	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
+		return x.AskCount != uint64(0)
+	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
+		return x.MinCount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSearchRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
+		x.Calldata = ""
+	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
+		x.AskCount = uint64(0)
+	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
+		x.MinCount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRequestSearchRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
+		value := x.Calldata
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
+		value := x.AskCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
+		value := x.MinCount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSearchRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
+		x.Calldata = value.Interface().(string)
+	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
+		x.AskCount = value.Uint()
+	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
+		x.MinCount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSearchRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.QueryRequestSearchRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.QueryRequestSearchRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
+		panic(fmt.Errorf("field ask_count of message band.oracle.v1.QueryRequestSearchRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.QueryRequestSearchRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRequestSearchRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRequestSearchRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestSearchRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRequestSearchRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSearchRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRequestSearchRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRequestSearchRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRequestSearchRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		}
+		l = len(x.Calldata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AskCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AskCount))
+		}
+		if x.MinCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinCount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestSearchRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.AskCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Calldata) > 0 {
+			i -= len(x.Calldata)
+			copy(dAtA[i:], x.Calldata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestSearchRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSearchRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				}
+				x.OracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Calldata = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				}
+				x.AskCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AskCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				}
+				x.MinCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryRequestSearchResponse         protoreflect.MessageDescriptor
+	fd_QueryRequestSearchResponse_request protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryRequestSearchResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestSearchResponse")
+	fd_QueryRequestSearchResponse_request = md_QueryRequestSearchResponse.Fields().ByName("request")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRequestSearchResponse)(nil)
+
+type fastReflection_QueryRequestSearchResponse QueryRequestSearchResponse
+
+func (x *QueryRequestSearchResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRequestSearchResponse)(x)
+}
+
+func (x *QueryRequestSearchResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRequestSearchResponse_messageType fastReflection_QueryRequestSearchResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRequestSearchResponse_messageType{}
+
+type fastReflection_QueryRequestSearchResponse_messageType struct{}
+
+func (x fastReflection_QueryRequestSearchResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRequestSearchResponse)(nil)
+}
+func (x fastReflection_QueryRequestSearchResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestSearchResponse)
+}
+func (x fastReflection_QueryRequestSearchResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestSearchResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRequestSearchResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestSearchResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRequestSearchResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRequestSearchResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRequestSearchResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestSearchResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRequestSearchResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryRequestSearchResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRequestSearchResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Request != nil {
+		value := protoreflect.ValueOfMessage(x.Request.ProtoReflect())
+		if !f(fd_QueryRequestSearchResponse_request, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRequestSearchResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSearchResponse.request":
+		return x.Request != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSearchResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSearchResponse.request":
+		x.Request = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRequestSearchResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryRequestSearchResponse.request":
+		value := x.Request
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSearchResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSearchResponse.request":
+		x.Request = value.Message().Interface().(*QueryRequestResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSearchResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSearchResponse.request":
+		if x.Request == nil {
+			x.Request = new(QueryRequestResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Request.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRequestSearchResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSearchResponse.request":
+		m := new(QueryRequestResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRequestSearchResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestSearchResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRequestSearchResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSearchResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRequestSearchResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRequestSearchResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRequestSearchResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Request != nil {
+			l = options.Size(x.Request)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestSearchResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Request != nil {
+			encoded, err := options.Marshal(x.Request)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestSearchResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSearchResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Request == nil {
+					x.Request = &QueryRequestResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Request); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryRequestPriceRequest_1_list)(nil)
+
+type _QueryRequestPriceRequest_1_list struct {
+	list *[]string
+}
+
+func (x *_QueryRequestPriceRequest_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryRequestPriceRequest_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_QueryRequestPriceRequest_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryRequestPriceRequest_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryRequestPriceRequest_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryRequestPriceRequest at list field Symbols as it is not of Message kind"))
+}
+
+func (x *_QueryRequestPriceRequest_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryRequestPriceRequest_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryRequestPriceRequest_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryRequestPriceRequest           protoreflect.MessageDescriptor
+	fd_QueryRequestPriceRequest_symbols   protoreflect.FieldDescriptor
+	fd_QueryRequestPriceRequest_ask_count protoreflect.FieldDescriptor
+	fd_QueryRequestPriceRequest_min_count protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryRequestPriceRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestPriceRequest")
+	fd_QueryRequestPriceRequest_symbols = md_QueryRequestPriceRequest.Fields().ByName("symbols")
+	fd_QueryRequestPriceRequest_ask_count = md_QueryRequestPriceRequest.Fields().ByName("ask_count")
+	fd_QueryRequestPriceRequest_min_count = md_QueryRequestPriceRequest.Fields().ByName("min_count")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRequestPriceRequest)(nil)
+
+type fastReflection_QueryRequestPriceRequest QueryRequestPriceRequest
+
+func (x *QueryRequestPriceRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRequestPriceRequest)(x)
+}
+
+func (x *QueryRequestPriceRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRequestPriceRequest_messageType fastReflection_QueryRequestPriceRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRequestPriceRequest_messageType{}
+
+type fastReflection_QueryRequestPriceRequest_messageType struct{}
+
+func (x fastReflection_QueryRequestPriceRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRequestPriceRequest)(nil)
+}
+func (x fastReflection_QueryRequestPriceRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestPriceRequest)
+}
+func (x fastReflection_QueryRequestPriceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestPriceRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRequestPriceRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestPriceRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRequestPriceRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRequestPriceRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRequestPriceRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestPriceRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRequestPriceRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryRequestPriceRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRequestPriceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Symbols) != 0 {
+		value := protoreflect.ValueOfList(&_QueryRequestPriceRequest_1_list{list: &x.Symbols})
+		if !f(fd_QueryRequestPriceRequest_symbols, value) {
+			return
+		}
+	}
+	if x.AskCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AskCount)
+		if !f(fd_QueryRequestPriceRequest_ask_count, value) {
+			return
+		}
+	}
+	if x.MinCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinCount)
+		if !f(fd_QueryRequestPriceRequest_min_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRequestPriceRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
+		return len(x.Symbols) != 0
+	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
+		return x.AskCount != uint64(0)
+	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
+		return x.MinCount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestPriceRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
+		x.Symbols = nil
+	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
+		x.AskCount = uint64(0)
+	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
+		x.MinCount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRequestPriceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
+		if len(x.Symbols) == 0 {
+			return protoreflect.ValueOfList(&_QueryRequestPriceRequest_1_list{})
+		}
+		listValue := &_QueryRequestPriceRequest_1_list{list: &x.Symbols}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
+		value := x.AskCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
+		value := x.MinCount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestPriceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
+		lv := value.List()
+		clv := lv.(*_QueryRequestPriceRequest_1_list)
+		x.Symbols = *clv.list
+	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
+		x.AskCount = value.Uint()
+	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
+		x.MinCount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestPriceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
+		if x.Symbols == nil {
+			x.Symbols = []string{}
+		}
+		value := &_QueryRequestPriceRequest_1_list{list: &x.Symbols}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
+		panic(fmt.Errorf("field ask_count of message band.oracle.v1.QueryRequestPriceRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.QueryRequestPriceRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRequestPriceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryRequestPriceRequest_1_list{list: &list})
+	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRequestPriceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestPriceRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRequestPriceRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestPriceRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRequestPriceRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRequestPriceRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRequestPriceRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Symbols) > 0 {
+			for _, s := range x.Symbols {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.AskCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AskCount))
+		}
+		if x.MinCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinCount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestPriceRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.AskCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Symbols) > 0 {
+			for iNdEx := len(x.Symbols) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Symbols[iNdEx])
+				copy(dAtA[i:], x.Symbols[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Symbols[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestPriceRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestPriceRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Symbols", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Symbols = append(x.Symbols, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				}
+				x.AskCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AskCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				}
+				x.MinCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryRequestPriceResponse_1_list)(nil)
+
+type _QueryRequestPriceResponse_1_list struct {
+	list *[]*PriceResult
+}
+
+func (x *_QueryRequestPriceResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryRequestPriceResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryRequestPriceResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceResult)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryRequestPriceResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceResult)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryRequestPriceResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(PriceResult)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryRequestPriceResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryRequestPriceResponse_1_list) NewElement() protoreflect.Value {
+	v := new(PriceResult)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryRequestPriceResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryRequestPriceResponse               protoreflect.MessageDescriptor
+	fd_QueryRequestPriceResponse_price_results protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryRequestPriceResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestPriceResponse")
+	fd_QueryRequestPriceResponse_price_results = md_QueryRequestPriceResponse.Fields().ByName("price_results")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRequestPriceResponse)(nil)
+
+type fastReflection_QueryRequestPriceResponse QueryRequestPriceResponse
+
+func (x *QueryRequestPriceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRequestPriceResponse)(x)
+}
+
+func (x *QueryRequestPriceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRequestPriceResponse_messageType fastReflection_QueryRequestPriceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRequestPriceResponse_messageType{}
+
+type fastReflection_QueryRequestPriceResponse_messageType struct{}
+
+func (x fastReflection_QueryRequestPriceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRequestPriceResponse)(nil)
+}
+func (x fastReflection_QueryRequestPriceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestPriceResponse)
+}
+func (x fastReflection_QueryRequestPriceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestPriceResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRequestPriceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestPriceResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRequestPriceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRequestPriceResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRequestPriceResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestPriceResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRequestPriceResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryRequestPriceResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRequestPriceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.PriceResults) != 0 {
+		value := protoreflect.ValueOfList(&_QueryRequestPriceResponse_1_list{list: &x.PriceResults})
+		if !f(fd_QueryRequestPriceResponse_price_results, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRequestPriceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
+		return len(x.PriceResults) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestPriceResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
+		x.PriceResults = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRequestPriceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
+		if len(x.PriceResults) == 0 {
+			return protoreflect.ValueOfList(&_QueryRequestPriceResponse_1_list{})
+		}
+		listValue := &_QueryRequestPriceResponse_1_list{list: &x.PriceResults}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestPriceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
+		lv := value.List()
+		clv := lv.(*_QueryRequestPriceResponse_1_list)
+		x.PriceResults = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestPriceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
+		if x.PriceResults == nil {
+			x.PriceResults = []*PriceResult{}
+		}
+		value := &_QueryRequestPriceResponse_1_list{list: &x.PriceResults}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRequestPriceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
+		list := []*PriceResult{}
+		return protoreflect.ValueOfList(&_QueryRequestPriceResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRequestPriceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestPriceResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRequestPriceResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestPriceResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRequestPriceResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRequestPriceResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRequestPriceResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.PriceResults) > 0 {
+			for _, e := range x.PriceResults {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestPriceResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PriceResults) > 0 {
+			for iNdEx := len(x.PriceResults) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PriceResults[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestPriceResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestPriceResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceResults", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceResults = append(x.PriceResults, &PriceResult{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceResults[len(x.PriceResults)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryRequestVerificationRequest                protoreflect.MessageDescriptor
+	fd_QueryRequestVerificationRequest_chain_id       protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationRequest_validator      protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationRequest_request_id     protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationRequest_external_id    protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationRequest_data_source_id protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationRequest_reporter       protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationRequest_signature      protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationRequest_max_delay      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryRequestVerificationRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestVerificationRequest")
+	fd_QueryRequestVerificationRequest_chain_id = md_QueryRequestVerificationRequest.Fields().ByName("chain_id")
+	fd_QueryRequestVerificationRequest_validator = md_QueryRequestVerificationRequest.Fields().ByName("validator")
+	fd_QueryRequestVerificationRequest_request_id = md_QueryRequestVerificationRequest.Fields().ByName("request_id")
+	fd_QueryRequestVerificationRequest_external_id = md_QueryRequestVerificationRequest.Fields().ByName("external_id")
+	fd_QueryRequestVerificationRequest_data_source_id = md_QueryRequestVerificationRequest.Fields().ByName("data_source_id")
+	fd_QueryRequestVerificationRequest_reporter = md_QueryRequestVerificationRequest.Fields().ByName("reporter")
+	fd_QueryRequestVerificationRequest_signature = md_QueryRequestVerificationRequest.Fields().ByName("signature")
+	fd_QueryRequestVerificationRequest_max_delay = md_QueryRequestVerificationRequest.Fields().ByName("max_delay")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRequestVerificationRequest)(nil)
+
+type fastReflection_QueryRequestVerificationRequest QueryRequestVerificationRequest
+
+func (x *QueryRequestVerificationRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRequestVerificationRequest)(x)
+}
+
+func (x *QueryRequestVerificationRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRequestVerificationRequest_messageType fastReflection_QueryRequestVerificationRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRequestVerificationRequest_messageType{}
+
+type fastReflection_QueryRequestVerificationRequest_messageType struct{}
+
+func (x fastReflection_QueryRequestVerificationRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRequestVerificationRequest)(nil)
+}
+func (x fastReflection_QueryRequestVerificationRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestVerificationRequest)
+}
+func (x fastReflection_QueryRequestVerificationRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestVerificationRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRequestVerificationRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestVerificationRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRequestVerificationRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRequestVerificationRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRequestVerificationRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestVerificationRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRequestVerificationRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryRequestVerificationRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRequestVerificationRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChainId != "" {
+		value := protoreflect.ValueOfString(x.ChainId)
+		if !f(fd_QueryRequestVerificationRequest_chain_id, value) {
+			return
+		}
+	}
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_QueryRequestVerificationRequest_validator, value) {
+			return
+		}
+	}
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_QueryRequestVerificationRequest_request_id, value) {
+			return
+		}
+	}
+	if x.ExternalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExternalId)
+		if !f(fd_QueryRequestVerificationRequest_external_id, value) {
+			return
+		}
+	}
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_QueryRequestVerificationRequest_data_source_id, value) {
+			return
+		}
+	}
+	if x.Reporter != "" {
+		value := protoreflect.ValueOfString(x.Reporter)
+		if !f(fd_QueryRequestVerificationRequest_reporter, value) {
+			return
+		}
+	}
+	if len(x.Signature) != 0 {
+		value := protoreflect.ValueOfBytes(x.Signature)
+		if !f(fd_QueryRequestVerificationRequest_signature, value) {
+			return
+		}
+	}
+	if x.MaxDelay != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxDelay)
+		if !f(fd_QueryRequestVerificationRequest_max_delay, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRequestVerificationRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
+		return x.ChainId != ""
+	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
+		return x.Validator != ""
+	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
+		return x.RequestId != uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
+		return x.ExternalId != uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
+		return x.DataSourceId != uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
+		return x.Reporter != ""
+	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
+		return len(x.Signature) != 0
+	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
+		return x.MaxDelay != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestVerificationRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
+		x.ChainId = ""
+	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
+		x.Validator = ""
+	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
+		x.RequestId = uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
+		x.ExternalId = uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
+		x.DataSourceId = uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
+		x.Reporter = ""
+	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
+		x.Signature = nil
+	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
+		x.MaxDelay = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRequestVerificationRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
+		value := x.ExternalId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
+		value := x.DataSourceId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
+		value := x.Reporter
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
+		value := x.Signature
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
+		value := x.MaxDelay
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestVerificationRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
+		x.ChainId = value.Interface().(string)
+	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
+		x.Validator = value.Interface().(string)
+	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
+		x.RequestId = value.Uint()
+	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
+		x.ExternalId = value.Uint()
+	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
+		x.DataSourceId = value.Uint()
+	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
+		x.Reporter = value.Interface().(string)
+	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
+		x.Signature = value.Bytes()
+	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
+		x.MaxDelay = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestVerificationRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
+		panic(fmt.Errorf("field chain_id of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
+		panic(fmt.Errorf("field validator of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
+		panic(fmt.Errorf("field external_id of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
+		panic(fmt.Errorf("field reporter of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
+		panic(fmt.Errorf("field signature of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
+		panic(fmt.Errorf("field max_delay of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRequestVerificationRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRequestVerificationRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestVerificationRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
 
-func (x *_QueryActiveValidatorsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(ActiveValidator)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRequestVerificationRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestVerificationRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRequestVerificationRequest) IsValid() bool {
+	return x != nil
 }
 
-func (x *_QueryActiveValidatorsResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRequestVerificationRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.ExternalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExternalId))
+		}
+		if x.DataSourceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceId))
+		}
+		l = len(x.Reporter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxDelay != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxDelay))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestVerificationRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxDelay != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxDelay))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Reporter) > 0 {
+			i -= len(x.Reporter)
+			copy(dAtA[i:], x.Reporter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reporter)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ExternalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ChainId) > 0 {
+			i -= len(x.ChainId)
+			copy(dAtA[i:], x.ChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestVerificationRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestVerificationRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				}
+				x.ExternalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExternalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+				}
+				x.DataSourceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DataSourceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reporter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = append(x.Signature[:0], dAtA[iNdEx:postIndex]...)
+				if x.Signature == nil {
+					x.Signature = []byte{}
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDelay", wireType)
+				}
+				x.MaxDelay = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxDelay |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
 	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryActiveValidatorsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(ActiveValidator)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryActiveValidatorsResponse_1_list) IsValid() bool {
-	return x.list != nil
 }
 
 var (
-	md_QueryActiveValidatorsResponse            protoreflect.MessageDescriptor
-	fd_QueryActiveValidatorsResponse_validators protoreflect.FieldDescriptor
+	md_QueryRequestVerificationResponse                protoreflect.MessageDescriptor
+	fd_QueryRequestVerificationResponse_chain_id       protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationResponse_validator      protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationResponse_request_id     protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationResponse_external_id    protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationResponse_data_source_id protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationResponse_is_delay       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryActiveValidatorsResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryActiveValidatorsResponse")
-	fd_QueryActiveValidatorsResponse_validators = md_QueryActiveValidatorsResponse.Fields().ByName("validators")
+	md_QueryRequestVerificationResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestVerificationResponse")
+	fd_QueryRequestVerificationResponse_chain_id = md_QueryRequestVerificationResponse.Fields().ByName("chain_id")
+	fd_QueryRequestVerificationResponse_validator = md_QueryRequestVerificationResponse.Fields().ByName("validator")
+	fd_QueryRequestVerificationResponse_request_id = md_QueryRequestVerificationResponse.Fields().ByName("request_id")
+	fd_QueryRequestVerificationResponse_external_id = md_QueryRequestVerificationResponse.Fields().ByName("external_id")
+	fd_QueryRequestVerificationResponse_data_source_id = md_QueryRequestVerificationResponse.Fields().ByName("data_source_id")
+	fd_QueryRequestVerificationResponse_is_delay = md_QueryRequestVerificationResponse.Fields().ByName("is_delay")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryActiveValidatorsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryRequestVerificationResponse)(nil)
 
-type fastReflection_QueryActiveValidatorsResponse QueryActiveValidatorsResponse
+type fastReflection_QueryRequestVerificationResponse QueryRequestVerificationResponse
 
-func (x *QueryActiveValidatorsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryActiveValidatorsResponse)(x)
+func (x *QueryRequestVerificationResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRequestVerificationResponse)(x)
 }
 
-func (x *QueryActiveValidatorsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[21]
+func (x *QueryRequestVerificationResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9360,43 +14881,43 @@ func (x *QueryActiveValidatorsResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryActiveValidatorsResponse_messageType fastReflection_QueryActiveValidatorsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryActiveValidatorsResponse_messageType{}
+var _fastReflection_QueryRequestVerificationResponse_messageType fastReflection_QueryRequestVerificationResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRequestVerificationResponse_messageType{}
 
-type fastReflection_QueryActiveValidatorsResponse_messageType struct{}
+type fastReflection_QueryRequestVerificationResponse_messageType struct{}
 
-func (x fastReflection_QueryActiveValidatorsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryActiveValidatorsResponse)(nil)
+func (x fastReflection_QueryRequestVerificationResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRequestVerificationResponse)(nil)
 }
-func (x fastReflection_QueryActiveValidatorsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryActiveValidatorsResponse)
+func (x fastReflection_QueryRequestVerificationResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestVerificationResponse)
 }
-func (x fastReflection_QueryActiveValidatorsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActiveValidatorsResponse
+func (x fastReflection_QueryRequestVerificationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestVerificationResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryActiveValidatorsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActiveValidatorsResponse
+func (x *fastReflection_QueryRequestVerificationResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestVerificationResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryActiveValidatorsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryActiveValidatorsResponse_messageType
+func (x *fastReflection_QueryRequestVerificationResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRequestVerificationResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryActiveValidatorsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryActiveValidatorsResponse)
+func (x *fastReflection_QueryRequestVerificationResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestVerificationResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryActiveValidatorsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryActiveValidatorsResponse)(x)
+func (x *fastReflection_QueryRequestVerificationResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryRequestVerificationResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9404,10 +14925,40 @@ func (x *fastReflection_QueryActiveValidatorsResponse) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryActiveValidatorsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Validators) != 0 {
-		value := protoreflect.ValueOfList(&_QueryActiveValidatorsResponse_1_list{list: &x.Validators})
-		if !f(fd_QueryActiveValidatorsResponse_validators, value) {
+func (x *fastReflection_QueryRequestVerificationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChainId != "" {
+		value := protoreflect.ValueOfString(x.ChainId)
+		if !f(fd_QueryRequestVerificationResponse_chain_id, value) {
+			return
+		}
+	}
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_QueryRequestVerificationResponse_validator, value) {
+			return
+		}
+	}
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_QueryRequestVerificationResponse_request_id, value) {
+			return
+		}
+	}
+	if x.ExternalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExternalId)
+		if !f(fd_QueryRequestVerificationResponse_external_id, value) {
+			return
+		}
+	}
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_QueryRequestVerificationResponse_data_source_id, value) {
+			return
+		}
+	}
+	if x.IsDelay != false {
+		value := protoreflect.ValueOfBool(x.IsDelay)
+		if !f(fd_QueryRequestVerificationResponse_is_delay, value) {
 			return
 		}
 	}
@@ -9424,15 +14975,25 @@ func (x *fastReflection_QueryActiveValidatorsResponse) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryActiveValidatorsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryRequestVerificationResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
-		return len(x.Validators) != 0
+	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
+		return x.ChainId != ""
+	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
+		return x.Validator != ""
+	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
+		return x.RequestId != uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
+		return x.ExternalId != uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
+		return x.DataSourceId != uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
+		return x.IsDelay != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9442,15 +15003,25 @@ func (x *fastReflection_QueryActiveValidatorsResponse) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveValidatorsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryRequestVerificationResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
-		x.Validators = nil
+	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
+		x.ChainId = ""
+	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
+		x.Validator = ""
+	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
+		x.RequestId = uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
+		x.ExternalId = uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
+		x.DataSourceId = uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
+		x.IsDelay = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9460,19 +15031,31 @@ func (x *fastReflection_QueryActiveValidatorsResponse) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryActiveValidatorsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryRequestVerificationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
-		if len(x.Validators) == 0 {
-			return protoreflect.ValueOfList(&_QueryActiveValidatorsResponse_1_list{})
-		}
-		listValue := &_QueryActiveValidatorsResponse_1_list{list: &x.Validators}
-		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
+		value := x.ExternalId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
+		value := x.DataSourceId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
+		value := x.IsDelay
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9486,17 +15069,25 @@ func (x *fastReflection_QueryActiveValidatorsResponse) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveValidatorsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryRequestVerificationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
-		lv := value.List()
-		clv := lv.(*_QueryActiveValidatorsResponse_1_list)
-		x.Validators = *clv.list
+	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
+		x.ChainId = value.Interface().(string)
+	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
+		x.Validator = value.Interface().(string)
+	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
+		x.RequestId = value.Uint()
+	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
+		x.ExternalId = value.Uint()
+	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
+		x.DataSourceId = value.Uint()
+	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
+		x.IsDelay = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9510,45 +15101,60 @@ func (x *fastReflection_QueryActiveValidatorsResponse) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveValidatorsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryRequestVerificationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
-		if x.Validators == nil {
-			x.Validators = []*ActiveValidator{}
-		}
-		value := &_QueryActiveValidatorsResponse_1_list{list: &x.Validators}
-		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
+		panic(fmt.Errorf("field chain_id of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
+		panic(fmt.Errorf("field validator of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
+		panic(fmt.Errorf("field external_id of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
+		panic(fmt.Errorf("field is_delay of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryActiveValidatorsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryRequestVerificationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
-		list := []*ActiveValidator{}
-		return protoreflect.ValueOfList(&_QueryActiveValidatorsResponse_1_list{list: &list})
+	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryActiveValidatorsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryRequestVerificationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryActiveValidatorsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestVerificationResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9556,7 +15162,7 @@ func (x *fastReflection_QueryActiveValidatorsResponse) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryActiveValidatorsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryRequestVerificationResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9567,7 +15173,7 @@ func (x *fastReflection_QueryActiveValidatorsResponse) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveValidatorsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryRequestVerificationResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9579,7 +15185,7 @@ func (x *fastReflection_QueryActiveValidatorsResponse) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryActiveValidatorsResponse) IsValid() bool {
+func (x *fastReflection_QueryRequestVerificationResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9589,9 +15195,9 @@ func (x *fastReflection_QueryActiveValidatorsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryActiveValidatorsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryActiveValidatorsResponse)
+		x := input.Message.Interface().(*QueryRequestVerificationResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9603,11 +15209,25 @@ func (x *fastReflection_QueryActiveValidatorsResponse) ProtoMethods() *protoifac
 		var n int
 		var l int
 		_ = l
-		if len(x.Validators) > 0 {
-			for _, e := range x.Validators {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.ChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.ExternalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExternalId))
+		}
+		if x.DataSourceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceId))
+		}
+		if x.IsDelay {
+			n += 2
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -9619,7 +15239,7 @@ func (x *fastReflection_QueryActiveValidatorsResponse) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActiveValidatorsResponse)
+		x := input.Message.Interface().(*QueryRequestVerificationResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9638,21 +15258,44 @@ func (x *fastReflection_QueryActiveValidatorsResponse) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Validators) > 0 {
-			for iNdEx := len(x.Validators) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Validators[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if x.IsDelay {
+			i--
+			if x.IsDelay {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ExternalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ChainId) > 0 {
+			i -= len(x.ChainId)
+			copy(dAtA[i:], x.ChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -9665,7 +15308,7 @@ func (x *fastReflection_QueryActiveValidatorsResponse) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActiveValidatorsResponse)
+		x := input.Message.Interface().(*QueryRequestVerificationResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9697,17 +15340,17 @@ func (x *fastReflection_QueryActiveValidatorsResponse) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveValidatorsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestVerificationResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9717,26 +15360,133 @@ func (x *fastReflection_QueryActiveValidatorsResponse) ProtoMethods() *protoifac
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Validators = append(x.Validators, &ActiveValidator{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Validators[len(x.Validators)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.ChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				}
+				x.ExternalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExternalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+				}
+				x.DataSourceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DataSourceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsDelay", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsDelay = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9773,32 +15523,26 @@ func (x *fastReflection_QueryActiveValidatorsResponse) ProtoMethods() *protoifac
 }
 
 var (
-	md_QueryRequestSearchRequest                  protoreflect.MessageDescriptor
-	fd_QueryRequestSearchRequest_oracle_script_id protoreflect.FieldDescriptor
-	fd_QueryRequestSearchRequest_calldata         protoreflect.FieldDescriptor
-	fd_QueryRequestSearchRequest_ask_count        protoreflect.FieldDescriptor
-	fd_QueryRequestSearchRequest_min_count        protoreflect.FieldDescriptor
+	md_QuerySubscriptionRequest                 protoreflect.MessageDescriptor
+	fd_QuerySubscriptionRequest_subscription_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryRequestSearchRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestSearchRequest")
-	fd_QueryRequestSearchRequest_oracle_script_id = md_QueryRequestSearchRequest.Fields().ByName("oracle_script_id")
-	fd_QueryRequestSearchRequest_calldata = md_QueryRequestSearchRequest.Fields().ByName("calldata")
-	fd_QueryRequestSearchRequest_ask_count = md_QueryRequestSearchRequest.Fields().ByName("ask_count")
-	fd_QueryRequestSearchRequest_min_count = md_QueryRequestSearchRequest.Fields().ByName("min_count")
+	md_QuerySubscriptionRequest = File_band_oracle_v1_query_proto.Messages().ByName("QuerySubscriptionRequest")
+	fd_QuerySubscriptionRequest_subscription_id = md_QuerySubscriptionRequest.Fields().ByName("subscription_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryRequestSearchRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QuerySubscriptionRequest)(nil)
 
-type fastReflection_QueryRequestSearchRequest QueryRequestSearchRequest
+type fastReflection_QuerySubscriptionRequest QuerySubscriptionRequest
 
-func (x *QueryRequestSearchRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryRequestSearchRequest)(x)
+func (x *QuerySubscriptionRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySubscriptionRequest)(x)
 }
 
-func (x *QueryRequestSearchRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[22]
+func (x *QuerySubscriptionRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9809,43 +15553,43 @@ func (x *QueryRequestSearchRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryRequestSearchRequest_messageType fastReflection_QueryRequestSearchRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryRequestSearchRequest_messageType{}
+var _fastReflection_QuerySubscriptionRequest_messageType fastReflection_QuerySubscriptionRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySubscriptionRequest_messageType{}
 
-type fastReflection_QueryRequestSearchRequest_messageType struct{}
+type fastReflection_QuerySubscriptionRequest_messageType struct{}
 
-func (x fastReflection_QueryRequestSearchRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryRequestSearchRequest)(nil)
+func (x fastReflection_QuerySubscriptionRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySubscriptionRequest)(nil)
 }
-func (x fastReflection_QueryRequestSearchRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestSearchRequest)
+func (x fastReflection_QuerySubscriptionRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySubscriptionRequest)
 }
-func (x fastReflection_QueryRequestSearchRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestSearchRequest
+func (x fastReflection_QuerySubscriptionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySubscriptionRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryRequestSearchRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestSearchRequest
+func (x *fastReflection_QuerySubscriptionRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySubscriptionRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryRequestSearchRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryRequestSearchRequest_messageType
+func (x *fastReflection_QuerySubscriptionRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySubscriptionRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryRequestSearchRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestSearchRequest)
+func (x *fastReflection_QuerySubscriptionRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySubscriptionRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryRequestSearchRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryRequestSearchRequest)(x)
+func (x *fastReflection_QuerySubscriptionRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySubscriptionRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9853,28 +15597,10 @@ func (x *fastReflection_QueryRequestSearchRequest) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryRequestSearchRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.OracleScriptId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OracleScriptId)
-		if !f(fd_QueryRequestSearchRequest_oracle_script_id, value) {
-			return
-		}
-	}
-	if x.Calldata != "" {
-		value := protoreflect.ValueOfString(x.Calldata)
-		if !f(fd_QueryRequestSearchRequest_calldata, value) {
-			return
-		}
-	}
-	if x.AskCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.AskCount)
-		if !f(fd_QueryRequestSearchRequest_ask_count, value) {
-			return
-		}
-	}
-	if x.MinCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MinCount)
-		if !f(fd_QueryRequestSearchRequest_min_count, value) {
+func (x *fastReflection_QuerySubscriptionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SubscriptionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SubscriptionId)
+		if !f(fd_QuerySubscriptionRequest_subscription_id, value) {
 			return
 		}
 	}
@@ -9891,21 +15617,15 @@ func (x *fastReflection_QueryRequestSearchRequest) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryRequestSearchRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QuerySubscriptionRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
-		return x.OracleScriptId != uint64(0)
-	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
-		return x.Calldata != ""
-	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
-		return x.AskCount != uint64(0)
-	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
-		return x.MinCount != uint64(0)
+	case "band.oracle.v1.QuerySubscriptionRequest.subscription_id":
+		return x.SubscriptionId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9915,21 +15635,15 @@ func (x *fastReflection_QueryRequestSearchRequest) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestSearchRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QuerySubscriptionRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
-		x.OracleScriptId = uint64(0)
-	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
-		x.Calldata = ""
-	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
-		x.AskCount = uint64(0)
-	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
-		x.MinCount = uint64(0)
+	case "band.oracle.v1.QuerySubscriptionRequest.subscription_id":
+		x.SubscriptionId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9939,25 +15653,16 @@ func (x *fastReflection_QueryRequestSearchRequest) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryRequestSearchRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySubscriptionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
-		value := x.OracleScriptId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
-		value := x.Calldata
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
-		value := x.AskCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
-		value := x.MinCount
+	case "band.oracle.v1.QuerySubscriptionRequest.subscription_id":
+		value := x.SubscriptionId
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9971,21 +15676,15 @@ func (x *fastReflection_QueryRequestSearchRequest) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestSearchRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QuerySubscriptionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
-		x.OracleScriptId = value.Uint()
-	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
-		x.Calldata = value.Interface().(string)
-	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
-		x.AskCount = value.Uint()
-	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
-		x.MinCount = value.Uint()
+	case "band.oracle.v1.QuerySubscriptionRequest.subscription_id":
+		x.SubscriptionId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9999,52 +15698,40 @@ func (x *fastReflection_QueryRequestSearchRequest) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestSearchRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySubscriptionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
-		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.QueryRequestSearchRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
-		panic(fmt.Errorf("field calldata of message band.oracle.v1.QueryRequestSearchRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
-		panic(fmt.Errorf("field ask_count of message band.oracle.v1.QueryRequestSearchRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
-		panic(fmt.Errorf("field min_count of message band.oracle.v1.QueryRequestSearchRequest is not mutable"))
+	case "band.oracle.v1.QuerySubscriptionRequest.subscription_id":
+		panic(fmt.Errorf("field subscription_id of message band.oracle.v1.QuerySubscriptionRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryRequestSearchRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySubscriptionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
+	case "band.oracle.v1.QuerySubscriptionRequest.subscription_id":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryRequestSearchRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QuerySubscriptionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestSearchRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QuerySubscriptionRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10052,7 +15739,7 @@ func (x *fastReflection_QueryRequestSearchRequest) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryRequestSearchRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QuerySubscriptionRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10063,7 +15750,7 @@ func (x *fastReflection_QueryRequestSearchRequest) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestSearchRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QuerySubscriptionRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10075,7 +15762,7 @@ func (x *fastReflection_QueryRequestSearchRequest) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryRequestSearchRequest) IsValid() bool {
+func (x *fastReflection_QuerySubscriptionRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -10085,9 +15772,9 @@ func (x *fastReflection_QueryRequestSearchRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryRequestSearchRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QuerySubscriptionRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryRequestSearchRequest)
+		x := input.Message.Interface().(*QuerySubscriptionRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10099,18 +15786,8 @@ func (x *fastReflection_QueryRequestSearchRequest) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if x.OracleScriptId != 0 {
-			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
-		}
-		l = len(x.Calldata)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.AskCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.AskCount))
-		}
-		if x.MinCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.MinCount))
+		if x.SubscriptionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubscriptionId))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -10122,7 +15799,7 @@ func (x *fastReflection_QueryRequestSearchRequest) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestSearchRequest)
+		x := input.Message.Interface().(*QuerySubscriptionRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10141,25 +15818,8 @@ func (x *fastReflection_QueryRequestSearchRequest) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MinCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.AskCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.Calldata) > 0 {
-			i -= len(x.Calldata)
-			copy(dAtA[i:], x.Calldata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.OracleScriptId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+		if x.SubscriptionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubscriptionId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -10174,7 +15834,7 @@ func (x *fastReflection_QueryRequestSearchRequest) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestSearchRequest)
+		x := input.Message.Interface().(*QuerySubscriptionRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10206,87 +15866,17 @@ func (x *fastReflection_QueryRequestSearchRequest) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSearchRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySubscriptionRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySubscriptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
-				}
-				x.OracleScriptId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OracleScriptId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Calldata = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
-				}
-				x.AskCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.AskCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
 				}
-				x.MinCount = 0
+				x.SubscriptionId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10296,7 +15886,7 @@ func (x *fastReflection_QueryRequestSearchRequest) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MinCount |= uint64(b&0x7F) << shift
+					x.SubscriptionId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -10336,27 +15926,80 @@ func (x *fastReflection_QueryRequestSearchRequest) ProtoMethods() *protoiface.Me
 	}
 }
 
+var _ protoreflect.List = (*_QuerySubscriptionResponse_2_list)(nil)
+
+type _QuerySubscriptionResponse_2_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QuerySubscriptionResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySubscriptionResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySubscriptionResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySubscriptionResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySubscriptionResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySubscriptionResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySubscriptionResponse_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySubscriptionResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryRequestSearchResponse         protoreflect.MessageDescriptor
-	fd_QueryRequestSearchResponse_request protoreflect.FieldDescriptor
+	md_QuerySubscriptionResponse              protoreflect.MessageDescriptor
+	fd_QuerySubscriptionResponse_subscription protoreflect.FieldDescriptor
+	fd_QuerySubscriptionResponse_balance      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryRequestSearchResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestSearchResponse")
-	fd_QueryRequestSearchResponse_request = md_QueryRequestSearchResponse.Fields().ByName("request")
+	md_QuerySubscriptionResponse = File_band_oracle_v1_query_proto.Messages().ByName("QuerySubscriptionResponse")
+	fd_QuerySubscriptionResponse_subscription = md_QuerySubscriptionResponse.Fields().ByName("subscription")
+	fd_QuerySubscriptionResponse_balance = md_QuerySubscriptionResponse.Fields().ByName("balance")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryRequestSearchResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QuerySubscriptionResponse)(nil)
 
-type fastReflection_QueryRequestSearchResponse QueryRequestSearchResponse
+type fastReflection_QuerySubscriptionResponse QuerySubscriptionResponse
 
-func (x *QueryRequestSearchResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryRequestSearchResponse)(x)
+func (x *QuerySubscriptionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySubscriptionResponse)(x)
 }
 
-func (x *QueryRequestSearchResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[23]
+func (x *QuerySubscriptionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10367,43 +16010,43 @@ func (x *QueryRequestSearchResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryRequestSearchResponse_messageType fastReflection_QueryRequestSearchResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryRequestSearchResponse_messageType{}
+var _fastReflection_QuerySubscriptionResponse_messageType fastReflection_QuerySubscriptionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySubscriptionResponse_messageType{}
 
-type fastReflection_QueryRequestSearchResponse_messageType struct{}
+type fastReflection_QuerySubscriptionResponse_messageType struct{}
 
-func (x fastReflection_QueryRequestSearchResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryRequestSearchResponse)(nil)
+func (x fastReflection_QuerySubscriptionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySubscriptionResponse)(nil)
 }
-func (x fastReflection_QueryRequestSearchResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestSearchResponse)
+func (x fastReflection_QuerySubscriptionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySubscriptionResponse)
 }
-func (x fastReflection_QueryRequestSearchResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestSearchResponse
+func (x fastReflection_QuerySubscriptionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySubscriptionResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryRequestSearchResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestSearchResponse
+func (x *fastReflection_QuerySubscriptionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySubscriptionResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryRequestSearchResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryRequestSearchResponse_messageType
+func (x *fastReflection_QuerySubscriptionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySubscriptionResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryRequestSearchResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestSearchResponse)
+func (x *fastReflection_QuerySubscriptionResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySubscriptionResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryRequestSearchResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryRequestSearchResponse)(x)
+func (x *fastReflection_QuerySubscriptionResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySubscriptionResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10411,10 +16054,16 @@ func (x *fastReflection_QueryRequestSearchResponse) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryRequestSearchResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Request != nil {
-		value := protoreflect.ValueOfMessage(x.Request.ProtoReflect())
-		if !f(fd_QueryRequestSearchResponse_request, value) {
+func (x *fastReflection_QuerySubscriptionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Subscription != nil {
+		value := protoreflect.ValueOfMessage(x.Subscription.ProtoReflect())
+		if !f(fd_QuerySubscriptionResponse_subscription, value) {
+			return
+		}
+	}
+	if len(x.Balance) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySubscriptionResponse_2_list{list: &x.Balance})
+		if !f(fd_QuerySubscriptionResponse_balance, value) {
 			return
 		}
 	}
@@ -10431,15 +16080,17 @@ func (x *fastReflection_QueryRequestSearchResponse) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryRequestSearchResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QuerySubscriptionResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestSearchResponse.request":
-		return x.Request != nil
+	case "band.oracle.v1.QuerySubscriptionResponse.subscription":
+		return x.Subscription != nil
+	case "band.oracle.v1.QuerySubscriptionResponse.balance":
+		return len(x.Balance) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10449,15 +16100,17 @@ func (x *fastReflection_QueryRequestSearchResponse) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestSearchResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QuerySubscriptionResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestSearchResponse.request":
-		x.Request = nil
+	case "band.oracle.v1.QuerySubscriptionResponse.subscription":
+		x.Subscription = nil
+	case "band.oracle.v1.QuerySubscriptionResponse.balance":
+		x.Balance = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10467,16 +16120,22 @@ func (x *fastReflection_QueryRequestSearchResponse) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryRequestSearchResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySubscriptionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryRequestSearchResponse.request":
-		value := x.Request
+	case "band.oracle.v1.QuerySubscriptionResponse.subscription":
+		value := x.Subscription
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.oracle.v1.QuerySubscriptionResponse.balance":
+		if len(x.Balance) == 0 {
+			return protoreflect.ValueOfList(&_QuerySubscriptionResponse_2_list{})
+		}
+		listValue := &_QuerySubscriptionResponse_2_list{list: &x.Balance}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10490,15 +16149,19 @@ func (x *fastReflection_QueryRequestSearchResponse) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestSearchResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QuerySubscriptionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestSearchResponse.request":
-		x.Request = value.Message().Interface().(*QueryRequestResponse)
+	case "band.oracle.v1.QuerySubscriptionResponse.subscription":
+		x.Subscription = value.Message().Interface().(*Subscription)
+	case "band.oracle.v1.QuerySubscriptionResponse.balance":
+		lv := value.List()
+		clv := lv.(*_QuerySubscriptionResponse_2_list)
+		x.Balance = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10512,44 +16175,53 @@ func (x *fastReflection_QueryRequestSearchResponse) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestSearchResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySubscriptionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestSearchResponse.request":
-		if x.Request == nil {
-			x.Request = new(QueryRequestResponse)
+	case "band.oracle.v1.QuerySubscriptionResponse.subscription":
+		if x.Subscription == nil {
+			x.Subscription = new(Subscription)
 		}
-		return protoreflect.ValueOfMessage(x.Request.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Subscription.ProtoReflect())
+	case "band.oracle.v1.QuerySubscriptionResponse.balance":
+		if x.Balance == nil {
+			x.Balance = []*v1beta11.Coin{}
+		}
+		value := &_QuerySubscriptionResponse_2_list{list: &x.Balance}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryRequestSearchResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySubscriptionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestSearchResponse.request":
-		m := new(QueryRequestResponse)
+	case "band.oracle.v1.QuerySubscriptionResponse.subscription":
+		m := new(Subscription)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.oracle.v1.QuerySubscriptionResponse.balance":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QuerySubscriptionResponse_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryRequestSearchResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QuerySubscriptionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestSearchResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QuerySubscriptionResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10557,7 +16229,7 @@ func (x *fastReflection_QueryRequestSearchResponse) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryRequestSearchResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QuerySubscriptionResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10568,7 +16240,7 @@ func (x *fastReflection_QueryRequestSearchResponse) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestSearchResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QuerySubscriptionResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10580,7 +16252,7 @@ func (x *fastReflection_QueryRequestSearchResponse) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryRequestSearchResponse) IsValid() bool {
+func (x *fastReflection_QuerySubscriptionResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -10590,9 +16262,9 @@ func (x *fastReflection_QueryRequestSearchResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryRequestSearchResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QuerySubscriptionResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryRequestSearchResponse)
+		x := input.Message.Interface().(*QuerySubscriptionResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10604,10 +16276,16 @@ func (x *fastReflection_QueryRequestSearchResponse) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		if x.Request != nil {
-			l = options.Size(x.Request)
+		if x.Subscription != nil {
+			l = options.Size(x.Subscription)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Balance) > 0 {
+			for _, e := range x.Balance {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -10618,7 +16296,7 @@ func (x *fastReflection_QueryRequestSearchResponse) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestSearchResponse)
+		x := input.Message.Interface().(*QuerySubscriptionResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10637,8 +16315,24 @@ func (x *fastReflection_QueryRequestSearchResponse) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Request != nil {
-			encoded, err := options.Marshal(x.Request)
+		if len(x.Balance) > 0 {
+			for iNdEx := len(x.Balance) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Balance[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Subscription != nil {
+			encoded, err := options.Marshal(x.Subscription)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10662,7 +16356,7 @@ func (x *fastReflection_QueryRequestSearchResponse) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestSearchResponse)
+		x := input.Message.Interface().(*QuerySubscriptionResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10694,15 +16388,15 @@ func (x *fastReflection_QueryRequestSearchResponse) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSearchResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySubscriptionResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Subscription", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10729,10 +16423,44 @@ func (x *fastReflection_QueryRequestSearchResponse) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Request == nil {
-					x.Request = &QueryRequestResponse{}
+				if x.Subscription == nil {
+					x.Subscription = &Subscription{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Request); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Subscription); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Balance = append(x.Balance, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Balance[len(x.Balance)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -10771,77 +16499,27 @@ func (x *fastReflection_QueryRequestSearchResponse) ProtoMethods() *protoiface.M
 	}
 }
 
-var _ protoreflect.List = (*_QueryRequestPriceRequest_1_list)(nil)
-
-type _QueryRequestPriceRequest_1_list struct {
-	list *[]string
-}
-
-func (x *_QueryRequestPriceRequest_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryRequestPriceRequest_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_QueryRequestPriceRequest_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryRequestPriceRequest_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryRequestPriceRequest_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message QueryRequestPriceRequest at list field Symbols as it is not of Message kind"))
-}
-
-func (x *_QueryRequestPriceRequest_1_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryRequestPriceRequest_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_QueryRequestPriceRequest_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryRequestPriceRequest           protoreflect.MessageDescriptor
-	fd_QueryRequestPriceRequest_symbols   protoreflect.FieldDescriptor
-	fd_QueryRequestPriceRequest_ask_count protoreflect.FieldDescriptor
-	fd_QueryRequestPriceRequest_min_count protoreflect.FieldDescriptor
+	md_QuerySubscriptionsRequest            protoreflect.MessageDescriptor
+	fd_QuerySubscriptionsRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryRequestPriceRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestPriceRequest")
-	fd_QueryRequestPriceRequest_symbols = md_QueryRequestPriceRequest.Fields().ByName("symbols")
-	fd_QueryRequestPriceRequest_ask_count = md_QueryRequestPriceRequest.Fields().ByName("ask_count")
-	fd_QueryRequestPriceRequest_min_count = md_QueryRequestPriceRequest.Fields().ByName("min_count")
+	md_QuerySubscriptionsRequest = File_band_oracle_v1_query_proto.Messages().ByName("QuerySubscriptionsRequest")
+	fd_QuerySubscriptionsRequest_pagination = md_QuerySubscriptionsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryRequestPriceRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QuerySubscriptionsRequest)(nil)
 
-type fastReflection_QueryRequestPriceRequest QueryRequestPriceRequest
+type fastReflection_QuerySubscriptionsRequest QuerySubscriptionsRequest
 
-func (x *QueryRequestPriceRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryRequestPriceRequest)(x)
+func (x *QuerySubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySubscriptionsRequest)(x)
 }
 
-func (x *QueryRequestPriceRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[24]
+func (x *QuerySubscriptionsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10852,43 +16530,43 @@ func (x *QueryRequestPriceRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryRequestPriceRequest_messageType fastReflection_QueryRequestPriceRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryRequestPriceRequest_messageType{}
+var _fastReflection_QuerySubscriptionsRequest_messageType fastReflection_QuerySubscriptionsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySubscriptionsRequest_messageType{}
 
-type fastReflection_QueryRequestPriceRequest_messageType struct{}
+type fastReflection_QuerySubscriptionsRequest_messageType struct{}
 
-func (x fastReflection_QueryRequestPriceRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryRequestPriceRequest)(nil)
+func (x fastReflection_QuerySubscriptionsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySubscriptionsRequest)(nil)
 }
-func (x fastReflection_QueryRequestPriceRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestPriceRequest)
+func (x fastReflection_QuerySubscriptionsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySubscriptionsRequest)
 }
-func (x fastReflection_QueryRequestPriceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestPriceRequest
+func (x fastReflection_QuerySubscriptionsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySubscriptionsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryRequestPriceRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestPriceRequest
+func (x *fastReflection_QuerySubscriptionsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySubscriptionsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryRequestPriceRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryRequestPriceRequest_messageType
+func (x *fastReflection_QuerySubscriptionsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySubscriptionsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryRequestPriceRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestPriceRequest)
+func (x *fastReflection_QuerySubscriptionsRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySubscriptionsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryRequestPriceRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryRequestPriceRequest)(x)
+func (x *fastReflection_QuerySubscriptionsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySubscriptionsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10896,22 +16574,10 @@ func (x *fastReflection_QueryRequestPriceRequest) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryRequestPriceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Symbols) != 0 {
-		value := protoreflect.ValueOfList(&_QueryRequestPriceRequest_1_list{list: &x.Symbols})
-		if !f(fd_QueryRequestPriceRequest_symbols, value) {
-			return
-		}
-	}
-	if x.AskCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.AskCount)
-		if !f(fd_QueryRequestPriceRequest_ask_count, value) {
-			return
-		}
-	}
-	if x.MinCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MinCount)
-		if !f(fd_QueryRequestPriceRequest_min_count, value) {
+func (x *fastReflection_QuerySubscriptionsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QuerySubscriptionsRequest_pagination, value) {
 			return
 		}
 	}
@@ -10928,19 +16594,15 @@ func (x *fastReflection_QueryRequestPriceRequest) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryRequestPriceRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QuerySubscriptionsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
-		return len(x.Symbols) != 0
-	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
-		return x.AskCount != uint64(0)
-	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
-		return x.MinCount != uint64(0)
+	case "band.oracle.v1.QuerySubscriptionsRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10950,19 +16612,15 @@ func (x *fastReflection_QueryRequestPriceRequest) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestPriceRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QuerySubscriptionsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
-		x.Symbols = nil
-	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
-		x.AskCount = uint64(0)
-	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
-		x.MinCount = uint64(0)
+	case "band.oracle.v1.QuerySubscriptionsRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10972,25 +16630,16 @@ func (x *fastReflection_QueryRequestPriceRequest) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryRequestPriceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySubscriptionsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
-		if len(x.Symbols) == 0 {
-			return protoreflect.ValueOfList(&_QueryRequestPriceRequest_1_list{})
-		}
-		listValue := &_QueryRequestPriceRequest_1_list{list: &x.Symbols}
-		return protoreflect.ValueOfList(listValue)
-	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
-		value := x.AskCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
-		value := x.MinCount
-		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QuerySubscriptionsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11004,21 +16653,15 @@ func (x *fastReflection_QueryRequestPriceRequest) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestPriceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QuerySubscriptionsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
-		lv := value.List()
-		clv := lv.(*_QueryRequestPriceRequest_1_list)
-		x.Symbols = *clv.list
-	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
-		x.AskCount = value.Uint()
-	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
-		x.MinCount = value.Uint()
+	case "band.oracle.v1.QuerySubscriptionsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11032,53 +16675,44 @@ func (x *fastReflection_QueryRequestPriceRequest) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestPriceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySubscriptionsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
-		if x.Symbols == nil {
-			x.Symbols = []string{}
+	case "band.oracle.v1.QuerySubscriptionsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
 		}
-		value := &_QueryRequestPriceRequest_1_list{list: &x.Symbols}
-		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
-		panic(fmt.Errorf("field ask_count of message band.oracle.v1.QueryRequestPriceRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
-		panic(fmt.Errorf("field min_count of message band.oracle.v1.QueryRequestPriceRequest is not mutable"))
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryRequestPriceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySubscriptionsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
-		list := []string{}
-		return protoreflect.ValueOfList(&_QueryRequestPriceRequest_1_list{list: &list})
-	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QuerySubscriptionsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryRequestPriceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QuerySubscriptionsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestPriceRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QuerySubscriptionsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11086,7 +16720,7 @@ func (x *fastReflection_QueryRequestPriceRequest) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryRequestPriceRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QuerySubscriptionsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11097,7 +16731,7 @@ func (x *fastReflection_QueryRequestPriceRequest) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestPriceRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QuerySubscriptionsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11109,7 +16743,7 @@ func (x *fastReflection_QueryRequestPriceRequest) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryRequestPriceRequest) IsValid() bool {
+func (x *fastReflection_QuerySubscriptionsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -11119,9 +16753,9 @@ func (x *fastReflection_QueryRequestPriceRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryRequestPriceRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QuerySubscriptionsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryRequestPriceRequest)
+		x := input.Message.Interface().(*QuerySubscriptionsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11133,17 +16767,9 @@ func (x *fastReflection_QueryRequestPriceRequest) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		if len(x.Symbols) > 0 {
-			for _, s := range x.Symbols {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.AskCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.AskCount))
-		}
-		if x.MinCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.MinCount))
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -11155,7 +16781,7 @@ func (x *fastReflection_QueryRequestPriceRequest) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestPriceRequest)
+		x := input.Message.Interface().(*QuerySubscriptionsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11174,24 +16800,19 @@ func (x *fastReflection_QueryRequestPriceRequest) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MinCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.AskCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.Symbols) > 0 {
-			for iNdEx := len(x.Symbols) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Symbols[iNdEx])
-				copy(dAtA[i:], x.Symbols[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Symbols[iNdEx])))
-				i--
-				dAtA[i] = 0xa
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -11204,7 +16825,7 @@ func (x *fastReflection_QueryRequestPriceRequest) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestPriceRequest)
+		x := input.Message.Interface().(*QuerySubscriptionsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11236,17 +16857,17 @@ func (x *fastReflection_QueryRequestPriceRequest) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestPriceRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySubscriptionsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySubscriptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Symbols", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11256,62 +16877,28 @@ func (x *fastReflection_QueryRequestPriceRequest) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Symbols = append(x.Symbols, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
-				}
-				x.AskCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.AskCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
 				}
-				x.MinCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MinCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -11347,78 +16934,80 @@ func (x *fastReflection_QueryRequestPriceRequest) ProtoMethods() *protoiface.Met
 	}
 }
 
-var _ protoreflect.List = (*_QueryRequestPriceResponse_1_list)(nil)
+var _ protoreflect.List = (*_QuerySubscriptionsResponse_1_list)(nil)
 
-type _QueryRequestPriceResponse_1_list struct {
-	list *[]*PriceResult
+type _QuerySubscriptionsResponse_1_list struct {
+	list *[]*Subscription
 }
 
-func (x *_QueryRequestPriceResponse_1_list) Len() int {
+func (x *_QuerySubscriptionsResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryRequestPriceResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QuerySubscriptionsResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryRequestPriceResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QuerySubscriptionsResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*PriceResult)
+	concreteValue := valueUnwrapped.Interface().(*Subscription)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryRequestPriceResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QuerySubscriptionsResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*PriceResult)
+	concreteValue := valueUnwrapped.Interface().(*Subscription)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryRequestPriceResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(PriceResult)
+func (x *_QuerySubscriptionsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Subscription)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryRequestPriceResponse_1_list) Truncate(n int) {
+func (x *_QuerySubscriptionsResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryRequestPriceResponse_1_list) NewElement() protoreflect.Value {
-	v := new(PriceResult)
+func (x *_QuerySubscriptionsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Subscription)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryRequestPriceResponse_1_list) IsValid() bool {
+func (x *_QuerySubscriptionsResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryRequestPriceResponse               protoreflect.MessageDescriptor
-	fd_QueryRequestPriceResponse_price_results protoreflect.FieldDescriptor
+	md_QuerySubscriptionsResponse               protoreflect.MessageDescriptor
+	fd_QuerySubscriptionsResponse_subscriptions protoreflect.FieldDescriptor
+	fd_QuerySubscriptionsResponse_pagination    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryRequestPriceResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestPriceResponse")
-	fd_QueryRequestPriceResponse_price_results = md_QueryRequestPriceResponse.Fields().ByName("price_results")
+	md_QuerySubscriptionsResponse = File_band_oracle_v1_query_proto.Messages().ByName("QuerySubscriptionsResponse")
+	fd_QuerySubscriptionsResponse_subscriptions = md_QuerySubscriptionsResponse.Fields().ByName("subscriptions")
+	fd_QuerySubscriptionsResponse_pagination = md_QuerySubscriptionsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryRequestPriceResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QuerySubscriptionsResponse)(nil)
 
-type fastReflection_QueryRequestPriceResponse QueryRequestPriceResponse
+type fastReflection_QuerySubscriptionsResponse QuerySubscriptionsResponse
 
-func (x *QueryRequestPriceResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryRequestPriceResponse)(x)
+func (x *QuerySubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySubscriptionsResponse)(x)
 }
 
-func (x *QueryRequestPriceResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[25]
+func (x *QuerySubscriptionsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11429,43 +17018,43 @@ func (x *QueryRequestPriceResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryRequestPriceResponse_messageType fastReflection_QueryRequestPriceResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryRequestPriceResponse_messageType{}
+var _fastReflection_QuerySubscriptionsResponse_messageType fastReflection_QuerySubscriptionsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySubscriptionsResponse_messageType{}
 
-type fastReflection_QueryRequestPriceResponse_messageType struct{}
+type fastReflection_QuerySubscriptionsResponse_messageType struct{}
 
-func (x fastReflection_QueryRequestPriceResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryRequestPriceResponse)(nil)
+func (x fastReflection_QuerySubscriptionsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySubscriptionsResponse)(nil)
 }
-func (x fastReflection_QueryRequestPriceResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestPriceResponse)
+func (x fastReflection_QuerySubscriptionsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySubscriptionsResponse)
 }
-func (x fastReflection_QueryRequestPriceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestPriceResponse
+func (x fastReflection_QuerySubscriptionsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySubscriptionsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryRequestPriceResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestPriceResponse
+func (x *fastReflection_QuerySubscriptionsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySubscriptionsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryRequestPriceResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryRequestPriceResponse_messageType
+func (x *fastReflection_QuerySubscriptionsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySubscriptionsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryRequestPriceResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestPriceResponse)
+func (x *fastReflection_QuerySubscriptionsResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySubscriptionsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryRequestPriceResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryRequestPriceResponse)(x)
+func (x *fastReflection_QuerySubscriptionsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySubscriptionsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -11473,10 +17062,16 @@ func (x *fastReflection_QueryRequestPriceResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryRequestPriceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.PriceResults) != 0 {
-		value := protoreflect.ValueOfList(&_QueryRequestPriceResponse_1_list{list: &x.PriceResults})
-		if !f(fd_QueryRequestPriceResponse_price_results, value) {
+func (x *fastReflection_QuerySubscriptionsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Subscriptions) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySubscriptionsResponse_1_list{list: &x.Subscriptions})
+		if !f(fd_QuerySubscriptionsResponse_subscriptions, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QuerySubscriptionsResponse_pagination, value) {
 			return
 		}
 	}
@@ -11493,15 +17088,17 @@ func (x *fastReflection_QueryRequestPriceResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryRequestPriceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QuerySubscriptionsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
-		return len(x.PriceResults) != 0
+	case "band.oracle.v1.QuerySubscriptionsResponse.subscriptions":
+		return len(x.Subscriptions) != 0
+	case "band.oracle.v1.QuerySubscriptionsResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11511,15 +17108,17 @@ func (x *fastReflection_QueryRequestPriceResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestPriceResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QuerySubscriptionsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
-		x.PriceResults = nil
+	case "band.oracle.v1.QuerySubscriptionsResponse.subscriptions":
+		x.Subscriptions = nil
+	case "band.oracle.v1.QuerySubscriptionsResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11529,19 +17128,22 @@ func (x *fastReflection_QueryRequestPriceResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryRequestPriceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySubscriptionsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
-		if len(x.PriceResults) == 0 {
-			return protoreflect.ValueOfList(&_QueryRequestPriceResponse_1_list{})
+	case "band.oracle.v1.QuerySubscriptionsResponse.subscriptions":
+		if len(x.Subscriptions) == 0 {
+			return protoreflect.ValueOfList(&_QuerySubscriptionsResponse_1_list{})
 		}
-		listValue := &_QueryRequestPriceResponse_1_list{list: &x.PriceResults}
+		listValue := &_QuerySubscriptionsResponse_1_list{list: &x.Subscriptions}
 		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.QuerySubscriptionsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11555,17 +17157,19 @@ func (x *fastReflection_QueryRequestPriceResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestPriceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QuerySubscriptionsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
+	case "band.oracle.v1.QuerySubscriptionsResponse.subscriptions":
 		lv := value.List()
-		clv := lv.(*_QueryRequestPriceResponse_1_list)
-		x.PriceResults = *clv.list
+		clv := lv.(*_QuerySubscriptionsResponse_1_list)
+		x.Subscriptions = *clv.list
+	case "band.oracle.v1.QuerySubscriptionsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11579,45 +17183,53 @@ func (x *fastReflection_QueryRequestPriceResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestPriceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySubscriptionsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
-		if x.PriceResults == nil {
-			x.PriceResults = []*PriceResult{}
+	case "band.oracle.v1.QuerySubscriptionsResponse.subscriptions":
+		if x.Subscriptions == nil {
+			x.Subscriptions = []*Subscription{}
 		}
-		value := &_QueryRequestPriceResponse_1_list{list: &x.PriceResults}
+		value := &_QuerySubscriptionsResponse_1_list{list: &x.Subscriptions}
 		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.QuerySubscriptionsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryRequestPriceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySubscriptionsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
-		list := []*PriceResult{}
-		return protoreflect.ValueOfList(&_QueryRequestPriceResponse_1_list{list: &list})
+	case "band.oracle.v1.QuerySubscriptionsResponse.subscriptions":
+		list := []*Subscription{}
+		return protoreflect.ValueOfList(&_QuerySubscriptionsResponse_1_list{list: &list})
+	case "band.oracle.v1.QuerySubscriptionsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySubscriptionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySubscriptionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryRequestPriceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QuerySubscriptionsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestPriceResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QuerySubscriptionsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11625,7 +17237,7 @@ func (x *fastReflection_QueryRequestPriceResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryRequestPriceResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QuerySubscriptionsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11636,7 +17248,7 @@ func (x *fastReflection_QueryRequestPriceResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestPriceResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QuerySubscriptionsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11648,7 +17260,7 @@ func (x *fastReflection_QueryRequestPriceResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryRequestPriceResponse) IsValid() bool {
+func (x *fastReflection_QuerySubscriptionsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -11658,9 +17270,9 @@ func (x *fastReflection_QueryRequestPriceResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryRequestPriceResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QuerySubscriptionsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryRequestPriceResponse)
+		x := input.Message.Interface().(*QuerySubscriptionsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11672,12 +17284,16 @@ func (x *fastReflection_QueryRequestPriceResponse) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if len(x.PriceResults) > 0 {
-			for _, e := range x.PriceResults {
+		if len(x.Subscriptions) > 0 {
+			for _, e := range x.Subscriptions {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -11688,7 +17304,7 @@ func (x *fastReflection_QueryRequestPriceResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestPriceResponse)
+		x := input.Message.Interface().(*QuerySubscriptionsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11707,9 +17323,23 @@ func (x *fastReflection_QueryRequestPriceResponse) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.PriceResults) > 0 {
-			for iNdEx := len(x.PriceResults) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.PriceResults[iNdEx])
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Subscriptions) > 0 {
+			for iNdEx := len(x.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Subscriptions[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11734,7 +17364,7 @@ func (x *fastReflection_QueryRequestPriceResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestPriceResponse)
+		x := input.Message.Interface().(*QuerySubscriptionsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11766,15 +17396,15 @@ func (x *fastReflection_QueryRequestPriceResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestPriceResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySubscriptionsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySubscriptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceResults", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11801,8 +17431,44 @@ func (x *fastReflection_QueryRequestPriceResponse) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PriceResults = append(x.PriceResults, &PriceResult{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceResults[len(x.PriceResults)-1]); err != nil {
+				x.Subscriptions = append(x.Subscriptions, &Subscription{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Subscriptions[len(x.Subscriptions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -11841,41 +17507,94 @@ func (x *fastReflection_QueryRequestPriceResponse) ProtoMethods() *protoiface.Me
 	}
 }
 
-var (
-	md_QueryRequestVerificationRequest                protoreflect.MessageDescriptor
-	fd_QueryRequestVerificationRequest_chain_id       protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationRequest_validator      protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationRequest_request_id     protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationRequest_external_id    protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationRequest_data_source_id protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationRequest_reporter       protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationRequest_signature      protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationRequest_max_delay      protoreflect.FieldDescriptor
+var _ protoreflect.List = (*_QuerySimulateRequestRequest_9_list)(nil)
+
+type _QuerySimulateRequestRequest_9_list struct {
+	list *[]*RawReport
+}
+
+func (x *_QuerySimulateRequestRequest_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySimulateRequestRequest_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySimulateRequestRequest_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawReport)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySimulateRequestRequest_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawReport)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySimulateRequestRequest_9_list) AppendMutable() protoreflect.Value {
+	v := new(RawReport)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateRequestRequest_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySimulateRequestRequest_9_list) NewElement() protoreflect.Value {
+	v := new(RawReport)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateRequestRequest_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QuerySimulateRequestRequest                       protoreflect.MessageDescriptor
+	fd_QuerySimulateRequestRequest_oracle_script_id      protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestRequest_oracle_script_version protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestRequest_code                  protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestRequest_calldata              protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestRequest_ask_count             protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestRequest_min_count             protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestRequest_prepare_gas           protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestRequest_execute_gas           protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestRequest_raw_reports           protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryRequestVerificationRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestVerificationRequest")
-	fd_QueryRequestVerificationRequest_chain_id = md_QueryRequestVerificationRequest.Fields().ByName("chain_id")
-	fd_QueryRequestVerificationRequest_validator = md_QueryRequestVerificationRequest.Fields().ByName("validator")
-	fd_QueryRequestVerificationRequest_request_id = md_QueryRequestVerificationRequest.Fields().ByName("request_id")
-	fd_QueryRequestVerificationRequest_external_id = md_QueryRequestVerificationRequest.Fields().ByName("external_id")
-	fd_QueryRequestVerificationRequest_data_source_id = md_QueryRequestVerificationRequest.Fields().ByName("data_source_id")
-	fd_QueryRequestVerificationRequest_reporter = md_QueryRequestVerificationRequest.Fields().ByName("reporter")
-	fd_QueryRequestVerificationRequest_signature = md_QueryRequestVerificationRequest.Fields().ByName("signature")
-	fd_QueryRequestVerificationRequest_max_delay = md_QueryRequestVerificationRequest.Fields().ByName("max_delay")
+	md_QuerySimulateRequestRequest = File_band_oracle_v1_query_proto.Messages().ByName("QuerySimulateRequestRequest")
+	fd_QuerySimulateRequestRequest_oracle_script_id = md_QuerySimulateRequestRequest.Fields().ByName("oracle_script_id")
+	fd_QuerySimulateRequestRequest_oracle_script_version = md_QuerySimulateRequestRequest.Fields().ByName("oracle_script_version")
+	fd_QuerySimulateRequestRequest_code = md_QuerySimulateRequestRequest.Fields().ByName("code")
+	fd_QuerySimulateRequestRequest_calldata = md_QuerySimulateRequestRequest.Fields().ByName("calldata")
+	fd_QuerySimulateRequestRequest_ask_count = md_QuerySimulateRequestRequest.Fields().ByName("ask_count")
+	fd_QuerySimulateRequestRequest_min_count = md_QuerySimulateRequestRequest.Fields().ByName("min_count")
+	fd_QuerySimulateRequestRequest_prepare_gas = md_QuerySimulateRequestRequest.Fields().ByName("prepare_gas")
+	fd_QuerySimulateRequestRequest_execute_gas = md_QuerySimulateRequestRequest.Fields().ByName("execute_gas")
+	fd_QuerySimulateRequestRequest_raw_reports = md_QuerySimulateRequestRequest.Fields().ByName("raw_reports")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryRequestVerificationRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QuerySimulateRequestRequest)(nil)
 
-type fastReflection_QueryRequestVerificationRequest QueryRequestVerificationRequest
+type fastReflection_QuerySimulateRequestRequest QuerySimulateRequestRequest
 
-func (x *QueryRequestVerificationRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryRequestVerificationRequest)(x)
+func (x *QuerySimulateRequestRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulateRequestRequest)(x)
 }
 
-func (x *QueryRequestVerificationRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[26]
+func (x *QuerySimulateRequestRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11886,43 +17605,43 @@ func (x *QueryRequestVerificationRequest) slowProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryRequestVerificationRequest_messageType fastReflection_QueryRequestVerificationRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryRequestVerificationRequest_messageType{}
+var _fastReflection_QuerySimulateRequestRequest_messageType fastReflection_QuerySimulateRequestRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulateRequestRequest_messageType{}
 
-type fastReflection_QueryRequestVerificationRequest_messageType struct{}
+type fastReflection_QuerySimulateRequestRequest_messageType struct{}
 
-func (x fastReflection_QueryRequestVerificationRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryRequestVerificationRequest)(nil)
+func (x fastReflection_QuerySimulateRequestRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulateRequestRequest)(nil)
 }
-func (x fastReflection_QueryRequestVerificationRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestVerificationRequest)
+func (x fastReflection_QuerySimulateRequestRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateRequestRequest)
 }
-func (x fastReflection_QueryRequestVerificationRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestVerificationRequest
+func (x fastReflection_QuerySimulateRequestRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateRequestRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryRequestVerificationRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestVerificationRequest
+func (x *fastReflection_QuerySimulateRequestRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateRequestRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryRequestVerificationRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryRequestVerificationRequest_messageType
+func (x *fastReflection_QuerySimulateRequestRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulateRequestRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryRequestVerificationRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestVerificationRequest)
+func (x *fastReflection_QuerySimulateRequestRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateRequestRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryRequestVerificationRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryRequestVerificationRequest)(x)
+func (x *fastReflection_QuerySimulateRequestRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulateRequestRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -11930,52 +17649,58 @@ func (x *fastReflection_QueryRequestVerificationRequest) Interface() protoreflec
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryRequestVerificationRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ChainId != "" {
-		value := protoreflect.ValueOfString(x.ChainId)
-		if !f(fd_QueryRequestVerificationRequest_chain_id, value) {
+func (x *fastReflection_QuerySimulateRequestRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_QuerySimulateRequestRequest_oracle_script_id, value) {
 			return
 		}
 	}
-	if x.Validator != "" {
-		value := protoreflect.ValueOfString(x.Validator)
-		if !f(fd_QueryRequestVerificationRequest_validator, value) {
+	if x.OracleScriptVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptVersion)
+		if !f(fd_QuerySimulateRequestRequest_oracle_script_version, value) {
 			return
 		}
 	}
-	if x.RequestId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_QueryRequestVerificationRequest_request_id, value) {
+	if len(x.Code) != 0 {
+		value := protoreflect.ValueOfBytes(x.Code)
+		if !f(fd_QuerySimulateRequestRequest_code, value) {
 			return
 		}
 	}
-	if x.ExternalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExternalId)
-		if !f(fd_QueryRequestVerificationRequest_external_id, value) {
+	if len(x.Calldata) != 0 {
+		value := protoreflect.ValueOfBytes(x.Calldata)
+		if !f(fd_QuerySimulateRequestRequest_calldata, value) {
 			return
 		}
 	}
-	if x.DataSourceId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.DataSourceId)
-		if !f(fd_QueryRequestVerificationRequest_data_source_id, value) {
+	if x.AskCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AskCount)
+		if !f(fd_QuerySimulateRequestRequest_ask_count, value) {
 			return
 		}
 	}
-	if x.Reporter != "" {
-		value := protoreflect.ValueOfString(x.Reporter)
-		if !f(fd_QueryRequestVerificationRequest_reporter, value) {
+	if x.MinCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinCount)
+		if !f(fd_QuerySimulateRequestRequest_min_count, value) {
 			return
 		}
 	}
-	if len(x.Signature) != 0 {
-		value := protoreflect.ValueOfBytes(x.Signature)
-		if !f(fd_QueryRequestVerificationRequest_signature, value) {
+	if x.PrepareGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PrepareGas)
+		if !f(fd_QuerySimulateRequestRequest_prepare_gas, value) {
 			return
 		}
 	}
-	if x.MaxDelay != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MaxDelay)
-		if !f(fd_QueryRequestVerificationRequest_max_delay, value) {
+	if x.ExecuteGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecuteGas)
+		if !f(fd_QuerySimulateRequestRequest_execute_gas, value) {
+			return
+		}
+	}
+	if len(x.RawReports) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySimulateRequestRequest_9_list{list: &x.RawReports})
+		if !f(fd_QuerySimulateRequestRequest_raw_reports, value) {
 			return
 		}
 	}
@@ -11992,29 +17717,31 @@ func (x *fastReflection_QueryRequestVerificationRequest) Range(f func(protorefle
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryRequestVerificationRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QuerySimulateRequestRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
-		return x.ChainId != ""
-	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
-		return x.Validator != ""
-	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
-		return x.RequestId != uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
-		return x.ExternalId != uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
-		return x.DataSourceId != uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
-		return x.Reporter != ""
-	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
-		return len(x.Signature) != 0
-	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
-		return x.MaxDelay != uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.oracle_script_version":
+		return x.OracleScriptVersion != uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.code":
+		return len(x.Code) != 0
+	case "band.oracle.v1.QuerySimulateRequestRequest.calldata":
+		return len(x.Calldata) != 0
+	case "band.oracle.v1.QuerySimulateRequestRequest.ask_count":
+		return x.AskCount != uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.min_count":
+		return x.MinCount != uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.prepare_gas":
+		return x.PrepareGas != uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.execute_gas":
+		return x.ExecuteGas != uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.raw_reports":
+		return len(x.RawReports) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12024,29 +17751,31 @@ func (x *fastReflection_QueryRequestVerificationRequest) Has(fd protoreflect.Fie
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestVerificationRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QuerySimulateRequestRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
-		x.ChainId = ""
-	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
-		x.Validator = ""
-	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
-		x.RequestId = uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
-		x.ExternalId = uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
-		x.DataSourceId = uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
-		x.Reporter = ""
-	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
-		x.Signature = nil
-	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
-		x.MaxDelay = uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.oracle_script_version":
+		x.OracleScriptVersion = uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.code":
+		x.Code = nil
+	case "band.oracle.v1.QuerySimulateRequestRequest.calldata":
+		x.Calldata = nil
+	case "band.oracle.v1.QuerySimulateRequestRequest.ask_count":
+		x.AskCount = uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.min_count":
+		x.MinCount = uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.prepare_gas":
+		x.PrepareGas = uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.execute_gas":
+		x.ExecuteGas = uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.raw_reports":
+		x.RawReports = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12056,37 +17785,43 @@ func (x *fastReflection_QueryRequestVerificationRequest) Clear(fd protoreflect.F
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryRequestVerificationRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySimulateRequestRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
-		value := x.Validator
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
-		value := x.RequestId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
-		value := x.ExternalId
+	case "band.oracle.v1.QuerySimulateRequestRequest.oracle_script_id":
+		value := x.OracleScriptId
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
-		value := x.DataSourceId
+	case "band.oracle.v1.QuerySimulateRequestRequest.oracle_script_version":
+		value := x.OracleScriptVersion
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
-		value := x.Reporter
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
-		value := x.Signature
+	case "band.oracle.v1.QuerySimulateRequestRequest.code":
+		value := x.Code
 		return protoreflect.ValueOfBytes(value)
-	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
-		value := x.MaxDelay
+	case "band.oracle.v1.QuerySimulateRequestRequest.calldata":
+		value := x.Calldata
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.QuerySimulateRequestRequest.ask_count":
+		value := x.AskCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QuerySimulateRequestRequest.min_count":
+		value := x.MinCount
 		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QuerySimulateRequestRequest.prepare_gas":
+		value := x.PrepareGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QuerySimulateRequestRequest.execute_gas":
+		value := x.ExecuteGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QuerySimulateRequestRequest.raw_reports":
+		if len(x.RawReports) == 0 {
+			return protoreflect.ValueOfList(&_QuerySimulateRequestRequest_9_list{})
+		}
+		listValue := &_QuerySimulateRequestRequest_9_list{list: &x.RawReports}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -12100,29 +17835,33 @@ func (x *fastReflection_QueryRequestVerificationRequest) Get(descriptor protoref
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestVerificationRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QuerySimulateRequestRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
-		x.ChainId = value.Interface().(string)
-	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
-		x.Validator = value.Interface().(string)
-	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
-		x.RequestId = value.Uint()
-	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
-		x.ExternalId = value.Uint()
-	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
-		x.DataSourceId = value.Uint()
-	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
-		x.Reporter = value.Interface().(string)
-	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
-		x.Signature = value.Bytes()
-	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
-		x.MaxDelay = value.Uint()
+	case "band.oracle.v1.QuerySimulateRequestRequest.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.QuerySimulateRequestRequest.oracle_script_version":
+		x.OracleScriptVersion = value.Uint()
+	case "band.oracle.v1.QuerySimulateRequestRequest.code":
+		x.Code = value.Bytes()
+	case "band.oracle.v1.QuerySimulateRequestRequest.calldata":
+		x.Calldata = value.Bytes()
+	case "band.oracle.v1.QuerySimulateRequestRequest.ask_count":
+		x.AskCount = value.Uint()
+	case "band.oracle.v1.QuerySimulateRequestRequest.min_count":
+		x.MinCount = value.Uint()
+	case "band.oracle.v1.QuerySimulateRequestRequest.prepare_gas":
+		x.PrepareGas = value.Uint()
+	case "band.oracle.v1.QuerySimulateRequestRequest.execute_gas":
+		x.ExecuteGas = value.Uint()
+	case "band.oracle.v1.QuerySimulateRequestRequest.raw_reports":
+		lv := value.List()
+		clv := lv.(*_QuerySimulateRequestRequest_9_list)
+		x.RawReports = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12136,68 +17875,77 @@ func (x *fastReflection_QueryRequestVerificationRequest) Set(fd protoreflect.Fie
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestVerificationRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySimulateRequestRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
-		panic(fmt.Errorf("field chain_id of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
-		panic(fmt.Errorf("field validator of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
-		panic(fmt.Errorf("field request_id of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
-		panic(fmt.Errorf("field external_id of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
-		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
-		panic(fmt.Errorf("field reporter of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
-		panic(fmt.Errorf("field signature of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
-		panic(fmt.Errorf("field max_delay of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestRequest.raw_reports":
+		if x.RawReports == nil {
+			x.RawReports = []*RawReport{}
+		}
+		value := &_QuerySimulateRequestRequest_9_list{list: &x.RawReports}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.QuerySimulateRequestRequest.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.QuerySimulateRequestRequest is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestRequest.oracle_script_version":
+		panic(fmt.Errorf("field oracle_script_version of message band.oracle.v1.QuerySimulateRequestRequest is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestRequest.code":
+		panic(fmt.Errorf("field code of message band.oracle.v1.QuerySimulateRequestRequest is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestRequest.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.QuerySimulateRequestRequest is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestRequest.ask_count":
+		panic(fmt.Errorf("field ask_count of message band.oracle.v1.QuerySimulateRequestRequest is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestRequest.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.QuerySimulateRequestRequest is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestRequest.prepare_gas":
+		panic(fmt.Errorf("field prepare_gas of message band.oracle.v1.QuerySimulateRequestRequest is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestRequest.execute_gas":
+		panic(fmt.Errorf("field execute_gas of message band.oracle.v1.QuerySimulateRequestRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryRequestVerificationRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySimulateRequestRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
+	case "band.oracle.v1.QuerySimulateRequestRequest.oracle_script_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
+	case "band.oracle.v1.QuerySimulateRequestRequest.oracle_script_version":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
+	case "band.oracle.v1.QuerySimulateRequestRequest.code":
 		return protoreflect.ValueOfBytes(nil)
-	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
+	case "band.oracle.v1.QuerySimulateRequestRequest.calldata":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.QuerySimulateRequestRequest.ask_count":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QuerySimulateRequestRequest.min_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QuerySimulateRequestRequest.prepare_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QuerySimulateRequestRequest.execute_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QuerySimulateRequestRequest.raw_reports":
+		list := []*RawReport{}
+		return protoreflect.ValueOfList(&_QuerySimulateRequestRequest_9_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryRequestVerificationRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QuerySimulateRequestRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestVerificationRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QuerySimulateRequestRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -12205,7 +17953,7 @@ func (x *fastReflection_QueryRequestVerificationRequest) WhichOneof(d protorefle
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryRequestVerificationRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QuerySimulateRequestRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -12216,7 +17964,7 @@ func (x *fastReflection_QueryRequestVerificationRequest) GetUnknown() protorefle
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestVerificationRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QuerySimulateRequestRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -12228,7 +17976,7 @@ func (x *fastReflection_QueryRequestVerificationRequest) SetUnknown(fields proto
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryRequestVerificationRequest) IsValid() bool {
+func (x *fastReflection_QuerySimulateRequestRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -12238,9 +17986,9 @@ func (x *fastReflection_QueryRequestVerificationRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QuerySimulateRequestRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryRequestVerificationRequest)
+		x := input.Message.Interface().(*QuerySimulateRequestRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12252,33 +18000,37 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 		var n int
 		var l int
 		_ = l
-		l = len(x.ChainId)
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		}
+		if x.OracleScriptVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptVersion))
+		}
+		l = len(x.Code)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Validator)
+		l = len(x.Calldata)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.RequestId != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestId))
-		}
-		if x.ExternalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExternalId))
+		if x.AskCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AskCount))
 		}
-		if x.DataSourceId != 0 {
-			n += 1 + runtime.Sov(uint64(x.DataSourceId))
+		if x.MinCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinCount))
 		}
-		l = len(x.Reporter)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.PrepareGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrepareGas))
 		}
-		l = len(x.Signature)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.ExecuteGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecuteGas))
 		}
-		if x.MaxDelay != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxDelay))
+		if len(x.RawReports) > 0 {
+			for _, e := range x.RawReports {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -12290,7 +18042,7 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestVerificationRequest)
+		x := input.Message.Interface().(*QuerySimulateRequestRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12309,53 +18061,65 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MaxDelay != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxDelay))
+		if len(x.RawReports) > 0 {
+			for iNdEx := len(x.RawReports) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RawReports[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.ExecuteGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGas))
 			i--
 			dAtA[i] = 0x40
 		}
-		if len(x.Signature) > 0 {
-			i -= len(x.Signature)
-			copy(dAtA[i:], x.Signature)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+		if x.PrepareGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrepareGas))
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x38
 		}
-		if len(x.Reporter) > 0 {
-			i -= len(x.Reporter)
-			copy(dAtA[i:], x.Reporter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reporter)))
+		if x.MinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x30
 		}
-		if x.DataSourceId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
+		if x.AskCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
 			i--
 			dAtA[i] = 0x28
 		}
-		if x.ExternalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
+		if len(x.Calldata) > 0 {
+			i -= len(x.Calldata)
+			copy(dAtA[i:], x.Calldata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
 			i--
-			dAtA[i] = 0x20
+			dAtA[i] = 0x22
 		}
-		if x.RequestId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+		if len(x.Code) > 0 {
+			i -= len(x.Code)
+			copy(dAtA[i:], x.Code)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Code)))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x1a
 		}
-		if len(x.Validator) > 0 {
-			i -= len(x.Validator)
-			copy(dAtA[i:], x.Validator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+		if x.OracleScriptVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptVersion))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if len(x.ChainId) > 0 {
-			i -= len(x.ChainId)
-			copy(dAtA[i:], x.ChainId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -12368,7 +18132,7 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestVerificationRequest)
+		x := input.Message.Interface().(*QuerySimulateRequestRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12400,17 +18164,55 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestVerificationRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateRequestRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				}
+				x.OracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
+				}
+				x.OracleScriptVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12420,29 +18222,31 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ChainId = string(dAtA[iNdEx:postIndex])
+				x.Code = append(x.Code[:0], dAtA[iNdEx:postIndex]...)
+				if x.Code == nil {
+					x.Code = []byte{}
+				}
 				iNdEx = postIndex
-			case 2:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12452,29 +18256,31 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Validator = string(dAtA[iNdEx:postIndex])
+				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Calldata == nil {
+					x.Calldata = []byte{}
+				}
 				iNdEx = postIndex
-			case 3:
+			case 5:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
 				}
-				x.RequestId = 0
+				x.AskCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12484,16 +18290,16 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
+					x.AskCount |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 4:
+			case 6:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
 				}
-				x.ExternalId = 0
+				x.MinCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12503,16 +18309,16 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ExternalId |= uint64(b&0x7F) << shift
+					x.MinCount |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 5:
+			case 7:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
 				}
-				x.DataSourceId = 0
+				x.PrepareGas = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12522,16 +18328,16 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.DataSourceId |= uint64(b&0x7F) << shift
+					x.PrepareGas |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
 				}
-				var stringLen uint64
+				x.ExecuteGas = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12541,29 +18347,16 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.ExecuteGas |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Reporter = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 7:
+			case 9:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12573,45 +18366,26 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Signature = append(x.Signature[:0], dAtA[iNdEx:postIndex]...)
-				if x.Signature == nil {
-					x.Signature = []byte{}
+				x.RawReports = append(x.RawReports, &RawReport{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RawReports[len(x.RawReports)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDelay", wireType)
-				}
-				x.MaxDelay = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MaxDelay |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -12631,53 +18405,157 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 			}
 		}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QuerySimulateRequestResponse_1_list)(nil)
+
+type _QuerySimulateRequestResponse_1_list struct {
+	list *[]*RawRequest
+}
+
+func (x *_QuerySimulateRequestResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySimulateRequestResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySimulateRequestResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawRequest)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySimulateRequestResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawRequest)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySimulateRequestResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(RawRequest)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateRequestResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySimulateRequestResponse_1_list) NewElement() protoreflect.Value {
+	v := new(RawRequest)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateRequestResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QuerySimulateRequestResponse_3_list)(nil)
+
+type _QuerySimulateRequestResponse_3_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QuerySimulateRequestResponse_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySimulateRequestResponse_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySimulateRequestResponse_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySimulateRequestResponse_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySimulateRequestResponse_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateRequestResponse_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySimulateRequestResponse_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateRequestResponse_3_list) IsValid() bool {
+	return x.list != nil
 }
 
 var (
-	md_QueryRequestVerificationResponse                protoreflect.MessageDescriptor
-	fd_QueryRequestVerificationResponse_chain_id       protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationResponse_validator      protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationResponse_request_id     protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationResponse_external_id    protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationResponse_data_source_id protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationResponse_is_delay       protoreflect.FieldDescriptor
+	md_QuerySimulateRequestResponse                  protoreflect.MessageDescriptor
+	fd_QuerySimulateRequestResponse_raw_requests     protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestResponse_prepare_gas_used protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestResponse_estimated_fees   protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestResponse_resolve_status   protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestResponse_result           protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestResponse_execute_gas_used protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestResponse_reason           protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryRequestVerificationResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestVerificationResponse")
-	fd_QueryRequestVerificationResponse_chain_id = md_QueryRequestVerificationResponse.Fields().ByName("chain_id")
-	fd_QueryRequestVerificationResponse_validator = md_QueryRequestVerificationResponse.Fields().ByName("validator")
-	fd_QueryRequestVerificationResponse_request_id = md_QueryRequestVerificationResponse.Fields().ByName("request_id")
-	fd_QueryRequestVerificationResponse_external_id = md_QueryRequestVerificationResponse.Fields().ByName("external_id")
-	fd_QueryRequestVerificationResponse_data_source_id = md_QueryRequestVerificationResponse.Fields().ByName("data_source_id")
-	fd_QueryRequestVerificationResponse_is_delay = md_QueryRequestVerificationResponse.Fields().ByName("is_delay")
+	md_QuerySimulateRequestResponse = File_band_oracle_v1_query_proto.Messages().ByName("QuerySimulateRequestResponse")
+	fd_QuerySimulateRequestResponse_raw_requests = md_QuerySimulateRequestResponse.Fields().ByName("raw_requests")
+	fd_QuerySimulateRequestResponse_prepare_gas_used = md_QuerySimulateRequestResponse.Fields().ByName("prepare_gas_used")
+	fd_QuerySimulateRequestResponse_estimated_fees = md_QuerySimulateRequestResponse.Fields().ByName("estimated_fees")
+	fd_QuerySimulateRequestResponse_resolve_status = md_QuerySimulateRequestResponse.Fields().ByName("resolve_status")
+	fd_QuerySimulateRequestResponse_result = md_QuerySimulateRequestResponse.Fields().ByName("result")
+	fd_QuerySimulateRequestResponse_execute_gas_used = md_QuerySimulateRequestResponse.Fields().ByName("execute_gas_used")
+	fd_QuerySimulateRequestResponse_reason = md_QuerySimulateRequestResponse.Fields().ByName("reason")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryRequestVerificationResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QuerySimulateRequestResponse)(nil)
 
-type fastReflection_QueryRequestVerificationResponse QueryRequestVerificationResponse
+type fastReflection_QuerySimulateRequestResponse QuerySimulateRequestResponse
 
-func (x *QueryRequestVerificationResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryRequestVerificationResponse)(x)
+func (x *QuerySimulateRequestResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulateRequestResponse)(x)
 }
 
-func (x *QueryRequestVerificationResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[27]
+func (x *QuerySimulateRequestResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12688,43 +18566,43 @@ func (x *QueryRequestVerificationResponse) slowProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryRequestVerificationResponse_messageType fastReflection_QueryRequestVerificationResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryRequestVerificationResponse_messageType{}
+var _fastReflection_QuerySimulateRequestResponse_messageType fastReflection_QuerySimulateRequestResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulateRequestResponse_messageType{}
 
-type fastReflection_QueryRequestVerificationResponse_messageType struct{}
+type fastReflection_QuerySimulateRequestResponse_messageType struct{}
 
-func (x fastReflection_QueryRequestVerificationResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryRequestVerificationResponse)(nil)
+func (x fastReflection_QuerySimulateRequestResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulateRequestResponse)(nil)
 }
-func (x fastReflection_QueryRequestVerificationResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestVerificationResponse)
+func (x fastReflection_QuerySimulateRequestResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateRequestResponse)
 }
-func (x fastReflection_QueryRequestVerificationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestVerificationResponse
+func (x fastReflection_QuerySimulateRequestResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateRequestResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryRequestVerificationResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestVerificationResponse
+func (x *fastReflection_QuerySimulateRequestResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateRequestResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryRequestVerificationResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryRequestVerificationResponse_messageType
+func (x *fastReflection_QuerySimulateRequestResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulateRequestResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryRequestVerificationResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestVerificationResponse)
+func (x *fastReflection_QuerySimulateRequestResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateRequestResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryRequestVerificationResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryRequestVerificationResponse)(x)
+func (x *fastReflection_QuerySimulateRequestResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulateRequestResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -12732,40 +18610,46 @@ func (x *fastReflection_QueryRequestVerificationResponse) Interface() protorefle
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryRequestVerificationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ChainId != "" {
-		value := protoreflect.ValueOfString(x.ChainId)
-		if !f(fd_QueryRequestVerificationResponse_chain_id, value) {
+func (x *fastReflection_QuerySimulateRequestResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.RawRequests) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySimulateRequestResponse_1_list{list: &x.RawRequests})
+		if !f(fd_QuerySimulateRequestResponse_raw_requests, value) {
 			return
 		}
 	}
-	if x.Validator != "" {
-		value := protoreflect.ValueOfString(x.Validator)
-		if !f(fd_QueryRequestVerificationResponse_validator, value) {
+	if x.PrepareGasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PrepareGasUsed)
+		if !f(fd_QuerySimulateRequestResponse_prepare_gas_used, value) {
 			return
 		}
 	}
-	if x.RequestId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_QueryRequestVerificationResponse_request_id, value) {
+	if len(x.EstimatedFees) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySimulateRequestResponse_3_list{list: &x.EstimatedFees})
+		if !f(fd_QuerySimulateRequestResponse_estimated_fees, value) {
 			return
 		}
 	}
-	if x.ExternalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExternalId)
-		if !f(fd_QueryRequestVerificationResponse_external_id, value) {
+	if x.ResolveStatus != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ResolveStatus))
+		if !f(fd_QuerySimulateRequestResponse_resolve_status, value) {
 			return
 		}
 	}
-	if x.DataSourceId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.DataSourceId)
-		if !f(fd_QueryRequestVerificationResponse_data_source_id, value) {
+	if len(x.Result) != 0 {
+		value := protoreflect.ValueOfBytes(x.Result)
+		if !f(fd_QuerySimulateRequestResponse_result, value) {
 			return
 		}
 	}
-	if x.IsDelay != false {
-		value := protoreflect.ValueOfBool(x.IsDelay)
-		if !f(fd_QueryRequestVerificationResponse_is_delay, value) {
+	if x.ExecuteGasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecuteGasUsed)
+		if !f(fd_QuerySimulateRequestResponse_execute_gas_used, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_QuerySimulateRequestResponse_reason, value) {
 			return
 		}
 	}
@@ -12782,25 +18666,27 @@ func (x *fastReflection_QueryRequestVerificationResponse) Range(f func(protorefl
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryRequestVerificationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QuerySimulateRequestResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
-		return x.ChainId != ""
-	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
-		return x.Validator != ""
-	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
-		return x.RequestId != uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
-		return x.ExternalId != uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
-		return x.DataSourceId != uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
-		return x.IsDelay != false
+	case "band.oracle.v1.QuerySimulateRequestResponse.raw_requests":
+		return len(x.RawRequests) != 0
+	case "band.oracle.v1.QuerySimulateRequestResponse.prepare_gas_used":
+		return x.PrepareGasUsed != uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestResponse.estimated_fees":
+		return len(x.EstimatedFees) != 0
+	case "band.oracle.v1.QuerySimulateRequestResponse.resolve_status":
+		return x.ResolveStatus != 0
+	case "band.oracle.v1.QuerySimulateRequestResponse.result":
+		return len(x.Result) != 0
+	case "band.oracle.v1.QuerySimulateRequestResponse.execute_gas_used":
+		return x.ExecuteGasUsed != uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestResponse.reason":
+		return x.Reason != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12810,25 +18696,27 @@ func (x *fastReflection_QueryRequestVerificationResponse) Has(fd protoreflect.Fi
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestVerificationResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QuerySimulateRequestResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
-		x.ChainId = ""
-	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
-		x.Validator = ""
-	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
-		x.RequestId = uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
-		x.ExternalId = uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
-		x.DataSourceId = uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
-		x.IsDelay = false
+	case "band.oracle.v1.QuerySimulateRequestResponse.raw_requests":
+		x.RawRequests = nil
+	case "band.oracle.v1.QuerySimulateRequestResponse.prepare_gas_used":
+		x.PrepareGasUsed = uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestResponse.estimated_fees":
+		x.EstimatedFees = nil
+	case "band.oracle.v1.QuerySimulateRequestResponse.resolve_status":
+		x.ResolveStatus = 0
+	case "band.oracle.v1.QuerySimulateRequestResponse.result":
+		x.Result = nil
+	case "band.oracle.v1.QuerySimulateRequestResponse.execute_gas_used":
+		x.ExecuteGasUsed = uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestResponse.reason":
+		x.Reason = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12838,31 +18726,40 @@ func (x *fastReflection_QueryRequestVerificationResponse) Clear(fd protoreflect.
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryRequestVerificationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySimulateRequestResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
-		value := x.Validator
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
-		value := x.RequestId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
-		value := x.ExternalId
+	case "band.oracle.v1.QuerySimulateRequestResponse.raw_requests":
+		if len(x.RawRequests) == 0 {
+			return protoreflect.ValueOfList(&_QuerySimulateRequestResponse_1_list{})
+		}
+		listValue := &_QuerySimulateRequestResponse_1_list{list: &x.RawRequests}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.QuerySimulateRequestResponse.prepare_gas_used":
+		value := x.PrepareGasUsed
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
-		value := x.DataSourceId
+	case "band.oracle.v1.QuerySimulateRequestResponse.estimated_fees":
+		if len(x.EstimatedFees) == 0 {
+			return protoreflect.ValueOfList(&_QuerySimulateRequestResponse_3_list{})
+		}
+		listValue := &_QuerySimulateRequestResponse_3_list{list: &x.EstimatedFees}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.QuerySimulateRequestResponse.resolve_status":
+		value := x.ResolveStatus
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.QuerySimulateRequestResponse.result":
+		value := x.Result
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.QuerySimulateRequestResponse.execute_gas_used":
+		value := x.ExecuteGasUsed
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
-		value := x.IsDelay
-		return protoreflect.ValueOfBool(value)
+	case "band.oracle.v1.QuerySimulateRequestResponse.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -12876,25 +18773,31 @@ func (x *fastReflection_QueryRequestVerificationResponse) Get(descriptor protore
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestVerificationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QuerySimulateRequestResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
-		x.ChainId = value.Interface().(string)
-	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
-		x.Validator = value.Interface().(string)
-	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
-		x.RequestId = value.Uint()
-	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
-		x.ExternalId = value.Uint()
-	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
-		x.DataSourceId = value.Uint()
-	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
-		x.IsDelay = value.Bool()
+	case "band.oracle.v1.QuerySimulateRequestResponse.raw_requests":
+		lv := value.List()
+		clv := lv.(*_QuerySimulateRequestResponse_1_list)
+		x.RawRequests = *clv.list
+	case "band.oracle.v1.QuerySimulateRequestResponse.prepare_gas_used":
+		x.PrepareGasUsed = value.Uint()
+	case "band.oracle.v1.QuerySimulateRequestResponse.estimated_fees":
+		lv := value.List()
+		clv := lv.(*_QuerySimulateRequestResponse_3_list)
+		x.EstimatedFees = *clv.list
+	case "band.oracle.v1.QuerySimulateRequestResponse.resolve_status":
+		x.ResolveStatus = (ResolveStatus)(value.Enum())
+	case "band.oracle.v1.QuerySimulateRequestResponse.result":
+		x.Result = value.Bytes()
+	case "band.oracle.v1.QuerySimulateRequestResponse.execute_gas_used":
+		x.ExecuteGasUsed = value.Uint()
+	case "band.oracle.v1.QuerySimulateRequestResponse.reason":
+		x.Reason = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12908,60 +18811,74 @@ func (x *fastReflection_QueryRequestVerificationResponse) Set(fd protoreflect.Fi
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestVerificationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySimulateRequestResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
-		panic(fmt.Errorf("field chain_id of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
-		panic(fmt.Errorf("field validator of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
-		panic(fmt.Errorf("field request_id of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
-		panic(fmt.Errorf("field external_id of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
-		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
-		panic(fmt.Errorf("field is_delay of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestResponse.raw_requests":
+		if x.RawRequests == nil {
+			x.RawRequests = []*RawRequest{}
+		}
+		value := &_QuerySimulateRequestResponse_1_list{list: &x.RawRequests}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.QuerySimulateRequestResponse.estimated_fees":
+		if x.EstimatedFees == nil {
+			x.EstimatedFees = []*v1beta11.Coin{}
+		}
+		value := &_QuerySimulateRequestResponse_3_list{list: &x.EstimatedFees}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.QuerySimulateRequestResponse.prepare_gas_used":
+		panic(fmt.Errorf("field prepare_gas_used of message band.oracle.v1.QuerySimulateRequestResponse is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestResponse.resolve_status":
+		panic(fmt.Errorf("field resolve_status of message band.oracle.v1.QuerySimulateRequestResponse is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestResponse.result":
+		panic(fmt.Errorf("field result of message band.oracle.v1.QuerySimulateRequestResponse is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestResponse.execute_gas_used":
+		panic(fmt.Errorf("field execute_gas_used of message band.oracle.v1.QuerySimulateRequestResponse is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestResponse.reason":
+		panic(fmt.Errorf("field reason of message band.oracle.v1.QuerySimulateRequestResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryRequestVerificationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySimulateRequestResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
+	case "band.oracle.v1.QuerySimulateRequestResponse.raw_requests":
+		list := []*RawRequest{}
+		return protoreflect.ValueOfList(&_QuerySimulateRequestResponse_1_list{list: &list})
+	case "band.oracle.v1.QuerySimulateRequestResponse.prepare_gas_used":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
+	case "band.oracle.v1.QuerySimulateRequestResponse.estimated_fees":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QuerySimulateRequestResponse_3_list{list: &list})
+	case "band.oracle.v1.QuerySimulateRequestResponse.resolve_status":
+		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.QuerySimulateRequestResponse.result":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.QuerySimulateRequestResponse.execute_gas_used":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
-		return protoreflect.ValueOfBool(false)
+	case "band.oracle.v1.QuerySimulateRequestResponse.reason":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryRequestVerificationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QuerySimulateRequestResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestVerificationResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QuerySimulateRequestResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -12969,7 +18886,7 @@ func (x *fastReflection_QueryRequestVerificationResponse) WhichOneof(d protorefl
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryRequestVerificationResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QuerySimulateRequestResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -12980,7 +18897,7 @@ func (x *fastReflection_QueryRequestVerificationResponse) GetUnknown() protorefl
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestVerificationResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QuerySimulateRequestResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -12992,7 +18909,7 @@ func (x *fastReflection_QueryRequestVerificationResponse) SetUnknown(fields prot
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryRequestVerificationResponse) IsValid() bool {
+func (x *fastReflection_QuerySimulateRequestResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -13002,9 +18919,9 @@ func (x *fastReflection_QueryRequestVerificationResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QuerySimulateRequestResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryRequestVerificationResponse)
+		x := input.Message.Interface().(*QuerySimulateRequestResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13016,25 +18933,34 @@ func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoi
 		var n int
 		var l int
 		_ = l
-		l = len(x.ChainId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.RawRequests) > 0 {
+			for _, e := range x.RawRequests {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.Validator)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.PrepareGasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrepareGasUsed))
 		}
-		if x.RequestId != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestId))
+		if len(x.EstimatedFees) > 0 {
+			for _, e := range x.EstimatedFees {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		if x.ExternalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExternalId))
+		if x.ResolveStatus != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResolveStatus))
 		}
-		if x.DataSourceId != 0 {
-			n += 1 + runtime.Sov(uint64(x.DataSourceId))
+		l = len(x.Result)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.IsDelay {
-			n += 2
+		if x.ExecuteGasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecuteGasUsed))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -13046,7 +18972,7 @@ func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoi
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestVerificationResponse)
+		x := input.Message.Interface().(*QuerySimulateRequestResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13065,44 +18991,66 @@ func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoi
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.IsDelay {
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
 			i--
-			if x.IsDelay {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+			dAtA[i] = 0x3a
+		}
+		if x.ExecuteGasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGasUsed))
 			i--
 			dAtA[i] = 0x30
 		}
-		if x.DataSourceId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
+		if len(x.Result) > 0 {
+			i -= len(x.Result)
+			copy(dAtA[i:], x.Result)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
 			i--
-			dAtA[i] = 0x28
+			dAtA[i] = 0x2a
 		}
-		if x.ExternalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
+		if x.ResolveStatus != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveStatus))
 			i--
 			dAtA[i] = 0x20
 		}
-		if x.RequestId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
-			i--
-			dAtA[i] = 0x18
+		if len(x.EstimatedFees) > 0 {
+			for iNdEx := len(x.EstimatedFees) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EstimatedFees[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
 		}
-		if len(x.Validator) > 0 {
-			i -= len(x.Validator)
-			copy(dAtA[i:], x.Validator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+		if x.PrepareGasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrepareGasUsed))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if len(x.ChainId) > 0 {
-			i -= len(x.ChainId)
-			copy(dAtA[i:], x.ChainId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
-			i--
-			dAtA[i] = 0xa
+		if len(x.RawRequests) > 0 {
+			for iNdEx := len(x.RawRequests) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RawRequests[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -13115,7 +19063,7 @@ func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoi
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestVerificationResponse)
+		x := input.Message.Interface().(*QuerySimulateRequestResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13147,17 +19095,17 @@ func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoi
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestVerificationResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateRequestResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawRequests", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -13167,29 +19115,50 @@ func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoi
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ChainId = string(dAtA[iNdEx:postIndex])
+				x.RawRequests = append(x.RawRequests, &RawRequest{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RawRequests[len(x.RawRequests)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrepareGasUsed", wireType)
+				}
+				x.PrepareGasUsed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrepareGasUsed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EstimatedFees", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -13199,29 +19168,31 @@ func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoi
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Validator = string(dAtA[iNdEx:postIndex])
+				x.EstimatedFees = append(x.EstimatedFees, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EstimatedFees[len(x.EstimatedFees)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 3:
+			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
 				}
-				x.RequestId = 0
+				x.ResolveStatus = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -13231,16 +19202,16 @@ func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoi
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
+					x.ResolveStatus |= ResolveStatus(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 				}
-				x.ExternalId = 0
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -13250,16 +19221,31 @@ func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoi
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ExternalId |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 5:
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Result = append(x.Result[:0], dAtA[iNdEx:postIndex]...)
+				if x.Result == nil {
+					x.Result = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGasUsed", wireType)
 				}
-				x.DataSourceId = 0
+				x.ExecuteGasUsed = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -13269,16 +19255,16 @@ func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoi
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.DataSourceId |= uint64(b&0x7F) << shift
+					x.ExecuteGasUsed |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsDelay", wireType)
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 				}
-				var v int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -13288,12 +19274,24 @@ func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoi
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.IsDelay = bool(v != 0)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -13649,6 +19647,194 @@ func (x *QueryOracleScriptResponse) GetOracleScript() *OracleScript {
 	return nil
 }
 
+// QueryDataSourceVersionsRequest is request type for the
+// Query/DataSourceVersions RPC method.
+type QueryDataSourceVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DataSourceID is ID of a data source script
+	DataSourceId uint64 `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryDataSourceVersionsRequest) Reset() {
+	*x = QueryDataSourceVersionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDataSourceVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDataSourceVersionsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryDataSourceVersionsRequest.ProtoReflect.Descriptor instead.
+func (*QueryDataSourceVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryDataSourceVersionsRequest) GetDataSourceId() uint64 {
+	if x != nil {
+		return x.DataSourceId
+	}
+	return 0
+}
+
+func (x *QueryDataSourceVersionsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryDataSourceVersionsResponse is response type for the
+// Query/DataSourceVersions RPC method.
+type QueryDataSourceVersionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Versions is the list of versions of the data source in ascending order
+	Versions []*DataSourceVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryDataSourceVersionsResponse) Reset() {
+	*x = QueryDataSourceVersionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDataSourceVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDataSourceVersionsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryDataSourceVersionsResponse.ProtoReflect.Descriptor instead.
+func (*QueryDataSourceVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryDataSourceVersionsResponse) GetVersions() []*DataSourceVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *QueryDataSourceVersionsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryOracleScriptVersionsRequest is request type for the
+// Query/OracleScriptVersions RPC method.
+type QueryOracleScriptVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OracleScriptID is ID of an oracle script
+	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryOracleScriptVersionsRequest) Reset() {
+	*x = QueryOracleScriptVersionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryOracleScriptVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryOracleScriptVersionsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryOracleScriptVersionsRequest.ProtoReflect.Descriptor instead.
+func (*QueryOracleScriptVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryOracleScriptVersionsRequest) GetOracleScriptId() uint64 {
+	if x != nil {
+		return x.OracleScriptId
+	}
+	return 0
+}
+
+func (x *QueryOracleScriptVersionsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryOracleScriptVersionsResponse is response type for the
+// Query/OracleScriptVersions RPC method.
+type QueryOracleScriptVersionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Versions is the list of versions of the oracle script in ascending order
+	Versions []*OracleScriptVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryOracleScriptVersionsResponse) Reset() {
+	*x = QueryOracleScriptVersionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryOracleScriptVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryOracleScriptVersionsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryOracleScriptVersionsResponse.ProtoReflect.Descriptor instead.
+func (*QueryOracleScriptVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryOracleScriptVersionsResponse) GetVersions() []*OracleScriptVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *QueryOracleScriptVersionsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 // QueryRequestRequest is request type for the Query/Request RPC method.
 type QueryRequestRequest struct {
 	state         protoimpl.MessageState
@@ -13662,7 +19848,7 @@ type QueryRequestRequest struct {
 func (x *QueryRequestRequest) Reset() {
 	*x = QueryRequestRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[8]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13676,7 +19862,7 @@ func (*QueryRequestRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryRequestRequest.ProtoReflect.Descriptor instead.
 func (*QueryRequestRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{8}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *QueryRequestRequest) GetRequestId() uint64 {
@@ -13701,12 +19887,15 @@ type QueryRequestResponse struct {
 	Result *Result `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
 	// Signing is the signing detail in the bandtss module.
 	Signing *SigningResult `protobuf:"bytes,4,opt,name=signing,proto3" json:"signing,omitempty"`
+	// Versions is the versions of the oracle script and data sources used by
+	// the request, which remain available after the request is removed.
+	Versions *RequestVersions `protobuf:"bytes,5,opt,name=versions,proto3" json:"versions,omitempty"`
 }
 
 func (x *QueryRequestResponse) Reset() {
 	*x = QueryRequestResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[9]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13720,7 +19909,7 @@ func (*QueryRequestResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryRequestResponse.ProtoReflect.Descriptor instead.
 func (*QueryRequestResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{9}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *QueryRequestResponse) GetRequest() *Request {
@@ -13751,6 +19940,13 @@ func (x *QueryRequestResponse) GetSigning() *SigningResult {
 	return nil
 }
 
+func (x *QueryRequestResponse) GetVersions() *RequestVersions {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
 // QueryPendingRequestRequest is request type for the Query/PendingRequests RPC
 // method.
 type QueryPendingRequestsRequest struct {
@@ -13765,7 +19961,7 @@ type QueryPendingRequestsRequest struct {
 func (x *QueryPendingRequestsRequest) Reset() {
 	*x = QueryPendingRequestsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[10]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13779,7 +19975,7 @@ func (*QueryPendingRequestsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryPendingRequestsRequest.ProtoReflect.Descriptor instead.
 func (*QueryPendingRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{10}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *QueryPendingRequestsRequest) GetValidatorAddress() string {
@@ -13803,7 +19999,7 @@ type QueryPendingRequestsResponse struct {
 func (x *QueryPendingRequestsResponse) Reset() {
 	*x = QueryPendingRequestsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[11]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13817,7 +20013,7 @@ func (*QueryPendingRequestsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryPendingRequestsResponse.ProtoReflect.Descriptor instead.
 func (*QueryPendingRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{11}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *QueryPendingRequestsResponse) GetRequestIds() []uint64 {
@@ -13837,7 +20033,7 @@ type QueryParamsRequest struct {
 func (x *QueryParamsRequest) Reset() {
 	*x = QueryParamsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[12]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13851,7 +20047,7 @@ func (*QueryParamsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{12}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
 // QueryParamsResponse is response type for the Query/Params RPC method.
@@ -13867,7 +20063,7 @@ type QueryParamsResponse struct {
 func (x *QueryParamsResponse) Reset() {
 	*x = QueryParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[13]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13881,7 +20077,7 @@ func (*QueryParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{13}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *QueryParamsResponse) GetParams() *Params {
@@ -13891,689 +20087,1068 @@ func (x *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
-// QueryValidatorRequest is request type for the Query/Validator RPC method.
-type QueryValidatorRequest struct {
+// QueryValidatorRequest is request type for the Query/Validator RPC method.
+type QueryValidatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ValidatorAddress is address of a validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (x *QueryValidatorRequest) Reset() {
+	*x = QueryValidatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryValidatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryValidatorRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryValidatorRequest.ProtoReflect.Descriptor instead.
+func (*QueryValidatorRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *QueryValidatorRequest) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryValidatorResponse is response type for the Query/Validator RPC method.
+type QueryValidatorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Status is status of a validator e.g. active/inactive
+	Status *ValidatorStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *QueryValidatorResponse) Reset() {
+	*x = QueryValidatorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryValidatorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryValidatorResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryValidatorResponse.ProtoReflect.Descriptor instead.
+func (*QueryValidatorResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QueryValidatorResponse) GetStatus() *ValidatorStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// QueryIsReporterRequest is request type for the Query/Reporter RPC method.
+type QueryIsReporterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ValidatorAddress is a validator address
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// ReporterAddress is a candidate account
+	ReporterAddress string `protobuf:"bytes,2,opt,name=reporter_address,json=reporterAddress,proto3" json:"reporter_address,omitempty"`
+}
+
+func (x *QueryIsReporterRequest) Reset() {
+	*x = QueryIsReporterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryIsReporterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryIsReporterRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryIsReporterRequest.ProtoReflect.Descriptor instead.
+func (*QueryIsReporterRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *QueryIsReporterRequest) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+func (x *QueryIsReporterRequest) GetReporterAddress() string {
+	if x != nil {
+		return x.ReporterAddress
+	}
+	return ""
+}
+
+// QueryIsReporterResponse is response type for the Query/Reporter RPC method.
+type QueryIsReporterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IsReporter is true if this account has been granted by validator
+	IsReporter bool `protobuf:"varint,1,opt,name=is_reporter,json=isReporter,proto3" json:"is_reporter,omitempty"`
+}
+
+func (x *QueryIsReporterResponse) Reset() {
+	*x = QueryIsReporterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryIsReporterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryIsReporterResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryIsReporterResponse.ProtoReflect.Descriptor instead.
+func (*QueryIsReporterResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *QueryIsReporterResponse) GetIsReporter() bool {
+	if x != nil {
+		return x.IsReporter
+	}
+	return false
+}
+
+// QueryReportersRequest is request type for the Query/Reporters RPC method.
+type QueryReportersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ValidatorAddress is a validator address
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (x *QueryReportersRequest) Reset() {
+	*x = QueryReportersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryReportersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryReportersRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryReportersRequest.ProtoReflect.Descriptor instead.
+func (*QueryReportersRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *QueryReportersRequest) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryReportersResponse is response type for the Query/Reporters RPC method.
+type QueryReportersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Reporter is a list of account addresses of reporters
+	Reporter []string `protobuf:"bytes,1,rep,name=reporter,proto3" json:"reporter,omitempty"`
+}
+
+func (x *QueryReportersResponse) Reset() {
+	*x = QueryReportersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryReportersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryReportersResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryReportersResponse.ProtoReflect.Descriptor instead.
+func (*QueryReportersResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QueryReportersResponse) GetReporter() []string {
+	if x != nil {
+		return x.Reporter
+	}
+	return nil
+}
+
+// QueryActiveValidatorsRequest is request type for the Query/ActiveValidators
+// RPC method.
+type QueryActiveValidatorsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// ValidatorAddress is address of a validator
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (x *QueryValidatorRequest) Reset() {
-	*x = QueryValidatorRequest{}
+func (x *QueryActiveValidatorsRequest) Reset() {
+	*x = QueryActiveValidatorsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[14]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryValidatorRequest) String() string {
+func (x *QueryActiveValidatorsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryValidatorRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryValidatorRequest.ProtoReflect.Descriptor instead.
-func (*QueryValidatorRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{14}
-}
+func (*QueryActiveValidatorsRequest) ProtoMessage() {}
 
-func (x *QueryValidatorRequest) GetValidatorAddress() string {
-	if x != nil {
-		return x.ValidatorAddress
-	}
-	return ""
+// Deprecated: Use QueryActiveValidatorsRequest.ProtoReflect.Descriptor instead.
+func (*QueryActiveValidatorsRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{24}
 }
 
-// QueryValidatorResponse is response type for the Query/Validator RPC method.
-type QueryValidatorResponse struct {
+// QueryActiveValidatorsResponse is response type for the Query/ActiveValidators
+// RPC method.
+type QueryActiveValidatorsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Status is status of a validator e.g. active/inactive
-	Status *ValidatorStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Validators is a list of active validators
+	Validators []*ActiveValidator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
 }
 
-func (x *QueryValidatorResponse) Reset() {
-	*x = QueryValidatorResponse{}
+func (x *QueryActiveValidatorsResponse) Reset() {
+	*x = QueryActiveValidatorsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[15]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryValidatorResponse) String() string {
+func (x *QueryActiveValidatorsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryValidatorResponse) ProtoMessage() {}
+func (*QueryActiveValidatorsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryValidatorResponse.ProtoReflect.Descriptor instead.
-func (*QueryValidatorResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use QueryActiveValidatorsResponse.ProtoReflect.Descriptor instead.
+func (*QueryActiveValidatorsResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *QueryValidatorResponse) GetStatus() *ValidatorStatus {
+func (x *QueryActiveValidatorsResponse) GetValidators() []*ActiveValidator {
 	if x != nil {
-		return x.Status
+		return x.Validators
 	}
 	return nil
 }
 
-// QueryIsReporterRequest is request type for the Query/Reporter RPC method.
-type QueryIsReporterRequest struct {
+// QueryRequestSearchRequest is request type for the Query/RequestSearch RPC
+// method.
+type QueryRequestSearchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ValidatorAddress is a validator address
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	// ReporterAddress is a candidate account
-	ReporterAddress string `protobuf:"bytes,2,opt,name=reporter_address,json=reporterAddress,proto3" json:"reporter_address,omitempty"`
+	// OracleScriptID is ID of an oracle script
+	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// Calldata is OBI-encoded data in hex format as argument params for the
+	// oracle script
+	Calldata string `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is number of validators allowed for fulfilling the request
+	AskCount uint64 `protobuf:"varint,3,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is number of validators required for fulfilling the request
+	MinCount uint64 `protobuf:"varint,4,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
 }
 
-func (x *QueryIsReporterRequest) Reset() {
-	*x = QueryIsReporterRequest{}
+func (x *QueryRequestSearchRequest) Reset() {
+	*x = QueryRequestSearchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[16]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryIsReporterRequest) String() string {
+func (x *QueryRequestSearchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryIsReporterRequest) ProtoMessage() {}
+func (*QueryRequestSearchRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryIsReporterRequest.ProtoReflect.Descriptor instead.
-func (*QueryIsReporterRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use QueryRequestSearchRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequestSearchRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *QueryIsReporterRequest) GetValidatorAddress() string {
+func (x *QueryRequestSearchRequest) GetOracleScriptId() uint64 {
 	if x != nil {
-		return x.ValidatorAddress
+		return x.OracleScriptId
 	}
-	return ""
+	return 0
 }
 
-func (x *QueryIsReporterRequest) GetReporterAddress() string {
+func (x *QueryRequestSearchRequest) GetCalldata() string {
 	if x != nil {
-		return x.ReporterAddress
+		return x.Calldata
 	}
 	return ""
 }
 
-// QueryIsReporterResponse is response type for the Query/Reporter RPC method.
-type QueryIsReporterResponse struct {
+func (x *QueryRequestSearchRequest) GetAskCount() uint64 {
+	if x != nil {
+		return x.AskCount
+	}
+	return 0
+}
+
+func (x *QueryRequestSearchRequest) GetMinCount() uint64 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
+}
+
+// QueryRequestSearchResponse is response type for the Query/RequestSearch RPC
+// method.
+type QueryRequestSearchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// IsReporter is true if this account has been granted by validator
-	IsReporter bool `protobuf:"varint,1,opt,name=is_reporter,json=isReporter,proto3" json:"is_reporter,omitempty"`
+	// Request is details of an oracle request
+	Request *QueryRequestResponse `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *QueryIsReporterResponse) Reset() {
-	*x = QueryIsReporterResponse{}
+func (x *QueryRequestSearchResponse) Reset() {
+	*x = QueryRequestSearchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[17]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryIsReporterResponse) String() string {
+func (x *QueryRequestSearchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryIsReporterResponse) ProtoMessage() {}
+func (*QueryRequestSearchResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryIsReporterResponse.ProtoReflect.Descriptor instead.
-func (*QueryIsReporterResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use QueryRequestSearchResponse.ProtoReflect.Descriptor instead.
+func (*QueryRequestSearchResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *QueryIsReporterResponse) GetIsReporter() bool {
+func (x *QueryRequestSearchResponse) GetRequest() *QueryRequestResponse {
 	if x != nil {
-		return x.IsReporter
+		return x.Request
 	}
-	return false
+	return nil
 }
 
-// QueryReportersRequest is request type for the Query/Reporters RPC method.
-type QueryReportersRequest struct {
+// QueryRequestPriceRequest is request type for the Query/RequestPrice RPC
+// method.
+type QueryRequestPriceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ValidatorAddress is a validator address
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Symbol is unit of data indicating what the data is
+	Symbols []string `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	// AskCount is number of validators allowed for fulfilling the request
+	AskCount uint64 `protobuf:"varint,2,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is number of validators required for fulfilling the request
+	MinCount uint64 `protobuf:"varint,3,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
 }
 
-func (x *QueryReportersRequest) Reset() {
-	*x = QueryReportersRequest{}
+func (x *QueryRequestPriceRequest) Reset() {
+	*x = QueryRequestPriceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[18]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryReportersRequest) String() string {
+func (x *QueryRequestPriceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryReportersRequest) ProtoMessage() {}
+func (*QueryRequestPriceRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryReportersRequest.ProtoReflect.Descriptor instead.
-func (*QueryReportersRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use QueryRequestPriceRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequestPriceRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *QueryReportersRequest) GetValidatorAddress() string {
+func (x *QueryRequestPriceRequest) GetSymbols() []string {
 	if x != nil {
-		return x.ValidatorAddress
+		return x.Symbols
 	}
-	return ""
+	return nil
 }
 
-// QueryReportersResponse is response type for the Query/Reporters RPC method.
-type QueryReportersResponse struct {
+func (x *QueryRequestPriceRequest) GetAskCount() uint64 {
+	if x != nil {
+		return x.AskCount
+	}
+	return 0
+}
+
+func (x *QueryRequestPriceRequest) GetMinCount() uint64 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
+}
+
+// QueryRequestPriceResponse is response type for the Query/RequestPrice RPC
+// method.
+type QueryRequestPriceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Reporter is a list of account addresses of reporters
-	Reporter []string `protobuf:"bytes,1,rep,name=reporter,proto3" json:"reporter,omitempty"`
+	// PriceResult is a list of price results for given symbols
+	PriceResults []*PriceResult `protobuf:"bytes,1,rep,name=price_results,json=priceResults,proto3" json:"price_results,omitempty"`
 }
 
-func (x *QueryReportersResponse) Reset() {
-	*x = QueryReportersResponse{}
+func (x *QueryRequestPriceResponse) Reset() {
+	*x = QueryRequestPriceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[19]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryReportersResponse) String() string {
+func (x *QueryRequestPriceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryReportersResponse) ProtoMessage() {}
+func (*QueryRequestPriceResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryReportersResponse.ProtoReflect.Descriptor instead.
-func (*QueryReportersResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use QueryRequestPriceResponse.ProtoReflect.Descriptor instead.
+func (*QueryRequestPriceResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *QueryReportersResponse) GetReporter() []string {
+func (x *QueryRequestPriceResponse) GetPriceResults() []*PriceResult {
 	if x != nil {
-		return x.Reporter
+		return x.PriceResults
 	}
 	return nil
 }
 
-// QueryActiveValidatorsRequest is request type for the Query/ActiveValidators
-// RPC method.
-type QueryActiveValidatorsRequest struct {
+// QueryRequestVerificationRequest is request type for the
+// Query/RequestVerification RPC
+type QueryRequestVerificationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// ChainID is the chain ID to identify which chain ID is used for the
+	// verification
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Validator is a validator address
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// RequestID is oracle request ID
+	RequestId uint64 `protobuf:"varint,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// ExternalID is an oracle's external ID
+	ExternalId uint64 `protobuf:"varint,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// DataSourceID is the ID of data source
+	DataSourceId uint64 `protobuf:"varint,5,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// Reporter is an bech32-encoded public key of the reporter authorized by the
+	// validator
+	Reporter string `protobuf:"bytes,6,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	// Signature is a signature signed by the reporter using reporter's private
+	// key
+	Signature []byte `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	// MaxDelay is a max number of RequestID delay acceptable in case of node
+	// delay
+	MaxDelay uint64 `protobuf:"varint,8,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"`
 }
 
-func (x *QueryActiveValidatorsRequest) Reset() {
-	*x = QueryActiveValidatorsRequest{}
+func (x *QueryRequestVerificationRequest) Reset() {
+	*x = QueryRequestVerificationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[20]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryActiveValidatorsRequest) String() string {
+func (x *QueryRequestVerificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryActiveValidatorsRequest) ProtoMessage() {}
+func (*QueryRequestVerificationRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryActiveValidatorsRequest.ProtoReflect.Descriptor instead.
-func (*QueryActiveValidatorsRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use QueryRequestVerificationRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequestVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{30}
 }
 
-// QueryActiveValidatorsResponse is response type for the Query/ActiveValidators
-// RPC method.
-type QueryActiveValidatorsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *QueryRequestVerificationRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
 
-	// Validators is a list of active validators
-	Validators []*ActiveValidator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+func (x *QueryRequestVerificationRequest) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
 }
 
-func (x *QueryActiveValidatorsResponse) Reset() {
-	*x = QueryActiveValidatorsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QueryRequestVerificationRequest) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
 	}
+	return 0
 }
 
-func (x *QueryActiveValidatorsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QueryRequestVerificationRequest) GetExternalId() uint64 {
+	if x != nil {
+		return x.ExternalId
+	}
+	return 0
 }
 
-func (*QueryActiveValidatorsResponse) ProtoMessage() {}
+func (x *QueryRequestVerificationRequest) GetDataSourceId() uint64 {
+	if x != nil {
+		return x.DataSourceId
+	}
+	return 0
+}
 
-// Deprecated: Use QueryActiveValidatorsResponse.ProtoReflect.Descriptor instead.
-func (*QueryActiveValidatorsResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{21}
+func (x *QueryRequestVerificationRequest) GetReporter() string {
+	if x != nil {
+		return x.Reporter
+	}
+	return ""
 }
 
-func (x *QueryActiveValidatorsResponse) GetValidators() []*ActiveValidator {
+func (x *QueryRequestVerificationRequest) GetSignature() []byte {
 	if x != nil {
-		return x.Validators
+		return x.Signature
 	}
 	return nil
 }
 
-// QueryRequestSearchRequest is request type for the Query/RequestSearch RPC
-// method.
-type QueryRequestSearchRequest struct {
+func (x *QueryRequestVerificationRequest) GetMaxDelay() uint64 {
+	if x != nil {
+		return x.MaxDelay
+	}
+	return 0
+}
+
+// QueryRequestVerificationResponse is response type for the
+// Query/RequestVerification RPC
+type QueryRequestVerificationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// OracleScriptID is ID of an oracle script
-	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
-	// Calldata is OBI-encoded data in hex format as argument params for the
-	// oracle script
-	Calldata string `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
-	// AskCount is number of validators allowed for fulfilling the request
-	AskCount uint64 `protobuf:"varint,3,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
-	// MinCount is number of validators required for fulfilling the request
-	MinCount uint64 `protobuf:"varint,4,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// ChainID is the targeted chain ID
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Validator is the targeted validator address
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// RequestID is the ID of targeted request
+	RequestId uint64 `protobuf:"varint,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// ExternalID is the ID of targeted oracle's external data source
+	ExternalId uint64 `protobuf:"varint,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// DataSourceID is the ID of a data source that relates to the targeted
+	// external ID
+	DataSourceId uint64 `protobuf:"varint,5,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// IsDelay is a flag to tell that this request is not exist yet but in
+	// MaxDelay range
+	IsDelay bool `protobuf:"varint,6,opt,name=is_delay,json=isDelay,proto3" json:"is_delay,omitempty"`
 }
 
-func (x *QueryRequestSearchRequest) Reset() {
-	*x = QueryRequestSearchRequest{}
+func (x *QueryRequestVerificationResponse) Reset() {
+	*x = QueryRequestVerificationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[22]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequestSearchRequest) String() string {
+func (x *QueryRequestVerificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequestSearchRequest) ProtoMessage() {}
+func (*QueryRequestVerificationResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryRequestSearchRequest.ProtoReflect.Descriptor instead.
-func (*QueryRequestSearchRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use QueryRequestVerificationResponse.ProtoReflect.Descriptor instead.
+func (*QueryRequestVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *QueryRequestSearchRequest) GetOracleScriptId() uint64 {
+func (x *QueryRequestVerificationResponse) GetChainId() string {
 	if x != nil {
-		return x.OracleScriptId
+		return x.ChainId
 	}
-	return 0
+	return ""
 }
 
-func (x *QueryRequestSearchRequest) GetCalldata() string {
+func (x *QueryRequestVerificationResponse) GetValidator() string {
 	if x != nil {
-		return x.Calldata
+		return x.Validator
 	}
 	return ""
 }
 
-func (x *QueryRequestSearchRequest) GetAskCount() uint64 {
+func (x *QueryRequestVerificationResponse) GetRequestId() uint64 {
 	if x != nil {
-		return x.AskCount
+		return x.RequestId
 	}
 	return 0
 }
 
-func (x *QueryRequestSearchRequest) GetMinCount() uint64 {
+func (x *QueryRequestVerificationResponse) GetExternalId() uint64 {
 	if x != nil {
-		return x.MinCount
+		return x.ExternalId
 	}
 	return 0
 }
 
-// QueryRequestSearchResponse is response type for the Query/RequestSearch RPC
+func (x *QueryRequestVerificationResponse) GetDataSourceId() uint64 {
+	if x != nil {
+		return x.DataSourceId
+	}
+	return 0
+}
+
+func (x *QueryRequestVerificationResponse) GetIsDelay() bool {
+	if x != nil {
+		return x.IsDelay
+	}
+	return false
+}
+
+// QuerySubscriptionRequest is request type for the Query/Subscription RPC
 // method.
-type QueryRequestSearchResponse struct {
+type QuerySubscriptionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Request is details of an oracle request
-	Request *QueryRequestResponse `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// SubscriptionID is ID of a subscription
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
 }
 
-func (x *QueryRequestSearchResponse) Reset() {
-	*x = QueryRequestSearchResponse{}
+func (x *QuerySubscriptionRequest) Reset() {
+	*x = QuerySubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[23]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequestSearchResponse) String() string {
+func (x *QuerySubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequestSearchResponse) ProtoMessage() {}
+func (*QuerySubscriptionRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryRequestSearchResponse.ProtoReflect.Descriptor instead.
-func (*QueryRequestSearchResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use QuerySubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*QuerySubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *QueryRequestSearchResponse) GetRequest() *QueryRequestResponse {
+func (x *QuerySubscriptionRequest) GetSubscriptionId() uint64 {
 	if x != nil {
-		return x.Request
+		return x.SubscriptionId
 	}
-	return nil
+	return 0
 }
 
-// QueryRequestPriceRequest is request type for the Query/RequestPrice RPC
+// QuerySubscriptionResponse is response type for the Query/Subscription RPC
 // method.
-type QueryRequestPriceRequest struct {
+type QuerySubscriptionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Symbol is unit of data indicating what the data is
-	Symbols []string `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
-	// AskCount is number of validators allowed for fulfilling the request
-	AskCount uint64 `protobuf:"varint,2,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
-	// MinCount is number of validators required for fulfilling the request
-	MinCount uint64 `protobuf:"varint,3,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// Subscription is the subscription of the given ID
+	Subscription *Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// Balance is the remaining funding balance held by the escrow of the
+	// subscription
+	Balance []*v1beta11.Coin `protobuf:"bytes,2,rep,name=balance,proto3" json:"balance,omitempty"`
 }
 
-func (x *QueryRequestPriceRequest) Reset() {
-	*x = QueryRequestPriceRequest{}
+func (x *QuerySubscriptionResponse) Reset() {
+	*x = QuerySubscriptionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[24]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequestPriceRequest) String() string {
+func (x *QuerySubscriptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequestPriceRequest) ProtoMessage() {}
+func (*QuerySubscriptionResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryRequestPriceRequest.ProtoReflect.Descriptor instead.
-func (*QueryRequestPriceRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use QuerySubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*QuerySubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *QueryRequestPriceRequest) GetSymbols() []string {
+func (x *QuerySubscriptionResponse) GetSubscription() *Subscription {
 	if x != nil {
-		return x.Symbols
+		return x.Subscription
 	}
 	return nil
 }
 
-func (x *QueryRequestPriceRequest) GetAskCount() uint64 {
+func (x *QuerySubscriptionResponse) GetBalance() []*v1beta11.Coin {
 	if x != nil {
-		return x.AskCount
+		return x.Balance
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryRequestPriceRequest) GetMinCount() uint64 {
+// QuerySubscriptionsRequest is request type for the Query/Subscriptions RPC
+// method.
+type QuerySubscriptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QuerySubscriptionsRequest) Reset() {
+	*x = QuerySubscriptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySubscriptionsRequest) ProtoMessage() {}
+
+// Deprecated: Use QuerySubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*QuerySubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *QuerySubscriptionsRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
-		return x.MinCount
+		return x.Pagination
 	}
-	return 0
+	return nil
 }
 
-// QueryRequestPriceResponse is response type for the Query/RequestPrice RPC
+// QuerySubscriptionsResponse is response type for the Query/Subscriptions RPC
 // method.
-type QueryRequestPriceResponse struct {
+type QuerySubscriptionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// PriceResult is a list of price results for given symbols
-	PriceResults []*PriceResult `protobuf:"bytes,1,rep,name=price_results,json=priceResults,proto3" json:"price_results,omitempty"`
+	// Subscriptions is the list of subscriptions
+	Subscriptions []*Subscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryRequestPriceResponse) Reset() {
-	*x = QueryRequestPriceResponse{}
+func (x *QuerySubscriptionsResponse) Reset() {
+	*x = QuerySubscriptionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[25]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequestPriceResponse) String() string {
+func (x *QuerySubscriptionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequestPriceResponse) ProtoMessage() {}
+func (*QuerySubscriptionsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryRequestPriceResponse.ProtoReflect.Descriptor instead.
-func (*QueryRequestPriceResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use QuerySubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*QuerySubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *QueryRequestPriceResponse) GetPriceResults() []*PriceResult {
+func (x *QuerySubscriptionsResponse) GetSubscriptions() []*Subscription {
 	if x != nil {
-		return x.PriceResults
+		return x.Subscriptions
 	}
 	return nil
 }
 
-// QueryRequestVerificationRequest is request type for the
-// Query/RequestVerification RPC
-type QueryRequestVerificationRequest struct {
+func (x *QuerySubscriptionsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QuerySimulateRequestRequest is request type for the Query/SimulateRequest RPC
+// method.
+type QuerySimulateRequestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ChainID is the chain ID to identify which chain ID is used for the
-	// verification
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// Validator is a validator address
-	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
-	// RequestID is oracle request ID
-	RequestId uint64 `protobuf:"varint,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// ExternalID is an oracle's external ID
-	ExternalId uint64 `protobuf:"varint,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
-	// DataSourceID is the ID of data source
-	DataSourceId uint64 `protobuf:"varint,5,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
-	// Reporter is an bech32-encoded public key of the reporter authorized by the
-	// validator
-	Reporter string `protobuf:"bytes,6,opt,name=reporter,proto3" json:"reporter,omitempty"`
-	// Signature is a signature signed by the reporter using reporter's private
-	// key
-	Signature []byte `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
-	// MaxDelay is a max number of RequestID delay acceptable in case of node
-	// delay
-	MaxDelay uint64 `protobuf:"varint,8,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"`
-}
-
-func (x *QueryRequestVerificationRequest) Reset() {
-	*x = QueryRequestVerificationRequest{}
+	// OracleScriptID is the ID of the on-chain oracle script to be simulated. It
+	// is ignored if code is provided.
+	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// OracleScriptVersion is the version of the on-chain oracle script to be
+	// simulated. The latest version is used if it is zero.
+	OracleScriptVersion uint64 `protobuf:"varint,2,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
+	// Code is the optional Owasm code of an oracle script that is not deployed
+	// on-chain. It is only accepted by nodes started with
+	// --oracle-simulate-request-code.
+	Code []byte `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// Calldata is the data used as argument params for the oracle script
+	Calldata []byte `protobuf:"bytes,4,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is the number of validators assumed to be asked for the request
+	AskCount uint64 `protobuf:"varint,5,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is the number of validators assumed to report to the request
+	MinCount uint64 `protobuf:"varint,6,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// PrepareGas is amount of gas to reserve for preparing
+	PrepareGas uint64 `protobuf:"varint,7,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
+	// ExecuteGas is amount of gas to reserve for executing
+	ExecuteGas uint64 `protobuf:"varint,8,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	// RawReports are the raw reports for every raw request made in the prepare
+	// phase. The execute phase is only run if they are provided.
+	RawReports []*RawReport `protobuf:"bytes,9,rep,name=raw_reports,json=rawReports,proto3" json:"raw_reports,omitempty"`
+}
+
+func (x *QuerySimulateRequestRequest) Reset() {
+	*x = QuerySimulateRequestRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[26]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequestVerificationRequest) String() string {
+func (x *QuerySimulateRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequestVerificationRequest) ProtoMessage() {}
+func (*QuerySimulateRequestRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryRequestVerificationRequest.ProtoReflect.Descriptor instead.
-func (*QueryRequestVerificationRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use QuerySimulateRequestRequest.ProtoReflect.Descriptor instead.
+func (*QuerySimulateRequestRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *QueryRequestVerificationRequest) GetChainId() string {
+func (x *QuerySimulateRequestRequest) GetOracleScriptId() uint64 {
 	if x != nil {
-		return x.ChainId
+		return x.OracleScriptId
 	}
-	return ""
+	return 0
 }
 
-func (x *QueryRequestVerificationRequest) GetValidator() string {
+func (x *QuerySimulateRequestRequest) GetOracleScriptVersion() uint64 {
 	if x != nil {
-		return x.Validator
+		return x.OracleScriptVersion
 	}
-	return ""
+	return 0
 }
 
-func (x *QueryRequestVerificationRequest) GetRequestId() uint64 {
+func (x *QuerySimulateRequestRequest) GetCode() []byte {
 	if x != nil {
-		return x.RequestId
+		return x.Code
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryRequestVerificationRequest) GetExternalId() uint64 {
+func (x *QuerySimulateRequestRequest) GetCalldata() []byte {
 	if x != nil {
-		return x.ExternalId
+		return x.Calldata
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryRequestVerificationRequest) GetDataSourceId() uint64 {
+func (x *QuerySimulateRequestRequest) GetAskCount() uint64 {
 	if x != nil {
-		return x.DataSourceId
+		return x.AskCount
 	}
 	return 0
 }
 
-func (x *QueryRequestVerificationRequest) GetReporter() string {
+func (x *QuerySimulateRequestRequest) GetMinCount() uint64 {
 	if x != nil {
-		return x.Reporter
+		return x.MinCount
 	}
-	return ""
+	return 0
 }
 
-func (x *QueryRequestVerificationRequest) GetSignature() []byte {
+func (x *QuerySimulateRequestRequest) GetPrepareGas() uint64 {
 	if x != nil {
-		return x.Signature
+		return x.PrepareGas
 	}
-	return nil
+	return 0
 }
 
-func (x *QueryRequestVerificationRequest) GetMaxDelay() uint64 {
+func (x *QuerySimulateRequestRequest) GetExecuteGas() uint64 {
 	if x != nil {
-		return x.MaxDelay
+		return x.ExecuteGas
 	}
 	return 0
 }
 
-// QueryRequestVerificationResponse is response type for the
-// Query/RequestVerification RPC
-type QueryRequestVerificationResponse struct {
+func (x *QuerySimulateRequestRequest) GetRawReports() []*RawReport {
+	if x != nil {
+		return x.RawReports
+	}
+	return nil
+}
+
+// QuerySimulateRequestResponse is response type for the Query/SimulateRequest
+// RPC method.
+type QuerySimulateRequestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ChainID is the targeted chain ID
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// Validator is the targeted validator address
-	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
-	// RequestID is the ID of targeted request
-	RequestId uint64 `protobuf:"varint,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// ExternalID is the ID of targeted oracle's external data source
-	ExternalId uint64 `protobuf:"varint,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
-	// DataSourceID is the ID of a data source that relates to the targeted
-	// external ID
-	DataSourceId uint64 `protobuf:"varint,5,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
-	// IsDelay is a flag to tell that this request is not exist yet but in
-	// MaxDelay range
-	IsDelay bool `protobuf:"varint,6,opt,name=is_delay,json=isDelay,proto3" json:"is_delay,omitempty"`
-}
-
-func (x *QueryRequestVerificationResponse) Reset() {
-	*x = QueryRequestVerificationResponse{}
+	// RawRequests are the raw requests made in the prepare phase
+	RawRequests []*RawRequest `protobuf:"bytes,1,rep,name=raw_requests,json=rawRequests,proto3" json:"raw_requests,omitempty"`
+	// PrepareGasUsed is the amount of gas used in the prepare phase
+	PrepareGasUsed uint64 `protobuf:"varint,2,opt,name=prepare_gas_used,json=prepareGasUsed,proto3" json:"prepare_gas_used,omitempty"`
+	// EstimatedFees are the data source fees to be paid by the request
+	EstimatedFees []*v1beta11.Coin `protobuf:"bytes,3,rep,name=estimated_fees,json=estimatedFees,proto3" json:"estimated_fees,omitempty"`
+	// ResolveStatus is the status of the execute phase. It is open if no raw
+	// reports are provided.
+	ResolveStatus ResolveStatus `protobuf:"varint,4,opt,name=resolve_status,json=resolveStatus,proto3,enum=band.oracle.v1.ResolveStatus" json:"resolve_status,omitempty"`
+	// Result is the result of the execute phase
+	Result []byte `protobuf:"bytes,5,opt,name=result,proto3" json:"result,omitempty"`
+	// ExecuteGasUsed is the amount of gas used in the execute phase
+	ExecuteGasUsed uint64 `protobuf:"varint,6,opt,name=execute_gas_used,json=executeGasUsed,proto3" json:"execute_gas_used,omitempty"`
+	// Reason is the reason of a failed execute phase
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *QuerySimulateRequestResponse) Reset() {
+	*x = QuerySimulateRequestResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[27]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequestVerificationResponse) String() string {
+func (x *QuerySimulateRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequestVerificationResponse) ProtoMessage() {}
+func (*QuerySimulateRequestResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryRequestVerificationResponse.ProtoReflect.Descriptor instead.
-func (*QueryRequestVerificationResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use QuerySimulateRequestResponse.ProtoReflect.Descriptor instead.
+func (*QuerySimulateRequestResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *QueryRequestVerificationResponse) GetChainId() string {
+func (x *QuerySimulateRequestResponse) GetRawRequests() []*RawRequest {
 	if x != nil {
-		return x.ChainId
+		return x.RawRequests
 	}
-	return ""
+	return nil
 }
 
-func (x *QueryRequestVerificationResponse) GetValidator() string {
+func (x *QuerySimulateRequestResponse) GetPrepareGasUsed() uint64 {
 	if x != nil {
-		return x.Validator
+		return x.PrepareGasUsed
 	}
-	return ""
+	return 0
 }
 
-func (x *QueryRequestVerificationResponse) GetRequestId() uint64 {
+func (x *QuerySimulateRequestResponse) GetEstimatedFees() []*v1beta11.Coin {
 	if x != nil {
-		return x.RequestId
+		return x.EstimatedFees
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryRequestVerificationResponse) GetExternalId() uint64 {
+func (x *QuerySimulateRequestResponse) GetResolveStatus() ResolveStatus {
 	if x != nil {
-		return x.ExternalId
+		return x.ResolveStatus
 	}
-	return 0
+	return ResolveStatus_RESOLVE_STATUS_OPEN_UNSPECIFIED
 }
 
-func (x *QueryRequestVerificationResponse) GetDataSourceId() uint64 {
+func (x *QuerySimulateRequestResponse) GetResult() []byte {
 	if x != nil {
-		return x.DataSourceId
+		return x.Result
+	}
+	return nil
+}
+
+func (x *QuerySimulateRequestResponse) GetExecuteGasUsed() uint64 {
+	if x != nil {
+		return x.ExecuteGasUsed
 	}
 	return 0
 }
 
-func (x *QueryRequestVerificationResponse) GetIsDelay() bool {
+func (x *QuerySimulateRequestResponse) GetReason() string {
 	if x != nil {
-		return x.IsDelay
+		return x.Reason
 	}
-	return false
+	return ""
 }
 
 var File_band_oracle_v1_query_proto protoreflect.FileDescriptor
@@ -14586,329 +21161,512 @@ var file_band_oracle_v1_query_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
 	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x61, 0x6e,
-	0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x96,
-	0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x11, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64,
-	0x61, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x64, 0x61, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x22, 0x27, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x3e, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x64,
-	0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
-	0x64, 0x22, 0x56, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b,
-	0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0a, 0x64,
-	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x44, 0x0a, 0x18, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x22,
-	0x5e, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0d,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x52, 0x0c, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22,
-	0x34, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xea, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
-	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x69, 0x67,
-	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x61, 0x6e,
-	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x69,
-	0x6e, 0x67, 0x22, 0x6d, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x13, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x64,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e,
+	0x0a, 0x13, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x48, 0x61, 0x73, 0x68, 0x22, 0x27, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3e, 0x0a,
+	0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x22, 0x56, 0x0a,
+	0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x44, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x22, 0x5e, 0x0a, 0x19, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0d, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x0c, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x8e, 0x01, 0x0a, 0x1e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xaf, 0x01, 0x0a,
+	0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x43, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x94,
+	0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x46, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb3, 0x01, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x13, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x22, 0xa7, 0x02, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a,
+	0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x3b,
+	0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6d, 0x0a, 0x1b, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x4f, 0x0a, 0x1c, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0b, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42,
+	0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x73, 0x52,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x4b, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x67,
+	0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x51, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x16, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x0a, 0x17, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0x67, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4e, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x4e, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22,
+	0x1e, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x60, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x22, 0x9b, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x28, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c,
+	0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c,
+	0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x5c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6e, 0x0a,
+	0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5d, 0x0a,
+	0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0c,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xba, 0x02, 0x0a,
+	0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x09, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21,
+	0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22, 0xff, 0x01, 0x0a, 0x20, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4,
 	0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
 	0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x22, 0x4f, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x44, 0x73, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x34, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x67, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e,
-	0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x51,
-	0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0xad, 0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x11,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x10,
-	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x22, 0x3a, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x69, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0x67, 0x0a,
-	0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x4e, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0x1e, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x60, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09,
-	0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e,
-	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69,
-	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x6e, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73,
-	0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61,
-	0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x40, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x73, 0x22, 0xba, 0x02, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x49, 0x64, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x61, 0x79,
-	0x22, 0xff, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
-	0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49,
-	0x64, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x64, 0x65,
-	0x6c, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x44, 0x65, 0x6c,
-	0x61, 0x79, 0x32, 0xb9, 0x0f, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x6c, 0x0a, 0x06,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x61, 0x6e,
-	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x70, 0x0a, 0x04, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x20, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22, 0x43, 0x0a, 0x18, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0xca, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46,
+	0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x65, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x63, 0x0a,
+	0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xaf, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x48, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe9, 0x02, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x32,
+	0x0a, 0x15, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x47, 0x61, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x40,
+	0x0a, 0x0b, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x22, 0xa1, 0x03, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72,
+	0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x47, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64,
+	0x12, 0x72, 0x0a, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x65,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64,
+	0x46, 0x65, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61,
+	0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x32, 0xe5, 0x15, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x6c,
+	0x0a, 0x06, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x70, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
 	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12,
-	0x1b, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61,
-	0x2f, 0x7b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x8f, 0x01, 0x0a,
-	0x0a, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x62, 0x61,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1d, 0x12, 0x1b, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61,
+	0x74, 0x61, 0x2f, 0x7b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x8f,
+	0x01, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f,
+	0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f,
+	0x7b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d,
+	0x12, 0x99, 0x01, 0x0a, 0x0c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x12, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x61,
 	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c,
+	0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xb0, 0x01, 0x0a,
+	0x12, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
 	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31,
-	0x2f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64,
-	0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x99,
-	0x01, 0x0a, 0x0c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12,
-	0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x7e, 0x0a, 0x07, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x61, 0x6e,
+	0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0xba, 0x01, 0x0a, 0x14, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x62, 0x61, 0x6e,
 	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xa5, 0x01, 0x0a, 0x0f, 0x50,
-	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2b,
-	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x31, 0x12, 0x2f, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x7d, 0x12, 0x8d, 0x01, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x7d, 0x12, 0xa1, 0x01, 0x0a, 0x0a, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65,
-	0x72, 0x12, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76,
+	0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73,
+	0x2f, 0x7b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x7e, 0x0a, 0x07,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f,
+	0x7b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xa5, 0x01, 0x0a,
+	0x0f, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x12, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x7d, 0x12, 0x8d, 0x01, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64,
 	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a, 0x2f, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x2f,
-	0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x8c, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x61,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x7d, 0x12, 0xa1, 0x01, 0x0a, 0x0a, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x61,
 	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
-	0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x95, 0x01, 0x0a, 0x10, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2c, 0x2e, 0x62, 0x61, 0x6e,
+	0x72, 0x79, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a, 0x2f, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x8c, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x95, 0x01, 0x0a, 0x10, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2c, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x62, 0x61, 0x6e,
 	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
 	0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12,
-	0x1c, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x6c, 0x0a,
-	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x89, 0x01, 0x0a, 0x0d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x29, 0x2e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1e, 0x12, 0x1c, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x6c, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
 	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x86, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x89, 0x01,
+	0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
+	0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19,
+	0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x86, 0x01, 0x0a, 0x0c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x12, 0x9b, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82,
 	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76,
-	0x31, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73,
-	0x12, 0x9b, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x31, 0x2f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x97, 0x01, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a,
+	0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x88, 0x01, 0x0a, 0x0d, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x94, 0x01, 0x0a, 0x0f, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64,
 	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f,
-	0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0xb8,
-	0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76,
-	0x31, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x4f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x5c,
-	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x42, 0x61, 0x6e, 0x64,
-	0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x4f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22,
+	0x1b, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x69, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0xb8, 0x01, 0x0a,
+	0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61,
+	0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x4f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x4f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -14923,92 +21681,138 @@ func file_band_oracle_v1_query_proto_rawDescGZIP() []byte {
 	return file_band_oracle_v1_query_proto_rawDescData
 }
 
-var file_band_oracle_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_band_oracle_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_band_oracle_v1_query_proto_goTypes = []interface{}{
-	(*QueryCountsRequest)(nil),               // 0: band.oracle.v1.QueryCountsRequest
-	(*QueryCountsResponse)(nil),              // 1: band.oracle.v1.QueryCountsResponse
-	(*QueryDataRequest)(nil),                 // 2: band.oracle.v1.QueryDataRequest
-	(*QueryDataResponse)(nil),                // 3: band.oracle.v1.QueryDataResponse
-	(*QueryDataSourceRequest)(nil),           // 4: band.oracle.v1.QueryDataSourceRequest
-	(*QueryDataSourceResponse)(nil),          // 5: band.oracle.v1.QueryDataSourceResponse
-	(*QueryOracleScriptRequest)(nil),         // 6: band.oracle.v1.QueryOracleScriptRequest
-	(*QueryOracleScriptResponse)(nil),        // 7: band.oracle.v1.QueryOracleScriptResponse
-	(*QueryRequestRequest)(nil),              // 8: band.oracle.v1.QueryRequestRequest
-	(*QueryRequestResponse)(nil),             // 9: band.oracle.v1.QueryRequestResponse
-	(*QueryPendingRequestsRequest)(nil),      // 10: band.oracle.v1.QueryPendingRequestsRequest
-	(*QueryPendingRequestsResponse)(nil),     // 11: band.oracle.v1.QueryPendingRequestsResponse
-	(*QueryParamsRequest)(nil),               // 12: band.oracle.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),              // 13: band.oracle.v1.QueryParamsResponse
-	(*QueryValidatorRequest)(nil),            // 14: band.oracle.v1.QueryValidatorRequest
-	(*QueryValidatorResponse)(nil),           // 15: band.oracle.v1.QueryValidatorResponse
-	(*QueryIsReporterRequest)(nil),           // 16: band.oracle.v1.QueryIsReporterRequest
-	(*QueryIsReporterResponse)(nil),          // 17: band.oracle.v1.QueryIsReporterResponse
-	(*QueryReportersRequest)(nil),            // 18: band.oracle.v1.QueryReportersRequest
-	(*QueryReportersResponse)(nil),           // 19: band.oracle.v1.QueryReportersResponse
-	(*QueryActiveValidatorsRequest)(nil),     // 20: band.oracle.v1.QueryActiveValidatorsRequest
-	(*QueryActiveValidatorsResponse)(nil),    // 21: band.oracle.v1.QueryActiveValidatorsResponse
-	(*QueryRequestSearchRequest)(nil),        // 22: band.oracle.v1.QueryRequestSearchRequest
-	(*QueryRequestSearchResponse)(nil),       // 23: band.oracle.v1.QueryRequestSearchResponse
-	(*QueryRequestPriceRequest)(nil),         // 24: band.oracle.v1.QueryRequestPriceRequest
-	(*QueryRequestPriceResponse)(nil),        // 25: band.oracle.v1.QueryRequestPriceResponse
-	(*QueryRequestVerificationRequest)(nil),  // 26: band.oracle.v1.QueryRequestVerificationRequest
-	(*QueryRequestVerificationResponse)(nil), // 27: band.oracle.v1.QueryRequestVerificationResponse
-	(*DataSource)(nil),                       // 28: band.oracle.v1.DataSource
-	(*OracleScript)(nil),                     // 29: band.oracle.v1.OracleScript
-	(*Request)(nil),                          // 30: band.oracle.v1.Request
-	(*Report)(nil),                           // 31: band.oracle.v1.Report
-	(*Result)(nil),                           // 32: band.oracle.v1.Result
-	(*SigningResult)(nil),                    // 33: band.oracle.v1.SigningResult
-	(*Params)(nil),                           // 34: band.oracle.v1.Params
-	(*ValidatorStatus)(nil),                  // 35: band.oracle.v1.ValidatorStatus
-	(*ActiveValidator)(nil),                  // 36: band.oracle.v1.ActiveValidator
-	(*PriceResult)(nil),                      // 37: band.oracle.v1.PriceResult
+	(*QueryCountsRequest)(nil),                // 0: band.oracle.v1.QueryCountsRequest
+	(*QueryCountsResponse)(nil),               // 1: band.oracle.v1.QueryCountsResponse
+	(*QueryDataRequest)(nil),                  // 2: band.oracle.v1.QueryDataRequest
+	(*QueryDataResponse)(nil),                 // 3: band.oracle.v1.QueryDataResponse
+	(*QueryDataSourceRequest)(nil),            // 4: band.oracle.v1.QueryDataSourceRequest
+	(*QueryDataSourceResponse)(nil),           // 5: band.oracle.v1.QueryDataSourceResponse
+	(*QueryOracleScriptRequest)(nil),          // 6: band.oracle.v1.QueryOracleScriptRequest
+	(*QueryOracleScriptResponse)(nil),         // 7: band.oracle.v1.QueryOracleScriptResponse
+	(*QueryDataSourceVersionsRequest)(nil),    // 8: band.oracle.v1.QueryDataSourceVersionsRequest
+	(*QueryDataSourceVersionsResponse)(nil),   // 9: band.oracle.v1.QueryDataSourceVersionsResponse
+	(*QueryOracleScriptVersionsRequest)(nil),  // 10: band.oracle.v1.QueryOracleScriptVersionsRequest
+	(*QueryOracleScriptVersionsResponse)(nil), // 11: band.oracle.v1.QueryOracleScriptVersionsResponse
+	(*QueryRequestRequest)(nil),               // 12: band.oracle.v1.QueryRequestRequest
+	(*QueryRequestResponse)(nil),              // 13: band.oracle.v1.QueryRequestResponse
+	(*QueryPendingRequestsRequest)(nil),       // 14: band.oracle.v1.QueryPendingRequestsRequest
+	(*QueryPendingRequestsResponse)(nil),      // 15: band.oracle.v1.QueryPendingRequestsResponse
+	(*QueryParamsRequest)(nil),                // 16: band.oracle.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),               // 17: band.oracle.v1.QueryParamsResponse
+	(*QueryValidatorRequest)(nil),             // 18: band.oracle.v1.QueryValidatorRequest
+	(*QueryValidatorResponse)(nil),            // 19: band.oracle.v1.QueryValidatorResponse
+	(*QueryIsReporterRequest)(nil),            // 20: band.oracle.v1.QueryIsReporterRequest
+	(*QueryIsReporterResponse)(nil),           // 21: band.oracle.v1.QueryIsReporterResponse
+	(*QueryReportersRequest)(nil),             // 22: band.oracle.v1.QueryReportersRequest
+	(*QueryReportersResponse)(nil),            // 23: band.oracle.v1.QueryReportersResponse
+	(*QueryActiveValidatorsRequest)(nil),      // 24: band.oracle.v1.QueryActiveValidatorsRequest
+	(*QueryActiveValidatorsResponse)(nil),     // 25: band.oracle.v1.QueryActiveValidatorsResponse
+	(*QueryRequestSearchRequest)(nil),         // 26: band.oracle.v1.QueryRequestSearchRequest
+	(*QueryRequestSearchResponse)(nil),        // 27: band.oracle.v1.QueryRequestSearchResponse
+	(*QueryRequestPriceRequest)(nil),          // 28: band.oracle.v1.QueryRequestPriceRequest
+	(*QueryRequestPriceResponse)(nil),         // 29: band.oracle.v1.QueryRequestPriceResponse
+	(*QueryRequestVerificationRequest)(nil),   // 30: band.oracle.v1.QueryRequestVerificationRequest
+	(*QueryRequestVerificationResponse)(nil),  // 31: band.oracle.v1.QueryRequestVerificationResponse
+	(*QuerySubscriptionRequest)(nil),          // 32: band.oracle.v1.QuerySubscriptionRequest
+	(*QuerySubscriptionResponse)(nil),         // 33: band.oracle.v1.QuerySubscriptionResponse
+	(*QuerySubscriptionsRequest)(nil),         // 34: band.oracle.v1.QuerySubscriptionsRequest
+	(*QuerySubscriptionsResponse)(nil),        // 35: band.oracle.v1.QuerySubscriptionsResponse
+	(*QuerySimulateRequestRequest)(nil),       // 36: band.oracle.v1.QuerySimulateRequestRequest
+	(*QuerySimulateRequestResponse)(nil),      // 37: band.oracle.v1.QuerySimulateRequestResponse
+	(*DataSource)(nil),                        // 38: band.oracle.v1.DataSource
+	(*OracleScript)(nil),                      // 39: band.oracle.v1.OracleScript
+	(*v1beta1.PageRequest)(nil),               // 40: cosmos.base.query.v1beta1.PageRequest
+	(*DataSourceVersion)(nil),                 // 41: band.oracle.v1.DataSourceVersion
+	(*v1beta1.PageResponse)(nil),              // 42: cosmos.base.query.v1beta1.PageResponse
+	(*OracleScriptVersion)(nil),               // 43: band.oracle.v1.OracleScriptVersion
+	(*Request)(nil),                           // 44: band.oracle.v1.Request
+	(*Report)(nil),                            // 45: band.oracle.v1.Report
+	(*Result)(nil),                            // 46: band.oracle.v1.Result
+	(*SigningResult)(nil),                     // 47: band.oracle.v1.SigningResult
+	(*RequestVersions)(nil),                   // 48: band.oracle.v1.RequestVersions
+	(*Params)(nil),                            // 49: band.oracle.v1.Params
+	(*ValidatorStatus)(nil),                   // 50: band.oracle.v1.ValidatorStatus
+	(*ActiveValidator)(nil),                   // 51: band.oracle.v1.ActiveValidator
+	(*PriceResult)(nil),                       // 52: band.oracle.v1.PriceResult
+	(*Subscription)(nil),                      // 53: band.oracle.v1.Subscription
+	(*v1beta11.Coin)(nil),                     // 54: cosmos.base.v1beta1.Coin
+	(*RawReport)(nil),                         // 55: band.oracle.v1.RawReport
+	(*RawRequest)(nil),                        // 56: band.oracle.v1.RawRequest
+	(ResolveStatus)(0),                        // 57: band.oracle.v1.ResolveStatus
 }
 var file_band_oracle_v1_query_proto_depIdxs = []int32{
-	28, // 0: band.oracle.v1.QueryDataSourceResponse.data_source:type_name -> band.oracle.v1.DataSource
-	29, // 1: band.oracle.v1.QueryOracleScriptResponse.oracle_script:type_name -> band.oracle.v1.OracleScript
-	30, // 2: band.oracle.v1.QueryRequestResponse.request:type_name -> band.oracle.v1.Request
-	31, // 3: band.oracle.v1.QueryRequestResponse.reports:type_name -> band.oracle.v1.Report
-	32, // 4: band.oracle.v1.QueryRequestResponse.result:type_name -> band.oracle.v1.Result
-	33, // 5: band.oracle.v1.QueryRequestResponse.signing:type_name -> band.oracle.v1.SigningResult
-	34, // 6: band.oracle.v1.QueryParamsResponse.params:type_name -> band.oracle.v1.Params
-	35, // 7: band.oracle.v1.QueryValidatorResponse.status:type_name -> band.oracle.v1.ValidatorStatus
-	36, // 8: band.oracle.v1.QueryActiveValidatorsResponse.validators:type_name -> band.oracle.v1.ActiveValidator
-	9,  // 9: band.oracle.v1.QueryRequestSearchResponse.request:type_name -> band.oracle.v1.QueryRequestResponse
-	37, // 10: band.oracle.v1.QueryRequestPriceResponse.price_results:type_name -> band.oracle.v1.PriceResult
-	0,  // 11: band.oracle.v1.Query.Counts:input_type -> band.oracle.v1.QueryCountsRequest
-	2,  // 12: band.oracle.v1.Query.Data:input_type -> band.oracle.v1.QueryDataRequest
-	4,  // 13: band.oracle.v1.Query.DataSource:input_type -> band.oracle.v1.QueryDataSourceRequest
-	6,  // 14: band.oracle.v1.Query.OracleScript:input_type -> band.oracle.v1.QueryOracleScriptRequest
-	8,  // 15: band.oracle.v1.Query.Request:input_type -> band.oracle.v1.QueryRequestRequest
-	10, // 16: band.oracle.v1.Query.PendingRequests:input_type -> band.oracle.v1.QueryPendingRequestsRequest
-	14, // 17: band.oracle.v1.Query.Validator:input_type -> band.oracle.v1.QueryValidatorRequest
-	16, // 18: band.oracle.v1.Query.IsReporter:input_type -> band.oracle.v1.QueryIsReporterRequest
-	18, // 19: band.oracle.v1.Query.Reporters:input_type -> band.oracle.v1.QueryReportersRequest
-	20, // 20: band.oracle.v1.Query.ActiveValidators:input_type -> band.oracle.v1.QueryActiveValidatorsRequest
-	12, // 21: band.oracle.v1.Query.Params:input_type -> band.oracle.v1.QueryParamsRequest
-	22, // 22: band.oracle.v1.Query.RequestSearch:input_type -> band.oracle.v1.QueryRequestSearchRequest
-	24, // 23: band.oracle.v1.Query.RequestPrice:input_type -> band.oracle.v1.QueryRequestPriceRequest
-	26, // 24: band.oracle.v1.Query.RequestVerification:input_type -> band.oracle.v1.QueryRequestVerificationRequest
-	1,  // 25: band.oracle.v1.Query.Counts:output_type -> band.oracle.v1.QueryCountsResponse
-	3,  // 26: band.oracle.v1.Query.Data:output_type -> band.oracle.v1.QueryDataResponse
-	5,  // 27: band.oracle.v1.Query.DataSource:output_type -> band.oracle.v1.QueryDataSourceResponse
-	7,  // 28: band.oracle.v1.Query.OracleScript:output_type -> band.oracle.v1.QueryOracleScriptResponse
-	9,  // 29: band.oracle.v1.Query.Request:output_type -> band.oracle.v1.QueryRequestResponse
-	11, // 30: band.oracle.v1.Query.PendingRequests:output_type -> band.oracle.v1.QueryPendingRequestsResponse
-	15, // 31: band.oracle.v1.Query.Validator:output_type -> band.oracle.v1.QueryValidatorResponse
-	17, // 32: band.oracle.v1.Query.IsReporter:output_type -> band.oracle.v1.QueryIsReporterResponse
-	19, // 33: band.oracle.v1.Query.Reporters:output_type -> band.oracle.v1.QueryReportersResponse
-	21, // 34: band.oracle.v1.Query.ActiveValidators:output_type -> band.oracle.v1.QueryActiveValidatorsResponse
-	13, // 35: band.oracle.v1.Query.Params:output_type -> band.oracle.v1.QueryParamsResponse
-	23, // 36: band.oracle.v1.Query.RequestSearch:output_type -> band.oracle.v1.QueryRequestSearchResponse
-	25, // 37: band.oracle.v1.Query.RequestPrice:output_type -> band.oracle.v1.QueryRequestPriceResponse
-	27, // 38: band.oracle.v1.Query.RequestVerification:output_type -> band.oracle.v1.QueryRequestVerificationResponse
-	25, // [25:39] is the sub-list for method output_type
-	11, // [11:25] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	38, // 0: band.oracle.v1.QueryDataSourceResponse.data_source:type_name -> band.oracle.v1.DataSource
+	39, // 1: band.oracle.v1.QueryOracleScriptResponse.oracle_script:type_name -> band.oracle.v1.OracleScript
+	40, // 2: band.oracle.v1.QueryDataSourceVersionsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	41, // 3: band.oracle.v1.QueryDataSourceVersionsResponse.versions:type_name -> band.oracle.v1.DataSourceVersion
+	42, // 4: band.oracle.v1.QueryDataSourceVersionsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	40, // 5: band.oracle.v1.QueryOracleScriptVersionsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	43, // 6: band.oracle.v1.QueryOracleScriptVersionsResponse.versions:type_name -> band.oracle.v1.OracleScriptVersion
+	42, // 7: band.oracle.v1.QueryOracleScriptVersionsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	44, // 8: band.oracle.v1.QueryRequestResponse.request:type_name -> band.oracle.v1.Request
+	45, // 9: band.oracle.v1.QueryRequestResponse.reports:type_name -> band.oracle.v1.Report
+	46, // 10: band.oracle.v1.QueryRequestResponse.result:type_name -> band.oracle.v1.Result
+	47, // 11: band.oracle.v1.QueryRequestResponse.signing:type_name -> band.oracle.v1.SigningResult
+	48, // 12: band.oracle.v1.QueryRequestResponse.versions:type_name -> band.oracle.v1.RequestVersions
+	49, // 13: band.oracle.v1.QueryParamsResponse.params:type_name -> band.oracle.v1.Params
+	50, // 14: band.oracle.v1.QueryValidatorResponse.status:type_name -> band.oracle.v1.ValidatorStatus
+	51, // 15: band.oracle.v1.QueryActiveValidatorsResponse.validators:type_name -> band.oracle.v1.ActiveValidator
+	13, // 16: band.oracle.v1.QueryRequestSearchResponse.request:type_name -> band.oracle.v1.QueryRequestResponse
+	52, // 17: band.oracle.v1.QueryRequestPriceResponse.price_results:type_name -> band.oracle.v1.PriceResult
+	53, // 18: band.oracle.v1.QuerySubscriptionResponse.subscription:type_name -> band.oracle.v1.Subscription
+	54, // 19: band.oracle.v1.QuerySubscriptionResponse.balance:type_name -> cosmos.base.v1beta1.Coin
+	40, // 20: band.oracle.v1.QuerySubscriptionsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	53, // 21: band.oracle.v1.QuerySubscriptionsResponse.subscriptions:type_name -> band.oracle.v1.Subscription
+	42, // 22: band.oracle.v1.QuerySubscriptionsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	55, // 23: band.oracle.v1.QuerySimulateRequestRequest.raw_reports:type_name -> band.oracle.v1.RawReport
+	56, // 24: band.oracle.v1.QuerySimulateRequestResponse.raw_requests:type_name -> band.oracle.v1.RawRequest
+	54, // 25: band.oracle.v1.QuerySimulateRequestResponse.estimated_fees:type_name -> cosmos.base.v1beta1.Coin
+	57, // 26: band.oracle.v1.QuerySimulateRequestResponse.resolve_status:type_name -> band.oracle.v1.ResolveStatus
+	0,  // 27: band.oracle.v1.Query.Counts:input_type -> band.oracle.v1.QueryCountsRequest
+	2,  // 28: band.oracle.v1.Query.Data:input_type -> band.oracle.v1.QueryDataRequest
+	4,  // 29: band.oracle.v1.Query.DataSource:input_type -> band.oracle.v1.QueryDataSourceRequest
+	6,  // 30: band.oracle.v1.Query.OracleScript:input_type -> band.oracle.v1.QueryOracleScriptRequest
+	8,  // 31: band.oracle.v1.Query.DataSourceVersions:input_type -> band.oracle.v1.QueryDataSourceVersionsRequest
+	10, // 32: band.oracle.v1.Query.OracleScriptVersions:input_type -> band.oracle.v1.QueryOracleScriptVersionsRequest
+	12, // 33: band.oracle.v1.Query.Request:input_type -> band.oracle.v1.QueryRequestRequest
+	14, // 34: band.oracle.v1.Query.PendingRequests:input_type -> band.oracle.v1.QueryPendingRequestsRequest
+	18, // 35: band.oracle.v1.Query.Validator:input_type -> band.oracle.v1.QueryValidatorRequest
+	20, // 36: band.oracle.v1.Query.IsReporter:input_type -> band.oracle.v1.QueryIsReporterRequest
+	22, // 37: band.oracle.v1.Query.Reporters:input_type -> band.oracle.v1.QueryReportersRequest
+	24, // 38: band.oracle.v1.Query.ActiveValidators:input_type -> band.oracle.v1.QueryActiveValidatorsRequest
+	16, // 39: band.oracle.v1.Query.Params:input_type -> band.oracle.v1.QueryParamsRequest
+	26, // 40: band.oracle.v1.Query.RequestSearch:input_type -> band.oracle.v1.QueryRequestSearchRequest
+	28, // 41: band.oracle.v1.Query.RequestPrice:input_type -> band.oracle.v1.QueryRequestPriceRequest
+	30, // 42: band.oracle.v1.Query.RequestVerification:input_type -> band.oracle.v1.QueryRequestVerificationRequest
+	32, // 43: band.oracle.v1.Query.Subscription:input_type -> band.oracle.v1.QuerySubscriptionRequest
+	34, // 44: band.oracle.v1.Query.Subscriptions:input_type -> band.oracle.v1.QuerySubscriptionsRequest
+	36, // 45: band.oracle.v1.Query.SimulateRequest:input_type -> band.oracle.v1.QuerySimulateRequestRequest
+	1,  // 46: band.oracle.v1.Query.Counts:output_type -> band.oracle.v1.QueryCountsResponse
+	3,  // 47: band.oracle.v1.Query.Data:output_type -> band.oracle.v1.QueryDataResponse
+	5,  // 48: band.oracle.v1.Query.DataSource:output_type -> band.oracle.v1.QueryDataSourceResponse
+	7,  // 49: band.oracle.v1.Query.OracleScript:output_type -> band.oracle.v1.QueryOracleScriptResponse
+	9,  // 50: band.oracle.v1.Query.DataSourceVersions:output_type -> band.oracle.v1.QueryDataSourceVersionsResponse
+	11, // 51: band.oracle.v1.Query.OracleScriptVersions:output_type -> band.oracle.v1.QueryOracleScriptVersionsResponse
+	13, // 52: band.oracle.v1.Query.Request:output_type -> band.oracle.v1.QueryRequestResponse
+	15, // 53: band.oracle.v1.Query.PendingRequests:output_type -> band.oracle.v1.QueryPendingRequestsResponse
+	19, // 54: band.oracle.v1.Query.Validator:output_type -> band.oracle.v1.QueryValidatorResponse
+	21, // 55: band.oracle.v1.Query.IsReporter:output_type -> band.oracle.v1.QueryIsReporterResponse
+	23, // 56: band.oracle.v1.Query.Reporters:output_type -> band.oracle.v1.QueryReportersResponse
+	25, // 57: band.oracle.v1.Query.ActiveValidators:output_type -> band.oracle.v1.QueryActiveValidatorsResponse
+	17, // 58: band.oracle.v1.Query.Params:output_type -> band.oracle.v1.QueryParamsResponse
+	27, // 59: band.oracle.v1.Query.RequestSearch:output_type -> band.oracle.v1.QueryRequestSearchResponse
+	29, // 60: band.oracle.v1.Query.RequestPrice:output_type -> band.oracle.v1.QueryRequestPriceResponse
+	31, // 61: band.oracle.v1.Query.RequestVerification:output_type -> band.oracle.v1.QueryRequestVerificationResponse
+	33, // 62: band.oracle.v1.Query.Subscription:output_type -> band.oracle.v1.QuerySubscriptionResponse
+	35, // 63: band.oracle.v1.Query.Subscriptions:output_type -> band.oracle.v1.QuerySubscriptionsResponse
+	37, // 64: band.oracle.v1.Query.SimulateRequest:output_type -> band.oracle.v1.QuerySimulateRequestResponse
+	46, // [46:65] is the sub-list for method output_type
+	27, // [27:46] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_band_oracle_v1_query_proto_init() }
@@ -15115,7 +21919,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequestRequest); i {
+			switch v := v.(*QueryDataSourceVersionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15127,7 +21931,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequestResponse); i {
+			switch v := v.(*QueryDataSourceVersionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15139,7 +21943,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryPendingRequestsRequest); i {
+			switch v := v.(*QueryOracleScriptVersionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15151,7 +21955,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryPendingRequestsResponse); i {
+			switch v := v.(*QueryOracleScriptVersionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15163,7 +21967,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsRequest); i {
+			switch v := v.(*QueryRequestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15175,7 +21979,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsResponse); i {
+			switch v := v.(*QueryRequestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15187,7 +21991,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryValidatorRequest); i {
+			switch v := v.(*QueryPendingRequestsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15199,7 +22003,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryValidatorResponse); i {
+			switch v := v.(*QueryPendingRequestsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15211,7 +22015,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryIsReporterRequest); i {
+			switch v := v.(*QueryParamsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15223,7 +22027,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryIsReporterResponse); i {
+			switch v := v.(*QueryParamsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15235,7 +22039,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryReportersRequest); i {
+			switch v := v.(*QueryValidatorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15247,7 +22051,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryReportersResponse); i {
+			switch v := v.(*QueryValidatorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15259,7 +22063,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryActiveValidatorsRequest); i {
+			switch v := v.(*QueryIsReporterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15271,7 +22075,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryActiveValidatorsResponse); i {
+			switch v := v.(*QueryIsReporterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15283,7 +22087,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequestSearchRequest); i {
+			switch v := v.(*QueryReportersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15295,7 +22099,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequestSearchResponse); i {
+			switch v := v.(*QueryReportersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15307,7 +22111,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequestPriceRequest); i {
+			switch v := v.(*QueryActiveValidatorsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15319,7 +22123,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequestPriceResponse); i {
+			switch v := v.(*QueryActiveValidatorsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15331,7 +22135,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequestVerificationRequest); i {
+			switch v := v.(*QueryRequestSearchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15343,6 +22147,54 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequestSearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequestPriceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequestPriceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequestVerificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryRequestVerificationResponse); i {
 			case 0:
 				return &v.state
@@ -15354,6 +22206,78 @@ func file_band_oracle_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_band_oracle_v1_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySubscriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySubscriptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySubscriptionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySimulateRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySimulateRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -15361,7 +22285,7 @@ func file_band_oracle_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_oracle_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
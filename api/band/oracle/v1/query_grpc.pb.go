@@ -19,20 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Counts_FullMethodName              = "/band.oracle.v1.Query/Counts"
-	Query_Data_FullMethodName                = "/band.oracle.v1.Query/Data"
-	Query_DataSource_FullMethodName          = "/band.oracle.v1.Query/DataSource"
-	Query_OracleScript_FullMethodName        = "/band.oracle.v1.Query/OracleScript"
-	Query_Request_FullMethodName             = "/band.oracle.v1.Query/Request"
-	Query_PendingRequests_FullMethodName     = "/band.oracle.v1.Query/PendingRequests"
-	Query_Validator_FullMethodName           = "/band.oracle.v1.Query/Validator"
-	Query_IsReporter_FullMethodName          = "/band.oracle.v1.Query/IsReporter"
-	Query_Reporters_FullMethodName           = "/band.oracle.v1.Query/Reporters"
-	Query_ActiveValidators_FullMethodName    = "/band.oracle.v1.Query/ActiveValidators"
-	Query_Params_FullMethodName              = "/band.oracle.v1.Query/Params"
-	Query_RequestSearch_FullMethodName       = "/band.oracle.v1.Query/RequestSearch"
-	Query_RequestPrice_FullMethodName        = "/band.oracle.v1.Query/RequestPrice"
-	Query_RequestVerification_FullMethodName = "/band.oracle.v1.Query/RequestVerification"
+	Query_Counts_FullMethodName               = "/band.oracle.v1.Query/Counts"
+	Query_Data_FullMethodName                 = "/band.oracle.v1.Query/Data"
+	Query_DataSource_FullMethodName           = "/band.oracle.v1.Query/DataSource"
+	Query_OracleScript_FullMethodName         = "/band.oracle.v1.Query/OracleScript"
+	Query_DataSourceVersions_FullMethodName   = "/band.oracle.v1.Query/DataSourceVersions"
+	Query_OracleScriptVersions_FullMethodName = "/band.oracle.v1.Query/OracleScriptVersions"
+	Query_Request_FullMethodName              = "/band.oracle.v1.Query/Request"
+	Query_PendingRequests_FullMethodName      = "/band.oracle.v1.Query/PendingRequests"
+	Query_Validator_FullMethodName            = "/band.oracle.v1.Query/Validator"
+	Query_IsReporter_FullMethodName           = "/band.oracle.v1.Query/IsReporter"
+	Query_Reporters_FullMethodName            = "/band.oracle.v1.Query/Reporters"
+	Query_ActiveValidators_FullMethodName     = "/band.oracle.v1.Query/ActiveValidators"
+	Query_Params_FullMethodName               = "/band.oracle.v1.Query/Params"
+	Query_RequestSearch_FullMethodName        = "/band.oracle.v1.Query/RequestSearch"
+	Query_RequestPrice_FullMethodName         = "/band.oracle.v1.Query/RequestPrice"
+	Query_RequestVerification_FullMethodName  = "/band.oracle.v1.Query/RequestVerification"
+	Query_Subscription_FullMethodName         = "/band.oracle.v1.Query/Subscription"
+	Query_Subscriptions_FullMethodName        = "/band.oracle.v1.Query/Subscriptions"
+	Query_SimulateRequest_FullMethodName      = "/band.oracle.v1.Query/SimulateRequest"
 )
 
 // QueryClient is the client API for Query service.
@@ -49,6 +54,10 @@ type QueryClient interface {
 	DataSource(ctx context.Context, in *QueryDataSourceRequest, opts ...grpc.CallOption) (*QueryDataSourceResponse, error)
 	// OracleScript queries oracle script summary info for given oracle script id.
 	OracleScript(ctx context.Context, in *QueryOracleScriptRequest, opts ...grpc.CallOption) (*QueryOracleScriptResponse, error)
+	// DataSourceVersions queries the version history of a data source.
+	DataSourceVersions(ctx context.Context, in *QueryDataSourceVersionsRequest, opts ...grpc.CallOption) (*QueryDataSourceVersionsResponse, error)
+	// OracleScriptVersions queries the version history of an oracle script.
+	OracleScriptVersions(ctx context.Context, in *QueryOracleScriptVersionsRequest, opts ...grpc.CallOption) (*QueryOracleScriptVersionsResponse, error)
 	// Request queries request info for given request id.
 	Request(ctx context.Context, in *QueryRequestRequest, opts ...grpc.CallOption) (*QueryRequestResponse, error)
 	// PendingRequests queries list of pending request IDs assigned to given
@@ -72,6 +81,14 @@ type QueryClient interface {
 	// RequestVerification verifies a request to make sure that
 	// all information that will be used to report the data is valid
 	RequestVerification(ctx context.Context, in *QueryRequestVerificationRequest, opts ...grpc.CallOption) (*QueryRequestVerificationResponse, error)
+	// Subscription queries a subscription and its funding balance for given
+	// subscription id.
+	Subscription(ctx context.Context, in *QuerySubscriptionRequest, opts ...grpc.CallOption) (*QuerySubscriptionResponse, error)
+	// Subscriptions queries all subscriptions.
+	Subscriptions(ctx context.Context, in *QuerySubscriptionsRequest, opts ...grpc.CallOption) (*QuerySubscriptionsResponse, error)
+	// SimulateRequest runs the prepare and execute phases of an oracle script
+	// against the current state without creating a request.
+	SimulateRequest(ctx context.Context, in *QuerySimulateRequestRequest, opts ...grpc.CallOption) (*QuerySimulateRequestResponse, error)
 }
 
 type queryClient struct {
@@ -118,6 +135,24 @@ func (c *queryClient) OracleScript(ctx context.Context, in *QueryOracleScriptReq
 	return out, nil
 }
 
+func (c *queryClient) DataSourceVersions(ctx context.Context, in *QueryDataSourceVersionsRequest, opts ...grpc.CallOption) (*QueryDataSourceVersionsResponse, error) {
+	out := new(QueryDataSourceVersionsResponse)
+	err := c.cc.Invoke(ctx, Query_DataSourceVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OracleScriptVersions(ctx context.Context, in *QueryOracleScriptVersionsRequest, opts ...grpc.CallOption) (*QueryOracleScriptVersionsResponse, error) {
+	out := new(QueryOracleScriptVersionsResponse)
+	err := c.cc.Invoke(ctx, Query_OracleScriptVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Request(ctx context.Context, in *QueryRequestRequest, opts ...grpc.CallOption) (*QueryRequestResponse, error) {
 	out := new(QueryRequestResponse)
 	err := c.cc.Invoke(ctx, Query_Request_FullMethodName, in, out, opts...)
@@ -208,6 +243,33 @@ func (c *queryClient) RequestVerification(ctx context.Context, in *QueryRequestV
 	return out, nil
 }
 
+func (c *queryClient) Subscription(ctx context.Context, in *QuerySubscriptionRequest, opts ...grpc.CallOption) (*QuerySubscriptionResponse, error) {
+	out := new(QuerySubscriptionResponse)
+	err := c.cc.Invoke(ctx, Query_Subscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Subscriptions(ctx context.Context, in *QuerySubscriptionsRequest, opts ...grpc.CallOption) (*QuerySubscriptionsResponse, error) {
+	out := new(QuerySubscriptionsResponse)
+	err := c.cc.Invoke(ctx, Query_Subscriptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateRequest(ctx context.Context, in *QuerySimulateRequestRequest, opts ...grpc.CallOption) (*QuerySimulateRequestResponse, error) {
+	out := new(QuerySimulateRequestResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -222,6 +284,10 @@ type QueryServer interface {
 	DataSource(context.Context, *QueryDataSourceRequest) (*QueryDataSourceResponse, error)
 	// OracleScript queries oracle script summary info for given oracle script id.
 	OracleScript(context.Context, *QueryOracleScriptRequest) (*QueryOracleScriptResponse, error)
+	// DataSourceVersions queries the version history of a data source.
+	DataSourceVersions(context.Context, *QueryDataSourceVersionsRequest) (*QueryDataSourceVersionsResponse, error)
+	// OracleScriptVersions queries the version history of an oracle script.
+	OracleScriptVersions(context.Context, *QueryOracleScriptVersionsRequest) (*QueryOracleScriptVersionsResponse, error)
 	// Request queries request info for given request id.
 	Request(context.Context, *QueryRequestRequest) (*QueryRequestResponse, error)
 	// PendingRequests queries list of pending request IDs assigned to given
@@ -245,6 +311,14 @@ type QueryServer interface {
 	// RequestVerification verifies a request to make sure that
 	// all information that will be used to report the data is valid
 	RequestVerification(context.Context, *QueryRequestVerificationRequest) (*QueryRequestVerificationResponse, error)
+	// Subscription queries a subscription and its funding balance for given
+	// subscription id.
+	Subscription(context.Context, *QuerySubscriptionRequest) (*QuerySubscriptionResponse, error)
+	// Subscriptions queries all subscriptions.
+	Subscriptions(context.Context, *QuerySubscriptionsRequest) (*QuerySubscriptionsResponse, error)
+	// SimulateRequest runs the prepare and execute phases of an oracle script
+	// against the current state without creating a request.
+	SimulateRequest(context.Context, *QuerySimulateRequestRequest) (*QuerySimulateRequestResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -264,6 +338,12 @@ func (UnimplementedQueryServer) DataSource(context.Context, *QueryDataSourceRequ
 func (UnimplementedQueryServer) OracleScript(context.Context, *QueryOracleScriptRequest) (*QueryOracleScriptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OracleScript not implemented")
 }
+func (UnimplementedQueryServer) DataSourceVersions(context.Context, *QueryDataSourceVersionsRequest) (*QueryDataSourceVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataSourceVersions not implemented")
+}
+func (UnimplementedQueryServer) OracleScriptVersions(context.Context, *QueryOracleScriptVersionsRequest) (*QueryOracleScriptVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleScriptVersions not implemented")
+}
 func (UnimplementedQueryServer) Request(context.Context, *QueryRequestRequest) (*QueryRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Request not implemented")
 }
@@ -294,6 +374,15 @@ func (UnimplementedQueryServer) RequestPrice(context.Context, *QueryRequestPrice
 func (UnimplementedQueryServer) RequestVerification(context.Context, *QueryRequestVerificationRequest) (*QueryRequestVerificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestVerification not implemented")
 }
+func (UnimplementedQueryServer) Subscription(context.Context, *QuerySubscriptionRequest) (*QuerySubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subscription not implemented")
+}
+func (UnimplementedQueryServer) Subscriptions(context.Context, *QuerySubscriptionsRequest) (*QuerySubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subscriptions not implemented")
+}
+func (UnimplementedQueryServer) SimulateRequest(context.Context, *QuerySimulateRequestRequest) (*QuerySimulateRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateRequest not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -379,6 +468,42 @@ func _Query_OracleScript_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DataSourceVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataSourceVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataSourceVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DataSourceVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataSourceVersions(ctx, req.(*QueryDataSourceVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OracleScriptVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleScriptVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleScriptVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OracleScriptVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleScriptVersions(ctx, req.(*QueryOracleScriptVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Request_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRequestRequest)
 	if err := dec(in); err != nil {
@@ -559,6 +684,60 @@ func _Query_RequestVerification_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Subscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Subscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Subscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Subscription(ctx, req.(*QuerySubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Subscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Subscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Subscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Subscriptions(ctx, req.(*QuerySubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateRequest(ctx, req.(*QuerySimulateRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -582,6 +761,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "OracleScript",
 			Handler:    _Query_OracleScript_Handler,
 		},
+		{
+			MethodName: "DataSourceVersions",
+			Handler:    _Query_DataSourceVersions_Handler,
+		},
+		{
+			MethodName: "OracleScriptVersions",
+			Handler:    _Query_OracleScriptVersions_Handler,
+		},
 		{
 			MethodName: "Request",
 			Handler:    _Query_Request_Handler,
@@ -622,6 +809,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestVerification",
 			Handler:    _Query_RequestVerification_Handler,
 		},
+		{
+			MethodName: "Subscription",
+			Handler:    _Query_Subscription_Handler,
+		},
+		{
+			MethodName: "Subscriptions",
+			Handler:    _Query_Subscriptions_Handler,
+		},
+		{
+			MethodName: "SimulateRequest",
+			Handler:    _Query_SimulateRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/oracle/v1/query.proto",
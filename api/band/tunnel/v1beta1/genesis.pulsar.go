@@ -115,13 +115,117 @@ func (x *_GenesisState_4_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*FeePayerTopUp
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeePayerTopUp)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeePayerTopUp)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(FeePayerTopUp)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(FeePayerTopUp)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*TunnelOperator
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TunnelOperator)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TunnelOperator)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(TunnelOperator)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(TunnelOperator)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState              protoreflect.MessageDescriptor
-	fd_GenesisState_params       protoreflect.FieldDescriptor
-	fd_GenesisState_tunnel_count protoreflect.FieldDescriptor
-	fd_GenesisState_tunnels      protoreflect.FieldDescriptor
-	fd_GenesisState_deposits     protoreflect.FieldDescriptor
-	fd_GenesisState_total_fees   protoreflect.FieldDescriptor
+	md_GenesisState                   protoreflect.MessageDescriptor
+	fd_GenesisState_params            protoreflect.FieldDescriptor
+	fd_GenesisState_tunnel_count      protoreflect.FieldDescriptor
+	fd_GenesisState_tunnels           protoreflect.FieldDescriptor
+	fd_GenesisState_deposits          protoreflect.FieldDescriptor
+	fd_GenesisState_total_fees        protoreflect.FieldDescriptor
+	fd_GenesisState_fee_payer_top_ups protoreflect.FieldDescriptor
+	fd_GenesisState_tunnel_operators  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -132,6 +236,8 @@ func init() {
 	fd_GenesisState_tunnels = md_GenesisState.Fields().ByName("tunnels")
 	fd_GenesisState_deposits = md_GenesisState.Fields().ByName("deposits")
 	fd_GenesisState_total_fees = md_GenesisState.Fields().ByName("total_fees")
+	fd_GenesisState_fee_payer_top_ups = md_GenesisState.Fields().ByName("fee_payer_top_ups")
+	fd_GenesisState_tunnel_operators = md_GenesisState.Fields().ByName("tunnel_operators")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -229,6 +335,18 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.FeePayerTopUps) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.FeePayerTopUps})
+		if !f(fd_GenesisState_fee_payer_top_ups, value) {
+			return
+		}
+	}
+	if len(x.TunnelOperators) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.TunnelOperators})
+		if !f(fd_GenesisState_tunnel_operators, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -254,6 +372,10 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.Deposits) != 0
 	case "band.tunnel.v1beta1.GenesisState.total_fees":
 		return x.TotalFees != nil
+	case "band.tunnel.v1beta1.GenesisState.fee_payer_top_ups":
+		return len(x.FeePayerTopUps) != 0
+	case "band.tunnel.v1beta1.GenesisState.tunnel_operators":
+		return len(x.TunnelOperators) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.GenesisState"))
@@ -280,6 +402,10 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Deposits = nil
 	case "band.tunnel.v1beta1.GenesisState.total_fees":
 		x.TotalFees = nil
+	case "band.tunnel.v1beta1.GenesisState.fee_payer_top_ups":
+		x.FeePayerTopUps = nil
+	case "band.tunnel.v1beta1.GenesisState.tunnel_operators":
+		x.TunnelOperators = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.GenesisState"))
@@ -317,6 +443,18 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "band.tunnel.v1beta1.GenesisState.total_fees":
 		value := x.TotalFees
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.tunnel.v1beta1.GenesisState.fee_payer_top_ups":
+		if len(x.FeePayerTopUps) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.FeePayerTopUps}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.GenesisState.tunnel_operators":
+		if len(x.TunnelOperators) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.TunnelOperators}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.GenesisState"))
@@ -351,6 +489,14 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.Deposits = *clv.list
 	case "band.tunnel.v1beta1.GenesisState.total_fees":
 		x.TotalFees = value.Message().Interface().(*TotalFees)
+	case "band.tunnel.v1beta1.GenesisState.fee_payer_top_ups":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.FeePayerTopUps = *clv.list
+	case "band.tunnel.v1beta1.GenesisState.tunnel_operators":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.TunnelOperators = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.GenesisState"))
@@ -393,6 +539,18 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.TotalFees = new(TotalFees)
 		}
 		return protoreflect.ValueOfMessage(x.TotalFees.ProtoReflect())
+	case "band.tunnel.v1beta1.GenesisState.fee_payer_top_ups":
+		if x.FeePayerTopUps == nil {
+			x.FeePayerTopUps = []*FeePayerTopUp{}
+		}
+		value := &_GenesisState_6_list{list: &x.FeePayerTopUps}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.GenesisState.tunnel_operators":
+		if x.TunnelOperators == nil {
+			x.TunnelOperators = []*TunnelOperator{}
+		}
+		value := &_GenesisState_7_list{list: &x.TunnelOperators}
+		return protoreflect.ValueOfList(value)
 	case "band.tunnel.v1beta1.GenesisState.tunnel_count":
 		panic(fmt.Errorf("field tunnel_count of message band.tunnel.v1beta1.GenesisState is not mutable"))
 	default:
@@ -422,6 +580,12 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "band.tunnel.v1beta1.GenesisState.total_fees":
 		m := new(TotalFees)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.tunnel.v1beta1.GenesisState.fee_payer_top_ups":
+		list := []*FeePayerTopUp{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "band.tunnel.v1beta1.GenesisState.tunnel_operators":
+		list := []*TunnelOperator{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.GenesisState"))
@@ -514,6 +678,18 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.TotalFees)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.FeePayerTopUps) > 0 {
+			for _, e := range x.FeePayerTopUps {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TunnelOperators) > 0 {
+			for _, e := range x.TunnelOperators {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -543,6 +719,38 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.TunnelOperators) > 0 {
+			for iNdEx := len(x.TunnelOperators) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TunnelOperators[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.FeePayerTopUps) > 0 {
+			for iNdEx := len(x.FeePayerTopUps) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeePayerTopUps[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
 		if x.TotalFees != nil {
 			encoded, err := options.Marshal(x.TotalFees)
 			if err != nil {
@@ -816,6 +1024,74 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeePayerTopUps", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeePayerTopUps = append(x.FeePayerTopUps, &FeePayerTopUp{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeePayerTopUps[len(x.FeePayerTopUps)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelOperators", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TunnelOperators = append(x.TunnelOperators, &TunnelOperator{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TunnelOperators[len(x.TunnelOperators)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -880,6 +1156,10 @@ type GenesisState struct {
 	Deposits []*Deposit `protobuf:"bytes,4,rep,name=deposits,proto3" json:"deposits,omitempty"`
 	// total_fees is the type for the total fees collected by the tunnel
 	TotalFees *TotalFees `protobuf:"bytes,5,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
+	// fee_payer_top_ups is the list of fee payer top-up authorizations.
+	FeePayerTopUps []*FeePayerTopUp `protobuf:"bytes,6,rep,name=fee_payer_top_ups,json=feePayerTopUps,proto3" json:"fee_payer_top_ups,omitempty"`
+	// tunnel_operators is the list of tunnel operators.
+	TunnelOperators []*TunnelOperator `protobuf:"bytes,7,rep,name=tunnel_operators,json=tunnelOperators,proto3" json:"tunnel_operators,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -937,6 +1217,20 @@ func (x *GenesisState) GetTotalFees() *TotalFees {
 	return nil
 }
 
+func (x *GenesisState) GetFeePayerTopUps() []*FeePayerTopUp {
+	if x != nil {
+		return x.FeePayerTopUps
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTunnelOperators() []*TunnelOperator {
+	if x != nil {
+		return x.TunnelOperators
+	}
+	return nil
+}
+
 var File_band_tunnel_v1beta1_genesis_proto protoreflect.FileDescriptor
 
 var file_band_tunnel_v1beta1_genesis_proto_rawDesc = []byte{
@@ -949,7 +1243,7 @@ var file_band_tunnel_v1beta1_genesis_proto_rawDesc = []byte{
 	0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x20, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31,
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xae, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74,
+	0x74, 0x6f, 0x22, 0xd9, 0x03, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74,
 	0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65,
 	0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
@@ -968,21 +1262,32 @@ var file_band_tunnel_v1beta1_genesis_proto_rawDesc = []byte{
 	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
 	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65,
 	0x65, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46,
-	0x65, 0x65, 0x73, 0x42, 0xdd, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42,
-	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76,
-	0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x13,
-	0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e, 0x64,
-	0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42, 0x61,
-	0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x11, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x75, 0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x65, 0x72, 0x54, 0x6f, 0x70,
+	0x55, 0x70, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x50, 0x61, 0x79,
+	0x65, 0x72, 0x54, 0x6f, 0x70, 0x55, 0x70, 0x73, 0x12, 0x54, 0x0a, 0x10, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x42, 0xdd,
+	0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x2e,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02,
+	0x13, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -999,22 +1304,26 @@ func file_band_tunnel_v1beta1_genesis_proto_rawDescGZIP() []byte {
 
 var file_band_tunnel_v1beta1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_band_tunnel_v1beta1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: band.tunnel.v1beta1.GenesisState
-	(*Params)(nil),       // 1: band.tunnel.v1beta1.Params
-	(*Tunnel)(nil),       // 2: band.tunnel.v1beta1.Tunnel
-	(*Deposit)(nil),      // 3: band.tunnel.v1beta1.Deposit
-	(*TotalFees)(nil),    // 4: band.tunnel.v1beta1.TotalFees
+	(*GenesisState)(nil),   // 0: band.tunnel.v1beta1.GenesisState
+	(*Params)(nil),         // 1: band.tunnel.v1beta1.Params
+	(*Tunnel)(nil),         // 2: band.tunnel.v1beta1.Tunnel
+	(*Deposit)(nil),        // 3: band.tunnel.v1beta1.Deposit
+	(*TotalFees)(nil),      // 4: band.tunnel.v1beta1.TotalFees
+	(*FeePayerTopUp)(nil),  // 5: band.tunnel.v1beta1.FeePayerTopUp
+	(*TunnelOperator)(nil), // 6: band.tunnel.v1beta1.TunnelOperator
 }
 var file_band_tunnel_v1beta1_genesis_proto_depIdxs = []int32{
 	1, // 0: band.tunnel.v1beta1.GenesisState.params:type_name -> band.tunnel.v1beta1.Params
 	2, // 1: band.tunnel.v1beta1.GenesisState.tunnels:type_name -> band.tunnel.v1beta1.Tunnel
 	3, // 2: band.tunnel.v1beta1.GenesisState.deposits:type_name -> band.tunnel.v1beta1.Deposit
 	4, // 3: band.tunnel.v1beta1.GenesisState.total_fees:type_name -> band.tunnel.v1beta1.TotalFees
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	5, // 4: band.tunnel.v1beta1.GenesisState.fee_payer_top_ups:type_name -> band.tunnel.v1beta1.FeePayerTopUp
+	6, // 5: band.tunnel.v1beta1.GenesisState.tunnel_operators:type_name -> band.tunnel.v1beta1.TunnelOperator
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_band_tunnel_v1beta1_genesis_proto_init() }
@@ -117,14 +117,18 @@ func (x *_Params_7_list) IsValid() bool {
 }
 
 var (
-	md_Params                   protoreflect.MessageDescriptor
-	fd_Params_min_deposit       protoreflect.FieldDescriptor
-	fd_Params_min_interval      protoreflect.FieldDescriptor
-	fd_Params_max_interval      protoreflect.FieldDescriptor
-	fd_Params_min_deviation_bps protoreflect.FieldDescriptor
-	fd_Params_max_deviation_bps protoreflect.FieldDescriptor
-	fd_Params_max_signals       protoreflect.FieldDescriptor
-	fd_Params_base_packet_fee   protoreflect.FieldDescriptor
+	md_Params                          protoreflect.MessageDescriptor
+	fd_Params_min_deposit              protoreflect.FieldDescriptor
+	fd_Params_min_interval             protoreflect.FieldDescriptor
+	fd_Params_max_interval             protoreflect.FieldDescriptor
+	fd_Params_min_deviation_bps        protoreflect.FieldDescriptor
+	fd_Params_max_deviation_bps        protoreflect.FieldDescriptor
+	fd_Params_max_signals              protoreflect.FieldDescriptor
+	fd_Params_base_packet_fee          protoreflect.FieldDescriptor
+	fd_Params_resend_timed_out_packet  protoreflect.FieldDescriptor
+	fd_Params_max_consecutive_failures protoreflect.FieldDescriptor
+	fd_Params_max_retained_packets     protoreflect.FieldDescriptor
+	fd_Params_packet_retention_period  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -137,6 +141,10 @@ func init() {
 	fd_Params_max_deviation_bps = md_Params.Fields().ByName("max_deviation_bps")
 	fd_Params_max_signals = md_Params.Fields().ByName("max_signals")
 	fd_Params_base_packet_fee = md_Params.Fields().ByName("base_packet_fee")
+	fd_Params_resend_timed_out_packet = md_Params.Fields().ByName("resend_timed_out_packet")
+	fd_Params_max_consecutive_failures = md_Params.Fields().ByName("max_consecutive_failures")
+	fd_Params_max_retained_packets = md_Params.Fields().ByName("max_retained_packets")
+	fd_Params_packet_retention_period = md_Params.Fields().ByName("packet_retention_period")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -246,6 +254,30 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.ResendTimedOutPacket != false {
+		value := protoreflect.ValueOfBool(x.ResendTimedOutPacket)
+		if !f(fd_Params_resend_timed_out_packet, value) {
+			return
+		}
+	}
+	if x.MaxConsecutiveFailures != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxConsecutiveFailures)
+		if !f(fd_Params_max_consecutive_failures, value) {
+			return
+		}
+	}
+	if x.MaxRetainedPackets != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRetainedPackets)
+		if !f(fd_Params_max_retained_packets, value) {
+			return
+		}
+	}
+	if x.PacketRetentionPeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PacketRetentionPeriod)
+		if !f(fd_Params_packet_retention_period, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -275,6 +307,14 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MaxSignals != uint64(0)
 	case "band.tunnel.v1beta1.Params.base_packet_fee":
 		return len(x.BasePacketFee) != 0
+	case "band.tunnel.v1beta1.Params.resend_timed_out_packet":
+		return x.ResendTimedOutPacket != false
+	case "band.tunnel.v1beta1.Params.max_consecutive_failures":
+		return x.MaxConsecutiveFailures != uint64(0)
+	case "band.tunnel.v1beta1.Params.max_retained_packets":
+		return x.MaxRetainedPackets != uint64(0)
+	case "band.tunnel.v1beta1.Params.packet_retention_period":
+		return x.PacketRetentionPeriod != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Params"))
@@ -305,6 +345,14 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MaxSignals = uint64(0)
 	case "band.tunnel.v1beta1.Params.base_packet_fee":
 		x.BasePacketFee = nil
+	case "band.tunnel.v1beta1.Params.resend_timed_out_packet":
+		x.ResendTimedOutPacket = false
+	case "band.tunnel.v1beta1.Params.max_consecutive_failures":
+		x.MaxConsecutiveFailures = uint64(0)
+	case "band.tunnel.v1beta1.Params.max_retained_packets":
+		x.MaxRetainedPackets = uint64(0)
+	case "band.tunnel.v1beta1.Params.packet_retention_period":
+		x.PacketRetentionPeriod = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Params"))
@@ -348,6 +396,18 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Params_7_list{list: &x.BasePacketFee}
 		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.Params.resend_timed_out_packet":
+		value := x.ResendTimedOutPacket
+		return protoreflect.ValueOfBool(value)
+	case "band.tunnel.v1beta1.Params.max_consecutive_failures":
+		value := x.MaxConsecutiveFailures
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.Params.max_retained_packets":
+		value := x.MaxRetainedPackets
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.Params.packet_retention_period":
+		value := x.PacketRetentionPeriod
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Params"))
@@ -386,6 +446,14 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Params_7_list)
 		x.BasePacketFee = *clv.list
+	case "band.tunnel.v1beta1.Params.resend_timed_out_packet":
+		x.ResendTimedOutPacket = value.Bool()
+	case "band.tunnel.v1beta1.Params.max_consecutive_failures":
+		x.MaxConsecutiveFailures = value.Uint()
+	case "band.tunnel.v1beta1.Params.max_retained_packets":
+		x.MaxRetainedPackets = value.Uint()
+	case "band.tunnel.v1beta1.Params.packet_retention_period":
+		x.PacketRetentionPeriod = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Params"))
@@ -428,6 +496,14 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field max_deviation_bps of message band.tunnel.v1beta1.Params is not mutable"))
 	case "band.tunnel.v1beta1.Params.max_signals":
 		panic(fmt.Errorf("field max_signals of message band.tunnel.v1beta1.Params is not mutable"))
+	case "band.tunnel.v1beta1.Params.resend_timed_out_packet":
+		panic(fmt.Errorf("field resend_timed_out_packet of message band.tunnel.v1beta1.Params is not mutable"))
+	case "band.tunnel.v1beta1.Params.max_consecutive_failures":
+		panic(fmt.Errorf("field max_consecutive_failures of message band.tunnel.v1beta1.Params is not mutable"))
+	case "band.tunnel.v1beta1.Params.max_retained_packets":
+		panic(fmt.Errorf("field max_retained_packets of message band.tunnel.v1beta1.Params is not mutable"))
+	case "band.tunnel.v1beta1.Params.packet_retention_period":
+		panic(fmt.Errorf("field packet_retention_period of message band.tunnel.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Params"))
@@ -457,6 +533,14 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "band.tunnel.v1beta1.Params.base_packet_fee":
 		list := []*v1beta1.Coin{}
 		return protoreflect.ValueOfList(&_Params_7_list{list: &list})
+	case "band.tunnel.v1beta1.Params.resend_timed_out_packet":
+		return protoreflect.ValueOfBool(false)
+	case "band.tunnel.v1beta1.Params.max_consecutive_failures":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.Params.max_retained_packets":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.Params.packet_retention_period":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Params"))
@@ -553,6 +637,18 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.ResendTimedOutPacket {
+			n += 2
+		}
+		if x.MaxConsecutiveFailures != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxConsecutiveFailures))
+		}
+		if x.MaxRetainedPackets != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRetainedPackets))
+		}
+		if x.PacketRetentionPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.PacketRetentionPeriod))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -582,6 +678,31 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.PacketRetentionPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PacketRetentionPeriod))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.MaxRetainedPackets != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRetainedPackets))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.MaxConsecutiveFailures != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxConsecutiveFailures))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.ResendTimedOutPacket {
+			i--
+			if x.ResendTimedOutPacket {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
 		if len(x.BasePacketFee) > 0 {
 			for iNdEx := len(x.BasePacketFee) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.BasePacketFee[iNdEx])
@@ -851,6 +972,83 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResendTimedOutPacket", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ResendTimedOutPacket = bool(v != 0)
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxConsecutiveFailures", wireType)
+				}
+				x.MaxConsecutiveFailures = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxConsecutiveFailures |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRetainedPackets", wireType)
+				}
+				x.MaxRetainedPackets = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRetainedPackets |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PacketRetentionPeriod", wireType)
+				}
+				x.PacketRetentionPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PacketRetentionPeriod |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -919,6 +1117,17 @@ type Params struct {
 	MaxSignals uint64 `protobuf:"varint,6,opt,name=max_signals,json=maxSignals,proto3" json:"max_signals,omitempty"`
 	// base_packet_fee is the base fee for each packet.
 	BasePacketFee []*v1beta1.Coin `protobuf:"bytes,7,rep,name=base_packet_fee,json=basePacketFee,proto3" json:"base_packet_fee,omitempty"`
+	// resend_timed_out_packet is the flag to re-send the latest packet of an IBC tunnel when it times out.
+	ResendTimedOutPacket bool `protobuf:"varint,8,opt,name=resend_timed_out_packet,json=resendTimedOutPacket,proto3" json:"resend_timed_out_packet,omitempty"`
+	// max_consecutive_failures is the number of consecutive failed IBC deliveries (error acknowledgements
+	// or timeouts) after which the tunnel is deactivated. Zero disables the deactivation.
+	MaxConsecutiveFailures uint64 `protobuf:"varint,9,opt,name=max_consecutive_failures,json=maxConsecutiveFailures,proto3" json:"max_consecutive_failures,omitempty"`
+	// max_retained_packets is the maximum number of packets kept in the state per tunnel. Older packets are
+	// pruned at the end block. Zero disables the pruning by count.
+	MaxRetainedPackets uint64 `protobuf:"varint,10,opt,name=max_retained_packets,json=maxRetainedPackets,proto3" json:"max_retained_packets,omitempty"`
+	// packet_retention_period is the duration in seconds for which a packet is kept in the state. Older packets
+	// are pruned at the end block. Zero disables the pruning by age.
+	PacketRetentionPeriod uint64 `protobuf:"varint,11,opt,name=packet_retention_period,json=packetRetentionPeriod,proto3" json:"packet_retention_period,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -990,6 +1199,34 @@ func (x *Params) GetBasePacketFee() []*v1beta1.Coin {
 	return nil
 }
 
+func (x *Params) GetResendTimedOutPacket() bool {
+	if x != nil {
+		return x.ResendTimedOutPacket
+	}
+	return false
+}
+
+func (x *Params) GetMaxConsecutiveFailures() uint64 {
+	if x != nil {
+		return x.MaxConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *Params) GetMaxRetainedPackets() uint64 {
+	if x != nil {
+		return x.MaxRetainedPackets
+	}
+	return 0
+}
+
+func (x *Params) GetPacketRetentionPeriod() uint64 {
+	if x != nil {
+		return x.PacketRetentionPeriod
+	}
+	return 0
+}
+
 var File_band_tunnel_v1beta1_params_proto protoreflect.FileDescriptor
 
 var file_band_tunnel_v1beta1_params_proto_rawDesc = []byte{
@@ -999,7 +1236,7 @@ var file_band_tunnel_v1beta1_params_proto_rawDesc = []byte{
 	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xda, 0x03,
+	0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb5, 0x05,
 	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x6c, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f,
 	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
@@ -1029,22 +1266,35 @@ var file_band_tunnel_v1beta1_params_proto_rawDesc = []byte{
 	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
 	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x46, 0x65, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xdc, 0x01, 0x0a, 0x17, 0x63,
-	0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64,
-	0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b,
-	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03,
-	0x42, 0x54, 0x58, 0xaa, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64,
-	0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2,
-	0x02, 0x1f, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x15, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x74, 0x46, 0x65, 0x65, 0x12, 0x35, 0x0a, 0x17, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x64, 0x4f, 0x75, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x6d,
+	0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6d,
+	0x61, 0x78, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x3a,
+	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xdc, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e,
+	0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02,
+	0x13, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e,
+	0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42,
+	0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -19,14 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Tunnels_FullMethodName   = "/band.tunnel.v1beta1.Query/Tunnels"
-	Query_Tunnel_FullMethodName    = "/band.tunnel.v1beta1.Query/Tunnel"
-	Query_Deposits_FullMethodName  = "/band.tunnel.v1beta1.Query/Deposits"
-	Query_Deposit_FullMethodName   = "/band.tunnel.v1beta1.Query/Deposit"
-	Query_Packets_FullMethodName   = "/band.tunnel.v1beta1.Query/Packets"
-	Query_Packet_FullMethodName    = "/band.tunnel.v1beta1.Query/Packet"
-	Query_TotalFees_FullMethodName = "/band.tunnel.v1beta1.Query/TotalFees"
-	Query_Params_FullMethodName    = "/band.tunnel.v1beta1.Query/Params"
+	Query_Tunnels_FullMethodName         = "/band.tunnel.v1beta1.Query/Tunnels"
+	Query_Tunnel_FullMethodName          = "/band.tunnel.v1beta1.Query/Tunnel"
+	Query_Deposits_FullMethodName        = "/band.tunnel.v1beta1.Query/Deposits"
+	Query_Deposit_FullMethodName         = "/band.tunnel.v1beta1.Query/Deposit"
+	Query_Packets_FullMethodName         = "/band.tunnel.v1beta1.Query/Packets"
+	Query_Packet_FullMethodName          = "/band.tunnel.v1beta1.Query/Packet"
+	Query_TunnelFeeRunway_FullMethodName = "/band.tunnel.v1beta1.Query/TunnelFeeRunway"
+	Query_TunnelOperators_FullMethodName = "/band.tunnel.v1beta1.Query/TunnelOperators"
+	Query_PacketRetention_FullMethodName = "/band.tunnel.v1beta1.Query/PacketRetention"
+	Query_SimulateTunnel_FullMethodName  = "/band.tunnel.v1beta1.Query/SimulateTunnel"
+	Query_TotalFees_FullMethodName       = "/band.tunnel.v1beta1.Query/TotalFees"
+	Query_Params_FullMethodName          = "/band.tunnel.v1beta1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -45,6 +49,17 @@ type QueryClient interface {
 	Packets(ctx context.Context, in *QueryPacketsRequest, opts ...grpc.CallOption) (*QueryPacketsResponse, error)
 	// Packet is a RPC method that returns a packet by its tunnel ID and sequence.
 	Packet(ctx context.Context, in *QueryPacketRequest, opts ...grpc.CallOption) (*QueryPacketResponse, error)
+	// TunnelFeeRunway is a RPC method that estimates the number of packets that the fee payer of a tunnel
+	// can still pay for at the current fee rates.
+	TunnelFeeRunway(ctx context.Context, in *QueryTunnelFeeRunwayRequest, opts ...grpc.CallOption) (*QueryTunnelFeeRunwayResponse, error)
+	// TunnelOperators is a RPC method that returns all operators of a tunnel.
+	TunnelOperators(ctx context.Context, in *QueryTunnelOperatorsRequest, opts ...grpc.CallOption) (*QueryTunnelOperatorsResponse, error)
+	// PacketRetention is a RPC method that returns the range of packet sequences of a tunnel that are
+	// still kept in the state.
+	PacketRetention(ctx context.Context, in *QueryPacketRetentionRequest, opts ...grpc.CallOption) (*QueryPacketRetentionResponse, error)
+	// SimulateTunnel is a RPC method that replays a proposed tunnel configuration against a price
+	// history and returns the packets that the tunnel would have produced along with their fees.
+	SimulateTunnel(ctx context.Context, in *QuerySimulateTunnelRequest, opts ...grpc.CallOption) (*QuerySimulateTunnelResponse, error)
 	// TotalFees is a RPC method that returns the total fees collected by the tunnel
 	TotalFees(ctx context.Context, in *QueryTotalFeesRequest, opts ...grpc.CallOption) (*QueryTotalFeesResponse, error)
 	// Params is a RPC method that returns all parameters of the module.
@@ -113,6 +128,42 @@ func (c *queryClient) Packet(ctx context.Context, in *QueryPacketRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) TunnelFeeRunway(ctx context.Context, in *QueryTunnelFeeRunwayRequest, opts ...grpc.CallOption) (*QueryTunnelFeeRunwayResponse, error) {
+	out := new(QueryTunnelFeeRunwayResponse)
+	err := c.cc.Invoke(ctx, Query_TunnelFeeRunway_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TunnelOperators(ctx context.Context, in *QueryTunnelOperatorsRequest, opts ...grpc.CallOption) (*QueryTunnelOperatorsResponse, error) {
+	out := new(QueryTunnelOperatorsResponse)
+	err := c.cc.Invoke(ctx, Query_TunnelOperators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PacketRetention(ctx context.Context, in *QueryPacketRetentionRequest, opts ...grpc.CallOption) (*QueryPacketRetentionResponse, error) {
+	out := new(QueryPacketRetentionResponse)
+	err := c.cc.Invoke(ctx, Query_PacketRetention_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateTunnel(ctx context.Context, in *QuerySimulateTunnelRequest, opts ...grpc.CallOption) (*QuerySimulateTunnelResponse, error) {
+	out := new(QuerySimulateTunnelResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateTunnel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TotalFees(ctx context.Context, in *QueryTotalFeesRequest, opts ...grpc.CallOption) (*QueryTotalFeesResponse, error) {
 	out := new(QueryTotalFeesResponse)
 	err := c.cc.Invoke(ctx, Query_TotalFees_FullMethodName, in, out, opts...)
@@ -147,6 +198,17 @@ type QueryServer interface {
 	Packets(context.Context, *QueryPacketsRequest) (*QueryPacketsResponse, error)
 	// Packet is a RPC method that returns a packet by its tunnel ID and sequence.
 	Packet(context.Context, *QueryPacketRequest) (*QueryPacketResponse, error)
+	// TunnelFeeRunway is a RPC method that estimates the number of packets that the fee payer of a tunnel
+	// can still pay for at the current fee rates.
+	TunnelFeeRunway(context.Context, *QueryTunnelFeeRunwayRequest) (*QueryTunnelFeeRunwayResponse, error)
+	// TunnelOperators is a RPC method that returns all operators of a tunnel.
+	TunnelOperators(context.Context, *QueryTunnelOperatorsRequest) (*QueryTunnelOperatorsResponse, error)
+	// PacketRetention is a RPC method that returns the range of packet sequences of a tunnel that are
+	// still kept in the state.
+	PacketRetention(context.Context, *QueryPacketRetentionRequest) (*QueryPacketRetentionResponse, error)
+	// SimulateTunnel is a RPC method that replays a proposed tunnel configuration against a price
+	// history and returns the packets that the tunnel would have produced along with their fees.
+	SimulateTunnel(context.Context, *QuerySimulateTunnelRequest) (*QuerySimulateTunnelResponse, error)
 	// TotalFees is a RPC method that returns the total fees collected by the tunnel
 	TotalFees(context.Context, *QueryTotalFeesRequest) (*QueryTotalFeesResponse, error)
 	// Params is a RPC method that returns all parameters of the module.
@@ -176,6 +238,18 @@ func (UnimplementedQueryServer) Packets(context.Context, *QueryPacketsRequest) (
 func (UnimplementedQueryServer) Packet(context.Context, *QueryPacketRequest) (*QueryPacketResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Packet not implemented")
 }
+func (UnimplementedQueryServer) TunnelFeeRunway(context.Context, *QueryTunnelFeeRunwayRequest) (*QueryTunnelFeeRunwayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TunnelFeeRunway not implemented")
+}
+func (UnimplementedQueryServer) TunnelOperators(context.Context, *QueryTunnelOperatorsRequest) (*QueryTunnelOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TunnelOperators not implemented")
+}
+func (UnimplementedQueryServer) PacketRetention(context.Context, *QueryPacketRetentionRequest) (*QueryPacketRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PacketRetention not implemented")
+}
+func (UnimplementedQueryServer) SimulateTunnel(context.Context, *QuerySimulateTunnelRequest) (*QuerySimulateTunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTunnel not implemented")
+}
 func (UnimplementedQueryServer) TotalFees(context.Context, *QueryTotalFeesRequest) (*QueryTotalFeesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalFees not implemented")
 }
@@ -303,6 +377,78 @@ func _Query_Packet_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TunnelFeeRunway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTunnelFeeRunwayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TunnelFeeRunway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TunnelFeeRunway_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TunnelFeeRunway(ctx, req.(*QueryTunnelFeeRunwayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TunnelOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTunnelOperatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TunnelOperators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TunnelOperators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TunnelOperators(ctx, req.(*QueryTunnelOperatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PacketRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PacketRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PacketRetention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PacketRetention(ctx, req.(*QueryPacketRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateTunnelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateTunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateTunnel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateTunnel(ctx, req.(*QuerySimulateTunnelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TotalFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTotalFeesRequest)
 	if err := dec(in); err != nil {
@@ -370,6 +516,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Packet",
 			Handler:    _Query_Packet_Handler,
 		},
+		{
+			MethodName: "TunnelFeeRunway",
+			Handler:    _Query_TunnelFeeRunway_Handler,
+		},
+		{
+			MethodName: "TunnelOperators",
+			Handler:    _Query_TunnelOperators_Handler,
+		},
+		{
+			MethodName: "PacketRetention",
+			Handler:    _Query_PacketRetention_Handler,
+		},
+		{
+			MethodName: "SimulateTunnel",
+			Handler:    _Query_SimulateTunnel_Handler,
+		},
 		{
 			MethodName: "TotalFees",
 			Handler:    _Query_TotalFees_Handler,
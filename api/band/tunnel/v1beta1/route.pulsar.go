@@ -2,6 +2,7 @@
 package tunnelv1beta1
 
 import (
+	v1beta11 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	v1beta1 "github.com/bandprotocol/chain/v3/api/band/feeds/v1beta1"
 	_ "github.com/cosmos/cosmos-proto"
@@ -20,6 +21,7 @@ var (
 	fd_TSSRoute_destination_chain_id         protoreflect.FieldDescriptor
 	fd_TSSRoute_destination_contract_address protoreflect.FieldDescriptor
 	fd_TSSRoute_encoder                      protoreflect.FieldDescriptor
+	fd_TSSRoute_batched                      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -28,6 +30,7 @@ func init() {
 	fd_TSSRoute_destination_chain_id = md_TSSRoute.Fields().ByName("destination_chain_id")
 	fd_TSSRoute_destination_contract_address = md_TSSRoute.Fields().ByName("destination_contract_address")
 	fd_TSSRoute_encoder = md_TSSRoute.Fields().ByName("encoder")
+	fd_TSSRoute_batched = md_TSSRoute.Fields().ByName("batched")
 }
 
 var _ protoreflect.Message = (*fastReflection_TSSRoute)(nil)
@@ -113,6 +116,12 @@ func (x *fastReflection_TSSRoute) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if x.Batched != false {
+		value := protoreflect.ValueOfBool(x.Batched)
+		if !f(fd_TSSRoute_batched, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -134,6 +143,8 @@ func (x *fastReflection_TSSRoute) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.DestinationContractAddress != ""
 	case "band.tunnel.v1beta1.TSSRoute.encoder":
 		return x.Encoder != 0
+	case "band.tunnel.v1beta1.TSSRoute.batched":
+		return x.Batched != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TSSRoute"))
@@ -156,6 +167,8 @@ func (x *fastReflection_TSSRoute) Clear(fd protoreflect.FieldDescriptor) {
 		x.DestinationContractAddress = ""
 	case "band.tunnel.v1beta1.TSSRoute.encoder":
 		x.Encoder = 0
+	case "band.tunnel.v1beta1.TSSRoute.batched":
+		x.Batched = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TSSRoute"))
@@ -181,6 +194,9 @@ func (x *fastReflection_TSSRoute) Get(descriptor protoreflect.FieldDescriptor) p
 	case "band.tunnel.v1beta1.TSSRoute.encoder":
 		value := x.Encoder
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.tunnel.v1beta1.TSSRoute.batched":
+		value := x.Batched
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TSSRoute"))
@@ -207,6 +223,8 @@ func (x *fastReflection_TSSRoute) Set(fd protoreflect.FieldDescriptor, value pro
 		x.DestinationContractAddress = value.Interface().(string)
 	case "band.tunnel.v1beta1.TSSRoute.encoder":
 		x.Encoder = (v1beta1.Encoder)(value.Enum())
+	case "band.tunnel.v1beta1.TSSRoute.batched":
+		x.Batched = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TSSRoute"))
@@ -233,6 +251,8 @@ func (x *fastReflection_TSSRoute) Mutable(fd protoreflect.FieldDescriptor) proto
 		panic(fmt.Errorf("field destination_contract_address of message band.tunnel.v1beta1.TSSRoute is not mutable"))
 	case "band.tunnel.v1beta1.TSSRoute.encoder":
 		panic(fmt.Errorf("field encoder of message band.tunnel.v1beta1.TSSRoute is not mutable"))
+	case "band.tunnel.v1beta1.TSSRoute.batched":
+		panic(fmt.Errorf("field batched of message band.tunnel.v1beta1.TSSRoute is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TSSRoute"))
@@ -252,6 +272,8 @@ func (x *fastReflection_TSSRoute) NewField(fd protoreflect.FieldDescriptor) prot
 		return protoreflect.ValueOfString("")
 	case "band.tunnel.v1beta1.TSSRoute.encoder":
 		return protoreflect.ValueOfEnum(0)
+	case "band.tunnel.v1beta1.TSSRoute.batched":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TSSRoute"))
@@ -332,6 +354,9 @@ func (x *fastReflection_TSSRoute) ProtoMethods() *protoiface.Methods {
 		if x.Encoder != 0 {
 			n += 1 + runtime.Sov(uint64(x.Encoder))
 		}
+		if x.Batched {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -361,6 +386,16 @@ func (x *fastReflection_TSSRoute) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Batched {
+			i--
+			if x.Batched {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.Encoder != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Encoder))
 			i--
@@ -512,6 +547,26 @@ func (x *fastReflection_TSSRoute) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Batched", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Batched = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -951,26 +1006,82 @@ func (x *fastReflection_TSSPacketReceipt) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_TSSBatchPacketReceipt_5_list)(nil)
+
+type _TSSBatchPacketReceipt_5_list struct {
+	list *[][]byte
+}
+
+func (x *_TSSBatchPacketReceipt_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TSSBatchPacketReceipt_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_TSSBatchPacketReceipt_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TSSBatchPacketReceipt_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TSSBatchPacketReceipt_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TSSBatchPacketReceipt at list field Proof as it is not of Message kind"))
+}
+
+func (x *_TSSBatchPacketReceipt_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TSSBatchPacketReceipt_5_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_TSSBatchPacketReceipt_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_IBCRoute            protoreflect.MessageDescriptor
-	fd_IBCRoute_channel_id protoreflect.FieldDescriptor
+	md_TSSBatchPacketReceipt                protoreflect.MessageDescriptor
+	fd_TSSBatchPacketReceipt_signing_id     protoreflect.FieldDescriptor
+	fd_TSSBatchPacketReceipt_merkle_root    protoreflect.FieldDescriptor
+	fd_TSSBatchPacketReceipt_leaf_index     protoreflect.FieldDescriptor
+	fd_TSSBatchPacketReceipt_total          protoreflect.FieldDescriptor
+	fd_TSSBatchPacketReceipt_proof          protoreflect.FieldDescriptor
+	fd_TSSBatchPacketReceipt_failure_reason protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_route_proto_init()
-	md_IBCRoute = File_band_tunnel_v1beta1_route_proto.Messages().ByName("IBCRoute")
-	fd_IBCRoute_channel_id = md_IBCRoute.Fields().ByName("channel_id")
+	md_TSSBatchPacketReceipt = File_band_tunnel_v1beta1_route_proto.Messages().ByName("TSSBatchPacketReceipt")
+	fd_TSSBatchPacketReceipt_signing_id = md_TSSBatchPacketReceipt.Fields().ByName("signing_id")
+	fd_TSSBatchPacketReceipt_merkle_root = md_TSSBatchPacketReceipt.Fields().ByName("merkle_root")
+	fd_TSSBatchPacketReceipt_leaf_index = md_TSSBatchPacketReceipt.Fields().ByName("leaf_index")
+	fd_TSSBatchPacketReceipt_total = md_TSSBatchPacketReceipt.Fields().ByName("total")
+	fd_TSSBatchPacketReceipt_proof = md_TSSBatchPacketReceipt.Fields().ByName("proof")
+	fd_TSSBatchPacketReceipt_failure_reason = md_TSSBatchPacketReceipt.Fields().ByName("failure_reason")
 }
 
-var _ protoreflect.Message = (*fastReflection_IBCRoute)(nil)
+var _ protoreflect.Message = (*fastReflection_TSSBatchPacketReceipt)(nil)
 
-type fastReflection_IBCRoute IBCRoute
+type fastReflection_TSSBatchPacketReceipt TSSBatchPacketReceipt
 
-func (x *IBCRoute) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_IBCRoute)(x)
+func (x *TSSBatchPacketReceipt) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TSSBatchPacketReceipt)(x)
 }
 
-func (x *IBCRoute) slowProtoReflect() protoreflect.Message {
+func (x *TSSBatchPacketReceipt) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -982,43 +1093,43 @@ func (x *IBCRoute) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_IBCRoute_messageType fastReflection_IBCRoute_messageType
-var _ protoreflect.MessageType = fastReflection_IBCRoute_messageType{}
+var _fastReflection_TSSBatchPacketReceipt_messageType fastReflection_TSSBatchPacketReceipt_messageType
+var _ protoreflect.MessageType = fastReflection_TSSBatchPacketReceipt_messageType{}
 
-type fastReflection_IBCRoute_messageType struct{}
+type fastReflection_TSSBatchPacketReceipt_messageType struct{}
 
-func (x fastReflection_IBCRoute_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_IBCRoute)(nil)
+func (x fastReflection_TSSBatchPacketReceipt_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TSSBatchPacketReceipt)(nil)
 }
-func (x fastReflection_IBCRoute_messageType) New() protoreflect.Message {
-	return new(fastReflection_IBCRoute)
+func (x fastReflection_TSSBatchPacketReceipt_messageType) New() protoreflect.Message {
+	return new(fastReflection_TSSBatchPacketReceipt)
 }
-func (x fastReflection_IBCRoute_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_IBCRoute
+func (x fastReflection_TSSBatchPacketReceipt_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TSSBatchPacketReceipt
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_IBCRoute) Descriptor() protoreflect.MessageDescriptor {
-	return md_IBCRoute
+func (x *fastReflection_TSSBatchPacketReceipt) Descriptor() protoreflect.MessageDescriptor {
+	return md_TSSBatchPacketReceipt
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_IBCRoute) Type() protoreflect.MessageType {
-	return _fastReflection_IBCRoute_messageType
+func (x *fastReflection_TSSBatchPacketReceipt) Type() protoreflect.MessageType {
+	return _fastReflection_TSSBatchPacketReceipt_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_IBCRoute) New() protoreflect.Message {
-	return new(fastReflection_IBCRoute)
+func (x *fastReflection_TSSBatchPacketReceipt) New() protoreflect.Message {
+	return new(fastReflection_TSSBatchPacketReceipt)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_IBCRoute) Interface() protoreflect.ProtoMessage {
-	return (*IBCRoute)(x)
+func (x *fastReflection_TSSBatchPacketReceipt) Interface() protoreflect.ProtoMessage {
+	return (*TSSBatchPacketReceipt)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1026,10 +1137,40 @@ func (x *fastReflection_IBCRoute) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_IBCRoute) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ChannelId != "" {
-		value := protoreflect.ValueOfString(x.ChannelId)
-		if !f(fd_IBCRoute_channel_id, value) {
+func (x *fastReflection_TSSBatchPacketReceipt) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SigningId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SigningId)
+		if !f(fd_TSSBatchPacketReceipt_signing_id, value) {
+			return
+		}
+	}
+	if len(x.MerkleRoot) != 0 {
+		value := protoreflect.ValueOfBytes(x.MerkleRoot)
+		if !f(fd_TSSBatchPacketReceipt_merkle_root, value) {
+			return
+		}
+	}
+	if x.LeafIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LeafIndex)
+		if !f(fd_TSSBatchPacketReceipt_leaf_index, value) {
+			return
+		}
+	}
+	if x.Total != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Total)
+		if !f(fd_TSSBatchPacketReceipt_total, value) {
+			return
+		}
+	}
+	if len(x.Proof) != 0 {
+		value := protoreflect.ValueOfList(&_TSSBatchPacketReceipt_5_list{list: &x.Proof})
+		if !f(fd_TSSBatchPacketReceipt_proof, value) {
+			return
+		}
+	}
+	if x.FailureReason != "" {
+		value := protoreflect.ValueOfString(x.FailureReason)
+		if !f(fd_TSSBatchPacketReceipt_failure_reason, value) {
 			return
 		}
 	}
@@ -1046,15 +1187,25 @@ func (x *fastReflection_IBCRoute) Range(f func(protoreflect.FieldDescriptor, pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_IBCRoute) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TSSBatchPacketReceipt) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.IBCRoute.channel_id":
-		return x.ChannelId != ""
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.signing_id":
+		return x.SigningId != uint64(0)
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.merkle_root":
+		return len(x.MerkleRoot) != 0
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.leaf_index":
+		return x.LeafIndex != uint64(0)
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.total":
+		return x.Total != uint64(0)
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.proof":
+		return len(x.Proof) != 0
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.failure_reason":
+		return x.FailureReason != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCRoute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TSSBatchPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCRoute does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TSSBatchPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1064,15 +1215,25 @@ func (x *fastReflection_IBCRoute) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IBCRoute) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TSSBatchPacketReceipt) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.IBCRoute.channel_id":
-		x.ChannelId = ""
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.signing_id":
+		x.SigningId = uint64(0)
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.merkle_root":
+		x.MerkleRoot = nil
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.leaf_index":
+		x.LeafIndex = uint64(0)
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.total":
+		x.Total = uint64(0)
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.proof":
+		x.Proof = nil
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.failure_reason":
+		x.FailureReason = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCRoute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TSSBatchPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCRoute does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TSSBatchPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1082,16 +1243,34 @@ func (x *fastReflection_IBCRoute) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_IBCRoute) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TSSBatchPacketReceipt) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.IBCRoute.channel_id":
-		value := x.ChannelId
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.signing_id":
+		value := x.SigningId
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.merkle_root":
+		value := x.MerkleRoot
+		return protoreflect.ValueOfBytes(value)
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.leaf_index":
+		value := x.LeafIndex
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.total":
+		value := x.Total
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.proof":
+		if len(x.Proof) == 0 {
+			return protoreflect.ValueOfList(&_TSSBatchPacketReceipt_5_list{})
+		}
+		listValue := &_TSSBatchPacketReceipt_5_list{list: &x.Proof}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.failure_reason":
+		value := x.FailureReason
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCRoute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TSSBatchPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCRoute does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TSSBatchPacketReceipt does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1105,15 +1284,27 @@ func (x *fastReflection_IBCRoute) Get(descriptor protoreflect.FieldDescriptor) p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IBCRoute) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TSSBatchPacketReceipt) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.IBCRoute.channel_id":
-		x.ChannelId = value.Interface().(string)
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.signing_id":
+		x.SigningId = value.Uint()
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.merkle_root":
+		x.MerkleRoot = value.Bytes()
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.leaf_index":
+		x.LeafIndex = value.Uint()
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.total":
+		x.Total = value.Uint()
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.proof":
+		lv := value.List()
+		clv := lv.(*_TSSBatchPacketReceipt_5_list)
+		x.Proof = *clv.list
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.failure_reason":
+		x.FailureReason = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCRoute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TSSBatchPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCRoute does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TSSBatchPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1127,40 +1318,65 @@ func (x *fastReflection_IBCRoute) Set(fd protoreflect.FieldDescriptor, value pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IBCRoute) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TSSBatchPacketReceipt) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.IBCRoute.channel_id":
-		panic(fmt.Errorf("field channel_id of message band.tunnel.v1beta1.IBCRoute is not mutable"))
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.proof":
+		if x.Proof == nil {
+			x.Proof = [][]byte{}
+		}
+		value := &_TSSBatchPacketReceipt_5_list{list: &x.Proof}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.signing_id":
+		panic(fmt.Errorf("field signing_id of message band.tunnel.v1beta1.TSSBatchPacketReceipt is not mutable"))
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.merkle_root":
+		panic(fmt.Errorf("field merkle_root of message band.tunnel.v1beta1.TSSBatchPacketReceipt is not mutable"))
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.leaf_index":
+		panic(fmt.Errorf("field leaf_index of message band.tunnel.v1beta1.TSSBatchPacketReceipt is not mutable"))
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.total":
+		panic(fmt.Errorf("field total of message band.tunnel.v1beta1.TSSBatchPacketReceipt is not mutable"))
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.failure_reason":
+		panic(fmt.Errorf("field failure_reason of message band.tunnel.v1beta1.TSSBatchPacketReceipt is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCRoute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TSSBatchPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCRoute does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TSSBatchPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_IBCRoute) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TSSBatchPacketReceipt) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.IBCRoute.channel_id":
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.signing_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.merkle_root":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.leaf_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.total":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.proof":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_TSSBatchPacketReceipt_5_list{list: &list})
+	case "band.tunnel.v1beta1.TSSBatchPacketReceipt.failure_reason":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCRoute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TSSBatchPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCRoute does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TSSBatchPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_IBCRoute) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TSSBatchPacketReceipt) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.IBCRoute", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.TSSBatchPacketReceipt", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1168,7 +1384,7 @@ func (x *fastReflection_IBCRoute) WhichOneof(d protoreflect.OneofDescriptor) pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_IBCRoute) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TSSBatchPacketReceipt) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1179,7 +1395,7 @@ func (x *fastReflection_IBCRoute) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IBCRoute) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TSSBatchPacketReceipt) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1191,7 +1407,7 @@ func (x *fastReflection_IBCRoute) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_IBCRoute) IsValid() bool {
+func (x *fastReflection_TSSBatchPacketReceipt) IsValid() bool {
 	return x != nil
 }
 
@@ -1201,9 +1417,9 @@ func (x *fastReflection_IBCRoute) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_IBCRoute) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TSSBatchPacketReceipt) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*IBCRoute)
+		x := input.Message.Interface().(*TSSBatchPacketReceipt)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1215,7 +1431,26 @@ func (x *fastReflection_IBCRoute) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.ChannelId)
+		if x.SigningId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SigningId))
+		}
+		l = len(x.MerkleRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LeafIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.LeafIndex))
+		}
+		if x.Total != 0 {
+			n += 1 + runtime.Sov(uint64(x.Total))
+		}
+		if len(x.Proof) > 0 {
+			for _, b := range x.Proof {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.FailureReason)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -1229,7 +1464,7 @@ func (x *fastReflection_IBCRoute) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*IBCRoute)
+		x := input.Message.Interface().(*TSSBatchPacketReceipt)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1248,12 +1483,43 @@ func (x *fastReflection_IBCRoute) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ChannelId) > 0 {
-			i -= len(x.ChannelId)
-			copy(dAtA[i:], x.ChannelId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+		if len(x.FailureReason) > 0 {
+			i -= len(x.FailureReason)
+			copy(dAtA[i:], x.FailureReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FailureReason)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x32
+		}
+		if len(x.Proof) > 0 {
+			for iNdEx := len(x.Proof) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Proof[iNdEx])
+				copy(dAtA[i:], x.Proof[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proof[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Total != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Total))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.LeafIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LeafIndex))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.MerkleRoot) > 0 {
+			i -= len(x.MerkleRoot)
+			copy(dAtA[i:], x.MerkleRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MerkleRoot)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.SigningId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigningId))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1266,7 +1532,7 @@ func (x *fastReflection_IBCRoute) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*IBCRoute)
+		x := input.Message.Interface().(*TSSBatchPacketReceipt)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1298,17 +1564,36 @@ func (x *fastReflection_IBCRoute) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCRoute: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TSSBatchPacketReceipt: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TSSBatchPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningId", wireType)
+				}
+				x.SigningId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SigningId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1318,41 +1603,145 @@ func (x *fastReflection_IBCRoute) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				x.MerkleRoot = append(x.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+				if x.MerkleRoot == nil {
+					x.MerkleRoot = []byte{}
+				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeafIndex", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.LeafIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LeafIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Total = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Total |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx += skippy
-			}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proof = append(x.Proof, make([]byte, postIndex-iNdEx))
+				copy(x.Proof[len(x.Proof)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FailureReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
 		}
 
 		if iNdEx > l {
@@ -1372,25 +1761,25 @@ func (x *fastReflection_IBCRoute) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_IBCPacketReceipt          protoreflect.MessageDescriptor
-	fd_IBCPacketReceipt_sequence protoreflect.FieldDescriptor
+	md_IBCRoute            protoreflect.MessageDescriptor
+	fd_IBCRoute_channel_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_route_proto_init()
-	md_IBCPacketReceipt = File_band_tunnel_v1beta1_route_proto.Messages().ByName("IBCPacketReceipt")
-	fd_IBCPacketReceipt_sequence = md_IBCPacketReceipt.Fields().ByName("sequence")
+	md_IBCRoute = File_band_tunnel_v1beta1_route_proto.Messages().ByName("IBCRoute")
+	fd_IBCRoute_channel_id = md_IBCRoute.Fields().ByName("channel_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_IBCPacketReceipt)(nil)
+var _ protoreflect.Message = (*fastReflection_IBCRoute)(nil)
 
-type fastReflection_IBCPacketReceipt IBCPacketReceipt
+type fastReflection_IBCRoute IBCRoute
 
-func (x *IBCPacketReceipt) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_IBCPacketReceipt)(x)
+func (x *IBCRoute) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IBCRoute)(x)
 }
 
-func (x *IBCPacketReceipt) slowProtoReflect() protoreflect.Message {
+func (x *IBCRoute) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1402,43 +1791,1702 @@ func (x *IBCPacketReceipt) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_IBCPacketReceipt_messageType fastReflection_IBCPacketReceipt_messageType
-var _ protoreflect.MessageType = fastReflection_IBCPacketReceipt_messageType{}
+var _fastReflection_IBCRoute_messageType fastReflection_IBCRoute_messageType
+var _ protoreflect.MessageType = fastReflection_IBCRoute_messageType{}
 
-type fastReflection_IBCPacketReceipt_messageType struct{}
+type fastReflection_IBCRoute_messageType struct{}
 
-func (x fastReflection_IBCPacketReceipt_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_IBCPacketReceipt)(nil)
+func (x fastReflection_IBCRoute_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IBCRoute)(nil)
 }
-func (x fastReflection_IBCPacketReceipt_messageType) New() protoreflect.Message {
-	return new(fastReflection_IBCPacketReceipt)
+func (x fastReflection_IBCRoute_messageType) New() protoreflect.Message {
+	return new(fastReflection_IBCRoute)
 }
-func (x fastReflection_IBCPacketReceipt_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_IBCPacketReceipt
+func (x fastReflection_IBCRoute_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCRoute
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_IBCPacketReceipt) Descriptor() protoreflect.MessageDescriptor {
-	return md_IBCPacketReceipt
+func (x *fastReflection_IBCRoute) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCRoute
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_IBCPacketReceipt) Type() protoreflect.MessageType {
-	return _fastReflection_IBCPacketReceipt_messageType
+func (x *fastReflection_IBCRoute) Type() protoreflect.MessageType {
+	return _fastReflection_IBCRoute_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_IBCRoute) New() protoreflect.Message {
+	return new(fastReflection_IBCRoute)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_IBCRoute) Interface() protoreflect.ProtoMessage {
+	return (*IBCRoute)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_IBCRoute) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_IBCRoute_channel_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_IBCRoute) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCRoute.channel_id":
+		return x.ChannelId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCRoute) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCRoute.channel_id":
+		x.ChannelId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_IBCRoute) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.IBCRoute.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCRoute does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCRoute) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCRoute.channel_id":
+		x.ChannelId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCRoute) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCRoute.channel_id":
+		panic(fmt.Errorf("field channel_id of message band.tunnel.v1beta1.IBCRoute is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_IBCRoute) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCRoute.channel_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_IBCRoute) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.IBCRoute", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_IBCRoute) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCRoute) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_IBCRoute) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_IBCRoute) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*IBCRoute)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*IBCRoute)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*IBCRoute)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCRoute: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_IBCPacketReceipt          protoreflect.MessageDescriptor
+	fd_IBCPacketReceipt_sequence protoreflect.FieldDescriptor
+	fd_IBCPacketReceipt_status   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_route_proto_init()
+	md_IBCPacketReceipt = File_band_tunnel_v1beta1_route_proto.Messages().ByName("IBCPacketReceipt")
+	fd_IBCPacketReceipt_sequence = md_IBCPacketReceipt.Fields().ByName("sequence")
+	fd_IBCPacketReceipt_status = md_IBCPacketReceipt.Fields().ByName("status")
+}
+
+var _ protoreflect.Message = (*fastReflection_IBCPacketReceipt)(nil)
+
+type fastReflection_IBCPacketReceipt IBCPacketReceipt
+
+func (x *IBCPacketReceipt) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IBCPacketReceipt)(x)
+}
+
+func (x *IBCPacketReceipt) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_IBCPacketReceipt_messageType fastReflection_IBCPacketReceipt_messageType
+var _ protoreflect.MessageType = fastReflection_IBCPacketReceipt_messageType{}
+
+type fastReflection_IBCPacketReceipt_messageType struct{}
+
+func (x fastReflection_IBCPacketReceipt_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IBCPacketReceipt)(nil)
+}
+func (x fastReflection_IBCPacketReceipt_messageType) New() protoreflect.Message {
+	return new(fastReflection_IBCPacketReceipt)
+}
+func (x fastReflection_IBCPacketReceipt_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCPacketReceipt
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_IBCPacketReceipt) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCPacketReceipt
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_IBCPacketReceipt) Type() protoreflect.MessageType {
+	return _fastReflection_IBCPacketReceipt_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_IBCPacketReceipt) New() protoreflect.Message {
+	return new(fastReflection_IBCPacketReceipt)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_IBCPacketReceipt) Interface() protoreflect.ProtoMessage {
+	return (*IBCPacketReceipt)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_IBCPacketReceipt) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_IBCPacketReceipt_sequence, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_IBCPacketReceipt_status, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_IBCPacketReceipt) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCPacketReceipt.sequence":
+		return x.Sequence != uint64(0)
+	case "band.tunnel.v1beta1.IBCPacketReceipt.status":
+		return x.Status != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCPacketReceipt"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCPacketReceipt does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCPacketReceipt) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCPacketReceipt.sequence":
+		x.Sequence = uint64(0)
+	case "band.tunnel.v1beta1.IBCPacketReceipt.status":
+		x.Status = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCPacketReceipt"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCPacketReceipt does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_IBCPacketReceipt) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.IBCPacketReceipt.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.IBCPacketReceipt.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCPacketReceipt"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCPacketReceipt does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCPacketReceipt) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCPacketReceipt.sequence":
+		x.Sequence = value.Uint()
+	case "band.tunnel.v1beta1.IBCPacketReceipt.status":
+		x.Status = (PacketStatus)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCPacketReceipt"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCPacketReceipt does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCPacketReceipt) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCPacketReceipt.sequence":
+		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.IBCPacketReceipt is not mutable"))
+	case "band.tunnel.v1beta1.IBCPacketReceipt.status":
+		panic(fmt.Errorf("field status of message band.tunnel.v1beta1.IBCPacketReceipt is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCPacketReceipt"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCPacketReceipt does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_IBCPacketReceipt) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCPacketReceipt.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.IBCPacketReceipt.status":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCPacketReceipt"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCPacketReceipt does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_IBCPacketReceipt) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.IBCPacketReceipt", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_IBCPacketReceipt) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCPacketReceipt) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_IBCPacketReceipt) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_IBCPacketReceipt) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*IBCPacketReceipt)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*IBCPacketReceipt)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*IBCPacketReceipt)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCPacketReceipt: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= PacketStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RouterRoute                              protoreflect.MessageDescriptor
+	fd_RouterRoute_channel_id                   protoreflect.FieldDescriptor
+	fd_RouterRoute_bridge_contract_address      protoreflect.FieldDescriptor
+	fd_RouterRoute_destination_chain_id         protoreflect.FieldDescriptor
+	fd_RouterRoute_destination_contract_address protoreflect.FieldDescriptor
+	fd_RouterRoute_destination_gas_limit        protoreflect.FieldDescriptor
+	fd_RouterRoute_fee                          protoreflect.FieldDescriptor
+	fd_RouterRoute_encoder                      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_route_proto_init()
+	md_RouterRoute = File_band_tunnel_v1beta1_route_proto.Messages().ByName("RouterRoute")
+	fd_RouterRoute_channel_id = md_RouterRoute.Fields().ByName("channel_id")
+	fd_RouterRoute_bridge_contract_address = md_RouterRoute.Fields().ByName("bridge_contract_address")
+	fd_RouterRoute_destination_chain_id = md_RouterRoute.Fields().ByName("destination_chain_id")
+	fd_RouterRoute_destination_contract_address = md_RouterRoute.Fields().ByName("destination_contract_address")
+	fd_RouterRoute_destination_gas_limit = md_RouterRoute.Fields().ByName("destination_gas_limit")
+	fd_RouterRoute_fee = md_RouterRoute.Fields().ByName("fee")
+	fd_RouterRoute_encoder = md_RouterRoute.Fields().ByName("encoder")
+}
+
+var _ protoreflect.Message = (*fastReflection_RouterRoute)(nil)
+
+type fastReflection_RouterRoute RouterRoute
+
+func (x *RouterRoute) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RouterRoute)(x)
+}
+
+func (x *RouterRoute) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RouterRoute_messageType fastReflection_RouterRoute_messageType
+var _ protoreflect.MessageType = fastReflection_RouterRoute_messageType{}
+
+type fastReflection_RouterRoute_messageType struct{}
+
+func (x fastReflection_RouterRoute_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RouterRoute)(nil)
+}
+func (x fastReflection_RouterRoute_messageType) New() protoreflect.Message {
+	return new(fastReflection_RouterRoute)
+}
+func (x fastReflection_RouterRoute_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RouterRoute
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RouterRoute) Descriptor() protoreflect.MessageDescriptor {
+	return md_RouterRoute
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RouterRoute) Type() protoreflect.MessageType {
+	return _fastReflection_RouterRoute_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RouterRoute) New() protoreflect.Message {
+	return new(fastReflection_RouterRoute)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RouterRoute) Interface() protoreflect.ProtoMessage {
+	return (*RouterRoute)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RouterRoute) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_RouterRoute_channel_id, value) {
+			return
+		}
+	}
+	if x.BridgeContractAddress != "" {
+		value := protoreflect.ValueOfString(x.BridgeContractAddress)
+		if !f(fd_RouterRoute_bridge_contract_address, value) {
+			return
+		}
+	}
+	if x.DestinationChainId != "" {
+		value := protoreflect.ValueOfString(x.DestinationChainId)
+		if !f(fd_RouterRoute_destination_chain_id, value) {
+			return
+		}
+	}
+	if x.DestinationContractAddress != "" {
+		value := protoreflect.ValueOfString(x.DestinationContractAddress)
+		if !f(fd_RouterRoute_destination_contract_address, value) {
+			return
+		}
+	}
+	if x.DestinationGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DestinationGasLimit)
+		if !f(fd_RouterRoute_destination_gas_limit, value) {
+			return
+		}
+	}
+	if x.Fee != nil {
+		value := protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
+		if !f(fd_RouterRoute_fee, value) {
+			return
+		}
+	}
+	if x.Encoder != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Encoder))
+		if !f(fd_RouterRoute_encoder, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RouterRoute) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterRoute.channel_id":
+		return x.ChannelId != ""
+	case "band.tunnel.v1beta1.RouterRoute.bridge_contract_address":
+		return x.BridgeContractAddress != ""
+	case "band.tunnel.v1beta1.RouterRoute.destination_chain_id":
+		return x.DestinationChainId != ""
+	case "band.tunnel.v1beta1.RouterRoute.destination_contract_address":
+		return x.DestinationContractAddress != ""
+	case "band.tunnel.v1beta1.RouterRoute.destination_gas_limit":
+		return x.DestinationGasLimit != uint64(0)
+	case "band.tunnel.v1beta1.RouterRoute.fee":
+		return x.Fee != nil
+	case "band.tunnel.v1beta1.RouterRoute.encoder":
+		return x.Encoder != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RouterRoute) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterRoute.channel_id":
+		x.ChannelId = ""
+	case "band.tunnel.v1beta1.RouterRoute.bridge_contract_address":
+		x.BridgeContractAddress = ""
+	case "band.tunnel.v1beta1.RouterRoute.destination_chain_id":
+		x.DestinationChainId = ""
+	case "band.tunnel.v1beta1.RouterRoute.destination_contract_address":
+		x.DestinationContractAddress = ""
+	case "band.tunnel.v1beta1.RouterRoute.destination_gas_limit":
+		x.DestinationGasLimit = uint64(0)
+	case "band.tunnel.v1beta1.RouterRoute.fee":
+		x.Fee = nil
+	case "band.tunnel.v1beta1.RouterRoute.encoder":
+		x.Encoder = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RouterRoute) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.RouterRoute.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.RouterRoute.bridge_contract_address":
+		value := x.BridgeContractAddress
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.RouterRoute.destination_chain_id":
+		value := x.DestinationChainId
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.RouterRoute.destination_contract_address":
+		value := x.DestinationContractAddress
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.RouterRoute.destination_gas_limit":
+		value := x.DestinationGasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.RouterRoute.fee":
+		value := x.Fee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.tunnel.v1beta1.RouterRoute.encoder":
+		value := x.Encoder
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterRoute does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RouterRoute) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterRoute.channel_id":
+		x.ChannelId = value.Interface().(string)
+	case "band.tunnel.v1beta1.RouterRoute.bridge_contract_address":
+		x.BridgeContractAddress = value.Interface().(string)
+	case "band.tunnel.v1beta1.RouterRoute.destination_chain_id":
+		x.DestinationChainId = value.Interface().(string)
+	case "band.tunnel.v1beta1.RouterRoute.destination_contract_address":
+		x.DestinationContractAddress = value.Interface().(string)
+	case "band.tunnel.v1beta1.RouterRoute.destination_gas_limit":
+		x.DestinationGasLimit = value.Uint()
+	case "band.tunnel.v1beta1.RouterRoute.fee":
+		x.Fee = value.Message().Interface().(*v1beta11.Coin)
+	case "band.tunnel.v1beta1.RouterRoute.encoder":
+		x.Encoder = (v1beta1.Encoder)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RouterRoute) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterRoute.fee":
+		if x.Fee == nil {
+			x.Fee = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
+	case "band.tunnel.v1beta1.RouterRoute.channel_id":
+		panic(fmt.Errorf("field channel_id of message band.tunnel.v1beta1.RouterRoute is not mutable"))
+	case "band.tunnel.v1beta1.RouterRoute.bridge_contract_address":
+		panic(fmt.Errorf("field bridge_contract_address of message band.tunnel.v1beta1.RouterRoute is not mutable"))
+	case "band.tunnel.v1beta1.RouterRoute.destination_chain_id":
+		panic(fmt.Errorf("field destination_chain_id of message band.tunnel.v1beta1.RouterRoute is not mutable"))
+	case "band.tunnel.v1beta1.RouterRoute.destination_contract_address":
+		panic(fmt.Errorf("field destination_contract_address of message band.tunnel.v1beta1.RouterRoute is not mutable"))
+	case "band.tunnel.v1beta1.RouterRoute.destination_gas_limit":
+		panic(fmt.Errorf("field destination_gas_limit of message band.tunnel.v1beta1.RouterRoute is not mutable"))
+	case "band.tunnel.v1beta1.RouterRoute.encoder":
+		panic(fmt.Errorf("field encoder of message band.tunnel.v1beta1.RouterRoute is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RouterRoute) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterRoute.channel_id":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.RouterRoute.bridge_contract_address":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.RouterRoute.destination_chain_id":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.RouterRoute.destination_contract_address":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.RouterRoute.destination_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.RouterRoute.fee":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.tunnel.v1beta1.RouterRoute.encoder":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RouterRoute) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.RouterRoute", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RouterRoute) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RouterRoute) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RouterRoute) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RouterRoute) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RouterRoute)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BridgeContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DestinationChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DestinationContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DestinationGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.DestinationGasLimit))
+		}
+		if x.Fee != nil {
+			l = options.Size(x.Fee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Encoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.Encoder))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RouterRoute)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Encoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Encoder))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Fee != nil {
+			encoded, err := options.Marshal(x.Fee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.DestinationGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DestinationGasLimit))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.DestinationContractAddress) > 0 {
+			i -= len(x.DestinationContractAddress)
+			copy(dAtA[i:], x.DestinationContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationContractAddress)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.DestinationChainId) > 0 {
+			i -= len(x.DestinationChainId)
+			copy(dAtA[i:], x.DestinationChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationChainId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BridgeContractAddress) > 0 {
+			i -= len(x.BridgeContractAddress)
+			copy(dAtA[i:], x.BridgeContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BridgeContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RouterRoute)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterRoute: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BridgeContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DestinationChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DestinationContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationGasLimit", wireType)
+				}
+				x.DestinationGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DestinationGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Fee == nil {
+					x.Fee = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+				}
+				x.Encoder = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Encoder |= v1beta1.Encoder(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RouterPacketReceipt          protoreflect.MessageDescriptor
+	fd_RouterPacketReceipt_sequence protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_route_proto_init()
+	md_RouterPacketReceipt = File_band_tunnel_v1beta1_route_proto.Messages().ByName("RouterPacketReceipt")
+	fd_RouterPacketReceipt_sequence = md_RouterPacketReceipt.Fields().ByName("sequence")
+}
+
+var _ protoreflect.Message = (*fastReflection_RouterPacketReceipt)(nil)
+
+type fastReflection_RouterPacketReceipt RouterPacketReceipt
+
+func (x *RouterPacketReceipt) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RouterPacketReceipt)(x)
+}
+
+func (x *RouterPacketReceipt) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RouterPacketReceipt_messageType fastReflection_RouterPacketReceipt_messageType
+var _ protoreflect.MessageType = fastReflection_RouterPacketReceipt_messageType{}
+
+type fastReflection_RouterPacketReceipt_messageType struct{}
+
+func (x fastReflection_RouterPacketReceipt_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RouterPacketReceipt)(nil)
+}
+func (x fastReflection_RouterPacketReceipt_messageType) New() protoreflect.Message {
+	return new(fastReflection_RouterPacketReceipt)
+}
+func (x fastReflection_RouterPacketReceipt_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RouterPacketReceipt
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RouterPacketReceipt) Descriptor() protoreflect.MessageDescriptor {
+	return md_RouterPacketReceipt
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RouterPacketReceipt) Type() protoreflect.MessageType {
+	return _fastReflection_RouterPacketReceipt_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_IBCPacketReceipt) New() protoreflect.Message {
-	return new(fastReflection_IBCPacketReceipt)
+func (x *fastReflection_RouterPacketReceipt) New() protoreflect.Message {
+	return new(fastReflection_RouterPacketReceipt)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_IBCPacketReceipt) Interface() protoreflect.ProtoMessage {
-	return (*IBCPacketReceipt)(x)
+func (x *fastReflection_RouterPacketReceipt) Interface() protoreflect.ProtoMessage {
+	return (*RouterPacketReceipt)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1446,10 +3494,10 @@ func (x *fastReflection_IBCPacketReceipt) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_IBCPacketReceipt) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_RouterPacketReceipt) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Sequence != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.Sequence)
-		if !f(fd_IBCPacketReceipt_sequence, value) {
+		if !f(fd_RouterPacketReceipt_sequence, value) {
 			return
 		}
 	}
@@ -1466,15 +3514,15 @@ func (x *fastReflection_IBCPacketReceipt) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_IBCPacketReceipt) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RouterPacketReceipt) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.IBCPacketReceipt.sequence":
+	case "band.tunnel.v1beta1.RouterPacketReceipt.sequence":
 		return x.Sequence != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCPacketReceipt"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCPacketReceipt does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1484,15 +3532,15 @@ func (x *fastReflection_IBCPacketReceipt) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IBCPacketReceipt) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RouterPacketReceipt) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.IBCPacketReceipt.sequence":
+	case "band.tunnel.v1beta1.RouterPacketReceipt.sequence":
 		x.Sequence = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCPacketReceipt"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCPacketReceipt does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1502,16 +3550,16 @@ func (x *fastReflection_IBCPacketReceipt) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_IBCPacketReceipt) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RouterPacketReceipt) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.IBCPacketReceipt.sequence":
+	case "band.tunnel.v1beta1.RouterPacketReceipt.sequence":
 		value := x.Sequence
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCPacketReceipt"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCPacketReceipt does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterPacketReceipt does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1525,15 +3573,15 @@ func (x *fastReflection_IBCPacketReceipt) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IBCPacketReceipt) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RouterPacketReceipt) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.IBCPacketReceipt.sequence":
+	case "band.tunnel.v1beta1.RouterPacketReceipt.sequence":
 		x.Sequence = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCPacketReceipt"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCPacketReceipt does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1547,40 +3595,40 @@ func (x *fastReflection_IBCPacketReceipt) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IBCPacketReceipt) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RouterPacketReceipt) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.IBCPacketReceipt.sequence":
-		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.IBCPacketReceipt is not mutable"))
+	case "band.tunnel.v1beta1.RouterPacketReceipt.sequence":
+		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.RouterPacketReceipt is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCPacketReceipt"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCPacketReceipt does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_IBCPacketReceipt) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RouterPacketReceipt) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.IBCPacketReceipt.sequence":
+	case "band.tunnel.v1beta1.RouterPacketReceipt.sequence":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCPacketReceipt"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCPacketReceipt does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_IBCPacketReceipt) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RouterPacketReceipt) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.IBCPacketReceipt", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.RouterPacketReceipt", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1588,7 +3636,7 @@ func (x *fastReflection_IBCPacketReceipt) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_IBCPacketReceipt) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RouterPacketReceipt) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1599,7 +3647,7 @@ func (x *fastReflection_IBCPacketReceipt) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IBCPacketReceipt) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RouterPacketReceipt) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1611,7 +3659,7 @@ func (x *fastReflection_IBCPacketReceipt) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_IBCPacketReceipt) IsValid() bool {
+func (x *fastReflection_RouterPacketReceipt) IsValid() bool {
 	return x != nil
 }
 
@@ -1621,9 +3669,9 @@ func (x *fastReflection_IBCPacketReceipt) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_IBCPacketReceipt) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RouterPacketReceipt) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*IBCPacketReceipt)
+		x := input.Message.Interface().(*RouterPacketReceipt)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1648,7 +3696,7 @@ func (x *fastReflection_IBCPacketReceipt) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*IBCPacketReceipt)
+		x := input.Message.Interface().(*RouterPacketReceipt)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1683,7 +3731,7 @@ func (x *fastReflection_IBCPacketReceipt) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*IBCPacketReceipt)
+		x := input.Message.Interface().(*RouterPacketReceipt)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1715,10 +3763,10 @@ func (x *fastReflection_IBCPacketReceipt) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCPacketReceipt: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterPacketReceipt: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -1852,7 +3900,7 @@ func (x *TunnelPricesPacketData) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TunnelPricesPacketData) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[4]
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2426,6 +4474,67 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// PacketStatus defines the delivery status of a packet sent through an IBC channel.
+type PacketStatus int32
+
+const (
+	// PACKET_STATUS_UNSPECIFIED defines an unspecified status.
+	PacketStatus_PACKET_STATUS_UNSPECIFIED PacketStatus = 0
+	// PACKET_STATUS_PENDING defines a packet that is sent but not yet acknowledged.
+	PacketStatus_PACKET_STATUS_PENDING PacketStatus = 1
+	// PACKET_STATUS_ACKNOWLEDGED defines a packet that is acknowledged successfully.
+	PacketStatus_PACKET_STATUS_ACKNOWLEDGED PacketStatus = 2
+	// PACKET_STATUS_ERROR_ACK defines a packet that is acknowledged with an error.
+	PacketStatus_PACKET_STATUS_ERROR_ACK PacketStatus = 3
+	// PACKET_STATUS_TIMED_OUT defines a packet that is timed out.
+	PacketStatus_PACKET_STATUS_TIMED_OUT PacketStatus = 4
+)
+
+// Enum value maps for PacketStatus.
+var (
+	PacketStatus_name = map[int32]string{
+		0: "PACKET_STATUS_UNSPECIFIED",
+		1: "PACKET_STATUS_PENDING",
+		2: "PACKET_STATUS_ACKNOWLEDGED",
+		3: "PACKET_STATUS_ERROR_ACK",
+		4: "PACKET_STATUS_TIMED_OUT",
+	}
+	PacketStatus_value = map[string]int32{
+		"PACKET_STATUS_UNSPECIFIED":  0,
+		"PACKET_STATUS_PENDING":      1,
+		"PACKET_STATUS_ACKNOWLEDGED": 2,
+		"PACKET_STATUS_ERROR_ACK":    3,
+		"PACKET_STATUS_TIMED_OUT":    4,
+	}
+)
+
+func (x PacketStatus) Enum() *PacketStatus {
+	p := new(PacketStatus)
+	*p = x
+	return p
+}
+
+func (x PacketStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PacketStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_tunnel_v1beta1_route_proto_enumTypes[0].Descriptor()
+}
+
+func (PacketStatus) Type() protoreflect.EnumType {
+	return &file_band_tunnel_v1beta1_route_proto_enumTypes[0]
+}
+
+func (x PacketStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PacketStatus.Descriptor instead.
+func (PacketStatus) EnumDescriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{0}
+}
+
 // TSSRoute represents a route for TSS packets and implements the RouteI interface.
 type TSSRoute struct {
 	state         protoimpl.MessageState
@@ -2438,6 +4547,9 @@ type TSSRoute struct {
 	DestinationContractAddress string `protobuf:"bytes,2,opt,name=destination_contract_address,json=destinationContractAddress,proto3" json:"destination_contract_address,omitempty"`
 	// encoder is the mode of encoding packet data.
 	Encoder v1beta1.Encoder `protobuf:"varint,3,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
+	// batched is a flag to aggregate the packet with the packets of other batched tunnels targeting
+	// the same destination chain in the same block into a single signing.
+	Batched bool `protobuf:"varint,4,opt,name=batched,proto3" json:"batched,omitempty"`
 }
 
 func (x *TSSRoute) Reset() {
@@ -2481,6 +4593,13 @@ func (x *TSSRoute) GetEncoder() v1beta1.Encoder {
 	return v1beta1.Encoder(0)
 }
 
+func (x *TSSRoute) GetBatched() bool {
+	if x != nil {
+		return x.Batched
+	}
+	return false
+}
+
 // TSSPacketReceipt represents a receipt for a TSS packet and implements the PacketReceiptI interface.
 type TSSPacketReceipt struct {
 	state         protoimpl.MessageState
@@ -2518,6 +4637,89 @@ func (x *TSSPacketReceipt) GetSigningId() uint64 {
 	return 0
 }
 
+// TSSBatchPacketReceipt represents a receipt for a TSS packet that is signed as a leaf of a batch
+// and implements the PacketReceiptI interface.
+type TSSBatchPacketReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signing_id is the signing ID of the batch; zero while the batch is not yet signed.
+	SigningId uint64 `protobuf:"varint,1,opt,name=signing_id,json=signingId,proto3" json:"signing_id,omitempty"`
+	// merkle_root is the root of the Merkle tree of the batch
+	MerkleRoot []byte `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// leaf_index is the index of the packet in the batch
+	LeafIndex uint64 `protobuf:"varint,3,opt,name=leaf_index,json=leafIndex,proto3" json:"leaf_index,omitempty"`
+	// total is the number of packets in the batch
+	Total uint64 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	// proof is the list of sibling hashes from the leaf up to the root of the Merkle tree
+	Proof [][]byte `protobuf:"bytes,5,rep,name=proof,proto3" json:"proof,omitempty"`
+	// failure_reason is the reason why the batch could not be signed; empty unless the signing failed.
+	FailureReason string `protobuf:"bytes,6,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+}
+
+func (x *TSSBatchPacketReceipt) Reset() {
+	*x = TSSBatchPacketReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSSBatchPacketReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSSBatchPacketReceipt) ProtoMessage() {}
+
+// Deprecated: Use TSSBatchPacketReceipt.ProtoReflect.Descriptor instead.
+func (*TSSBatchPacketReceipt) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TSSBatchPacketReceipt) GetSigningId() uint64 {
+	if x != nil {
+		return x.SigningId
+	}
+	return 0
+}
+
+func (x *TSSBatchPacketReceipt) GetMerkleRoot() []byte {
+	if x != nil {
+		return x.MerkleRoot
+	}
+	return nil
+}
+
+func (x *TSSBatchPacketReceipt) GetLeafIndex() uint64 {
+	if x != nil {
+		return x.LeafIndex
+	}
+	return 0
+}
+
+func (x *TSSBatchPacketReceipt) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TSSBatchPacketReceipt) GetProof() [][]byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *TSSBatchPacketReceipt) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
 // IBCRoute represents a route for IBC packets and implements the RouteI interface.
 type IBCRoute struct {
 	state         protoimpl.MessageState
@@ -2531,7 +4733,7 @@ type IBCRoute struct {
 func (x *IBCRoute) Reset() {
 	*x = IBCRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[2]
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2545,7 +4747,7 @@ func (*IBCRoute) ProtoMessage() {}
 
 // Deprecated: Use IBCRoute.ProtoReflect.Descriptor instead.
 func (*IBCRoute) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{2}
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *IBCRoute) GetChannelId() string {
@@ -2563,12 +4765,14 @@ type IBCPacketReceipt struct {
 
 	// sequence is representing the sequence of the IBC packet.
 	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// status is the delivery status of the IBC packet.
+	Status PacketStatus `protobuf:"varint,2,opt,name=status,proto3,enum=band.tunnel.v1beta1.PacketStatus" json:"status,omitempty"`
 }
 
 func (x *IBCPacketReceipt) Reset() {
 	*x = IBCPacketReceipt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[3]
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2582,7 +4786,7 @@ func (*IBCPacketReceipt) ProtoMessage() {}
 
 // Deprecated: Use IBCPacketReceipt.ProtoReflect.Descriptor instead.
 func (*IBCPacketReceipt) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{3}
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *IBCPacketReceipt) GetSequence() uint64 {
@@ -2592,6 +4796,142 @@ func (x *IBCPacketReceipt) GetSequence() uint64 {
 	return 0
 }
 
+func (x *IBCPacketReceipt) GetStatus() PacketStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PacketStatus_PACKET_STATUS_UNSPECIFIED
+}
+
+// RouterRoute represents a route for delivering packets to an EVM contract through an IBC general
+// message passing bridge and implements the RouteI interface.
+type RouterRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// channel_id is the ICS-20 transfer channel ID to the bridge chain
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// bridge_contract_address is the address on the bridge chain that receives the transfer and its memo
+	BridgeContractAddress string `protobuf:"bytes,2,opt,name=bridge_contract_address,json=bridgeContractAddress,proto3" json:"bridge_contract_address,omitempty"`
+	// destination_chain_id is the destination chain ID
+	DestinationChainId string `protobuf:"bytes,3,opt,name=destination_chain_id,json=destinationChainId,proto3" json:"destination_chain_id,omitempty"`
+	// destination_contract_address is the destination contract address
+	DestinationContractAddress string `protobuf:"bytes,4,opt,name=destination_contract_address,json=destinationContractAddress,proto3" json:"destination_contract_address,omitempty"`
+	// destination_gas_limit is the gas limit used when calling the destination contract
+	DestinationGasLimit uint64 `protobuf:"varint,5,opt,name=destination_gas_limit,json=destinationGasLimit,proto3" json:"destination_gas_limit,omitempty"`
+	// fee is the amount transferred to the bridge to pay for delivering each packet
+	Fee *v1beta11.Coin `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	// encoder is the mode of encoding packet data.
+	Encoder v1beta1.Encoder `protobuf:"varint,7,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
+}
+
+func (x *RouterRoute) Reset() {
+	*x = RouterRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouterRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouterRoute) ProtoMessage() {}
+
+// Deprecated: Use RouterRoute.ProtoReflect.Descriptor instead.
+func (*RouterRoute) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RouterRoute) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *RouterRoute) GetBridgeContractAddress() string {
+	if x != nil {
+		return x.BridgeContractAddress
+	}
+	return ""
+}
+
+func (x *RouterRoute) GetDestinationChainId() string {
+	if x != nil {
+		return x.DestinationChainId
+	}
+	return ""
+}
+
+func (x *RouterRoute) GetDestinationContractAddress() string {
+	if x != nil {
+		return x.DestinationContractAddress
+	}
+	return ""
+}
+
+func (x *RouterRoute) GetDestinationGasLimit() uint64 {
+	if x != nil {
+		return x.DestinationGasLimit
+	}
+	return 0
+}
+
+func (x *RouterRoute) GetFee() *v1beta11.Coin {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *RouterRoute) GetEncoder() v1beta1.Encoder {
+	if x != nil {
+		return x.Encoder
+	}
+	return v1beta1.Encoder(0)
+}
+
+// RouterPacketReceipt represents a receipt for a Router packet and implements the PacketReceiptI interface.
+type RouterPacketReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sequence is representing the sequence of the ICS-20 transfer packet sent to the bridge.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *RouterPacketReceipt) Reset() {
+	*x = RouterPacketReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouterPacketReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouterPacketReceipt) ProtoMessage() {}
+
+// Deprecated: Use RouterPacketReceipt.ProtoReflect.Descriptor instead.
+func (*RouterPacketReceipt) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RouterPacketReceipt) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
 // TunnelPricesPacketData represents the IBC packet payload for the tunnel packet.
 type TunnelPricesPacketData struct {
 	state         protoimpl.MessageState
@@ -2611,7 +4951,7 @@ type TunnelPricesPacketData struct {
 func (x *TunnelPricesPacketData) Reset() {
 	*x = TunnelPricesPacketData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[4]
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2625,7 +4965,7 @@ func (*TunnelPricesPacketData) ProtoMessage() {}
 
 // Deprecated: Use TunnelPricesPacketData.ProtoReflect.Descriptor instead.
 func (*TunnelPricesPacketData) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{4}
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TunnelPricesPacketData) GetTunnelId() uint64 {
@@ -2665,11 +5005,13 @@ var file_band_tunnel_v1beta1_route_proto_rawDesc = []byte{
 	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66, 0x65,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66, 0x65,
 	0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x6e, 0x63, 0x6f,
 	0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x62, 0x61, 0x6e, 0x64, 0x2f,
 	0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x66, 0x65,
-	0x65, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd9, 0x01, 0x0a, 0x08, 0x54, 0x53,
+	0x65, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf3, 0x01, 0x0a, 0x08, 0x54, 0x53,
 	0x53, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xe2, 0xde, 0x1f, 0x12, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
@@ -2682,51 +5024,117 @@ var file_band_tunnel_v1beta1_route_proto_rawDesc = []byte{
 	0x73, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73,
 	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
-	0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a, 0x0a, 0xca, 0xb4, 0x2d, 0x06, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x49, 0x22, 0x92, 0x01, 0x0a, 0x10, 0x54, 0x53, 0x53, 0x50, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x6a, 0x0a, 0x0a, 0x73, 0x69,
-	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x4b,
-	0xe2, 0xde, 0x1f, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0xfa, 0xde, 0x1f,
-	0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76,
-	0x33, 0x2f, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x52, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x3a, 0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x22, 0x44, 0x0a, 0x08, 0x49, 0x42,
-	0x43, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x3a, 0x0a, 0xca, 0xb4, 0x2d, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x22,
+	0x92, 0x01, 0x0a, 0x10, 0x54, 0x53, 0x53, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x12, 0x6a, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x4b, 0xe2, 0xde, 0x1f, 0x09, 0x53, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x78, 0x2f, 0x62, 0x61,
+	0x6e, 0x64, 0x74, 0x73, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x69, 0x6e, 0x67, 0x49, 0x44, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64,
+	0x3a, 0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x49, 0x22, 0xaa, 0x02, 0x0a, 0x15, 0x54, 0x53, 0x53, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x6a,
+	0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x4b, 0xe2, 0xde, 0x1f, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49,
+	0x44, 0xfa, 0xde, 0x1f, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c,
+	0x65, 0x61, 0x66, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x6c, 0x65, 0x61, 0x66, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x3a, 0x12, 0xca,
+	0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x49, 0x22, 0x44, 0x0a, 0x08, 0x49, 0x42, 0x43, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x2c, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x3a, 0x0a, 0xca, 0xb4, 0x2d,
+	0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x22, 0x7d, 0x0a, 0x10, 0x49, 0x42, 0x43, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x3a, 0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x22, 0xa9, 0x03, 0x0a, 0x0b, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
 	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09,
 	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x49, 0x64, 0x3a, 0x0a, 0xca, 0xb4, 0x2d, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49,
-	0x22, 0x42, 0x0a, 0x10, 0x49, 0x42, 0x43, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
-	0x3a, 0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x49, 0x22, 0xb7, 0x01, 0x0a, 0x16, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44,
-	0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65,
-	0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0xdf,
-	0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0a, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62,
-	0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x3b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x42,
-	0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa8, 0xe2, 0x1e, 0x01,
+	0x65, 0x6c, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x48, 0x0a, 0x14,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xe2, 0xde, 0x1f, 0x12,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x44, 0x52, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x1c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x31, 0x0a, 0x03,
+	0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12,
+	0x35, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a, 0x0a, 0xca, 0xb4, 0x2d, 0x06, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x49, 0x22, 0x45, 0x0a, 0x13, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x22, 0xb7, 0x01, 0x0a, 0x16, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x2a, 0xa8, 0x01, 0x0a, 0x0c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1e,
+	0x0a, 0x1a, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x41, 0x43, 0x4b, 0x4e, 0x4f, 0x57, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b,
+	0x0a, 0x17, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x41, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x50,
+	0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x49, 0x4d,
+	0x45, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x04, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xdf,
+	0x01, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0a,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x13, 0x42, 0x61, 0x6e,
+	0x64, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0xca, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42, 0x61, 0x6e, 0x64, 0x3a,
+	0x3a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
 	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
@@ -2742,24 +5150,33 @@ func file_band_tunnel_v1beta1_route_proto_rawDescGZIP() []byte {
 	return file_band_tunnel_v1beta1_route_proto_rawDescData
 }
 
-var file_band_tunnel_v1beta1_route_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_band_tunnel_v1beta1_route_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_band_tunnel_v1beta1_route_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_band_tunnel_v1beta1_route_proto_goTypes = []interface{}{
-	(*TSSRoute)(nil),               // 0: band.tunnel.v1beta1.TSSRoute
-	(*TSSPacketReceipt)(nil),       // 1: band.tunnel.v1beta1.TSSPacketReceipt
-	(*IBCRoute)(nil),               // 2: band.tunnel.v1beta1.IBCRoute
-	(*IBCPacketReceipt)(nil),       // 3: band.tunnel.v1beta1.IBCPacketReceipt
-	(*TunnelPricesPacketData)(nil), // 4: band.tunnel.v1beta1.TunnelPricesPacketData
-	(v1beta1.Encoder)(0),           // 5: band.feeds.v1beta1.Encoder
-	(*v1beta1.Price)(nil),          // 6: band.feeds.v1beta1.Price
+	(PacketStatus)(0),              // 0: band.tunnel.v1beta1.PacketStatus
+	(*TSSRoute)(nil),               // 1: band.tunnel.v1beta1.TSSRoute
+	(*TSSPacketReceipt)(nil),       // 2: band.tunnel.v1beta1.TSSPacketReceipt
+	(*TSSBatchPacketReceipt)(nil),  // 3: band.tunnel.v1beta1.TSSBatchPacketReceipt
+	(*IBCRoute)(nil),               // 4: band.tunnel.v1beta1.IBCRoute
+	(*IBCPacketReceipt)(nil),       // 5: band.tunnel.v1beta1.IBCPacketReceipt
+	(*RouterRoute)(nil),            // 6: band.tunnel.v1beta1.RouterRoute
+	(*RouterPacketReceipt)(nil),    // 7: band.tunnel.v1beta1.RouterPacketReceipt
+	(*TunnelPricesPacketData)(nil), // 8: band.tunnel.v1beta1.TunnelPricesPacketData
+	(v1beta1.Encoder)(0),           // 9: band.feeds.v1beta1.Encoder
+	(*v1beta11.Coin)(nil),          // 10: cosmos.base.v1beta1.Coin
+	(*v1beta1.Price)(nil),          // 11: band.feeds.v1beta1.Price
 }
 var file_band_tunnel_v1beta1_route_proto_depIdxs = []int32{
-	5, // 0: band.tunnel.v1beta1.TSSRoute.encoder:type_name -> band.feeds.v1beta1.Encoder
-	6, // 1: band.tunnel.v1beta1.TunnelPricesPacketData.prices:type_name -> band.feeds.v1beta1.Price
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	9,  // 0: band.tunnel.v1beta1.TSSRoute.encoder:type_name -> band.feeds.v1beta1.Encoder
+	0,  // 1: band.tunnel.v1beta1.IBCPacketReceipt.status:type_name -> band.tunnel.v1beta1.PacketStatus
+	10, // 2: band.tunnel.v1beta1.RouterRoute.fee:type_name -> cosmos.base.v1beta1.Coin
+	9,  // 3: band.tunnel.v1beta1.RouterRoute.encoder:type_name -> band.feeds.v1beta1.Encoder
+	11, // 4: band.tunnel.v1beta1.TunnelPricesPacketData.prices:type_name -> band.feeds.v1beta1.Price
+	5,  // [5:5] is the sub-list for method output_type
+	5,  // [5:5] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_band_tunnel_v1beta1_route_proto_init() }
@@ -2793,7 +5210,7 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_route_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IBCRoute); i {
+			switch v := v.(*TSSBatchPacketReceipt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2805,7 +5222,7 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_route_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IBCPacketReceipt); i {
+			switch v := v.(*IBCRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2817,6 +5234,42 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_route_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IBCPacketReceipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_route_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouterRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_route_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouterPacketReceipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_route_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TunnelPricesPacketData); i {
 			case 0:
 				return &v.state
@@ -2834,13 +5287,14 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_tunnel_v1beta1_route_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      1,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_band_tunnel_v1beta1_route_proto_goTypes,
 		DependencyIndexes: file_band_tunnel_v1beta1_route_proto_depIdxs,
+		EnumInfos:         file_band_tunnel_v1beta1_route_proto_enumTypes,
 		MessageInfos:      file_band_tunnel_v1beta1_route_proto_msgTypes,
 	}.Build()
 	File_band_tunnel_v1beta1_route_proto = out.File
@@ -120,18 +120,72 @@ func (x *_Tunnel_7_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Tunnel_12_list)(nil)
+
+type _Tunnel_12_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Tunnel_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Tunnel_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Tunnel_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Tunnel_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Tunnel_12_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Tunnel_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Tunnel_12_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Tunnel_12_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Tunnel                   protoreflect.MessageDescriptor
-	fd_Tunnel_id                protoreflect.FieldDescriptor
-	fd_Tunnel_sequence          protoreflect.FieldDescriptor
-	fd_Tunnel_route             protoreflect.FieldDescriptor
-	fd_Tunnel_fee_payer         protoreflect.FieldDescriptor
-	fd_Tunnel_signal_deviations protoreflect.FieldDescriptor
-	fd_Tunnel_interval          protoreflect.FieldDescriptor
-	fd_Tunnel_total_deposit     protoreflect.FieldDescriptor
-	fd_Tunnel_is_active         protoreflect.FieldDescriptor
-	fd_Tunnel_created_at        protoreflect.FieldDescriptor
-	fd_Tunnel_creator           protoreflect.FieldDescriptor
+	md_Tunnel                       protoreflect.MessageDescriptor
+	fd_Tunnel_id                    protoreflect.FieldDescriptor
+	fd_Tunnel_sequence              protoreflect.FieldDescriptor
+	fd_Tunnel_route                 protoreflect.FieldDescriptor
+	fd_Tunnel_fee_payer             protoreflect.FieldDescriptor
+	fd_Tunnel_signal_deviations     protoreflect.FieldDescriptor
+	fd_Tunnel_interval              protoreflect.FieldDescriptor
+	fd_Tunnel_total_deposit         protoreflect.FieldDescriptor
+	fd_Tunnel_is_active             protoreflect.FieldDescriptor
+	fd_Tunnel_created_at            protoreflect.FieldDescriptor
+	fd_Tunnel_creator               protoreflect.FieldDescriptor
+	fd_Tunnel_schedule              protoreflect.FieldDescriptor
+	fd_Tunnel_low_balance_threshold protoreflect.FieldDescriptor
+	fd_Tunnel_owner                 protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -147,6 +201,9 @@ func init() {
 	fd_Tunnel_is_active = md_Tunnel.Fields().ByName("is_active")
 	fd_Tunnel_created_at = md_Tunnel.Fields().ByName("created_at")
 	fd_Tunnel_creator = md_Tunnel.Fields().ByName("creator")
+	fd_Tunnel_schedule = md_Tunnel.Fields().ByName("schedule")
+	fd_Tunnel_low_balance_threshold = md_Tunnel.Fields().ByName("low_balance_threshold")
+	fd_Tunnel_owner = md_Tunnel.Fields().ByName("owner")
 }
 
 var _ protoreflect.Message = (*fastReflection_Tunnel)(nil)
@@ -274,6 +331,24 @@ func (x *fastReflection_Tunnel) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.Schedule != nil {
+		value := protoreflect.ValueOfMessage(x.Schedule.ProtoReflect())
+		if !f(fd_Tunnel_schedule, value) {
+			return
+		}
+	}
+	if len(x.LowBalanceThreshold) != 0 {
+		value := protoreflect.ValueOfList(&_Tunnel_12_list{list: &x.LowBalanceThreshold})
+		if !f(fd_Tunnel_low_balance_threshold, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_Tunnel_owner, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -309,6 +384,12 @@ func (x *fastReflection_Tunnel) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.CreatedAt != int64(0)
 	case "band.tunnel.v1beta1.Tunnel.creator":
 		return x.Creator != ""
+	case "band.tunnel.v1beta1.Tunnel.schedule":
+		return x.Schedule != nil
+	case "band.tunnel.v1beta1.Tunnel.low_balance_threshold":
+		return len(x.LowBalanceThreshold) != 0
+	case "band.tunnel.v1beta1.Tunnel.owner":
+		return x.Owner != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Tunnel"))
@@ -345,6 +426,12 @@ func (x *fastReflection_Tunnel) Clear(fd protoreflect.FieldDescriptor) {
 		x.CreatedAt = int64(0)
 	case "band.tunnel.v1beta1.Tunnel.creator":
 		x.Creator = ""
+	case "band.tunnel.v1beta1.Tunnel.schedule":
+		x.Schedule = nil
+	case "band.tunnel.v1beta1.Tunnel.low_balance_threshold":
+		x.LowBalanceThreshold = nil
+	case "band.tunnel.v1beta1.Tunnel.owner":
+		x.Owner = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Tunnel"))
@@ -397,6 +484,18 @@ func (x *fastReflection_Tunnel) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "band.tunnel.v1beta1.Tunnel.creator":
 		value := x.Creator
 		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.Tunnel.schedule":
+		value := x.Schedule
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.tunnel.v1beta1.Tunnel.low_balance_threshold":
+		if len(x.LowBalanceThreshold) == 0 {
+			return protoreflect.ValueOfList(&_Tunnel_12_list{})
+		}
+		listValue := &_Tunnel_12_list{list: &x.LowBalanceThreshold}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.Tunnel.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Tunnel"))
@@ -441,6 +540,14 @@ func (x *fastReflection_Tunnel) Set(fd protoreflect.FieldDescriptor, value proto
 		x.CreatedAt = value.Int()
 	case "band.tunnel.v1beta1.Tunnel.creator":
 		x.Creator = value.Interface().(string)
+	case "band.tunnel.v1beta1.Tunnel.schedule":
+		x.Schedule = value.Message().Interface().(*Schedule)
+	case "band.tunnel.v1beta1.Tunnel.low_balance_threshold":
+		lv := value.List()
+		clv := lv.(*_Tunnel_12_list)
+		x.LowBalanceThreshold = *clv.list
+	case "band.tunnel.v1beta1.Tunnel.owner":
+		x.Owner = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Tunnel"))
@@ -478,6 +585,17 @@ func (x *fastReflection_Tunnel) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Tunnel_7_list{list: &x.TotalDeposit}
 		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.Tunnel.schedule":
+		if x.Schedule == nil {
+			x.Schedule = new(Schedule)
+		}
+		return protoreflect.ValueOfMessage(x.Schedule.ProtoReflect())
+	case "band.tunnel.v1beta1.Tunnel.low_balance_threshold":
+		if x.LowBalanceThreshold == nil {
+			x.LowBalanceThreshold = []*v1beta1.Coin{}
+		}
+		value := &_Tunnel_12_list{list: &x.LowBalanceThreshold}
+		return protoreflect.ValueOfList(value)
 	case "band.tunnel.v1beta1.Tunnel.id":
 		panic(fmt.Errorf("field id of message band.tunnel.v1beta1.Tunnel is not mutable"))
 	case "band.tunnel.v1beta1.Tunnel.sequence":
@@ -492,6 +610,8 @@ func (x *fastReflection_Tunnel) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field created_at of message band.tunnel.v1beta1.Tunnel is not mutable"))
 	case "band.tunnel.v1beta1.Tunnel.creator":
 		panic(fmt.Errorf("field creator of message band.tunnel.v1beta1.Tunnel is not mutable"))
+	case "band.tunnel.v1beta1.Tunnel.owner":
+		panic(fmt.Errorf("field owner of message band.tunnel.v1beta1.Tunnel is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Tunnel"))
@@ -528,6 +648,14 @@ func (x *fastReflection_Tunnel) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfInt64(int64(0))
 	case "band.tunnel.v1beta1.Tunnel.creator":
 		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.Tunnel.schedule":
+		m := new(Schedule)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.tunnel.v1beta1.Tunnel.low_balance_threshold":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Tunnel_12_list{list: &list})
+	case "band.tunnel.v1beta1.Tunnel.owner":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Tunnel"))
@@ -636,6 +764,20 @@ func (x *fastReflection_Tunnel) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Schedule != nil {
+			l = options.Size(x.Schedule)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.LowBalanceThreshold) > 0 {
+			for _, e := range x.LowBalanceThreshold {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -665,6 +807,43 @@ func (x *fastReflection_Tunnel) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.LowBalanceThreshold) > 0 {
+			for iNdEx := len(x.LowBalanceThreshold) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LowBalanceThreshold[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.Schedule != nil {
+			encoded, err := options.Marshal(x.Schedule)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
 		if len(x.Creator) > 0 {
 			i -= len(x.Creator)
 			copy(dAtA[i:], x.Creator)
@@ -1068,6 +1247,108 @@ func (x *fastReflection_Tunnel) ProtoMethods() *protoiface.Methods {
 				}
 				x.Creator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Schedule == nil {
+					x.Schedule = &Schedule{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Schedule); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LowBalanceThreshold", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LowBalanceThreshold = append(x.LowBalanceThreshold, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LowBalanceThreshold[len(x.LowBalanceThreshold)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1103,81 +1384,76 @@ func (x *fastReflection_Tunnel) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_LatestPrices_2_list)(nil)
+var _ protoreflect.List = (*_TunnelOperator_3_list)(nil)
 
-type _LatestPrices_2_list struct {
-	list *[]*v1beta11.Price
+type _TunnelOperator_3_list struct {
+	list *[]OperatorRole
 }
 
-func (x *_LatestPrices_2_list) Len() int {
+func (x *_TunnelOperator_3_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_LatestPrices_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func (x *_TunnelOperator_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
 }
 
-func (x *_LatestPrices_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta11.Price)
+func (x *_TunnelOperator_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (OperatorRole)(valueUnwrapped)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_LatestPrices_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta11.Price)
+func (x *_TunnelOperator_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (OperatorRole)(valueUnwrapped)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_LatestPrices_2_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta11.Price)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_TunnelOperator_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TunnelOperator at list field Roles as it is not of Message kind"))
 }
 
-func (x *_LatestPrices_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
+func (x *_TunnelOperator_3_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_LatestPrices_2_list) NewElement() protoreflect.Value {
-	v := new(v1beta11.Price)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_TunnelOperator_3_list) NewElement() protoreflect.Value {
+	v := 0
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
 }
 
-func (x *_LatestPrices_2_list) IsValid() bool {
+func (x *_TunnelOperator_3_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_LatestPrices               protoreflect.MessageDescriptor
-	fd_LatestPrices_tunnel_id     protoreflect.FieldDescriptor
-	fd_LatestPrices_prices        protoreflect.FieldDescriptor
-	fd_LatestPrices_last_interval protoreflect.FieldDescriptor
+	md_TunnelOperator           protoreflect.MessageDescriptor
+	fd_TunnelOperator_tunnel_id protoreflect.FieldDescriptor
+	fd_TunnelOperator_address   protoreflect.FieldDescriptor
+	fd_TunnelOperator_roles     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_tunnel_proto_init()
-	md_LatestPrices = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("LatestPrices")
-	fd_LatestPrices_tunnel_id = md_LatestPrices.Fields().ByName("tunnel_id")
-	fd_LatestPrices_prices = md_LatestPrices.Fields().ByName("prices")
-	fd_LatestPrices_last_interval = md_LatestPrices.Fields().ByName("last_interval")
+	md_TunnelOperator = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("TunnelOperator")
+	fd_TunnelOperator_tunnel_id = md_TunnelOperator.Fields().ByName("tunnel_id")
+	fd_TunnelOperator_address = md_TunnelOperator.Fields().ByName("address")
+	fd_TunnelOperator_roles = md_TunnelOperator.Fields().ByName("roles")
 }
 
-var _ protoreflect.Message = (*fastReflection_LatestPrices)(nil)
+var _ protoreflect.Message = (*fastReflection_TunnelOperator)(nil)
 
-type fastReflection_LatestPrices LatestPrices
+type fastReflection_TunnelOperator TunnelOperator
 
-func (x *LatestPrices) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_LatestPrices)(x)
+func (x *TunnelOperator) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TunnelOperator)(x)
 }
 
-func (x *LatestPrices) slowProtoReflect() protoreflect.Message {
+func (x *TunnelOperator) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1189,43 +1465,43 @@ func (x *LatestPrices) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_LatestPrices_messageType fastReflection_LatestPrices_messageType
-var _ protoreflect.MessageType = fastReflection_LatestPrices_messageType{}
+var _fastReflection_TunnelOperator_messageType fastReflection_TunnelOperator_messageType
+var _ protoreflect.MessageType = fastReflection_TunnelOperator_messageType{}
 
-type fastReflection_LatestPrices_messageType struct{}
+type fastReflection_TunnelOperator_messageType struct{}
 
-func (x fastReflection_LatestPrices_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_LatestPrices)(nil)
+func (x fastReflection_TunnelOperator_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TunnelOperator)(nil)
 }
-func (x fastReflection_LatestPrices_messageType) New() protoreflect.Message {
-	return new(fastReflection_LatestPrices)
+func (x fastReflection_TunnelOperator_messageType) New() protoreflect.Message {
+	return new(fastReflection_TunnelOperator)
 }
-func (x fastReflection_LatestPrices_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_LatestPrices
+func (x fastReflection_TunnelOperator_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TunnelOperator
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_LatestPrices) Descriptor() protoreflect.MessageDescriptor {
-	return md_LatestPrices
+func (x *fastReflection_TunnelOperator) Descriptor() protoreflect.MessageDescriptor {
+	return md_TunnelOperator
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_LatestPrices) Type() protoreflect.MessageType {
-	return _fastReflection_LatestPrices_messageType
+func (x *fastReflection_TunnelOperator) Type() protoreflect.MessageType {
+	return _fastReflection_TunnelOperator_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_LatestPrices) New() protoreflect.Message {
-	return new(fastReflection_LatestPrices)
+func (x *fastReflection_TunnelOperator) New() protoreflect.Message {
+	return new(fastReflection_TunnelOperator)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_LatestPrices) Interface() protoreflect.ProtoMessage {
-	return (*LatestPrices)(x)
+func (x *fastReflection_TunnelOperator) Interface() protoreflect.ProtoMessage {
+	return (*TunnelOperator)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1233,22 +1509,22 @@ func (x *fastReflection_LatestPrices) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_LatestPrices) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_TunnelOperator) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.TunnelId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.TunnelId)
-		if !f(fd_LatestPrices_tunnel_id, value) {
+		if !f(fd_TunnelOperator_tunnel_id, value) {
 			return
 		}
 	}
-	if len(x.Prices) != 0 {
-		value := protoreflect.ValueOfList(&_LatestPrices_2_list{list: &x.Prices})
-		if !f(fd_LatestPrices_prices, value) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_TunnelOperator_address, value) {
 			return
 		}
 	}
-	if x.LastInterval != int64(0) {
-		value := protoreflect.ValueOfInt64(x.LastInterval)
-		if !f(fd_LatestPrices_last_interval, value) {
+	if len(x.Roles) != 0 {
+		value := protoreflect.ValueOfList(&_TunnelOperator_3_list{list: &x.Roles})
+		if !f(fd_TunnelOperator_roles, value) {
 			return
 		}
 	}
@@ -1265,19 +1541,19 @@ func (x *fastReflection_LatestPrices) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_LatestPrices) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TunnelOperator) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
+	case "band.tunnel.v1beta1.TunnelOperator.tunnel_id":
 		return x.TunnelId != uint64(0)
-	case "band.tunnel.v1beta1.LatestPrices.prices":
-		return len(x.Prices) != 0
-	case "band.tunnel.v1beta1.LatestPrices.last_interval":
-		return x.LastInterval != int64(0)
+	case "band.tunnel.v1beta1.TunnelOperator.address":
+		return x.Address != ""
+	case "band.tunnel.v1beta1.TunnelOperator.roles":
+		return len(x.Roles) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelOperator"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelOperator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1287,19 +1563,19 @@ func (x *fastReflection_LatestPrices) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LatestPrices) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TunnelOperator) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
+	case "band.tunnel.v1beta1.TunnelOperator.tunnel_id":
 		x.TunnelId = uint64(0)
-	case "band.tunnel.v1beta1.LatestPrices.prices":
-		x.Prices = nil
-	case "band.tunnel.v1beta1.LatestPrices.last_interval":
-		x.LastInterval = int64(0)
+	case "band.tunnel.v1beta1.TunnelOperator.address":
+		x.Address = ""
+	case "band.tunnel.v1beta1.TunnelOperator.roles":
+		x.Roles = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelOperator"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelOperator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1309,25 +1585,25 @@ func (x *fastReflection_LatestPrices) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_LatestPrices) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TunnelOperator) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
+	case "band.tunnel.v1beta1.TunnelOperator.tunnel_id":
 		value := x.TunnelId
 		return protoreflect.ValueOfUint64(value)
-	case "band.tunnel.v1beta1.LatestPrices.prices":
-		if len(x.Prices) == 0 {
-			return protoreflect.ValueOfList(&_LatestPrices_2_list{})
+	case "band.tunnel.v1beta1.TunnelOperator.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.TunnelOperator.roles":
+		if len(x.Roles) == 0 {
+			return protoreflect.ValueOfList(&_TunnelOperator_3_list{})
 		}
-		listValue := &_LatestPrices_2_list{list: &x.Prices}
+		listValue := &_TunnelOperator_3_list{list: &x.Roles}
 		return protoreflect.ValueOfList(listValue)
-	case "band.tunnel.v1beta1.LatestPrices.last_interval":
-		value := x.LastInterval
-		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelOperator"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelOperator does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1341,21 +1617,21 @@ func (x *fastReflection_LatestPrices) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LatestPrices) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TunnelOperator) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
+	case "band.tunnel.v1beta1.TunnelOperator.tunnel_id":
 		x.TunnelId = value.Uint()
-	case "band.tunnel.v1beta1.LatestPrices.prices":
+	case "band.tunnel.v1beta1.TunnelOperator.address":
+		x.Address = value.Interface().(string)
+	case "band.tunnel.v1beta1.TunnelOperator.roles":
 		lv := value.List()
-		clv := lv.(*_LatestPrices_2_list)
-		x.Prices = *clv.list
-	case "band.tunnel.v1beta1.LatestPrices.last_interval":
-		x.LastInterval = value.Int()
+		clv := lv.(*_TunnelOperator_3_list)
+		x.Roles = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelOperator"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelOperator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1369,53 +1645,53 @@ func (x *fastReflection_LatestPrices) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LatestPrices) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TunnelOperator) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.LatestPrices.prices":
-		if x.Prices == nil {
-			x.Prices = []*v1beta11.Price{}
+	case "band.tunnel.v1beta1.TunnelOperator.roles":
+		if x.Roles == nil {
+			x.Roles = []OperatorRole{}
 		}
-		value := &_LatestPrices_2_list{list: &x.Prices}
+		value := &_TunnelOperator_3_list{list: &x.Roles}
 		return protoreflect.ValueOfList(value)
-	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
-		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.LatestPrices is not mutable"))
-	case "band.tunnel.v1beta1.LatestPrices.last_interval":
-		panic(fmt.Errorf("field last_interval of message band.tunnel.v1beta1.LatestPrices is not mutable"))
+	case "band.tunnel.v1beta1.TunnelOperator.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.TunnelOperator is not mutable"))
+	case "band.tunnel.v1beta1.TunnelOperator.address":
+		panic(fmt.Errorf("field address of message band.tunnel.v1beta1.TunnelOperator is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelOperator"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelOperator does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_LatestPrices) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TunnelOperator) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
+	case "band.tunnel.v1beta1.TunnelOperator.tunnel_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.tunnel.v1beta1.LatestPrices.prices":
-		list := []*v1beta11.Price{}
-		return protoreflect.ValueOfList(&_LatestPrices_2_list{list: &list})
-	case "band.tunnel.v1beta1.LatestPrices.last_interval":
-		return protoreflect.ValueOfInt64(int64(0))
+	case "band.tunnel.v1beta1.TunnelOperator.address":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.TunnelOperator.roles":
+		list := []OperatorRole{}
+		return protoreflect.ValueOfList(&_TunnelOperator_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelOperator"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelOperator does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_LatestPrices) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TunnelOperator) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.LatestPrices", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.TunnelOperator", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1423,7 +1699,7 @@ func (x *fastReflection_LatestPrices) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_LatestPrices) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TunnelOperator) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1434,7 +1710,7 @@ func (x *fastReflection_LatestPrices) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LatestPrices) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TunnelOperator) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1446,7 +1722,7 @@ func (x *fastReflection_LatestPrices) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_LatestPrices) IsValid() bool {
+func (x *fastReflection_TunnelOperator) IsValid() bool {
 	return x != nil
 }
 
@@ -1456,9 +1732,9 @@ func (x *fastReflection_LatestPrices) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_LatestPrices) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TunnelOperator) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*LatestPrices)
+		x := input.Message.Interface().(*TunnelOperator)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1473,14 +1749,16 @@ func (x *fastReflection_LatestPrices) ProtoMethods() *protoiface.Methods {
 		if x.TunnelId != 0 {
 			n += 1 + runtime.Sov(uint64(x.TunnelId))
 		}
-		if len(x.Prices) > 0 {
-			for _, e := range x.Prices {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.LastInterval != 0 {
-			n += 1 + runtime.Sov(uint64(x.LastInterval))
+		if len(x.Roles) > 0 {
+			l = 0
+			for _, e := range x.Roles {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1492,7 +1770,7 @@ func (x *fastReflection_LatestPrices) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*LatestPrices)
+		x := input.Message.Interface().(*TunnelOperator)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1511,26 +1789,33 @@ func (x *fastReflection_LatestPrices) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.LastInterval != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastInterval))
+		if len(x.Roles) > 0 {
+			var pksize2 int
+			for _, num := range x.Roles {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num1 := range x.Roles {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x1a
 		}
-		if len(x.Prices) > 0 {
-			for iNdEx := len(x.Prices) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Prices[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
 		}
 		if x.TunnelId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
@@ -1548,7 +1833,7 @@ func (x *fastReflection_LatestPrices) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*LatestPrices)
+		x := input.Message.Interface().(*TunnelOperator)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1580,10 +1865,10 @@ func (x *fastReflection_LatestPrices) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LatestPrices: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TunnelOperator: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LatestPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TunnelOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -1607,9 +1892,9 @@ func (x *fastReflection_LatestPrices) ProtoMethods() *protoiface.Methods {
 				}
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1619,44 +1904,92 @@ func (x *fastReflection_LatestPrices) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Prices = append(x.Prices, &v1beta11.Price{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Prices[len(x.Prices)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastInterval", wireType)
-				}
-				x.LastInterval = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				if wireType == 0 {
+					var v OperatorRole
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= OperatorRole(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
-					if iNdEx >= l {
+					x.Roles = append(x.Roles, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.LastInterval |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
+					var elementCount int
+					if elementCount != 0 && len(x.Roles) == 0 {
+						x.Roles = make([]OperatorRole, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v OperatorRole
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= OperatorRole(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.Roles = append(x.Roles, v)
 					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 				}
 			default:
 				iNdEx = preIndex
@@ -1693,77 +2026,74 @@ func (x *fastReflection_LatestPrices) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_TotalFees_1_list)(nil)
+var _ protoreflect.List = (*_Schedule_2_list)(nil)
 
-type _TotalFees_1_list struct {
-	list *[]*v1beta1.Coin
+type _Schedule_2_list struct {
+	list *[]uint64
 }
 
-func (x *_TotalFees_1_list) Len() int {
+func (x *_Schedule_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_TotalFees_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func (x *_Schedule_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
 }
 
-func (x *_TotalFees_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+func (x *_Schedule_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_TotalFees_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+func (x *_Schedule_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_TotalFees_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_Schedule_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Schedule at list field BlockHeights as it is not of Message kind"))
 }
 
-func (x *_TotalFees_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
+func (x *_Schedule_2_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_TotalFees_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_Schedule_2_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
 }
 
-func (x *_TotalFees_1_list) IsValid() bool {
+func (x *_Schedule_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_TotalFees                       protoreflect.MessageDescriptor
-	fd_TotalFees_total_base_packet_fee protoreflect.FieldDescriptor
+	md_Schedule               protoreflect.MessageDescriptor
+	fd_Schedule_cron          protoreflect.FieldDescriptor
+	fd_Schedule_block_heights protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_tunnel_proto_init()
-	md_TotalFees = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("TotalFees")
-	fd_TotalFees_total_base_packet_fee = md_TotalFees.Fields().ByName("total_base_packet_fee")
+	md_Schedule = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("Schedule")
+	fd_Schedule_cron = md_Schedule.Fields().ByName("cron")
+	fd_Schedule_block_heights = md_Schedule.Fields().ByName("block_heights")
 }
 
-var _ protoreflect.Message = (*fastReflection_TotalFees)(nil)
+var _ protoreflect.Message = (*fastReflection_Schedule)(nil)
 
-type fastReflection_TotalFees TotalFees
+type fastReflection_Schedule Schedule
 
-func (x *TotalFees) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TotalFees)(x)
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Schedule)(x)
 }
 
-func (x *TotalFees) slowProtoReflect() protoreflect.Message {
+func (x *Schedule) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1775,43 +2105,43 @@ func (x *TotalFees) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TotalFees_messageType fastReflection_TotalFees_messageType
-var _ protoreflect.MessageType = fastReflection_TotalFees_messageType{}
+var _fastReflection_Schedule_messageType fastReflection_Schedule_messageType
+var _ protoreflect.MessageType = fastReflection_Schedule_messageType{}
 
-type fastReflection_TotalFees_messageType struct{}
+type fastReflection_Schedule_messageType struct{}
 
-func (x fastReflection_TotalFees_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TotalFees)(nil)
+func (x fastReflection_Schedule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Schedule)(nil)
 }
-func (x fastReflection_TotalFees_messageType) New() protoreflect.Message {
-	return new(fastReflection_TotalFees)
+func (x fastReflection_Schedule_messageType) New() protoreflect.Message {
+	return new(fastReflection_Schedule)
 }
-func (x fastReflection_TotalFees_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TotalFees
+func (x fastReflection_Schedule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Schedule
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TotalFees) Descriptor() protoreflect.MessageDescriptor {
-	return md_TotalFees
+func (x *fastReflection_Schedule) Descriptor() protoreflect.MessageDescriptor {
+	return md_Schedule
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TotalFees) Type() protoreflect.MessageType {
-	return _fastReflection_TotalFees_messageType
+func (x *fastReflection_Schedule) Type() protoreflect.MessageType {
+	return _fastReflection_Schedule_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TotalFees) New() protoreflect.Message {
-	return new(fastReflection_TotalFees)
+func (x *fastReflection_Schedule) New() protoreflect.Message {
+	return new(fastReflection_Schedule)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TotalFees) Interface() protoreflect.ProtoMessage {
-	return (*TotalFees)(x)
+func (x *fastReflection_Schedule) Interface() protoreflect.ProtoMessage {
+	return (*Schedule)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1819,10 +2149,16 @@ func (x *fastReflection_TotalFees) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TotalFees) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.TotalBasePacketFee) != 0 {
-		value := protoreflect.ValueOfList(&_TotalFees_1_list{list: &x.TotalBasePacketFee})
-		if !f(fd_TotalFees_total_base_packet_fee, value) {
+func (x *fastReflection_Schedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Cron != "" {
+		value := protoreflect.ValueOfString(x.Cron)
+		if !f(fd_Schedule_cron, value) {
+			return
+		}
+	}
+	if len(x.BlockHeights) != 0 {
+		value := protoreflect.ValueOfList(&_Schedule_2_list{list: &x.BlockHeights})
+		if !f(fd_Schedule_block_heights, value) {
 			return
 		}
 	}
@@ -1839,15 +2175,17 @@ func (x *fastReflection_TotalFees) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TotalFees) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Schedule) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
-		return len(x.TotalBasePacketFee) != 0
+	case "band.tunnel.v1beta1.Schedule.cron":
+		return x.Cron != ""
+	case "band.tunnel.v1beta1.Schedule.block_heights":
+		return len(x.BlockHeights) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Schedule"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Schedule does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1857,15 +2195,17 @@ func (x *fastReflection_TotalFees) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TotalFees) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Schedule) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
-		x.TotalBasePacketFee = nil
+	case "band.tunnel.v1beta1.Schedule.cron":
+		x.Cron = ""
+	case "band.tunnel.v1beta1.Schedule.block_heights":
+		x.BlockHeights = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Schedule"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Schedule does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1875,19 +2215,22 @@ func (x *fastReflection_TotalFees) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TotalFees) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Schedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
-		if len(x.TotalBasePacketFee) == 0 {
-			return protoreflect.ValueOfList(&_TotalFees_1_list{})
+	case "band.tunnel.v1beta1.Schedule.cron":
+		value := x.Cron
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.Schedule.block_heights":
+		if len(x.BlockHeights) == 0 {
+			return protoreflect.ValueOfList(&_Schedule_2_list{})
 		}
-		listValue := &_TotalFees_1_list{list: &x.TotalBasePacketFee}
+		listValue := &_Schedule_2_list{list: &x.BlockHeights}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Schedule"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Schedule does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1901,17 +2244,19 @@ func (x *fastReflection_TotalFees) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TotalFees) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Schedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
+	case "band.tunnel.v1beta1.Schedule.cron":
+		x.Cron = value.Interface().(string)
+	case "band.tunnel.v1beta1.Schedule.block_heights":
 		lv := value.List()
-		clv := lv.(*_TotalFees_1_list)
-		x.TotalBasePacketFee = *clv.list
+		clv := lv.(*_Schedule_2_list)
+		x.BlockHeights = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Schedule"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Schedule does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1925,45 +2270,49 @@ func (x *fastReflection_TotalFees) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TotalFees) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Schedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
-		if x.TotalBasePacketFee == nil {
-			x.TotalBasePacketFee = []*v1beta1.Coin{}
+	case "band.tunnel.v1beta1.Schedule.block_heights":
+		if x.BlockHeights == nil {
+			x.BlockHeights = []uint64{}
 		}
-		value := &_TotalFees_1_list{list: &x.TotalBasePacketFee}
+		value := &_Schedule_2_list{list: &x.BlockHeights}
 		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.Schedule.cron":
+		panic(fmt.Errorf("field cron of message band.tunnel.v1beta1.Schedule is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Schedule"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Schedule does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TotalFees) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Schedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_TotalFees_1_list{list: &list})
+	case "band.tunnel.v1beta1.Schedule.cron":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.Schedule.block_heights":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_Schedule_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Schedule"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Schedule does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TotalFees) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Schedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.TotalFees", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.Schedule", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1971,7 +2320,7 @@ func (x *fastReflection_TotalFees) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TotalFees) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Schedule) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1982,7 +2331,7 @@ func (x *fastReflection_TotalFees) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TotalFees) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Schedule) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1994,7 +2343,7 @@ func (x *fastReflection_TotalFees) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TotalFees) IsValid() bool {
+func (x *fastReflection_Schedule) IsValid() bool {
 	return x != nil
 }
 
@@ -2004,9 +2353,9 @@ func (x *fastReflection_TotalFees) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TotalFees) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Schedule) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TotalFees)
+		x := input.Message.Interface().(*Schedule)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2018,11 +2367,16 @@ func (x *fastReflection_TotalFees) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.TotalBasePacketFee) > 0 {
-			for _, e := range x.TotalBasePacketFee {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
+		l = len(x.Cron)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.BlockHeights) > 0 {
+			l = 0
+			for _, e := range x.BlockHeights {
+				l += runtime.Sov(uint64(e))
 			}
+			n += 1 + runtime.Sov(uint64(l)) + l
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2034,7 +2388,7 @@ func (x *fastReflection_TotalFees) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TotalFees)
+		x := input.Message.Interface().(*Schedule)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2053,21 +2407,32 @@ func (x *fastReflection_TotalFees) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.TotalBasePacketFee) > 0 {
-			for iNdEx := len(x.TotalBasePacketFee) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TotalBasePacketFee[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
+		if len(x.BlockHeights) > 0 {
+			var pksize2 int
+			for _, num := range x.BlockHeights {
+				pksize2 += runtime.Sov(uint64(num))
 			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.BlockHeights {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Cron) > 0 {
+			i -= len(x.Cron)
+			copy(dAtA[i:], x.Cron)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Cron)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2080,7 +2445,7 @@ func (x *fastReflection_TotalFees) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TotalFees)
+		x := input.Message.Interface().(*Schedule)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2112,17 +2477,17 @@ func (x *fastReflection_TotalFees) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TotalFees: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Schedule: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TotalFees: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalBasePacketFee", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2132,26 +2497,100 @@ func (x *fastReflection_TotalFees) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TotalBasePacketFee = append(x.TotalBasePacketFee, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalBasePacketFee[len(x.TotalBasePacketFee)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Cron = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.BlockHeights = append(x.BlockHeights, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.BlockHeights) == 0 {
+						x.BlockHeights = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.BlockHeights = append(x.BlockHeights, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeights", wireType)
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2187,85 +2626,83 @@ func (x *fastReflection_TotalFees) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Packet_3_list)(nil)
+var _ protoreflect.List = (*_LatestPrices_2_list)(nil)
 
-type _Packet_3_list struct {
+type _LatestPrices_2_list struct {
 	list *[]*v1beta11.Price
 }
 
-func (x *_Packet_3_list) Len() int {
+func (x *_LatestPrices_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Packet_3_list) Get(i int) protoreflect.Value {
+func (x *_LatestPrices_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Packet_3_list) Set(i int, value protoreflect.Value) {
+func (x *_LatestPrices_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta11.Price)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Packet_3_list) Append(value protoreflect.Value) {
+func (x *_LatestPrices_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta11.Price)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Packet_3_list) AppendMutable() protoreflect.Value {
+func (x *_LatestPrices_2_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta11.Price)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Packet_3_list) Truncate(n int) {
+func (x *_LatestPrices_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Packet_3_list) NewElement() protoreflect.Value {
+func (x *_LatestPrices_2_list) NewElement() protoreflect.Value {
 	v := new(v1beta11.Price)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Packet_3_list) IsValid() bool {
+func (x *_LatestPrices_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Packet            protoreflect.MessageDescriptor
-	fd_Packet_tunnel_id  protoreflect.FieldDescriptor
-	fd_Packet_sequence   protoreflect.FieldDescriptor
-	fd_Packet_prices     protoreflect.FieldDescriptor
-	fd_Packet_receipt    protoreflect.FieldDescriptor
-	fd_Packet_created_at protoreflect.FieldDescriptor
+	md_LatestPrices                   protoreflect.MessageDescriptor
+	fd_LatestPrices_tunnel_id         protoreflect.FieldDescriptor
+	fd_LatestPrices_prices            protoreflect.FieldDescriptor
+	fd_LatestPrices_last_interval     protoreflect.FieldDescriptor
+	fd_LatestPrices_last_scheduled_at protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_tunnel_proto_init()
-	md_Packet = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("Packet")
-	fd_Packet_tunnel_id = md_Packet.Fields().ByName("tunnel_id")
-	fd_Packet_sequence = md_Packet.Fields().ByName("sequence")
-	fd_Packet_prices = md_Packet.Fields().ByName("prices")
-	fd_Packet_receipt = md_Packet.Fields().ByName("receipt")
-	fd_Packet_created_at = md_Packet.Fields().ByName("created_at")
+	md_LatestPrices = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("LatestPrices")
+	fd_LatestPrices_tunnel_id = md_LatestPrices.Fields().ByName("tunnel_id")
+	fd_LatestPrices_prices = md_LatestPrices.Fields().ByName("prices")
+	fd_LatestPrices_last_interval = md_LatestPrices.Fields().ByName("last_interval")
+	fd_LatestPrices_last_scheduled_at = md_LatestPrices.Fields().ByName("last_scheduled_at")
 }
 
-var _ protoreflect.Message = (*fastReflection_Packet)(nil)
+var _ protoreflect.Message = (*fastReflection_LatestPrices)(nil)
 
-type fastReflection_Packet Packet
+type fastReflection_LatestPrices LatestPrices
 
-func (x *Packet) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Packet)(x)
+func (x *LatestPrices) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LatestPrices)(x)
 }
 
-func (x *Packet) slowProtoReflect() protoreflect.Message {
+func (x *LatestPrices) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2277,43 +2714,43 @@ func (x *Packet) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Packet_messageType fastReflection_Packet_messageType
-var _ protoreflect.MessageType = fastReflection_Packet_messageType{}
+var _fastReflection_LatestPrices_messageType fastReflection_LatestPrices_messageType
+var _ protoreflect.MessageType = fastReflection_LatestPrices_messageType{}
 
-type fastReflection_Packet_messageType struct{}
+type fastReflection_LatestPrices_messageType struct{}
 
-func (x fastReflection_Packet_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Packet)(nil)
+func (x fastReflection_LatestPrices_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LatestPrices)(nil)
 }
-func (x fastReflection_Packet_messageType) New() protoreflect.Message {
-	return new(fastReflection_Packet)
+func (x fastReflection_LatestPrices_messageType) New() protoreflect.Message {
+	return new(fastReflection_LatestPrices)
 }
-func (x fastReflection_Packet_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Packet
+func (x fastReflection_LatestPrices_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LatestPrices
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Packet) Descriptor() protoreflect.MessageDescriptor {
-	return md_Packet
+func (x *fastReflection_LatestPrices) Descriptor() protoreflect.MessageDescriptor {
+	return md_LatestPrices
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Packet) Type() protoreflect.MessageType {
-	return _fastReflection_Packet_messageType
+func (x *fastReflection_LatestPrices) Type() protoreflect.MessageType {
+	return _fastReflection_LatestPrices_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Packet) New() protoreflect.Message {
-	return new(fastReflection_Packet)
+func (x *fastReflection_LatestPrices) New() protoreflect.Message {
+	return new(fastReflection_LatestPrices)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Packet) Interface() protoreflect.ProtoMessage {
-	return (*Packet)(x)
+func (x *fastReflection_LatestPrices) Interface() protoreflect.ProtoMessage {
+	return (*LatestPrices)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2321,34 +2758,28 @@ func (x *fastReflection_Packet) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Packet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_LatestPrices) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.TunnelId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.TunnelId)
-		if !f(fd_Packet_tunnel_id, value) {
-			return
-		}
-	}
-	if x.Sequence != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Sequence)
-		if !f(fd_Packet_sequence, value) {
+		if !f(fd_LatestPrices_tunnel_id, value) {
 			return
 		}
 	}
 	if len(x.Prices) != 0 {
-		value := protoreflect.ValueOfList(&_Packet_3_list{list: &x.Prices})
-		if !f(fd_Packet_prices, value) {
+		value := protoreflect.ValueOfList(&_LatestPrices_2_list{list: &x.Prices})
+		if !f(fd_LatestPrices_prices, value) {
 			return
 		}
 	}
-	if x.Receipt != nil {
-		value := protoreflect.ValueOfMessage(x.Receipt.ProtoReflect())
-		if !f(fd_Packet_receipt, value) {
+	if x.LastInterval != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastInterval)
+		if !f(fd_LatestPrices_last_interval, value) {
 			return
 		}
 	}
-	if x.CreatedAt != int64(0) {
-		value := protoreflect.ValueOfInt64(x.CreatedAt)
-		if !f(fd_Packet_created_at, value) {
+	if x.LastScheduledAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastScheduledAt)
+		if !f(fd_LatestPrices_last_scheduled_at, value) {
 			return
 		}
 	}
@@ -2365,23 +2796,21 @@ func (x *fastReflection_Packet) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Packet) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_LatestPrices) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Packet.tunnel_id":
+	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
 		return x.TunnelId != uint64(0)
-	case "band.tunnel.v1beta1.Packet.sequence":
-		return x.Sequence != uint64(0)
-	case "band.tunnel.v1beta1.Packet.prices":
+	case "band.tunnel.v1beta1.LatestPrices.prices":
 		return len(x.Prices) != 0
-	case "band.tunnel.v1beta1.Packet.receipt":
-		return x.Receipt != nil
-	case "band.tunnel.v1beta1.Packet.created_at":
-		return x.CreatedAt != int64(0)
+	case "band.tunnel.v1beta1.LatestPrices.last_interval":
+		return x.LastInterval != int64(0)
+	case "band.tunnel.v1beta1.LatestPrices.last_scheduled_at":
+		return x.LastScheduledAt != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2391,23 +2820,21 @@ func (x *fastReflection_Packet) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Packet) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_LatestPrices) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Packet.tunnel_id":
+	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
 		x.TunnelId = uint64(0)
-	case "band.tunnel.v1beta1.Packet.sequence":
-		x.Sequence = uint64(0)
-	case "band.tunnel.v1beta1.Packet.prices":
+	case "band.tunnel.v1beta1.LatestPrices.prices":
 		x.Prices = nil
-	case "band.tunnel.v1beta1.Packet.receipt":
-		x.Receipt = nil
-	case "band.tunnel.v1beta1.Packet.created_at":
-		x.CreatedAt = int64(0)
+	case "band.tunnel.v1beta1.LatestPrices.last_interval":
+		x.LastInterval = int64(0)
+	case "band.tunnel.v1beta1.LatestPrices.last_scheduled_at":
+		x.LastScheduledAt = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2417,31 +2844,28 @@ func (x *fastReflection_Packet) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Packet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LatestPrices) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.Packet.tunnel_id":
+	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
 		value := x.TunnelId
 		return protoreflect.ValueOfUint64(value)
-	case "band.tunnel.v1beta1.Packet.sequence":
-		value := x.Sequence
-		return protoreflect.ValueOfUint64(value)
-	case "band.tunnel.v1beta1.Packet.prices":
+	case "band.tunnel.v1beta1.LatestPrices.prices":
 		if len(x.Prices) == 0 {
-			return protoreflect.ValueOfList(&_Packet_3_list{})
+			return protoreflect.ValueOfList(&_LatestPrices_2_list{})
 		}
-		listValue := &_Packet_3_list{list: &x.Prices}
+		listValue := &_LatestPrices_2_list{list: &x.Prices}
 		return protoreflect.ValueOfList(listValue)
-	case "band.tunnel.v1beta1.Packet.receipt":
-		value := x.Receipt
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.tunnel.v1beta1.Packet.created_at":
-		value := x.CreatedAt
+	case "band.tunnel.v1beta1.LatestPrices.last_interval":
+		value := x.LastInterval
+		return protoreflect.ValueOfInt64(value)
+	case "band.tunnel.v1beta1.LatestPrices.last_scheduled_at":
+		value := x.LastScheduledAt
 		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2455,25 +2879,23 @@ func (x *fastReflection_Packet) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Packet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_LatestPrices) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Packet.tunnel_id":
+	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
 		x.TunnelId = value.Uint()
-	case "band.tunnel.v1beta1.Packet.sequence":
-		x.Sequence = value.Uint()
-	case "band.tunnel.v1beta1.Packet.prices":
+	case "band.tunnel.v1beta1.LatestPrices.prices":
 		lv := value.List()
-		clv := lv.(*_Packet_3_list)
+		clv := lv.(*_LatestPrices_2_list)
 		x.Prices = *clv.list
-	case "band.tunnel.v1beta1.Packet.receipt":
-		x.Receipt = value.Message().Interface().(*anypb.Any)
-	case "band.tunnel.v1beta1.Packet.created_at":
-		x.CreatedAt = value.Int()
+	case "band.tunnel.v1beta1.LatestPrices.last_interval":
+		x.LastInterval = value.Int()
+	case "band.tunnel.v1beta1.LatestPrices.last_scheduled_at":
+		x.LastScheduledAt = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2487,65 +2909,57 @@ func (x *fastReflection_Packet) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Packet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LatestPrices) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Packet.prices":
+	case "band.tunnel.v1beta1.LatestPrices.prices":
 		if x.Prices == nil {
 			x.Prices = []*v1beta11.Price{}
 		}
-		value := &_Packet_3_list{list: &x.Prices}
+		value := &_LatestPrices_2_list{list: &x.Prices}
 		return protoreflect.ValueOfList(value)
-	case "band.tunnel.v1beta1.Packet.receipt":
-		if x.Receipt == nil {
-			x.Receipt = new(anypb.Any)
-		}
-		return protoreflect.ValueOfMessage(x.Receipt.ProtoReflect())
-	case "band.tunnel.v1beta1.Packet.tunnel_id":
-		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.Packet is not mutable"))
-	case "band.tunnel.v1beta1.Packet.sequence":
-		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.Packet is not mutable"))
-	case "band.tunnel.v1beta1.Packet.created_at":
-		panic(fmt.Errorf("field created_at of message band.tunnel.v1beta1.Packet is not mutable"))
+	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.LatestPrices is not mutable"))
+	case "band.tunnel.v1beta1.LatestPrices.last_interval":
+		panic(fmt.Errorf("field last_interval of message band.tunnel.v1beta1.LatestPrices is not mutable"))
+	case "band.tunnel.v1beta1.LatestPrices.last_scheduled_at":
+		panic(fmt.Errorf("field last_scheduled_at of message band.tunnel.v1beta1.LatestPrices is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Packet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LatestPrices) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Packet.tunnel_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.tunnel.v1beta1.Packet.sequence":
+	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.tunnel.v1beta1.Packet.prices":
+	case "band.tunnel.v1beta1.LatestPrices.prices":
 		list := []*v1beta11.Price{}
-		return protoreflect.ValueOfList(&_Packet_3_list{list: &list})
-	case "band.tunnel.v1beta1.Packet.receipt":
-		m := new(anypb.Any)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.tunnel.v1beta1.Packet.created_at":
+		return protoreflect.ValueOfList(&_LatestPrices_2_list{list: &list})
+	case "band.tunnel.v1beta1.LatestPrices.last_interval":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.tunnel.v1beta1.LatestPrices.last_scheduled_at":
 		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Packet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_LatestPrices) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.Packet", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.LatestPrices", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2553,7 +2967,7 @@ func (x *fastReflection_Packet) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Packet) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_LatestPrices) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2564,7 +2978,7 @@ func (x *fastReflection_Packet) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Packet) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_LatestPrices) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2576,7 +2990,7 @@ func (x *fastReflection_Packet) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Packet) IsValid() bool {
+func (x *fastReflection_LatestPrices) IsValid() bool {
 	return x != nil
 }
 
@@ -2586,9 +3000,9 @@ func (x *fastReflection_Packet) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_LatestPrices) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Packet)
+		x := input.Message.Interface().(*LatestPrices)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2603,21 +3017,17 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 		if x.TunnelId != 0 {
 			n += 1 + runtime.Sov(uint64(x.TunnelId))
 		}
-		if x.Sequence != 0 {
-			n += 1 + runtime.Sov(uint64(x.Sequence))
-		}
 		if len(x.Prices) > 0 {
 			for _, e := range x.Prices {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.Receipt != nil {
-			l = options.Size(x.Receipt)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.LastInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastInterval))
 		}
-		if x.CreatedAt != 0 {
-			n += 1 + runtime.Sov(uint64(x.CreatedAt))
+		if x.LastScheduledAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastScheduledAt))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2629,7 +3039,7 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Packet)
+		x := input.Message.Interface().(*LatestPrices)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2648,24 +3058,15 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.CreatedAt != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
+		if x.LastScheduledAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastScheduledAt))
 			i--
-			dAtA[i] = 0x28
+			dAtA[i] = 0x20
 		}
-		if x.Receipt != nil {
-			encoded, err := options.Marshal(x.Receipt)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.LastInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastInterval))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x18
 		}
 		if len(x.Prices) > 0 {
 			for iNdEx := len(x.Prices) - 1; iNdEx >= 0; iNdEx-- {
@@ -2680,14 +3081,9 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x1a
+				dAtA[i] = 0x12
 			}
 		}
-		if x.Sequence != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
-			i--
-			dAtA[i] = 0x10
-		}
 		if x.TunnelId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
 			i--
@@ -2704,7 +3100,7 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Packet)
+		x := input.Message.Interface().(*LatestPrices)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2736,10 +3132,10 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Packet: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LatestPrices: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Packet: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LatestPrices: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2762,25 +3158,6 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 					}
 				}
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-				}
-				x.Sequence = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Sequence |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 				}
@@ -2814,11 +3191,11 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastInterval", wireType)
 				}
-				var msglen int
+				x.LastInterval = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2828,33 +3205,16 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.LastInterval |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Receipt == nil {
-					x.Receipt = &anypb.Any{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Receipt); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 5:
+			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastScheduledAt", wireType)
 				}
-				x.CreatedAt = 0
+				x.LastScheduledAt = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2864,7 +3224,7 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.CreatedAt |= int64(b&0x7F) << shift
+					x.LastScheduledAt |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -2904,81 +3264,77 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Deposit_3_list)(nil)
+var _ protoreflect.List = (*_TotalFees_1_list)(nil)
 
-type _Deposit_3_list struct {
+type _TotalFees_1_list struct {
 	list *[]*v1beta1.Coin
 }
 
-func (x *_Deposit_3_list) Len() int {
+func (x *_TotalFees_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Deposit_3_list) Get(i int) protoreflect.Value {
+func (x *_TotalFees_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Deposit_3_list) Set(i int, value protoreflect.Value) {
+func (x *_TotalFees_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Deposit_3_list) Append(value protoreflect.Value) {
+func (x *_TotalFees_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Deposit_3_list) AppendMutable() protoreflect.Value {
+func (x *_TotalFees_1_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Deposit_3_list) Truncate(n int) {
+func (x *_TotalFees_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Deposit_3_list) NewElement() protoreflect.Value {
+func (x *_TotalFees_1_list) NewElement() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Deposit_3_list) IsValid() bool {
+func (x *_TotalFees_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Deposit           protoreflect.MessageDescriptor
-	fd_Deposit_tunnel_id protoreflect.FieldDescriptor
-	fd_Deposit_depositor protoreflect.FieldDescriptor
-	fd_Deposit_amount    protoreflect.FieldDescriptor
+	md_TotalFees                       protoreflect.MessageDescriptor
+	fd_TotalFees_total_base_packet_fee protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_tunnel_proto_init()
-	md_Deposit = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("Deposit")
-	fd_Deposit_tunnel_id = md_Deposit.Fields().ByName("tunnel_id")
-	fd_Deposit_depositor = md_Deposit.Fields().ByName("depositor")
-	fd_Deposit_amount = md_Deposit.Fields().ByName("amount")
+	md_TotalFees = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("TotalFees")
+	fd_TotalFees_total_base_packet_fee = md_TotalFees.Fields().ByName("total_base_packet_fee")
 }
 
-var _ protoreflect.Message = (*fastReflection_Deposit)(nil)
+var _ protoreflect.Message = (*fastReflection_TotalFees)(nil)
 
-type fastReflection_Deposit Deposit
+type fastReflection_TotalFees TotalFees
 
-func (x *Deposit) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Deposit)(x)
+func (x *TotalFees) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TotalFees)(x)
 }
 
-func (x *Deposit) slowProtoReflect() protoreflect.Message {
+func (x *TotalFees) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2990,43 +3346,43 @@ func (x *Deposit) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Deposit_messageType fastReflection_Deposit_messageType
-var _ protoreflect.MessageType = fastReflection_Deposit_messageType{}
+var _fastReflection_TotalFees_messageType fastReflection_TotalFees_messageType
+var _ protoreflect.MessageType = fastReflection_TotalFees_messageType{}
 
-type fastReflection_Deposit_messageType struct{}
+type fastReflection_TotalFees_messageType struct{}
 
-func (x fastReflection_Deposit_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Deposit)(nil)
+func (x fastReflection_TotalFees_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TotalFees)(nil)
 }
-func (x fastReflection_Deposit_messageType) New() protoreflect.Message {
-	return new(fastReflection_Deposit)
+func (x fastReflection_TotalFees_messageType) New() protoreflect.Message {
+	return new(fastReflection_TotalFees)
 }
-func (x fastReflection_Deposit_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Deposit
+func (x fastReflection_TotalFees_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TotalFees
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Deposit) Descriptor() protoreflect.MessageDescriptor {
-	return md_Deposit
+func (x *fastReflection_TotalFees) Descriptor() protoreflect.MessageDescriptor {
+	return md_TotalFees
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Deposit) Type() protoreflect.MessageType {
-	return _fastReflection_Deposit_messageType
+func (x *fastReflection_TotalFees) Type() protoreflect.MessageType {
+	return _fastReflection_TotalFees_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Deposit) New() protoreflect.Message {
-	return new(fastReflection_Deposit)
+func (x *fastReflection_TotalFees) New() protoreflect.Message {
+	return new(fastReflection_TotalFees)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Deposit) Interface() protoreflect.ProtoMessage {
-	return (*Deposit)(x)
+func (x *fastReflection_TotalFees) Interface() protoreflect.ProtoMessage {
+	return (*TotalFees)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3034,22 +3390,10 @@ func (x *fastReflection_Deposit) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Deposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.TunnelId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.TunnelId)
-		if !f(fd_Deposit_tunnel_id, value) {
-			return
-		}
-	}
-	if x.Depositor != "" {
-		value := protoreflect.ValueOfString(x.Depositor)
-		if !f(fd_Deposit_depositor, value) {
-			return
-		}
-	}
-	if len(x.Amount) != 0 {
-		value := protoreflect.ValueOfList(&_Deposit_3_list{list: &x.Amount})
-		if !f(fd_Deposit_amount, value) {
+func (x *fastReflection_TotalFees) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.TotalBasePacketFee) != 0 {
+		value := protoreflect.ValueOfList(&_TotalFees_1_list{list: &x.TotalBasePacketFee})
+		if !f(fd_TotalFees_total_base_packet_fee, value) {
 			return
 		}
 	}
@@ -3066,19 +3410,15 @@ func (x *fastReflection_Deposit) Range(f func(protoreflect.FieldDescriptor, prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Deposit) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TotalFees) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Deposit.tunnel_id":
-		return x.TunnelId != uint64(0)
-	case "band.tunnel.v1beta1.Deposit.depositor":
-		return x.Depositor != ""
-	case "band.tunnel.v1beta1.Deposit.amount":
-		return len(x.Amount) != 0
+	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
+		return len(x.TotalBasePacketFee) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3088,19 +3428,15 @@ func (x *fastReflection_Deposit) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Deposit) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TotalFees) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Deposit.tunnel_id":
-		x.TunnelId = uint64(0)
-	case "band.tunnel.v1beta1.Deposit.depositor":
-		x.Depositor = ""
-	case "band.tunnel.v1beta1.Deposit.amount":
-		x.Amount = nil
+	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
+		x.TotalBasePacketFee = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3110,25 +3446,19 @@ func (x *fastReflection_Deposit) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Deposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TotalFees) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.Deposit.tunnel_id":
-		value := x.TunnelId
-		return protoreflect.ValueOfUint64(value)
-	case "band.tunnel.v1beta1.Deposit.depositor":
-		value := x.Depositor
-		return protoreflect.ValueOfString(value)
-	case "band.tunnel.v1beta1.Deposit.amount":
-		if len(x.Amount) == 0 {
-			return protoreflect.ValueOfList(&_Deposit_3_list{})
+	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
+		if len(x.TotalBasePacketFee) == 0 {
+			return protoreflect.ValueOfList(&_TotalFees_1_list{})
 		}
-		listValue := &_Deposit_3_list{list: &x.Amount}
+		listValue := &_TotalFees_1_list{list: &x.TotalBasePacketFee}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3142,21 +3472,17 @@ func (x *fastReflection_Deposit) Get(descriptor protoreflect.FieldDescriptor) pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Deposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TotalFees) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Deposit.tunnel_id":
-		x.TunnelId = value.Uint()
-	case "band.tunnel.v1beta1.Deposit.depositor":
-		x.Depositor = value.Interface().(string)
-	case "band.tunnel.v1beta1.Deposit.amount":
+	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
 		lv := value.List()
-		clv := lv.(*_Deposit_3_list)
-		x.Amount = *clv.list
+		clv := lv.(*_TotalFees_1_list)
+		x.TotalBasePacketFee = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3170,53 +3496,45 @@ func (x *fastReflection_Deposit) Set(fd protoreflect.FieldDescriptor, value prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Deposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TotalFees) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Deposit.amount":
-		if x.Amount == nil {
-			x.Amount = []*v1beta1.Coin{}
+	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
+		if x.TotalBasePacketFee == nil {
+			x.TotalBasePacketFee = []*v1beta1.Coin{}
 		}
-		value := &_Deposit_3_list{list: &x.Amount}
+		value := &_TotalFees_1_list{list: &x.TotalBasePacketFee}
 		return protoreflect.ValueOfList(value)
-	case "band.tunnel.v1beta1.Deposit.tunnel_id":
-		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.Deposit is not mutable"))
-	case "band.tunnel.v1beta1.Deposit.depositor":
-		panic(fmt.Errorf("field depositor of message band.tunnel.v1beta1.Deposit is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Deposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TotalFees) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Deposit.tunnel_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.tunnel.v1beta1.Deposit.depositor":
-		return protoreflect.ValueOfString("")
-	case "band.tunnel.v1beta1.Deposit.amount":
+	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
 		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Deposit_3_list{list: &list})
+		return protoreflect.ValueOfList(&_TotalFees_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Deposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TotalFees) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.Deposit", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.TotalFees", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3224,7 +3542,7 @@ func (x *fastReflection_Deposit) WhichOneof(d protoreflect.OneofDescriptor) prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Deposit) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TotalFees) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3235,7 +3553,7 @@ func (x *fastReflection_Deposit) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Deposit) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TotalFees) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3247,7 +3565,7 @@ func (x *fastReflection_Deposit) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Deposit) IsValid() bool {
+func (x *fastReflection_TotalFees) IsValid() bool {
 	return x != nil
 }
 
@@ -3257,9 +3575,9 @@ func (x *fastReflection_Deposit) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TotalFees) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Deposit)
+		x := input.Message.Interface().(*TotalFees)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3271,15 +3589,8 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.TunnelId != 0 {
-			n += 1 + runtime.Sov(uint64(x.TunnelId))
-		}
-		l = len(x.Depositor)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Amount) > 0 {
-			for _, e := range x.Amount {
+		if len(x.TotalBasePacketFee) > 0 {
+			for _, e := range x.TotalBasePacketFee {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -3294,7 +3605,7 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Deposit)
+		x := input.Message.Interface().(*TotalFees)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3313,9 +3624,9 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Amount) > 0 {
-			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Amount[iNdEx])
+		if len(x.TotalBasePacketFee) > 0 {
+			for iNdEx := len(x.TotalBasePacketFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TotalBasePacketFee[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3326,21 +3637,9 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x1a
+				dAtA[i] = 0xa
 			}
 		}
-		if len(x.Depositor) > 0 {
-			i -= len(x.Depositor)
-			copy(dAtA[i:], x.Depositor)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.TunnelId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
-			i--
-			dAtA[i] = 0x8
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -3352,7 +3651,7 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Deposit)
+		x := input.Message.Interface().(*TotalFees)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3384,66 +3683,15 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TotalFees: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TotalFees: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
-				}
-				x.TunnelId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.TunnelId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Depositor = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalBasePacketFee", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3470,8 +3718,8 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = append(x.Amount, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+				x.TotalBasePacketFee = append(x.TotalBasePacketFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalBasePacketFee[len(x.TotalBasePacketFee)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3510,30 +3758,85 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_Packet_3_list)(nil)
+
+type _Packet_3_list struct {
+	list *[]*v1beta11.Price
+}
+
+func (x *_Packet_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Packet_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Packet_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Price)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Packet_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Price)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Packet_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Price)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Packet_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Packet_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Price)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Packet_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_SignalDeviation                    protoreflect.MessageDescriptor
-	fd_SignalDeviation_signal_id          protoreflect.FieldDescriptor
-	fd_SignalDeviation_soft_deviation_bps protoreflect.FieldDescriptor
-	fd_SignalDeviation_hard_deviation_bps protoreflect.FieldDescriptor
+	md_Packet            protoreflect.MessageDescriptor
+	fd_Packet_tunnel_id  protoreflect.FieldDescriptor
+	fd_Packet_sequence   protoreflect.FieldDescriptor
+	fd_Packet_prices     protoreflect.FieldDescriptor
+	fd_Packet_receipt    protoreflect.FieldDescriptor
+	fd_Packet_created_at protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_tunnel_proto_init()
-	md_SignalDeviation = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("SignalDeviation")
-	fd_SignalDeviation_signal_id = md_SignalDeviation.Fields().ByName("signal_id")
-	fd_SignalDeviation_soft_deviation_bps = md_SignalDeviation.Fields().ByName("soft_deviation_bps")
-	fd_SignalDeviation_hard_deviation_bps = md_SignalDeviation.Fields().ByName("hard_deviation_bps")
+	md_Packet = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("Packet")
+	fd_Packet_tunnel_id = md_Packet.Fields().ByName("tunnel_id")
+	fd_Packet_sequence = md_Packet.Fields().ByName("sequence")
+	fd_Packet_prices = md_Packet.Fields().ByName("prices")
+	fd_Packet_receipt = md_Packet.Fields().ByName("receipt")
+	fd_Packet_created_at = md_Packet.Fields().ByName("created_at")
 }
 
-var _ protoreflect.Message = (*fastReflection_SignalDeviation)(nil)
+var _ protoreflect.Message = (*fastReflection_Packet)(nil)
 
-type fastReflection_SignalDeviation SignalDeviation
+type fastReflection_Packet Packet
 
-func (x *SignalDeviation) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SignalDeviation)(x)
+func (x *Packet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Packet)(x)
 }
 
-func (x *SignalDeviation) slowProtoReflect() protoreflect.Message {
+func (x *Packet) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3545,43 +3848,43 @@ func (x *SignalDeviation) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SignalDeviation_messageType fastReflection_SignalDeviation_messageType
-var _ protoreflect.MessageType = fastReflection_SignalDeviation_messageType{}
+var _fastReflection_Packet_messageType fastReflection_Packet_messageType
+var _ protoreflect.MessageType = fastReflection_Packet_messageType{}
 
-type fastReflection_SignalDeviation_messageType struct{}
+type fastReflection_Packet_messageType struct{}
 
-func (x fastReflection_SignalDeviation_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SignalDeviation)(nil)
+func (x fastReflection_Packet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Packet)(nil)
 }
-func (x fastReflection_SignalDeviation_messageType) New() protoreflect.Message {
-	return new(fastReflection_SignalDeviation)
+func (x fastReflection_Packet_messageType) New() protoreflect.Message {
+	return new(fastReflection_Packet)
 }
-func (x fastReflection_SignalDeviation_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SignalDeviation
+func (x fastReflection_Packet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Packet
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SignalDeviation) Descriptor() protoreflect.MessageDescriptor {
-	return md_SignalDeviation
+func (x *fastReflection_Packet) Descriptor() protoreflect.MessageDescriptor {
+	return md_Packet
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SignalDeviation) Type() protoreflect.MessageType {
-	return _fastReflection_SignalDeviation_messageType
-}
+func (x *fastReflection_Packet) Type() protoreflect.MessageType {
+	return _fastReflection_Packet_messageType
+}
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SignalDeviation) New() protoreflect.Message {
-	return new(fastReflection_SignalDeviation)
+func (x *fastReflection_Packet) New() protoreflect.Message {
+	return new(fastReflection_Packet)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SignalDeviation) Interface() protoreflect.ProtoMessage {
-	return (*SignalDeviation)(x)
+func (x *fastReflection_Packet) Interface() protoreflect.ProtoMessage {
+	return (*Packet)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3589,22 +3892,34 @@ func (x *fastReflection_SignalDeviation) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SignalDeviation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.SignalId != "" {
-		value := protoreflect.ValueOfString(x.SignalId)
-		if !f(fd_SignalDeviation_signal_id, value) {
+func (x *fastReflection_Packet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_Packet_tunnel_id, value) {
 			return
 		}
 	}
-	if x.SoftDeviationBps != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.SoftDeviationBps)
-		if !f(fd_SignalDeviation_soft_deviation_bps, value) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_Packet_sequence, value) {
 			return
 		}
 	}
-	if x.HardDeviationBps != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.HardDeviationBps)
-		if !f(fd_SignalDeviation_hard_deviation_bps, value) {
+	if len(x.Prices) != 0 {
+		value := protoreflect.ValueOfList(&_Packet_3_list{list: &x.Prices})
+		if !f(fd_Packet_prices, value) {
+			return
+		}
+	}
+	if x.Receipt != nil {
+		value := protoreflect.ValueOfMessage(x.Receipt.ProtoReflect())
+		if !f(fd_Packet_receipt, value) {
+			return
+		}
+	}
+	if x.CreatedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreatedAt)
+		if !f(fd_Packet_created_at, value) {
 			return
 		}
 	}
@@ -3621,19 +3936,23 @@ func (x *fastReflection_SignalDeviation) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SignalDeviation) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Packet) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
-		return x.SignalId != ""
-	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
-		return x.SoftDeviationBps != uint64(0)
-	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
-		return x.HardDeviationBps != uint64(0)
+	case "band.tunnel.v1beta1.Packet.tunnel_id":
+		return x.TunnelId != uint64(0)
+	case "band.tunnel.v1beta1.Packet.sequence":
+		return x.Sequence != uint64(0)
+	case "band.tunnel.v1beta1.Packet.prices":
+		return len(x.Prices) != 0
+	case "band.tunnel.v1beta1.Packet.receipt":
+		return x.Receipt != nil
+	case "band.tunnel.v1beta1.Packet.created_at":
+		return x.CreatedAt != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3643,19 +3962,23 @@ func (x *fastReflection_SignalDeviation) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignalDeviation) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Packet) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
-		x.SignalId = ""
-	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
-		x.SoftDeviationBps = uint64(0)
-	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
-		x.HardDeviationBps = uint64(0)
+	case "band.tunnel.v1beta1.Packet.tunnel_id":
+		x.TunnelId = uint64(0)
+	case "band.tunnel.v1beta1.Packet.sequence":
+		x.Sequence = uint64(0)
+	case "band.tunnel.v1beta1.Packet.prices":
+		x.Prices = nil
+	case "band.tunnel.v1beta1.Packet.receipt":
+		x.Receipt = nil
+	case "band.tunnel.v1beta1.Packet.created_at":
+		x.CreatedAt = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3665,22 +3988,31 @@ func (x *fastReflection_SignalDeviation) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SignalDeviation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Packet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
-		value := x.SignalId
-		return protoreflect.ValueOfString(value)
-	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
-		value := x.SoftDeviationBps
+	case "band.tunnel.v1beta1.Packet.tunnel_id":
+		value := x.TunnelId
 		return protoreflect.ValueOfUint64(value)
-	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
-		value := x.HardDeviationBps
+	case "band.tunnel.v1beta1.Packet.sequence":
+		value := x.Sequence
 		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.Packet.prices":
+		if len(x.Prices) == 0 {
+			return protoreflect.ValueOfList(&_Packet_3_list{})
+		}
+		listValue := &_Packet_3_list{list: &x.Prices}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.Packet.receipt":
+		value := x.Receipt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.tunnel.v1beta1.Packet.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3694,19 +4026,25 @@ func (x *fastReflection_SignalDeviation) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignalDeviation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Packet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
-		x.SignalId = value.Interface().(string)
-	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
-		x.SoftDeviationBps = value.Uint()
-	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
-		x.HardDeviationBps = value.Uint()
+	case "band.tunnel.v1beta1.Packet.tunnel_id":
+		x.TunnelId = value.Uint()
+	case "band.tunnel.v1beta1.Packet.sequence":
+		x.Sequence = value.Uint()
+	case "band.tunnel.v1beta1.Packet.prices":
+		lv := value.List()
+		clv := lv.(*_Packet_3_list)
+		x.Prices = *clv.list
+	case "band.tunnel.v1beta1.Packet.receipt":
+		x.Receipt = value.Message().Interface().(*anypb.Any)
+	case "band.tunnel.v1beta1.Packet.created_at":
+		x.CreatedAt = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3720,48 +4058,65 @@ func (x *fastReflection_SignalDeviation) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignalDeviation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Packet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
-		panic(fmt.Errorf("field signal_id of message band.tunnel.v1beta1.SignalDeviation is not mutable"))
-	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
-		panic(fmt.Errorf("field soft_deviation_bps of message band.tunnel.v1beta1.SignalDeviation is not mutable"))
-	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
-		panic(fmt.Errorf("field hard_deviation_bps of message band.tunnel.v1beta1.SignalDeviation is not mutable"))
+	case "band.tunnel.v1beta1.Packet.prices":
+		if x.Prices == nil {
+			x.Prices = []*v1beta11.Price{}
+		}
+		value := &_Packet_3_list{list: &x.Prices}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.Packet.receipt":
+		if x.Receipt == nil {
+			x.Receipt = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.Receipt.ProtoReflect())
+	case "band.tunnel.v1beta1.Packet.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.Packet is not mutable"))
+	case "band.tunnel.v1beta1.Packet.sequence":
+		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.Packet is not mutable"))
+	case "band.tunnel.v1beta1.Packet.created_at":
+		panic(fmt.Errorf("field created_at of message band.tunnel.v1beta1.Packet is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SignalDeviation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Packet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
-		return protoreflect.ValueOfString("")
-	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+	case "band.tunnel.v1beta1.Packet.tunnel_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+	case "band.tunnel.v1beta1.Packet.sequence":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.Packet.prices":
+		list := []*v1beta11.Price{}
+		return protoreflect.ValueOfList(&_Packet_3_list{list: &list})
+	case "band.tunnel.v1beta1.Packet.receipt":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.tunnel.v1beta1.Packet.created_at":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SignalDeviation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Packet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.SignalDeviation", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.Packet", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3769,7 +4124,7 @@ func (x *fastReflection_SignalDeviation) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SignalDeviation) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Packet) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3780,7 +4135,7 @@ func (x *fastReflection_SignalDeviation) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignalDeviation) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Packet) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3792,7 +4147,7 @@ func (x *fastReflection_SignalDeviation) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SignalDeviation) IsValid() bool {
+func (x *fastReflection_Packet) IsValid() bool {
 	return x != nil
 }
 
@@ -3802,9 +4157,9 @@ func (x *fastReflection_SignalDeviation) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SignalDeviation)
+		x := input.Message.Interface().(*Packet)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3816,15 +4171,24 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.SignalId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
 		}
-		if x.SoftDeviationBps != 0 {
-			n += 1 + runtime.Sov(uint64(x.SoftDeviationBps))
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
 		}
-		if x.HardDeviationBps != 0 {
-			n += 1 + runtime.Sov(uint64(x.HardDeviationBps))
+		if len(x.Prices) > 0 {
+			for _, e := range x.Prices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Receipt != nil {
+			l = options.Size(x.Receipt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CreatedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedAt))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3836,7 +4200,7 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SignalDeviation)
+		x := input.Message.Interface().(*Packet)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3855,22 +4219,50 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.HardDeviationBps != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.HardDeviationBps))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.SoftDeviationBps != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SoftDeviationBps))
+		if x.CreatedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x28
 		}
-		if len(x.SignalId) > 0 {
-			i -= len(x.SignalId)
-			copy(dAtA[i:], x.SignalId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+		if x.Receipt != nil {
+			encoded, err := options.Marshal(x.Receipt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x22
+		}
+		if len(x.Prices) > 0 {
+			for iNdEx := len(x.Prices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Prices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3883,7 +4275,7 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SignalDeviation)
+		x := input.Message.Interface().(*Packet)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3915,17 +4307,55 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalDeviation: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Packet: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Packet: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				}
+				x.TunnelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TunnelId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3935,29 +4365,31 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SignalId = string(dAtA[iNdEx:postIndex])
+				x.Prices = append(x.Prices, &v1beta11.Price{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Prices[len(x.Prices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SoftDeviationBps", wireType)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
 				}
-				x.SoftDeviationBps = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3967,16 +4399,33 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.SoftDeviationBps |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 3:
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Receipt == nil {
+					x.Receipt = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Receipt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HardDeviationBps", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 				}
-				x.HardDeviationBps = 0
+				x.CreatedAt = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3986,7 +4435,7 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.HardDeviationBps |= uint64(b&0x7F) << shift
+					x.CreatedAt |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -4026,83 +4475,81 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_TunnelSignatureOrder_2_list)(nil)
+var _ protoreflect.List = (*_Deposit_3_list)(nil)
 
-type _TunnelSignatureOrder_2_list struct {
-	list *[]*v1beta11.Price
+type _Deposit_3_list struct {
+	list *[]*v1beta1.Coin
 }
 
-func (x *_TunnelSignatureOrder_2_list) Len() int {
+func (x *_Deposit_3_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_TunnelSignatureOrder_2_list) Get(i int) protoreflect.Value {
+func (x *_Deposit_3_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_TunnelSignatureOrder_2_list) Set(i int, value protoreflect.Value) {
+func (x *_Deposit_3_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta11.Price)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_TunnelSignatureOrder_2_list) Append(value protoreflect.Value) {
+func (x *_Deposit_3_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta11.Price)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_TunnelSignatureOrder_2_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta11.Price)
+func (x *_Deposit_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_TunnelSignatureOrder_2_list) Truncate(n int) {
+func (x *_Deposit_3_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_TunnelSignatureOrder_2_list) NewElement() protoreflect.Value {
-	v := new(v1beta11.Price)
+func (x *_Deposit_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_TunnelSignatureOrder_2_list) IsValid() bool {
+func (x *_Deposit_3_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_TunnelSignatureOrder            protoreflect.MessageDescriptor
-	fd_TunnelSignatureOrder_sequence   protoreflect.FieldDescriptor
-	fd_TunnelSignatureOrder_prices     protoreflect.FieldDescriptor
-	fd_TunnelSignatureOrder_created_at protoreflect.FieldDescriptor
-	fd_TunnelSignatureOrder_encoder    protoreflect.FieldDescriptor
+	md_Deposit           protoreflect.MessageDescriptor
+	fd_Deposit_tunnel_id protoreflect.FieldDescriptor
+	fd_Deposit_depositor protoreflect.FieldDescriptor
+	fd_Deposit_amount    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_tunnel_proto_init()
-	md_TunnelSignatureOrder = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("TunnelSignatureOrder")
-	fd_TunnelSignatureOrder_sequence = md_TunnelSignatureOrder.Fields().ByName("sequence")
-	fd_TunnelSignatureOrder_prices = md_TunnelSignatureOrder.Fields().ByName("prices")
-	fd_TunnelSignatureOrder_created_at = md_TunnelSignatureOrder.Fields().ByName("created_at")
-	fd_TunnelSignatureOrder_encoder = md_TunnelSignatureOrder.Fields().ByName("encoder")
+	md_Deposit = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("Deposit")
+	fd_Deposit_tunnel_id = md_Deposit.Fields().ByName("tunnel_id")
+	fd_Deposit_depositor = md_Deposit.Fields().ByName("depositor")
+	fd_Deposit_amount = md_Deposit.Fields().ByName("amount")
 }
 
-var _ protoreflect.Message = (*fastReflection_TunnelSignatureOrder)(nil)
+var _ protoreflect.Message = (*fastReflection_Deposit)(nil)
 
-type fastReflection_TunnelSignatureOrder TunnelSignatureOrder
+type fastReflection_Deposit Deposit
 
-func (x *TunnelSignatureOrder) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TunnelSignatureOrder)(x)
+func (x *Deposit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Deposit)(x)
 }
 
-func (x *TunnelSignatureOrder) slowProtoReflect() protoreflect.Message {
+func (x *Deposit) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4114,43 +4561,43 @@ func (x *TunnelSignatureOrder) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TunnelSignatureOrder_messageType fastReflection_TunnelSignatureOrder_messageType
-var _ protoreflect.MessageType = fastReflection_TunnelSignatureOrder_messageType{}
+var _fastReflection_Deposit_messageType fastReflection_Deposit_messageType
+var _ protoreflect.MessageType = fastReflection_Deposit_messageType{}
 
-type fastReflection_TunnelSignatureOrder_messageType struct{}
+type fastReflection_Deposit_messageType struct{}
 
-func (x fastReflection_TunnelSignatureOrder_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TunnelSignatureOrder)(nil)
+func (x fastReflection_Deposit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Deposit)(nil)
 }
-func (x fastReflection_TunnelSignatureOrder_messageType) New() protoreflect.Message {
-	return new(fastReflection_TunnelSignatureOrder)
+func (x fastReflection_Deposit_messageType) New() protoreflect.Message {
+	return new(fastReflection_Deposit)
 }
-func (x fastReflection_TunnelSignatureOrder_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TunnelSignatureOrder
+func (x fastReflection_Deposit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Deposit
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TunnelSignatureOrder) Descriptor() protoreflect.MessageDescriptor {
-	return md_TunnelSignatureOrder
+func (x *fastReflection_Deposit) Descriptor() protoreflect.MessageDescriptor {
+	return md_Deposit
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TunnelSignatureOrder) Type() protoreflect.MessageType {
-	return _fastReflection_TunnelSignatureOrder_messageType
+func (x *fastReflection_Deposit) Type() protoreflect.MessageType {
+	return _fastReflection_Deposit_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TunnelSignatureOrder) New() protoreflect.Message {
-	return new(fastReflection_TunnelSignatureOrder)
+func (x *fastReflection_Deposit) New() protoreflect.Message {
+	return new(fastReflection_Deposit)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TunnelSignatureOrder) Interface() protoreflect.ProtoMessage {
-	return (*TunnelSignatureOrder)(x)
+func (x *fastReflection_Deposit) Interface() protoreflect.ProtoMessage {
+	return (*Deposit)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4158,28 +4605,22 @@ func (x *fastReflection_TunnelSignatureOrder) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TunnelSignatureOrder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Sequence != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Sequence)
-		if !f(fd_TunnelSignatureOrder_sequence, value) {
-			return
-		}
-	}
-	if len(x.Prices) != 0 {
-		value := protoreflect.ValueOfList(&_TunnelSignatureOrder_2_list{list: &x.Prices})
-		if !f(fd_TunnelSignatureOrder_prices, value) {
+func (x *fastReflection_Deposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_Deposit_tunnel_id, value) {
 			return
 		}
 	}
-	if x.CreatedAt != int64(0) {
-		value := protoreflect.ValueOfInt64(x.CreatedAt)
-		if !f(fd_TunnelSignatureOrder_created_at, value) {
+	if x.Depositor != "" {
+		value := protoreflect.ValueOfString(x.Depositor)
+		if !f(fd_Deposit_depositor, value) {
 			return
 		}
 	}
-	if x.Encoder != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Encoder))
-		if !f(fd_TunnelSignatureOrder_encoder, value) {
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_Deposit_3_list{list: &x.Amount})
+		if !f(fd_Deposit_amount, value) {
 			return
 		}
 	}
@@ -4196,21 +4637,19 @@ func (x *fastReflection_TunnelSignatureOrder) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TunnelSignatureOrder) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Deposit) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
-		return x.Sequence != uint64(0)
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
-		return len(x.Prices) != 0
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
-		return x.CreatedAt != int64(0)
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
-		return x.Encoder != 0
+	case "band.tunnel.v1beta1.Deposit.tunnel_id":
+		return x.TunnelId != uint64(0)
+	case "band.tunnel.v1beta1.Deposit.depositor":
+		return x.Depositor != ""
+	case "band.tunnel.v1beta1.Deposit.amount":
+		return len(x.Amount) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4220,21 +4659,19 @@ func (x *fastReflection_TunnelSignatureOrder) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TunnelSignatureOrder) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Deposit) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
-		x.Sequence = uint64(0)
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
-		x.Prices = nil
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
-		x.CreatedAt = int64(0)
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
-		x.Encoder = 0
+	case "band.tunnel.v1beta1.Deposit.tunnel_id":
+		x.TunnelId = uint64(0)
+	case "band.tunnel.v1beta1.Deposit.depositor":
+		x.Depositor = ""
+	case "band.tunnel.v1beta1.Deposit.amount":
+		x.Amount = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4244,28 +4681,25 @@ func (x *fastReflection_TunnelSignatureOrder) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TunnelSignatureOrder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Deposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
-		value := x.Sequence
+	case "band.tunnel.v1beta1.Deposit.tunnel_id":
+		value := x.TunnelId
 		return protoreflect.ValueOfUint64(value)
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
-		if len(x.Prices) == 0 {
-			return protoreflect.ValueOfList(&_TunnelSignatureOrder_2_list{})
+	case "band.tunnel.v1beta1.Deposit.depositor":
+		value := x.Depositor
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.Deposit.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_Deposit_3_list{})
 		}
-		listValue := &_TunnelSignatureOrder_2_list{list: &x.Prices}
+		listValue := &_Deposit_3_list{list: &x.Amount}
 		return protoreflect.ValueOfList(listValue)
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
-		value := x.CreatedAt
-		return protoreflect.ValueOfInt64(value)
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
-		value := x.Encoder
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4279,23 +4713,21 @@ func (x *fastReflection_TunnelSignatureOrder) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TunnelSignatureOrder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Deposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
-		x.Sequence = value.Uint()
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
+	case "band.tunnel.v1beta1.Deposit.tunnel_id":
+		x.TunnelId = value.Uint()
+	case "band.tunnel.v1beta1.Deposit.depositor":
+		x.Depositor = value.Interface().(string)
+	case "band.tunnel.v1beta1.Deposit.amount":
 		lv := value.List()
-		clv := lv.(*_TunnelSignatureOrder_2_list)
-		x.Prices = *clv.list
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
-		x.CreatedAt = value.Int()
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
-		x.Encoder = (v1beta11.Encoder)(value.Enum())
+		clv := lv.(*_Deposit_3_list)
+		x.Amount = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4309,57 +4741,53 @@ func (x *fastReflection_TunnelSignatureOrder) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TunnelSignatureOrder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Deposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
-		if x.Prices == nil {
-			x.Prices = []*v1beta11.Price{}
+	case "band.tunnel.v1beta1.Deposit.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
 		}
-		value := &_TunnelSignatureOrder_2_list{list: &x.Prices}
+		value := &_Deposit_3_list{list: &x.Amount}
 		return protoreflect.ValueOfList(value)
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
-		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.TunnelSignatureOrder is not mutable"))
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
-		panic(fmt.Errorf("field created_at of message band.tunnel.v1beta1.TunnelSignatureOrder is not mutable"))
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
-		panic(fmt.Errorf("field encoder of message band.tunnel.v1beta1.TunnelSignatureOrder is not mutable"))
+	case "band.tunnel.v1beta1.Deposit.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.Deposit is not mutable"))
+	case "band.tunnel.v1beta1.Deposit.depositor":
+		panic(fmt.Errorf("field depositor of message band.tunnel.v1beta1.Deposit is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TunnelSignatureOrder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Deposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
+	case "band.tunnel.v1beta1.Deposit.tunnel_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
-		list := []*v1beta11.Price{}
-		return protoreflect.ValueOfList(&_TunnelSignatureOrder_2_list{list: &list})
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
-		return protoreflect.ValueOfEnum(0)
+	case "band.tunnel.v1beta1.Deposit.depositor":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.Deposit.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Deposit_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TunnelSignatureOrder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Deposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.TunnelSignatureOrder", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.Deposit", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4367,7 +4795,7 @@ func (x *fastReflection_TunnelSignatureOrder) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TunnelSignatureOrder) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Deposit) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4378,7 +4806,7 @@ func (x *fastReflection_TunnelSignatureOrder) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TunnelSignatureOrder) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Deposit) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4390,7 +4818,7 @@ func (x *fastReflection_TunnelSignatureOrder) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TunnelSignatureOrder) IsValid() bool {
+func (x *fastReflection_Deposit) IsValid() bool {
 	return x != nil
 }
 
@@ -4400,9 +4828,9 @@ func (x *fastReflection_TunnelSignatureOrder) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TunnelSignatureOrder)
+		x := input.Message.Interface().(*Deposit)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4414,21 +4842,19 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Sequence != 0 {
-			n += 1 + runtime.Sov(uint64(x.Sequence))
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
 		}
-		if len(x.Prices) > 0 {
-			for _, e := range x.Prices {
+		l = len(x.Depositor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.CreatedAt != 0 {
-			n += 1 + runtime.Sov(uint64(x.CreatedAt))
-		}
-		if x.Encoder != 0 {
-			n += 1 + runtime.Sov(uint64(x.Encoder))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4439,7 +4865,7 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TunnelSignatureOrder)
+		x := input.Message.Interface().(*Deposit)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4458,19 +4884,9 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Encoder != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Encoder))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.CreatedAt != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.Prices) > 0 {
-			for iNdEx := len(x.Prices) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Prices[iNdEx])
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4481,11 +4897,18 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x12
+				dAtA[i] = 0x1a
 			}
 		}
-		if x.Sequence != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+		if len(x.Depositor) > 0 {
+			i -= len(x.Depositor)
+			copy(dAtA[i:], x.Depositor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -4500,7 +4923,7 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TunnelSignatureOrder)
+		x := input.Message.Interface().(*Deposit)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4532,17 +4955,17 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TunnelSignatureOrder: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Deposit: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TunnelSignatureOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
 				}
-				x.Sequence = 0
+				x.TunnelId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4552,16 +4975,16 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Sequence |= uint64(b&0x7F) << shift
+					x.TunnelId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4571,31 +4994,29 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Prices = append(x.Prices, &v1beta11.Price{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Prices[len(x.Prices)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Depositor = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
-				x.CreatedAt = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4605,30 +5026,26 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.CreatedAt |= int64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.Encoder = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Encoder |= v1beta11.Encoder(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4664,476 +5081,5568 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: band/tunnel/v1beta1/tunnel.proto
+var _ protoreflect.List = (*_FeePayerTopUp_3_list)(nil)
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+type _FeePayerTopUp_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeePayerTopUp_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeePayerTopUp_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeePayerTopUp_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeePayerTopUp_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeePayerTopUp_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayerTopUp_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeePayerTopUp_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayerTopUp_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FeePayerTopUp_4_list)(nil)
+
+type _FeePayerTopUp_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeePayerTopUp_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeePayerTopUp_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeePayerTopUp_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeePayerTopUp_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeePayerTopUp_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayerTopUp_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeePayerTopUp_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayerTopUp_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FeePayerTopUp_5_list)(nil)
+
+type _FeePayerTopUp_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeePayerTopUp_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeePayerTopUp_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeePayerTopUp_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeePayerTopUp_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeePayerTopUp_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayerTopUp_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeePayerTopUp_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayerTopUp_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_FeePayerTopUp                 protoreflect.MessageDescriptor
+	fd_FeePayerTopUp_tunnel_id       protoreflect.FieldDescriptor
+	fd_FeePayerTopUp_funder          protoreflect.FieldDescriptor
+	fd_FeePayerTopUp_target_balance  protoreflect.FieldDescriptor
+	fd_FeePayerTopUp_cap             protoreflect.FieldDescriptor
+	fd_FeePayerTopUp_total_topped_up protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tunnel_proto_init()
+	md_FeePayerTopUp = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("FeePayerTopUp")
+	fd_FeePayerTopUp_tunnel_id = md_FeePayerTopUp.Fields().ByName("tunnel_id")
+	fd_FeePayerTopUp_funder = md_FeePayerTopUp.Fields().ByName("funder")
+	fd_FeePayerTopUp_target_balance = md_FeePayerTopUp.Fields().ByName("target_balance")
+	fd_FeePayerTopUp_cap = md_FeePayerTopUp.Fields().ByName("cap")
+	fd_FeePayerTopUp_total_topped_up = md_FeePayerTopUp.Fields().ByName("total_topped_up")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeePayerTopUp)(nil)
+
+type fastReflection_FeePayerTopUp FeePayerTopUp
+
+func (x *FeePayerTopUp) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeePayerTopUp)(x)
+}
+
+func (x *FeePayerTopUp) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeePayerTopUp_messageType fastReflection_FeePayerTopUp_messageType
+var _ protoreflect.MessageType = fastReflection_FeePayerTopUp_messageType{}
+
+type fastReflection_FeePayerTopUp_messageType struct{}
+
+func (x fastReflection_FeePayerTopUp_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeePayerTopUp)(nil)
+}
+func (x fastReflection_FeePayerTopUp_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeePayerTopUp)
+}
+func (x fastReflection_FeePayerTopUp_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeePayerTopUp
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeePayerTopUp) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeePayerTopUp
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeePayerTopUp) Type() protoreflect.MessageType {
+	return _fastReflection_FeePayerTopUp_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeePayerTopUp) New() protoreflect.Message {
+	return new(fastReflection_FeePayerTopUp)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeePayerTopUp) Interface() protoreflect.ProtoMessage {
+	return (*FeePayerTopUp)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeePayerTopUp) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_FeePayerTopUp_tunnel_id, value) {
+			return
+		}
+	}
+	if x.Funder != "" {
+		value := protoreflect.ValueOfString(x.Funder)
+		if !f(fd_FeePayerTopUp_funder, value) {
+			return
+		}
+	}
+	if len(x.TargetBalance) != 0 {
+		value := protoreflect.ValueOfList(&_FeePayerTopUp_3_list{list: &x.TargetBalance})
+		if !f(fd_FeePayerTopUp_target_balance, value) {
+			return
+		}
+	}
+	if len(x.Cap) != 0 {
+		value := protoreflect.ValueOfList(&_FeePayerTopUp_4_list{list: &x.Cap})
+		if !f(fd_FeePayerTopUp_cap, value) {
+			return
+		}
+	}
+	if len(x.TotalToppedUp) != 0 {
+		value := protoreflect.ValueOfList(&_FeePayerTopUp_5_list{list: &x.TotalToppedUp})
+		if !f(fd_FeePayerTopUp_total_topped_up, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeePayerTopUp) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.FeePayerTopUp.tunnel_id":
+		return x.TunnelId != uint64(0)
+	case "band.tunnel.v1beta1.FeePayerTopUp.funder":
+		return x.Funder != ""
+	case "band.tunnel.v1beta1.FeePayerTopUp.target_balance":
+		return len(x.TargetBalance) != 0
+	case "band.tunnel.v1beta1.FeePayerTopUp.cap":
+		return len(x.Cap) != 0
+	case "band.tunnel.v1beta1.FeePayerTopUp.total_topped_up":
+		return len(x.TotalToppedUp) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FeePayerTopUp"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FeePayerTopUp does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeePayerTopUp) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.FeePayerTopUp.tunnel_id":
+		x.TunnelId = uint64(0)
+	case "band.tunnel.v1beta1.FeePayerTopUp.funder":
+		x.Funder = ""
+	case "band.tunnel.v1beta1.FeePayerTopUp.target_balance":
+		x.TargetBalance = nil
+	case "band.tunnel.v1beta1.FeePayerTopUp.cap":
+		x.Cap = nil
+	case "band.tunnel.v1beta1.FeePayerTopUp.total_topped_up":
+		x.TotalToppedUp = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FeePayerTopUp"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FeePayerTopUp does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeePayerTopUp) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.FeePayerTopUp.tunnel_id":
+		value := x.TunnelId
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.FeePayerTopUp.funder":
+		value := x.Funder
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.FeePayerTopUp.target_balance":
+		if len(x.TargetBalance) == 0 {
+			return protoreflect.ValueOfList(&_FeePayerTopUp_3_list{})
+		}
+		listValue := &_FeePayerTopUp_3_list{list: &x.TargetBalance}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.FeePayerTopUp.cap":
+		if len(x.Cap) == 0 {
+			return protoreflect.ValueOfList(&_FeePayerTopUp_4_list{})
+		}
+		listValue := &_FeePayerTopUp_4_list{list: &x.Cap}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.FeePayerTopUp.total_topped_up":
+		if len(x.TotalToppedUp) == 0 {
+			return protoreflect.ValueOfList(&_FeePayerTopUp_5_list{})
+		}
+		listValue := &_FeePayerTopUp_5_list{list: &x.TotalToppedUp}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FeePayerTopUp"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FeePayerTopUp does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeePayerTopUp) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.FeePayerTopUp.tunnel_id":
+		x.TunnelId = value.Uint()
+	case "band.tunnel.v1beta1.FeePayerTopUp.funder":
+		x.Funder = value.Interface().(string)
+	case "band.tunnel.v1beta1.FeePayerTopUp.target_balance":
+		lv := value.List()
+		clv := lv.(*_FeePayerTopUp_3_list)
+		x.TargetBalance = *clv.list
+	case "band.tunnel.v1beta1.FeePayerTopUp.cap":
+		lv := value.List()
+		clv := lv.(*_FeePayerTopUp_4_list)
+		x.Cap = *clv.list
+	case "band.tunnel.v1beta1.FeePayerTopUp.total_topped_up":
+		lv := value.List()
+		clv := lv.(*_FeePayerTopUp_5_list)
+		x.TotalToppedUp = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FeePayerTopUp"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FeePayerTopUp does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeePayerTopUp) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.FeePayerTopUp.target_balance":
+		if x.TargetBalance == nil {
+			x.TargetBalance = []*v1beta1.Coin{}
+		}
+		value := &_FeePayerTopUp_3_list{list: &x.TargetBalance}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.FeePayerTopUp.cap":
+		if x.Cap == nil {
+			x.Cap = []*v1beta1.Coin{}
+		}
+		value := &_FeePayerTopUp_4_list{list: &x.Cap}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.FeePayerTopUp.total_topped_up":
+		if x.TotalToppedUp == nil {
+			x.TotalToppedUp = []*v1beta1.Coin{}
+		}
+		value := &_FeePayerTopUp_5_list{list: &x.TotalToppedUp}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.FeePayerTopUp.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.FeePayerTopUp is not mutable"))
+	case "band.tunnel.v1beta1.FeePayerTopUp.funder":
+		panic(fmt.Errorf("field funder of message band.tunnel.v1beta1.FeePayerTopUp is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FeePayerTopUp"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FeePayerTopUp does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeePayerTopUp) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.FeePayerTopUp.tunnel_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.FeePayerTopUp.funder":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.FeePayerTopUp.target_balance":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeePayerTopUp_3_list{list: &list})
+	case "band.tunnel.v1beta1.FeePayerTopUp.cap":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeePayerTopUp_4_list{list: &list})
+	case "band.tunnel.v1beta1.FeePayerTopUp.total_topped_up":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeePayerTopUp_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FeePayerTopUp"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FeePayerTopUp does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeePayerTopUp) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.FeePayerTopUp", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeePayerTopUp) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeePayerTopUp) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeePayerTopUp) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeePayerTopUp) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeePayerTopUp)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
+		}
+		l = len(x.Funder)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.TargetBalance) > 0 {
+			for _, e := range x.TargetBalance {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Cap) > 0 {
+			for _, e := range x.Cap {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TotalToppedUp) > 0 {
+			for _, e := range x.TotalToppedUp {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeePayerTopUp)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TotalToppedUp) > 0 {
+			for iNdEx := len(x.TotalToppedUp) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TotalToppedUp[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Cap) > 0 {
+			for iNdEx := len(x.Cap) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Cap[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.TargetBalance) > 0 {
+			for iNdEx := len(x.TargetBalance) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TargetBalance[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Funder) > 0 {
+			i -= len(x.Funder)
+			copy(dAtA[i:], x.Funder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Funder)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeePayerTopUp)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeePayerTopUp: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeePayerTopUp: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				}
+				x.TunnelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TunnelId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Funder = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetBalance", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TargetBalance = append(x.TargetBalance, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TargetBalance[len(x.TargetBalance)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Cap = append(x.Cap, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Cap[len(x.Cap)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalToppedUp", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalToppedUp = append(x.TotalToppedUp, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalToppedUp[len(x.TotalToppedUp)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SignalDeviation                    protoreflect.MessageDescriptor
+	fd_SignalDeviation_signal_id          protoreflect.FieldDescriptor
+	fd_SignalDeviation_soft_deviation_bps protoreflect.FieldDescriptor
+	fd_SignalDeviation_hard_deviation_bps protoreflect.FieldDescriptor
+	fd_SignalDeviation_derivation         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tunnel_proto_init()
+	md_SignalDeviation = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("SignalDeviation")
+	fd_SignalDeviation_signal_id = md_SignalDeviation.Fields().ByName("signal_id")
+	fd_SignalDeviation_soft_deviation_bps = md_SignalDeviation.Fields().ByName("soft_deviation_bps")
+	fd_SignalDeviation_hard_deviation_bps = md_SignalDeviation.Fields().ByName("hard_deviation_bps")
+	fd_SignalDeviation_derivation = md_SignalDeviation.Fields().ByName("derivation")
+}
+
+var _ protoreflect.Message = (*fastReflection_SignalDeviation)(nil)
+
+type fastReflection_SignalDeviation SignalDeviation
+
+func (x *SignalDeviation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignalDeviation)(x)
+}
+
+func (x *SignalDeviation) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SignalDeviation_messageType fastReflection_SignalDeviation_messageType
+var _ protoreflect.MessageType = fastReflection_SignalDeviation_messageType{}
+
+type fastReflection_SignalDeviation_messageType struct{}
+
+func (x fastReflection_SignalDeviation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignalDeviation)(nil)
+}
+func (x fastReflection_SignalDeviation_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignalDeviation)
+}
+func (x fastReflection_SignalDeviation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalDeviation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SignalDeviation) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalDeviation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SignalDeviation) Type() protoreflect.MessageType {
+	return _fastReflection_SignalDeviation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SignalDeviation) New() protoreflect.Message {
+	return new(fastReflection_SignalDeviation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SignalDeviation) Interface() protoreflect.ProtoMessage {
+	return (*SignalDeviation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SignalDeviation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_SignalDeviation_signal_id, value) {
+			return
+		}
+	}
+	if x.SoftDeviationBps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SoftDeviationBps)
+		if !f(fd_SignalDeviation_soft_deviation_bps, value) {
+			return
+		}
+	}
+	if x.HardDeviationBps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HardDeviationBps)
+		if !f(fd_SignalDeviation_hard_deviation_bps, value) {
+			return
+		}
+	}
+	if x.Derivation != nil {
+		value := protoreflect.ValueOfMessage(x.Derivation.ProtoReflect())
+		if !f(fd_SignalDeviation_derivation, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SignalDeviation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+		return x.SignalId != ""
+	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+		return x.SoftDeviationBps != uint64(0)
+	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+		return x.HardDeviationBps != uint64(0)
+	case "band.tunnel.v1beta1.SignalDeviation.derivation":
+		return x.Derivation != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalDeviation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+		x.SignalId = ""
+	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+		x.SoftDeviationBps = uint64(0)
+	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+		x.HardDeviationBps = uint64(0)
+	case "band.tunnel.v1beta1.SignalDeviation.derivation":
+		x.Derivation = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SignalDeviation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+		value := x.SignalId
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+		value := x.SoftDeviationBps
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+		value := x.HardDeviationBps
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.SignalDeviation.derivation":
+		value := x.Derivation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalDeviation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+		x.SoftDeviationBps = value.Uint()
+	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+		x.HardDeviationBps = value.Uint()
+	case "band.tunnel.v1beta1.SignalDeviation.derivation":
+		x.Derivation = value.Message().Interface().(*SignalDerivation)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalDeviation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.SignalDeviation.derivation":
+		if x.Derivation == nil {
+			x.Derivation = new(SignalDerivation)
+		}
+		return protoreflect.ValueOfMessage(x.Derivation.ProtoReflect())
+	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.tunnel.v1beta1.SignalDeviation is not mutable"))
+	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+		panic(fmt.Errorf("field soft_deviation_bps of message band.tunnel.v1beta1.SignalDeviation is not mutable"))
+	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+		panic(fmt.Errorf("field hard_deviation_bps of message band.tunnel.v1beta1.SignalDeviation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SignalDeviation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.SignalDeviation.derivation":
+		m := new(SignalDerivation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SignalDeviation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.SignalDeviation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SignalDeviation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalDeviation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SignalDeviation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SignalDeviation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SoftDeviationBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.SoftDeviationBps))
+		}
+		if x.HardDeviationBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.HardDeviationBps))
+		}
+		if x.Derivation != nil {
+			l = options.Size(x.Derivation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SignalDeviation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Derivation != nil {
+			encoded, err := options.Marshal(x.Derivation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.HardDeviationBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HardDeviationBps))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.SoftDeviationBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SoftDeviationBps))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SignalDeviation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalDeviation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SoftDeviationBps", wireType)
+				}
+				x.SoftDeviationBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SoftDeviationBps |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HardDeviationBps", wireType)
+				}
+				x.HardDeviationBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HardDeviationBps |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Derivation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Derivation == nil {
+					x.Derivation = &SignalDerivation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Derivation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SignalDerivation                        protoreflect.MessageDescriptor
+	fd_SignalDerivation_type                   protoreflect.FieldDescriptor
+	fd_SignalDerivation_base_signal_id         protoreflect.FieldDescriptor
+	fd_SignalDerivation_quote_signal_id        protoreflect.FieldDescriptor
+	fd_SignalDerivation_multiplier_numerator   protoreflect.FieldDescriptor
+	fd_SignalDerivation_multiplier_denominator protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tunnel_proto_init()
+	md_SignalDerivation = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("SignalDerivation")
+	fd_SignalDerivation_type = md_SignalDerivation.Fields().ByName("type")
+	fd_SignalDerivation_base_signal_id = md_SignalDerivation.Fields().ByName("base_signal_id")
+	fd_SignalDerivation_quote_signal_id = md_SignalDerivation.Fields().ByName("quote_signal_id")
+	fd_SignalDerivation_multiplier_numerator = md_SignalDerivation.Fields().ByName("multiplier_numerator")
+	fd_SignalDerivation_multiplier_denominator = md_SignalDerivation.Fields().ByName("multiplier_denominator")
+}
+
+var _ protoreflect.Message = (*fastReflection_SignalDerivation)(nil)
+
+type fastReflection_SignalDerivation SignalDerivation
+
+func (x *SignalDerivation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignalDerivation)(x)
+}
+
+func (x *SignalDerivation) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SignalDerivation_messageType fastReflection_SignalDerivation_messageType
+var _ protoreflect.MessageType = fastReflection_SignalDerivation_messageType{}
+
+type fastReflection_SignalDerivation_messageType struct{}
+
+func (x fastReflection_SignalDerivation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignalDerivation)(nil)
+}
+func (x fastReflection_SignalDerivation_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignalDerivation)
+}
+func (x fastReflection_SignalDerivation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalDerivation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SignalDerivation) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalDerivation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SignalDerivation) Type() protoreflect.MessageType {
+	return _fastReflection_SignalDerivation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SignalDerivation) New() protoreflect.Message {
+	return new(fastReflection_SignalDerivation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SignalDerivation) Interface() protoreflect.ProtoMessage {
+	return (*SignalDerivation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SignalDerivation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Type_ != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Type_))
+		if !f(fd_SignalDerivation_type, value) {
+			return
+		}
+	}
+	if x.BaseSignalId != "" {
+		value := protoreflect.ValueOfString(x.BaseSignalId)
+		if !f(fd_SignalDerivation_base_signal_id, value) {
+			return
+		}
+	}
+	if x.QuoteSignalId != "" {
+		value := protoreflect.ValueOfString(x.QuoteSignalId)
+		if !f(fd_SignalDerivation_quote_signal_id, value) {
+			return
+		}
+	}
+	if x.MultiplierNumerator != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MultiplierNumerator)
+		if !f(fd_SignalDerivation_multiplier_numerator, value) {
+			return
+		}
+	}
+	if x.MultiplierDenominator != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MultiplierDenominator)
+		if !f(fd_SignalDerivation_multiplier_denominator, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SignalDerivation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.SignalDerivation.type":
+		return x.Type_ != 0
+	case "band.tunnel.v1beta1.SignalDerivation.base_signal_id":
+		return x.BaseSignalId != ""
+	case "band.tunnel.v1beta1.SignalDerivation.quote_signal_id":
+		return x.QuoteSignalId != ""
+	case "band.tunnel.v1beta1.SignalDerivation.multiplier_numerator":
+		return x.MultiplierNumerator != uint64(0)
+	case "band.tunnel.v1beta1.SignalDerivation.multiplier_denominator":
+		return x.MultiplierDenominator != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDerivation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDerivation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalDerivation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.SignalDerivation.type":
+		x.Type_ = 0
+	case "band.tunnel.v1beta1.SignalDerivation.base_signal_id":
+		x.BaseSignalId = ""
+	case "band.tunnel.v1beta1.SignalDerivation.quote_signal_id":
+		x.QuoteSignalId = ""
+	case "band.tunnel.v1beta1.SignalDerivation.multiplier_numerator":
+		x.MultiplierNumerator = uint64(0)
+	case "band.tunnel.v1beta1.SignalDerivation.multiplier_denominator":
+		x.MultiplierDenominator = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDerivation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDerivation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SignalDerivation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.SignalDerivation.type":
+		value := x.Type_
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.tunnel.v1beta1.SignalDerivation.base_signal_id":
+		value := x.BaseSignalId
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.SignalDerivation.quote_signal_id":
+		value := x.QuoteSignalId
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.SignalDerivation.multiplier_numerator":
+		value := x.MultiplierNumerator
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.SignalDerivation.multiplier_denominator":
+		value := x.MultiplierDenominator
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDerivation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDerivation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalDerivation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.SignalDerivation.type":
+		x.Type_ = (DerivationType)(value.Enum())
+	case "band.tunnel.v1beta1.SignalDerivation.base_signal_id":
+		x.BaseSignalId = value.Interface().(string)
+	case "band.tunnel.v1beta1.SignalDerivation.quote_signal_id":
+		x.QuoteSignalId = value.Interface().(string)
+	case "band.tunnel.v1beta1.SignalDerivation.multiplier_numerator":
+		x.MultiplierNumerator = value.Uint()
+	case "band.tunnel.v1beta1.SignalDerivation.multiplier_denominator":
+		x.MultiplierDenominator = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDerivation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDerivation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalDerivation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.SignalDerivation.type":
+		panic(fmt.Errorf("field type of message band.tunnel.v1beta1.SignalDerivation is not mutable"))
+	case "band.tunnel.v1beta1.SignalDerivation.base_signal_id":
+		panic(fmt.Errorf("field base_signal_id of message band.tunnel.v1beta1.SignalDerivation is not mutable"))
+	case "band.tunnel.v1beta1.SignalDerivation.quote_signal_id":
+		panic(fmt.Errorf("field quote_signal_id of message band.tunnel.v1beta1.SignalDerivation is not mutable"))
+	case "band.tunnel.v1beta1.SignalDerivation.multiplier_numerator":
+		panic(fmt.Errorf("field multiplier_numerator of message band.tunnel.v1beta1.SignalDerivation is not mutable"))
+	case "band.tunnel.v1beta1.SignalDerivation.multiplier_denominator":
+		panic(fmt.Errorf("field multiplier_denominator of message band.tunnel.v1beta1.SignalDerivation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDerivation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDerivation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SignalDerivation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.SignalDerivation.type":
+		return protoreflect.ValueOfEnum(0)
+	case "band.tunnel.v1beta1.SignalDerivation.base_signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.SignalDerivation.quote_signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.SignalDerivation.multiplier_numerator":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.SignalDerivation.multiplier_denominator":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDerivation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDerivation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SignalDerivation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.SignalDerivation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SignalDerivation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalDerivation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SignalDerivation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SignalDerivation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SignalDerivation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Type_ != 0 {
+			n += 1 + runtime.Sov(uint64(x.Type_))
+		}
+		l = len(x.BaseSignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.QuoteSignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MultiplierNumerator != 0 {
+			n += 1 + runtime.Sov(uint64(x.MultiplierNumerator))
+		}
+		if x.MultiplierDenominator != 0 {
+			n += 1 + runtime.Sov(uint64(x.MultiplierDenominator))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SignalDerivation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MultiplierDenominator != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MultiplierDenominator))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MultiplierNumerator != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MultiplierNumerator))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.QuoteSignalId) > 0 {
+			i -= len(x.QuoteSignalId)
+			copy(dAtA[i:], x.QuoteSignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.QuoteSignalId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BaseSignalId) > 0 {
+			i -= len(x.BaseSignalId)
+			copy(dAtA[i:], x.BaseSignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseSignalId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Type_ != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Type_))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SignalDerivation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalDerivation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalDerivation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
+				}
+				x.Type_ = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Type_ |= DerivationType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseSignalId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseSignalId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuoteSignalId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.QuoteSignalId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultiplierNumerator", wireType)
+				}
+				x.MultiplierNumerator = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MultiplierNumerator |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultiplierDenominator", wireType)
+				}
+				x.MultiplierDenominator = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MultiplierDenominator |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TunnelSignatureOrder_2_list)(nil)
+
+type _TunnelSignatureOrder_2_list struct {
+	list *[]*v1beta11.Price
+}
+
+func (x *_TunnelSignatureOrder_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TunnelSignatureOrder_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TunnelSignatureOrder_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Price)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TunnelSignatureOrder_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Price)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TunnelSignatureOrder_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Price)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TunnelSignatureOrder_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TunnelSignatureOrder_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Price)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TunnelSignatureOrder_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TunnelSignatureOrder            protoreflect.MessageDescriptor
+	fd_TunnelSignatureOrder_sequence   protoreflect.FieldDescriptor
+	fd_TunnelSignatureOrder_prices     protoreflect.FieldDescriptor
+	fd_TunnelSignatureOrder_created_at protoreflect.FieldDescriptor
+	fd_TunnelSignatureOrder_encoder    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tunnel_proto_init()
+	md_TunnelSignatureOrder = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("TunnelSignatureOrder")
+	fd_TunnelSignatureOrder_sequence = md_TunnelSignatureOrder.Fields().ByName("sequence")
+	fd_TunnelSignatureOrder_prices = md_TunnelSignatureOrder.Fields().ByName("prices")
+	fd_TunnelSignatureOrder_created_at = md_TunnelSignatureOrder.Fields().ByName("created_at")
+	fd_TunnelSignatureOrder_encoder = md_TunnelSignatureOrder.Fields().ByName("encoder")
+}
+
+var _ protoreflect.Message = (*fastReflection_TunnelSignatureOrder)(nil)
+
+type fastReflection_TunnelSignatureOrder TunnelSignatureOrder
+
+func (x *TunnelSignatureOrder) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TunnelSignatureOrder)(x)
+}
+
+func (x *TunnelSignatureOrder) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TunnelSignatureOrder_messageType fastReflection_TunnelSignatureOrder_messageType
+var _ protoreflect.MessageType = fastReflection_TunnelSignatureOrder_messageType{}
+
+type fastReflection_TunnelSignatureOrder_messageType struct{}
+
+func (x fastReflection_TunnelSignatureOrder_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TunnelSignatureOrder)(nil)
+}
+func (x fastReflection_TunnelSignatureOrder_messageType) New() protoreflect.Message {
+	return new(fastReflection_TunnelSignatureOrder)
+}
+func (x fastReflection_TunnelSignatureOrder_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TunnelSignatureOrder
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TunnelSignatureOrder) Descriptor() protoreflect.MessageDescriptor {
+	return md_TunnelSignatureOrder
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TunnelSignatureOrder) Type() protoreflect.MessageType {
+	return _fastReflection_TunnelSignatureOrder_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TunnelSignatureOrder) New() protoreflect.Message {
+	return new(fastReflection_TunnelSignatureOrder)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TunnelSignatureOrder) Interface() protoreflect.ProtoMessage {
+	return (*TunnelSignatureOrder)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TunnelSignatureOrder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_TunnelSignatureOrder_sequence, value) {
+			return
+		}
+	}
+	if len(x.Prices) != 0 {
+		value := protoreflect.ValueOfList(&_TunnelSignatureOrder_2_list{list: &x.Prices})
+		if !f(fd_TunnelSignatureOrder_prices, value) {
+			return
+		}
+	}
+	if x.CreatedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreatedAt)
+		if !f(fd_TunnelSignatureOrder_created_at, value) {
+			return
+		}
+	}
+	if x.Encoder != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Encoder))
+		if !f(fd_TunnelSignatureOrder_encoder, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TunnelSignatureOrder) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
+		return x.Sequence != uint64(0)
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
+		return len(x.Prices) != 0
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
+		return x.CreatedAt != int64(0)
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
+		return x.Encoder != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TunnelSignatureOrder) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
+		x.Sequence = uint64(0)
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
+		x.Prices = nil
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
+		x.CreatedAt = int64(0)
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
+		x.Encoder = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TunnelSignatureOrder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
+		if len(x.Prices) == 0 {
+			return protoreflect.ValueOfList(&_TunnelSignatureOrder_2_list{})
+		}
+		listValue := &_TunnelSignatureOrder_2_list{list: &x.Prices}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfInt64(value)
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
+		value := x.Encoder
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TunnelSignatureOrder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
+		x.Sequence = value.Uint()
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
+		lv := value.List()
+		clv := lv.(*_TunnelSignatureOrder_2_list)
+		x.Prices = *clv.list
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
+		x.CreatedAt = value.Int()
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
+		x.Encoder = (v1beta11.Encoder)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TunnelSignatureOrder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
+		if x.Prices == nil {
+			x.Prices = []*v1beta11.Price{}
+		}
+		value := &_TunnelSignatureOrder_2_list{list: &x.Prices}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
+		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.TunnelSignatureOrder is not mutable"))
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
+		panic(fmt.Errorf("field created_at of message band.tunnel.v1beta1.TunnelSignatureOrder is not mutable"))
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
+		panic(fmt.Errorf("field encoder of message band.tunnel.v1beta1.TunnelSignatureOrder is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TunnelSignatureOrder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
+		list := []*v1beta11.Price{}
+		return protoreflect.ValueOfList(&_TunnelSignatureOrder_2_list{list: &list})
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TunnelSignatureOrder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.TunnelSignatureOrder", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TunnelSignatureOrder) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TunnelSignatureOrder) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TunnelSignatureOrder) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TunnelSignatureOrder)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if len(x.Prices) > 0 {
+			for _, e := range x.Prices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CreatedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedAt))
+		}
+		if x.Encoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.Encoder))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TunnelSignatureOrder)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Encoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Encoder))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.CreatedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Prices) > 0 {
+			for iNdEx := len(x.Prices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Prices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TunnelSignatureOrder)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TunnelSignatureOrder: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TunnelSignatureOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Prices = append(x.Prices, &v1beta11.Price{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Prices[len(x.Prices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				}
+				x.CreatedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreatedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+				}
+				x.Encoder = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Encoder |= v1beta11.Encoder(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TunnelBatchSignatureOrder                      protoreflect.MessageDescriptor
+	fd_TunnelBatchSignatureOrder_destination_chain_id protoreflect.FieldDescriptor
+	fd_TunnelBatchSignatureOrder_merkle_root          protoreflect.FieldDescriptor
+	fd_TunnelBatchSignatureOrder_packet_count         protoreflect.FieldDescriptor
+	fd_TunnelBatchSignatureOrder_created_at           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tunnel_proto_init()
+	md_TunnelBatchSignatureOrder = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("TunnelBatchSignatureOrder")
+	fd_TunnelBatchSignatureOrder_destination_chain_id = md_TunnelBatchSignatureOrder.Fields().ByName("destination_chain_id")
+	fd_TunnelBatchSignatureOrder_merkle_root = md_TunnelBatchSignatureOrder.Fields().ByName("merkle_root")
+	fd_TunnelBatchSignatureOrder_packet_count = md_TunnelBatchSignatureOrder.Fields().ByName("packet_count")
+	fd_TunnelBatchSignatureOrder_created_at = md_TunnelBatchSignatureOrder.Fields().ByName("created_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_TunnelBatchSignatureOrder)(nil)
+
+type fastReflection_TunnelBatchSignatureOrder TunnelBatchSignatureOrder
+
+func (x *TunnelBatchSignatureOrder) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TunnelBatchSignatureOrder)(x)
+}
+
+func (x *TunnelBatchSignatureOrder) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TunnelBatchSignatureOrder_messageType fastReflection_TunnelBatchSignatureOrder_messageType
+var _ protoreflect.MessageType = fastReflection_TunnelBatchSignatureOrder_messageType{}
+
+type fastReflection_TunnelBatchSignatureOrder_messageType struct{}
+
+func (x fastReflection_TunnelBatchSignatureOrder_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TunnelBatchSignatureOrder)(nil)
+}
+func (x fastReflection_TunnelBatchSignatureOrder_messageType) New() protoreflect.Message {
+	return new(fastReflection_TunnelBatchSignatureOrder)
+}
+func (x fastReflection_TunnelBatchSignatureOrder_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TunnelBatchSignatureOrder
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TunnelBatchSignatureOrder) Descriptor() protoreflect.MessageDescriptor {
+	return md_TunnelBatchSignatureOrder
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TunnelBatchSignatureOrder) Type() protoreflect.MessageType {
+	return _fastReflection_TunnelBatchSignatureOrder_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TunnelBatchSignatureOrder) New() protoreflect.Message {
+	return new(fastReflection_TunnelBatchSignatureOrder)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TunnelBatchSignatureOrder) Interface() protoreflect.ProtoMessage {
+	return (*TunnelBatchSignatureOrder)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TunnelBatchSignatureOrder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DestinationChainId != "" {
+		value := protoreflect.ValueOfString(x.DestinationChainId)
+		if !f(fd_TunnelBatchSignatureOrder_destination_chain_id, value) {
+			return
+		}
+	}
+	if len(x.MerkleRoot) != 0 {
+		value := protoreflect.ValueOfBytes(x.MerkleRoot)
+		if !f(fd_TunnelBatchSignatureOrder_merkle_root, value) {
+			return
+		}
+	}
+	if x.PacketCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PacketCount)
+		if !f(fd_TunnelBatchSignatureOrder_packet_count, value) {
+			return
+		}
+	}
+	if x.CreatedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreatedAt)
+		if !f(fd_TunnelBatchSignatureOrder_created_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TunnelBatchSignatureOrder) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.destination_chain_id":
+		return x.DestinationChainId != ""
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.merkle_root":
+		return len(x.MerkleRoot) != 0
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.packet_count":
+		return x.PacketCount != uint64(0)
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.created_at":
+		return x.CreatedAt != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelBatchSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelBatchSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TunnelBatchSignatureOrder) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.destination_chain_id":
+		x.DestinationChainId = ""
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.merkle_root":
+		x.MerkleRoot = nil
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.packet_count":
+		x.PacketCount = uint64(0)
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.created_at":
+		x.CreatedAt = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelBatchSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelBatchSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TunnelBatchSignatureOrder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.destination_chain_id":
+		value := x.DestinationChainId
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.merkle_root":
+		value := x.MerkleRoot
+		return protoreflect.ValueOfBytes(value)
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.packet_count":
+		value := x.PacketCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelBatchSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelBatchSignatureOrder does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TunnelBatchSignatureOrder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.destination_chain_id":
+		x.DestinationChainId = value.Interface().(string)
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.merkle_root":
+		x.MerkleRoot = value.Bytes()
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.packet_count":
+		x.PacketCount = value.Uint()
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.created_at":
+		x.CreatedAt = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelBatchSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelBatchSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TunnelBatchSignatureOrder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.destination_chain_id":
+		panic(fmt.Errorf("field destination_chain_id of message band.tunnel.v1beta1.TunnelBatchSignatureOrder is not mutable"))
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.merkle_root":
+		panic(fmt.Errorf("field merkle_root of message band.tunnel.v1beta1.TunnelBatchSignatureOrder is not mutable"))
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.packet_count":
+		panic(fmt.Errorf("field packet_count of message band.tunnel.v1beta1.TunnelBatchSignatureOrder is not mutable"))
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.created_at":
+		panic(fmt.Errorf("field created_at of message band.tunnel.v1beta1.TunnelBatchSignatureOrder is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelBatchSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelBatchSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TunnelBatchSignatureOrder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.destination_chain_id":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.merkle_root":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.packet_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.TunnelBatchSignatureOrder.created_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelBatchSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelBatchSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TunnelBatchSignatureOrder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.TunnelBatchSignatureOrder", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TunnelBatchSignatureOrder) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TunnelBatchSignatureOrder) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TunnelBatchSignatureOrder) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TunnelBatchSignatureOrder) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TunnelBatchSignatureOrder)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.DestinationChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MerkleRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PacketCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.PacketCount))
+		}
+		if x.CreatedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedAt))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TunnelBatchSignatureOrder)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CreatedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.PacketCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PacketCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.MerkleRoot) > 0 {
+			i -= len(x.MerkleRoot)
+			copy(dAtA[i:], x.MerkleRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MerkleRoot)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.DestinationChainId) > 0 {
+			i -= len(x.DestinationChainId)
+			copy(dAtA[i:], x.DestinationChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationChainId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TunnelBatchSignatureOrder)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TunnelBatchSignatureOrder: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TunnelBatchSignatureOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DestinationChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MerkleRoot = append(x.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+				if x.MerkleRoot == nil {
+					x.MerkleRoot = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PacketCount", wireType)
+				}
+				x.PacketCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PacketCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				}
+				x.CreatedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreatedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PendingTSSBatchPacket_3_list)(nil)
+
+type _PendingTSSBatchPacket_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_PendingTSSBatchPacket_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PendingTSSBatchPacket_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PendingTSSBatchPacket_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PendingTSSBatchPacket_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PendingTSSBatchPacket_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PendingTSSBatchPacket_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PendingTSSBatchPacket_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PendingTSSBatchPacket_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PendingTSSBatchPacket              protoreflect.MessageDescriptor
+	fd_PendingTSSBatchPacket_tunnel_id    protoreflect.FieldDescriptor
+	fd_PendingTSSBatchPacket_sequence     protoreflect.FieldDescriptor
+	fd_PendingTSSBatchPacket_escrowed_fee protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tunnel_proto_init()
+	md_PendingTSSBatchPacket = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("PendingTSSBatchPacket")
+	fd_PendingTSSBatchPacket_tunnel_id = md_PendingTSSBatchPacket.Fields().ByName("tunnel_id")
+	fd_PendingTSSBatchPacket_sequence = md_PendingTSSBatchPacket.Fields().ByName("sequence")
+	fd_PendingTSSBatchPacket_escrowed_fee = md_PendingTSSBatchPacket.Fields().ByName("escrowed_fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_PendingTSSBatchPacket)(nil)
+
+type fastReflection_PendingTSSBatchPacket PendingTSSBatchPacket
+
+func (x *PendingTSSBatchPacket) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PendingTSSBatchPacket)(x)
+}
+
+func (x *PendingTSSBatchPacket) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PendingTSSBatchPacket_messageType fastReflection_PendingTSSBatchPacket_messageType
+var _ protoreflect.MessageType = fastReflection_PendingTSSBatchPacket_messageType{}
+
+type fastReflection_PendingTSSBatchPacket_messageType struct{}
+
+func (x fastReflection_PendingTSSBatchPacket_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PendingTSSBatchPacket)(nil)
+}
+func (x fastReflection_PendingTSSBatchPacket_messageType) New() protoreflect.Message {
+	return new(fastReflection_PendingTSSBatchPacket)
+}
+func (x fastReflection_PendingTSSBatchPacket_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingTSSBatchPacket
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PendingTSSBatchPacket) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingTSSBatchPacket
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PendingTSSBatchPacket) Type() protoreflect.MessageType {
+	return _fastReflection_PendingTSSBatchPacket_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PendingTSSBatchPacket) New() protoreflect.Message {
+	return new(fastReflection_PendingTSSBatchPacket)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PendingTSSBatchPacket) Interface() protoreflect.ProtoMessage {
+	return (*PendingTSSBatchPacket)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PendingTSSBatchPacket) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_PendingTSSBatchPacket_tunnel_id, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_PendingTSSBatchPacket_sequence, value) {
+			return
+		}
+	}
+	if len(x.EscrowedFee) != 0 {
+		value := protoreflect.ValueOfList(&_PendingTSSBatchPacket_3_list{list: &x.EscrowedFee})
+		if !f(fd_PendingTSSBatchPacket_escrowed_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PendingTSSBatchPacket) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.PendingTSSBatchPacket.tunnel_id":
+		return x.TunnelId != uint64(0)
+	case "band.tunnel.v1beta1.PendingTSSBatchPacket.sequence":
+		return x.Sequence != uint64(0)
+	case "band.tunnel.v1beta1.PendingTSSBatchPacket.escrowed_fee":
+		return len(x.EscrowedFee) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.PendingTSSBatchPacket"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.PendingTSSBatchPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingTSSBatchPacket) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.PendingTSSBatchPacket.tunnel_id":
+		x.TunnelId = uint64(0)
+	case "band.tunnel.v1beta1.PendingTSSBatchPacket.sequence":
+		x.Sequence = uint64(0)
+	case "band.tunnel.v1beta1.PendingTSSBatchPacket.escrowed_fee":
+		x.EscrowedFee = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.PendingTSSBatchPacket"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.PendingTSSBatchPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PendingTSSBatchPacket) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.PendingTSSBatchPacket.tunnel_id":
+		value := x.TunnelId
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.PendingTSSBatchPacket.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.PendingTSSBatchPacket.escrowed_fee":
+		if len(x.EscrowedFee) == 0 {
+			return protoreflect.ValueOfList(&_PendingTSSBatchPacket_3_list{})
+		}
+		listValue := &_PendingTSSBatchPacket_3_list{list: &x.EscrowedFee}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.PendingTSSBatchPacket"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.PendingTSSBatchPacket does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingTSSBatchPacket) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.PendingTSSBatchPacket.tunnel_id":
+		x.TunnelId = value.Uint()
+	case "band.tunnel.v1beta1.PendingTSSBatchPacket.sequence":
+		x.Sequence = value.Uint()
+	case "band.tunnel.v1beta1.PendingTSSBatchPacket.escrowed_fee":
+		lv := value.List()
+		clv := lv.(*_PendingTSSBatchPacket_3_list)
+		x.EscrowedFee = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.PendingTSSBatchPacket"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.PendingTSSBatchPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingTSSBatchPacket) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.PendingTSSBatchPacket.escrowed_fee":
+		if x.EscrowedFee == nil {
+			x.EscrowedFee = []*v1beta1.Coin{}
+		}
+		value := &_PendingTSSBatchPacket_3_list{list: &x.EscrowedFee}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.PendingTSSBatchPacket.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.PendingTSSBatchPacket is not mutable"))
+	case "band.tunnel.v1beta1.PendingTSSBatchPacket.sequence":
+		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.PendingTSSBatchPacket is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.PendingTSSBatchPacket"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.PendingTSSBatchPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PendingTSSBatchPacket) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.PendingTSSBatchPacket.tunnel_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.PendingTSSBatchPacket.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.PendingTSSBatchPacket.escrowed_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_PendingTSSBatchPacket_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.PendingTSSBatchPacket"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.PendingTSSBatchPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PendingTSSBatchPacket) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.PendingTSSBatchPacket", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PendingTSSBatchPacket) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingTSSBatchPacket) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PendingTSSBatchPacket) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PendingTSSBatchPacket) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PendingTSSBatchPacket)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if len(x.EscrowedFee) > 0 {
+			for _, e := range x.EscrowedFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PendingTSSBatchPacket)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EscrowedFee) > 0 {
+			for iNdEx := len(x.EscrowedFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EscrowedFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PendingTSSBatchPacket)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingTSSBatchPacket: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingTSSBatchPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				}
+				x.TunnelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TunnelId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowedFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EscrowedFee = append(x.EscrowedFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EscrowedFee[len(x.EscrowedFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EncodedTSSPacket_2_list)(nil)
+
+type _EncodedTSSPacket_2_list struct {
+	list *[]*v1beta11.EncodedRelayPrice
+}
+
+func (x *_EncodedTSSPacket_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EncodedTSSPacket_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EncodedTSSPacket_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.EncodedRelayPrice)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EncodedTSSPacket_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.EncodedRelayPrice)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EncodedTSSPacket_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.EncodedRelayPrice)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EncodedTSSPacket_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EncodedTSSPacket_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.EncodedRelayPrice)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EncodedTSSPacket_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EncodedTSSPacket              protoreflect.MessageDescriptor
+	fd_EncodedTSSPacket_sequence     protoreflect.FieldDescriptor
+	fd_EncodedTSSPacket_relay_prices protoreflect.FieldDescriptor
+	fd_EncodedTSSPacket_created_at   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tunnel_proto_init()
+	md_EncodedTSSPacket = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("EncodedTSSPacket")
+	fd_EncodedTSSPacket_sequence = md_EncodedTSSPacket.Fields().ByName("sequence")
+	fd_EncodedTSSPacket_relay_prices = md_EncodedTSSPacket.Fields().ByName("relay_prices")
+	fd_EncodedTSSPacket_created_at = md_EncodedTSSPacket.Fields().ByName("created_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_EncodedTSSPacket)(nil)
+
+type fastReflection_EncodedTSSPacket EncodedTSSPacket
+
+func (x *EncodedTSSPacket) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EncodedTSSPacket)(x)
+}
+
+func (x *EncodedTSSPacket) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EncodedTSSPacket_messageType fastReflection_EncodedTSSPacket_messageType
+var _ protoreflect.MessageType = fastReflection_EncodedTSSPacket_messageType{}
+
+type fastReflection_EncodedTSSPacket_messageType struct{}
+
+func (x fastReflection_EncodedTSSPacket_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EncodedTSSPacket)(nil)
+}
+func (x fastReflection_EncodedTSSPacket_messageType) New() protoreflect.Message {
+	return new(fastReflection_EncodedTSSPacket)
+}
+func (x fastReflection_EncodedTSSPacket_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EncodedTSSPacket
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EncodedTSSPacket) Descriptor() protoreflect.MessageDescriptor {
+	return md_EncodedTSSPacket
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EncodedTSSPacket) Type() protoreflect.MessageType {
+	return _fastReflection_EncodedTSSPacket_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EncodedTSSPacket) New() protoreflect.Message {
+	return new(fastReflection_EncodedTSSPacket)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EncodedTSSPacket) Interface() protoreflect.ProtoMessage {
+	return (*EncodedTSSPacket)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EncodedTSSPacket) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_EncodedTSSPacket_sequence, value) {
+			return
+		}
+	}
+	if len(x.RelayPrices) != 0 {
+		value := protoreflect.ValueOfList(&_EncodedTSSPacket_2_list{list: &x.RelayPrices})
+		if !f(fd_EncodedTSSPacket_relay_prices, value) {
+			return
+		}
+	}
+	if x.CreatedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreatedAt)
+		if !f(fd_EncodedTSSPacket_created_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EncodedTSSPacket) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.EncodedTSSPacket.sequence":
+		return x.Sequence != uint64(0)
+	case "band.tunnel.v1beta1.EncodedTSSPacket.relay_prices":
+		return len(x.RelayPrices) != 0
+	case "band.tunnel.v1beta1.EncodedTSSPacket.created_at":
+		return x.CreatedAt != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.EncodedTSSPacket"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.EncodedTSSPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncodedTSSPacket) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.EncodedTSSPacket.sequence":
+		x.Sequence = uint64(0)
+	case "band.tunnel.v1beta1.EncodedTSSPacket.relay_prices":
+		x.RelayPrices = nil
+	case "band.tunnel.v1beta1.EncodedTSSPacket.created_at":
+		x.CreatedAt = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.EncodedTSSPacket"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.EncodedTSSPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EncodedTSSPacket) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.EncodedTSSPacket.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.EncodedTSSPacket.relay_prices":
+		if len(x.RelayPrices) == 0 {
+			return protoreflect.ValueOfList(&_EncodedTSSPacket_2_list{})
+		}
+		listValue := &_EncodedTSSPacket_2_list{list: &x.RelayPrices}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.EncodedTSSPacket.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.EncodedTSSPacket"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.EncodedTSSPacket does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncodedTSSPacket) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.EncodedTSSPacket.sequence":
+		x.Sequence = value.Uint()
+	case "band.tunnel.v1beta1.EncodedTSSPacket.relay_prices":
+		lv := value.List()
+		clv := lv.(*_EncodedTSSPacket_2_list)
+		x.RelayPrices = *clv.list
+	case "band.tunnel.v1beta1.EncodedTSSPacket.created_at":
+		x.CreatedAt = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.EncodedTSSPacket"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.EncodedTSSPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncodedTSSPacket) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.EncodedTSSPacket.relay_prices":
+		if x.RelayPrices == nil {
+			x.RelayPrices = []*v1beta11.EncodedRelayPrice{}
+		}
+		value := &_EncodedTSSPacket_2_list{list: &x.RelayPrices}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.EncodedTSSPacket.sequence":
+		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.EncodedTSSPacket is not mutable"))
+	case "band.tunnel.v1beta1.EncodedTSSPacket.created_at":
+		panic(fmt.Errorf("field created_at of message band.tunnel.v1beta1.EncodedTSSPacket is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.EncodedTSSPacket"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.EncodedTSSPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EncodedTSSPacket) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.EncodedTSSPacket.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.EncodedTSSPacket.relay_prices":
+		list := []*v1beta11.EncodedRelayPrice{}
+		return protoreflect.ValueOfList(&_EncodedTSSPacket_2_list{list: &list})
+	case "band.tunnel.v1beta1.EncodedTSSPacket.created_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.EncodedTSSPacket"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.EncodedTSSPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EncodedTSSPacket) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.EncodedTSSPacket", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EncodedTSSPacket) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncodedTSSPacket) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EncodedTSSPacket) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EncodedTSSPacket) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EncodedTSSPacket)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if len(x.RelayPrices) > 0 {
+			for _, e := range x.RelayPrices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CreatedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedAt))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EncodedTSSPacket)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CreatedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.RelayPrices) > 0 {
+			for iNdEx := len(x.RelayPrices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RelayPrices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EncodedTSSPacket)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EncodedTSSPacket: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EncodedTSSPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RelayPrices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RelayPrices = append(x.RelayPrices, &v1beta11.EncodedRelayPrice{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RelayPrices[len(x.RelayPrices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				}
+				x.CreatedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreatedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: band/tunnel/v1beta1/tunnel.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// OperatorRole defines a permission that the owner of a tunnel grants to an operator.
+type OperatorRole int32
+
+const (
+	// OPERATOR_ROLE_UNSPECIFIED defines an unspecified role.
+	OperatorRole_OPERATOR_ROLE_UNSPECIFIED OperatorRole = 0
+	// OPERATOR_ROLE_TRIGGER allows the operator to trigger the tunnel.
+	OperatorRole_OPERATOR_ROLE_TRIGGER OperatorRole = 1
+	// OPERATOR_ROLE_CONFIG allows the operator to update the route, signals, interval and low balance
+	// threshold of the tunnel and to activate or deactivate it.
+	OperatorRole_OPERATOR_ROLE_CONFIG OperatorRole = 2
+	// OPERATOR_ROLE_FUNDS allows the operator to withdraw the fee payer funds to the owner and to manage
+	// the fee payer top-up of the tunnel.
+	OperatorRole_OPERATOR_ROLE_FUNDS OperatorRole = 3
+)
+
+// Enum value maps for OperatorRole.
+var (
+	OperatorRole_name = map[int32]string{
+		0: "OPERATOR_ROLE_UNSPECIFIED",
+		1: "OPERATOR_ROLE_TRIGGER",
+		2: "OPERATOR_ROLE_CONFIG",
+		3: "OPERATOR_ROLE_FUNDS",
+	}
+	OperatorRole_value = map[string]int32{
+		"OPERATOR_ROLE_UNSPECIFIED": 0,
+		"OPERATOR_ROLE_TRIGGER":     1,
+		"OPERATOR_ROLE_CONFIG":      2,
+		"OPERATOR_ROLE_FUNDS":       3,
+	}
+)
+
+func (x OperatorRole) Enum() *OperatorRole {
+	p := new(OperatorRole)
+	*p = x
+	return p
+}
+
+func (x OperatorRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OperatorRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_tunnel_v1beta1_tunnel_proto_enumTypes[0].Descriptor()
+}
+
+func (OperatorRole) Type() protoreflect.EnumType {
+	return &file_band_tunnel_v1beta1_tunnel_proto_enumTypes[0]
+}
+
+func (x OperatorRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OperatorRole.Descriptor instead.
+func (OperatorRole) EnumDescriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{0}
+}
+
+// DerivationType defines the operation used to derive a signal price from the feeds prices.
+type DerivationType int32
+
+const (
+	// DERIVATION_TYPE_UNSPECIFIED defines an unspecified derivation.
+	DerivationType_DERIVATION_TYPE_UNSPECIFIED DerivationType = 0
+	// DERIVATION_TYPE_CROSS_RATE defines a cross rate, i.e. the price of the base signal divided by
+	// the price of the quote signal.
+	DerivationType_DERIVATION_TYPE_CROSS_RATE DerivationType = 1
+	// DERIVATION_TYPE_INVERSE defines the inverse of the price of the base signal.
+	DerivationType_DERIVATION_TYPE_INVERSE DerivationType = 2
+	// DERIVATION_TYPE_RESCALE defines the price of the base signal multiplied by a fixed ratio.
+	DerivationType_DERIVATION_TYPE_RESCALE DerivationType = 3
+)
+
+// Enum value maps for DerivationType.
+var (
+	DerivationType_name = map[int32]string{
+		0: "DERIVATION_TYPE_UNSPECIFIED",
+		1: "DERIVATION_TYPE_CROSS_RATE",
+		2: "DERIVATION_TYPE_INVERSE",
+		3: "DERIVATION_TYPE_RESCALE",
+	}
+	DerivationType_value = map[string]int32{
+		"DERIVATION_TYPE_UNSPECIFIED": 0,
+		"DERIVATION_TYPE_CROSS_RATE":  1,
+		"DERIVATION_TYPE_INVERSE":     2,
+		"DERIVATION_TYPE_RESCALE":     3,
+	}
+)
+
+func (x DerivationType) Enum() *DerivationType {
+	p := new(DerivationType)
+	*p = x
+	return p
+}
+
+func (x DerivationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DerivationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_tunnel_v1beta1_tunnel_proto_enumTypes[1].Descriptor()
+}
+
+func (DerivationType) Type() protoreflect.EnumType {
+	return &file_band_tunnel_v1beta1_tunnel_proto_enumTypes[1]
+}
+
+func (x DerivationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DerivationType.Descriptor instead.
+func (DerivationType) EnumDescriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{1}
+}
+
+// Tunnel contains the information of the tunnel that is created by the user
+type Tunnel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the tunnel ID
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sequence is representing the sequence of the tunnel packet.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// route is the route for delivering the signal prices
+	Route *anypb.Any `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
+	// fee_payer is the address of the fee payer
+	FeePayer string `protobuf:"bytes,4,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// signal_deviations is the list of signal deviations
+	SignalDeviations []*SignalDeviation `protobuf:"bytes,5,rep,name=signal_deviations,json=signalDeviations,proto3" json:"signal_deviations,omitempty"`
+	// interval is the interval for delivering the signal prices
+	Interval uint64 `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	// total_deposit is the total deposit on the tunnel.
+	TotalDeposit []*v1beta1.Coin `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3" json:"total_deposit,omitempty"`
+	// is_active is the flag to indicate if the tunnel is active
+	IsActive bool `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// created_at is the timestamp when the tunnel is created
+	CreatedAt int64 `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// creator is the address of the creator
+	Creator string `protobuf:"bytes,10,opt,name=creator,proto3" json:"creator,omitempty"`
+	// schedule is the schedule for delivering the signal prices in addition to the interval and deviations
+	Schedule *Schedule `protobuf:"bytes,11,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// low_balance_threshold is the fee payer balance below which a low balance event is emitted
+	LowBalanceThreshold []*v1beta1.Coin `protobuf:"bytes,12,rep,name=low_balance_threshold,json=lowBalanceThreshold,proto3" json:"low_balance_threshold,omitempty"`
+	// owner is the address of the account that controls the tunnel. It can be any account, e.g. a multisig
+	// account or a module account.
+	Owner string `protobuf:"bytes,13,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *Tunnel) Reset() {
+	*x = Tunnel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tunnel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tunnel) ProtoMessage() {}
+
+// Deprecated: Use Tunnel.ProtoReflect.Descriptor instead.
+func (*Tunnel) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Tunnel) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Tunnel) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Tunnel) GetRoute() *anypb.Any {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *Tunnel) GetFeePayer() string {
+	if x != nil {
+		return x.FeePayer
+	}
+	return ""
+}
+
+func (x *Tunnel) GetSignalDeviations() []*SignalDeviation {
+	if x != nil {
+		return x.SignalDeviations
+	}
+	return nil
+}
+
+func (x *Tunnel) GetInterval() uint64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *Tunnel) GetTotalDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.TotalDeposit
+	}
+	return nil
+}
+
+func (x *Tunnel) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Tunnel) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Tunnel) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *Tunnel) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *Tunnel) GetLowBalanceThreshold() []*v1beta1.Coin {
+	if x != nil {
+		return x.LowBalanceThreshold
+	}
+	return nil
+}
+
+func (x *Tunnel) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+// TunnelOperator is an account that the owner of a tunnel allows to manage the tunnel with the given roles.
+type TunnelOperator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the ID of the tunnel.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// address is the address of the operator.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// roles is the list of roles of the operator.
+	Roles []OperatorRole `protobuf:"varint,3,rep,packed,name=roles,proto3,enum=band.tunnel.v1beta1.OperatorRole" json:"roles,omitempty"`
+}
+
+func (x *TunnelOperator) Reset() {
+	*x = TunnelOperator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelOperator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelOperator) ProtoMessage() {}
+
+// Deprecated: Use TunnelOperator.ProtoReflect.Descriptor instead.
+func (*TunnelOperator) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TunnelOperator) GetTunnelId() uint64 {
+	if x != nil {
+		return x.TunnelId
+	}
+	return 0
+}
+
+func (x *TunnelOperator) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TunnelOperator) GetRoles() []OperatorRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// Schedule defines the fixed times at which a tunnel delivers all of its signal prices.
+type Schedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cron is a UTC cron expression with five fields (minute, hour, day of month, month and day of week),
+	// e.g. "0 0,16 * * *" for every day at 00:00 and 16:00.
+	Cron string `protobuf:"bytes,1,opt,name=cron,proto3" json:"cron,omitempty"`
+	// block_heights is the list of block heights in ascending order.
+	BlockHeights []uint64 `protobuf:"varint,2,rep,packed,name=block_heights,json=blockHeights,proto3" json:"block_heights,omitempty"`
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Schedule) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *Schedule) GetBlockHeights() []uint64 {
+	if x != nil {
+		return x.BlockHeights
+	}
+	return nil
+}
+
+// LatestPrices is the type for prices that tunnel produces
+type LatestPrices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the tunnel ID
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// prices is the list of prices information from feeds module.
+	Prices []*v1beta11.Price `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
+	// last_interval is the last interval when the signal prices are produced by interval trigger
+	LastInterval int64 `protobuf:"varint,3,opt,name=last_interval,json=lastInterval,proto3" json:"last_interval,omitempty"`
+	// last_scheduled_at is the last time when the signal prices are produced by schedule trigger
+	LastScheduledAt int64 `protobuf:"varint,4,opt,name=last_scheduled_at,json=lastScheduledAt,proto3" json:"last_scheduled_at,omitempty"`
+}
+
+func (x *LatestPrices) Reset() {
+	*x = LatestPrices{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LatestPrices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatestPrices) ProtoMessage() {}
+
+// Deprecated: Use LatestPrices.ProtoReflect.Descriptor instead.
+func (*LatestPrices) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LatestPrices) GetTunnelId() uint64 {
+	if x != nil {
+		return x.TunnelId
+	}
+	return 0
+}
+
+func (x *LatestPrices) GetPrices() []*v1beta11.Price {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *LatestPrices) GetLastInterval() int64 {
+	if x != nil {
+		return x.LastInterval
+	}
+	return 0
+}
+
+func (x *LatestPrices) GetLastScheduledAt() int64 {
+	if x != nil {
+		return x.LastScheduledAt
+	}
+	return 0
+}
+
+// TotalFees is the type for the total fees collected by the tunnel
+type TotalFees struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// total_base_packet_fee is the total base packet fee collected by the tunnel
+	TotalBasePacketFee []*v1beta1.Coin `protobuf:"bytes,1,rep,name=total_base_packet_fee,json=totalBasePacketFee,proto3" json:"total_base_packet_fee,omitempty"`
+}
 
-// Tunnel contains the information of the tunnel that is created by the user
-type Tunnel struct {
+func (x *TotalFees) Reset() {
+	*x = TotalFees{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TotalFees) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TotalFees) ProtoMessage() {}
+
+// Deprecated: Use TotalFees.ProtoReflect.Descriptor instead.
+func (*TotalFees) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TotalFees) GetTotalBasePacketFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.TotalBasePacketFee
+	}
+	return nil
+}
+
+// Packet is the packet that tunnel produces
+type Packet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the tunnel ID
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// tunnel_id is the tunnel ID
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
 	// sequence is representing the sequence of the tunnel packet.
 	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	// route is the route for delivering the signal prices
-	Route *anypb.Any `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
-	// fee_payer is the address of the fee payer
-	FeePayer string `protobuf:"bytes,4,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
-	// signal_deviations is the list of signal deviations
-	SignalDeviations []*SignalDeviation `protobuf:"bytes,5,rep,name=signal_deviations,json=signalDeviations,proto3" json:"signal_deviations,omitempty"`
-	// interval is the interval for delivering the signal prices
-	Interval uint64 `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
-	// total_deposit is the total deposit on the tunnel.
-	TotalDeposit []*v1beta1.Coin `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3" json:"total_deposit,omitempty"`
-	// is_active is the flag to indicate if the tunnel is active
-	IsActive bool `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	// created_at is the timestamp when the tunnel is created
-	CreatedAt int64 `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// creator is the address of the creator
-	Creator string `protobuf:"bytes,10,opt,name=creator,proto3" json:"creator,omitempty"`
+	// prices is the list of prices information from feeds module.
+	Prices []*v1beta11.Price `protobuf:"bytes,3,rep,name=prices,proto3" json:"prices,omitempty"`
+	// receipt represents the confirmation of the packet delivery to the destination via the specified route.
+	Receipt *anypb.Any `protobuf:"bytes,4,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	// created_at is the timestamp when the packet is created
+	CreatedAt int64 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *Tunnel) Reset() {
-	*x = Tunnel{}
+func (x *Packet) Reset() {
+	*x = Packet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[0]
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Tunnel) String() string {
+func (x *Packet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tunnel) ProtoMessage() {}
+func (*Packet) ProtoMessage() {}
 
-// Deprecated: Use Tunnel.ProtoReflect.Descriptor instead.
-func (*Tunnel) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use Packet.ProtoReflect.Descriptor instead.
+func (*Packet) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Tunnel) GetId() uint64 {
+func (x *Packet) GetTunnelId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.TunnelId
 	}
 	return 0
 }
 
-func (x *Tunnel) GetSequence() uint64 {
+func (x *Packet) GetSequence() uint64 {
 	if x != nil {
 		return x.Sequence
 	}
 	return 0
 }
 
-func (x *Tunnel) GetRoute() *anypb.Any {
+func (x *Packet) GetPrices() []*v1beta11.Price {
 	if x != nil {
-		return x.Route
+		return x.Prices
 	}
 	return nil
 }
 
-func (x *Tunnel) GetFeePayer() string {
+func (x *Packet) GetReceipt() *anypb.Any {
 	if x != nil {
-		return x.FeePayer
+		return x.Receipt
 	}
-	return ""
+	return nil
 }
 
-func (x *Tunnel) GetSignalDeviations() []*SignalDeviation {
+func (x *Packet) GetCreatedAt() int64 {
 	if x != nil {
-		return x.SignalDeviations
+		return x.CreatedAt
 	}
-	return nil
+	return 0
 }
 
-func (x *Tunnel) GetInterval() uint64 {
+// Deposit defines an amount deposited by an account address to the tunnel.
+type Deposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id defines the unique id of the tunnel.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// depositor defines the deposit addresses from the proposals.
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// amount to be deposited by depositor.
+	Amount []*v1beta1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Deposit) Reset() {
+	*x = Deposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Deposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deposit) ProtoMessage() {}
+
+// Deprecated: Use Deposit.ProtoReflect.Descriptor instead.
+func (*Deposit) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Deposit) GetTunnelId() uint64 {
 	if x != nil {
-		return x.Interval
+		return x.TunnelId
 	}
 	return 0
 }
 
-func (x *Tunnel) GetTotalDeposit() []*v1beta1.Coin {
+func (x *Deposit) GetDepositor() string {
 	if x != nil {
-		return x.TotalDeposit
+		return x.Depositor
 	}
-	return nil
+	return ""
 }
 
-func (x *Tunnel) GetIsActive() bool {
+func (x *Deposit) GetAmount() []*v1beta1.Coin {
 	if x != nil {
-		return x.IsActive
+		return x.Amount
 	}
-	return false
+	return nil
 }
 
-func (x *Tunnel) GetCreatedAt() int64 {
+// FeePayerTopUp is the authorization of a funding account to refill the fee payer of a tunnel.
+type FeePayerTopUp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the ID of the tunnel whose fee payer is refilled.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// funder is the address of the account that funds the refills.
+	Funder string `protobuf:"bytes,2,opt,name=funder,proto3" json:"funder,omitempty"`
+	// target_balance is the balance that the fee payer is refilled up to.
+	TargetBalance []*v1beta1.Coin `protobuf:"bytes,3,rep,name=target_balance,json=targetBalance,proto3" json:"target_balance,omitempty"`
+	// cap is the maximum total amount that the funder transfers to the fee payer.
+	Cap []*v1beta1.Coin `protobuf:"bytes,4,rep,name=cap,proto3" json:"cap,omitempty"`
+	// total_topped_up is the total amount that the funder has transferred to the fee payer.
+	TotalToppedUp []*v1beta1.Coin `protobuf:"bytes,5,rep,name=total_topped_up,json=totalToppedUp,proto3" json:"total_topped_up,omitempty"`
+}
+
+func (x *FeePayerTopUp) Reset() {
+	*x = FeePayerTopUp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeePayerTopUp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeePayerTopUp) ProtoMessage() {}
+
+// Deprecated: Use FeePayerTopUp.ProtoReflect.Descriptor instead.
+func (*FeePayerTopUp) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FeePayerTopUp) GetTunnelId() uint64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.TunnelId
 	}
 	return 0
 }
 
-func (x *Tunnel) GetCreator() string {
+func (x *FeePayerTopUp) GetFunder() string {
 	if x != nil {
-		return x.Creator
+		return x.Funder
 	}
 	return ""
 }
 
-// LatestPrices is the type for prices that tunnel produces
-type LatestPrices struct {
+func (x *FeePayerTopUp) GetTargetBalance() []*v1beta1.Coin {
+	if x != nil {
+		return x.TargetBalance
+	}
+	return nil
+}
+
+func (x *FeePayerTopUp) GetCap() []*v1beta1.Coin {
+	if x != nil {
+		return x.Cap
+	}
+	return nil
+}
+
+func (x *FeePayerTopUp) GetTotalToppedUp() []*v1beta1.Coin {
+	if x != nil {
+		return x.TotalToppedUp
+	}
+	return nil
+}
+
+// SignalDeviation is the type for a signal with soft and hard deviation
+type SignalDeviation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tunnel_id is the tunnel ID
-	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
-	// prices is the list of prices information from feeds module.
-	Prices []*v1beta11.Price `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
-	// last_interval is the last interval when the signal prices are produced by interval trigger
-	LastInterval int64 `protobuf:"varint,3,opt,name=last_interval,json=lastInterval,proto3" json:"last_interval,omitempty"`
+	// signal_id is the signal ID
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// soft_deviation_bps is the soft deviation in basis points
+	SoftDeviationBps uint64 `protobuf:"varint,2,opt,name=soft_deviation_bps,json=softDeviationBps,proto3" json:"soft_deviation_bps,omitempty"`
+	// hard_deviation_bps is the hard deviation in basis points
+	HardDeviationBps uint64 `protobuf:"varint,3,opt,name=hard_deviation_bps,json=hardDeviationBps,proto3" json:"hard_deviation_bps,omitempty"`
+	// derivation defines how the price of the signal is derived from the feeds prices. If it is not set,
+	// the price of the signal is taken from the feeds module as is.
+	Derivation *SignalDerivation `protobuf:"bytes,4,opt,name=derivation,proto3" json:"derivation,omitempty"`
 }
 
-func (x *LatestPrices) Reset() {
-	*x = LatestPrices{}
+func (x *SignalDeviation) Reset() {
+	*x = SignalDeviation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[1]
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LatestPrices) String() string {
+func (x *SignalDeviation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LatestPrices) ProtoMessage() {}
+func (*SignalDeviation) ProtoMessage() {}
 
-// Deprecated: Use LatestPrices.ProtoReflect.Descriptor instead.
-func (*LatestPrices) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use SignalDeviation.ProtoReflect.Descriptor instead.
+func (*SignalDeviation) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *LatestPrices) GetTunnelId() uint64 {
+func (x *SignalDeviation) GetSignalId() string {
 	if x != nil {
-		return x.TunnelId
+		return x.SignalId
 	}
-	return 0
+	return ""
 }
 
-func (x *LatestPrices) GetPrices() []*v1beta11.Price {
+func (x *SignalDeviation) GetSoftDeviationBps() uint64 {
 	if x != nil {
-		return x.Prices
+		return x.SoftDeviationBps
 	}
-	return nil
+	return 0
 }
 
-func (x *LatestPrices) GetLastInterval() int64 {
+func (x *SignalDeviation) GetHardDeviationBps() uint64 {
 	if x != nil {
-		return x.LastInterval
+		return x.HardDeviationBps
 	}
 	return 0
 }
 
-// TotalFees is the type for the total fees collected by the tunnel
-type TotalFees struct {
+func (x *SignalDeviation) GetDerivation() *SignalDerivation {
+	if x != nil {
+		return x.Derivation
+	}
+	return nil
+}
+
+// SignalDerivation defines how the price of a derived signal is computed from the feeds prices.
+type SignalDerivation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// total_base_packet_fee is the total base packet fee collected by the tunnel
-	TotalBasePacketFee []*v1beta1.Coin `protobuf:"bytes,1,rep,name=total_base_packet_fee,json=totalBasePacketFee,proto3" json:"total_base_packet_fee,omitempty"`
+	// type is the operation used to derive the price.
+	Type_ DerivationType `protobuf:"varint,1,opt,name=type,proto3,enum=band.tunnel.v1beta1.DerivationType" json:"type,omitempty"`
+	// base_signal_id is the signal ID of the feeds price that the derived price is based on.
+	BaseSignalId string `protobuf:"bytes,2,opt,name=base_signal_id,json=baseSignalId,proto3" json:"base_signal_id,omitempty"`
+	// quote_signal_id is the signal ID of the feeds price that divides the base price of a cross rate.
+	QuoteSignalId string `protobuf:"bytes,3,opt,name=quote_signal_id,json=quoteSignalId,proto3" json:"quote_signal_id,omitempty"`
+	// multiplier_numerator is the numerator of the ratio applied to the base price of a rescale.
+	MultiplierNumerator uint64 `protobuf:"varint,4,opt,name=multiplier_numerator,json=multiplierNumerator,proto3" json:"multiplier_numerator,omitempty"`
+	// multiplier_denominator is the denominator of the ratio applied to the base price of a rescale.
+	MultiplierDenominator uint64 `protobuf:"varint,5,opt,name=multiplier_denominator,json=multiplierDenominator,proto3" json:"multiplier_denominator,omitempty"`
 }
 
-func (x *TotalFees) Reset() {
-	*x = TotalFees{}
+func (x *SignalDerivation) Reset() {
+	*x = SignalDerivation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[2]
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TotalFees) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SignalDerivation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalDerivation) ProtoMessage() {}
+
+// Deprecated: Use SignalDerivation.ProtoReflect.Descriptor instead.
+func (*SignalDerivation) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SignalDerivation) GetType_() DerivationType {
+	if x != nil {
+		return x.Type_
+	}
+	return DerivationType_DERIVATION_TYPE_UNSPECIFIED
+}
+
+func (x *SignalDerivation) GetBaseSignalId() string {
+	if x != nil {
+		return x.BaseSignalId
+	}
+	return ""
+}
+
+func (x *SignalDerivation) GetQuoteSignalId() string {
+	if x != nil {
+		return x.QuoteSignalId
+	}
+	return ""
 }
 
-func (*TotalFees) ProtoMessage() {}
-
-// Deprecated: Use TotalFees.ProtoReflect.Descriptor instead.
-func (*TotalFees) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{2}
+func (x *SignalDerivation) GetMultiplierNumerator() uint64 {
+	if x != nil {
+		return x.MultiplierNumerator
+	}
+	return 0
 }
 
-func (x *TotalFees) GetTotalBasePacketFee() []*v1beta1.Coin {
+func (x *SignalDerivation) GetMultiplierDenominator() uint64 {
 	if x != nil {
-		return x.TotalBasePacketFee
+		return x.MultiplierDenominator
 	}
-	return nil
+	return 0
 }
 
-// Packet is the packet that tunnel produces
-type Packet struct {
+// TunnelSignatureOrder defines a general signature order for sending signature to tss group.
+type TunnelSignatureOrder struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tunnel_id is the tunnel ID
-	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
-	// sequence is representing the sequence of the tunnel packet.
-	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// sequence is the sequence of the packet
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	// prices is the list of prices information from feeds module.
-	Prices []*v1beta11.Price `protobuf:"bytes,3,rep,name=prices,proto3" json:"prices,omitempty"`
-	// receipt represents the confirmation of the packet delivery to the destination via the specified route.
-	Receipt *anypb.Any `protobuf:"bytes,4,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	Prices []*v1beta11.Price `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
 	// created_at is the timestamp when the packet is created
-	CreatedAt int64 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedAt int64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// encoder is the mode of encoding data.
+	Encoder v1beta11.Encoder `protobuf:"varint,4,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
 }
 
-func (x *Packet) Reset() {
-	*x = Packet{}
+func (x *TunnelSignatureOrder) Reset() {
+	*x = TunnelSignatureOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[3]
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Packet) String() string {
+func (x *TunnelSignatureOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Packet) ProtoMessage() {}
-
-// Deprecated: Use Packet.ProtoReflect.Descriptor instead.
-func (*Packet) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{3}
-}
+func (*TunnelSignatureOrder) ProtoMessage() {}
 
-func (x *Packet) GetTunnelId() uint64 {
-	if x != nil {
-		return x.TunnelId
-	}
-	return 0
+// Deprecated: Use TunnelSignatureOrder.ProtoReflect.Descriptor instead.
+func (*TunnelSignatureOrder) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Packet) GetSequence() uint64 {
+func (x *TunnelSignatureOrder) GetSequence() uint64 {
 	if x != nil {
 		return x.Sequence
 	}
 	return 0
 }
 
-func (x *Packet) GetPrices() []*v1beta11.Price {
+func (x *TunnelSignatureOrder) GetPrices() []*v1beta11.Price {
 	if x != nil {
 		return x.Prices
 	}
 	return nil
 }
 
-func (x *Packet) GetReceipt() *anypb.Any {
+func (x *TunnelSignatureOrder) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Receipt
+		return x.CreatedAt
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetCreatedAt() int64 {
+func (x *TunnelSignatureOrder) GetEncoder() v1beta11.Encoder {
 	if x != nil {
-		return x.CreatedAt
+		return x.Encoder
 	}
-	return 0
+	return v1beta11.Encoder(0)
 }
 
-// Deposit defines an amount deposited by an account address to the tunnel.
-type Deposit struct {
+// TunnelBatchSignatureOrder defines a signature order for signing the Merkle root of a batch of
+// tunnel packets targeting the same destination chain.
+type TunnelBatchSignatureOrder struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tunnel_id defines the unique id of the tunnel.
-	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
-	// depositor defines the deposit addresses from the proposals.
-	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
-	// amount to be deposited by depositor.
-	Amount []*v1beta1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
+	// destination_chain_id is the destination chain ID of every packet in the batch
+	DestinationChainId string `protobuf:"bytes,1,opt,name=destination_chain_id,json=destinationChainId,proto3" json:"destination_chain_id,omitempty"`
+	// merkle_root is the root of the Merkle tree of the encoded packets
+	MerkleRoot []byte `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// packet_count is the number of packets in the batch
+	PacketCount uint64 `protobuf:"varint,3,opt,name=packet_count,json=packetCount,proto3" json:"packet_count,omitempty"`
+	// created_at is the timestamp when the batch is created
+	CreatedAt int64 `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *Deposit) Reset() {
-	*x = Deposit{}
+func (x *TunnelBatchSignatureOrder) Reset() {
+	*x = TunnelBatchSignatureOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[4]
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Deposit) String() string {
+func (x *TunnelBatchSignatureOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Deposit) ProtoMessage() {}
+func (*TunnelBatchSignatureOrder) ProtoMessage() {}
 
-// Deprecated: Use Deposit.ProtoReflect.Descriptor instead.
-func (*Deposit) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use TunnelBatchSignatureOrder.ProtoReflect.Descriptor instead.
+func (*TunnelBatchSignatureOrder) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Deposit) GetTunnelId() uint64 {
+func (x *TunnelBatchSignatureOrder) GetDestinationChainId() string {
 	if x != nil {
-		return x.TunnelId
+		return x.DestinationChainId
 	}
-	return 0
+	return ""
 }
 
-func (x *Deposit) GetDepositor() string {
+func (x *TunnelBatchSignatureOrder) GetMerkleRoot() []byte {
 	if x != nil {
-		return x.Depositor
+		return x.MerkleRoot
 	}
-	return ""
+	return nil
 }
 
-func (x *Deposit) GetAmount() []*v1beta1.Coin {
+func (x *TunnelBatchSignatureOrder) GetPacketCount() uint64 {
 	if x != nil {
-		return x.Amount
+		return x.PacketCount
 	}
-	return nil
+	return 0
 }
 
-// SignalDeviation is the type for a signal with soft and hard deviation
-type SignalDeviation struct {
+func (x *TunnelBatchSignatureOrder) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// PendingTSSBatchPacket defines a packet waiting to be signed as a part of a batch at the end of the block.
+type PendingTSSBatchPacket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// signal_id is the signal ID
-	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
-	// soft_deviation_bps is the soft deviation in basis points
-	SoftDeviationBps uint64 `protobuf:"varint,2,opt,name=soft_deviation_bps,json=softDeviationBps,proto3" json:"soft_deviation_bps,omitempty"`
-	// hard_deviation_bps is the hard deviation in basis points
-	HardDeviationBps uint64 `protobuf:"varint,3,opt,name=hard_deviation_bps,json=hardDeviationBps,proto3" json:"hard_deviation_bps,omitempty"`
+	// tunnel_id is the ID of the tunnel
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// sequence is the sequence of the packet
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// escrowed_fee is the signing fee escrowed from the fee payer of the tunnel
+	EscrowedFee []*v1beta1.Coin `protobuf:"bytes,3,rep,name=escrowed_fee,json=escrowedFee,proto3" json:"escrowed_fee,omitempty"`
 }
 
-func (x *SignalDeviation) Reset() {
-	*x = SignalDeviation{}
+func (x *PendingTSSBatchPacket) Reset() {
+	*x = PendingTSSBatchPacket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[5]
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SignalDeviation) String() string {
+func (x *PendingTSSBatchPacket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignalDeviation) ProtoMessage() {}
+func (*PendingTSSBatchPacket) ProtoMessage() {}
 
-// Deprecated: Use SignalDeviation.ProtoReflect.Descriptor instead.
-func (*SignalDeviation) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use PendingTSSBatchPacket.ProtoReflect.Descriptor instead.
+func (*PendingTSSBatchPacket) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SignalDeviation) GetSignalId() string {
+func (x *PendingTSSBatchPacket) GetTunnelId() uint64 {
 	if x != nil {
-		return x.SignalId
+		return x.TunnelId
 	}
-	return ""
+	return 0
 }
 
-func (x *SignalDeviation) GetSoftDeviationBps() uint64 {
+func (x *PendingTSSBatchPacket) GetSequence() uint64 {
 	if x != nil {
-		return x.SoftDeviationBps
+		return x.Sequence
 	}
 	return 0
 }
 
-func (x *SignalDeviation) GetHardDeviationBps() uint64 {
+func (x *PendingTSSBatchPacket) GetEscrowedFee() []*v1beta1.Coin {
 	if x != nil {
-		return x.HardDeviationBps
+		return x.EscrowedFee
 	}
-	return 0
+	return nil
 }
 
-// TunnelSignatureOrder defines a general signature order for sending signature to tss group.
-type TunnelSignatureOrder struct {
+// EncodedTSSPacket is the protobuf layout of a tunnel packet encoded by ENCODER_FIXED_POINT_PROTOBUF.
+type EncodedTSSPacket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// sequence is the sequence of the packet
 	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	// prices is the list of prices information from feeds module.
-	Prices []*v1beta11.Price `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
+	// relay_prices is the list of relay prices of the packet.
+	RelayPrices []*v1beta11.EncodedRelayPrice `protobuf:"bytes,2,rep,name=relay_prices,json=relayPrices,proto3" json:"relay_prices,omitempty"`
 	// created_at is the timestamp when the packet is created
 	CreatedAt int64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// encoder is the mode of encoding data.
-	Encoder v1beta11.Encoder `protobuf:"varint,4,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
 }
 
-func (x *TunnelSignatureOrder) Reset() {
-	*x = TunnelSignatureOrder{}
+func (x *EncodedTSSPacket) Reset() {
+	*x = EncodedTSSPacket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[6]
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TunnelSignatureOrder) String() string {
+func (x *EncodedTSSPacket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TunnelSignatureOrder) ProtoMessage() {}
+func (*EncodedTSSPacket) ProtoMessage() {}
 
-// Deprecated: Use TunnelSignatureOrder.ProtoReflect.Descriptor instead.
-func (*TunnelSignatureOrder) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use EncodedTSSPacket.ProtoReflect.Descriptor instead.
+func (*EncodedTSSPacket) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *TunnelSignatureOrder) GetSequence() uint64 {
+func (x *EncodedTSSPacket) GetSequence() uint64 {
 	if x != nil {
 		return x.Sequence
 	}
 	return 0
 }
 
-func (x *TunnelSignatureOrder) GetPrices() []*v1beta11.Price {
+func (x *EncodedTSSPacket) GetRelayPrices() []*v1beta11.EncodedRelayPrice {
 	if x != nil {
-		return x.Prices
+		return x.RelayPrices
 	}
 	return nil
 }
 
-func (x *TunnelSignatureOrder) GetCreatedAt() int64 {
+func (x *EncodedTSSPacket) GetCreatedAt() int64 {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *TunnelSignatureOrder) GetEncoder() v1beta11.Encoder {
-	if x != nil {
-		return x.Encoder
-	}
-	return v1beta11.Encoder(0)
-}
-
 var File_band_tunnel_v1beta1_tunnel_proto protoreflect.FileDescriptor
 
 var file_band_tunnel_v1beta1_tunnel_proto_rawDesc = []byte{
@@ -5152,7 +10661,7 @@ var file_band_tunnel_v1beta1_tunnel_proto_rawDesc = []byte{
 	0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x6e, 0x63, 0x6f, 0x64,
 	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66,
 	0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x66, 0x65, 0x65,
-	0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8d, 0x04, 0x0a, 0x06, 0x54, 0x75, 0x6e,
+	0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf9, 0x05, 0x0a, 0x06, 0x54, 0x75, 0x6e,
 	0x6e, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
 	0x06, 0xe2, 0xde, 0x1f, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73,
 	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73,
@@ -5185,95 +10694,234 @@ var file_band_tunnel_v1beta1_tunnel_proto_rawDesc = []byte{
 	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
 	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x9d, 0x01, 0x0a, 0x0c, 0x4c, 0x61, 0x74,
-	0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde,
-	0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64,
-	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a,
-	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
-	0x61, 0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x91, 0x01, 0x0a, 0x09, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x12, 0x7e, 0x0a, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x73, 0x52, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xeb, 0x01, 0x0a,
-	0x06, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6f, 0x72, 0x12, 0x39, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x7f, 0x0a,
+	0x15, 0x6c, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x13, 0x6c, 0x6f, 0x77, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2e,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x3a, 0x04,
+	0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xae, 0x01, 0x0a, 0x0e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65,
 	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08,
 	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x37,
-	0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x12,
-	0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
-	0x74, 0x49, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xdc, 0x01, 0x0a, 0x07, 0x44,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
-	0x64, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09,
-	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x68, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x49, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x3a,
+	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x49, 0x0a, 0x08, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
+	0x22, 0xc9, 0x01, 0x0a, 0x0c, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x06,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61,
+	0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x91, 0x01, 0x0a,
+	0x09, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x12, 0x7e, 0x0a, 0x15, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
 	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xca, 0x01, 0x0a, 0x0f, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x12, 0x73, 0x6f, 0x66, 0x74,
-	0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65,
-	0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x50, 0x53, 0x52, 0x10, 0x73, 0x6f, 0x66, 0x74,
-	0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70, 0x73, 0x12, 0x42, 0x0a, 0x12,
-	0x68, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62,
-	0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10, 0x48, 0x61,
-	0x72, 0x64, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x50, 0x53, 0x52, 0x10,
-	0x68, 0x61, 0x72, 0x64, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70, 0x73,
-	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc7, 0x01, 0x0a, 0x14, 0x54, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
-	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64,
-	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00,
-	0x42, 0xe0, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0b, 0x54, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64,
-	0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca,
-	0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa8,
-	0xe2, 0x1e, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x73,
+	0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
+	0x22, 0xeb, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c,
+	0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x07, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
+	0x6e, 0x79, 0x42, 0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xdc,
+	0x01, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2,
+	0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x68, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc4, 0x03,
+	0x0a, 0x0d, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x65, 0x72, 0x54, 0x6f, 0x70, 0x55, 0x70, 0x12,
+	0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44,
+	0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x66, 0x75,
+	0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x77, 0x0a, 0x0e,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x03, 0x63, 0x61, 0x70, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8,
+	0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x03, 0x63, 0x61, 0x70, 0x12, 0x73, 0x0a, 0x0f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8,
+	0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52,
+	0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x55, 0x70, 0x3a, 0x04,
+	0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x91, 0x02, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x44,
+	0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f,
+	0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x12, 0x73, 0x6f, 0x66, 0x74, 0x5f, 0x64, 0x65, 0x76, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x14, 0xe2, 0xde, 0x1f, 0x10, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x50, 0x53, 0x52, 0x10, 0x73, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x76, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70, 0x73, 0x12, 0x42, 0x0a, 0x12, 0x68, 0x61, 0x72, 0x64, 0x5f,
+	0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10, 0x48, 0x61, 0x72, 0x64, 0x44, 0x65, 0x76,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x50, 0x53, 0x52, 0x10, 0x68, 0x61, 0x72, 0x64, 0x44,
+	0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x64,
+	0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xae, 0x02, 0x0a, 0x10, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x44, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10,
+	0xe2, 0xde, 0x1f, 0x0c, 0x42, 0x61, 0x73, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44,
+	0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x39,
+	0x0a, 0x0f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x0d, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x16,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc7, 0x01, 0x0a, 0x14, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x37,
+	0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66,
+	0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a, 0x04, 0x88,
+	0xa0, 0x1f, 0x00, 0x22, 0xce, 0x01, 0x0a, 0x19, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x48, 0x0a, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x16, 0xe2, 0xde, 0x1f, 0x12, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x3a, 0x04,
+	0x88, 0xa0, 0x1f, 0x00, 0x22, 0xce, 0x01, 0x0a, 0x15, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x54, 0x53, 0x53, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x29,
+	0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52,
+	0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x6e, 0x0a, 0x0c, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65,
+	0x64, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0b, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77,
+	0x65, 0x64, 0x46, 0x65, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x10, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x64, 0x54, 0x53, 0x53, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x79,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x2a, 0x81, 0x01, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x4f, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f,
+	0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x10, 0x01,
+	0x12, 0x18, 0x0a, 0x14, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x4f, 0x4c,
+	0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x50,
+	0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x46, 0x55, 0x4e, 0x44,
+	0x53, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x91, 0x01, 0x0a, 0x0e, 0x44, 0x65,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b,
+	0x44, 0x45, 0x52, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a,
+	0x1a, 0x44, 0x45, 0x52, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x43, 0x52, 0x4f, 0x53, 0x53, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x1b, 0x0a,
+	0x17, 0x44, 0x45, 0x52, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x49, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x45, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x45,
+	0x52, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45,
+	0x53, 0x43, 0x41, 0x4c, 0x45, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xe0, 0x01,
+	0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0b, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x13, 0x42, 0x61, 0x6e,
+	0x64, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0xca, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42, 0x61, 0x6e, 0x64, 0x3a,
+	0x3a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5288,36 +10936,57 @@ func file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP() []byte {
 	return file_band_tunnel_v1beta1_tunnel_proto_rawDescData
 }
 
-var file_band_tunnel_v1beta1_tunnel_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_band_tunnel_v1beta1_tunnel_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_band_tunnel_v1beta1_tunnel_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_band_tunnel_v1beta1_tunnel_proto_goTypes = []interface{}{
-	(*Tunnel)(nil),               // 0: band.tunnel.v1beta1.Tunnel
-	(*LatestPrices)(nil),         // 1: band.tunnel.v1beta1.LatestPrices
-	(*TotalFees)(nil),            // 2: band.tunnel.v1beta1.TotalFees
-	(*Packet)(nil),               // 3: band.tunnel.v1beta1.Packet
-	(*Deposit)(nil),              // 4: band.tunnel.v1beta1.Deposit
-	(*SignalDeviation)(nil),      // 5: band.tunnel.v1beta1.SignalDeviation
-	(*TunnelSignatureOrder)(nil), // 6: band.tunnel.v1beta1.TunnelSignatureOrder
-	(*anypb.Any)(nil),            // 7: google.protobuf.Any
-	(*v1beta1.Coin)(nil),         // 8: cosmos.base.v1beta1.Coin
-	(*v1beta11.Price)(nil),       // 9: band.feeds.v1beta1.Price
-	(v1beta11.Encoder)(0),        // 10: band.feeds.v1beta1.Encoder
+	(OperatorRole)(0),                  // 0: band.tunnel.v1beta1.OperatorRole
+	(DerivationType)(0),                // 1: band.tunnel.v1beta1.DerivationType
+	(*Tunnel)(nil),                     // 2: band.tunnel.v1beta1.Tunnel
+	(*TunnelOperator)(nil),             // 3: band.tunnel.v1beta1.TunnelOperator
+	(*Schedule)(nil),                   // 4: band.tunnel.v1beta1.Schedule
+	(*LatestPrices)(nil),               // 5: band.tunnel.v1beta1.LatestPrices
+	(*TotalFees)(nil),                  // 6: band.tunnel.v1beta1.TotalFees
+	(*Packet)(nil),                     // 7: band.tunnel.v1beta1.Packet
+	(*Deposit)(nil),                    // 8: band.tunnel.v1beta1.Deposit
+	(*FeePayerTopUp)(nil),              // 9: band.tunnel.v1beta1.FeePayerTopUp
+	(*SignalDeviation)(nil),            // 10: band.tunnel.v1beta1.SignalDeviation
+	(*SignalDerivation)(nil),           // 11: band.tunnel.v1beta1.SignalDerivation
+	(*TunnelSignatureOrder)(nil),       // 12: band.tunnel.v1beta1.TunnelSignatureOrder
+	(*TunnelBatchSignatureOrder)(nil),  // 13: band.tunnel.v1beta1.TunnelBatchSignatureOrder
+	(*PendingTSSBatchPacket)(nil),      // 14: band.tunnel.v1beta1.PendingTSSBatchPacket
+	(*EncodedTSSPacket)(nil),           // 15: band.tunnel.v1beta1.EncodedTSSPacket
+	(*anypb.Any)(nil),                  // 16: google.protobuf.Any
+	(*v1beta1.Coin)(nil),               // 17: cosmos.base.v1beta1.Coin
+	(*v1beta11.Price)(nil),             // 18: band.feeds.v1beta1.Price
+	(v1beta11.Encoder)(0),              // 19: band.feeds.v1beta1.Encoder
+	(*v1beta11.EncodedRelayPrice)(nil), // 20: band.feeds.v1beta1.EncodedRelayPrice
 }
 var file_band_tunnel_v1beta1_tunnel_proto_depIdxs = []int32{
-	7,  // 0: band.tunnel.v1beta1.Tunnel.route:type_name -> google.protobuf.Any
-	5,  // 1: band.tunnel.v1beta1.Tunnel.signal_deviations:type_name -> band.tunnel.v1beta1.SignalDeviation
-	8,  // 2: band.tunnel.v1beta1.Tunnel.total_deposit:type_name -> cosmos.base.v1beta1.Coin
-	9,  // 3: band.tunnel.v1beta1.LatestPrices.prices:type_name -> band.feeds.v1beta1.Price
-	8,  // 4: band.tunnel.v1beta1.TotalFees.total_base_packet_fee:type_name -> cosmos.base.v1beta1.Coin
-	9,  // 5: band.tunnel.v1beta1.Packet.prices:type_name -> band.feeds.v1beta1.Price
-	7,  // 6: band.tunnel.v1beta1.Packet.receipt:type_name -> google.protobuf.Any
-	8,  // 7: band.tunnel.v1beta1.Deposit.amount:type_name -> cosmos.base.v1beta1.Coin
-	9,  // 8: band.tunnel.v1beta1.TunnelSignatureOrder.prices:type_name -> band.feeds.v1beta1.Price
-	10, // 9: band.tunnel.v1beta1.TunnelSignatureOrder.encoder:type_name -> band.feeds.v1beta1.Encoder
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	16, // 0: band.tunnel.v1beta1.Tunnel.route:type_name -> google.protobuf.Any
+	10, // 1: band.tunnel.v1beta1.Tunnel.signal_deviations:type_name -> band.tunnel.v1beta1.SignalDeviation
+	17, // 2: band.tunnel.v1beta1.Tunnel.total_deposit:type_name -> cosmos.base.v1beta1.Coin
+	4,  // 3: band.tunnel.v1beta1.Tunnel.schedule:type_name -> band.tunnel.v1beta1.Schedule
+	17, // 4: band.tunnel.v1beta1.Tunnel.low_balance_threshold:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 5: band.tunnel.v1beta1.TunnelOperator.roles:type_name -> band.tunnel.v1beta1.OperatorRole
+	18, // 6: band.tunnel.v1beta1.LatestPrices.prices:type_name -> band.feeds.v1beta1.Price
+	17, // 7: band.tunnel.v1beta1.TotalFees.total_base_packet_fee:type_name -> cosmos.base.v1beta1.Coin
+	18, // 8: band.tunnel.v1beta1.Packet.prices:type_name -> band.feeds.v1beta1.Price
+	16, // 9: band.tunnel.v1beta1.Packet.receipt:type_name -> google.protobuf.Any
+	17, // 10: band.tunnel.v1beta1.Deposit.amount:type_name -> cosmos.base.v1beta1.Coin
+	17, // 11: band.tunnel.v1beta1.FeePayerTopUp.target_balance:type_name -> cosmos.base.v1beta1.Coin
+	17, // 12: band.tunnel.v1beta1.FeePayerTopUp.cap:type_name -> cosmos.base.v1beta1.Coin
+	17, // 13: band.tunnel.v1beta1.FeePayerTopUp.total_topped_up:type_name -> cosmos.base.v1beta1.Coin
+	11, // 14: band.tunnel.v1beta1.SignalDeviation.derivation:type_name -> band.tunnel.v1beta1.SignalDerivation
+	1,  // 15: band.tunnel.v1beta1.SignalDerivation.type:type_name -> band.tunnel.v1beta1.DerivationType
+	18, // 16: band.tunnel.v1beta1.TunnelSignatureOrder.prices:type_name -> band.feeds.v1beta1.Price
+	19, // 17: band.tunnel.v1beta1.TunnelSignatureOrder.encoder:type_name -> band.feeds.v1beta1.Encoder
+	17, // 18: band.tunnel.v1beta1.PendingTSSBatchPacket.escrowed_fee:type_name -> cosmos.base.v1beta1.Coin
+	20, // 19: band.tunnel.v1beta1.EncodedTSSPacket.relay_prices:type_name -> band.feeds.v1beta1.EncodedRelayPrice
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_band_tunnel_v1beta1_tunnel_proto_init() }
@@ -5339,7 +11008,7 @@ func file_band_tunnel_v1beta1_tunnel_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LatestPrices); i {
+			switch v := v.(*TunnelOperator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5351,7 +11020,7 @@ func file_band_tunnel_v1beta1_tunnel_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TotalFees); i {
+			switch v := v.(*Schedule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5363,7 +11032,7 @@ func file_band_tunnel_v1beta1_tunnel_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Packet); i {
+			switch v := v.(*LatestPrices); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5375,7 +11044,7 @@ func file_band_tunnel_v1beta1_tunnel_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Deposit); i {
+			switch v := v.(*TotalFees); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5387,7 +11056,7 @@ func file_band_tunnel_v1beta1_tunnel_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignalDeviation); i {
+			switch v := v.(*Packet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5399,6 +11068,54 @@ func file_band_tunnel_v1beta1_tunnel_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Deposit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeePayerTopUp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignalDeviation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignalDerivation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TunnelSignatureOrder); i {
 			case 0:
 				return &v.state
@@ -5410,19 +11127,56 @@ func file_band_tunnel_v1beta1_tunnel_proto_init() {
 				return nil
 			}
 		}
+		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelBatchSignatureOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingTSSBatchPacket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncodedTSSPacket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_tunnel_v1beta1_tunnel_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      2,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_band_tunnel_v1beta1_tunnel_proto_goTypes,
 		DependencyIndexes: file_band_tunnel_v1beta1_tunnel_proto_depIdxs,
+		EnumInfos:         file_band_tunnel_v1beta1_tunnel_proto_enumTypes,
 		MessageInfos:      file_band_tunnel_v1beta1_tunnel_proto_msgTypes,
 	}.Build()
 	File_band_tunnel_v1beta1_tunnel_proto = out.File
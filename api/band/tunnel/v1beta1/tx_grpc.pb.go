@@ -19,16 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_CreateTunnel_FullMethodName             = "/band.tunnel.v1beta1.Msg/CreateTunnel"
-	Msg_UpdateRoute_FullMethodName              = "/band.tunnel.v1beta1.Msg/UpdateRoute"
-	Msg_UpdateSignalsAndInterval_FullMethodName = "/band.tunnel.v1beta1.Msg/UpdateSignalsAndInterval"
-	Msg_WithdrawFeePayerFunds_FullMethodName    = "/band.tunnel.v1beta1.Msg/WithdrawFeePayerFunds"
-	Msg_ActivateTunnel_FullMethodName           = "/band.tunnel.v1beta1.Msg/ActivateTunnel"
-	Msg_DeactivateTunnel_FullMethodName         = "/band.tunnel.v1beta1.Msg/DeactivateTunnel"
-	Msg_TriggerTunnel_FullMethodName            = "/band.tunnel.v1beta1.Msg/TriggerTunnel"
-	Msg_DepositToTunnel_FullMethodName          = "/band.tunnel.v1beta1.Msg/DepositToTunnel"
-	Msg_WithdrawFromTunnel_FullMethodName       = "/band.tunnel.v1beta1.Msg/WithdrawFromTunnel"
-	Msg_UpdateParams_FullMethodName             = "/band.tunnel.v1beta1.Msg/UpdateParams"
+	Msg_CreateTunnel_FullMethodName              = "/band.tunnel.v1beta1.Msg/CreateTunnel"
+	Msg_UpdateRoute_FullMethodName               = "/band.tunnel.v1beta1.Msg/UpdateRoute"
+	Msg_UpdateSignalsAndInterval_FullMethodName  = "/band.tunnel.v1beta1.Msg/UpdateSignalsAndInterval"
+	Msg_WithdrawFeePayerFunds_FullMethodName     = "/band.tunnel.v1beta1.Msg/WithdrawFeePayerFunds"
+	Msg_ActivateTunnel_FullMethodName            = "/band.tunnel.v1beta1.Msg/ActivateTunnel"
+	Msg_DeactivateTunnel_FullMethodName          = "/band.tunnel.v1beta1.Msg/DeactivateTunnel"
+	Msg_TriggerTunnel_FullMethodName             = "/band.tunnel.v1beta1.Msg/TriggerTunnel"
+	Msg_DepositToTunnel_FullMethodName           = "/band.tunnel.v1beta1.Msg/DepositToTunnel"
+	Msg_WithdrawFromTunnel_FullMethodName        = "/band.tunnel.v1beta1.Msg/WithdrawFromTunnel"
+	Msg_SetFeePayerTopUp_FullMethodName          = "/band.tunnel.v1beta1.Msg/SetFeePayerTopUp"
+	Msg_RemoveFeePayerTopUp_FullMethodName       = "/band.tunnel.v1beta1.Msg/RemoveFeePayerTopUp"
+	Msg_UpdateLowBalanceThreshold_FullMethodName = "/band.tunnel.v1beta1.Msg/UpdateLowBalanceThreshold"
+	Msg_TransferTunnelOwnership_FullMethodName   = "/band.tunnel.v1beta1.Msg/TransferTunnelOwnership"
+	Msg_SetTunnelOperator_FullMethodName         = "/band.tunnel.v1beta1.Msg/SetTunnelOperator"
+	Msg_UpdateParams_FullMethodName              = "/band.tunnel.v1beta1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -53,6 +58,16 @@ type MsgClient interface {
 	DepositToTunnel(ctx context.Context, in *MsgDepositToTunnel, opts ...grpc.CallOption) (*MsgDepositToTunnelResponse, error)
 	// WithdrawFromTunnel is a RPC method to withdraw a deposit from an existing tunnel.
 	WithdrawFromTunnel(ctx context.Context, in *MsgWithdrawFromTunnel, opts ...grpc.CallOption) (*MsgWithdrawFromTunnelResponse, error)
+	// SetFeePayerTopUp is a RPC method to authorize a funding account to refill the fee payer of a tunnel.
+	SetFeePayerTopUp(ctx context.Context, in *MsgSetFeePayerTopUp, opts ...grpc.CallOption) (*MsgSetFeePayerTopUpResponse, error)
+	// RemoveFeePayerTopUp is a RPC method to remove the fee payer top-up authorization of a tunnel.
+	RemoveFeePayerTopUp(ctx context.Context, in *MsgRemoveFeePayerTopUp, opts ...grpc.CallOption) (*MsgRemoveFeePayerTopUpResponse, error)
+	// UpdateLowBalanceThreshold is a RPC method to update the low balance threshold of a tunnel.
+	UpdateLowBalanceThreshold(ctx context.Context, in *MsgUpdateLowBalanceThreshold, opts ...grpc.CallOption) (*MsgUpdateLowBalanceThresholdResponse, error)
+	// TransferTunnelOwnership is a RPC method to transfer the ownership of a tunnel.
+	TransferTunnelOwnership(ctx context.Context, in *MsgTransferTunnelOwnership, opts ...grpc.CallOption) (*MsgTransferTunnelOwnershipResponse, error)
+	// SetTunnelOperator is a RPC method to set the roles of an operator of a tunnel.
+	SetTunnelOperator(ctx context.Context, in *MsgSetTunnelOperator, opts ...grpc.CallOption) (*MsgSetTunnelOperatorResponse, error)
 	// UpdateParams is a RPC method to update parameters
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -146,6 +161,51 @@ func (c *msgClient) WithdrawFromTunnel(ctx context.Context, in *MsgWithdrawFromT
 	return out, nil
 }
 
+func (c *msgClient) SetFeePayerTopUp(ctx context.Context, in *MsgSetFeePayerTopUp, opts ...grpc.CallOption) (*MsgSetFeePayerTopUpResponse, error) {
+	out := new(MsgSetFeePayerTopUpResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFeePayerTopUp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFeePayerTopUp(ctx context.Context, in *MsgRemoveFeePayerTopUp, opts ...grpc.CallOption) (*MsgRemoveFeePayerTopUpResponse, error) {
+	out := new(MsgRemoveFeePayerTopUpResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveFeePayerTopUp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateLowBalanceThreshold(ctx context.Context, in *MsgUpdateLowBalanceThreshold, opts ...grpc.CallOption) (*MsgUpdateLowBalanceThresholdResponse, error) {
+	out := new(MsgUpdateLowBalanceThresholdResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateLowBalanceThreshold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferTunnelOwnership(ctx context.Context, in *MsgTransferTunnelOwnership, opts ...grpc.CallOption) (*MsgTransferTunnelOwnershipResponse, error) {
+	out := new(MsgTransferTunnelOwnershipResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferTunnelOwnership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTunnelOperator(ctx context.Context, in *MsgSetTunnelOperator, opts ...grpc.CallOption) (*MsgSetTunnelOperatorResponse, error) {
+	out := new(MsgSetTunnelOperatorResponse)
+	err := c.cc.Invoke(ctx, Msg_SetTunnelOperator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -177,6 +237,16 @@ type MsgServer interface {
 	DepositToTunnel(context.Context, *MsgDepositToTunnel) (*MsgDepositToTunnelResponse, error)
 	// WithdrawFromTunnel is a RPC method to withdraw a deposit from an existing tunnel.
 	WithdrawFromTunnel(context.Context, *MsgWithdrawFromTunnel) (*MsgWithdrawFromTunnelResponse, error)
+	// SetFeePayerTopUp is a RPC method to authorize a funding account to refill the fee payer of a tunnel.
+	SetFeePayerTopUp(context.Context, *MsgSetFeePayerTopUp) (*MsgSetFeePayerTopUpResponse, error)
+	// RemoveFeePayerTopUp is a RPC method to remove the fee payer top-up authorization of a tunnel.
+	RemoveFeePayerTopUp(context.Context, *MsgRemoveFeePayerTopUp) (*MsgRemoveFeePayerTopUpResponse, error)
+	// UpdateLowBalanceThreshold is a RPC method to update the low balance threshold of a tunnel.
+	UpdateLowBalanceThreshold(context.Context, *MsgUpdateLowBalanceThreshold) (*MsgUpdateLowBalanceThresholdResponse, error)
+	// TransferTunnelOwnership is a RPC method to transfer the ownership of a tunnel.
+	TransferTunnelOwnership(context.Context, *MsgTransferTunnelOwnership) (*MsgTransferTunnelOwnershipResponse, error)
+	// SetTunnelOperator is a RPC method to set the roles of an operator of a tunnel.
+	SetTunnelOperator(context.Context, *MsgSetTunnelOperator) (*MsgSetTunnelOperatorResponse, error)
 	// UpdateParams is a RPC method to update parameters
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
@@ -213,6 +283,21 @@ func (UnimplementedMsgServer) DepositToTunnel(context.Context, *MsgDepositToTunn
 func (UnimplementedMsgServer) WithdrawFromTunnel(context.Context, *MsgWithdrawFromTunnel) (*MsgWithdrawFromTunnelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromTunnel not implemented")
 }
+func (UnimplementedMsgServer) SetFeePayerTopUp(context.Context, *MsgSetFeePayerTopUp) (*MsgSetFeePayerTopUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeePayerTopUp not implemented")
+}
+func (UnimplementedMsgServer) RemoveFeePayerTopUp(context.Context, *MsgRemoveFeePayerTopUp) (*MsgRemoveFeePayerTopUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFeePayerTopUp not implemented")
+}
+func (UnimplementedMsgServer) UpdateLowBalanceThreshold(context.Context, *MsgUpdateLowBalanceThreshold) (*MsgUpdateLowBalanceThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLowBalanceThreshold not implemented")
+}
+func (UnimplementedMsgServer) TransferTunnelOwnership(context.Context, *MsgTransferTunnelOwnership) (*MsgTransferTunnelOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTunnelOwnership not implemented")
+}
+func (UnimplementedMsgServer) SetTunnelOperator(context.Context, *MsgSetTunnelOperator) (*MsgSetTunnelOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTunnelOperator not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -391,6 +476,96 @@ func _Msg_WithdrawFromTunnel_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetFeePayerTopUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeePayerTopUp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeePayerTopUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFeePayerTopUp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeePayerTopUp(ctx, req.(*MsgSetFeePayerTopUp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFeePayerTopUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFeePayerTopUp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFeePayerTopUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveFeePayerTopUp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFeePayerTopUp(ctx, req.(*MsgRemoveFeePayerTopUp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateLowBalanceThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateLowBalanceThreshold)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateLowBalanceThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateLowBalanceThreshold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateLowBalanceThreshold(ctx, req.(*MsgUpdateLowBalanceThreshold))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferTunnelOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferTunnelOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferTunnelOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferTunnelOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferTunnelOwnership(ctx, req.(*MsgTransferTunnelOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTunnelOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTunnelOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTunnelOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetTunnelOperator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTunnelOperator(ctx, req.(*MsgSetTunnelOperator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -452,6 +627,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawFromTunnel",
 			Handler:    _Msg_WithdrawFromTunnel_Handler,
 		},
+		{
+			MethodName: "SetFeePayerTopUp",
+			Handler:    _Msg_SetFeePayerTopUp_Handler,
+		},
+		{
+			MethodName: "RemoveFeePayerTopUp",
+			Handler:    _Msg_RemoveFeePayerTopUp_Handler,
+		},
+		{
+			MethodName: "UpdateLowBalanceThreshold",
+			Handler:    _Msg_UpdateLowBalanceThreshold_Handler,
+		},
+		{
+			MethodName: "TransferTunnelOwnership",
+			Handler:    _Msg_TransferTunnelOwnership_Handler,
+		},
+		{
+			MethodName: "SetTunnelOperator",
+			Handler:    _Msg_SetTunnelOperator_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
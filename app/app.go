@@ -57,6 +57,8 @@ import (
 	v3 "github.com/bandprotocol/chain/v3/app/upgrades/v3"
 	nodeservice "github.com/bandprotocol/chain/v3/client/grpc/node"
 	proofservice "github.com/bandprotocol/chain/v3/client/grpc/oracle/proof"
+	"github.com/bandprotocol/chain/v3/x/feeds"
+	feedsvoteext "github.com/bandprotocol/chain/v3/x/feeds/voteext"
 	oraclekeeper "github.com/bandprotocol/chain/v3/x/oracle/keeper"
 )
 
@@ -92,6 +94,9 @@ type BandApp struct {
 	// simulation manager
 	sm           *module.SimulationManager
 	configurator module.Configurator
+
+	// feeds proposal handler applying signal prices from vote extensions
+	feedsProposalHandler *feedsvoteext.ProposalHandler
 }
 
 func init() {
@@ -293,6 +298,28 @@ func NewBandApp(
 	app.SetAnteHandler(anteHandler)
 	app.SetPostHandler(postHandler)
 
+	// set vote extension and proposal handlers to deliver signal prices of the feeds module. Vote extensions
+	// are only used once the VoteExtensionsEnableHeight consensus param is set, e.g. by a governance
+	// proposal with MsgUpdateParams of the consensus module.
+	var priceProvider feedsvoteext.PriceProvider
+	if url := cast.ToString(appOpts.Get(feeds.FlagPriceServerURL)); url != "" {
+		priceProvider = feedsvoteext.NewHTTPPriceProvider(url, feedsvoteext.DefaultPriceProviderTimeout)
+	}
+	voteExtHandler := feedsvoteext.NewVoteExtHandler(logger, priceProvider, app.FeedsKeeper)
+	app.SetExtendVoteHandler(voteExtHandler.ExtendVoteHandler())
+	app.SetVerifyVoteExtensionHandler(voteExtHandler.VerifyVoteExtensionHandler())
+
+	defaultProposalHandler := baseapp.NewDefaultProposalHandler(app.Mempool(), app)
+	app.feedsProposalHandler = feedsvoteext.NewProposalHandler(
+		logger,
+		app.FeedsKeeper,
+		app.StakingKeeper,
+		defaultProposalHandler.PrepareProposalHandler(),
+		defaultProposalHandler.ProcessProposalHandler(),
+	)
+	app.SetPrepareProposal(app.feedsProposalHandler.PrepareProposalHandler())
+	app.SetProcessProposal(app.feedsProposalHandler.ProcessProposalHandler())
+
 	app.SetInitChainer(app.InitChainer)
 	app.SetPreBlocker(app.PreBlocker)
 	app.SetBeginBlocker(app.BeginBlocker)
@@ -337,8 +364,32 @@ func NewBandApp(
 func (app *BandApp) Name() string { return app.BaseApp.Name() }
 
 // PreBlocker application updates every pre block
-func (app *BandApp) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
-	return app.mm.PreBlock(ctx)
+func (app *BandApp) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	resp, err := app.mm.PreBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// apply signal prices from vote extensions after the upgrade module has run its upgrade
+	app.feedsProposalHandler.PreBlocker(ctx, req)
+
+	return resp, nil
+}
+
+// FinalizeBlock executes the block. The extended commit info injected as the first transaction of a
+// proposal is applied by the PreBlocker and is never delivered as a transaction, so it is reported as an
+// empty successful transaction instead of a transaction that failed to decode.
+func (app *BandApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	resp, err := app.BaseApp.FinalizeBlock(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Txs) > 0 && len(resp.TxResults) > 0 && feedsvoteext.IsExtendedCommitInfoTx(req.Txs[0]) {
+		resp.TxResults[0] = &abci.ExecTxResult{}
+	}
+
+	return resp, nil
 }
 
 // BeginBlocker application updates every begin block
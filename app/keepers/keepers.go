@@ -4,6 +4,8 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/spf13/cast"
+
 	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	ica "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts"
@@ -90,6 +92,11 @@ import (
 	tunneltypes "github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
+// OracleHooksAppOption is the app option of additional OracleHooks keyed by their callback route,
+// which are registered to the oracle hooks router together with the modules of the app, e.g. by
+// tests that need a module consuming oracle results.
+const OracleHooksAppOption = "oracle-hooks"
+
 type AppKeepers struct {
 	// keys to access the substores
 	keys    map[string]*storetypes.KVStoreKey
@@ -136,6 +143,7 @@ type AppKeepers struct {
 	ScopedICAHostKeeper  capabilitykeeper.ScopedKeeper
 	ScopedOracleKeeper   capabilitykeeper.ScopedKeeper
 	ScopedTunnelKeeper   capabilitykeeper.ScopedKeeper
+	ScopedFeedsKeeper    capabilitykeeper.ScopedKeeper
 }
 
 func NewAppKeeper(
@@ -197,6 +205,7 @@ func NewAppKeeper(
 	appKeepers.ScopedTransferKeeper = appKeepers.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	appKeepers.ScopedOracleKeeper = appKeepers.CapabilityKeeper.ScopeToModule(oracletypes.ModuleName)
 	appKeepers.ScopedTunnelKeeper = appKeepers.CapabilityKeeper.ScopeToModule(tunneltypes.ModuleName)
+	appKeepers.ScopedFeedsKeeper = appKeepers.CapabilityKeeper.ScopeToModule(feedstypes.ModuleName)
 
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
 	// their scoped modules in `NewApp` with `ScopeToModule`
@@ -453,6 +462,9 @@ func NewAppKeeper(
 		authtypes.FeeCollectorName,
 	)
 
+	// register the modules consuming oracle results via request callbacks
+	oracleHooksRouter := oracletypes.NewOracleHooksRouter()
+
 	appKeepers.OracleKeeper = oraclekeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[oracletypes.StoreKey],
@@ -468,9 +480,11 @@ func NewAppKeeper(
 		appKeepers.RollingseedKeeper,
 		appKeepers.BandtssKeeper,
 		appKeepers.ScopedOracleKeeper,
+		oracleHooksRouter,
 		owasmVM,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	appKeepers.OracleKeeper.SetSimulateRequestCode(cast.ToBool(appOpts.Get(oracle.FlagSimulateRequestCode)))
 
 	appKeepers.FeedsKeeper = feedskeeper.NewKeeper(
 		appCodec,
@@ -479,6 +493,10 @@ func NewAppKeeper(
 		appKeepers.StakingKeeper,
 		appKeepers.RestakeKeeper,
 		appKeepers.AuthzKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.IBCKeeper.PortKeeper,
+		appKeepers.ScopedFeedsKeeper,
+		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -493,6 +511,7 @@ func NewAppKeeper(
 		appKeepers.IBCFeeKeeper,
 		appKeepers.IBCKeeper.PortKeeper,
 		appKeepers.ScopedTunnelKeeper,
+		appKeepers.TransferKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -513,6 +532,16 @@ func NewAppKeeper(
 	tssContentRouter.Seal()
 	tssCbRouter.Seal()
 
+	// Modules consuming oracle results register their OracleHooks here, e.g.
+	// oracleHooksRouter.AddRoute(moduletypes.RouterKey, modulekeeper.NewOracleHooks(...)),
+	// before the router is sealed.
+	if oracleHooks, ok := appOpts.Get(OracleHooksAppOption).(map[string]oracletypes.OracleHooks); ok {
+		for module, hooks := range oracleHooks {
+			oracleHooksRouter.AddRoute(module, hooks)
+		}
+	}
+	oracleHooksRouter.Seal()
+
 	// Middleware Stacks
 	appKeepers.ICAModule = ica.NewAppModule(nil, &appKeepers.ICAHostKeeper)
 	appKeepers.TransferModule = transfer.NewAppModule(appKeepers.TransferKeeper)
@@ -533,10 +562,14 @@ func NewAppKeeper(
 	// Create Tunnel Stack
 	var tunnelStack porttypes.IBCModule = tunnel.NewIBCModule(appKeepers.TunnelKeeper)
 
+	// Create Feeds Stack
+	var feedsStack porttypes.IBCModule = feeds.NewIBCModule(appKeepers.FeedsKeeper)
+
 	ibcRouter := porttypes.NewRouter().AddRoute(icahosttypes.SubModuleName, icaHostStack).
 		AddRoute(ibctransfertypes.ModuleName, transferStack).
 		AddRoute(oracletypes.ModuleName, oracleStack).
-		AddRoute(tunneltypes.ModuleName, tunnelStack)
+		AddRoute(tunneltypes.ModuleName, tunnelStack).
+		AddRoute(feedstypes.ModuleName, feedsStack)
 
 	appKeepers.IBCKeeper.SetRouter(ibcRouter)
 
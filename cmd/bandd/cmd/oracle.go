@@ -0,0 +1,236 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/yoda/executor"
+)
+
+const (
+	flagCalldata            = "calldata"
+	flagOracleScriptVersion = "oracle-script-version"
+	flagPrepareGas          = "prepare-gas"
+	flagExecuteGas          = "execute-gas"
+	flagReport              = "report"
+	flagExecutor            = "executor"
+)
+
+// OracleCmd returns the oracle developer tools cobra Command.
+func OracleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "oracle",
+		Short:                      "Oracle script development subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(SimulateRequestCmd())
+
+	return cmd
+}
+
+// SimulateRequestCmd returns simulate cobra Command.
+func SimulateRequestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "simulate [oracle-script-id|path-to-wasm] [ask-count] [min-count] (-c [calldata]) " +
+			"(--oracle-script-version [version]) (--prepare-gas [gas]) (--execute-gas [gas]) " +
+			"(--report [external-id]:[exit-code]:[data]...) (--executor [executor])",
+		Short: "Simulate an oracle request without broadcasting a transaction",
+		Long: `Simulate an oracle request against the current chain state without broadcasting a transaction.
+The oracle script is either the ID of an on-chain oracle script or the path to a local Wasm file.
+A local Wasm file can only be simulated on a node started with --oracle-simulate-request-code.
+
+Without --report or --executor, only the prepare phase is run and the raw requests are printed.
+With --report, the execute phase is run as if every validator reported the given raw reports.
+With --executor, the data sources are run with the given Yoda executor and their outputs are used as raw reports.`,
+		Example: `$ bandd oracle simulate 1 4 3 -c 0000000342544300000000000003e8 --node <node>
+$ bandd oracle simulate ./script.wasm 4 3 -c 0000000342544300000000000003e8 --report 1:0:42 --report 2:0:43
+$ bandd oracle simulate 1 4 3 -c 0000000342544300000000000003e8 --executor "rest:<url>?timeout=10s"`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySimulateRequestRequest{}
+			if id, err := strconv.ParseUint(args[0], 10, 64); err == nil {
+				req.OracleScriptId = id
+			} else {
+				req.Code, err = os.ReadFile(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			req.AskCount, err = strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req.MinCount, err = strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req.Calldata, err = cmd.Flags().GetBytesHex(flagCalldata)
+			if err != nil {
+				return err
+			}
+
+			req.OracleScriptVersion, err = cmd.Flags().GetUint64(flagOracleScriptVersion)
+			if err != nil {
+				return err
+			}
+
+			req.PrepareGas, err = cmd.Flags().GetUint64(flagPrepareGas)
+			if err != nil {
+				return err
+			}
+
+			req.ExecuteGas, err = cmd.Flags().GetUint64(flagExecuteGas)
+			if err != nil {
+				return err
+			}
+
+			reportStrs, err := cmd.Flags().GetStringArray(flagReport)
+			if err != nil {
+				return err
+			}
+
+			executorStr, err := cmd.Flags().GetString(flagExecutor)
+			if err != nil {
+				return err
+			}
+
+			if len(reportStrs) > 0 && executorStr != "" {
+				return fmt.Errorf("--%s and --%s cannot be used together", flagReport, flagExecutor)
+			}
+
+			req.RawReports, err = parseRawReports(reportStrs)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SimulateRequest(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			if executorStr != "" {
+				exec, err := executor.NewExecutor(executorStr)
+				if err != nil {
+					return err
+				}
+
+				req.RawReports, err = executeRawRequests(
+					cmd.Context(),
+					queryClient,
+					exec,
+					clientCtx.ChainID,
+					res.RawRequests,
+				)
+				if err != nil {
+					return err
+				}
+
+				res, err = queryClient.SimulateRequest(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().BytesHexP(flagCalldata, "c", nil, "Calldata used in calling the oracle script")
+	cmd.Flags().Uint64(flagOracleScriptVersion, 0, "Version of the on-chain oracle script (0 for the latest)")
+	cmd.Flags().Uint64(flagPrepareGas, 50000, "Prepare gas used in fee counting for prepare request")
+	cmd.Flags().Uint64(flagExecuteGas, 300000, "Execute gas used in fee counting for execute request")
+	cmd.Flags().StringArray(flagReport, nil, "Mocked raw report in the form [external-id]:[exit-code]:[data]")
+	cmd.Flags().String(flagExecutor, "", "Yoda executor used to run the data sources, e.g. rest:<url>?timeout=10s")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseRawReports parses raw reports given in the form [external-id]:[exit-code]:[data].
+func parseRawReports(reportStrs []string) ([]types.RawReport, error) {
+	rawReports := make([]types.RawReport, 0, len(reportStrs))
+	for _, reportStr := range reportStrs {
+		parts := strings.SplitN(reportStr, ":", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid report %q, expected [external-id]:[exit-code]:[data]", reportStr)
+		}
+
+		eid, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid external id in report %q: %w", reportStr, err)
+		}
+
+		exitCode, err := strconv.ParseUint(parts[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exit code in report %q: %w", reportStr, err)
+		}
+
+		rawReports = append(rawReports, types.NewRawReport(types.ExternalID(eid), uint32(exitCode), []byte(parts[2])))
+	}
+
+	return rawReports, nil
+}
+
+// executeRawRequests runs the data source of each raw request with the given executor, the same
+// way Yoda does, and returns the outputs as raw reports.
+func executeRawRequests(
+	ctx context.Context,
+	queryClient types.QueryClient,
+	exec executor.Executor,
+	chainID string,
+	rawRequests []types.RawRequest,
+) ([]types.RawReport, error) {
+	rawReports := make([]types.RawReport, 0, len(rawRequests))
+	for _, rawReq := range rawRequests {
+		dsRes, err := queryClient.DataSource(
+			ctx,
+			&types.QueryDataSourceRequest{DataSourceId: uint64(rawReq.DataSourceID)},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		dataRes, err := queryClient.Data(ctx, &types.QueryDataRequest{DataHash: dsRes.DataSource.Filename})
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := exec.Exec(dataRes.Data, string(rawReq.Calldata), map[string]interface{}{
+			"BAND_CHAIN_ID":       chainID,
+			"BAND_DATA_SOURCE_ID": strconv.Itoa(int(rawReq.DataSourceID)),
+			"BAND_VALIDATOR":      "",
+			"BAND_REQUEST_ID":     "0",
+			"BAND_EXTERNAL_ID":    strconv.Itoa(int(rawReq.ExternalID)),
+			"BAND_REPORTER":       "",
+			"BAND_SIGNATURE":      "",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute data source %d: %w", rawReq.DataSourceID, err)
+		}
+
+		rawReports = append(rawReports, types.NewRawReport(rawReq.ExternalID, result.Code, result.Output))
+	}
+
+	return rawReports, nil
+}
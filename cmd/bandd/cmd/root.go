@@ -50,6 +50,7 @@ import (
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 
 	band "github.com/bandprotocol/chain/v3/app"
+	"github.com/bandprotocol/chain/v3/x/feeds"
 	"github.com/bandprotocol/chain/v3/x/oracle"
 )
 
@@ -215,6 +216,7 @@ func initRootCmd(
 		),
 		queryCommand(),
 		txCommand(basicManager),
+		OracleCmd(),
 		keys.Commands(),
 	)
 
@@ -225,6 +227,7 @@ func initRootCmd(
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
 	oracle.AddModuleInitFlags(startCmd)
+	feeds.AddModuleInitFlags(startCmd)
 }
 
 // genesisCommand builds genesis-related `bandd genesis` command. Users may provide application specific commands as a parameter
@@ -21,6 +21,7 @@ import (
 	bothanclient "github.com/bandprotocol/bothan/bothan-api/client/go-client"
 
 	"github.com/bandprotocol/chain/v3/grogu/context"
+	"github.com/bandprotocol/chain/v3/grogu/priceserver"
 	"github.com/bandprotocol/chain/v3/grogu/querier"
 	"github.com/bandprotocol/chain/v3/grogu/signaller"
 	"github.com/bandprotocol/chain/v3/grogu/submitter"
@@ -40,6 +41,7 @@ const (
 	flagDistrOffsetPct       = "distribution-offset-pct"
 	flagLogLevel             = "log-level"
 	flagUpdaterQueryInterval = "updater-query-interval"
+	flagPriceServer          = "price-server"
 )
 
 func RunCmd(ctx *context.Context) *cobra.Command {
@@ -64,6 +66,7 @@ func RunCmd(ctx *context.Context) *cobra.Command {
 	cmd.Flags().String(flagBothanTimeout, "3s", "The timeout duration for Bothan requests.")
 	cmd.Flags().String(flagLogLevel, "info", "The application's log level.")
 	cmd.Flags().String(flagUpdaterQueryInterval, "1m", "The interval for updater querying chain.")
+	cmd.Flags().String(flagPriceServer, "", "The address to serve signal prices to the local node, e.g. localhost:8080.")
 
 	_ = viper.BindPFlag(flagValidator, cmd.Flags().Lookup(flagValidator))
 	_ = viper.BindPFlag(flagNodes, cmd.Flags().Lookup(flagNodes))
@@ -78,6 +81,7 @@ func RunCmd(ctx *context.Context) *cobra.Command {
 	_ = viper.BindPFlag(flagBothanTimeout, cmd.Flags().Lookup(flagBothanTimeout))
 	_ = viper.BindPFlag(flagLogLevel, cmd.Flags().Lookup(flagLogLevel))
 	_ = viper.BindPFlag(flagUpdaterQueryInterval, cmd.Flags().Lookup(flagUpdaterQueryInterval))
+	_ = viper.BindPFlag(flagPriceServer, cmd.Flags().Lookup(flagPriceServer))
 
 	return cmd
 }
@@ -181,6 +185,7 @@ func createRunE(ctx *context.Context) func(cmd *cobra.Command, args []string) er
 			l,
 			submitSignalPriceCh,
 			authQuerier,
+			feedQuerier,
 			txQuerier,
 			valAddr,
 			&pendingSignalIDs,
@@ -217,6 +222,12 @@ func createRunE(ctx *context.Context) func(cmd *cobra.Command, args []string) er
 		go signallerService.Start()
 		go submitterService.Start()
 
+		// Start price server for vote extensions if enabled
+		if ctx.Config.PriceServer != "" {
+			priceServerService := priceserver.New(feedQuerier, bothanService, l, ctx.Config.PriceServer, time.Second)
+			go priceServerService.Start()
+		}
+
 		l.Info("Grogu has started")
 
 		<-sigChan
@@ -47,6 +47,9 @@ type Config struct {
 
 	// UpdaterQueryInterval is the interval for updater querying chain.
 	UpdaterQueryInterval string `mapstructure:"updater-query-interval"`
+
+	// PriceServer is the address to serve signal prices to the local node for vote extensions.
+	PriceServer string `mapstructure:"price-server"`
 }
 
 // Context holds the runtime context for the application.
@@ -0,0 +1,15 @@
+package priceserver
+
+import (
+	bothanclient "github.com/bandprotocol/bothan/bothan-api/client/go-client"
+
+	feeds "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+type BothanClient interface {
+	bothanclient.Client
+}
+
+type FeedQuerier interface {
+	QueryCurrentFeeds() (*feeds.QueryCurrentFeedsResponse, error)
+}
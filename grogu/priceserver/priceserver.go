@@ -0,0 +1,124 @@
+package priceserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/bandprotocol/chain/v3/grogu/signaller"
+	"github.com/bandprotocol/chain/v3/pkg/logger"
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// PriceServer serves the latest signal prices of the current feeds to the local BandChain node, which
+// attaches them to its vote extensions.
+//
+// The prices are refreshed in the background and served from memory, so a request from the node never
+// waits on the chain or Bothan.
+type PriceServer struct {
+	feedQuerier  FeedQuerier
+	bothanClient BothanClient
+	logger       *logger.Logger
+	address      string
+	// How often to refresh the prices
+	interval time.Duration
+
+	mu           sync.RWMutex
+	signalPrices []types.SignalPrice
+}
+
+func New(
+	feedQuerier FeedQuerier,
+	bothanClient BothanClient,
+	logger *logger.Logger,
+	address string,
+	interval time.Duration,
+) *PriceServer {
+	return &PriceServer{
+		feedQuerier:  feedQuerier,
+		bothanClient: bothanClient,
+		logger:       logger,
+		address:      address,
+		interval:     interval,
+		signalPrices: []types.SignalPrice{},
+	}
+}
+
+func (s *PriceServer) Start() {
+	go func() {
+		s.logger.Info("[PriceServer] serving signal prices on %s", s.address)
+		if err := http.ListenAndServe(s.address, s); err != nil {
+			s.logger.Error("[PriceServer] failed to serve signal prices: %v", err)
+		}
+	}()
+
+	for {
+		s.updateSignalPrices()
+		time.Sleep(s.interval)
+	}
+}
+
+// ServeHTTP responds with the latest signal prices encoded as a SignalPricesVoteExtension in JSON.
+func (s *PriceServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(types.SignalPricesVoteExtension{SignalPrices: s.getSignalPrices()}); err != nil {
+		s.logger.Error("[PriceServer] failed to encode signal prices: %v", err)
+	}
+}
+
+func (s *PriceServer) getSignalPrices() []types.SignalPrice {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.signalPrices
+}
+
+func (s *PriceServer) updateSignalPrices() {
+	resp, err := s.feedQuerier.QueryCurrentFeeds()
+	if err != nil {
+		s.logger.Error("[PriceServer] failed to query current feeds: %v", err)
+		s.setSignalPrices([]types.SignalPrice{})
+		return
+	}
+
+	signalIDs := make([]string, 0, len(resp.CurrentFeeds.Feeds))
+	for _, feed := range resp.CurrentFeeds.Feeds {
+		signalIDs = append(signalIDs, feed.SignalID)
+	}
+
+	signalPrices := make([]types.SignalPrice, 0, len(signalIDs))
+	if len(signalIDs) != 0 {
+		res, err := s.bothanClient.GetPrices(signalIDs)
+		if err != nil {
+			s.logger.Error("[PriceServer] failed to query prices from bothan: %v", err)
+			s.setSignalPrices([]types.SignalPrice{})
+			return
+		}
+
+		for _, price := range res.Prices {
+			signalPrice, err := signaller.ConvertPriceData(price)
+			if err != nil {
+				s.logger.Debug("[PriceServer] failed to parse price data: %v", err)
+				continue
+			}
+			signalPrices = append(signalPrices, signalPrice)
+		}
+	}
+
+	s.setSignalPrices(signalPrices)
+}
+
+// setSignalPrices replaces the served signal prices; they are cleared on failure so that stale prices
+// are never attached to a vote extension.
+func (s *PriceServer) setSignalPrices(signalPrices []types.SignalPrice) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.signalPrices = signalPrices
+}
@@ -0,0 +1,78 @@
+package priceserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/log"
+
+	bothan "github.com/bandprotocol/bothan/bothan-api/client/go-client/proto/bothan/v1"
+
+	"github.com/bandprotocol/chain/v3/grogu/signaller/testutil"
+	"github.com/bandprotocol/chain/v3/pkg/logger"
+	feeds "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func TestPriceServer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockFeedQuerier := testutil.NewMockFeedQuerier(ctrl)
+	mockFeedQuerier.EXPECT().
+		QueryCurrentFeeds().
+		Return(&feeds.QueryCurrentFeedsResponse{CurrentFeeds: feeds.CurrentFeedWithDeviations{
+			Feeds: []feeds.FeedWithDeviation{
+				{SignalID: "signal1", Interval: 60},
+				{SignalID: "signal2", Interval: 60},
+			},
+		}}, nil).
+		AnyTimes()
+
+	mockBothanClient := testutil.NewMockBothanClient(ctrl)
+	gomock.InOrder(
+		mockBothanClient.EXPECT().GetPrices([]string{"signal1", "signal2"}).
+			Return(&bothan.GetPricesResponse{
+				Prices: []*bothan.Price{
+					{SignalId: "signal1", Price: 10000, Status: bothan.Status_STATUS_AVAILABLE},
+					{SignalId: "signal2", Status: bothan.Status_STATUS_UNAVAILABLE},
+				},
+			}, nil),
+		mockBothanClient.EXPECT().GetPrices(gomock.Any()).
+			Return(nil, errors.New("bothan is down")),
+	)
+
+	allowLevel, _ := log.ParseLogLevel("info")
+	s := New(mockFeedQuerier, mockBothanClient, logger.NewLogger(allowLevel), "", 0)
+
+	getSignalPrices := func() []feeds.SignalPrice {
+		rec := httptest.NewRecorder()
+		s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var ve feeds.SignalPricesVoteExtension
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&ve))
+		return ve.SignalPrices
+	}
+
+	// no prices before the first update
+	require.Empty(t, getSignalPrices())
+
+	s.updateSignalPrices()
+	require.Equal(t, []feeds.SignalPrice{
+		feeds.NewSignalPrice(feeds.SIGNAL_PRICE_STATUS_AVAILABLE, "signal1", 10000),
+		feeds.NewSignalPrice(feeds.SIGNAL_PRICE_STATUS_UNAVAILABLE, "signal2", 0),
+	}, getSignalPrices())
+
+	// prices are cleared when bothan fails
+	s.updateSignalPrices()
+	require.Empty(t, getSignalPrices())
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", nil))
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
@@ -257,7 +257,7 @@ func (s *Signaller) filterAndPrepareSignalPrices(
 			continue
 		}
 
-		signalPrice, err := convertPriceData(price)
+		signalPrice, err := ConvertPriceData(price)
 		if err != nil {
 			s.logger.Debug("[Signaller] failed to parse price data: %v", err)
 			continue
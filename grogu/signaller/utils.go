@@ -44,7 +44,8 @@ func isDeviated(deviationBasisPoint int64, oldPrice uint64, newPrice uint64) boo
 	return deviationBasisPoint <= dev
 }
 
-func convertPriceData(price *bothan.Price) (types.SignalPrice, error) {
+// ConvertPriceData converts a price from Bothan to a signal price.
+func ConvertPriceData(price *bothan.Price) (types.SignalPrice, error) {
 	switch price.Status {
 	case bothan.Status_STATUS_UNSUPPORTED:
 		return types.NewSignalPrice(
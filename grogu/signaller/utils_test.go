@@ -70,7 +70,7 @@ func TestConvertPriceData(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := convertPriceData(tt.priceData)
+			result, err := ConvertPriceData(tt.priceData)
 			if tt.expectingError {
 				assert.Error(t, err)
 			} else {
@@ -7,6 +7,8 @@ import (
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	bothanclient "github.com/bandprotocol/bothan/bothan-api/client/go-client"
+
+	feeds "github.com/bandprotocol/chain/v3/x/feeds/types"
 )
 
 type RemoteClient interface {
@@ -21,6 +23,10 @@ type AuthQuerier interface {
 	QueryAccount(address sdk.Address) (*auth.QueryAccountResponse, error)
 }
 
+type FeedQuerier interface {
+	QueryParams() (*feeds.QueryParamsResponse, error)
+}
+
 type TxQuerier interface {
 	QueryTx(hash string) (*sdk.TxResponse, error)
 }
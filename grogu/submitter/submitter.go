@@ -2,6 +2,7 @@ package submitter
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
 	"sync"
 	"time"
@@ -32,6 +33,7 @@ type Submitter struct {
 	logger              *logger.Logger
 	submitSignalPriceCh <-chan SignalPriceSubmission
 	authQuerier         AuthQuerier
+	feedQuerier         FeedQuerier
 	txQuerier           TxQuerier
 	valAddress          sdk.ValAddress
 	pendingSignalIDs    *sync.Map
@@ -51,6 +53,7 @@ func New(
 	logger *logger.Logger,
 	submitSignalPriceCh <-chan SignalPriceSubmission,
 	authQuerier AuthQuerier,
+	feedQuerier FeedQuerier,
 	txQuerier TxQuerier,
 	valAddress sdk.ValAddress,
 	pendingSignalIDs *sync.Map,
@@ -84,6 +87,7 @@ func New(
 		logger:              logger,
 		submitSignalPriceCh: submitSignalPriceCh,
 		authQuerier:         authQuerier,
+		feedQuerier:         feedQuerier,
 		txQuerier:           txQuerier,
 		valAddress:          valAddress,
 		pendingSignalIDs:    pendingSignalIDs,
@@ -113,12 +117,6 @@ func (s *Submitter) submitPrice(pricesSubmission SignalPriceSubmission, keyID st
 		s.idleKeyIDChannel <- keyID
 	}()
 
-	msg := types.MsgSubmitSignalPrices{
-		Validator:    s.valAddress.String(),
-		Timestamp:    time.Now().Unix(),
-		SignalPrices: signalPrices,
-	}
-	msgs := []sdk.Msg{&msg}
 	memo := fmt.Sprintf("grogu: %s, uuid: %s", version.Version, uuid)
 
 	key, err := s.clientCtx.Keyring.Key(keyID)
@@ -127,6 +125,67 @@ func (s *Submitter) submitPrice(pricesSubmission SignalPriceSubmission, keyID st
 		return
 	}
 
+	paramsResp, err := s.feedQuerier.QueryParams()
+	if err != nil {
+		s.logger.Error("[Submitter] failed to query params: %v", err)
+		return
+	}
+
+	var txHash string
+	var ok bool
+	if paramsResp.Params.IsCommitRevealEnabled() {
+		txHash, ok = s.commitAndRevealPrice(key, signalPrices, paramsResp.Params, memo)
+	} else {
+		msg := types.NewMsgSubmitSignalPrices(s.valAddress.String(), time.Now().Unix(), signalPrices)
+		txHash, ok = s.broadcastWithRetry(key, []sdk.Msg{msg}, memo)
+	}
+	if !ok {
+		s.logger.Error("[Submitter] failed to submit price")
+		return
+	}
+
+	s.logger.Info("[Submitter] price submitted at %v", txHash)
+	s.pushMonitoringRecords(uuid, txHash)
+}
+
+// commitAndRevealPrice commits the hash of the signal prices in the commit phase of a commit-reveal round
+// and reveals the signal prices once the reveal phase of the round starts. The round is estimated from
+// the local time, so a transaction landing in the wrong phase is retried by broadcastWithRetry.
+func (s *Submitter) commitAndRevealPrice(
+	key *keyring.Record,
+	signalPrices []types.SignalPrice,
+	params types.Params,
+	memo string,
+) (string, bool) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		s.logger.Error("[Submitter] failed to generate salt: %v", err)
+		return "", false
+	}
+
+	// wait for the commit phase of the next round if the current round is in the reveal phase
+	round, isCommitPhase := types.GetCommitRevealRound(time.Now().Unix(), params.CommitWindow, params.RevealWindow)
+	if !isCommitPhase {
+		round++
+		sleepUntil(round * (params.CommitWindow + params.RevealWindow))
+	}
+
+	hash := types.CalculateSignalPricesCommitHash(s.valAddress, salt, signalPrices)
+	commitMsg := types.NewMsgCommitSignalPrices(s.valAddress.String(), hash)
+	if _, ok := s.broadcastWithRetry(key, []sdk.Msg{commitMsg}, memo); !ok {
+		s.logger.Error("[Submitter] failed to commit price")
+		return "", false
+	}
+
+	sleepUntil(types.GetRevealPhaseStartTime(round, params.CommitWindow, params.RevealWindow))
+
+	revealMsg := types.NewMsgRevealSignalPrices(s.valAddress.String(), salt, signalPrices)
+	return s.broadcastWithRetry(key, []sdk.Msg{revealMsg}, memo)
+}
+
+// broadcastWithRetry broadcasts the messages until the transaction is successfully executed or the maximum
+// number of tries is reached, and returns the hash of the successful transaction.
+func (s *Submitter) broadcastWithRetry(key *keyring.Record, msgs []sdk.Msg, memo string) (string, bool) {
 	gasAdjustment := 1.3
 	for i := uint64(0); i < s.broadcastMaxTry; i++ {
 		txResp, err := s.broadcastMsg(
@@ -158,9 +217,7 @@ func (s *Submitter) submitPrice(pricesSubmission SignalPriceSubmission, keyID st
 
 		switch {
 		case finalizedTxResp.Code == 0:
-			s.logger.Info("[Submitter] price submitted at %v", finalizedTxResp.TxHash)
-			s.pushMonitoringRecords(uuid, finalizedTxResp.TxHash)
-			return
+			return finalizedTxResp.TxHash, true
 		case finalizedTxResp.Codespace == sdkerrors.RootCodespace && finalizedTxResp.Code == sdkerrors.ErrOutOfGas.ABCICode():
 			s.logger.Info("[Submitter] transaction is out of gas, retrying with increased gas adjustment")
 			gasAdjustment += 0.1
@@ -169,7 +226,7 @@ func (s *Submitter) submitPrice(pricesSubmission SignalPriceSubmission, keyID st
 		}
 	}
 
-	s.logger.Error("[Submitter] failed to submit price")
+	return "", false
 }
 
 func (s *Submitter) pushMonitoringRecords(uuid, txHash string) {
@@ -370,3 +427,8 @@ func (s *Submitter) getTxResponse(
 
 	return nil, fmt.Errorf("timeout exceeded with error: %v", err)
 }
+
+// sleepUntil sleeps until the given unix timestamp.
+func sleepUntil(timestamp int64) {
+	time.Sleep(time.Until(time.Unix(timestamp, 0)))
+}
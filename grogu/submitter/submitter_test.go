@@ -130,6 +130,12 @@ func (s *SubmitterTestSuite) SetupTest() {
 		}).
 		AnyTimes()
 
+	mockFeedQuerier := testutil.NewMockFeedQuerier(ctrl)
+	mockFeedQuerier.EXPECT().
+		QueryParams().
+		Return(&types.QueryParamsResponse{Params: types.DefaultParams()}, nil).
+		AnyTimes()
+
 	mockTxQuerier := testutil.NewMockTxQuerier(ctrl)
 	mockTxQuerier.EXPECT().
 		QueryTx(gomock.Any()).
@@ -157,6 +163,7 @@ func (s *SubmitterTestSuite) SetupTest() {
 		l,
 		submitSignalPriceCh,
 		mockAuthQuerier,
+		mockFeedQuerier,
 		mockTxQuerier,
 		validAddress,
 		&pendingSignalIDs,
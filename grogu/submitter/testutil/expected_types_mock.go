@@ -14,13 +14,14 @@ import (
 	reflect "reflect"
 
 	proto "github.com/bandprotocol/bothan/bothan-api/client/go-client/proto/bothan/v1"
+	types "github.com/bandprotocol/chain/v3/x/feeds/types"
 	bytes "github.com/cometbft/cometbft/libs/bytes"
 	log "github.com/cometbft/cometbft/libs/log"
 	client "github.com/cometbft/cometbft/rpc/client"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
-	types "github.com/cometbft/cometbft/types"
-	types0 "github.com/cosmos/cosmos-sdk/types"
-	types1 "github.com/cosmos/cosmos-sdk/x/auth/types"
+	types0 "github.com/cometbft/cometbft/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	types2 "github.com/cosmos/cosmos-sdk/x/auth/types"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -169,7 +170,7 @@ func (mr *MockRemoteClientMockRecorder) BlockchainInfo(ctx, minHeight, maxHeight
 }
 
 // BroadcastEvidence mocks base method.
-func (m *MockRemoteClient) BroadcastEvidence(arg0 context.Context, arg1 types.Evidence) (*coretypes.ResultBroadcastEvidence, error) {
+func (m *MockRemoteClient) BroadcastEvidence(arg0 context.Context, arg1 types0.Evidence) (*coretypes.ResultBroadcastEvidence, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BroadcastEvidence", arg0, arg1)
 	ret0, _ := ret[0].(*coretypes.ResultBroadcastEvidence)
@@ -184,7 +185,7 @@ func (mr *MockRemoteClientMockRecorder) BroadcastEvidence(arg0, arg1 any) *gomoc
 }
 
 // BroadcastTxAsync mocks base method.
-func (m *MockRemoteClient) BroadcastTxAsync(arg0 context.Context, arg1 types.Tx) (*coretypes.ResultBroadcastTx, error) {
+func (m *MockRemoteClient) BroadcastTxAsync(arg0 context.Context, arg1 types0.Tx) (*coretypes.ResultBroadcastTx, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BroadcastTxAsync", arg0, arg1)
 	ret0, _ := ret[0].(*coretypes.ResultBroadcastTx)
@@ -199,7 +200,7 @@ func (mr *MockRemoteClientMockRecorder) BroadcastTxAsync(arg0, arg1 any) *gomock
 }
 
 // BroadcastTxCommit mocks base method.
-func (m *MockRemoteClient) BroadcastTxCommit(arg0 context.Context, arg1 types.Tx) (*coretypes.ResultBroadcastTxCommit, error) {
+func (m *MockRemoteClient) BroadcastTxCommit(arg0 context.Context, arg1 types0.Tx) (*coretypes.ResultBroadcastTxCommit, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BroadcastTxCommit", arg0, arg1)
 	ret0, _ := ret[0].(*coretypes.ResultBroadcastTxCommit)
@@ -214,7 +215,7 @@ func (mr *MockRemoteClientMockRecorder) BroadcastTxCommit(arg0, arg1 any) *gomoc
 }
 
 // BroadcastTxSync mocks base method.
-func (m *MockRemoteClient) BroadcastTxSync(arg0 context.Context, arg1 types.Tx) (*coretypes.ResultBroadcastTx, error) {
+func (m *MockRemoteClient) BroadcastTxSync(arg0 context.Context, arg1 types0.Tx) (*coretypes.ResultBroadcastTx, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BroadcastTxSync", arg0, arg1)
 	ret0, _ := ret[0].(*coretypes.ResultBroadcastTx)
@@ -229,7 +230,7 @@ func (mr *MockRemoteClientMockRecorder) BroadcastTxSync(arg0, arg1 any) *gomock.
 }
 
 // CheckTx mocks base method.
-func (m *MockRemoteClient) CheckTx(arg0 context.Context, arg1 types.Tx) (*coretypes.ResultCheckTx, error) {
+func (m *MockRemoteClient) CheckTx(arg0 context.Context, arg1 types0.Tx) (*coretypes.ResultCheckTx, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CheckTx", arg0, arg1)
 	ret0, _ := ret[0].(*coretypes.ResultCheckTx)
@@ -788,10 +789,10 @@ func (m *MockAuthQuerier) EXPECT() *MockAuthQuerierMockRecorder {
 }
 
 // QueryAccount mocks base method.
-func (m *MockAuthQuerier) QueryAccount(address types0.Address) (*types1.QueryAccountResponse, error) {
+func (m *MockAuthQuerier) QueryAccount(address types1.Address) (*types2.QueryAccountResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "QueryAccount", address)
-	ret0, _ := ret[0].(*types1.QueryAccountResponse)
+	ret0, _ := ret[0].(*types2.QueryAccountResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -802,6 +803,45 @@ func (mr *MockAuthQuerierMockRecorder) QueryAccount(address any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryAccount", reflect.TypeOf((*MockAuthQuerier)(nil).QueryAccount), address)
 }
 
+// MockFeedQuerier is a mock of FeedQuerier interface.
+type MockFeedQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockFeedQuerierMockRecorder is the mock recorder for MockFeedQuerier.
+type MockFeedQuerierMockRecorder struct {
+	mock *MockFeedQuerier
+}
+
+// NewMockFeedQuerier creates a new mock instance.
+func NewMockFeedQuerier(ctrl *gomock.Controller) *MockFeedQuerier {
+	mock := &MockFeedQuerier{ctrl: ctrl}
+	mock.recorder = &MockFeedQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeedQuerier) EXPECT() *MockFeedQuerierMockRecorder {
+	return m.recorder
+}
+
+// QueryParams mocks base method.
+func (m *MockFeedQuerier) QueryParams() (*types.QueryParamsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryParams")
+	ret0, _ := ret[0].(*types.QueryParamsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryParams indicates an expected call of QueryParams.
+func (mr *MockFeedQuerierMockRecorder) QueryParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryParams", reflect.TypeOf((*MockFeedQuerier)(nil).QueryParams))
+}
+
 // MockTxQuerier is a mock of TxQuerier interface.
 type MockTxQuerier struct {
 	ctrl     *gomock.Controller
@@ -827,10 +867,10 @@ func (m *MockTxQuerier) EXPECT() *MockTxQuerierMockRecorder {
 }
 
 // QueryTx mocks base method.
-func (m *MockTxQuerier) QueryTx(hash string) (*types0.TxResponse, error) {
+func (m *MockTxQuerier) QueryTx(hash string) (*types1.TxResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "QueryTx", hash)
-	ret0, _ := ret[0].(*types0.TxResponse)
+	ret0, _ := ret[0].(*types1.TxResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
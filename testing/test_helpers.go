@@ -25,6 +25,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -303,6 +304,15 @@ func SetupWithCustomHome(isCheckTx bool, dir string) *band.BandApp {
 }
 
 func SetupWithCustomHomeAndChainId(isCheckTx bool, dir, chainID string) *band.BandApp {
+	return SetupWithCustomHomeAndAppOptions(isCheckTx, dir, chainID, sims.EmptyAppOptions{})
+}
+
+// SetupWithCustomHomeAndAppOptions initializes a new BandApp with a custom home directory, chain ID and app options
+func SetupWithCustomHomeAndAppOptions(
+	isCheckTx bool,
+	dir, chainID string,
+	appOpts servertypes.AppOptions,
+) *band.BandApp {
 	db := cosmosdb.NewMemDB()
 
 	snapshotDir := filepath.Join(dir, "data", "snapshots")
@@ -322,7 +332,7 @@ func SetupWithCustomHomeAndChainId(isCheckTx bool, dir, chainID string) *band.Ba
 		true,
 		map[int64]bool{},
 		dir,
-		sims.EmptyAppOptions{},
+		appOpts,
 		100,
 		baseapp.SetChainID(chainID),
 		baseapp.SetSnapshot(snapshotStore, snapshottypes.SnapshotOptions{KeepRecent: 2}),
@@ -24,5 +24,14 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	}
 
 	// re-calculate prices of all current feeds
-	return k.CalculatePrices(ctx)
+	if err := k.CalculatePrices(ctx); err != nil {
+		return err
+	}
+
+	// penalize outlier validators and reset their accuracies every `AccuracyWindow` blocks
+	if window := k.GetParams(ctx).AccuracyWindow; window > 0 && ctx.BlockHeight()%window == 0 {
+		k.ProcessValidatorAccuracies(ctx)
+	}
+
+	return nil
 }
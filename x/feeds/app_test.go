@@ -0,0 +1,176 @@
+package feeds_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	protoio "github.com/cosmos/gogoproto/io"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	consensusparamtypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+
+	band "github.com/bandprotocol/chain/v3/app"
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+	"github.com/bandprotocol/chain/v3/x/feeds/voteext"
+)
+
+type AppTestSuite struct {
+	suite.Suite
+
+	app *band.BandApp
+}
+
+func TestAppTestSuite(t *testing.T) {
+	suite.Run(t, new(AppTestSuite))
+}
+
+func (s *AppTestSuite) SetupTest() {
+	dir := testutil.GetTempDir(s.T())
+	s.app = bandtesting.SetupWithCustomHome(false, dir)
+	ctx := s.app.BaseApp.NewUncachedContext(false, cmtproto.Header{})
+
+	// Activate validators
+	for _, v := range bandtesting.Validators {
+		err := s.app.OracleKeeper.Activate(ctx, v.ValAddress)
+		s.Require().NoError(err)
+	}
+
+	s.finalizeAndCommit(nil)
+}
+
+// finalizeAndCommit finalizes the next block with the given transactions and commits it.
+func (s *AppTestSuite) finalizeAndCommit(txs [][]byte) *abci.ResponseFinalizeBlock {
+	height := s.app.LastBlockHeight() + 1
+	res, err := s.app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: height,
+		Time:   time.Unix(1700000000+height, 0),
+		Txs:    txs,
+	})
+	s.Require().NoError(err)
+	_, err = s.app.Commit()
+	s.Require().NoError(err)
+
+	return res
+}
+
+// voteWithExtension returns the vote of the validator that carries the given vote extension signed for
+// the given height.
+func (s *AppTestSuite) voteWithExtension(val bandtesting.Account, height int64, ext []byte) abci.ExtendedVoteInfo {
+	ctx := s.app.BaseApp.NewUncachedContext(false, cmtproto.Header{})
+	validator, err := s.app.StakingKeeper.GetValidator(ctx, val.ValAddress)
+	s.Require().NoError(err)
+
+	var buf bytes.Buffer
+	err = protoio.NewDelimitedWriter(&buf).WriteMsg(&cmtproto.CanonicalVoteExtension{
+		Extension: ext,
+		Height:    height,
+		Round:     0,
+		ChainId:   bandtesting.ChainID,
+	})
+	s.Require().NoError(err)
+
+	signature, err := val.PrivKey.Sign(buf.Bytes())
+	s.Require().NoError(err)
+
+	return abci.ExtendedVoteInfo{
+		Validator: abci.Validator{
+			Address: val.PubKey.Address(),
+			Power:   validator.ConsensusPower(sdk.DefaultPowerReduction),
+		},
+		VoteExtension:      ext,
+		ExtensionSignature: signature,
+		BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+	}
+}
+
+func (s *AppTestSuite) TestVoteExtensionSignalPrices() {
+	require := s.Require()
+	ctx := s.app.BaseApp.NewUncachedContext(false, cmtproto.Header{})
+
+	// vote extensions are enabled by updating the consensus params, e.g. through a governance proposal.
+	enableHeight := s.app.LastBlockHeight() + 2
+	cp, err := s.app.ConsensusParamsKeeper.ParamsStore.Get(ctx)
+	require.NoError(err)
+	_, err = s.app.ConsensusParamsKeeper.UpdateParams(ctx, &consensusparamtypes.MsgUpdateParams{
+		Authority: s.app.ConsensusParamsKeeper.GetAuthority(),
+		Block:     cp.Block,
+		Evidence:  cp.Evidence,
+		Validator: cp.Validator,
+		Abci:      &cmtproto.ABCIParams{VoteExtensionsEnableHeight: enableHeight},
+	})
+	require.NoError(err)
+
+	for s.app.LastBlockHeight() < enableHeight {
+		s.finalizeAndCommit(nil)
+	}
+
+	ctx = s.app.BaseApp.NewUncachedContext(false, cmtproto.Header{})
+	s.app.FeedsKeeper.SetCurrentFeeds(ctx, []types.Feed{types.NewFeed("CS:BAND-USD", 1e10, 60)})
+
+	signalPrices := []types.SignalPrice{
+		types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1e9),
+	}
+	ext, err := (&types.SignalPricesVoteExtension{SignalPrices: signalPrices}).Marshal()
+	require.NoError(err)
+
+	// votes are ordered by voting power as in the last commit
+	height := s.app.LastBlockHeight() + 1
+	extCommit := abci.ExtendedCommitInfo{
+		Votes: []abci.ExtendedVoteInfo{
+			s.voteWithExtension(bandtesting.Validators[0], height-1, ext),
+			s.voteWithExtension(bandtesting.Validators[2], height-1, ext),
+			s.voteWithExtension(bandtesting.Validators[1], height-1, ext),
+		},
+	}
+	lastCommit := abci.CommitInfo{}
+	for _, vote := range extCommit.Votes {
+		lastCommit.Votes = append(lastCommit.Votes, abci.VoteInfo{
+			Validator:   vote.Validator,
+			BlockIdFlag: vote.BlockIdFlag,
+		})
+	}
+
+	// the proposer injects the extended commit info as the first transaction
+	prepareRes, err := s.app.PrepareProposal(&abci.RequestPrepareProposal{
+		Height:          height,
+		Time:            time.Unix(1700000000+height, 0),
+		MaxTxBytes:      1_000_000,
+		LocalLastCommit: extCommit,
+	})
+	require.NoError(err)
+	require.Len(prepareRes.Txs, 1)
+	require.True(voteext.IsExtendedCommitInfoTx(prepareRes.Txs[0]))
+
+	// the other validators verify the vote extensions of the proposal
+	processRes, err := s.app.ProcessProposal(&abci.RequestProcessProposal{
+		Height:             height,
+		Time:               time.Unix(1700000000+height, 0),
+		Txs:                prepareRes.Txs,
+		ProposedLastCommit: lastCommit,
+	})
+	require.NoError(err)
+	require.Equal(abci.ResponseProcessProposal_ACCEPT, processRes.Status)
+
+	// the extended commit info is not delivered as a failing transaction
+	finalizeRes := s.finalizeAndCommit(prepareRes.Txs)
+	require.Len(finalizeRes.TxResults, 1)
+	require.Equal(uint32(0), finalizeRes.TxResults[0].Code)
+
+	// the signal prices of the vote extensions are submitted on behalf of their validators
+	ctx = s.app.BaseApp.NewUncachedContext(false, cmtproto.Header{})
+	for _, val := range bandtesting.Validators {
+		valPrices, err := s.app.FeedsKeeper.GetValidatorPriceList(ctx, val.ValAddress)
+		require.NoError(err)
+		require.Len(valPrices.ValidatorPrices, 1)
+		require.Equal(signalPrices[0].SignalID, valPrices.ValidatorPrices[0].SignalID)
+		require.Equal(signalPrices[0].Price, valPrices.ValidatorPrices[0].Price)
+		require.Equal(height, valPrices.ValidatorPrices[0].BlockHeight)
+	}
+}
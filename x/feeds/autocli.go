@@ -48,11 +48,48 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "all-prices",
 					Short:     "Get all prices",
 				},
+				{
+					RpcMethod:      "PriceHistory",
+					Use:            "price-history [signal-id]",
+					Short:          "Get the price history of a signal ID within a time range",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "signal_id"}},
+				},
+				{
+					RpcMethod: "TWAP",
+					Use:       "twap [window] [signal-ids]",
+					Short:     "Get time-weighted average prices of signal IDs over a window (in seconds)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "window"},
+						{ProtoField: "signal_ids", Varargs: true},
+					},
+				},
 				{
 					RpcMethod: "ReferenceSourceConfig",
 					Use:       "reference-source-config",
 					Short:     "Get the configuration of the reference price source",
 				},
+				{
+					RpcMethod:      "SignalInfo",
+					Use:            "signal-info [signal-id]",
+					Short:          "Get the registered metadata of a signal ID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "signal_id"}},
+				},
+				{
+					RpcMethod: "SignalInfos",
+					Use:       "signal-infos",
+					Short:     "Get the registered metadata of all signal IDs",
+				},
+				{
+					RpcMethod:      "PriceGuard",
+					Use:            "price-guard [signal-id]",
+					Short:          "Get the price guard of a signal ID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "signal_id"}},
+				},
+				{
+					RpcMethod: "PriceGuards",
+					Use:       "price-guards",
+					Short:     "Get the price guards of all signal IDs",
+				},
 				{
 					RpcMethod: "SignalTotalPowers",
 					Use:       "signal-total-powers",
@@ -64,6 +101,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Check if the validator is valid to send prices",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator"}},
 				},
+				{
+					RpcMethod:      "ValidatorAccuracy",
+					Use:            "validator-accuracy [validator-address]",
+					Short:          "Get the accuracy of prices submitted by a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator"}},
+				},
+				{
+					RpcMethod:      "ValidatorFeedsReport",
+					Use:            "validator-feeds-report [validator-address]",
+					Short:          "Get the price submission report of a validator for the current feeds",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator"}},
+				},
 				{
 					RpcMethod:      "ValidatorPrices",
 					Use:            "validator-prices [validator-address]",
@@ -76,6 +125,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Get signals voted by a voter",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "voter"}},
 				},
+				{
+					RpcMethod:      "SignalVoteDelegation",
+					Use:            "signal-vote-delegation [delegator]",
+					Short:          "Get the voting power delegation of a delegator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator"}},
+				},
+				{
+					RpcMethod:      "CuratorSignalVoteDelegations",
+					Use:            "curator-signal-vote-delegations [curator]",
+					Short:          "Get the voting power delegations to a curator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "curator"}},
+				},
+				{
+					RpcMethod:      "CuratorDelegatedPower",
+					Use:            "curator-delegated-power [curator]",
+					Short:          "Get the total power delegated to a curator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "curator"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -28,6 +29,8 @@ const (
 func getGrantMsgTypes() []string {
 	return []string{
 		sdk.MsgTypeURL(&types.MsgSubmitSignalPrices{}),
+		sdk.MsgTypeURL(&types.MsgCommitSignalPrices{}),
+		sdk.MsgTypeURL(&types.MsgRevealSignalPrices{}),
 	}
 }
 
@@ -44,6 +47,8 @@ func GetTxCmd() *cobra.Command {
 		GetTxCmdAddFeeders(),
 		GetTxCmdRemoveFeeders(),
 		GetTxCmdUpdateReferenceSourceConfig(),
+		GetTxCmdUpdateSignalInfos(),
+		GetTxCmdUpdatePriceGuards(),
 		GetTxCmdVote(),
 	)
 
@@ -136,6 +141,115 @@ $ %s tx feeds update-reference-source-config <YOUR_IPFS_HASH> 1.0.0 --from mykey
 	return cmd
 }
 
+// GetTxCmdUpdateSignalInfos creates a CLI command for registering or updating metadata of signal ids
+func GetTxCmdUpdateSignalInfos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-signal-infos [signal-infos-file]",
+		Short: "Register or update metadata of signal ids",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Register or update metadata of signal ids in the signal registry from a JSON file.
+Example:
+$ %s tx feeds update-signal-infos signal_infos.json --from mykey
+
+Where signal_infos.json contains:
+{
+  "signal_infos": [
+    {
+      "signal_id": "CS:BTC-USD",
+      "decimals": 9,
+      "quote_currency": "USD",
+      "asset_class": "ASSET_CLASS_CRYPTO",
+      "description": "Bitcoin price in USD",
+      "source": "Band reference registry",
+      "deprecated": false
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var msg types.MsgUpdateSignalInfos
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &msg); err != nil {
+				return err
+			}
+			msg.Admin = clientCtx.GetFromAddress().String()
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetTxCmdUpdatePriceGuards creates a CLI command for setting or removing price guards of signal ids
+func GetTxCmdUpdatePriceGuards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-price-guards [price-guards-file]",
+		Short: "Set or remove price guards of signal ids",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Set or remove price guards of signal ids from a JSON file. A zero limit is disabled and
+a price guard with all limits disabled removes the price guard of the signal id.
+Example:
+$ %s tx feeds update-price-guards price_guards.json --from mykey
+
+Where price_guards.json contains:
+{
+  "price_guards": [
+    {
+      "signal_id": "CS:BTC-USD",
+      "max_change_basis_point": "2000",
+      "min_price": "1000000000000",
+      "max_price": "1000000000000000",
+      "max_staleness": "300"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var msg types.MsgUpdatePriceGuards
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &msg); err != nil {
+				return err
+			}
+			msg.Admin = clientCtx.GetFromAddress().String()
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetTxCmdVote creates a CLI command for voting signals
 func GetTxCmdVote() *cobra.Command {
 	cmd := &cobra.Command{
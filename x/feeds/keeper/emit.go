@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
 
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bandprotocol/chain/v3/x/feeds/types"
@@ -52,6 +55,83 @@ func emitEventSubmitSignalPrice(ctx sdk.Context, val sdk.ValAddress, valPrice ty
 	)
 }
 
+func emitEventDelegateSignalVote(ctx sdk.Context, delegator sdk.AccAddress, curator sdk.AccAddress, power int64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelegateSignalVote,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyCurator, curator.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+		),
+	)
+}
+
+func emitEventCommitSignalPrices(ctx sdk.Context, commitment types.SignalPricesCommitment) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommitSignalPrices,
+			sdk.NewAttribute(types.AttributeKeyValidator, commitment.Validator),
+			sdk.NewAttribute(types.AttributeKeyHash, hex.EncodeToString(commitment.Hash)),
+			sdk.NewAttribute(types.AttributeKeyRound, fmt.Sprintf("%d", commitment.Round)),
+		),
+	)
+}
+
+func emitEventUpdateSignalInfo(ctx sdk.Context, signalInfo types.SignalInfo) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateSignalInfo,
+			sdk.NewAttribute(types.AttributeKeySignalID, signalInfo.SignalID),
+			sdk.NewAttribute(types.AttributeKeyDecimals, fmt.Sprintf("%d", signalInfo.Decimals)),
+			sdk.NewAttribute(types.AttributeKeyQuoteCurrency, signalInfo.QuoteCurrency),
+			sdk.NewAttribute(types.AttributeKeyAssetClass, signalInfo.AssetClass.String()),
+			sdk.NewAttribute(types.AttributeKeyDeprecated, fmt.Sprintf("%t", signalInfo.Deprecated)),
+		),
+	)
+}
+
+func emitEventUpdatePriceGuard(ctx sdk.Context, priceGuard types.PriceGuard) {
+	eventType := types.EventTypeUpdatePriceGuard
+	if priceGuard.IsDisabled() {
+		eventType = types.EventTypeRemovePriceGuard
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeySignalID, priceGuard.SignalID),
+			sdk.NewAttribute(types.AttributeKeyMaxChangeBasisPoint, fmt.Sprintf("%d", priceGuard.MaxChangeBasisPoint)),
+			sdk.NewAttribute(types.AttributeKeyMinPrice, fmt.Sprintf("%d", priceGuard.MinPrice)),
+			sdk.NewAttribute(types.AttributeKeyMaxPrice, fmt.Sprintf("%d", priceGuard.MaxPrice)),
+			sdk.NewAttribute(types.AttributeKeyMaxStaleness, fmt.Sprintf("%d", priceGuard.MaxStaleness)),
+		),
+	)
+}
+
+func emitEventHaltPrice(ctx sdk.Context, price types.Price, reason string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHaltPrice,
+			sdk.NewAttribute(types.AttributeKeySignalID, price.SignalID),
+			sdk.NewAttribute(types.AttributeKeyPrice, fmt.Sprintf("%d", price.Price)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", price.Timestamp)),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+}
+
+func emitEventQueryPrices(ctx sdk.Context, packet channeltypes.Packet, feePayer sdk.AccAddress, fee sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueryPrices,
+			sdk.NewAttribute(types.AttributeKeyChannelID, packet.DestinationChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyFeePayer, feePayer.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+}
+
 func emitEventUpdateReferenceSourceConfig(ctx sdk.Context, referenceSourceConfig types.ReferenceSourceConfig) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -80,3 +160,19 @@ func emitEventUpdatePrice(ctx sdk.Context, price types.Price) {
 		),
 	)
 }
+
+func emitEventPenalizeOutlierValidator(
+	ctx sdk.Context,
+	accuracy types.ValidatorAccuracy,
+	penalty types.OutlierPenalty,
+) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePenalizeOutlierValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, accuracy.Validator),
+			sdk.NewAttribute(types.AttributeKeyEvaluatedCount, fmt.Sprintf("%d", accuracy.EvaluatedCount)),
+			sdk.NewAttribute(types.AttributeKeyOutlierCount, fmt.Sprintf("%d", accuracy.OutlierCount)),
+			sdk.NewAttribute(types.AttributeKeyPenalty, penalty.String()),
+		),
+	)
+}
@@ -15,8 +15,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	}
 
 	k.SetVotes(ctx, genState.Votes)
+	k.SetSignalVoteDelegations(ctx, genState.SignalVoteDelegations)
 
-	signalTotalPowers := CalculateSignalTotalPowersFromVotes(genState.Votes)
+	// the signal-total-powers include the powers delegated to the voters
+	weightedVotes := make([]types.Vote, 0, len(genState.Votes))
+	for _, v := range genState.Votes {
+		voter := sdk.MustAccAddressFromBech32(v.Voter)
+		weightedVotes = append(weightedVotes, types.NewVote(v.Voter, k.GetWeightedSignals(ctx, voter)))
+	}
+
+	signalTotalPowers := CalculateSignalTotalPowersFromVotes(weightedVotes)
 	k.SetSignalTotalPowers(ctx, signalTotalPowers)
 
 	feeds := k.CalculateNewCurrentFeeds(ctx)
@@ -25,6 +33,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	if err := k.SetReferenceSourceConfig(ctx, genState.ReferenceSourceConfig); err != nil {
 		panic(err)
 	}
+
+	k.SetSignalInfos(ctx, genState.SignalInfos)
+	k.SetPriceGuards(ctx, genState.PriceGuards)
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -33,6 +44,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.GetParams(ctx),
 		k.GetVotes(ctx),
 		k.GetReferenceSourceConfig(ctx),
+		k.GetSignalInfos(ctx),
+		k.GetPriceGuards(ctx),
+		k.GetSignalVoteDelegations(ctx),
 	)
 }
 
@@ -45,11 +45,30 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	}
 	suite.feedsKeeper.SetVotes(ctx, votes)
 
+	signalInfos := []types.SignalInfo{
+		types.NewSignalInfo("CS:BAND-USD", 9, "USD", types.ASSET_CLASS_CRYPTO, "", "", false),
+		types.NewSignalInfo("CS:BTC-USD", 9, "USD", types.ASSET_CLASS_CRYPTO, "", "", true),
+	}
+	suite.feedsKeeper.SetSignalInfos(ctx, signalInfos)
+
+	priceGuards := []types.PriceGuard{
+		types.NewPriceGuard("CS:BAND-USD", 2000, 0, 0, 0),
+	}
+	suite.feedsKeeper.SetPriceGuards(ctx, priceGuards)
+
+	delegations := []types.SignalVoteDelegation{
+		types.NewSignalVoteDelegation(InvalidVoter.String(), ValidVoter.String(), 1000),
+	}
+	suite.feedsKeeper.SetSignalVoteDelegations(ctx, delegations)
+
 	exportGenesis := suite.feedsKeeper.ExportGenesis(ctx)
 
 	suite.Require().Equal(types.DefaultParams(), exportGenesis.Params)
 	suite.Require().Equal(types.DefaultReferenceSourceConfig(), exportGenesis.ReferenceSourceConfig)
 	suite.Require().Equal(votes, exportGenesis.Votes)
+	suite.Require().Equal(signalInfos, exportGenesis.SignalInfos)
+	suite.Require().Equal(priceGuards, exportGenesis.PriceGuards)
+	suite.Require().Equal(delegations, exportGenesis.SignalVoteDelegations)
 }
 
 func (suite *KeeperTestSuite) TestInitGenesis() {
@@ -88,11 +107,19 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	g := types.DefaultGenesisState()
 	g.Votes = votes
 	g.Params = params
+	g.SignalInfos = []types.SignalInfo{
+		types.NewSignalInfo("CS:BAND-USD", 9, "USD", types.ASSET_CLASS_CRYPTO, "", "", false),
+	}
+	g.PriceGuards = []types.PriceGuard{
+		types.NewPriceGuard("CS:BAND-USD", 0, 1000, 0, 0),
+	}
 
 	suite.feedsKeeper.InitGenesis(suite.ctx, *g)
 
 	suite.Require().Equal(types.DefaultReferenceSourceConfig(), suite.feedsKeeper.GetReferenceSourceConfig(ctx))
 	suite.Require().Equal(params, suite.feedsKeeper.GetParams(ctx))
+	suite.Require().Equal(g.SignalInfos, suite.feedsKeeper.GetSignalInfos(ctx))
+	suite.Require().Equal(g.PriceGuards, suite.feedsKeeper.GetPriceGuards(ctx))
 	for _, v := range votes {
 		suite.Require().
 			Equal(v.Signals, suite.feedsKeeper.GetVote(ctx, sdk.MustAccAddressFromBech32(v.Voter)))
@@ -129,3 +156,34 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 		LastUpdateBlock:     ctx.BlockHeight(),
 	}, suite.feedsKeeper.GetCurrentFeeds(ctx))
 }
+
+func (suite *KeeperTestSuite) TestInitGenesisWithSignalVoteDelegations() {
+	ctx := suite.ctx
+
+	g := types.DefaultGenesisState()
+	g.Votes = []types.Vote{
+		types.NewVote(ValidVoter2.String(), []types.Signal{
+			types.NewSignal("CS:BAND-USD", 100),
+			types.NewSignal("CS:BTC-USD", 300),
+		}),
+	}
+	g.SignalVoteDelegations = []types.SignalVoteDelegation{
+		types.NewSignalVoteDelegation(ValidVoter.String(), ValidVoter2.String(), 1000),
+	}
+
+	suite.feedsKeeper.InitGenesis(suite.ctx, *g)
+
+	suite.Require().Equal(g.SignalVoteDelegations, suite.feedsKeeper.GetSignalVoteDelegations(ctx))
+	suite.Require().Equal(
+		types.NewCuratorDelegatedPower(ValidVoter2.String(), 1000),
+		suite.feedsKeeper.GetCuratorDelegatedPower(ctx, ValidVoter2),
+	)
+
+	stpBand, err := suite.feedsKeeper.GetSignalTotalPower(ctx, "CS:BAND-USD")
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewSignal("CS:BAND-USD", 350), stpBand)
+
+	stpBtc, err := suite.feedsKeeper.GetSignalTotalPower(ctx, "CS:BTC-USD")
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewSignal("CS:BTC-USD", 1050), stpBtc)
+}
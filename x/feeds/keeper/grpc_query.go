@@ -147,6 +147,69 @@ func (q queryServer) AllPrices(
 	}, nil
 }
 
+// PriceHistory queries the price history of a signal id within a time range.
+func (q queryServer) PriceHistory(
+	goCtx context.Context, req *types.QueryPriceHistoryRequest,
+) (*types.QueryPriceHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(q.keeper.storeKey)
+	priceHistoryStore := prefix.NewStore(store, types.PriceHistoryBySignalIDStoreKey(req.SignalId))
+
+	filteredPrices, pageRes, err := query.GenericFilteredPaginate(
+		q.keeper.cdc,
+		priceHistoryStore,
+		req.Pagination,
+		func(key []byte, price *types.Price) (*types.Price, error) {
+			if price.Timestamp < req.StartTime || (req.EndTime != 0 && price.Timestamp > req.EndTime) {
+				return nil, nil
+			}
+
+			return price, nil
+		},
+		func() *types.Price {
+			return &types.Price{}
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	prices := make([]types.Price, 0, len(filteredPrices))
+	for _, price := range filteredPrices {
+		prices = append(prices, *price)
+	}
+
+	return &types.QueryPriceHistoryResponse{
+		Prices:     prices,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TWAP queries time-weighted average prices of signal ids.
+func (q queryServer) TWAP(
+	goCtx context.Context, req *types.QueryTWAPRequest,
+) (*types.QueryTWAPResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.Window <= 0 {
+		return nil, types.ErrInvalidTWAPWindow.Wrapf("window must be positive: %d", req.Window)
+	}
+
+	if uint64(len(req.SignalIds)) > q.keeper.GetParams(ctx).MaxCurrentFeeds {
+		return nil, types.ErrInvalidSignalIDs.Wrapf("number of signal ids exceeds max current feeds")
+	}
+
+	prices := make([]types.Price, 0, len(req.SignalIds))
+	for _, signalID := range req.SignalIds {
+		prices = append(prices, q.keeper.GetTWAP(ctx, signalID, req.Window))
+	}
+
+	return &types.QueryTWAPResponse{
+		Prices: prices,
+	}, nil
+}
+
 // ReferenceSourceConfig queries current reference source config.
 func (q queryServer) ReferenceSourceConfig(
 	goCtx context.Context, _ *types.QueryReferenceSourceConfigRequest,
@@ -158,6 +221,108 @@ func (q queryServer) ReferenceSourceConfig(
 	}, nil
 }
 
+// SignalInfo queries the registered metadata of a signal id.
+func (q queryServer) SignalInfo(
+	goCtx context.Context, req *types.QuerySignalInfoRequest,
+) (*types.QuerySignalInfoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signalInfo, err := q.keeper.GetSignalInfo(ctx, req.SignalId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QuerySignalInfoResponse{
+		SignalInfo: signalInfo,
+	}, nil
+}
+
+// SignalInfos queries the registered metadata of all signal ids.
+func (q queryServer) SignalInfos(
+	goCtx context.Context, req *types.QuerySignalInfosRequest,
+) (*types.QuerySignalInfosResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(q.keeper.storeKey)
+	signalInfoStore := prefix.NewStore(store, types.SignalInfoStoreKeyPrefix)
+
+	filteredSignalInfos, pageRes, err := query.GenericFilteredPaginate(
+		q.keeper.cdc,
+		signalInfoStore,
+		req.Pagination,
+		func(key []byte, signalInfo *types.SignalInfo) (*types.SignalInfo, error) {
+			return signalInfo, nil
+		},
+		func() *types.SignalInfo {
+			return &types.SignalInfo{}
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	signalInfos := make([]types.SignalInfo, 0, len(filteredSignalInfos))
+	for _, signalInfo := range filteredSignalInfos {
+		signalInfos = append(signalInfos, *signalInfo)
+	}
+
+	return &types.QuerySignalInfosResponse{
+		SignalInfos: signalInfos,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// PriceGuard queries the price guard of a signal id.
+func (q queryServer) PriceGuard(
+	goCtx context.Context, req *types.QueryPriceGuardRequest,
+) (*types.QueryPriceGuardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	priceGuard, err := q.keeper.GetPriceGuard(ctx, req.SignalId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryPriceGuardResponse{
+		PriceGuard: priceGuard,
+	}, nil
+}
+
+// PriceGuards queries the price guards of all signal ids.
+func (q queryServer) PriceGuards(
+	goCtx context.Context, req *types.QueryPriceGuardsRequest,
+) (*types.QueryPriceGuardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(q.keeper.storeKey)
+	priceGuardStore := prefix.NewStore(store, types.PriceGuardStoreKeyPrefix)
+
+	filteredPriceGuards, pageRes, err := query.GenericFilteredPaginate(
+		q.keeper.cdc,
+		priceGuardStore,
+		req.Pagination,
+		func(key []byte, priceGuard *types.PriceGuard) (*types.PriceGuard, error) {
+			return priceGuard, nil
+		},
+		func() *types.PriceGuard {
+			return &types.PriceGuard{}
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	priceGuards := make([]types.PriceGuard, 0, len(filteredPriceGuards))
+	for _, priceGuard := range filteredPriceGuards {
+		priceGuards = append(priceGuards, *priceGuard)
+	}
+
+	return &types.QueryPriceGuardsResponse{
+		PriceGuards: priceGuards,
+		Pagination:  pageRes,
+	}, nil
+}
+
 // SignalTotalPowers queries all current signal-total-powers.
 func (q queryServer) SignalTotalPowers(
 	goCtx context.Context, req *types.QuerySignalTotalPowersRequest,
@@ -228,6 +393,40 @@ func (q queryServer) ValidValidator(
 	return &types.QueryValidValidatorResponse{Valid: isValid}, nil
 }
 
+// ValidatorAccuracy queries the accuracy of prices submitted by a validator.
+func (q queryServer) ValidatorAccuracy(
+	goCtx context.Context, req *types.QueryValidatorAccuracyRequest,
+) (*types.QueryValidatorAccuracyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, err := sdk.ValAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorAccuracyResponse{
+		ValidatorAccuracy: q.keeper.GetValidatorAccuracy(ctx, val),
+	}, nil
+}
+
+// ValidatorFeedsReport queries the price submission report of a validator for the current feeds.
+func (q queryServer) ValidatorFeedsReport(
+	goCtx context.Context, req *types.QueryValidatorFeedsReportRequest,
+) (*types.QueryValidatorFeedsReportResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, err := sdk.ValAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorFeedsReportResponse{
+		IsActive:          q.keeper.oracleKeeper.GetValidatorStatus(ctx, val).IsActive,
+		ValidatorAccuracy: q.keeper.GetValidatorAccuracy(ctx, val),
+		FeedReports:       q.keeper.GetValidatorFeedReports(ctx, val),
+	}, nil
+}
+
 // ValidatorPrices queries all price-validator submitted by a validator.
 func (q queryServer) ValidatorPrices(
 	goCtx context.Context, req *types.QueryValidatorPricesRequest,
@@ -287,3 +486,72 @@ func (q queryServer) Vote(
 
 	return &types.QueryVoteResponse{Signals: signals}, nil
 }
+
+// SignalVoteDelegation queries the voting power delegation of a delegator.
+func (q queryServer) SignalVoteDelegation(
+	goCtx context.Context, req *types.QuerySignalVoteDelegationRequest,
+) (*types.QuerySignalVoteDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator, err := sdk.AccAddressFromBech32(req.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	delegation, err := q.keeper.GetSignalVoteDelegation(ctx, delegator)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QuerySignalVoteDelegationResponse{SignalVoteDelegation: delegation}, nil
+}
+
+// CuratorSignalVoteDelegations queries the voting power delegations to a curator.
+func (q queryServer) CuratorSignalVoteDelegations(
+	goCtx context.Context, req *types.QueryCuratorSignalVoteDelegationsRequest,
+) (*types.QueryCuratorSignalVoteDelegationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	curator, err := sdk.AccAddressFromBech32(req.Curator)
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(q.keeper.storeKey)
+	delegationIndexStore := prefix.NewStore(store, types.SignalVoteDelegationsByCuratorIndexKey(curator))
+
+	var delegations []types.SignalVoteDelegation
+	pageRes, err := query.Paginate(delegationIndexStore, req.Pagination, func(_ []byte, value []byte) error {
+		delegation, err := q.keeper.GetSignalVoteDelegation(ctx, sdk.AccAddress(value))
+		if err != nil {
+			return err
+		}
+
+		delegations = append(delegations, delegation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCuratorSignalVoteDelegationsResponse{
+		SignalVoteDelegations: delegations,
+		Pagination:            pageRes,
+	}, nil
+}
+
+// CuratorDelegatedPower queries the total power delegated to a curator.
+func (q queryServer) CuratorDelegatedPower(
+	goCtx context.Context, req *types.QueryCuratorDelegatedPowerRequest,
+) (*types.QueryCuratorDelegatedPowerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	curator, err := sdk.AccAddressFromBech32(req.Curator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCuratorDelegatedPowerResponse{
+		CuratorDelegatedPower: q.keeper.GetCuratorDelegatedPower(ctx, curator),
+	}, nil
+}
@@ -74,6 +74,67 @@ func (suite *KeeperTestSuite) TestQueryPrice() {
 	}, res)
 }
 
+func (suite *KeeperTestSuite) TestQueryPriceHistory() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	// setup
+	var prices []types.Price
+	for i := int64(1); i <= 5; i++ {
+		price := types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", uint64(i*100), i*10)
+		suite.feedsKeeper.AddPriceHistory(ctx, price, 10)
+		prices = append(prices, price)
+	}
+
+	// query and check
+	res, err := queryClient.PriceHistory(context.Background(), &types.QueryPriceHistoryRequest{
+		SignalId:  "CS:BAND-USD",
+		StartTime: 20,
+		EndTime:   40,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(prices[1:4], res.Prices)
+
+	res, err = queryClient.PriceHistory(context.Background(), &types.QueryPriceHistoryRequest{
+		SignalId:   "CS:BAND-USD",
+		StartTime:  20,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(prices[1:3], res.Prices)
+	suite.Require().NotNil(res.Pagination.NextKey)
+}
+
+func (suite *KeeperTestSuite) TestQueryTWAP() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+	now := ctx.BlockTime().Unix()
+
+	// setup
+	suite.feedsKeeper.AddPriceHistory(
+		ctx,
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, now-60),
+		10,
+	)
+
+	// query and check
+	res, err := queryClient.TWAP(context.Background(), &types.QueryTWAPRequest{
+		SignalIds: []string{"CS:BAND-USD", "CS:ATOM-USD"},
+		Window:    30,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryTWAPResponse{
+		Prices: []types.Price{
+			types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, now),
+			types.NewPrice(types.PRICE_STATUS_NOT_READY, "CS:ATOM-USD", 0, now),
+		},
+	}, res)
+
+	_, err = queryClient.TWAP(context.Background(), &types.QueryTWAPRequest{
+		SignalIds: []string{"CS:BAND-USD"},
+		Window:    0,
+	})
+	suite.Require().ErrorContains(err, types.ErrInvalidTWAPWindow.Error())
+}
+
 func (suite *KeeperTestSuite) TestQueryPrices() {
 	ctx, queryClient := suite.ctx, suite.queryClient
 
@@ -295,6 +356,35 @@ func (suite *KeeperTestSuite) TestQueryValidatorPrices() {
 	}, res)
 }
 
+func (suite *KeeperTestSuite) TestQueryValidatorFeedsReport() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	suite.feedsKeeper.SetCurrentFeeds(ctx, []types.Feed{types.NewFeed("CS:BAND-USD", 60, 60)})
+	suite.feedsKeeper.SetValidatorAccuracy(ctx, ValidValidator, types.NewValidatorAccuracy(ValidValidator, 10, 1, 500))
+
+	res, err := queryClient.ValidatorFeedsReport(context.Background(), &types.QueryValidatorFeedsReportRequest{
+		Validator: ValidValidator.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryValidatorFeedsReportResponse{
+		IsActive:          true,
+		ValidatorAccuracy: types.NewValidatorAccuracy(ValidValidator, 10, 1, 500),
+		FeedReports:       suite.feedsKeeper.GetValidatorFeedReports(ctx, ValidValidator),
+	}, res)
+	suite.Require().Len(res.FeedReports, 1)
+
+	res, err = queryClient.ValidatorFeedsReport(context.Background(), &types.QueryValidatorFeedsReportRequest{
+		Validator: InvalidValidator.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(res.IsActive)
+
+	_, err = queryClient.ValidatorFeedsReport(context.Background(), &types.QueryValidatorFeedsReportRequest{
+		Validator: "invalid",
+	})
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestQueryValidValidator() {
 	queryClient := suite.queryClient
 
@@ -490,6 +580,96 @@ func (suite *KeeperTestSuite) TestQueryReferenceSourceConfig() {
 	}, res)
 }
 
+func (suite *KeeperTestSuite) TestQuerySignalInfos() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	signalInfos := []types.SignalInfo{
+		types.NewSignalInfo("CS:BAND-USD", 9, "USD", types.ASSET_CLASS_CRYPTO, "", "", false),
+		types.NewSignalInfo("CS:BTC-USD", 9, "USD", types.ASSET_CLASS_CRYPTO, "", "", false),
+	}
+	suite.feedsKeeper.SetSignalInfos(ctx, signalInfos)
+
+	res, err := queryClient.SignalInfo(context.Background(), &types.QuerySignalInfoRequest{SignalId: "CS:BTC-USD"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(signalInfos[1], res.SignalInfo)
+
+	_, err = queryClient.SignalInfo(context.Background(), &types.QuerySignalInfoRequest{SignalId: "CS:ETH-USD"})
+	suite.Require().Error(err)
+
+	allRes, err := queryClient.SignalInfos(context.Background(), &types.QuerySignalInfosRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(signalInfos, allRes.SignalInfos)
+}
+
+func (suite *KeeperTestSuite) TestQueryPriceGuards() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	priceGuards := []types.PriceGuard{
+		types.NewPriceGuard("CS:BAND-USD", 2000, 0, 0, 0),
+		types.NewPriceGuard("CS:BTC-USD", 0, 1000, 1000000, 300),
+	}
+	suite.feedsKeeper.SetPriceGuards(ctx, priceGuards)
+
+	res, err := queryClient.PriceGuard(context.Background(), &types.QueryPriceGuardRequest{SignalId: "CS:BTC-USD"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(priceGuards[1], res.PriceGuard)
+
+	_, err = queryClient.PriceGuard(context.Background(), &types.QueryPriceGuardRequest{SignalId: "CS:ETH-USD"})
+	suite.Require().Error(err)
+
+	allRes, err := queryClient.PriceGuards(context.Background(), &types.QueryPriceGuardsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(priceGuards, allRes.PriceGuards)
+}
+
+func (suite *KeeperTestSuite) TestQuerySignalVoteDelegations() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	delegations := []types.SignalVoteDelegation{
+		types.NewSignalVoteDelegation(ValidVoter.String(), ValidVoter2.String(), 1000),
+		types.NewSignalVoteDelegation(InvalidVoter.String(), ValidVoter2.String(), 2000),
+	}
+	suite.feedsKeeper.SetSignalVoteDelegations(ctx, delegations)
+
+	res, err := queryClient.SignalVoteDelegation(
+		context.Background(),
+		&types.QuerySignalVoteDelegationRequest{Delegator: ValidVoter.String()},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(delegations[0], res.SignalVoteDelegation)
+
+	_, err = queryClient.SignalVoteDelegation(
+		context.Background(),
+		&types.QuerySignalVoteDelegationRequest{Delegator: ValidVoter2.String()},
+	)
+	suite.Require().Error(err)
+
+	curatorRes, err := queryClient.CuratorSignalVoteDelegations(
+		context.Background(),
+		&types.QueryCuratorSignalVoteDelegationsRequest{Curator: ValidVoter2.String()},
+	)
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(delegations, curatorRes.SignalVoteDelegations)
+
+	curatorRes, err = queryClient.CuratorSignalVoteDelegations(
+		context.Background(),
+		&types.QueryCuratorSignalVoteDelegationsRequest{
+			Curator:    ValidVoter2.String(),
+			Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+		},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(curatorRes.SignalVoteDelegations, 1)
+	suite.Require().Equal(uint64(2), curatorRes.Pagination.Total)
+
+	powerRes, err := queryClient.CuratorDelegatedPower(
+		context.Background(),
+		&types.QueryCuratorDelegatedPowerRequest{Curator: ValidVoter2.String()},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewCuratorDelegatedPower(ValidVoter2.String(), 3000), powerRes.CuratorDelegatedPower)
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx, queryClient := suite.ctx, suite.queryClient
 
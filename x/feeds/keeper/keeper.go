@@ -19,8 +19,12 @@ type Keeper struct {
 	stakingKeeper types.StakingKeeper
 	restakeKeeper types.RestakeKeeper
 	authzKeeper   types.AuthzKeeper
+	bankKeeper    types.BankKeeper
+	portKeeper    types.PortKeeper
+	scopedKeeper  types.ScopedKeeper
 
-	authority string
+	feeCollectorName string
+	authority        string
 }
 
 // NewKeeper creates a new feeds Keeper instance.
@@ -31,6 +35,10 @@ func NewKeeper(
 	stakingKeeper types.StakingKeeper,
 	restakeKeeper types.RestakeKeeper,
 	authzKeeper types.AuthzKeeper,
+	bankKeeper types.BankKeeper,
+	portKeeper types.PortKeeper,
+	scopedKeeper types.ScopedKeeper,
+	feeCollectorName string,
 	authority string,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
@@ -38,13 +46,17 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:           cdc,
-		storeKey:      storeKey,
-		oracleKeeper:  oracleKeeper,
-		stakingKeeper: stakingKeeper,
-		restakeKeeper: restakeKeeper,
-		authzKeeper:   authzKeeper,
-		authority:     authority,
+		cdc:              cdc,
+		storeKey:         storeKey,
+		oracleKeeper:     oracleKeeper,
+		stakingKeeper:    stakingKeeper,
+		restakeKeeper:    restakeKeeper,
+		authzKeeper:      authzKeeper,
+		bankKeeper:       bankKeeper,
+		portKeeper:       portKeeper,
+		scopedKeeper:     scopedKeeper,
+		feeCollectorName: feeCollectorName,
+		authority:        authority,
 	}
 }
 
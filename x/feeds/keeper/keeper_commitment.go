@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// GetCommitment returns the signal prices commitment of a validator.
+func (k Keeper) GetCommitment(ctx sdk.Context, val sdk.ValAddress) (types.SignalPricesCommitment, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CommitmentStoreKey(val))
+	if bz == nil {
+		return types.SignalPricesCommitment{}, types.ErrCommitmentNotFound.Wrapf(
+			"failed to get commitment for validator: %s",
+			val.String(),
+		)
+	}
+
+	var commitment types.SignalPricesCommitment
+	k.cdc.MustUnmarshal(bz, &commitment)
+
+	return commitment, nil
+}
+
+// SetCommitment sets the signal prices commitment of a validator.
+func (k Keeper) SetCommitment(ctx sdk.Context, val sdk.ValAddress, commitment types.SignalPricesCommitment) {
+	ctx.KVStore(k.storeKey).Set(types.CommitmentStoreKey(val), k.cdc.MustMarshal(&commitment))
+}
+
+// DeleteCommitment deletes the signal prices commitment of a validator.
+func (k Keeper) DeleteCommitment(ctx sdk.Context, val sdk.ValAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.CommitmentStoreKey(val))
+}
+
+// CommitValidatorSignalPrices records the hash of the signal prices of a validator in the commit phase of the
+// current commit-reveal round. A new commitment replaces the previous commitment of the validator.
+func (k Keeper) CommitValidatorSignalPrices(ctx sdk.Context, val sdk.ValAddress, hash []byte) error {
+	params := k.GetParams(ctx)
+	if !params.IsCommitRevealEnabled() {
+		return types.ErrCommitRevealDisabled
+	}
+
+	// check if the validator is required to send prices
+	if err := k.ValidateValidatorRequiredToSend(ctx, val); err != nil {
+		return err
+	}
+
+	round, isCommitPhase := types.GetCommitRevealRound(
+		ctx.BlockTime().Unix(),
+		params.CommitWindow,
+		params.RevealWindow,
+	)
+	if !isCommitPhase {
+		return types.ErrInvalidCommitRevealPhase.Wrapf("round %d is in the reveal phase", round)
+	}
+
+	commitment := types.NewSignalPricesCommitment(val, hash, round)
+	k.SetCommitment(ctx, val, commitment)
+	emitEventCommitSignalPrices(ctx, commitment)
+
+	return nil
+}
+
+// RevealValidatorSignalPrices submits the signal prices of a validator in the reveal phase of the round in which
+// they were committed. The signal prices are only accepted if they match the commitment of the validator.
+func (k Keeper) RevealValidatorSignalPrices(
+	ctx sdk.Context,
+	val sdk.ValAddress,
+	salt []byte,
+	signalPrices []types.SignalPrice,
+) error {
+	params := k.GetParams(ctx)
+	if !params.IsCommitRevealEnabled() {
+		return types.ErrCommitRevealDisabled
+	}
+
+	round, isCommitPhase := types.GetCommitRevealRound(
+		ctx.BlockTime().Unix(),
+		params.CommitWindow,
+		params.RevealWindow,
+	)
+	if isCommitPhase {
+		return types.ErrInvalidCommitRevealPhase.Wrapf("round %d is in the commit phase", round)
+	}
+
+	commitment, err := k.GetCommitment(ctx, val)
+	if err != nil {
+		return err
+	}
+
+	if commitment.Round != round {
+		return types.ErrCommitmentNotFound.Wrapf(
+			"commitment of validator %s is from round %d, current round is %d",
+			val.String(),
+			commitment.Round,
+			round,
+		)
+	}
+
+	if !bytes.Equal(commitment.Hash, types.CalculateSignalPricesCommitHash(val, salt, signalPrices)) {
+		return types.ErrCommitmentMismatch
+	}
+
+	// each commitment can only be revealed once
+	k.DeleteCommitment(ctx, val)
+
+	return k.SubmitValidatorSignalPrices(ctx, val, signalPrices, false)
+}
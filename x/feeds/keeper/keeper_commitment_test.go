@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func (suite *KeeperTestSuite) TestGetSetDeleteCommitment() {
+	ctx := suite.ctx
+
+	_, err := suite.feedsKeeper.GetCommitment(ctx, ValidValidator)
+	suite.Require().ErrorIs(err, types.ErrCommitmentNotFound)
+
+	commitment := types.NewSignalPricesCommitment(ValidValidator, []byte("hash"), 20)
+	suite.feedsKeeper.SetCommitment(ctx, ValidValidator, commitment)
+
+	stored, err := suite.feedsKeeper.GetCommitment(ctx, ValidValidator)
+	suite.Require().NoError(err)
+	suite.Require().Equal(commitment, stored)
+
+	suite.feedsKeeper.DeleteCommitment(ctx, ValidValidator)
+	_, err = suite.feedsKeeper.GetCommitment(ctx, ValidValidator)
+	suite.Require().ErrorIs(err, types.ErrCommitmentNotFound)
+}
+
+func (suite *KeeperTestSuite) TestCommitRevealValidatorSignalPrices() {
+	suite.feedsKeeper.SetCurrentFeeds(suite.ctx, []types.Feed{{
+		SignalID: "CS:BAND-USD",
+		Interval: 100,
+	}})
+
+	salt := []byte("salt")
+	signalPrices := []types.SignalPrice{
+		types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000),
+	}
+	hash := types.CalculateSignalPricesCommitHash(ValidValidator, salt, signalPrices)
+
+	// rounds of 60 seconds with 40 seconds of commit phase and 20 seconds of reveal phase
+	commitCtx := suite.ctx.WithBlockTime(time.Unix(1200, 0))
+	revealCtx := suite.ctx.WithBlockTime(time.Unix(1245, 0))
+	nextRoundRevealCtx := suite.ctx.WithBlockTime(time.Unix(1305, 0))
+
+	// commit-reveal is disabled by default
+	err := suite.feedsKeeper.CommitValidatorSignalPrices(commitCtx, ValidValidator, hash)
+	suite.Require().ErrorIs(err, types.ErrCommitRevealDisabled)
+	err = suite.feedsKeeper.RevealValidatorSignalPrices(revealCtx, ValidValidator, salt, signalPrices)
+	suite.Require().ErrorIs(err, types.ErrCommitRevealDisabled)
+
+	params := suite.feedsKeeper.GetParams(suite.ctx)
+	params.CommitWindow = 40
+	params.RevealWindow = 20
+	suite.Require().NoError(suite.feedsKeeper.SetParams(suite.ctx, params))
+
+	// commit in the reveal phase
+	err = suite.feedsKeeper.CommitValidatorSignalPrices(revealCtx, ValidValidator, hash)
+	suite.Require().ErrorIs(err, types.ErrInvalidCommitRevealPhase)
+
+	// reveal without commitment
+	err = suite.feedsKeeper.RevealValidatorSignalPrices(revealCtx, ValidValidator, salt, signalPrices)
+	suite.Require().ErrorIs(err, types.ErrCommitmentNotFound)
+
+	// commit in the commit phase
+	err = suite.feedsKeeper.CommitValidatorSignalPrices(commitCtx, ValidValidator, hash)
+	suite.Require().NoError(err)
+
+	commitment, err := suite.feedsKeeper.GetCommitment(commitCtx, ValidValidator)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewSignalPricesCommitment(ValidValidator, hash, 20), commitment)
+
+	// reveal in the commit phase
+	err = suite.feedsKeeper.RevealValidatorSignalPrices(commitCtx, ValidValidator, salt, signalPrices)
+	suite.Require().ErrorIs(err, types.ErrInvalidCommitRevealPhase)
+
+	// reveal in the reveal phase of another round
+	err = suite.feedsKeeper.RevealValidatorSignalPrices(nextRoundRevealCtx, ValidValidator, salt, signalPrices)
+	suite.Require().ErrorIs(err, types.ErrCommitmentNotFound)
+
+	// reveal signal prices not matching the commitment
+	mismatchedSignalPrices := []types.SignalPrice{
+		types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1001),
+	}
+	err = suite.feedsKeeper.RevealValidatorSignalPrices(revealCtx, ValidValidator, salt, mismatchedSignalPrices)
+	suite.Require().ErrorIs(err, types.ErrCommitmentMismatch)
+
+	// reveal matching signal prices
+	err = suite.feedsKeeper.RevealValidatorSignalPrices(revealCtx, ValidValidator, salt, signalPrices)
+	suite.Require().NoError(err)
+
+	valPrices, err := suite.feedsKeeper.GetValidatorPriceList(revealCtx, ValidValidator)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ValidatorPrice{
+		types.NewValidatorPrice(signalPrices[0], revealCtx.BlockTime().Unix(), revealCtx.BlockHeight()),
+	}, valPrices.ValidatorPrices)
+
+	// the commitment can only be revealed once
+	_, err = suite.feedsKeeper.GetCommitment(revealCtx, ValidValidator)
+	suite.Require().ErrorIs(err, types.ErrCommitmentNotFound)
+}
@@ -117,6 +117,78 @@ func (k Keeper) SetValidatorPriceList(
 	return nil
 }
 
+// SubmitValidatorSignalPrices sets the signal prices of a validator at the current block. A signal price
+// updated sooner than the cooldown time after the previous one is rejected, or skipped if skipTooEarly is true.
+func (k Keeper) SubmitValidatorSignalPrices(
+	ctx sdk.Context,
+	val sdk.ValAddress,
+	signalPrices []types.SignalPrice,
+	skipTooEarly bool,
+) error {
+	blockTime := ctx.BlockTime().Unix()
+	blockHeight := ctx.BlockHeight()
+
+	params := k.GetParams(ctx)
+	currentFeeds := k.GetCurrentFeeds(ctx)
+
+	// check if the number of signal prices exceeds the length of current feeds
+	if len(signalPrices) > len(currentFeeds.Feeds) {
+		return types.ErrSignalPricesTooLarge
+	}
+
+	// check if the validator is required to send prices
+	if err := k.ValidateValidatorRequiredToSend(ctx, val); err != nil {
+		return err
+	}
+
+	// create current feed map from current feeds to map signal id to index of current feeds
+	currentFeedsMap := make(map[string]int)
+	for idx, feed := range currentFeeds.Feeds {
+		currentFeedsMap[feed.SignalID] = idx
+	}
+
+	newValidatorPrices := make([]types.ValidatorPrice, len(currentFeedsMap))
+	// fill new validator latest price with latest submitted price
+	prevValPrices, err := k.GetValidatorPriceList(ctx, val)
+	if err == nil {
+		for _, p := range prevValPrices.ValidatorPrices {
+			idx, ok := currentFeedsMap[p.SignalID]
+			// only update if this signal in current feed
+			if ok {
+				newValidatorPrices[idx] = p
+			}
+		}
+	}
+
+	cooldownTime := params.CooldownTime
+	for _, signalPrice := range signalPrices {
+		// revert if send signal price that not in current feed
+		idx, ok := currentFeedsMap[signalPrice.SignalID]
+		if !ok {
+			return types.ErrSignalIDNotSupported.Wrapf(
+				"signal_id: %s",
+				signalPrice.SignalID,
+			)
+		}
+
+		// check if price have been set and update too fast
+		latestPrice := newValidatorPrices[idx]
+		if latestPrice.SignalPriceStatus != types.SIGNAL_PRICE_STATUS_UNSPECIFIED &&
+			blockTime < latestPrice.Timestamp+cooldownTime {
+			if skipTooEarly {
+				continue
+			}
+			return types.ErrPriceSubmitTooEarly
+		}
+
+		// update new validator price with the submitted price
+		newValidatorPrices[idx] = types.NewValidatorPrice(signalPrice, blockTime, blockHeight)
+		emitEventSubmitSignalPrice(ctx, val, newValidatorPrices[idx])
+	}
+
+	return k.SetValidatorPriceList(ctx, val, newValidatorPrices)
+}
+
 // CalculatePrices calculates final prices for all supported feeds.
 func (k Keeper) CalculatePrices(ctx sdk.Context) error {
 	// get the current feeds
@@ -176,9 +248,16 @@ func (k Keeper) CalculatePrices(ctx sdk.Context) error {
 		return err
 	}
 	powerQuorum := totalBondedToken.Mul(priceQuorum).TruncateInt()
+
+	// accuracies of validators submitting prices in this block, updated in memory and stored at the end
+	accuracies := make(map[string]types.ValidatorAccuracy)
+
 	// calculate prices for each feed
 	for _, feed := range currentFeeds.Feeds {
 		var validatorPriceInfos []types.ValidatorPriceInfo
+		var submittedVals []sdk.ValAddress
+		var submittedPrices []uint64
+		var latestTimestamp int64
 		for _, valInfo := range validatorsByPower {
 			valPrice := allValidatorPrices[valInfo.Address.String()][feed.SignalID]
 
@@ -208,30 +287,72 @@ func (k Keeper) CalculatePrices(ctx sdk.Context) error {
 						valPrice.Timestamp,
 					),
 				)
+				latestTimestamp = max(latestTimestamp, valPrice.Timestamp)
+
+				// only the prices submitted in this block are evaluated for accuracy,
+				// so that each submission is evaluated once.
+				if valPrice.SignalPriceStatus == types.SIGNAL_PRICE_STATUS_AVAILABLE &&
+					valPrice.BlockHeight == ctx.BlockHeight() {
+					submittedVals = append(submittedVals, valInfo.Address)
+					submittedPrices = append(submittedPrices, valPrice.Price)
+				}
 			}
 		}
 
 		// calculate the final price for the feed
-		price, err := k.CalculatePrice(ctx, feed, validatorPriceInfos, powerQuorum)
+		price, err := k.CalculatePrice(ctx, feed, validatorPriceInfos, tbt, powerQuorum)
 		if err != nil {
 			return err
 		}
 
-		// set the calculated price in the store
+		// halt the price if it breaches the price guard of the signal id
+		price = k.ApplyPriceGuard(ctx, price, latestTimestamp)
+
+		// set the calculated price in the store and record it in the price history
 		k.SetPrice(ctx, price)
+		k.AddPriceHistory(ctx, price, params.PriceHistoryRetention)
 
 		// emit event for updated price
 		emitEventUpdatePrice(ctx, price)
+
+		// evaluate the submitted prices against the calculated price
+		if price.Status != types.PRICE_STATUS_AVAILABLE ||
+			params.OutlierThresholdBasisPoint == 0 ||
+			params.AccuracyWindow == 0 {
+			continue
+		}
+
+		for i, val := range submittedVals {
+			accuracy, ok := accuracies[val.String()]
+			if !ok {
+				accuracy = k.GetValidatorAccuracy(ctx, val)
+			}
+
+			deviation := types.CalculatePriceDeviationBasisPoint(submittedPrices[i], price.Price)
+			accuracies[val.String()] = accuracy.AddEvaluation(
+				deviation,
+				deviation > params.OutlierThresholdBasisPoint,
+			)
+		}
+	}
+
+	// store the updated accuracies in the order of validators by power
+	for _, valInfo := range validatorsByPower {
+		if accuracy, ok := accuracies[valInfo.Address.String()]; ok {
+			k.SetValidatorAccuracy(ctx, valInfo.Address, accuracy)
+		}
 	}
 
 	return nil
 }
 
-// CalculatePrice calculates the final price from validator prices and punishes validators who did not report.
+// CalculatePrice calculates the final price from validator prices and attaches the confidence data
+// of the validator prices to an available price.
 func (k Keeper) CalculatePrice(
 	ctx sdk.Context,
 	feed types.Feed,
 	validatorPriceInfos []types.ValidatorPriceInfo,
+	totalBondedPower sdkmath.Int,
 	powerQuorum sdkmath.Int,
 ) (types.Price, error) {
 	totalPower, availablePower, _, unsupportedPower := types.CalculatePricesPowers(validatorPriceInfos)
@@ -264,12 +385,16 @@ func (k Keeper) CalculatePrice(
 		return types.Price{}, err
 	}
 
-	return types.NewPrice(
+	finalPrice := types.NewPrice(
 		types.PRICE_STATUS_AVAILABLE,
 		feed.SignalID,
 		price,
 		ctx.BlockTime().Unix(),
-	), nil
+	)
+	finalPrice.InterquartileRange, finalPrice.ParticipationBasisPoint, finalPrice.ReporterCount =
+		types.CalculatePriceConfidence(validatorPriceInfos, totalBondedPower)
+
+	return finalPrice, nil
 }
 
 // CheckMissReport checks if a validator has missed a report based on the given parameters.
@@ -284,6 +409,29 @@ func CheckMissReport(
 	blockHeight int64,
 	gracePeriod int64,
 ) bool {
+	deadlineTime, deadlineBlock := CalculateReportDeadline(
+		feed,
+		lastUpdateTimestamp,
+		lastUpdateBlock,
+		valPrice,
+		valInfo,
+		gracePeriod,
+	)
+
+	// Determine if the validator has missed the report based on the deadline time and block height.
+	return deadlineTime < blockTime.Unix() && deadlineBlock < blockHeight
+}
+
+// CalculateReportDeadline calculates the deadline time and block height for a validator to report a price of
+// the feed. The validator misses the report once both deadlines have passed.
+func CalculateReportDeadline(
+	feed types.Feed,
+	lastUpdateTimestamp int64,
+	lastUpdateBlock int64,
+	valPrice types.ValidatorPrice,
+	valInfo types.ValidatorInfo,
+	gracePeriod int64,
+) (int64, int64) {
 	// Calculate the deadline time and block height for the validator to report.
 	// During the grace period, if the block time exceeds ExpectedBlockTime, it will be capped at ExpectedBlockTime.
 	// This prevents validator deactivation due to slower block times, as long as the block height remains within the threshold.
@@ -308,8 +456,7 @@ func CheckMissReport(
 		}
 	}
 
-	// Determine if the validator has missed the report based on the deadline time and block height.
-	return deadlineTime < blockTime.Unix() && deadlineBlock < blockHeight
+	return deadlineTime, deadlineBlock
 }
 
 // checkHavePrice checks if a validator has a price feed within interval range.
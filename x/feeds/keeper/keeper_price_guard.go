@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	dbm "github.com/cosmos/cosmos-db"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// GetPriceGuardsIterator returns an iterator for price guards store.
+func (k Keeper) GetPriceGuardsIterator(ctx sdk.Context) dbm.Iterator {
+	return storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PriceGuardStoreKeyPrefix)
+}
+
+// GetPriceGuards returns a list of all price guards.
+func (k Keeper) GetPriceGuards(ctx sdk.Context) (priceGuards []types.PriceGuard) {
+	iterator := k.GetPriceGuardsIterator(ctx)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var priceGuard types.PriceGuard
+		k.cdc.MustUnmarshal(iterator.Value(), &priceGuard)
+		priceGuards = append(priceGuards, priceGuard)
+	}
+
+	return
+}
+
+// GetPriceGuard returns the price guard of a signal id.
+func (k Keeper) GetPriceGuard(ctx sdk.Context, signalID string) (types.PriceGuard, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.PriceGuardStoreKey(signalID))
+	if bz == nil {
+		return types.PriceGuard{}, types.ErrPriceGuardNotFound.Wrapf(
+			"failed to get price guard for signal id: %s",
+			signalID,
+		)
+	}
+
+	var priceGuard types.PriceGuard
+	k.cdc.MustUnmarshal(bz, &priceGuard)
+
+	return priceGuard, nil
+}
+
+// SetPriceGuards sets multiple price guards.
+func (k Keeper) SetPriceGuards(ctx sdk.Context, priceGuards []types.PriceGuard) {
+	for _, priceGuard := range priceGuards {
+		k.SetPriceGuard(ctx, priceGuard)
+	}
+}
+
+// SetPriceGuard sets the price guard of a signal id and resets its last accepted price, so that a halted
+// price can be resumed by setting the price guard again. A price guard with all limits disabled removes
+// the price guard of the signal id.
+func (k Keeper) SetPriceGuard(ctx sdk.Context, priceGuard types.PriceGuard) {
+	if priceGuard.IsDisabled() {
+		k.DeletePriceGuard(ctx, priceGuard.SignalID)
+		return
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.PriceGuardStoreKey(priceGuard.SignalID), k.cdc.MustMarshal(&priceGuard))
+	ctx.KVStore(k.storeKey).Delete(types.LastAcceptedPriceStoreKey(priceGuard.SignalID))
+}
+
+// DeletePriceGuard deletes the price guard and the last accepted price of a signal id.
+func (k Keeper) DeletePriceGuard(ctx sdk.Context, signalID string) {
+	ctx.KVStore(k.storeKey).Delete(types.PriceGuardStoreKey(signalID))
+	ctx.KVStore(k.storeKey).Delete(types.LastAcceptedPriceStoreKey(signalID))
+}
+
+// GetLastAcceptedPrice returns the last price of a signal id accepted by its price guard.
+func (k Keeper) GetLastAcceptedPrice(ctx sdk.Context, signalID string) (types.Price, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.LastAcceptedPriceStoreKey(signalID))
+	if bz == nil {
+		return types.Price{}, false
+	}
+
+	var price types.Price
+	k.cdc.MustUnmarshal(bz, &price)
+
+	return price, true
+}
+
+// SetLastAcceptedPrice sets the last price of a signal id accepted by its price guard.
+func (k Keeper) SetLastAcceptedPrice(ctx sdk.Context, price types.Price) {
+	ctx.KVStore(k.storeKey).Set(types.LastAcceptedPriceStoreKey(price.SignalID), k.cdc.MustMarshal(&price))
+}
+
+// ApplyPriceGuard checks an available price against the price guard of its signal id. If the price
+// breaches the price guard, a price with halted status that keeps the last accepted price is returned
+// and an event is emitted. Otherwise, the price is recorded as the last accepted price and returned as is.
+func (k Keeper) ApplyPriceGuard(ctx sdk.Context, price types.Price, latestTimestamp int64) types.Price {
+	if price.Status != types.PRICE_STATUS_AVAILABLE {
+		return price
+	}
+
+	priceGuard, err := k.GetPriceGuard(ctx, price.SignalID)
+	if err != nil {
+		return price
+	}
+
+	lastAcceptedPrice, found := k.GetLastAcceptedPrice(ctx, price.SignalID)
+	reason, breached := priceGuard.CheckPrice(
+		price.Price,
+		lastAcceptedPrice.Price,
+		latestTimestamp,
+		ctx.BlockTime().Unix(),
+	)
+	if !breached {
+		k.SetLastAcceptedPrice(ctx, price)
+		return price
+	}
+
+	emitEventHaltPrice(ctx, price, reason)
+
+	if !found {
+		return types.NewPrice(types.PRICE_STATUS_HALTED, price.SignalID, 0, price.Timestamp)
+	}
+
+	return types.NewPrice(
+		types.PRICE_STATUS_HALTED,
+		price.SignalID,
+		lastAcceptedPrice.Price,
+		lastAcceptedPrice.Timestamp,
+	)
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func (suite *KeeperTestSuite) TestGetSetDeletePriceGuard() {
+	ctx := suite.ctx
+
+	// not found
+	_, err := suite.feedsKeeper.GetPriceGuard(ctx, "CS:BAND-USD")
+	suite.Require().ErrorIs(err, types.ErrPriceGuardNotFound)
+
+	// set
+	priceGuard := types.NewPriceGuard("CS:BAND-USD", 2000, 0, 0, 0)
+	suite.feedsKeeper.SetPriceGuard(ctx, priceGuard)
+
+	// get
+	pg, err := suite.feedsKeeper.GetPriceGuard(ctx, "CS:BAND-USD")
+	suite.Require().NoError(err)
+	suite.Require().Equal(priceGuard, pg)
+
+	// setting a price guard with all limits disabled removes it
+	suite.feedsKeeper.SetPriceGuard(ctx, types.NewPriceGuard("CS:BAND-USD", 0, 0, 0, 0))
+	_, err = suite.feedsKeeper.GetPriceGuard(ctx, "CS:BAND-USD")
+	suite.Require().ErrorIs(err, types.ErrPriceGuardNotFound)
+	suite.Require().Empty(suite.feedsKeeper.GetPriceGuards(ctx))
+}
+
+func (suite *KeeperTestSuite) TestApplyPriceGuard() {
+	ctx := suite.ctx
+	now := ctx.BlockTime().Unix()
+
+	// no price guard
+	price := types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 10000, now)
+	suite.Require().Equal(price, suite.feedsKeeper.ApplyPriceGuard(ctx, price, now))
+
+	suite.feedsKeeper.SetPriceGuard(ctx, types.NewPriceGuard("CS:BAND-USD", 2000, 0, 0, 60))
+
+	// accepted price is recorded
+	suite.Require().Equal(price, suite.feedsKeeper.ApplyPriceGuard(ctx, price, now))
+	lastAcceptedPrice, found := suite.feedsKeeper.GetLastAcceptedPrice(ctx, "CS:BAND-USD")
+	suite.Require().True(found)
+	suite.Require().Equal(price, lastAcceptedPrice)
+
+	// a price that moves too much is halted and keeps the last accepted price
+	jumped := types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 18000, now+1)
+	suite.Require().Equal(
+		types.NewPrice(types.PRICE_STATUS_HALTED, "CS:BAND-USD", 10000, now),
+		suite.feedsKeeper.ApplyPriceGuard(ctx, jumped, now+1),
+	)
+	lastAcceptedPrice, _ = suite.feedsKeeper.GetLastAcceptedPrice(ctx, "CS:BAND-USD")
+	suite.Require().Equal(price, lastAcceptedPrice)
+
+	events := ctx.EventManager().Events()
+	suite.Require().Equal(types.EventTypeHaltPrice, events[len(events)-1].Type)
+
+	// a price calculated from stale validator prices is halted
+	stale := types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 10000, now)
+	suite.Require().Equal(
+		types.PRICE_STATUS_HALTED,
+		suite.feedsKeeper.ApplyPriceGuard(ctx, stale, now-61).Status,
+	)
+
+	// a price that is not available is not checked
+	notReady := types.NewPrice(types.PRICE_STATUS_NOT_READY, "CS:BAND-USD", 0, now)
+	suite.Require().Equal(notReady, suite.feedsKeeper.ApplyPriceGuard(ctx, notReady, now))
+
+	// setting the price guard again resets the last accepted price
+	suite.feedsKeeper.SetPriceGuard(ctx, types.NewPriceGuard("CS:BAND-USD", 2000, 0, 0, 60))
+	suite.Require().Equal(jumped, suite.feedsKeeper.ApplyPriceGuard(ctx, jumped, now+1))
+}
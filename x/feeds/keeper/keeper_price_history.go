@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"bytes"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// GetPriceHistoryIterator returns an iterator for the price history store of a signal id.
+func (k Keeper) GetPriceHistoryIterator(ctx sdk.Context, signalID string) dbm.Iterator {
+	return storetypes.KVStorePrefixIterator(
+		ctx.KVStore(k.storeKey),
+		types.PriceHistoryBySignalIDStoreKey(signalID),
+	)
+}
+
+// GetPriceHistory returns the price history of a signal id ordered by timestamp.
+func (k Keeper) GetPriceHistory(ctx sdk.Context, signalID string) (prices []types.Price) {
+	iterator := k.GetPriceHistoryIterator(ctx, signalID)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.Price
+		k.cdc.MustUnmarshal(iterator.Value(), &price)
+		prices = append(prices, price)
+	}
+
+	return
+}
+
+// GetPriceHistoryAtOrAfter returns the earliest historical price of a signal id with a timestamp at or after
+// the given timestamp. It returns false if there is no such price in the price history.
+func (k Keeper) GetPriceHistoryAtOrAfter(ctx sdk.Context, signalID string, timestamp int64) (types.Price, bool) {
+	iterator := ctx.KVStore(k.storeKey).Iterator(
+		types.PriceHistoryStoreKey(signalID, timestamp),
+		storetypes.PrefixEndBytes(types.PriceHistoryBySignalIDStoreKey(signalID)),
+	)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.Price{}, false
+	}
+
+	var price types.Price
+	k.cdc.MustUnmarshal(iterator.Value(), &price)
+
+	return price, true
+}
+
+// GetPriceHistoryCount returns the number of prices in the price history of a signal id.
+func (k Keeper) GetPriceHistoryCount(ctx sdk.Context, signalID string) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.PriceHistoryCountStoreKey(signalID))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetPriceHistoryCount sets the number of prices in the price history of a signal id.
+func (k Keeper) SetPriceHistoryCount(ctx sdk.Context, signalID string, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if count == 0 {
+		store.Delete(types.PriceHistoryCountStoreKey(signalID))
+		return
+	}
+
+	store.Set(types.PriceHistoryCountStoreKey(signalID), sdk.Uint64ToBigEndian(count))
+}
+
+// AddPriceHistory records a price in the price history of its signal id and drops the oldest prices
+// so that at most retention prices are kept.
+func (k Keeper) AddPriceHistory(ctx sdk.Context, price types.Price, retention uint64) {
+	store := ctx.KVStore(k.storeKey)
+	count := k.GetPriceHistoryCount(ctx, price.SignalID)
+
+	if retention > 0 {
+		key := types.PriceHistoryStoreKey(price.SignalID, price.Timestamp)
+		if !store.Has(key) {
+			count++
+		}
+		store.Set(key, k.cdc.MustMarshal(&price))
+	}
+
+	if count > retention {
+		count -= k.deleteOldestPriceHistory(ctx, price.SignalID, count-retention)
+	}
+
+	k.SetPriceHistoryCount(ctx, price.SignalID, count)
+}
+
+// deleteOldestPriceHistory deletes at most n of the oldest prices in the price history of a signal id
+// and returns the number of deleted prices.
+func (k Keeper) deleteOldestPriceHistory(ctx sdk.Context, signalID string, n uint64) uint64 {
+	iterator := k.GetPriceHistoryIterator(ctx, signalID)
+	keys := make([][]byte, 0, n)
+	for ; iterator.Valid() && uint64(len(keys)) < n; iterator.Next() {
+		keys = append(keys, bytes.Clone(iterator.Key()))
+	}
+	iterator.Close()
+
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return uint64(len(keys))
+}
+
+// GetTWAP returns the time-weighted average price of a signal id over the window (in seconds) ending at
+// the current block time. Each historical price is weighted by the time until the next price; prices
+// that are not available are left out. If there is no available price in the window, a price with
+// not ready status is returned.
+func (k Keeper) GetTWAP(ctx sdk.Context, signalID string, window int64) types.Price {
+	now := ctx.BlockTime().Unix()
+	start := now - window
+
+	iterator := storetypes.KVStoreReversePrefixIterator(
+		ctx.KVStore(k.storeKey),
+		types.PriceHistoryBySignalIDStoreKey(signalID),
+	)
+	defer iterator.Close()
+
+	sum, totalWeight := sdkmath.ZeroInt(), int64(0)
+	end := now
+	for ; iterator.Valid() && end > start; iterator.Next() {
+		var price types.Price
+		k.cdc.MustUnmarshal(iterator.Value(), &price)
+
+		segmentStart := max(price.Timestamp, start)
+		weight := end - segmentStart
+		if price.Status == types.PRICE_STATUS_AVAILABLE && weight > 0 {
+			sum = sum.Add(sdkmath.NewIntFromUint64(price.Price).MulRaw(weight))
+			totalWeight += weight
+		}
+		end = min(end, segmentStart)
+	}
+
+	if totalWeight == 0 {
+		return types.NewPrice(types.PRICE_STATUS_NOT_READY, signalID, 0, now)
+	}
+
+	return types.NewPrice(
+		types.PRICE_STATUS_AVAILABLE,
+		signalID,
+		sum.QuoRaw(totalWeight).Uint64(),
+		now,
+	)
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func (suite *KeeperTestSuite) TestAddPriceHistory() {
+	ctx := suite.ctx
+	now := ctx.BlockTime().Unix()
+
+	var prices []types.Price
+	for i := int64(0); i < 5; i++ {
+		price := types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", uint64(100+i), now+i)
+		suite.feedsKeeper.AddPriceHistory(ctx, price, 3)
+		prices = append(prices, price)
+	}
+
+	// only the latest 3 prices are kept
+	suite.Require().Equal(prices[2:], suite.feedsKeeper.GetPriceHistory(ctx, "CS:BAND-USD"))
+	suite.Require().Equal(uint64(3), suite.feedsKeeper.GetPriceHistoryCount(ctx, "CS:BAND-USD"))
+	suite.Require().Empty(suite.feedsKeeper.GetPriceHistory(ctx, "CS:BAND"))
+
+	// recording a price at an existing timestamp replaces it without dropping other prices
+	price := types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 150, now+4)
+	suite.feedsKeeper.AddPriceHistory(ctx, price, 3)
+	suite.Require().Equal(
+		[]types.Price{prices[2], prices[3], price},
+		suite.feedsKeeper.GetPriceHistory(ctx, "CS:BAND-USD"),
+	)
+	suite.Require().Equal(uint64(3), suite.feedsKeeper.GetPriceHistoryCount(ctx, "CS:BAND-USD"))
+
+	// lowering the retention drops the extra prices
+	price = types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 200, now+5)
+	suite.feedsKeeper.AddPriceHistory(ctx, price, 1)
+	suite.Require().Equal([]types.Price{price}, suite.feedsKeeper.GetPriceHistory(ctx, "CS:BAND-USD"))
+	suite.Require().Equal(uint64(1), suite.feedsKeeper.GetPriceHistoryCount(ctx, "CS:BAND-USD"))
+
+	// zero retention disables the history
+	price = types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 300, now+6)
+	suite.feedsKeeper.AddPriceHistory(ctx, price, 0)
+	suite.Require().Empty(suite.feedsKeeper.GetPriceHistory(ctx, "CS:BAND-USD"))
+	suite.Require().Equal(uint64(0), suite.feedsKeeper.GetPriceHistoryCount(ctx, "CS:BAND-USD"))
+}
+
+func (suite *KeeperTestSuite) TestGetPriceHistoryAtOrAfter() {
+	ctx := suite.ctx
+	now := ctx.BlockTime().Unix()
+
+	prices := []types.Price{
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, now-20),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 2000, now-10),
+	}
+	for _, price := range prices {
+		suite.feedsKeeper.AddPriceHistory(ctx, price, 10)
+	}
+	suite.feedsKeeper.AddPriceHistory(
+		ctx,
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BTC-USD", 3000, now),
+		10,
+	)
+
+	price, found := suite.feedsKeeper.GetPriceHistoryAtOrAfter(ctx, "CS:BAND-USD", now-30)
+	suite.Require().True(found)
+	suite.Require().Equal(prices[0], price)
+
+	price, found = suite.feedsKeeper.GetPriceHistoryAtOrAfter(ctx, "CS:BAND-USD", now-20)
+	suite.Require().True(found)
+	suite.Require().Equal(prices[0], price)
+
+	price, found = suite.feedsKeeper.GetPriceHistoryAtOrAfter(ctx, "CS:BAND-USD", now-15)
+	suite.Require().True(found)
+	suite.Require().Equal(prices[1], price)
+
+	// no price of the signal id at or after the timestamp
+	_, found = suite.feedsKeeper.GetPriceHistoryAtOrAfter(ctx, "CS:BAND-USD", now-5)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestGetTWAP() {
+	ctx := suite.ctx
+	now := ctx.BlockTime().Unix()
+
+	// no history
+	suite.Require().Equal(
+		types.NewPrice(types.PRICE_STATUS_NOT_READY, "CS:BAND-USD", 0, now),
+		suite.feedsKeeper.GetTWAP(ctx, "CS:BAND-USD", 60),
+	)
+
+	for _, price := range []types.Price{
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, now-100),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 2000, now-40),
+		types.NewPrice(types.PRICE_STATUS_NOT_READY, "CS:BAND-USD", 0, now-30),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 4000, now-20),
+	} {
+		suite.feedsKeeper.AddPriceHistory(ctx, price, 10)
+	}
+
+	// window [now-60, now]: 1000 for 20s, 2000 for 10s, not ready for 10s, 4000 for 20s
+	suite.Require().Equal(
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 2400, now),
+		suite.feedsKeeper.GetTWAP(ctx, "CS:BAND-USD", 60),
+	)
+
+	// window [now-10, now]: 4000 for 10s
+	suite.Require().Equal(
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 4000, now),
+		suite.feedsKeeper.GetTWAP(ctx, "CS:BAND-USD", 10),
+	)
+}
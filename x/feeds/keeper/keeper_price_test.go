@@ -106,14 +106,52 @@ func (suite *KeeperTestSuite) TestGetSetValidatorPriceList() {
 	suite.Require().Equal(expValPrices, valPrices.ValidatorPrices)
 }
 
+func (suite *KeeperTestSuite) TestSubmitValidatorSignalPrices() {
+	ctx := suite.ctx
+	suite.feedsKeeper.SetCurrentFeeds(ctx, []types.Feed{
+		{SignalID: "CS:BAND-USD", Interval: 100},
+		{SignalID: "CS:ETH-USD", Interval: 100},
+	})
+
+	signalPrices := []types.SignalPrice{
+		types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1e10),
+	}
+	err := suite.feedsKeeper.SubmitValidatorSignalPrices(ctx, ValidValidator, signalPrices, false)
+	suite.Require().NoError(err)
+
+	// not required to send prices
+	err = suite.feedsKeeper.SubmitValidatorSignalPrices(ctx, InvalidValidator, signalPrices, false)
+	suite.Require().Error(err)
+
+	// the signal is still in the cooldown time
+	newSignalPrices := []types.SignalPrice{
+		types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 2e10),
+		types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "CS:ETH-USD", 3e10),
+	}
+	err = suite.feedsKeeper.SubmitValidatorSignalPrices(ctx, ValidValidator, newSignalPrices, false)
+	suite.Require().ErrorIs(err, types.ErrPriceSubmitTooEarly)
+
+	// skip the signal in the cooldown time and set the others
+	err = suite.feedsKeeper.SubmitValidatorSignalPrices(ctx, ValidValidator, newSignalPrices, true)
+	suite.Require().NoError(err)
+
+	valPrices, err := suite.feedsKeeper.GetValidatorPriceList(ctx, ValidValidator)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ValidatorPrice{
+		types.NewValidatorPrice(signalPrices[0], ctx.BlockTime().Unix(), ctx.BlockHeight()),
+		types.NewValidatorPrice(newSignalPrices[1], ctx.BlockTime().Unix(), ctx.BlockHeight()),
+	}, valPrices.ValidatorPrices)
+}
+
 func (suite *KeeperTestSuite) TestCalculatePrices() {
 	ctx := suite.ctx
 
 	tests := []struct {
-		name           string
-		setup          func()
-		expectError    bool
-		expectedPrices []types.Price
+		name               string
+		setup              func()
+		expectError        bool
+		expectedPrices     []types.Price
+		expectedAccuracies []types.ValidatorAccuracy
 	}{
 		{
 			name: "normal case with valid prices",
@@ -169,12 +207,19 @@ func (suite *KeeperTestSuite) TestCalculatePrices() {
 			expectError: false,
 			expectedPrices: []types.Price{
 				{
-					Status:    types.PRICE_STATUS_AVAILABLE,
-					SignalID:  "CS:BAND-USD",
-					Price:     1000,
-					Timestamp: ctx.BlockTime().Unix(),
+					Status:                  types.PRICE_STATUS_AVAILABLE,
+					SignalID:                "CS:BAND-USD",
+					Price:                   1000,
+					Timestamp:               ctx.BlockTime().Unix(),
+					InterquartileRange:      1000,
+					ParticipationBasisPoint: 7272,
+					ReporterCount:           2,
 				},
 			},
+			expectedAccuracies: []types.ValidatorAccuracy{
+				types.NewValidatorAccuracy(ValidValidator, 1, 0, 0),
+				types.NewValidatorAccuracy(ValidValidator2, 1, 1, 10000),
+			},
 		},
 		{
 			name: "error fetching total bonded tokens",
@@ -219,6 +264,11 @@ func (suite *KeeperTestSuite) TestCalculatePrices() {
 					price := suite.feedsKeeper.GetPrice(ctx, expectedPrice.SignalID)
 					suite.Require().Equal(expectedPrice, price)
 				}
+				for _, expectedAccuracy := range tt.expectedAccuracies {
+					val, err := sdk.ValAddressFromBech32(expectedAccuracy.Validator)
+					suite.Require().NoError(err)
+					suite.Require().Equal(expectedAccuracy, suite.feedsKeeper.GetValidatorAccuracy(ctx, val))
+				}
 			}
 		})
 	}
@@ -326,10 +376,13 @@ func (suite *KeeperTestSuite) TestCalculatePrice() {
 			},
 			powerQuorum: sdkmath.NewInt(7000),
 			expectedPrice: types.Price{
-				Status:    types.PRICE_STATUS_AVAILABLE,
-				SignalID:  "CS:BAND-USD",
-				Price:     1000,
-				Timestamp: ctx.BlockTime().Unix(),
+				Status:                  types.PRICE_STATUS_AVAILABLE,
+				SignalID:                "CS:BAND-USD",
+				Price:                   1000,
+				Timestamp:               ctx.BlockTime().Unix(),
+				InterquartileRange:      1000,
+				ParticipationBasisPoint: 5500,
+				ReporterCount:           3,
 			},
 			expectError: false,
 		},
@@ -349,7 +402,13 @@ func (suite *KeeperTestSuite) TestCalculatePrice() {
 
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
-			price, err := suite.feedsKeeper.CalculatePrice(ctx, feed, tt.validatorPriceInfos, tt.powerQuorum)
+			price, err := suite.feedsKeeper.CalculatePrice(
+				ctx,
+				feed,
+				tt.validatorPriceInfos,
+				sdkmath.NewInt(20000),
+				tt.powerQuorum,
+			)
 			if tt.expectError {
 				suite.Require().Error(err)
 			} else {
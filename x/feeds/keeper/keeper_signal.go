@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"sort"
+
 	dbm "github.com/cosmos/cosmos-db"
 
 	"cosmossdk.io/math"
@@ -149,23 +151,37 @@ func (k Keeper) SignalTotalPowersByPowerStoreIterator(ctx sdk.Context) dbm.Itera
 	)
 }
 
-// LockVoterPower locks the voter's power equal to the sum of the signal powers.
+// LockVoterPower locks the voter's power equal to the sum of the signal powers and the power delegated by the voter.
 // It returns an error if the voter does not have enough power to lock.
 func (k Keeper) LockVoterPower(
 	ctx sdk.Context,
 	voter sdk.AccAddress,
 	signals []types.Signal,
 ) error {
-	sumPower := types.SumPower(signals)
-	if err := k.restakeKeeper.SetLockedPower(ctx, voter, types.ModuleName, math.NewInt(sumPower)); err != nil {
-		return err
+	var delegatedPower int64
+	if delegation, err := k.GetSignalVoteDelegation(ctx, voter); err == nil {
+		delegatedPower = delegation.Power
 	}
 
-	return nil
+	return k.lockPower(ctx, voter, signals, delegatedPower)
+}
+
+// LockDelegatorPower locks the delegator's power equal to the sum of its signal powers
+// and the given power to be delegated.
+func (k Keeper) LockDelegatorPower(ctx sdk.Context, delegator sdk.AccAddress, delegatedPower int64) error {
+	return k.lockPower(ctx, delegator, k.GetVote(ctx, delegator), delegatedPower)
+}
+
+// lockPower locks the power of the address equal to the sum of the signal powers and the delegated power.
+func (k Keeper) lockPower(ctx sdk.Context, addr sdk.AccAddress, signals []types.Signal, delegatedPower int64) error {
+	sumPower := types.SumPower(signals) + delegatedPower
+
+	return k.restakeKeeper.SetLockedPower(ctx, addr, types.ModuleName, math.NewInt(sumPower))
 }
 
 // UpdateVoteAndReturnPowerDiff delete previous signals and add new signals.
-// It also calculates feed power differences from voter's previous signals and new signals.
+// It also calculates feed power differences from voter's previous signals and new signals,
+// including the power delegated to the voter.
 func (k Keeper) UpdateVoteAndReturnPowerDiff(
 	ctx sdk.Context,
 	voter sdk.AccAddress,
@@ -173,7 +189,7 @@ func (k Keeper) UpdateVoteAndReturnPowerDiff(
 ) map[string]int64 {
 	signalIDToPowerDiff := make(map[string]int64)
 
-	prevSignals := k.GetVote(ctx, voter)
+	prevSignals := k.GetWeightedSignals(ctx, voter)
 	k.DeleteVote(ctx, voter)
 
 	for _, prevSignal := range prevSignals {
@@ -182,9 +198,49 @@ func (k Keeper) UpdateVoteAndReturnPowerDiff(
 
 	k.SetVote(ctx, types.NewVote(voter.String(), signals))
 
-	for _, signal := range signals {
+	for _, signal := range k.GetWeightedSignals(ctx, voter) {
 		signalIDToPowerDiff[signal.ID] += signal.Power
 	}
 
 	return signalIDToPowerDiff
 }
+
+// UpdateSignalTotalPowers adds the power differences to the signal-total-powers.
+// It returns an error if the total power of a signal becomes negative.
+func (k Keeper) UpdateSignalTotalPowers(ctx sdk.Context, signalIDToPowerDiff map[string]int64) error {
+	// sort keys to guarantee order of signalIDToPowerDiff iteration
+	keys := make([]string, 0, len(signalIDToPowerDiff))
+	for k := range signalIDToPowerDiff {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	// iterate over each signal ID, updating the total power and ensuring no negative power values
+	for _, signalID := range keys {
+		// get the power difference for the current signal ID
+		powerDiff := signalIDToPowerDiff[signalID]
+
+		// retrieve the total power of the current signal ID from the store
+		signalTotalPower, err := k.GetSignalTotalPower(ctx, signalID)
+		if err != nil {
+			// initialize a new signal with zero power if the signal ID does not exist
+			signalTotalPower = types.NewSignal(
+				signalID,
+				0,
+			)
+		}
+
+		// update the total power of the signal by adding the power difference
+		signalTotalPower.Power += powerDiff
+
+		// ensure the total power of the signal is not negative
+		if signalTotalPower.Power < 0 {
+			return types.ErrPowerNegative
+		}
+
+		// save the updated signal total power back to the store
+		k.SetSignalTotalPower(ctx, signalTotalPower)
+	}
+
+	return nil
+}
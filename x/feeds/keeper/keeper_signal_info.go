@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	dbm "github.com/cosmos/cosmos-db"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// GetSignalInfosIterator returns an iterator for signal infos store.
+func (k Keeper) GetSignalInfosIterator(ctx sdk.Context) dbm.Iterator {
+	return storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.SignalInfoStoreKeyPrefix)
+}
+
+// GetSignalInfos returns a list of all registered signal infos.
+func (k Keeper) GetSignalInfos(ctx sdk.Context) (signalInfos []types.SignalInfo) {
+	iterator := k.GetSignalInfosIterator(ctx)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var signalInfo types.SignalInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &signalInfo)
+		signalInfos = append(signalInfos, signalInfo)
+	}
+
+	return
+}
+
+// GetSignalInfo returns the registered metadata of a signal id.
+func (k Keeper) GetSignalInfo(ctx sdk.Context, signalID string) (types.SignalInfo, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.SignalInfoStoreKey(signalID))
+	if bz == nil {
+		return types.SignalInfo{}, types.ErrSignalInfoNotFound.Wrapf(
+			"failed to get signal info for signal id: %s",
+			signalID,
+		)
+	}
+
+	var signalInfo types.SignalInfo
+	k.cdc.MustUnmarshal(bz, &signalInfo)
+
+	return signalInfo, nil
+}
+
+// SetSignalInfos sets multiple signal infos.
+func (k Keeper) SetSignalInfos(ctx sdk.Context, signalInfos []types.SignalInfo) {
+	for _, signalInfo := range signalInfos {
+		k.SetSignalInfo(ctx, signalInfo)
+	}
+}
+
+// SetSignalInfo registers or updates the metadata of a signal id.
+func (k Keeper) SetSignalInfo(ctx sdk.Context, signalInfo types.SignalInfo) {
+	ctx.KVStore(k.storeKey).Set(types.SignalInfoStoreKey(signalInfo.SignalID), k.cdc.MustMarshal(&signalInfo))
+}
+
+// ValidateSignalsRegistered checks that all signals are registered and not deprecated when votes are restricted
+// to registered signals.
+func (k Keeper) ValidateSignalsRegistered(ctx sdk.Context, signals []types.Signal) error {
+	if !k.GetParams(ctx).RegisteredSignalsOnly {
+		return nil
+	}
+
+	for _, signal := range signals {
+		signalInfo, err := k.GetSignalInfo(ctx, signal.ID)
+		if err != nil {
+			return types.ErrSignalNotRegistered.Wrapf("signal id: %s", signal.ID)
+		}
+
+		if signalInfo.Deprecated {
+			return types.ErrSignalNotRegistered.Wrapf("signal id %s is deprecated", signal.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func (suite *KeeperTestSuite) TestGetSetSignalInfo() {
+	ctx := suite.ctx
+
+	// not found
+	_, err := suite.feedsKeeper.GetSignalInfo(ctx, "CS:BAND-USD")
+	suite.Require().ErrorIs(err, types.ErrSignalInfoNotFound)
+
+	// set
+	signalInfo := types.NewSignalInfo("CS:BAND-USD", 9, "USD", types.ASSET_CLASS_CRYPTO, "Band price", "", false)
+	suite.feedsKeeper.SetSignalInfo(ctx, signalInfo)
+
+	// get
+	si, err := suite.feedsKeeper.GetSignalInfo(ctx, "CS:BAND-USD")
+	suite.Require().NoError(err)
+	suite.Require().Equal(signalInfo, si)
+
+	// update
+	signalInfo.Deprecated = true
+	suite.feedsKeeper.SetSignalInfo(ctx, signalInfo)
+	suite.Require().Equal([]types.SignalInfo{signalInfo}, suite.feedsKeeper.GetSignalInfos(ctx))
+}
+
+func (suite *KeeperTestSuite) TestValidateSignalsRegistered() {
+	ctx := suite.ctx
+	suite.feedsKeeper.SetSignalInfos(ctx, []types.SignalInfo{
+		types.NewSignalInfo("CS:BAND-USD", 9, "USD", types.ASSET_CLASS_CRYPTO, "", "", false),
+		types.NewSignalInfo("CS:LUNA-USD", 9, "USD", types.ASSET_CLASS_CRYPTO, "", "", true),
+	})
+
+	registered := []types.Signal{{ID: "CS:BAND-USD", Power: 1e9}}
+	unregistered := []types.Signal{{ID: "CS:BTC-USD", Power: 1e9}}
+	deprecated := []types.Signal{{ID: "CS:LUNA-USD", Power: 1e9}}
+
+	// not restricted by default
+	suite.Require().NoError(suite.feedsKeeper.ValidateSignalsRegistered(ctx, unregistered))
+
+	params := suite.feedsKeeper.GetParams(ctx)
+	params.RegisteredSignalsOnly = true
+	suite.Require().NoError(suite.feedsKeeper.SetParams(ctx, params))
+
+	suite.Require().NoError(suite.feedsKeeper.ValidateSignalsRegistered(ctx, registered))
+	suite.Require().ErrorIs(
+		suite.feedsKeeper.ValidateSignalsRegistered(ctx, unregistered),
+		types.ErrSignalNotRegistered,
+	)
+	suite.Require().ErrorIs(
+		suite.feedsKeeper.ValidateSignalsRegistered(ctx, deprecated),
+		types.ErrSignalNotRegistered,
+	)
+}
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	dbm "github.com/cosmos/cosmos-db"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// GetSignalVoteDelegationsIterator returns an iterator for signal vote delegations store.
+func (k Keeper) GetSignalVoteDelegationsIterator(ctx sdk.Context) dbm.Iterator {
+	return storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.SignalVoteDelegationStoreKeyPrefix)
+}
+
+// GetSignalVoteDelegations returns a list of all signal vote delegations.
+func (k Keeper) GetSignalVoteDelegations(ctx sdk.Context) (delegations []types.SignalVoteDelegation) {
+	iterator := k.GetSignalVoteDelegationsIterator(ctx)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.SignalVoteDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+
+	return
+}
+
+// GetSignalVoteDelegation returns the signal vote delegation of a delegator.
+func (k Keeper) GetSignalVoteDelegation(
+	ctx sdk.Context,
+	delegator sdk.AccAddress,
+) (types.SignalVoteDelegation, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.SignalVoteDelegationStoreKey(delegator))
+	if bz == nil {
+		return types.SignalVoteDelegation{}, types.ErrDelegationNotFound.Wrapf(
+			"failed to get signal vote delegation for delegator: %s",
+			delegator.String(),
+		)
+	}
+
+	var delegation types.SignalVoteDelegation
+	k.cdc.MustUnmarshal(bz, &delegation)
+
+	return delegation, nil
+}
+
+// SetSignalVoteDelegations sets multiple signal vote delegations and adds their powers to the delegated
+// powers of their curators.
+func (k Keeper) SetSignalVoteDelegations(ctx sdk.Context, delegations []types.SignalVoteDelegation) {
+	for _, delegation := range delegations {
+		k.SetSignalVoteDelegation(ctx, delegation)
+
+		curator := sdk.MustAccAddressFromBech32(delegation.Curator)
+		delegatedPower := k.GetCuratorDelegatedPower(ctx, curator)
+		delegatedPower.Power += delegation.Power
+		k.SetCuratorDelegatedPower(ctx, delegatedPower)
+	}
+}
+
+// SetSignalVoteDelegation sets the signal vote delegation of a delegator and indexes it by its curator.
+func (k Keeper) SetSignalVoteDelegation(ctx sdk.Context, delegation types.SignalVoteDelegation) {
+	delegator := sdk.MustAccAddressFromBech32(delegation.Delegator)
+	curator := sdk.MustAccAddressFromBech32(delegation.Curator)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SignalVoteDelegationStoreKey(delegator), k.cdc.MustMarshal(&delegation))
+	store.Set(types.SignalVoteDelegationByCuratorIndexKey(curator, delegator), delegator)
+}
+
+// DeleteSignalVoteDelegation deletes the signal vote delegation of a delegator.
+func (k Keeper) DeleteSignalVoteDelegation(ctx sdk.Context, delegator sdk.AccAddress) {
+	delegation, err := k.GetSignalVoteDelegation(ctx, delegator)
+	if err != nil {
+		return
+	}
+
+	curator := sdk.MustAccAddressFromBech32(delegation.Curator)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SignalVoteDelegationStoreKey(delegator))
+	store.Delete(types.SignalVoteDelegationByCuratorIndexKey(curator, delegator))
+}
+
+// GetCuratorDelegatedPower returns the total power delegated to a curator.
+func (k Keeper) GetCuratorDelegatedPower(ctx sdk.Context, curator sdk.AccAddress) types.CuratorDelegatedPower {
+	bz := ctx.KVStore(k.storeKey).Get(types.CuratorDelegatedPowerStoreKey(curator))
+	if bz == nil {
+		return types.NewCuratorDelegatedPower(curator.String(), 0)
+	}
+
+	var delegatedPower types.CuratorDelegatedPower
+	k.cdc.MustUnmarshal(bz, &delegatedPower)
+
+	return delegatedPower
+}
+
+// SetCuratorDelegatedPower sets the total power delegated to a curator. A zero power is removed from the store.
+func (k Keeper) SetCuratorDelegatedPower(ctx sdk.Context, delegatedPower types.CuratorDelegatedPower) {
+	curator := sdk.MustAccAddressFromBech32(delegatedPower.Curator)
+	if delegatedPower.Power == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.CuratorDelegatedPowerStoreKey(curator))
+		return
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.CuratorDelegatedPowerStoreKey(curator), k.cdc.MustMarshal(&delegatedPower))
+}
+
+// GetWeightedSignals returns the signals voted by a voter with the power delegated to the voter distributed
+// among them in proportion to their powers.
+func (k Keeper) GetWeightedSignals(ctx sdk.Context, voter sdk.AccAddress) []types.Signal {
+	return types.AddDelegatedPower(k.GetVote(ctx, voter), k.GetCuratorDelegatedPower(ctx, voter).Power)
+}
+
+// UpdateDelegationAndReturnPowerDiff replaces the previous signal vote delegation of a delegator with a new
+// delegation of the given power to the curator, or removes it if the power is zero. It returns the signal power
+// differences caused by the changes in the weighted signals of the previous and the new curators.
+func (k Keeper) UpdateDelegationAndReturnPowerDiff(
+	ctx sdk.Context,
+	delegator sdk.AccAddress,
+	curator sdk.AccAddress,
+	power int64,
+) map[string]int64 {
+	signalIDToPowerDiff := make(map[string]int64)
+
+	prevDelegation, err := k.GetSignalVoteDelegation(ctx, delegator)
+	if err == nil {
+		k.DeleteSignalVoteDelegation(ctx, delegator)
+		prevCurator := sdk.MustAccAddressFromBech32(prevDelegation.Curator)
+		k.addCuratorDelegatedPower(ctx, prevCurator, -prevDelegation.Power, signalIDToPowerDiff)
+	}
+
+	if power > 0 {
+		k.SetSignalVoteDelegation(ctx, types.NewSignalVoteDelegation(delegator.String(), curator.String(), power))
+		k.addCuratorDelegatedPower(ctx, curator, power, signalIDToPowerDiff)
+	}
+
+	return signalIDToPowerDiff
+}
+
+// addCuratorDelegatedPower adds the power to the delegated power of a curator and records the resulting
+// differences of the weighted signals of the curator.
+func (k Keeper) addCuratorDelegatedPower(
+	ctx sdk.Context,
+	curator sdk.AccAddress,
+	power int64,
+	signalIDToPowerDiff map[string]int64,
+) {
+	for _, signal := range k.GetWeightedSignals(ctx, curator) {
+		signalIDToPowerDiff[signal.ID] -= signal.Power
+	}
+
+	delegatedPower := k.GetCuratorDelegatedPower(ctx, curator)
+	delegatedPower.Power += power
+	k.SetCuratorDelegatedPower(ctx, delegatedPower)
+
+	for _, signal := range k.GetWeightedSignals(ctx, curator) {
+		signalIDToPowerDiff[signal.ID] += signal.Power
+	}
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func (suite *KeeperTestSuite) TestGetSetDeleteSignalVoteDelegation() {
+	ctx := suite.ctx
+
+	_, err := suite.feedsKeeper.GetSignalVoteDelegation(ctx, ValidVoter)
+	suite.Require().ErrorIs(err, types.ErrDelegationNotFound)
+
+	delegation := types.NewSignalVoteDelegation(ValidVoter.String(), ValidVoter2.String(), 1000)
+	suite.feedsKeeper.SetSignalVoteDelegations(ctx, []types.SignalVoteDelegation{delegation})
+
+	stored, err := suite.feedsKeeper.GetSignalVoteDelegation(ctx, ValidVoter)
+	suite.Require().NoError(err)
+	suite.Require().Equal(delegation, stored)
+	suite.Require().Equal([]types.SignalVoteDelegation{delegation}, suite.feedsKeeper.GetSignalVoteDelegations(ctx))
+	suite.Require().Equal(
+		types.NewCuratorDelegatedPower(ValidVoter2.String(), 1000),
+		suite.feedsKeeper.GetCuratorDelegatedPower(ctx, ValidVoter2),
+	)
+
+	suite.feedsKeeper.DeleteSignalVoteDelegation(ctx, ValidVoter)
+	_, err = suite.feedsKeeper.GetSignalVoteDelegation(ctx, ValidVoter)
+	suite.Require().ErrorIs(err, types.ErrDelegationNotFound)
+	suite.Require().Empty(suite.feedsKeeper.GetSignalVoteDelegations(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGetWeightedSignals() {
+	ctx := suite.ctx
+
+	// no delegated power
+	suite.feedsKeeper.SetVote(ctx, types.NewVote(ValidVoter2.String(), []types.Signal{
+		types.NewSignal("CS:BAND-USD", 100),
+		types.NewSignal("CS:BTC-USD", 300),
+	}))
+	suite.Require().Equal([]types.Signal{
+		types.NewSignal("CS:BAND-USD", 100),
+		types.NewSignal("CS:BTC-USD", 300),
+	}, suite.feedsKeeper.GetWeightedSignals(ctx, ValidVoter2))
+
+	// delegated power is distributed in proportion to the signal powers
+	suite.feedsKeeper.SetCuratorDelegatedPower(ctx, types.NewCuratorDelegatedPower(ValidVoter2.String(), 1000))
+	suite.Require().Equal([]types.Signal{
+		types.NewSignal("CS:BAND-USD", 350),
+		types.NewSignal("CS:BTC-USD", 1050),
+	}, suite.feedsKeeper.GetWeightedSignals(ctx, ValidVoter2))
+}
+
+func (suite *KeeperTestSuite) TestMsgDelegateSignalVote() {
+	ctx := suite.ctx
+	suite.restakeKeeper.EXPECT().
+		SetLockedPower(gomock.Any(), ValidVoter2, types.ModuleName, gomock.Any()).
+		Return(nil).
+		AnyTimes()
+
+	requireSignalTotalPower := func(signalID string, power int64) {
+		signalTotalPower, err := suite.feedsKeeper.GetSignalTotalPower(ctx, signalID)
+		if power == 0 {
+			suite.Require().ErrorIs(err, types.ErrSignalTotalPowerNotFound)
+			return
+		}
+		suite.Require().NoError(err)
+		suite.Require().Equal(power, signalTotalPower.Power)
+	}
+
+	// curator votes signals
+	_, err := suite.msgServer.Vote(ctx, types.NewMsgVote(ValidVoter2.String(), []types.Signal{
+		types.NewSignal("CS:BAND-USD", 100),
+		types.NewSignal("CS:BTC-USD", 300),
+	}))
+	suite.Require().NoError(err)
+
+	// delegate more power than the delegator has
+	_, err = suite.msgServer.DelegateSignalVote(
+		ctx,
+		types.NewMsgDelegateSignalVote(ValidVoter.String(), ValidVoter2.String(), 1e10+1),
+	)
+	suite.Require().ErrorContains(err, "power not enough")
+
+	// delegate power to the curator
+	_, err = suite.msgServer.DelegateSignalVote(
+		ctx,
+		types.NewMsgDelegateSignalVote(ValidVoter.String(), ValidVoter2.String(), 1000),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(1000), suite.feedsKeeper.GetCuratorDelegatedPower(ctx, ValidVoter2).Power)
+	requireSignalTotalPower("CS:BAND-USD", 350)
+	requireSignalTotalPower("CS:BTC-USD", 1050)
+
+	// curator changes its vote
+	_, err = suite.msgServer.Vote(ctx, types.NewMsgVote(ValidVoter2.String(), []types.Signal{
+		types.NewSignal("CS:ETH-USD", 50),
+	}))
+	suite.Require().NoError(err)
+	requireSignalTotalPower("CS:BAND-USD", 0)
+	requireSignalTotalPower("CS:BTC-USD", 0)
+	requireSignalTotalPower("CS:ETH-USD", 1050)
+
+	// delegator votes with its remaining power
+	_, err = suite.msgServer.Vote(ctx, types.NewMsgVote(ValidVoter.String(), []types.Signal{
+		types.NewSignal("CS:ETH-USD", 1e10-1000+1),
+	}))
+	suite.Require().ErrorContains(err, "power not enough")
+
+	_, err = suite.msgServer.Vote(ctx, types.NewMsgVote(ValidVoter.String(), []types.Signal{
+		types.NewSignal("CS:ETH-USD", 1e10-1000),
+	}))
+	suite.Require().NoError(err)
+	requireSignalTotalPower("CS:ETH-USD", 1e10+50)
+
+	// remove the delegation
+	_, err = suite.msgServer.DelegateSignalVote(
+		ctx,
+		types.NewMsgDelegateSignalVote(ValidVoter.String(), ValidVoter2.String(), 0),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(0), suite.feedsKeeper.GetCuratorDelegatedPower(ctx, ValidVoter2).Power)
+	requireSignalTotalPower("CS:ETH-USD", 1e10-1000+50)
+
+	_, err = suite.feedsKeeper.GetSignalVoteDelegation(ctx, ValidVoter)
+	suite.Require().ErrorIs(err, types.ErrDelegationNotFound)
+}
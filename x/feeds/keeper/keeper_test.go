@@ -42,10 +42,14 @@ type KeeperTestSuite struct {
 	suite.Suite
 
 	ctx           sdk.Context
+	storeKey      *storetypes.KVStoreKey
 	feedsKeeper   keeper.Keeper
 	oracleKeeper  *feedstestutil.MockOracleKeeper
 	stakingKeeper *feedstestutil.MockStakingKeeper
 	restakeKeeper *feedstestutil.MockRestakeKeeper
+	bankKeeper    *feedstestutil.MockBankKeeper
+	portKeeper    *feedstestutil.MockPortKeeper
+	scopedKeeper  *feedstestutil.MockScopedKeeper
 
 	queryClient types.QueryClient
 	msgServer   types.MsgServer
@@ -59,6 +63,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	key := storetypes.NewKVStoreKey(types.StoreKey)
 	testCtx := testutil.DefaultContextWithDB(suite.T(), key, storetypes.NewTransientStoreKey("transient_test"))
 	suite.ctx = testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: tmtime.Now()})
+	suite.storeKey = key
 	encCfg := moduletestutil.MakeTestEncodingConfig()
 
 	// gomock initializations
@@ -120,6 +125,15 @@ func (suite *KeeperTestSuite) SetupTest() {
 
 	authzKeeper := feedstestutil.NewMockAuthzKeeper(ctrl)
 
+	bankKeeper := feedstestutil.NewMockBankKeeper(ctrl)
+	suite.bankKeeper = bankKeeper
+
+	portKeeper := feedstestutil.NewMockPortKeeper(ctrl)
+	suite.portKeeper = portKeeper
+
+	scopedKeeper := feedstestutil.NewMockScopedKeeper(ctrl)
+	suite.scopedKeeper = scopedKeeper
+
 	suite.feedsKeeper = keeper.NewKeeper(
 		encCfg.Codec,
 		key,
@@ -127,6 +141,10 @@ func (suite *KeeperTestSuite) SetupTest() {
 		stakingKeeper,
 		restakeKeeper,
 		authzKeeper,
+		bankKeeper,
+		portKeeper,
+		scopedKeeper,
+		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	suite.feedsKeeper.InitGenesis(suite.ctx, *types.DefaultGenesisState())
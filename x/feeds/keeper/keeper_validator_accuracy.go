@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"bytes"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// GetValidatorAccuraciesIterator returns an iterator for validator accuracies store.
+func (k Keeper) GetValidatorAccuraciesIterator(ctx sdk.Context) dbm.Iterator {
+	return storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ValidatorAccuracyStoreKeyPrefix)
+}
+
+// GetAllValidatorAccuracies returns a list of all validator accuracies.
+func (k Keeper) GetAllValidatorAccuracies(ctx sdk.Context) (accuracies []types.ValidatorAccuracy) {
+	iterator := k.GetValidatorAccuraciesIterator(ctx)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var accuracy types.ValidatorAccuracy
+		k.cdc.MustUnmarshal(iterator.Value(), &accuracy)
+		accuracies = append(accuracies, accuracy)
+	}
+
+	return
+}
+
+// GetValidatorAccuracy returns the accuracy of a validator within the current accuracy window.
+func (k Keeper) GetValidatorAccuracy(ctx sdk.Context, val sdk.ValAddress) types.ValidatorAccuracy {
+	bz := ctx.KVStore(k.storeKey).Get(types.ValidatorAccuracyStoreKey(val))
+	if bz == nil {
+		return types.NewValidatorAccuracy(val, 0, 0, 0)
+	}
+
+	var accuracy types.ValidatorAccuracy
+	k.cdc.MustUnmarshal(bz, &accuracy)
+
+	return accuracy
+}
+
+// SetValidatorAccuracy sets the accuracy of a validator.
+func (k Keeper) SetValidatorAccuracy(ctx sdk.Context, val sdk.ValAddress, accuracy types.ValidatorAccuracy) {
+	ctx.KVStore(k.storeKey).Set(types.ValidatorAccuracyStoreKey(val), k.cdc.MustMarshal(&accuracy))
+}
+
+// DeleteAllValidatorAccuracies deletes all validator accuracies.
+func (k Keeper) DeleteAllValidatorAccuracies(ctx sdk.Context) {
+	iterator := k.GetValidatorAccuraciesIterator(ctx)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, bytes.Clone(iterator.Key()))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		ctx.KVStore(k.storeKey).Delete(key)
+	}
+}
+
+// ProcessValidatorAccuracies penalizes validators whose ratio of outliers within the accuracy window
+// exceeds the maximum outlier ratio, and then resets all validator accuracies for the next window.
+func (k Keeper) ProcessValidatorAccuracies(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	for _, accuracy := range k.GetAllValidatorAccuracies(ctx) {
+		if params.OutlierPenalty == types.OUTLIER_PENALTY_NONE ||
+			!accuracy.ExceedsMaxOutlierRatio(params.MaxOutlierRatioBasisPoint) {
+			continue
+		}
+
+		val, err := sdk.ValAddressFromBech32(accuracy.Validator)
+		if err != nil {
+			continue
+		}
+
+		if !k.oracleKeeper.GetValidatorStatus(ctx, val).IsActive {
+			continue
+		}
+
+		// OUTLIER_PENALTY_DEACTIVATE deactivates the validator through the oracle module
+		// the same way as missing a report.
+		k.oracleKeeper.MissReport(ctx, val, ctx.BlockTime())
+
+		emitEventPenalizeOutlierValidator(ctx, accuracy, params.OutlierPenalty)
+	}
+
+	k.DeleteAllValidatorAccuracies(ctx)
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func (suite *KeeperTestSuite) TestGetSetValidatorAccuracy() {
+	ctx := suite.ctx
+
+	// get default
+	suite.Require().Equal(
+		types.NewValidatorAccuracy(ValidValidator, 0, 0, 0),
+		suite.feedsKeeper.GetValidatorAccuracy(ctx, ValidValidator),
+	)
+
+	// set
+	expAccuracy := types.NewValidatorAccuracy(ValidValidator, 10, 2, 3000)
+	suite.feedsKeeper.SetValidatorAccuracy(ctx, ValidValidator, expAccuracy)
+
+	// get
+	suite.Require().Equal(expAccuracy, suite.feedsKeeper.GetValidatorAccuracy(ctx, ValidValidator))
+	suite.Require().Equal([]types.ValidatorAccuracy{expAccuracy}, suite.feedsKeeper.GetAllValidatorAccuracies(ctx))
+
+	// delete all
+	suite.feedsKeeper.DeleteAllValidatorAccuracies(ctx)
+	suite.Require().Empty(suite.feedsKeeper.GetAllValidatorAccuracies(ctx))
+}
+
+func (suite *KeeperTestSuite) TestProcessValidatorAccuracies() {
+	ctx := suite.ctx
+
+	params := suite.feedsKeeper.GetParams(ctx)
+	params.OutlierPenalty = types.OUTLIER_PENALTY_DEACTIVATE
+	suite.Require().NoError(suite.feedsKeeper.SetParams(ctx, params))
+
+	// ValidValidator exceeds the default max outlier ratio of 20%, ValidValidator2 does not
+	suite.feedsKeeper.SetValidatorAccuracy(ctx, ValidValidator, types.NewValidatorAccuracy(ValidValidator, 10, 3, 0))
+	suite.feedsKeeper.SetValidatorAccuracy(ctx, ValidValidator2, types.NewValidatorAccuracy(ValidValidator2, 10, 2, 0))
+
+	suite.oracleKeeper.EXPECT().MissReport(ctx, ValidValidator, ctx.BlockTime())
+
+	suite.feedsKeeper.ProcessValidatorAccuracies(ctx)
+	suite.Require().Empty(suite.feedsKeeper.GetAllValidatorAccuracies(ctx))
+
+	events := ctx.EventManager().Events()
+	suite.Require().Equal(types.EventTypePenalizeOutlierValidator, events[len(events)-1].Type)
+
+	// no penalty is applied with OUTLIER_PENALTY_NONE
+	params = suite.feedsKeeper.GetParams(ctx)
+	params.OutlierPenalty = types.OUTLIER_PENALTY_NONE
+	suite.Require().NoError(suite.feedsKeeper.SetParams(ctx, params))
+
+	suite.feedsKeeper.SetValidatorAccuracy(ctx, ValidValidator, types.NewValidatorAccuracy(ValidValidator, 10, 3, 0))
+	suite.feedsKeeper.ProcessValidatorAccuracies(ctx)
+	suite.Require().Empty(suite.feedsKeeper.GetAllValidatorAccuracies(ctx))
+}
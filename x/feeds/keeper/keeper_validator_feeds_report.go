@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// GetValidatorFeedReports returns the price submission reports of a validator for each signal id in the
+// current feeds.
+func (k Keeper) GetValidatorFeedReports(ctx sdk.Context, val sdk.ValAddress) []types.ValidatorFeedReport {
+	params := k.GetParams(ctx)
+	currentFeeds := k.GetCurrentFeeds(ctx)
+	valInfo := types.NewValidatorInfo(val, 0, k.oracleKeeper.GetValidatorStatus(ctx, val))
+	blockTime := ctx.BlockTime().Unix()
+
+	valPricesMap := make(map[string]types.ValidatorPrice)
+	if valPricesList, err := k.GetValidatorPriceList(ctx, val); err == nil {
+		for _, valPrice := range valPricesList.ValidatorPrices {
+			if valPrice.SignalPriceStatus != types.SIGNAL_PRICE_STATUS_UNSPECIFIED {
+				valPricesMap[valPrice.SignalID] = valPrice
+			}
+		}
+	}
+
+	reports := make([]types.ValidatorFeedReport, 0, len(currentFeeds.Feeds))
+	for _, feed := range currentFeeds.Feeds {
+		valPrice, ok := valPricesMap[feed.SignalID]
+		if !ok {
+			valPrice = types.ValidatorPrice{SignalID: feed.SignalID}
+		}
+
+		deadlineTime, deadlineBlock := CalculateReportDeadline(
+			feed,
+			currentFeeds.LastUpdateTimestamp,
+			currentFeeds.LastUpdateBlock,
+			valPrice,
+			valInfo,
+			params.GracePeriod,
+		)
+
+		report := types.ValidatorFeedReport{
+			SignalID:                  feed.SignalID,
+			ValidatorPrice:            valPrice,
+			AggregatedPrice:           k.GetPrice(ctx, feed.SignalID),
+			ReportDeadlineTimestamp:   deadlineTime,
+			ReportDeadlineBlockHeight: deadlineBlock,
+			TimeUntilMissReport:       max(deadlineTime-blockTime, 0),
+		}
+
+		if ok {
+			// compare against the aggregation that took place at or after the submission
+			if price, found := k.GetPriceHistoryAtOrAfter(ctx, feed.SignalID, valPrice.Timestamp); found {
+				report.AggregatedPrice = price
+			}
+
+			aggregatedPrice := report.AggregatedPrice
+			if valPrice.SignalPriceStatus == types.SIGNAL_PRICE_STATUS_AVAILABLE &&
+				aggregatedPrice.Status == types.PRICE_STATUS_AVAILABLE {
+				report.DeviationBasisPoint = types.CalculatePriceDeviationBasisPoint(
+					valPrice.Price,
+					aggregatedPrice.Price,
+				)
+				report.CountedInMedian = valInfo.Status.IsActive &&
+					valPrice.Timestamp <= aggregatedPrice.Timestamp &&
+					valPrice.Timestamp >= aggregatedPrice.Timestamp-feed.Interval
+			}
+
+			report.CooldownEndTimestamp = valPrice.Timestamp + params.CooldownTime
+			report.InCooldown = blockTime < report.CooldownEndTimestamp
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func (suite *KeeperTestSuite) TestGetValidatorFeedReports() {
+	now := suite.ctx.BlockTime()
+
+	// current feeds were updated 100 seconds ago at block 10
+	suite.feedsKeeper.SetCurrentFeeds(
+		suite.ctx.WithBlockTime(now.Add(-100*time.Second)).WithBlockHeight(10),
+		[]types.Feed{
+			types.NewFeed("CS:BAND-USD", 60, 60),
+			types.NewFeed("CS:BTC-USD", 60, 60),
+		},
+	)
+	ctx := suite.ctx.WithBlockHeight(30)
+
+	// the validator submitted a price of CS:BAND-USD 10 seconds ago, which was aggregated in the same block
+	valPrice := types.NewValidatorPrice(
+		types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1010),
+		now.Unix()-10,
+		28,
+	)
+	err := suite.feedsKeeper.SetValidatorPriceList(ctx, ValidValidator, []types.ValidatorPrice{valPrice, {}})
+	suite.Require().NoError(err)
+
+	aggregatedPrice := types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, now.Unix()-10)
+	suite.feedsKeeper.AddPriceHistory(ctx, aggregatedPrice, 10)
+	latestPrice := types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 2000, now.Unix()-5)
+	suite.feedsKeeper.AddPriceHistory(ctx, latestPrice, 10)
+	suite.feedsKeeper.SetPrice(ctx, latestPrice)
+
+	// the validator became active at the current block time, which extends the report deadlines
+	suite.Require().Equal([]types.ValidatorFeedReport{
+		{
+			SignalID:                  "CS:BAND-USD",
+			ValidatorPrice:            valPrice,
+			AggregatedPrice:           aggregatedPrice,
+			DeviationBasisPoint:       100,
+			CountedInMedian:           true,
+			ReportDeadlineTimestamp:   now.Unix() + 50,
+			ReportDeadlineBlockHeight: 88,
+			TimeUntilMissReport:       50,
+			CooldownEndTimestamp:      now.Unix() + 20,
+			InCooldown:                true,
+		},
+		{
+			SignalID:                  "CS:BTC-USD",
+			ValidatorPrice:            types.ValidatorPrice{SignalID: "CS:BTC-USD"},
+			AggregatedPrice:           suite.feedsKeeper.GetPrice(ctx, "CS:BTC-USD"),
+			ReportDeadlineTimestamp:   now.Unix() + 30,
+			ReportDeadlineBlockHeight: 40,
+			TimeUntilMissReport:       30,
+		},
+	}, suite.feedsKeeper.GetValidatorFeedReports(ctx, ValidValidator))
+
+	// the price of an inactive validator is not counted in the median
+	err = suite.feedsKeeper.SetValidatorPriceList(ctx, InvalidValidator, []types.ValidatorPrice{valPrice, {}})
+	suite.Require().NoError(err)
+
+	reports := suite.feedsKeeper.GetValidatorFeedReports(ctx, InvalidValidator)
+	suite.Require().Len(reports, 2)
+	suite.Require().Equal(uint64(100), reports[0].DeviationBasisPoint)
+	suite.Require().False(reports[0].CountedInMedian)
+
+	// the deadline has passed once the block time is after it
+	reports = suite.feedsKeeper.GetValidatorFeedReports(
+		ctx.WithBlockTime(now.Add(60*time.Second)),
+		ValidValidator,
+	)
+	suite.Require().Equal(int64(0), reports[0].TimeUntilMissReport)
+	suite.Require().False(reports[0].InCooldown)
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// Migrator is a struct for handling in-place state migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{
+		keeper: k,
+	}
+}
+
+// Migrate1to2 migrates the x/feeds module state from the consensus version 1 to
+// version 2. Specifically, it sets the default values of the params that were added in
+// version 2 and binds the feeds port on chains that added the module before the port was
+// introduced.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.keeper.SetParams(ctx, migrateParamsV2(m.keeper.GetParams(ctx))); err != nil {
+		return err
+	}
+
+	if m.keeper.IsBound(ctx, types.PortID) {
+		return nil
+	}
+
+	return m.keeper.BindPort(ctx, types.PortID)
+}
+
+// migrateParamsV2 fills the params that are not stored by version 1 with their default values.
+func migrateParamsV2(p types.Params) types.Params {
+	defaultParams := types.DefaultParams()
+
+	if p.PriceHistoryRetention == 0 {
+		p.PriceHistoryRetention = defaultParams.PriceHistoryRetention
+	}
+	if p.OutlierThresholdBasisPoint == 0 {
+		p.OutlierThresholdBasisPoint = defaultParams.OutlierThresholdBasisPoint
+	}
+	if p.AccuracyWindow == 0 {
+		p.AccuracyWindow = defaultParams.AccuracyWindow
+	}
+	if p.MaxOutlierRatioBasisPoint == 0 {
+		p.MaxOutlierRatioBasisPoint = defaultParams.MaxOutlierRatioBasisPoint
+	}
+	if p.OutlierPenalty == types.OUTLIER_PENALTY_NONE {
+		p.OutlierPenalty = defaultParams.OutlierPenalty
+	}
+	if !p.RegisteredSignalsOnly {
+		p.RegisteredSignalsOnly = defaultParams.RegisteredSignalsOnly
+	}
+	if p.QueryPricesFee.Empty() {
+		p.QueryPricesFee = defaultParams.QueryPricesFee
+	}
+	if len(p.QueryPricesAllowedChannels) == 0 {
+		p.QueryPricesAllowedChannels = defaultParams.QueryPricesAllowedChannels
+	}
+	if p.CommitWindow == 0 && p.RevealWindow == 0 {
+		p.CommitWindow = defaultParams.CommitWindow
+		p.RevealWindow = defaultParams.RevealWindow
+	}
+
+	return p
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/keeper"
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	ctx := suite.ctx
+	migrator := keeper.NewMigrator(suite.feedsKeeper)
+	portCap := capabilitytypes.NewCapability(1)
+
+	// params of version 1 do not have the params that were added in version 2
+	defaultParams := types.DefaultParams()
+	v1Params := types.Params{
+		Admin:                         defaultParams.Admin,
+		AllowableBlockTimeDiscrepancy: defaultParams.AllowableBlockTimeDiscrepancy,
+		GracePeriod:                   defaultParams.GracePeriod,
+		MinInterval:                   defaultParams.MinInterval,
+		MaxInterval:                   defaultParams.MaxInterval,
+		PowerStepThreshold:            defaultParams.PowerStepThreshold,
+		MaxCurrentFeeds:               defaultParams.MaxCurrentFeeds,
+		CooldownTime:                  defaultParams.CooldownTime,
+		MinDeviationBasisPoint:        defaultParams.MinDeviationBasisPoint,
+		MaxDeviationBasisPoint:        defaultParams.MaxDeviationBasisPoint,
+		CurrentFeedsUpdateInterval:    defaultParams.CurrentFeedsUpdateInterval,
+		PriceQuorum:                   defaultParams.PriceQuorum,
+		MaxSignalIDsPerSigning:        defaultParams.MaxSignalIDsPerSigning,
+	}
+	bz, err := v1Params.Marshal()
+	suite.Require().NoError(err)
+	ctx.KVStore(suite.storeKey).Set(types.ParamsKey, bz)
+
+	// port is not bound yet
+	suite.scopedKeeper.EXPECT().GetCapability(gomock.Any(), host.PortPath(types.PortID)).Return(nil, false)
+	suite.portKeeper.EXPECT().BindPort(gomock.Any(), types.PortID).Return(portCap)
+	suite.scopedKeeper.EXPECT().ClaimCapability(gomock.Any(), portCap, host.PortPath(types.PortID)).Return(nil)
+	suite.Require().NoError(migrator.Migrate1to2(ctx))
+	suite.Require().Equal(defaultParams, suite.feedsKeeper.GetParams(ctx))
+
+	// params that are already set are kept and the port is already bound
+	params := defaultParams
+	params.PriceHistoryRetention = 10
+	params.AccuracyWindow = 60
+	params.CommitWindow = 5
+	params.RevealWindow = 5
+	suite.Require().NoError(suite.feedsKeeper.SetParams(ctx, params))
+
+	suite.scopedKeeper.EXPECT().GetCapability(gomock.Any(), host.PortPath(types.PortID)).Return(portCap, true)
+	suite.Require().NoError(migrator.Migrate1to2(ctx))
+	suite.Require().Equal(params, suite.feedsKeeper.GetParams(ctx))
+}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -46,6 +45,11 @@ func (k msgServer) Vote(
 		)
 	}
 
+	// check if the signals are registered if votes are restricted to registered signals
+	if err := k.Keeper.ValidateSignalsRegistered(ctx, msg.Signals); err != nil {
+		return nil, err
+	}
+
 	// lock the voter's power equal to the sum of the signal powers
 	err = k.Keeper.LockVoterPower(ctx, voter, msg.Signals)
 	if err != nil {
@@ -55,42 +59,48 @@ func (k msgServer) Vote(
 	// RegisterNewSignals deletes previous signals and registers new signals then returns feed power differences
 	signalIDToPowerDiff := k.Keeper.UpdateVoteAndReturnPowerDiff(ctx, voter, msg.Signals)
 
-	// sort keys to guarantee order of signalIDToPowerDiff iteration
-	keys := make([]string, 0, len(signalIDToPowerDiff))
-	for k := range signalIDToPowerDiff {
-		keys = append(keys, k)
+	// update the signal-total-powers with the power differences
+	if err := k.Keeper.UpdateSignalTotalPowers(ctx, signalIDToPowerDiff); err != nil {
+		return nil, err
 	}
-	sort.Strings(keys)
 
-	// iterate over each signal ID, updating the total power and ensuring no negative power values
-	for _, signalID := range keys {
-		// get the power difference for the current signal ID
-		powerDiff := signalIDToPowerDiff[signalID]
+	// return an empty response indicating success
+	return &types.MsgVoteResponse{}, nil
+}
 
-		// retrieve the total power of the current signal ID from the store
-		signalTotalPower, err := k.Keeper.GetSignalTotalPower(ctx, signalID)
-		if err != nil {
-			// initialize a new signal with zero power if the signal ID does not exist
-			signalTotalPower = types.NewSignal(
-				signalID,
-				0,
-			)
-		}
+// DelegateSignalVote delegates feeds voting power to a curator.
+func (k msgServer) DelegateSignalVote(
+	goCtx context.Context,
+	msg *types.MsgDelegateSignalVote,
+) (*types.MsgDelegateSignalVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
 
-		// update the total power of the signal by adding the power difference
-		signalTotalPower.Power += powerDiff
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
 
-		// ensure the total power of the signal is not negative
-		if signalTotalPower.Power < 0 {
-			return nil, types.ErrPowerNegative
-		}
+	curator, err := sdk.AccAddressFromBech32(msg.Curator)
+	if err != nil {
+		return nil, err
+	}
 
-		// save the updated signal total power back to the store
-		k.Keeper.SetSignalTotalPower(ctx, signalTotalPower)
+	// lock the delegator's power equal to the sum of its signal powers and the delegated power
+	if err := k.Keeper.LockDelegatorPower(ctx, delegator, msg.Power); err != nil {
+		return nil, err
 	}
 
-	// return an empty response indicating success
-	return &types.MsgVoteResponse{}, nil
+	// replace the previous delegation and get the power differences of the weighted signals of the curators
+	signalIDToPowerDiff := k.Keeper.UpdateDelegationAndReturnPowerDiff(ctx, delegator, curator, msg.Power)
+
+	// update the signal-total-powers with the power differences
+	if err := k.Keeper.UpdateSignalTotalPowers(ctx, signalIDToPowerDiff); err != nil {
+		return nil, err
+	}
+
+	emitEventDelegateSignalVote(ctx, delegator, curator, msg.Power)
+
+	return &types.MsgDelegateSignalVoteResponse{}, nil
 }
 
 // SubmitSignalPrices submits new validator prices.
@@ -100,27 +110,14 @@ func (k msgServer) SubmitSignalPrices(
 ) (*types.MsgSubmitSignalPricesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	blockTime := ctx.BlockTime().Unix()
-	blockHeight := ctx.BlockHeight()
-
-	params := k.Keeper.GetParams(ctx)
-	currentFeeds := k.Keeper.GetCurrentFeeds(ctx)
-
-	// check if the number of signal prices exceeds the length of current feeds
-	if len(msg.SignalPrices) > len(currentFeeds.Feeds) {
-		return nil, types.ErrSignalPricesTooLarge
-	}
 
 	val, err := sdk.ValAddressFromBech32(msg.Validator)
 	if err != nil {
 		return nil, err
 	}
 
-	// check if the validator is required to send prices
-	if err := k.Keeper.ValidateValidatorRequiredToSend(ctx, val); err != nil {
-		return nil, err
-	}
-
 	// check if the timestamp is not too far from the block time
+	params := k.Keeper.GetParams(ctx)
 	if types.AbsInt64(msg.Timestamp-blockTime) > params.AllowableBlockTimeDiscrepancy {
 		return nil, types.ErrInvalidTimestamp.Wrapf(
 			"block_time: %d, timestamp: %d",
@@ -129,53 +126,55 @@ func (k msgServer) SubmitSignalPrices(
 		)
 	}
 
-	// create current feed map from current feeds to map signal id to index of current feeds
-	currentFeedsMap := make(map[string]int)
-	for idx, feed := range currentFeeds.Feeds {
-		currentFeedsMap[feed.SignalID] = idx
+	// signal prices submitted directly are visible to other validators before they are included in a block,
+	// so they must be committed and revealed instead when commit-reveal is enabled
+	if params.IsCommitRevealEnabled() {
+		return nil, types.ErrCommitRevealRequired
 	}
 
-	newValidatorPrices := make([]types.ValidatorPrice, len(currentFeedsMap))
-	// fill new validator latest price with latest submitted price
-	prevValPrices, err := k.Keeper.GetValidatorPriceList(ctx, val)
-	if err == nil {
-		for _, p := range prevValPrices.ValidatorPrices {
-			idx, ok := currentFeedsMap[p.SignalID]
-			// only update if this signal in current feed
-			if ok {
-				newValidatorPrices[idx] = p
-			}
-		}
+	if err := k.Keeper.SubmitValidatorSignalPrices(ctx, val, msg.SignalPrices, false); err != nil {
+		return nil, err
 	}
 
-	cooldownTime := params.CooldownTime
-	for _, msgPrice := range msg.SignalPrices {
-		// revert if send signal price that not in current feed
-		idx, ok := currentFeedsMap[msgPrice.SignalID]
-		if !ok {
-			return nil, types.ErrSignalIDNotSupported.Wrapf(
-				"signal_id: %s",
-				msgPrice.SignalID,
-			)
-		}
+	return &types.MsgSubmitSignalPricesResponse{}, nil
+}
 
-		// check if price have been set and update too fast
-		latestPrice := newValidatorPrices[idx]
-		if latestPrice.SignalPriceStatus != types.SIGNAL_PRICE_STATUS_UNSPECIFIED &&
-			blockTime < latestPrice.Timestamp+cooldownTime {
-			return nil, types.ErrPriceSubmitTooEarly
-		}
+// CommitSignalPrices commits the hash of validator signal prices.
+func (k msgServer) CommitSignalPrices(
+	goCtx context.Context,
+	msg *types.MsgCommitSignalPrices,
+) (*types.MsgCommitSignalPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
 
-		// update new validator price with price from msg
-		newValidatorPrices[idx] = types.NewValidatorPrice(msgPrice, blockTime, blockHeight)
-		emitEventSubmitSignalPrice(ctx, val, newValidatorPrices[idx])
+	val, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := k.Keeper.SetValidatorPriceList(ctx, val, newValidatorPrices); err != nil {
+	if err := k.Keeper.CommitValidatorSignalPrices(ctx, val, msg.Hash); err != nil {
 		return nil, err
 	}
 
-	return &types.MsgSubmitSignalPricesResponse{}, nil
+	return &types.MsgCommitSignalPricesResponse{}, nil
+}
+
+// RevealSignalPrices reveals committed validator signal prices.
+func (k msgServer) RevealSignalPrices(
+	goCtx context.Context,
+	msg *types.MsgRevealSignalPrices,
+) (*types.MsgRevealSignalPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RevealValidatorSignalPrices(ctx, val, msg.Salt, msg.SignalPrices); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevealSignalPricesResponse{}, nil
 }
 
 // UpdateReferenceSourceConfig updates reference source configuration.
@@ -205,6 +204,58 @@ func (k msgServer) UpdateReferenceSourceConfig(
 	return &types.MsgUpdateReferenceSourceConfigResponse{}, nil
 }
 
+// UpdateSignalInfos registers or updates the metadata of signal ids.
+func (k msgServer) UpdateSignalInfos(
+	goCtx context.Context,
+	msg *types.MsgUpdateSignalInfos,
+) (*types.MsgUpdateSignalInfosResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check if the request is from the admin or the authority
+	admin := k.Keeper.GetParams(ctx).Admin
+	if admin != msg.Admin && k.Keeper.GetAuthority() != msg.Admin {
+		return nil, types.ErrInvalidSigner.Wrapf(
+			"invalid admin; expected %s or %s, got %s",
+			admin,
+			k.Keeper.GetAuthority(),
+			msg.Admin,
+		)
+	}
+
+	for _, signalInfo := range msg.SignalInfos {
+		k.Keeper.SetSignalInfo(ctx, signalInfo)
+		emitEventUpdateSignalInfo(ctx, signalInfo)
+	}
+
+	return &types.MsgUpdateSignalInfosResponse{}, nil
+}
+
+// UpdatePriceGuards sets or removes the price guards of signal ids.
+func (k msgServer) UpdatePriceGuards(
+	goCtx context.Context,
+	msg *types.MsgUpdatePriceGuards,
+) (*types.MsgUpdatePriceGuardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check if the request is from the admin or the authority
+	admin := k.Keeper.GetParams(ctx).Admin
+	if admin != msg.Admin && k.Keeper.GetAuthority() != msg.Admin {
+		return nil, types.ErrInvalidSigner.Wrapf(
+			"invalid admin; expected %s or %s, got %s",
+			admin,
+			k.Keeper.GetAuthority(),
+			msg.Admin,
+		)
+	}
+
+	for _, priceGuard := range msg.PriceGuards {
+		k.Keeper.SetPriceGuard(ctx, priceGuard)
+		emitEventUpdatePriceGuard(ctx, priceGuard)
+	}
+
+	return &types.MsgUpdatePriceGuardsResponse{}, nil
+}
+
 // UpdateParams updates the feeds module params.
 func (k msgServer) UpdateParams(
 	goCtx context.Context,
@@ -265,6 +265,26 @@ func (suite *KeeperTestSuite) TestMsgSubmitSignalPrices() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgSubmitSignalPricesCommitRevealRequired() {
+	params := suite.feedsKeeper.GetParams(suite.ctx)
+	params.CommitWindow = 40
+	params.RevealWindow = 20
+	suite.Require().NoError(suite.feedsKeeper.SetParams(suite.ctx, params))
+
+	_, err := suite.msgServer.SubmitSignalPrices(suite.ctx, &types.MsgSubmitSignalPrices{
+		Validator: ValidValidator.String(),
+		Timestamp: suite.ctx.BlockTime().Unix(),
+		SignalPrices: []types.SignalPrice{
+			{
+				Status:   types.SIGNAL_PRICE_STATUS_AVAILABLE,
+				SignalID: "CS:BAND-USD",
+				Price:    10e12,
+			},
+		},
+	})
+	suite.Require().ErrorIs(err, types.ErrCommitRevealRequired)
+}
+
 func (suite *KeeperTestSuite) TestMsgUpdateReferenceSourceConfig() {
 	params := suite.feedsKeeper.GetParams(suite.ctx)
 	referenceSourceConfig := types.DefaultReferenceSourceConfig()
@@ -308,6 +328,143 @@ func (suite *KeeperTestSuite) TestMsgUpdateReferenceSourceConfig() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgUpdateSignalInfos() {
+	params := suite.feedsKeeper.GetParams(suite.ctx)
+	signalInfos := []types.SignalInfo{
+		types.NewSignalInfo("CS:BAND-USD", 9, "USD", types.ASSET_CLASS_CRYPTO, "Band price", "", false),
+	}
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdateSignalInfos
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid admin",
+			input: &types.MsgUpdateSignalInfos{
+				Admin:       "invalid",
+				SignalInfos: signalInfos,
+			},
+			expErr:    true,
+			expErrMsg: "invalid admin",
+		},
+		{
+			name: "all good by admin",
+			input: &types.MsgUpdateSignalInfos{
+				Admin:       params.Admin,
+				SignalInfos: signalInfos,
+			},
+			expErr: false,
+		},
+		{
+			name: "all good by authority",
+			input: &types.MsgUpdateSignalInfos{
+				Admin:       suite.feedsKeeper.GetAuthority(),
+				SignalInfos: signalInfos,
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.UpdateSignalInfos(suite.ctx, tc.input)
+
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(signalInfos, suite.feedsKeeper.GetSignalInfos(suite.ctx))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgUpdatePriceGuards() {
+	params := suite.feedsKeeper.GetParams(suite.ctx)
+	priceGuards := []types.PriceGuard{
+		types.NewPriceGuard("CS:BAND-USD", 2000, 1000, 1000000, 300),
+	}
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdatePriceGuards
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid admin",
+			input: &types.MsgUpdatePriceGuards{
+				Admin:       "invalid",
+				PriceGuards: priceGuards,
+			},
+			expErr:    true,
+			expErrMsg: "invalid admin",
+		},
+		{
+			name: "all good by admin",
+			input: &types.MsgUpdatePriceGuards{
+				Admin:       params.Admin,
+				PriceGuards: priceGuards,
+			},
+			expErr: false,
+		},
+		{
+			name: "all good by authority",
+			input: &types.MsgUpdatePriceGuards{
+				Admin:       suite.feedsKeeper.GetAuthority(),
+				PriceGuards: priceGuards,
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.UpdatePriceGuards(suite.ctx, tc.input)
+
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(priceGuards, suite.feedsKeeper.GetPriceGuards(suite.ctx))
+			}
+		})
+	}
+
+	// a price guard with all limits disabled removes the price guard
+	_, err := suite.msgServer.UpdatePriceGuards(suite.ctx, &types.MsgUpdatePriceGuards{
+		Admin:       params.Admin,
+		PriceGuards: []types.PriceGuard{types.NewPriceGuard("CS:BAND-USD", 0, 0, 0, 0)},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.feedsKeeper.GetPriceGuards(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestMsgVoteRegisteredSignalsOnly() {
+	params := suite.feedsKeeper.GetParams(suite.ctx)
+	params.RegisteredSignalsOnly = true
+	suite.Require().NoError(suite.feedsKeeper.SetParams(suite.ctx, params))
+
+	msg := &types.MsgVote{
+		Voter:   ValidVoter.String(),
+		Signals: []types.Signal{{ID: "CS:BAND-USD", Power: 1e9}},
+	}
+
+	_, err := suite.msgServer.Vote(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrSignalNotRegistered)
+
+	suite.feedsKeeper.SetSignalInfo(
+		suite.ctx,
+		types.NewSignalInfo("CS:BAND-USD", 9, "USD", types.ASSET_CLASS_CRYPTO, "", "", false),
+	)
+	_, err = suite.msgServer.Vote(suite.ctx, msg)
+	suite.Require().NoError(err)
+}
+
 func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 	params := types.DefaultParams()
 
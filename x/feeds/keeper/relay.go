@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"slices"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// IsBound checks if the feeds module is already bound to the desired port
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort defines a wrapper function for the port Keeper's function in
+// order to expose it to module's InitGenesis function
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	cap := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, cap, host.PortPath(portID))
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
+}
+
+// ClaimCapability allows the feeds module that can claim a capability that IBC module
+// passes to it
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
+
+// OnRecvQueryPricesPacket processes a price query packet received over IBC. The channel must be allowed
+// to query prices and the query fee is collected from the fee payer account of the channel.
+func (k Keeper) OnRecvQueryPricesPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data types.QueryPricesPacketData,
+) ([]types.Price, error) {
+	params := k.GetParams(ctx)
+	if !slices.Contains(params.QueryPricesAllowedChannels, packet.DestinationChannel) {
+		return nil, types.ErrChannelNotAllowed.Wrapf("channel id: %s", packet.DestinationChannel)
+	}
+
+	if err := data.ValidateBasic(params.MaxCurrentFeeds); err != nil {
+		return nil, err
+	}
+
+	feePayer := types.GetQueryPricesFeePayer(packet.DestinationChannel)
+	if !params.QueryPricesFee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(
+			ctx,
+			feePayer,
+			k.feeCollectorName,
+			params.QueryPricesFee,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	prices := k.GetPrices(ctx, data.SignalIDs)
+	emitEventQueryPrices(ctx, packet, feePayer, params.QueryPricesFee)
+
+	return prices, nil
+}
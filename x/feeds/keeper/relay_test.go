@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func (suite *KeeperTestSuite) TestOnRecvQueryPricesPacket() {
+	ctx := suite.ctx
+	feePayer := types.GetQueryPricesFeePayer("channel-0")
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uband", 100))
+
+	price := types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, ctx.BlockTime().Unix())
+	suite.feedsKeeper.SetPrice(ctx, price)
+
+	packet := channeltypes.Packet{Sequence: 1, DestinationPort: types.PortID, DestinationChannel: "channel-0"}
+	data := types.NewQueryPricesPacketData([]string{"CS:BAND-USD", "CS:BTC-USD"})
+
+	// channel is not allowed
+	_, err := suite.feedsKeeper.OnRecvQueryPricesPacket(ctx, packet, data)
+	suite.Require().ErrorIs(err, types.ErrChannelNotAllowed)
+
+	params := suite.feedsKeeper.GetParams(ctx)
+	params.QueryPricesAllowedChannels = []string{"channel-0"}
+	params.QueryPricesFee = fee
+	suite.Require().NoError(suite.feedsKeeper.SetParams(ctx, params))
+
+	// invalid packet data
+	_, err = suite.feedsKeeper.OnRecvQueryPricesPacket(
+		ctx,
+		packet,
+		types.NewQueryPricesPacketData([]string{}),
+	)
+	suite.Require().ErrorIs(err, types.ErrInvalidPacketData)
+
+	// fee payer of the channel cannot pay the fee
+	suite.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), feePayer, authtypes.FeeCollectorName, fee).
+		Return(errors.New("insufficient funds"))
+	_, err = suite.feedsKeeper.OnRecvQueryPricesPacket(ctx, packet, data)
+	suite.Require().Error(err)
+
+	// all good
+	suite.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), feePayer, authtypes.FeeCollectorName, fee).
+		Return(nil)
+	prices, err := suite.feedsKeeper.OnRecvQueryPricesPacket(ctx, packet, data)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Price{
+		price,
+		types.NewPrice(types.PRICE_STATUS_NOT_IN_CURRENT_FEEDS, "CS:BTC-USD", 0, ctx.BlockTime().Unix()),
+	}, prices)
+}
@@ -22,7 +22,7 @@ import (
 )
 
 // ConsensusVersion defines the current x/feeds module consensus version.
-const ConsensusVersion uint64 = 1
+const ConsensusVersion uint64 = 2
 
 var (
 	_ module.AppModuleBasic = AppModuleBasic{}
@@ -33,6 +33,11 @@ var (
 	_ appmodule.HasEndBlocker = AppModule{}
 )
 
+// Module init related flags
+const (
+	FlagPriceServerURL = "feeds-price-server-url"
+)
+
 // ----------------------------------------------------------------------------
 // AppModuleBasic
 // ----------------------------------------------------------------------------
@@ -113,10 +118,24 @@ func (am AppModule) IsOnePerModuleType() {}
 // IsAppModule implements the appmodule.AppModule interface.
 func (am AppModule) IsAppModule() {}
 
+// AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	startCmd.Flags().String(
+		FlagPriceServerURL,
+		"",
+		"The URL of the price server (e.g. Grogu) providing signal prices for vote extensions",
+	)
+}
+
 // RegisterServices registers a gRPC query service to respond to the module-specific gRPC queries
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
@@ -127,6 +146,16 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.Ra
 	var genState types.GenesisState
 	cdc.MustUnmarshalJSON(gs, &genState)
 	am.keeper.InitGenesis(ctx, genState)
+
+	// Only try to bind to port if it is not already bound, since we may already own
+	// port capability from capability InitGenesis
+	if !am.keeper.IsBound(ctx, types.PortID) {
+		// feeds module binds to the feeds port on InitChain
+		// and claims the returned capability
+		if err := am.keeper.BindPort(ctx, types.PortID); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis state as raw JSON bytes.
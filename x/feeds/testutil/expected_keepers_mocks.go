@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: x/feeds/types/expected_keepers.go
+// Source: types/expected_keepers.go
 //
 // Generated by this command:
 //
-//	mockgen -source=x/feeds/types/expected_keepers.go -package testutil -destination x/feeds/testutil/expected_keepers_mocks.go
+//	mockgen -source=types/expected_keepers.go -package testutil -destination testutil/expected_keepers_mocks.go
 //
 
 // Package testutil is a generated GoMock package.
@@ -19,6 +19,7 @@ import (
 	types0 "github.com/cosmos/cosmos-sdk/types"
 	authz "github.com/cosmos/cosmos-sdk/x/authz"
 	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
+	types2 "github.com/cosmos/ibc-go/modules/capability/types"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -216,3 +217,146 @@ func (mr *MockRestakeKeeperMockRecorder) SetLockedPower(ctx, addr, key, amount a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLockedPower", reflect.TypeOf((*MockRestakeKeeper)(nil).SetLockedPower), ctx, addr, key, amount)
 }
+
+// MockBankKeeper is a mock of BankKeeper interface.
+type MockBankKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBankKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockBankKeeperMockRecorder is the mock recorder for MockBankKeeper.
+type MockBankKeeperMockRecorder struct {
+	mock *MockBankKeeper
+}
+
+// NewMockBankKeeper creates a new mock instance.
+func NewMockBankKeeper(ctrl *gomock.Controller) *MockBankKeeper {
+	mock := &MockBankKeeper{ctrl: ctrl}
+	mock.recorder = &MockBankKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
+	return m.recorder
+}
+
+// SendCoinsFromAccountToModule mocks base method.
+func (m *MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr types0.AccAddress, recipientModule string, amt types0.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromAccountToModule", ctx, senderAddr, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromAccountToModule indicates an expected call of SendCoinsFromAccountToModule.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromAccountToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromAccountToModule), ctx, senderAddr, recipientModule, amt)
+}
+
+// MockPortKeeper is a mock of PortKeeper interface.
+type MockPortKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockPortKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockPortKeeperMockRecorder is the mock recorder for MockPortKeeper.
+type MockPortKeeperMockRecorder struct {
+	mock *MockPortKeeper
+}
+
+// NewMockPortKeeper creates a new mock instance.
+func NewMockPortKeeper(ctrl *gomock.Controller) *MockPortKeeper {
+	mock := &MockPortKeeper{ctrl: ctrl}
+	mock.recorder = &MockPortKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPortKeeper) EXPECT() *MockPortKeeperMockRecorder {
+	return m.recorder
+}
+
+// BindPort mocks base method.
+func (m *MockPortKeeper) BindPort(ctx types0.Context, portID string) *types2.Capability {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BindPort", ctx, portID)
+	ret0, _ := ret[0].(*types2.Capability)
+	return ret0
+}
+
+// BindPort indicates an expected call of BindPort.
+func (mr *MockPortKeeperMockRecorder) BindPort(ctx, portID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindPort", reflect.TypeOf((*MockPortKeeper)(nil).BindPort), ctx, portID)
+}
+
+// MockScopedKeeper is a mock of ScopedKeeper interface.
+type MockScopedKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockScopedKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockScopedKeeperMockRecorder is the mock recorder for MockScopedKeeper.
+type MockScopedKeeperMockRecorder struct {
+	mock *MockScopedKeeper
+}
+
+// NewMockScopedKeeper creates a new mock instance.
+func NewMockScopedKeeper(ctrl *gomock.Controller) *MockScopedKeeper {
+	mock := &MockScopedKeeper{ctrl: ctrl}
+	mock.recorder = &MockScopedKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScopedKeeper) EXPECT() *MockScopedKeeperMockRecorder {
+	return m.recorder
+}
+
+// AuthenticateCapability mocks base method.
+func (m *MockScopedKeeper) AuthenticateCapability(ctx types0.Context, cap *types2.Capability, name string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateCapability", ctx, cap, name)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// AuthenticateCapability indicates an expected call of AuthenticateCapability.
+func (mr *MockScopedKeeperMockRecorder) AuthenticateCapability(ctx, cap, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateCapability", reflect.TypeOf((*MockScopedKeeper)(nil).AuthenticateCapability), ctx, cap, name)
+}
+
+// ClaimCapability mocks base method.
+func (m *MockScopedKeeper) ClaimCapability(ctx types0.Context, cap *types2.Capability, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimCapability", ctx, cap, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClaimCapability indicates an expected call of ClaimCapability.
+func (mr *MockScopedKeeperMockRecorder) ClaimCapability(ctx, cap, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimCapability", reflect.TypeOf((*MockScopedKeeper)(nil).ClaimCapability), ctx, cap, name)
+}
+
+// GetCapability mocks base method.
+func (m *MockScopedKeeper) GetCapability(ctx types0.Context, name string) (*types2.Capability, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCapability", ctx, name)
+	ret0, _ := ret[0].(*types2.Capability)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetCapability indicates an expected call of GetCapability.
+func (mr *MockScopedKeeperMockRecorder) GetCapability(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCapability", reflect.TypeOf((*MockScopedKeeper)(nil).GetCapability), ctx, name)
+}
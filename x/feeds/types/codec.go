@@ -10,11 +10,23 @@ import (
 	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
 )
 
+// ModuleCdc references the global x/feeds module codec. Note, the codec
+// should ONLY be used in certain instances of tests and for JSON encoding.
+//
+// The actual codec used for serialization should be provided to x/feeds and
+// defined at the application level.
+var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
 // RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "feeds/MsgVote")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateSignalVote{}, "feeds/MsgDelegateSignalVote")
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitSignalPrices{}, "feeds/MsgSubmitSignalPrices")
+	legacy.RegisterAminoMsg(cdc, &MsgCommitSignalPrices{}, "feeds/MsgCommitSignalPrices")
+	legacy.RegisterAminoMsg(cdc, &MsgRevealSignalPrices{}, "feeds/MsgRevealSignalPrices")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateReferenceSourceConfig{}, "feeds/MsgUpdateReferenceSourceConfig")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateSignalInfos{}, "feeds/MsgUpdateSignalInfos")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdatePriceGuards{}, "feeds/MsgUpdatePriceGuards")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "feeds/MsgUpdateParams")
 
 	cdc.RegisterConcrete(&FeedsSignatureOrder{}, "feeds/FeedsSignatureOrder", nil)
@@ -26,8 +38,13 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgVote{},
+		&MsgDelegateSignalVote{},
 		&MsgSubmitSignalPrices{},
+		&MsgCommitSignalPrices{},
+		&MsgRevealSignalPrices{},
 		&MsgUpdateReferenceSourceConfig{},
+		&MsgUpdateSignalInfos{},
+		&MsgUpdatePriceGuards{},
 		&MsgUpdateParams{},
 	)
 
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSignalPricesCommitment creates a new SignalPricesCommitment instance.
+func NewSignalPricesCommitment(val sdk.ValAddress, hash []byte, round int64) SignalPricesCommitment {
+	return SignalPricesCommitment{
+		Validator: val.String(),
+		Hash:      hash,
+		Round:     round,
+	}
+}
+
+// GetCommitRevealRound returns the commit-reveal round of the given timestamp and whether the timestamp is in
+// the commit phase of the round. Each round starts with the commit phase followed by the reveal phase.
+func GetCommitRevealRound(timestamp int64, commitWindow int64, revealWindow int64) (round int64, isCommitPhase bool) {
+	roundDuration := commitWindow + revealWindow
+	return timestamp / roundDuration, timestamp%roundDuration < commitWindow
+}
+
+// GetRevealPhaseStartTime returns the timestamp at which the reveal phase of the given round starts.
+func GetRevealPhaseStartTime(round int64, commitWindow int64, revealWindow int64) int64 {
+	return round*(commitWindow+revealWindow) + commitWindow
+}
+
+// CalculateSignalPricesCommitHash calculates the hash committed by a validator for the given salt and signal
+// prices. Each variable-length field is prefixed with its length so that the encoding is unambiguous.
+func CalculateSignalPricesCommitHash(val sdk.ValAddress, salt []byte, signalPrices []SignalPrice) []byte {
+	bz := appendLengthPrefixed(nil, val.Bytes())
+	bz = appendLengthPrefixed(bz, salt)
+	for _, signalPrice := range signalPrices {
+		bz = appendLengthPrefixed(bz, []byte(signalPrice.SignalID))
+		bz = binary.BigEndian.AppendUint32(bz, uint32(signalPrice.Status))
+		bz = binary.BigEndian.AppendUint64(bz, signalPrice.Price)
+	}
+
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
+
+// appendLengthPrefixed appends the length of the data as a 4-byte big-endian integer followed by the data.
+func appendLengthPrefixed(bz []byte, data []byte) []byte {
+	bz = binary.BigEndian.AppendUint32(bz, uint32(len(data)))
+	return append(bz, data...)
+}
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func TestGetCommitRevealRound(t *testing.T) {
+	testCases := []struct {
+		name             string
+		timestamp        int64
+		expRound         int64
+		expIsCommitPhase bool
+	}{
+		{"start of commit phase", 1200, 20, true},
+		{"end of commit phase", 1239, 20, true},
+		{"start of reveal phase", 1240, 20, false},
+		{"end of reveal phase", 1259, 20, false},
+		{"start of next round", 1260, 21, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			round, isCommitPhase := types.GetCommitRevealRound(tc.timestamp, 40, 20)
+			require.Equal(t, tc.expRound, round)
+			require.Equal(t, tc.expIsCommitPhase, isCommitPhase)
+		})
+	}
+
+	require.Equal(t, int64(1240), types.GetRevealPhaseStartTime(20, 40, 20))
+}
+
+func TestCalculateSignalPricesCommitHash(t *testing.T) {
+	val := sdk.ValAddress("1000000001")
+	salt := []byte("salt")
+	signalPrices := []types.SignalPrice{
+		types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000),
+		types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_UNAVAILABLE, "CS:BTC-USD", 0),
+	}
+
+	hash := types.CalculateSignalPricesCommitHash(val, salt, signalPrices)
+	require.Len(t, hash, 32)
+	require.Equal(t, hash, types.CalculateSignalPricesCommitHash(val, salt, signalPrices))
+
+	// different validator
+	require.NotEqual(t, hash, types.CalculateSignalPricesCommitHash(sdk.ValAddress("1000000002"), salt, signalPrices))
+
+	// different salt
+	require.NotEqual(t, hash, types.CalculateSignalPricesCommitHash(val, []byte("salt2"), signalPrices))
+
+	// different price
+	changedSignalPrices := []types.SignalPrice{
+		types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1001),
+		types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_UNAVAILABLE, "CS:BTC-USD", 0),
+	}
+	require.NotEqual(t, hash, types.CalculateSignalPricesCommitHash(val, salt, changedSignalPrices))
+}
@@ -4,6 +4,15 @@ const (
 	// MaxSignalIDCharacters defines the maximum number of characters allowed in a signal ID.
 	MaxSignalIDCharacters uint64 = 32
 
+	// MaxSignalInfoDecimals defines the maximum number of display decimals of a signal info.
+	MaxSignalInfoDecimals uint32 = 18
+
+	// MaxSignalInfoTextCharacters defines the maximum number of characters allowed in a text field of a signal info.
+	MaxSignalInfoTextCharacters uint64 = 256
+
+	// MaxCommitSaltLength defines the maximum length of a salt used to commit signal prices.
+	MaxCommitSaltLength = 64
+
 	// ExpectedBlockTime specifies the expected block time (in seconds).
 	ExpectedBlockTime int64 = 1
 )
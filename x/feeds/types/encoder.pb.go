@@ -4,10 +4,13 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
 	math "math"
+	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -31,18 +34,30 @@ const (
 	ENCODER_FIXED_POINT_ABI Encoder = 1
 	// ENCODER_TICK_ABI is a tick abi encoder.
 	ENCODER_TICK_ABI Encoder = 2
+	// ENCODER_FIXED_POINT_BORSH is a fixed-point price borsh encoder (price * 10^9) for Solana programs.
+	ENCODER_FIXED_POINT_BORSH Encoder = 3
+	// ENCODER_FIXED_POINT_BCS is a fixed-point price bcs encoder (price * 10^9) for Move chains.
+	ENCODER_FIXED_POINT_BCS Encoder = 4
+	// ENCODER_FIXED_POINT_PROTOBUF is a fixed-point price protobuf encoder (price * 10^9).
+	ENCODER_FIXED_POINT_PROTOBUF Encoder = 5
 )
 
 var Encoder_name = map[int32]string{
 	0: "ENCODER_UNSPECIFIED",
 	1: "ENCODER_FIXED_POINT_ABI",
 	2: "ENCODER_TICK_ABI",
+	3: "ENCODER_FIXED_POINT_BORSH",
+	4: "ENCODER_FIXED_POINT_BCS",
+	5: "ENCODER_FIXED_POINT_PROTOBUF",
 }
 
 var Encoder_value = map[string]int32{
-	"ENCODER_UNSPECIFIED":     0,
-	"ENCODER_FIXED_POINT_ABI": 1,
-	"ENCODER_TICK_ABI":        2,
+	"ENCODER_UNSPECIFIED":          0,
+	"ENCODER_FIXED_POINT_ABI":      1,
+	"ENCODER_TICK_ABI":             2,
+	"ENCODER_FIXED_POINT_BORSH":    3,
+	"ENCODER_FIXED_POINT_BCS":      4,
+	"ENCODER_FIXED_POINT_PROTOBUF": 5,
 }
 
 func (x Encoder) String() string {
@@ -53,27 +68,628 @@ func (Encoder) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_ac3e992b65436f01, []int{0}
 }
 
+// EncodedRelayPrice is the protobuf layout of a relay price encoded by ENCODER_FIXED_POINT_PROTOBUF.
+type EncodedRelayPrice struct {
+	// signal_id is the signal ID right-aligned and zero-padded to 32 bytes.
+	SignalID []byte `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// price is the fixed-point price (price * 10^9).
+	Price uint64 `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (m *EncodedRelayPrice) Reset()         { *m = EncodedRelayPrice{} }
+func (m *EncodedRelayPrice) String() string { return proto.CompactTextString(m) }
+func (*EncodedRelayPrice) ProtoMessage()    {}
+func (*EncodedRelayPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac3e992b65436f01, []int{0}
+}
+func (m *EncodedRelayPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncodedRelayPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncodedRelayPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncodedRelayPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncodedRelayPrice.Merge(m, src)
+}
+func (m *EncodedRelayPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncodedRelayPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncodedRelayPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncodedRelayPrice proto.InternalMessageInfo
+
+func (m *EncodedRelayPrice) GetSignalID() []byte {
+	if m != nil {
+		return m.SignalID
+	}
+	return nil
+}
+
+func (m *EncodedRelayPrice) GetPrice() uint64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+// EncodedRelayPriceData is the protobuf layout of the feeds prices encoded by ENCODER_FIXED_POINT_PROTOBUF.
+type EncodedRelayPriceData struct {
+	// prices is the list of relay prices.
+	Prices []EncodedRelayPrice `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
+	// timestamp is the timestamp of the prices.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *EncodedRelayPriceData) Reset()         { *m = EncodedRelayPriceData{} }
+func (m *EncodedRelayPriceData) String() string { return proto.CompactTextString(m) }
+func (*EncodedRelayPriceData) ProtoMessage()    {}
+func (*EncodedRelayPriceData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac3e992b65436f01, []int{1}
+}
+func (m *EncodedRelayPriceData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncodedRelayPriceData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncodedRelayPriceData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncodedRelayPriceData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncodedRelayPriceData.Merge(m, src)
+}
+func (m *EncodedRelayPriceData) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncodedRelayPriceData) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncodedRelayPriceData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncodedRelayPriceData proto.InternalMessageInfo
+
+func (m *EncodedRelayPriceData) GetPrices() []EncodedRelayPrice {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+func (m *EncodedRelayPriceData) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("band.feeds.v1beta1.Encoder", Encoder_name, Encoder_value)
+	proto.RegisterType((*EncodedRelayPrice)(nil), "band.feeds.v1beta1.EncodedRelayPrice")
+	proto.RegisterType((*EncodedRelayPriceData)(nil), "band.feeds.v1beta1.EncodedRelayPriceData")
 }
 
 func init() { proto.RegisterFile("band/feeds/v1beta1/encoder.proto", fileDescriptor_ac3e992b65436f01) }
 
 var fileDescriptor_ac3e992b65436f01 = []byte{
-	// 226 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4a, 0xcc, 0x4b,
-	0xd1, 0x4f, 0x4b, 0x4d, 0x4d, 0x29, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f,
-	0xcd, 0x4b, 0xce, 0x4f, 0x49, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x02, 0xa9,
-	0xd0, 0x03, 0xab, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83,
-	0x58, 0x10, 0x95, 0x5a, 0xd1, 0x5c, 0xec, 0xae, 0x10, 0xad, 0x42, 0xe2, 0x5c, 0xc2, 0xae, 0x7e,
-	0xce, 0xfe, 0x2e, 0xae, 0x41, 0xf1, 0xa1, 0x7e, 0xc1, 0x01, 0xae, 0xce, 0x9e, 0x6e, 0x9e, 0xae,
-	0x2e, 0x02, 0x0c, 0x42, 0xd2, 0x5c, 0xe2, 0x30, 0x09, 0x37, 0xcf, 0x08, 0x57, 0x97, 0xf8, 0x00,
-	0x7f, 0x4f, 0xbf, 0x90, 0x78, 0x47, 0x27, 0x4f, 0x01, 0x46, 0x21, 0x11, 0x2e, 0x01, 0x98, 0x64,
-	0x88, 0xa7, 0xb3, 0x37, 0x58, 0x94, 0x49, 0x8a, 0xa5, 0x63, 0xb1, 0x1c, 0x83, 0x93, 0xc7, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x83, 0xdc, 0x0a, 0x76, 0x4c, 0x72, 0x7e, 0x8e, 0x7e, 0x72, 0x46,
-	0x62, 0x66, 0x9e, 0x7e, 0x99, 0xb1, 0x7e, 0x05, 0xd4, 0x83, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49,
-	0x6c, 0x60, 0x05, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xef, 0x0d, 0x10, 0xc0, 0xfb, 0x00,
-	0x00, 0x00,
+	// 403 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4d, 0x6f, 0xd3, 0x30,
+	0x1c, 0xc6, 0xe3, 0x35, 0x1b, 0x9b, 0xd9, 0x21, 0x98, 0xa2, 0x95, 0x31, 0xd2, 0x68, 0x12, 0x52,
+	0xe1, 0x10, 0x6b, 0xec, 0xc6, 0x8d, 0xbc, 0x54, 0xb3, 0x90, 0x9a, 0xc8, 0xe9, 0x24, 0xb4, 0x4b,
+	0xe4, 0x24, 0x26, 0x8b, 0xd4, 0x26, 0x51, 0x12, 0x2a, 0x7a, 0xe3, 0xc8, 0x91, 0x8f, 0x80, 0xc4,
+	0xa7, 0xe0, 0x1b, 0xf4, 0xd8, 0x23, 0xa7, 0x0a, 0xa5, 0x17, 0x3e, 0x06, 0x8a, 0xd3, 0x8a, 0x43,
+	0xbb, 0x9b, 0xfd, 0x3c, 0xbf, 0xe7, 0xf1, 0x5f, 0xb6, 0xa1, 0x16, 0xb0, 0x34, 0xc2, 0x9f, 0x38,
+	0x8f, 0x4a, 0x3c, 0xbb, 0x0a, 0x78, 0xc5, 0xae, 0x30, 0x4f, 0xc3, 0x2c, 0xe2, 0x85, 0x9e, 0x17,
+	0x59, 0x95, 0x21, 0xd4, 0x10, 0xba, 0x20, 0xf4, 0x0d, 0x71, 0xde, 0x8d, 0xb3, 0x38, 0x13, 0x36,
+	0x6e, 0x56, 0x2d, 0x79, 0x79, 0x07, 0x9f, 0xd8, 0x22, 0x1a, 0x51, 0x3e, 0x61, 0x73, 0xb7, 0x48,
+	0x42, 0x8e, 0x5e, 0xc3, 0x93, 0x32, 0x89, 0x53, 0x36, 0xf1, 0x93, 0xa8, 0x07, 0x34, 0x30, 0x38,
+	0x35, 0x4e, 0xeb, 0x55, 0xff, 0xd8, 0x13, 0x22, 0xb1, 0xe8, 0x71, 0x6b, 0x93, 0x08, 0x75, 0xe1,
+	0x61, 0xde, 0x64, 0x7a, 0x07, 0x1a, 0x18, 0xc8, 0xb4, 0xdd, 0xbc, 0x93, 0xff, 0xfe, 0xe8, 0x83,
+	0xcb, 0xaf, 0x00, 0x3e, 0xdb, 0x29, 0xb7, 0x58, 0xc5, 0x90, 0x09, 0x8f, 0x04, 0x58, 0xf6, 0x80,
+	0xd6, 0x19, 0x3c, 0x7e, 0xfb, 0x4a, 0xdf, 0x1d, 0x58, 0xdf, 0x89, 0x1a, 0xf2, 0x62, 0xd5, 0x97,
+	0xe8, 0x26, 0x8a, 0x2e, 0xe0, 0x49, 0x95, 0x4c, 0x79, 0x59, 0xb1, 0x69, 0x2e, 0x8e, 0xef, 0xd0,
+	0xff, 0x42, 0x3b, 0xc2, 0x9b, 0x5f, 0x00, 0x3e, 0x6a, 0x7b, 0x0a, 0x74, 0x06, 0x9f, 0xda, 0x23,
+	0xd3, 0xb1, 0x6c, 0xea, 0xdf, 0x8e, 0x3c, 0xd7, 0x36, 0xc9, 0x90, 0xd8, 0x96, 0x22, 0xa1, 0x17,
+	0xf0, 0x6c, 0x6b, 0x0c, 0xc9, 0x47, 0xdb, 0xf2, 0x5d, 0x87, 0x8c, 0xc6, 0xfe, 0x7b, 0x83, 0x28,
+	0x00, 0x75, 0xa1, 0xb2, 0x35, 0xc7, 0xc4, 0xfc, 0x20, 0xd4, 0x03, 0xf4, 0x12, 0x3e, 0xdf, 0x17,
+	0x31, 0x1c, 0xea, 0xdd, 0x28, 0x9d, 0x87, 0x1a, 0x0d, 0xd3, 0x53, 0x64, 0xa4, 0xc1, 0x8b, 0x7d,
+	0xa6, 0x4b, 0x9d, 0xb1, 0x63, 0xdc, 0x0e, 0x95, 0xc3, 0x73, 0xf9, 0xdb, 0x4f, 0x55, 0x32, 0x6e,
+	0x16, 0xb5, 0x0a, 0x96, 0xb5, 0x0a, 0xfe, 0xd4, 0x2a, 0xf8, 0xbe, 0x56, 0xa5, 0xe5, 0x5a, 0x95,
+	0x7e, 0xaf, 0x55, 0xe9, 0x4e, 0x8f, 0x93, 0xea, 0xfe, 0x73, 0xa0, 0x87, 0xd9, 0x14, 0x37, 0x17,
+	0x27, 0x9e, 0x32, 0xcc, 0x26, 0x38, 0xbc, 0x67, 0x49, 0x8a, 0x67, 0xd7, 0xf8, 0xcb, 0xe6, 0x7b,
+	0x54, 0xf3, 0x9c, 0x97, 0xc1, 0x91, 0x00, 0xae, 0xff, 0x0d, 0x00, 0x59, 0xf7, 0x6c, 0x69, 0x39,
+	0x02, 0x00, 0x00,
+}
+
+func (this *EncodedRelayPrice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EncodedRelayPrice)
+	if !ok {
+		that2, ok := that.(EncodedRelayPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.SignalID, that1.SignalID) {
+		return false
+	}
+	if this.Price != that1.Price {
+		return false
+	}
+	return true
+}
+func (this *EncodedRelayPriceData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EncodedRelayPriceData)
+	if !ok {
+		that2, ok := that.(EncodedRelayPriceData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Prices) != len(that1.Prices) {
+		return false
+	}
+	for i := range this.Prices {
+		if !this.Prices[i].Equal(&that1.Prices[i]) {
+			return false
+		}
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	return true
+}
+func (m *EncodedRelayPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncodedRelayPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncodedRelayPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Price != 0 {
+		i = encodeVarintEncoder(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalID) > 0 {
+		i -= len(m.SignalID)
+		copy(dAtA[i:], m.SignalID)
+		i = encodeVarintEncoder(dAtA, i, uint64(len(m.SignalID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncodedRelayPriceData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncodedRelayPriceData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncodedRelayPriceData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintEncoder(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEncoder(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
+
+func encodeVarintEncoder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEncoder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EncodedRelayPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalID)
+	if l > 0 {
+		n += 1 + l + sovEncoder(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 1 + sovEncoder(uint64(m.Price))
+	}
+	return n
+}
+
+func (m *EncodedRelayPriceData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovEncoder(uint64(l))
+		}
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovEncoder(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func sovEncoder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEncoder(x uint64) (n int) {
+	return sovEncoder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EncodedRelayPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncoder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncodedRelayPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncodedRelayPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncoder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEncoder
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncoder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalID = append(m.SignalID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignalID == nil {
+				m.SignalID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncoder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncoder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncoder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncodedRelayPriceData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncoder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncodedRelayPriceData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncodedRelayPriceData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncoder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncoder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncoder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, EncodedRelayPrice{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncoder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncoder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncoder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEncoder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEncoder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEncoder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEncoder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEncoder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEncoder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEncoder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEncoder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEncoder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEncoder = fmt.Errorf("proto: unexpected end of group")
+)
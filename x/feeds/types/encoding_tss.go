@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/binary"
+
 	"github.com/ethereum/go-ethereum/accounts/abi"
 
 	"github.com/bandprotocol/chain/v3/pkg/tickmath"
@@ -9,6 +11,10 @@ import (
 const (
 	EncoderFixedPointABIPrefix = "\xcb\xa0\xad\x5a" // tss.Hash([]byte("FixedPointABI"))[:4]
 	EncoderTickABIPrefix       = "\xdb\x99\xb2\xb3" // tss.Hash([]byte("TickABI"))[:4]
+
+	EncoderFixedPointBorshPrefix    = "\xa8\x4e\xa1\x74" // tss.Hash([]byte("FixedPointBorsh"))[:4]
+	EncoderFixedPointBCSPrefix      = "\xb7\x98\x5e\x14" // tss.Hash([]byte("FixedPointBCS"))[:4]
+	EncoderFixedPointProtobufPrefix = "\xac\x22\x8f\xb2" // tss.Hash([]byte("FixedPointProtobuf"))[:4]
 )
 
 var (
@@ -76,6 +82,57 @@ func ToRelayTickPrices(prices []Price) ([]RelayPrice, error) {
 	return relayPrices, nil
 }
 
+// ToEncodedRelayPrices converts a list of RelayPrice to its protobuf layout
+func ToEncodedRelayPrices(relayPrices []RelayPrice) []EncodedRelayPrice {
+	encodedPrices := make([]EncodedRelayPrice, 0, len(relayPrices))
+	for _, rp := range relayPrices {
+		encodedPrices = append(encodedPrices, EncodedRelayPrice{SignalID: rp.SignalID[:], Price: rp.Price})
+	}
+
+	return encodedPrices
+}
+
+// AppendBorshRelayPrices appends the borsh encoding of the relay prices to the given bytes. The relay prices
+// are encoded as a Vec of (signal_id: [u8; 32], price: u64), i.e. a u32 little-endian length followed by the items.
+func AppendBorshRelayPrices(bz []byte, relayPrices []RelayPrice) []byte {
+	bz = binary.LittleEndian.AppendUint32(bz, uint32(len(relayPrices)))
+	for _, rp := range relayPrices {
+		bz = append(bz, rp.SignalID[:]...)
+		bz = binary.LittleEndian.AppendUint64(bz, rp.Price)
+	}
+
+	return bz
+}
+
+// AppendBCSRelayPrices appends the bcs encoding of the relay prices to the given bytes. The relay prices
+// are encoded as a vector of (signal_id: vector<u8>, price: u64), i.e. a ULEB128 length followed by the items.
+func AppendBCSRelayPrices(bz []byte, relayPrices []RelayPrice) []byte {
+	bz = binary.AppendUvarint(bz, uint64(len(relayPrices)))
+	for _, rp := range relayPrices {
+		bz = binary.AppendUvarint(bz, uint64(len(rp.SignalID)))
+		bz = append(bz, rp.SignalID[:]...)
+		bz = binary.LittleEndian.AppendUint64(bz, rp.Price)
+	}
+
+	return bz
+}
+
+// AppendBCSTimestamp appends the bcs encoding of the timestamp as u64 to the given bytes,
+// as Move does not support signed integers.
+func AppendBCSTimestamp(bz []byte, timestamp int64) ([]byte, error) {
+	if timestamp < 0 {
+		return nil, ErrEncodingPriceFailed.Wrapf("negative timestamp %d is not supported", timestamp)
+	}
+
+	return binary.LittleEndian.AppendUint64(bz, uint64(timestamp)), nil
+}
+
+// AppendBorshTimestamp appends the borsh encoding of the timestamp as i64 to the given bytes.
+func AppendBorshTimestamp(bz []byte, timestamp int64) []byte {
+	// two's complement conversion keeps the i64 little-endian layout
+	return binary.LittleEndian.AppendUint64(bz, uint64(timestamp))
+}
+
 // EncodeTSS encodes the feed prices to tss message
 func EncodeTSS(prices []Price, timestamp int64, encoder Encoder) ([]byte, error) {
 	switch encoder {
@@ -103,6 +160,35 @@ func EncodeTSS(prices []Price, timestamp int64, encoder Encoder) ([]byte, error)
 		}
 
 		return append([]byte(EncoderTickABIPrefix), bz...), nil
+	case ENCODER_FIXED_POINT_BORSH:
+		relayPrices, err := ToRelayPrices(prices)
+		if err != nil {
+			return nil, err
+		}
+
+		bz := AppendBorshRelayPrices([]byte(EncoderFixedPointBorshPrefix), relayPrices)
+		return AppendBorshTimestamp(bz, timestamp), nil
+	case ENCODER_FIXED_POINT_BCS:
+		relayPrices, err := ToRelayPrices(prices)
+		if err != nil {
+			return nil, err
+		}
+
+		bz := AppendBCSRelayPrices([]byte(EncoderFixedPointBCSPrefix), relayPrices)
+		return AppendBCSTimestamp(bz, timestamp)
+	case ENCODER_FIXED_POINT_PROTOBUF:
+		relayPrices, err := ToRelayPrices(prices)
+		if err != nil {
+			return nil, err
+		}
+
+		data := EncodedRelayPriceData{Prices: ToEncodedRelayPrices(relayPrices), Timestamp: timestamp}
+		bz, err := data.Marshal()
+		if err != nil {
+			return nil, ErrEncodingPriceFailed.Wrapf("failed to encode price data: %s", err)
+		}
+
+		return append([]byte(EncoderFixedPointProtobufPrefix), bz...), nil
 	default:
 		return nil, ErrInvalidEncoder.Wrapf("invalid encoder: %s", encoder)
 	}
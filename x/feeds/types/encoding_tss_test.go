@@ -13,6 +13,9 @@ import (
 func TestEncoderPrefix(t *testing.T) {
 	require.Equal(t, []byte(types.EncoderFixedPointABIPrefix), tss.Hash([]byte("FixedPointABI"))[:4])
 	require.Equal(t, []byte(types.EncoderTickABIPrefix), tss.Hash([]byte("TickABI"))[:4])
+	require.Equal(t, []byte(types.EncoderFixedPointBorshPrefix), tss.Hash([]byte("FixedPointBorsh"))[:4])
+	require.Equal(t, []byte(types.EncoderFixedPointBCSPrefix), tss.Hash([]byte("FixedPointBCS"))[:4])
+	require.Equal(t, []byte(types.EncoderFixedPointProtobufPrefix), tss.Hash([]byte("FixedPointProtobuf"))[:4])
 }
 
 func TestPriceEncoderEncodingABI(t *testing.T) {
@@ -39,6 +42,45 @@ func TestTickPriceEncoderEncodingABI(t *testing.T) {
 	require.Equal(t, expected, hex.EncodeToString(result))
 }
 
+func TestPriceEncoderEncodingBorsh(t *testing.T) {
+	prices := []types.Price{
+		{SignalID: "testSignal", Price: 100, Status: types.PRICE_STATUS_AVAILABLE},
+	}
+
+	result, err := types.EncodeTSS(prices, 123456789, types.ENCODER_FIXED_POINT_BORSH)
+	require.NoError(t, err)
+
+	expected := "a84ea1740100000000000000000000000000000000000000000000000000746573745369676e616c640000000000000015cd5b0700000000"
+	require.Equal(t, expected, hex.EncodeToString(result))
+}
+
+func TestPriceEncoderEncodingBCS(t *testing.T) {
+	prices := []types.Price{
+		{SignalID: "testSignal", Price: 100, Status: types.PRICE_STATUS_AVAILABLE},
+	}
+
+	result, err := types.EncodeTSS(prices, 123456789, types.ENCODER_FIXED_POINT_BCS)
+	require.NoError(t, err)
+
+	expected := "b7985e14012000000000000000000000000000000000000000000000746573745369676e616c640000000000000015cd5b0700000000"
+	require.Equal(t, expected, hex.EncodeToString(result))
+
+	_, err = types.EncodeTSS(prices, -1, types.ENCODER_FIXED_POINT_BCS)
+	require.ErrorIs(t, err, types.ErrEncodingPriceFailed)
+}
+
+func TestPriceEncoderEncodingProtobuf(t *testing.T) {
+	prices := []types.Price{
+		{SignalID: "testSignal", Price: 100, Status: types.PRICE_STATUS_AVAILABLE},
+	}
+
+	result, err := types.EncodeTSS(prices, 123456789, types.ENCODER_FIXED_POINT_PROTOBUF)
+	require.NoError(t, err)
+
+	expected := "ac228fb20a240a2000000000000000000000000000000000000000000000746573745369676e616c106410959aef3a"
+	require.Equal(t, expected, hex.EncodeToString(result))
+}
+
 func TestToRelayPrices(t *testing.T) {
 	signalIDAtom, err := types.StringToBytes32("CS:ATOM-USD")
 	require.NoError(t, err)
@@ -26,4 +26,21 @@ var (
 	ErrInvalidSignalIDs         = errorsmod.Register(ModuleName, 19, "invalid signal ids")
 	ErrInvalidEncoder           = errorsmod.Register(ModuleName, 20, "invalid encoder")
 	ErrEncodingPriceFailed      = errorsmod.Register(ModuleName, 21, "fail to encode price")
+	ErrInvalidTWAPWindow        = errorsmod.Register(ModuleName, 22, "invalid twap window")
+	ErrSignalInfoNotFound       = errorsmod.Register(ModuleName, 23, "signal info not found")
+	ErrInvalidSignalInfo        = errorsmod.Register(ModuleName, 24, "invalid signal info")
+	ErrSignalNotRegistered      = errorsmod.Register(ModuleName, 25, "signal is not registered")
+	ErrPriceGuardNotFound       = errorsmod.Register(ModuleName, 26, "price guard not found")
+	ErrInvalidPriceGuard        = errorsmod.Register(ModuleName, 27, "invalid price guard")
+	ErrInvalidVersion           = errorsmod.Register(ModuleName, 28, "invalid ibc version")
+	ErrMaxFeedsChannels         = errorsmod.Register(ModuleName, 29, "max feeds channels")
+	ErrChannelNotAllowed        = errorsmod.Register(ModuleName, 30, "channel is not allowed to query prices")
+	ErrInvalidPacketData        = errorsmod.Register(ModuleName, 31, "invalid packet data")
+	ErrCommitRevealDisabled     = errorsmod.Register(ModuleName, 32, "commit-reveal is disabled")
+	ErrCommitRevealRequired     = errorsmod.Register(ModuleName, 33, "signal prices must be committed and revealed")
+	ErrInvalidCommitRevealPhase = errorsmod.Register(ModuleName, 34, "invalid commit-reveal phase")
+	ErrCommitmentNotFound       = errorsmod.Register(ModuleName, 35, "commitment not found")
+	ErrCommitmentMismatch       = errorsmod.Register(ModuleName, 36, "revealed signal prices do not match commitment")
+	ErrInvalidDelegation        = errorsmod.Register(ModuleName, 37, "invalid signal vote delegation")
+	ErrDelegationNotFound       = errorsmod.Register(ModuleName, 38, "signal vote delegation not found")
 )
@@ -9,6 +9,14 @@ const (
 	EventTypeUpdateCurrentFeeds          = "update_current_feeds"
 	EventTypeUpdateReferenceSourceConfig = "update_reference_source_config"
 	EventTypeUpdateParams                = "update_params"
+	EventTypePenalizeOutlierValidator    = "penalize_outlier_validator"
+	EventTypeUpdateSignalInfo            = "update_signal_info"
+	EventTypeUpdatePriceGuard            = "update_price_guard"
+	EventTypeRemovePriceGuard            = "remove_price_guard"
+	EventTypeHaltPrice                   = "halt_price"
+	EventTypeQueryPrices                 = "query_prices"
+	EventTypeCommitSignalPrices          = "commit_signal_prices"
+	EventTypeDelegateSignalVote          = "delegate_signal_vote"
 
 	AttributeKeySignalPriceStatus   = "signal_price_status"
 	AttributeKeyPriceStatus         = "price_status"
@@ -25,4 +33,24 @@ const (
 	AttributeKeyRegistryIPFSHash    = "registry_ipfs_hash"
 	AttributeKeyRegistryVersion     = "registry_version"
 	AttributeKeyParams              = "params"
+	AttributeKeyEvaluatedCount      = "evaluated_count"
+	AttributeKeyOutlierCount        = "outlier_count"
+	AttributeKeyPenalty             = "penalty"
+	AttributeKeyDecimals            = "decimals"
+	AttributeKeyQuoteCurrency       = "quote_currency"
+	AttributeKeyAssetClass          = "asset_class"
+	AttributeKeyDeprecated          = "deprecated"
+	AttributeKeyMaxChangeBasisPoint = "max_change_basis_point"
+	AttributeKeyMinPrice            = "min_price"
+	AttributeKeyMaxPrice            = "max_price"
+	AttributeKeyMaxStaleness        = "max_staleness"
+	AttributeKeyReason              = "reason"
+	AttributeKeyChannelID           = "channel_id"
+	AttributeKeySequence            = "sequence"
+	AttributeKeyFee                 = "fee"
+	AttributeKeyFeePayer            = "fee_payer"
+	AttributeKeyHash                = "hash"
+	AttributeKeyRound               = "round"
+	AttributeKeyDelegator           = "delegator"
+	AttributeKeyCurator             = "curator"
 )
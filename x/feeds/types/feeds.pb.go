@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -38,6 +39,9 @@ const (
 	PRICE_STATUS_AVAILABLE PriceStatus = 3
 	// PRICE_STATUS_NOT_IN_CURRENT_FEEDS is a not in current feed price status.
 	PRICE_STATUS_NOT_IN_CURRENT_FEEDS PriceStatus = 4
+	// PRICE_STATUS_HALTED is a halted price status, i.e. the calculated price breaches the price guard of the signal
+	// id and the last accepted price is kept instead.
+	PRICE_STATUS_HALTED PriceStatus = 5
 )
 
 var PriceStatus_name = map[int32]string{
@@ -46,6 +50,7 @@ var PriceStatus_name = map[int32]string{
 	2: "PRICE_STATUS_NOT_READY",
 	3: "PRICE_STATUS_AVAILABLE",
 	4: "PRICE_STATUS_NOT_IN_CURRENT_FEEDS",
+	5: "PRICE_STATUS_HALTED",
 }
 
 var PriceStatus_value = map[string]int32{
@@ -54,6 +59,7 @@ var PriceStatus_value = map[string]int32{
 	"PRICE_STATUS_NOT_READY":            2,
 	"PRICE_STATUS_AVAILABLE":            3,
 	"PRICE_STATUS_NOT_IN_CURRENT_FEEDS": 4,
+	"PRICE_STATUS_HALTED":               5,
 }
 
 func (x PriceStatus) String() string {
@@ -100,6 +106,46 @@ func (SignalPriceStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_fc3afe81d3b13674, []int{1}
 }
 
+// AssetClass is an enumerator that defines the asset class of a signal.
+type AssetClass int32
+
+const (
+	// ASSET_CLASS_UNSPECIFIED is an unspecified asset class.
+	ASSET_CLASS_UNSPECIFIED AssetClass = 0
+	// ASSET_CLASS_CRYPTO is a cryptocurrency asset class.
+	ASSET_CLASS_CRYPTO AssetClass = 1
+	// ASSET_CLASS_FOREX is a foreign exchange asset class.
+	ASSET_CLASS_FOREX AssetClass = 2
+	// ASSET_CLASS_COMMODITY is a commodity asset class.
+	ASSET_CLASS_COMMODITY AssetClass = 3
+	// ASSET_CLASS_EQUITY is an equity asset class.
+	ASSET_CLASS_EQUITY AssetClass = 4
+)
+
+var AssetClass_name = map[int32]string{
+	0: "ASSET_CLASS_UNSPECIFIED",
+	1: "ASSET_CLASS_CRYPTO",
+	2: "ASSET_CLASS_FOREX",
+	3: "ASSET_CLASS_COMMODITY",
+	4: "ASSET_CLASS_EQUITY",
+}
+
+var AssetClass_value = map[string]int32{
+	"ASSET_CLASS_UNSPECIFIED": 0,
+	"ASSET_CLASS_CRYPTO":      1,
+	"ASSET_CLASS_FOREX":       2,
+	"ASSET_CLASS_COMMODITY":   3,
+	"ASSET_CLASS_EQUITY":      4,
+}
+
+func (x AssetClass) String() string {
+	return proto.EnumName(AssetClass_name, int32(x))
+}
+
+func (AssetClass) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{2}
+}
+
 // Signal is the data structure that contains signal id and power of that signal.
 type Signal struct {
 	// id is the id of the signal.
@@ -210,6 +256,126 @@ func (m *Vote) GetSignals() []Signal {
 	return nil
 }
 
+// SignalVoteDelegation is the data structure that contains the feeds voting power delegated by a delegator to a
+// curator.
+type SignalVoteDelegation struct {
+	// delegator is the address of the delegator.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// curator is the address of the curator voting signals with the delegated power.
+	Curator string `protobuf:"bytes,2,opt,name=curator,proto3" json:"curator,omitempty"`
+	// power is the power delegated to the curator.
+	Power int64 `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *SignalVoteDelegation) Reset()         { *m = SignalVoteDelegation{} }
+func (m *SignalVoteDelegation) String() string { return proto.CompactTextString(m) }
+func (*SignalVoteDelegation) ProtoMessage()    {}
+func (*SignalVoteDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{2}
+}
+func (m *SignalVoteDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalVoteDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalVoteDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalVoteDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalVoteDelegation.Merge(m, src)
+}
+func (m *SignalVoteDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalVoteDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalVoteDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalVoteDelegation proto.InternalMessageInfo
+
+func (m *SignalVoteDelegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *SignalVoteDelegation) GetCurator() string {
+	if m != nil {
+		return m.Curator
+	}
+	return ""
+}
+
+func (m *SignalVoteDelegation) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// CuratorDelegatedPower is the data structure that contains the total power delegated to a curator.
+type CuratorDelegatedPower struct {
+	// curator is the address of the curator.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// power is the total power delegated to the curator.
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *CuratorDelegatedPower) Reset()         { *m = CuratorDelegatedPower{} }
+func (m *CuratorDelegatedPower) String() string { return proto.CompactTextString(m) }
+func (*CuratorDelegatedPower) ProtoMessage()    {}
+func (*CuratorDelegatedPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{3}
+}
+func (m *CuratorDelegatedPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CuratorDelegatedPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CuratorDelegatedPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CuratorDelegatedPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CuratorDelegatedPower.Merge(m, src)
+}
+func (m *CuratorDelegatedPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *CuratorDelegatedPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_CuratorDelegatedPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CuratorDelegatedPower proto.InternalMessageInfo
+
+func (m *CuratorDelegatedPower) GetCurator() string {
+	if m != nil {
+		return m.Curator
+	}
+	return ""
+}
+
+func (m *CuratorDelegatedPower) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
 // Feed is a structure that holds a signal id, its total power, and its calculated interval.
 type Feed struct {
 	// signal_id is the unique string that identifies the unit of feed.
@@ -224,7 +390,7 @@ func (m *Feed) Reset()         { *m = Feed{} }
 func (m *Feed) String() string { return proto.CompactTextString(m) }
 func (*Feed) ProtoMessage()    {}
 func (*Feed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{2}
+	return fileDescriptor_fc3afe81d3b13674, []int{4}
 }
 func (m *Feed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -290,7 +456,7 @@ func (m *FeedWithDeviation) Reset()         { *m = FeedWithDeviation{} }
 func (m *FeedWithDeviation) String() string { return proto.CompactTextString(m) }
 func (*FeedWithDeviation) ProtoMessage()    {}
 func (*FeedWithDeviation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{3}
+	return fileDescriptor_fc3afe81d3b13674, []int{5}
 }
 func (m *FeedWithDeviation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,7 +527,7 @@ func (m *CurrentFeeds) Reset()         { *m = CurrentFeeds{} }
 func (m *CurrentFeeds) String() string { return proto.CompactTextString(m) }
 func (*CurrentFeeds) ProtoMessage()    {}
 func (*CurrentFeeds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{4}
+	return fileDescriptor_fc3afe81d3b13674, []int{6}
 }
 func (m *CurrentFeeds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -426,7 +592,7 @@ func (m *CurrentFeedWithDeviations) Reset()         { *m = CurrentFeedWithDeviat
 func (m *CurrentFeedWithDeviations) String() string { return proto.CompactTextString(m) }
 func (*CurrentFeedWithDeviations) ProtoMessage()    {}
 func (*CurrentFeedWithDeviations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{5}
+	return fileDescriptor_fc3afe81d3b13674, []int{7}
 }
 func (m *CurrentFeedWithDeviations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -486,13 +652,20 @@ type Price struct {
 	Price uint64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
 	// timestamp is the timestamp at which the price was aggregated.
 	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// interquartile_range is the power-weighted interquartile range of the validator prices used in the aggregation.
+	InterquartileRange uint64 `protobuf:"varint,5,opt,name=interquartile_range,json=interquartileRange,proto3" json:"interquartile_range,omitempty"`
+	// participation_basis_point is the power of validators reporting available prices relative to the total bonded
+	// power (in basis point).
+	ParticipationBasisPoint uint64 `protobuf:"varint,6,opt,name=participation_basis_point,json=participationBasisPoint,proto3" json:"participation_basis_point,omitempty"`
+	// reporter_count is the number of validators reporting available prices.
+	ReporterCount uint64 `protobuf:"varint,7,opt,name=reporter_count,json=reporterCount,proto3" json:"reporter_count,omitempty"`
 }
 
 func (m *Price) Reset()         { *m = Price{} }
 func (m *Price) String() string { return proto.CompactTextString(m) }
 func (*Price) ProtoMessage()    {}
 func (*Price) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{6}
+	return fileDescriptor_fc3afe81d3b13674, []int{8}
 }
 func (m *Price) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -549,6 +722,27 @@ func (m *Price) GetTimestamp() int64 {
 	return 0
 }
 
+func (m *Price) GetInterquartileRange() uint64 {
+	if m != nil {
+		return m.InterquartileRange
+	}
+	return 0
+}
+
+func (m *Price) GetParticipationBasisPoint() uint64 {
+	if m != nil {
+		return m.ParticipationBasisPoint
+	}
+	return 0
+}
+
+func (m *Price) GetReporterCount() uint64 {
+	if m != nil {
+		return m.ReporterCount
+	}
+	return 0
+}
+
 // SignalPrice is a structure that defines the signaled price of a signal id.
 type SignalPrice struct {
 	// status is the status of the signal price.
@@ -563,7 +757,7 @@ func (m *SignalPrice) Reset()         { *m = SignalPrice{} }
 func (m *SignalPrice) String() string { return proto.CompactTextString(m) }
 func (*SignalPrice) ProtoMessage()    {}
 func (*SignalPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{7}
+	return fileDescriptor_fc3afe81d3b13674, []int{9}
 }
 func (m *SignalPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -631,7 +825,7 @@ func (m *ValidatorPrice) Reset()         { *m = ValidatorPrice{} }
 func (m *ValidatorPrice) String() string { return proto.CompactTextString(m) }
 func (*ValidatorPrice) ProtoMessage()    {}
 func (*ValidatorPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{8}
+	return fileDescriptor_fc3afe81d3b13674, []int{10}
 }
 func (m *ValidatorPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -708,7 +902,7 @@ func (m *ValidatorPriceList) Reset()         { *m = ValidatorPriceList{} }
 func (m *ValidatorPriceList) String() string { return proto.CompactTextString(m) }
 func (*ValidatorPriceList) ProtoMessage()    {}
 func (*ValidatorPriceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{9}
+	return fileDescriptor_fc3afe81d3b13674, []int{11}
 }
 func (m *ValidatorPriceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,26 +945,29 @@ func (m *ValidatorPriceList) GetValidatorPrices() []ValidatorPrice {
 	return nil
 }
 
-// ReferenceSourceConfig is a structure that defines the information of reference price source.
-type ReferenceSourceConfig struct {
-	// registry_ipfs_hash is the hash of the reference registry.
-	RegistryIPFSHash string `protobuf:"bytes,1,opt,name=registry_ipfs_hash,json=registryIpfsHash,proto3" json:"registry_ipfs_hash,omitempty"`
-	// registry_version is the version of the reference registry.
-	RegistryVersion string `protobuf:"bytes,2,opt,name=registry_version,json=registryVersion,proto3" json:"registry_version,omitempty"`
+// SignalPricesCommitment is a structure that defines the commitment of signal prices of a validator, which must be
+// revealed in the reveal phase of the same commit-reveal round.
+type SignalPricesCommitment struct {
+	// validator is the validator address.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// hash is the hash of the committed signal prices.
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// round is the commit-reveal round in which the signal prices were committed.
+	Round int64 `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
 }
 
-func (m *ReferenceSourceConfig) Reset()         { *m = ReferenceSourceConfig{} }
-func (m *ReferenceSourceConfig) String() string { return proto.CompactTextString(m) }
-func (*ReferenceSourceConfig) ProtoMessage()    {}
-func (*ReferenceSourceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{10}
+func (m *SignalPricesCommitment) Reset()         { *m = SignalPricesCommitment{} }
+func (m *SignalPricesCommitment) String() string { return proto.CompactTextString(m) }
+func (*SignalPricesCommitment) ProtoMessage()    {}
+func (*SignalPricesCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{12}
 }
-func (m *ReferenceSourceConfig) XXX_Unmarshal(b []byte) error {
+func (m *SignalPricesCommitment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReferenceSourceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SignalPricesCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ReferenceSourceConfig.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SignalPricesCommitment.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -780,52 +977,65 @@ func (m *ReferenceSourceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *ReferenceSourceConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReferenceSourceConfig.Merge(m, src)
+func (m *SignalPricesCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalPricesCommitment.Merge(m, src)
 }
-func (m *ReferenceSourceConfig) XXX_Size() int {
+func (m *SignalPricesCommitment) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReferenceSourceConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReferenceSourceConfig.DiscardUnknown(m)
+func (m *SignalPricesCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalPricesCommitment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReferenceSourceConfig proto.InternalMessageInfo
+var xxx_messageInfo_SignalPricesCommitment proto.InternalMessageInfo
 
-func (m *ReferenceSourceConfig) GetRegistryIPFSHash() string {
+func (m *SignalPricesCommitment) GetValidator() string {
 	if m != nil {
-		return m.RegistryIPFSHash
+		return m.Validator
 	}
 	return ""
 }
 
-func (m *ReferenceSourceConfig) GetRegistryVersion() string {
+func (m *SignalPricesCommitment) GetHash() []byte {
 	if m != nil {
-		return m.RegistryVersion
+		return m.Hash
 	}
-	return ""
+	return nil
 }
 
-// FeedsSignatureOrder defines a general signature order for feed data.
-type FeedsSignatureOrder struct {
-	// signal_ids is the list of signal ids that require signatures.
-	SignalIDs []string `protobuf:"bytes,1,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
-	// encoder is the mode of encoding feeds signature order.
-	Encoder Encoder `protobuf:"varint,2,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
+func (m *SignalPricesCommitment) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
 }
 
-func (m *FeedsSignatureOrder) Reset()         { *m = FeedsSignatureOrder{} }
-func (m *FeedsSignatureOrder) String() string { return proto.CompactTextString(m) }
-func (*FeedsSignatureOrder) ProtoMessage()    {}
-func (*FeedsSignatureOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{11}
-}
-func (m *FeedsSignatureOrder) XXX_Unmarshal(b []byte) error {
+// ValidatorAccuracy is a structure that defines the accuracy of prices submitted by a validator within the current
+// accuracy window.
+type ValidatorAccuracy struct {
+	// validator is the validator address.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// evaluated_count is the number of submitted prices compared against the aggregated prices.
+	EvaluatedCount uint64 `protobuf:"varint,2,opt,name=evaluated_count,json=evaluatedCount,proto3" json:"evaluated_count,omitempty"`
+	// outlier_count is the number of submitted prices deviating from the aggregated prices by more than the outlier
+	// threshold.
+	OutlierCount uint64 `protobuf:"varint,3,opt,name=outlier_count,json=outlierCount,proto3" json:"outlier_count,omitempty"`
+	// total_deviation_basis_point is the sum of deviations (in basis point) of the evaluated prices.
+	TotalDeviationBasisPoint uint64 `protobuf:"varint,4,opt,name=total_deviation_basis_point,json=totalDeviationBasisPoint,proto3" json:"total_deviation_basis_point,omitempty"`
+}
+
+func (m *ValidatorAccuracy) Reset()         { *m = ValidatorAccuracy{} }
+func (m *ValidatorAccuracy) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAccuracy) ProtoMessage()    {}
+func (*ValidatorAccuracy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{13}
+}
+func (m *ValidatorAccuracy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FeedsSignatureOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorAccuracy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FeedsSignatureOrder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorAccuracy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -835,303 +1045,633 @@ func (m *FeedsSignatureOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *FeedsSignatureOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FeedsSignatureOrder.Merge(m, src)
+func (m *ValidatorAccuracy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAccuracy.Merge(m, src)
 }
-func (m *FeedsSignatureOrder) XXX_Size() int {
+func (m *ValidatorAccuracy) XXX_Size() int {
 	return m.Size()
 }
-func (m *FeedsSignatureOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_FeedsSignatureOrder.DiscardUnknown(m)
+func (m *ValidatorAccuracy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAccuracy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FeedsSignatureOrder proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorAccuracy proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("band.feeds.v1beta1.PriceStatus", PriceStatus_name, PriceStatus_value)
-	proto.RegisterEnum("band.feeds.v1beta1.SignalPriceStatus", SignalPriceStatus_name, SignalPriceStatus_value)
-	proto.RegisterType((*Signal)(nil), "band.feeds.v1beta1.Signal")
-	proto.RegisterType((*Vote)(nil), "band.feeds.v1beta1.Vote")
-	proto.RegisterType((*Feed)(nil), "band.feeds.v1beta1.Feed")
-	proto.RegisterType((*FeedWithDeviation)(nil), "band.feeds.v1beta1.FeedWithDeviation")
-	proto.RegisterType((*CurrentFeeds)(nil), "band.feeds.v1beta1.CurrentFeeds")
-	proto.RegisterType((*CurrentFeedWithDeviations)(nil), "band.feeds.v1beta1.CurrentFeedWithDeviations")
-	proto.RegisterType((*Price)(nil), "band.feeds.v1beta1.Price")
-	proto.RegisterType((*SignalPrice)(nil), "band.feeds.v1beta1.SignalPrice")
-	proto.RegisterType((*ValidatorPrice)(nil), "band.feeds.v1beta1.ValidatorPrice")
-	proto.RegisterType((*ValidatorPriceList)(nil), "band.feeds.v1beta1.ValidatorPriceList")
-	proto.RegisterType((*ReferenceSourceConfig)(nil), "band.feeds.v1beta1.ReferenceSourceConfig")
-	proto.RegisterType((*FeedsSignatureOrder)(nil), "band.feeds.v1beta1.FeedsSignatureOrder")
+func (m *ValidatorAccuracy) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("band/feeds/v1beta1/feeds.proto", fileDescriptor_fc3afe81d3b13674) }
+func (m *ValidatorAccuracy) GetEvaluatedCount() uint64 {
+	if m != nil {
+		return m.EvaluatedCount
+	}
+	return 0
+}
 
-var fileDescriptor_fc3afe81d3b13674 = []byte{
-	// 989 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x6f, 0x1a, 0x47,
-	0x14, 0x66, 0x31, 0x76, 0xcc, 0xb3, 0x6b, 0xe3, 0xb1, 0x13, 0x6d, 0x68, 0x02, 0xb6, 0x2b, 0x4b,
-	0x8e, 0xd5, 0x80, 0xe2, 0xb4, 0xaa, 0x64, 0xb5, 0xaa, 0xc0, 0xac, 0xeb, 0x55, 0x2d, 0x8c, 0x76,
-	0xc1, 0x51, 0x7b, 0x59, 0x2d, 0xec, 0x00, 0xa3, 0xe2, 0x5d, 0x34, 0x33, 0xd0, 0xe4, 0xd6, 0x63,
-	0x0e, 0x3d, 0x54, 0xea, 0x1f, 0x88, 0x54, 0xf5, 0xd2, 0x5e, 0x7a, 0xf0, 0x4f, 0xe8, 0x21, 0xc7,
-	0x28, 0xa7, 0x9e, 0x50, 0x85, 0x2f, 0xbd, 0xf5, 0x2f, 0x54, 0x3b, 0x33, 0x0b, 0xc6, 0x86, 0x56,
-	0xaa, 0x64, 0xe5, 0xc6, 0x7c, 0xdf, 0xf7, 0xf6, 0x7d, 0xef, 0xcd, 0xdb, 0xc7, 0x42, 0xa6, 0xee,
-	0xfa, 0x5e, 0xbe, 0x89, 0xb1, 0xc7, 0xf2, 0xfd, 0x27, 0x75, 0xcc, 0xdd, 0x27, 0xf2, 0x94, 0xeb,
-	0xd2, 0x80, 0x07, 0x08, 0x85, 0x7c, 0x4e, 0x22, 0x8a, 0x4f, 0xdf, 0x6f, 0x04, 0xec, 0x3c, 0x60,
-	0x8e, 0x50, 0xe4, 0xe5, 0x41, 0xca, 0xd3, 0x1b, 0xad, 0xa0, 0x15, 0x48, 0x3c, 0xfc, 0xa5, 0xd0,
-	0xcd, 0x29, 0x49, 0xb0, 0xdf, 0x08, 0x3c, 0x4c, 0xa5, 0x62, 0xfb, 0x53, 0x58, 0xb0, 0x49, 0xcb,
-	0x77, 0x3b, 0xe8, 0x1e, 0xc4, 0x89, 0xa7, 0x6b, 0x9b, 0xda, 0x6e, 0xb2, 0xb8, 0x30, 0x1c, 0x64,
-	0xe3, 0x66, 0xc9, 0x8a, 0x13, 0x0f, 0x6d, 0xc0, 0x7c, 0x37, 0xf8, 0x16, 0x53, 0x3d, 0xbe, 0xa9,
-	0xed, 0xce, 0x59, 0xf2, 0x70, 0x90, 0xf8, 0xeb, 0x55, 0x56, 0xdb, 0x7e, 0x0e, 0x89, 0xb3, 0x80,
-	0x63, 0x94, 0x83, 0xf9, 0x7e, 0xc0, 0x31, 0x55, 0xe1, 0xfa, 0xdb, 0x8b, 0xc7, 0x1b, 0xca, 0x5e,
-	0xc1, 0xf3, 0x28, 0x66, 0xcc, 0xe6, 0x94, 0xf8, 0x2d, 0x4b, 0xca, 0xd0, 0x01, 0xdc, 0x61, 0x22,
-	0x2b, 0xd3, 0xe3, 0x9b, 0x73, 0xbb, 0x4b, 0xfb, 0xe9, 0xdc, 0xcd, 0x72, 0x73, 0xd2, 0x58, 0x31,
-	0xf1, 0x7a, 0x90, 0x8d, 0x59, 0x51, 0x80, 0xca, 0x4c, 0x20, 0x71, 0x84, 0xb1, 0x87, 0x1e, 0x41,
-	0x52, 0x12, 0xce, 0xc8, 0xfc, 0xf2, 0x70, 0x90, 0x5d, 0x94, 0xb1, 0x66, 0xc9, 0x5a, 0x94, 0xb4,
-	0x39, 0xa3, 0x10, 0x94, 0x86, 0x45, 0xe2, 0x73, 0x4c, 0xfb, 0x6e, 0x47, 0x9f, 0x13, 0xc4, 0xe8,
-	0xac, 0x52, 0xfd, 0xa2, 0xc1, 0x5a, 0x98, 0xeb, 0x19, 0xe1, 0xed, 0x12, 0xee, 0x13, 0x97, 0x93,
-	0xc0, 0xbf, 0xd5, 0xc4, 0x68, 0x1f, 0xee, 0x7a, 0x51, 0x26, 0xa7, 0xee, 0x32, 0xc2, 0x9c, 0x6e,
-	0x40, 0x7c, 0xae, 0x27, 0x84, 0x70, 0x7d, 0x44, 0x16, 0x43, 0xae, 0x12, 0x52, 0x63, 0xb3, 0xcb,
-	0x87, 0x3d, 0x4a, 0xb1, 0xcf, 0x43, 0xcf, 0x0c, 0x7d, 0x04, 0xf3, 0xa2, 0xab, 0xba, 0x26, 0x1a,
-	0xad, 0x4f, 0x6b, 0x74, 0xa8, 0x54, 0x6d, 0x96, 0xe2, 0xd0, 0x40, 0xc7, 0x65, 0xdc, 0xe9, 0x75,
-	0x3d, 0x97, 0x63, 0x87, 0x93, 0x73, 0xcc, 0xb8, 0x7b, 0xde, 0x55, 0x25, 0xac, 0x87, 0x64, 0x4d,
-	0x70, 0xd5, 0x88, 0x42, 0x7b, 0xb0, 0x76, 0x35, 0xa6, 0xde, 0x09, 0x1a, 0xdf, 0xa8, 0xca, 0x56,
-	0xc7, 0xfa, 0x62, 0x08, 0x2b, 0xb3, 0xbf, 0x6b, 0x70, 0xff, 0x8a, 0xd9, 0x89, 0x06, 0x33, 0x54,
-	0x98, 0x74, 0xbe, 0x33, 0xcb, 0xf9, 0x44, 0xd8, 0xbb, 0x28, 0xe3, 0x67, 0x0d, 0xe6, 0x2b, 0x94,
-	0x34, 0x30, 0xfa, 0x04, 0x16, 0x18, 0x77, 0x79, 0x8f, 0x89, 0x89, 0x58, 0xd9, 0xcf, 0x4e, 0xf3,
-	0x2c, 0xa4, 0xb6, 0x90, 0x59, 0x4a, 0x3e, 0x39, 0x4d, 0xf1, 0xff, 0x9c, 0xa6, 0xf0, 0x09, 0xc2,
-	0x53, 0xc2, 0x92, 0x07, 0xf4, 0x00, 0x92, 0xe3, 0xea, 0xe4, 0x94, 0x8c, 0x01, 0xe5, 0xf3, 0x47,
-	0x0d, 0x96, 0xe4, 0x03, 0xa5, 0xdb, 0xcf, 0xae, 0xb9, 0xdd, 0x99, 0xfd, 0x12, 0xde, 0x86, 0x67,
-	0xe5, 0xea, 0x6f, 0x0d, 0x56, 0xce, 0xdc, 0x0e, 0xf1, 0x5c, 0x1e, 0x50, 0x69, 0xac, 0x06, 0xeb,
-	0xea, 0xc9, 0x42, 0xe8, 0xfc, 0x1f, 0x97, 0x6b, 0xec, 0x3a, 0x74, 0xcb, 0x4d, 0x46, 0x5b, 0xb0,
-	0x2c, 0x86, 0xc5, 0x69, 0x63, 0xd2, 0x6a, 0x73, 0x7d, 0x5e, 0x08, 0x96, 0x04, 0x76, 0x2c, 0x20,
-	0x55, 0xf1, 0x6f, 0x1a, 0xa0, 0xc9, 0x8a, 0x4f, 0x08, 0xe3, 0xe8, 0x73, 0x48, 0xf6, 0x23, 0x54,
-	0x6d, 0x94, 0xad, 0xb7, 0x17, 0x8f, 0x1f, 0xaa, 0x45, 0x3a, 0x8a, 0x98, 0xdc, 0xa8, 0xe3, 0x18,
-	0x64, 0x43, 0x6a, 0x74, 0x90, 0x9d, 0x8b, 0xd6, 0xeb, 0xf6, 0xb4, 0x9e, 0x4d, 0x5a, 0x50, 0x2f,
-	0xce, 0x6a, 0x7f, 0x02, 0x8d, 0xd6, 0xed, 0xf7, 0x1a, 0xdc, 0xb5, 0x70, 0x13, 0x53, 0xec, 0x37,
-	0xb0, 0x1d, 0xf4, 0x68, 0x03, 0x1f, 0x06, 0x7e, 0x93, 0xb4, 0x50, 0x11, 0x10, 0xc5, 0x2d, 0xc2,
-	0x38, 0x7d, 0xe1, 0x90, 0x6e, 0x93, 0x39, 0x6d, 0x97, 0xb5, 0x95, 0xfd, 0x8d, 0xe1, 0x20, 0x9b,
-	0xb2, 0x14, 0x6b, 0x56, 0x8e, 0xec, 0x63, 0x97, 0xb5, 0xad, 0x54, 0xa4, 0x37, 0xbb, 0x4d, 0x16,
-	0x22, 0xe8, 0x11, 0x8c, 0x30, 0xa7, 0x8f, 0x29, 0x23, 0x81, 0x2f, 0xef, 0xc7, 0x5a, 0x8d, 0xf0,
-	0x33, 0x09, 0x2b, 0x3b, 0xdf, 0x69, 0xb0, 0x2e, 0xd6, 0x9b, 0xb8, 0x3a, 0xde, 0xa3, 0xf8, 0x94,
-	0x7a, 0x98, 0xa2, 0x0f, 0x01, 0x46, 0x37, 0x2c, 0xf7, 0x46, 0xb2, 0xf8, 0xde, 0x70, 0x90, 0x4d,
-	0x46, 0x57, 0xcc, 0xac, 0x64, 0x74, 0xc7, 0x0c, 0x7d, 0x0c, 0x77, 0xd4, 0x9f, 0xa1, 0xc8, 0xb6,
-	0xb2, 0xff, 0xfe, 0xb4, 0x36, 0x19, 0x52, 0x62, 0x45, 0xda, 0x83, 0xc4, 0xcb, 0x57, 0xd9, 0xd8,
-	0xde, 0x85, 0x06, 0x4b, 0x57, 0x87, 0xeb, 0x01, 0xe8, 0x15, 0xcb, 0x3c, 0x34, 0x1c, 0xbb, 0x5a,
-	0xa8, 0xd6, 0x6c, 0xa7, 0x56, 0xb6, 0x2b, 0xc6, 0xa1, 0x79, 0x64, 0x1a, 0xa5, 0x54, 0x0c, 0x6d,
-	0x43, 0xe6, 0x1a, 0xfb, 0x65, 0xf9, 0xf4, 0x59, 0xd9, 0xb1, 0xcd, 0x2f, 0xca, 0x85, 0x13, 0xc7,
-	0x2c, 0xa5, 0x34, 0x94, 0x86, 0x7b, 0x13, 0x9a, 0xf2, 0x69, 0xd5, 0xb1, 0x8c, 0x42, 0xe9, 0xab,
-	0x54, 0xfc, 0x06, 0x57, 0x38, 0x2b, 0x98, 0x27, 0x85, 0xe2, 0x89, 0x91, 0x9a, 0x43, 0x3b, 0xb0,
-	0x75, 0x23, 0xce, 0x2c, 0x3b, 0x87, 0x35, 0xcb, 0x32, 0xca, 0x55, 0xe7, 0xc8, 0x30, 0x4a, 0x76,
-	0x2a, 0x91, 0x4e, 0xbc, 0xfc, 0x29, 0x13, 0xdb, 0xfb, 0x55, 0x83, 0xb5, 0x1b, 0x2f, 0x0b, 0xfa,
-	0x00, 0xb2, 0xca, 0xc9, 0xbf, 0xd4, 0x30, 0x5b, 0x54, 0xab, 0x54, 0x4e, 0xad, 0xaa, 0x11, 0x16,
-	0x31, 0x53, 0x34, 0x76, 0x1c, 0x47, 0x5b, 0xf0, 0x70, 0x9a, 0xe8, 0x4a, 0x51, 0xd2, 0x6d, 0xf1,
-	0xf8, 0xf5, 0x30, 0xa3, 0xbd, 0x19, 0x66, 0xb4, 0x3f, 0x87, 0x19, 0xed, 0x87, 0xcb, 0x4c, 0xec,
-	0xcd, 0x65, 0x26, 0xf6, 0xc7, 0x65, 0x26, 0xf6, 0x75, 0xae, 0x45, 0x78, 0xbb, 0x57, 0xcf, 0x35,
-	0x82, 0xf3, 0x7c, 0x78, 0x69, 0xe2, 0x6b, 0xa6, 0x11, 0x74, 0xf2, 0x8d, 0xb6, 0x4b, 0xfc, 0x7c,
-	0xff, 0x69, 0xfe, 0xb9, 0xfa, 0xee, 0xe1, 0x2f, 0xba, 0x98, 0xd5, 0x17, 0x84, 0xe0, 0xe9, 0x3f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x51, 0x26, 0xa8, 0x77, 0x09, 0x00, 0x00,
+func (m *ValidatorAccuracy) GetOutlierCount() uint64 {
+	if m != nil {
+		return m.OutlierCount
+	}
+	return 0
 }
 
-func (this *Signal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ValidatorAccuracy) GetTotalDeviationBasisPoint() uint64 {
+	if m != nil {
+		return m.TotalDeviationBasisPoint
 	}
+	return 0
+}
 
-	that1, ok := that.(*Signal)
-	if !ok {
-		that2, ok := that.(Signal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ValidatorFeedReport is a structure that reports the price submission of a validator for a signal id in the
+// current feeds.
+type ValidatorFeedReport struct {
+	// signal_id is the signal id of the current feed.
+	SignalID string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// validator_price is the latest price submitted by the validator. Its status is unspecified if the validator has
+	// not submitted a price since the signal id was added to the current feeds.
+	ValidatorPrice ValidatorPrice `protobuf:"bytes,2,opt,name=validator_price,json=validatorPrice,proto3" json:"validator_price"`
+	// aggregated_price is the first aggregated price at or after the submission of the validator price within the
+	// retained price history, or the current price if no such price is retained.
+	AggregatedPrice Price `protobuf:"bytes,3,opt,name=aggregated_price,json=aggregatedPrice,proto3" json:"aggregated_price"`
+	// deviation_basis_point is the deviation (in basis point) of the validator price from the aggregated price. It is
+	// zero unless both prices are available.
+	DeviationBasisPoint uint64 `protobuf:"varint,4,opt,name=deviation_basis_point,json=deviationBasisPoint,proto3" json:"deviation_basis_point,omitempty"`
+	// counted_in_median is a flag indicating whether the validator price was used in the median of the aggregated
+	// price.
+	CountedInMedian bool `protobuf:"varint,5,opt,name=counted_in_median,json=countedInMedian,proto3" json:"counted_in_median,omitempty"`
+	// report_deadline_timestamp is the timestamp after which the validator misses the report if it has not submitted a
+	// new price.
+	ReportDeadlineTimestamp int64 `protobuf:"varint,6,opt,name=report_deadline_timestamp,json=reportDeadlineTimestamp,proto3" json:"report_deadline_timestamp,omitempty"`
+	// report_deadline_block_height is the block height after which the validator misses the report if it has not
+	// submitted a new price.
+	ReportDeadlineBlockHeight int64 `protobuf:"varint,7,opt,name=report_deadline_block_height,json=reportDeadlineBlockHeight,proto3" json:"report_deadline_block_height,omitempty"`
+	// time_until_miss_report is the number of seconds remaining until the report deadline timestamp, or zero if the
+	// deadline has passed.
+	TimeUntilMissReport int64 `protobuf:"varint,8,opt,name=time_until_miss_report,json=timeUntilMissReport,proto3" json:"time_until_miss_report,omitempty"`
+	// cooldown_end_timestamp is the timestamp before which a new price of the validator is rejected.
+	CooldownEndTimestamp int64 `protobuf:"varint,9,opt,name=cooldown_end_timestamp,json=cooldownEndTimestamp,proto3" json:"cooldown_end_timestamp,omitempty"`
+	// in_cooldown is a flag indicating whether a new price of the validator is currently rejected.
+	InCooldown bool `protobuf:"varint,10,opt,name=in_cooldown,json=inCooldown,proto3" json:"in_cooldown,omitempty"`
+}
+
+func (m *ValidatorFeedReport) Reset()         { *m = ValidatorFeedReport{} }
+func (m *ValidatorFeedReport) String() string { return proto.CompactTextString(m) }
+func (*ValidatorFeedReport) ProtoMessage()    {}
+func (*ValidatorFeedReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{14}
+}
+func (m *ValidatorFeedReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorFeedReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorFeedReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *ValidatorFeedReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorFeedReport.Merge(m, src)
+}
+func (m *ValidatorFeedReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorFeedReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorFeedReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorFeedReport proto.InternalMessageInfo
+
+func (m *ValidatorFeedReport) GetSignalID() string {
+	if m != nil {
+		return m.SignalID
 	}
-	if this.ID != that1.ID {
-		return false
+	return ""
+}
+
+func (m *ValidatorFeedReport) GetValidatorPrice() ValidatorPrice {
+	if m != nil {
+		return m.ValidatorPrice
 	}
-	if this.Power != that1.Power {
-		return false
+	return ValidatorPrice{}
+}
+
+func (m *ValidatorFeedReport) GetAggregatedPrice() Price {
+	if m != nil {
+		return m.AggregatedPrice
 	}
-	return true
+	return Price{}
 }
-func (this *Vote) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ValidatorFeedReport) GetDeviationBasisPoint() uint64 {
+	if m != nil {
+		return m.DeviationBasisPoint
 	}
+	return 0
+}
 
-	that1, ok := that.(*Vote)
-	if !ok {
-		that2, ok := that.(Vote)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ValidatorFeedReport) GetCountedInMedian() bool {
+	if m != nil {
+		return m.CountedInMedian
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return false
+}
+
+func (m *ValidatorFeedReport) GetReportDeadlineTimestamp() int64 {
+	if m != nil {
+		return m.ReportDeadlineTimestamp
 	}
-	if this.Voter != that1.Voter {
-		return false
+	return 0
+}
+
+func (m *ValidatorFeedReport) GetReportDeadlineBlockHeight() int64 {
+	if m != nil {
+		return m.ReportDeadlineBlockHeight
 	}
-	if len(this.Signals) != len(that1.Signals) {
-		return false
+	return 0
+}
+
+func (m *ValidatorFeedReport) GetTimeUntilMissReport() int64 {
+	if m != nil {
+		return m.TimeUntilMissReport
 	}
-	for i := range this.Signals {
-		if !this.Signals[i].Equal(&that1.Signals[i]) {
-			return false
-		}
+	return 0
+}
+
+func (m *ValidatorFeedReport) GetCooldownEndTimestamp() int64 {
+	if m != nil {
+		return m.CooldownEndTimestamp
 	}
-	return true
+	return 0
 }
-func (this *Feed) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ValidatorFeedReport) GetInCooldown() bool {
+	if m != nil {
+		return m.InCooldown
 	}
+	return false
+}
 
-	that1, ok := that.(*Feed)
-	if !ok {
-		that2, ok := that.(Feed)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ReferenceSourceConfig is a structure that defines the information of reference price source.
+type ReferenceSourceConfig struct {
+	// registry_ipfs_hash is the hash of the reference registry.
+	RegistryIPFSHash string `protobuf:"bytes,1,opt,name=registry_ipfs_hash,json=registryIpfsHash,proto3" json:"registry_ipfs_hash,omitempty"`
+	// registry_version is the version of the reference registry.
+	RegistryVersion string `protobuf:"bytes,2,opt,name=registry_version,json=registryVersion,proto3" json:"registry_version,omitempty"`
+}
+
+func (m *ReferenceSourceConfig) Reset()         { *m = ReferenceSourceConfig{} }
+func (m *ReferenceSourceConfig) String() string { return proto.CompactTextString(m) }
+func (*ReferenceSourceConfig) ProtoMessage()    {}
+func (*ReferenceSourceConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{15}
+}
+func (m *ReferenceSourceConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferenceSourceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferenceSourceConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.SignalID != that1.SignalID {
-		return false
-	}
-	if this.Power != that1.Power {
-		return false
-	}
-	if this.Interval != that1.Interval {
-		return false
+}
+func (m *ReferenceSourceConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferenceSourceConfig.Merge(m, src)
+}
+func (m *ReferenceSourceConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferenceSourceConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferenceSourceConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferenceSourceConfig proto.InternalMessageInfo
+
+func (m *ReferenceSourceConfig) GetRegistryIPFSHash() string {
+	if m != nil {
+		return m.RegistryIPFSHash
 	}
-	return true
+	return ""
 }
-func (this *FeedWithDeviation) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ReferenceSourceConfig) GetRegistryVersion() string {
+	if m != nil {
+		return m.RegistryVersion
 	}
+	return ""
+}
 
-	that1, ok := that.(*FeedWithDeviation)
-	if !ok {
-		that2, ok := that.(FeedWithDeviation)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// SignalInfo is a structure that defines the registered metadata of a signal id.
+type SignalInfo struct {
+	// signal_id is the signal id of the metadata.
+	SignalID string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// decimals is the number of decimals used to display the price of the signal id. It is display-only metadata, as
+	// prices are always stored with a fixed precision of 9 decimals (i.e. 1000000000 is 1.0) regardless of it.
+	Decimals uint32 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// quote_currency is the currency in which the price of the signal id is quoted.
+	QuoteCurrency string `protobuf:"bytes,3,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
+	// asset_class is the asset class of the signal id.
+	AssetClass AssetClass `protobuf:"varint,4,opt,name=asset_class,json=assetClass,proto3,enum=band.feeds.v1beta1.AssetClass" json:"asset_class,omitempty"`
+	// description is the description of the signal id.
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// source is the description of the source of the price of the signal id.
+	Source string `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	// deprecated is the flag to show if the signal id is deprecated.
+	Deprecated bool `protobuf:"varint,7,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+}
+
+func (m *SignalInfo) Reset()         { *m = SignalInfo{} }
+func (m *SignalInfo) String() string { return proto.CompactTextString(m) }
+func (*SignalInfo) ProtoMessage()    {}
+func (*SignalInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{16}
+}
+func (m *SignalInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.SignalID != that1.SignalID {
-		return false
-	}
-	if this.Power != that1.Power {
-		return false
+}
+func (m *SignalInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalInfo.Merge(m, src)
+}
+func (m *SignalInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalInfo proto.InternalMessageInfo
+
+func (m *SignalInfo) GetSignalID() string {
+	if m != nil {
+		return m.SignalID
 	}
-	if this.Interval != that1.Interval {
-		return false
+	return ""
+}
+
+func (m *SignalInfo) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
 	}
-	if this.DeviationBasisPoint != that1.DeviationBasisPoint {
-		return false
+	return 0
+}
+
+func (m *SignalInfo) GetQuoteCurrency() string {
+	if m != nil {
+		return m.QuoteCurrency
 	}
-	return true
+	return ""
 }
-func (this *CurrentFeeds) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SignalInfo) GetAssetClass() AssetClass {
+	if m != nil {
+		return m.AssetClass
 	}
+	return ASSET_CLASS_UNSPECIFIED
+}
 
-	that1, ok := that.(*CurrentFeeds)
-	if !ok {
-		that2, ok := that.(CurrentFeeds)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *SignalInfo) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *SignalInfo) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	if len(this.Feeds) != len(that1.Feeds) {
-		return false
+	return ""
+}
+
+func (m *SignalInfo) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
 	}
-	for i := range this.Feeds {
-		if !this.Feeds[i].Equal(&that1.Feeds[i]) {
-			return false
+	return false
+}
+
+// PriceGuard is a structure that defines the guard rails of the price of a signal id. A calculated price that
+// breaches any of the limits is halted. A zero limit is disabled.
+type PriceGuard struct {
+	// signal_id is the signal id of the price guard.
+	SignalID string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// max_change_basis_point is the maximum change of the price from the last accepted price in basis point.
+	MaxChangeBasisPoint uint64 `protobuf:"varint,2,opt,name=max_change_basis_point,json=maxChangeBasisPoint,proto3" json:"max_change_basis_point,omitempty"`
+	// min_price is the minimum sane price of the signal id.
+	MinPrice uint64 `protobuf:"varint,3,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	// max_price is the maximum sane price of the signal id.
+	MaxPrice uint64 `protobuf:"varint,4,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	// max_staleness is the maximum age (in seconds) of the latest validator price used to calculate the price.
+	MaxStaleness int64 `protobuf:"varint,5,opt,name=max_staleness,json=maxStaleness,proto3" json:"max_staleness,omitempty"`
+}
+
+func (m *PriceGuard) Reset()         { *m = PriceGuard{} }
+func (m *PriceGuard) String() string { return proto.CompactTextString(m) }
+func (*PriceGuard) ProtoMessage()    {}
+func (*PriceGuard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{17}
+}
+func (m *PriceGuard) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceGuard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceGuard.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.LastUpdateTimestamp != that1.LastUpdateTimestamp {
-		return false
-	}
-	if this.LastUpdateBlock != that1.LastUpdateBlock {
-		return false
+}
+func (m *PriceGuard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceGuard.Merge(m, src)
+}
+func (m *PriceGuard) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceGuard) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceGuard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceGuard proto.InternalMessageInfo
+
+func (m *PriceGuard) GetSignalID() string {
+	if m != nil {
+		return m.SignalID
 	}
-	return true
+	return ""
 }
-func (this *CurrentFeedWithDeviations) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *PriceGuard) GetMaxChangeBasisPoint() uint64 {
+	if m != nil {
+		return m.MaxChangeBasisPoint
 	}
+	return 0
+}
 
-	that1, ok := that.(*CurrentFeedWithDeviations)
-	if !ok {
-		that2, ok := that.(CurrentFeedWithDeviations)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *PriceGuard) GetMinPrice() uint64 {
+	if m != nil {
+		return m.MinPrice
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *PriceGuard) GetMaxPrice() uint64 {
+	if m != nil {
+		return m.MaxPrice
 	}
-	if len(this.Feeds) != len(that1.Feeds) {
-		return false
+	return 0
+}
+
+func (m *PriceGuard) GetMaxStaleness() int64 {
+	if m != nil {
+		return m.MaxStaleness
 	}
-	for i := range this.Feeds {
-		if !this.Feeds[i].Equal(&that1.Feeds[i]) {
+	return 0
+}
+
+// FeedsSignatureOrder defines a general signature order for feed data.
+type FeedsSignatureOrder struct {
+	// signal_ids is the list of signal ids that require signatures.
+	SignalIDs []string `protobuf:"bytes,1,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
+	// encoder is the mode of encoding feeds signature order.
+	Encoder Encoder `protobuf:"varint,2,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
+}
+
+func (m *FeedsSignatureOrder) Reset()         { *m = FeedsSignatureOrder{} }
+func (m *FeedsSignatureOrder) String() string { return proto.CompactTextString(m) }
+func (*FeedsSignatureOrder) ProtoMessage()    {}
+func (*FeedsSignatureOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{18}
+}
+func (m *FeedsSignatureOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeedsSignatureOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeedsSignatureOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeedsSignatureOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedsSignatureOrder.Merge(m, src)
+}
+func (m *FeedsSignatureOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeedsSignatureOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedsSignatureOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeedsSignatureOrder proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("band.feeds.v1beta1.PriceStatus", PriceStatus_name, PriceStatus_value)
+	proto.RegisterEnum("band.feeds.v1beta1.SignalPriceStatus", SignalPriceStatus_name, SignalPriceStatus_value)
+	proto.RegisterEnum("band.feeds.v1beta1.AssetClass", AssetClass_name, AssetClass_value)
+	proto.RegisterType((*Signal)(nil), "band.feeds.v1beta1.Signal")
+	proto.RegisterType((*Vote)(nil), "band.feeds.v1beta1.Vote")
+	proto.RegisterType((*SignalVoteDelegation)(nil), "band.feeds.v1beta1.SignalVoteDelegation")
+	proto.RegisterType((*CuratorDelegatedPower)(nil), "band.feeds.v1beta1.CuratorDelegatedPower")
+	proto.RegisterType((*Feed)(nil), "band.feeds.v1beta1.Feed")
+	proto.RegisterType((*FeedWithDeviation)(nil), "band.feeds.v1beta1.FeedWithDeviation")
+	proto.RegisterType((*CurrentFeeds)(nil), "band.feeds.v1beta1.CurrentFeeds")
+	proto.RegisterType((*CurrentFeedWithDeviations)(nil), "band.feeds.v1beta1.CurrentFeedWithDeviations")
+	proto.RegisterType((*Price)(nil), "band.feeds.v1beta1.Price")
+	proto.RegisterType((*SignalPrice)(nil), "band.feeds.v1beta1.SignalPrice")
+	proto.RegisterType((*ValidatorPrice)(nil), "band.feeds.v1beta1.ValidatorPrice")
+	proto.RegisterType((*ValidatorPriceList)(nil), "band.feeds.v1beta1.ValidatorPriceList")
+	proto.RegisterType((*SignalPricesCommitment)(nil), "band.feeds.v1beta1.SignalPricesCommitment")
+	proto.RegisterType((*ValidatorAccuracy)(nil), "band.feeds.v1beta1.ValidatorAccuracy")
+	proto.RegisterType((*ValidatorFeedReport)(nil), "band.feeds.v1beta1.ValidatorFeedReport")
+	proto.RegisterType((*ReferenceSourceConfig)(nil), "band.feeds.v1beta1.ReferenceSourceConfig")
+	proto.RegisterType((*SignalInfo)(nil), "band.feeds.v1beta1.SignalInfo")
+	proto.RegisterType((*PriceGuard)(nil), "band.feeds.v1beta1.PriceGuard")
+	proto.RegisterType((*FeedsSignatureOrder)(nil), "band.feeds.v1beta1.FeedsSignatureOrder")
+}
+
+func init() { proto.RegisterFile("band/feeds/v1beta1/feeds.proto", fileDescriptor_fc3afe81d3b13674) }
+
+var fileDescriptor_fc3afe81d3b13674 = []byte{
+	// 1694 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x4f, 0x3b, 0xce, 0x87, 0x5f, 0xbe, 0x9c, 0xca, 0xc7, 0x38, 0x99, 0x59, 0x3b, 0x33, 0xa3,
+	0x11, 0xb3, 0x23, 0x36, 0xd1, 0x66, 0x16, 0x90, 0x46, 0xac, 0x46, 0xfe, 0xca, 0x8e, 0x21, 0x13,
+	0x7b, 0xcb, 0xf6, 0x2c, 0xc3, 0xa5, 0xd4, 0xe9, 0xae, 0xd8, 0x25, 0xda, 0xdd, 0xde, 0xae, 0xb2,
+	0x37, 0xb9, 0x71, 0x5c, 0x24, 0x0e, 0x20, 0x8e, 0x48, 0x68, 0x25, 0x6e, 0x70, 0xe1, 0xb0, 0x17,
+	0xee, 0x1c, 0xf6, 0x02, 0x5a, 0xf6, 0xc4, 0x29, 0x42, 0x99, 0x03, 0xdc, 0xf8, 0x17, 0x50, 0x7d,
+	0xb4, 0xed, 0x76, 0xec, 0x19, 0x05, 0x18, 0x71, 0x73, 0xbd, 0xf7, 0x7b, 0x5d, 0xbf, 0xf7, 0xea,
+	0xf7, 0xea, 0x55, 0x02, 0xd9, 0x53, 0xdb, 0x77, 0x0f, 0xce, 0x28, 0x75, 0xf9, 0x41, 0xff, 0xfd,
+	0x53, 0x2a, 0xec, 0xf7, 0xf5, 0x6a, 0xbf, 0x1b, 0x06, 0x22, 0x40, 0x48, 0xfa, 0xf7, 0xb5, 0xc5,
+	0xf8, 0x77, 0x77, 0x9c, 0x80, 0x77, 0x02, 0x4e, 0x14, 0xe2, 0x40, 0x2f, 0x34, 0x7c, 0x77, 0xb3,
+	0x15, 0xb4, 0x02, 0x6d, 0x97, 0xbf, 0x8c, 0x75, 0x6f, 0xc2, 0x26, 0xd4, 0x77, 0x02, 0x97, 0x86,
+	0x1a, 0x71, 0xef, 0xfb, 0x30, 0x5f, 0x67, 0x2d, 0xdf, 0xf6, 0xd0, 0x36, 0x24, 0x98, 0x9b, 0xb1,
+	0xf6, 0xac, 0x87, 0xa9, 0xc2, 0xfc, 0xd5, 0x65, 0x2e, 0x51, 0x29, 0xe1, 0x04, 0x73, 0xd1, 0x26,
+	0xcc, 0x75, 0x83, 0xcf, 0x68, 0x98, 0x49, 0xec, 0x59, 0x0f, 0x67, 0xb1, 0x5e, 0x3c, 0x49, 0xfe,
+	0xf3, 0x8b, 0x9c, 0x75, 0xef, 0x1c, 0x92, 0x2f, 0x02, 0x41, 0xd1, 0x3e, 0xcc, 0xf5, 0x03, 0x41,
+	0x43, 0x13, 0x9e, 0xf9, 0xe6, 0xcb, 0xf7, 0x36, 0x0d, 0xbd, 0xbc, 0xeb, 0x86, 0x94, 0xf3, 0xba,
+	0x08, 0x99, 0xdf, 0xc2, 0x1a, 0x86, 0x9e, 0xc0, 0x02, 0x57, 0xbb, 0xf2, 0x4c, 0x62, 0x6f, 0xf6,
+	0xe1, 0xd2, 0xe1, 0xee, 0xfe, 0xf5, 0x74, 0xf7, 0x35, 0xb1, 0x42, 0xf2, 0xab, 0xcb, 0xdc, 0x0c,
+	0x8e, 0x02, 0xcc, 0xce, 0xbf, 0xb1, 0x60, 0x53, 0xfb, 0x25, 0x81, 0x12, 0xf5, 0x68, 0xcb, 0x16,
+	0x2c, 0xf0, 0xd1, 0x77, 0x21, 0xe5, 0xea, 0x55, 0xf0, 0x66, 0x3a, 0x43, 0x28, 0x3a, 0x84, 0x05,
+	0xa7, 0x17, 0xaa, 0xa8, 0xc4, 0x1b, 0xa2, 0x22, 0xe0, 0xb0, 0x34, 0xb3, 0xd7, 0x4b, 0xd3, 0x82,
+	0xad, 0xa2, 0x86, 0x19, 0x72, 0xd4, 0xad, 0x49, 0xf7, 0xe8, 0x46, 0xd6, 0x8d, 0x37, 0x9a, 0x70,
+	0x06, 0x0c, 0x92, 0x47, 0x94, 0xba, 0xe8, 0x5d, 0x48, 0xe9, 0x12, 0x91, 0xc1, 0x31, 0x2e, 0x5f,
+	0x5d, 0xe6, 0x16, 0x75, 0x95, 0x2a, 0x25, 0xbc, 0xa8, 0xdd, 0x95, 0x29, 0x47, 0x8a, 0x76, 0x61,
+	0x91, 0xf9, 0x82, 0x86, 0x7d, 0xdb, 0x33, 0x09, 0x0d, 0xd6, 0x66, 0xab, 0xdf, 0x59, 0xb0, 0x2e,
+	0xf7, 0xfa, 0x84, 0x89, 0x76, 0x89, 0xf6, 0x99, 0xae, 0xf8, 0xdb, 0xdc, 0x18, 0x1d, 0xc2, 0x96,
+	0x1b, 0xed, 0x44, 0x4e, 0x6d, 0xce, 0x38, 0xe9, 0x06, 0xcc, 0x17, 0x99, 0xa4, 0x02, 0x6e, 0x0c,
+	0x9c, 0x05, 0xe9, 0xab, 0x49, 0xd7, 0x90, 0xec, 0x72, 0xb1, 0x17, 0x86, 0xd4, 0x17, 0x92, 0x33,
+	0x47, 0x1f, 0xc0, 0x9c, 0xd2, 0x57, 0xc6, 0x52, 0x92, 0xcb, 0x4c, 0x92, 0x9c, 0x44, 0x1a, 0xc1,
+	0x69, 0xb0, 0x24, 0xe0, 0xd9, 0x5c, 0x90, 0x5e, 0xd7, 0xb5, 0x05, 0x25, 0x82, 0x75, 0x28, 0x17,
+	0x76, 0xa7, 0x6b, 0x52, 0xd8, 0x90, 0xce, 0xa6, 0xf2, 0x35, 0x22, 0x17, 0x7a, 0x04, 0xeb, 0xa3,
+	0x31, 0xa7, 0x5e, 0xe0, 0xfc, 0xc4, 0x64, 0xb6, 0x36, 0xc4, 0x17, 0xa4, 0xd9, 0x90, 0xfd, 0x93,
+	0x05, 0x3b, 0x23, 0x64, 0x63, 0x05, 0xe6, 0x28, 0x1f, 0x67, 0xfe, 0x60, 0x1a, 0xf3, 0x58, 0xd8,
+	0xff, 0x23, 0x8d, 0x3f, 0x26, 0x60, 0xae, 0x16, 0x32, 0x87, 0xa2, 0xef, 0xc1, 0x3c, 0x17, 0xb6,
+	0xe8, 0x71, 0xa5, 0x88, 0xd5, 0xc3, 0xdc, 0x24, 0xce, 0x0a, 0x5a, 0x57, 0x30, 0x6c, 0xe0, 0x71,
+	0x35, 0x25, 0xde, 0xa8, 0x26, 0xf9, 0x05, 0xc5, 0x29, 0x89, 0xf5, 0x02, 0xdd, 0x81, 0xd4, 0x30,
+	0x3b, 0xad, 0x92, 0xa1, 0x01, 0x1d, 0xc0, 0x86, 0xd2, 0xd6, 0xa7, 0x3d, 0x3b, 0x14, 0xcc, 0xa3,
+	0x24, 0xb4, 0xfd, 0x16, 0xcd, 0xcc, 0xa9, 0x2f, 0xa0, 0x98, 0x0b, 0x4b, 0x0f, 0x7a, 0x02, 0x3b,
+	0x5d, 0xb9, 0x76, 0x58, 0xf7, 0xba, 0x08, 0xe7, 0x55, 0xd8, 0xad, 0x18, 0x60, 0x28, 0x44, 0xf4,
+	0x00, 0x56, 0x43, 0xda, 0x0d, 0x42, 0x41, 0x43, 0xe2, 0x04, 0x3d, 0x5f, 0x64, 0x16, 0x54, 0xc0,
+	0x4a, 0x64, 0x2d, 0x4a, 0xa3, 0xa9, 0xdd, 0xaf, 0x2c, 0x58, 0xd2, 0x49, 0xea, 0x0a, 0x7e, 0x38,
+	0x56, 0xc1, 0x07, 0xd3, 0xaf, 0xc8, 0xb7, 0x51, 0x47, 0xc3, 0xea, 0x5f, 0x16, 0xac, 0xbe, 0xb0,
+	0x3d, 0xe6, 0xca, 0x7b, 0x48, 0x13, 0x6b, 0xc2, 0x86, 0xf9, 0xb2, 0x02, 0x92, 0xff, 0x84, 0xe5,
+	0x3a, 0x1f, 0x37, 0xbd, 0xed, 0x83, 0xbf, 0x0b, 0xcb, 0x4a, 0xc0, 0xa4, 0x4d, 0x59, 0xab, 0x2d,
+	0xd4, 0x89, 0xcf, 0xe2, 0x25, 0x65, 0x7b, 0xa6, 0x4c, 0x26, 0xe3, 0x3f, 0x58, 0x80, 0xe2, 0x19,
+	0x1f, 0x33, 0x2e, 0xd0, 0x53, 0x48, 0xf5, 0x23, 0xab, 0xb9, 0xe5, 0xee, 0x7e, 0xf3, 0xe5, 0x7b,
+	0xef, 0x98, 0x8b, 0x7b, 0x10, 0x31, 0x36, 0x60, 0x06, 0x31, 0xa8, 0x0e, 0xe9, 0xc1, 0x42, 0x57,
+	0x2e, 0x1a, 0x7e, 0xf7, 0x26, 0xd5, 0x2c, 0x4e, 0xc1, 0x34, 0xf3, 0x5a, 0x3f, 0x66, 0x8d, 0x86,
+	0xe1, 0xcf, 0x2c, 0xd8, 0x1e, 0xa9, 0x31, 0x2f, 0x06, 0x9d, 0x0e, 0x13, 0x1d, 0xea, 0xff, 0x0f,
+	0x68, 0x23, 0x48, 0xb6, 0x6d, 0xde, 0x56, 0x27, 0xb2, 0x8c, 0xd5, 0x6f, 0x59, 0xff, 0x30, 0xe8,
+	0xf9, 0x6e, 0x34, 0xf7, 0xd4, 0xc2, 0x70, 0xf9, 0x87, 0x05, 0xeb, 0xc3, 0xaf, 0x3a, 0x72, 0x82,
+	0x39, 0x17, 0xff, 0x3d, 0x8d, 0x6f, 0xc1, 0x1a, 0xed, 0xdb, 0x5e, 0x4f, 0xce, 0x51, 0xd3, 0x4b,
+	0x09, 0x75, 0xf8, 0xab, 0x03, 0xb3, 0x6a, 0x26, 0x74, 0x1f, 0x56, 0x82, 0x9e, 0xf0, 0xd8, 0xa0,
+	0xe5, 0xb4, 0x46, 0x96, 0x8d, 0x51, 0x83, 0x3e, 0x84, 0xdb, 0x22, 0x10, 0xb6, 0x47, 0xa6, 0xcf,
+	0x96, 0x24, 0xce, 0x28, 0x48, 0x69, 0xea, 0x80, 0xf9, 0x4b, 0x12, 0x36, 0x06, 0xc4, 0xe5, 0xf5,
+	0x8b, 0x55, 0x57, 0xdf, 0x64, 0x1e, 0x7e, 0x0c, 0x6b, 0x63, 0x9a, 0x50, 0x59, 0xdd, 0x44, 0x12,
+	0xab, 0x71, 0x49, 0xa0, 0x1f, 0x40, 0xda, 0x6e, 0xb5, 0x42, 0xfd, 0xe2, 0x20, 0xc3, 0x36, 0x59,
+	0x3a, 0xdc, 0x99, 0x7a, 0x05, 0x47, 0xea, 0x1a, 0x06, 0xea, 0x6f, 0xbd, 0x76, 0xf8, 0x26, 0x27,
+	0x0e, 0x5f, 0x39, 0x34, 0x54, 0xdd, 0xa9, 0x4b, 0x98, 0x4f, 0x3a, 0xd4, 0x65, 0xb6, 0xaf, 0x9a,
+	0x6d, 0x11, 0xaf, 0x19, 0x47, 0xc5, 0x7f, 0xae, 0xcc, 0xf2, 0x6e, 0xd5, 0x37, 0x21, 0x71, 0xa9,
+	0xed, 0x7a, 0xcc, 0x1f, 0x1d, 0x4c, 0xf3, 0x4a, 0x5b, 0xb7, 0x34, 0xa0, 0x64, 0xfc, 0xc3, 0xe1,
+	0xf4, 0x14, 0xee, 0x8c, 0xc7, 0xc6, 0xfa, 0x7b, 0x41, 0x85, 0xef, 0xc4, 0xc3, 0x0b, 0xc3, 0x6e,
+	0x47, 0x8f, 0x61, 0x5b, 0x6e, 0x46, 0x7a, 0xbe, 0x60, 0x1e, 0xe9, 0x30, 0xce, 0x89, 0x06, 0x67,
+	0x16, 0xf5, 0x48, 0x94, 0xde, 0xa6, 0x74, 0x3e, 0x67, 0x9c, 0x9b, 0xb3, 0xfd, 0x00, 0xb6, 0x9d,
+	0x20, 0xf0, 0xdc, 0xe0, 0x33, 0x9f, 0x50, 0xdf, 0x1d, 0xa1, 0x9b, 0x52, 0x41, 0x9b, 0x91, 0xb7,
+	0xec, 0xbb, 0x43, 0xae, 0x39, 0x58, 0x62, 0x3e, 0x89, 0x5c, 0x19, 0x50, 0xd5, 0x00, 0xe6, 0x17,
+	0x8d, 0xc5, 0x08, 0xea, 0xe7, 0x16, 0x6c, 0x61, 0x7a, 0x46, 0x43, 0xea, 0x3b, 0xb4, 0x1e, 0xf4,
+	0x42, 0x87, 0x16, 0x03, 0xff, 0x8c, 0xb5, 0x50, 0x01, 0x50, 0x48, 0x5b, 0x8c, 0x8b, 0xf0, 0x82,
+	0xb0, 0xee, 0x19, 0x27, 0xaa, 0x25, 0xb5, 0xb6, 0x36, 0xaf, 0x2e, 0x73, 0x69, 0x6c, 0xbc, 0x95,
+	0xda, 0x51, 0xfd, 0x99, 0xcd, 0xdb, 0x38, 0x1d, 0xe1, 0x2b, 0xdd, 0x33, 0x2e, 0x2d, 0xe8, 0x5d,
+	0x18, 0xd8, 0x48, 0x9f, 0x86, 0x9c, 0x05, 0xbe, 0xbe, 0x66, 0xf1, 0x5a, 0x64, 0x7f, 0xa1, 0xcd,
+	0x86, 0xce, 0xaf, 0x13, 0x00, 0x46, 0xb3, 0xfe, 0x59, 0x70, 0x13, 0x59, 0xef, 0xc2, 0xa2, 0x4b,
+	0x1d, 0xd6, 0xd1, 0xef, 0x7b, 0xeb, 0xe1, 0x0a, 0x1e, 0xac, 0xe5, 0x4c, 0xfc, 0xb4, 0x17, 0x08,
+	0x4a, 0x1c, 0xf5, 0xdc, 0x71, 0x2e, 0x94, 0x3a, 0x53, 0x78, 0x45, 0x59, 0x8b, 0xc6, 0x88, 0x9e,
+	0xc2, 0x92, 0xcd, 0x39, 0x15, 0xc4, 0xf1, 0x6c, 0xce, 0x95, 0xe0, 0x56, 0x0f, 0xb3, 0x93, 0x14,
+	0x9c, 0x97, 0xb0, 0xa2, 0x44, 0x61, 0xb0, 0x07, 0xbf, 0xd1, 0x1e, 0x2c, 0xb9, 0x94, 0x3b, 0x21,
+	0xeb, 0x4a, 0x81, 0x2a, 0x05, 0xa6, 0xf0, 0xa8, 0x09, 0x6d, 0xc3, 0x3c, 0x57, 0x45, 0x56, 0x52,
+	0x4b, 0x61, 0xb3, 0x42, 0x59, 0x00, 0x97, 0x76, 0x43, 0xea, 0xc8, 0x46, 0x50, 0x3a, 0x5a, 0xc4,
+	0x23, 0x16, 0x53, 0x9d, 0xbf, 0x5a, 0x00, 0xaa, 0x4b, 0x3e, 0xea, 0xd9, 0xe1, 0x8d, 0x5e, 0xdf,
+	0x8f, 0x61, 0xbb, 0x63, 0x9f, 0x13, 0xa7, 0x2d, 0xdf, 0x17, 0xb1, 0xb6, 0xd2, 0x37, 0xda, 0x46,
+	0xc7, 0x3e, 0x2f, 0x2a, 0xe7, 0x48, 0x5b, 0xdd, 0x86, 0x54, 0x87, 0xf9, 0x64, 0x74, 0xec, 0x2d,
+	0x76, 0x98, 0xaf, 0xfb, 0x54, 0x3a, 0xed, 0x73, 0xe3, 0x4c, 0x1a, 0xa7, 0x7d, 0xae, 0x9d, 0xf7,
+	0x61, 0x45, 0x3a, 0xb9, 0xb0, 0x3d, 0xea, 0x53, 0xce, 0xcd, 0xe4, 0x5b, 0xee, 0xd8, 0xe7, 0xf5,
+	0xc8, 0x66, 0x72, 0xfa, 0xa9, 0x05, 0x1b, 0xea, 0xad, 0xac, 0x58, 0x8b, 0x5e, 0x48, 0xab, 0xa1,
+	0x4b, 0x43, 0xf4, 0x6d, 0x80, 0x41, 0x72, 0xfa, 0x11, 0x9a, 0x2a, 0xac, 0x5c, 0x5d, 0xe6, 0x52,
+	0x51, 0x76, 0x1c, 0xa7, 0xa2, 0xf4, 0x38, 0xfa, 0x0e, 0x2c, 0x98, 0xbf, 0x31, 0x55, 0x42, 0xab,
+	0x87, 0xb7, 0x27, 0x1d, 0x5b, 0x59, 0x43, 0x70, 0x84, 0x7d, 0x92, 0xfc, 0xfc, 0x8b, 0xdc, 0xcc,
+	0xa3, 0x3f, 0x5b, 0xb0, 0x34, 0xfa, 0x2a, 0xb8, 0x03, 0x99, 0x1a, 0xae, 0x14, 0xcb, 0xa4, 0xde,
+	0xc8, 0x37, 0x9a, 0x75, 0xd2, 0x3c, 0xa9, 0xd7, 0xca, 0xc5, 0xca, 0x51, 0xa5, 0x5c, 0x4a, 0xcf,
+	0xa0, 0x7b, 0x90, 0x1d, 0xf3, 0xfe, 0xf0, 0xa4, 0xfa, 0xc9, 0x09, 0xa9, 0x57, 0x3e, 0x3a, 0xc9,
+	0x1f, 0x93, 0x4a, 0x29, 0x6d, 0xa1, 0x5d, 0xd8, 0x8e, 0x61, 0x4e, 0xaa, 0x0d, 0x82, 0xcb, 0xf9,
+	0xd2, 0xcb, 0x74, 0xe2, 0x9a, 0x2f, 0xff, 0x22, 0x5f, 0x39, 0xce, 0x17, 0x8e, 0xcb, 0xe9, 0x59,
+	0xf4, 0x00, 0xee, 0x5e, 0x8b, 0xab, 0x9c, 0x90, 0x62, 0x13, 0xe3, 0xf2, 0x49, 0x83, 0x1c, 0x95,
+	0xcb, 0xa5, 0x7a, 0x3a, 0x89, 0x6e, 0xc1, 0x46, 0x0c, 0xf6, 0x2c, 0x7f, 0xdc, 0x28, 0x97, 0xd2,
+	0x73, 0xbb, 0xc9, 0xcf, 0x7f, 0x9b, 0x9d, 0x79, 0xf4, 0x7b, 0x0b, 0xd6, 0xaf, 0x3d, 0x7f, 0xd0,
+	0x7d, 0xc8, 0x19, 0x8a, 0xaf, 0x49, 0x6e, 0x3a, 0xa8, 0x59, 0xab, 0x55, 0xb1, 0xdc, 0xc5, 0x9a,
+	0x0e, 0x1a, 0xa6, 0x92, 0x40, 0x77, 0xe1, 0x9d, 0x49, 0xa0, 0x91, 0x6c, 0x0d, 0xdb, 0x5f, 0x5a,
+	0x00, 0xc3, 0x7e, 0x42, 0xb7, 0xe1, 0x56, 0xbe, 0x5e, 0x2f, 0x37, 0x48, 0xf1, 0x38, 0x5f, 0x1f,
+	0xa7, 0xb7, 0x0d, 0x68, 0xd4, 0x59, 0xc4, 0x2f, 0x6b, 0x8d, 0x6a, 0xda, 0x42, 0x5b, 0xb0, 0x3e,
+	0x6a, 0x3f, 0xaa, 0xe2, 0xf2, 0x8f, 0xd2, 0x09, 0xb4, 0x03, 0x5b, 0x31, 0x78, 0xf5, 0xf9, 0xf3,
+	0x6a, 0xa9, 0xd2, 0x78, 0x99, 0x9e, 0x1d, 0xff, 0x52, 0xf9, 0xe3, 0xa6, 0xb4, 0x27, 0x35, 0xa7,
+	0xc2, 0xb3, 0xaf, 0xae, 0xb2, 0xd6, 0xd7, 0x57, 0x59, 0xeb, 0xef, 0x57, 0x59, 0xeb, 0x17, 0xaf,
+	0xb2, 0x33, 0x5f, 0xbf, 0xca, 0xce, 0xfc, 0xed, 0x55, 0x76, 0xe6, 0xc7, 0xfb, 0x2d, 0x26, 0xda,
+	0xbd, 0xd3, 0x7d, 0x27, 0xe8, 0x1c, 0x48, 0x85, 0xa9, 0xff, 0x68, 0x38, 0x81, 0x77, 0xe0, 0xb4,
+	0x6d, 0xe6, 0x1f, 0xf4, 0x1f, 0x1f, 0x9c, 0x9b, 0xff, 0x7d, 0x88, 0x8b, 0x2e, 0xe5, 0xa7, 0xf3,
+	0x0a, 0xf0, 0xf8, 0xdf, 0x03, 0x00, 0xfc, 0x3b, 0x73, 0x50, 0x7b, 0x11, 0x00, 0x00,
+}
+
+func (this *Signal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Signal)
+	if !ok {
+		that2, ok := that.(Signal)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if this.LastUpdateTimestamp != that1.LastUpdateTimestamp {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.LastUpdateBlock != that1.LastUpdateBlock {
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Power != that1.Power {
 		return false
 	}
 	return true
 }
-func (this *Price) Equal(that interface{}) bool {
+func (this *Vote) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Price)
+	that1, ok := that.(*Vote)
 	if !ok {
-		that2, ok := that.(Price)
+		that2, ok := that.(Vote)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1143,28 +1683,27 @@ func (this *Price) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Status != that1.Status {
-		return false
-	}
-	if this.SignalID != that1.SignalID {
+	if this.Voter != that1.Voter {
 		return false
 	}
-	if this.Price != that1.Price {
+	if len(this.Signals) != len(that1.Signals) {
 		return false
 	}
-	if this.Timestamp != that1.Timestamp {
-		return false
+	for i := range this.Signals {
+		if !this.Signals[i].Equal(&that1.Signals[i]) {
+			return false
+		}
 	}
 	return true
 }
-func (this *SignalPrice) Equal(that interface{}) bool {
+func (this *SignalVoteDelegation) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SignalPrice)
+	that1, ok := that.(*SignalVoteDelegation)
 	if !ok {
-		that2, ok := that.(SignalPrice)
+		that2, ok := that.(SignalVoteDelegation)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1176,25 +1715,25 @@ func (this *SignalPrice) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Status != that1.Status {
+	if this.Delegator != that1.Delegator {
 		return false
 	}
-	if this.SignalID != that1.SignalID {
+	if this.Curator != that1.Curator {
 		return false
 	}
-	if this.Price != that1.Price {
+	if this.Power != that1.Power {
 		return false
 	}
 	return true
 }
-func (this *ValidatorPrice) Equal(that interface{}) bool {
+func (this *CuratorDelegatedPower) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ValidatorPrice)
+	that1, ok := that.(*CuratorDelegatedPower)
 	if !ok {
-		that2, ok := that.(ValidatorPrice)
+		that2, ok := that.(CuratorDelegatedPower)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1206,31 +1745,52 @@ func (this *ValidatorPrice) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.SignalPriceStatus != that1.SignalPriceStatus {
+	if this.Curator != that1.Curator {
 		return false
 	}
-	if this.SignalID != that1.SignalID {
+	if this.Power != that1.Power {
 		return false
 	}
-	if this.Price != that1.Price {
+	return true
+}
+func (this *Feed) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Feed)
+	if !ok {
+		that2, ok := that.(Feed)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.Timestamp != that1.Timestamp {
+	if this.SignalID != that1.SignalID {
 		return false
 	}
-	if this.BlockHeight != that1.BlockHeight {
+	if this.Power != that1.Power {
+		return false
+	}
+	if this.Interval != that1.Interval {
 		return false
 	}
 	return true
 }
-func (this *ValidatorPriceList) Equal(that interface{}) bool {
+func (this *FeedWithDeviation) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ValidatorPriceList)
+	that1, ok := that.(*FeedWithDeviation)
 	if !ok {
-		that2, ok := that.(ValidatorPriceList)
+		that2, ok := that.(FeedWithDeviation)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1242,27 +1802,28 @@ func (this *ValidatorPriceList) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Validator != that1.Validator {
+	if this.SignalID != that1.SignalID {
 		return false
 	}
-	if len(this.ValidatorPrices) != len(that1.ValidatorPrices) {
+	if this.Power != that1.Power {
 		return false
 	}
-	for i := range this.ValidatorPrices {
-		if !this.ValidatorPrices[i].Equal(&that1.ValidatorPrices[i]) {
-			return false
-		}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.DeviationBasisPoint != that1.DeviationBasisPoint {
+		return false
 	}
 	return true
 }
-func (this *ReferenceSourceConfig) Equal(that interface{}) bool {
+func (this *CurrentFeeds) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ReferenceSourceConfig)
+	that1, ok := that.(*CurrentFeeds)
 	if !ok {
-		that2, ok := that.(ReferenceSourceConfig)
+		that2, ok := that.(CurrentFeeds)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1274,318 +1835,417 @@ func (this *ReferenceSourceConfig) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.RegistryIPFSHash != that1.RegistryIPFSHash {
+	if len(this.Feeds) != len(that1.Feeds) {
 		return false
 	}
-	if this.RegistryVersion != that1.RegistryVersion {
+	for i := range this.Feeds {
+		if !this.Feeds[i].Equal(&that1.Feeds[i]) {
+			return false
+		}
+	}
+	if this.LastUpdateTimestamp != that1.LastUpdateTimestamp {
+		return false
+	}
+	if this.LastUpdateBlock != that1.LastUpdateBlock {
 		return false
 	}
 	return true
 }
-func (m *Signal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CurrentFeedWithDeviations) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Signal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Signal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Power != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x10
+	that1, ok := that.(*CurrentFeedWithDeviations)
+	if !ok {
+		that2, ok := that.(CurrentFeedWithDeviations)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintFeeds(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Vote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.Feeds) != len(that1.Feeds) {
+		return false
 	}
-	return dAtA[:n], nil
+	for i := range this.Feeds {
+		if !this.Feeds[i].Equal(&that1.Feeds[i]) {
+			return false
+		}
+	}
+	if this.LastUpdateTimestamp != that1.LastUpdateTimestamp {
+		return false
+	}
+	if this.LastUpdateBlock != that1.LastUpdateBlock {
+		return false
+	}
+	return true
 }
+func (this *Price) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signals) > 0 {
-		for iNdEx := len(m.Signals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFeeds(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	that1, ok := that.(*Price)
+	if !ok {
+		that2, ok := that.(Price)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintFeeds(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Feed) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Status != that1.Status {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Feed) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Feed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Interval != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Interval))
-		i--
-		dAtA[i] = 0x18
+	if this.SignalID != that1.SignalID {
+		return false
 	}
-	if m.Power != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x10
+	if this.Price != that1.Price {
+		return false
 	}
-	if len(m.SignalID) > 0 {
-		i -= len(m.SignalID)
-		copy(dAtA[i:], m.SignalID)
-		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
-		i--
-		dAtA[i] = 0xa
+	if this.Timestamp != that1.Timestamp {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *FeedWithDeviation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.InterquartileRange != that1.InterquartileRange {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FeedWithDeviation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.ParticipationBasisPoint != that1.ParticipationBasisPoint {
+		return false
+	}
+	if this.ReporterCount != that1.ReporterCount {
+		return false
+	}
+	return true
 }
+func (this *SignalPrice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *FeedWithDeviation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DeviationBasisPoint != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.DeviationBasisPoint))
-		i--
-		dAtA[i] = 0x20
+	that1, ok := that.(*SignalPrice)
+	if !ok {
+		that2, ok := that.(SignalPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Interval != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Interval))
-		i--
-		dAtA[i] = 0x18
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Power != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x10
+	if this.Status != that1.Status {
+		return false
 	}
-	if len(m.SignalID) > 0 {
-		i -= len(m.SignalID)
-		copy(dAtA[i:], m.SignalID)
-		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
-		i--
-		dAtA[i] = 0xa
+	if this.SignalID != that1.SignalID {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CurrentFeeds) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Price != that1.Price {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
+func (this *ValidatorPrice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CurrentFeeds) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*ValidatorPrice)
+	if !ok {
+		that2, ok := that.(ValidatorPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SignalPriceStatus != that1.SignalPriceStatus {
+		return false
+	}
+	if this.SignalID != that1.SignalID {
+		return false
+	}
+	if this.Price != that1.Price {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	return true
 }
+func (this *ValidatorPriceList) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CurrentFeeds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastUpdateBlock != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.LastUpdateBlock))
-		i--
-		dAtA[i] = 0x18
+	that1, ok := that.(*ValidatorPriceList)
+	if !ok {
+		that2, ok := that.(ValidatorPriceList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.LastUpdateTimestamp != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.LastUpdateTimestamp))
-		i--
-		dAtA[i] = 0x10
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Feeds) > 0 {
-		for iNdEx := len(m.Feeds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Feeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFeeds(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if this.Validator != that1.Validator {
+		return false
+	}
+	if len(this.ValidatorPrices) != len(that1.ValidatorPrices) {
+		return false
+	}
+	for i := range this.ValidatorPrices {
+		if !this.ValidatorPrices[i].Equal(&that1.ValidatorPrices[i]) {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *CurrentFeedWithDeviations) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *SignalPricesCommitment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *CurrentFeedWithDeviations) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*SignalPricesCommitment)
+	if !ok {
+		that2, ok := that.(SignalPricesCommitment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	return true
 }
+func (this *ValidatorAccuracy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CurrentFeedWithDeviations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastUpdateBlock != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.LastUpdateBlock))
-		i--
-		dAtA[i] = 0x18
+	that1, ok := that.(*ValidatorAccuracy)
+	if !ok {
+		that2, ok := that.(ValidatorAccuracy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.LastUpdateTimestamp != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.LastUpdateTimestamp))
-		i--
-		dAtA[i] = 0x10
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Feeds) > 0 {
-		for iNdEx := len(m.Feeds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Feeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFeeds(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if this.Validator != that1.Validator {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.EvaluatedCount != that1.EvaluatedCount {
+		return false
+	}
+	if this.OutlierCount != that1.OutlierCount {
+		return false
+	}
+	if this.TotalDeviationBasisPoint != that1.TotalDeviationBasisPoint {
+		return false
+	}
+	return true
 }
+func (this *ValidatorFeedReport) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Price) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ValidatorFeedReport)
+	if !ok {
+		that2, ok := that.(ValidatorFeedReport)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SignalID != that1.SignalID {
+		return false
+	}
+	if !this.ValidatorPrice.Equal(&that1.ValidatorPrice) {
+		return false
+	}
+	if !this.AggregatedPrice.Equal(&that1.AggregatedPrice) {
+		return false
+	}
+	if this.DeviationBasisPoint != that1.DeviationBasisPoint {
+		return false
+	}
+	if this.CountedInMedian != that1.CountedInMedian {
+		return false
+	}
+	if this.ReportDeadlineTimestamp != that1.ReportDeadlineTimestamp {
+		return false
+	}
+	if this.ReportDeadlineBlockHeight != that1.ReportDeadlineBlockHeight {
+		return false
+	}
+	if this.TimeUntilMissReport != that1.TimeUntilMissReport {
+		return false
+	}
+	if this.CooldownEndTimestamp != that1.CooldownEndTimestamp {
+		return false
+	}
+	if this.InCooldown != that1.InCooldown {
+		return false
+	}
+	return true
 }
+func (this *ReferenceSourceConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Price) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*ReferenceSourceConfig)
+	if !ok {
+		that2, ok := that.(ReferenceSourceConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RegistryIPFSHash != that1.RegistryIPFSHash {
+		return false
+	}
+	if this.RegistryVersion != that1.RegistryVersion {
+		return false
+	}
+	return true
 }
+func (this *SignalInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Price) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x20
+	that1, ok := that.(*SignalInfo)
+	if !ok {
+		that2, ok := that.(SignalInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Price != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Price))
-		i--
-		dAtA[i] = 0x18
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.SignalID) > 0 {
-		i -= len(m.SignalID)
-		copy(dAtA[i:], m.SignalID)
-		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
-		i--
-		dAtA[i] = 0x12
+	if this.SignalID != that1.SignalID {
+		return false
 	}
-	if m.Status != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+	if this.Decimals != that1.Decimals {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.QuoteCurrency != that1.QuoteCurrency {
+		return false
+	}
+	if this.AssetClass != that1.AssetClass {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Source != that1.Source {
+		return false
+	}
+	if this.Deprecated != that1.Deprecated {
+		return false
+	}
+	return true
 }
+func (this *PriceGuard) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SignalPrice) Marshal() (dAtA []byte, err error) {
+	that1, ok := that.(*PriceGuard)
+	if !ok {
+		that2, ok := that.(PriceGuard)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SignalID != that1.SignalID {
+		return false
+	}
+	if this.MaxChangeBasisPoint != that1.MaxChangeBasisPoint {
+		return false
+	}
+	if this.MinPrice != that1.MinPrice {
+		return false
+	}
+	if this.MaxPrice != that1.MaxPrice {
+		return false
+	}
+	if this.MaxStaleness != that1.MaxStaleness {
+		return false
+	}
+	return true
+}
+func (m *Signal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1595,37 +2255,32 @@ func (m *SignalPrice) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SignalPrice) MarshalTo(dAtA []byte) (int, error) {
+func (m *Signal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignalPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Signal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Price != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Price))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.SignalID) > 0 {
-		i -= len(m.SignalID)
-		copy(dAtA[i:], m.SignalID)
-		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
+	if m.Power != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Power))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.Status != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Status))
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.ID)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorPrice) Marshal() (dAtA []byte, err error) {
+func (m *Vote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1635,47 +2290,83 @@ func (m *ValidatorPrice) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorPrice) MarshalTo(dAtA []byte) (int, error) {
+func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x28
+	if len(m.Signals) > 0 {
+		for iNdEx := len(m.Signals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeds(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Timestamp))
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.Voter)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0xa
 	}
-	if m.Price != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Price))
+	return len(dAtA) - i, nil
+}
+
+func (m *SignalVoteDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalVoteDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalVoteDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Power))
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.SignalID) > 0 {
-		i -= len(m.SignalID)
-		copy(dAtA[i:], m.SignalID)
-		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.Curator)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.SignalPriceStatus != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.SignalPriceStatus))
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.Delegator)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorPriceList) Marshal() (dAtA []byte, err error) {
+func (m *CuratorDelegatedPower) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1685,41 +2376,32 @@ func (m *ValidatorPriceList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorPriceList) MarshalTo(dAtA []byte) (int, error) {
+func (m *CuratorDelegatedPower) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorPriceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CuratorDelegatedPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorPrices) > 0 {
-		for iNdEx := len(m.ValidatorPrices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFeeds(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.Power != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintFeeds(dAtA, i, uint64(len(m.Validator)))
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.Curator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReferenceSourceConfig) Marshal() (dAtA []byte, err error) {
+func (m *Feed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1729,34 +2411,37 @@ func (m *ReferenceSourceConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReferenceSourceConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *Feed) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReferenceSourceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Feed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RegistryVersion) > 0 {
-		i -= len(m.RegistryVersion)
-		copy(dAtA[i:], m.RegistryVersion)
-		i = encodeVarintFeeds(dAtA, i, uint64(len(m.RegistryVersion)))
+	if m.Interval != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Interval))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if len(m.RegistryIPFSHash) > 0 {
-		i -= len(m.RegistryIPFSHash)
-		copy(dAtA[i:], m.RegistryIPFSHash)
-		i = encodeVarintFeeds(dAtA, i, uint64(len(m.RegistryIPFSHash)))
+	if m.Power != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalID) > 0 {
+		i -= len(m.SignalID)
+		copy(dAtA[i:], m.SignalID)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FeedsSignatureOrder) Marshal() (dAtA []byte, err error) {
+func (m *FeedWithDeviation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1766,289 +2451,2416 @@ func (m *FeedsSignatureOrder) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FeedsSignatureOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeedWithDeviation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FeedsSignatureOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeedWithDeviation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Encoder != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Encoder))
+	if m.DeviationBasisPoint != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.DeviationBasisPoint))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Interval != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Power != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Power))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.SignalIDs) > 0 {
-		for iNdEx := len(m.SignalIDs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SignalIDs[iNdEx])
-			copy(dAtA[i:], m.SignalIDs[iNdEx])
-			i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalIDs[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.SignalID) > 0 {
+		i -= len(m.SignalID)
+		copy(dAtA[i:], m.SignalID)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFeeds(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFeeds(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CurrentFeeds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Signal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovFeeds(uint64(l))
-	}
-	if m.Power != 0 {
-		n += 1 + sovFeeds(uint64(m.Power))
-	}
-	return n
+
+func (m *CurrentFeeds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Vote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CurrentFeeds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovFeeds(uint64(l))
+	if m.LastUpdateBlock != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.LastUpdateBlock))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Signals) > 0 {
-		for _, e := range m.Signals {
-			l = e.Size()
-			n += 1 + l + sovFeeds(uint64(l))
+	if m.LastUpdateTimestamp != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.LastUpdateTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Feeds) > 0 {
+		for iNdEx := len(m.Feeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Feeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeds(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Feed) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SignalID)
-	if l > 0 {
-		n += 1 + l + sovFeeds(uint64(l))
-	}
-	if m.Power != 0 {
-		n += 1 + sovFeeds(uint64(m.Power))
-	}
-	if m.Interval != 0 {
-		n += 1 + sovFeeds(uint64(m.Interval))
+func (m *CurrentFeedWithDeviations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FeedWithDeviation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CurrentFeedWithDeviations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrentFeedWithDeviations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SignalID)
-	if l > 0 {
-		n += 1 + l + sovFeeds(uint64(l))
+	if m.LastUpdateBlock != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.LastUpdateBlock))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Power != 0 {
-		n += 1 + sovFeeds(uint64(m.Power))
-	}
-	if m.Interval != 0 {
-		n += 1 + sovFeeds(uint64(m.Interval))
+	if m.LastUpdateTimestamp != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.LastUpdateTimestamp))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.DeviationBasisPoint != 0 {
-		n += 1 + sovFeeds(uint64(m.DeviationBasisPoint))
+	if len(m.Feeds) > 0 {
+		for iNdEx := len(m.Feeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Feeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeds(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CurrentFeeds) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Price) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Price) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Price) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Feeds) > 0 {
-		for _, e := range m.Feeds {
-			l = e.Size()
-			n += 1 + l + sovFeeds(uint64(l))
-		}
+	if m.ReporterCount != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.ReporterCount))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.LastUpdateTimestamp != 0 {
-		n += 1 + sovFeeds(uint64(m.LastUpdateTimestamp))
+	if m.ParticipationBasisPoint != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.ParticipationBasisPoint))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.LastUpdateBlock != 0 {
-		n += 1 + sovFeeds(uint64(m.LastUpdateBlock))
+	if m.InterquartileRange != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.InterquartileRange))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
+	if m.Timestamp != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Price != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SignalID) > 0 {
+		i -= len(m.SignalID)
+		copy(dAtA[i:], m.SignalID)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CurrentFeedWithDeviations) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SignalPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Feeds) > 0 {
-		for _, e := range m.Feeds {
-			l = e.Size()
-			n += 1 + l + sovFeeds(uint64(l))
-		}
+	if m.Price != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.LastUpdateTimestamp != 0 {
-		n += 1 + sovFeeds(uint64(m.LastUpdateTimestamp))
+	if len(m.SignalID) > 0 {
+		i -= len(m.SignalID)
+		copy(dAtA[i:], m.SignalID)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.LastUpdateBlock != 0 {
-		n += 1 + sovFeeds(uint64(m.LastUpdateBlock))
+	if m.Status != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Price) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sovFeeds(uint64(m.Status))
+	if m.BlockHeight != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.SignalID)
-	if l > 0 {
-		n += 1 + l + sovFeeds(uint64(l))
+	if m.Timestamp != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.Price != 0 {
-		n += 1 + sovFeeds(uint64(m.Price))
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovFeeds(uint64(m.Timestamp))
+	if len(m.SignalID) > 0 {
+		i -= len(m.SignalID)
+		copy(dAtA[i:], m.SignalID)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.SignalPriceStatus != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.SignalPriceStatus))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SignalPrice) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorPriceList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPriceList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPriceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sovFeeds(uint64(m.Status))
-	}
-	l = len(m.SignalID)
-	if l > 0 {
-		n += 1 + l + sovFeeds(uint64(l))
+	if len(m.ValidatorPrices) > 0 {
+		for iNdEx := len(m.ValidatorPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeds(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Price != 0 {
-		n += 1 + sovFeeds(uint64(m.Price))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorPrice) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SignalPricesCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalPricesCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalPricesCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SignalPriceStatus != 0 {
-		n += 1 + sovFeeds(uint64(m.SignalPriceStatus))
+	if m.Round != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.SignalID)
-	if l > 0 {
-		n += 1 + l + sovFeeds(uint64(l))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Price != 0 {
-		n += 1 + sovFeeds(uint64(m.Price))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovFeeds(uint64(m.Timestamp))
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorAccuracy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovFeeds(uint64(m.BlockHeight))
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorAccuracy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorAccuracy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalDeviationBasisPoint != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.TotalDeviationBasisPoint))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
+	if m.OutlierCount != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.OutlierCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EvaluatedCount != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.EvaluatedCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorPriceList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorFeedReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorFeedReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorFeedReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovFeeds(uint64(l))
+	if m.InCooldown {
+		i--
+		if m.InCooldown {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	if len(m.ValidatorPrices) > 0 {
-		for _, e := range m.ValidatorPrices {
-			l = e.Size()
-			n += 1 + l + sovFeeds(uint64(l))
+	if m.CooldownEndTimestamp != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.CooldownEndTimestamp))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TimeUntilMissReport != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.TimeUntilMissReport))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ReportDeadlineBlockHeight != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.ReportDeadlineBlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ReportDeadlineTimestamp != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.ReportDeadlineTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CountedInMedian {
+		i--
+		if m.CountedInMedian {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DeviationBasisPoint != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.DeviationBasisPoint))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.AggregatedPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeeds(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.ValidatorPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeeds(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.SignalID) > 0 {
+		i -= len(m.SignalID)
+		copy(dAtA[i:], m.SignalID)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReferenceSourceConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferenceSourceConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferenceSourceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RegistryVersion) > 0 {
+		i -= len(m.RegistryVersion)
+		copy(dAtA[i:], m.RegistryVersion)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.RegistryVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RegistryIPFSHash) > 0 {
+		i -= len(m.RegistryIPFSHash)
+		copy(dAtA[i:], m.RegistryIPFSHash)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.RegistryIPFSHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignalInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AssetClass != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.AssetClass))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.QuoteCurrency) > 0 {
+		i -= len(m.QuoteCurrency)
+		copy(dAtA[i:], m.QuoteCurrency)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.QuoteCurrency)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalID) > 0 {
+		i -= len(m.SignalID)
+		copy(dAtA[i:], m.SignalID)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceGuard) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceGuard) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceGuard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxStaleness != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.MaxStaleness))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxPrice != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.MaxPrice))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinPrice != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.MinPrice))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxChangeBasisPoint != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.MaxChangeBasisPoint))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalID) > 0 {
+		i -= len(m.SignalID)
+		copy(dAtA[i:], m.SignalID)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeedsSignatureOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedsSignatureOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedsSignatureOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Encoder != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Encoder))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalIDs) > 0 {
+		for iNdEx := len(m.SignalIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignalIDs[iNdEx])
+			copy(dAtA[i:], m.SignalIDs[iNdEx])
+			i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalIDs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeds(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeds(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Signal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovFeeds(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *Vote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if len(m.Signals) > 0 {
+		for _, e := range m.Signals {
+			l = e.Size()
+			n += 1 + l + sovFeeds(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignalVoteDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovFeeds(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *CuratorDelegatedPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovFeeds(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *Feed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalID)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovFeeds(uint64(m.Power))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovFeeds(uint64(m.Interval))
+	}
+	return n
+}
+
+func (m *FeedWithDeviation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalID)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovFeeds(uint64(m.Power))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovFeeds(uint64(m.Interval))
+	}
+	if m.DeviationBasisPoint != 0 {
+		n += 1 + sovFeeds(uint64(m.DeviationBasisPoint))
+	}
+	return n
+}
+
+func (m *CurrentFeeds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Feeds) > 0 {
+		for _, e := range m.Feeds {
+			l = e.Size()
+			n += 1 + l + sovFeeds(uint64(l))
+		}
+	}
+	if m.LastUpdateTimestamp != 0 {
+		n += 1 + sovFeeds(uint64(m.LastUpdateTimestamp))
+	}
+	if m.LastUpdateBlock != 0 {
+		n += 1 + sovFeeds(uint64(m.LastUpdateBlock))
+	}
+	return n
+}
+
+func (m *CurrentFeedWithDeviations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Feeds) > 0 {
+		for _, e := range m.Feeds {
+			l = e.Size()
+			n += 1 + l + sovFeeds(uint64(l))
+		}
+	}
+	if m.LastUpdateTimestamp != 0 {
+		n += 1 + sovFeeds(uint64(m.LastUpdateTimestamp))
+	}
+	if m.LastUpdateBlock != 0 {
+		n += 1 + sovFeeds(uint64(m.LastUpdateBlock))
+	}
+	return n
+}
+
+func (m *Price) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovFeeds(uint64(m.Status))
+	}
+	l = len(m.SignalID)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 1 + sovFeeds(uint64(m.Price))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovFeeds(uint64(m.Timestamp))
+	}
+	if m.InterquartileRange != 0 {
+		n += 1 + sovFeeds(uint64(m.InterquartileRange))
+	}
+	if m.ParticipationBasisPoint != 0 {
+		n += 1 + sovFeeds(uint64(m.ParticipationBasisPoint))
+	}
+	if m.ReporterCount != 0 {
+		n += 1 + sovFeeds(uint64(m.ReporterCount))
+	}
+	return n
+}
+
+func (m *SignalPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovFeeds(uint64(m.Status))
+	}
+	l = len(m.SignalID)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 1 + sovFeeds(uint64(m.Price))
+	}
+	return n
+}
+
+func (m *ValidatorPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignalPriceStatus != 0 {
+		n += 1 + sovFeeds(uint64(m.SignalPriceStatus))
+	}
+	l = len(m.SignalID)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 1 + sovFeeds(uint64(m.Price))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovFeeds(uint64(m.Timestamp))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovFeeds(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *ValidatorPriceList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if len(m.ValidatorPrices) > 0 {
+		for _, e := range m.ValidatorPrices {
+			l = e.Size()
+			n += 1 + l + sovFeeds(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignalPricesCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.Round != 0 {
+		n += 1 + sovFeeds(uint64(m.Round))
+	}
+	return n
+}
+
+func (m *ValidatorAccuracy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.EvaluatedCount != 0 {
+		n += 1 + sovFeeds(uint64(m.EvaluatedCount))
+	}
+	if m.OutlierCount != 0 {
+		n += 1 + sovFeeds(uint64(m.OutlierCount))
+	}
+	if m.TotalDeviationBasisPoint != 0 {
+		n += 1 + sovFeeds(uint64(m.TotalDeviationBasisPoint))
+	}
+	return n
+}
+
+func (m *ValidatorFeedReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalID)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	l = m.ValidatorPrice.Size()
+	n += 1 + l + sovFeeds(uint64(l))
+	l = m.AggregatedPrice.Size()
+	n += 1 + l + sovFeeds(uint64(l))
+	if m.DeviationBasisPoint != 0 {
+		n += 1 + sovFeeds(uint64(m.DeviationBasisPoint))
+	}
+	if m.CountedInMedian {
+		n += 2
+	}
+	if m.ReportDeadlineTimestamp != 0 {
+		n += 1 + sovFeeds(uint64(m.ReportDeadlineTimestamp))
+	}
+	if m.ReportDeadlineBlockHeight != 0 {
+		n += 1 + sovFeeds(uint64(m.ReportDeadlineBlockHeight))
+	}
+	if m.TimeUntilMissReport != 0 {
+		n += 1 + sovFeeds(uint64(m.TimeUntilMissReport))
+	}
+	if m.CooldownEndTimestamp != 0 {
+		n += 1 + sovFeeds(uint64(m.CooldownEndTimestamp))
+	}
+	if m.InCooldown {
+		n += 2
+	}
+	return n
+}
+
+func (m *ReferenceSourceConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RegistryIPFSHash)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	l = len(m.RegistryVersion)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	return n
+}
+
+func (m *SignalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalID)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovFeeds(uint64(m.Decimals))
+	}
+	l = len(m.QuoteCurrency)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.AssetClass != 0 {
+		n += 1 + sovFeeds(uint64(m.AssetClass))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.Deprecated {
+		n += 2
+	}
+	return n
+}
+
+func (m *PriceGuard) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalID)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.MaxChangeBasisPoint != 0 {
+		n += 1 + sovFeeds(uint64(m.MaxChangeBasisPoint))
+	}
+	if m.MinPrice != 0 {
+		n += 1 + sovFeeds(uint64(m.MinPrice))
+	}
+	if m.MaxPrice != 0 {
+		n += 1 + sovFeeds(uint64(m.MaxPrice))
+	}
+	if m.MaxStaleness != 0 {
+		n += 1 + sovFeeds(uint64(m.MaxStaleness))
+	}
+	return n
+}
+
+func (m *FeedsSignatureOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignalIDs) > 0 {
+		for _, s := range m.SignalIDs {
+			l = len(s)
+			n += 1 + l + sovFeeds(uint64(l))
+		}
+	}
+	if m.Encoder != 0 {
+		n += 1 + sovFeeds(uint64(m.Encoder))
+	}
+	return n
+}
+
+func sovFeeds(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeds(x uint64) (n int) {
+	return sovFeeds(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Signal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Signal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Signal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signals = append(m.Signals, Signal{})
+			if err := m.Signals[len(m.Signals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalVoteDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalVoteDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalVoteDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CuratorDelegatedPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CuratorDelegatedPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CuratorDelegatedPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Feed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Feed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Feed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeedWithDeviation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeedWithDeviation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeedWithDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationBasisPoint", wireType)
+			}
+			m.DeviationBasisPoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationBasisPoint |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrentFeeds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrentFeeds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrentFeeds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeds = append(m.Feeds, Feed{})
+			if err := m.Feeds[len(m.Feeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+			}
+			m.LastUpdateTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlock", wireType)
+			}
+			m.LastUpdateBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrentFeedWithDeviations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrentFeedWithDeviations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrentFeedWithDeviations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeds = append(m.Feeds, FeedWithDeviation{})
+			if err := m.Feeds[len(m.Feeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+			}
+			m.LastUpdateTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlock", wireType)
+			}
+			m.LastUpdateBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Price) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Price: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Price: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PriceStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterquartileRange", wireType)
+			}
+			m.InterquartileRange = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InterquartileRange |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationBasisPoint", wireType)
+			}
+			m.ParticipationBasisPoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipationBasisPoint |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterCount", wireType)
+			}
+			m.ReporterCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReporterCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *ReferenceSourceConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RegistryIPFSHash)
-	if l > 0 {
-		n += 1 + l + sovFeeds(uint64(l))
-	}
-	l = len(m.RegistryVersion)
-	if l > 0 {
-		n += 1 + l + sovFeeds(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *FeedsSignatureOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SignalIDs) > 0 {
-		for _, s := range m.SignalIDs {
-			l = len(s)
-			n += 1 + l + sovFeeds(uint64(l))
+func (m *SignalPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SignalPriceStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Encoder != 0 {
-		n += 1 + sovFeeds(uint64(m.Encoder))
-	}
-	return n
-}
 
-func sovFeeds(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFeeds(x uint64) (n int) {
-	return sovFeeds(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Signal) Unmarshal(dAtA []byte) error {
+func (m *ValidatorPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2071,15 +4883,34 @@ func (m *Signal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Signal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Signal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalPriceStatus", wireType)
+			}
+			m.SignalPriceStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignalPriceStatus |= SignalPriceStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2107,13 +4938,13 @@ func (m *Signal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.SignalID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.Power = 0
+			m.Price = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2123,7 +4954,45 @@ func (m *Signal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
+				m.Price |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2149,7 +5018,7 @@ func (m *Signal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Vote) Unmarshal(dAtA []byte) error {
+func (m *ValidatorPriceList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2172,15 +5041,15 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorPriceList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorPriceList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2208,11 +5077,11 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2239,8 +5108,8 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signals = append(m.Signals, Signal{})
-			if err := m.Signals[len(m.Signals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorPrices = append(m.ValidatorPrices, ValidatorPrice{})
+			if err := m.ValidatorPrices[len(m.ValidatorPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2265,7 +5134,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Feed) Unmarshal(dAtA []byte) error {
+func (m *SignalPricesCommitment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2288,15 +5157,15 @@ func (m *Feed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Feed: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalPricesCommitment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Feed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalPricesCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2324,13 +5193,13 @@ func (m *Feed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalID = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			m.Power = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2340,16 +5209,31 @@ func (m *Feed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
 			}
-			m.Interval = 0
+			m.Round = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2359,7 +5243,7 @@ func (m *Feed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Interval |= int64(b&0x7F) << shift
+				m.Round |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2385,7 +5269,7 @@ func (m *Feed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeedWithDeviation) Unmarshal(dAtA []byte) error {
+func (m *ValidatorAccuracy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2408,15 +5292,15 @@ func (m *FeedWithDeviation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeedWithDeviation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorAccuracy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeedWithDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorAccuracy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2444,13 +5328,13 @@ func (m *FeedWithDeviation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalID = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluatedCount", wireType)
 			}
-			m.Power = 0
+			m.EvaluatedCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2460,16 +5344,16 @@ func (m *FeedWithDeviation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
+				m.EvaluatedCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutlierCount", wireType)
 			}
-			m.Interval = 0
+			m.OutlierCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2479,16 +5363,16 @@ func (m *FeedWithDeviation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Interval |= int64(b&0x7F) << shift
+				m.OutlierCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviationBasisPoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeviationBasisPoint", wireType)
 			}
-			m.DeviationBasisPoint = 0
+			m.TotalDeviationBasisPoint = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2498,7 +5382,7 @@ func (m *FeedWithDeviation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeviationBasisPoint |= int64(b&0x7F) << shift
+				m.TotalDeviationBasisPoint |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2524,7 +5408,7 @@ func (m *FeedWithDeviation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CurrentFeeds) Unmarshal(dAtA []byte) error {
+func (m *ValidatorFeedReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2547,17 +5431,17 @@ func (m *CurrentFeeds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CurrentFeeds: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorFeedReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrentFeeds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorFeedReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2567,50 +5451,29 @@ func (m *CurrentFeeds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFeeds
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeeds
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Feeds = append(m.Feeds, Feed{})
-			if err := m.Feeds[len(m.Feeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SignalID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
-			}
-			m.LastUpdateTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFeeds
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastUpdateTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlock", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPrice", wireType)
 			}
-			m.LastUpdateBlock = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2620,64 +5483,28 @@ func (m *CurrentFeeds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdateBlock |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFeeds(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFeeds
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CurrentFeedWithDeviations) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFeeds
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ValidatorPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CurrentFeedWithDeviations: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrentFeedWithDeviations: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedPrice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2704,16 +5531,15 @@ func (m *CurrentFeedWithDeviations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Feeds = append(m.Feeds, FeedWithDeviation{})
-			if err := m.Feeds[len(m.Feeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AggregatedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationBasisPoint", wireType)
 			}
-			m.LastUpdateTimestamp = 0
+			m.DeviationBasisPoint = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2723,16 +5549,16 @@ func (m *CurrentFeedWithDeviations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdateTimestamp |= int64(b&0x7F) << shift
+				m.DeviationBasisPoint |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CountedInMedian", wireType)
 			}
-			m.LastUpdateBlock = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2742,66 +5568,17 @@ func (m *CurrentFeedWithDeviations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdateBlock |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFeeds(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthFeeds
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Price) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFeeds
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Price: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Price: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.CountedInMedian = bool(v != 0)
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportDeadlineTimestamp", wireType)
 			}
-			m.Status = 0
+			m.ReportDeadlineTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2811,16 +5588,16 @@ func (m *Price) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= PriceStatus(b&0x7F) << shift
+				m.ReportDeadlineTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportDeadlineBlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.ReportDeadlineBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2830,29 +5607,35 @@ func (m *Price) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReportDeadlineBlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFeeds
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFeeds
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeUntilMissReport", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.TimeUntilMissReport = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeUntilMissReport |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.SignalID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CooldownEndTimestamp", wireType)
 			}
-			m.Price = 0
+			m.CooldownEndTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2862,16 +5645,16 @@ func (m *Price) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= uint64(b&0x7F) << shift
+				m.CooldownEndTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InCooldown", wireType)
 			}
-			m.Timestamp = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2881,11 +5664,12 @@ func (m *Price) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.InCooldown = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeeds(dAtA[iNdEx:])
@@ -2907,7 +5691,7 @@ func (m *Price) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalPrice) Unmarshal(dAtA []byte) error {
+func (m *ReferenceSourceConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2930,17 +5714,17 @@ func (m *SignalPrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalPrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReferenceSourceConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReferenceSourceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryIPFSHash", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2950,14 +5734,27 @@ func (m *SignalPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= SignalPriceStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistryIPFSHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2985,27 +5782,8 @@ func (m *SignalPrice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalID = string(dAtA[iNdEx:postIndex])
+			m.RegistryVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
-			}
-			m.Price = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFeeds
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Price |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeeds(dAtA[iNdEx:])
@@ -3027,7 +5805,7 @@ func (m *SignalPrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorPrice) Unmarshal(dAtA []byte) error {
+func (m *SignalInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3050,32 +5828,13 @@ func (m *ValidatorPrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorPrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalPriceStatus", wireType)
-			}
-			m.SignalPriceStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFeeds
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SignalPriceStatus |= SignalPriceStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
 			}
@@ -3107,11 +5866,11 @@ func (m *ValidatorPrice) Unmarshal(dAtA []byte) error {
 			}
 			m.SignalID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
 			}
-			m.Price = 0
+			m.Decimals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -3121,16 +5880,16 @@ func (m *ValidatorPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= uint64(b&0x7F) << shift
+				m.Decimals |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteCurrency", wireType)
 			}
-			m.Timestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -3140,16 +5899,29 @@ func (m *ValidatorPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteCurrency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetClass", wireType)
 			}
-			m.BlockHeight = 0
+			m.AssetClass = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -3159,64 +5931,14 @@ func (m *ValidatorPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				m.AssetClass |= AssetClass(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFeeds(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthFeeds
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorPriceList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFeeds
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorPriceList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorPriceList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3244,13 +5966,13 @@ func (m *ValidatorPriceList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -3260,26 +5982,44 @@ func (m *ValidatorPriceList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFeeds
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeeds
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorPrices = append(m.ValidatorPrices, ValidatorPrice{})
-			if err := m.ValidatorPrices[len(m.ValidatorPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeeds(dAtA[iNdEx:])
@@ -3301,7 +6041,7 @@ func (m *ValidatorPriceList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReferenceSourceConfig) Unmarshal(dAtA []byte) error {
+func (m *PriceGuard) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3324,15 +6064,15 @@ func (m *ReferenceSourceConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReferenceSourceConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceGuard: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReferenceSourceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceGuard: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegistryIPFSHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3360,13 +6100,13 @@ func (m *ReferenceSourceConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RegistryIPFSHash = string(dAtA[iNdEx:postIndex])
+			m.SignalID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegistryVersion", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeBasisPoint", wireType)
 			}
-			var stringLen uint64
+			m.MaxChangeBasisPoint = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -3376,24 +6116,68 @@ func (m *ReferenceSourceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxChangeBasisPoint |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFeeds
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFeeds
+			m.MinPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			m.MaxPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStaleness", wireType)
+			}
+			m.MaxStaleness = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStaleness |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.RegistryVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeeds(dAtA[iNdEx:])
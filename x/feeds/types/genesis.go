@@ -5,17 +5,30 @@ func NewGenesisState(
 	params Params,
 	votes []Vote,
 	rs ReferenceSourceConfig,
+	signalInfos []SignalInfo,
+	priceGuards []PriceGuard,
+	signalVoteDelegations []SignalVoteDelegation,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                params,
 		Votes:                 votes,
 		ReferenceSourceConfig: rs,
+		SignalInfos:           signalInfos,
+		PriceGuards:           priceGuards,
+		SignalVoteDelegations: signalVoteDelegations,
 	}
 }
 
 // DefaultGenesisState returns the default genesis state
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), []Vote{}, DefaultReferenceSourceConfig())
+	return NewGenesisState(
+		DefaultParams(),
+		[]Vote{},
+		DefaultReferenceSourceConfig(),
+		[]SignalInfo{},
+		[]PriceGuard{},
+		[]SignalVoteDelegation{},
+	)
 }
 
 // Validate performs basic genesis state validation
@@ -34,5 +47,17 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := ValidateSignalInfos(gs.SignalInfos); err != nil {
+		return err
+	}
+
+	if err := ValidatePriceGuards(gs.PriceGuards); err != nil {
+		return err
+	}
+
+	if err := ValidateSignalVoteDelegations(gs.SignalVoteDelegations); err != nil {
+		return err
+	}
+
 	return nil
 }
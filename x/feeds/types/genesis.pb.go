@@ -31,6 +31,12 @@ type GenesisState struct {
 	Votes []Vote `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes"`
 	// reference_source_config is the information about reference price config.
 	ReferenceSourceConfig ReferenceSourceConfig `protobuf:"bytes,3,opt,name=reference_source_config,json=referenceSourceConfig,proto3" json:"reference_source_config"`
+	// signal_infos is a list of registered metadata of signal ids.
+	SignalInfos []SignalInfo `protobuf:"bytes,4,rep,name=signal_infos,json=signalInfos,proto3" json:"signal_infos"`
+	// price_guards is a list of price guards of signal ids.
+	PriceGuards []PriceGuard `protobuf:"bytes,5,rep,name=price_guards,json=priceGuards,proto3" json:"price_guards"`
+	// signal_vote_delegations is a list of feeds voting power delegations.
+	SignalVoteDelegations []SignalVoteDelegation `protobuf:"bytes,6,rep,name=signal_vote_delegations,json=signalVoteDelegations,proto3" json:"signal_vote_delegations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +93,27 @@ func (m *GenesisState) GetReferenceSourceConfig() ReferenceSourceConfig {
 	return ReferenceSourceConfig{}
 }
 
+func (m *GenesisState) GetSignalInfos() []SignalInfo {
+	if m != nil {
+		return m.SignalInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceGuards() []PriceGuard {
+	if m != nil {
+		return m.PriceGuards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSignalVoteDelegations() []SignalVoteDelegation {
+	if m != nil {
+		return m.SignalVoteDelegations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "band.feeds.v1beta1.GenesisState")
 }
@@ -94,25 +121,31 @@ func init() {
 func init() { proto.RegisterFile("band/feeds/v1beta1/genesis.proto", fileDescriptor_3665d63bc534e43f) }
 
 var fileDescriptor_3665d63bc534e43f = []byte{
-	// 285 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x63, 0x0a, 0x1d, 0x52, 0xa6, 0x08, 0x44, 0x94, 0xc1, 0x8d, 0x98, 0xca, 0x62, 0xab,
-	0x2d, 0x03, 0x33, 0x0c, 0x30, 0xa2, 0x56, 0x62, 0x60, 0xa9, 0x1c, 0xf7, 0xe2, 0x46, 0xa2, 0x71,
-	0x64, 0xbb, 0x11, 0xbc, 0x05, 0x8f, 0xd5, 0xb1, 0x23, 0x13, 0x42, 0xc9, 0xc0, 0x6b, 0xa0, 0x38,
-	0x66, 0xaa, 0xb7, 0x93, 0xfe, 0xef, 0xff, 0xee, 0x74, 0x61, 0x9a, 0xb1, 0x72, 0x4d, 0x73, 0x80,
-	0xb5, 0xa6, 0xf5, 0x34, 0x03, 0xc3, 0xa6, 0x54, 0x40, 0x09, 0xba, 0xd0, 0xa4, 0x52, 0xd2, 0xc8,
-	0x28, 0xea, 0x08, 0x62, 0x09, 0xe2, 0x88, 0xe4, 0x42, 0x48, 0x21, 0x6d, 0x4c, 0xbb, 0xa9, 0x27,
-	0x93, 0xb1, 0xc7, 0x55, 0x31, 0xc5, 0xb6, 0x4e, 0x95, 0x60, 0x0f, 0xd0, 0x8b, 0x6d, 0x7e, 0xfd,
-	0x8b, 0xc2, 0xf3, 0xc7, 0x7e, 0xf9, 0xd2, 0x30, 0x03, 0xd1, 0x5d, 0x38, 0xec, 0x05, 0x31, 0x4a,
-	0xd1, 0x64, 0x34, 0x4b, 0xc8, 0xf1, 0x31, 0xe4, 0xd9, 0x12, 0xf7, 0xa7, 0xfb, 0xef, 0x71, 0xb0,
-	0x70, 0x7c, 0x74, 0x1b, 0x9e, 0xd5, 0xd2, 0x80, 0x8e, 0x4f, 0xd2, 0xc1, 0x64, 0x34, 0x8b, 0x7d,
-	0xc5, 0x17, 0x69, 0xc0, 0xd5, 0x7a, 0x38, 0x12, 0xe1, 0x95, 0x82, 0x1c, 0x14, 0x94, 0x1c, 0x56,
-	0x5a, 0xee, 0x14, 0x87, 0x15, 0x97, 0x65, 0x5e, 0x88, 0x78, 0x60, 0x0f, 0xb8, 0xf1, 0x79, 0x16,
-	0xff, 0x95, 0xa5, 0x6d, 0x3c, 0xd8, 0x82, 0x13, 0x5f, 0x2a, 0x6f, 0xf8, 0xb4, 0x6f, 0x30, 0x3a,
-	0x34, 0x18, 0xfd, 0x34, 0x18, 0x7d, 0xb6, 0x38, 0x38, 0xb4, 0x38, 0xf8, 0x6a, 0x71, 0xf0, 0x4a,
-	0x44, 0x61, 0x36, 0xbb, 0x8c, 0x70, 0xb9, 0xa5, 0xdd, 0x2e, 0xfb, 0x19, 0x2e, 0xdf, 0x28, 0xdf,
-	0xb0, 0xa2, 0xa4, 0xf5, 0x9c, 0xbe, 0xbb, 0x0f, 0x9a, 0x8f, 0x0a, 0x74, 0x36, 0xb4, 0xc0, 0xfc,
-	0x2f, 0x00, 0x00, 0xff, 0xff, 0x44, 0xfc, 0x4b, 0xbb, 0xc9, 0x01, 0x00, 0x00,
+	// 373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x3f, 0x8f, 0xda, 0x30,
+	0x18, 0xc6, 0x93, 0xf2, 0x67, 0x08, 0x4c, 0x51, 0x2b, 0xa2, 0x0c, 0x06, 0x75, 0xa2, 0x4b, 0x2c,
+	0xa0, 0x43, 0x67, 0x5a, 0x89, 0x76, 0x43, 0x20, 0x75, 0xb8, 0x25, 0x72, 0x92, 0x37, 0xc6, 0x12,
+	0xd8, 0x91, 0x6d, 0xd0, 0xdd, 0xb7, 0xb8, 0x8f, 0xc5, 0xc8, 0x78, 0xd3, 0xe9, 0x0e, 0xbe, 0xc8,
+	0xc9, 0x8e, 0x61, 0xb9, 0xb0, 0x45, 0x7e, 0x7f, 0xcf, 0xcf, 0x4f, 0xac, 0x37, 0x18, 0x65, 0x84,
+	0x17, 0xb8, 0x04, 0x28, 0x14, 0x3e, 0x4c, 0x32, 0xd0, 0x64, 0x82, 0x29, 0x70, 0x50, 0x4c, 0x25,
+	0x95, 0x14, 0x5a, 0x84, 0xa1, 0x21, 0x12, 0x4b, 0x24, 0x8e, 0x88, 0xbf, 0x52, 0x41, 0x85, 0x1d,
+	0x63, 0xf3, 0x55, 0x93, 0xf1, 0xb0, 0xc1, 0x55, 0x11, 0x49, 0x76, 0x4e, 0x15, 0xa3, 0x06, 0xa0,
+	0x16, 0xdb, 0xf9, 0xf7, 0xf7, 0x56, 0xd0, 0x5f, 0xd4, 0x97, 0xaf, 0x35, 0xd1, 0x10, 0xfe, 0x0a,
+	0xba, 0xb5, 0x20, 0xf2, 0x47, 0xfe, 0xb8, 0x37, 0x8d, 0x93, 0xcf, 0x65, 0x92, 0xa5, 0x25, 0xe6,
+	0xed, 0xe3, 0xeb, 0xd0, 0x5b, 0x39, 0x3e, 0xfc, 0x19, 0x74, 0x0e, 0x42, 0x83, 0x8a, 0xbe, 0x8c,
+	0x5a, 0xe3, 0xde, 0x34, 0x6a, 0x0a, 0xfe, 0x17, 0x1a, 0x5c, 0xac, 0x86, 0x43, 0x1a, 0x0c, 0x24,
+	0x94, 0x20, 0x81, 0xe7, 0x90, 0x2a, 0xb1, 0x97, 0x39, 0xa4, 0xb9, 0xe0, 0x25, 0xa3, 0x51, 0xcb,
+	0x16, 0xf8, 0xd1, 0xe4, 0x59, 0x5d, 0x23, 0x6b, 0x9b, 0xf8, 0x6d, 0x03, 0x4e, 0xfc, 0x4d, 0x36,
+	0x0d, 0xc3, 0x45, 0xd0, 0x57, 0x8c, 0x72, 0xb2, 0x4d, 0x19, 0x2f, 0x85, 0x8a, 0xda, 0xb6, 0x25,
+	0x6a, 0xb2, 0xaf, 0x2d, 0xf7, 0x8f, 0x97, 0xc2, 0x29, 0x7b, 0xea, 0x76, 0xa2, 0x8c, 0xa8, 0x92,
+	0x2c, 0x87, 0x94, 0xee, 0x89, 0x2c, 0x54, 0xd4, 0xb9, 0x2f, 0x5a, 0x1a, 0x6e, 0x61, 0xb0, 0xab,
+	0xa8, 0xba, 0x9d, 0xa8, 0xb0, 0x0c, 0x06, 0xae, 0x91, 0x79, 0x8a, 0xb4, 0x80, 0x2d, 0x50, 0xa2,
+	0x99, 0xe0, 0x2a, 0xea, 0x5a, 0xe7, 0xf8, 0x7e, 0x39, 0xf3, 0x90, 0x7f, 0x6e, 0x81, 0xeb, 0x9f,
+	0xab, 0x86, 0x99, 0x9a, 0xff, 0x3d, 0x9e, 0x91, 0x7f, 0x3a, 0x23, 0xff, 0xed, 0x8c, 0xfc, 0xe7,
+	0x0b, 0xf2, 0x4e, 0x17, 0xe4, 0xbd, 0x5c, 0x90, 0xf7, 0x90, 0x50, 0xa6, 0x37, 0xfb, 0x2c, 0xc9,
+	0xc5, 0x0e, 0x9b, 0xab, 0xec, 0x4e, 0xe4, 0x62, 0x8b, 0xf3, 0x0d, 0x61, 0x1c, 0x1f, 0x66, 0xf8,
+	0xd1, 0xed, 0x8e, 0x7e, 0xaa, 0x40, 0x65, 0x5d, 0x0b, 0xcc, 0x3e, 0x06, 0x00, 0xd5, 0x05, 0x68,
+	0xd6, 0xc3, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +168,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SignalVoteDelegations) > 0 {
+		for iNdEx := len(m.SignalVoteDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalVoteDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PriceGuards) > 0 {
+		for iNdEx := len(m.PriceGuards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceGuards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SignalInfos) > 0 {
+		for iNdEx := len(m.SignalInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.ReferenceSourceConfig.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -199,6 +274,24 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.ReferenceSourceConfig.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SignalInfos) > 0 {
+		for _, e := range m.SignalInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceGuards) > 0 {
+		for _, e := range m.PriceGuards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SignalVoteDelegations) > 0 {
+		for _, e := range m.SignalVoteDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -337,6 +430,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalInfos = append(m.SignalInfos, SignalInfo{})
+			if err := m.SignalInfos[len(m.SignalInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceGuards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceGuards = append(m.PriceGuards, PriceGuard{})
+			if err := m.PriceGuards[len(m.PriceGuards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalVoteDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalVoteDelegations = append(m.SignalVoteDelegations, SignalVoteDelegation{})
+			if err := m.SignalVoteDelegations[len(m.SignalVoteDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -35,6 +35,39 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"duplicate signal infos",
+			GenesisState{
+				Params:                DefaultParams(),
+				Votes:                 []Vote{},
+				ReferenceSourceConfig: DefaultReferenceSourceConfig(),
+				SignalInfos:           []SignalInfo{ValidSignalInfos[0], ValidSignalInfos[0]},
+			},
+			true,
+		},
+		{
+			"duplicate price guards",
+			GenesisState{
+				Params:                DefaultParams(),
+				Votes:                 []Vote{},
+				ReferenceSourceConfig: DefaultReferenceSourceConfig(),
+				PriceGuards:           []PriceGuard{ValidPriceGuards[0], ValidPriceGuards[0]},
+			},
+			true,
+		},
+		{
+			"duplicate signal vote delegations",
+			GenesisState{
+				Params:                DefaultParams(),
+				Votes:                 []Vote{},
+				ReferenceSourceConfig: DefaultReferenceSourceConfig(),
+				SignalVoteDelegations: []SignalVoteDelegation{
+					NewSignalVoteDelegation(ValidVoter, ValidCurator, 100),
+					NewSignalVoteDelegation(ValidVoter, ValidCurator, 200),
+				},
+			},
+			true,
+		},
 		{
 			"invalid reference source config",
 			GenesisState{
@@ -19,6 +19,15 @@ const (
 
 	// QuerierRoute is the querier route for the feeds module
 	QuerierRoute = ModuleName
+
+	// Version defines the current version the IBC feeds module supports
+	Version = "feeds-1"
+
+	// PortID is the default port id that feeds module binds to
+	PortID = ModuleName
+
+	// QueryPricesFeePayerKey is the key used to derive the fee payer accounts of price queries
+	QueryPricesFeePayerKey = "query-prices-fee-payer"
 )
 
 // Constants for keys
@@ -32,9 +41,20 @@ var (
 	PriceStoreKeyPrefix              = []byte{0x11}
 	VoteStoreKeyPrefix               = []byte{0x12}
 	SignalTotalPowerStoreKeyPrefix   = []byte{0x13}
+	PriceHistoryStoreKeyPrefix       = []byte{0x14}
+	ValidatorAccuracyStoreKeyPrefix  = []byte{0x15}
+	SignalInfoStoreKeyPrefix         = []byte{0x16}
+	PriceGuardStoreKeyPrefix         = []byte{0x17}
+	LastAcceptedPriceStoreKeyPrefix  = []byte{0x18}
+	CommitmentStoreKeyPrefix         = []byte{0x19}
+	// voting power delegations
+	SignalVoteDelegationStoreKeyPrefix  = []byte{0x1a}
+	CuratorDelegatedPowerStoreKeyPrefix = []byte{0x1b}
+	PriceHistoryCountStoreKeyPrefix     = []byte{0x1c}
 
 	// index prefixes
 	SignalTotalPowerByPowerIndexKeyPrefix = []byte{0x80}
+	DelegationByCuratorIndexKeyPrefix     = []byte{0x81}
 
 	// params store keys
 	ParamsKey = []byte{0x90}
@@ -60,6 +80,67 @@ func PriceStoreKey(signalID string) []byte {
 	return append(PriceStoreKeyPrefix, []byte(signalID)...)
 }
 
+// SignalInfoStoreKey creates a key for storing the metadata of a signal id
+func SignalInfoStoreKey(signalID string) []byte {
+	return append(SignalInfoStoreKeyPrefix, []byte(signalID)...)
+}
+
+// PriceGuardStoreKey creates a key for storing the price guard of a signal id
+func PriceGuardStoreKey(signalID string) []byte {
+	return append(PriceGuardStoreKeyPrefix, []byte(signalID)...)
+}
+
+// LastAcceptedPriceStoreKey creates a key for storing the last price of a signal id accepted by its price guard
+func LastAcceptedPriceStoreKey(signalID string) []byte {
+	return append(LastAcceptedPriceStoreKeyPrefix, []byte(signalID)...)
+}
+
+// ValidatorAccuracyStoreKey creates a key for storing a validator accuracy
+func ValidatorAccuracyStoreKey(validator sdk.ValAddress) []byte {
+	return append(ValidatorAccuracyStoreKeyPrefix, address.MustLengthPrefix(validator.Bytes())...)
+}
+
+// CommitmentStoreKey creates a key for storing a signal prices commitment of a validator
+func CommitmentStoreKey(validator sdk.ValAddress) []byte {
+	return append(CommitmentStoreKeyPrefix, address.MustLengthPrefix(validator.Bytes())...)
+}
+
+// SignalVoteDelegationStoreKey creates a key for storing the voting power delegation of a delegator
+func SignalVoteDelegationStoreKey(delegator sdk.AccAddress) []byte {
+	return append(SignalVoteDelegationStoreKeyPrefix, address.MustLengthPrefix(delegator.Bytes())...)
+}
+
+// CuratorDelegatedPowerStoreKey creates a key for storing the total power delegated to a curator
+func CuratorDelegatedPowerStoreKey(curator sdk.AccAddress) []byte {
+	return append(CuratorDelegatedPowerStoreKeyPrefix, address.MustLengthPrefix(curator.Bytes())...)
+}
+
+// SignalVoteDelegationsByCuratorIndexKey creates a key prefix for indexing the voting power delegations to a curator
+func SignalVoteDelegationsByCuratorIndexKey(curator sdk.AccAddress) []byte {
+	return append(DelegationByCuratorIndexKeyPrefix, address.MustLengthPrefix(curator.Bytes())...)
+}
+
+// SignalVoteDelegationByCuratorIndexKey creates a key for indexing the voting power delegation of a delegator
+// to a curator
+func SignalVoteDelegationByCuratorIndexKey(curator sdk.AccAddress, delegator sdk.AccAddress) []byte {
+	return append(SignalVoteDelegationsByCuratorIndexKey(curator), delegator.Bytes()...)
+}
+
+// PriceHistoryBySignalIDStoreKey creates a key for storing the price history of a signal id
+func PriceHistoryBySignalIDStoreKey(signalID string) []byte {
+	return append(PriceHistoryStoreKeyPrefix, address.MustLengthPrefix([]byte(signalID))...)
+}
+
+// PriceHistoryStoreKey creates a key for storing a historical price of a signal id at the given timestamp
+func PriceHistoryStoreKey(signalID string, timestamp int64) []byte {
+	return append(PriceHistoryBySignalIDStoreKey(signalID), sdk.Uint64ToBigEndian(uint64(timestamp))...)
+}
+
+// PriceHistoryCountStoreKey creates a key for storing the number of prices in the price history of a signal id
+func PriceHistoryCountStoreKey(signalID string) []byte {
+	return append(PriceHistoryCountStoreKeyPrefix, address.MustLengthPrefix([]byte(signalID))...)
+}
+
 // SignalTotalPowerByPowerIndexKey creates a key for storing signal-total-powers by power index
 func SignalTotalPowerByPowerIndexKey(signalID string, power int64) []byte {
 	powerBytes := make([]byte, 8)
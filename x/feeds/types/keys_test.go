@@ -52,6 +52,61 @@ func TestSignalTotalPowerStoreKey(t *testing.T) {
 	require.Equal(t, expectEmpty, SignalTotalPowerStoreKey(""))
 }
 
+func TestValidatorAccuracyStoreKey(t *testing.T) {
+	// Prefix: 0x15
+	expect, _ := hex.DecodeString("150a31303030303030303031")
+	require.Equal(t, expect, ValidatorAccuracyStoreKey(sdk.ValAddress("1000000001")))
+}
+
+func TestSignalInfoStoreKey(t *testing.T) {
+	// Prefix: 0x16
+	expect, _ := hex.DecodeString("1642414e44")
+	require.Equal(t, expect, SignalInfoStoreKey("BAND"))
+}
+
+func TestPriceGuardStoreKey(t *testing.T) {
+	// Prefix: 0x17
+	expect, _ := hex.DecodeString("1742414e44")
+	require.Equal(t, expect, PriceGuardStoreKey("BAND"))
+}
+
+func TestLastAcceptedPriceStoreKey(t *testing.T) {
+	// Prefix: 0x18
+	expect, _ := hex.DecodeString("1842414e44")
+	require.Equal(t, expect, LastAcceptedPriceStoreKey("BAND"))
+}
+
+func TestCommitmentStoreKey(t *testing.T) {
+	// Prefix: 0x19
+	expect, _ := hex.DecodeString("190a31303030303030303031")
+	require.Equal(t, expect, CommitmentStoreKey(sdk.ValAddress("1000000001")))
+}
+
+func TestSignalVoteDelegationStoreKey(t *testing.T) {
+	// Prefix: 0x1a
+	expect, _ := hex.DecodeString("1a0a31303030303030303031")
+	require.Equal(t, expect, SignalVoteDelegationStoreKey(sdk.AccAddress("1000000001")))
+}
+
+func TestCuratorDelegatedPowerStoreKey(t *testing.T) {
+	// Prefix: 0x1b
+	expect, _ := hex.DecodeString("1b0a31303030303030303031")
+	require.Equal(t, expect, CuratorDelegatedPowerStoreKey(sdk.AccAddress("1000000001")))
+}
+
+func TestPriceHistoryStoreKey(t *testing.T) {
+	// Prefix: 0x14
+	expect, _ := hex.DecodeString("140442414e44")
+	require.Equal(t, expect, PriceHistoryBySignalIDStoreKey("BAND"))
+
+	expect, _ = hex.DecodeString("140442414e440000000000000064")
+	require.Equal(t, expect, PriceHistoryStoreKey("BAND", 100))
+
+	// Prefix: 0x1c
+	expect, _ = hex.DecodeString("1c0442414e44")
+	require.Equal(t, expect, PriceHistoryCountStoreKey("BAND"))
+}
+
 func TestSignalTotalPowerByPowerIndexKey(t *testing.T) {
 	// Prefix: 0x80
 	// Test with signal ID "BAND" and power 100
@@ -69,3 +124,16 @@ func TestSignalTotalPowerByPowerIndexKey(t *testing.T) {
 	expectLargePower, _ := hex.DecodeString("807fffffffffffffff00")
 	require.Equal(t, expectLargePower, SignalTotalPowerByPowerIndexKey("", largePower))
 }
+
+func TestSignalVoteDelegationByCuratorIndexKey(t *testing.T) {
+	// Prefix: 0x81
+	expect, _ := hex.DecodeString("810a31303030303030303031")
+	require.Equal(t, expect, SignalVoteDelegationsByCuratorIndexKey(sdk.AccAddress("1000000001")))
+
+	expect, _ = hex.DecodeString("810a3130303030303030303131303030303030303032")
+	require.Equal(
+		t,
+		expect,
+		SignalVoteDelegationByCuratorIndexKey(sdk.AccAddress("1000000001"), sdk.AccAddress("1000000002")),
+	)
+}
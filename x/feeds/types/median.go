@@ -82,6 +82,55 @@ func CalculatePricesPowers(
 	return totalPower, availablePower, unavailablePower, unsupportedPower
 }
 
+// CalculatePriceConfidence calculates the confidence data of an aggregated price from ValidatorPriceInfo
+// entries with available prices. It returns the power-weighted interquartile range of the prices, the
+// power of the entries relative to the total bonded power (in basis point), and the number of entries.
+func CalculatePriceConfidence(
+	validatorPriceInfos []ValidatorPriceInfo,
+	totalBondedPower sdkmath.Int,
+) (interquartileRange uint64, participationBasisPoint uint64, reporterCount uint64) {
+	var validPrices []ValidatorPriceInfo
+	availablePower := sdkmath.NewInt(0)
+	for _, priceInfo := range validatorPriceInfos {
+		if priceInfo.SignalPriceStatus == SIGNAL_PRICE_STATUS_AVAILABLE {
+			validPrices = append(validPrices, priceInfo)
+			availablePower = availablePower.Add(priceInfo.Power)
+		}
+	}
+
+	if len(validPrices) == 0 || !availablePower.IsPositive() {
+		return 0, 0, uint64(len(validPrices))
+	}
+
+	// sort by price (ascending) and find the first prices at which the cumulative power
+	// reaches a quarter and three quarters of the available power.
+	slices.SortStableFunc(validPrices, func(a, b ValidatorPriceInfo) int {
+		return cmp.Compare(a.Price, b.Price)
+	})
+
+	var lowerQuartile, upperQuartile uint64
+	foundLower := false
+	cumulativePower := sdkmath.NewInt(0)
+	for _, priceInfo := range validPrices {
+		cumulativePower = cumulativePower.Add(priceInfo.Power)
+		if !foundLower && cumulativePower.MulRaw(4).GTE(availablePower) {
+			lowerQuartile = priceInfo.Price
+			foundLower = true
+		}
+		if cumulativePower.MulRaw(4).GTE(availablePower.MulRaw(3)) {
+			upperQuartile = priceInfo.Price
+			break
+		}
+	}
+
+	if totalBondedPower.IsPositive() {
+		participation := availablePower.MulRaw(10000).Quo(totalBondedPower)
+		participationBasisPoint = min(participation.Uint64(), 10000)
+	}
+
+	return upperQuartile - lowerQuartile, participationBasisPoint, uint64(len(validPrices))
+}
+
 // MedianValidatorPriceInfos calculates a time-weighted and power-weighted median price
 // from ValidatorPriceInfo entries, prioritizing recent timestamps and higher power values.
 //
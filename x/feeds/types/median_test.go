@@ -159,6 +159,63 @@ func TestMedianValidatorPriceInfo(t *testing.T) {
 	}
 }
 
+func TestCalculatePriceConfidence(t *testing.T) {
+	testCases := []struct {
+		name                       string
+		validatorPriceInfos        []types.ValidatorPriceInfo
+		totalBondedPower           sdkmath.Int
+		expInterquartileRange      uint64
+		expParticipationBasisPoint uint64
+		expReporterCount           uint64
+	}{
+		{
+			name: "tight prices",
+			validatorPriceInfos: []types.ValidatorPriceInfo{
+				{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Power: sdkmath.NewInt(100), Price: 1000},
+				{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Power: sdkmath.NewInt(100), Price: 1000},
+				{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Power: sdkmath.NewInt(100), Price: 1001},
+				{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Power: sdkmath.NewInt(100), Price: 1001},
+			},
+			totalBondedPower:           sdkmath.NewInt(400),
+			expInterquartileRange:      1,
+			expParticipationBasisPoint: 10000,
+			expReporterCount:           4,
+		},
+		{
+			name: "spread prices with unavailable ones",
+			validatorPriceInfos: []types.ValidatorPriceInfo{
+				{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Power: sdkmath.NewInt(100), Price: 1100},
+				{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Power: sdkmath.NewInt(200), Price: 1000},
+				{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Power: sdkmath.NewInt(100), Price: 900},
+				{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_UNAVAILABLE, Power: sdkmath.NewInt(100), Price: 0},
+			},
+			totalBondedPower:           sdkmath.NewInt(1000),
+			expInterquartileRange:      100,
+			expParticipationBasisPoint: 4000,
+			expReporterCount:           3,
+		},
+		{
+			name: "no available price",
+			validatorPriceInfos: []types.ValidatorPriceInfo{
+				{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_UNSUPPORTED, Power: sdkmath.NewInt(100), Price: 0},
+			},
+			totalBondedPower:           sdkmath.NewInt(1000),
+			expInterquartileRange:      0,
+			expParticipationBasisPoint: 0,
+			expReporterCount:           0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			iqr, participation, reporters := types.CalculatePriceConfidence(tc.validatorPriceInfos, tc.totalBondedPower)
+			require.Equal(t, tc.expInterquartileRange, iqr)
+			require.Equal(t, tc.expParticipationBasisPoint, participation)
+			require.Equal(t, tc.expReporterCount, reporters)
+		})
+	}
+}
+
 func TestMedianWeightedPrice(t *testing.T) {
 	testCases := []struct {
 		name           string
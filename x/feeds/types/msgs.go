@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,14 +11,24 @@ import (
 
 var (
 	_ sdk.Msg = (*MsgSubmitSignalPrices)(nil)
+	_ sdk.Msg = (*MsgCommitSignalPrices)(nil)
+	_ sdk.Msg = (*MsgRevealSignalPrices)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgUpdateReferenceSourceConfig)(nil)
+	_ sdk.Msg = (*MsgUpdateSignalInfos)(nil)
+	_ sdk.Msg = (*MsgUpdatePriceGuards)(nil)
 	_ sdk.Msg = (*MsgVote)(nil)
+	_ sdk.Msg = (*MsgDelegateSignalVote)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgSubmitSignalPrices)(nil)
+	_ sdk.HasValidateBasic = (*MsgCommitSignalPrices)(nil)
+	_ sdk.HasValidateBasic = (*MsgRevealSignalPrices)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateReferenceSourceConfig)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateSignalInfos)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdatePriceGuards)(nil)
 	_ sdk.HasValidateBasic = (*MsgVote)(nil)
+	_ sdk.HasValidateBasic = (*MsgDelegateSignalVote)(nil)
 )
 
 // ====================================
@@ -42,10 +54,16 @@ func (m *MsgSubmitSignalPrices) ValidateBasic() error {
 		return errorsmod.Wrap(err, "invalid validator address")
 	}
 
+	return ValidateSignalPrices(m.SignalPrices)
+}
+
+// ValidateSignalPrices validates the status and price of each signal price and checks that
+// there is no duplicate signal ID.
+func ValidateSignalPrices(signalPrices []SignalPrice) error {
 	// Map to track signal IDs for duplicate check
 	signalIDSet := make(map[string]struct{})
 
-	for _, signalPrice := range m.SignalPrices {
+	for _, signalPrice := range signalPrices {
 		// Validate SignalPrice Status
 		if _, ok := SignalPriceStatus_name[int32(signalPrice.Status)]; !ok {
 			return sdkerrors.ErrInvalidRequest.Wrapf(
@@ -78,6 +96,68 @@ func (m *MsgSubmitSignalPrices) ValidateBasic() error {
 	return nil
 }
 
+// ====================================
+// MsgCommitSignalPrices
+// ====================================
+
+// NewMsgCommitSignalPrices creates a new MsgCommitSignalPrices instance.
+func NewMsgCommitSignalPrices(
+	validator string,
+	hash []byte,
+) *MsgCommitSignalPrices {
+	return &MsgCommitSignalPrices{
+		Validator: validator,
+		Hash:      hash,
+	}
+}
+
+// ValidateBasic does a check on the provided data.
+func (m *MsgCommitSignalPrices) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(m.Validator); err != nil {
+		return errorsmod.Wrap(err, "invalid validator address")
+	}
+
+	if len(m.Hash) != sha256.Size {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"hash length must be %d but got %d", sha256.Size, len(m.Hash),
+		)
+	}
+
+	return nil
+}
+
+// ====================================
+// MsgRevealSignalPrices
+// ====================================
+
+// NewMsgRevealSignalPrices creates a new MsgRevealSignalPrices instance.
+func NewMsgRevealSignalPrices(
+	validator string,
+	salt []byte,
+	signalPrices []SignalPrice,
+) *MsgRevealSignalPrices {
+	return &MsgRevealSignalPrices{
+		Validator:    validator,
+		Salt:         salt,
+		SignalPrices: signalPrices,
+	}
+}
+
+// ValidateBasic does a check on the provided data.
+func (m *MsgRevealSignalPrices) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(m.Validator); err != nil {
+		return errorsmod.Wrap(err, "invalid validator address")
+	}
+
+	if len(m.Salt) == 0 || len(m.Salt) > MaxCommitSaltLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"salt length must be between 1 and %d but got %d", MaxCommitSaltLength, len(m.Salt),
+		)
+	}
+
+	return ValidateSignalPrices(m.SignalPrices)
+}
+
 // ====================================
 // MsgUpdateParams
 // ====================================
@@ -134,6 +214,62 @@ func (m *MsgUpdateReferenceSourceConfig) ValidateBasic() error {
 	return nil
 }
 
+// ====================================
+// MsgUpdateSignalInfos
+// ====================================
+
+// NewMsgUpdateSignalInfos creates a new MsgUpdateSignalInfos instance.
+func NewMsgUpdateSignalInfos(
+	admin string,
+	signalInfos []SignalInfo,
+) *MsgUpdateSignalInfos {
+	return &MsgUpdateSignalInfos{
+		Admin:       admin,
+		SignalInfos: signalInfos,
+	}
+}
+
+// ValidateBasic does a check on the provided data.
+func (m *MsgUpdateSignalInfos) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return errorsmod.Wrap(err, "invalid admin address")
+	}
+
+	if len(m.SignalInfos) == 0 {
+		return ErrInvalidSignalInfo.Wrap("signal infos cannot be empty")
+	}
+
+	return ValidateSignalInfos(m.SignalInfos)
+}
+
+// ====================================
+// MsgUpdatePriceGuards
+// ====================================
+
+// NewMsgUpdatePriceGuards creates a new MsgUpdatePriceGuards instance.
+func NewMsgUpdatePriceGuards(
+	admin string,
+	priceGuards []PriceGuard,
+) *MsgUpdatePriceGuards {
+	return &MsgUpdatePriceGuards{
+		Admin:       admin,
+		PriceGuards: priceGuards,
+	}
+}
+
+// ValidateBasic does a check on the provided data.
+func (m *MsgUpdatePriceGuards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return errorsmod.Wrap(err, "invalid admin address")
+	}
+
+	if len(m.PriceGuards) == 0 {
+		return ErrInvalidPriceGuard.Wrap("price guards cannot be empty")
+	}
+
+	return ValidatePriceGuards(m.PriceGuards)
+}
+
 // ====================================
 // MsgVote
 // ====================================
@@ -176,3 +312,41 @@ func (m *MsgVote) ValidateBasic() error {
 
 	return nil
 }
+
+// ====================================
+// MsgDelegateSignalVote
+// ====================================
+
+// NewMsgDelegateSignalVote creates a new MsgDelegateSignalVote instance.
+func NewMsgDelegateSignalVote(
+	delegator string,
+	curator string,
+	power int64,
+) *MsgDelegateSignalVote {
+	return &MsgDelegateSignalVote{
+		Delegator: delegator,
+		Curator:   curator,
+		Power:     power,
+	}
+}
+
+// ValidateBasic does a check on the provided data.
+func (m *MsgDelegateSignalVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Delegator); err != nil {
+		return errorsmod.Wrap(err, "invalid delegator address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Curator); err != nil {
+		return errorsmod.Wrap(err, "invalid curator address")
+	}
+
+	if m.Delegator == m.Curator {
+		return ErrInvalidDelegation.Wrap("delegator cannot delegate to itself")
+	}
+
+	if m.Power < 0 {
+		return ErrInvalidDelegation.Wrap("power cannot be negative")
+	}
+
+	return nil
+}
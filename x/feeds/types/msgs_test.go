@@ -14,6 +14,8 @@ var (
 	ValidAdmin = sdk.AccAddress("1000000001").String()
 	ValidVoter = sdk.AccAddress("1000000002").String()
 
+	ValidCurator = sdk.AccAddress("1000000003").String()
+
 	ValidValidator = sdk.ValAddress("2000000001").String()
 
 	ValidSignals = []Signal{
@@ -32,6 +34,14 @@ var (
 			Price:    100000 * 10e9,
 		},
 	}
+	ValidSignalInfos = []SignalInfo{
+		NewSignalInfo("CS:BTC-USD", 9, "USD", ASSET_CLASS_CRYPTO, "Bitcoin price in USD", "", false),
+	}
+	ValidCommitHash  = make([]byte, 32)
+	ValidSalt        = []byte("salt")
+	ValidPriceGuards = []PriceGuard{
+		NewPriceGuard("CS:BTC-USD", 2000, 1000, 1000000, 300),
+	}
 
 	InvalidValidator = "invalidValidator"
 	InvalidAuthority = "invalidAuthority"
@@ -62,6 +72,66 @@ func TestMsgSubmitSignalPrices_ValidateBasic(t *testing.T) {
 	require.Error(t, err)
 }
 
+// ====================================
+// MsgCommitSignalPrices
+// ====================================
+
+func TestNewMsgCommitSignalPrices(t *testing.T) {
+	msg := NewMsgCommitSignalPrices(ValidValidator, ValidCommitHash)
+	require.Equal(t, ValidValidator, msg.Validator)
+	require.Equal(t, ValidCommitHash, msg.Hash)
+}
+
+func TestMsgCommitSignalPrices_ValidateBasic(t *testing.T) {
+	// Valid validator
+	msg := NewMsgCommitSignalPrices(ValidValidator, ValidCommitHash)
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid validator
+	msg = NewMsgCommitSignalPrices(InvalidValidator, ValidCommitHash)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Invalid hash
+	msg = NewMsgCommitSignalPrices(ValidValidator, []byte("hash"))
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+}
+
+// ====================================
+// MsgRevealSignalPrices
+// ====================================
+
+func TestNewMsgRevealSignalPrices(t *testing.T) {
+	msg := NewMsgRevealSignalPrices(ValidValidator, ValidSalt, ValidSignalPrices)
+	require.Equal(t, ValidValidator, msg.Validator)
+	require.Equal(t, ValidSalt, msg.Salt)
+	require.Equal(t, ValidSignalPrices, msg.SignalPrices)
+}
+
+func TestMsgRevealSignalPrices_ValidateBasic(t *testing.T) {
+	// Valid validator
+	msg := NewMsgRevealSignalPrices(ValidValidator, ValidSalt, ValidSignalPrices)
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid validator
+	msg = NewMsgRevealSignalPrices(InvalidValidator, ValidSalt, ValidSignalPrices)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Empty salt
+	msg = NewMsgRevealSignalPrices(ValidValidator, []byte{}, ValidSignalPrices)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Too long salt
+	msg = NewMsgRevealSignalPrices(ValidValidator, make([]byte, MaxCommitSaltLength+1), ValidSignalPrices)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+}
+
 // ====================================
 // MsgUpdateParams
 // ====================================
@@ -106,6 +176,70 @@ func TestMsgUpdateReferenceSourceConfig_ValidateBasic(t *testing.T) {
 	require.Error(t, err)
 }
 
+// ====================================
+// MsgUpdateSignalInfos
+// ====================================
+
+func TestNewMsgUpdateSignalInfos(t *testing.T) {
+	msg := NewMsgUpdateSignalInfos(ValidAdmin, ValidSignalInfos)
+	require.Equal(t, ValidAdmin, msg.Admin)
+	require.Equal(t, ValidSignalInfos, msg.SignalInfos)
+}
+
+func TestMsgUpdateSignalInfos_ValidateBasic(t *testing.T) {
+	// Valid admin
+	msg := NewMsgUpdateSignalInfos(ValidAdmin, ValidSignalInfos)
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid admin
+	msg = NewMsgUpdateSignalInfos(InvalidAdmin, ValidSignalInfos)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Empty signal infos
+	msg = NewMsgUpdateSignalInfos(ValidAdmin, []SignalInfo{})
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrInvalidSignalInfo)
+
+	// Duplicate signal infos
+	msg = NewMsgUpdateSignalInfos(ValidAdmin, []SignalInfo{ValidSignalInfos[0], ValidSignalInfos[0]})
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrDuplicateSignalID)
+}
+
+// ====================================
+// MsgUpdatePriceGuards
+// ====================================
+
+func TestNewMsgUpdatePriceGuards(t *testing.T) {
+	msg := NewMsgUpdatePriceGuards(ValidAdmin, ValidPriceGuards)
+	require.Equal(t, ValidAdmin, msg.Admin)
+	require.Equal(t, ValidPriceGuards, msg.PriceGuards)
+}
+
+func TestMsgUpdatePriceGuards_ValidateBasic(t *testing.T) {
+	// Valid admin
+	msg := NewMsgUpdatePriceGuards(ValidAdmin, ValidPriceGuards)
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid admin
+	msg = NewMsgUpdatePriceGuards(InvalidAdmin, ValidPriceGuards)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Empty price guards
+	msg = NewMsgUpdatePriceGuards(ValidAdmin, []PriceGuard{})
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrInvalidPriceGuard)
+
+	// Duplicate price guards
+	msg = NewMsgUpdatePriceGuards(ValidAdmin, []PriceGuard{ValidPriceGuards[0], ValidPriceGuards[0]})
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrDuplicateSignalID)
+}
+
 // ====================================
 // MsgVote
 // ====================================
@@ -127,3 +261,46 @@ func TestMsgVote_ValidateBasic(t *testing.T) {
 	err = msg.ValidateBasic()
 	require.Error(t, err)
 }
+
+// ====================================
+// MsgDelegateSignalVote
+// ====================================
+
+func TestNewMsgDelegateSignalVote(t *testing.T) {
+	msg := NewMsgDelegateSignalVote(ValidVoter, ValidCurator, 100)
+	require.Equal(t, ValidVoter, msg.Delegator)
+	require.Equal(t, ValidCurator, msg.Curator)
+	require.Equal(t, int64(100), msg.Power)
+}
+
+func TestMsgDelegateSignalVote_ValidateBasic(t *testing.T) {
+	// Valid delegation
+	msg := NewMsgDelegateSignalVote(ValidVoter, ValidCurator, 100)
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Zero power removes the delegation
+	msg = NewMsgDelegateSignalVote(ValidVoter, ValidCurator, 0)
+	err = msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid delegator
+	msg = NewMsgDelegateSignalVote(InvalidVoter, ValidCurator, 100)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Invalid curator
+	msg = NewMsgDelegateSignalVote(ValidVoter, InvalidVoter, 100)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Self delegation
+	msg = NewMsgDelegateSignalVote(ValidVoter, ValidVoter, 100)
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrInvalidDelegation)
+
+	// Negative power
+	msg = NewMsgDelegateSignalVote(ValidVoter, ValidCurator, -1)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// GetQueryPricesFeePayer returns the account that pays the price query fee of the given channel. Anyone
+// can fund it to pay for the price queries of the counterparty chain of the channel.
+func GetQueryPricesFeePayer(channelID string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(QueryPricesFeePayerKey), []byte(channelID))
+}
+
+// NewQueryPricesPacketData creates a new QueryPricesPacketData instance.
+func NewQueryPricesPacketData(signalIDs []string) QueryPricesPacketData {
+	return QueryPricesPacketData{
+		SignalIDs: signalIDs,
+	}
+}
+
+// ValidateBasic is used for validating the price query packet data.
+func (p QueryPricesPacketData) ValidateBasic(maxSignalIDs uint64) error {
+	if len(p.SignalIDs) == 0 {
+		return ErrInvalidPacketData.Wrap("signal ids cannot be empty")
+	}
+
+	if uint64(len(p.SignalIDs)) > maxSignalIDs {
+		return ErrInvalidPacketData.Wrapf(
+			"maximum number of signal ids is %d but received %d",
+			maxSignalIDs, len(p.SignalIDs),
+		)
+	}
+
+	signalIDSet := make(map[string]struct{})
+	for _, signalID := range p.SignalIDs {
+		if signalID == "" {
+			return ErrInvalidPacketData.Wrap("signal id cannot be empty")
+		}
+
+		if _, ok := signalIDSet[signalID]; ok {
+			return ErrDuplicateSignalID.Wrapf("duplicate signal ID found: %s", signalID)
+		}
+		signalIDSet[signalID] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetBytes is a helper for serialising the price query packet data.
+func (p QueryPricesPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&p))
+}
+
+// NewQueryPricesPacketAcknowledgement creates a new QueryPricesPacketAcknowledgement instance.
+func NewQueryPricesPacketAcknowledgement(prices []Price) QueryPricesPacketAcknowledgement {
+	return QueryPricesPacketAcknowledgement{
+		Prices: prices,
+	}
+}
+
+// GetBytes is a helper for serialising the price query packet acknowledgement.
+func (a QueryPricesPacketAcknowledgement) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&a))
+}
@@ -0,0 +1,511 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: band/feeds/v1beta1/packet.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryPricesPacketData is the IBC packet data to query the current prices of signal ids.
+type QueryPricesPacketData struct {
+	// signal_ids is the list of signal ids to query the prices for.
+	SignalIDs []string `protobuf:"bytes,1,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
+}
+
+func (m *QueryPricesPacketData) Reset()         { *m = QueryPricesPacketData{} }
+func (m *QueryPricesPacketData) String() string { return proto.CompactTextString(m) }
+func (*QueryPricesPacketData) ProtoMessage()    {}
+func (*QueryPricesPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_395f6e5a66bce721, []int{0}
+}
+func (m *QueryPricesPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPricesPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPricesPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPricesPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPricesPacketData.Merge(m, src)
+}
+func (m *QueryPricesPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPricesPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPricesPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPricesPacketData proto.InternalMessageInfo
+
+func (m *QueryPricesPacketData) GetSignalIDs() []string {
+	if m != nil {
+		return m.SignalIDs
+	}
+	return nil
+}
+
+// QueryPricesPacketAcknowledgement is the IBC acknowledgement of a price query packet.
+type QueryPricesPacketAcknowledgement struct {
+	// prices is the list of current prices of the queried signal ids.
+	Prices []Price `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
+}
+
+func (m *QueryPricesPacketAcknowledgement) Reset()         { *m = QueryPricesPacketAcknowledgement{} }
+func (m *QueryPricesPacketAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*QueryPricesPacketAcknowledgement) ProtoMessage()    {}
+func (*QueryPricesPacketAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_395f6e5a66bce721, []int{1}
+}
+func (m *QueryPricesPacketAcknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPricesPacketAcknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPricesPacketAcknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPricesPacketAcknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPricesPacketAcknowledgement.Merge(m, src)
+}
+func (m *QueryPricesPacketAcknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPricesPacketAcknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPricesPacketAcknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPricesPacketAcknowledgement proto.InternalMessageInfo
+
+func (m *QueryPricesPacketAcknowledgement) GetPrices() []Price {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryPricesPacketData)(nil), "band.feeds.v1beta1.QueryPricesPacketData")
+	proto.RegisterType((*QueryPricesPacketAcknowledgement)(nil), "band.feeds.v1beta1.QueryPricesPacketAcknowledgement")
+}
+
+func init() { proto.RegisterFile("band/feeds/v1beta1/packet.proto", fileDescriptor_395f6e5a66bce721) }
+
+var fileDescriptor_395f6e5a66bce721 = []byte{
+	// 265 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x8f, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0x13, 0x81, 0x2a, 0xc5, 0x88, 0x25, 0x02, 0x09, 0x3a, 0x38, 0x55, 0x27, 0x06, 0x64,
+	0xab, 0x74, 0x60, 0x26, 0x2a, 0x12, 0xdd, 0x4a, 0xd9, 0x60, 0x40, 0x8e, 0x73, 0xa4, 0x56, 0x53,
+	0x3b, 0x8a, 0xdd, 0x42, 0xdf, 0x82, 0xc7, 0xea, 0xd8, 0x91, 0xa9, 0x42, 0xc9, 0x8b, 0xa0, 0x5c,
+	0xb2, 0x95, 0xed, 0x74, 0xff, 0x77, 0xdf, 0xe9, 0x27, 0x51, 0x22, 0x74, 0xca, 0x3f, 0x00, 0x52,
+	0xcb, 0x37, 0xa3, 0x04, 0x9c, 0x18, 0xf1, 0x42, 0xc8, 0x25, 0x38, 0x56, 0x94, 0xc6, 0x99, 0x30,
+	0x6c, 0x00, 0x86, 0x00, 0xeb, 0x80, 0xfe, 0x45, 0x66, 0x32, 0x83, 0x31, 0x6f, 0xa6, 0x96, 0xec,
+	0xd3, 0x7f, 0x54, 0xed, 0x1d, 0xe6, 0xc3, 0x47, 0x72, 0xf9, 0xbc, 0x86, 0x72, 0x3b, 0x2b, 0x95,
+	0x04, 0x3b, 0xc3, 0x27, 0x13, 0xe1, 0x44, 0x78, 0x4b, 0x88, 0x55, 0x99, 0x16, 0xf9, 0xbb, 0x4a,
+	0xed, 0x95, 0x3f, 0x38, 0xb9, 0x09, 0xe2, 0xf3, 0xea, 0x10, 0x05, 0x2f, 0xb8, 0x9d, 0x4e, 0xec,
+	0x3c, 0x68, 0x81, 0x69, 0x6a, 0x87, 0x6f, 0x64, 0x70, 0xa4, 0x79, 0x90, 0x4b, 0x6d, 0x3e, 0x73,
+	0x48, 0x33, 0x58, 0x81, 0x76, 0xe1, 0x3d, 0xe9, 0x15, 0x18, 0xa3, 0xed, 0xec, 0xee, 0x9a, 0x1d,
+	0xb7, 0x60, 0x28, 0x88, 0x4f, 0x77, 0x87, 0xc8, 0x9b, 0x77, 0x78, 0xfc, 0xb4, 0xab, 0xa8, 0xbf,
+	0xaf, 0xa8, 0xff, 0x5b, 0x51, 0xff, 0xbb, 0xa6, 0xde, 0xbe, 0xa6, 0xde, 0x4f, 0x4d, 0xbd, 0x57,
+	0x96, 0x29, 0xb7, 0x58, 0x27, 0x4c, 0x9a, 0x15, 0x6f, 0x64, 0xd8, 0x49, 0x9a, 0x9c, 0xcb, 0x85,
+	0x50, 0x9a, 0x6f, 0xc6, 0xfc, 0xab, 0xeb, 0xee, 0xb6, 0x05, 0xd8, 0xa4, 0x87, 0xc0, 0xf8, 0x6f,
+	0x00, 0xbb, 0xa4, 0xb5, 0x2f, 0x61, 0x01, 0x00, 0x00,
+}
+
+func (m *QueryPricesPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPricesPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPricesPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignalIDs) > 0 {
+		for iNdEx := len(m.SignalIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignalIDs[iNdEx])
+			copy(dAtA[i:], m.SignalIDs[iNdEx])
+			i = encodeVarintPacket(dAtA, i, uint64(len(m.SignalIDs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPricesPacketAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPricesPacketAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPricesPacketAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPricesPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignalIDs) > 0 {
+		for _, s := range m.SignalIDs {
+			l = len(s)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPricesPacketAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPricesPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalIDs = append(m.SignalIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPricesPacketAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesPacketAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesPacketAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacket = fmt.Errorf("proto: unexpected end of group")
+)
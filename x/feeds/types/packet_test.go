@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func TestQueryPricesPacketDataValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		data   types.QueryPricesPacketData
+		expErr error
+	}{
+		{
+			name: "valid packet data",
+			data: types.NewQueryPricesPacketData([]string{"CS:BAND-USD", "CS:BTC-USD"}),
+		},
+		{
+			name:   "empty signal ids",
+			data:   types.NewQueryPricesPacketData([]string{}),
+			expErr: types.ErrInvalidPacketData,
+		},
+		{
+			name:   "too many signal ids",
+			data:   types.NewQueryPricesPacketData([]string{"CS:BAND-USD", "CS:BTC-USD", "CS:ETH-USD"}),
+			expErr: types.ErrInvalidPacketData,
+		},
+		{
+			name:   "empty signal id",
+			data:   types.NewQueryPricesPacketData([]string{"CS:BAND-USD", ""}),
+			expErr: types.ErrInvalidPacketData,
+		},
+		{
+			name:   "duplicate signal ids",
+			data:   types.NewQueryPricesPacketData([]string{"CS:BAND-USD", "CS:BAND-USD"}),
+			expErr: types.ErrDuplicateSignalID,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.data.ValidateBasic(2)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestQueryPricesPacketGetBytes(t *testing.T) {
+	data := types.NewQueryPricesPacketData([]string{"CS:BAND-USD"})
+	require.Equal(t, `{"signal_ids":["CS:BAND-USD"]}`, string(data.GetBytes()))
+
+	ack := types.NewQueryPricesPacketAcknowledgement([]types.Price{
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, 1733000000),
+	})
+	require.Equal(
+		t,
+		`{"prices":[{"interquartile_range":"0","participation_basis_point":"0","price":"1000","reporter_count":"0","signal_id":"CS:BAND-USD","status":"PRICE_STATUS_AVAILABLE","timestamp":"1733000000"}]}`,
+		string(ack.GetBytes()),
+	)
+}
@@ -3,7 +3,11 @@ package types
 import (
 	"fmt"
 
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
 	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -21,6 +25,20 @@ var (
 	DefaultCurrentFeedsUpdateInterval = int64(86400)
 	DefaultPriceQuorum                = math.LegacyNewDecWithPrec(30, 2)
 	DefaultMaxSignalIDsPerSigning     = uint64(25)
+	// estimated from block time of 1 seconds, aims for 30 minutes of price history
+	DefaultPriceHistoryRetention      = uint64(1800)
+	DefaultOutlierThresholdBasisPoint = uint64(1000)
+	// estimated from block time of 1 seconds, aims for 1 hour window
+	DefaultAccuracyWindow            = int64(3600)
+	DefaultMaxOutlierRatioBasisPoint = uint64(2000)
+	DefaultOutlierPenalty            = OUTLIER_PENALTY_NONE
+	DefaultRegisteredSignalsOnly     = false
+	// no channel is allowed to query prices until it is added by governance
+	DefaultQueryPricesFee             = sdk.Coins(nil)
+	DefaultQueryPricesAllowedChannels = []string(nil)
+	// signal prices are submitted directly until commit-reveal is enabled by governance
+	DefaultCommitWindow = int64(0)
+	DefaultRevealWindow = int64(0)
 )
 
 // NewParams creates a new Params instance
@@ -38,6 +56,16 @@ func NewParams(
 	currentFeedsUpdateInterval int64,
 	priceQuorum string,
 	maxSignalIDsPerSigning uint64,
+	priceHistoryRetention uint64,
+	outlierThresholdBasisPoint uint64,
+	accuracyWindow int64,
+	maxOutlierRatioBasisPoint uint64,
+	outlierPenalty OutlierPenalty,
+	registeredSignalsOnly bool,
+	queryPricesFee sdk.Coins,
+	queryPricesAllowedChannels []string,
+	commitWindow int64,
+	revealWindow int64,
 ) Params {
 	return Params{
 		Admin:                         admin,
@@ -53,6 +81,16 @@ func NewParams(
 		CurrentFeedsUpdateInterval:    currentFeedsUpdateInterval,
 		PriceQuorum:                   priceQuorum,
 		MaxSignalIDsPerSigning:        maxSignalIDsPerSigning,
+		PriceHistoryRetention:         priceHistoryRetention,
+		OutlierThresholdBasisPoint:    outlierThresholdBasisPoint,
+		AccuracyWindow:                accuracyWindow,
+		MaxOutlierRatioBasisPoint:     maxOutlierRatioBasisPoint,
+		OutlierPenalty:                outlierPenalty,
+		RegisteredSignalsOnly:         registeredSignalsOnly,
+		QueryPricesFee:                queryPricesFee,
+		QueryPricesAllowedChannels:    queryPricesAllowedChannels,
+		CommitWindow:                  commitWindow,
+		RevealWindow:                  revealWindow,
 	}
 }
 
@@ -72,6 +110,16 @@ func DefaultParams() Params {
 		DefaultCurrentFeedsUpdateInterval,
 		DefaultPriceQuorum.String(),
 		DefaultMaxSignalIDsPerSigning,
+		DefaultPriceHistoryRetention,
+		DefaultOutlierThresholdBasisPoint,
+		DefaultAccuracyWindow,
+		DefaultMaxOutlierRatioBasisPoint,
+		DefaultOutlierPenalty,
+		DefaultRegisteredSignalsOnly,
+		DefaultQueryPricesFee,
+		DefaultQueryPricesAllowedChannels,
+		DefaultCommitWindow,
+		DefaultRevealWindow,
 	)
 }
 
@@ -125,6 +173,51 @@ func (p Params) Validate() error {
 	if err := validateUint64("max signal IDs per signing", false, p.MaxSignalIDsPerSigning); err != nil {
 		return err
 	}
+	if err := validateUint64("price history retention", false, p.PriceHistoryRetention); err != nil {
+		return err
+	}
+	if err := validateUint64("outlier threshold basis point", false, p.OutlierThresholdBasisPoint); err != nil {
+		return err
+	}
+	if p.AccuracyWindow < 0 {
+		return fmt.Errorf("accuracy window cannot be negative: %d", p.AccuracyWindow)
+	}
+	if p.MaxOutlierRatioBasisPoint > 10000 {
+		return fmt.Errorf("max outlier ratio basis point too large: %d", p.MaxOutlierRatioBasisPoint)
+	}
+	if _, ok := OutlierPenalty_name[int32(p.OutlierPenalty)]; !ok {
+		return fmt.Errorf("invalid outlier penalty: %d", p.OutlierPenalty)
+	}
+	if !p.QueryPricesFee.IsValid() {
+		return fmt.Errorf("invalid query prices fee: %s", p.QueryPricesFee)
+	}
+
+	channelIDSet := make(map[string]struct{})
+	for _, channelID := range p.QueryPricesAllowedChannels {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return fmt.Errorf("invalid query prices allowed channel: %w", err)
+		}
+		if _, ok := channelIDSet[channelID]; ok {
+			return fmt.Errorf("duplicate query prices allowed channel: %s", channelID)
+		}
+		channelIDSet[channelID] = struct{}{}
+	}
+
+	if p.CommitWindow < 0 || p.RevealWindow < 0 {
+		return fmt.Errorf("commit and reveal windows cannot be negative: %d, %d", p.CommitWindow, p.RevealWindow)
+	}
+	if (p.CommitWindow == 0) != (p.RevealWindow == 0) {
+		return fmt.Errorf(
+			"commit and reveal windows must be both set or both zero: %d, %d",
+			p.CommitWindow,
+			p.RevealWindow,
+		)
+	}
 
 	return nil
 }
+
+// IsCommitRevealEnabled returns true if signal prices must be committed and revealed instead of submitted directly.
+func (p Params) IsCommitRevealEnabled() bool {
+	return p.CommitWindow > 0 && p.RevealWindow > 0
+}
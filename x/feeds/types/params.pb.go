@@ -6,6 +6,8 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -24,6 +26,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// OutlierPenalty is an enumerator that defines the penalty applied to validators submitting too many outliers.
+// Reducing the rewards of a validator is not supported, as the feeds module does not distribute rewards.
+type OutlierPenalty int32
+
+const (
+	// OUTLIER_PENALTY_NONE tracks the validator accuracy without any penalty.
+	OUTLIER_PENALTY_NONE OutlierPenalty = 0
+	// OUTLIER_PENALTY_DEACTIVATE deactivates the validator through its oracle validator status.
+	OUTLIER_PENALTY_DEACTIVATE OutlierPenalty = 1
+)
+
+var OutlierPenalty_name = map[int32]string{
+	0: "OUTLIER_PENALTY_NONE",
+	1: "OUTLIER_PENALTY_DEACTIVATE",
+}
+
+var OutlierPenalty_value = map[string]int32{
+	"OUTLIER_PENALTY_NONE":       0,
+	"OUTLIER_PENALTY_DEACTIVATE": 1,
+}
+
+func (x OutlierPenalty) String() string {
+	return proto.EnumName(OutlierPenalty_name, int32(x))
+}
+
+func (OutlierPenalty) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d6fe56a3e836005, []int{0}
+}
+
 // Params is the data structure that keeps the parameters of the feeds module.
 type Params struct {
 	// admin is the address of the admin that is allowed to update reference source config on modules.
@@ -55,6 +86,33 @@ type Params struct {
 	PriceQuorum string `protobuf:"bytes,12,opt,name=price_quorum,json=priceQuorum,proto3" json:"price_quorum,omitempty"`
 	// max_signal_ids_per_signing is the maximum number of signals allowed in a single tss signing request.
 	MaxSignalIDsPerSigning uint64 `protobuf:"varint,13,opt,name=max_signal_ids_per_signing,json=maxSignalIdsPerSigning,proto3" json:"max_signal_ids_per_signing,omitempty"`
+	// price_history_retention is the maximum number of calculated prices kept in the price history of each signal.
+	// If it is zero, the price history is disabled.
+	PriceHistoryRetention uint64 `protobuf:"varint,14,opt,name=price_history_retention,json=priceHistoryRetention,proto3" json:"price_history_retention,omitempty"`
+	// outlier_threshold_basis_point is the deviation (in basis point) from the aggregated price above which a
+	// validator price is counted as an outlier. If it is zero, the validator accuracy is not tracked.
+	OutlierThresholdBasisPoint uint64 `protobuf:"varint,15,opt,name=outlier_threshold_basis_point,json=outlierThresholdBasisPoint,proto3" json:"outlier_threshold_basis_point,omitempty"`
+	// accuracy_window is the number of blocks over which the validator accuracy is accumulated before the penalty is
+	// evaluated and the accuracy is reset. The windows are fixed and do not overlap, i.e. a new window starts every
+	// accuracy_window blocks. If it is zero, the validator accuracy is not tracked.
+	AccuracyWindow int64 `protobuf:"varint,16,opt,name=accuracy_window,json=accuracyWindow,proto3" json:"accuracy_window,omitempty"`
+	// max_outlier_ratio_basis_point is the maximum ratio (in basis point) of outliers to evaluated prices of a
+	// validator within an accuracy window before the validator is penalized.
+	MaxOutlierRatioBasisPoint uint64 `protobuf:"varint,17,opt,name=max_outlier_ratio_basis_point,json=maxOutlierRatioBasisPoint,proto3" json:"max_outlier_ratio_basis_point,omitempty"`
+	// outlier_penalty is the penalty applied to validators exceeding the maximum outlier ratio.
+	OutlierPenalty OutlierPenalty `protobuf:"varint,18,opt,name=outlier_penalty,json=outlierPenalty,proto3,enum=band.feeds.v1beta1.OutlierPenalty" json:"outlier_penalty,omitempty"`
+	// registered_signals_only is the flag to restrict votes to registered signal ids that are not deprecated.
+	RegisteredSignalsOnly bool `protobuf:"varint,19,opt,name=registered_signals_only,json=registeredSignalsOnly,proto3" json:"registered_signals_only,omitempty"`
+	// query_prices_fee is the fee charged to the fee payer account of the channel for each price query packet received
+	// over IBC.
+	QueryPricesFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,20,rep,name=query_prices_fee,json=queryPricesFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"query_prices_fee"`
+	// query_prices_allowed_channels is the list of IBC channels that are allowed to query prices.
+	QueryPricesAllowedChannels []string `protobuf:"bytes,21,rep,name=query_prices_allowed_channels,json=queryPricesAllowedChannels,proto3" json:"query_prices_allowed_channels,omitempty"`
+	// commit_window is the duration (in seconds) of the commit phase of a commit-reveal round. Signal prices must be
+	// committed and revealed instead of submitted directly if both commit and reveal windows are set.
+	CommitWindow int64 `protobuf:"varint,22,opt,name=commit_window,json=commitWindow,proto3" json:"commit_window,omitempty"`
+	// reveal_window is the duration (in seconds) of the reveal phase of a commit-reveal round.
+	RevealWindow int64 `protobuf:"varint,23,opt,name=reveal_window,json=revealWindow,proto3" json:"reveal_window,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -181,48 +239,141 @@ func (m *Params) GetMaxSignalIDsPerSigning() uint64 {
 	return 0
 }
 
+func (m *Params) GetPriceHistoryRetention() uint64 {
+	if m != nil {
+		return m.PriceHistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetOutlierThresholdBasisPoint() uint64 {
+	if m != nil {
+		return m.OutlierThresholdBasisPoint
+	}
+	return 0
+}
+
+func (m *Params) GetAccuracyWindow() int64 {
+	if m != nil {
+		return m.AccuracyWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxOutlierRatioBasisPoint() uint64 {
+	if m != nil {
+		return m.MaxOutlierRatioBasisPoint
+	}
+	return 0
+}
+
+func (m *Params) GetOutlierPenalty() OutlierPenalty {
+	if m != nil {
+		return m.OutlierPenalty
+	}
+	return OUTLIER_PENALTY_NONE
+}
+
+func (m *Params) GetRegisteredSignalsOnly() bool {
+	if m != nil {
+		return m.RegisteredSignalsOnly
+	}
+	return false
+}
+
+func (m *Params) GetQueryPricesFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.QueryPricesFee
+	}
+	return nil
+}
+
+func (m *Params) GetQueryPricesAllowedChannels() []string {
+	if m != nil {
+		return m.QueryPricesAllowedChannels
+	}
+	return nil
+}
+
+func (m *Params) GetCommitWindow() int64 {
+	if m != nil {
+		return m.CommitWindow
+	}
+	return 0
+}
+
+func (m *Params) GetRevealWindow() int64 {
+	if m != nil {
+		return m.RevealWindow
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("band.feeds.v1beta1.OutlierPenalty", OutlierPenalty_name, OutlierPenalty_value)
 	proto.RegisterType((*Params)(nil), "band.feeds.v1beta1.Params")
 }
 
 func init() { proto.RegisterFile("band/feeds/v1beta1/params.proto", fileDescriptor_2d6fe56a3e836005) }
 
 var fileDescriptor_2d6fe56a3e836005 = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x41, 0x6f, 0xd3, 0x3c,
-	0x1c, 0xc6, 0x97, 0x77, 0x5b, 0x5f, 0xe6, 0x76, 0x42, 0x44, 0xd5, 0x94, 0x55, 0x5a, 0x5a, 0xe0,
-	0x52, 0x21, 0x91, 0x30, 0xed, 0x04, 0xb7, 0x95, 0x0a, 0xd8, 0x01, 0xa9, 0xb4, 0x83, 0x03, 0x17,
-	0xcb, 0xb1, 0x4d, 0x6a, 0x91, 0xd8, 0xc1, 0x76, 0xdb, 0xec, 0x5b, 0xf0, 0x11, 0xb8, 0xf1, 0x05,
-	0xf8, 0x10, 0x1c, 0x27, 0x4e, 0x9c, 0x10, 0x6a, 0x2f, 0x7c, 0x0c, 0xe4, 0x7f, 0xd2, 0xb5, 0x48,
-	0x70, 0x8b, 0x9f, 0xe7, 0xf7, 0x58, 0xfe, 0x3f, 0xb1, 0x51, 0x37, 0x21, 0x92, 0xc5, 0xef, 0x38,
-	0x67, 0x26, 0x9e, 0x9f, 0x26, 0xdc, 0x92, 0xd3, 0xb8, 0x20, 0x9a, 0xe4, 0x26, 0x2a, 0xb4, 0xb2,
-	0xca, 0xf7, 0x1d, 0x10, 0x01, 0x10, 0xd5, 0x40, 0xa7, 0x9d, 0xaa, 0x54, 0x81, 0x1d, 0xbb, 0xaf,
-	0x8a, 0xec, 0x1c, 0x53, 0x65, 0x72, 0x65, 0x70, 0x65, 0x54, 0x8b, 0xca, 0xba, 0xf7, 0x79, 0x1f,
-	0x35, 0x46, 0xb0, 0xab, 0x1f, 0xa1, 0x7d, 0xc2, 0x72, 0x21, 0x03, 0xaf, 0xe7, 0xf5, 0x0f, 0x06,
-	0xc1, 0xb7, 0x2f, 0x0f, 0xdb, 0x35, 0x7b, 0xce, 0x98, 0xe6, 0xc6, 0x4c, 0xac, 0x16, 0x32, 0x1d,
-	0x57, 0x98, 0xff, 0x1c, 0xf5, 0x48, 0x96, 0xa9, 0x05, 0x49, 0x32, 0x8e, 0x93, 0x4c, 0xd1, 0xf7,
-	0xd8, 0x8a, 0x9c, 0x63, 0x26, 0x0c, 0xd5, 0xbc, 0x20, 0x92, 0x5e, 0x05, 0xff, 0xf5, 0xbc, 0xfe,
-	0xee, 0xf8, 0xe4, 0x86, 0x1b, 0x38, 0xec, 0x52, 0xe4, 0x7c, 0xb8, 0x81, 0xfc, 0xbb, 0xa8, 0x95,
-	0x6a, 0x42, 0x39, 0x2e, 0xb8, 0x16, 0x8a, 0x05, 0xbb, 0x10, 0x6a, 0x82, 0x36, 0x02, 0xc9, 0x21,
-	0xb9, 0x90, 0x58, 0x48, 0xcb, 0xf5, 0x9c, 0x64, 0xc1, 0x5e, 0x85, 0xe4, 0x42, 0x5e, 0xd4, 0x12,
-	0x20, 0xa4, 0xdc, 0x20, 0xfb, 0x35, 0x42, 0xca, 0x1b, 0xe4, 0x11, 0x6a, 0x17, 0x6a, 0xc1, 0x35,
-	0x36, 0x96, 0x17, 0xd8, 0x4e, 0x35, 0x37, 0x53, 0x95, 0xb1, 0xa0, 0x01, 0xa8, 0x0f, 0xde, 0xc4,
-	0xf2, 0xe2, 0x72, 0xed, 0xf8, 0x0f, 0xd0, 0x1d, 0xb7, 0x29, 0x9d, 0x69, 0xcd, 0xa5, 0xc5, 0x50,
-	0x76, 0xf0, 0x7f, 0xcf, 0xeb, 0xef, 0x8d, 0x6f, 0xe7, 0xa4, 0x7c, 0x5a, 0xe9, 0xcf, 0x9c, 0xec,
-	0xdf, 0x47, 0x87, 0x54, 0xa9, 0x8c, 0xa9, 0x85, 0x84, 0x22, 0x82, 0x5b, 0xb0, 0x6d, 0x6b, 0x2d,
-	0xba, 0xb1, 0xfd, 0xc7, 0xe8, 0xd8, 0x0d, 0xc2, 0xf8, 0x5c, 0x10, 0x2b, 0x94, 0xc4, 0x09, 0x31,
-	0xc2, 0xe0, 0x42, 0x09, 0x69, 0x83, 0x03, 0x08, 0x1c, 0xe5, 0x42, 0x0e, 0xd7, 0xfe, 0xc0, 0xd9,
-	0x23, 0xe7, 0x42, 0x94, 0x94, 0xff, 0x88, 0xa2, 0x3a, 0x4a, 0xca, 0xbf, 0x45, 0xcf, 0xd1, 0xc9,
-	0x1f, 0x23, 0xe0, 0x59, 0xc1, 0x88, 0xe5, 0x9b, 0xb2, 0x9a, 0x10, 0xef, 0xd0, 0xad, 0x79, 0x5e,
-	0x03, 0xb2, 0x5d, 0x6f, 0xa1, 0x05, 0xe5, 0xf8, 0xc3, 0x4c, 0xe9, 0x59, 0x1e, 0xb4, 0xdc, 0x25,
-	0x19, 0x37, 0x41, 0x7b, 0x05, 0x92, 0xff, 0x06, 0x75, 0xdc, 0x01, 0x8d, 0x48, 0x25, 0xc9, 0xb0,
-	0x60, 0xc6, 0xfd, 0x50, 0x58, 0x0a, 0x99, 0x06, 0x87, 0xae, 0xb5, 0x41, 0x67, 0xf9, 0xa3, 0x7b,
-	0xf4, 0x92, 0x94, 0x13, 0x80, 0x2e, 0x86, 0x66, 0xc4, 0xf5, 0xa4, 0x22, 0xe0, 0xf4, 0xb5, 0xce,
-	0xb6, 0xf4, 0x27, 0x7b, 0xbf, 0x3e, 0x75, 0xbd, 0xc1, 0x8b, 0xaf, 0xcb, 0xd0, 0xbb, 0x5e, 0x86,
-	0xde, 0xcf, 0x65, 0xe8, 0x7d, 0x5c, 0x85, 0x3b, 0xd7, 0xab, 0x70, 0xe7, 0xfb, 0x2a, 0xdc, 0x79,
-	0x1b, 0xa5, 0xc2, 0x4e, 0x67, 0x49, 0x44, 0x55, 0x1e, 0xbb, 0x37, 0x01, 0x37, 0x9b, 0xaa, 0x2c,
-	0xa6, 0x53, 0x22, 0x64, 0x3c, 0x3f, 0x8b, 0xcb, 0xfa, 0x1d, 0xd9, 0xab, 0x82, 0x9b, 0xa4, 0x01,
-	0xc0, 0xd9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc8, 0xef, 0xfe, 0xd0, 0x62, 0x03, 0x00, 0x00,
+	// 895 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x95, 0xcb, 0x6e, 0x1b, 0x37,
+	0x14, 0x86, 0x35, 0xb5, 0x93, 0xc6, 0xb4, 0x2d, 0x3b, 0x53, 0xd9, 0xa1, 0x05, 0x58, 0x52, 0x93,
+	0x45, 0x85, 0x00, 0x91, 0x72, 0x01, 0x0a, 0xb4, 0xab, 0x4a, 0xb6, 0xd2, 0x18, 0x4d, 0x6d, 0x75,
+	0xac, 0xa4, 0x68, 0x37, 0x04, 0x35, 0x73, 0x2a, 0x11, 0x99, 0x21, 0x27, 0x24, 0x47, 0x97, 0x37,
+	0xe8, 0xb2, 0xab, 0xae, 0x0b, 0x74, 0xd7, 0x75, 0x1f, 0x22, 0xcb, 0xa0, 0xab, 0xae, 0xd2, 0xc2,
+	0xde, 0xf4, 0x31, 0x0a, 0x92, 0x33, 0xba, 0xf4, 0xb2, 0xb2, 0xf9, 0xff, 0xdf, 0xe1, 0x19, 0xfe,
+	0x67, 0x86, 0x42, 0xf5, 0x21, 0xe5, 0x51, 0xfb, 0x3b, 0x80, 0x48, 0xb5, 0x27, 0x8f, 0x86, 0xa0,
+	0xe9, 0xa3, 0x76, 0x4a, 0x25, 0x4d, 0x54, 0x2b, 0x95, 0x42, 0x0b, 0xdf, 0x37, 0x40, 0xcb, 0x02,
+	0xad, 0x1c, 0xa8, 0x56, 0x46, 0x62, 0x24, 0xac, 0xdd, 0x36, 0xff, 0x39, 0xb2, 0x7a, 0x14, 0x0a,
+	0x95, 0x08, 0x45, 0x9c, 0xe1, 0x16, 0xb9, 0x55, 0x73, 0xab, 0xf6, 0x90, 0x2a, 0x58, 0xb4, 0x09,
+	0x05, 0xe3, 0xce, 0xbf, 0xfb, 0x23, 0x42, 0x37, 0xfb, 0xb6, 0xab, 0xdf, 0x42, 0x37, 0x68, 0x94,
+	0x30, 0x8e, 0xbd, 0x86, 0xd7, 0xdc, 0xea, 0xe2, 0xdf, 0x7e, 0x7d, 0x50, 0xc9, 0xf7, 0xea, 0x44,
+	0x91, 0x04, 0xa5, 0x2e, 0xb5, 0x64, 0x7c, 0x14, 0x38, 0xcc, 0xff, 0x1c, 0x35, 0x68, 0x1c, 0x8b,
+	0x29, 0x1d, 0xc6, 0x40, 0x86, 0xb1, 0x08, 0x5f, 0x11, 0xcd, 0x12, 0x20, 0x11, 0x53, 0xa1, 0x84,
+	0x94, 0xf2, 0x70, 0x8e, 0xdf, 0x6b, 0x78, 0xcd, 0x8d, 0xe0, 0x78, 0xc1, 0x75, 0x0d, 0x36, 0x60,
+	0x09, 0x9c, 0x2e, 0x21, 0xff, 0x43, 0xb4, 0x33, 0x92, 0x34, 0x04, 0x92, 0x82, 0x64, 0x22, 0xc2,
+	0x1b, 0xb6, 0x68, 0xdb, 0x6a, 0x7d, 0x2b, 0x19, 0x24, 0x61, 0x9c, 0x30, 0xae, 0x41, 0x4e, 0x68,
+	0x8c, 0x37, 0x1d, 0x92, 0x30, 0x7e, 0x96, 0x4b, 0x16, 0xa1, 0xb3, 0x25, 0x72, 0x23, 0x47, 0xe8,
+	0x6c, 0x81, 0x3c, 0x44, 0x95, 0x54, 0x4c, 0x41, 0x12, 0xa5, 0x21, 0x25, 0x7a, 0x2c, 0x41, 0x8d,
+	0x45, 0x1c, 0xe1, 0x9b, 0x16, 0xf5, 0xad, 0x77, 0xa9, 0x21, 0x1d, 0x14, 0x8e, 0x7f, 0x1f, 0xdd,
+	0x36, 0x9b, 0x86, 0x99, 0x94, 0xc0, 0x35, 0xb1, 0xc3, 0xc0, 0xef, 0x37, 0xbc, 0xe6, 0x66, 0xb0,
+	0x97, 0xd0, 0xd9, 0x89, 0xd3, 0x9f, 0x1a, 0xd9, 0xbf, 0x87, 0x76, 0x43, 0x21, 0xe2, 0x48, 0x4c,
+	0xb9, 0x0d, 0x02, 0xdf, 0xb2, 0xdb, 0xee, 0x14, 0xa2, 0x39, 0xb6, 0xff, 0x09, 0x3a, 0x32, 0x07,
+	0x89, 0x60, 0xc2, 0xa8, 0x66, 0x82, 0x93, 0x21, 0x55, 0x4c, 0x91, 0x54, 0x30, 0xae, 0xf1, 0x96,
+	0x2d, 0x38, 0x4c, 0x18, 0x3f, 0x2d, 0xfc, 0xae, 0xb1, 0xfb, 0xc6, 0xb5, 0xa5, 0x74, 0xf6, 0x3f,
+	0xa5, 0x28, 0x2f, 0xa5, 0xb3, 0xff, 0x2a, 0xed, 0xa0, 0xe3, 0xb5, 0x23, 0x90, 0x2c, 0x8d, 0xa8,
+	0x86, 0x65, 0x58, 0xdb, 0xb6, 0xbc, 0x1a, 0xae, 0x9c, 0xe7, 0x85, 0x45, 0x56, 0xe3, 0x4d, 0x25,
+	0x0b, 0x81, 0xbc, 0xce, 0x84, 0xcc, 0x12, 0xbc, 0x63, 0x5e, 0x92, 0x60, 0xdb, 0x6a, 0x5f, 0x59,
+	0xc9, 0x7f, 0x89, 0xaa, 0xe6, 0x01, 0x15, 0x1b, 0x71, 0x1a, 0x13, 0x16, 0x29, 0x33, 0x50, 0xbb,
+	0x64, 0x7c, 0x84, 0x77, 0x4d, 0x6a, 0xdd, 0xea, 0xd5, 0xbb, 0xfa, 0xe1, 0x97, 0x74, 0x76, 0x69,
+	0xa1, 0xb3, 0x53, 0xd5, 0x07, 0x79, 0xe9, 0x08, 0xfb, 0xf4, 0xb9, 0x1e, 0xad, 0xe8, 0xfe, 0xc7,
+	0xe8, 0x8e, 0x6b, 0x3d, 0x66, 0x4a, 0x0b, 0x39, 0x27, 0x12, 0x34, 0x70, 0x73, 0x44, 0x5c, 0xb6,
+	0xa3, 0x38, 0xb0, 0xf6, 0x33, 0xe7, 0x06, 0x85, 0x69, 0x4e, 0x2d, 0x32, 0x1d, 0x33, 0x90, 0xcb,
+	0x59, 0xaf, 0x85, 0xb6, 0x67, 0xab, 0xab, 0x39, 0xb4, 0x98, 0xfa, 0x4a, 0x70, 0x1f, 0xa1, 0x3d,
+	0x1a, 0x86, 0x99, 0xa4, 0xe1, 0x9c, 0x4c, 0x19, 0x8f, 0xc4, 0x14, 0xef, 0xdb, 0xa8, 0xca, 0x85,
+	0xfc, 0xb5, 0x55, 0xfd, 0xcf, 0xd0, 0xb1, 0x39, 0x7b, 0xd1, 0x4f, 0x9a, 0x09, 0xac, 0xf5, 0xba,
+	0x6d, 0x7b, 0x99, 0x09, 0x5e, 0x38, 0x26, 0x30, 0xc8, 0x4a, 0xab, 0x2f, 0xd0, 0x5e, 0x51, 0x9d,
+	0x02, 0xa7, 0xb1, 0x9e, 0x63, 0xbf, 0xe1, 0x35, 0xcb, 0x8f, 0xef, 0xb6, 0xfe, 0x7d, 0x11, 0xb4,
+	0xf2, 0x4d, 0xfa, 0x8e, 0x0c, 0xca, 0x62, 0x6d, 0x6d, 0x22, 0x93, 0x30, 0x62, 0x4a, 0x83, 0x84,
+	0x28, 0x9f, 0x88, 0x22, 0x82, 0xc7, 0x73, 0xfc, 0x41, 0xc3, 0x6b, 0xde, 0x0a, 0x0e, 0x96, 0xb6,
+	0x8b, 0x5c, 0x5d, 0xf0, 0x78, 0xee, 0x67, 0x68, 0xff, 0x75, 0x06, 0x72, 0x4e, 0x6c, 0xa2, 0xca,
+	0xbc, 0x2d, 0xb8, 0xd2, 0xd8, 0x68, 0x6e, 0x3f, 0x3e, 0x6a, 0xe5, 0x77, 0x81, 0xb9, 0x49, 0x16,
+	0x8f, 0x71, 0x22, 0x18, 0xef, 0x3e, 0x7c, 0xf3, 0xae, 0x5e, 0xfa, 0xe5, 0x8f, 0x7a, 0x73, 0xc4,
+	0xf4, 0x38, 0x1b, 0xb6, 0x42, 0x91, 0xe4, 0x97, 0x50, 0xfe, 0xe7, 0x81, 0x8a, 0x5e, 0xb5, 0xf5,
+	0x3c, 0x05, 0x65, 0x0b, 0x54, 0x50, 0xb6, 0x4d, 0xfa, 0xb6, 0xc7, 0x53, 0x00, 0x33, 0xa9, 0xb5,
+	0xb6, 0xf6, 0xbe, 0x80, 0x88, 0x84, 0x63, 0xca, 0x39, 0xc4, 0x0a, 0x1f, 0x34, 0x36, 0x9a, 0x5b,
+	0x41, 0x75, 0xa5, 0xac, 0xe3, 0x90, 0x93, 0x9c, 0x70, 0x5f, 0x5f, 0x92, 0x30, 0x5d, 0xcc, 0xe9,
+	0xb0, 0xf8, 0xfa, 0x8c, 0x98, 0x4f, 0xe9, 0x1e, 0xda, 0x95, 0x30, 0x01, 0x1a, 0x17, 0xd0, 0x1d,
+	0x07, 0x39, 0xd1, 0x41, 0x9f, 0x6e, 0xfe, 0xf5, 0x53, 0xdd, 0xbb, 0xdf, 0x47, 0xe5, 0xf5, 0x8c,
+	0x7d, 0x8c, 0x2a, 0x17, 0x2f, 0x06, 0xcf, 0xcf, 0x7a, 0x01, 0xe9, 0xf7, 0xce, 0x3b, 0xcf, 0x07,
+	0xdf, 0x90, 0xf3, 0x8b, 0xf3, 0xde, 0x7e, 0xc9, 0xaf, 0xa1, 0xea, 0x3f, 0x9d, 0xd3, 0x5e, 0xe7,
+	0x64, 0x70, 0xf6, 0xb2, 0x33, 0xe8, 0xed, 0x7b, 0xd5, 0xcd, 0xef, 0x7f, 0xae, 0x95, 0xba, 0xcf,
+	0xde, 0x5c, 0xd5, 0xbc, 0xb7, 0x57, 0x35, 0xef, 0xcf, 0xab, 0x9a, 0xf7, 0xc3, 0x75, 0xad, 0xf4,
+	0xf6, 0xba, 0x56, 0xfa, 0xfd, 0xba, 0x56, 0xfa, 0xb6, 0xb5, 0x12, 0x9c, 0x99, 0xb5, 0xbd, 0x9a,
+	0x43, 0x11, 0xb7, 0xc3, 0x31, 0x65, 0xbc, 0x3d, 0x79, 0xd2, 0x9e, 0xe5, 0x3f, 0x14, 0x36, 0xc4,
+	0xe1, 0x4d, 0x0b, 0x3c, 0xf9, 0x7b, 0x00, 0x75, 0x7e, 0x7e, 0xaa, 0x43, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -283,6 +434,46 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.MaxSignalIDsPerSigning != that1.MaxSignalIDsPerSigning {
 		return false
 	}
+	if this.PriceHistoryRetention != that1.PriceHistoryRetention {
+		return false
+	}
+	if this.OutlierThresholdBasisPoint != that1.OutlierThresholdBasisPoint {
+		return false
+	}
+	if this.AccuracyWindow != that1.AccuracyWindow {
+		return false
+	}
+	if this.MaxOutlierRatioBasisPoint != that1.MaxOutlierRatioBasisPoint {
+		return false
+	}
+	if this.OutlierPenalty != that1.OutlierPenalty {
+		return false
+	}
+	if this.RegisteredSignalsOnly != that1.RegisteredSignalsOnly {
+		return false
+	}
+	if len(this.QueryPricesFee) != len(that1.QueryPricesFee) {
+		return false
+	}
+	for i := range this.QueryPricesFee {
+		if !this.QueryPricesFee[i].Equal(&that1.QueryPricesFee[i]) {
+			return false
+		}
+	}
+	if len(this.QueryPricesAllowedChannels) != len(that1.QueryPricesAllowedChannels) {
+		return false
+	}
+	for i := range this.QueryPricesAllowedChannels {
+		if this.QueryPricesAllowedChannels[i] != that1.QueryPricesAllowedChannels[i] {
+			return false
+		}
+	}
+	if this.CommitWindow != that1.CommitWindow {
+		return false
+	}
+	if this.RevealWindow != that1.RevealWindow {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -305,6 +496,90 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RevealWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RevealWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.CommitWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CommitWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.QueryPricesAllowedChannels) > 0 {
+		for iNdEx := len(m.QueryPricesAllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QueryPricesAllowedChannels[iNdEx])
+			copy(dAtA[i:], m.QueryPricesAllowedChannels[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.QueryPricesAllowedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.QueryPricesFee) > 0 {
+		for iNdEx := len(m.QueryPricesFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueryPricesFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.RegisteredSignalsOnly {
+		i--
+		if m.RegisteredSignalsOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.OutlierPenalty != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OutlierPenalty))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxOutlierRatioBasisPoint != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOutlierRatioBasisPoint))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.AccuracyWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AccuracyWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.OutlierThresholdBasisPoint != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OutlierThresholdBasisPoint))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.PriceHistoryRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PriceHistoryRetention))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.MaxSignalIDsPerSigning != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxSignalIDsPerSigning))
 		i--
@@ -435,6 +710,42 @@ func (m *Params) Size() (n int) {
 	if m.MaxSignalIDsPerSigning != 0 {
 		n += 1 + sovParams(uint64(m.MaxSignalIDsPerSigning))
 	}
+	if m.PriceHistoryRetention != 0 {
+		n += 1 + sovParams(uint64(m.PriceHistoryRetention))
+	}
+	if m.OutlierThresholdBasisPoint != 0 {
+		n += 1 + sovParams(uint64(m.OutlierThresholdBasisPoint))
+	}
+	if m.AccuracyWindow != 0 {
+		n += 2 + sovParams(uint64(m.AccuracyWindow))
+	}
+	if m.MaxOutlierRatioBasisPoint != 0 {
+		n += 2 + sovParams(uint64(m.MaxOutlierRatioBasisPoint))
+	}
+	if m.OutlierPenalty != 0 {
+		n += 2 + sovParams(uint64(m.OutlierPenalty))
+	}
+	if m.RegisteredSignalsOnly {
+		n += 3
+	}
+	if len(m.QueryPricesFee) > 0 {
+		for _, e := range m.QueryPricesFee {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.QueryPricesAllowedChannels) > 0 {
+		for _, s := range m.QueryPricesAllowedChannels {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.CommitWindow != 0 {
+		n += 2 + sovParams(uint64(m.CommitWindow))
+	}
+	if m.RevealWindow != 0 {
+		n += 2 + sovParams(uint64(m.RevealWindow))
+	}
 	return n
 }
 
@@ -746,6 +1057,225 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryRetention", wireType)
+			}
+			m.PriceHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutlierThresholdBasisPoint", wireType)
+			}
+			m.OutlierThresholdBasisPoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutlierThresholdBasisPoint |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccuracyWindow", wireType)
+			}
+			m.AccuracyWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccuracyWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutlierRatioBasisPoint", wireType)
+			}
+			m.MaxOutlierRatioBasisPoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOutlierRatioBasisPoint |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutlierPenalty", wireType)
+			}
+			m.OutlierPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutlierPenalty |= OutlierPenalty(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredSignalsOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RegisteredSignalsOnly = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryPricesFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryPricesFee = append(m.QueryPricesFee, types.Coin{})
+			if err := m.QueryPricesFee[len(m.QueryPricesFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryPricesAllowedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryPricesAllowedChannels = append(m.QueryPricesAllowedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitWindow", wireType)
+			}
+			m.CommitWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealWindow", wireType)
+			}
+			m.RevealWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
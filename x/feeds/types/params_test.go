@@ -75,6 +75,37 @@ func TestParams_Validate(t *testing.T) {
 			params.CurrentFeedsUpdateInterval = 0 // Invalid value
 			return params
 		}(), fmt.Errorf("current feeds update interval must be positive: 0")},
+		{"invalid AccuracyWindow", func() types.Params {
+			params := types.DefaultParams()
+			params.AccuracyWindow = -1 // Invalid value
+			return params
+		}(), fmt.Errorf("accuracy window cannot be negative: -1")},
+		{"invalid MaxOutlierRatioBasisPoint", func() types.Params {
+			params := types.DefaultParams()
+			params.MaxOutlierRatioBasisPoint = 10001 // Invalid value
+			return params
+		}(), fmt.Errorf("max outlier ratio basis point too large: 10001")},
+		{"invalid OutlierPenalty", func() types.Params {
+			params := types.DefaultParams()
+			params.OutlierPenalty = 2 // Invalid value
+			return params
+		}(), fmt.Errorf("invalid outlier penalty: 2")},
+		{"duplicate QueryPricesAllowedChannels", func() types.Params {
+			params := types.DefaultParams()
+			params.QueryPricesAllowedChannels = []string{"channel-0", "channel-0"} // Invalid value
+			return params
+		}(), fmt.Errorf("duplicate query prices allowed channel: channel-0")},
+		{"negative CommitWindow", func() types.Params {
+			params := types.DefaultParams()
+			params.CommitWindow = -1 // Invalid value
+			params.RevealWindow = 30
+			return params
+		}(), fmt.Errorf("commit and reveal windows cannot be negative: -1, 30")},
+		{"CommitWindow without RevealWindow", func() types.Params {
+			params := types.DefaultParams()
+			params.CommitWindow = 30 // Invalid value
+			return params
+		}(), fmt.Errorf("commit and reveal windows must be both set or both zero: 30, 0")},
 	}
 
 	for _, tt := range tests {
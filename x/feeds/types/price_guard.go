@@ -0,0 +1,108 @@
+package types
+
+import "fmt"
+
+// NewPriceGuard creates a new price guard instance.
+func NewPriceGuard(
+	signalID string,
+	maxChangeBasisPoint uint64,
+	minPrice uint64,
+	maxPrice uint64,
+	maxStaleness int64,
+) PriceGuard {
+	return PriceGuard{
+		SignalID:            signalID,
+		MaxChangeBasisPoint: maxChangeBasisPoint,
+		MinPrice:            minPrice,
+		MaxPrice:            maxPrice,
+		MaxStaleness:        maxStaleness,
+	}
+}
+
+// Validate validates the price guard.
+func (pg PriceGuard) Validate() error {
+	if pg.SignalID == "" {
+		return ErrInvalidPriceGuard.Wrap("signal id cannot be empty")
+	}
+
+	if uint64(len(pg.SignalID)) > MaxSignalIDCharacters {
+		return ErrSignalIDTooLarge.Wrapf(
+			"maximum number of characters is %d but received %d characters",
+			MaxSignalIDCharacters, len(pg.SignalID),
+		)
+	}
+
+	if pg.MinPrice > 0 && pg.MaxPrice > 0 && pg.MinPrice > pg.MaxPrice {
+		return ErrInvalidPriceGuard.Wrapf(
+			"min price %d is greater than max price %d",
+			pg.MinPrice, pg.MaxPrice,
+		)
+	}
+
+	if pg.MaxStaleness < 0 {
+		return ErrInvalidPriceGuard.Wrapf("max staleness cannot be negative: %d", pg.MaxStaleness)
+	}
+
+	return nil
+}
+
+// IsDisabled returns true if all limits of the price guard are disabled.
+func (pg PriceGuard) IsDisabled() bool {
+	return pg.MaxChangeBasisPoint == 0 && pg.MinPrice == 0 && pg.MaxPrice == 0 && pg.MaxStaleness == 0
+}
+
+// CheckPrice checks the calculated price against the limits of the price guard and returns the reason of
+// the breach if any. The change limit is only checked if there is a last accepted price, and the staleness
+// limit is checked with the timestamp of the latest validator price used to calculate the price.
+func (pg PriceGuard) CheckPrice(
+	price uint64,
+	lastAcceptedPrice uint64,
+	latestTimestamp int64,
+	blockTime int64,
+) (reason string, breached bool) {
+	if pg.MinPrice > 0 && price < pg.MinPrice {
+		return fmt.Sprintf("price %d is below the min price %d", price, pg.MinPrice), true
+	}
+
+	if pg.MaxPrice > 0 && price > pg.MaxPrice {
+		return fmt.Sprintf("price %d is above the max price %d", price, pg.MaxPrice), true
+	}
+
+	if pg.MaxChangeBasisPoint > 0 && lastAcceptedPrice > 0 {
+		change := CalculatePriceDeviationBasisPoint(price, lastAcceptedPrice)
+		if change > pg.MaxChangeBasisPoint {
+			return fmt.Sprintf(
+				"price change %d basis point exceeds the max change %d basis point",
+				change, pg.MaxChangeBasisPoint,
+			), true
+		}
+	}
+
+	if pg.MaxStaleness > 0 && blockTime-latestTimestamp > pg.MaxStaleness {
+		return fmt.Sprintf(
+			"latest validator price is %d seconds old, exceeding the max staleness %d seconds",
+			blockTime-latestTimestamp, pg.MaxStaleness,
+		), true
+	}
+
+	return "", false
+}
+
+// ValidatePriceGuards validates each price guard and checks that there is no duplicate signal ID.
+func ValidatePriceGuards(priceGuards []PriceGuard) error {
+	signalIDSet := make(map[string]struct{})
+	for _, pg := range priceGuards {
+		if err := pg.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := signalIDSet[pg.SignalID]; ok {
+			return ErrDuplicateSignalID.Wrapf(
+				"duplicate signal ID found: %s", pg.SignalID,
+			)
+		}
+		signalIDSet[pg.SignalID] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func TestPriceGuardValidate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		priceGuard types.PriceGuard
+		expErr     error
+	}{
+		{
+			name:       "valid price guard",
+			priceGuard: types.NewPriceGuard("CS:BTC-USD", 2000, 1000, 1000000, 300),
+		},
+		{
+			name:       "valid price guard with disabled limits",
+			priceGuard: types.NewPriceGuard("CS:BTC-USD", 0, 0, 0, 0),
+		},
+		{
+			name:       "empty signal id",
+			priceGuard: types.NewPriceGuard("", 2000, 0, 0, 0),
+			expErr:     types.ErrInvalidPriceGuard,
+		},
+		{
+			name: "signal id too large",
+			priceGuard: types.NewPriceGuard(
+				strings.Repeat("A", int(types.MaxSignalIDCharacters)+1), 2000, 0, 0, 0,
+			),
+			expErr: types.ErrSignalIDTooLarge,
+		},
+		{
+			name:       "min price greater than max price",
+			priceGuard: types.NewPriceGuard("CS:BTC-USD", 0, 2000, 1000, 0),
+			expErr:     types.ErrInvalidPriceGuard,
+		},
+		{
+			name:       "negative max staleness",
+			priceGuard: types.NewPriceGuard("CS:BTC-USD", 0, 0, 0, -1),
+			expErr:     types.ErrInvalidPriceGuard,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.priceGuard.Validate()
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPriceGuardCheckPrice(t *testing.T) {
+	priceGuard := types.NewPriceGuard("CS:BTC-USD", 2000, 1000, 1000000, 300)
+
+	testCases := []struct {
+		name              string
+		price             uint64
+		lastAcceptedPrice uint64
+		latestTimestamp   int64
+		expBreached       bool
+	}{
+		{
+			name:              "within all limits",
+			price:             11000,
+			lastAcceptedPrice: 10000,
+			latestTimestamp:   1000,
+			expBreached:       false,
+		},
+		{
+			name:              "no last accepted price",
+			price:             50000,
+			lastAcceptedPrice: 0,
+			latestTimestamp:   1000,
+			expBreached:       false,
+		},
+		{
+			name:              "below min price",
+			price:             999,
+			lastAcceptedPrice: 0,
+			latestTimestamp:   1000,
+			expBreached:       true,
+		},
+		{
+			name:              "above max price",
+			price:             1000001,
+			lastAcceptedPrice: 0,
+			latestTimestamp:   1000,
+			expBreached:       true,
+		},
+		{
+			name:              "change exceeds max change",
+			price:             12001,
+			lastAcceptedPrice: 10000,
+			latestTimestamp:   1000,
+			expBreached:       true,
+		},
+		{
+			name:              "stale validator price",
+			price:             10000,
+			lastAcceptedPrice: 10000,
+			latestTimestamp:   699,
+			expBreached:       true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reason, breached := priceGuard.CheckPrice(tc.price, tc.lastAcceptedPrice, tc.latestTimestamp, 1000)
+			require.Equal(t, tc.expBreached, breached)
+			require.Equal(t, tc.expBreached, reason != "")
+		})
+	}
+}
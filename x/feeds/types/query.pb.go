@@ -583,22 +583,31 @@ func (m *QueryAllPricesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// QueryReferenceSourceConfigRequest is the request type for the Query/ReferenceSourceConfig RPC method.
-type QueryReferenceSourceConfigRequest struct {
+// QueryPriceHistoryRequest is the request type for the Query/PriceHistory RPC method.
+type QueryPriceHistoryRequest struct {
+	// signal_id is the signal id to query the price history for.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// start_time is the unix timestamp (in seconds) of the beginning of the time range (inclusive).
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the unix timestamp (in seconds) of the end of the time range (inclusive).
+	// If it is zero, the time range is not bounded by the end.
+	EndTime int64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// pagination is the pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryReferenceSourceConfigRequest) Reset()         { *m = QueryReferenceSourceConfigRequest{} }
-func (m *QueryReferenceSourceConfigRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryReferenceSourceConfigRequest) ProtoMessage()    {}
-func (*QueryReferenceSourceConfigRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPriceHistoryRequest) Reset()         { *m = QueryPriceHistoryRequest{} }
+func (m *QueryPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceHistoryRequest) ProtoMessage()    {}
+func (*QueryPriceHistoryRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39690037efa6f66f, []int{12}
 }
-func (m *QueryReferenceSourceConfigRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryReferenceSourceConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryReferenceSourceConfigRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceHistoryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -608,36 +617,66 @@ func (m *QueryReferenceSourceConfigRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryReferenceSourceConfigRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryReferenceSourceConfigRequest.Merge(m, src)
+func (m *QueryPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceHistoryRequest.Merge(m, src)
 }
-func (m *QueryReferenceSourceConfigRequest) XXX_Size() int {
+func (m *QueryPriceHistoryRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryReferenceSourceConfigRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryReferenceSourceConfigRequest.DiscardUnknown(m)
+func (m *QueryPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryReferenceSourceConfigRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceHistoryRequest proto.InternalMessageInfo
 
-// QueryReferenceSourceConfigResponse is the response type for the Query/ReferenceSourceConfig RPC method.
-type QueryReferenceSourceConfigResponse struct {
-	// reference_source_config is the information about the reference price source.
-	ReferenceSourceConfig ReferenceSourceConfig `protobuf:"bytes,1,opt,name=reference_source_config,json=referenceSourceConfig,proto3" json:"reference_source_config"`
+func (m *QueryPriceHistoryRequest) GetSignalId() string {
+	if m != nil {
+		return m.SignalId
+	}
+	return ""
 }
 
-func (m *QueryReferenceSourceConfigResponse) Reset()         { *m = QueryReferenceSourceConfigResponse{} }
-func (m *QueryReferenceSourceConfigResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryReferenceSourceConfigResponse) ProtoMessage()    {}
-func (*QueryReferenceSourceConfigResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPriceHistoryRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *QueryPriceHistoryRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *QueryPriceHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPriceHistoryResponse is the response type for the Query/PriceHistory RPC method.
+type QueryPriceHistoryResponse struct {
+	// prices is a list of historical prices of the signal id ordered by timestamp.
+	Prices []Price `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
+	// pagination is the pagination information in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPriceHistoryResponse) Reset()         { *m = QueryPriceHistoryResponse{} }
+func (m *QueryPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceHistoryResponse) ProtoMessage()    {}
+func (*QueryPriceHistoryResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39690037efa6f66f, []int{13}
 }
-func (m *QueryReferenceSourceConfigResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryReferenceSourceConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryReferenceSourceConfigResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceHistoryResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -647,45 +686,52 @@ func (m *QueryReferenceSourceConfigResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryReferenceSourceConfigResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryReferenceSourceConfigResponse.Merge(m, src)
+func (m *QueryPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceHistoryResponse.Merge(m, src)
 }
-func (m *QueryReferenceSourceConfigResponse) XXX_Size() int {
+func (m *QueryPriceHistoryResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryReferenceSourceConfigResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryReferenceSourceConfigResponse.DiscardUnknown(m)
+func (m *QueryPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryReferenceSourceConfigResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceHistoryResponse proto.InternalMessageInfo
 
-func (m *QueryReferenceSourceConfigResponse) GetReferenceSourceConfig() ReferenceSourceConfig {
+func (m *QueryPriceHistoryResponse) GetPrices() []Price {
 	if m != nil {
-		return m.ReferenceSourceConfig
+		return m.Prices
 	}
-	return ReferenceSourceConfig{}
+	return nil
 }
 
-// QuerySignalTotalPowersRequest is the request type for the Query/SignalTotalPowers RPC method.
-type QuerySignalTotalPowersRequest struct {
-	// signal_ids is a list of signal ids to query.
+func (m *QueryPriceHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTWAPRequest is the request type for the Query/TWAP RPC method.
+type QueryTWAPRequest struct {
+	// signal_ids is a list of signal ids to query time-weighted average prices for.
 	SignalIds []string `protobuf:"bytes,1,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
-	// pagination is the pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// window is the length (in seconds) of the time window ending at the current block time.
+	Window int64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
 }
 
-func (m *QuerySignalTotalPowersRequest) Reset()         { *m = QuerySignalTotalPowersRequest{} }
-func (m *QuerySignalTotalPowersRequest) String() string { return proto.CompactTextString(m) }
-func (*QuerySignalTotalPowersRequest) ProtoMessage()    {}
-func (*QuerySignalTotalPowersRequest) Descriptor() ([]byte, []int) {
+func (m *QueryTWAPRequest) Reset()         { *m = QueryTWAPRequest{} }
+func (m *QueryTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPRequest) ProtoMessage()    {}
+func (*QueryTWAPRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39690037efa6f66f, []int{14}
 }
-func (m *QuerySignalTotalPowersRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTWAPRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySignalTotalPowersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySignalTotalPowersRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTWAPRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -695,52 +741,50 @@ func (m *QuerySignalTotalPowersRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QuerySignalTotalPowersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySignalTotalPowersRequest.Merge(m, src)
+func (m *QueryTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPRequest.Merge(m, src)
 }
-func (m *QuerySignalTotalPowersRequest) XXX_Size() int {
+func (m *QueryTWAPRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySignalTotalPowersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySignalTotalPowersRequest.DiscardUnknown(m)
+func (m *QueryTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySignalTotalPowersRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTWAPRequest proto.InternalMessageInfo
 
-func (m *QuerySignalTotalPowersRequest) GetSignalIds() []string {
+func (m *QueryTWAPRequest) GetSignalIds() []string {
 	if m != nil {
 		return m.SignalIds
 	}
 	return nil
 }
 
-func (m *QuerySignalTotalPowersRequest) GetPagination() *query.PageRequest {
+func (m *QueryTWAPRequest) GetWindow() int64 {
 	if m != nil {
-		return m.Pagination
+		return m.Window
 	}
-	return nil
+	return 0
 }
 
-// QuerySignalTotalPowersResponse is the response type for the Query/SignalTotalPowers RPC method.
-type QuerySignalTotalPowersResponse struct {
-	// signal_total_powers is a list of signal-total-powers.
-	SignalTotalPowers []*Signal `protobuf:"bytes,1,rep,name=signal_total_powers,json=signalTotalPowers,proto3" json:"signal_total_powers,omitempty"`
-	// pagination is the pagination information in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryTWAPResponse is the response type for the Query/TWAP RPC method.
+type QueryTWAPResponse struct {
+	// prices is a list of time-weighted average prices.
+	Prices []Price `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
 }
 
-func (m *QuerySignalTotalPowersResponse) Reset()         { *m = QuerySignalTotalPowersResponse{} }
-func (m *QuerySignalTotalPowersResponse) String() string { return proto.CompactTextString(m) }
-func (*QuerySignalTotalPowersResponse) ProtoMessage()    {}
-func (*QuerySignalTotalPowersResponse) Descriptor() ([]byte, []int) {
+func (m *QueryTWAPResponse) Reset()         { *m = QueryTWAPResponse{} }
+func (m *QueryTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPResponse) ProtoMessage()    {}
+func (*QueryTWAPResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39690037efa6f66f, []int{15}
 }
-func (m *QuerySignalTotalPowersResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTWAPResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySignalTotalPowersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySignalTotalPowersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTWAPResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -750,50 +794,41 @@ func (m *QuerySignalTotalPowersResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QuerySignalTotalPowersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySignalTotalPowersResponse.Merge(m, src)
+func (m *QueryTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPResponse.Merge(m, src)
 }
-func (m *QuerySignalTotalPowersResponse) XXX_Size() int {
+func (m *QueryTWAPResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySignalTotalPowersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySignalTotalPowersResponse.DiscardUnknown(m)
+func (m *QueryTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySignalTotalPowersResponse proto.InternalMessageInfo
-
-func (m *QuerySignalTotalPowersResponse) GetSignalTotalPowers() []*Signal {
-	if m != nil {
-		return m.SignalTotalPowers
-	}
-	return nil
-}
+var xxx_messageInfo_QueryTWAPResponse proto.InternalMessageInfo
 
-func (m *QuerySignalTotalPowersResponse) GetPagination() *query.PageResponse {
+func (m *QueryTWAPResponse) GetPrices() []Price {
 	if m != nil {
-		return m.Pagination
+		return m.Prices
 	}
 	return nil
 }
 
-// QueryValidValidatorRequest is the request type for the Query/ValidValidator RPC method.
-type QueryValidValidatorRequest struct {
-	// validator is the validator address to query the flag.
-	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+// QueryReferenceSourceConfigRequest is the request type for the Query/ReferenceSourceConfig RPC method.
+type QueryReferenceSourceConfigRequest struct {
 }
 
-func (m *QueryValidValidatorRequest) Reset()         { *m = QueryValidValidatorRequest{} }
-func (m *QueryValidValidatorRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryValidValidatorRequest) ProtoMessage()    {}
-func (*QueryValidValidatorRequest) Descriptor() ([]byte, []int) {
+func (m *QueryReferenceSourceConfigRequest) Reset()         { *m = QueryReferenceSourceConfigRequest{} }
+func (m *QueryReferenceSourceConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReferenceSourceConfigRequest) ProtoMessage()    {}
+func (*QueryReferenceSourceConfigRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39690037efa6f66f, []int{16}
 }
-func (m *QueryValidValidatorRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryReferenceSourceConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryReferenceSourceConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidValidatorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryReferenceSourceConfigRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -803,43 +838,36 @@ func (m *QueryValidValidatorRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryValidValidatorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidValidatorRequest.Merge(m, src)
+func (m *QueryReferenceSourceConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReferenceSourceConfigRequest.Merge(m, src)
 }
-func (m *QueryValidValidatorRequest) XXX_Size() int {
+func (m *QueryReferenceSourceConfigRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidValidatorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidValidatorRequest.DiscardUnknown(m)
+func (m *QueryReferenceSourceConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReferenceSourceConfigRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidValidatorRequest proto.InternalMessageInfo
-
-func (m *QueryValidValidatorRequest) GetValidator() string {
-	if m != nil {
-		return m.Validator
-	}
-	return ""
-}
+var xxx_messageInfo_QueryReferenceSourceConfigRequest proto.InternalMessageInfo
 
-// QueryValidValidatorResponse is the response type for the Query/ValidValidator RPC method.
-type QueryValidValidatorResponse struct {
-	// valid is a valid flag.
-	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+// QueryReferenceSourceConfigResponse is the response type for the Query/ReferenceSourceConfig RPC method.
+type QueryReferenceSourceConfigResponse struct {
+	// reference_source_config is the information about the reference price source.
+	ReferenceSourceConfig ReferenceSourceConfig `protobuf:"bytes,1,opt,name=reference_source_config,json=referenceSourceConfig,proto3" json:"reference_source_config"`
 }
 
-func (m *QueryValidValidatorResponse) Reset()         { *m = QueryValidValidatorResponse{} }
-func (m *QueryValidValidatorResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryValidValidatorResponse) ProtoMessage()    {}
-func (*QueryValidValidatorResponse) Descriptor() ([]byte, []int) {
+func (m *QueryReferenceSourceConfigResponse) Reset()         { *m = QueryReferenceSourceConfigResponse{} }
+func (m *QueryReferenceSourceConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReferenceSourceConfigResponse) ProtoMessage()    {}
+func (*QueryReferenceSourceConfigResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39690037efa6f66f, []int{17}
 }
-func (m *QueryValidValidatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryReferenceSourceConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryReferenceSourceConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidValidatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryReferenceSourceConfigResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -849,45 +877,43 @@ func (m *QueryValidValidatorResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryValidValidatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidValidatorResponse.Merge(m, src)
+func (m *QueryReferenceSourceConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReferenceSourceConfigResponse.Merge(m, src)
 }
-func (m *QueryValidValidatorResponse) XXX_Size() int {
+func (m *QueryReferenceSourceConfigResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidValidatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidValidatorResponse.DiscardUnknown(m)
+func (m *QueryReferenceSourceConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReferenceSourceConfigResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidValidatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryReferenceSourceConfigResponse proto.InternalMessageInfo
 
-func (m *QueryValidValidatorResponse) GetValid() bool {
+func (m *QueryReferenceSourceConfigResponse) GetReferenceSourceConfig() ReferenceSourceConfig {
 	if m != nil {
-		return m.Valid
+		return m.ReferenceSourceConfig
 	}
-	return false
+	return ReferenceSourceConfig{}
 }
 
-// QueryValidatorPricesRequest is the request type for the Query/ValidatorPrices RPC method.
-type QueryValidatorPricesRequest struct {
-	// validator is the validator address to query prices for.
-	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
-	// signal_ids is the list of signal ids to query the price for.
-	SignalIds []string `protobuf:"bytes,2,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
+// QuerySignalInfoRequest is the request type for the Query/SignalInfo RPC method.
+type QuerySignalInfoRequest struct {
+	// signal_id is the signal id to query the metadata for.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
 }
 
-func (m *QueryValidatorPricesRequest) Reset()         { *m = QueryValidatorPricesRequest{} }
-func (m *QueryValidatorPricesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryValidatorPricesRequest) ProtoMessage()    {}
-func (*QueryValidatorPricesRequest) Descriptor() ([]byte, []int) {
+func (m *QuerySignalInfoRequest) Reset()         { *m = QuerySignalInfoRequest{} }
+func (m *QuerySignalInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalInfoRequest) ProtoMessage()    {}
+func (*QuerySignalInfoRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39690037efa6f66f, []int{18}
 }
-func (m *QueryValidatorPricesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySignalInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidatorPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySignalInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidatorPricesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySignalInfoRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -897,50 +923,43 @@ func (m *QueryValidatorPricesRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryValidatorPricesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidatorPricesRequest.Merge(m, src)
+func (m *QuerySignalInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalInfoRequest.Merge(m, src)
 }
-func (m *QueryValidatorPricesRequest) XXX_Size() int {
+func (m *QuerySignalInfoRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidatorPricesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidatorPricesRequest.DiscardUnknown(m)
+func (m *QuerySignalInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidatorPricesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySignalInfoRequest proto.InternalMessageInfo
 
-func (m *QueryValidatorPricesRequest) GetValidator() string {
+func (m *QuerySignalInfoRequest) GetSignalId() string {
 	if m != nil {
-		return m.Validator
+		return m.SignalId
 	}
 	return ""
 }
 
-func (m *QueryValidatorPricesRequest) GetSignalIds() []string {
-	if m != nil {
-		return m.SignalIds
-	}
-	return nil
-}
-
-// QueryValidatorPricesResponse is the response type for the Query/ValidatorPrices RPC method.
-type QueryValidatorPricesResponse struct {
-	// validator_prices is a list of prices submitted by the validator.
-	ValidatorPrices []ValidatorPrice `protobuf:"bytes,1,rep,name=validator_prices,json=validatorPrices,proto3" json:"validator_prices"`
+// QuerySignalInfoResponse is the response type for the Query/SignalInfo RPC method.
+type QuerySignalInfoResponse struct {
+	// signal_info is the registered metadata of the signal id.
+	SignalInfo SignalInfo `protobuf:"bytes,1,opt,name=signal_info,json=signalInfo,proto3" json:"signal_info"`
 }
 
-func (m *QueryValidatorPricesResponse) Reset()         { *m = QueryValidatorPricesResponse{} }
-func (m *QueryValidatorPricesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryValidatorPricesResponse) ProtoMessage()    {}
-func (*QueryValidatorPricesResponse) Descriptor() ([]byte, []int) {
+func (m *QuerySignalInfoResponse) Reset()         { *m = QuerySignalInfoResponse{} }
+func (m *QuerySignalInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalInfoResponse) ProtoMessage()    {}
+func (*QuerySignalInfoResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39690037efa6f66f, []int{19}
 }
-func (m *QueryValidatorPricesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySignalInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidatorPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySignalInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidatorPricesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySignalInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -950,43 +969,43 @@ func (m *QueryValidatorPricesResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryValidatorPricesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidatorPricesResponse.Merge(m, src)
+func (m *QuerySignalInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalInfoResponse.Merge(m, src)
 }
-func (m *QueryValidatorPricesResponse) XXX_Size() int {
+func (m *QuerySignalInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidatorPricesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidatorPricesResponse.DiscardUnknown(m)
+func (m *QuerySignalInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidatorPricesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySignalInfoResponse proto.InternalMessageInfo
 
-func (m *QueryValidatorPricesResponse) GetValidatorPrices() []ValidatorPrice {
+func (m *QuerySignalInfoResponse) GetSignalInfo() SignalInfo {
 	if m != nil {
-		return m.ValidatorPrices
+		return m.SignalInfo
 	}
-	return nil
+	return SignalInfo{}
 }
 
-// QueryVoteRequest is the request type for the Query/Vote RPC method.
-type QueryVoteRequest struct {
-	// voter is the voter address to query signal for.
-	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+// QuerySignalInfosRequest is the request type for the Query/SignalInfos RPC method.
+type QuerySignalInfosRequest struct {
+	// pagination is the pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryVoteRequest) Reset()         { *m = QueryVoteRequest{} }
-func (m *QueryVoteRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryVoteRequest) ProtoMessage()    {}
-func (*QueryVoteRequest) Descriptor() ([]byte, []int) {
+func (m *QuerySignalInfosRequest) Reset()         { *m = QuerySignalInfosRequest{} }
+func (m *QuerySignalInfosRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalInfosRequest) ProtoMessage()    {}
+func (*QuerySignalInfosRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39690037efa6f66f, []int{20}
 }
-func (m *QueryVoteRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySignalInfosRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySignalInfosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVoteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySignalInfosRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -996,43 +1015,45 @@ func (m *QueryVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *QueryVoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVoteRequest.Merge(m, src)
+func (m *QuerySignalInfosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalInfosRequest.Merge(m, src)
 }
-func (m *QueryVoteRequest) XXX_Size() int {
+func (m *QuerySignalInfosRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVoteRequest.DiscardUnknown(m)
+func (m *QuerySignalInfosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalInfosRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVoteRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySignalInfosRequest proto.InternalMessageInfo
 
-func (m *QueryVoteRequest) GetVoter() string {
+func (m *QuerySignalInfosRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Voter
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QueryVoteResponse is the response type for the Query/Vote RPC method.
-type QueryVoteResponse struct {
-	// signals is a list of signals submitted by the voter.
-	Signals []Signal `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals"`
+// QuerySignalInfosResponse is the response type for the Query/SignalInfos RPC method.
+type QuerySignalInfosResponse struct {
+	// signal_infos is a list of registered metadata of signal ids.
+	SignalInfos []SignalInfo `protobuf:"bytes,1,rep,name=signal_infos,json=signalInfos,proto3" json:"signal_infos"`
+	// pagination is the pagination information in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryVoteResponse) Reset()         { *m = QueryVoteResponse{} }
-func (m *QueryVoteResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryVoteResponse) ProtoMessage()    {}
-func (*QueryVoteResponse) Descriptor() ([]byte, []int) {
+func (m *QuerySignalInfosResponse) Reset()         { *m = QuerySignalInfosResponse{} }
+func (m *QuerySignalInfosResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalInfosResponse) ProtoMessage()    {}
+func (*QuerySignalInfosResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39690037efa6f66f, []int{21}
 }
-func (m *QueryVoteResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySignalInfosResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySignalInfosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVoteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySignalInfosResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1042,1664 +1063,6372 @@ func (m *QueryVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *QueryVoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVoteResponse.Merge(m, src)
+func (m *QuerySignalInfosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalInfosResponse.Merge(m, src)
 }
-func (m *QueryVoteResponse) XXX_Size() int {
+func (m *QuerySignalInfosResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVoteResponse.DiscardUnknown(m)
+func (m *QuerySignalInfosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalInfosResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVoteResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySignalInfosResponse proto.InternalMessageInfo
 
-func (m *QueryVoteResponse) GetSignals() []Signal {
+func (m *QuerySignalInfosResponse) GetSignalInfos() []SignalInfo {
 	if m != nil {
-		return m.Signals
+		return m.SignalInfos
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryCurrentFeedsRequest)(nil), "band.feeds.v1beta1.QueryCurrentFeedsRequest")
-	proto.RegisterType((*QueryCurrentFeedsResponse)(nil), "band.feeds.v1beta1.QueryCurrentFeedsResponse")
-	proto.RegisterType((*QueryIsFeederRequest)(nil), "band.feeds.v1beta1.QueryIsFeederRequest")
-	proto.RegisterType((*QueryIsFeederResponse)(nil), "band.feeds.v1beta1.QueryIsFeederResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "band.feeds.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "band.feeds.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryPriceRequest)(nil), "band.feeds.v1beta1.QueryPriceRequest")
-	proto.RegisterType((*QueryPriceResponse)(nil), "band.feeds.v1beta1.QueryPriceResponse")
-	proto.RegisterType((*QueryPricesRequest)(nil), "band.feeds.v1beta1.QueryPricesRequest")
-	proto.RegisterType((*QueryPricesResponse)(nil), "band.feeds.v1beta1.QueryPricesResponse")
-	proto.RegisterType((*QueryAllPricesRequest)(nil), "band.feeds.v1beta1.QueryAllPricesRequest")
-	proto.RegisterType((*QueryAllPricesResponse)(nil), "band.feeds.v1beta1.QueryAllPricesResponse")
-	proto.RegisterType((*QueryReferenceSourceConfigRequest)(nil), "band.feeds.v1beta1.QueryReferenceSourceConfigRequest")
-	proto.RegisterType((*QueryReferenceSourceConfigResponse)(nil), "band.feeds.v1beta1.QueryReferenceSourceConfigResponse")
-	proto.RegisterType((*QuerySignalTotalPowersRequest)(nil), "band.feeds.v1beta1.QuerySignalTotalPowersRequest")
-	proto.RegisterType((*QuerySignalTotalPowersResponse)(nil), "band.feeds.v1beta1.QuerySignalTotalPowersResponse")
-	proto.RegisterType((*QueryValidValidatorRequest)(nil), "band.feeds.v1beta1.QueryValidValidatorRequest")
-	proto.RegisterType((*QueryValidValidatorResponse)(nil), "band.feeds.v1beta1.QueryValidValidatorResponse")
-	proto.RegisterType((*QueryValidatorPricesRequest)(nil), "band.feeds.v1beta1.QueryValidatorPricesRequest")
-	proto.RegisterType((*QueryValidatorPricesResponse)(nil), "band.feeds.v1beta1.QueryValidatorPricesResponse")
-	proto.RegisterType((*QueryVoteRequest)(nil), "band.feeds.v1beta1.QueryVoteRequest")
-	proto.RegisterType((*QueryVoteResponse)(nil), "band.feeds.v1beta1.QueryVoteResponse")
+func (m *QuerySignalInfosResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("band/feeds/v1beta1/query.proto", fileDescriptor_39690037efa6f66f) }
-
-var fileDescriptor_39690037efa6f66f = []byte{
-	// 1157 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xdf, 0x6f, 0xdb, 0x54,
-	0x14, 0xc7, 0xeb, 0xb0, 0x84, 0xe6, 0x50, 0xd8, 0x7a, 0x97, 0xb2, 0xd6, 0x6d, 0x33, 0x7a, 0xd7,
-	0x75, 0x6d, 0x69, 0xec, 0xb6, 0xd9, 0x00, 0xf1, 0x82, 0xd6, 0xa1, 0xc1, 0x40, 0x62, 0x23, 0x45,
-	0x80, 0x90, 0x50, 0xe4, 0x38, 0xb7, 0xae, 0xa5, 0xd4, 0x37, 0xf3, 0x75, 0xc2, 0xa6, 0xaa, 0x42,
-	0x20, 0x81, 0xc4, 0x03, 0x12, 0x68, 0x6f, 0xfc, 0x01, 0x3c, 0xf0, 0x3c, 0xf8, 0x1b, 0xf6, 0x38,
-	0x8d, 0x17, 0x9e, 0x10, 0x6a, 0x79, 0xe2, 0xaf, 0x40, 0xbe, 0xf7, 0x38, 0x8d, 0x5d, 0x7b, 0xf1,
-	0x7e, 0x3c, 0x35, 0xf6, 0xf9, 0x9e, 0x73, 0x3e, 0xe7, 0xdc, 0x1f, 0xc7, 0x85, 0x6a, 0xcb, 0xf2,
-	0xda, 0xe6, 0x0e, 0x63, 0x6d, 0x61, 0xf6, 0x37, 0x5a, 0x2c, 0xb0, 0x36, 0xcc, 0xdb, 0x3d, 0xe6,
-	0xdf, 0x35, 0xba, 0x3e, 0x0f, 0x38, 0x21, 0xa1, 0xdd, 0x90, 0x76, 0x03, 0xed, 0x7a, 0xc5, 0xe1,
-	0x0e, 0x97, 0x66, 0x33, 0xfc, 0xa5, 0x94, 0xfa, 0x9c, 0xc3, 0xb9, 0xd3, 0x61, 0xa6, 0xd5, 0x75,
-	0x4d, 0xcb, 0xf3, 0x78, 0x60, 0x05, 0x2e, 0xf7, 0x04, 0x5a, 0x67, 0x6c, 0x2e, 0xf6, 0xb8, 0x68,
-	0x2a, 0x37, 0xf5, 0x80, 0xa6, 0x55, 0xf5, 0x64, 0xb6, 0x2c, 0xc1, 0x54, 0xee, 0x01, 0x49, 0xd7,
-	0x72, 0x5c, 0x4f, 0xc6, 0x41, 0x6d, 0x1a, 0xae, 0x82, 0x53, 0xf6, 0xf3, 0x29, 0xf6, 0xae, 0xe5,
-	0x5b, 0x7b, 0x28, 0xa0, 0x3a, 0x4c, 0x7f, 0x1c, 0xa6, 0xb8, 0xd6, 0xf3, 0x7d, 0xe6, 0x05, 0xd7,
-	0x43, 0x65, 0x83, 0xdd, 0xee, 0x31, 0x11, 0xd0, 0x1e, 0xcc, 0xa4, 0xd8, 0x44, 0x97, 0x7b, 0x82,
-	0x91, 0xcf, 0xe1, 0x65, 0x5b, 0xbd, 0x6f, 0xca, 0xf0, 0xd3, 0xda, 0x6b, 0xda, 0xf2, 0x4b, 0x9b,
-	0x35, 0xe3, 0x64, 0x83, 0x8c, 0xa1, 0x00, 0x9f, 0xb9, 0xc1, 0xee, 0xbb, 0xac, 0xef, 0xaa, 0x66,
-	0x6c, 0x9d, 0x7a, 0xf0, 0xf7, 0xf9, 0xb1, 0xc6, 0x84, 0x3d, 0x94, 0x81, 0xfe, 0xa0, 0x41, 0x45,
-	0xe6, 0xbd, 0x21, 0xc2, 0x17, 0xcc, 0x47, 0x1e, 0xf2, 0x0e, 0x94, 0xfb, 0x56, 0xc7, 0x6d, 0x5b,
-	0x01, 0xf7, 0x65, 0xba, 0xf2, 0xd6, 0xc2, 0xa3, 0xfb, 0xb5, 0x79, 0xec, 0xde, 0xa7, 0x91, 0xed,
-	0x6a, 0xbb, 0xed, 0x33, 0x21, 0xb6, 0x03, 0xdf, 0xf5, 0x9c, 0xc6, 0xb1, 0x0f, 0x59, 0x87, 0xd2,
-	0x8e, 0x8c, 0x38, 0x5d, 0x90, 0xde, 0xd3, 0x8f, 0xee, 0xd7, 0x2a, 0xe8, 0x1d, 0x77, 0x42, 0x1d,
-	0xbd, 0x0c, 0x53, 0x09, 0x14, 0x2c, 0x7f, 0x16, 0xca, 0xae, 0x68, 0x62, 0xb4, 0x90, 0x65, 0xbc,
-	0x31, 0xee, 0xa2, 0x88, 0x56, 0x80, 0x48, 0xaf, 0x5b, 0xb2, 0xd3, 0x51, 0x3b, 0x6f, 0xc2, 0xd9,
-	0xd8, 0x5b, 0x8c, 0xf4, 0x16, 0x94, 0xd4, 0x8a, 0x60, 0x07, 0xf5, 0xb4, 0x0e, 0x2a, 0x1f, 0x6c,
-	0x17, 0xea, 0xe9, 0x3a, 0x4c, 0xaa, 0x80, 0xbe, 0x6b, 0xb3, 0xa8, 0x49, 0xb3, 0x50, 0x16, 0xae,
-	0xe3, 0x59, 0x9d, 0xa6, 0xdb, 0x56, 0x4d, 0x6a, 0x8c, 0xab, 0x17, 0x37, 0xda, 0xf4, 0xc3, 0x08,
-	0x4c, 0x79, 0x20, 0xc1, 0x15, 0x28, 0x76, 0xc3, 0x17, 0x08, 0x30, 0x93, 0x0a, 0x10, 0x0a, 0x30,
-	0xbf, 0x52, 0xd3, 0xfa, 0x70, 0xb0, 0xa8, 0x4a, 0x32, 0x0f, 0x30, 0xc8, 0x1f, 0x96, 0xf4, 0xc2,
-	0x72, 0xb9, 0x51, 0x8e, 0x00, 0x04, 0xfd, 0x28, 0x6a, 0x02, 0x3a, 0x21, 0xc2, 0x9b, 0x50, 0x92,
-	0x41, 0x95, 0x47, 0x0e, 0x06, 0x94, 0xd3, 0x26, 0x2e, 0xd0, 0xd5, 0x4e, 0x27, 0xce, 0x71, 0x1d,
-	0xe0, 0xf8, 0xb4, 0x60, 0x65, 0x4b, 0x06, 0x2e, 0x76, 0x78, 0xb4, 0x0c, 0x75, 0xac, 0x8f, 0x3b,
-	0xec, 0x44, 0x3d, 0x6c, 0x0c, 0x79, 0xd2, 0x5f, 0x34, 0x78, 0x35, 0x99, 0xe1, 0x19, 0xa1, 0xc9,
-	0x7b, 0x31, 0xb6, 0x82, 0x64, 0xbb, 0x34, 0x92, 0x4d, 0x65, 0x8d, 0xc1, 0x5d, 0x80, 0x05, 0xc9,
-	0xd6, 0x60, 0x3b, 0xcc, 0x67, 0x9e, 0xcd, 0xb6, 0x79, 0xcf, 0xb7, 0xd9, 0x35, 0xee, 0xed, 0xb8,
-	0x4e, 0xb4, 0xef, 0x7e, 0xd4, 0x80, 0x3e, 0x4e, 0x85, 0xd5, 0x38, 0x70, 0xce, 0x8f, 0x04, 0x4d,
-	0x21, 0x15, 0x4d, 0x5b, 0x4a, 0xb0, 0x7b, 0x2b, 0x69, 0xe5, 0xa5, 0xc6, 0xc4, 0x72, 0xa7, 0xfc,
-	0x34, 0x23, 0xfd, 0x5e, 0x83, 0x79, 0xc9, 0xb3, 0x2d, 0x77, 0xc5, 0x27, 0x3c, 0xb0, 0x3a, 0xb7,
-	0xf8, 0x57, 0xcc, 0xcf, 0xb9, 0x87, 0x12, 0x4b, 0x5b, 0x78, 0xea, 0xa5, 0xfd, 0x5d, 0x83, 0x6a,
-	0x16, 0x08, 0x36, 0xe5, 0x03, 0x38, 0x8b, 0x24, 0x41, 0x68, 0x6d, 0x76, 0xa5, 0x19, 0xd7, 0x3b,
-	0xf5, 0xa4, 0xaa, 0x58, 0x8d, 0x49, 0x91, 0x8c, 0xf9, 0xfc, 0x56, 0xfd, 0x4b, 0xd0, 0x25, 0xb6,
-	0xbc, 0xf0, 0x06, 0xb7, 0xde, 0xf3, 0xba, 0x25, 0x69, 0x1d, 0x66, 0x53, 0xc3, 0x63, 0x4b, 0x2a,
-	0x50, 0x94, 0x5a, 0xbc, 0xf5, 0xd4, 0x03, 0x3d, 0x18, 0x76, 0x0a, 0xf5, 0xf1, 0xd3, 0xf8, 0xcc,
-	0x57, 0x77, 0x7c, 0x4b, 0x14, 0x92, 0xd7, 0x8a, 0x80, 0xb9, 0xf4, 0xf4, 0x08, 0xbd, 0x0d, 0x67,
-	0x06, 0xb1, 0x9a, 0xb1, 0x43, 0x4b, 0xd3, 0x16, 0x31, 0x1e, 0x06, 0xb7, 0xf3, 0xe9, 0x7e, 0x3c,
-	0x38, 0xdd, 0x82, 0x33, 0x2a, 0x29, 0x0f, 0x06, 0xd7, 0xaf, 0x01, 0xc5, 0x3e, 0x0f, 0x58, 0x54,
-	0x64, 0xf6, 0x84, 0x51, 0x32, 0x7a, 0x13, 0xef, 0x70, 0x15, 0x03, 0x69, 0xdf, 0x86, 0x17, 0x55,
-	0x69, 0x39, 0x76, 0x1a, 0xc2, 0x45, 0x0e, 0x9b, 0xff, 0x4d, 0x40, 0x51, 0x46, 0x24, 0x3f, 0x6b,
-	0x30, 0x31, 0x3c, 0xba, 0xc9, 0x5a, 0x5a, 0x94, 0xac, 0xe9, 0xaf, 0xd7, 0x72, 0xaa, 0x15, 0x33,
-	0x5d, 0xfc, 0xf6, 0xcf, 0x7f, 0xef, 0x15, 0xaa, 0x64, 0x2e, 0xf1, 0xb5, 0x11, 0xfb, 0x48, 0x20,
-	0xf7, 0x34, 0x18, 0x8f, 0x66, 0x29, 0x59, 0xce, 0xcc, 0x90, 0x98, 0xfc, 0xfa, 0x4a, 0x0e, 0x25,
-	0x72, 0x6c, 0x4a, 0x8e, 0x35, 0xb2, 0x9a, 0xf2, 0x55, 0xc4, 0x7c, 0x73, 0x7f, 0xb0, 0x88, 0x07,
-	0xe6, 0xbe, 0x7a, 0x77, 0x40, 0xee, 0x40, 0x49, 0x0d, 0x58, 0xb2, 0x94, 0x99, 0x28, 0x36, 0xcb,
-	0xf5, 0x4b, 0x23, 0x75, 0x88, 0x33, 0x2f, 0x71, 0xce, 0x91, 0xa9, 0xd4, 0x8f, 0x30, 0xf2, 0x8d,
-	0x06, 0x45, 0xb9, 0x9b, 0xc8, 0xc5, 0xec, 0x88, 0x43, 0xe3, 0x5d, 0x5f, 0x1a, 0x25, 0xc3, 0xbc,
-	0x2b, 0x32, 0xef, 0x05, 0xb2, 0x90, 0xcc, 0x2b, 0xb7, 0xae, 0xb9, 0x3f, 0x38, 0x4c, 0xaa, 0x7a,
-	0x35, 0x97, 0x46, 0x04, 0xcf, 0x53, 0x7d, 0xec, 0xd8, 0x65, 0x57, 0xaf, 0xf2, 0x7d, 0xa7, 0x41,
-	0x79, 0x30, 0x56, 0x49, 0xf6, 0x22, 0x27, 0x87, 0xbb, 0xbe, 0x9a, 0x47, 0x8a, 0x0c, 0x0b, 0x92,
-	0x61, 0x96, 0xcc, 0x24, 0x18, 0xac, 0x4e, 0x07, 0x6f, 0x02, 0xf2, 0x87, 0x06, 0x53, 0xa9, 0x83,
-	0x8c, 0x5c, 0xc9, 0x4c, 0xf4, 0xb8, 0x91, 0xab, 0xbf, 0xf1, 0xa4, 0x6e, 0xc8, 0x6a, 0x48, 0xd6,
-	0x65, 0xb2, 0x94, 0x60, 0xcd, 0x18, 0xcc, 0xe4, 0x57, 0x0d, 0x26, 0x4f, 0x0c, 0x2f, 0xb2, 0x91,
-	0x99, 0x3d, 0x6b, 0xe2, 0xea, 0x9b, 0x4f, 0xe2, 0x82, 0xb0, 0xab, 0x12, 0x76, 0x91, 0xd0, 0x04,
-	0x6c, 0xca, 0xc0, 0x0c, 0x41, 0x5f, 0x89, 0xcf, 0x13, 0x62, 0x64, 0xa6, 0x4c, 0x9d, 0x6b, 0xba,
-	0x99, 0x5b, 0x8f, 0x7c, 0x75, 0xc9, 0x57, 0x23, 0xaf, 0x27, 0xf8, 0x06, 0x37, 0x80, 0x88, 0xdd,
-	0x06, 0xf2, 0x27, 0xf9, 0x4d, 0x83, 0xd3, 0x89, 0x21, 0x42, 0x46, 0x64, 0x3e, 0x31, 0xed, 0xf4,
-	0xf5, 0xfc, 0x0e, 0xc8, 0x7a, 0x59, 0xb2, 0x1a, 0x64, 0x2d, 0x1f, 0x2b, 0xee, 0xdb, 0xaf, 0xe1,
-	0x54, 0x38, 0x37, 0xc8, 0x62, 0x76, 0xbe, 0xe3, 0xd1, 0xa4, 0x5f, 0x1c, 0xa1, 0x1a, 0xb1, 0xac,
-	0x72, 0x5e, 0x85, 0x18, 0xe1, 0xdf, 0x03, 0xf9, 0xb8, 0xf5, 0xfe, 0x83, 0xc3, 0xaa, 0xf6, 0xf0,
-	0xb0, 0xaa, 0xfd, 0x73, 0x58, 0xd5, 0x7e, 0x3a, 0xaa, 0x8e, 0x3d, 0x3c, 0xaa, 0x8e, 0xfd, 0x75,
-	0x54, 0x1d, 0xfb, 0xc2, 0x70, 0xdc, 0x60, 0xb7, 0xd7, 0x32, 0x6c, 0xbe, 0x67, 0x86, 0x69, 0xe5,
-	0x7f, 0x9b, 0x36, 0xef, 0x98, 0xf6, 0xae, 0xe5, 0x7a, 0x66, 0xbf, 0x6e, 0xde, 0xc1, 0xf8, 0xc1,
-	0xdd, 0x2e, 0x13, 0xad, 0x92, 0x14, 0xd4, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x75, 0x45, 0x1c,
-	0x50, 0x80, 0x0f, 0x00, 0x00,
+// QueryPriceGuardRequest is the request type for the Query/PriceGuard RPC method.
+type QueryPriceGuardRequest struct {
+	// signal_id is the signal id to query the price guard for.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryPriceGuardRequest) Reset()         { *m = QueryPriceGuardRequest{} }
+func (m *QueryPriceGuardRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceGuardRequest) ProtoMessage()    {}
+func (*QueryPriceGuardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{22}
+}
+func (m *QueryPriceGuardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceGuardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceGuardRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceGuardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceGuardRequest.Merge(m, src)
+}
+func (m *QueryPriceGuardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceGuardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceGuardRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPriceGuardRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// CurrentFeeds is an RPC method that returns a list of current supported feeds.
-	CurrentFeeds(ctx context.Context, in *QueryCurrentFeedsRequest, opts ...grpc.CallOption) (*QueryCurrentFeedsResponse, error)
-	// IsFeeder is an RPC method that returns whether an account is a feeder for a specified validator.
-	IsFeeder(ctx context.Context, in *QueryIsFeederRequest, opts ...grpc.CallOption) (*QueryIsFeederResponse, error)
-	// Params is an RPC method that returns all parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Price is an RPC method that returns price by signal id.
-	Price(ctx context.Context, in *QueryPriceRequest, opts ...grpc.CallOption) (*QueryPriceResponse, error)
-	// Prices is an RPC method that prices by signal ids.
-	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
-	// AllPrices is an RPC method that returns all prices.
-	AllPrices(ctx context.Context, in *QueryAllPricesRequest, opts ...grpc.CallOption) (*QueryAllPricesResponse, error)
-	// ReferenceSourceConfig is an RPC method that returns information on the reference price source.
-	ReferenceSourceConfig(ctx context.Context, in *QueryReferenceSourceConfigRequest, opts ...grpc.CallOption) (*QueryReferenceSourceConfigResponse, error)
-	// SignalTotalPowers is an RPC method that returns all signal-total-powers or specified signal-total-power by signal
-	// id.
-	SignalTotalPowers(ctx context.Context, in *QuerySignalTotalPowersRequest, opts ...grpc.CallOption) (*QuerySignalTotalPowersResponse, error)
-	// ValidValidator is an RPC method that returns a flag to show if the validator is required to send prices.
-	ValidValidator(ctx context.Context, in *QueryValidValidatorRequest, opts ...grpc.CallOption) (*QueryValidValidatorResponse, error)
-	// ValidatorPrices is an RPC method that returns prices of a validator.
-	ValidatorPrices(ctx context.Context, in *QueryValidatorPricesRequest, opts ...grpc.CallOption) (*QueryValidatorPricesResponse, error)
-	// Vote is an RPC method that returns signals of a voter.
-	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
+func (m *QueryPriceGuardRequest) GetSignalId() string {
+	if m != nil {
+		return m.SignalId
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryPriceGuardResponse is the response type for the Query/PriceGuard RPC method.
+type QueryPriceGuardResponse struct {
+	// price_guard is the price guard of the signal id.
+	PriceGuard PriceGuard `protobuf:"bytes,1,opt,name=price_guard,json=priceGuard,proto3" json:"price_guard"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPriceGuardResponse) Reset()         { *m = QueryPriceGuardResponse{} }
+func (m *QueryPriceGuardResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceGuardResponse) ProtoMessage()    {}
+func (*QueryPriceGuardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{23}
 }
-
-func (c *queryClient) CurrentFeeds(ctx context.Context, in *QueryCurrentFeedsRequest, opts ...grpc.CallOption) (*QueryCurrentFeedsResponse, error) {
-	out := new(QueryCurrentFeedsResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/CurrentFeeds", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPriceGuardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) IsFeeder(ctx context.Context, in *QueryIsFeederRequest, opts ...grpc.CallOption) (*QueryIsFeederResponse, error) {
-	out := new(QueryIsFeederResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/IsFeeder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPriceGuardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceGuardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPriceGuardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceGuardResponse.Merge(m, src)
+}
+func (m *QueryPriceGuardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceGuardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceGuardResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPriceGuardResponse proto.InternalMessageInfo
+
+func (m *QueryPriceGuardResponse) GetPriceGuard() PriceGuard {
+	if m != nil {
+		return m.PriceGuard
 	}
-	return out, nil
+	return PriceGuard{}
 }
 
-func (c *queryClient) Price(ctx context.Context, in *QueryPriceRequest, opts ...grpc.CallOption) (*QueryPriceResponse, error) {
-	out := new(QueryPriceResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/Price", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryPriceGuardsRequest is the request type for the Query/PriceGuards RPC method.
+type QueryPriceGuardsRequest struct {
+	// pagination is the pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error) {
-	out := new(QueryPricesResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/Prices", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPriceGuardsRequest) Reset()         { *m = QueryPriceGuardsRequest{} }
+func (m *QueryPriceGuardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceGuardsRequest) ProtoMessage()    {}
+func (*QueryPriceGuardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{24}
+}
+func (m *QueryPriceGuardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceGuardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceGuardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPriceGuardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceGuardsRequest.Merge(m, src)
+}
+func (m *QueryPriceGuardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceGuardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceGuardsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllPrices(ctx context.Context, in *QueryAllPricesRequest, opts ...grpc.CallOption) (*QueryAllPricesResponse, error) {
-	out := new(QueryAllPricesResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/AllPrices", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPriceGuardsRequest proto.InternalMessageInfo
+
+func (m *QueryPriceGuardsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ReferenceSourceConfig(ctx context.Context, in *QueryReferenceSourceConfigRequest, opts ...grpc.CallOption) (*QueryReferenceSourceConfigResponse, error) {
-	out := new(QueryReferenceSourceConfigResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/ReferenceSourceConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryPriceGuardsResponse is the response type for the Query/PriceGuards RPC method.
+type QueryPriceGuardsResponse struct {
+	// price_guards is a list of price guards of signal ids.
+	PriceGuards []PriceGuard `protobuf:"bytes,1,rep,name=price_guards,json=priceGuards,proto3" json:"price_guards"`
+	// pagination is the pagination information in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) SignalTotalPowers(ctx context.Context, in *QuerySignalTotalPowersRequest, opts ...grpc.CallOption) (*QuerySignalTotalPowersResponse, error) {
-	out := new(QuerySignalTotalPowersResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/SignalTotalPowers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPriceGuardsResponse) Reset()         { *m = QueryPriceGuardsResponse{} }
+func (m *QueryPriceGuardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceGuardsResponse) ProtoMessage()    {}
+func (*QueryPriceGuardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{25}
+}
+func (m *QueryPriceGuardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceGuardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceGuardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPriceGuardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceGuardsResponse.Merge(m, src)
+}
+func (m *QueryPriceGuardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceGuardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceGuardsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ValidValidator(ctx context.Context, in *QueryValidValidatorRequest, opts ...grpc.CallOption) (*QueryValidValidatorResponse, error) {
-	out := new(QueryValidValidatorResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/ValidValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPriceGuardsResponse proto.InternalMessageInfo
+
+func (m *QueryPriceGuardsResponse) GetPriceGuards() []PriceGuard {
+	if m != nil {
+		return m.PriceGuards
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ValidatorPrices(ctx context.Context, in *QueryValidatorPricesRequest, opts ...grpc.CallOption) (*QueryValidatorPricesResponse, error) {
-	out := new(QueryValidatorPricesResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/ValidatorPrices", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPriceGuardsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error) {
-	out := new(QueryVoteResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/Vote", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QuerySignalTotalPowersRequest is the request type for the Query/SignalTotalPowers RPC method.
+type QuerySignalTotalPowersRequest struct {
+	// signal_ids is a list of signal ids to query.
+	SignalIds []string `protobuf:"bytes,1,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
+	// pagination is the pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// CurrentFeeds is an RPC method that returns a list of current supported feeds.
-	CurrentFeeds(context.Context, *QueryCurrentFeedsRequest) (*QueryCurrentFeedsResponse, error)
-	// IsFeeder is an RPC method that returns whether an account is a feeder for a specified validator.
-	IsFeeder(context.Context, *QueryIsFeederRequest) (*QueryIsFeederResponse, error)
-	// Params is an RPC method that returns all parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Price is an RPC method that returns price by signal id.
-	Price(context.Context, *QueryPriceRequest) (*QueryPriceResponse, error)
-	// Prices is an RPC method that prices by signal ids.
-	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
-	// AllPrices is an RPC method that returns all prices.
-	AllPrices(context.Context, *QueryAllPricesRequest) (*QueryAllPricesResponse, error)
-	// ReferenceSourceConfig is an RPC method that returns information on the reference price source.
-	ReferenceSourceConfig(context.Context, *QueryReferenceSourceConfigRequest) (*QueryReferenceSourceConfigResponse, error)
-	// SignalTotalPowers is an RPC method that returns all signal-total-powers or specified signal-total-power by signal
-	// id.
-	SignalTotalPowers(context.Context, *QuerySignalTotalPowersRequest) (*QuerySignalTotalPowersResponse, error)
-	// ValidValidator is an RPC method that returns a flag to show if the validator is required to send prices.
-	ValidValidator(context.Context, *QueryValidValidatorRequest) (*QueryValidValidatorResponse, error)
-	// ValidatorPrices is an RPC method that returns prices of a validator.
-	ValidatorPrices(context.Context, *QueryValidatorPricesRequest) (*QueryValidatorPricesResponse, error)
-	// Vote is an RPC method that returns signals of a voter.
-	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
+func (m *QuerySignalTotalPowersRequest) Reset()         { *m = QuerySignalTotalPowersRequest{} }
+func (m *QuerySignalTotalPowersRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalTotalPowersRequest) ProtoMessage()    {}
+func (*QuerySignalTotalPowersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{26}
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QuerySignalTotalPowersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedQueryServer) CurrentFeeds(ctx context.Context, req *QueryCurrentFeedsRequest) (*QueryCurrentFeedsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrentFeeds not implemented")
+func (m *QuerySignalTotalPowersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignalTotalPowersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) IsFeeder(ctx context.Context, req *QueryIsFeederRequest) (*QueryIsFeederResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsFeeder not implemented")
+func (m *QuerySignalTotalPowersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalTotalPowersRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QuerySignalTotalPowersRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Price(ctx context.Context, req *QueryPriceRequest) (*QueryPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Price not implemented")
+func (m *QuerySignalTotalPowersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalTotalPowersRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
+
+var xxx_messageInfo_QuerySignalTotalPowersRequest proto.InternalMessageInfo
+
+func (m *QuerySignalTotalPowersRequest) GetSignalIds() []string {
+	if m != nil {
+		return m.SignalIds
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) AllPrices(ctx context.Context, req *QueryAllPricesRequest) (*QueryAllPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllPrices not implemented")
+
+func (m *QuerySignalTotalPowersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) ReferenceSourceConfig(ctx context.Context, req *QueryReferenceSourceConfigRequest) (*QueryReferenceSourceConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReferenceSourceConfig not implemented")
+
+// QuerySignalTotalPowersResponse is the response type for the Query/SignalTotalPowers RPC method.
+type QuerySignalTotalPowersResponse struct {
+	// signal_total_powers is a list of signal-total-powers.
+	SignalTotalPowers []*Signal `protobuf:"bytes,1,rep,name=signal_total_powers,json=signalTotalPowers,proto3" json:"signal_total_powers,omitempty"`
+	// pagination is the pagination information in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) SignalTotalPowers(ctx context.Context, req *QuerySignalTotalPowersRequest) (*QuerySignalTotalPowersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignalTotalPowers not implemented")
+
+func (m *QuerySignalTotalPowersResponse) Reset()         { *m = QuerySignalTotalPowersResponse{} }
+func (m *QuerySignalTotalPowersResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalTotalPowersResponse) ProtoMessage()    {}
+func (*QuerySignalTotalPowersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{27}
 }
-func (*UnimplementedQueryServer) ValidValidator(ctx context.Context, req *QueryValidValidatorRequest) (*QueryValidValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidValidator not implemented")
+func (m *QuerySignalTotalPowersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ValidatorPrices(ctx context.Context, req *QueryValidatorPricesRequest) (*QueryValidatorPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPrices not implemented")
+func (m *QuerySignalTotalPowersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignalTotalPowersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Vote(ctx context.Context, req *QueryVoteRequest) (*QueryVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+func (m *QuerySignalTotalPowersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalTotalPowersResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QuerySignalTotalPowersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignalTotalPowersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalTotalPowersResponse.DiscardUnknown(m)
 }
 
-func _Query_CurrentFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCurrentFeedsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CurrentFeeds(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/CurrentFeeds",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CurrentFeeds(ctx, req.(*QueryCurrentFeedsRequest))
+var xxx_messageInfo_QuerySignalTotalPowersResponse proto.InternalMessageInfo
+
+func (m *QuerySignalTotalPowersResponse) GetSignalTotalPowers() []*Signal {
+	if m != nil {
+		return m.SignalTotalPowers
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_IsFeeder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIsFeederRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IsFeeder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/IsFeeder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IsFeeder(ctx, req.(*QueryIsFeederRequest))
+func (m *QuerySignalTotalPowersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryValidValidatorRequest is the request type for the Query/ValidValidator RPC method.
+type QueryValidValidatorRequest struct {
+	// validator is the validator address to query the flag.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 }
 
-func _Query_Price_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Price(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/Price",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Price(ctx, req.(*QueryPriceRequest))
+func (m *QueryValidValidatorRequest) Reset()         { *m = QueryValidValidatorRequest{} }
+func (m *QueryValidValidatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidValidatorRequest) ProtoMessage()    {}
+func (*QueryValidValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{28}
+}
+func (m *QueryValidValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidValidatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryValidValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidValidatorRequest.Merge(m, src)
+}
+func (m *QueryValidValidatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidValidatorRequest.DiscardUnknown(m)
 }
 
-func _Query_Prices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPricesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Prices(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/Prices",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Prices(ctx, req.(*QueryPricesRequest))
+var xxx_messageInfo_QueryValidValidatorRequest proto.InternalMessageInfo
+
+func (m *QueryValidValidatorRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AllPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPricesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllPrices(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/AllPrices",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllPrices(ctx, req.(*QueryAllPricesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryValidValidatorResponse is the response type for the Query/ValidValidator RPC method.
+type QueryValidValidatorResponse struct {
+	// valid is a valid flag.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
 }
 
-func _Query_ReferenceSourceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReferenceSourceConfigRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ReferenceSourceConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/ReferenceSourceConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ReferenceSourceConfig(ctx, req.(*QueryReferenceSourceConfigRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryValidValidatorResponse) Reset()         { *m = QueryValidValidatorResponse{} }
+func (m *QueryValidValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidValidatorResponse) ProtoMessage()    {}
+func (*QueryValidValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{29}
 }
-
-func _Query_SignalTotalPowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySignalTotalPowersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SignalTotalPowers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/SignalTotalPowers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SignalTotalPowers(ctx, req.(*QuerySignalTotalPowersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryValidValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_ValidValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidValidatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidValidator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/ValidValidator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidValidator(ctx, req.(*QueryValidValidatorRequest))
+func (m *QueryValidValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_ValidatorPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorPricesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorPrices(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/ValidatorPrices",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorPrices(ctx, req.(*QueryValidatorPricesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryValidValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidValidatorResponse.Merge(m, src)
+}
+func (m *QueryValidValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidValidatorResponse.DiscardUnknown(m)
 }
 
-func _Query_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVoteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Vote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/Vote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Vote(ctx, req.(*QueryVoteRequest))
+var xxx_messageInfo_QueryValidValidatorResponse proto.InternalMessageInfo
+
+func (m *QueryValidValidatorResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "band.feeds.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CurrentFeeds",
-			Handler:    _Query_CurrentFeeds_Handler,
-		},
-		{
-			MethodName: "IsFeeder",
-			Handler:    _Query_IsFeeder_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Price",
-			Handler:    _Query_Price_Handler,
-		},
-		{
-			MethodName: "Prices",
-			Handler:    _Query_Prices_Handler,
-		},
-		{
-			MethodName: "AllPrices",
-			Handler:    _Query_AllPrices_Handler,
-		},
-		{
-			MethodName: "ReferenceSourceConfig",
-			Handler:    _Query_ReferenceSourceConfig_Handler,
-		},
-		{
-			MethodName: "SignalTotalPowers",
-			Handler:    _Query_SignalTotalPowers_Handler,
-		},
-		{
-			MethodName: "ValidValidator",
-			Handler:    _Query_ValidValidator_Handler,
-		},
-		{
-			MethodName: "ValidatorPrices",
-			Handler:    _Query_ValidatorPrices_Handler,
-		},
-		{
-			MethodName: "Vote",
-			Handler:    _Query_Vote_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "band/feeds/v1beta1/query.proto",
+// QueryValidatorPricesRequest is the request type for the Query/ValidatorPrices RPC method.
+type QueryValidatorPricesRequest struct {
+	// validator is the validator address to query prices for.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// signal_ids is the list of signal ids to query the price for.
+	SignalIds []string `protobuf:"bytes,2,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
 }
 
-func (m *QueryCurrentFeedsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorPricesRequest) Reset()         { *m = QueryValidatorPricesRequest{} }
+func (m *QueryValidatorPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPricesRequest) ProtoMessage()    {}
+func (*QueryValidatorPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{30}
+}
+func (m *QueryValidatorPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryCurrentFeedsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValidatorPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPricesRequest.Merge(m, src)
+}
+func (m *QueryValidatorPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPricesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCurrentFeedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryValidatorPricesRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorPricesRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
 }
 
-func (m *QueryCurrentFeedsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorPricesRequest) GetSignalIds() []string {
+	if m != nil {
+		return m.SignalIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryCurrentFeedsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorPricesResponse is the response type for the Query/ValidatorPrices RPC method.
+type QueryValidatorPricesResponse struct {
+	// validator_prices is a list of prices submitted by the validator.
+	ValidatorPrices []ValidatorPrice `protobuf:"bytes,1,rep,name=validator_prices,json=validatorPrices,proto3" json:"validator_prices"`
 }
 
-func (m *QueryCurrentFeedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.CurrentFeeds.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryValidatorPricesResponse) Reset()         { *m = QueryValidatorPricesResponse{} }
+func (m *QueryValidatorPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPricesResponse) ProtoMessage()    {}
+func (*QueryValidatorPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{31}
+}
+func (m *QueryValidatorPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPricesResponse.Merge(m, src)
+}
+func (m *QueryValidatorPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPricesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryIsFeederRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorPricesResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorPricesResponse) GetValidatorPrices() []ValidatorPrice {
+	if m != nil {
+		return m.ValidatorPrices
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIsFeederRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorAccuracyRequest is the request type for the Query/ValidatorAccuracy RPC method.
+type QueryValidatorAccuracyRequest struct {
+	// validator is the validator address to query the accuracy for.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 }
 
-func (m *QueryIsFeederRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Feeder) > 0 {
-		i -= len(m.Feeder)
-		copy(dAtA[i:], m.Feeder)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Feeder)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryValidatorAccuracyRequest) Reset()         { *m = QueryValidatorAccuracyRequest{} }
+func (m *QueryValidatorAccuracyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorAccuracyRequest) ProtoMessage()    {}
+func (*QueryValidatorAccuracyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{32}
+}
+func (m *QueryValidatorAccuracyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorAccuracyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorAccuracyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorAccuracyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorAccuracyRequest.Merge(m, src)
+}
+func (m *QueryValidatorAccuracyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorAccuracyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorAccuracyRequest.DiscardUnknown(m)
 }
 
-func (m *QueryIsFeederResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorAccuracyRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorAccuracyRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryIsFeederResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorAccuracyResponse is the response type for the Query/ValidatorAccuracy RPC method.
+type QueryValidatorAccuracyResponse struct {
+	// validator_accuracy is the accuracy of the validator within the current accuracy window.
+	ValidatorAccuracy ValidatorAccuracy `protobuf:"bytes,1,opt,name=validator_accuracy,json=validatorAccuracy,proto3" json:"validator_accuracy"`
 }
 
-func (m *QueryIsFeederResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsFeeder {
-		i--
-		if m.IsFeeder {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryValidatorAccuracyResponse) Reset()         { *m = QueryValidatorAccuracyResponse{} }
+func (m *QueryValidatorAccuracyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorAccuracyResponse) ProtoMessage()    {}
+func (*QueryValidatorAccuracyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{33}
+}
+func (m *QueryValidatorAccuracyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorAccuracyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorAccuracyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorAccuracyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorAccuracyResponse.Merge(m, src)
+}
+func (m *QueryValidatorAccuracyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorAccuracyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorAccuracyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorAccuracyResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorAccuracyResponse) GetValidatorAccuracy() ValidatorAccuracy {
+	if m != nil {
+		return m.ValidatorAccuracy
 	}
-	return dAtA[:n], nil
+	return ValidatorAccuracy{}
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorFeedsReportRequest is the request type for the Query/ValidatorFeedsReport RPC method.
+type QueryValidatorFeedsReportRequest struct {
+	// validator is the validator address to query the report for.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryValidatorFeedsReportRequest) Reset()         { *m = QueryValidatorFeedsReportRequest{} }
+func (m *QueryValidatorFeedsReportRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorFeedsReportRequest) ProtoMessage()    {}
+func (*QueryValidatorFeedsReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{34}
+}
+func (m *QueryValidatorFeedsReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorFeedsReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorFeedsReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorFeedsReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorFeedsReportRequest.Merge(m, src)
+}
+func (m *QueryValidatorFeedsReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorFeedsReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorFeedsReportRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorFeedsReportRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorFeedsReportRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorFeedsReportResponse is the response type for the Query/ValidatorFeedsReport RPC method.
+type QueryValidatorFeedsReportResponse struct {
+	// is_active is a flag indicating whether the oracle status of the validator is active.
+	IsActive bool `protobuf:"varint,1,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// validator_accuracy is the accuracy of the validator within the current accuracy window.
+	ValidatorAccuracy ValidatorAccuracy `protobuf:"bytes,2,opt,name=validator_accuracy,json=validatorAccuracy,proto3" json:"validator_accuracy"`
+	// feed_reports is a list of the reports of the validator for each signal id in the current feeds.
+	FeedReports []ValidatorFeedReport `protobuf:"bytes,3,rep,name=feed_reports,json=feedReports,proto3" json:"feed_reports"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryValidatorFeedsReportResponse) Reset()         { *m = QueryValidatorFeedsReportResponse{} }
+func (m *QueryValidatorFeedsReportResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorFeedsReportResponse) ProtoMessage()    {}
+func (*QueryValidatorFeedsReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{35}
+}
+func (m *QueryValidatorFeedsReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorFeedsReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorFeedsReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryValidatorFeedsReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorFeedsReportResponse.Merge(m, src)
+}
+func (m *QueryValidatorFeedsReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorFeedsReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorFeedsReportResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryValidatorFeedsReportResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorFeedsReportResponse) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
+	}
+	return false
 }
 
-func (m *QueryPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SignalId) > 0 {
-		i -= len(m.SignalId)
-		copy(dAtA[i:], m.SignalId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryValidatorFeedsReportResponse) GetValidatorAccuracy() ValidatorAccuracy {
+	if m != nil {
+		return m.ValidatorAccuracy
 	}
-	return len(dAtA) - i, nil
+	return ValidatorAccuracy{}
 }
 
-func (m *QueryPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorFeedsReportResponse) GetFeedReports() []ValidatorFeedReport {
+	if m != nil {
+		return m.FeedReports
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVoteRequest is the request type for the Query/Vote RPC method.
+type QueryVoteRequest struct {
+	// voter is the voter address to query signal for.
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
 }
 
-func (m *QueryPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+func (m *QueryVoteRequest) Reset()         { *m = QueryVoteRequest{} }
+func (m *QueryVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteRequest) ProtoMessage()    {}
+func (*QueryVoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{36}
+}
+func (m *QueryVoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteRequest.Merge(m, src)
+}
+func (m *QueryVoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVoteRequest proto.InternalMessageInfo
+
+func (m *QueryVoteRequest) GetVoter() string {
+	if m != nil {
+		return m.Voter
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVoteResponse is the response type for the Query/Vote RPC method.
+type QueryVoteResponse struct {
+	// signals is a list of signals submitted by the voter.
+	Signals []Signal `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals"`
 }
 
-func (m *QueryPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SignalIds) > 0 {
-		for iNdEx := len(m.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SignalIds[iNdEx])
-			copy(dAtA[i:], m.SignalIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryVoteResponse) Reset()         { *m = QueryVoteResponse{} }
+func (m *QueryVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteResponse) ProtoMessage()    {}
+func (*QueryVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{37}
+}
+func (m *QueryVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteResponse.Merge(m, src)
+}
+func (m *QueryVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVoteResponse proto.InternalMessageInfo
+
+func (m *QueryVoteResponse) GetSignals() []Signal {
+	if m != nil {
+		return m.Signals
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySignalVoteDelegationRequest is the request type for the Query/SignalVoteDelegation RPC method.
+type QuerySignalVoteDelegationRequest struct {
+	// delegator is the delegator address to query the delegation for.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
 }
 
-func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QuerySignalVoteDelegationRequest) Reset()         { *m = QuerySignalVoteDelegationRequest{} }
+func (m *QuerySignalVoteDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalVoteDelegationRequest) ProtoMessage()    {}
+func (*QuerySignalVoteDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{38}
+}
+func (m *QuerySignalVoteDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignalVoteDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignalVoteDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySignalVoteDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalVoteDelegationRequest.Merge(m, src)
+}
+func (m *QuerySignalVoteDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignalVoteDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalVoteDelegationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySignalVoteDelegationRequest proto.InternalMessageInfo
+
+func (m *QuerySignalVoteDelegationRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySignalVoteDelegationResponse is the response type for the Query/SignalVoteDelegation RPC method.
+type QuerySignalVoteDelegationResponse struct {
+	// signal_vote_delegation is the voting power delegation of the delegator.
+	SignalVoteDelegation SignalVoteDelegation `protobuf:"bytes,1,opt,name=signal_vote_delegation,json=signalVoteDelegation,proto3" json:"signal_vote_delegation"`
 }
 
-func (m *QueryAllPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySignalVoteDelegationResponse) Reset()         { *m = QuerySignalVoteDelegationResponse{} }
+func (m *QuerySignalVoteDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalVoteDelegationResponse) ProtoMessage()    {}
+func (*QuerySignalVoteDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{39}
+}
+func (m *QuerySignalVoteDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignalVoteDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignalVoteDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySignalVoteDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalVoteDelegationResponse.Merge(m, src)
+}
+func (m *QuerySignalVoteDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignalVoteDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalVoteDelegationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySignalVoteDelegationResponse proto.InternalMessageInfo
+
+func (m *QuerySignalVoteDelegationResponse) GetSignalVoteDelegation() SignalVoteDelegation {
+	if m != nil {
+		return m.SignalVoteDelegation
 	}
-	return dAtA[:n], nil
+	return SignalVoteDelegation{}
 }
 
-func (m *QueryAllPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCuratorSignalVoteDelegationsRequest is the request type for the Query/CuratorSignalVoteDelegations RPC
+// method.
+type QueryCuratorSignalVoteDelegationsRequest struct {
+	// curator is the curator address to query the delegations for.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// pagination is the pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryCuratorSignalVoteDelegationsRequest) Reset() {
+	*m = QueryCuratorSignalVoteDelegationsRequest{}
+}
+func (m *QueryCuratorSignalVoteDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCuratorSignalVoteDelegationsRequest) ProtoMessage()    {}
+func (*QueryCuratorSignalVoteDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{40}
+}
+func (m *QueryCuratorSignalVoteDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCuratorSignalVoteDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCuratorSignalVoteDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Prices) > 0 {
-		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryCuratorSignalVoteDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCuratorSignalVoteDelegationsRequest.Merge(m, src)
+}
+func (m *QueryCuratorSignalVoteDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCuratorSignalVoteDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCuratorSignalVoteDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCuratorSignalVoteDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryCuratorSignalVoteDelegationsRequest) GetCurator() string {
+	if m != nil {
+		return m.Curator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryReferenceSourceConfigRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCuratorSignalVoteDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryReferenceSourceConfigRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCuratorSignalVoteDelegationsResponse is the response type for the Query/CuratorSignalVoteDelegations RPC
+// method.
+type QueryCuratorSignalVoteDelegationsResponse struct {
+	// signal_vote_delegations is a list of voting power delegations to the curator.
+	SignalVoteDelegations []SignalVoteDelegation `protobuf:"bytes,1,rep,name=signal_vote_delegations,json=signalVoteDelegations,proto3" json:"signal_vote_delegations"`
+	// pagination is the pagination information in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryReferenceSourceConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryCuratorSignalVoteDelegationsResponse) Reset() {
+	*m = QueryCuratorSignalVoteDelegationsResponse{}
 }
-
-func (m *QueryReferenceSourceConfigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCuratorSignalVoteDelegationsResponse) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *QueryReferenceSourceConfigResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*QueryCuratorSignalVoteDelegationsResponse) ProtoMessage() {}
+func (*QueryCuratorSignalVoteDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{41}
 }
-
-func (m *QueryReferenceSourceConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ReferenceSourceConfig.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryCuratorSignalVoteDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCuratorSignalVoteDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCuratorSignalVoteDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QuerySignalTotalPowersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCuratorSignalVoteDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCuratorSignalVoteDelegationsResponse.Merge(m, src)
 }
-
-func (m *QuerySignalTotalPowersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCuratorSignalVoteDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCuratorSignalVoteDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCuratorSignalVoteDelegationsResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySignalTotalPowersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SignalIds) > 0 {
-		for iNdEx := len(m.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SignalIds[iNdEx])
-			copy(dAtA[i:], m.SignalIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryCuratorSignalVoteDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryCuratorSignalVoteDelegationsResponse) GetSignalVoteDelegations() []SignalVoteDelegation {
+	if m != nil {
+		return m.SignalVoteDelegations
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QuerySignalTotalPowersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCuratorSignalVoteDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySignalTotalPowersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCuratorDelegatedPowerRequest is the request type for the Query/CuratorDelegatedPower RPC method.
+type QueryCuratorDelegatedPowerRequest struct {
+	// curator is the curator address to query the delegated power for.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
 }
 
-func (m *QuerySignalTotalPowersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SignalTotalPowers) > 0 {
-		for iNdEx := len(m.SignalTotalPowers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SignalTotalPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryCuratorDelegatedPowerRequest) Reset()         { *m = QueryCuratorDelegatedPowerRequest{} }
+func (m *QueryCuratorDelegatedPowerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCuratorDelegatedPowerRequest) ProtoMessage()    {}
+func (*QueryCuratorDelegatedPowerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{42}
+}
+func (m *QueryCuratorDelegatedPowerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCuratorDelegatedPowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCuratorDelegatedPowerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryValidValidatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCuratorDelegatedPowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCuratorDelegatedPowerRequest.Merge(m, src)
 }
-
-func (m *QueryValidValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCuratorDelegatedPowerRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryValidValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryCuratorDelegatedPowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCuratorDelegatedPowerRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCuratorDelegatedPowerRequest proto.InternalMessageInfo
+
+func (m *QueryCuratorDelegatedPowerRequest) GetCurator() string {
+	if m != nil {
+		return m.Curator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryValidValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCuratorDelegatedPowerResponse is the response type for the Query/CuratorDelegatedPower RPC method.
+type QueryCuratorDelegatedPowerResponse struct {
+	// curator_delegated_power is the total power delegated to the curator.
+	CuratorDelegatedPower CuratorDelegatedPower `protobuf:"bytes,1,opt,name=curator_delegated_power,json=curatorDelegatedPower,proto3" json:"curator_delegated_power"`
 }
 
-func (m *QueryValidValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Valid {
-		i--
-		if m.Valid {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryCuratorDelegatedPowerResponse) Reset()         { *m = QueryCuratorDelegatedPowerResponse{} }
+func (m *QueryCuratorDelegatedPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCuratorDelegatedPowerResponse) ProtoMessage()    {}
+func (*QueryCuratorDelegatedPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{43}
+}
+func (m *QueryCuratorDelegatedPowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCuratorDelegatedPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCuratorDelegatedPowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryValidatorPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCuratorDelegatedPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCuratorDelegatedPowerResponse.Merge(m, src)
 }
-
-func (m *QueryValidatorPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCuratorDelegatedPowerResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryValidatorPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SignalIds) > 0 {
-		for iNdEx := len(m.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SignalIds[iNdEx])
-			copy(dAtA[i:], m.SignalIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalIds[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryCuratorDelegatedPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCuratorDelegatedPowerResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCuratorDelegatedPowerResponse proto.InternalMessageInfo
+
+func (m *QueryCuratorDelegatedPowerResponse) GetCuratorDelegatedPower() CuratorDelegatedPower {
+	if m != nil {
+		return m.CuratorDelegatedPower
 	}
-	return dAtA[:n], nil
+	return CuratorDelegatedPower{}
 }
 
-func (m *QueryValidatorPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryCurrentFeedsRequest)(nil), "band.feeds.v1beta1.QueryCurrentFeedsRequest")
+	proto.RegisterType((*QueryCurrentFeedsResponse)(nil), "band.feeds.v1beta1.QueryCurrentFeedsResponse")
+	proto.RegisterType((*QueryIsFeederRequest)(nil), "band.feeds.v1beta1.QueryIsFeederRequest")
+	proto.RegisterType((*QueryIsFeederResponse)(nil), "band.feeds.v1beta1.QueryIsFeederResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "band.feeds.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "band.feeds.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryPriceRequest)(nil), "band.feeds.v1beta1.QueryPriceRequest")
+	proto.RegisterType((*QueryPriceResponse)(nil), "band.feeds.v1beta1.QueryPriceResponse")
+	proto.RegisterType((*QueryPricesRequest)(nil), "band.feeds.v1beta1.QueryPricesRequest")
+	proto.RegisterType((*QueryPricesResponse)(nil), "band.feeds.v1beta1.QueryPricesResponse")
+	proto.RegisterType((*QueryAllPricesRequest)(nil), "band.feeds.v1beta1.QueryAllPricesRequest")
+	proto.RegisterType((*QueryAllPricesResponse)(nil), "band.feeds.v1beta1.QueryAllPricesResponse")
+	proto.RegisterType((*QueryPriceHistoryRequest)(nil), "band.feeds.v1beta1.QueryPriceHistoryRequest")
+	proto.RegisterType((*QueryPriceHistoryResponse)(nil), "band.feeds.v1beta1.QueryPriceHistoryResponse")
+	proto.RegisterType((*QueryTWAPRequest)(nil), "band.feeds.v1beta1.QueryTWAPRequest")
+	proto.RegisterType((*QueryTWAPResponse)(nil), "band.feeds.v1beta1.QueryTWAPResponse")
+	proto.RegisterType((*QueryReferenceSourceConfigRequest)(nil), "band.feeds.v1beta1.QueryReferenceSourceConfigRequest")
+	proto.RegisterType((*QueryReferenceSourceConfigResponse)(nil), "band.feeds.v1beta1.QueryReferenceSourceConfigResponse")
+	proto.RegisterType((*QuerySignalInfoRequest)(nil), "band.feeds.v1beta1.QuerySignalInfoRequest")
+	proto.RegisterType((*QuerySignalInfoResponse)(nil), "band.feeds.v1beta1.QuerySignalInfoResponse")
+	proto.RegisterType((*QuerySignalInfosRequest)(nil), "band.feeds.v1beta1.QuerySignalInfosRequest")
+	proto.RegisterType((*QuerySignalInfosResponse)(nil), "band.feeds.v1beta1.QuerySignalInfosResponse")
+	proto.RegisterType((*QueryPriceGuardRequest)(nil), "band.feeds.v1beta1.QueryPriceGuardRequest")
+	proto.RegisterType((*QueryPriceGuardResponse)(nil), "band.feeds.v1beta1.QueryPriceGuardResponse")
+	proto.RegisterType((*QueryPriceGuardsRequest)(nil), "band.feeds.v1beta1.QueryPriceGuardsRequest")
+	proto.RegisterType((*QueryPriceGuardsResponse)(nil), "band.feeds.v1beta1.QueryPriceGuardsResponse")
+	proto.RegisterType((*QuerySignalTotalPowersRequest)(nil), "band.feeds.v1beta1.QuerySignalTotalPowersRequest")
+	proto.RegisterType((*QuerySignalTotalPowersResponse)(nil), "band.feeds.v1beta1.QuerySignalTotalPowersResponse")
+	proto.RegisterType((*QueryValidValidatorRequest)(nil), "band.feeds.v1beta1.QueryValidValidatorRequest")
+	proto.RegisterType((*QueryValidValidatorResponse)(nil), "band.feeds.v1beta1.QueryValidValidatorResponse")
+	proto.RegisterType((*QueryValidatorPricesRequest)(nil), "band.feeds.v1beta1.QueryValidatorPricesRequest")
+	proto.RegisterType((*QueryValidatorPricesResponse)(nil), "band.feeds.v1beta1.QueryValidatorPricesResponse")
+	proto.RegisterType((*QueryValidatorAccuracyRequest)(nil), "band.feeds.v1beta1.QueryValidatorAccuracyRequest")
+	proto.RegisterType((*QueryValidatorAccuracyResponse)(nil), "band.feeds.v1beta1.QueryValidatorAccuracyResponse")
+	proto.RegisterType((*QueryValidatorFeedsReportRequest)(nil), "band.feeds.v1beta1.QueryValidatorFeedsReportRequest")
+	proto.RegisterType((*QueryValidatorFeedsReportResponse)(nil), "band.feeds.v1beta1.QueryValidatorFeedsReportResponse")
+	proto.RegisterType((*QueryVoteRequest)(nil), "band.feeds.v1beta1.QueryVoteRequest")
+	proto.RegisterType((*QueryVoteResponse)(nil), "band.feeds.v1beta1.QueryVoteResponse")
+	proto.RegisterType((*QuerySignalVoteDelegationRequest)(nil), "band.feeds.v1beta1.QuerySignalVoteDelegationRequest")
+	proto.RegisterType((*QuerySignalVoteDelegationResponse)(nil), "band.feeds.v1beta1.QuerySignalVoteDelegationResponse")
+	proto.RegisterType((*QueryCuratorSignalVoteDelegationsRequest)(nil), "band.feeds.v1beta1.QueryCuratorSignalVoteDelegationsRequest")
+	proto.RegisterType((*QueryCuratorSignalVoteDelegationsResponse)(nil), "band.feeds.v1beta1.QueryCuratorSignalVoteDelegationsResponse")
+	proto.RegisterType((*QueryCuratorDelegatedPowerRequest)(nil), "band.feeds.v1beta1.QueryCuratorDelegatedPowerRequest")
+	proto.RegisterType((*QueryCuratorDelegatedPowerResponse)(nil), "band.feeds.v1beta1.QueryCuratorDelegatedPowerResponse")
 }
 
-func (m *QueryValidatorPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorPrices) > 0 {
-		for iNdEx := len(m.ValidatorPrices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("band/feeds/v1beta1/query.proto", fileDescriptor_39690037efa6f66f) }
+
+var fileDescriptor_39690037efa6f66f = []byte{
+	// 1957 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x9a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0xc0, 0xd3, 0x4e, 0xec, 0x78, 0x9e, 0x0d, 0xbb, 0xae, 0x8c, 0x13, 0xbb, 0x1d, 0xcf, 0xae,
+	0x3b, 0x5f, 0x76, 0x12, 0x4f, 0xc7, 0x76, 0x1c, 0xd8, 0x65, 0x01, 0x39, 0x1b, 0x92, 0x0d, 0x20,
+	0xd6, 0x8c, 0xa3, 0x0d, 0x8a, 0x84, 0x66, 0xdb, 0xdd, 0x35, 0xe3, 0x96, 0xc6, 0xdd, 0xb3, 0xdd,
+	0x3d, 0xe3, 0xb5, 0x8c, 0x85, 0x40, 0x02, 0x69, 0x0f, 0x2b, 0x81, 0x56, 0xe2, 0x80, 0x04, 0xc7,
+	0x3d, 0x20, 0x4e, 0x68, 0x01, 0x71, 0x42, 0xdc, 0x96, 0xdb, 0x6a, 0xb9, 0x70, 0x40, 0x11, 0x4a,
+	0x38, 0xf3, 0x37, 0xa0, 0xae, 0x7a, 0xfd, 0x39, 0xd5, 0x1f, 0x13, 0x0f, 0xd2, 0x9e, 0xe2, 0xa9,
+	0x7a, 0x1f, 0xbf, 0xf7, 0xaa, 0xaa, 0xab, 0xde, 0x53, 0xa0, 0xb6, 0xab, 0x59, 0x86, 0xda, 0xa2,
+	0xd4, 0x70, 0xd5, 0xfe, 0xda, 0x2e, 0xf5, 0xb4, 0x35, 0xf5, 0xbd, 0x1e, 0x75, 0x0e, 0xeb, 0x5d,
+	0xc7, 0xf6, 0x6c, 0x42, 0xfc, 0xf9, 0x3a, 0x9b, 0xaf, 0xe3, 0xbc, 0x5c, 0x6d, 0xdb, 0x6d, 0x9b,
+	0x4d, 0xab, 0xfe, 0x5f, 0x5c, 0x52, 0xbe, 0xd8, 0xb6, 0xed, 0x76, 0x87, 0xaa, 0x5a, 0xd7, 0x54,
+	0x35, 0xcb, 0xb2, 0x3d, 0xcd, 0x33, 0x6d, 0xcb, 0xc5, 0xd9, 0x79, 0xdd, 0x76, 0xf7, 0x6d, 0xb7,
+	0xc9, 0xd5, 0xf8, 0x0f, 0x9c, 0xba, 0xce, 0x7f, 0xa9, 0xbb, 0x9a, 0x4b, 0xb9, 0xef, 0x90, 0xa4,
+	0xab, 0xb5, 0x4d, 0x8b, 0xd9, 0x41, 0x59, 0x11, 0x2e, 0x87, 0xe3, 0xf3, 0xaf, 0x08, 0xe6, 0xbb,
+	0x9a, 0xa3, 0xed, 0xa3, 0x80, 0x22, 0xc3, 0xdc, 0xf7, 0x7d, 0x17, 0x6f, 0xf6, 0x1c, 0x87, 0x5a,
+	0xde, 0x7d, 0x5f, 0xb2, 0x41, 0xdf, 0xeb, 0x51, 0xd7, 0x53, 0x7a, 0x30, 0x2f, 0x98, 0x73, 0xbb,
+	0xb6, 0xe5, 0x52, 0xf2, 0x03, 0xf8, 0x92, 0xce, 0xc7, 0x9b, 0xcc, 0xfc, 0x9c, 0xf4, 0xaa, 0xb4,
+	0x3c, 0xb5, 0xbe, 0x5a, 0x1f, 0x4c, 0x50, 0x3d, 0x66, 0xe0, 0xb1, 0xe9, 0xed, 0xdd, 0xa3, 0x7d,
+	0x93, 0x27, 0xe3, 0xee, 0x99, 0x4f, 0x9f, 0xbe, 0x72, 0xaa, 0x31, 0xad, 0xc7, 0x3c, 0x28, 0x1f,
+	0x48, 0x50, 0x65, 0x7e, 0x1f, 0xba, 0xfe, 0x00, 0x75, 0x90, 0x87, 0x7c, 0x13, 0x2a, 0x7d, 0xad,
+	0x63, 0x1a, 0x9a, 0x67, 0x3b, 0xcc, 0x5d, 0xe5, 0xee, 0xd2, 0xe7, 0x9f, 0xac, 0x2e, 0x62, 0xf6,
+	0xde, 0x09, 0xe6, 0xb6, 0x0c, 0xc3, 0xa1, 0xae, 0xbb, 0xe3, 0x39, 0xa6, 0xd5, 0x6e, 0x44, 0x3a,
+	0xe4, 0x16, 0x4c, 0xb4, 0x98, 0xc5, 0xb9, 0x31, 0xa6, 0x3d, 0xf7, 0xf9, 0x27, 0xab, 0x55, 0xd4,
+	0x4e, 0x2a, 0xa1, 0x9c, 0x72, 0x1b, 0x66, 0x53, 0x28, 0x18, 0xfe, 0x02, 0x54, 0x4c, 0xb7, 0x89,
+	0xd6, 0x7c, 0x96, 0xc9, 0xc6, 0xa4, 0x89, 0x42, 0x4a, 0x15, 0x08, 0xd3, 0xda, 0x66, 0x99, 0x0e,
+	0xd2, 0xf9, 0x36, 0x9c, 0x4b, 0x8c, 0xa2, 0xa5, 0xaf, 0xc2, 0x04, 0x5f, 0x11, 0xcc, 0xa0, 0x2c,
+	0xca, 0x20, 0xd7, 0xc1, 0x74, 0xa1, 0xbc, 0x72, 0x0b, 0x66, 0xb8, 0x41, 0xc7, 0xd4, 0x69, 0x90,
+	0xa4, 0x05, 0xa8, 0xb8, 0x66, 0xdb, 0xd2, 0x3a, 0x4d, 0xd3, 0xe0, 0x49, 0x6a, 0x4c, 0xf2, 0x81,
+	0x87, 0x86, 0xf2, 0x1d, 0x20, 0x71, 0x0d, 0x24, 0xd8, 0x84, 0xf1, 0xae, 0x3f, 0x80, 0x00, 0xf3,
+	0x42, 0x00, 0x5f, 0x00, 0xfd, 0x73, 0x69, 0x65, 0x23, 0x6e, 0x2c, 0x88, 0x92, 0x2c, 0x02, 0x84,
+	0xfe, 0xfd, 0x90, 0x4e, 0x2f, 0x57, 0x1a, 0x95, 0x00, 0xc0, 0x55, 0xbe, 0x07, 0xe7, 0x12, 0x4a,
+	0x88, 0xf0, 0x15, 0x98, 0x60, 0x46, 0xb9, 0x46, 0x09, 0x06, 0x14, 0x57, 0x9a, 0xb8, 0x40, 0x5b,
+	0x9d, 0x4e, 0x92, 0xe3, 0x3e, 0x40, 0x74, 0x5a, 0x30, 0xb2, 0xab, 0x75, 0x5c, 0x6c, 0xff, 0x68,
+	0xd5, 0xf9, 0xb1, 0x8e, 0x32, 0xdc, 0x0e, 0x72, 0xd8, 0x88, 0x69, 0x2a, 0xbf, 0x96, 0xe0, 0x7c,
+	0xda, 0xc3, 0x09, 0xa1, 0xc9, 0x83, 0x04, 0xdb, 0x18, 0x63, 0xbb, 0x56, 0xc8, 0xc6, 0xbd, 0x26,
+	0xe0, 0xfe, 0x22, 0xe1, 0xf1, 0x65, 0x5e, 0xde, 0x32, 0x5d, 0xcf, 0x76, 0x0e, 0xcb, 0xec, 0x04,
+	0xb6, 0x4c, 0x9e, 0xe6, 0x78, 0x4d, 0xcf, 0xdc, 0xa7, 0x0c, 0xe1, 0x74, 0xa3, 0xc2, 0x46, 0x1e,
+	0x99, 0xfb, 0x94, 0xcc, 0xc3, 0x24, 0xb5, 0x0c, 0x3e, 0x79, 0x9a, 0x4d, 0x9e, 0xa5, 0x96, 0xc1,
+	0xa6, 0x92, 0x89, 0x3d, 0xf3, 0xc2, 0x89, 0xfd, 0x8d, 0x04, 0xf3, 0x02, 0xf6, 0x2f, 0x4c, 0x6e,
+	0x1f, 0xc2, 0xcb, 0x0c, 0xef, 0xd1, 0xe3, 0xad, 0xed, 0x72, 0x9b, 0x9b, 0x9c, 0x87, 0x89, 0x03,
+	0xd3, 0x32, 0xec, 0x03, 0x4c, 0x28, 0xfe, 0x52, 0xbe, 0x0b, 0x33, 0x31, 0x53, 0x27, 0xdd, 0xf2,
+	0x97, 0x60, 0x89, 0x59, 0x6b, 0xd0, 0x16, 0x75, 0xa8, 0xa5, 0xd3, 0x1d, 0xbb, 0xe7, 0xe8, 0xf4,
+	0x4d, 0xdb, 0x6a, 0x99, 0xed, 0xe0, 0x63, 0xf3, 0xa1, 0x04, 0x4a, 0x9e, 0x14, 0x42, 0xb4, 0xe1,
+	0x82, 0x13, 0x08, 0x34, 0x5d, 0x26, 0xd1, 0xd4, 0x99, 0x08, 0x1e, 0x99, 0x15, 0x11, 0x95, 0xd0,
+	0x26, 0x52, 0xce, 0x3a, 0xa2, 0x49, 0x65, 0x13, 0x4f, 0xd1, 0x0e, 0x4f, 0x96, 0xd5, 0xb2, 0x4b,
+	0x7d, 0xb0, 0xde, 0x85, 0x0b, 0x03, 0x6a, 0x88, 0xfe, 0x2d, 0x98, 0x0a, 0xf4, 0xac, 0x96, 0x8d,
+	0xb8, 0x35, 0x11, 0x6e, 0xa4, 0x8c, 0x8c, 0xe0, 0x86, 0x23, 0x8a, 0x36, 0xe0, 0x61, 0xe4, 0x9f,
+	0x90, 0xdf, 0x07, 0xa7, 0x34, 0xe1, 0x03, 0xc3, 0x78, 0x00, 0xd3, 0xb1, 0x30, 0x82, 0xcd, 0x50,
+	0x2e, 0x8e, 0xa9, 0x28, 0x8e, 0x11, 0x6e, 0xfc, 0x60, 0xa9, 0xd8, 0xde, 0x7b, 0xd0, 0xd3, 0x1c,
+	0x63, 0xa8, 0xa5, 0x8a, 0xab, 0x45, 0x4b, 0xc5, 0xf6, 0x6e, 0xb3, 0xed, 0x0f, 0xe7, 0x2d, 0x55,
+	0xa4, 0x1c, 0x2c, 0x55, 0x37, 0x1c, 0x09, 0x97, 0x2a, 0x12, 0xfa, 0xff, 0x2d, 0x55, 0xc2, 0x47,
+	0xb4, 0x54, 0xb1, 0x30, 0x72, 0x97, 0x6a, 0x20, 0x8e, 0xa9, 0x28, 0x8e, 0x11, 0x2e, 0xd5, 0xcf,
+	0x25, 0x58, 0x8c, 0xed, 0xac, 0x47, 0xb6, 0xa7, 0x75, 0xb6, 0xed, 0x03, 0xea, 0x94, 0xbc, 0x8e,
+	0xc9, 0x7d, 0x01, 0xc9, 0x8b, 0xe4, 0xed, 0x8f, 0x12, 0xd4, 0xb2, 0x40, 0x30, 0x7b, 0xdf, 0x86,
+	0x73, 0x48, 0xe2, 0xf9, 0xb3, 0xcd, 0x2e, 0x9b, 0xc6, 0x24, 0xca, 0xd9, 0xfb, 0xbd, 0x31, 0xe3,
+	0xa6, 0x6d, 0x8e, 0x2e, 0x81, 0x3f, 0x04, 0x99, 0x61, 0xb3, 0xb7, 0x63, 0xf8, 0x80, 0x1c, 0xd5,
+	0x83, 0x53, 0xd9, 0x80, 0x05, 0xa1, 0x79, 0x4c, 0x49, 0x15, 0xc6, 0x99, 0x2c, 0x3e, 0x20, 0xf9,
+	0x0f, 0xe5, 0x38, 0xae, 0xe4, 0xcb, 0x27, 0x1f, 0x36, 0x27, 0x7e, 0x05, 0x27, 0xb7, 0xc4, 0x58,
+	0xfa, 0x85, 0xe6, 0xc2, 0x45, 0xb1, 0x7b, 0x84, 0xde, 0x81, 0x97, 0x43, 0x5b, 0xcd, 0xc4, 0x0d,
+	0xa6, 0x88, 0x16, 0x31, 0x69, 0x06, 0x4f, 0xc3, 0x4b, 0xfd, 0xa4, 0x71, 0xe5, 0x5d, 0xdc, 0xc7,
+	0x11, 0xbd, 0xae, 0xf7, 0x1c, 0x4d, 0x3f, 0x1c, 0xd9, 0x52, 0xfc, 0x08, 0x37, 0xa8, 0xc0, 0x03,
+	0x06, 0xf6, 0x04, 0x48, 0x14, 0x98, 0x86, 0xb3, 0xf8, 0x2d, 0xb9, 0x92, 0x1b, 0x5a, 0x60, 0x0a,
+	0xa3, 0x9b, 0xe9, 0xa7, 0x27, 0x14, 0x1d, 0x5e, 0x4d, 0x7a, 0xc7, 0x62, 0xaa, 0x6b, 0x3b, 0xde,
+	0xc8, 0x42, 0xfc, 0xaf, 0x84, 0x2f, 0x03, 0xb1, 0x97, 0x44, 0xe5, 0xa2, 0xe9, 0x9e, 0xd9, 0xa7,
+	0x51, 0xe5, 0xb2, 0xc5, 0x7e, 0x67, 0xe4, 0x60, 0x6c, 0x14, 0x39, 0x20, 0xdb, 0x30, 0xed, 0xeb,
+	0x36, 0x1d, 0xc6, 0xe3, 0xce, 0x9d, 0x66, 0x9b, 0xe6, 0x5a, 0xae, 0x55, 0x3f, 0x00, 0xce, 0x1f,
+	0x7c, 0x47, 0x5b, 0xe1, 0x88, 0xab, 0xdc, 0xc5, 0x27, 0xda, 0x3b, 0xb6, 0x17, 0xd6, 0x3f, 0x75,
+	0x18, 0xef, 0xdb, 0x1e, 0x0d, 0x32, 0x98, 0x5d, 0xe2, 0x71, 0x31, 0xe5, 0x6d, 0x98, 0x89, 0xd9,
+	0xc0, 0x1c, 0xbd, 0x0e, 0x67, 0xf9, 0x81, 0x28, 0xf1, 0x7d, 0x42, 0xb0, 0x40, 0x41, 0x79, 0x82,
+	0x4b, 0xcd, 0x67, 0x7d, 0xb3, 0xf7, 0x68, 0x87, 0xb6, 0xd9, 0xf7, 0x26, 0x80, 0xbc, 0x03, 0x15,
+	0x83, 0x0f, 0xda, 0xc5, 0xa0, 0x91, 0xa8, 0xf2, 0x41, 0xb0, 0xc2, 0x62, 0xe3, 0x48, 0x6f, 0xc0,
+	0x79, 0x3c, 0xe0, 0x7e, 0x88, 0x4d, 0x23, 0x94, 0xc0, 0xcd, 0xbc, 0x9c, 0x1d, 0x4c, 0xd2, 0x22,
+	0x86, 0x56, 0x75, 0x05, 0x73, 0xca, 0xc7, 0x12, 0x2c, 0x07, 0xed, 0x01, 0x1f, 0x4e, 0x64, 0x20,
+	0xfc, 0x68, 0xad, 0xc3, 0x59, 0x9d, 0x8b, 0x15, 0x86, 0x1b, 0x08, 0x8e, 0xec, 0x6e, 0xfa, 0x97,
+	0x04, 0x2b, 0x25, 0x40, 0x31, 0x79, 0x2d, 0xb8, 0x20, 0x4e, 0x5e, 0xb0, 0x15, 0x86, 0xcd, 0xde,
+	0xac, 0x28, 0x7b, 0x23, 0xbc, 0xc2, 0x1e, 0xc3, 0x52, 0x3c, 0x3a, 0xf4, 0x41, 0x0d, 0x76, 0x55,
+	0x9e, 0x20, 0xff, 0x51, 0x09, 0x91, 0x61, 0x39, 0x2a, 0x21, 0x50, 0x23, 0x48, 0x16, 0x35, 0xf8,
+	0xe5, 0x9e, 0x57, 0x42, 0x08, 0x6d, 0x06, 0x19, 0xd3, 0x45, 0x93, 0xeb, 0x7f, 0x5e, 0x80, 0x71,
+	0xc6, 0x43, 0x7e, 0x29, 0xc1, 0x74, 0xbc, 0x29, 0x45, 0x6e, 0x8a, 0x5c, 0x64, 0xf5, 0xb5, 0xe4,
+	0xd5, 0x92, 0xd2, 0x3c, 0x40, 0xe5, 0xf2, 0x4f, 0xff, 0xf1, 0x9f, 0x8f, 0xc6, 0x6a, 0xe4, 0x62,
+	0xaa, 0x8f, 0x96, 0x68, 0x7f, 0x91, 0x8f, 0x24, 0x98, 0x0c, 0xba, 0x44, 0x64, 0x39, 0xd3, 0x43,
+	0xaa, 0xa7, 0x25, 0xaf, 0x94, 0x90, 0x44, 0x8e, 0x75, 0xc6, 0x71, 0x93, 0x5c, 0x17, 0xf4, 0xfb,
+	0xa8, 0xa3, 0x1e, 0x85, 0x5f, 0xdc, 0x63, 0xf5, 0x88, 0x8f, 0x1d, 0x93, 0xf7, 0x61, 0x82, 0xb7,
+	0x8e, 0xc8, 0xd5, 0x4c, 0x47, 0x89, 0x2e, 0x95, 0x7c, 0xad, 0x50, 0x0e, 0x71, 0x16, 0x19, 0xce,
+	0x05, 0x32, 0x2b, 0x6c, 0x2f, 0x92, 0x9f, 0x48, 0x30, 0xce, 0x2e, 0x77, 0x72, 0x25, 0xdb, 0x62,
+	0xac, 0x71, 0x25, 0x5f, 0x2d, 0x12, 0x43, 0xbf, 0x2b, 0xcc, 0xef, 0x25, 0xb2, 0x94, 0xf6, 0xeb,
+	0x4b, 0xb9, 0xea, 0x51, 0xf8, 0xb6, 0xe1, 0xd1, 0xb3, 0x51, 0x52, 0x60, 0xbc, 0x4c, 0xf4, 0x89,
+	0x57, 0x50, 0x76, 0xf4, 0xdc, 0xdf, 0xcf, 0x24, 0xa8, 0x84, 0x0d, 0x23, 0x92, 0xbd, 0xc8, 0xe9,
+	0xb6, 0x95, 0x7c, 0xbd, 0x8c, 0x28, 0x32, 0x2c, 0x31, 0x86, 0x05, 0x32, 0x9f, 0x62, 0xd0, 0x3a,
+	0x1d, 0x7c, 0x98, 0x91, 0xdf, 0x4a, 0x30, 0x1d, 0xef, 0xaf, 0xe4, 0x9c, 0x14, 0x41, 0x0b, 0x49,
+	0x5e, 0x2d, 0x29, 0x8d, 0x40, 0x6b, 0x0c, 0xe8, 0x06, 0x59, 0x29, 0x5c, 0x1a, 0x75, 0x0f, 0x79,
+	0xba, 0x70, 0xc6, 0xef, 0x8a, 0x90, 0xcb, 0x99, 0x9e, 0x62, 0xfd, 0x17, 0xf9, 0x4a, 0x81, 0x14,
+	0x72, 0x2c, 0x30, 0x8e, 0x59, 0x72, 0x2e, 0xc5, 0xe1, 0x1d, 0x68, 0x5d, 0xf2, 0x27, 0x09, 0x66,
+	0x85, 0x0d, 0x0c, 0xb2, 0x99, 0x69, 0x3d, 0xaf, 0xd5, 0x22, 0xdf, 0x19, 0x56, 0x0d, 0x29, 0xeb,
+	0x8c, 0x72, 0x99, 0x5c, 0x4d, 0x51, 0x66, 0x34, 0x64, 0xc8, 0xaf, 0x24, 0x80, 0xa8, 0x05, 0x40,
+	0xb2, 0x77, 0xca, 0x40, 0x8f, 0x45, 0xbe, 0x51, 0x4a, 0x16, 0xb9, 0x54, 0xc6, 0xb5, 0x42, 0xae,
+	0xa5, 0xb8, 0xe2, 0x6d, 0x8a, 0xc4, 0x31, 0xfb, 0x50, 0x82, 0xa9, 0x9d, 0x58, 0x27, 0xa2, 0x8c,
+	0xb7, 0x70, 0xc3, 0xdf, 0x2c, 0x27, 0x8c, 0x6c, 0x97, 0x18, 0xdb, 0x22, 0x59, 0xc8, 0x61, 0x63,
+	0x89, 0x8a, 0x0a, 0xf0, 0x9c, 0x44, 0x0d, 0x74, 0x38, 0xe4, 0x1b, 0xa5, 0x64, 0x0b, 0x12, 0x15,
+	0x6f, 0x12, 0x0c, 0x24, 0x6a, 0x3b, 0xd6, 0x07, 0x28, 0xe3, 0xad, 0x44, 0xa2, 0x04, 0xbd, 0x8a,
+	0xcc, 0x44, 0xc5, 0xd9, 0xc8, 0xc7, 0x12, 0xcc, 0x0c, 0x14, 0xec, 0x64, 0xad, 0x60, 0x45, 0x06,
+	0xbb, 0x0c, 0xf2, 0xfa, 0x30, 0x2a, 0x48, 0x78, 0x9d, 0x11, 0x5e, 0x26, 0x8a, 0x78, 0x29, 0xe3,
+	0x4d, 0x02, 0x1f, 0xf4, 0xcb, 0xc9, 0x1a, 0x9a, 0xd4, 0x33, 0x5d, 0x0a, 0x6b, 0x79, 0x59, 0x2d,
+	0x2d, 0x8f, 0x7c, 0x1b, 0x8c, 0x6f, 0x95, 0xdc, 0x48, 0xf1, 0x85, 0xd7, 0xac, 0x9b, 0xb8, 0x72,
+	0xd9, 0x9f, 0xe4, 0x0f, 0x12, 0xcc, 0x0c, 0x94, 0x44, 0x39, 0x19, 0xcd, 0xaa, 0x77, 0xe5, 0xf5,
+	0x61, 0x54, 0x90, 0xf8, 0x0e, 0x23, 0xbe, 0x45, 0xea, 0xe5, 0x88, 0x83, 0xf2, 0x8e, 0xfc, 0x55,
+	0x82, 0xaa, 0xa8, 0x64, 0x24, 0xb7, 0x8b, 0x21, 0x06, 0xeb, 0x58, 0x79, 0x73, 0x48, 0x2d, 0xa4,
+	0x7f, 0x9d, 0xd1, 0xdf, 0x26, 0xeb, 0xe5, 0xe8, 0x99, 0x10, 0xd6, 0x92, 0xe4, 0x77, 0x12, 0xbc,
+	0x94, 0xea, 0x57, 0x10, 0xb5, 0x18, 0x23, 0x79, 0xf5, 0xde, 0x2a, 0xaf, 0x80, 0xc8, 0xb7, 0x19,
+	0x72, 0x9d, 0xdc, 0x2c, 0x87, 0x8c, 0x77, 0xf2, 0x8f, 0xe1, 0x8c, 0x5f, 0x0c, 0xe4, 0x5c, 0x79,
+	0xb1, 0x7a, 0x56, 0xbe, 0x52, 0x20, 0x55, 0x70, 0x9a, 0x58, 0x91, 0xeb, 0x63, 0xf8, 0xff, 0x1e,
+	0xb3, 0x9f, 0xe4, 0xef, 0x12, 0x54, 0x45, 0x05, 0x4b, 0xce, 0x7a, 0xe7, 0x14, 0xb3, 0xf2, 0xe6,
+	0x90, 0x5a, 0x48, 0x7c, 0x8f, 0x11, 0x7f, 0x83, 0xbc, 0x91, 0x22, 0x0e, 0xab, 0x5d, 0x57, 0x3d,
+	0x0a, 0xff, 0x3e, 0x56, 0xc5, 0x45, 0x19, 0x79, 0x2a, 0xc1, 0xc5, 0xbc, 0xba, 0x8e, 0xbc, 0x91,
+	0xf7, 0xd8, 0x2f, 0xaa, 0x5b, 0xe5, 0xaf, 0xbf, 0xa0, 0x36, 0xc6, 0xb8, 0xc5, 0x62, 0xfc, 0x1a,
+	0x79, 0x6d, 0xb0, 0x74, 0xc0, 0x08, 0xf1, 0xaf, 0xac, 0xf8, 0x5c, 0xf2, 0x37, 0x09, 0x66, 0x85,
+	0xc5, 0x52, 0xce, 0x73, 0x25, 0xaf, 0x14, 0x94, 0xef, 0x0c, 0xab, 0x86, 0xb1, 0xbc, 0xc6, 0x62,
+	0xd9, 0x20, 0x6b, 0xc5, 0xb1, 0xa4, 0xea, 0xc0, 0xbb, 0x6f, 0x7d, 0xfa, 0xac, 0x26, 0x7d, 0xf6,
+	0xac, 0x26, 0xfd, 0xfb, 0x59, 0x4d, 0xfa, 0xc5, 0xf3, 0xda, 0xa9, 0xcf, 0x9e, 0xd7, 0x4e, 0xfd,
+	0xf3, 0x79, 0xed, 0xd4, 0x93, 0x7a, 0xdb, 0xf4, 0xf6, 0x7a, 0xbb, 0x75, 0xdd, 0xde, 0x57, 0x7d,
+	0x2c, 0xf6, 0x9f, 0x12, 0x74, 0xbb, 0xa3, 0xea, 0x7b, 0x9a, 0x69, 0xa9, 0xfd, 0x0d, 0xf5, 0x7d,
+	0x74, 0xe7, 0x1d, 0x76, 0xa9, 0xbb, 0x3b, 0xc1, 0x04, 0x36, 0xfe, 0x37, 0x00, 0x37, 0x90, 0x96,
+	0x33, 0xa7, 0x21, 0x00, 0x00,
 }
 
-func (m *QueryVoteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// CurrentFeeds is an RPC method that returns a list of current supported feeds.
+	CurrentFeeds(ctx context.Context, in *QueryCurrentFeedsRequest, opts ...grpc.CallOption) (*QueryCurrentFeedsResponse, error)
+	// IsFeeder is an RPC method that returns whether an account is a feeder for a specified validator.
+	IsFeeder(ctx context.Context, in *QueryIsFeederRequest, opts ...grpc.CallOption) (*QueryIsFeederResponse, error)
+	// Params is an RPC method that returns all parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Price is an RPC method that returns price by signal id.
+	Price(ctx context.Context, in *QueryPriceRequest, opts ...grpc.CallOption) (*QueryPriceResponse, error)
+	// Prices is an RPC method that prices by signal ids.
+	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
+	// AllPrices is an RPC method that returns all prices.
+	AllPrices(ctx context.Context, in *QueryAllPricesRequest, opts ...grpc.CallOption) (*QueryAllPricesResponse, error)
+	// PriceHistory is an RPC method that returns the price history of a signal id within a time range.
+	PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error)
+	// TWAP is an RPC method that returns the time-weighted average prices of signal ids over a time window.
+	TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error)
+	// ReferenceSourceConfig is an RPC method that returns information on the reference price source.
+	ReferenceSourceConfig(ctx context.Context, in *QueryReferenceSourceConfigRequest, opts ...grpc.CallOption) (*QueryReferenceSourceConfigResponse, error)
+	// SignalInfo is an RPC method that returns the registered metadata of a signal id.
+	SignalInfo(ctx context.Context, in *QuerySignalInfoRequest, opts ...grpc.CallOption) (*QuerySignalInfoResponse, error)
+	// SignalInfos is an RPC method that returns the registered metadata of all signal ids.
+	SignalInfos(ctx context.Context, in *QuerySignalInfosRequest, opts ...grpc.CallOption) (*QuerySignalInfosResponse, error)
+	// PriceGuard is an RPC method that returns the price guard of a signal id.
+	PriceGuard(ctx context.Context, in *QueryPriceGuardRequest, opts ...grpc.CallOption) (*QueryPriceGuardResponse, error)
+	// PriceGuards is an RPC method that returns the price guards of all signal ids.
+	PriceGuards(ctx context.Context, in *QueryPriceGuardsRequest, opts ...grpc.CallOption) (*QueryPriceGuardsResponse, error)
+	// SignalTotalPowers is an RPC method that returns all signal-total-powers or specified signal-total-power by signal
+	// id.
+	SignalTotalPowers(ctx context.Context, in *QuerySignalTotalPowersRequest, opts ...grpc.CallOption) (*QuerySignalTotalPowersResponse, error)
+	// ValidValidator is an RPC method that returns a flag to show if the validator is required to send prices.
+	ValidValidator(ctx context.Context, in *QueryValidValidatorRequest, opts ...grpc.CallOption) (*QueryValidValidatorResponse, error)
+	// ValidatorAccuracy is an RPC method that returns the accuracy of prices submitted by a validator.
+	ValidatorAccuracy(ctx context.Context, in *QueryValidatorAccuracyRequest, opts ...grpc.CallOption) (*QueryValidatorAccuracyResponse, error)
+	// ValidatorFeedsReport is an RPC method that returns the price submission report of a validator for the current
+	// feeds.
+	ValidatorFeedsReport(ctx context.Context, in *QueryValidatorFeedsReportRequest, opts ...grpc.CallOption) (*QueryValidatorFeedsReportResponse, error)
+	// ValidatorPrices is an RPC method that returns prices of a validator.
+	ValidatorPrices(ctx context.Context, in *QueryValidatorPricesRequest, opts ...grpc.CallOption) (*QueryValidatorPricesResponse, error)
+	// Vote is an RPC method that returns signals of a voter.
+	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
+	// SignalVoteDelegation is an RPC method that returns the voting power delegation of a delegator.
+	SignalVoteDelegation(ctx context.Context, in *QuerySignalVoteDelegationRequest, opts ...grpc.CallOption) (*QuerySignalVoteDelegationResponse, error)
+	// CuratorSignalVoteDelegations is an RPC method that returns the voting power delegations to a curator.
+	CuratorSignalVoteDelegations(ctx context.Context, in *QueryCuratorSignalVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryCuratorSignalVoteDelegationsResponse, error)
+	// CuratorDelegatedPower is an RPC method that returns the total power delegated to a curator.
+	CuratorDelegatedPower(ctx context.Context, in *QueryCuratorDelegatedPowerRequest, opts ...grpc.CallOption) (*QueryCuratorDelegatedPowerResponse, error)
 }
 
-func (m *QueryVoteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryVoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CurrentFeeds(ctx context.Context, in *QueryCurrentFeedsRequest, opts ...grpc.CallOption) (*QueryCurrentFeedsResponse, error) {
+	out := new(QueryCurrentFeedsResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/CurrentFeeds", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryVoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IsFeeder(ctx context.Context, in *QueryIsFeederRequest, opts ...grpc.CallOption) (*QueryIsFeederResponse, error) {
+	out := new(QueryIsFeederResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/IsFeeder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signals) > 0 {
-		for iNdEx := len(m.Signals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) Price(ctx context.Context, in *QueryPriceRequest, opts ...grpc.CallOption) (*QueryPriceResponse, error) {
+	out := new(QueryPriceResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/Price", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryCurrentFeedsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error) {
+	out := new(QueryPricesResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/Prices", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryCurrentFeedsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) AllPrices(ctx context.Context, in *QueryAllPricesRequest, opts ...grpc.CallOption) (*QueryAllPricesResponse, error) {
+	out := new(QueryAllPricesResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/AllPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.CurrentFeeds.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryIsFeederRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Feeder)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error) {
+	out := new(QueryPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/PriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryIsFeederResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error) {
+	out := new(QueryTWAPResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/TWAP", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.IsFeeder {
-		n += 2
+	return out, nil
+}
+
+func (c *queryClient) ReferenceSourceConfig(ctx context.Context, in *QueryReferenceSourceConfigRequest, opts ...grpc.CallOption) (*QueryReferenceSourceConfigResponse, error) {
+	out := new(QueryReferenceSourceConfigResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/ReferenceSourceConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) SignalInfo(ctx context.Context, in *QuerySignalInfoRequest, opts ...grpc.CallOption) (*QuerySignalInfoResponse, error) {
+	out := new(QuerySignalInfoResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/SignalInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) SignalInfos(ctx context.Context, in *QuerySignalInfosRequest, opts ...grpc.CallOption) (*QuerySignalInfosResponse, error) {
+	out := new(QuerySignalInfosResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/SignalInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) PriceGuard(ctx context.Context, in *QueryPriceGuardRequest, opts ...grpc.CallOption) (*QueryPriceGuardResponse, error) {
+	out := new(QueryPriceGuardResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/PriceGuard", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.SignalId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return out, nil
 }
 
-func (m *QueryPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) PriceGuards(ctx context.Context, in *QueryPriceGuardsRequest, opts ...grpc.CallOption) (*QueryPriceGuardsResponse, error) {
+	out := new(QueryPriceGuardsResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/PriceGuards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SignalIds) > 0 {
-		for _, s := range m.SignalIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) SignalTotalPowers(ctx context.Context, in *QuerySignalTotalPowersRequest, opts ...grpc.CallOption) (*QuerySignalTotalPowersResponse, error) {
+	out := new(QuerySignalTotalPowersResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/SignalTotalPowers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for _, e := range m.Prices {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) ValidValidator(ctx context.Context, in *QueryValidValidatorRequest, opts ...grpc.CallOption) (*QueryValidValidatorResponse, error) {
+	out := new(QueryValidValidatorResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/ValidValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) ValidatorAccuracy(ctx context.Context, in *QueryValidatorAccuracyRequest, opts ...grpc.CallOption) (*QueryValidatorAccuracyResponse, error) {
+	out := new(QueryValidatorAccuracyResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/ValidatorAccuracy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for _, e := range m.Prices {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) ValidatorFeedsReport(ctx context.Context, in *QueryValidatorFeedsReportRequest, opts ...grpc.CallOption) (*QueryValidatorFeedsReportResponse, error) {
+	out := new(QueryValidatorFeedsReportResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/ValidatorFeedsReport", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryReferenceSourceConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ValidatorPrices(ctx context.Context, in *QueryValidatorPricesRequest, opts ...grpc.CallOption) (*QueryValidatorPricesResponse, error) {
+	out := new(QueryValidatorPricesResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/ValidatorPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryReferenceSourceConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error) {
+	out := new(QueryVoteResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/Vote", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ReferenceSourceConfig.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QuerySignalTotalPowersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SignalIds) > 0 {
-		for _, s := range m.SignalIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) SignalVoteDelegation(ctx context.Context, in *QuerySignalVoteDelegationRequest, opts ...grpc.CallOption) (*QuerySignalVoteDelegationResponse, error) {
+	out := new(QuerySignalVoteDelegationResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/SignalVoteDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QuerySignalTotalPowersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SignalTotalPowers) > 0 {
-		for _, e := range m.SignalTotalPowers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) CuratorSignalVoteDelegations(ctx context.Context, in *QueryCuratorSignalVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryCuratorSignalVoteDelegationsResponse, error) {
+	out := new(QueryCuratorSignalVoteDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/CuratorSignalVoteDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryValidValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) CuratorDelegatedPower(ctx context.Context, in *QueryCuratorDelegatedPowerRequest, opts ...grpc.CallOption) (*QueryCuratorDelegatedPowerResponse, error) {
+	out := new(QueryCuratorDelegatedPowerResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/CuratorDelegatedPower", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryValidValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Valid {
-		n += 2
-	}
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// CurrentFeeds is an RPC method that returns a list of current supported feeds.
+	CurrentFeeds(context.Context, *QueryCurrentFeedsRequest) (*QueryCurrentFeedsResponse, error)
+	// IsFeeder is an RPC method that returns whether an account is a feeder for a specified validator.
+	IsFeeder(context.Context, *QueryIsFeederRequest) (*QueryIsFeederResponse, error)
+	// Params is an RPC method that returns all parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Price is an RPC method that returns price by signal id.
+	Price(context.Context, *QueryPriceRequest) (*QueryPriceResponse, error)
+	// Prices is an RPC method that prices by signal ids.
+	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
+	// AllPrices is an RPC method that returns all prices.
+	AllPrices(context.Context, *QueryAllPricesRequest) (*QueryAllPricesResponse, error)
+	// PriceHistory is an RPC method that returns the price history of a signal id within a time range.
+	PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error)
+	// TWAP is an RPC method that returns the time-weighted average prices of signal ids over a time window.
+	TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error)
+	// ReferenceSourceConfig is an RPC method that returns information on the reference price source.
+	ReferenceSourceConfig(context.Context, *QueryReferenceSourceConfigRequest) (*QueryReferenceSourceConfigResponse, error)
+	// SignalInfo is an RPC method that returns the registered metadata of a signal id.
+	SignalInfo(context.Context, *QuerySignalInfoRequest) (*QuerySignalInfoResponse, error)
+	// SignalInfos is an RPC method that returns the registered metadata of all signal ids.
+	SignalInfos(context.Context, *QuerySignalInfosRequest) (*QuerySignalInfosResponse, error)
+	// PriceGuard is an RPC method that returns the price guard of a signal id.
+	PriceGuard(context.Context, *QueryPriceGuardRequest) (*QueryPriceGuardResponse, error)
+	// PriceGuards is an RPC method that returns the price guards of all signal ids.
+	PriceGuards(context.Context, *QueryPriceGuardsRequest) (*QueryPriceGuardsResponse, error)
+	// SignalTotalPowers is an RPC method that returns all signal-total-powers or specified signal-total-power by signal
+	// id.
+	SignalTotalPowers(context.Context, *QuerySignalTotalPowersRequest) (*QuerySignalTotalPowersResponse, error)
+	// ValidValidator is an RPC method that returns a flag to show if the validator is required to send prices.
+	ValidValidator(context.Context, *QueryValidValidatorRequest) (*QueryValidValidatorResponse, error)
+	// ValidatorAccuracy is an RPC method that returns the accuracy of prices submitted by a validator.
+	ValidatorAccuracy(context.Context, *QueryValidatorAccuracyRequest) (*QueryValidatorAccuracyResponse, error)
+	// ValidatorFeedsReport is an RPC method that returns the price submission report of a validator for the current
+	// feeds.
+	ValidatorFeedsReport(context.Context, *QueryValidatorFeedsReportRequest) (*QueryValidatorFeedsReportResponse, error)
+	// ValidatorPrices is an RPC method that returns prices of a validator.
+	ValidatorPrices(context.Context, *QueryValidatorPricesRequest) (*QueryValidatorPricesResponse, error)
+	// Vote is an RPC method that returns signals of a voter.
+	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
+	// SignalVoteDelegation is an RPC method that returns the voting power delegation of a delegator.
+	SignalVoteDelegation(context.Context, *QuerySignalVoteDelegationRequest) (*QuerySignalVoteDelegationResponse, error)
+	// CuratorSignalVoteDelegations is an RPC method that returns the voting power delegations to a curator.
+	CuratorSignalVoteDelegations(context.Context, *QueryCuratorSignalVoteDelegationsRequest) (*QueryCuratorSignalVoteDelegationsResponse, error)
+	// CuratorDelegatedPower is an RPC method that returns the total power delegated to a curator.
+	CuratorDelegatedPower(context.Context, *QueryCuratorDelegatedPowerRequest) (*QueryCuratorDelegatedPowerResponse, error)
 }
 
-func (m *QueryValidatorPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.SignalIds) > 0 {
-		for _, s := range m.SignalIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryValidatorPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ValidatorPrices) > 0 {
-		for _, e := range m.ValidatorPrices {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (*UnimplementedQueryServer) CurrentFeeds(ctx context.Context, req *QueryCurrentFeedsRequest) (*QueryCurrentFeedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentFeeds not implemented")
 }
-
-func (m *QueryVoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) IsFeeder(ctx context.Context, req *QueryIsFeederRequest) (*QueryIsFeederResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsFeeder not implemented")
 }
-
-func (m *QueryVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Signals) > 0 {
-		for _, e := range m.Signals {
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Price(ctx context.Context, req *QueryPriceRequest) (*QueryPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Price not implemented")
+}
+func (*UnimplementedQueryServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
+}
+func (*UnimplementedQueryServer) AllPrices(ctx context.Context, req *QueryAllPricesRequest) (*QueryAllPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllPrices not implemented")
+}
+func (*UnimplementedQueryServer) PriceHistory(ctx context.Context, req *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceHistory not implemented")
+}
+func (*UnimplementedQueryServer) TWAP(ctx context.Context, req *QueryTWAPRequest) (*QueryTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TWAP not implemented")
+}
+func (*UnimplementedQueryServer) ReferenceSourceConfig(ctx context.Context, req *QueryReferenceSourceConfigRequest) (*QueryReferenceSourceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReferenceSourceConfig not implemented")
+}
+func (*UnimplementedQueryServer) SignalInfo(ctx context.Context, req *QuerySignalInfoRequest) (*QuerySignalInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalInfo not implemented")
+}
+func (*UnimplementedQueryServer) SignalInfos(ctx context.Context, req *QuerySignalInfosRequest) (*QuerySignalInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalInfos not implemented")
+}
+func (*UnimplementedQueryServer) PriceGuard(ctx context.Context, req *QueryPriceGuardRequest) (*QueryPriceGuardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceGuard not implemented")
+}
+func (*UnimplementedQueryServer) PriceGuards(ctx context.Context, req *QueryPriceGuardsRequest) (*QueryPriceGuardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceGuards not implemented")
+}
+func (*UnimplementedQueryServer) SignalTotalPowers(ctx context.Context, req *QuerySignalTotalPowersRequest) (*QuerySignalTotalPowersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalTotalPowers not implemented")
+}
+func (*UnimplementedQueryServer) ValidValidator(ctx context.Context, req *QueryValidValidatorRequest) (*QueryValidValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidValidator not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorAccuracy(ctx context.Context, req *QueryValidatorAccuracyRequest) (*QueryValidatorAccuracyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorAccuracy not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorFeedsReport(ctx context.Context, req *QueryValidatorFeedsReportRequest) (*QueryValidatorFeedsReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorFeedsReport not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorPrices(ctx context.Context, req *QueryValidatorPricesRequest) (*QueryValidatorPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPrices not implemented")
+}
+func (*UnimplementedQueryServer) Vote(ctx context.Context, req *QueryVoteRequest) (*QueryVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+}
+func (*UnimplementedQueryServer) SignalVoteDelegation(ctx context.Context, req *QuerySignalVoteDelegationRequest) (*QuerySignalVoteDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalVoteDelegation not implemented")
+}
+func (*UnimplementedQueryServer) CuratorSignalVoteDelegations(ctx context.Context, req *QueryCuratorSignalVoteDelegationsRequest) (*QueryCuratorSignalVoteDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CuratorSignalVoteDelegations not implemented")
+}
+func (*UnimplementedQueryServer) CuratorDelegatedPower(ctx context.Context, req *QueryCuratorDelegatedPowerRequest) (*QueryCuratorDelegatedPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CuratorDelegatedPower not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_CurrentFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentFeedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentFeeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/CurrentFeeds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentFeeds(ctx, req.(*QueryCurrentFeedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsFeeder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsFeederRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsFeeder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/IsFeeder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsFeeder(ctx, req.(*QueryIsFeederRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Price_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Price(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/Price",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Price(ctx, req.(*QueryPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Prices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Prices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/Prices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Prices(ctx, req.(*QueryPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/AllPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllPrices(ctx, req.(*QueryAllPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/PriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceHistory(ctx, req.(*QueryPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/TWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TWAP(ctx, req.(*QueryTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReferenceSourceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReferenceSourceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReferenceSourceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/ReferenceSourceConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReferenceSourceConfig(ctx, req.(*QueryReferenceSourceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignalInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignalInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/SignalInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignalInfo(ctx, req.(*QuerySignalInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignalInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignalInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignalInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/SignalInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignalInfos(ctx, req.(*QuerySignalInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceGuard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceGuardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceGuard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/PriceGuard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceGuard(ctx, req.(*QueryPriceGuardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceGuards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceGuardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceGuards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/PriceGuards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceGuards(ctx, req.(*QueryPriceGuardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignalTotalPowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignalTotalPowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignalTotalPowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/SignalTotalPowers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignalTotalPowers(ctx, req.(*QuerySignalTotalPowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/ValidValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidValidator(ctx, req.(*QueryValidValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorAccuracy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorAccuracyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorAccuracy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/ValidatorAccuracy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorAccuracy(ctx, req.(*QueryValidatorAccuracyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorFeedsReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorFeedsReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorFeedsReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/ValidatorFeedsReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorFeedsReport(ctx, req.(*QueryValidatorFeedsReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/ValidatorPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPrices(ctx, req.(*QueryValidatorPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Vote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/Vote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Vote(ctx, req.(*QueryVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignalVoteDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignalVoteDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignalVoteDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/SignalVoteDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignalVoteDelegation(ctx, req.(*QuerySignalVoteDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CuratorSignalVoteDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCuratorSignalVoteDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CuratorSignalVoteDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/CuratorSignalVoteDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CuratorSignalVoteDelegations(ctx, req.(*QueryCuratorSignalVoteDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CuratorDelegatedPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCuratorDelegatedPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CuratorDelegatedPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/CuratorDelegatedPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CuratorDelegatedPower(ctx, req.(*QueryCuratorDelegatedPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "band.feeds.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CurrentFeeds",
+			Handler:    _Query_CurrentFeeds_Handler,
+		},
+		{
+			MethodName: "IsFeeder",
+			Handler:    _Query_IsFeeder_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Price",
+			Handler:    _Query_Price_Handler,
+		},
+		{
+			MethodName: "Prices",
+			Handler:    _Query_Prices_Handler,
+		},
+		{
+			MethodName: "AllPrices",
+			Handler:    _Query_AllPrices_Handler,
+		},
+		{
+			MethodName: "PriceHistory",
+			Handler:    _Query_PriceHistory_Handler,
+		},
+		{
+			MethodName: "TWAP",
+			Handler:    _Query_TWAP_Handler,
+		},
+		{
+			MethodName: "ReferenceSourceConfig",
+			Handler:    _Query_ReferenceSourceConfig_Handler,
+		},
+		{
+			MethodName: "SignalInfo",
+			Handler:    _Query_SignalInfo_Handler,
+		},
+		{
+			MethodName: "SignalInfos",
+			Handler:    _Query_SignalInfos_Handler,
+		},
+		{
+			MethodName: "PriceGuard",
+			Handler:    _Query_PriceGuard_Handler,
+		},
+		{
+			MethodName: "PriceGuards",
+			Handler:    _Query_PriceGuards_Handler,
+		},
+		{
+			MethodName: "SignalTotalPowers",
+			Handler:    _Query_SignalTotalPowers_Handler,
+		},
+		{
+			MethodName: "ValidValidator",
+			Handler:    _Query_ValidValidator_Handler,
+		},
+		{
+			MethodName: "ValidatorAccuracy",
+			Handler:    _Query_ValidatorAccuracy_Handler,
+		},
+		{
+			MethodName: "ValidatorFeedsReport",
+			Handler:    _Query_ValidatorFeedsReport_Handler,
+		},
+		{
+			MethodName: "ValidatorPrices",
+			Handler:    _Query_ValidatorPrices_Handler,
+		},
+		{
+			MethodName: "Vote",
+			Handler:    _Query_Vote_Handler,
+		},
+		{
+			MethodName: "SignalVoteDelegation",
+			Handler:    _Query_SignalVoteDelegation_Handler,
+		},
+		{
+			MethodName: "CuratorSignalVoteDelegations",
+			Handler:    _Query_CuratorSignalVoteDelegations_Handler,
+		},
+		{
+			MethodName: "CuratorDelegatedPower",
+			Handler:    _Query_CuratorDelegatedPower_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "band/feeds/v1beta1/query.proto",
+}
+
+func (m *QueryCurrentFeedsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentFeedsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentFeedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentFeedsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentFeedsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentFeedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrentFeeds.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsFeederRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsFeederRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsFeederRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsFeederResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsFeederResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsFeederResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsFeeder {
+		i--
+		if m.IsFeeder {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignalId) > 0 {
+		i -= len(m.SignalId)
+		copy(dAtA[i:], m.SignalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignalIds) > 0 {
+		for iNdEx := len(m.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignalIds[iNdEx])
+			copy(dAtA[i:], m.SignalIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalId) > 0 {
+		i -= len(m.SignalId)
+		copy(dAtA[i:], m.SignalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTWAPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalIds) > 0 {
+		for iNdEx := len(m.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignalIds[iNdEx])
+			copy(dAtA[i:], m.SignalIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTWAPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReferenceSourceConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReferenceSourceConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReferenceSourceConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReferenceSourceConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReferenceSourceConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReferenceSourceConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ReferenceSourceConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignalInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignalInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignalId) > 0 {
+		i -= len(m.SignalId)
+		copy(dAtA[i:], m.SignalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignalInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignalInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SignalInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignalInfosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignalInfosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignalInfosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignalInfosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignalInfos) > 0 {
+		for iNdEx := len(m.SignalInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceGuardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceGuardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceGuardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignalId) > 0 {
+		i -= len(m.SignalId)
+		copy(dAtA[i:], m.SignalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceGuardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceGuardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceGuardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PriceGuard.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceGuardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceGuardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceGuardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceGuardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceGuardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceGuardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PriceGuards) > 0 {
+		for iNdEx := len(m.PriceGuards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceGuards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignalTotalPowersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignalTotalPowersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalTotalPowersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignalIds) > 0 {
+		for iNdEx := len(m.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignalIds[iNdEx])
+			copy(dAtA[i:], m.SignalIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignalTotalPowersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignalTotalPowersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalTotalPowersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignalTotalPowers) > 0 {
+		for iNdEx := len(m.SignalTotalPowers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalTotalPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignalIds) > 0 {
+		for iNdEx := len(m.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignalIds[iNdEx])
+			copy(dAtA[i:], m.SignalIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorPrices) > 0 {
+		for iNdEx := len(m.ValidatorPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorAccuracyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorAccuracyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorAccuracyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorAccuracyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorAccuracyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorAccuracyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ValidatorAccuracy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorFeedsReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorFeedsReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorFeedsReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorFeedsReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorFeedsReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorFeedsReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeedReports) > 0 {
+		for iNdEx := len(m.FeedReports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeedReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.ValidatorAccuracy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signals) > 0 {
+		for iNdEx := len(m.Signals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignalVoteDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignalVoteDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalVoteDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignalVoteDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignalVoteDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalVoteDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SignalVoteDelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCuratorSignalVoteDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCuratorSignalVoteDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCuratorSignalVoteDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCuratorSignalVoteDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCuratorSignalVoteDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCuratorSignalVoteDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignalVoteDelegations) > 0 {
+		for iNdEx := len(m.SignalVoteDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalVoteDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCuratorDelegatedPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCuratorDelegatedPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCuratorDelegatedPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCuratorDelegatedPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCuratorDelegatedPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCuratorDelegatedPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CuratorDelegatedPower.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCurrentFeedsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentFeedsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrentFeeds.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIsFeederRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsFeederResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsFeeder {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignalIds) > 0 {
+		for _, s := range m.SignalIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTWAPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignalIds) > 0 {
+		for _, s := range m.SignalIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *QueryTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryReferenceSourceConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryReferenceSourceConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReferenceSourceConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySignalInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignalInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SignalInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySignalInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignalInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignalInfos) > 0 {
+		for _, e := range m.SignalInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceGuardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceGuardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PriceGuard.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPriceGuardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceGuardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceGuards) > 0 {
+		for _, e := range m.PriceGuards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignalTotalPowersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignalIds) > 0 {
+		for _, s := range m.SignalIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignalTotalPowersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignalTotalPowers) > 0 {
+		for _, e := range m.SignalTotalPowers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryValidatorPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SignalIds) > 0 {
+		for _, s := range m.SignalIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorPrices) > 0 {
+		for _, e := range m.ValidatorPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorAccuracyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorAccuracyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValidatorAccuracy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorFeedsReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorFeedsReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsActive {
+		n += 2
+	}
+	l = m.ValidatorAccuracy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.FeedReports) > 0 {
+		for _, e := range m.FeedReports {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signals) > 0 {
+		for _, e := range m.Signals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySignalVoteDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignalVoteDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SignalVoteDelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCuratorSignalVoteDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCuratorSignalVoteDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignalVoteDelegations) > 0 {
+		for _, e := range m.SignalVoteDelegations {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCuratorDelegatedPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCuratorDelegatedPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CuratorDelegatedPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryCurrentFeedsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentFeedsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentFeedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentFeedsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentFeedsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentFeedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentFeeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentFeeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsFeederRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsFeederRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsFeederRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsFeederResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsFeederResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsFeederResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFeeder", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFeeder = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalIds = append(m.SignalIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTWAPRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTWAPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalIds = append(m.SignalIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTWAPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTWAPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReferenceSourceConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReferenceSourceConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReferenceSourceConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReferenceSourceConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReferenceSourceConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReferenceSourceConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceSourceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferenceSourceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignalInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignalInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignalInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignalInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignalInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignalInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignalInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignalInfosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignalInfosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignalInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignalInfosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignalInfosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignalInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalInfos = append(m.SignalInfos, SignalInfo{})
+			if err := m.SignalInfos[len(m.SignalInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryCurrentFeedsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceGuardRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2722,12 +7451,44 @@ func (m *QueryCurrentFeedsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentFeedsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceGuardRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentFeedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceGuardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2749,7 +7510,7 @@ func (m *QueryCurrentFeedsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentFeedsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceGuardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2772,15 +7533,15 @@ func (m *QueryCurrentFeedsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentFeedsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceGuardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentFeedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceGuardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentFeeds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceGuard", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2807,7 +7568,7 @@ func (m *QueryCurrentFeedsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentFeeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceGuard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2832,7 +7593,7 @@ func (m *QueryCurrentFeedsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsFeederRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceGuardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2855,17 +7616,17 @@ func (m *QueryIsFeederRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsFeederRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceGuardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsFeederRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceGuardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2875,55 +7636,27 @@ func (m *QueryIsFeederRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Feeder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2946,7 +7679,7 @@ func (m *QueryIsFeederRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsFeederResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceGuardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2969,17 +7702,17 @@ func (m *QueryIsFeederResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsFeederResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceGuardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsFeederResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceGuardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFeeder", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceGuards", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2989,12 +7722,62 @@ func (m *QueryIsFeederResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsFeeder = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceGuards = append(m.PriceGuards, PriceGuard{})
+			if err := m.PriceGuards[len(m.PriceGuards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3016,7 +7799,7 @@ func (m *QueryIsFeederResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySignalTotalPowersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3035,16 +7818,84 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignalTotalPowersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignalTotalPowersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalIds = append(m.SignalIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3066,7 +7917,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySignalTotalPowersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3089,15 +7940,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignalTotalPowersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignalTotalPowersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalTotalPowers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3124,7 +7975,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SignalTotalPowers = append(m.SignalTotalPowers, &Signal{})
+			if err := m.SignalTotalPowers[len(m.SignalTotalPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3149,7 +8037,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidValidatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3172,15 +8060,15 @@ func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidValidatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3208,7 +8096,7 @@ func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalId = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3231,7 +8119,7 @@ func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidValidatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3254,17 +8142,17 @@ func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidValidatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3274,25 +8162,12 @@ func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Valid = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3314,7 +8189,7 @@ func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3337,13 +8212,45 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorPricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
 			}
@@ -3396,7 +8303,7 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3419,15 +8326,15 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3454,8 +8361,8 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, Price{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorPrices = append(m.ValidatorPrices, ValidatorPrice{})
+			if err := m.ValidatorPrices[len(m.ValidatorPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3480,7 +8387,7 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorAccuracyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3503,17 +8410,17 @@ func (m *QueryAllPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorAccuracyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorAccuracyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3523,27 +8430,23 @@ func (m *QueryAllPricesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3566,7 +8469,7 @@ func (m *QueryAllPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorAccuracyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3589,49 +8492,15 @@ func (m *QueryAllPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorAccuracyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorAccuracyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Prices = append(m.Prices, Price{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAccuracy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3658,63 +8527,10 @@ func (m *QueryAllPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryReferenceSourceConfigRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ValidatorAccuracy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReferenceSourceConfigRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReferenceSourceConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3736,7 +8552,7 @@ func (m *QueryReferenceSourceConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReferenceSourceConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorFeedsReportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3759,17 +8575,17 @@ func (m *QueryReferenceSourceConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReferenceSourceConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorFeedsReportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReferenceSourceConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorFeedsReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceSourceConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3779,24 +8595,23 @@ func (m *QueryReferenceSourceConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReferenceSourceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3819,7 +8634,7 @@ func (m *QueryReferenceSourceConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySignalTotalPowersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorFeedsReportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3842,17 +8657,37 @@ func (m *QuerySignalTotalPowersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySignalTotalPowersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorFeedsReportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySignalTotalPowersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorFeedsReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAccuracy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3862,27 +8697,28 @@ func (m *QuerySignalTotalPowersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalIds = append(m.SignalIds, string(dAtA[iNdEx:postIndex]))
+			if err := m.ValidatorAccuracy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedReports", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3909,10 +8745,8 @@ func (m *QuerySignalTotalPowersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeedReports = append(m.FeedReports, ValidatorFeedReport{})
+			if err := m.FeedReports[len(m.FeedReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3937,7 +8771,7 @@ func (m *QuerySignalTotalPowersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySignalTotalPowersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3960,17 +8794,17 @@ func (m *QuerySignalTotalPowersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySignalTotalPowersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySignalTotalPowersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalTotalPowers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3980,29 +8814,77 @@ func (m *QuerySignalTotalPowersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalTotalPowers = append(m.SignalTotalPowers, &Signal{})
-			if err := m.SignalTotalPowers[len(m.SignalTotalPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4029,10 +8911,8 @@ func (m *QuerySignalTotalPowersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Signals = append(m.Signals, Signal{})
+			if err := m.Signals[len(m.Signals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4057,7 +8937,7 @@ func (m *QuerySignalTotalPowersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidValidatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySignalVoteDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4080,15 +8960,15 @@ func (m *QueryValidValidatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidValidatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignalVoteDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignalVoteDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4116,7 +8996,7 @@ func (m *QueryValidValidatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4139,7 +9019,7 @@ func (m *QueryValidValidatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySignalVoteDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4162,17 +9042,17 @@ func (m *QueryValidValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignalVoteDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignalVoteDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalVoteDelegation", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4182,12 +9062,25 @@ func (m *QueryValidValidatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Valid = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignalVoteDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4209,7 +9102,7 @@ func (m *QueryValidValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCuratorSignalVoteDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4232,15 +9125,15 @@ func (m *QueryValidatorPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCuratorSignalVoteDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCuratorSignalVoteDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4268,13 +9161,13 @@ func (m *QueryValidatorPricesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Curator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4284,23 +9177,27 @@ func (m *QueryValidatorPricesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalIds = append(m.SignalIds, string(dAtA[iNdEx:postIndex]))
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4323,7 +9220,7 @@ func (m *QueryValidatorPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCuratorSignalVoteDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4346,15 +9243,15 @@ func (m *QueryValidatorPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCuratorSignalVoteDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCuratorSignalVoteDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalVoteDelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4381,8 +9278,44 @@ func (m *QueryValidatorPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorPrices = append(m.ValidatorPrices, ValidatorPrice{})
-			if err := m.ValidatorPrices[len(m.ValidatorPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SignalVoteDelegations = append(m.SignalVoteDelegations, SignalVoteDelegation{})
+			if err := m.SignalVoteDelegations[len(m.SignalVoteDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4407,7 +9340,7 @@ func (m *QueryValidatorPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCuratorDelegatedPowerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4430,15 +9363,15 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCuratorDelegatedPowerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCuratorDelegatedPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4466,7 +9399,7 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Curator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4489,7 +9422,7 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCuratorDelegatedPowerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4512,15 +9445,15 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCuratorDelegatedPowerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCuratorDelegatedPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CuratorDelegatedPower", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4547,8 +9480,7 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signals = append(m.Signals, Signal{})
-			if err := m.Signals[len(m.Signals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CuratorDelegatedPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9,11 +9,8 @@ func NewSignal(id string, power int64) Signal {
 }
 
 func (s *Signal) Validate() error {
-	// Check if the signal ID is empty
-	if s.ID == "" {
-		return ErrInvalidSignal.Wrap(
-			"signal id cannot be empty",
-		)
+	if err := ValidateSignalID(s.ID); err != nil {
+		return err
 	}
 
 	// Check if the signal power is positive
@@ -23,8 +20,20 @@ func (s *Signal) Validate() error {
 		)
 	}
 
+	return nil
+}
+
+// ValidateSignalID validates the format of a signal ID.
+func ValidateSignalID(signalID string) error {
+	// Check if the signal ID is empty
+	if signalID == "" {
+		return ErrInvalidSignal.Wrap(
+			"signal id cannot be empty",
+		)
+	}
+
 	// Check if the signal ID length exceeds the maximum allowed characters
-	signalIDLength := len(s.ID)
+	signalIDLength := len(signalID)
 	if uint64(signalIDLength) > MaxSignalIDCharacters {
 		return ErrSignalIDTooLarge.Wrapf(
 			"maximum number of characters is %d but received %d characters",
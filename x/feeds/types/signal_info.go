@@ -0,0 +1,87 @@
+package types
+
+// NewSignalInfo creates a new signal info instance.
+func NewSignalInfo(
+	signalID string,
+	decimals uint32,
+	quoteCurrency string,
+	assetClass AssetClass,
+	description string,
+	source string,
+	deprecated bool,
+) SignalInfo {
+	return SignalInfo{
+		SignalID:      signalID,
+		Decimals:      decimals,
+		QuoteCurrency: quoteCurrency,
+		AssetClass:    assetClass,
+		Description:   description,
+		Source:        source,
+		Deprecated:    deprecated,
+	}
+}
+
+// Validate validates the signal info.
+func (si SignalInfo) Validate() error {
+	if err := ValidateSignalID(si.SignalID); err != nil {
+		return err
+	}
+
+	if si.Decimals > MaxSignalInfoDecimals {
+		return ErrInvalidSignalInfo.Wrapf(
+			"maximum number of decimals is %d but received %d",
+			MaxSignalInfoDecimals, si.Decimals,
+		)
+	}
+
+	if si.QuoteCurrency == "" {
+		return ErrInvalidSignalInfo.Wrap("quote currency cannot be empty")
+	}
+
+	if _, ok := AssetClass_name[int32(si.AssetClass)]; !ok {
+		return ErrInvalidSignalInfo.Wrapf("invalid asset class: %d", si.AssetClass)
+	}
+
+	if err := validateSignalInfoText("quote currency", si.QuoteCurrency); err != nil {
+		return err
+	}
+	if err := validateSignalInfoText("description", si.Description); err != nil {
+		return err
+	}
+	if err := validateSignalInfoText("source", si.Source); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateSignalInfos validates each signal info and checks that there is no duplicate signal ID.
+func ValidateSignalInfos(signalInfos []SignalInfo) error {
+	signalIDSet := make(map[string]struct{})
+	for _, si := range signalInfos {
+		if err := si.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := signalIDSet[si.SignalID]; ok {
+			return ErrDuplicateSignalID.Wrapf(
+				"duplicate signal ID found: %s", si.SignalID,
+			)
+		}
+		signalIDSet[si.SignalID] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateSignalInfoText checks that a text field of a signal info does not exceed the maximum number of characters.
+func validateSignalInfoText(name string, text string) error {
+	if uint64(len(text)) > MaxSignalInfoTextCharacters {
+		return ErrInvalidSignalInfo.Wrapf(
+			"maximum number of characters of %s is %d but received %d characters",
+			name, MaxSignalInfoTextCharacters, len(text),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func TestSignalInfoValidate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		signalInfo types.SignalInfo
+		expErr     error
+	}{
+		{
+			name:       "valid signal info",
+			signalInfo: types.NewSignalInfo("CS:BTC-USD", 9, "USD", types.ASSET_CLASS_CRYPTO, "Bitcoin", "", false),
+		},
+		{
+			name:       "empty signal id",
+			signalInfo: types.NewSignalInfo("", 9, "USD", types.ASSET_CLASS_CRYPTO, "", "", false),
+			expErr:     types.ErrInvalidSignal,
+		},
+		{
+			name: "signal id too large",
+			signalInfo: types.NewSignalInfo(
+				strings.Repeat("A", int(types.MaxSignalIDCharacters)+1), 9, "USD", types.ASSET_CLASS_CRYPTO, "", "", false,
+			),
+			expErr: types.ErrSignalIDTooLarge,
+		},
+		{
+			name:       "too many decimals",
+			signalInfo: types.NewSignalInfo("CS:BTC-USD", 19, "USD", types.ASSET_CLASS_CRYPTO, "", "", false),
+			expErr:     types.ErrInvalidSignalInfo,
+		},
+		{
+			name:       "empty quote currency",
+			signalInfo: types.NewSignalInfo("CS:BTC-USD", 9, "", types.ASSET_CLASS_CRYPTO, "", "", false),
+			expErr:     types.ErrInvalidSignalInfo,
+		},
+		{
+			name:       "invalid asset class",
+			signalInfo: types.NewSignalInfo("CS:BTC-USD", 9, "USD", types.AssetClass(100), "", "", false),
+			expErr:     types.ErrInvalidSignalInfo,
+		},
+		{
+			name: "description too large",
+			signalInfo: types.NewSignalInfo(
+				"CS:BTC-USD", 9, "USD", types.ASSET_CLASS_CRYPTO,
+				strings.Repeat("A", int(types.MaxSignalInfoTextCharacters)+1), "", false,
+			),
+			expErr: types.ErrInvalidSignalInfo,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.signalInfo.Validate()
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -22,3 +23,9 @@ func TestSumPower(t *testing.T) {
 		},
 	}))
 }
+
+func TestValidateSignalID(t *testing.T) {
+	require.NoError(t, ValidateSignalID("CS:BAND-USD"))
+	require.ErrorIs(t, ValidateSignalID(""), ErrInvalidSignal)
+	require.ErrorIs(t, ValidateSignalID(strings.Repeat("A", int(MaxSignalIDCharacters)+1)), ErrSignalIDTooLarge)
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSignalVoteDelegation creates a new SignalVoteDelegation instance.
+func NewSignalVoteDelegation(delegator string, curator string, power int64) SignalVoteDelegation {
+	return SignalVoteDelegation{
+		Delegator: delegator,
+		Curator:   curator,
+		Power:     power,
+	}
+}
+
+// Validate validates the signal vote delegation.
+func (d SignalVoteDelegation) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(d.Delegator); err != nil {
+		return errorsmod.Wrap(err, "invalid delegator address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(d.Curator); err != nil {
+		return errorsmod.Wrap(err, "invalid curator address")
+	}
+
+	if d.Delegator == d.Curator {
+		return ErrInvalidDelegation.Wrap("delegator cannot delegate to itself")
+	}
+
+	if d.Power <= 0 {
+		return ErrInvalidDelegation.Wrap("power must be positive")
+	}
+
+	return nil
+}
+
+// ValidateSignalVoteDelegations validates each signal vote delegation and checks that there is no duplicate
+// delegator.
+func ValidateSignalVoteDelegations(delegations []SignalVoteDelegation) error {
+	delegatorSet := make(map[string]struct{})
+	for _, d := range delegations {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := delegatorSet[d.Delegator]; ok {
+			return ErrInvalidDelegation.Wrapf("duplicate delegator found: %s", d.Delegator)
+		}
+		delegatorSet[d.Delegator] = struct{}{}
+	}
+
+	return nil
+}
+
+// NewCuratorDelegatedPower creates a new CuratorDelegatedPower instance.
+func NewCuratorDelegatedPower(curator string, power int64) CuratorDelegatedPower {
+	return CuratorDelegatedPower{
+		Curator: curator,
+		Power:   power,
+	}
+}
+
+// AddDelegatedPower returns the signals of a curator with the delegated power distributed among them in
+// proportion to their powers. The delegated power is not used if the curator has no signal.
+func AddDelegatedPower(signals []Signal, delegatedPower int64) []Signal {
+	sumPower := SumPower(signals)
+	if delegatedPower == 0 || sumPower == 0 {
+		return signals
+	}
+
+	weightedSignals := make([]Signal, 0, len(signals))
+	for _, signal := range signals {
+		share := sdkmath.NewInt(delegatedPower).MulRaw(signal.Power).QuoRaw(sumPower)
+		weightedSignals = append(weightedSignals, NewSignal(signal.ID, signal.Power+share.Int64()))
+	}
+
+	return weightedSignals
+}
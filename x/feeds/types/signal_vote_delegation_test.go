@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignalVoteDelegationValidate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		delegation SignalVoteDelegation
+		expErr     bool
+	}{
+		{
+			"valid delegation",
+			NewSignalVoteDelegation(ValidVoter, ValidCurator, 100),
+			false,
+		},
+		{
+			"invalid delegator",
+			NewSignalVoteDelegation(InvalidVoter, ValidCurator, 100),
+			true,
+		},
+		{
+			"invalid curator",
+			NewSignalVoteDelegation(ValidVoter, InvalidVoter, 100),
+			true,
+		},
+		{
+			"self delegation",
+			NewSignalVoteDelegation(ValidVoter, ValidVoter, 100),
+			true,
+		},
+		{
+			"zero power",
+			NewSignalVoteDelegation(ValidVoter, ValidCurator, 0),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			err := tc.delegation.Validate()
+
+			if tc.expErr {
+				require.Error(tt, err)
+			} else {
+				require.NoError(tt, err)
+			}
+		})
+	}
+}
+
+func TestAddDelegatedPower(t *testing.T) {
+	signals := []Signal{
+		NewSignal("CS:BAND-USD", 100),
+		NewSignal("CS:BTC-USD", 200),
+	}
+
+	// no delegated power
+	require.Equal(t, signals, AddDelegatedPower(signals, 0))
+
+	// no signal to distribute to
+	require.Empty(t, AddDelegatedPower([]Signal{}, 1000))
+
+	// delegated power is distributed in proportion to the signal powers, rounding down
+	require.Equal(t, []Signal{
+		NewSignal("CS:BAND-USD", 433),
+		NewSignal("CS:BTC-USD", 866),
+	}, AddDelegatedPower(signals, 1000))
+}
@@ -123,6 +123,108 @@ func (m *MsgVoteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVoteResponse proto.InternalMessageInfo
 
+// MsgDelegateSignalVote is the transaction message to delegate feeds voting power to a curator.
+type MsgDelegateSignalVote struct {
+	// delegator is the address of the delegator that wants to delegate its voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// curator is the address of the curator to delegate the voting power to.
+	Curator string `protobuf:"bytes,2,opt,name=curator,proto3" json:"curator,omitempty"`
+	// power is the power to delegate, replacing the previous delegation of the delegator. A zero power removes the
+	// delegation.
+	Power int64 `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *MsgDelegateSignalVote) Reset()         { *m = MsgDelegateSignalVote{} }
+func (m *MsgDelegateSignalVote) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateSignalVote) ProtoMessage()    {}
+func (*MsgDelegateSignalVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{2}
+}
+func (m *MsgDelegateSignalVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateSignalVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateSignalVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateSignalVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateSignalVote.Merge(m, src)
+}
+func (m *MsgDelegateSignalVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateSignalVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateSignalVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateSignalVote proto.InternalMessageInfo
+
+func (m *MsgDelegateSignalVote) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *MsgDelegateSignalVote) GetCurator() string {
+	if m != nil {
+		return m.Curator
+	}
+	return ""
+}
+
+func (m *MsgDelegateSignalVote) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// MsgDelegateSignalVoteResponse is the response type for the Msg/DelegateSignalVote RPC method.
+type MsgDelegateSignalVoteResponse struct {
+}
+
+func (m *MsgDelegateSignalVoteResponse) Reset()         { *m = MsgDelegateSignalVoteResponse{} }
+func (m *MsgDelegateSignalVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateSignalVoteResponse) ProtoMessage()    {}
+func (*MsgDelegateSignalVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{3}
+}
+func (m *MsgDelegateSignalVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateSignalVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateSignalVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateSignalVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateSignalVoteResponse.Merge(m, src)
+}
+func (m *MsgDelegateSignalVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateSignalVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateSignalVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateSignalVoteResponse proto.InternalMessageInfo
+
 // MsgSubmitSignalPrices is the transaction message to submit multiple signal prices.
 type MsgSubmitSignalPrices struct {
 	// validator is the address of the validator that is performing the operation.
@@ -137,7 +239,7 @@ func (m *MsgSubmitSignalPrices) Reset()         { *m = MsgSubmitSignalPrices{} }
 func (m *MsgSubmitSignalPrices) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitSignalPrices) ProtoMessage()    {}
 func (*MsgSubmitSignalPrices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1bc41512ee10d84, []int{2}
+	return fileDescriptor_e1bc41512ee10d84, []int{4}
 }
 func (m *MsgSubmitSignalPrices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,7 +297,7 @@ func (m *MsgSubmitSignalPricesResponse) Reset()         { *m = MsgSubmitSignalPr
 func (m *MsgSubmitSignalPricesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitSignalPricesResponse) ProtoMessage()    {}
 func (*MsgSubmitSignalPricesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1bc41512ee10d84, []int{3}
+	return fileDescriptor_e1bc41512ee10d84, []int{5}
 }
 func (m *MsgSubmitSignalPricesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,6 +326,199 @@ func (m *MsgSubmitSignalPricesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitSignalPricesResponse proto.InternalMessageInfo
 
+// MsgCommitSignalPrices is the transaction message to commit the hash of signal prices.
+type MsgCommitSignalPrices struct {
+	// validator is the address of the validator that is performing the operation.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// hash is the sha256 hash of the validator address, the salt and the signal prices to reveal.
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *MsgCommitSignalPrices) Reset()         { *m = MsgCommitSignalPrices{} }
+func (m *MsgCommitSignalPrices) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitSignalPrices) ProtoMessage()    {}
+func (*MsgCommitSignalPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{6}
+}
+func (m *MsgCommitSignalPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitSignalPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitSignalPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitSignalPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitSignalPrices.Merge(m, src)
+}
+func (m *MsgCommitSignalPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitSignalPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitSignalPrices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitSignalPrices proto.InternalMessageInfo
+
+func (m *MsgCommitSignalPrices) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *MsgCommitSignalPrices) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+// MsgCommitSignalPricesResponse is the response type for the Msg/CommitSignalPrices RPC method.
+type MsgCommitSignalPricesResponse struct {
+}
+
+func (m *MsgCommitSignalPricesResponse) Reset()         { *m = MsgCommitSignalPricesResponse{} }
+func (m *MsgCommitSignalPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitSignalPricesResponse) ProtoMessage()    {}
+func (*MsgCommitSignalPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{7}
+}
+func (m *MsgCommitSignalPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitSignalPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitSignalPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitSignalPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitSignalPricesResponse.Merge(m, src)
+}
+func (m *MsgCommitSignalPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitSignalPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitSignalPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitSignalPricesResponse proto.InternalMessageInfo
+
+// MsgRevealSignalPrices is the transaction message to reveal committed signal prices.
+type MsgRevealSignalPrices struct {
+	// validator is the address of the validator that is performing the operation.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// salt is the random salt used to compute the committed hash.
+	Salt []byte `protobuf:"bytes,2,opt,name=salt,proto3" json:"salt,omitempty"`
+	// signal_prices is a list of committed signal prices.
+	SignalPrices []SignalPrice `protobuf:"bytes,3,rep,name=signal_prices,json=signalPrices,proto3" json:"signal_prices"`
+}
+
+func (m *MsgRevealSignalPrices) Reset()         { *m = MsgRevealSignalPrices{} }
+func (m *MsgRevealSignalPrices) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealSignalPrices) ProtoMessage()    {}
+func (*MsgRevealSignalPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{8}
+}
+func (m *MsgRevealSignalPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealSignalPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealSignalPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealSignalPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealSignalPrices.Merge(m, src)
+}
+func (m *MsgRevealSignalPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealSignalPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealSignalPrices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealSignalPrices proto.InternalMessageInfo
+
+func (m *MsgRevealSignalPrices) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *MsgRevealSignalPrices) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
+func (m *MsgRevealSignalPrices) GetSignalPrices() []SignalPrice {
+	if m != nil {
+		return m.SignalPrices
+	}
+	return nil
+}
+
+// MsgRevealSignalPricesResponse is the response type for the Msg/RevealSignalPrices RPC method.
+type MsgRevealSignalPricesResponse struct {
+}
+
+func (m *MsgRevealSignalPricesResponse) Reset()         { *m = MsgRevealSignalPricesResponse{} }
+func (m *MsgRevealSignalPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealSignalPricesResponse) ProtoMessage()    {}
+func (*MsgRevealSignalPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{9}
+}
+func (m *MsgRevealSignalPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealSignalPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealSignalPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealSignalPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealSignalPricesResponse.Merge(m, src)
+}
+func (m *MsgRevealSignalPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealSignalPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealSignalPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealSignalPricesResponse proto.InternalMessageInfo
+
 // MsgUpdateReferenceSourceConfig is the transaction message to update reference price source's configuration.
 type MsgUpdateReferenceSourceConfig struct {
 	// admin is the address of the admin that is performing the operation.
@@ -236,7 +531,7 @@ func (m *MsgUpdateReferenceSourceConfig) Reset()         { *m = MsgUpdateReferen
 func (m *MsgUpdateReferenceSourceConfig) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateReferenceSourceConfig) ProtoMessage()    {}
 func (*MsgUpdateReferenceSourceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1bc41512ee10d84, []int{4}
+	return fileDescriptor_e1bc41512ee10d84, []int{10}
 }
 func (m *MsgUpdateReferenceSourceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,7 +584,7 @@ func (m *MsgUpdateReferenceSourceConfigResponse) Reset() {
 func (m *MsgUpdateReferenceSourceConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateReferenceSourceConfigResponse) ProtoMessage()    {}
 func (*MsgUpdateReferenceSourceConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1bc41512ee10d84, []int{5}
+	return fileDescriptor_e1bc41512ee10d84, []int{11}
 }
 func (m *MsgUpdateReferenceSourceConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -318,26 +613,26 @@ func (m *MsgUpdateReferenceSourceConfigResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateReferenceSourceConfigResponse proto.InternalMessageInfo
 
-// MsgUpdateParams is the transaction message to update parameters.
-type MsgUpdateParams struct {
-	// authority is the address of the governance account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params is the x/feeds parameters to update.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+// MsgUpdateSignalInfos is the transaction message to register or update metadata of signal ids.
+type MsgUpdateSignalInfos struct {
+	// admin is the address of the admin or the governance account that is performing the operation.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// signal_infos is a list of metadata of signal ids to register or update.
+	SignalInfos []SignalInfo `protobuf:"bytes,2,rep,name=signal_infos,json=signalInfos,proto3" json:"signal_infos"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1bc41512ee10d84, []int{6}
+func (m *MsgUpdateSignalInfos) Reset()         { *m = MsgUpdateSignalInfos{} }
+func (m *MsgUpdateSignalInfos) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSignalInfos) ProtoMessage()    {}
+func (*MsgUpdateSignalInfos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{12}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateSignalInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateSignalInfos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateSignalInfos.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -347,48 +642,48 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgUpdateSignalInfos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSignalInfos.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgUpdateSignalInfos) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgUpdateSignalInfos) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSignalInfos.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateSignalInfos proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgUpdateSignalInfos) GetAdmin() string {
 	if m != nil {
-		return m.Authority
+		return m.Admin
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgUpdateSignalInfos) GetSignalInfos() []SignalInfo {
 	if m != nil {
-		return m.Params
+		return m.SignalInfos
 	}
-	return Params{}
+	return nil
 }
 
-// MsgUpdateParamsResponse is the response type for the Msg/UpdateParams RPC method.
-type MsgUpdateParamsResponse struct {
+// MsgUpdateSignalInfosResponse is the response type for the Msg/UpdateSignalInfos RPC method.
+type MsgUpdateSignalInfosResponse struct {
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1bc41512ee10d84, []int{7}
+func (m *MsgUpdateSignalInfosResponse) Reset()         { *m = MsgUpdateSignalInfosResponse{} }
+func (m *MsgUpdateSignalInfosResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSignalInfosResponse) ProtoMessage()    {}
+func (*MsgUpdateSignalInfosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{13}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateSignalInfosResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateSignalInfosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateSignalInfosResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -398,79 +693,291 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgUpdateSignalInfosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSignalInfosResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgUpdateSignalInfosResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgUpdateSignalInfosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSignalInfosResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateSignalInfosResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgVote)(nil), "band.feeds.v1beta1.MsgVote")
-	proto.RegisterType((*MsgVoteResponse)(nil), "band.feeds.v1beta1.MsgVoteResponse")
-	proto.RegisterType((*MsgSubmitSignalPrices)(nil), "band.feeds.v1beta1.MsgSubmitSignalPrices")
-	proto.RegisterType((*MsgSubmitSignalPricesResponse)(nil), "band.feeds.v1beta1.MsgSubmitSignalPricesResponse")
-	proto.RegisterType((*MsgUpdateReferenceSourceConfig)(nil), "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig")
-	proto.RegisterType((*MsgUpdateReferenceSourceConfigResponse)(nil), "band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "band.feeds.v1beta1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "band.feeds.v1beta1.MsgUpdateParamsResponse")
+// MsgUpdatePriceGuards is the transaction message to set or remove price guards of signal ids.
+type MsgUpdatePriceGuards struct {
+	// admin is the address of the admin or the governance account that is performing the operation.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// price_guards is a list of price guards to set. A price guard with all limits disabled removes the price guard
+	// of the signal id.
+	PriceGuards []PriceGuard `protobuf:"bytes,2,rep,name=price_guards,json=priceGuards,proto3" json:"price_guards"`
 }
 
-func init() { proto.RegisterFile("band/feeds/v1beta1/tx.proto", fileDescriptor_e1bc41512ee10d84) }
-
-var fileDescriptor_e1bc41512ee10d84 = []byte{
-	// 673 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x4b, 0x1b, 0x41,
-	0x14, 0xce, 0x1a, 0x7f, 0x90, 0x51, 0x29, 0x0e, 0x8a, 0x71, 0xa3, 0x1b, 0x1b, 0x4b, 0x89, 0x16,
-	0x77, 0x49, 0x84, 0x52, 0x72, 0x29, 0xb5, 0x17, 0x29, 0x04, 0x24, 0xa1, 0x1e, 0x7a, 0x49, 0x27,
-	0xbb, 0xe3, 0x3a, 0xe0, 0xee, 0x2c, 0x33, 0x93, 0xa0, 0xb7, 0xd2, 0x63, 0xa1, 0x50, 0xe8, 0xdf,
-	0x51, 0xf0, 0xe0, 0xb1, 0x7f, 0x80, 0x47, 0xf1, 0xd4, 0x53, 0x29, 0x7a, 0xf0, 0xd6, 0x43, 0xff,
-	0x82, 0xb2, 0x33, 0xb3, 0x9b, 0xaa, 0x9b, 0x68, 0x2f, 0xc9, 0xee, 0x7c, 0xdf, 0xfb, 0xde, 0xfb,
-	0xde, 0xbc, 0x97, 0x80, 0x52, 0x17, 0x85, 0x9e, 0xb3, 0x8f, 0xb1, 0xc7, 0x9d, 0x7e, 0xad, 0x8b,
-	0x05, 0xaa, 0x39, 0xe2, 0xc8, 0x8e, 0x18, 0x15, 0x14, 0xc2, 0x18, 0xb4, 0x25, 0x68, 0x6b, 0xd0,
-	0x9c, 0xf7, 0xa9, 0x4f, 0x25, 0xec, 0xc4, 0x4f, 0x8a, 0x69, 0x5a, 0x19, 0x32, 0x2a, 0x4e, 0xe1,
-	0xe5, 0x0c, 0x3c, 0x42, 0x0c, 0x05, 0x09, 0x61, 0xc9, 0xa5, 0x3c, 0xa0, 0xbc, 0xa3, 0x94, 0xd5,
-	0x8b, 0x86, 0x16, 0xd5, 0x9b, 0x13, 0x70, 0xdf, 0xe9, 0xd7, 0xe2, 0x2f, 0x0d, 0xcc, 0xa1, 0x80,
-	0x84, 0xd4, 0x91, 0x9f, 0xea, 0xa8, 0xf2, 0xd5, 0x00, 0x53, 0x4d, 0xee, 0xef, 0x51, 0x81, 0xa1,
-	0x0d, 0x26, 0xfa, 0x54, 0x60, 0x56, 0x34, 0x56, 0x8d, 0x6a, 0x61, 0xbb, 0x78, 0x71, 0xba, 0x39,
-	0xaf, 0x85, 0x5f, 0x79, 0x1e, 0xc3, 0x9c, 0xb7, 0x05, 0x23, 0xa1, 0xdf, 0x52, 0x34, 0xd8, 0x00,
-	0x53, 0x9c, 0xf8, 0x21, 0x3a, 0xe4, 0xc5, 0xb1, 0xd5, 0x7c, 0x75, 0xba, 0x6e, 0xda, 0x77, 0xfd,
-	0xdb, 0x6d, 0x49, 0xd9, 0x1e, 0x3f, 0xfb, 0x59, 0xce, 0xb5, 0x92, 0x80, 0xc6, 0xf2, 0xc7, 0xeb,
-	0x93, 0x0d, 0xa5, 0xf3, 0xe9, 0xfa, 0x64, 0x63, 0x56, 0x39, 0xd5, 0x95, 0x54, 0xe6, 0xc0, 0x23,
-	0xfd, 0xd8, 0xc2, 0x3c, 0xa2, 0x21, 0xc7, 0x95, 0x3f, 0x06, 0x58, 0x68, 0x72, 0xbf, 0xdd, 0xeb,
-	0x06, 0x44, 0x28, 0xcd, 0x5d, 0x46, 0x5c, 0xcc, 0xe1, 0x4b, 0x50, 0xe8, 0xa3, 0x43, 0xe2, 0x21,
-	0x41, 0x93, 0xd2, 0x1f, 0x5f, 0x9c, 0x6e, 0xae, 0xe8, 0xd2, 0xf7, 0x12, 0xec, 0xa6, 0x87, 0x41,
-	0x0c, 0x5c, 0x06, 0x05, 0x41, 0x02, 0xcc, 0x05, 0x0a, 0xa2, 0xe2, 0xd8, 0xaa, 0x51, 0xcd, 0xb7,
-	0x06, 0x07, 0xf0, 0x0d, 0x98, 0x55, 0x45, 0x77, 0x22, 0x99, 0xaf, 0x98, 0x97, 0x5e, 0xcb, 0xc3,
-	0xbd, 0xca, 0xba, 0xb4, 0xe1, 0x19, 0xfe, 0x4f, 0xa9, 0x0d, 0x3b, 0x76, 0x3d, 0xc8, 0x1c, 0x3b,
-	0x2f, 0xa5, 0xce, 0xef, 0x5a, 0xab, 0x94, 0xc1, 0x4a, 0x26, 0x90, 0x76, 0xe5, 0xb7, 0x01, 0xac,
-	0x26, 0xf7, 0xdf, 0x46, 0x1e, 0x8a, 0x7b, 0xb5, 0x8f, 0x19, 0x0e, 0x5d, 0xdc, 0xa6, 0x3d, 0xe6,
-	0xe2, 0xd7, 0x34, 0xdc, 0x27, 0x7e, 0x7c, 0xab, 0xc8, 0x0b, 0x48, 0x78, 0xff, 0xad, 0x4a, 0x1a,
-	0xf4, 0xc1, 0x22, 0x4b, 0x84, 0x3a, 0x5c, 0x2a, 0x75, 0x5c, 0x29, 0x25, 0x7b, 0x33, 0x5d, 0x5f,
-	0xcf, 0x72, 0x9e, 0x99, 0x5b, 0xf7, 0x60, 0x81, 0x65, 0x81, 0x8d, 0x2d, 0x39, 0x02, 0x32, 0x69,
-	0xdc, 0x88, 0x27, 0x69, 0x23, 0x46, 0xb8, 0xa9, 0x54, 0xc1, 0xd3, 0xd1, 0x8c, 0xb4, 0x35, 0xdf,
-	0x0c, 0x39, 0x44, 0x8a, 0xba, 0x2b, 0x57, 0x07, 0x3e, 0x07, 0x05, 0xd4, 0x13, 0x07, 0x94, 0x11,
-	0x71, 0x7c, 0x6f, 0x3f, 0x06, 0x54, 0xf8, 0x02, 0x4c, 0xaa, 0xe5, 0xd3, 0x2d, 0xc8, 0x1c, 0x74,
-	0x95, 0x43, 0x7b, 0xd6, 0xfc, 0x46, 0x55, 0xde, 0x78, 0xaa, 0x14, 0x1b, 0x5d, 0xb8, 0x65, 0x54,
-	0xc5, 0x55, 0x96, 0xc0, 0xe2, 0xad, 0xa3, 0xc4, 0x4a, 0xfd, 0x7b, 0x1e, 0xe4, 0x9b, 0xdc, 0x87,
-	0x3b, 0x60, 0x5c, 0x2e, 0x6a, 0x29, 0x2b, 0xbd, 0x5e, 0x18, 0x73, 0x6d, 0x04, 0x98, 0x28, 0x42,
-	0x06, 0x60, 0xc6, 0x26, 0xad, 0x0f, 0x09, 0xbd, 0x4b, 0x35, 0x6b, 0x0f, 0xa6, 0xa6, 0x39, 0x3f,
-	0x1b, 0xa0, 0x34, 0x6a, 0x50, 0xeb, 0x43, 0x24, 0x47, 0xc4, 0x98, 0x8d, 0xff, 0x8f, 0x49, 0xeb,
-	0x79, 0x0f, 0x66, 0x6e, 0x0c, 0xc7, 0xda, 0x48, 0x2d, 0x45, 0x32, 0x9f, 0x3d, 0x80, 0x94, 0x64,
-	0x30, 0x27, 0x3e, 0x5c, 0x9f, 0x6c, 0x18, 0xdb, 0x3b, 0x67, 0x97, 0x96, 0x71, 0x7e, 0x69, 0x19,
-	0xbf, 0x2e, 0x2d, 0xe3, 0xcb, 0x95, 0x95, 0x3b, 0xbf, 0xb2, 0x72, 0x3f, 0xae, 0xac, 0xdc, 0x3b,
-	0xdb, 0x27, 0xe2, 0xa0, 0xd7, 0xb5, 0x5d, 0x1a, 0x38, 0xb1, 0xae, 0xfc, 0x4d, 0x76, 0xe9, 0xa1,
-	0xe3, 0x1e, 0x20, 0x12, 0x3a, 0xfd, 0x2d, 0xe7, 0x48, 0xff, 0x07, 0x88, 0xe3, 0x08, 0xf3, 0xee,
-	0xa4, 0x24, 0x6c, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xe4, 0xb7, 0x17, 0xf2, 0x85, 0x06, 0x00,
-	0x00,
+func (m *MsgUpdatePriceGuards) Reset()         { *m = MsgUpdatePriceGuards{} }
+func (m *MsgUpdatePriceGuards) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePriceGuards) ProtoMessage()    {}
+func (*MsgUpdatePriceGuards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{14}
+}
+func (m *MsgUpdatePriceGuards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePriceGuards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePriceGuards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePriceGuards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePriceGuards.Merge(m, src)
+}
+func (m *MsgUpdatePriceGuards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePriceGuards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePriceGuards.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
+var xxx_messageInfo_MsgUpdatePriceGuards proto.InternalMessageInfo
+
+func (m *MsgUpdatePriceGuards) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgUpdatePriceGuards) GetPriceGuards() []PriceGuard {
+	if m != nil {
+		return m.PriceGuards
+	}
+	return nil
+}
+
+// MsgUpdatePriceGuardsResponse is the response type for the Msg/UpdatePriceGuards RPC method.
+type MsgUpdatePriceGuardsResponse struct {
+}
+
+func (m *MsgUpdatePriceGuardsResponse) Reset()         { *m = MsgUpdatePriceGuardsResponse{} }
+func (m *MsgUpdatePriceGuardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePriceGuardsResponse) ProtoMessage()    {}
+func (*MsgUpdatePriceGuardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{15}
+}
+func (m *MsgUpdatePriceGuardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePriceGuardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePriceGuardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePriceGuardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePriceGuardsResponse.Merge(m, src)
+}
+func (m *MsgUpdatePriceGuardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePriceGuardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePriceGuardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePriceGuardsResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the transaction message to update parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params is the x/feeds parameters to update.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{16}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse is the response type for the Msg/UpdateParams RPC method.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{17}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgVote)(nil), "band.feeds.v1beta1.MsgVote")
+	proto.RegisterType((*MsgVoteResponse)(nil), "band.feeds.v1beta1.MsgVoteResponse")
+	proto.RegisterType((*MsgDelegateSignalVote)(nil), "band.feeds.v1beta1.MsgDelegateSignalVote")
+	proto.RegisterType((*MsgDelegateSignalVoteResponse)(nil), "band.feeds.v1beta1.MsgDelegateSignalVoteResponse")
+	proto.RegisterType((*MsgSubmitSignalPrices)(nil), "band.feeds.v1beta1.MsgSubmitSignalPrices")
+	proto.RegisterType((*MsgSubmitSignalPricesResponse)(nil), "band.feeds.v1beta1.MsgSubmitSignalPricesResponse")
+	proto.RegisterType((*MsgCommitSignalPrices)(nil), "band.feeds.v1beta1.MsgCommitSignalPrices")
+	proto.RegisterType((*MsgCommitSignalPricesResponse)(nil), "band.feeds.v1beta1.MsgCommitSignalPricesResponse")
+	proto.RegisterType((*MsgRevealSignalPrices)(nil), "band.feeds.v1beta1.MsgRevealSignalPrices")
+	proto.RegisterType((*MsgRevealSignalPricesResponse)(nil), "band.feeds.v1beta1.MsgRevealSignalPricesResponse")
+	proto.RegisterType((*MsgUpdateReferenceSourceConfig)(nil), "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig")
+	proto.RegisterType((*MsgUpdateReferenceSourceConfigResponse)(nil), "band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse")
+	proto.RegisterType((*MsgUpdateSignalInfos)(nil), "band.feeds.v1beta1.MsgUpdateSignalInfos")
+	proto.RegisterType((*MsgUpdateSignalInfosResponse)(nil), "band.feeds.v1beta1.MsgUpdateSignalInfosResponse")
+	proto.RegisterType((*MsgUpdatePriceGuards)(nil), "band.feeds.v1beta1.MsgUpdatePriceGuards")
+	proto.RegisterType((*MsgUpdatePriceGuardsResponse)(nil), "band.feeds.v1beta1.MsgUpdatePriceGuardsResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "band.feeds.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "band.feeds.v1beta1.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("band/feeds/v1beta1/tx.proto", fileDescriptor_e1bc41512ee10d84) }
+
+var fileDescriptor_e1bc41512ee10d84 = []byte{
+	// 951 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x41, 0x6b, 0xe3, 0x46,
+	0x14, 0xb6, 0xd6, 0xc9, 0x1a, 0x4f, 0xb2, 0x2c, 0x11, 0x0e, 0xf1, 0xca, 0x59, 0x39, 0xf5, 0x96,
+	0xe2, 0xa4, 0xac, 0xb4, 0x76, 0xa0, 0x14, 0x5f, 0x4a, 0xb3, 0x85, 0xdd, 0x16, 0x0c, 0xc5, 0xa6,
+	0x7b, 0xe8, 0xc5, 0x1d, 0x4b, 0x63, 0x59, 0x60, 0x69, 0x84, 0x46, 0x76, 0x37, 0xb7, 0xd2, 0x63,
+	0xa1, 0x50, 0xe8, 0xb5, 0x7f, 0xa1, 0x90, 0x43, 0x4e, 0xfd, 0x05, 0xe9, 0x2d, 0xe4, 0xd4, 0x53,
+	0x29, 0xc9, 0x21, 0x3d, 0xf5, 0xd0, 0x63, 0x4f, 0x45, 0x33, 0xa3, 0x91, 0x6c, 0xc9, 0xb2, 0x53,
+	0x42, 0x2f, 0x89, 0x34, 0xef, 0x7b, 0xef, 0x7b, 0xdf, 0x37, 0xe3, 0x79, 0x08, 0xd4, 0x86, 0xd0,
+	0x35, 0xf5, 0x11, 0x42, 0x26, 0xd1, 0x67, 0xad, 0x21, 0x0a, 0x60, 0x4b, 0x0f, 0xde, 0x6a, 0x9e,
+	0x8f, 0x03, 0x2c, 0xcb, 0x61, 0x50, 0xa3, 0x41, 0x8d, 0x07, 0x95, 0x8a, 0x85, 0x2d, 0x4c, 0xc3,
+	0x7a, 0xf8, 0xc4, 0x90, 0x8a, 0x9a, 0x51, 0x86, 0xe5, 0xb1, 0x78, 0x3d, 0x23, 0xee, 0x41, 0x1f,
+	0x3a, 0x11, 0xe0, 0x89, 0x81, 0x89, 0x83, 0xc9, 0x80, 0x55, 0x66, 0x2f, 0x3c, 0xb4, 0xc7, 0xde,
+	0x74, 0x87, 0x58, 0xfa, 0xac, 0x15, 0xfe, 0xe3, 0x81, 0x1d, 0xe8, 0xd8, 0x2e, 0xd6, 0xe9, 0x5f,
+	0xb6, 0xd4, 0xf8, 0x51, 0x02, 0xa5, 0x2e, 0xb1, 0xde, 0xe0, 0x00, 0xc9, 0x1a, 0xd8, 0x9c, 0xe1,
+	0x00, 0xf9, 0x55, 0xe9, 0x40, 0x6a, 0x96, 0x4f, 0xaa, 0x57, 0xe7, 0xcf, 0x2b, 0xbc, 0xf0, 0xc7,
+	0xa6, 0xe9, 0x23, 0x42, 0xfa, 0x81, 0x6f, 0xbb, 0x56, 0x8f, 0xc1, 0xe4, 0x0e, 0x28, 0x11, 0xdb,
+	0x72, 0xe1, 0x84, 0x54, 0x1f, 0x1c, 0x14, 0x9b, 0x5b, 0x6d, 0x45, 0x4b, 0xeb, 0xd7, 0xfa, 0x14,
+	0x72, 0xb2, 0x71, 0xf1, 0x7b, 0xbd, 0xd0, 0x8b, 0x12, 0x3a, 0xfb, 0xdf, 0xde, 0x9e, 0x1d, 0xb1,
+	0x3a, 0xdf, 0xdd, 0x9e, 0x1d, 0x3d, 0x62, 0x4a, 0x79, 0x27, 0x8d, 0x1d, 0xf0, 0x98, 0x3f, 0xf6,
+	0x10, 0xf1, 0xb0, 0x4b, 0x50, 0xe3, 0x57, 0x09, 0xec, 0x76, 0x89, 0xf5, 0x09, 0x9a, 0x20, 0x0b,
+	0x06, 0x88, 0x55, 0xa5, 0x6d, 0x7f, 0x00, 0xca, 0x26, 0x5b, 0xc5, 0xab, 0x5b, 0x8f, 0xa1, 0x72,
+	0x1b, 0x94, 0x8c, 0xa9, 0x4f, 0xb3, 0x1e, 0xac, 0xc8, 0x8a, 0x80, 0x72, 0x05, 0x6c, 0x7a, 0xf8,
+	0x6b, 0xe4, 0x57, 0x8b, 0x07, 0x52, 0xb3, 0xd8, 0x63, 0x2f, 0x1d, 0x2d, 0x14, 0x13, 0x57, 0x0e,
+	0x05, 0xd5, 0x84, 0xa0, 0x74, 0xc7, 0x8d, 0x3a, 0x78, 0x9a, 0x19, 0x10, 0x62, 0xff, 0x66, 0x62,
+	0xfb, 0xd3, 0xa1, 0x63, 0x07, 0x2c, 0xfe, 0xb9, 0x6f, 0x1b, 0x88, 0xc8, 0x1f, 0x81, 0xf2, 0x0c,
+	0x4e, 0x6c, 0x33, 0x21, 0xf6, 0x9d, 0xab, 0xf3, 0xe7, 0x4f, 0x79, 0xdb, 0x6f, 0xa2, 0xd8, 0x82,
+	0x6a, 0x91, 0x23, 0xef, 0x83, 0x72, 0x60, 0x3b, 0x88, 0x04, 0xd0, 0xf1, 0xa8, 0xee, 0x62, 0x2f,
+	0x5e, 0x90, 0x3f, 0x03, 0x8f, 0xd8, 0x0e, 0x0d, 0x3c, 0xca, 0x57, 0x2d, 0xd2, 0x8d, 0xad, 0x2f,
+	0xdf, 0x58, 0xda, 0x17, 0xdf, 0xdd, 0x6d, 0x12, 0x2f, 0x11, 0xee, 0x8a, 0x60, 0x9e, 0x77, 0x25,
+	0x2d, 0x8d, 0xbb, 0x92, 0x0e, 0x08, 0x57, 0x7e, 0x62, 0xae, 0xbc, 0xc4, 0xce, 0xbd, 0xbb, 0x22,
+	0x83, 0x8d, 0x31, 0x24, 0x63, 0x6a, 0xc8, 0x76, 0x8f, 0x3e, 0xe7, 0xf7, 0x9f, 0x6e, 0x82, 0xf7,
+	0x9f, 0x0e, 0x88, 0xfe, 0xff, 0x64, 0xfd, 0xf7, 0xd0, 0x0c, 0xc1, 0xc9, 0xbd, 0xf7, 0x4f, 0xe0,
+	0x24, 0x88, 0xfa, 0x0f, 0x9f, 0xff, 0xbf, 0xbd, 0x4c, 0x0b, 0xe2, 0x5e, 0xa4, 0x03, 0xc2, 0x8b,
+	0xbf, 0x24, 0xa0, 0x76, 0x89, 0xf5, 0x85, 0x67, 0xc2, 0xf0, 0xdc, 0x8f, 0x90, 0x8f, 0x5c, 0x03,
+	0xf5, 0xf1, 0xd4, 0x37, 0xd0, 0x4b, 0xec, 0x8e, 0x6c, 0x2b, 0xbc, 0x8e, 0xa0, 0xe9, 0xd8, 0xee,
+	0xea, 0xeb, 0x88, 0xc2, 0x64, 0x0b, 0xec, 0xf9, 0x51, 0xa1, 0x01, 0xa1, 0x95, 0x06, 0x06, 0x2d,
+	0x45, 0x6d, 0xd9, 0x6a, 0x1f, 0x66, 0x29, 0xcf, 0xe4, 0xe6, 0x1e, 0xec, 0xfa, 0x59, 0xc1, 0xce,
+	0x31, 0xbd, 0xbb, 0x28, 0x69, 0x68, 0xc4, 0xbb, 0xc2, 0x88, 0x1c, 0x35, 0x8d, 0x26, 0x78, 0x2f,
+	0x1f, 0x21, 0xac, 0xf9, 0x45, 0x02, 0x15, 0x01, 0x65, 0xe6, 0x7d, 0xea, 0x8e, 0x30, 0xb9, 0xb3,
+	0x21, 0xaf, 0x00, 0xdf, 0xc4, 0x81, 0x1d, 0xe6, 0xf3, 0x4b, 0x5a, 0x5d, 0xbe, 0xff, 0x21, 0x0d,
+	0x97, 0xbe, 0x45, 0x62, 0xe2, 0xce, 0xe1, 0xbc, 0x60, 0x65, 0x41, 0x70, 0xa2, 0xc7, 0x86, 0x0a,
+	0xf6, 0xb3, 0xd6, 0xb3, 0xc5, 0xd1, 0x33, 0xf1, 0x6a, 0x0a, 0x7d, 0xf3, 0x3f, 0x89, 0xa3, 0xc7,
+	0x7a, 0x60, 0xd1, 0xfc, 0x3c, 0x71, 0x31, 0x4d, 0x24, 0xce, 0x8b, 0x89, 0x57, 0x89, 0x4b, 0xf4,
+	0x38, 0x27, 0x2e, 0xb1, 0x2e, 0xc4, 0xfd, 0x2c, 0x81, 0xc7, 0x31, 0x80, 0x4e, 0xeb, 0x70, 0x3a,
+	0xc1, 0x69, 0x30, 0xc6, 0xbe, 0x1d, 0x9c, 0xae, 0x9e, 0x4e, 0x02, 0x2a, 0x7f, 0x08, 0x1e, 0xb2,
+	0x79, 0xcf, 0x0f, 0x6f, 0xe6, 0x6c, 0x65, 0x1c, 0x5c, 0x15, 0xc7, 0x77, 0x9a, 0xf4, 0xb7, 0x2a,
+	0x2a, 0x85, 0xa2, 0x76, 0x17, 0x45, 0x51, 0x64, 0xe3, 0x09, 0xd8, 0x5b, 0x58, 0x8a, 0xa4, 0xb4,
+	0xff, 0x29, 0x81, 0x62, 0x97, 0x58, 0xf2, 0x6b, 0xb0, 0x41, 0x87, 0x6c, 0x2d, 0x8b, 0x9e, 0xcf,
+	0x68, 0xe5, 0x59, 0x4e, 0x30, 0xaa, 0x28, 0xfb, 0x40, 0xce, 0x18, 0xde, 0x87, 0x4b, 0x52, 0xd3,
+	0x50, 0xa5, 0xb5, 0x36, 0x34, 0xc9, 0x99, 0x31, 0x43, 0x97, 0x71, 0xa6, 0xa1, 0x4a, 0x6b, 0x6d,
+	0x68, 0x92, 0x33, 0x63, 0x42, 0x2d, 0xe3, 0x4c, 0x43, 0x95, 0xd6, 0xda, 0xd0, 0x24, 0x67, 0xc6,
+	0x54, 0x59, 0xc6, 0x99, 0x86, 0x2a, 0xad, 0xb5, 0xa1, 0x82, 0xf3, 0x7b, 0x09, 0xd4, 0xf2, 0xae,
+	0xef, 0xf6, 0x92, 0x92, 0x39, 0x39, 0x4a, 0xe7, 0xee, 0x39, 0xa2, 0x1f, 0x0c, 0x76, 0xd2, 0x57,
+	0x66, 0x33, 0xb7, 0x60, 0x02, 0xa9, 0xbc, 0x58, 0x17, 0x99, 0x26, 0x4c, 0x5e, 0x63, 0xf9, 0x84,
+	0x09, 0xa4, 0xf2, 0x62, 0x5d, 0xa4, 0x20, 0xfc, 0x0a, 0x6c, 0xcf, 0x5d, 0x2d, 0xcf, 0xf2, 0x2b,
+	0x50, 0x90, 0xf2, 0xfe, 0x1a, 0xa0, 0x88, 0x41, 0xd9, 0xfc, 0xe6, 0xf6, 0xec, 0x48, 0x3a, 0x79,
+	0x7d, 0x71, 0xad, 0x4a, 0x97, 0xd7, 0xaa, 0xf4, 0xc7, 0xb5, 0x2a, 0xfd, 0x70, 0xa3, 0x16, 0x2e,
+	0x6f, 0xd4, 0xc2, 0x6f, 0x37, 0x6a, 0xe1, 0x4b, 0xcd, 0xb2, 0x83, 0xf1, 0x74, 0xa8, 0x19, 0xd8,
+	0xd1, 0xc3, 0xba, 0xf4, 0x23, 0xc2, 0xc0, 0x13, 0xdd, 0x18, 0x43, 0xdb, 0xd5, 0x67, 0xc7, 0xfa,
+	0x5b, 0xfe, 0xd1, 0x12, 0x9c, 0x7a, 0x88, 0x0c, 0x1f, 0x52, 0xc0, 0xf1, 0xbf, 0x03, 0x00, 0xac,
+	0xe6, 0xd7, 0xe8, 0x36, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
 var _ grpc.ClientConn
 
@@ -484,10 +991,20 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// Vote is an RPC method to vote signal ids and their powers.
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
+	// DelegateSignalVote is an RPC method to delegate feeds voting power to a curator.
+	DelegateSignalVote(ctx context.Context, in *MsgDelegateSignalVote, opts ...grpc.CallOption) (*MsgDelegateSignalVoteResponse, error)
 	// SubmitSignalPrices is an RPC method to submit signal prices.
 	SubmitSignalPrices(ctx context.Context, in *MsgSubmitSignalPrices, opts ...grpc.CallOption) (*MsgSubmitSignalPricesResponse, error)
+	// CommitSignalPrices is an RPC method to commit the hash of signal prices in the commit phase.
+	CommitSignalPrices(ctx context.Context, in *MsgCommitSignalPrices, opts ...grpc.CallOption) (*MsgCommitSignalPricesResponse, error)
+	// RevealSignalPrices is an RPC method to reveal committed signal prices in the reveal phase.
+	RevealSignalPrices(ctx context.Context, in *MsgRevealSignalPrices, opts ...grpc.CallOption) (*MsgRevealSignalPricesResponse, error)
 	// UpdateReferenceSourceConfig is an RPC method to update reference price source configuration.
 	UpdateReferenceSourceConfig(ctx context.Context, in *MsgUpdateReferenceSourceConfig, opts ...grpc.CallOption) (*MsgUpdateReferenceSourceConfigResponse, error)
+	// UpdateSignalInfos is an RPC method to register or update metadata of signal ids.
+	UpdateSignalInfos(ctx context.Context, in *MsgUpdateSignalInfos, opts ...grpc.CallOption) (*MsgUpdateSignalInfosResponse, error)
+	// UpdatePriceGuards is an RPC method to set or remove price guards of signal ids.
+	UpdatePriceGuards(ctx context.Context, in *MsgUpdatePriceGuards, opts ...grpc.CallOption) (*MsgUpdatePriceGuardsResponse, error)
 	// UpdateParams is an RPC method to update parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -509,6 +1026,15 @@ func (c *msgClient) Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) DelegateSignalVote(ctx context.Context, in *MsgDelegateSignalVote, opts ...grpc.CallOption) (*MsgDelegateSignalVoteResponse, error) {
+	out := new(MsgDelegateSignalVoteResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Msg/DelegateSignalVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitSignalPrices(ctx context.Context, in *MsgSubmitSignalPrices, opts ...grpc.CallOption) (*MsgSubmitSignalPricesResponse, error) {
 	out := new(MsgSubmitSignalPricesResponse)
 	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Msg/SubmitSignalPrices", in, out, opts...)
@@ -518,6 +1044,24 @@ func (c *msgClient) SubmitSignalPrices(ctx context.Context, in *MsgSubmitSignalP
 	return out, nil
 }
 
+func (c *msgClient) CommitSignalPrices(ctx context.Context, in *MsgCommitSignalPrices, opts ...grpc.CallOption) (*MsgCommitSignalPricesResponse, error) {
+	out := new(MsgCommitSignalPricesResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Msg/CommitSignalPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealSignalPrices(ctx context.Context, in *MsgRevealSignalPrices, opts ...grpc.CallOption) (*MsgRevealSignalPricesResponse, error) {
+	out := new(MsgRevealSignalPricesResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Msg/RevealSignalPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateReferenceSourceConfig(ctx context.Context, in *MsgUpdateReferenceSourceConfig, opts ...grpc.CallOption) (*MsgUpdateReferenceSourceConfigResponse, error) {
 	out := new(MsgUpdateReferenceSourceConfigResponse)
 	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Msg/UpdateReferenceSourceConfig", in, out, opts...)
@@ -527,6 +1071,24 @@ func (c *msgClient) UpdateReferenceSourceConfig(ctx context.Context, in *MsgUpda
 	return out, nil
 }
 
+func (c *msgClient) UpdateSignalInfos(ctx context.Context, in *MsgUpdateSignalInfos, opts ...grpc.CallOption) (*MsgUpdateSignalInfosResponse, error) {
+	out := new(MsgUpdateSignalInfosResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Msg/UpdateSignalInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePriceGuards(ctx context.Context, in *MsgUpdatePriceGuards, opts ...grpc.CallOption) (*MsgUpdatePriceGuardsResponse, error) {
+	out := new(MsgUpdatePriceGuardsResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Msg/UpdatePriceGuards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Msg/UpdateParams", in, out, opts...)
@@ -540,10 +1102,20 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 type MsgServer interface {
 	// Vote is an RPC method to vote signal ids and their powers.
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
+	// DelegateSignalVote is an RPC method to delegate feeds voting power to a curator.
+	DelegateSignalVote(context.Context, *MsgDelegateSignalVote) (*MsgDelegateSignalVoteResponse, error)
 	// SubmitSignalPrices is an RPC method to submit signal prices.
 	SubmitSignalPrices(context.Context, *MsgSubmitSignalPrices) (*MsgSubmitSignalPricesResponse, error)
+	// CommitSignalPrices is an RPC method to commit the hash of signal prices in the commit phase.
+	CommitSignalPrices(context.Context, *MsgCommitSignalPrices) (*MsgCommitSignalPricesResponse, error)
+	// RevealSignalPrices is an RPC method to reveal committed signal prices in the reveal phase.
+	RevealSignalPrices(context.Context, *MsgRevealSignalPrices) (*MsgRevealSignalPricesResponse, error)
 	// UpdateReferenceSourceConfig is an RPC method to update reference price source configuration.
 	UpdateReferenceSourceConfig(context.Context, *MsgUpdateReferenceSourceConfig) (*MsgUpdateReferenceSourceConfigResponse, error)
+	// UpdateSignalInfos is an RPC method to register or update metadata of signal ids.
+	UpdateSignalInfos(context.Context, *MsgUpdateSignalInfos) (*MsgUpdateSignalInfosResponse, error)
+	// UpdatePriceGuards is an RPC method to set or remove price guards of signal ids.
+	UpdatePriceGuards(context.Context, *MsgUpdatePriceGuards) (*MsgUpdatePriceGuardsResponse, error)
 	// UpdateParams is an RPC method to update parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
@@ -555,12 +1127,27 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
+func (*UnimplementedMsgServer) DelegateSignalVote(ctx context.Context, req *MsgDelegateSignalVote) (*MsgDelegateSignalVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateSignalVote not implemented")
+}
 func (*UnimplementedMsgServer) SubmitSignalPrices(ctx context.Context, req *MsgSubmitSignalPrices) (*MsgSubmitSignalPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignalPrices not implemented")
 }
+func (*UnimplementedMsgServer) CommitSignalPrices(ctx context.Context, req *MsgCommitSignalPrices) (*MsgCommitSignalPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitSignalPrices not implemented")
+}
+func (*UnimplementedMsgServer) RevealSignalPrices(ctx context.Context, req *MsgRevealSignalPrices) (*MsgRevealSignalPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealSignalPrices not implemented")
+}
 func (*UnimplementedMsgServer) UpdateReferenceSourceConfig(ctx context.Context, req *MsgUpdateReferenceSourceConfig) (*MsgUpdateReferenceSourceConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateReferenceSourceConfig not implemented")
 }
+func (*UnimplementedMsgServer) UpdateSignalInfos(ctx context.Context, req *MsgUpdateSignalInfos) (*MsgUpdateSignalInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSignalInfos not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePriceGuards(ctx context.Context, req *MsgUpdatePriceGuards) (*MsgUpdatePriceGuardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePriceGuards not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -587,6 +1174,24 @@ func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateSignalVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateSignalVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateSignalVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Msg/DelegateSignalVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateSignalVote(ctx, req.(*MsgDelegateSignalVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitSignalPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitSignalPrices)
 	if err := dec(in); err != nil {
@@ -605,6 +1210,42 @@ func _Msg_SubmitSignalPrices_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CommitSignalPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitSignalPrices)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitSignalPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Msg/CommitSignalPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitSignalPrices(ctx, req.(*MsgCommitSignalPrices))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealSignalPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealSignalPrices)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealSignalPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Msg/RevealSignalPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealSignalPrices(ctx, req.(*MsgRevealSignalPrices))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateReferenceSourceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateReferenceSourceConfig)
 	if err := dec(in); err != nil {
@@ -623,6 +1264,42 @@ func _Msg_UpdateReferenceSourceConfig_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateSignalInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSignalInfos)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSignalInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Msg/UpdateSignalInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSignalInfos(ctx, req.(*MsgUpdateSignalInfos))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePriceGuards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePriceGuards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePriceGuards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Msg/UpdatePriceGuards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePriceGuards(ctx, req.(*MsgUpdatePriceGuards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -641,6 +1318,7 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "band.feeds.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -649,14 +1327,34 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Vote",
 			Handler:    _Msg_Vote_Handler,
 		},
+		{
+			MethodName: "DelegateSignalVote",
+			Handler:    _Msg_DelegateSignalVote_Handler,
+		},
 		{
 			MethodName: "SubmitSignalPrices",
 			Handler:    _Msg_SubmitSignalPrices_Handler,
 		},
+		{
+			MethodName: "CommitSignalPrices",
+			Handler:    _Msg_CommitSignalPrices_Handler,
+		},
+		{
+			MethodName: "RevealSignalPrices",
+			Handler:    _Msg_RevealSignalPrices_Handler,
+		},
 		{
 			MethodName: "UpdateReferenceSourceConfig",
 			Handler:    _Msg_UpdateReferenceSourceConfig_Handler,
 		},
+		{
+			MethodName: "UpdateSignalInfos",
+			Handler:    _Msg_UpdateSignalInfos_Handler,
+		},
+		{
+			MethodName: "UpdatePriceGuards",
+			Handler:    _Msg_UpdatePriceGuards_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -733,7 +1431,7 @@ func (m *MsgVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitSignalPrices) Marshal() (dAtA []byte, err error) {
+func (m *MsgDelegateSignalVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -743,19 +1441,84 @@ func (m *MsgSubmitSignalPrices) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitSignalPrices) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDelegateSignalVote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitSignalPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDelegateSignalVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SignalPrices) > 0 {
-		for iNdEx := len(m.SignalPrices) - 1; iNdEx >= 0; iNdEx-- {
-			{
+	if m.Power != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateSignalVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateSignalVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateSignalVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSignalPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSignalPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSignalPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignalPrices) > 0 {
+		for iNdEx := len(m.SignalPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
 				size, err := m.SignalPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
@@ -805,6 +1568,140 @@ func (m *MsgSubmitSignalPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCommitSignalPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitSignalPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitSignalPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitSignalPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitSignalPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitSignalPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealSignalPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealSignalPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealSignalPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignalPrices) > 0 {
+		for iNdEx := len(m.SignalPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealSignalPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealSignalPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealSignalPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateReferenceSourceConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -868,7 +1765,7 @@ func (m *MsgUpdateReferenceSourceConfigResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateSignalInfos) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -878,37 +1775,41 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateSignalInfos) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateSignalInfos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.SignalInfos) > 0 {
+		for iNdEx := len(m.SignalInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateSignalInfosResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -918,12 +1819,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateSignalInfosResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateSignalInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -931,46 +1832,205 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdatePriceGuards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgVote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdatePriceGuards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePriceGuards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Signals) > 0 {
-		for _, e := range m.Signals {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.PriceGuards) > 0 {
+		for iNdEx := len(m.PriceGuards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceGuards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
-}
-
-func (m *MsgVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
 	}
-	var l int
-	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitSignalPrices) Size() (n int) {
+func (m *MsgUpdatePriceGuardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePriceGuardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePriceGuardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signals) > 0 {
+		for _, e := range m.Signals {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateSignalVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovTx(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *MsgDelegateSignalVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitSignalPrices) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1001,6 +2061,64 @@ func (m *MsgSubmitSignalPricesResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCommitSignalPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCommitSignalPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevealSignalPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SignalPrices) > 0 {
+		for _, e := range m.SignalPrices {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRevealSignalPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateReferenceSourceConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1025,6 +2143,62 @@ func (m *MsgUpdateReferenceSourceConfigResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateSignalInfos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SignalInfos) > 0 {
+		for _, e := range m.SignalInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateSignalInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePriceGuards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PriceGuards) > 0 {
+		for _, e := range m.PriceGuards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdatePriceGuardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1035,27 +2209,727 @@ func (m *MsgUpdateParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signals = append(m.Signals, Signal{})
+			if err := m.Signals[len(m.Signals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateSignalVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateSignalVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateSignalVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateSignalVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateSignalVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateSignalVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitSignalPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitSignalPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitSignalPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalPrices = append(m.SignalPrices, SignalPrice{})
+			if err := m.SignalPrices[len(m.SignalPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitSignalPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitSignalPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitSignalPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitSignalPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitSignalPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitSignalPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitSignalPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitSignalPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitSignalPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgVote) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealSignalPrices) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1078,15 +2952,15 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealSignalPrices: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealSignalPrices: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1114,11 +2988,45 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalPrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1145,8 +3053,8 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signals = append(m.Signals, Signal{})
-			if err := m.Signals[len(m.Signals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SignalPrices = append(m.SignalPrices, SignalPrice{})
+			if err := m.SignalPrices[len(m.SignalPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1171,7 +3079,7 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealSignalPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1194,10 +3102,10 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealSignalPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealSignalPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1221,7 +3129,7 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSignalPrices) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateReferenceSourceConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1244,15 +3152,15 @@ func (m *MsgSubmitSignalPrices) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSignalPrices: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateReferenceSourceConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSignalPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateReferenceSourceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1280,13 +3188,13 @@ func (m *MsgSubmitSignalPrices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceSourceConfig", wireType)
 			}
-			m.Timestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1296,14 +3204,160 @@ func (m *MsgSubmitSignalPrices) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferenceSourceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateReferenceSourceConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateReferenceSourceConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateReferenceSourceConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSignalInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSignalInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSignalInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1330,8 +3384,8 @@ func (m *MsgSubmitSignalPrices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalPrices = append(m.SignalPrices, SignalPrice{})
-			if err := m.SignalPrices[len(m.SignalPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SignalInfos = append(m.SignalInfos, SignalInfo{})
+			if err := m.SignalInfos[len(m.SignalInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1356,7 +3410,7 @@ func (m *MsgSubmitSignalPrices) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSignalPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSignalInfosResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1379,10 +3433,10 @@ func (m *MsgSubmitSignalPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSignalPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSignalInfosResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSignalPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSignalInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1406,7 +3460,7 @@ func (m *MsgSubmitSignalPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateReferenceSourceConfig) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePriceGuards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1429,10 +3483,10 @@ func (m *MsgUpdateReferenceSourceConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateReferenceSourceConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePriceGuards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateReferenceSourceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePriceGuards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1469,7 +3523,7 @@ func (m *MsgUpdateReferenceSourceConfig) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceSourceConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceGuards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1496,7 +3550,8 @@ func (m *MsgUpdateReferenceSourceConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReferenceSourceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PriceGuards = append(m.PriceGuards, PriceGuard{})
+			if err := m.PriceGuards[len(m.PriceGuards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1521,7 +3576,7 @@ func (m *MsgUpdateReferenceSourceConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateReferenceSourceConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePriceGuardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1544,10 +3599,10 @@ func (m *MsgUpdateReferenceSourceConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateReferenceSourceConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePriceGuardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateReferenceSourceConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePriceGuardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
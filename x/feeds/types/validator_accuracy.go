@@ -0,0 +1,76 @@
+package types
+
+import (
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewValidatorAccuracy creates new ValidatorAccuracy.
+func NewValidatorAccuracy(
+	val sdk.ValAddress,
+	evaluatedCount uint64,
+	outlierCount uint64,
+	totalDeviationBasisPoint uint64,
+) ValidatorAccuracy {
+	return ValidatorAccuracy{
+		Validator:                val.String(),
+		EvaluatedCount:           evaluatedCount,
+		OutlierCount:             outlierCount,
+		TotalDeviationBasisPoint: totalDeviationBasisPoint,
+	}
+}
+
+// ExceedsMaxOutlierRatio returns true if the ratio of outliers to evaluated prices is higher than
+// the given maximum ratio (in basis point).
+func (a ValidatorAccuracy) ExceedsMaxOutlierRatio(maxOutlierRatioBasisPoint uint64) bool {
+	if a.EvaluatedCount == 0 {
+		return false
+	}
+
+	return a.OutlierCount*10000 > maxOutlierRatioBasisPoint*a.EvaluatedCount
+}
+
+// AddEvaluation returns the accuracy with a new evaluated price of the given deviation (in basis point).
+func (a ValidatorAccuracy) AddEvaluation(deviationBasisPoint uint64, isOutlier bool) ValidatorAccuracy {
+	a.EvaluatedCount++
+	if isOutlier {
+		a.OutlierCount++
+	}
+
+	// saturate instead of overflowing the total deviation
+	if a.TotalDeviationBasisPoint > math.MaxUint64-deviationBasisPoint {
+		a.TotalDeviationBasisPoint = math.MaxUint64
+	} else {
+		a.TotalDeviationBasisPoint += deviationBasisPoint
+	}
+
+	return a
+}
+
+// CalculatePriceDeviationBasisPoint calculates the deviation (in basis point) of a price from the
+// reference price, i.e., |price - referencePrice| * 10000 / referencePrice. The deviation is capped
+// at the maximum uint64, including any deviation from a zero reference price.
+func CalculatePriceDeviationBasisPoint(price uint64, referencePrice uint64) uint64 {
+	diff := price - referencePrice
+	if price < referencePrice {
+		diff = referencePrice - price
+	}
+
+	if diff == 0 {
+		return 0
+	}
+
+	if referencePrice == 0 {
+		return math.MaxUint64
+	}
+
+	deviation := sdkmath.NewIntFromUint64(diff).MulRaw(10000).Quo(sdkmath.NewIntFromUint64(referencePrice))
+	if !deviation.IsUint64() {
+		return math.MaxUint64
+	}
+
+	return deviation.Uint64()
+}
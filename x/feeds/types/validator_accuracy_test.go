@@ -0,0 +1,34 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func TestCalculatePriceDeviationBasisPoint(t *testing.T) {
+	require.Equal(t, uint64(0), types.CalculatePriceDeviationBasisPoint(1000, 1000))
+	require.Equal(t, uint64(500), types.CalculatePriceDeviationBasisPoint(1050, 1000))
+	require.Equal(t, uint64(500), types.CalculatePriceDeviationBasisPoint(950, 1000))
+	require.Equal(t, uint64(math.MaxUint64), types.CalculatePriceDeviationBasisPoint(1, 0))
+	require.Equal(t, uint64(math.MaxUint64), types.CalculatePriceDeviationBasisPoint(math.MaxUint64, 1))
+}
+
+func TestValidatorAccuracy(t *testing.T) {
+	accuracy := types.ValidatorAccuracy{}
+	require.False(t, accuracy.ExceedsMaxOutlierRatio(0))
+
+	accuracy = accuracy.AddEvaluation(100, false)
+	accuracy = accuracy.AddEvaluation(2000, true)
+	require.Equal(t, types.ValidatorAccuracy{EvaluatedCount: 2, OutlierCount: 1, TotalDeviationBasisPoint: 2100}, accuracy)
+
+	require.True(t, accuracy.ExceedsMaxOutlierRatio(4999))
+	require.False(t, accuracy.ExceedsMaxOutlierRatio(5000))
+
+	// total deviation saturates
+	accuracy = accuracy.AddEvaluation(math.MaxUint64, true)
+	require.Equal(t, uint64(math.MaxUint64), accuracy.TotalDeviationBasisPoint)
+}
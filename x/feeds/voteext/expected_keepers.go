@@ -0,0 +1,29 @@
+package voteext
+
+import (
+	"context"
+
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// FeedsKeeper defines the expected feeds keeper.
+type FeedsKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	SubmitValidatorSignalPrices(
+		ctx sdk.Context,
+		val sdk.ValAddress,
+		signalPrices []types.SignalPrice,
+		skipTooEarly bool,
+	) error
+}
+
+// StakingKeeper defines the expected staking keeper.
+type StakingKeeper interface {
+	GetPubKeyByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (cmtprotocrypto.PublicKey, error)
+	GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error)
+}
@@ -0,0 +1,55 @@
+package voteext
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// DefaultPriceProviderTimeout is the default timeout of a request to the price server; it is kept short
+// as ExtendVote is on the critical path of the consensus.
+const DefaultPriceProviderTimeout = 500 * time.Millisecond
+
+// PriceProvider provides the signal prices of the validator to be attached to its vote extension.
+type PriceProvider interface {
+	GetSignalPrices() ([]types.SignalPrice, error)
+}
+
+var _ PriceProvider = &HTTPPriceProvider{}
+
+// HTTPPriceProvider fetches the signal prices from a price server, e.g. Grogu running in price server mode.
+type HTTPPriceProvider struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPPriceProvider creates a new HTTPPriceProvider instance.
+func NewHTTPPriceProvider(url string, timeout time.Duration) *HTTPPriceProvider {
+	return &HTTPPriceProvider{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// GetSignalPrices fetches the signal prices from the price server.
+func (p *HTTPPriceProvider) GetSignalPrices() ([]types.SignalPrice, error) {
+	resp, err := p.client.Get(p.url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code from price server: %d", resp.StatusCode)
+	}
+
+	var ve types.SignalPricesVoteExtension
+	if err := json.NewDecoder(resp.Body).Decode(&ve); err != nil {
+		return nil, err
+	}
+
+	return ve.SignalPrices, nil
+}
@@ -0,0 +1,206 @@
+package voteext
+
+import (
+	"bytes"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// ProposalHandler injects the vote extensions of the last commit into the block proposal and applies
+// the signal prices they carry before the block is executed.
+//
+// When vote extensions are enabled, the first transaction of a proposal is the marshaled
+// ExtendedCommitInfo of the last commit marked with ExtendedCommitInfoTxPrefix; the remaining
+// transactions are handled by the wrapped handlers.
+type ProposalHandler struct {
+	logger          log.Logger
+	feedsKeeper     FeedsKeeper
+	stakingKeeper   StakingKeeper
+	prepareProposal sdk.PrepareProposalHandler
+	processProposal sdk.ProcessProposalHandler
+}
+
+// ExtendedCommitInfoTxPrefix marks the transaction of a proposal that carries the extended commit info.
+// It starts with a zero byte, which is an invalid protobuf tag, so a marked transaction can never be
+// decoded as a regular transaction.
+var ExtendedCommitInfoTxPrefix = []byte("\x00feeds-extended-commit-info")
+
+// IsExtendedCommitInfoTx returns true if the transaction carries the extended commit info.
+func IsExtendedCommitInfoTx(tx []byte) bool {
+	return bytes.HasPrefix(tx, ExtendedCommitInfoTxPrefix)
+}
+
+// EncodeExtendedCommitInfoTx encodes the extended commit info into a marked transaction.
+func EncodeExtendedCommitInfoTx(extCommit abci.ExtendedCommitInfo) ([]byte, error) {
+	bz, err := extCommit.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(bytes.Clone(ExtendedCommitInfoTxPrefix), bz...), nil
+}
+
+// DecodeExtendedCommitInfoTx decodes the extended commit info from a marked transaction.
+func DecodeExtendedCommitInfoTx(tx []byte) (abci.ExtendedCommitInfo, error) {
+	var extCommit abci.ExtendedCommitInfo
+	if !IsExtendedCommitInfoTx(tx) {
+		return extCommit, fmt.Errorf("transaction is not marked as the extended commit info")
+	}
+
+	err := extCommit.Unmarshal(tx[len(ExtendedCommitInfoTxPrefix):])
+	return extCommit, err
+}
+
+// NewProposalHandler creates a new ProposalHandler instance.
+func NewProposalHandler(
+	logger log.Logger,
+	feedsKeeper FeedsKeeper,
+	stakingKeeper StakingKeeper,
+	prepareProposal sdk.PrepareProposalHandler,
+	processProposal sdk.ProcessProposalHandler,
+) *ProposalHandler {
+	return &ProposalHandler{
+		logger:          logger,
+		feedsKeeper:     feedsKeeper,
+		stakingKeeper:   stakingKeeper,
+		prepareProposal: prepareProposal,
+		processProposal: processProposal,
+	}
+}
+
+// PrepareProposalHandler returns the handler that prepends the extended commit info of the last commit
+// to the transactions selected by the wrapped handler.
+func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return h.prepareProposal(ctx, req)
+		}
+
+		extCommitBz, err := EncodeExtendedCommitInfoTx(req.LocalLastCommit)
+		if err != nil {
+			return nil, err
+		}
+
+		// reserve the space of the extended commit info from the transactions of the proposal
+		req.MaxTxBytes -= int64(len(extCommitBz))
+		if req.MaxTxBytes < 0 {
+			return nil, fmt.Errorf("extended commit info exceeds the max tx bytes: %d", len(extCommitBz))
+		}
+
+		resp, err := h.prepareProposal(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Txs = append([][]byte{extCommitBz}, resp.Txs...)
+		return resp, nil
+	}
+}
+
+// ProcessProposalHandler returns the handler that verifies the extended commit info of the proposal
+// and passes the remaining transactions to the wrapped handler.
+func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return h.processProposal(ctx, req)
+		}
+
+		reject := &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+		if len(req.Txs) == 0 {
+			h.logger.Debug("proposal does not contain the extended commit info", "height", req.Height)
+			return reject, nil
+		}
+
+		extCommit, err := DecodeExtendedCommitInfoTx(req.Txs[0])
+		if err != nil {
+			h.logger.Debug("failed to decode extended commit info", "height", req.Height, "error", err)
+			return reject, nil
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, h.stakingKeeper, req.Height, ctx.ChainID(), extCommit); err != nil {
+			h.logger.Debug("invalid vote extensions", "height", req.Height, "error", err)
+			return reject, nil
+		}
+
+		txsReq := *req
+		txsReq.Txs = req.Txs[1:]
+		return h.processProposal(ctx, &txsReq)
+	}
+}
+
+// PreBlocker submits the signal prices carried by the vote extensions of the block's extended commit
+// info on behalf of their validators. The extended commit info is only read if vote extensions were
+// enabled at the height of the block. A vote extension that cannot be applied is skipped.
+func (h *ProposalHandler) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) {
+	if !voteExtensionsEnabled(ctx, req.Height) || len(req.Txs) == 0 {
+		return
+	}
+
+	extCommit, err := DecodeExtendedCommitInfoTx(req.Txs[0])
+	if err != nil {
+		h.logger.Error("failed to decode extended commit info", "height", req.Height, "error", err)
+		return
+	}
+
+	for _, vote := range extCommit.Votes {
+		if len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		if err := h.applyVoteExtension(ctx, sdk.ConsAddress(vote.Validator.Address), vote.VoteExtension); err != nil {
+			h.logger.Debug("failed to apply vote extension", "height", req.Height, "error", err)
+		}
+	}
+}
+
+// applyVoteExtension submits the signal prices of a vote extension; signal prices still in the cooldown
+// time are skipped and the state is left untouched on any error. Vote extensions are not applied while
+// commit-reveal is enabled, since their signal prices were never committed.
+func (h *ProposalHandler) applyVoteExtension(ctx sdk.Context, consAddr sdk.ConsAddress, bz []byte) error {
+	if h.feedsKeeper.GetParams(ctx).IsCommitRevealEnabled() {
+		return types.ErrCommitRevealRequired
+	}
+
+	var ve types.SignalPricesVoteExtension
+	if err := ve.Unmarshal(bz); err != nil {
+		return err
+	}
+
+	if err := types.ValidateSignalPrices(ve.SignalPrices); err != nil {
+		return err
+	}
+
+	validator, err := h.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+
+	val, err := sdk.ValAddressFromBech32(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := h.feedsKeeper.SubmitValidatorSignalPrices(cacheCtx, val, ve.SignalPrices, true); err != nil {
+		return err
+	}
+	writeCache()
+
+	return nil
+}
+
+// voteExtensionsEnabled returns true if the vote extensions of the last commit are available at the
+// given height.
+func voteExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight != 0 &&
+		height > cp.Abci.VoteExtensionsEnableHeight
+}
@@ -0,0 +1,157 @@
+package voteext_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+	"github.com/bandprotocol/chain/v3/x/feeds/voteext"
+)
+
+func TestPrepareProposalHandler(t *testing.T) {
+	h := voteext.NewProposalHandler(
+		log.NewNopLogger(),
+		&mockFeedsKeeper{},
+		mockStakingKeeper{},
+		baseapp.NoOpPrepareProposal(),
+		baseapp.NoOpProcessProposal(),
+	)
+
+	extCommit := abci.ExtendedCommitInfo{Round: 1}
+	extCommitBz, err := voteext.EncodeExtendedCommitInfoTx(extCommit)
+	require.NoError(t, err)
+
+	req := &abci.RequestPrepareProposal{
+		Height:          10,
+		Txs:             [][]byte{[]byte("tx1")},
+		MaxTxBytes:      1000,
+		LocalLastCommit: extCommit,
+	}
+
+	// the extended commit info is injected as the first transaction
+	resp, err := h.PrepareProposalHandler()(newContext(), req)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{extCommitBz, []byte("tx1")}, resp.Txs)
+	require.True(t, voteext.IsExtendedCommitInfoTx(resp.Txs[0]))
+	require.False(t, voteext.IsExtendedCommitInfoTx(resp.Txs[1]))
+
+	// nothing is injected when vote extensions are disabled
+	ctx := newContext().WithConsensusParams(cmtproto.ConsensusParams{})
+	resp, err = h.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{Txs: [][]byte{[]byte("tx1")}})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("tx1")}, resp.Txs)
+}
+
+func TestProcessProposalHandlerRejectsMissingExtendedCommitInfo(t *testing.T) {
+	h := voteext.NewProposalHandler(
+		log.NewNopLogger(),
+		&mockFeedsKeeper{},
+		mockStakingKeeper{},
+		baseapp.NoOpPrepareProposal(),
+		baseapp.NoOpProcessProposal(),
+	)
+
+	resp, err := h.ProcessProposalHandler()(newContext(), &abci.RequestProcessProposal{Height: 10})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, resp.Status)
+
+	resp, err = h.ProcessProposalHandler()(
+		newContext(),
+		&abci.RequestProcessProposal{Height: 10, Txs: [][]byte{[]byte("malformed")}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, resp.Status)
+
+	// the extended commit info must be marked
+	extCommitBz, err := (&abci.ExtendedCommitInfo{}).Marshal()
+	require.NoError(t, err)
+	resp, err = h.ProcessProposalHandler()(
+		newContext(),
+		&abci.RequestProcessProposal{Height: 10, Txs: [][]byte{extCommitBz}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, resp.Status)
+}
+
+func TestPreBlocker(t *testing.T) {
+	feedsKeeper := &mockFeedsKeeper{submitted: make(map[string][]types.SignalPrice)}
+	h := voteext.NewProposalHandler(
+		log.NewNopLogger(),
+		feedsKeeper,
+		mockStakingKeeper{},
+		baseapp.NoOpPrepareProposal(),
+		baseapp.NoOpProcessProposal(),
+	)
+
+	signalPrices := []types.SignalPrice{
+		types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1e9),
+	}
+	extCommit := abci.ExtendedCommitInfo{
+		Votes: []abci.ExtendedVoteInfo{
+			{
+				Validator:     abci.Validator{Address: validConsAddr},
+				VoteExtension: mustMarshalVoteExtension(t, signalPrices),
+			},
+			{
+				// unknown validator
+				Validator:     abci.Validator{Address: sdk.ConsAddress("consensus_address_2")},
+				VoteExtension: mustMarshalVoteExtension(t, signalPrices),
+			},
+			{
+				// empty vote extension
+				Validator: abci.Validator{Address: validConsAddr},
+			},
+		},
+	}
+	extCommitBz, err := voteext.EncodeExtendedCommitInfoTx(extCommit)
+	require.NoError(t, err)
+
+	// the extended commit info is not read at a height whose proposal has no vote extensions
+	h.PreBlocker(newContext(), &abci.RequestFinalizeBlock{Height: 1, Txs: [][]byte{extCommitBz}})
+	require.Empty(t, feedsKeeper.submitted)
+
+	// an unmarked transaction is not read as the extended commit info
+	unmarkedBz, err := extCommit.Marshal()
+	require.NoError(t, err)
+	h.PreBlocker(newContext(), &abci.RequestFinalizeBlock{Height: 10, Txs: [][]byte{unmarkedBz}})
+	require.Empty(t, feedsKeeper.submitted)
+
+	h.PreBlocker(newContext(), &abci.RequestFinalizeBlock{Height: 10, Txs: [][]byte{extCommitBz}})
+	require.Equal(t, map[string][]types.SignalPrice{validVal.String(): signalPrices}, feedsKeeper.submitted)
+}
+
+func TestPreBlockerWithCommitReveal(t *testing.T) {
+	feedsKeeper := &mockFeedsKeeper{submitted: make(map[string][]types.SignalPrice), commitReveal: true}
+	h := voteext.NewProposalHandler(
+		log.NewNopLogger(),
+		feedsKeeper,
+		mockStakingKeeper{},
+		baseapp.NoOpPrepareProposal(),
+		baseapp.NoOpProcessProposal(),
+	)
+
+	extCommit := abci.ExtendedCommitInfo{
+		Votes: []abci.ExtendedVoteInfo{
+			{
+				Validator: abci.Validator{Address: validConsAddr},
+				VoteExtension: mustMarshalVoteExtension(t, []types.SignalPrice{
+					types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1e9),
+				}),
+			},
+		},
+	}
+	extCommitBz, err := voteext.EncodeExtendedCommitInfoTx(extCommit)
+	require.NoError(t, err)
+
+	// signal prices of vote extensions are not submitted while commit-reveal is enabled
+	h.PreBlocker(newContext(), &abci.RequestFinalizeBlock{Height: 10, Txs: [][]byte{extCommitBz}})
+	require.Empty(t, feedsKeeper.submitted)
+}
@@ -0,0 +1,102 @@
+package voteext
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// VoteExtHandler handles the ExtendVote and VerifyVoteExtension requests to deliver the signal prices
+// of validators through vote extensions.
+type VoteExtHandler struct {
+	logger        log.Logger
+	priceProvider PriceProvider
+	feedsKeeper   FeedsKeeper
+}
+
+// NewVoteExtHandler creates a new VoteExtHandler instance. The price provider may be nil, in which
+// case the validator attaches an empty vote extension.
+func NewVoteExtHandler(logger log.Logger, priceProvider PriceProvider, feedsKeeper FeedsKeeper) *VoteExtHandler {
+	return &VoteExtHandler{
+		logger:        logger,
+		priceProvider: priceProvider,
+		feedsKeeper:   feedsKeeper,
+	}
+}
+
+// ExtendVoteHandler returns the handler that attaches the signal prices of the validator to its vote.
+// Any failure results in an empty vote extension so that the validator never fails to vote. No signal
+// prices are attached while commit-reveal is enabled, as they must be committed before being revealed.
+func (h *VoteExtHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		empty := &abci.ResponseExtendVote{VoteExtension: []byte{}}
+		if h.priceProvider == nil || h.feedsKeeper.GetParams(ctx).IsCommitRevealEnabled() {
+			return empty, nil
+		}
+
+		signalPrices, err := h.priceProvider.GetSignalPrices()
+		if err != nil {
+			h.logger.Error("failed to get signal prices for vote extension", "height", req.Height, "error", err)
+			return empty, nil
+		}
+
+		ve := types.SignalPricesVoteExtension{SignalPrices: signalPrices}
+		if err := h.validateVoteExtension(ctx, ve); err != nil {
+			h.logger.Error("invalid signal prices for vote extension", "height", req.Height, "error", err)
+			return empty, nil
+		}
+
+		bz, err := ve.Marshal()
+		if err != nil {
+			h.logger.Error("failed to marshal vote extension", "height", req.Height, "error", err)
+			return empty, nil
+		}
+
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns the handler that verifies the vote extension of other validators.
+// An empty vote extension is always accepted, while a non-empty one is rejected if commit-reveal is enabled.
+func (h *VoteExtHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if len(req.VoteExtension) == 0 {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+		}
+
+		if h.feedsKeeper.GetParams(ctx).IsCommitRevealEnabled() {
+			h.logger.Debug("vote extension is not allowed while commit-reveal is enabled", "height", req.Height)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		var ve types.SignalPricesVoteExtension
+		if err := ve.Unmarshal(req.VoteExtension); err != nil {
+			h.logger.Debug("failed to unmarshal vote extension", "height", req.Height, "error", err)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		if err := h.validateVoteExtension(ctx, ve); err != nil {
+			h.logger.Debug("invalid vote extension", "height", req.Height, "error", err)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+// validateVoteExtension checks that the number of signal prices does not exceed the maximum number of
+// current feeds and that each signal price is valid.
+func (h *VoteExtHandler) validateVoteExtension(ctx sdk.Context, ve types.SignalPricesVoteExtension) error {
+	maxCurrentFeeds := h.feedsKeeper.GetParams(ctx).MaxCurrentFeeds
+	if uint64(len(ve.SignalPrices)) > maxCurrentFeeds {
+		return fmt.Errorf("too many signal prices: %d > %d", len(ve.SignalPrices), maxCurrentFeeds)
+	}
+
+	return types.ValidateSignalPrices(ve.SignalPrices)
+}
@@ -0,0 +1,221 @@
+package voteext_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+	"github.com/bandprotocol/chain/v3/x/feeds/voteext"
+)
+
+var (
+	validConsAddr = sdk.ConsAddress("consensus_address_1")
+	validVal      = sdk.ValAddress("1000000001")
+)
+
+type mockPriceProvider struct {
+	signalPrices []types.SignalPrice
+	err          error
+}
+
+func (p mockPriceProvider) GetSignalPrices() ([]types.SignalPrice, error) {
+	return p.signalPrices, p.err
+}
+
+type mockFeedsKeeper struct {
+	submitted    map[string][]types.SignalPrice
+	commitReveal bool
+}
+
+func (k *mockFeedsKeeper) GetParams(_ sdk.Context) types.Params {
+	params := types.DefaultParams()
+	params.MaxCurrentFeeds = 2
+	if k.commitReveal {
+		params.CommitWindow = 30
+		params.RevealWindow = 30
+	}
+	return params
+}
+
+func (k *mockFeedsKeeper) SubmitValidatorSignalPrices(
+	_ sdk.Context,
+	val sdk.ValAddress,
+	signalPrices []types.SignalPrice,
+	_ bool,
+) error {
+	k.submitted[val.String()] = signalPrices
+	return nil
+}
+
+type mockStakingKeeper struct{}
+
+func (mockStakingKeeper) GetPubKeyByConsAddr(_ context.Context, _ sdk.ConsAddress) (cmtprotocrypto.PublicKey, error) {
+	return cmtprotocrypto.PublicKey{}, errors.New("not implemented")
+}
+
+func (mockStakingKeeper) GetValidatorByConsAddr(
+	_ context.Context,
+	consAddr sdk.ConsAddress,
+) (stakingtypes.Validator, error) {
+	if !consAddr.Equals(validConsAddr) {
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
+	return stakingtypes.Validator{OperatorAddress: validVal.String()}, nil
+}
+
+func newContext() sdk.Context {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	return testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test")).
+		WithBlockHeight(10).
+		WithConsensusParams(cmtproto.ConsensusParams{Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 1}})
+}
+
+func mustMarshalVoteExtension(t *testing.T, signalPrices []types.SignalPrice) []byte {
+	bz, err := (&types.SignalPricesVoteExtension{SignalPrices: signalPrices}).Marshal()
+	require.NoError(t, err)
+	return bz
+}
+
+func TestExtendVoteHandler(t *testing.T) {
+	ctx := newContext()
+	feedsKeeper := &mockFeedsKeeper{}
+	signalPrices := []types.SignalPrice{
+		types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1e9),
+	}
+
+	testCases := []struct {
+		name          string
+		priceProvider voteext.PriceProvider
+		expVoteExt    []byte
+	}{
+		{
+			name:          "no price provider",
+			priceProvider: nil,
+			expVoteExt:    []byte{},
+		},
+		{
+			name:          "price provider error",
+			priceProvider: mockPriceProvider{err: errors.New("connection refused")},
+			expVoteExt:    []byte{},
+		},
+		{
+			name: "invalid signal prices",
+			priceProvider: mockPriceProvider{signalPrices: []types.SignalPrice{
+				signalPrices[0],
+				signalPrices[0],
+			}},
+			expVoteExt: []byte{},
+		},
+		{
+			name:          "valid signal prices",
+			priceProvider: mockPriceProvider{signalPrices: signalPrices},
+			expVoteExt:    mustMarshalVoteExtension(t, signalPrices),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := voteext.NewVoteExtHandler(log.NewNopLogger(), tc.priceProvider, feedsKeeper)
+			resp, err := h.ExtendVoteHandler()(ctx, &abci.RequestExtendVote{Height: 10})
+			require.NoError(t, err)
+			require.Equal(t, tc.expVoteExt, resp.VoteExtension)
+		})
+	}
+}
+
+func TestVoteExtensionWithCommitReveal(t *testing.T) {
+	ctx := newContext()
+	feedsKeeper := &mockFeedsKeeper{commitReveal: true}
+	signalPrices := []types.SignalPrice{
+		types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1e9),
+	}
+	h := voteext.NewVoteExtHandler(log.NewNopLogger(), mockPriceProvider{signalPrices: signalPrices}, feedsKeeper)
+
+	// no signal prices are attached while commit-reveal is enabled
+	extResp, err := h.ExtendVoteHandler()(ctx, &abci.RequestExtendVote{Height: 10})
+	require.NoError(t, err)
+	require.Equal(t, []byte{}, extResp.VoteExtension)
+
+	// an empty vote extension is still accepted
+	verifyResp, err := h.VerifyVoteExtensionHandler()(ctx, &abci.RequestVerifyVoteExtension{Height: 10})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseVerifyVoteExtension_ACCEPT, verifyResp.Status)
+
+	// a vote extension carrying signal prices is rejected
+	verifyResp, err = h.VerifyVoteExtensionHandler()(
+		ctx,
+		&abci.RequestVerifyVoteExtension{Height: 10, VoteExtension: mustMarshalVoteExtension(t, signalPrices)},
+	)
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseVerifyVoteExtension_REJECT, verifyResp.Status)
+}
+
+func TestVerifyVoteExtensionHandler(t *testing.T) {
+	ctx := newContext()
+	h := voteext.NewVoteExtHandler(log.NewNopLogger(), nil, &mockFeedsKeeper{})
+
+	testCases := []struct {
+		name      string
+		voteExt   []byte
+		expStatus abci.ResponseVerifyVoteExtension_VerifyStatus
+	}{
+		{
+			name:      "empty vote extension",
+			voteExt:   nil,
+			expStatus: abci.ResponseVerifyVoteExtension_ACCEPT,
+		},
+		{
+			name:      "malformed vote extension",
+			voteExt:   []byte("malformed"),
+			expStatus: abci.ResponseVerifyVoteExtension_REJECT,
+		},
+		{
+			name: "too many signal prices",
+			voteExt: mustMarshalVoteExtension(t, []types.SignalPrice{
+				types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1e9),
+				types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "CS:ETH-USD", 1e9),
+				types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "CS:BTC-USD", 1e9),
+			}),
+			expStatus: abci.ResponseVerifyVoteExtension_REJECT,
+		},
+		{
+			name: "unavailable signal price with price",
+			voteExt: mustMarshalVoteExtension(t, []types.SignalPrice{
+				types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_UNAVAILABLE, "CS:BAND-USD", 1e9),
+			}),
+			expStatus: abci.ResponseVerifyVoteExtension_REJECT,
+		},
+		{
+			name: "valid vote extension",
+			voteExt: mustMarshalVoteExtension(t, []types.SignalPrice{
+				types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1e9),
+				types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_UNSUPPORTED, "CS:ETH-USD", 0),
+			}),
+			expStatus: abci.ResponseVerifyVoteExtension_ACCEPT,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := h.VerifyVoteExtensionHandler()(
+				ctx,
+				&abci.RequestVerifyVoteExtension{Height: 10, VoteExtension: tc.voteExt},
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expStatus, resp.Status)
+		})
+	}
+}
@@ -150,6 +150,22 @@ func (fc FeeChecker) IsBypassMinFeeMsg(ctx sdk.Context, msg sdk.Msg) bool {
 		if _, err := fc.FeedsMsgServer.SubmitSignalPrices(ctx, msg); err != nil {
 			return false
 		}
+	case *feedstypes.MsgCommitSignalPrices:
+		if err := checkValidatorRequiredToSend(ctx, fc.FeedsKeeper, msg.Validator); err != nil {
+			return false
+		}
+
+		if _, err := fc.FeedsMsgServer.CommitSignalPrices(ctx, msg); err != nil {
+			return false
+		}
+	case *feedstypes.MsgRevealSignalPrices:
+		if err := checkValidatorRequiredToSend(ctx, fc.FeedsKeeper, msg.Validator); err != nil {
+			return false
+		}
+
+		if _, err := fc.FeedsMsgServer.RevealSignalPrices(ctx, msg); err != nil {
+			return false
+		}
 	case *tsstypes.MsgSubmitDKGRound1:
 		if _, err := fc.TSSMsgServer.SubmitDKGRound1(ctx, msg); err != nil {
 			return false
@@ -787,6 +787,69 @@ func (suite *FeeCheckerTestSuite) TestIsBypassMinFeeTxAndCheckTxFee() {
 	}
 }
 
+func (suite *FeeCheckerTestSuite) TestCommitRevealSignalPricesBypass() {
+	params := feedstypes.DefaultParams()
+	params.CommitWindow = 60
+	params.RevealWindow = 60
+	suite.Require().NoError(suite.FeeChecker.FeedsKeeper.SetParams(suite.ctx, params))
+
+	salt := []byte("salt")
+	validator := bandtesting.Validators[0].ValAddress
+	nonValidator := bandtesting.Alice.ValAddress
+
+	// commit phase of round 1
+	commitCtx := suite.ctx.WithBlockTime(time.Unix(120, 0))
+
+	// a bonded validator commits its signal prices without fee
+	commitMsg := feedstypes.NewMsgCommitSignalPrices(
+		validator.String(),
+		feedstypes.CalculateSignalPricesCommitHash(validator, salt, nil),
+	)
+	suite.Require().True(suite.FeeChecker.IsBypassMinFeeTx(commitCtx, &StubTx{Msgs: []sdk.Msg{commitMsg}}))
+
+	// a commit of an account that is not a validator is rejected before reaching the msg server
+	nonValidatorCommitTx := &StubTx{
+		Msgs: []sdk.Msg{
+			feedstypes.NewMsgCommitSignalPrices(
+				nonValidator.String(),
+				feedstypes.CalculateSignalPricesCommitHash(nonValidator, salt, nil),
+			),
+		},
+	}
+	suite.Require().False(suite.FeeChecker.IsBypassMinFeeTx(commitCtx, nonValidatorCommitTx))
+	_, _, err := suite.FeeChecker.CheckTxFee(commitCtx, nonValidatorCommitTx)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	// reveal phase of round 1
+	revealCtx := suite.ctx.WithBlockTime(time.Unix(180, 0))
+	suite.FeeChecker.FeedsKeeper.SetCommitment(
+		revealCtx,
+		validator,
+		feedstypes.NewSignalPricesCommitment(validator, commitMsg.Hash, 1),
+	)
+	suite.FeeChecker.FeedsKeeper.SetCommitment(
+		revealCtx,
+		nonValidator,
+		feedstypes.NewSignalPricesCommitment(
+			nonValidator,
+			feedstypes.CalculateSignalPricesCommitHash(nonValidator, salt, nil),
+			1,
+		),
+	)
+
+	// a bonded validator reveals its signal prices without fee
+	revealMsg := feedstypes.NewMsgRevealSignalPrices(validator.String(), salt, nil)
+	suite.Require().True(suite.FeeChecker.IsBypassMinFeeTx(revealCtx, &StubTx{Msgs: []sdk.Msg{revealMsg}}))
+
+	// a reveal of an account that is not a validator is rejected even if it has a commitment
+	nonValidatorRevealTx := &StubTx{
+		Msgs: []sdk.Msg{feedstypes.NewMsgRevealSignalPrices(nonValidator.String(), salt, nil)},
+	}
+	suite.Require().False(suite.FeeChecker.IsBypassMinFeeTx(revealCtx, nonValidatorRevealTx))
+	_, _, err = suite.FeeChecker.CheckTxFee(revealCtx, nonValidatorRevealTx)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+}
+
 func (suite *FeeCheckerTestSuite) TestDefaultZeroGlobalFee() {
 	coins, err := suite.FeeChecker.DefaultZeroGlobalFee(suite.ctx)
 
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	feedskeeper "github.com/bandprotocol/chain/v3/x/feeds/keeper"
 	oraclekeeper "github.com/bandprotocol/chain/v3/x/oracle/keeper"
 	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
 )
@@ -66,3 +67,14 @@ func checkValidMsgReport(ctx sdk.Context, oracleKeeper *oraclekeeper.Keeper, msg
 
 	return oracleKeeper.CheckValidReport(ctx, msg.RequestID, validator, msg.RawReports)
 }
+
+// checkValidatorRequiredToSend checks that the validator of a signal prices message is a bonded validator with an
+// active oracle status, which is required for the message to bypass the min fee.
+func checkValidatorRequiredToSend(ctx sdk.Context, feedsKeeper *feedskeeper.Keeper, validator string) error {
+	val, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return err
+	}
+
+	return feedsKeeper.ValidateValidatorRequiredToSend(ctx, val)
+}
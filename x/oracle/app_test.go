@@ -11,18 +11,46 @@ import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 
 	band "github.com/bandprotocol/chain/v3/app"
+	"github.com/bandprotocol/chain/v3/app/keepers"
 	bandtesting "github.com/bandprotocol/chain/v3/testing"
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
 )
 
+const appTestCallbackModule = "apptest"
+
+// appTestOracleHooks is an OracleHooks implementation of a module consuming oracle results that
+// records every call of its hook.
+type appTestOracleHooks struct {
+	ids        []types.RequestID
+	requesters []sdk.AccAddress
+	payloads   [][]byte
+	results    []types.Result
+}
+
+func (h *appTestOracleHooks) AfterRequestResolved(
+	_ sdk.Context,
+	id types.RequestID,
+	requester sdk.AccAddress,
+	payload []byte,
+	result types.Result,
+) error {
+	h.ids = append(h.ids, id)
+	h.requesters = append(h.requesters, requester)
+	h.payloads = append(h.payloads, payload)
+	h.results = append(h.results, result)
+	return nil
+}
+
 type AppTestSuite struct {
 	suite.Suite
 
-	app *band.BandApp
+	app         *band.BandApp
+	oracleHooks *appTestOracleHooks
 }
 
 func TestAppTestSuite(t *testing.T) {
@@ -31,7 +59,10 @@ func TestAppTestSuite(t *testing.T) {
 
 func (s *AppTestSuite) SetupTest() {
 	dir := testutil.GetTempDir(s.T())
-	s.app = bandtesting.SetupWithCustomHome(false, dir)
+	s.oracleHooks = &appTestOracleHooks{}
+	s.app = bandtesting.SetupWithCustomHomeAndAppOptions(false, dir, bandtesting.ChainID, sims.AppOptionsMap{
+		keepers.OracleHooksAppOption: map[string]types.OracleHooks{appTestCallbackModule: s.oracleHooks},
+	})
 	ctx := s.app.BaseApp.NewUncachedContext(false, cmtproto.Header{})
 
 	// Activate validators
@@ -109,6 +140,11 @@ func (s *AppTestSuite) TestSuccessRequestOracleData() {
 		bandtesting.Validators[0].Address.String(),
 		nil,
 	)
+	// Requests record the versions of the oracle script and data sources they use.
+	expectRequest.OracleScriptVersion = 1
+	for i := range expectRequest.RawRequests {
+		expectRequest.RawRequests[i].DataSourceVersion = 1
+	}
 
 	request, err := s.app.OracleKeeper.GetRequest(ctx, types.RequestID(1))
 	require.NoError(err)
@@ -296,6 +332,11 @@ func (s *AppTestSuite) TestExpiredRequestOracleData() {
 		bandtesting.Validators[0].Address.String(),
 		nil,
 	)
+	// Requests record the versions of the oracle script and data sources they use.
+	expectRequest.OracleScriptVersion = 1
+	for i := range expectRequest.RawRequests {
+		expectRequest.RawRequests[i].DataSourceVersion = 1
+	}
 
 	request, err := s.app.OracleKeeper.GetRequest(ctx, types.RequestID(1))
 	require.NoError(err)
@@ -341,3 +382,76 @@ func (s *AppTestSuite) TestExpiredRequestOracleData() {
 
 	require.Equal(expectEvents, result.Events)
 }
+
+func (s *AppTestSuite) TestRequestOracleDataWithCallback() {
+	require := s.Require()
+	ctx := s.app.BaseApp.NewUncachedContext(false, cmtproto.Header{})
+	txConfig := moduletestutil.MakeTestTxConfig()
+
+	requestMsg := types.NewMsgRequestData(
+		types.OracleScriptID(1),
+		[]byte("calldata"),
+		3,
+		2,
+		"app_test",
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 9000000)),
+		bandtesting.TestDefaultPrepareGas,
+		bandtesting.TestDefaultExecuteGas,
+		bandtesting.Validators[0].Address,
+		0,
+	)
+	requestMsg.Callback = types.NewRequestCallback(appTestCallbackModule, []byte("payload"))
+
+	acc := s.app.AccountKeeper.GetAccount(ctx, bandtesting.Validators[0].Address)
+	_, _, _, err := bandtesting.SignCheckDeliver(
+		s.T(),
+		txConfig,
+		s.app.BaseApp,
+		cmtproto.Header{Height: s.app.LastBlockHeight() + 1, Time: time.Unix(1581589790, 0)},
+		[]sdk.Msg{requestMsg},
+		s.app.ChainID(),
+		[]uint64{acc.GetAccountNumber()},
+		[]uint64{acc.GetSequence()},
+		true,
+		true,
+		bandtesting.Validators[0].PrivKey,
+	)
+	require.NoError(err)
+
+	// the request is resolved once enough validators report
+	for i, val := range []bandtesting.Account{bandtesting.Validators[0], bandtesting.Validators[1]} {
+		reportMsg := types.NewMsgReportData(
+			types.RequestID(1), []types.RawReport{
+				types.NewRawReport(1, 0, []byte("answer1")),
+				types.NewRawReport(2, 0, []byte("answer2")),
+				types.NewRawReport(3, 0, []byte("answer3")),
+			},
+			val.ValAddress,
+		)
+
+		acc := s.app.AccountKeeper.GetAccount(ctx, val.Address)
+		_, _, _, err := bandtesting.SignCheckDeliver(
+			s.T(),
+			txConfig,
+			s.app.BaseApp,
+			cmtproto.Header{Height: s.app.LastBlockHeight() + 1, Time: time.Unix(1581589791+int64(i), 0)},
+			[]sdk.Msg{reportMsg},
+			s.app.ChainID(),
+			[]uint64{acc.GetAccountNumber()},
+			[]uint64{acc.GetSequence()},
+			true,
+			true,
+			val.PrivKey,
+		)
+		require.NoError(err)
+	}
+
+	// the module registered under the callback route receives the requester, the payload and the result
+	result, err := s.app.OracleKeeper.GetResult(ctx, types.RequestID(1))
+	require.NoError(err)
+	require.Equal(types.RESOLVE_STATUS_SUCCESS, result.ResolveStatus)
+	require.Equal([]types.RequestID{1}, s.oracleHooks.ids)
+	require.Equal([]sdk.AccAddress{bandtesting.Validators[0].Address}, s.oracleHooks.requesters)
+	require.Equal([][]byte{[]byte("payload")}, s.oracleHooks.payloads)
+	require.Equal([]types.Result{result}, s.oracleHooks.results)
+}
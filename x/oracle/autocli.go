@@ -35,6 +35,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Get summary information of an oracle script",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "oracle_script_id"}},
 				},
+				{
+					RpcMethod:      "DataSourceVersions",
+					Use:            "data-source-versions [id]",
+					Short:          "Get the version history of a data source",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "data_source_id"}},
+				},
+				{
+					RpcMethod:      "OracleScriptVersions",
+					Use:            "oracle-script-versions [id]",
+					Short:          "Get the version history of an oracle script",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "oracle_script_id"}},
+				},
 				{
 					RpcMethod:      "Request",
 					Use:            "request [id]",
@@ -78,6 +90,27 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Get current parameters of Bandchain's oracle module",
 				},
+				{
+					RpcMethod: "RequestSearch",
+					Use:       "request-search [oracle-script-id] [calldata-hex] [ask-count] [min-count]",
+					Short:     "Get the latest successful request of an oracle script with given calldata, ask count and min count",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "oracle_script_id"},
+						{ProtoField: "calldata"},
+						{ProtoField: "ask_count"},
+						{ProtoField: "min_count"},
+					},
+				},
+				{
+					RpcMethod: "RequestPrice",
+					Use:       "request-price [ask-count] [min-count] [symbol1] [symbol2] ...",
+					Short:     "Get the latest prices of symbols from the standard price reference oracle script",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "ask_count"},
+						{ProtoField: "min_count"},
+						{ProtoField: "symbols", Varargs: true},
+					},
+				},
 				{
 					RpcMethod: "RequestVerification",
 					Use:       "verify-request [chain-id] [validator-addr] [request-id] [data-source-external-id] [reporter-pubkey] [reporter-signature-hex]",
@@ -91,6 +124,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "signature"},
 					},
 				},
+				{
+					RpcMethod:      "Subscription",
+					Use:            "subscription [id]",
+					Short:          "Get a subscription and its funding balance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subscription_id"}},
+				},
+				{
+					RpcMethod: "Subscriptions",
+					Use:       "subscriptions",
+					Short:     "Get all subscriptions",
+				},
+				{
+					// Served by the custom `bandd oracle simulate` command.
+					RpcMethod: "SimulateRequest",
+					Skip:      true,
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -36,6 +36,15 @@ const (
 	flagFee           = "fee"
 	flagTreasury      = "treasury"
 	flagExpiration    = "expiration"
+
+	flagCallbackModule  = "callback-module"
+	flagCallbackPayload = "callback-payload"
+
+	flagBlockInterval = "block-interval"
+	flagTimeInterval  = "time-interval"
+	flagDeposit       = "deposit"
+
+	flagOracleScriptVersion = "oracle-script-version"
 )
 
 // NewTxCmd returns the transaction commands for this module
@@ -56,6 +65,9 @@ func NewTxCmd() *cobra.Command {
 		GetCmdActivate(),
 		GetCmdAddReporters(),
 		GetCmdRemoveReporters(),
+		GetCmdCreateSubscription(),
+		GetCmdUpdateSubscription(),
+		GetCmdCancelSubscription(),
 	)
 
 	return txCmd
@@ -64,7 +76,7 @@ func NewTxCmd() *cobra.Command {
 // GetCmdRequest implements the request command handler.
 func GetCmdRequest() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "request [oracle-script-id] [ask-count] [min-count] (-c [calldata]) (-m [client-id]) (--prepare-gas=[prepare-gas] (--execute-gas=[execute-gas])) (--fee-limit=[fee-limit])",
+		Use:   "request [oracle-script-id] [ask-count] [min-count] (-c [calldata]) (-m [client-id]) (--prepare-gas=[prepare-gas] (--execute-gas=[execute-gas])) (--fee-limit=[fee-limit]) (--callback-module=[module] (--callback-payload=[payload])) (--oracle-script-version=[version])",
 		Short: "Make a new data request via an existing oracle script",
 		Args:  cobra.ExactArgs(3),
 		Long: strings.TrimSpace(
@@ -146,6 +158,25 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --fee-l
 				types.Encoder(tssEncoder),
 			)
 
+			callbackModule, err := cmd.Flags().GetString(flagCallbackModule)
+			if err != nil {
+				return err
+			}
+
+			callbackPayload, err := cmd.Flags().GetBytesHex(flagCallbackPayload)
+			if err != nil {
+				return err
+			}
+
+			if callbackModule != "" {
+				msg.Callback = types.NewRequestCallback(callbackModule, callbackPayload)
+			}
+
+			msg.OracleScriptVersion, err = cmd.Flags().GetUint64(flagOracleScriptVersion)
+			if err != nil {
+				return err
+			}
+
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -162,6 +193,9 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --fee-l
 		String(flagFeeLimit, "", "The maximum tokens paid to all data source and TSS signature providers, if any")
 	cmd.Flags().
 		Int32(flagTSSEncoder, 0, "The encode type of oracle result that will be sent to TSS (1=proto, 2=ABI, 3=Partial ABI)")
+	cmd.Flags().String(flagCallbackModule, "", "The module route to be notified when the request is resolved")
+	cmd.Flags().BytesHex(flagCallbackPayload, nil, "Payload passed back to the callback module alongside the result")
+	cmd.Flags().Uint64(flagOracleScriptVersion, 0, "The version of the oracle script to be used, or 0 for the latest")
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -736,3 +770,258 @@ $ %s tx bandtss request-signature oracle-result 1 2 --fee-limit 10uband
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// subscriptionRequest holds the request configuration of a subscription parsed from the command.
+type subscriptionRequest struct {
+	oracleScriptID types.OracleScriptID
+	calldata       []byte
+	askCount       uint64
+	minCount       uint64
+	clientID       string
+	feeLimit       sdk.Coins
+	prepareGas     uint64
+	executeGas     uint64
+	tssEncoder     types.Encoder
+	blockInterval  uint64
+	timeInterval   uint64
+	deposit        sdk.Coins
+}
+
+// parseSubscriptionRequest parses the [oracle-script-id] [ask-count] [min-count] arguments and
+// the request, interval and deposit flags of a subscription command.
+func parseSubscriptionRequest(cmd *cobra.Command, args []string) (subscriptionRequest, error) {
+	var r subscriptionRequest
+
+	oracleScriptID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return r, err
+	}
+	r.oracleScriptID = types.OracleScriptID(oracleScriptID)
+
+	if r.askCount, err = strconv.ParseUint(args[1], 10, 64); err != nil {
+		return r, err
+	}
+
+	if r.minCount, err = strconv.ParseUint(args[2], 10, 64); err != nil {
+		return r, err
+	}
+
+	if r.calldata, err = cmd.Flags().GetBytesHex(flagCalldata); err != nil {
+		return r, err
+	}
+
+	if r.clientID, err = cmd.Flags().GetString(flagClientID); err != nil {
+		return r, err
+	}
+
+	if r.prepareGas, err = cmd.Flags().GetUint64(flagPrepareGas); err != nil {
+		return r, err
+	}
+
+	if r.executeGas, err = cmd.Flags().GetUint64(flagExecuteGas); err != nil {
+		return r, err
+	}
+
+	feeLimitStr, err := cmd.Flags().GetString(flagFeeLimit)
+	if err != nil {
+		return r, err
+	}
+	if r.feeLimit, err = sdk.ParseCoinsNormalized(feeLimitStr); err != nil {
+		return r, err
+	}
+
+	tssEncoder, err := cmd.Flags().GetInt32(flagTSSEncoder)
+	if err != nil {
+		return r, err
+	}
+	r.tssEncoder = types.Encoder(tssEncoder)
+
+	if r.blockInterval, err = cmd.Flags().GetUint64(flagBlockInterval); err != nil {
+		return r, err
+	}
+
+	if r.timeInterval, err = cmd.Flags().GetUint64(flagTimeInterval); err != nil {
+		return r, err
+	}
+
+	depositStr, err := cmd.Flags().GetString(flagDeposit)
+	if err != nil {
+		return r, err
+	}
+	if r.deposit, err = sdk.ParseCoinsNormalized(depositStr); err != nil {
+		return r, err
+	}
+
+	return r, nil
+}
+
+// addSubscriptionRequestFlags adds the request, interval and deposit flags of a subscription command.
+func addSubscriptionRequestFlags(cmd *cobra.Command) {
+	cmd.Flags().BytesHexP(flagCalldata, "c", nil, "Calldata used in calling the oracle script")
+	cmd.Flags().StringP(flagClientID, "m", "", "Client ID attached to every request of the subscription")
+	cmd.Flags().Uint64(flagPrepareGas, 50000, "Prepare gas used in fee counting for prepare request")
+	cmd.Flags().Uint64(flagExecuteGas, 300000, "Execute gas used in fee counting for execute request")
+	cmd.Flags().
+		String(flagFeeLimit, "", "The maximum tokens paid to all data source and TSS signature providers for each request")
+	cmd.Flags().
+		Int32(flagTSSEncoder, 0, "The encode type of oracle result that will be sent to TSS (1=proto, 2=ABI, 3=Partial ABI)")
+	cmd.Flags().Uint64(flagBlockInterval, 0, "The number of blocks between two requests")
+	cmd.Flags().Uint64(flagTimeInterval, 0, "The number of seconds between two requests")
+	cmd.Flags().String(flagDeposit, "", "The amount of tokens transferred to the escrow of the subscription")
+}
+
+// GetCmdCreateSubscription implements the create subscription command handler.
+func GetCmdCreateSubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-subscription [oracle-script-id] [ask-count] [min-count] (-c [calldata]) (-m [client-id]) (--prepare-gas=[prepare-gas] (--execute-gas=[execute-gas])) (--fee-limit=[fee-limit]) (--block-interval=[blocks] | --time-interval=[seconds]) (--deposit=[deposit])",
+		Short: "Create a recurring data request paid from a prefunded escrow",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a subscription that makes a request via an existing oracle script on schedule.
+Example:
+$ %s tx oracle create-subscription 1 4 3 -c 1234abcdef --fee-limit 10uband --time-interval 600 --deposit 1000000uband --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			r, err := parseSubscriptionRequest(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateSubscription(
+				r.oracleScriptID,
+				r.calldata,
+				r.askCount,
+				r.minCount,
+				r.clientID,
+				r.feeLimit,
+				r.prepareGas,
+				r.executeGas,
+				r.tssEncoder,
+				r.blockInterval,
+				r.timeInterval,
+				r.deposit,
+				clientCtx.GetFromAddress(),
+			)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSubscriptionRequestFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdUpdateSubscription implements the update subscription command handler.
+func GetCmdUpdateSubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-subscription [subscription-id] [oracle-script-id] [ask-count] [min-count] (-c [calldata]) (-m [client-id]) (--prepare-gas=[prepare-gas] (--execute-gas=[execute-gas])) (--fee-limit=[fee-limit]) (--block-interval=[blocks] | --time-interval=[seconds]) (--deposit=[deposit])",
+		Short: "Update and resume an existing subscription",
+		Args:  cobra.ExactArgs(4),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the request configuration of a subscription, optionally top up its escrow, and resume it if paused.
+Example:
+$ %s tx oracle update-subscription 1 1 4 3 -c 1234abcdef --fee-limit 10uband --block-interval 100 --deposit 1000000uband --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subscriptionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			r, err := parseSubscriptionRequest(cmd, args[1:])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateSubscription(
+				types.SubscriptionID(subscriptionID),
+				r.oracleScriptID,
+				r.calldata,
+				r.askCount,
+				r.minCount,
+				r.clientID,
+				r.feeLimit,
+				r.prepareGas,
+				r.executeGas,
+				r.tssEncoder,
+				r.blockInterval,
+				r.timeInterval,
+				r.deposit,
+				clientCtx.GetFromAddress(),
+			)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSubscriptionRequestFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCancelSubscription implements the cancel subscription command handler.
+func GetCmdCancelSubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-subscription [subscription-id]",
+		Short: "Cancel a subscription and refund the remaining balance of its escrow",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a subscription and refund the remaining balance of its escrow to the owner.
+Example:
+$ %s tx oracle cancel-subscription 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subscriptionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelSubscription(types.SubscriptionID(subscriptionID), clientCtx.GetFromAddress())
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -27,6 +27,21 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *types.GenesisState) {
 	for _, oracleScript := range data.OracleScripts {
 		_ = k.AddOracleScript(ctx, oracleScript)
 	}
+	for _, dataSourceVersion := range data.DataSourceVersions {
+		k.SetDataSourceVersion(ctx, dataSourceVersion)
+	}
+	for _, oracleScriptVersion := range data.OracleScriptVersions {
+		k.SetOracleScriptVersion(ctx, oracleScriptVersion)
+	}
+
+	var subscriptionCount uint64
+	for _, subscription := range data.Subscriptions {
+		k.SetSubscription(ctx, subscription)
+		if uint64(subscription.ID) > subscriptionCount {
+			subscriptionCount = uint64(subscription.ID)
+		}
+	}
+	k.SetSubscriptionCount(ctx, subscriptionCount)
 
 	k.SetPort(ctx, types.PortID)
 	// Only try to bind to port if it is not already bound, since we may already own
@@ -44,8 +59,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *types.GenesisState) {
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:        k.GetParams(ctx),
-		DataSources:   k.GetAllDataSources(ctx),
-		OracleScripts: k.GetAllOracleScripts(ctx),
+		Params:               k.GetParams(ctx),
+		DataSources:          k.GetAllDataSources(ctx),
+		OracleScripts:        k.GetAllOracleScripts(ctx),
+		Subscriptions:        k.GetAllSubscriptions(ctx),
+		DataSourceVersions:   k.GetAllDataSourceVersions(ctx),
+		OracleScriptVersions: k.GetAllOracleScriptVersions(ctx),
 	}
 }
@@ -0,0 +1,222 @@
+package keeper_test
+
+import (
+	"errors"
+
+	"go.uber.org/mock/gomock"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+const testCallbackModule = "callbacktest"
+
+var testCallbackStoreKey = []byte{0xff, 0xfe}
+
+// testOracleHooks is an OracleHooks implementation that records every resolved result
+// and writes a marker into the store so that tests can check whether its state changes
+// were committed.
+type testOracleHooks struct {
+	storeKey storetypes.StoreKey
+
+	err        error
+	withPanic  bool
+	requesters []sdk.AccAddress
+	payloads   [][]byte
+	results    []types.Result
+}
+
+func (h *testOracleHooks) AfterRequestResolved(
+	ctx sdk.Context,
+	id types.RequestID,
+	requester sdk.AccAddress,
+	payload []byte,
+	result types.Result,
+) error {
+	h.requesters = append(h.requesters, requester)
+	h.payloads = append(h.payloads, payload)
+	h.results = append(h.results, result)
+	ctx.KVStore(h.storeKey).Set(testCallbackStoreKey, sdk.Uint64ToBigEndian(uint64(id)))
+
+	if h.withPanic {
+		panic("callback panic")
+	}
+	return h.err
+}
+
+func (suite *KeeperTestSuite) TestSaveResultWithCallback() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	ctx = ctx.WithBlockTime(bandtesting.ParseTime(200))
+	request := defaultRequest()
+	request.Callback = types.NewRequestCallback(testCallbackModule, []byte("payload"))
+	k.SetRequest(ctx, 42, request)
+	k.SetReport(ctx, 42, types.NewReport(validators[0].Address, true, nil))
+
+	k.ResolveSuccess(ctx, 42, request.Requester, request.FeeLimit, basicResult, 1234, 0)
+
+	expect := types.NewResult(
+		basicClientID, 1, basicCalldata, 2, 2, 42, 1, bandtesting.ParseTime(0).Unix(),
+		bandtesting.ParseTime(200).Unix(), types.RESOLVE_STATUS_SUCCESS, basicResult,
+	)
+	require.Equal([]sdk.AccAddress{bandtesting.FeePayer.Address}, suite.oracleHooks.requesters)
+	require.Equal([][]byte{[]byte("payload")}, suite.oracleHooks.payloads)
+	require.Equal([]types.Result{expect}, suite.oracleHooks.results)
+	require.Equal(sdk.Uint64ToBigEndian(42), ctx.KVStore(suite.key).Get(testCallbackStoreKey))
+	require.Equal(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRequestCallback,
+			sdk.NewAttribute(types.AttributeKeyID, "42"),
+			sdk.NewAttribute(types.AttributeKeyCallbackModule, testCallbackModule),
+			sdk.NewAttribute(types.AttributeKeyCallbackSuccess, "true"),
+		),
+		sdk.NewEvent(
+			types.EventTypeResolve,
+			sdk.NewAttribute(types.AttributeKeyID, "42"),
+			sdk.NewAttribute(types.AttributeKeyResolveStatus, "1"),
+			sdk.NewAttribute(types.AttributeKeyResult, "42415349435f524553554c54"), // BASIC_RESULT
+			sdk.NewAttribute(types.AttributeKeyGasUsed, "1234"),
+		),
+	}, ctx.EventManager().Events())
+}
+
+func (suite *KeeperTestSuite) TestSaveResultWithCallbackOnExpired() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	request := defaultRequest()
+	request.Callback = types.NewRequestCallback(testCallbackModule, nil)
+	k.SetRequest(ctx, 42, request)
+
+	k.ResolveExpired(ctx, 42)
+
+	require.Len(suite.oracleHooks.results, 1)
+	require.Equal(types.RESOLVE_STATUS_EXPIRED, suite.oracleHooks.results[0].ResolveStatus)
+	require.Equal(sdk.Uint64ToBigEndian(42), ctx.KVStore(suite.key).Get(testCallbackStoreKey))
+}
+
+func (suite *KeeperTestSuite) TestSaveResultWithCallbackError() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.oracleHooks.err = errors.New("settlement failed")
+
+	request := defaultRequest()
+	request.Callback = types.NewRequestCallback(testCallbackModule, nil)
+	k.SetRequest(ctx, 42, request)
+
+	k.ResolveFailure(ctx, 42, "reason")
+
+	// The result is still saved but the state changes from the hook are discarded.
+	require.Equal(types.RESOLVE_STATUS_FAILURE, k.MustGetResult(ctx, 42).ResolveStatus)
+	require.Len(suite.oracleHooks.results, 1)
+	require.Nil(ctx.KVStore(suite.key).Get(testCallbackStoreKey))
+	require.Equal(sdk.NewEvent(
+		types.EventTypeRequestCallback,
+		sdk.NewAttribute(types.AttributeKeyID, "42"),
+		sdk.NewAttribute(types.AttributeKeyCallbackModule, testCallbackModule),
+		sdk.NewAttribute(types.AttributeKeyCallbackSuccess, "false"),
+		sdk.NewAttribute(types.AttributeKeyReason, "settlement failed"),
+	), ctx.EventManager().Events()[0])
+}
+
+func (suite *KeeperTestSuite) TestSaveResultWithCallbackPanic() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.oracleHooks.withPanic = true
+
+	request := defaultRequest()
+	request.Callback = types.NewRequestCallback(testCallbackModule, nil)
+	k.SetRequest(ctx, 42, request)
+
+	require.NotPanics(func() { k.ResolveExpired(ctx, 42) })
+
+	require.Equal(types.RESOLVE_STATUS_EXPIRED, k.MustGetResult(ctx, 42).ResolveStatus)
+	require.Nil(ctx.KVStore(suite.key).Get(testCallbackStoreKey))
+	require.Equal(sdk.NewEvent(
+		types.EventTypeRequestCallback,
+		sdk.NewAttribute(types.AttributeKeyID, "42"),
+		sdk.NewAttribute(types.AttributeKeyCallbackModule, testCallbackModule),
+		sdk.NewAttribute(types.AttributeKeyCallbackSuccess, "false"),
+		sdk.NewAttribute(types.AttributeKeyReason, types.ErrCallbackPanic.Error()),
+	), ctx.EventManager().Events()[0])
+}
+
+func (suite *KeeperTestSuite) TestSaveResultWithoutCallback() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.SetRequest(ctx, 42, defaultRequest())
+	k.ResolveExpired(ctx, 42)
+
+	require.Empty(suite.oracleHooks.results)
+	require.Nil(ctx.KVStore(suite.key).Get(testCallbackStoreKey))
+}
+
+func (suite *KeeperTestSuite) TestPrepareRequestWithCallback() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+
+	msg := types.NewMsgRequestData(
+		1,
+		basicCalldata,
+		1,
+		1,
+		basicClientID,
+		bandtesting.Coins100band,
+		testDefaultPrepareGas,
+		testDefaultExecuteGas,
+		alice,
+		0,
+	)
+	msg.Callback = types.NewRequestCallback(testCallbackModule, []byte("payload"))
+
+	suite.rollingseedKeeper.
+		EXPECT().
+		GetRollingSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY"))
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, treasury, bandtesting.Coins1band).Times(3)
+
+	id, err := k.PrepareRequest(ctx, msg, alice, nil)
+	require.NoError(err)
+	require.Equal(msg.Callback, k.MustGetRequest(ctx, id).Callback)
+}
+
+func (suite *KeeperTestSuite) TestPrepareRequestWithUnknownCallbackModule() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	msg := types.NewMsgRequestData(
+		1,
+		basicCalldata,
+		1,
+		1,
+		basicClientID,
+		bandtesting.Coins100band,
+		testDefaultPrepareGas,
+		testDefaultExecuteGas,
+		alice,
+		0,
+	)
+	msg.Callback = types.NewRequestCallback("unknown", nil)
+
+	_, err := k.PrepareRequest(ctx, msg, alice, nil)
+	require.ErrorIs(err, types.ErrCallbackRouteNotFound)
+}
@@ -41,14 +41,19 @@ func (k Keeper) SetDataSource(ctx sdk.Context, id types.DataSourceID, dataSource
 	store.Set(types.DataSourceStoreKey(id), k.cdc.MustMarshal(&dataSource))
 }
 
-// AddDataSource adds the given data source to the storage.
+// AddDataSource adds the given data source to the storage. A data source without a version
+// is recorded as its first version.
 func (k Keeper) AddDataSource(ctx sdk.Context, dataSource types.DataSource) types.DataSourceID {
 	id := k.GetNextDataSourceID(ctx)
+	if dataSource.Version == 0 {
+		dataSource.Version = k.addDataSourceVersion(ctx, id, dataSource)
+	}
 	k.SetDataSource(ctx, id, dataSource)
 	return id
 }
 
-// MustEditDataSource edits the given data source by id and flushes it to the storage.
+// MustEditDataSource edits the given data source by id and flushes it to the storage. A new
+// version is recorded if any of the versioned fields is changed.
 func (k Keeper) MustEditDataSource(ctx sdk.Context, id types.DataSourceID, new types.DataSource) {
 	dataSource := k.MustGetDataSource(ctx, id)
 	dataSource.Owner = new.Owner
@@ -57,6 +62,11 @@ func (k Keeper) MustEditDataSource(ctx sdk.Context, id types.DataSourceID, new t
 	dataSource.Filename = modify(dataSource.Filename, new.Filename)
 	dataSource.Treasury = new.Treasury
 	dataSource.Fee = new.Fee
+
+	latest, err := k.GetDataSourceVersion(ctx, id, dataSource.Version)
+	if err != nil || latest.IsChangedFrom(dataSource) {
+		dataSource.Version = k.addDataSourceVersion(ctx, id, dataSource)
+	}
 	k.SetDataSource(ctx, id, dataSource)
 }
 
@@ -73,6 +83,58 @@ func (k Keeper) GetAllDataSources(ctx sdk.Context) (dataSources []types.DataSour
 	return dataSources
 }
 
+// GetDataSourceVersion returns the given version of the data source or error if not exists.
+func (k Keeper) GetDataSourceVersion(
+	ctx sdk.Context,
+	id types.DataSourceID,
+	version uint64,
+) (types.DataSourceVersion, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.DataSourceVersionStoreKey(id, version))
+	if bz == nil {
+		return types.DataSourceVersion{}, types.ErrVersionNotFound.Wrapf(
+			"data source id: %d, version: %d",
+			id,
+			version,
+		)
+	}
+	var dataSourceVersion types.DataSourceVersion
+	k.cdc.MustUnmarshal(bz, &dataSourceVersion)
+	return dataSourceVersion, nil
+}
+
+// SetDataSourceVersion saves the given data source version to the storage without performing validation.
+func (k Keeper) SetDataSourceVersion(ctx sdk.Context, dataSourceVersion types.DataSourceVersion) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(
+		types.DataSourceVersionStoreKey(dataSourceVersion.DataSourceID, dataSourceVersion.Version),
+		k.cdc.MustMarshal(&dataSourceVersion),
+	)
+}
+
+// GetAllDataSourceVersions returns the list of all versions of all data sources in the store.
+func (k Keeper) GetAllDataSourceVersions(ctx sdk.Context) (dataSourceVersions []types.DataSourceVersion) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.DataSourceVersionStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var dataSourceVersion types.DataSourceVersion
+		k.cdc.MustUnmarshal(iterator.Value(), &dataSourceVersion)
+		dataSourceVersions = append(dataSourceVersions, dataSourceVersion)
+	}
+	return dataSourceVersions
+}
+
+// addDataSourceVersion records the given data source as the next version of the data source
+// of the given ID and returns the new version number.
+func (k Keeper) addDataSourceVersion(ctx sdk.Context, id types.DataSourceID, dataSource types.DataSource) uint64 {
+	version := dataSource.Version + 1
+	k.SetDataSourceVersion(
+		ctx,
+		types.NewDataSourceVersion(id, version, dataSource, ctx.BlockHeight(), ctx.BlockTime()),
+	)
+	return version
+}
+
 // AddExecutableFile saves the given executable file to a file to filecahe storage and returns
 // its sha256sum reference name. Returns do-not-modify symbol if the input is do-not-modify.
 func (k Keeper) AddExecutableFile(file []byte) string {
@@ -96,6 +96,7 @@ func (suite *KeeperTestSuite) TestAddDataSourceEditDataSourceBasic() {
 	)
 	// Adds a new data source to the store. We should be able to retrieve it back.
 	id := k.AddDataSource(ctx, dataSource1)
+	dataSource1.Version = 1
 	require.Equal(dataSource1, k.MustGetDataSource(ctx, id))
 	require.NotEqual(dataSource2, k.MustGetDataSource(ctx, id))
 	owner, err := sdk.AccAddressFromBech32(dataSource2.Owner)
@@ -106,6 +107,7 @@ func (suite *KeeperTestSuite) TestAddDataSourceEditDataSourceBasic() {
 	k.MustEditDataSource(ctx, id, types.NewDataSource(
 		owner, dataSource2.Name, dataSource2.Description, dataSource2.Filename, bandtesting.EmptyCoins, treasury,
 	))
+	dataSource2.Version = 2
 	require.NotEqual(dataSource1, k.MustGetDataSource(ctx, id))
 	require.Equal(dataSource2, k.MustGetDataSource(ctx, id))
 }
@@ -134,6 +136,7 @@ func (suite *KeeperTestSuite) TestEditDataSourceDoNotModify() {
 	)
 	// Adds a new data source to the store. We should be able to retrieve it back.
 	id := k.AddDataSource(ctx, dataSource1)
+	dataSource1.Version = 1
 	require.Equal(dataSource1, k.MustGetDataSource(ctx, id))
 	require.NotEqual(dataSource2, k.MustGetDataSource(ctx, id))
 	// Edits the data source. We should get the updated data source.
@@ -149,6 +152,42 @@ func (suite *KeeperTestSuite) TestEditDataSourceDoNotModify() {
 	require.Equal(dataSourceRes.Treasury, dataSource2.Treasury)
 }
 
+func (suite *KeeperTestSuite) TestEditDataSourceVersionHistory() {
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(bandtesting.ParseTime(100))
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	dataSource := types.NewDataSource(owner, "NAME1", "DESCRIPTION1", "FILENAME1", bandtesting.Coins1band, treasury)
+	id := k.AddDataSource(ctx, dataSource)
+	require.Equal(uint64(1), k.MustGetDataSource(ctx, id).Version)
+	version1 := types.NewDataSourceVersion(id, 1, dataSource, 10, bandtesting.ParseTime(100))
+	v, err := k.GetDataSourceVersion(ctx, id, 1)
+	require.NoError(err)
+	require.Equal(version1, v)
+
+	// Editing only the display fields does not create a new version.
+	k.MustEditDataSource(ctx, id, types.NewDataSource(
+		owner, "NAME2", "DESCRIPTION2", types.DoNotModify, bandtesting.Coins1band, treasury,
+	))
+	require.Equal(uint64(1), k.MustGetDataSource(ctx, id).Version)
+	_, err = k.GetDataSourceVersion(ctx, id, 2)
+	require.ErrorIs(err, types.ErrVersionNotFound)
+
+	// Changing the executable or the fee creates a new version and keeps the old one intact.
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(bandtesting.ParseTime(200))
+	k.MustEditDataSource(ctx, id, types.NewDataSource(
+		owner, types.DoNotModify, types.DoNotModify, "FILENAME2", bandtesting.Coins10uband, treasury,
+	))
+	dataSource = k.MustGetDataSource(ctx, id)
+	require.Equal(uint64(2), dataSource.Version)
+	v, err = k.GetDataSourceVersion(ctx, id, 1)
+	require.NoError(err)
+	require.Equal(version1, v)
+	v, err = k.GetDataSourceVersion(ctx, id, 2)
+	require.NoError(err)
+	require.Equal(types.NewDataSourceVersion(id, 2, dataSource, 20, bandtesting.ParseTime(200)), v)
+}
+
 func (suite *KeeperTestSuite) TestAddDataSourceDataSourceMustReturnCorrectID() {
 	ctx := suite.ctx
 	k := suite.oracleKeeper
@@ -209,6 +248,9 @@ func (suite *KeeperTestSuite) TestGetAllDataSources() {
 	require := suite.Require()
 
 	dataSources := bandtesting.GenerateDataSources(suite.homeDir)
+	for i := range dataSources {
+		dataSources[i].Version = 1
+	}
 
 	require.Equal(dataSources, k.GetAllDataSources(ctx))
 }
@@ -10,7 +10,10 @@ import (
 
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 
+	"cosmossdk.io/store/prefix"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -78,6 +81,72 @@ func (k Querier) OracleScript(
 	return &types.QueryOracleScriptResponse{OracleScript: &os}, nil
 }
 
+// DataSourceVersions queries the version history of a data source.
+func (k Querier) DataSourceVersions(
+	c context.Context,
+	req *types.QueryDataSourceVersionsRequest,
+) (*types.QueryDataSourceVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	id := types.DataSourceID(req.DataSourceId)
+	if !k.HasDataSource(ctx, id) {
+		return nil, types.ErrDataSourceNotFound.Wrapf("id: %d", id)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DataSourceVersionsStoreKey(id))
+
+	var versions []types.DataSourceVersion
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var version types.DataSourceVersion
+		if err := k.cdc.Unmarshal(value, &version); err != nil {
+			return err
+		}
+
+		versions = append(versions, version)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDataSourceVersionsResponse{Versions: versions, Pagination: pageRes}, nil
+}
+
+// OracleScriptVersions queries the version history of an oracle script.
+func (k Querier) OracleScriptVersions(
+	c context.Context,
+	req *types.QueryOracleScriptVersionsRequest,
+) (*types.QueryOracleScriptVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	id := types.OracleScriptID(req.OracleScriptId)
+	if !k.HasOracleScript(ctx, id) {
+		return nil, types.ErrOracleScriptNotFound.Wrapf("id: %d", id)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OracleScriptVersionsStoreKey(id))
+
+	var versions []types.OracleScriptVersion
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var version types.OracleScriptVersion
+		if err := k.cdc.Unmarshal(value, &version); err != nil {
+			return err
+		}
+
+		versions = append(versions, version)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOracleScriptVersionsResponse{Versions: versions, Pagination: pageRes}, nil
+}
+
 // Request queries request info for given request id.
 func (k Querier) Request(c context.Context, req *types.QueryRequestRequest) (*types.QueryRequestResponse, error) {
 	if req == nil {
@@ -96,6 +165,12 @@ func (k Querier) Request(c context.Context, req *types.QueryRequestRequest) (*ty
 		signingResult = &sResult
 	}
 
+	// Note: ignore error because requests made before the versions were recorded have no versions.
+	var versions *types.RequestVersions
+	if v, err := k.GetRequestVersions(ctx, rid); err == nil {
+		versions = &v
+	}
+
 	request, err := k.GetRequest(ctx, rid)
 	if err != nil {
 		lastExpired := k.GetRequestLastExpired(ctx)
@@ -110,21 +185,34 @@ func (k Querier) Request(c context.Context, req *types.QueryRequestRequest) (*ty
 			)
 		}
 		result := k.MustGetResult(ctx, rid)
-		return &types.QueryRequestResponse{Request: nil, Reports: nil, Result: &result, Signing: signingResult}, nil
+		return &types.QueryRequestResponse{
+			Request:  nil,
+			Reports:  nil,
+			Result:   &result,
+			Signing:  signingResult,
+			Versions: versions,
+		}, nil
 	}
 
 	reports := k.GetReports(ctx, rid)
 	if !k.HasResult(ctx, rid) {
-		return &types.QueryRequestResponse{Request: &request, Reports: reports, Result: nil, Signing: nil}, nil
+		return &types.QueryRequestResponse{
+			Request:  &request,
+			Reports:  reports,
+			Result:   nil,
+			Signing:  nil,
+			Versions: versions,
+		}, nil
 	}
 
 	result := k.MustGetResult(ctx, rid)
 
 	return &types.QueryRequestResponse{
-		Request: &request,
-		Reports: reports,
-		Result:  &result,
-		Signing: signingResult,
+		Request:  &request,
+		Reports:  reports,
+		Result:   &result,
+		Signing:  signingResult,
+		Versions: versions,
 	}, nil
 }
 
@@ -315,7 +403,31 @@ func (k Querier) RequestSearch(
 	c context.Context,
 	req *types.QueryRequestSearchRequest,
 ) (*types.QueryRequestSearchResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "This feature can be taken from extra/rest branch")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	calldata, err := hex.DecodeString(req.Calldata)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unable to decode calldata: %s", err))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rid, err := k.GetLatestRequestID(
+		ctx,
+		types.OracleScriptID(req.OracleScriptId),
+		calldata,
+		req.AskCount,
+		req.MinCount,
+	)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	request, err := k.Request(c, &types.QueryRequestRequest{RequestId: uint64(rid)})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryRequestSearchResponse{Request: request}, nil
 }
 
 // RequestPrice queries the latest price on standard price reference oracle
@@ -324,7 +436,23 @@ func (k Querier) RequestPrice(
 	c context.Context,
 	req *types.QueryRequestPriceRequest,
 ) (*types.QueryRequestPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "This feature can be taken from extra/rest branch")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Symbols) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no symbols provided")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	priceResults := make([]*types.PriceResult, 0, len(req.Symbols))
+	for _, symbol := range req.Symbols {
+		priceResult, err := k.GetPriceResult(ctx, symbol, req.AskCount, req.MinCount)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		priceResults = append(priceResults, &priceResult)
+	}
+	return &types.QueryRequestPriceResponse{PriceResults: priceResults}, nil
 }
 
 // RequestVerification verifies oracle request for validation before executing data sources
@@ -484,3 +612,64 @@ func (k Querier) RequestVerification(
 		IsDelay:      false,
 	}, nil
 }
+
+// Subscription queries a subscription and its funding balance for given subscription id.
+func (k Querier) Subscription(
+	c context.Context,
+	req *types.QuerySubscriptionRequest,
+) (*types.QuerySubscriptionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	subscription, err := k.GetSubscription(ctx, types.SubscriptionID(req.SubscriptionId))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	balance := k.bankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(subscription.Escrow))
+	return &types.QuerySubscriptionResponse{Subscription: subscription, Balance: balance}, nil
+}
+
+// Subscriptions queries all subscriptions.
+func (k Querier) Subscriptions(
+	c context.Context,
+	req *types.QuerySubscriptionsRequest,
+) (*types.QuerySubscriptionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SubscriptionStoreKeyPrefix)
+
+	var subscriptions []types.Subscription
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var subscription types.Subscription
+		if err := k.cdc.Unmarshal(value, &subscription); err != nil {
+			return err
+		}
+
+		subscriptions = append(subscriptions, subscription)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySubscriptionsResponse{Subscriptions: subscriptions, Pagination: pageRes}, nil
+}
+
+// SimulateRequest runs the prepare and execute phases of an oracle script without creating a request.
+func (k Querier) SimulateRequest(
+	c context.Context,
+	req *types.QuerySimulateRequestRequest,
+) (*types.QuerySimulateRequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return k.Keeper.SimulateRequest(ctx, req)
+}
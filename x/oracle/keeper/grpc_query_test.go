@@ -722,3 +722,85 @@ func (suite *KeeperTestSuite) TestPendingRequestsSuccess() {
 	require.Equal(&types.QueryPendingRequestsResponse{RequestIDs: []uint64{3}}, r)
 	require.NoError(err)
 }
+
+// ------------------------------------
+// --- Test for QueryRequestVersions ---
+// ------------------------------------
+
+func (suite *KeeperTestSuite) TestQueryRequestVersionsAfterExpired() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	req := defaultRequest()
+	req.OracleScriptVersion = 2
+	req.RawRequests[1].DataSourceVersion = 3
+	rid := k.AddRequest(ctx, req)
+	expected := types.RequestVersions{
+		OracleScriptVersion: 2,
+		RawRequestVersions: []types.RawRequestVersion{
+			types.NewRawRequestVersion(1, 1, 0),
+			types.NewRawRequestVersion(2, 2, 3),
+			types.NewRawRequestVersion(3, 3, 0),
+		},
+	}
+
+	res, err := suite.queryClient.Request(ctx, &types.QueryRequestRequest{RequestId: uint64(rid)})
+	require.NoError(err)
+	require.Equal(&expected, res.Versions)
+
+	// The versions are kept after the request is resolved and removed.
+	k.SaveResult(ctx, rid, types.RESOLVE_STATUS_SUCCESS, basicResult)
+	k.DeleteRequest(ctx, rid)
+	k.SetRequestLastExpired(ctx, rid)
+
+	res, err = suite.queryClient.Request(ctx, &types.QueryRequestRequest{RequestId: uint64(rid)})
+	require.NoError(err)
+	require.Nil(res.Request)
+	require.NotNil(res.Result)
+	require.Equal(&expected, res.Versions)
+}
+
+// ------------------------------
+// --- Test for QueryVersions ---
+// ------------------------------
+
+func (suite *KeeperTestSuite) TestQueryDataSourceVersions() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.MustEditDataSource(ctx, 1, types.NewDataSource(
+		owner, types.DoNotModify, types.DoNotModify, "FILENAME2", bandtesting.Coins1band, treasury,
+	))
+
+	res, err := suite.queryClient.DataSourceVersions(ctx, &types.QueryDataSourceVersionsRequest{DataSourceId: 1})
+	require.NoError(err)
+	require.Len(res.Versions, 2)
+	require.Equal(uint64(1), res.Versions[0].Version)
+	require.Equal(uint64(2), res.Versions[1].Version)
+	require.Equal("FILENAME2", res.Versions[1].Filename)
+
+	_, err = suite.queryClient.DataSourceVersions(ctx, &types.QueryDataSourceVersionsRequest{DataSourceId: 999})
+	require.ErrorContains(err, types.ErrDataSourceNotFound.Error())
+}
+
+func (suite *KeeperTestSuite) TestQueryOracleScriptVersions() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.MustEditOracleScript(ctx, 1, types.NewOracleScript(
+		owner, types.DoNotModify, types.DoNotModify, types.DoNotModify, "schema2", types.DoNotModify,
+	))
+
+	res, err := suite.queryClient.OracleScriptVersions(ctx, &types.QueryOracleScriptVersionsRequest{OracleScriptId: 1})
+	require.NoError(err)
+	require.Len(res.Versions, 2)
+	require.Equal(uint64(1), res.Versions[0].Version)
+	require.Equal(uint64(2), res.Versions[1].Version)
+	require.Equal("schema2", res.Versions[1].Schema)
+
+	_, err = suite.queryClient.OracleScriptVersions(ctx, &types.QueryOracleScriptVersionsRequest{OracleScriptId: 999})
+	require.ErrorContains(err, types.ErrOracleScriptNotFound.Error())
+}
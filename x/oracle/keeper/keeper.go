@@ -40,6 +40,10 @@ type Keeper struct {
 	rollingseedKepper types.RollingseedKeeper
 	bandtssKeeper     types.BandtssKeeper
 	scopedKeeper      capabilitykeeper.ScopedKeeper
+	hooksRouter       *types.OracleHooksRouter
+
+	// whether Query/SimulateRequest may compile and run Owasm code that is not deployed on-chain.
+	simulateRequestCode bool
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -62,6 +66,7 @@ func NewKeeper(
 	rollingseedKepper types.RollingseedKeeper,
 	bandtssKeeper types.BandtssKeeper,
 	scopeKeeper capabilitykeeper.ScopedKeeper,
+	hooksRouter *types.OracleHooksRouter,
 	owasmVM *owasm.Vm,
 	authority string,
 ) Keeper {
@@ -81,10 +86,17 @@ func NewKeeper(
 		rollingseedKepper: rollingseedKepper,
 		bandtssKeeper:     bandtssKeeper,
 		scopedKeeper:      scopeKeeper,
+		hooksRouter:       hooksRouter,
 		authority:         authority,
 	}
 }
 
+// SetSimulateRequestCode sets whether Query/SimulateRequest may compile and run Owasm code that is
+// not deployed on-chain. It is a node-local setting and is disabled by default.
+func (k *Keeper) SetSimulateRequestCode(enabled bool) {
+	k.simulateRequestCode = enabled
+}
+
 // GetAuthority returns the x/oracle module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
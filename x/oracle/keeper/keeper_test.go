@@ -45,6 +45,9 @@ type KeeperTestSuite struct {
 	rollingseedKeeper *oracletestutil.MockRollingseedKeeper
 	bandtssKeeper     *oracletestutil.MockBandtssKeeper
 
+	hooksRouter *types.OracleHooksRouter
+	oracleHooks *testOracleHooks
+
 	key         storetypes.StoreKey
 	queryClient types.QueryClient
 	msgServer   types.MsgServer
@@ -75,6 +78,9 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.rollingseedKeeper = oracletestutil.NewMockRollingseedKeeper(ctrl)
 	suite.bandtssKeeper = oracletestutil.NewMockBandtssKeeper(ctrl)
 
+	suite.oracleHooks = &testOracleHooks{storeKey: key}
+	suite.hooksRouter = types.NewOracleHooksRouter().AddRoute(testCallbackModule, suite.oracleHooks)
+
 	suite.key = key
 	suite.homeDir = testutil.GetTempDir(suite.T())
 	suite.fileDir = filepath.Join(suite.homeDir, "files")
@@ -98,6 +104,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 		suite.rollingseedKeeper,
 		suite.bandtssKeeper,
 		capabilitykeeper.ScopedKeeper{},
+		suite.hooksRouter,
 		owasmVM,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -115,6 +122,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 		Keeper: suite.oracleKeeper,
 	})
 	suite.queryClient = types.NewQueryClient(queryHelper)
+	suite.msgServer = keeper.NewMsgServerImpl(suite.oracleKeeper)
 
 	suite.authzKeeper.EXPECT().
 		GetAuthorization(gomock.Any(), reporterAddr, sdk.AccAddress(validators[0].Address), sdk.MsgTypeURL(&types.MsgReportData{})).
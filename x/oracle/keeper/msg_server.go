@@ -171,9 +171,11 @@ func (k msgServer) EditDataSource(
 		newOwner, msg.Name, msg.Description, k.AddExecutableFile(msg.Executable), msg.Fee, treasury,
 	))
 
+	version := k.MustGetDataSource(ctx, msg.DataSourceID).Version
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeEditDataSource,
 		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", msg.DataSourceID)),
+		sdk.NewAttribute(types.AttributeKeyVersion, fmt.Sprintf("%d", version)),
 	))
 
 	return &types.MsgEditDataSourceResponse{}, nil
@@ -264,9 +266,11 @@ func (k msgServer) EditOracleScript(
 		newOwner, msg.Name, msg.Description, filename, msg.Schema, msg.SourceCodeURL,
 	))
 
+	version := k.MustGetOracleScript(ctx, msg.OracleScriptID).Version
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeEditOracleScript,
 		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", msg.OracleScriptID)),
+		sdk.NewAttribute(types.AttributeKeyVersion, fmt.Sprintf("%d", version)),
 	))
 
 	return &types.MsgEditOracleScriptResponse{}, nil
@@ -290,6 +294,171 @@ func (k msgServer) Activate(goCtx context.Context, msg *types.MsgActivate) (*typ
 	return &types.MsgActivateResponse{}, nil
 }
 
+func (k msgServer) CreateSubscription(
+	goCtx context.Context,
+	msg *types.MsgCreateSubscription,
+) (*types.MsgCreateSubscriptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.validateSubscriptionRequest(
+		ctx,
+		msg.OracleScriptID,
+		msg.Calldata,
+		msg.AskCount,
+		msg.PrepareGas,
+		msg.ExecuteGas,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkActiveSubscriptionLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := k.AddSubscription(ctx, types.NewSubscription(
+		0,
+		msg.OracleScriptID,
+		msg.Calldata,
+		msg.AskCount,
+		msg.MinCount,
+		msg.ClientID,
+		msg.FeeLimit,
+		msg.PrepareGas,
+		msg.ExecuteGas,
+		msg.TSSEncoder,
+		msg.BlockInterval,
+		msg.TimeInterval,
+		"",
+		msg.Owner,
+		true,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.DepositSubscription(ctx, id, owner, msg.InitialDeposit); err != nil {
+		return nil, err
+	}
+
+	subscription := k.MustGetSubscription(ctx, id)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateSubscription,
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyOracleScriptID, fmt.Sprintf("%d", subscription.OracleScriptID)),
+		sdk.NewAttribute(types.AttributeKeyBlockInterval, fmt.Sprintf("%d", subscription.BlockInterval)),
+		sdk.NewAttribute(types.AttributeKeyTimeInterval, fmt.Sprintf("%d", subscription.TimeInterval)),
+		sdk.NewAttribute(types.AttributeKeyEscrow, subscription.Escrow),
+		sdk.NewAttribute(types.AttributeKeyOwner, subscription.Owner),
+		sdk.NewAttribute(types.AttributeKeyDeposit, msg.InitialDeposit.String()),
+	))
+
+	return &types.MsgCreateSubscriptionResponse{SubscriptionID: id}, nil
+}
+
+func (k msgServer) UpdateSubscription(
+	goCtx context.Context,
+	msg *types.MsgUpdateSubscription,
+) (*types.MsgUpdateSubscriptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	subscription, err := k.GetSubscription(ctx, msg.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	// sender must be the owner of subscription
+	if subscription.Owner != owner.String() {
+		return nil, types.ErrEditorNotAuthorized
+	}
+
+	if err := k.validateSubscriptionRequest(
+		ctx,
+		msg.OracleScriptID,
+		msg.Calldata,
+		msg.AskCount,
+		msg.PrepareGas,
+		msg.ExecuteGas,
+	); err != nil {
+		return nil, err
+	}
+
+	if !subscription.IsActive {
+		if err := k.checkActiveSubscriptionLimit(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	subscription.OracleScriptID = msg.OracleScriptID
+	subscription.Calldata = msg.Calldata
+	subscription.AskCount = msg.AskCount
+	subscription.MinCount = msg.MinCount
+	subscription.ClientID = msg.ClientID
+	subscription.FeeLimit = msg.FeeLimit
+	subscription.PrepareGas = msg.PrepareGas
+	subscription.ExecuteGas = msg.ExecuteGas
+	subscription.TSSEncoder = msg.TSSEncoder
+	subscription.BlockInterval = msg.BlockInterval
+	subscription.TimeInterval = msg.TimeInterval
+	// a subscription paused for running out of funds is resumed once it is updated.
+	subscription.IsActive = true
+	k.SetSubscription(ctx, subscription)
+
+	if err := k.DepositSubscription(ctx, subscription.ID, owner, msg.Deposit); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateSubscription,
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, fmt.Sprintf("%d", subscription.ID)),
+		sdk.NewAttribute(types.AttributeKeyOracleScriptID, fmt.Sprintf("%d", subscription.OracleScriptID)),
+		sdk.NewAttribute(types.AttributeKeyBlockInterval, fmt.Sprintf("%d", subscription.BlockInterval)),
+		sdk.NewAttribute(types.AttributeKeyTimeInterval, fmt.Sprintf("%d", subscription.TimeInterval)),
+		sdk.NewAttribute(types.AttributeKeyDeposit, msg.Deposit.String()),
+	))
+
+	return &types.MsgUpdateSubscriptionResponse{}, nil
+}
+
+func (k msgServer) CancelSubscription(
+	goCtx context.Context,
+	msg *types.MsgCancelSubscription,
+) (*types.MsgCancelSubscriptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	subscription, err := k.GetSubscription(ctx, msg.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// sender must be the owner of subscription
+	if subscription.Owner != msg.Owner {
+		return nil, types.ErrEditorNotAuthorized
+	}
+
+	refund, err := k.RemoveSubscription(ctx, msg.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelSubscription,
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, fmt.Sprintf("%d", msg.SubscriptionID)),
+		sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+	))
+
+	return &types.MsgCancelSubscriptionResponse{}, nil
+}
+
 func (k msgServer) UpdateParams(
 	goCtx context.Context,
 	msg *types.MsgUpdateParams,
@@ -41,14 +41,19 @@ func (k Keeper) SetOracleScript(ctx sdk.Context, id types.OracleScriptID, oracle
 	store.Set(types.OracleScriptStoreKey(id), k.cdc.MustMarshal(&oracleScript))
 }
 
-// AddOracleScript adds the given oracle script to the storage.
+// AddOracleScript adds the given oracle script to the storage. An oracle script without a
+// version is recorded as its first version.
 func (k Keeper) AddOracleScript(ctx sdk.Context, oracleScript types.OracleScript) types.OracleScriptID {
 	id := k.GetNextOracleScriptID(ctx)
+	if oracleScript.Version == 0 {
+		oracleScript.Version = k.addOracleScriptVersion(ctx, id, oracleScript)
+	}
 	k.SetOracleScript(ctx, id, oracleScript)
 	return id
 }
 
-// MustEditOracleScript edits the given oracle script by id and flushes it to the storage. Panic if not exists.
+// MustEditOracleScript edits the given oracle script by id and flushes it to the storage. A new
+// version is recorded if any of the versioned fields is changed. Panic if not exists.
 func (k Keeper) MustEditOracleScript(ctx sdk.Context, id types.OracleScriptID, new types.OracleScript) {
 	oracleScript := k.MustGetOracleScript(ctx, id)
 	oracleScript.Owner = new.Owner
@@ -57,6 +62,11 @@ func (k Keeper) MustEditOracleScript(ctx sdk.Context, id types.OracleScriptID, n
 	oracleScript.Filename = modify(oracleScript.Filename, new.Filename)
 	oracleScript.Schema = modify(oracleScript.Schema, new.Schema)
 	oracleScript.SourceCodeURL = modify(oracleScript.SourceCodeURL, new.SourceCodeURL)
+
+	latest, err := k.GetOracleScriptVersion(ctx, id, oracleScript.Version)
+	if err != nil || latest.IsChangedFrom(oracleScript) {
+		oracleScript.Version = k.addOracleScriptVersion(ctx, id, oracleScript)
+	}
 	k.SetOracleScript(ctx, id, oracleScript)
 }
 
@@ -73,6 +83,83 @@ func (k Keeper) GetAllOracleScripts(ctx sdk.Context) (oracleScripts []types.Orac
 	return oracleScripts
 }
 
+// GetOracleScriptVersion returns the given version of the oracle script or error if not exists.
+func (k Keeper) GetOracleScriptVersion(
+	ctx sdk.Context,
+	id types.OracleScriptID,
+	version uint64,
+) (types.OracleScriptVersion, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.OracleScriptVersionStoreKey(id, version))
+	if bz == nil {
+		return types.OracleScriptVersion{}, types.ErrVersionNotFound.Wrapf(
+			"oracle script id: %d, version: %d",
+			id,
+			version,
+		)
+	}
+	var oracleScriptVersion types.OracleScriptVersion
+	k.cdc.MustUnmarshal(bz, &oracleScriptVersion)
+	return oracleScriptVersion, nil
+}
+
+// SetOracleScriptVersion saves the given oracle script version to the storage without performing validation.
+func (k Keeper) SetOracleScriptVersion(ctx sdk.Context, oracleScriptVersion types.OracleScriptVersion) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(
+		types.OracleScriptVersionStoreKey(oracleScriptVersion.OracleScriptID, oracleScriptVersion.Version),
+		k.cdc.MustMarshal(&oracleScriptVersion),
+	)
+}
+
+// GetAllOracleScriptVersions returns the list of all versions of all oracle scripts in the store.
+func (k Keeper) GetAllOracleScriptVersions(ctx sdk.Context) (oracleScriptVersions []types.OracleScriptVersion) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.OracleScriptVersionStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var oracleScriptVersion types.OracleScriptVersion
+		k.cdc.MustUnmarshal(iterator.Value(), &oracleScriptVersion)
+		oracleScriptVersions = append(oracleScriptVersions, oracleScriptVersion)
+	}
+	return oracleScriptVersions
+}
+
+// GetOracleScriptFilename returns the filename of the given version of the oracle script. The
+// latest version is used if the given version is zero.
+func (k Keeper) GetOracleScriptFilename(
+	ctx sdk.Context,
+	id types.OracleScriptID,
+	version uint64,
+) (string, error) {
+	oracleScript, err := k.GetOracleScript(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if version == 0 || version == oracleScript.Version {
+		return oracleScript.Filename, nil
+	}
+	oracleScriptVersion, err := k.GetOracleScriptVersion(ctx, id, version)
+	if err != nil {
+		return "", err
+	}
+	return oracleScriptVersion.Filename, nil
+}
+
+// addOracleScriptVersion records the given oracle script as the next version of the oracle
+// script of the given ID and returns the new version number.
+func (k Keeper) addOracleScriptVersion(
+	ctx sdk.Context,
+	id types.OracleScriptID,
+	oracleScript types.OracleScript,
+) uint64 {
+	version := oracleScript.Version + 1
+	k.SetOracleScriptVersion(
+		ctx,
+		types.NewOracleScriptVersion(id, version, oracleScript, ctx.BlockHeight(), ctx.BlockTime()),
+	)
+	return version
+}
+
 // AddOracleScriptFile compiles Wasm code (see go-owasm), adds the compiled file to filecache,
 // and returns its sha256 reference name. Returns do-not-modify symbol if input is do-not-modify.
 func (k Keeper) AddOracleScriptFile(file []byte) (string, error) {
@@ -70,6 +70,7 @@ func (suite *KeeperTestSuite) TestAddEditOracleScriptBasic() {
 	)
 	// Adds a new oracle script to the store. We should be able to retrieve it back.
 	id := k.AddOracleScript(ctx, oracleScript1)
+	oracleScript1.Version = 1
 	require.Equal(oracleScript1, k.MustGetOracleScript(ctx, id))
 	require.NotEqual(oracleScript2, k.MustGetOracleScript(ctx, id))
 	// Edits the oracle script. We should get the updated oracle script.
@@ -81,6 +82,7 @@ func (suite *KeeperTestSuite) TestAddEditOracleScriptBasic() {
 			oracleScript2.Schema, oracleScript2.SourceCodeURL,
 		))
 	})
+	oracleScript2.Version = 2
 	require.NotEqual(oracleScript1, k.MustGetOracleScript(ctx, id))
 	require.Equal(oracleScript2, k.MustGetOracleScript(ctx, id))
 }
@@ -100,6 +102,7 @@ func (suite *KeeperTestSuite) TestAddEditOracleScriptDoNotModify() {
 	)
 	// Adds a new oracle script to the store. We should be able to retrieve it back.
 	id := k.AddOracleScript(ctx, oracleScript1)
+	oracleScript1.Version = 1
 	require.Equal(oracleScript1, k.MustGetOracleScript(ctx, id))
 	require.NotEqual(oracleScript2, k.MustGetOracleScript(ctx, id))
 	// Edits the oracle script. We should get the updated oracle script.
@@ -115,6 +118,55 @@ func (suite *KeeperTestSuite) TestAddEditOracleScriptDoNotModify() {
 	require.Equal(oracleScriptRes.SourceCodeURL, oracleScript1.SourceCodeURL)
 }
 
+func (suite *KeeperTestSuite) TestEditOracleScriptVersionHistory() {
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(bandtesting.ParseTime(100))
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	oracleScript := types.NewOracleScript(
+		bandtesting.Alice.Address, "NAME1", "DESCRIPTION1", "FILENAME1", basicSchema, basicSourceCodeURL,
+	)
+	id := k.AddOracleScript(ctx, oracleScript)
+	require.Equal(uint64(1), k.MustGetOracleScript(ctx, id).Version)
+	version1 := types.NewOracleScriptVersion(id, 1, oracleScript, 10, bandtesting.ParseTime(100))
+	v, err := k.GetOracleScriptVersion(ctx, id, 1)
+	require.NoError(err)
+	require.Equal(version1, v)
+
+	// Editing only the display fields does not create a new version.
+	k.MustEditOracleScript(ctx, id, types.NewOracleScript(
+		bandtesting.Alice.Address, "NAME2", "DESCRIPTION2", types.DoNotModify, types.DoNotModify, "url2",
+	))
+	require.Equal(uint64(1), k.MustGetOracleScript(ctx, id).Version)
+	_, err = k.GetOracleScriptVersion(ctx, id, 2)
+	require.ErrorIs(err, types.ErrVersionNotFound)
+
+	// Changing the code creates a new version and keeps the old one intact.
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(bandtesting.ParseTime(200))
+	k.MustEditOracleScript(ctx, id, types.NewOracleScript(
+		bandtesting.Alice.Address, types.DoNotModify, types.DoNotModify, "FILENAME2",
+		types.DoNotModify, types.DoNotModify,
+	))
+	oracleScript = k.MustGetOracleScript(ctx, id)
+	require.Equal(uint64(2), oracleScript.Version)
+	v, err = k.GetOracleScriptVersion(ctx, id, 1)
+	require.NoError(err)
+	require.Equal(version1, v)
+	v, err = k.GetOracleScriptVersion(ctx, id, 2)
+	require.NoError(err)
+	require.Equal(types.NewOracleScriptVersion(id, 2, oracleScript, 20, bandtesting.ParseTime(200)), v)
+
+	// The filename of every version remains available.
+	filename, err := k.GetOracleScriptFilename(ctx, id, 1)
+	require.NoError(err)
+	require.Equal("FILENAME1", filename)
+	filename, err = k.GetOracleScriptFilename(ctx, id, 0)
+	require.NoError(err)
+	require.Equal("FILENAME2", filename)
+	_, err = k.GetOracleScriptFilename(ctx, id, 3)
+	require.ErrorIs(err, types.ErrVersionNotFound)
+}
+
 func (suite *KeeperTestSuite) TestAddOracleScriptMustReturnCorrectID() {
 	ctx := suite.ctx
 	k := suite.oracleKeeper
@@ -154,6 +206,9 @@ func (suite *KeeperTestSuite) TestGetAllOracleScripts() {
 	require := suite.Require()
 
 	oracleScripts := bandtesting.GenerateOracleScripts(suite.homeDir)
+	for i := range oracleScripts {
+		oracleScripts[i].Version = 1
+	}
 
 	// We should be able to get all genesis oracle scripts.
 	require.Equal(
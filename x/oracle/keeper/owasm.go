@@ -85,6 +85,11 @@ func (k Keeper) PrepareRequest(
 		return 0, types.WrapMaxError(types.ErrInvalidAskCount, int(askCount), int(params.MaxAskCount))
 	}
 
+	callback := r.GetCallback()
+	if callback != nil && !k.hooksRouter.HasRoute(callback.Module) {
+		return 0, types.ErrCallbackRouteNotFound.Wrapf("module: %s", callback.Module)
+	}
+
 	// Consume gas for data requests.
 	ctx.GasMeter().ConsumeGas(askCount*params.PerValidatorRequestGas, "PER_VALIDATOR_REQUEST_FEE")
 
@@ -110,6 +115,21 @@ func (k Keeper) PrepareRequest(
 		feePayer.String(),
 		r.GetFeeLimit(),
 	)
+	req.Callback = callback
+
+	// Use the requested version of the oracle script, or the latest version if it is not pinned.
+	script, err := k.GetOracleScript(ctx, req.OracleScriptID)
+	if err != nil {
+		return 0, err
+	}
+	req.OracleScriptVersion = r.GetOracleScriptVersion()
+	if req.OracleScriptVersion == 0 {
+		req.OracleScriptVersion = script.Version
+	}
+	filename, err := k.GetOracleScriptFilename(ctx, req.OracleScriptID, req.OracleScriptVersion)
+	if err != nil {
+		return 0, err
+	}
 
 	// Create an execution environment and call Owasm prepare function.
 	env := types.NewPrepareEnv(
@@ -118,15 +138,11 @@ func (k Keeper) PrepareRequest(
 		int64(params.MaxRawRequestCount),
 		int64(k.GetSpanSize(ctx)),
 	)
-	script, err := k.GetOracleScript(ctx, req.OracleScriptID)
-	if err != nil {
-		return 0, err
-	}
 
 	// Consume fee and execute owasm code
 	ctx.GasMeter().ConsumeGas(params.BaseOwasmGas, "BASE_OWASM_FEE")
 	ctx.GasMeter().ConsumeGas(r.GetPrepareGas(), "OWASM_PREPARE_FEE")
-	code := k.GetFile(script.Filename)
+	code := k.GetFile(filename)
 	output, err := k.owasmVM.Prepare(code, ConvertToOwasmGas(r.GetPrepareGas()), env)
 	if err != nil {
 		return 0, types.ErrBadWasmExecution.Wrap(err.Error())
@@ -137,6 +153,14 @@ func (k Keeper) PrepareRequest(
 	if len(req.RawRequests) == 0 {
 		return 0, types.ErrEmptyRawRequests
 	}
+	// Record the version of each data source used by the raw requests
+	for i, rawReq := range req.RawRequests {
+		ds, err := k.GetDataSource(ctx, rawReq.DataSourceID)
+		if err != nil {
+			return 0, err
+		}
+		req.RawRequests[i].DataSourceVersion = ds.Version
+	}
 	// Collect ds fee
 	totalFees, err := k.CollectFee(ctx, feePayer, req.FeeLimit, askCount, req.RawRequests)
 	if err != nil {
@@ -162,6 +186,9 @@ func (k Keeper) PrepareRequest(
 	for _, val := range req.RequestedValidators {
 		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyValidator, val))
 	}
+	if callback != nil {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyCallbackModule, callback.Module))
+	}
 	ctx.EventManager().EmitEvent(event)
 
 	// Subtract execute fee
@@ -191,8 +218,12 @@ func (k Keeper) PrepareRequest(
 func (k Keeper) ResolveRequest(ctx sdk.Context, reqID types.RequestID) {
 	req := k.MustGetRequest(ctx, reqID)
 	env := types.NewExecuteEnv(req, k.GetReports(ctx, reqID), ctx.BlockTime(), int64(k.GetSpanSize(ctx)))
-	script := k.MustGetOracleScript(ctx, req.OracleScriptID)
-	code := k.GetFile(script.Filename)
+	filename, err := k.GetOracleScriptFilename(ctx, req.OracleScriptID, req.OracleScriptVersion)
+	if err != nil {
+		k.ResolveFailure(ctx, reqID, err.Error())
+		return
+	}
+	code := k.GetFile(filename)
 	output, err := k.owasmVM.Execute(code, ConvertToOwasmGas(req.GetExecuteGas()), env)
 
 	if err != nil {
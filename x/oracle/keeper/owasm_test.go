@@ -230,6 +230,80 @@ func (suite *KeeperTestSuite) TestPrepareRequestSuccessBasic() {
 	require.Equal(1, wrappedGasMeter.CountRecord(testDefaultExecuteGas, "OWASM_EXECUTE_FEE"))
 }
 
+func (suite *KeeperTestSuite) TestPrepareRequestPinnedOracleScriptVersion() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx.WithBlockTime(bandtesting.ParseTime(1581589790)).WithBlockHeight(42)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	// OracleScript#1 is Wasm1 at version 1. Its version 2 uses the code of OracleScript#2,
+	// which fails during prepare.
+	k.MustEditOracleScript(ctx, 1, types.NewOracleScript(
+		owner, types.DoNotModify, types.DoNotModify, k.MustGetOracleScript(ctx, 2).Filename,
+		types.DoNotModify, types.DoNotModify,
+	))
+	require.Equal(uint64(2), k.MustGetOracleScript(ctx, 1).Version)
+
+	newMsg := func(version uint64) *types.MsgRequestData {
+		msg := types.NewMsgRequestData(
+			1,
+			basicCalldata,
+			1,
+			1,
+			basicClientID,
+			bandtesting.Coins100band,
+			testDefaultPrepareGas,
+			testDefaultExecuteGas,
+			alice,
+			0,
+		)
+		msg.OracleScriptVersion = version
+		return msg
+	}
+
+	suite.rollingseedKeeper.
+		EXPECT().
+		GetRollingSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY")).
+		Times(3)
+	suite.bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), alice, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		Times(3)
+
+	// The latest version is used by default.
+	_, err := k.PrepareRequest(ctx, newMsg(0), alice, nil)
+	require.ErrorIs(err, types.ErrBadWasmExecution)
+
+	// A version that does not exist cannot be pinned.
+	_, err = k.PrepareRequest(ctx, newMsg(3), alice, nil)
+	require.ErrorIs(err, types.ErrVersionNotFound)
+
+	// OracleScript#1 version 1: Prepare asks for DS#1,2,3 with ExtID#1,2,3 and calldata "test"
+	id, err := k.PrepareRequest(ctx, newMsg(1), alice, nil)
+	require.NoError(err)
+
+	request := k.MustGetRequest(ctx, id)
+	require.Equal(uint64(1), request.OracleScriptVersion)
+	for _, rawReq := range request.RawRequests {
+		require.Equal(uint64(1), rawReq.DataSourceVersion)
+	}
+
+	// The request is resolved with the pinned version as well.
+	k.SetReport(ctx, id, types.NewReport(
+		validators[0].Address, true, []types.RawReport{
+			types.NewRawReport(1, 0, []byte("test")),
+			types.NewRawReport(2, 0, []byte("test")),
+			types.NewRawReport(3, 0, []byte("test")),
+		},
+	))
+	k.ResolveRequest(ctx, id)
+
+	result := k.MustGetResult(ctx, id)
+	require.Equal(types.RESOLVE_STATUS_SUCCESS, result.ResolveStatus)
+	require.Equal([]byte("test"), result.Result)
+}
+
 func (suite *KeeperTestSuite) TestPrepareRequestInvalidCalldataSize() {
 	ctx := suite.ctx
 	k := suite.oracleKeeper
@@ -1031,6 +1105,58 @@ func (suite *KeeperTestSuite) TestResolveRequestNoReturnData() {
 	)}, ctx.EventManager().Events())
 }
 
+func (suite *KeeperTestSuite) TestResolveRequestOracleScriptVersionNotFound() {
+	require := suite.Require()
+	suite.mockIterateBondedValidatorsByPower()
+	suite.activeAllValidators()
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+
+	ctx = ctx.WithBlockTime(bandtesting.ParseTime(1581589890))
+	request := types.NewRequest(
+		// 4th Wasm - pinned to a version that does not exist
+		4,
+		basicCalldata,
+		[]sdk.ValAddress{bandtesting.Validators[0].ValAddress, bandtesting.Validators[1].ValAddress},
+		1,
+		42,
+		bandtesting.ParseTime(1581589790),
+		basicClientID,
+		[]types.RawRequest{
+			types.NewRawRequest(1, 1, []byte("test")),
+		},
+		nil,
+		bandtesting.TestDefaultExecuteGas,
+		0,
+		bandtesting.FeePayer.Address.String(),
+		bandtesting.Coins100band,
+	)
+	request.OracleScriptVersion = 99
+	k.SetRequest(ctx, 42, request)
+	k.SetReport(ctx, 42, types.NewReport(
+		bandtesting.Validators[0].ValAddress, true, []types.RawReport{
+			types.NewRawReport(1, 0, []byte("test")),
+		},
+	))
+
+	_, err := k.GetOracleScriptFilename(ctx, 4, 99)
+	require.ErrorIs(err, types.ErrVersionNotFound)
+
+	// the request is resolved as a failure instead of halting the chain
+	require.NotPanics(func() { k.ResolveRequest(ctx, 42) })
+	result := types.NewResult(
+		basicClientID, 4, basicCalldata, 2, 1, 42, 1, bandtesting.ParseTime(1581589790).Unix(),
+		bandtesting.ParseTime(1581589890).Unix(), types.RESOLVE_STATUS_FAILURE, nil,
+	)
+	require.Equal(result, k.MustGetResult(ctx, 42))
+	require.Equal(sdk.Events{sdk.NewEvent(
+		types.EventTypeResolve,
+		sdk.NewAttribute(types.AttributeKeyID, "42"),
+		sdk.NewAttribute(types.AttributeKeyResolveStatus, "2"),
+		sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+	)}, ctx.EventManager().Events())
+}
+
 func (suite *KeeperTestSuite) TestResolveRequestWasmFailure() {
 	require := suite.Require()
 	suite.mockIterateBondedValidatorsByPower()
@@ -23,6 +23,7 @@ func (suite *KeeperTestSuite) TestGetSetParams() {
 		OracleRewardPercentage:  50,
 		InactivePenaltyDuration: 1000,
 		IBCRequestEnabled:       true,
+		MaxSubscriptionGas:      2000000,
 	}
 	err := k.SetParams(ctx, expectedParams)
 	require.NoError(err)
@@ -40,6 +41,7 @@ func (suite *KeeperTestSuite) TestGetSetParams() {
 		OracleRewardPercentage:  80,
 		InactivePenaltyDuration: 10000,
 		IBCRequestEnabled:       false,
+		MaxSubscriptionGas:      2000000,
 	}
 	err = k.SetParams(ctx, expectedParams)
 	require.NoError(err)
@@ -57,6 +59,7 @@ func (suite *KeeperTestSuite) TestGetSetParams() {
 		OracleRewardPercentage:  0,
 		InactivePenaltyDuration: 0,
 		IBCRequestEnabled:       false,
+		MaxSubscriptionGas:      2000000,
 	}
 	err = k.SetParams(ctx, expectedParams)
 	require.NoError(err)
@@ -74,6 +77,7 @@ func (suite *KeeperTestSuite) TestGetSetParams() {
 		OracleRewardPercentage:  80,
 		InactivePenaltyDuration: 10000,
 		IBCRequestEnabled:       false,
+		MaxSubscriptionGas:      2000000,
 	}
 	err = k.SetParams(ctx, expectedParams)
 	require.EqualError(fmt.Errorf("max raw request count must be positive: 0"), err.Error())
@@ -43,13 +43,32 @@ func (k Keeper) DeleteRequest(ctx sdk.Context, id types.RequestID) {
 	ctx.KVStore(k.storeKey).Delete(types.RequestStoreKey(id))
 }
 
-// AddRequest attempts to create and save a new request.
+// AddRequest attempts to create and save a new request, together with the versions of the oracle
+// script and data sources it uses.
 func (k Keeper) AddRequest(ctx sdk.Context, req types.Request) types.RequestID {
 	id := k.GetNextRequestID(ctx)
 	k.SetRequest(ctx, id, req)
+	k.SetRequestVersions(ctx, id, req.GetVersions())
 	return id
 }
 
+// SetRequestVersions saves the versions of the oracle script and data sources used by the request.
+// Unlike the request, they are kept after the request is removed.
+func (k Keeper) SetRequestVersions(ctx sdk.Context, id types.RequestID, versions types.RequestVersions) {
+	ctx.KVStore(k.storeKey).Set(types.RequestVersionsStoreKey(id), k.cdc.MustMarshal(&versions))
+}
+
+// GetRequestVersions returns the versions of the oracle script and data sources used by the request.
+func (k Keeper) GetRequestVersions(ctx sdk.Context, id types.RequestID) (types.RequestVersions, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RequestVersionsStoreKey(id))
+	if bz == nil {
+		return types.RequestVersions{}, types.ErrRequestNotFound.Wrapf("versions of id: %d", id)
+	}
+	var versions types.RequestVersions
+	k.cdc.MustUnmarshal(bz, &versions)
+	return versions, nil
+}
+
 // ProcessExpiredRequests resolves all expired requests and deactivates missed validators.
 func (k Keeper) ProcessExpiredRequests(ctx sdk.Context) {
 	currentReqID := k.GetRequestLastExpired(ctx) + 1
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// SetLatestRequestID sets the ID of the latest successful request of an oracle script with the given
+// calldata, ask count and min count.
+func (k Keeper) SetLatestRequestID(
+	ctx sdk.Context,
+	oid types.OracleScriptID,
+	calldata []byte,
+	askCount, minCount uint64,
+	rid types.RequestID,
+) {
+	ctx.KVStore(k.storeKey).Set(
+		types.RequestSearchIndexKey(oid, calldata, askCount, minCount),
+		sdk.Uint64ToBigEndian(uint64(rid)),
+	)
+}
+
+// GetLatestRequestID returns the ID of the latest successful request of an oracle script with the given
+// calldata, ask count and min count.
+func (k Keeper) GetLatestRequestID(
+	ctx sdk.Context,
+	oid types.OracleScriptID,
+	calldata []byte,
+	askCount, minCount uint64,
+) (types.RequestID, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RequestSearchIndexKey(oid, calldata, askCount, minCount))
+	if bz == nil {
+		return 0, types.ErrRequestNotFound.Wrapf(
+			"oracle script id: %d, ask count: %d, min count: %d",
+			oid,
+			askCount,
+			minCount,
+		)
+	}
+	return types.RequestID(sdk.BigEndianToUint64(bz)), nil
+}
+
+// SetPriceResult sets the latest price result of a symbol with the given ask count and min count.
+func (k Keeper) SetPriceResult(ctx sdk.Context, askCount, minCount uint64, priceResult types.PriceResult) {
+	ctx.KVStore(k.storeKey).Set(
+		types.PriceResultStoreKey(priceResult.Symbol, askCount, minCount),
+		k.cdc.MustMarshal(&priceResult),
+	)
+}
+
+// GetPriceResult returns the latest price result of a symbol with the given ask count and min count.
+func (k Keeper) GetPriceResult(
+	ctx sdk.Context,
+	symbol string,
+	askCount, minCount uint64,
+) (types.PriceResult, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.PriceResultStoreKey(symbol, askCount, minCount))
+	if bz == nil {
+		return types.PriceResult{}, types.ErrPriceResultNotFound.Wrapf(
+			"symbol: %s, ask count: %d, min count: %d",
+			symbol,
+			askCount,
+			minCount,
+		)
+	}
+
+	var priceResult types.PriceResult
+	k.cdc.MustUnmarshal(bz, &priceResult)
+	return priceResult, nil
+}
+
+// IndexResult indexes a successful result for the request search and, if the result is of the price
+// reference oracle script, indexes the price of each symbol for the price lookup.
+func (k Keeper) IndexResult(ctx sdk.Context, result types.Result) {
+	if result.ResolveStatus != types.RESOLVE_STATUS_SUCCESS {
+		return
+	}
+
+	k.SetLatestRequestID(
+		ctx,
+		result.OracleScriptID,
+		result.Calldata,
+		result.AskCount,
+		result.MinCount,
+		result.RequestID,
+	)
+
+	oid := k.GetParams(ctx).PriceReferenceOracleScriptID
+	if oid == 0 || result.OracleScriptID != oid {
+		return
+	}
+
+	priceResults, err := types.DecodePriceResults(result)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to index price results of request %d: %s", result.RequestID, err))
+		return
+	}
+
+	for _, priceResult := range priceResults {
+		k.SetPriceResult(ctx, result.AskCount, result.MinCount, priceResult)
+	}
+}
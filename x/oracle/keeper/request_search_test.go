@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/bandprotocol/chain/v3/pkg/obi"
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func priceReferenceRequest(symbols []string, multiplier uint64) types.Request {
+	req := defaultRequest()
+	req.Calldata = obi.MustEncode(types.PriceReferenceInput{Symbols: symbols, Multiplier: multiplier})
+	return req
+}
+
+func (suite *KeeperTestSuite) TestIndexResultRequestSearch() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	_, err := k.GetLatestRequestID(ctx, 1, basicCalldata, 2, 2)
+	require.ErrorIs(err, types.ErrRequestNotFound)
+
+	// A successful result is indexed by its search criteria.
+	k.SetRequest(ctx, 42, defaultRequest())
+	k.SaveResult(ctx, 42, types.RESOLVE_STATUS_SUCCESS, basicResult)
+	rid, err := k.GetLatestRequestID(ctx, 1, basicCalldata, 2, 2)
+	require.NoError(err)
+	require.Equal(types.RequestID(42), rid)
+
+	// A failed result does not replace the latest successful request.
+	k.SetRequest(ctx, 43, defaultRequest())
+	k.SaveResult(ctx, 43, types.RESOLVE_STATUS_FAILURE, nil)
+	rid, err = k.GetLatestRequestID(ctx, 1, basicCalldata, 2, 2)
+	require.NoError(err)
+	require.Equal(types.RequestID(42), rid)
+
+	// A newer successful result replaces it.
+	k.SetRequest(ctx, 44, defaultRequest())
+	k.SaveResult(ctx, 44, types.RESOLVE_STATUS_SUCCESS, basicResult)
+	rid, err = k.GetLatestRequestID(ctx, 1, basicCalldata, 2, 2)
+	require.NoError(err)
+	require.Equal(types.RequestID(44), rid)
+
+	// Different search criteria are indexed separately.
+	_, err = k.GetLatestRequestID(ctx, 1, basicCalldata, 2, 1)
+	require.ErrorIs(err, types.ErrRequestNotFound)
+	_, err = k.GetLatestRequestID(ctx, 2, basicCalldata, 2, 2)
+	require.ErrorIs(err, types.ErrRequestNotFound)
+}
+
+func (suite *KeeperTestSuite) TestIndexResultPriceResults() {
+	ctx := suite.ctx.WithBlockTime(bandtesting.ParseTime(200))
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	req := priceReferenceRequest([]string{"BTC", "ETH"}, 1000000)
+	result := obi.MustEncode(types.PriceReferenceOutput{Pxs: []uint64{60000000000, 3000000000}})
+
+	// Prices are not indexed until the price reference oracle script is set.
+	k.SetRequest(ctx, 42, req)
+	k.SaveResult(ctx, 42, types.RESOLVE_STATUS_SUCCESS, result)
+	_, err := k.GetPriceResult(ctx, "BTC", 2, 2)
+	require.ErrorIs(err, types.ErrPriceResultNotFound)
+
+	params := k.GetParams(ctx)
+	params.PriceReferenceOracleScriptID = 1
+	require.NoError(k.SetParams(ctx, params))
+
+	k.SetRequest(ctx, 43, req)
+	k.SaveResult(ctx, 43, types.RESOLVE_STATUS_SUCCESS, result)
+	btc, err := k.GetPriceResult(ctx, "BTC", 2, 2)
+	require.NoError(err)
+	require.Equal(types.NewPriceResult("BTC", 1000000, 60000000000, 43, bandtesting.ParseTime(200).Unix()), btc)
+	eth, err := k.GetPriceResult(ctx, "ETH", 2, 2)
+	require.NoError(err)
+	require.Equal(types.NewPriceResult("ETH", 1000000, 3000000000, 43, bandtesting.ParseTime(200).Unix()), eth)
+
+	// A result that cannot be decoded is skipped without affecting the indexed prices.
+	k.SetRequest(ctx, 44, req)
+	k.SaveResult(ctx, 44, types.RESOLVE_STATUS_SUCCESS, basicResult)
+	btc, err = k.GetPriceResult(ctx, "BTC", 2, 2)
+	require.NoError(err)
+	require.Equal(types.RequestID(43), btc.RequestID)
+	require.True(k.HasResult(ctx, 44))
+}
+
+func (suite *KeeperTestSuite) TestQueryRequestSearch() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	querier := suite.queryClient
+	require := suite.Require()
+
+	req := &types.QueryRequestSearchRequest{
+		OracleScriptId: 1,
+		Calldata:       hex.EncodeToString(basicCalldata),
+		AskCount:       2,
+		MinCount:       2,
+	}
+	_, err := querier.RequestSearch(context.Background(), req)
+	require.ErrorContains(err, "request not found")
+
+	k.SetRequest(ctx, 42, defaultRequest())
+	k.SetReport(ctx, 42, types.NewReport(validators[0].Address, true, nil))
+	k.SaveResult(ctx, 42, types.RESOLVE_STATUS_SUCCESS, basicResult)
+	k.SetRequestCount(ctx, 42)
+
+	res, err := querier.RequestSearch(context.Background(), req)
+	require.NoError(err)
+	expected, err := querier.Request(context.Background(), &types.QueryRequestRequest{RequestId: 42})
+	require.NoError(err)
+	require.Equal(&types.QueryRequestSearchResponse{Request: expected}, res)
+
+	_, err = querier.RequestSearch(context.Background(), &types.QueryRequestSearchRequest{
+		OracleScriptId: 1,
+		Calldata:       "invalid",
+		AskCount:       2,
+		MinCount:       2,
+	})
+	require.ErrorContains(err, "unable to decode calldata")
+}
+
+func (suite *KeeperTestSuite) TestQueryRequestPrice() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	querier := suite.queryClient
+	require := suite.Require()
+
+	btc := types.NewPriceResult("BTC", 1000000, 60000000000, 1, 1589535022)
+	eth := types.NewPriceResult("ETH", 1000000, 3000000000, 2, 1589535023)
+	k.SetPriceResult(ctx, 16, 10, btc)
+	k.SetPriceResult(ctx, 16, 10, eth)
+
+	res, err := querier.RequestPrice(context.Background(), &types.QueryRequestPriceRequest{
+		Symbols:  []string{"ETH", "BTC"},
+		AskCount: 16,
+		MinCount: 10,
+	})
+	require.NoError(err)
+	require.Equal(&types.QueryRequestPriceResponse{PriceResults: []*types.PriceResult{&eth, &btc}}, res)
+
+	_, err = querier.RequestPrice(context.Background(), &types.QueryRequestPriceRequest{
+		Symbols:  []string{"BTC", "BAND"},
+		AskCount: 16,
+		MinCount: 10,
+	})
+	require.ErrorContains(err, "price result not found")
+
+	_, err = querier.RequestPrice(context.Background(), &types.QueryRequestPriceRequest{AskCount: 16, MinCount: 10})
+	require.ErrorContains(err, "no symbols provided")
+}
@@ -152,6 +152,33 @@ func (suite *KeeperTestSuite) TestSetterGetterRequest() {
 	require.Equal(req2, k.MustGetRequest(ctx, 42))
 }
 
+func (suite *KeeperTestSuite) TestSetterGetterRequestVersions() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	// Getting non-existent request versions should return error.
+	_, err := k.GetRequestVersions(ctx, 42)
+	require.ErrorIs(err, types.ErrRequestNotFound)
+
+	versions := types.RequestVersions{
+		OracleScriptVersion: 2,
+		RawRequestVersions:  []types.RawRequestVersion{types.NewRawRequestVersion(1, 1, 3)},
+	}
+	k.SetRequestVersions(ctx, 42, versions)
+	res, err := k.GetRequestVersions(ctx, 42)
+	require.NoError(err)
+	require.Equal(versions, res)
+
+	// Adding a request also saves its versions.
+	req := defaultRequest()
+	req.OracleScriptVersion = 4
+	rid := k.AddRequest(ctx, req)
+	res, err = k.GetRequestVersions(ctx, rid)
+	require.NoError(err)
+	require.Equal(req.GetVersions(), res)
+}
+
 func (suite *KeeperTestSuite) TestSetterGettterPendingResolveList() {
 	ctx := suite.ctx
 	k := suite.oracleKeeper
@@ -364,4 +391,10 @@ func (suite *KeeperTestSuite) TestProcessExpiredRequests() {
 	suite.testRequest(k, ctx, types.RequestID(2), types.RESOLVE_STATUS_FAILURE, 0, false)
 	suite.testRequest(k, ctx, types.RequestID(3), types.RESOLVE_STATUS_EXPIRED, 0, false)
 	suite.testRequest(k, ctx, types.RequestID(4), types.RESOLVE_STATUS_SUCCESS, 0, false)
+
+	// Versions of the requests are kept after the requests are removed.
+	for rid := types.RequestID(1); rid <= 4; rid++ {
+		_, err := k.GetRequestVersions(ctx, rid)
+		require.NoError(err)
+	}
 }
@@ -187,7 +187,7 @@ func (k Keeper) SaveResult(
 ) {
 	r := k.MustGetRequest(ctx, id)
 	reportCount := k.GetReportCount(ctx, id)
-	k.SetResult(ctx, id, types.NewResult(
+	res := types.NewResult(
 		r.ClientID,                         // ClientID
 		r.OracleScriptID,                   // OracleScriptID
 		r.Calldata,                         // Calldata
@@ -199,7 +199,13 @@ func (k Keeper) SaveResult(
 		ctx.BlockTime().Unix(),             // ResolveTime
 		status,                             // ResolveStatus
 		result,                             // Result
-	))
+	)
+	k.SetResult(ctx, id, res)
+	k.IndexResult(ctx, res)
+
+	if r.Callback != nil {
+		k.handleRequestCallback(ctx, id, r.Requester, *r.Callback, res)
+	}
 
 	if r.IBCChannel != nil {
 		sourceChannel := r.IBCChannel.ChannelId
@@ -234,3 +240,68 @@ func (k Keeper) SaveResult(
 		}
 	}
 }
+
+// handleRequestCallback notifies the module registered under the route of the callback that
+// the request has been resolved. State changes made by the module are discarded if the hook
+// returns an error or panics, and the outcome is recorded in an event.
+func (k Keeper) handleRequestCallback(
+	ctx sdk.Context,
+	id types.RequestID,
+	requester string,
+	callback types.RequestCallback,
+	result types.Result,
+) {
+	event := sdk.NewEvent(
+		types.EventTypeRequestCallback,
+		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyCallbackModule, callback.Module),
+	)
+
+	if err := k.safeAfterRequestResolved(ctx, id, requester, callback, result); err != nil {
+		ctx.EventManager().EmitEvent(event.AppendAttributes(
+			sdk.NewAttribute(types.AttributeKeyCallbackSuccess, "false"),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		))
+		return
+	}
+
+	ctx.EventManager().EmitEvent(event.AppendAttributes(
+		sdk.NewAttribute(types.AttributeKeyCallbackSuccess, "true"),
+	))
+}
+
+// safeAfterRequestResolved calls the AfterRequestResolved hook of the module of the callback
+// in a cached context and only commits its state changes if the hook succeeds.
+func (k Keeper) safeAfterRequestResolved(
+	ctx sdk.Context,
+	id types.RequestID,
+	requester string,
+	callback types.RequestCallback,
+	result types.Result,
+) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			ctx.Logger().Error(fmt.Sprintf("Panic recovered: %v", r))
+			err = types.ErrCallbackPanic
+		}
+	}()
+
+	hooks, ok := k.hooksRouter.GetRoute(callback.Module)
+	if !ok {
+		return types.ErrCallbackRouteNotFound.Wrapf("module: %s", callback.Module)
+	}
+
+	requesterAddr, err := sdk.AccAddressFromBech32(requester)
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := hooks.AfterRequestResolved(cacheCtx, id, requesterAddr, callback.Payload, result); err != nil {
+		return err
+	}
+
+	writeFn()
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/bandprotocol/chain/v3/pkg/gzip"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// SimulateRequest runs the prepare phase of the given oracle script and, if raw reports are
+// given, its execute phase as if every one of the first min count validators reported them.
+// Nothing is written to the store; the validators are deterministic placeholder addresses.
+func (k Keeper) SimulateRequest(
+	ctx sdk.Context,
+	req *types.QuerySimulateRequestRequest,
+) (*types.QuerySimulateRequestResponse, error) {
+	if err := k.validateSimulateRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	code, err := k.getSimulateRequestCode(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]sdk.ValAddress, req.AskCount)
+	for i := range validators {
+		validators[i] = address.Module(types.ModuleName, []byte(fmt.Sprintf("simulate-%d", i)))
+	}
+
+	params := k.GetParams(ctx)
+	request := types.NewRequest(
+		types.OracleScriptID(req.OracleScriptId),
+		req.Calldata,
+		validators,
+		req.MinCount,
+		ctx.BlockHeight(),
+		ctx.BlockTime(),
+		"",
+		nil,
+		nil,
+		req.ExecuteGas,
+		0,
+		"",
+		nil,
+	)
+
+	prepareEnv := types.NewPrepareEnv(
+		request,
+		int64(params.MaxCalldataSize),
+		int64(params.MaxRawRequestCount),
+		int64(k.GetSpanSize(ctx)),
+	)
+	prepareOutput, err := k.owasmVM.Prepare(code, ConvertToOwasmGas(req.PrepareGas), prepareEnv)
+	if err != nil {
+		return nil, types.ErrBadWasmExecution.Wrap(err.Error())
+	}
+
+	request.RawRequests = prepareEnv.GetRawRequests()
+	if len(request.RawRequests) == 0 {
+		return nil, types.ErrEmptyRawRequests
+	}
+
+	estimatedFees := sdk.NewCoins()
+	for i, rawReq := range request.RawRequests {
+		ds, err := k.GetDataSource(ctx, rawReq.DataSourceID)
+		if err != nil {
+			return nil, err
+		}
+		request.RawRequests[i].DataSourceVersion = ds.Version
+
+		for _, c := range ds.Fee {
+			c.Amount = c.Amount.Mul(math.NewInt(int64(req.AskCount)))
+			estimatedFees = estimatedFees.Add(c)
+		}
+	}
+
+	res := &types.QuerySimulateRequestResponse{
+		RawRequests:    request.RawRequests,
+		PrepareGasUsed: prepareOutput.GasUsed,
+		EstimatedFees:  estimatedFees,
+		ResolveStatus:  types.RESOLVE_STATUS_OPEN,
+	}
+	if len(req.RawReports) == 0 {
+		return res, nil
+	}
+
+	if len(req.RawReports) != len(request.RawRequests) {
+		return nil, types.ErrInvalidReportSize
+	}
+	reported := make(map[types.ExternalID]bool)
+	for _, rep := range req.RawReports {
+		if !ContainsEID(request.RawRequests, rep.ExternalID) {
+			return nil, types.ErrRawRequestNotFound.Wrapf("extID: %d", rep.ExternalID)
+		}
+		if reported[rep.ExternalID] {
+			return nil, types.ErrDuplicateExternalID.Wrapf("extID: %d", rep.ExternalID)
+		}
+		reported[rep.ExternalID] = true
+	}
+
+	reports := make([]types.Report, req.MinCount)
+	for i := range reports {
+		reports[i] = types.NewReport(validators[i], true, req.RawReports)
+	}
+
+	executeEnv := types.NewExecuteEnv(request, reports, ctx.BlockTime(), int64(k.GetSpanSize(ctx)))
+	executeOutput, err := k.owasmVM.Execute(code, ConvertToOwasmGas(req.ExecuteGas), executeEnv)
+	switch {
+	case err != nil:
+		res.ResolveStatus = types.RESOLVE_STATUS_FAILURE
+		res.Reason = err.Error()
+	case executeEnv.Retdata == nil:
+		res.ResolveStatus = types.RESOLVE_STATUS_FAILURE
+		res.Reason = "no return data"
+	default:
+		res.ResolveStatus = types.RESOLVE_STATUS_SUCCESS
+		res.Result = executeEnv.Retdata
+		res.ExecuteGasUsed = executeOutput.GasUsed
+	}
+
+	return res, nil
+}
+
+// validateSimulateRequest performs the same checks on the simulated request as the ones done
+// on a real request.
+func (k Keeper) validateSimulateRequest(ctx sdk.Context, req *types.QuerySimulateRequestRequest) error {
+	if req.MinCount <= 0 {
+		return types.ErrInvalidMinCount.Wrapf("got: %d", req.MinCount)
+	}
+	if req.AskCount < req.MinCount {
+		return types.ErrInvalidAskCount.Wrapf("got: %d, min count: %d", req.AskCount, req.MinCount)
+	}
+	if maxAskCount := k.GetParams(ctx).MaxAskCount; req.AskCount > maxAskCount {
+		return types.WrapMaxError(types.ErrInvalidAskCount, int(req.AskCount), int(maxAskCount))
+	}
+	if len(req.Calldata) > int(k.GetSpanSize(ctx)) {
+		return types.WrapMaxError(types.ErrTooLargeCalldata, len(req.Calldata), int(k.GetSpanSize(ctx)))
+	}
+	if req.PrepareGas <= 0 {
+		return types.ErrInvalidOwasmGas.Wrapf("invalid prepare gas: %d", req.PrepareGas)
+	}
+	if req.ExecuteGas <= 0 {
+		return types.ErrInvalidOwasmGas.Wrapf("invalid execute gas: %d", req.ExecuteGas)
+	}
+	if req.PrepareGas+req.ExecuteGas > types.MaximumOwasmGas {
+		return types.ErrInvalidOwasmGas.Wrapf(
+			"sum of prepare gas and execute gas (%d) exceed %d",
+			req.PrepareGas+req.ExecuteGas,
+			types.MaximumOwasmGas,
+		)
+	}
+	return nil
+}
+
+// getSimulateRequestCode returns the compiled Owasm code to be simulated, either loaded from the
+// given version of the on-chain oracle script or, only if the node opts in, compiled from the given code.
+func (k Keeper) getSimulateRequestCode(ctx sdk.Context, req *types.QuerySimulateRequestRequest) ([]byte, error) {
+	if len(req.Code) == 0 {
+		filename, err := k.GetOracleScriptFilename(
+			ctx,
+			types.OracleScriptID(req.OracleScriptId),
+			req.OracleScriptVersion,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return k.GetFile(filename), nil
+	}
+
+	if !k.simulateRequestCode {
+		return nil, types.ErrCodeSimulationDisabled.Wrap("only on-chain oracle scripts can be simulated on this node")
+	}
+
+	code := req.Code
+	if gzip.IsGzipped(code) {
+		var err error
+		code, err = gzip.Uncompress(code, types.MaxWasmCodeSize)
+		if err != nil {
+			return nil, types.ErrUncompressionFailed.Wrap(err.Error())
+		}
+	}
+	if len(code) > types.MaxWasmCodeSize {
+		return nil, types.WrapMaxError(types.ErrTooLargeWasmCode, len(code), types.MaxWasmCodeSize)
+	}
+
+	compiledCode, err := k.owasmVM.Compile(code, types.MaxCompiledWasmCodeSize)
+	if err != nil {
+		return nil, types.ErrOwasmCompilation.Wrapf("caused by %s", err.Error())
+	}
+	return compiledCode, nil
+}
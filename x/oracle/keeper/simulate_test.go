@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/testing/testdata"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func defaultSimulateRequest() *types.QuerySimulateRequestRequest {
+	// OracleScript#1: Prepare asks for DS#1,2,3 with ExtID#1,2,3 and calldata "test"
+	return &types.QuerySimulateRequestRequest{
+		OracleScriptId: 1,
+		Calldata:       basicCalldata,
+		AskCount:       2,
+		MinCount:       1,
+		PrepareGas:     testDefaultPrepareGas,
+		ExecuteGas:     testDefaultExecuteGas,
+	}
+}
+
+func (suite *KeeperTestSuite) TestSimulateRequestPrepareOnly() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	res, err := suite.queryClient.SimulateRequest(ctx, defaultSimulateRequest())
+	require.NoError(err)
+
+	expectRawRequests := []types.RawRequest{
+		types.NewRawRequest(1, 1, []byte("test")),
+		types.NewRawRequest(2, 2, []byte("test")),
+		types.NewRawRequest(3, 3, []byte("test")),
+	}
+	for i := range expectRawRequests {
+		expectRawRequests[i].DataSourceVersion = 1
+	}
+	require.Equal(expectRawRequests, res.RawRequests)
+	require.NotZero(res.PrepareGasUsed)
+	// Each of the 3 data sources charges 1band per validator asked.
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("uband", 6000000)), res.EstimatedFees)
+	require.Equal(types.RESOLVE_STATUS_OPEN, res.ResolveStatus)
+	require.Nil(res.Result)
+
+	// Nothing is saved to the store.
+	require.Equal(uint64(0), suite.oracleKeeper.GetRequestCount(ctx))
+}
+
+func (suite *KeeperTestSuite) TestSimulateRequestWithRawReports() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	req := defaultSimulateRequest()
+	req.RawReports = []types.RawReport{
+		types.NewRawReport(1, 0, []byte("test")),
+		types.NewRawReport(2, 0, []byte("test")),
+		types.NewRawReport(3, 0, []byte("test")),
+	}
+
+	res, err := k.SimulateRequest(ctx, req)
+	require.NoError(err)
+	require.Equal(types.RESOLVE_STATUS_SUCCESS, res.ResolveStatus)
+	require.Equal([]byte("test"), res.Result)
+	require.NotZero(res.ExecuteGasUsed)
+
+	// Simulating the local code of the oracle script is disabled by default.
+	req.OracleScriptId = 0
+	req.Code = testdata.Wasm1
+	_, err = k.SimulateRequest(ctx, req)
+	require.ErrorIs(err, types.ErrCodeSimulationDisabled)
+	_, err = suite.queryClient.SimulateRequest(ctx, req)
+	require.ErrorContains(err, types.ErrCodeSimulationDisabled.Error())
+
+	// Once the node opts in, the same result is given by the local code of the oracle script.
+	k.SetSimulateRequestCode(true)
+	localRes, err := k.SimulateRequest(ctx, req)
+	require.NoError(err)
+	require.Equal(res, localRes)
+}
+
+func (suite *KeeperTestSuite) TestSimulateRequestFailure() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	// OracleScript#2 sets return data in prepare, which is not allowed.
+	req := defaultSimulateRequest()
+	req.OracleScriptId = 2
+	_, err := k.SimulateRequest(ctx, req)
+	require.ErrorIs(err, types.ErrBadWasmExecution)
+
+	req = defaultSimulateRequest()
+	req.OracleScriptId = 999
+	_, err = k.SimulateRequest(ctx, req)
+	require.ErrorIs(err, types.ErrOracleScriptNotFound)
+
+	req = defaultSimulateRequest()
+	req.MinCount = 3
+	_, err = k.SimulateRequest(ctx, req)
+	require.ErrorIs(err, types.ErrInvalidAskCount)
+
+	req = defaultSimulateRequest()
+	req.PrepareGas = 0
+	_, err = k.SimulateRequest(ctx, req)
+	require.ErrorIs(err, types.ErrInvalidOwasmGas)
+
+	req = defaultSimulateRequest()
+	req.Code = []byte("not wasm")
+	_, err = k.SimulateRequest(ctx, req)
+	require.ErrorIs(err, types.ErrCodeSimulationDisabled)
+	k.SetSimulateRequestCode(true)
+	_, err = k.SimulateRequest(ctx, req)
+	require.ErrorIs(err, types.ErrOwasmCompilation)
+
+	req = defaultSimulateRequest()
+	req.RawReports = []types.RawReport{types.NewRawReport(1, 0, []byte("test"))}
+	_, err = k.SimulateRequest(ctx, req)
+	require.ErrorIs(err, types.ErrInvalidReportSize)
+
+	req.RawReports = []types.RawReport{
+		types.NewRawReport(1, 0, []byte("test")),
+		types.NewRawReport(1, 0, []byte("test")),
+		types.NewRawReport(3, 0, []byte("test")),
+	}
+	_, err = k.SimulateRequest(ctx, req)
+	require.ErrorIs(err, types.ErrDuplicateExternalID)
+
+	req.RawReports[1].ExternalID = 4
+	_, err = k.SimulateRequest(ctx, req)
+	require.ErrorIs(err, types.ErrRawRequestNotFound)
+}
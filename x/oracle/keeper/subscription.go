@@ -0,0 +1,318 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// SetSubscriptionCount sets the number of subscription count to the given value.
+func (k Keeper) SetSubscriptionCount(ctx sdk.Context, count uint64) {
+	ctx.KVStore(k.storeKey).Set(types.SubscriptionCountStoreKey, sdk.Uint64ToBigEndian(count))
+}
+
+// GetSubscriptionCount returns the current number of all subscriptions ever exist.
+func (k Keeper) GetSubscriptionCount(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.SubscriptionCountStoreKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// HasSubscription checks if the subscription of this ID exists in the storage.
+func (k Keeper) HasSubscription(ctx sdk.Context, id types.SubscriptionID) bool {
+	return ctx.KVStore(k.storeKey).Has(types.SubscriptionStoreKey(id))
+}
+
+// GetSubscription returns the subscription struct for the given ID or error if not exists.
+func (k Keeper) GetSubscription(ctx sdk.Context, id types.SubscriptionID) (types.Subscription, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.SubscriptionStoreKey(id))
+	if bz == nil {
+		return types.Subscription{}, types.ErrSubscriptionNotFound.Wrapf("id: %d", id)
+	}
+	var subscription types.Subscription
+	k.cdc.MustUnmarshal(bz, &subscription)
+	return subscription, nil
+}
+
+// MustGetSubscription returns the subscription struct for the given ID. Panic if not exists.
+func (k Keeper) MustGetSubscription(ctx sdk.Context, id types.SubscriptionID) types.Subscription {
+	subscription, err := k.GetSubscription(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return subscription
+}
+
+// SetSubscription saves the given subscription to the storage without performing validation.
+// The active subscription index is kept in sync with the IsActive flag.
+func (k Keeper) SetSubscription(ctx sdk.Context, subscription types.Subscription) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SubscriptionStoreKey(subscription.ID), k.cdc.MustMarshal(&subscription))
+
+	if subscription.IsActive {
+		store.Set(types.ActiveSubscriptionIDStoreKey(subscription.ID), []byte{0x01})
+	} else {
+		store.Delete(types.ActiveSubscriptionIDStoreKey(subscription.ID))
+	}
+}
+
+// DeleteSubscription removes the given subscription and its active index from the storage.
+func (k Keeper) DeleteSubscription(ctx sdk.Context, id types.SubscriptionID) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SubscriptionStoreKey(id))
+	store.Delete(types.ActiveSubscriptionIDStoreKey(id))
+}
+
+// GetAllSubscriptions returns the list of all subscriptions in the store, or nil if there is none.
+func (k Keeper) GetAllSubscriptions(ctx sdk.Context) (subscriptions []types.Subscription) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.SubscriptionStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var subscription types.Subscription
+		k.cdc.MustUnmarshal(iterator.Value(), &subscription)
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions
+}
+
+// GetActiveSubscriptionIDs returns the IDs of all active subscriptions in ascending order.
+func (k Keeper) GetActiveSubscriptionIDs(ctx sdk.Context) (ids []types.SubscriptionID) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ActiveSubscriptionIDStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[len(types.ActiveSubscriptionIDStoreKeyPrefix):])
+		ids = append(ids, types.SubscriptionID(id))
+	}
+	return ids
+}
+
+// AddSubscription assigns a new ID and escrow account to the given subscription, activates
+// it and saves it to the storage.
+func (k Keeper) AddSubscription(ctx sdk.Context, subscription types.Subscription) (types.SubscriptionID, error) {
+	id := types.SubscriptionID(k.GetSubscriptionCount(ctx) + 1)
+
+	escrow, err := k.GenerateSubscriptionAccount(ctx, fmt.Sprintf("%d", id))
+	if err != nil {
+		return 0, err
+	}
+
+	subscription.ID = id
+	subscription.Escrow = escrow.String()
+	subscription.IsActive = true
+	k.SetSubscription(ctx, subscription)
+	k.SetSubscriptionCount(ctx, uint64(id))
+
+	return id, nil
+}
+
+// GenerateSubscriptionAccount creates a new account to be used as the escrow of a subscription.
+func (k Keeper) GenerateSubscriptionAccount(ctx sdk.Context, key string) (sdk.AccAddress, error) {
+	header := ctx.BlockHeader()
+
+	buf := []byte(key)
+	buf = append(buf, header.AppHash...)
+	buf = append(buf, header.DataHash...)
+
+	moduleCred, err := authtypes.NewModuleCredential(types.ModuleName, []byte(types.SubscriptionAccountsKey), buf)
+	if err != nil {
+		return nil, err
+	}
+
+	escrow := sdk.AccAddress(moduleCred.Address())
+
+	if acc := k.authKeeper.GetAccount(ctx, escrow); acc != nil {
+		// this should not happen
+		return nil, types.ErrAccountAlreadyExist.Wrapf(
+			"existing account for newly generated key account address %s",
+			escrow.String(),
+		)
+	}
+
+	escrowAcc, err := authtypes.NewBaseAccountWithPubKey(moduleCred)
+	if err != nil {
+		return nil, err
+	}
+
+	k.authKeeper.NewAccount(ctx, escrowAcc)
+	k.authKeeper.SetAccount(ctx, escrowAcc)
+
+	return escrow, nil
+}
+
+// DepositSubscription transfers the given amount from the depositor to the escrow of the subscription.
+func (k Keeper) DepositSubscription(
+	ctx sdk.Context,
+	id types.SubscriptionID,
+	depositor sdk.AccAddress,
+	amount sdk.Coins,
+) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	subscription, err := k.GetSubscription(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoins(ctx, depositor, sdk.MustAccAddressFromBech32(subscription.Escrow), amount)
+}
+
+// RemoveSubscription refunds the remaining balance of the escrow to the owner and removes the
+// subscription from the storage. It returns the refunded amount.
+func (k Keeper) RemoveSubscription(ctx sdk.Context, id types.SubscriptionID) (sdk.Coins, error) {
+	subscription, err := k.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	escrow := sdk.MustAccAddressFromBech32(subscription.Escrow)
+	refund := k.bankKeeper.SpendableCoins(ctx, escrow)
+	if !refund.IsZero() {
+		if err := k.bankKeeper.SendCoins(
+			ctx,
+			escrow,
+			sdk.MustAccAddressFromBech32(subscription.Owner),
+			refund,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	k.DeleteSubscription(ctx, id)
+
+	return refund, nil
+}
+
+// ProcessSubscriptions makes a new request for every active subscription that is due at the
+// current block. A subscription whose request cannot be made, e.g. because its escrow has run
+// out of funds or it runs out of gas, is paused.
+func (k Keeper) ProcessSubscriptions(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	for _, id := range k.GetActiveSubscriptionIDs(ctx) {
+		subscription := k.MustGetSubscription(ctx, id)
+		if !subscription.IsDue(ctx.BlockHeight(), ctx.BlockTime()) {
+			continue
+		}
+
+		reqID, err := k.safePrepareSubscriptionRequest(ctx, subscription, params)
+		if err != nil {
+			subscription.IsActive = false
+			k.SetSubscription(ctx, subscription)
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypePauseSubscription,
+				sdk.NewAttribute(types.AttributeKeySubscriptionID, fmt.Sprintf("%d", id)),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			))
+			continue
+		}
+
+		subscription.LastRequestID = reqID
+		subscription.LastRequestHeight = ctx.BlockHeight()
+		subscription.LastRequestTime = ctx.BlockTime().Unix()
+		k.SetSubscription(ctx, subscription)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSubscriptionRequest,
+			sdk.NewAttribute(types.AttributeKeySubscriptionID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", reqID)),
+			sdk.NewAttribute(types.AttributeKeyFee, params.SubscriptionFee.String()),
+		))
+	}
+}
+
+// safePrepareSubscriptionRequest charges the subscription fee from the escrow and makes a request
+// on behalf of the subscription in a cached context with a gas meter limited to the max subscription
+// gas. Its state changes are only committed if the request is made successfully.
+func (k Keeper) safePrepareSubscriptionRequest(
+	ctx sdk.Context,
+	subscription types.Subscription,
+	params types.Params,
+) (reqID types.RequestID, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = sdkerrors.ErrOutOfGas.Wrapf("out of gas in location: %s", oog.Descriptor)
+				return
+			}
+
+			ctx.Logger().Error(fmt.Sprintf("Panic recovered: %v", r))
+			err = fmt.Errorf("panic in preparing request: %v", r)
+		}
+	}()
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(params.MaxSubscriptionGas))
+
+	escrow := sdk.MustAccAddressFromBech32(subscription.Escrow)
+	if !params.SubscriptionFee.IsZero() {
+		feeCollector := k.authKeeper.GetModuleAccount(cacheCtx, k.feeCollectorName)
+		if err := k.bankKeeper.SendCoins(cacheCtx, escrow, feeCollector.GetAddress(), params.SubscriptionFee); err != nil {
+			return 0, err
+		}
+	}
+
+	reqID, err = k.PrepareRequest(cacheCtx, subscription.NewRequestSpec(), escrow, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	writeFn()
+
+	return reqID, nil
+}
+
+// checkActiveSubscriptionLimit returns an error if the number of active subscriptions has already
+// reached the max active subscriptions.
+func (k Keeper) checkActiveSubscriptionLimit(ctx sdk.Context) error {
+	maxActiveSubscriptions := k.GetParams(ctx).MaxActiveSubscriptions
+	if activeCount := uint64(len(k.GetActiveSubscriptionIDs(ctx))); activeCount >= maxActiveSubscriptions {
+		return types.ErrSubscriptionLimit.Wrapf("max: %d", maxActiveSubscriptions)
+	}
+
+	return nil
+}
+
+// validateSubscriptionRequest checks the parts of a subscription's request that depend on the
+// current state, so that a misconfigured subscription is rejected upfront instead of being
+// paused on its first schedule.
+func (k Keeper) validateSubscriptionRequest(
+	ctx sdk.Context,
+	oracleScriptID types.OracleScriptID,
+	calldata []byte,
+	askCount uint64,
+	prepareGas uint64,
+	executeGas uint64,
+) error {
+	if !k.HasOracleScript(ctx, oracleScriptID) {
+		return types.ErrOracleScriptNotFound.Wrapf("id: %d", oracleScriptID)
+	}
+
+	if len(calldata) > int(k.GetSpanSize(ctx)) {
+		return types.WrapMaxError(types.ErrTooLargeCalldata, len(calldata), int(k.GetSpanSize(ctx)))
+	}
+
+	params := k.GetParams(ctx)
+	if askCount > params.MaxAskCount {
+		return types.WrapMaxError(types.ErrInvalidAskCount, int(askCount), int(params.MaxAskCount))
+	}
+
+	// the gas of the store accesses is not known upfront, so only the fixed gas of the request is checked
+	requestGas := 2*params.BaseOwasmGas + askCount*params.PerValidatorRequestGas + prepareGas + executeGas
+	if requestGas > params.MaxSubscriptionGas {
+		return types.WrapMaxError(types.ErrInvalidOwasmGas, int(requestGas), int(params.MaxSubscriptionGas))
+	}
+
+	return nil
+}
@@ -0,0 +1,449 @@
+package keeper_test
+
+import (
+	"errors"
+
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func defaultSubscription(id types.SubscriptionID, escrow sdk.AccAddress, isActive bool) types.Subscription {
+	return types.NewSubscription(
+		id,
+		1,
+		basicCalldata,
+		1,
+		1,
+		basicClientID,
+		bandtesting.Coins100band,
+		testDefaultPrepareGas,
+		testDefaultExecuteGas,
+		types.ENCODER_UNSPECIFIED,
+		10,
+		0,
+		escrow.String(),
+		owner.String(),
+		isActive,
+	)
+}
+
+func (suite *KeeperTestSuite) mockNewAccount() {
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil)
+	suite.authKeeper.EXPECT().NewAccount(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, acc sdk.AccountI) sdk.AccountI { return acc },
+	)
+	suite.authKeeper.EXPECT().SetAccount(gomock.Any(), gomock.Any())
+}
+
+func (suite *KeeperTestSuite) mockFeeCollector() sdk.AccAddress {
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount(authtypes.FeeCollectorName)
+	suite.authKeeper.EXPECT().
+		GetModuleAccount(gomock.Any(), authtypes.FeeCollectorName).
+		Return(feeCollectorAcc).
+		AnyTimes()
+	return feeCollectorAcc.GetAddress()
+}
+
+func (suite *KeeperTestSuite) TestSubscriptionBasicFunctions() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	require.Equal(uint64(0), k.GetSubscriptionCount(ctx))
+	k.SetSubscriptionCount(ctx, 42)
+	require.Equal(uint64(42), k.GetSubscriptionCount(ctx))
+
+	sub1 := defaultSubscription(1, alice, true)
+	sub2 := defaultSubscription(2, bob, false)
+	k.SetSubscription(ctx, sub1)
+	k.SetSubscription(ctx, sub2)
+
+	require.True(k.HasSubscription(ctx, 1))
+	require.False(k.HasSubscription(ctx, 3))
+	_, err := k.GetSubscription(ctx, 3)
+	require.ErrorIs(err, types.ErrSubscriptionNotFound)
+	require.Panics(func() { k.MustGetSubscription(ctx, 3) })
+	require.Equal(sub2, k.MustGetSubscription(ctx, 2))
+	require.Equal([]types.Subscription{sub1, sub2}, k.GetAllSubscriptions(ctx))
+	require.Equal([]types.SubscriptionID{1}, k.GetActiveSubscriptionIDs(ctx))
+
+	// The active index follows the IsActive flag.
+	sub1.IsActive = false
+	sub2.IsActive = true
+	k.SetSubscription(ctx, sub1)
+	k.SetSubscription(ctx, sub2)
+	require.Equal([]types.SubscriptionID{2}, k.GetActiveSubscriptionIDs(ctx))
+
+	k.DeleteSubscription(ctx, 2)
+	require.False(k.HasSubscription(ctx, 2))
+	require.Empty(k.GetActiveSubscriptionIDs(ctx))
+}
+
+func (suite *KeeperTestSuite) TestAddSubscription() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.mockNewAccount()
+
+	id, err := k.AddSubscription(ctx, defaultSubscription(0, sdk.AccAddress{}, false))
+	require.NoError(err)
+	require.Equal(types.SubscriptionID(1), id)
+	require.Equal(uint64(1), k.GetSubscriptionCount(ctx))
+
+	subscription := k.MustGetSubscription(ctx, id)
+	require.Equal(id, subscription.ID)
+	require.True(subscription.IsActive)
+	require.NotEmpty(subscription.Escrow)
+	require.NotEqual(owner.String(), subscription.Escrow)
+	require.Equal([]types.SubscriptionID{1}, k.GetActiveSubscriptionIDs(ctx))
+}
+
+func (suite *KeeperTestSuite) TestAddSubscriptionAccountAlreadyExist() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(authtypes.NewBaseAccountWithAddress(alice))
+
+	_, err := k.AddSubscription(ctx, defaultSubscription(0, sdk.AccAddress{}, false))
+	require.ErrorIs(err, types.ErrAccountAlreadyExist)
+	require.Equal(uint64(0), k.GetSubscriptionCount(ctx))
+}
+
+func (suite *KeeperTestSuite) TestProcessSubscriptions() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+	k.SetSubscription(ctx, defaultSubscription(1, alice, true))
+	k.SetSubscription(ctx, defaultSubscription(2, bob, false))
+
+	suite.rollingseedKeeper.
+		EXPECT().
+		GetRollingSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY")).
+		Times(2)
+	feeCollector := suite.mockFeeCollector()
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, feeCollector, types.DefaultSubscriptionFee).Times(2)
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, treasury, bandtesting.Coins1band).Times(6)
+
+	// The first request is made right away.
+	ctx = ctx.WithBlockTime(bandtesting.ParseTime(1581589790)).WithBlockHeight(42)
+	k.ProcessSubscriptions(ctx)
+
+	subscription := k.MustGetSubscription(ctx, 1)
+	require.Equal(types.RequestID(1), subscription.LastRequestID)
+	require.Equal(int64(42), subscription.LastRequestHeight)
+	require.Equal(int64(1581589790), subscription.LastRequestTime)
+	require.True(subscription.IsActive)
+
+	request := k.MustGetRequest(ctx, 1)
+	require.Equal(alice.String(), request.Requester)
+	require.Equal(basicClientID, request.ClientID)
+	require.Equal(basicCalldata, request.Calldata)
+	require.Equal(sdk.NewEvent(
+		types.EventTypeSubscriptionRequest,
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, "1"),
+		sdk.NewAttribute(types.AttributeKeyID, "1"),
+		sdk.NewAttribute(types.AttributeKeyFee, types.DefaultSubscriptionFee.String()),
+	), ctx.EventManager().Events()[len(ctx.EventManager().Events())-1])
+
+	// Nothing happens before the interval has passed.
+	k.ProcessSubscriptions(ctx.WithBlockHeight(51))
+	require.Equal(uint64(1), k.GetRequestCount(ctx))
+
+	// The next request is made once the interval has passed.
+	k.ProcessSubscriptions(ctx.WithBlockHeight(52))
+	require.Equal(uint64(2), k.GetRequestCount(ctx))
+	require.Equal(types.RequestID(2), k.MustGetSubscription(ctx, 1).LastRequestID)
+	require.Equal(int64(52), k.MustGetSubscription(ctx, 1).LastRequestHeight)
+
+	// Inactive subscriptions never make requests.
+	require.Equal(types.RequestID(0), k.MustGetSubscription(ctx, 2).LastRequestID)
+}
+
+func (suite *KeeperTestSuite) TestProcessSubscriptionsPauseOnInsufficientFunds() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+	k.SetSubscription(ctx, defaultSubscription(1, alice, true))
+
+	suite.rollingseedKeeper.
+		EXPECT().
+		GetRollingSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY"))
+	feeCollector := suite.mockFeeCollector()
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, feeCollector, types.DefaultSubscriptionFee)
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, treasury, bandtesting.Coins1band).
+		Return(sdkerrors.ErrInsufficientFunds)
+
+	k.ProcessSubscriptions(ctx)
+
+	subscription := k.MustGetSubscription(ctx, 1)
+	require.False(subscription.IsActive)
+	require.Equal(types.RequestID(0), subscription.LastRequestID)
+	require.Empty(k.GetActiveSubscriptionIDs(ctx))
+	require.Equal(uint64(0), k.GetRequestCount(ctx))
+	require.Equal(sdk.Events{sdk.NewEvent(
+		types.EventTypePauseSubscription,
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, "1"),
+		sdk.NewAttribute(types.AttributeKeyReason, sdkerrors.ErrInsufficientFunds.Error()),
+	)}, ctx.EventManager().Events())
+}
+
+func (suite *KeeperTestSuite) TestProcessSubscriptionsPauseOnUnpaidSubscriptionFee() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	// a subscription without data source fees still pays the subscription fee
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+	k.SetSubscription(ctx, defaultSubscription(1, alice, true))
+
+	feeCollector := suite.mockFeeCollector()
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, feeCollector, types.DefaultSubscriptionFee).
+		Return(sdkerrors.ErrInsufficientFunds)
+
+	k.ProcessSubscriptions(ctx)
+
+	require.False(k.MustGetSubscription(ctx, 1).IsActive)
+	require.Equal(uint64(0), k.GetRequestCount(ctx))
+}
+
+func (suite *KeeperTestSuite) TestProcessSubscriptionsPauseOnOutOfGas() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	params := k.GetParams(ctx)
+	params.SubscriptionFee = sdk.NewCoins()
+	params.MaxSubscriptionGas = testDefaultPrepareGas
+	require.NoError(k.SetParams(ctx, params))
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+	k.SetSubscription(ctx, defaultSubscription(1, alice, true))
+
+	suite.rollingseedKeeper.
+		EXPECT().
+		GetRollingSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY")).
+		AnyTimes()
+
+	// the request is bounded by the max subscription gas instead of the infinite gas meter of the block
+	k.ProcessSubscriptions(ctx)
+
+	require.False(k.MustGetSubscription(ctx, 1).IsActive)
+	require.Equal(uint64(0), k.GetRequestCount(ctx))
+	events := ctx.EventManager().Events()
+	require.Equal(types.EventTypePauseSubscription, events[len(events)-1].Type)
+	reason, ok := events[len(events)-1].GetAttribute(types.AttributeKeyReason)
+	require.True(ok)
+	require.Contains(reason.Value, "out of gas")
+}
+
+func (suite *KeeperTestSuite) TestMsgCreateSubscription() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+
+	msg := types.NewMsgCreateSubscription(
+		1,
+		basicCalldata,
+		1,
+		1,
+		basicClientID,
+		bandtesting.Coins100band,
+		testDefaultPrepareGas,
+		testDefaultExecuteGas,
+		types.ENCODER_UNSPECIFIED,
+		0,
+		600,
+		bandtesting.Coins100band,
+		owner,
+	)
+
+	suite.mockNewAccount()
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), owner, gomock.Any(), bandtesting.Coins100band)
+
+	res, err := suite.msgServer.CreateSubscription(ctx, msg)
+	require.NoError(err)
+	require.Equal(types.SubscriptionID(1), res.SubscriptionID)
+
+	subscription := k.MustGetSubscription(ctx, 1)
+	require.Equal(owner.String(), subscription.Owner)
+	require.Equal(uint64(600), subscription.TimeInterval)
+	require.True(subscription.IsActive)
+
+	// Unknown oracle script is rejected upfront.
+	msg.OracleScriptID = 42
+	_, err = suite.msgServer.CreateSubscription(ctx, msg)
+	require.ErrorIs(err, types.ErrOracleScriptNotFound)
+
+	// Ask count beyond the max ask count param is rejected upfront.
+	msg.OracleScriptID = 1
+	msg.AskCount = k.GetParams(ctx).MaxAskCount + 1
+	_, err = suite.msgServer.CreateSubscription(ctx, msg)
+	require.ErrorIs(err, types.ErrInvalidAskCount)
+
+	// Gas beyond the max subscription gas param is rejected upfront.
+	msg.AskCount = 1
+	msg.PrepareGas = k.GetParams(ctx).MaxSubscriptionGas
+	_, err = suite.msgServer.CreateSubscription(ctx, msg)
+	require.ErrorIs(err, types.ErrInvalidOwasmGas)
+
+	// No more subscription can be created once the max active subscriptions is reached.
+	msg.PrepareGas = testDefaultPrepareGas
+	params := k.GetParams(ctx)
+	params.MaxActiveSubscriptions = 1
+	require.NoError(k.SetParams(ctx, params))
+	_, err = suite.msgServer.CreateSubscription(ctx, msg)
+	require.ErrorIs(err, types.ErrSubscriptionLimit)
+}
+
+func (suite *KeeperTestSuite) TestMsgUpdateSubscription() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+	k.SetSubscription(ctx, defaultSubscription(1, alice, false))
+
+	msg := types.NewMsgUpdateSubscription(
+		1,
+		4,
+		[]byte("new calldata"),
+		2,
+		1,
+		"new client id",
+		bandtesting.Coins1band,
+		testDefaultPrepareGas,
+		testDefaultExecuteGas,
+		types.ENCODER_UNSPECIFIED,
+		20,
+		0,
+		bandtesting.Coins10uband,
+		bob,
+	)
+
+	// Only the owner can update the subscription.
+	_, err := suite.msgServer.UpdateSubscription(ctx, msg)
+	require.ErrorIs(err, types.ErrEditorNotAuthorized)
+
+	msg.Owner = owner.String()
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), owner, alice, bandtesting.Coins10uband)
+
+	_, err = suite.msgServer.UpdateSubscription(ctx, msg)
+	require.NoError(err)
+
+	subscription := k.MustGetSubscription(ctx, 1)
+	require.Equal(types.OracleScriptID(4), subscription.OracleScriptID)
+	require.Equal([]byte("new calldata"), subscription.Calldata)
+	require.Equal(uint64(2), subscription.AskCount)
+	require.Equal("new client id", subscription.ClientID)
+	require.Equal(bandtesting.Coins1band, subscription.FeeLimit)
+	require.Equal(uint64(20), subscription.BlockInterval)
+	require.Equal(alice.String(), subscription.Escrow)
+	// A paused subscription is resumed.
+	require.True(subscription.IsActive)
+	require.Equal([]types.SubscriptionID{1}, k.GetActiveSubscriptionIDs(ctx))
+
+	msg.SubscriptionID = 2
+	_, err = suite.msgServer.UpdateSubscription(ctx, msg)
+	require.ErrorIs(err, types.ErrSubscriptionNotFound)
+
+	// A paused subscription cannot be resumed once the max active subscriptions is reached.
+	params := k.GetParams(ctx)
+	params.MaxActiveSubscriptions = 1
+	require.NoError(k.SetParams(ctx, params))
+	k.SetSubscription(ctx, defaultSubscription(2, bob, false))
+	_, err = suite.msgServer.UpdateSubscription(ctx, msg)
+	require.ErrorIs(err, types.ErrSubscriptionLimit)
+
+	// An active subscription can still be updated.
+	msg.SubscriptionID = 1
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), owner, alice, bandtesting.Coins10uband)
+	_, err = suite.msgServer.UpdateSubscription(ctx, msg)
+	require.NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestMsgCancelSubscription() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.SetSubscription(ctx, defaultSubscription(1, alice, true))
+
+	// Only the owner can cancel the subscription.
+	_, err := suite.msgServer.CancelSubscription(ctx, types.NewMsgCancelSubscription(1, bob))
+	require.ErrorIs(err, types.ErrEditorNotAuthorized)
+
+	suite.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), alice).Return(bandtesting.Coins10uband)
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, owner, bandtesting.Coins10uband)
+
+	_, err = suite.msgServer.CancelSubscription(ctx, types.NewMsgCancelSubscription(1, owner))
+	require.NoError(err)
+	require.False(k.HasSubscription(ctx, 1))
+	require.Empty(k.GetActiveSubscriptionIDs(ctx))
+
+	_, err = suite.msgServer.CancelSubscription(ctx, types.NewMsgCancelSubscription(1, owner))
+	require.ErrorIs(err, types.ErrSubscriptionNotFound)
+}
+
+func (suite *KeeperTestSuite) TestMsgCancelSubscriptionRefundFailed() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.SetSubscription(ctx, defaultSubscription(1, alice, true))
+
+	suite.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), alice).Return(bandtesting.Coins10uband)
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, owner, bandtesting.Coins10uband).
+		Return(errors.New("send failed"))
+
+	_, err := suite.msgServer.CancelSubscription(ctx, types.NewMsgCancelSubscription(1, owner))
+	require.EqualError(err, "send failed")
+	require.True(k.HasSubscription(ctx, 1))
+}
+
+func (suite *KeeperTestSuite) TestQuerySubscriptions() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	sub1 := defaultSubscription(1, alice, true)
+	sub2 := defaultSubscription(2, bob, false)
+	k.SetSubscription(ctx, sub1)
+	k.SetSubscription(ctx, sub2)
+
+	suite.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), bob).Return(bandtesting.Coins1band)
+
+	res, err := suite.queryClient.Subscription(ctx, &types.QuerySubscriptionRequest{SubscriptionId: 2})
+	require.NoError(err)
+	require.Equal(&types.QuerySubscriptionResponse{Subscription: sub2, Balance: bandtesting.Coins1band}, res)
+
+	_, err = suite.queryClient.Subscription(ctx, &types.QuerySubscriptionRequest{SubscriptionId: 3})
+	require.ErrorContains(err, types.ErrSubscriptionNotFound.Error())
+
+	listRes, err := suite.queryClient.Subscriptions(ctx, &types.QuerySubscriptionsRequest{})
+	require.NoError(err)
+	require.Equal([]types.Subscription{sub1, sub2}, listRes.Subscriptions)
+	require.Equal(uint64(2), listRes.Pagination.Total)
+}
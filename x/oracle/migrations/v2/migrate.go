@@ -27,6 +27,11 @@ func Migrate(
 	var currParams types.Params
 	legacySubspace.GetParamSet(ctx, &currParams)
 
+	// the subscription params were never managed by x/params, so they start from their defaults
+	currParams.SubscriptionFee = types.DefaultSubscriptionFee
+	currParams.MaxSubscriptionGas = types.DefaultMaxSubscriptionGas
+	currParams.MaxActiveSubscriptions = types.DefaultMaxActiveSubscriptions
+
 	if err := currParams.Validate(); err != nil {
 		return err
 	}
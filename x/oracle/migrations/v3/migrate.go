@@ -0,0 +1,87 @@
+package v3
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+const (
+	ModuleName = "oracle"
+)
+
+// Migrate migrates the x/oracle module state from the consensus version 2 to
+// version 3. Specifically, it records the current state of every existing data
+// source and oracle script as their first version, and sets the subscription
+// params to their defaults.
+func Migrate(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	if bz := store.Get(types.ParamsKeyPrefix); bz != nil {
+		cdc.MustUnmarshal(bz, &params)
+		if params.MaxSubscriptionGas == 0 {
+			params.SubscriptionFee = types.DefaultSubscriptionFee
+			params.MaxSubscriptionGas = types.DefaultMaxSubscriptionGas
+			params.MaxActiveSubscriptions = types.DefaultMaxActiveSubscriptions
+			store.Set(types.ParamsKeyPrefix, cdc.MustMarshal(&params))
+		}
+	}
+
+	dataSources := make(map[types.DataSourceID]types.DataSource)
+	var dataSourceIDs []types.DataSourceID
+	dsIterator := storetypes.KVStorePrefixIterator(store, types.DataSourceStoreKeyPrefix)
+	for ; dsIterator.Valid(); dsIterator.Next() {
+		id := types.DataSourceID(sdk.BigEndianToUint64(dsIterator.Key()[len(types.DataSourceStoreKeyPrefix):]))
+		var dataSource types.DataSource
+		cdc.MustUnmarshal(dsIterator.Value(), &dataSource)
+		dataSources[id] = dataSource
+		dataSourceIDs = append(dataSourceIDs, id)
+	}
+	dsIterator.Close()
+
+	for _, id := range dataSourceIDs {
+		dataSource := dataSources[id]
+		if dataSource.Version != 0 {
+			continue
+		}
+
+		dataSource.Version = 1
+		version := types.NewDataSourceVersion(id, dataSource.Version, dataSource, ctx.BlockHeight(), ctx.BlockTime())
+		store.Set(types.DataSourceStoreKey(id), cdc.MustMarshal(&dataSource))
+		store.Set(types.DataSourceVersionStoreKey(id, version.Version), cdc.MustMarshal(&version))
+	}
+
+	oracleScripts := make(map[types.OracleScriptID]types.OracleScript)
+	var oracleScriptIDs []types.OracleScriptID
+	osIterator := storetypes.KVStorePrefixIterator(store, types.OracleScriptStoreKeyPrefix)
+	for ; osIterator.Valid(); osIterator.Next() {
+		id := types.OracleScriptID(sdk.BigEndianToUint64(osIterator.Key()[len(types.OracleScriptStoreKeyPrefix):]))
+		var oracleScript types.OracleScript
+		cdc.MustUnmarshal(osIterator.Value(), &oracleScript)
+		oracleScripts[id] = oracleScript
+		oracleScriptIDs = append(oracleScriptIDs, id)
+	}
+	osIterator.Close()
+
+	for _, id := range oracleScriptIDs {
+		oracleScript := oracleScripts[id]
+		if oracleScript.Version != 0 {
+			continue
+		}
+
+		oracleScript.Version = 1
+		version := types.NewOracleScriptVersion(
+			id,
+			oracleScript.Version,
+			oracleScript,
+			ctx.BlockHeight(),
+			ctx.BlockTime(),
+		)
+		store.Set(types.OracleScriptStoreKey(id), cdc.MustMarshal(&oracleScript))
+		store.Set(types.OracleScriptVersionStoreKey(id, version.Version), cdc.MustMarshal(&version))
+	}
+
+	return nil
+}
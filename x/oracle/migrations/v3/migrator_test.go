@@ -0,0 +1,62 @@
+package v3_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/bandprotocol/chain/v3/x/oracle"
+	v3 "github.com/bandprotocol/chain/v3/x/oracle/migrations/v3"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(oracle.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v3.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey).WithBlockHeight(100).WithBlockTime(time.Unix(1000, 0))
+	store := ctx.KVStore(storeKey)
+
+	owner := sdk.AccAddress([]byte("owner"))
+	dataSource := types.NewDataSource(owner, "name", "desc", "ds-file", sdk.NewCoins(sdk.NewInt64Coin("uband", 10)), owner)
+	oracleScript := types.NewOracleScript(owner, "name", "desc", "os-file", "schema", "url")
+	store.Set(types.DataSourceStoreKey(1), cdc.MustMarshal(&dataSource))
+	store.Set(types.OracleScriptStoreKey(1), cdc.MustMarshal(&oracleScript))
+
+	params := types.DefaultParams()
+	params.SubscriptionFee = nil
+	params.MaxSubscriptionGas = 0
+	params.MaxActiveSubscriptions = 0
+	store.Set(types.ParamsKeyPrefix, cdc.MustMarshal(&params))
+
+	require.NoError(t, v3.Migrate(ctx, store, cdc))
+
+	var gotParams types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKeyPrefix), &gotParams)
+	require.Equal(t, types.DefaultParams(), gotParams)
+
+	var gotDataSource types.DataSource
+	cdc.MustUnmarshal(store.Get(types.DataSourceStoreKey(1)), &gotDataSource)
+	require.Equal(t, uint64(1), gotDataSource.Version)
+
+	var gotDataSourceVersion types.DataSourceVersion
+	cdc.MustUnmarshal(store.Get(types.DataSourceVersionStoreKey(1, 1)), &gotDataSourceVersion)
+	require.Equal(t, types.NewDataSourceVersion(1, 1, dataSource, 100, time.Unix(1000, 0)), gotDataSourceVersion)
+
+	var gotOracleScript types.OracleScript
+	cdc.MustUnmarshal(store.Get(types.OracleScriptStoreKey(1)), &gotOracleScript)
+	require.Equal(t, uint64(1), gotOracleScript.Version)
+
+	var gotOracleScriptVersion types.OracleScriptVersion
+	cdc.MustUnmarshal(store.Get(types.OracleScriptVersionStoreKey(1, 1)), &gotOracleScriptVersion)
+	require.Equal(t, types.NewOracleScriptVersion(1, 1, oracleScript, 100, time.Unix(1000, 0)), gotOracleScriptVersion)
+}
@@ -27,7 +27,7 @@ import (
 )
 
 // ConsensusVersion defines the current x/oracle module consensus version.
-const ConsensusVersion = 2
+const ConsensusVersion = 3
 
 var (
 	_ module.AppModuleBasic      = AppModuleBasic{}
@@ -44,7 +44,8 @@ var (
 
 // Module init related flags
 const (
-	FlagWithOwasmCacheSize = "oracle-script-cache-size"
+	FlagWithOwasmCacheSize  = "oracle-script-cache-size"
+	FlagSimulateRequestCode = "oracle-simulate-request-code"
 )
 
 // AppModuleBasic is Band Oracle's module basic object.
@@ -136,6 +137,11 @@ func (am AppModule) IsAppModule() {}
 // AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
 func AddModuleInitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().Uint32(FlagWithOwasmCacheSize, 100, "Number of oracle scripts to cache")
+	startCmd.Flags().Bool(
+		FlagSimulateRequestCode,
+		false,
+		"Allow the simulate request query to compile and run Owasm code that is not deployed on-chain",
+	)
 }
 
 // RegisterServices registers module services.
@@ -148,6 +154,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
 	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the oracle module.
@@ -147,6 +147,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 			oracleRewardPercentage,
 			inactivePenaltyDuration,
 			ibcRequestEnabled,
+			types.DefaultPriceReferenceOracleScriptID,
+			types.DefaultSubscriptionFee,
+			types.DefaultMaxSubscriptionGas,
+			types.DefaultMaxActiveSubscriptions,
 		),
 		[]types.DataSource{},
 		[]types.OracleScript{},
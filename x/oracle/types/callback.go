@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRequestCallback creates a new RequestCallback instance.
+func NewRequestCallback(module string, payload []byte) *RequestCallback {
+	return &RequestCallback{
+		Module:  module,
+		Payload: payload,
+	}
+}
+
+// Validate validates the request callback.
+func (c RequestCallback) Validate() error {
+	if c.Module == "" {
+		return ErrInvalidCallback.Wrap("module must not be empty")
+	}
+	if !sdk.IsAlphaNumeric(c.Module) {
+		return ErrInvalidCallback.Wrapf("module must be alphanumeric: %s", c.Module)
+	}
+	if len(c.Module) > MaxCallbackModuleLength {
+		return WrapMaxError(ErrInvalidCallback, len(c.Module), MaxCallbackModuleLength)
+	}
+	if len(c.Payload) > MaxCallbackPayloadLength {
+		return WrapMaxError(ErrInvalidCallback, len(c.Payload), MaxCallbackPayloadLength)
+	}
+	return nil
+}
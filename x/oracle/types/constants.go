@@ -9,11 +9,17 @@ const (
 	MaxSchemaLength      = 512
 	MaxURLLength         = 128
 
+	MaxCallbackModuleLength  = 64
+	MaxCallbackPayloadLength = 512
+
 	MaxExecutableSize       = 8 * 1024        // 8kB
 	MaxWasmCodeSize         = 512 * 1024      // 512kB
 	MaxCompiledWasmCodeSize = 1 * 1024 * 1024 // 1MB
 
 	MaximumOwasmGas = 8000000
+
+	MinSubscriptionBlockInterval = 10 // blocks
+	MinSubscriptionTimeInterval  = 60 // seconds
 )
 
 var DoNotModifyBytes = []byte(DoNotModify)
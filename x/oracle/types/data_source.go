@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -16,3 +18,26 @@ func NewDataSource(
 		Fee:         fee,
 	}
 }
+
+// NewDataSourceVersion creates a version record of the given data source at the given version.
+func NewDataSourceVersion(
+	id DataSourceID, version uint64, dataSource DataSource, height int64, timestamp time.Time,
+) DataSourceVersion {
+	return DataSourceVersion{
+		DataSourceID: id,
+		Version:      version,
+		Filename:     dataSource.Filename,
+		Treasury:     dataSource.Treasury,
+		Fee:          dataSource.Fee,
+		Height:       height,
+		Timestamp:    timestamp.Unix(),
+	}
+}
+
+// IsChangedFrom returns whether the given data source differs from this version in any of
+// the fields that are recorded in the version history.
+func (v DataSourceVersion) IsChangedFrom(dataSource DataSource) bool {
+	return v.Filename != dataSource.Filename ||
+		v.Treasury != dataSource.Treasury ||
+		!v.Fee.Equal(dataSource.Fee)
+}
@@ -53,6 +53,16 @@ var (
 	ErrInvalidRequestID         = errorsmod.Register(ModuleName, 47, "invalid request id")
 	ErrInvalidOracleEncoder     = errorsmod.Register(ModuleName, 48, "invalid oracle encoder")
 	ErrCreateSigningPanic       = errorsmod.Register(ModuleName, 49, "panic in creating tss signing")
+	ErrPriceResultNotFound      = errorsmod.Register(ModuleName, 50, "price result not found")
+	ErrInvalidCallback          = errorsmod.Register(ModuleName, 51, "invalid request callback")
+	ErrCallbackRouteNotFound    = errorsmod.Register(ModuleName, 52, "request callback route not found")
+	ErrCallbackPanic            = errorsmod.Register(ModuleName, 53, "panic in request callback")
+	ErrSubscriptionNotFound     = errorsmod.Register(ModuleName, 54, "subscription not found")
+	ErrInvalidInterval          = errorsmod.Register(ModuleName, 55, "invalid subscription interval")
+	ErrAccountAlreadyExist      = errorsmod.Register(ModuleName, 56, "account already exist")
+	ErrVersionNotFound          = errorsmod.Register(ModuleName, 57, "version not found")
+	ErrSubscriptionLimit        = errorsmod.Register(ModuleName, 58, "too many active subscriptions")
+	ErrCodeSimulationDisabled   = errorsmod.Register(ModuleName, 59, "simulating uploaded code is disabled")
 )
 
 // WrapMaxError wraps an error message with additional info of the current and max values.
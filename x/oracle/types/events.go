@@ -16,6 +16,12 @@ const (
 	EventTypeSendPacketFail        = "send_packet_fail"
 	EventTypeUpdateParams          = "update_params"
 	EventTypeHandleRequestSignFail = "handle_request_sign_fail"
+	EventTypeRequestCallback       = "request_callback"
+	EventTypeCreateSubscription    = "create_subscription"
+	EventTypeUpdateSubscription    = "update_subscription"
+	EventTypeCancelSubscription    = "cancel_subscription"
+	EventTypeSubscriptionRequest   = "subscription_request"
+	EventTypePauseSubscription     = "pause_subscription"
 
 	AttributeKeyID                  = "id"
 	AttributeKeySigningID           = "signing_id"
@@ -38,4 +44,14 @@ const (
 	AttributeKeyParams              = "params"
 	AttributeKeySigningErrCodespace = "signing_error_codespace"
 	AttributeKeySigningErrCode      = "signing_error_code"
+	AttributeKeyCallbackModule      = "callback_module"
+	AttributeKeyCallbackSuccess     = "callback_success"
+	AttributeKeySubscriptionID      = "subscription_id"
+	AttributeKeyOwner               = "owner"
+	AttributeKeyEscrow              = "escrow"
+	AttributeKeyBlockInterval       = "block_interval"
+	AttributeKeyTimeInterval        = "time_interval"
+	AttributeKeyDeposit             = "deposit"
+	AttributeKeyRefund              = "refund"
+	AttributeKeyVersion             = "version"
 )
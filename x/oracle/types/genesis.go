@@ -2,8 +2,13 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState instanc e
@@ -18,9 +23,12 @@ func NewGenesisState(params Params, dataSources []DataSource, oracleScripts []Or
 // DefaultGenesisState returns the default oracle genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:        DefaultParams(),
-		DataSources:   []DataSource{},
-		OracleScripts: []OracleScript{},
+		Params:               DefaultParams(),
+		DataSources:          []DataSource{},
+		OracleScripts:        []OracleScript{},
+		Subscriptions:        []Subscription{},
+		DataSourceVersions:   []DataSourceVersion{},
+		OracleScriptVersions: []OracleScriptVersion{},
 	}
 }
 
@@ -37,4 +45,60 @@ func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.R
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
-func (g GenesisState) Validate() error { return nil }
+func (g GenesisState) Validate() error {
+	ids := make(map[SubscriptionID]bool)
+	for _, s := range g.Subscriptions {
+		if s.ID == 0 {
+			return errors.New("subscription id must not be zero")
+		}
+		if ids[s.ID] {
+			return fmt.Errorf("duplicate subscription id: %d", s.ID)
+		}
+		ids[s.ID] = true
+
+		if _, err := sdk.AccAddressFromBech32(s.Owner); err != nil {
+			return errorsmod.Wrapf(err, "invalid owner of subscription %d", s.ID)
+		}
+		if _, err := sdk.AccAddressFromBech32(s.Escrow); err != nil {
+			return errorsmod.Wrapf(err, "invalid escrow of subscription %d", s.ID)
+		}
+		if err := ValidateSubscriptionInterval(s.BlockInterval, s.TimeInterval); err != nil {
+			return errorsmod.Wrapf(err, "subscription %d", s.ID)
+		}
+	}
+
+	dsVersions := make(map[DataSourceID]map[uint64]bool)
+	for _, v := range g.DataSourceVersions {
+		if v.DataSourceID == 0 || int(v.DataSourceID) > len(g.DataSources) {
+			return fmt.Errorf("data source version refers to unknown data source id: %d", v.DataSourceID)
+		}
+		if v.Version == 0 || v.Version > g.DataSources[v.DataSourceID-1].Version {
+			return fmt.Errorf("invalid version %d of data source %d", v.Version, v.DataSourceID)
+		}
+		if dsVersions[v.DataSourceID] == nil {
+			dsVersions[v.DataSourceID] = make(map[uint64]bool)
+		}
+		if dsVersions[v.DataSourceID][v.Version] {
+			return fmt.Errorf("duplicate version %d of data source %d", v.Version, v.DataSourceID)
+		}
+		dsVersions[v.DataSourceID][v.Version] = true
+	}
+
+	osVersions := make(map[OracleScriptID]map[uint64]bool)
+	for _, v := range g.OracleScriptVersions {
+		if v.OracleScriptID == 0 || int(v.OracleScriptID) > len(g.OracleScripts) {
+			return fmt.Errorf("oracle script version refers to unknown oracle script id: %d", v.OracleScriptID)
+		}
+		if v.Version == 0 || v.Version > g.OracleScripts[v.OracleScriptID-1].Version {
+			return fmt.Errorf("invalid version %d of oracle script %d", v.Version, v.OracleScriptID)
+		}
+		if osVersions[v.OracleScriptID] == nil {
+			osVersions[v.OracleScriptID] = make(map[uint64]bool)
+		}
+		if osVersions[v.OracleScriptID][v.Version] {
+			return fmt.Errorf("duplicate version %d of oracle script %d", v.Version, v.OracleScriptID)
+		}
+		osVersions[v.OracleScriptID][v.Version] = true
+	}
+	return nil
+}
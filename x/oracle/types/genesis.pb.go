@@ -31,6 +31,13 @@ type GenesisState struct {
 	DataSources []DataSource `protobuf:"bytes,2,rep,name=data_sources,json=dataSources,proto3" json:"data_sources"`
 	// OracleScripts are list of oracle scripts to be installed during genesis phase.
 	OracleScripts []OracleScript `protobuf:"bytes,3,rep,name=oracle_scripts,json=oracleScripts,proto3" json:"oracle_scripts"`
+	// Subscriptions are recurring oracle requests to be installed during genesis
+	// phase.
+	Subscriptions []Subscription `protobuf:"bytes,4,rep,name=subscriptions,proto3" json:"subscriptions"`
+	// DataSourceVersions are the version histories of the data sources.
+	DataSourceVersions []DataSourceVersion `protobuf:"bytes,5,rep,name=data_source_versions,json=dataSourceVersions,proto3" json:"data_source_versions"`
+	// OracleScriptVersions are the version histories of the oracle scripts.
+	OracleScriptVersions []OracleScriptVersion `protobuf:"bytes,6,rep,name=oracle_script_versions,json=oracleScriptVersions,proto3" json:"oracle_script_versions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +94,27 @@ func (m *GenesisState) GetOracleScripts() []OracleScript {
 	return nil
 }
 
+func (m *GenesisState) GetSubscriptions() []Subscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDataSourceVersions() []DataSourceVersion {
+	if m != nil {
+		return m.DataSourceVersions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOracleScriptVersions() []OracleScriptVersion {
+	if m != nil {
+		return m.OracleScriptVersions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "band.oracle.v1.GenesisState")
 }
@@ -94,25 +122,29 @@ func init() {
 func init() { proto.RegisterFile("band/oracle/v1/genesis.proto", fileDescriptor_b23429f682cd4ce7) }
 
 var fileDescriptor_b23429f682cd4ce7 = []byte{
-	// 277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x31, 0x4b, 0xc3, 0x40,
-	0x14, 0x80, 0x73, 0x56, 0x3a, 0xa4, 0xb5, 0x43, 0x10, 0x09, 0xb1, 0x9c, 0xc5, 0xa9, 0xd3, 0x1d,
-	0x6d, 0xfd, 0x05, 0x55, 0x90, 0x4e, 0x8a, 0xd9, 0x5c, 0xca, 0xcb, 0xe5, 0x48, 0x03, 0x6d, 0x2e,
-	0xdc, 0xbb, 0x06, 0xfd, 0x17, 0xfe, 0xac, 0x8e, 0x1d, 0x1c, 0x9c, 0x44, 0x92, 0x3f, 0x22, 0xb9,
-	0x44, 0xd1, 0x6c, 0xc7, 0xfb, 0xbe, 0xf7, 0x1d, 0x3c, 0x77, 0x1c, 0x41, 0x16, 0x73, 0xa5, 0x41,
-	0x6c, 0x25, 0x2f, 0x66, 0x3c, 0x91, 0x99, 0xc4, 0x14, 0x59, 0xae, 0x95, 0x51, 0xde, 0xa8, 0xa6,
-	0xac, 0xa1, 0xac, 0x98, 0x05, 0xe7, 0x89, 0x4a, 0x94, 0x45, 0xbc, 0x7e, 0x35, 0x56, 0x70, 0xd9,
-	0x69, 0xb4, 0xbe, 0x85, 0xd7, 0xef, 0xc4, 0x1d, 0xde, 0x37, 0xd1, 0xd0, 0x80, 0x91, 0xde, 0x8d,
-	0xdb, 0xcf, 0x41, 0xc3, 0x0e, 0x7d, 0x32, 0x21, 0xd3, 0xc1, 0xfc, 0x82, 0xfd, 0xff, 0x84, 0x3d,
-	0x5a, 0xba, 0x3c, 0x3d, 0x7c, 0x5e, 0x39, 0x4f, 0xad, 0xeb, 0xdd, 0xba, 0xc3, 0x18, 0x0c, 0xac,
-	0x51, 0xed, 0xb5, 0x90, 0xe8, 0x9f, 0x4c, 0x7a, 0xd3, 0xc1, 0x3c, 0xe8, 0xee, 0xde, 0x81, 0x81,
-	0xd0, 0x2a, 0xed, 0xfe, 0x20, 0xfe, 0x9d, 0xa0, 0xb7, 0x72, 0x47, 0x8d, 0xba, 0x46, 0xa1, 0xd3,
-	0xdc, 0xa0, 0xdf, 0xb3, 0x99, 0x71, 0x37, 0xf3, 0x60, 0x5f, 0xa1, 0x95, 0xda, 0xd0, 0x99, 0xfa,
-	0x33, 0xc3, 0xe5, 0xea, 0x50, 0x52, 0x72, 0x2c, 0x29, 0xf9, 0x2a, 0x29, 0x79, 0xab, 0xa8, 0x73,
-	0xac, 0xa8, 0xf3, 0x51, 0x51, 0xe7, 0x99, 0x27, 0xa9, 0xd9, 0xec, 0x23, 0x26, 0xd4, 0x8e, 0xd7,
-	0x59, 0x7b, 0x06, 0xa1, 0xb6, 0x5c, 0x6c, 0x20, 0xcd, 0x78, 0xb1, 0xe0, 0x2f, 0x3f, 0xb7, 0x32,
-	0xaf, 0xb9, 0xc4, 0xa8, 0x6f, 0x8d, 0xc5, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x92, 0xdd,
-	0xef, 0x8b, 0x01, 0x00, 0x00,
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcb, 0x4a, 0xc3, 0x40,
+	0x14, 0x86, 0x13, 0x5b, 0xbb, 0x98, 0x5e, 0x16, 0x43, 0x29, 0xa1, 0x96, 0x58, 0x75, 0xd3, 0x55,
+	0x86, 0xb6, 0x3e, 0x41, 0x15, 0xb4, 0x2b, 0xc5, 0x80, 0xa0, 0x9b, 0x30, 0x49, 0x86, 0x34, 0xd0,
+	0x66, 0x42, 0xce, 0x34, 0xe8, 0x5b, 0xf8, 0x58, 0x5d, 0x76, 0x29, 0x2e, 0x44, 0xda, 0x17, 0x91,
+	0xce, 0xa4, 0x9a, 0xc4, 0xcb, 0x6e, 0x98, 0xff, 0x3b, 0xdf, 0xe1, 0x87, 0x83, 0x7a, 0x2e, 0x8d,
+	0x7c, 0xc2, 0x13, 0xea, 0xcd, 0x19, 0x49, 0x87, 0x24, 0x60, 0x11, 0x83, 0x10, 0xac, 0x38, 0xe1,
+	0x82, 0xe3, 0xd6, 0x2e, 0xb5, 0x54, 0x6a, 0xa5, 0xc3, 0x6e, 0x3b, 0xe0, 0x01, 0x97, 0x11, 0xd9,
+	0xbd, 0x14, 0xd5, 0x3d, 0x2a, 0x39, 0x32, 0x5e, 0x86, 0xa7, 0x6f, 0x15, 0xd4, 0xb8, 0x52, 0x52,
+	0x5b, 0x50, 0xc1, 0xf0, 0x39, 0xaa, 0xc5, 0x34, 0xa1, 0x0b, 0x30, 0xf4, 0xbe, 0x3e, 0xa8, 0x8f,
+	0x3a, 0x56, 0x71, 0x89, 0x75, 0x2b, 0xd3, 0x49, 0x75, 0xf5, 0x7e, 0xac, 0xdd, 0x65, 0x2c, 0xbe,
+	0x40, 0x0d, 0x9f, 0x0a, 0xea, 0x00, 0x5f, 0x26, 0x1e, 0x03, 0xe3, 0xa0, 0x5f, 0x19, 0xd4, 0x47,
+	0xdd, 0xf2, 0xec, 0x25, 0x15, 0xd4, 0x96, 0x48, 0x36, 0x5f, 0xf7, 0xbf, 0x7e, 0x00, 0x4f, 0x51,
+	0x4b, 0xa1, 0x0e, 0x78, 0x49, 0x18, 0x0b, 0x30, 0x2a, 0x52, 0xd3, 0x2b, 0x6b, 0x6e, 0xe4, 0xcb,
+	0x96, 0x50, 0x26, 0x6a, 0xf2, 0xdc, 0x1f, 0xe0, 0x6b, 0xd4, 0x84, 0xa5, 0xab, 0x34, 0x21, 0x8f,
+	0xc0, 0xa8, 0xfe, 0x6e, 0xb2, 0x73, 0xd0, 0xde, 0x54, 0x18, 0xc4, 0x0f, 0xa8, 0x9d, 0x6b, 0xe6,
+	0xa4, 0x2c, 0x01, 0x29, 0x3c, 0x94, 0xc2, 0x93, 0xbf, 0x1b, 0xde, 0x2b, 0x32, 0xb3, 0x62, 0xbf,
+	0x1c, 0x00, 0x76, 0x50, 0xa7, 0xd0, 0xf7, 0x5b, 0x5e, 0x93, 0xf2, 0xb3, 0xff, 0x7a, 0x17, 0xf5,
+	0x6d, 0xfe, 0x33, 0x82, 0xc9, 0x74, 0xb5, 0x31, 0xf5, 0xf5, 0xc6, 0xd4, 0x3f, 0x36, 0xa6, 0xfe,
+	0xb2, 0x35, 0xb5, 0xf5, 0xd6, 0xd4, 0x5e, 0xb7, 0xa6, 0xf6, 0x48, 0x82, 0x50, 0xcc, 0x96, 0xae,
+	0xe5, 0xf1, 0x05, 0xd9, 0x2d, 0x91, 0xc7, 0xe0, 0xf1, 0x39, 0xf1, 0x66, 0x34, 0x8c, 0x48, 0x3a,
+	0x26, 0x4f, 0xfb, 0x8b, 0x11, 0xcf, 0x31, 0x03, 0xb7, 0x26, 0x89, 0xf1, 0xe7, 0x00, 0xb9, 0xf5,
+	0x05, 0xae, 0x91, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +167,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OracleScriptVersions) > 0 {
+		for iNdEx := len(m.OracleScriptVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleScriptVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DataSourceVersions) > 0 {
+		for iNdEx := len(m.DataSourceVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataSourceVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.OracleScripts) > 0 {
 		for iNdEx := len(m.OracleScripts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -207,6 +281,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DataSourceVersions) > 0 {
+		for _, e := range m.DataSourceVersions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OracleScriptVersions) > 0 {
+		for _, e := range m.OracleScriptVersions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -346,6 +438,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, Subscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataSourceVersions = append(m.DataSourceVersions, DataSourceVersion{})
+			if err := m.DataSourceVersions[len(m.DataSourceVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleScriptVersions = append(m.OracleScriptVersions, OracleScriptVersion{})
+			if err := m.OracleScriptVersions[len(m.OracleScriptVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OracleHooks defines the expected interface for a module that wants to consume
+// the results of oracle requests naming it as the callback route.
+type OracleHooks interface {
+	// Must be called after a request carrying a callback to the module is resolved,
+	// whether successfully, with a failure or by expiration. The requester is the account
+	// that sent the request and the payload is the one attached to its callback, so that
+	// the module can decide whether to trust the request and what to do with the result.
+	AfterRequestResolved(
+		ctx sdk.Context,
+		id RequestID,
+		requester sdk.AccAddress,
+		payload []byte,
+		result Result,
+	) error
+}
+
+// OracleHooksRouter is a struct that holds a map of OracleHooks objects for each module.
+type OracleHooksRouter struct {
+	routes map[string]OracleHooks
+	sealed bool
+}
+
+// NewOracleHooksRouter creates a new OracleHooksRouter instance.
+func NewOracleHooksRouter() *OracleHooksRouter {
+	return &OracleHooksRouter{
+		routes: make(map[string]OracleHooks),
+	}
+}
+
+// Seal seals the OracleHooksRouter which prohibits any subsequent OracleHooks to be added.
+// Seal will panic if called more than once.
+func (r *OracleHooksRouter) Seal() {
+	if r.sealed {
+		panic(errors.New("oracle hooks router is already sealed"))
+	}
+	r.sealed = true
+}
+
+// Sealed returns whether the OracleHooksRouter can be changed or not.
+func (r OracleHooksRouter) Sealed() bool {
+	return r.sealed
+}
+
+// AddRoute adds OracleHooks for a given module name. It returns the OracleHooksRouter
+// so that the function can be chained. It will panic if the OracleHooksRouter is sealed.
+func (r *OracleHooksRouter) AddRoute(module string, hooks OracleHooks) *OracleHooksRouter {
+	if r.sealed {
+		panic(fmt.Errorf("oracle hooks router sealed; cannot register %s route hooks", module))
+	}
+	if !sdk.IsAlphaNumeric(module) {
+		panic(errors.New("oracle hooks route expressions can only contain alphanumeric characters"))
+	}
+	if r.HasRoute(module) {
+		panic(fmt.Errorf("route %s has already been registered", module))
+	}
+
+	r.routes[module] = hooks
+	return r
+}
+
+// HasRoute returns whether the given module is registered.
+func (r *OracleHooksRouter) HasRoute(module string) bool {
+	_, ok := r.routes[module]
+	return ok
+}
+
+// GetRoute returns OracleHooks for a given module.
+func (r *OracleHooksRouter) GetRoute(module string) (OracleHooks, bool) {
+	if !r.HasRoute(module) {
+		return nil, false
+	}
+	return r.routes[module], true
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -22,6 +24,9 @@ const (
 
 	// PortID is the default port id that oracle module binds to.
 	PortID = ModuleName
+
+	// SubscriptionAccountsKey is used to derive the escrow accounts of subscriptions.
+	SubscriptionAccountsKey = "subscription-accounts"
 )
 
 var (
@@ -39,6 +44,8 @@ var (
 	DataSourceCountStoreKey = append(GlobalStoreKeyPrefix, []byte("DataSourceCount")...)
 	// OracleScriptCountStoreKey is the key that keeps the total oracle script count.
 	OracleScriptCountStoreKey = append(GlobalStoreKeyPrefix, []byte("OracleScriptCount")...)
+	// SubscriptionCountStoreKey is the key that keeps the total subscription count.
+	SubscriptionCountStoreKey = append(GlobalStoreKeyPrefix, []byte("SubscriptionCount")...)
 
 	// RequestStoreKeyPrefix is the prefix for request store.
 	RequestStoreKeyPrefix = []byte{0x01}
@@ -54,6 +61,20 @@ var (
 	ParamsKeyPrefix = []byte{0x06}
 	// SigningResultStoreKeyPrefix is the prefix for signing ID store.
 	SigningResultStoreKeyPrefix = []byte{0x07}
+	// RequestSearchIndexKeyPrefix is the prefix for the index of the latest successful request by search criteria.
+	RequestSearchIndexKeyPrefix = []byte{0x08}
+	// PriceResultStoreKeyPrefix is the prefix for price result store.
+	PriceResultStoreKeyPrefix = []byte{0x09}
+	// SubscriptionStoreKeyPrefix is the prefix for subscription store.
+	SubscriptionStoreKeyPrefix = []byte{0x0a}
+	// ActiveSubscriptionIDStoreKeyPrefix is the prefix for the set of active subscription IDs.
+	ActiveSubscriptionIDStoreKeyPrefix = []byte{0x0b}
+	// DataSourceVersionStoreKeyPrefix is the prefix for data source version store.
+	DataSourceVersionStoreKeyPrefix = []byte{0x0c}
+	// OracleScriptVersionStoreKeyPrefix is the prefix for oracle script version store.
+	OracleScriptVersionStoreKeyPrefix = []byte{0x0d}
+	// RequestVersionsStoreKeyPrefix is the prefix for the versions of the oracle script and data sources used by requests.
+	RequestVersionsStoreKeyPrefix = []byte{0x0e}
 	// ResultStoreKeyPrefix is the prefix for request result store.
 	ResultStoreKeyPrefix = []byte{0xff}
 
@@ -96,6 +117,60 @@ func ResultStoreKey(requestID RequestID) []byte {
 	return append(ResultStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(requestID))...)
 }
 
+// RequestVersionsStoreKey returns the key to the versions of the oracle script and data sources used by a request.
+func RequestVersionsStoreKey(requestID RequestID) []byte {
+	return append(RequestVersionsStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(requestID))...)
+}
+
+// SubscriptionStoreKey returns the key to retrieve a specific subscription from the store.
+func SubscriptionStoreKey(subscriptionID SubscriptionID) []byte {
+	return append(SubscriptionStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(subscriptionID))...)
+}
+
+// ActiveSubscriptionIDStoreKey returns the key to retrieve a specific active subscription ID from the store.
+func ActiveSubscriptionIDStoreKey(subscriptionID SubscriptionID) []byte {
+	return append(ActiveSubscriptionIDStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(subscriptionID))...)
+}
+
+// DataSourceVersionsStoreKey returns the key prefix of all versions of a specific data source.
+func DataSourceVersionsStoreKey(dataSourceID DataSourceID) []byte {
+	return append(DataSourceVersionStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(dataSourceID))...)
+}
+
+// DataSourceVersionStoreKey returns the key to retrieve a specific version of a data source from the store.
+func DataSourceVersionStoreKey(dataSourceID DataSourceID, version uint64) []byte {
+	return append(DataSourceVersionsStoreKey(dataSourceID), sdk.Uint64ToBigEndian(version)...)
+}
+
+// OracleScriptVersionsStoreKey returns the key prefix of all versions of a specific oracle script.
+func OracleScriptVersionsStoreKey(oracleScriptID OracleScriptID) []byte {
+	return append(OracleScriptVersionStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(oracleScriptID))...)
+}
+
+// OracleScriptVersionStoreKey returns the key to retrieve a specific version of an oracle script from the store.
+func OracleScriptVersionStoreKey(oracleScriptID OracleScriptID, version uint64) []byte {
+	return append(OracleScriptVersionsStoreKey(oracleScriptID), sdk.Uint64ToBigEndian(version)...)
+}
+
+// RequestSearchIndexKey returns the key to the ID of the latest successful request of an oracle script
+// with the given calldata, ask count and min count.
+func RequestSearchIndexKey(oid OracleScriptID, calldata []byte, askCount uint64, minCount uint64) []byte {
+	calldataHash := sha256.Sum256(calldata)
+	buf := append(RequestSearchIndexKeyPrefix, sdk.Uint64ToBigEndian(uint64(oid))...)
+	buf = append(buf, calldataHash[:]...)
+	buf = append(buf, sdk.Uint64ToBigEndian(askCount)...)
+	buf = append(buf, sdk.Uint64ToBigEndian(minCount)...)
+	return buf
+}
+
+// PriceResultStoreKey returns the key to the latest price result of a symbol with the given ask count and min count.
+func PriceResultStoreKey(symbol string, askCount uint64, minCount uint64) []byte {
+	buf := append(PriceResultStoreKeyPrefix, sdk.Uint64ToBigEndian(askCount)...)
+	buf = append(buf, sdk.Uint64ToBigEndian(minCount)...)
+	buf = append(buf, []byte(symbol)...)
+	return buf
+}
+
 // ReportsOfValidatorPrefixKey returns the prefix key to get all reports for a request from a validator.
 func ReportsOfValidatorPrefixKey(reqID RequestID, val sdk.ValAddress) []byte {
 	buf := append(ReportStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(reqID))...)
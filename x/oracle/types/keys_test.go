@@ -40,6 +40,31 @@ func TestSigningResultStoreKey(t *testing.T) {
 	require.Equal(t, expect, SigningResultStoreKey(RequestID(20)))
 }
 
+func TestSubscriptionStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("0a0000000000000014")
+	require.Equal(t, expect, SubscriptionStoreKey(20))
+}
+
+func TestActiveSubscriptionIDStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("0b0000000000000014")
+	require.Equal(t, expect, ActiveSubscriptionIDStoreKey(20))
+}
+
+func TestDataSourceVersionStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("0c00000000000000140000000000000002")
+	require.Equal(t, expect, DataSourceVersionStoreKey(20, 2))
+}
+
+func TestOracleScriptVersionStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("0d00000000000000140000000000000002")
+	require.Equal(t, expect, OracleScriptVersionStoreKey(20, 2))
+}
+
+func TestRequestVersionsStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("0e0000000000000014")
+	require.Equal(t, expect, RequestVersionsStoreKey(20))
+}
+
 func TestResultStoreKey(t *testing.T) {
 	expect, _ := hex.DecodeString("ff0000000000000014")
 	require.Equal(t, expect, ResultStoreKey(20))
@@ -50,3 +75,18 @@ func TestReportsOfValidatorPrefixKey(t *testing.T) {
 	expect, _ := hex.DecodeString("020000000000000014b80f2a5df7d5710b15622d1a9f1e3830ded5bda8")
 	require.Equal(t, expect, ReportsOfValidatorPrefixKey(20, val))
 }
+
+func TestRequestSearchIndexKey(t *testing.T) {
+	// sha256("calldata")
+	expect, _ := hex.DecodeString(
+		"08000000000000007b" +
+			"6ba9f2ab1a28c0f5c704466d6b3e166997041ff61ff7db30d9fabf945334afb8" +
+			"00000000000000100000000000000008",
+	)
+	require.Equal(t, expect, RequestSearchIndexKey(123, []byte("calldata"), 16, 8))
+}
+
+func TestPriceResultStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("0900000000000000100000000000000008425443")
+	require.Equal(t, expect, PriceResultStoreKey("BTC", 16, 8))
+}
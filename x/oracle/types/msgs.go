@@ -18,6 +18,9 @@ var (
 	_ sdk.Msg = (*MsgEditOracleScript)(nil)
 	_ sdk.Msg = (*MsgActivate)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgCreateSubscription)(nil)
+	_ sdk.Msg = (*MsgUpdateSubscription)(nil)
+	_ sdk.Msg = (*MsgCancelSubscription)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgRequestData)(nil)
 	_ sdk.HasValidateBasic = (*MsgReportData)(nil)
@@ -27,6 +30,9 @@ var (
 	_ sdk.HasValidateBasic = (*MsgEditOracleScript)(nil)
 	_ sdk.HasValidateBasic = (*MsgActivate)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgCreateSubscription)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateSubscription)(nil)
+	_ sdk.HasValidateBasic = (*MsgCancelSubscription)(nil)
 )
 
 // NewMsgRequestData creates a new MsgRequestData instance.
@@ -93,6 +99,12 @@ func (m MsgRequestData) ValidateBasic() error {
 		return ErrInvalidOracleEncoder.Wrapf("invalid encoder type: %d", m.TSSEncoder)
 	}
 
+	if m.Callback != nil {
+		if err := m.Callback.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -398,3 +410,139 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgCreateSubscription creates a new MsgCreateSubscription instance
+func NewMsgCreateSubscription(
+	oracleScriptID OracleScriptID,
+	calldata []byte,
+	askCount, minCount uint64,
+	clientID string,
+	feeLimit sdk.Coins,
+	prepareGas, executeGas uint64,
+	tssEncoder Encoder,
+	blockInterval, timeInterval uint64,
+	initialDeposit sdk.Coins,
+	owner sdk.AccAddress,
+) *MsgCreateSubscription {
+	return &MsgCreateSubscription{
+		OracleScriptID: oracleScriptID,
+		Calldata:       calldata,
+		AskCount:       askCount,
+		MinCount:       minCount,
+		ClientID:       clientID,
+		FeeLimit:       feeLimit,
+		PrepareGas:     prepareGas,
+		ExecuteGas:     executeGas,
+		TSSEncoder:     tssEncoder,
+		BlockInterval:  blockInterval,
+		TimeInterval:   timeInterval,
+		InitialDeposit: initialDeposit,
+		Owner:          owner.String(),
+	}
+}
+
+// ValidateBasic checks whether the given MsgCreateSubscription instance (sdk.Msg interface).
+func (m MsgCreateSubscription) ValidateBasic() error {
+	// the request made on each schedule must pass the same checks as MsgRequestData.
+	req := MsgRequestData{
+		OracleScriptID: m.OracleScriptID,
+		Calldata:       m.Calldata,
+		AskCount:       m.AskCount,
+		MinCount:       m.MinCount,
+		ClientID:       m.ClientID,
+		FeeLimit:       m.FeeLimit,
+		PrepareGas:     m.PrepareGas,
+		ExecuteGas:     m.ExecuteGas,
+		Sender:         m.Owner,
+		TSSEncoder:     m.TSSEncoder,
+	}
+	if err := req.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := ValidateSubscriptionInterval(m.BlockInterval, m.TimeInterval); err != nil {
+		return err
+	}
+	if !m.InitialDeposit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrap(m.InitialDeposit.String())
+	}
+	return nil
+}
+
+// NewMsgUpdateSubscription creates a new MsgUpdateSubscription instance
+func NewMsgUpdateSubscription(
+	subscriptionID SubscriptionID,
+	oracleScriptID OracleScriptID,
+	calldata []byte,
+	askCount, minCount uint64,
+	clientID string,
+	feeLimit sdk.Coins,
+	prepareGas, executeGas uint64,
+	tssEncoder Encoder,
+	blockInterval, timeInterval uint64,
+	deposit sdk.Coins,
+	owner sdk.AccAddress,
+) *MsgUpdateSubscription {
+	return &MsgUpdateSubscription{
+		SubscriptionID: subscriptionID,
+		OracleScriptID: oracleScriptID,
+		Calldata:       calldata,
+		AskCount:       askCount,
+		MinCount:       minCount,
+		ClientID:       clientID,
+		FeeLimit:       feeLimit,
+		PrepareGas:     prepareGas,
+		ExecuteGas:     executeGas,
+		TSSEncoder:     tssEncoder,
+		BlockInterval:  blockInterval,
+		TimeInterval:   timeInterval,
+		Deposit:        deposit,
+		Owner:          owner.String(),
+	}
+}
+
+// ValidateBasic checks whether the given MsgUpdateSubscription instance (sdk.Msg interface).
+func (m MsgUpdateSubscription) ValidateBasic() error {
+	// the request made on each schedule must pass the same checks as MsgRequestData.
+	req := MsgRequestData{
+		OracleScriptID: m.OracleScriptID,
+		Calldata:       m.Calldata,
+		AskCount:       m.AskCount,
+		MinCount:       m.MinCount,
+		ClientID:       m.ClientID,
+		FeeLimit:       m.FeeLimit,
+		PrepareGas:     m.PrepareGas,
+		ExecuteGas:     m.ExecuteGas,
+		Sender:         m.Owner,
+		TSSEncoder:     m.TSSEncoder,
+	}
+	if err := req.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := ValidateSubscriptionInterval(m.BlockInterval, m.TimeInterval); err != nil {
+		return err
+	}
+	if !m.Deposit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrap(m.Deposit.String())
+	}
+	return nil
+}
+
+// NewMsgCancelSubscription creates a new MsgCancelSubscription instance
+func NewMsgCancelSubscription(subscriptionID SubscriptionID, owner sdk.AccAddress) *MsgCancelSubscription {
+	return &MsgCancelSubscription{
+		SubscriptionID: subscriptionID,
+		Owner:          owner.String(),
+	}
+}
+
+// ValidateBasic checks whether the given MsgCancelSubscription instance (sdk.Msg interface).
+func (m MsgCancelSubscription) ValidateBasic() error {
+	owner, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("owner: %s", m.Owner)
+	}
+	return nil
+}
@@ -374,6 +374,79 @@ func TestMsgRequestDataValidation(t *testing.T) {
 	})
 }
 
+func TestMsgRequestDataCallbackValidation(t *testing.T) {
+	withCallback := func(callback *RequestCallback) *MsgRequestData {
+		msg := NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, GoodTestAddr, 0)
+		msg.Callback = callback
+		return msg
+	}
+
+	performValidateTests(t, []validateTestCase{
+		{true, withCallback(nil)},
+		{true, withCallback(NewRequestCallback("settlement", []byte("payload")))},
+		{true, withCallback(NewRequestCallback("settlement", make([]byte, MaxCallbackPayloadLength)))},
+		{false, withCallback(NewRequestCallback("", []byte("payload")))},
+		{false, withCallback(NewRequestCallback("settlement/v1", []byte("payload")))},
+		{false, withCallback(NewRequestCallback(strings.Repeat("x", MaxCallbackModuleLength+1), nil))},
+		{false, withCallback(NewRequestCallback("settlement", make([]byte, MaxCallbackPayloadLength+1)))},
+	})
+}
+
+func TestMsgCreateSubscriptionValidation(t *testing.T) {
+	newMsg := func(
+		askCount, minCount, blockInterval, timeInterval uint64,
+		deposit sdk.Coins,
+		owner sdk.AccAddress,
+	) *MsgCreateSubscription {
+		return NewMsgCreateSubscription(
+			1, []byte("calldata"), askCount, minCount, "client-id", GoodCoins, 1, 1, 0,
+			blockInterval, timeInterval, deposit, owner,
+		)
+	}
+
+	performValidateTests(t, []validateTestCase{
+		{true, newMsg(10, 5, 10, 0, GoodCoins, GoodTestAddr)},
+		{true, newMsg(10, 5, 0, 60, GoodCoins, GoodTestAddr)},
+		{false, newMsg(2, 5, 10, 0, GoodCoins, GoodTestAddr)},
+		{false, newMsg(10, 5, 0, 0, GoodCoins, GoodTestAddr)},
+		{false, newMsg(10, 5, 10, 60, GoodCoins, GoodTestAddr)},
+		{false, newMsg(10, 5, 9, 0, GoodCoins, GoodTestAddr)},
+		{false, newMsg(10, 5, 0, 59, GoodCoins, GoodTestAddr)},
+		{false, newMsg(10, 5, 10, 0, BadCoins, GoodTestAddr)},
+		{false, newMsg(10, 5, 10, 0, GoodCoins, EmptyAddr)},
+	})
+}
+
+func TestMsgUpdateSubscriptionValidation(t *testing.T) {
+	newMsg := func(
+		askCount, minCount, blockInterval, timeInterval uint64,
+		deposit sdk.Coins,
+		owner sdk.AccAddress,
+	) *MsgUpdateSubscription {
+		return NewMsgUpdateSubscription(
+			1, 1, []byte("calldata"), askCount, minCount, "client-id", GoodCoins, 1, 1, 0,
+			blockInterval, timeInterval, deposit, owner,
+		)
+	}
+
+	performValidateTests(t, []validateTestCase{
+		{true, newMsg(10, 5, 10, 0, GoodCoins, GoodTestAddr)},
+		{true, newMsg(10, 5, 0, 60, GoodCoins, GoodTestAddr)},
+		{false, newMsg(2, 5, 10, 0, GoodCoins, GoodTestAddr)},
+		{false, newMsg(10, 5, 0, 0, GoodCoins, GoodTestAddr)},
+		{false, newMsg(10, 5, 10, 60, GoodCoins, GoodTestAddr)},
+		{false, newMsg(10, 5, 10, 0, BadCoins, GoodTestAddr)},
+		{false, newMsg(10, 5, 10, 0, GoodCoins, EmptyAddr)},
+	})
+}
+
+func TestMsgCancelSubscriptionValidation(t *testing.T) {
+	performValidateTests(t, []validateTestCase{
+		{true, NewMsgCancelSubscription(1, GoodTestAddr)},
+		{false, NewMsgCancelSubscription(1, EmptyAddr)},
+	})
+}
+
 func TestMsgReportDataValidation(t *testing.T) {
 	performValidateTests(t, []validateTestCase{
 		{true, NewMsgReportData(1, []RawReport{{1, 1, []byte("data1")}, {2, 2, []byte("data2")}}, GoodTestValAddr)},
@@ -7,6 +7,7 @@ import (
 	bytes "bytes"
 	fmt "fmt"
 	github_com_bandprotocol_chain_v3_x_bandtss_types "github.com/bandprotocol/chain/v3/x/bandtss/types"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -120,6 +121,9 @@ type DataSource struct {
 	// Fee is the data source fee per ask_count that data provider will receive
 	// from requester.
 	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// Version is the latest version of the data source. It is increased every
+	// time the executable, treasury or fee of the data source is changed.
+	Version uint64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (m *DataSource) Reset()         { *m = DataSource{} }
@@ -197,6 +201,13 @@ func (m *DataSource) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *DataSource) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 // OracleScript is the data structure for storing oracle scripts in the storage.
 type OracleScript struct {
 	// Owner is an address of the account who own the oracle script
@@ -216,6 +227,9 @@ type OracleScript struct {
 	// It is recommendded to store source code on IPFS and get its URL to preserve
 	// decentralization.
 	SourceCodeURL string `protobuf:"bytes,6,opt,name=source_code_url,json=sourceCodeUrl,proto3" json:"source_code_url,omitempty"`
+	// Version is the latest version of the oracle script. It is increased every
+	// time the code or schema of the oracle script is changed.
+	Version uint64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (m *OracleScript) Reset()         { *m = OracleScript{} }
@@ -293,6 +307,206 @@ func (m *OracleScript) GetSourceCodeURL() string {
 	return ""
 }
 
+func (m *OracleScript) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// DataSourceVersion is an immutable record of the parts of a data source that
+// affect the data it produces at a specific version.
+type DataSourceVersion struct {
+	// DataSourceID is the ID of the data source
+	DataSourceID DataSourceID `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3,casttype=DataSourceID" json:"data_source_id,omitempty"`
+	// Version is the version number of the data source, starting from 1
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Filename is the file name of the data source executable at this version
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Treasury is the account address who receive data source fee at this version
+	Treasury string `protobuf:"bytes,4,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	// Fee is the data source fee per ask_count at this version
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// Height is the block height at which this version was created
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// Timestamp is the block time at which this version was created
+	Timestamp int64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *DataSourceVersion) Reset()         { *m = DataSourceVersion{} }
+func (m *DataSourceVersion) String() string { return proto.CompactTextString(m) }
+func (*DataSourceVersion) ProtoMessage()    {}
+func (*DataSourceVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{2}
+}
+func (m *DataSourceVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataSourceVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataSourceVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataSourceVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSourceVersion.Merge(m, src)
+}
+func (m *DataSourceVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataSourceVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSourceVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSourceVersion proto.InternalMessageInfo
+
+func (m *DataSourceVersion) GetDataSourceID() DataSourceID {
+	if m != nil {
+		return m.DataSourceID
+	}
+	return 0
+}
+
+func (m *DataSourceVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *DataSourceVersion) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+func (m *DataSourceVersion) GetTreasury() string {
+	if m != nil {
+		return m.Treasury
+	}
+	return ""
+}
+
+func (m *DataSourceVersion) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *DataSourceVersion) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DataSourceVersion) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// OracleScriptVersion is an immutable record of the parts of an oracle script
+// that affect the result it produces at a specific version.
+type OracleScriptVersion struct {
+	// OracleScriptID is the ID of the oracle script
+	OracleScriptID OracleScriptID `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3,casttype=OracleScriptID" json:"oracle_script_id,omitempty"`
+	// Version is the version number of the oracle script, starting from 1
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Filename is the file name of the compiled oracle script at this version
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Schema is the OBI schema of the oracle script at this version
+	Schema string `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	// Height is the block height at which this version was created
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// Timestamp is the block time at which this version was created
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *OracleScriptVersion) Reset()         { *m = OracleScriptVersion{} }
+func (m *OracleScriptVersion) String() string { return proto.CompactTextString(m) }
+func (*OracleScriptVersion) ProtoMessage()    {}
+func (*OracleScriptVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{3}
+}
+func (m *OracleScriptVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleScriptVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleScriptVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleScriptVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleScriptVersion.Merge(m, src)
+}
+func (m *OracleScriptVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleScriptVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleScriptVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleScriptVersion proto.InternalMessageInfo
+
+func (m *OracleScriptVersion) GetOracleScriptID() OracleScriptID {
+	if m != nil {
+		return m.OracleScriptID
+	}
+	return 0
+}
+
+func (m *OracleScriptVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *OracleScriptVersion) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+func (m *OracleScriptVersion) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
+func (m *OracleScriptVersion) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *OracleScriptVersion) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
 // RawRequest is the data structure for storing raw requests in the storage.
 type RawRequest struct {
 	// ExternalID is an ID of the raw request
@@ -302,13 +516,17 @@ type RawRequest struct {
 	// Calldata is the data used as argument params for executing data source
 	// script
 	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// DataSourceVersion is the version of the data source used by the raw
+	// request. Reporters execute the executable of this version rather than the
+	// current one of the data source.
+	DataSourceVersion uint64 `protobuf:"varint,4,opt,name=data_source_version,json=dataSourceVersion,proto3" json:"data_source_version,omitempty"`
 }
 
 func (m *RawRequest) Reset()         { *m = RawRequest{} }
 func (m *RawRequest) String() string { return proto.CompactTextString(m) }
 func (*RawRequest) ProtoMessage()    {}
 func (*RawRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{2}
+	return fileDescriptor_9714783eaff1514b, []int{4}
 }
 func (m *RawRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,6 +576,13 @@ func (m *RawRequest) GetCalldata() []byte {
 	return nil
 }
 
+func (m *RawRequest) GetDataSourceVersion() uint64 {
+	if m != nil {
+		return m.DataSourceVersion
+	}
+	return 0
+}
+
 // RawRequest is the data structure for storing raw reporter in the storage.
 type RawReport struct {
 	// ExternalID is an ID of the raw request
@@ -376,7 +601,7 @@ func (m *RawReport) Reset()         { *m = RawReport{} }
 func (m *RawReport) String() string { return proto.CompactTextString(m) }
 func (*RawReport) ProtoMessage()    {}
 func (*RawReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{3}
+	return fileDescriptor_9714783eaff1514b, []int{5}
 }
 func (m *RawReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -460,13 +685,19 @@ type Request struct {
 	Requester string `protobuf:"bytes,12,opt,name=requester,proto3" json:"requester,omitempty"`
 	// FeeLimit is the maximum tokens that will be paid for this request.
 	FeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=fee_limit,json=feeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_limit"`
+	// Callback is the module route and payload to be notified when the request
+	// is resolved.
+	Callback *RequestCallback `protobuf:"bytes,14,opt,name=callback,proto3" json:"callback,omitempty"`
+	// OracleScriptVersion is the version of the oracle script used by the
+	// request
+	OracleScriptVersion uint64 `protobuf:"varint,15,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
 func (m *Request) String() string { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()    {}
 func (*Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{4}
+	return fileDescriptor_9714783eaff1514b, []int{6}
 }
 func (m *Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -586,30 +817,43 @@ func (m *Request) GetFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
-// Report is the data structure for storing reports in the storage.
-type Report struct {
-	// Validator is a validator address who submit the report
-	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
-	// InBeforeResolve indicates whether the report is submitted before the
-	// request resolved
-	InBeforeResolve bool `protobuf:"varint,2,opt,name=in_before_resolve,json=inBeforeResolve,proto3" json:"in_before_resolve,omitempty"`
-	// RawReports is list of raw reports provided by the validator.
-	// Each raw report has different external ID
-	RawReports []RawReport `protobuf:"bytes,3,rep,name=raw_reports,json=rawReports,proto3" json:"raw_reports"`
+func (m *Request) GetCallback() *RequestCallback {
+	if m != nil {
+		return m.Callback
+	}
+	return nil
 }
 
-func (m *Report) Reset()         { *m = Report{} }
-func (m *Report) String() string { return proto.CompactTextString(m) }
-func (*Report) ProtoMessage()    {}
-func (*Report) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{5}
+func (m *Request) GetOracleScriptVersion() uint64 {
+	if m != nil {
+		return m.OracleScriptVersion
+	}
+	return 0
 }
-func (m *Report) XXX_Unmarshal(b []byte) error {
+
+// RequestCallback is the data structure for a module callback attached to a
+// request. The module registered under the route is notified through its
+// OracleHooks once the request is resolved.
+type RequestCallback struct {
+	// Module is the route of the module to be notified.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// Payload is arbitrary data passed back to the module alongside the requester
+	// and the result. Modules must check the requester before trusting it.
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *RequestCallback) Reset()         { *m = RequestCallback{} }
+func (m *RequestCallback) String() string { return proto.CompactTextString(m) }
+func (*RequestCallback) ProtoMessage()    {}
+func (*RequestCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{7}
+}
+func (m *RequestCallback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Report) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RequestCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Report.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RequestCallback.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -619,82 +863,91 @@ func (m *Report) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Report) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Report.Merge(m, src)
+func (m *RequestCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestCallback.Merge(m, src)
 }
-func (m *Report) XXX_Size() int {
+func (m *RequestCallback) XXX_Size() int {
 	return m.Size()
 }
-func (m *Report) XXX_DiscardUnknown() {
-	xxx_messageInfo_Report.DiscardUnknown(m)
+func (m *RequestCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestCallback.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Report proto.InternalMessageInfo
+var xxx_messageInfo_RequestCallback proto.InternalMessageInfo
 
-func (m *Report) GetValidator() string {
+func (m *RequestCallback) GetModule() string {
 	if m != nil {
-		return m.Validator
+		return m.Module
 	}
 	return ""
 }
 
-func (m *Report) GetInBeforeResolve() bool {
-	if m != nil {
-		return m.InBeforeResolve
-	}
-	return false
-}
-
-func (m *Report) GetRawReports() []RawReport {
+func (m *RequestCallback) GetPayload() []byte {
 	if m != nil {
-		return m.RawReports
+		return m.Payload
 	}
 	return nil
 }
 
-// OracleRequestPacketData encodes an oracle request sent from other blockchains
-// to BandChain.
-type OracleRequestPacketData struct {
-	// ClientID is the unique identifier of this oracle request, as specified by
-	// the client. This same unique ID will be sent back to the requester with the
-	// oracle response.
-	ClientID string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	// OracleScriptID is the unique identifier of the oracle script to be
-	// executed.
+// Subscription is the data structure for a recurring oracle request that is
+// fired on schedule and paid from a prefunded escrow account.
+type Subscription struct {
+	// ID is the unique identifier of the subscription
+	ID SubscriptionID `protobuf:"varint,1,opt,name=id,proto3,casttype=SubscriptionID" json:"id,omitempty"`
+	// OracleScriptID is ID of an oracle script
 	OracleScriptID OracleScriptID `protobuf:"varint,2,opt,name=oracle_script_id,json=oracleScriptId,proto3,casttype=OracleScriptID" json:"oracle_script_id,omitempty"`
-	// Calldata is the OBI-encoded calldata bytes available for oracle executor to
-	// read.
+	// Calldata is the data used as argument params for the oracle script
 	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
-	// AskCount is the number of validators that are requested to respond to this
-	// oracle request. Higher value means more security, at a higher gas cost.
+	// AskCount is the number of validators to perform each oracle request.
 	AskCount uint64 `protobuf:"varint,4,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
-	// MinCount is the minimum number of validators necessary for the request to
-	// proceed to the execution phase. Higher value means more security, at the
-	// cost of liveness.
+	// MinCount is the minimum number of validators sufficient to resolve each
+	// oracle request.
 	MinCount uint64 `protobuf:"varint,5,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
-	// FeeLimit is the maximum tokens that will be paid to all data source
-	// providers.
-	FeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fee_limit,json=feeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_limit"`
+	// ClientID is arbitrary id provided by the owner. It is attached to every
+	// request made by the subscription.
+	ClientID string `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// FeeLimit is the maximum tokens that will be paid for each request.
+	FeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=fee_limit,json=feeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_limit"`
 	// PrepareGas is amount of gas to pay to prepare raw requests
-	PrepareGas uint64 `protobuf:"varint,7,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
+	PrepareGas uint64 `protobuf:"varint,8,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
 	// ExecuteGas is amount of gas to reserve for executing
-	ExecuteGas uint64 `protobuf:"varint,8,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	ExecuteGas uint64 `protobuf:"varint,9,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
 	// TSSEncoder is the mode of encoding oracle result signature order.
-	TSSEncoder Encoder `protobuf:"varint,9,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
-}
-
-func (m *OracleRequestPacketData) Reset()         { *m = OracleRequestPacketData{} }
-func (m *OracleRequestPacketData) String() string { return proto.CompactTextString(m) }
-func (*OracleRequestPacketData) ProtoMessage()    {}
-func (*OracleRequestPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{6}
+	TSSEncoder Encoder `protobuf:"varint,10,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// BlockInterval is the number of blocks between two requests. Exactly one of
+	// BlockInterval and TimeInterval is set.
+	BlockInterval uint64 `protobuf:"varint,11,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	// TimeInterval is the number of seconds between two requests. Exactly one of
+	// BlockInterval and TimeInterval is set.
+	TimeInterval uint64 `protobuf:"varint,12,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
+	// Escrow is the account address holding the funding balance of the
+	// subscription. It is the fee payer of every request.
+	Escrow string `protobuf:"bytes,13,opt,name=escrow,proto3" json:"escrow,omitempty"`
+	// Owner is the account address that manages the subscription.
+	Owner string `protobuf:"bytes,14,opt,name=owner,proto3" json:"owner,omitempty"`
+	// IsActive is the flag indicating whether the subscription is firing
+	// requests. It is paused automatically once a request cannot be made.
+	IsActive bool `protobuf:"varint,15,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// LastRequestID is the ID of the latest request made by the subscription.
+	LastRequestID RequestID `protobuf:"varint,16,opt,name=last_request_id,json=lastRequestId,proto3,casttype=RequestID" json:"last_request_id,omitempty"`
+	// LastRequestHeight is the block height of the latest request.
+	LastRequestHeight int64 `protobuf:"varint,17,opt,name=last_request_height,json=lastRequestHeight,proto3" json:"last_request_height,omitempty"`
+	// LastRequestTime is the block timestamp of the latest request.
+	LastRequestTime int64 `protobuf:"varint,18,opt,name=last_request_time,json=lastRequestTime,proto3" json:"last_request_time,omitempty"`
+}
+
+func (m *Subscription) Reset()         { *m = Subscription{} }
+func (m *Subscription) String() string { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()    {}
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{8}
 }
-func (m *OracleRequestPacketData) XXX_Unmarshal(b []byte) error {
+func (m *Subscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OracleRequestPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OracleRequestPacketData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Subscription.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -704,93 +957,349 @@ func (m *OracleRequestPacketData) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *OracleRequestPacketData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OracleRequestPacketData.Merge(m, src)
+func (m *Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subscription.Merge(m, src)
 }
-func (m *OracleRequestPacketData) XXX_Size() int {
+func (m *Subscription) XXX_Size() int {
 	return m.Size()
 }
-func (m *OracleRequestPacketData) XXX_DiscardUnknown() {
-	xxx_messageInfo_OracleRequestPacketData.DiscardUnknown(m)
+func (m *Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subscription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OracleRequestPacketData proto.InternalMessageInfo
+var xxx_messageInfo_Subscription proto.InternalMessageInfo
 
-func (m *OracleRequestPacketData) GetClientID() string {
+func (m *Subscription) GetID() SubscriptionID {
 	if m != nil {
-		return m.ClientID
+		return m.ID
 	}
-	return ""
+	return 0
 }
 
-func (m *OracleRequestPacketData) GetOracleScriptID() OracleScriptID {
+func (m *Subscription) GetOracleScriptID() OracleScriptID {
 	if m != nil {
 		return m.OracleScriptID
 	}
 	return 0
 }
 
-func (m *OracleRequestPacketData) GetCalldata() []byte {
+func (m *Subscription) GetCalldata() []byte {
 	if m != nil {
 		return m.Calldata
 	}
 	return nil
 }
 
-func (m *OracleRequestPacketData) GetAskCount() uint64 {
+func (m *Subscription) GetAskCount() uint64 {
 	if m != nil {
 		return m.AskCount
 	}
 	return 0
 }
 
-func (m *OracleRequestPacketData) GetMinCount() uint64 {
+func (m *Subscription) GetMinCount() uint64 {
 	if m != nil {
 		return m.MinCount
 	}
 	return 0
 }
 
-func (m *OracleRequestPacketData) GetFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *Subscription) GetClientID() string {
+	if m != nil {
+		return m.ClientID
+	}
+	return ""
+}
+
+func (m *Subscription) GetFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.FeeLimit
 	}
 	return nil
 }
 
-func (m *OracleRequestPacketData) GetPrepareGas() uint64 {
+func (m *Subscription) GetPrepareGas() uint64 {
 	if m != nil {
 		return m.PrepareGas
 	}
 	return 0
 }
 
-func (m *OracleRequestPacketData) GetExecuteGas() uint64 {
+func (m *Subscription) GetExecuteGas() uint64 {
 	if m != nil {
 		return m.ExecuteGas
 	}
 	return 0
 }
 
-func (m *OracleRequestPacketData) GetTSSEncoder() Encoder {
+func (m *Subscription) GetTSSEncoder() Encoder {
 	if m != nil {
 		return m.TSSEncoder
 	}
 	return ENCODER_UNSPECIFIED
 }
 
-// OracleRequestPacketAcknowledgement encodes an oracle request acknowledgement
-// send back to requester chain.
-type OracleRequestPacketAcknowledgement struct {
-	// RequestID is BandChain's unique identifier for this oracle request.
-	RequestID RequestID `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3,casttype=RequestID" json:"request_id,omitempty"`
+func (m *Subscription) GetBlockInterval() uint64 {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return 0
 }
 
-func (m *OracleRequestPacketAcknowledgement) Reset()         { *m = OracleRequestPacketAcknowledgement{} }
-func (m *OracleRequestPacketAcknowledgement) String() string { return proto.CompactTextString(m) }
-func (*OracleRequestPacketAcknowledgement) ProtoMessage()    {}
-func (*OracleRequestPacketAcknowledgement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{7}
+func (m *Subscription) GetTimeInterval() uint64 {
+	if m != nil {
+		return m.TimeInterval
+	}
+	return 0
+}
+
+func (m *Subscription) GetEscrow() string {
+	if m != nil {
+		return m.Escrow
+	}
+	return ""
+}
+
+func (m *Subscription) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Subscription) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
+	}
+	return false
+}
+
+func (m *Subscription) GetLastRequestID() RequestID {
+	if m != nil {
+		return m.LastRequestID
+	}
+	return 0
+}
+
+func (m *Subscription) GetLastRequestHeight() int64 {
+	if m != nil {
+		return m.LastRequestHeight
+	}
+	return 0
+}
+
+func (m *Subscription) GetLastRequestTime() int64 {
+	if m != nil {
+		return m.LastRequestTime
+	}
+	return 0
+}
+
+// Report is the data structure for storing reports in the storage.
+type Report struct {
+	// Validator is a validator address who submit the report
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// InBeforeResolve indicates whether the report is submitted before the
+	// request resolved
+	InBeforeResolve bool `protobuf:"varint,2,opt,name=in_before_resolve,json=inBeforeResolve,proto3" json:"in_before_resolve,omitempty"`
+	// RawReports is list of raw reports provided by the validator.
+	// Each raw report has different external ID
+	RawReports []RawReport `protobuf:"bytes,3,rep,name=raw_reports,json=rawReports,proto3" json:"raw_reports"`
+}
+
+func (m *Report) Reset()         { *m = Report{} }
+func (m *Report) String() string { return proto.CompactTextString(m) }
+func (*Report) ProtoMessage()    {}
+func (*Report) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{9}
+}
+func (m *Report) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Report) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Report.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Report) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Report.Merge(m, src)
+}
+func (m *Report) XXX_Size() int {
+	return m.Size()
+}
+func (m *Report) XXX_DiscardUnknown() {
+	xxx_messageInfo_Report.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Report proto.InternalMessageInfo
+
+func (m *Report) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *Report) GetInBeforeResolve() bool {
+	if m != nil {
+		return m.InBeforeResolve
+	}
+	return false
+}
+
+func (m *Report) GetRawReports() []RawReport {
+	if m != nil {
+		return m.RawReports
+	}
+	return nil
+}
+
+// OracleRequestPacketData encodes an oracle request sent from other blockchains
+// to BandChain.
+type OracleRequestPacketData struct {
+	// ClientID is the unique identifier of this oracle request, as specified by
+	// the client. This same unique ID will be sent back to the requester with the
+	// oracle response.
+	ClientID string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// OracleScriptID is the unique identifier of the oracle script to be
+	// executed.
+	OracleScriptID OracleScriptID `protobuf:"varint,2,opt,name=oracle_script_id,json=oracleScriptId,proto3,casttype=OracleScriptID" json:"oracle_script_id,omitempty"`
+	// Calldata is the OBI-encoded calldata bytes available for oracle executor to
+	// read.
+	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is the number of validators that are requested to respond to this
+	// oracle request. Higher value means more security, at a higher gas cost.
+	AskCount uint64 `protobuf:"varint,4,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is the minimum number of validators necessary for the request to
+	// proceed to the execution phase. Higher value means more security, at the
+	// cost of liveness.
+	MinCount uint64 `protobuf:"varint,5,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// FeeLimit is the maximum tokens that will be paid to all data source
+	// providers.
+	FeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fee_limit,json=feeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_limit"`
+	// PrepareGas is amount of gas to pay to prepare raw requests
+	PrepareGas uint64 `protobuf:"varint,7,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
+	// ExecuteGas is amount of gas to reserve for executing
+	ExecuteGas uint64 `protobuf:"varint,8,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	// TSSEncoder is the mode of encoding oracle result signature order.
+	TSSEncoder Encoder `protobuf:"varint,9,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+}
+
+func (m *OracleRequestPacketData) Reset()         { *m = OracleRequestPacketData{} }
+func (m *OracleRequestPacketData) String() string { return proto.CompactTextString(m) }
+func (*OracleRequestPacketData) ProtoMessage()    {}
+func (*OracleRequestPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{10}
+}
+func (m *OracleRequestPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleRequestPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleRequestPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleRequestPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleRequestPacketData.Merge(m, src)
+}
+func (m *OracleRequestPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleRequestPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleRequestPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleRequestPacketData proto.InternalMessageInfo
+
+func (m *OracleRequestPacketData) GetClientID() string {
+	if m != nil {
+		return m.ClientID
+	}
+	return ""
+}
+
+func (m *OracleRequestPacketData) GetOracleScriptID() OracleScriptID {
+	if m != nil {
+		return m.OracleScriptID
+	}
+	return 0
+}
+
+func (m *OracleRequestPacketData) GetCalldata() []byte {
+	if m != nil {
+		return m.Calldata
+	}
+	return nil
+}
+
+func (m *OracleRequestPacketData) GetAskCount() uint64 {
+	if m != nil {
+		return m.AskCount
+	}
+	return 0
+}
+
+func (m *OracleRequestPacketData) GetMinCount() uint64 {
+	if m != nil {
+		return m.MinCount
+	}
+	return 0
+}
+
+func (m *OracleRequestPacketData) GetFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeLimit
+	}
+	return nil
+}
+
+func (m *OracleRequestPacketData) GetPrepareGas() uint64 {
+	if m != nil {
+		return m.PrepareGas
+	}
+	return 0
+}
+
+func (m *OracleRequestPacketData) GetExecuteGas() uint64 {
+	if m != nil {
+		return m.ExecuteGas
+	}
+	return 0
+}
+
+func (m *OracleRequestPacketData) GetTSSEncoder() Encoder {
+	if m != nil {
+		return m.TSSEncoder
+	}
+	return ENCODER_UNSPECIFIED
+}
+
+// OracleRequestPacketAcknowledgement encodes an oracle request acknowledgement
+// send back to requester chain.
+type OracleRequestPacketAcknowledgement struct {
+	// RequestID is BandChain's unique identifier for this oracle request.
+	RequestID RequestID `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3,casttype=RequestID" json:"request_id,omitempty"`
+}
+
+func (m *OracleRequestPacketAcknowledgement) Reset()         { *m = OracleRequestPacketAcknowledgement{} }
+func (m *OracleRequestPacketAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*OracleRequestPacketAcknowledgement) ProtoMessage()    {}
+func (*OracleRequestPacketAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{11}
 }
 func (m *OracleRequestPacketAcknowledgement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,7 +1365,7 @@ func (m *OracleResponsePacketData) Reset()         { *m = OracleResponsePacketDa
 func (m *OracleResponsePacketData) String() string { return proto.CompactTextString(m) }
 func (*OracleResponsePacketData) ProtoMessage()    {}
 func (*OracleResponsePacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{8}
+	return fileDescriptor_9714783eaff1514b, []int{12}
 }
 func (m *OracleResponsePacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -975,7 +1484,7 @@ func (m *Result) Reset()         { *m = Result{} }
 func (m *Result) String() string { return proto.CompactTextString(m) }
 func (*Result) ProtoMessage()    {}
 func (*Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{9}
+	return fileDescriptor_9714783eaff1514b, []int{13}
 }
 func (m *Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1081,21 +1590,146 @@ func (m *Result) GetResult() []byte {
 	return nil
 }
 
-// SigningResult encodes a result of signing of request
-type SigningResult struct {
-	// signing_id is the id of the bandtss signing
-	SigningID github_com_bandprotocol_chain_v3_x_bandtss_types.SigningID `protobuf:"varint,1,opt,name=signing_id,json=signingId,proto3,casttype=github.com/bandprotocol/chain/v3/x/bandtss/types.SigningID" json:"signing_id,omitempty"`
-	// error_codespace is the codespace of the error
-	ErrorCodespace string `protobuf:"bytes,2,opt,name=error_codespace,json=errorCodespace,proto3" json:"error_codespace,omitempty"`
-	// error_code is the code in the codespace of the error
-	ErrorCode uint64 `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+// RequestVersions is the record of the versions of the oracle script and data
+// sources used by a request. Unlike the request, it is kept after the request is
+// resolved and removed, and it is stored apart from the result so that the
+// encoding of the result is unchanged.
+type RequestVersions struct {
+	// OracleScriptVersion is the version of the oracle script used by the
+	// request.
+	OracleScriptVersion uint64 `protobuf:"varint,1,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
+	// RawRequestVersions is the list of versions of the data sources used by the
+	// raw requests of the request.
+	RawRequestVersions []RawRequestVersion `protobuf:"bytes,2,rep,name=raw_request_versions,json=rawRequestVersions,proto3" json:"raw_request_versions"`
 }
 
-func (m *SigningResult) Reset()         { *m = SigningResult{} }
-func (m *SigningResult) String() string { return proto.CompactTextString(m) }
-func (*SigningResult) ProtoMessage()    {}
-func (*SigningResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{10}
+func (m *RequestVersions) Reset()         { *m = RequestVersions{} }
+func (m *RequestVersions) String() string { return proto.CompactTextString(m) }
+func (*RequestVersions) ProtoMessage()    {}
+func (*RequestVersions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{14}
+}
+func (m *RequestVersions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestVersions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestVersions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestVersions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestVersions.Merge(m, src)
+}
+func (m *RequestVersions) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestVersions) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestVersions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestVersions proto.InternalMessageInfo
+
+func (m *RequestVersions) GetOracleScriptVersion() uint64 {
+	if m != nil {
+		return m.OracleScriptVersion
+	}
+	return 0
+}
+
+func (m *RequestVersions) GetRawRequestVersions() []RawRequestVersion {
+	if m != nil {
+		return m.RawRequestVersions
+	}
+	return nil
+}
+
+// RawRequestVersion is the version of the data source used by a raw request.
+type RawRequestVersion struct {
+	// ExternalID is an ID of the raw request
+	ExternalID ExternalID `protobuf:"varint,1,opt,name=external_id,json=externalId,proto3,casttype=ExternalID" json:"external_id,omitempty"`
+	// DataSourceID is an ID of data source script that relates to the raw request
+	DataSourceID DataSourceID `protobuf:"varint,2,opt,name=data_source_id,json=dataSourceId,proto3,casttype=DataSourceID" json:"data_source_id,omitempty"`
+	// DataSourceVersion is the version of the data source used by the raw
+	// request
+	DataSourceVersion uint64 `protobuf:"varint,3,opt,name=data_source_version,json=dataSourceVersion,proto3" json:"data_source_version,omitempty"`
+}
+
+func (m *RawRequestVersion) Reset()         { *m = RawRequestVersion{} }
+func (m *RawRequestVersion) String() string { return proto.CompactTextString(m) }
+func (*RawRequestVersion) ProtoMessage()    {}
+func (*RawRequestVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{15}
+}
+func (m *RawRequestVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RawRequestVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RawRequestVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RawRequestVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawRequestVersion.Merge(m, src)
+}
+func (m *RawRequestVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *RawRequestVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawRequestVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RawRequestVersion proto.InternalMessageInfo
+
+func (m *RawRequestVersion) GetExternalID() ExternalID {
+	if m != nil {
+		return m.ExternalID
+	}
+	return 0
+}
+
+func (m *RawRequestVersion) GetDataSourceID() DataSourceID {
+	if m != nil {
+		return m.DataSourceID
+	}
+	return 0
+}
+
+func (m *RawRequestVersion) GetDataSourceVersion() uint64 {
+	if m != nil {
+		return m.DataSourceVersion
+	}
+	return 0
+}
+
+// SigningResult encodes a result of signing of request
+type SigningResult struct {
+	// signing_id is the id of the bandtss signing
+	SigningID github_com_bandprotocol_chain_v3_x_bandtss_types.SigningID `protobuf:"varint,1,opt,name=signing_id,json=signingId,proto3,casttype=github.com/bandprotocol/chain/v3/x/bandtss/types.SigningID" json:"signing_id,omitempty"`
+	// error_codespace is the codespace of the error
+	ErrorCodespace string `protobuf:"bytes,2,opt,name=error_codespace,json=errorCodespace,proto3" json:"error_codespace,omitempty"`
+	// error_code is the code in the codespace of the error
+	ErrorCode uint64 `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+}
+
+func (m *SigningResult) Reset()         { *m = SigningResult{} }
+func (m *SigningResult) String() string { return proto.CompactTextString(m) }
+func (*SigningResult) ProtoMessage()    {}
+func (*SigningResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{16}
 }
 func (m *SigningResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1793,7 @@ func (m *ValidatorStatus) Reset()         { *m = ValidatorStatus{} }
 func (m *ValidatorStatus) String() string { return proto.CompactTextString(m) }
 func (*ValidatorStatus) ProtoMessage()    {}
 func (*ValidatorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{11}
+	return fileDescriptor_9714783eaff1514b, []int{17}
 }
 func (m *ValidatorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1214,7 +1848,7 @@ func (m *ActiveValidator) Reset()         { *m = ActiveValidator{} }
 func (m *ActiveValidator) String() string { return proto.CompactTextString(m) }
 func (*ActiveValidator) ProtoMessage()    {}
 func (*ActiveValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{12}
+	return fileDescriptor_9714783eaff1514b, []int{18}
 }
 func (m *ActiveValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1290,13 +1924,26 @@ type Params struct {
 	// IBCRequestEnabled is a flag indicating whether sending oracle request via
 	// IBC is allowed
 	IBCRequestEnabled bool `protobuf:"varint,11,opt,name=ibc_request_enabled,json=ibcRequestEnabled,proto3" json:"ibc_request_enabled,omitempty"`
+	// PriceReferenceOracleScriptID is the ID of the standard price reference
+	// oracle script whose results are indexed by symbol for the
+	// Query/RequestPrice RPC. Zero disables the price indexing.
+	PriceReferenceOracleScriptID OracleScriptID `protobuf:"varint,12,opt,name=price_reference_oracle_script_id,json=priceReferenceOracleScriptId,proto3,casttype=OracleScriptID" json:"price_reference_oracle_script_id,omitempty"`
+	// SubscriptionFee is the fee charged from the escrow of a subscription to
+	// the fee collector every time the subscription makes a request, on top of
+	// the data source fees. A subscription that cannot pay it is paused.
+	SubscriptionFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=subscription_fee,json=subscriptionFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"subscription_fee"`
+	// MaxSubscriptionGas is the maximum amount of Cosmos-SDK gas a subscription
+	// can consume to make a request in the begin block.
+	MaxSubscriptionGas uint64 `protobuf:"varint,14,opt,name=max_subscription_gas,json=maxSubscriptionGas,proto3" json:"max_subscription_gas,omitempty"`
+	// MaxActiveSubscriptions is the maximum number of active subscriptions.
+	MaxActiveSubscriptions uint64 `protobuf:"varint,15,opt,name=max_active_subscriptions,json=maxActiveSubscriptions,proto3" json:"max_active_subscriptions,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{13}
+	return fileDescriptor_9714783eaff1514b, []int{19}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1402,6 +2049,34 @@ func (m *Params) GetIBCRequestEnabled() bool {
 	return false
 }
 
+func (m *Params) GetPriceReferenceOracleScriptID() OracleScriptID {
+	if m != nil {
+		return m.PriceReferenceOracleScriptID
+	}
+	return 0
+}
+
+func (m *Params) GetSubscriptionFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SubscriptionFee
+	}
+	return nil
+}
+
+func (m *Params) GetMaxSubscriptionGas() uint64 {
+	if m != nil {
+		return m.MaxSubscriptionGas
+	}
+	return 0
+}
+
+func (m *Params) GetMaxActiveSubscriptions() uint64 {
+	if m != nil {
+		return m.MaxActiveSubscriptions
+	}
+	return 0
+}
+
 // PendingResolveList is a list of requests that are waiting to be resolved
 type PendingResolveList struct {
 	// RequestIDs is a list of request IDs that are waiting to be resolved
@@ -1412,7 +2087,7 @@ func (m *PendingResolveList) Reset()         { *m = PendingResolveList{} }
 func (m *PendingResolveList) String() string { return proto.CompactTextString(m) }
 func (*PendingResolveList) ProtoMessage()    {}
 func (*PendingResolveList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{14}
+	return fileDescriptor_9714783eaff1514b, []int{20}
 }
 func (m *PendingResolveList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1463,7 +2138,7 @@ func (m *IBCChannel) Reset()         { *m = IBCChannel{} }
 func (m *IBCChannel) String() string { return proto.CompactTextString(m) }
 func (*IBCChannel) ProtoMessage()    {}
 func (*IBCChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{15}
+	return fileDescriptor_9714783eaff1514b, []int{21}
 }
 func (m *IBCChannel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1525,7 +2200,7 @@ func (m *RequestVerification) Reset()         { *m = RequestVerification{} }
 func (m *RequestVerification) String() string { return proto.CompactTextString(m) }
 func (*RequestVerification) ProtoMessage()    {}
 func (*RequestVerification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{16}
+	return fileDescriptor_9714783eaff1514b, []int{22}
 }
 func (m *RequestVerification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1610,7 +2285,7 @@ func (m *PriceResult) Reset()         { *m = PriceResult{} }
 func (m *PriceResult) String() string { return proto.CompactTextString(m) }
 func (*PriceResult) ProtoMessage()    {}
 func (*PriceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{17}
+	return fileDescriptor_9714783eaff1514b, []int{23}
 }
 func (m *PriceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1686,7 +2361,7 @@ func (m *OracleResultSignatureOrder) Reset()         { *m = OracleResultSignatur
 func (m *OracleResultSignatureOrder) String() string { return proto.CompactTextString(m) }
 func (*OracleResultSignatureOrder) ProtoMessage()    {}
 func (*OracleResultSignatureOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{18}
+	return fileDescriptor_9714783eaff1514b, []int{24}
 }
 func (m *OracleResultSignatureOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1720,14 +2395,20 @@ func init() {
 	proto.RegisterEnum("band.oracle.v1.Encoder", Encoder_name, Encoder_value)
 	proto.RegisterType((*DataSource)(nil), "band.oracle.v1.DataSource")
 	proto.RegisterType((*OracleScript)(nil), "band.oracle.v1.OracleScript")
+	proto.RegisterType((*DataSourceVersion)(nil), "band.oracle.v1.DataSourceVersion")
+	proto.RegisterType((*OracleScriptVersion)(nil), "band.oracle.v1.OracleScriptVersion")
 	proto.RegisterType((*RawRequest)(nil), "band.oracle.v1.RawRequest")
 	proto.RegisterType((*RawReport)(nil), "band.oracle.v1.RawReport")
 	proto.RegisterType((*Request)(nil), "band.oracle.v1.Request")
+	proto.RegisterType((*RequestCallback)(nil), "band.oracle.v1.RequestCallback")
+	proto.RegisterType((*Subscription)(nil), "band.oracle.v1.Subscription")
 	proto.RegisterType((*Report)(nil), "band.oracle.v1.Report")
 	proto.RegisterType((*OracleRequestPacketData)(nil), "band.oracle.v1.OracleRequestPacketData")
 	proto.RegisterType((*OracleRequestPacketAcknowledgement)(nil), "band.oracle.v1.OracleRequestPacketAcknowledgement")
 	proto.RegisterType((*OracleResponsePacketData)(nil), "band.oracle.v1.OracleResponsePacketData")
 	proto.RegisterType((*Result)(nil), "band.oracle.v1.Result")
+	proto.RegisterType((*RequestVersions)(nil), "band.oracle.v1.RequestVersions")
+	proto.RegisterType((*RawRequestVersion)(nil), "band.oracle.v1.RawRequestVersion")
 	proto.RegisterType((*SigningResult)(nil), "band.oracle.v1.SigningResult")
 	proto.RegisterType((*ValidatorStatus)(nil), "band.oracle.v1.ValidatorStatus")
 	proto.RegisterType((*ActiveValidator)(nil), "band.oracle.v1.ActiveValidator")
@@ -1742,130 +2423,163 @@ func init() {
 func init() { proto.RegisterFile("band/oracle/v1/oracle.proto", fileDescriptor_9714783eaff1514b) }
 
 var fileDescriptor_9714783eaff1514b = []byte{
-	// 1965 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xdb, 0x6f, 0x63, 0x47,
-	0x19, 0xcf, 0xb1, 0x9d, 0xc4, 0xfe, 0xec, 0x38, 0xc9, 0x6c, 0xba, 0xf1, 0x7a, 0xb7, 0x71, 0x88,
-	0x0a, 0x2c, 0x2b, 0xb0, 0xc9, 0x16, 0x21, 0x76, 0x01, 0x89, 0xf8, 0xb2, 0xd4, 0x34, 0xda, 0x58,
-	0xe3, 0x64, 0x85, 0x90, 0xd0, 0xd1, 0xf8, 0x9c, 0x89, 0x33, 0xcd, 0xb9, 0x31, 0x73, 0x9c, 0x38,
-	0x7d, 0xe3, 0xad, 0xea, 0xd3, 0x3e, 0x23, 0x55, 0xaa, 0xd4, 0x37, 0x5e, 0x11, 0xff, 0x01, 0x88,
-	0xf2, 0x44, 0x1f, 0x91, 0x90, 0x52, 0xe4, 0x95, 0x10, 0xff, 0x00, 0x2f, 0xf0, 0x82, 0xe6, 0x72,
-	0x7c, 0xab, 0xe9, 0x76, 0x77, 0x0b, 0x0f, 0x3c, 0xf9, 0x7c, 0x97, 0x99, 0xf9, 0x6e, 0xbf, 0x6f,
-	0xbe, 0x31, 0xdc, 0xee, 0x91, 0xc0, 0xad, 0x85, 0x9c, 0x38, 0x1e, 0xad, 0x5d, 0xec, 0x9b, 0xaf,
-	0x6a, 0xc4, 0xc3, 0x38, 0x44, 0x45, 0x29, 0xac, 0x1a, 0xd6, 0xc5, 0x7e, 0x79, 0xab, 0x1f, 0xf6,
-	0x43, 0x25, 0xaa, 0xc9, 0x2f, 0xad, 0x55, 0xae, 0xf4, 0xc3, 0xb0, 0xef, 0xd1, 0x9a, 0xa2, 0x7a,
-	0x83, 0xd3, 0x5a, 0xcc, 0x7c, 0x2a, 0x62, 0xe2, 0x47, 0x46, 0x61, 0xc7, 0x09, 0x85, 0x1f, 0x8a,
-	0x5a, 0x8f, 0x08, 0x79, 0x46, 0x8f, 0xc6, 0x64, 0xbf, 0xe6, 0x84, 0x2c, 0xd0, 0xf2, 0xbd, 0x7f,
-	0x58, 0x00, 0x4d, 0x12, 0x93, 0x6e, 0x38, 0xe0, 0x0e, 0x45, 0x5b, 0xb0, 0x1c, 0x5e, 0x06, 0x94,
-	0x97, 0xac, 0x5d, 0xeb, 0x6e, 0x0e, 0x6b, 0x02, 0x21, 0xc8, 0x04, 0xc4, 0xa7, 0xa5, 0x94, 0x62,
-	0xaa, 0x6f, 0xb4, 0x0b, 0x79, 0x97, 0x0a, 0x87, 0xb3, 0x28, 0x66, 0x61, 0x50, 0x4a, 0x2b, 0xd1,
-	0x34, 0x0b, 0x95, 0x21, 0x7b, 0xca, 0x3c, 0xaa, 0x56, 0x66, 0x94, 0x78, 0x4c, 0x4b, 0x59, 0xcc,
-	0x29, 0x11, 0x03, 0x7e, 0x55, 0x5a, 0xd6, 0xb2, 0x84, 0x46, 0x3f, 0x87, 0xf4, 0x29, 0xa5, 0xa5,
-	0x95, 0xdd, 0xf4, 0xdd, 0xfc, 0xfd, 0x5b, 0x55, 0xed, 0x40, 0x55, 0x3a, 0x50, 0x35, 0x0e, 0x54,
-	0x1b, 0x21, 0x0b, 0xea, 0xdf, 0xfe, 0xf8, 0xba, 0xb2, 0xf4, 0xeb, 0x4f, 0x2b, 0x77, 0xfb, 0x2c,
-	0x3e, 0x1b, 0xf4, 0xaa, 0x4e, 0xe8, 0xd7, 0x8c, 0xb7, 0xfa, 0xe7, 0x5b, 0xc2, 0x3d, 0xaf, 0xc5,
-	0x57, 0x11, 0x15, 0x6a, 0x81, 0xc0, 0x72, 0xdf, 0x87, 0x99, 0xbf, 0x7f, 0x58, 0xb1, 0xf6, 0xfe,
-	0x64, 0x41, 0xe1, 0x48, 0x05, 0xb7, 0xab, 0x0c, 0xfe, 0x9f, 0x79, 0x7e, 0x13, 0x56, 0x84, 0x73,
-	0x46, 0x7d, 0x62, 0xfc, 0x36, 0x14, 0x7a, 0x00, 0xeb, 0x42, 0xe5, 0xc0, 0x76, 0x42, 0x97, 0xda,
-	0x03, 0xee, 0x95, 0x56, 0xa4, 0x42, 0x7d, 0x73, 0x74, 0x5d, 0x59, 0xd3, 0xe9, 0x69, 0x84, 0x2e,
-	0x3d, 0xc1, 0x87, 0x78, 0x4d, 0x4c, 0x48, 0xee, 0x19, 0x8f, 0x7e, 0x6b, 0x01, 0x60, 0x72, 0x89,
-	0xe9, 0x2f, 0x06, 0x54, 0xc4, 0xe8, 0x87, 0x90, 0xa7, 0xc3, 0x98, 0xf2, 0x80, 0x78, 0x36, 0x73,
-	0x95, 0x57, 0x99, 0xfa, 0x9d, 0xd1, 0x75, 0x05, 0x5a, 0x86, 0xdd, 0x6e, 0xfe, 0x73, 0x86, 0xc2,
-	0x90, 0x2c, 0x68, 0xbb, 0xe8, 0x11, 0x14, 0x5d, 0x12, 0x13, 0xdb, 0xd8, 0xc4, 0x5c, 0x15, 0x82,
-	0x4c, 0x7d, 0x77, 0x74, 0x5d, 0x29, 0x4c, 0x0a, 0x46, 0xed, 0x31, 0x43, 0xe3, 0x82, 0x3b, 0xa1,
-	0x5c, 0x19, 0x0a, 0x87, 0x78, 0x9e, 0xe4, 0xa9, 0x48, 0x15, 0xf0, 0x98, 0x36, 0x76, 0xff, 0xd2,
-	0x82, 0x9c, 0xb2, 0x3b, 0x0a, 0xf9, 0x2b, 0x9b, 0x7d, 0x1b, 0x72, 0x74, 0xc8, 0x62, 0x15, 0x43,
-	0x65, 0xf1, 0x1a, 0xce, 0x4a, 0x86, 0x0c, 0x95, 0x4c, 0xe6, 0x94, 0x1d, 0x99, 0x29, 0x1b, 0x7e,
-	0xbf, 0x0c, 0xab, 0x49, 0xe0, 0x1e, 0xc3, 0x86, 0x46, 0x9d, 0xad, 0x13, 0x3a, 0x31, 0xe3, 0x8d,
-	0xd1, 0x75, 0xa5, 0x38, 0x5d, 0x34, 0xca, 0x94, 0x39, 0x0e, 0x2e, 0x86, 0xd3, 0xf4, 0x6c, 0x04,
-	0x52, 0xb3, 0x11, 0x40, 0xfb, 0xb0, 0xc5, 0xf5, 0xb1, 0xd4, 0xb5, 0x2f, 0x88, 0xc7, 0x5c, 0x12,
-	0x87, 0x5c, 0x94, 0xd2, 0xbb, 0xe9, 0xbb, 0x39, 0x7c, 0x63, 0x2c, 0x7b, 0x32, 0x16, 0x49, 0x0f,
-	0x7d, 0x16, 0xd8, 0x4e, 0x38, 0x08, 0x62, 0x55, 0x5c, 0x19, 0x9c, 0xf5, 0x59, 0xd0, 0x90, 0x34,
-	0xfa, 0x2a, 0x14, 0xcd, 0x1a, 0xfb, 0x8c, 0xb2, 0xfe, 0x59, 0xac, 0x8a, 0x2c, 0x8d, 0xd7, 0x0c,
-	0xf7, 0x2d, 0xc5, 0x44, 0x5f, 0x81, 0x42, 0xa2, 0x26, 0xfb, 0x85, 0x2a, 0xb4, 0x34, 0xce, 0x1b,
-	0xde, 0x31, 0xf3, 0x29, 0xfa, 0x06, 0xe4, 0x1c, 0x8f, 0xd1, 0x40, 0xb9, 0xbf, 0xaa, 0x0a, 0xb1,
-	0x30, 0xba, 0xae, 0x64, 0x1b, 0x8a, 0xd9, 0x6e, 0xe2, 0xac, 0x16, 0xb7, 0x5d, 0xd4, 0x80, 0x02,
-	0x27, 0x97, 0xb6, 0x59, 0x2d, 0x4a, 0x59, 0x05, 0xdc, 0x72, 0x75, 0xb6, 0x81, 0x55, 0x27, 0xb5,
-	0x59, 0xcf, 0x48, 0xe4, 0xe2, 0x3c, 0x1f, 0x73, 0x04, 0x7a, 0x1b, 0xf2, 0xac, 0xe7, 0xd8, 0xce,
-	0x19, 0x09, 0x02, 0xea, 0x95, 0x72, 0xbb, 0xd6, 0xa2, 0x3d, 0xda, 0xf5, 0x46, 0x43, 0x6b, 0xd4,
-	0x8b, 0xb2, 0x26, 0x26, 0x34, 0x06, 0xd6, 0x73, 0xcc, 0x37, 0xaa, 0xc8, 0x22, 0xa2, 0xce, 0x20,
-	0xa6, 0x76, 0x9f, 0x88, 0x12, 0xa8, 0x28, 0x81, 0x61, 0xfd, 0x98, 0x08, 0xf4, 0x16, 0xe4, 0x63,
-	0x21, 0x6c, 0x1a, 0xc8, 0x3a, 0xe1, 0xa5, 0xfc, 0xae, 0x75, 0xb7, 0x78, 0x7f, 0x7b, 0xfe, 0xb4,
-	0x96, 0x16, 0xeb, 0xa3, 0x8e, 0xbb, 0x5d, 0x43, 0x63, 0x88, 0x85, 0x30, 0xdf, 0xe8, 0x0e, 0xe4,
-	0x92, 0x2c, 0xf1, 0x52, 0x41, 0x21, 0x7a, 0xc2, 0x40, 0x67, 0x90, 0x3b, 0xa5, 0xd4, 0xf6, 0x98,
-	0xcf, 0xe2, 0xd2, 0xda, 0x97, 0xdf, 0xd0, 0xb2, 0xa7, 0x94, 0x1e, 0xca, 0xcd, 0x4d, 0x1d, 0xff,
-	0xca, 0x82, 0x15, 0x03, 0xa4, 0x3b, 0x90, 0x1b, 0x17, 0x94, 0xe9, 0x69, 0x13, 0x06, 0xba, 0x07,
-	0x9b, 0x2c, 0xb0, 0x7b, 0xf4, 0x34, 0xe4, 0xd4, 0xe6, 0x54, 0x84, 0xde, 0x85, 0xc6, 0x4b, 0x16,
-	0xaf, 0xb3, 0xa0, 0xae, 0xf8, 0x58, 0xb3, 0xd1, 0x8f, 0x20, 0xaf, 0xf3, 0x2b, 0xf7, 0xd5, 0xb5,
-	0x29, 0xdd, 0x58, 0x94, 0x5e, 0xa9, 0x61, 0xb2, 0x0b, 0x3c, 0x61, 0x08, 0x63, 0xdc, 0xdf, 0xd2,
-	0xb0, 0xad, 0xb1, 0x62, 0xb2, 0xde, 0x21, 0xce, 0x39, 0x8d, 0x65, 0xf3, 0x98, 0x2d, 0x37, 0xeb,
-	0x73, 0xcb, 0x6d, 0x11, 0x3e, 0x53, 0x5f, 0x12, 0x3e, 0xe7, 0x3a, 0x94, 0x04, 0x1b, 0x11, 0xe7,
-	0xb3, 0x60, 0x23, 0xe2, 0x5c, 0x83, 0x6d, 0x06, 0x89, 0xcb, 0x73, 0x48, 0x9c, 0xc9, 0xfc, 0xca,
-	0x7f, 0x31, 0xf3, 0xb2, 0xd8, 0x23, 0x4e, 0x23, 0xc2, 0x75, 0xb1, 0xaf, 0xea, 0x62, 0x37, 0x2c,
-	0x59, 0xec, 0x73, 0x68, 0xc8, 0x3e, 0x0f, 0x0d, 0xb9, 0x97, 0x46, 0x83, 0x49, 0x34, 0x85, 0xbd,
-	0x05, 0x79, 0x3e, 0x70, 0xce, 0x83, 0xf0, 0xd2, 0xa3, 0x6e, 0x9f, 0xfa, 0x34, 0x88, 0xd1, 0x03,
-	0x80, 0xa4, 0x09, 0x8d, 0x3b, 0x6c, 0x79, 0x74, 0x5d, 0xc9, 0x99, 0x55, 0x2a, 0x79, 0x13, 0x62,
-	0x0c, 0xab, 0xb6, 0x6b, 0x8e, 0xf9, 0x43, 0x0a, 0x4a, 0xc9, 0x39, 0x22, 0x0a, 0x03, 0x41, 0x5f,
-	0xae, 0xa0, 0x66, 0x0d, 0x49, 0xbd, 0x80, 0x21, 0xaa, 0x3e, 0x02, 0x61, 0x4a, 0x20, 0x6d, 0xea,
-	0x23, 0x10, 0xba, 0x04, 0xe6, 0xbb, 0x6c, 0xe6, 0xb3, 0x5d, 0x56, 0xa9, 0x28, 0x94, 0x69, 0x95,
-	0xe5, 0x44, 0x45, 0xf1, 0x94, 0x4a, 0x53, 0xb6, 0x74, 0xad, 0x22, 0x62, 0x12, 0x0f, 0x84, 0xea,
-	0xd6, 0xc5, 0xfb, 0xaf, 0x7f, 0x06, 0x80, 0x5a, 0xab, 0xab, 0x94, 0x64, 0xc7, 0x9f, 0x22, 0xe5,
-	0xd4, 0xc1, 0xa9, 0x18, 0x78, 0xb1, 0xaa, 0x8f, 0x02, 0x36, 0x94, 0x89, 0xe4, 0x5f, 0xd2, 0xb2,
-	0x6d, 0x48, 0xc6, 0xff, 0x1f, 0x10, 0x67, 0xb3, 0xbb, 0xf2, 0xd2, 0xd9, 0x5d, 0x7d, 0x4e, 0x76,
-	0xb3, 0xcf, 0xcf, 0x6e, 0xee, 0x8b, 0x64, 0x17, 0x5e, 0x29, 0xbb, 0xf9, 0x05, 0xd9, 0xfd, 0xa3,
-	0x05, 0x6b, 0x5d, 0xd6, 0x0f, 0x58, 0xd0, 0x37, 0x49, 0x7e, 0x07, 0x40, 0x68, 0xc6, 0x04, 0x7a,
-	0x6f, 0xcb, 0x98, 0x18, 0x35, 0x15, 0x93, 0x87, 0x53, 0xbd, 0x48, 0x1a, 0xa3, 0xde, 0x0b, 0x4e,
-	0xe8, 0xd5, 0x9c, 0x33, 0xc2, 0x82, 0xda, 0xc5, 0x9b, 0xb5, 0xa1, 0xe2, 0xc7, 0x42, 0x98, 0xce,
-	0x34, 0x5e, 0x8d, 0x73, 0x66, 0xfb, 0xb6, 0x8b, 0xbe, 0x0e, 0xeb, 0x94, 0xf3, 0x90, 0xab, 0x91,
-	0x4c, 0x44, 0xc4, 0x49, 0x86, 0xe9, 0xa2, 0x62, 0x37, 0x12, 0x2e, 0x7a, 0x1d, 0x60, 0xa2, 0x68,
-	0xc0, 0x94, 0x1b, 0xeb, 0x18, 0x5f, 0x22, 0x58, 0x1f, 0xcf, 0x42, 0xc6, 0xf9, 0xdb, 0x90, 0x63,
-	0xc2, 0x26, 0x4e, 0xcc, 0x2e, 0xa8, 0xf2, 0x25, 0x8b, 0xb3, 0x4c, 0x1c, 0x28, 0x1a, 0x3d, 0x84,
-	0x65, 0xc1, 0x02, 0x73, 0xa6, 0x1c, 0x28, 0xf4, 0x7b, 0xa9, 0x9a, 0xbc, 0x97, 0xaa, 0xc7, 0xc9,
-	0x7b, 0xa9, 0x9e, 0x95, 0x3d, 0xf8, 0xe9, 0xa7, 0x15, 0x0b, 0xeb, 0x25, 0xe6, 0xc4, 0x03, 0x58,
-	0xd7, 0x7b, 0x8d, 0xcf, 0x45, 0x25, 0x58, 0x25, 0xae, 0xcb, 0xa9, 0x10, 0xe6, 0x62, 0x4d, 0x48,
-	0xf9, 0x88, 0x88, 0xc2, 0x4b, 0xca, 0x35, 0x0e, 0xb0, 0x26, 0xf6, 0x7e, 0x97, 0x81, 0x95, 0x0e,
-	0xe1, 0xc4, 0x17, 0x68, 0x1f, 0x5e, 0xf3, 0xc9, 0xd0, 0x9e, 0x9a, 0x97, 0x4c, 0x79, 0xa9, 0x24,
-	0x60, 0xe4, 0x93, 0xe1, 0x64, 0x4e, 0xd2, 0x85, 0xb6, 0x07, 0x6b, 0x72, 0xc9, 0xa4, 0xfc, 0xf5,
-	0xde, 0x79, 0x9f, 0x0c, 0x0f, 0x12, 0x04, 0xdc, 0x83, 0x4d, 0xa9, 0x93, 0xc0, 0xc5, 0x16, 0xec,
-	0xdd, 0x24, 0x84, 0xeb, 0x3e, 0x19, 0x36, 0x0c, 0xbf, 0xcb, 0xde, 0xa5, 0xa8, 0x06, 0x5b, 0xca,
-	0x04, 0x75, 0x37, 0xdb, 0x13, 0x75, 0x8d, 0x2a, 0xb9, 0x8f, 0xbe, 0xb6, 0x9b, 0xc9, 0x82, 0xef,
-	0xc0, 0x4d, 0x3a, 0x8c, 0x18, 0x27, 0xf2, 0x6d, 0x63, 0xf7, 0xbc, 0xd0, 0x39, 0x9f, 0xc1, 0xda,
-	0xd6, 0x44, 0x5a, 0x97, 0x42, 0x6d, 0xd2, 0x1b, 0x50, 0x94, 0xf7, 0x9c, 0x1d, 0x5e, 0x12, 0xe1,
-	0xab, 0x8b, 0x47, 0x61, 0x0f, 0x17, 0x24, 0xf7, 0x48, 0x32, 0xe5, 0xd5, 0xf3, 0x00, 0x6e, 0x45,
-	0x94, 0x4f, 0x46, 0xdf, 0x71, 0x54, 0x26, 0x57, 0xd9, 0xcd, 0x88, 0xf2, 0x71, 0xec, 0x4d, 0x64,
-	0xe4, 0xd2, 0x6f, 0x02, 0x12, 0xc4, 0x8f, 0x3c, 0x59, 0xc5, 0x31, 0xbf, 0x32, 0x26, 0xe9, 0xdb,
-	0x6d, 0x23, 0x91, 0x1c, 0xf3, 0x2b, 0x6d, 0xce, 0xf7, 0xa0, 0x64, 0x9a, 0x15, 0xa7, 0x97, 0x84,
-	0xbb, 0x76, 0x44, 0xb9, 0x43, 0x83, 0x98, 0xf4, 0x35, 0x2e, 0x33, 0xf8, 0x66, 0x68, 0xee, 0x12,
-	0x29, 0xee, 0x8c, 0xa5, 0xe8, 0x21, 0xdc, 0x62, 0x81, 0x2e, 0x2f, 0x3b, 0xa2, 0x01, 0xf1, 0xe2,
-	0x2b, 0xdb, 0x1d, 0x68, 0x7f, 0xcd, 0x68, 0xb9, 0x9d, 0x28, 0x74, 0xb4, 0xbc, 0x69, 0xc4, 0xa8,
-	0x05, 0x37, 0xe4, 0x54, 0x9b, 0x38, 0x45, 0x03, 0xd2, 0xf3, 0xa8, 0xab, 0x50, 0x9a, 0xad, 0xbf,
-	0x36, 0xba, 0xae, 0x6c, 0xb6, 0xeb, 0x0d, 0xe3, 0x53, 0x4b, 0x0b, 0xf1, 0x26, 0xeb, 0x39, 0xb3,
-	0x2c, 0x53, 0x89, 0xdf, 0x07, 0xd4, 0xa1, 0x81, 0xab, 0x61, 0x2c, 0xd1, 0x7f, 0xc8, 0x84, 0xba,
-	0xfe, 0x27, 0xfd, 0x4d, 0x16, 0x64, 0x5a, 0xde, 0xee, 0xe3, 0x26, 0x96, 0x0c, 0x5f, 0x3f, 0x81,
-	0xa9, 0x61, 0x19, 0x6d, 0xc3, 0xaa, 0xca, 0x7e, 0xd2, 0xe3, 0xf1, 0x8a, 0x24, 0xdb, 0xae, 0x04,
-	0xa1, 0x19, 0xc1, 0x93, 0x6e, 0x9e, 0xc3, 0x39, 0xc3, 0x19, 0x5f, 0xbc, 0x1f, 0xa5, 0xe0, 0x86,
-	0xb1, 0xf0, 0x09, 0xe5, 0xec, 0x94, 0x39, 0xda, 0xdb, 0xaf, 0x41, 0x56, 0xf5, 0x86, 0xc9, 0xd5,
-	0x91, 0x1f, 0x5d, 0x57, 0x56, 0x1b, 0x92, 0xd7, 0x6e, 0xe2, 0x55, 0x25, 0x6c, 0xbb, 0xb3, 0xa3,
-	0x69, 0x6a, 0x7e, 0x34, 0x9d, 0x6d, 0xd8, 0xe9, 0x17, 0x69, 0xd8, 0x73, 0x8f, 0xc7, 0xcc, 0x2b,
-	0xbf, 0x79, 0x97, 0x5f, 0xe6, 0xcd, 0x6b, 0xa2, 0xf4, 0x1b, 0x0b, 0xf2, 0x1d, 0xce, 0x1c, 0x6a,
-	0x9a, 0xae, 0x7c, 0xf8, 0x5f, 0xf9, 0xbd, 0xd0, 0x4b, 0x42, 0xae, 0x29, 0xb4, 0x03, 0xe0, 0x0f,
-	0xbc, 0x98, 0x45, 0x1e, 0x1b, 0x37, 0x8e, 0x29, 0x0e, 0x2a, 0x42, 0x2a, 0x1a, 0x1a, 0x30, 0xa7,
-	0xa2, 0xe1, 0x5c, 0x7c, 0x32, 0x2f, 0x12, 0x9f, 0xe7, 0x8f, 0x1b, 0x7b, 0x4f, 0x2d, 0x28, 0x8f,
-	0x87, 0xaa, 0x81, 0x17, 0xcb, 0x9e, 0x4e, 0xe2, 0x01, 0xa7, 0x47, 0x5c, 0x3e, 0x77, 0x5e, 0x7e,
-	0x68, 0x43, 0xfb, 0xb0, 0x9a, 0x4c, 0x98, 0xa9, 0xcf, 0x9d, 0x30, 0x71, 0xa2, 0xf7, 0x30, 0xf3,
-	0xde, 0x87, 0x95, 0xa5, 0x7b, 0xff, 0xb2, 0x60, 0x6d, 0xe6, 0xfa, 0x43, 0x3f, 0x80, 0x0a, 0x6e,
-	0x75, 0x8f, 0x0e, 0x9f, 0xb4, 0xec, 0xee, 0xf1, 0xc1, 0xf1, 0x49, 0xd7, 0x3e, 0xea, 0xb4, 0x1e,
-	0xdb, 0x27, 0x8f, 0xbb, 0x9d, 0x56, 0xa3, 0xfd, 0xa8, 0xdd, 0x6a, 0x6e, 0x2c, 0x95, 0xb7, 0xdf,
-	0xff, 0x60, 0xf7, 0xc6, 0x02, 0x35, 0xf4, 0x5d, 0xb8, 0x39, 0xc7, 0xee, 0x9e, 0x34, 0x1a, 0xad,
-	0x6e, 0x77, 0xc3, 0x2a, 0x97, 0xdf, 0xff, 0x60, 0xf7, 0x3f, 0x48, 0x17, 0xac, 0x7b, 0x74, 0xd0,
-	0x3e, 0x3c, 0xc1, 0xad, 0x8d, 0xd4, 0xc2, 0x75, 0x46, 0xba, 0x60, 0x5d, 0xeb, 0xa7, 0x9d, 0x36,
-	0x6e, 0x35, 0x37, 0xd2, 0x0b, 0xd7, 0x19, 0x69, 0x39, 0xf3, 0xde, 0x47, 0x3b, 0x4b, 0xf7, 0xde,
-	0x81, 0xd5, 0xe4, 0xad, 0xb9, 0x0d, 0x37, 0x5a, 0x8f, 0x1b, 0x47, 0xcd, 0x16, 0x9e, 0x75, 0x15,
-	0x6d, 0xc2, 0x5a, 0x22, 0xe8, 0xe0, 0xa3, 0xe3, 0xa3, 0x0d, 0x0b, 0x6d, 0xc1, 0x46, 0xc2, 0x7a,
-	0x74, 0x72, 0x78, 0x68, 0x1f, 0xd4, 0xdb, 0x1b, 0xa9, 0xe9, 0x1d, 0x3a, 0x07, 0xf8, 0xb8, 0x7d,
-	0xa0, 0x05, 0x69, 0x7d, 0x56, 0xbd, 0xfd, 0xf1, 0x68, 0xc7, 0xfa, 0x64, 0xb4, 0x63, 0xfd, 0x75,
-	0xb4, 0x63, 0x3d, 0x7d, 0xb6, 0xb3, 0xf4, 0xc9, 0xb3, 0x9d, 0xa5, 0x3f, 0x3f, 0xdb, 0x59, 0xfa,
-	0x59, 0xed, 0x0b, 0x0c, 0x03, 0xe6, 0x8f, 0x4c, 0x35, 0x0b, 0xf4, 0x56, 0x94, 0xc6, 0x9b, 0xff,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xb1, 0x6d, 0x99, 0xe4, 0x14, 0x00, 0x00,
+	// 2485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcd, 0x6f, 0x24, 0x47,
+	0x15, 0x77, 0xcf, 0x8c, 0xe7, 0xe3, 0xcd, 0x87, 0xed, 0xb2, 0x63, 0xcf, 0x3a, 0x1b, 0x8f, 0x63,
+	0x12, 0x30, 0x2b, 0x32, 0x13, 0x6f, 0x10, 0xca, 0x6e, 0x40, 0xc2, 0x33, 0x1e, 0x93, 0x21, 0xd6,
+	0xda, 0xea, 0xb1, 0x57, 0x80, 0x84, 0x5a, 0x35, 0xdd, 0x65, 0xbb, 0xe3, 0x9e, 0xee, 0xa6, 0xab,
+	0xc7, 0x1f, 0x7b, 0xe3, 0x16, 0xe5, 0xc2, 0x9e, 0x91, 0x22, 0x45, 0x0a, 0xe2, 0xc0, 0x15, 0xc4,
+	0xbf, 0xc0, 0x72, 0x5b, 0x71, 0x42, 0x42, 0x72, 0xd0, 0xac, 0x40, 0x70, 0xe2, 0xc2, 0x09, 0x2e,
+	0xa8, 0x3e, 0xfa, 0x6b, 0x3c, 0x5e, 0x67, 0xbd, 0xbb, 0x11, 0x70, 0xf2, 0xbc, 0xaf, 0xae, 0x7a,
+	0xaf, 0xde, 0xef, 0xbd, 0x57, 0x65, 0x78, 0xb5, 0x87, 0x6d, 0xa3, 0xe1, 0x78, 0x58, 0xb7, 0x48,
+	0xe3, 0x78, 0x4d, 0xfe, 0xaa, 0xbb, 0x9e, 0xe3, 0x3b, 0xa8, 0xc2, 0x84, 0x75, 0xc9, 0x3a, 0x5e,
+	0x5b, 0x9c, 0x3b, 0x70, 0x0e, 0x1c, 0x2e, 0x6a, 0xb0, 0x5f, 0x42, 0x6b, 0xb1, 0x76, 0xe0, 0x38,
+	0x07, 0x16, 0x69, 0x70, 0xaa, 0x37, 0xd8, 0x6f, 0xf8, 0x66, 0x9f, 0x50, 0x1f, 0xf7, 0x5d, 0xa9,
+	0xb0, 0xa4, 0x3b, 0xb4, 0xef, 0xd0, 0x46, 0x0f, 0x53, 0xb6, 0x46, 0x8f, 0xf8, 0x78, 0xad, 0xa1,
+	0x3b, 0xa6, 0x2d, 0xe5, 0x37, 0x84, 0x5c, 0x13, 0x5f, 0x16, 0x84, 0x10, 0xad, 0xfc, 0x2c, 0x05,
+	0xb0, 0x81, 0x7d, 0xdc, 0x75, 0x06, 0x9e, 0x4e, 0xd0, 0x1c, 0x4c, 0x3a, 0x27, 0x36, 0xf1, 0xaa,
+	0xca, 0xb2, 0xb2, 0x5a, 0x50, 0x05, 0x81, 0x10, 0x64, 0x6c, 0xdc, 0x27, 0xd5, 0x14, 0x67, 0xf2,
+	0xdf, 0x68, 0x19, 0x8a, 0x06, 0xa1, 0xba, 0x67, 0xba, 0xbe, 0xe9, 0xd8, 0xd5, 0x34, 0x17, 0xc5,
+	0x59, 0x68, 0x11, 0xf2, 0xfb, 0xa6, 0x45, 0xb8, 0x65, 0x86, 0x8b, 0x43, 0x9a, 0xc9, 0x7c, 0x8f,
+	0x60, 0x3a, 0xf0, 0xce, 0xaa, 0x93, 0x42, 0x16, 0xd0, 0xe8, 0xc7, 0x90, 0xde, 0x27, 0xa4, 0x9a,
+	0x5d, 0x4e, 0xaf, 0x16, 0x6f, 0xdf, 0xa8, 0xcb, 0xed, 0x32, 0xdf, 0xea, 0xd2, 0xb7, 0x7a, 0xcb,
+	0x31, 0xed, 0xe6, 0xdb, 0x8f, 0xce, 0x6b, 0x13, 0xbf, 0xfa, 0xbc, 0xb6, 0x7a, 0x60, 0xfa, 0x87,
+	0x83, 0x5e, 0x5d, 0x77, 0xfa, 0xd2, 0x37, 0xf9, 0xe7, 0x2d, 0x6a, 0x1c, 0x35, 0xfc, 0x33, 0x97,
+	0x50, 0x6e, 0x40, 0x55, 0xf6, 0x5d, 0x54, 0x85, 0xdc, 0x31, 0xf1, 0x28, 0xdb, 0x74, 0x6e, 0x59,
+	0x59, 0xcd, 0xa8, 0x01, 0x79, 0x37, 0xf3, 0xb7, 0x4f, 0x6b, 0xca, 0xca, 0xdf, 0x15, 0x28, 0x6d,
+	0xf3, 0x13, 0xe9, 0x72, 0x57, 0xbe, 0xb4, 0x98, 0xcc, 0x43, 0x96, 0xea, 0x87, 0xa4, 0x8f, 0x65,
+	0x44, 0x24, 0x85, 0xee, 0xc0, 0x14, 0xe5, 0xa7, 0xa3, 0xe9, 0x8e, 0x41, 0xb4, 0x81, 0x67, 0x55,
+	0xb3, 0x4c, 0xa1, 0x39, 0x33, 0x3c, 0xaf, 0x95, 0xc5, 0xc1, 0xb5, 0x1c, 0x83, 0xec, 0xa9, 0x5b,
+	0x6a, 0x99, 0x46, 0xa4, 0x67, 0x5d, 0xe9, 0xeb, 0xa3, 0x14, 0xcc, 0x44, 0xa7, 0x7f, 0x5f, 0xc8,
+	0xd0, 0x26, 0x54, 0x0c, 0xec, 0x63, 0x4d, 0xae, 0x6a, 0x1a, 0xdc, 0xf3, 0x4c, 0x73, 0x79, 0x78,
+	0x5e, 0x2b, 0x45, 0xea, 0x9d, 0x8d, 0x7f, 0x8d, 0xd0, 0x6a, 0xc9, 0x88, 0x28, 0x23, 0xbe, 0x7a,
+	0x2a, 0xb1, 0x7a, 0x22, 0x0c, 0xe9, 0xa7, 0xa4, 0x46, 0x66, 0x7c, 0x6a, 0x4c, 0xbe, 0xa4, 0xd4,
+	0x98, 0x87, 0xec, 0x21, 0x31, 0x0f, 0x0e, 0x7d, 0x1e, 0xe0, 0xb4, 0x2a, 0x29, 0x74, 0x13, 0x0a,
+	0x21, 0xe4, 0x78, 0x20, 0xd3, 0x6a, 0xc4, 0x90, 0xa1, 0xfc, 0x87, 0x02, 0xb3, 0xf1, 0xb4, 0x09,
+	0x82, 0x79, 0x0f, 0xa6, 0x05, 0xbe, 0x35, 0x91, 0x05, 0x51, 0x38, 0xdf, 0x18, 0x9e, 0xd7, 0x2a,
+	0x71, 0x13, 0x1e, 0xd0, 0x11, 0x8e, 0x5a, 0x71, 0xe2, 0xf4, 0x75, 0x83, 0x1a, 0xe5, 0x56, 0x26,
+	0x91, 0x5b, 0x91, 0xc7, 0x93, 0x97, 0x7b, 0x9c, 0x1d, 0xef, 0xf1, 0x5f, 0x14, 0x00, 0x15, 0x9f,
+	0xa8, 0xe4, 0x27, 0x03, 0x42, 0x7d, 0xf4, 0x1d, 0x28, 0x92, 0x53, 0x9f, 0x78, 0x36, 0xb6, 0x22,
+	0x1f, 0x6f, 0x0e, 0xcf, 0x6b, 0xd0, 0x96, 0x6c, 0xee, 0x5f, 0x8c, 0x52, 0x21, 0x30, 0xe8, 0x18,
+	0x63, 0x92, 0x2e, 0x75, 0xad, 0xa4, 0x5b, 0x84, 0xbc, 0x8e, 0x2d, 0x8b, 0xf1, 0x78, 0x14, 0x4a,
+	0x6a, 0x48, 0xa3, 0x3a, 0xcc, 0xc6, 0xd7, 0x08, 0xe2, 0x98, 0xe1, 0x71, 0x9c, 0x31, 0x46, 0x81,
+	0x20, 0xfd, 0xfc, 0xa9, 0x02, 0x05, 0xee, 0xa7, 0xeb, 0x78, 0xcf, 0xed, 0xe6, 0xab, 0x50, 0x20,
+	0xa7, 0xa6, 0xcf, 0xa1, 0xcc, 0x3d, 0x2c, 0xab, 0x79, 0xc6, 0x60, 0x88, 0x65, 0x35, 0x25, 0xb6,
+	0x6f, 0xfe, 0x5b, 0xee, 0xe1, 0xb7, 0x59, 0xc8, 0x05, 0x81, 0x7e, 0xd1, 0x19, 0x15, 0x8f, 0x58,
+	0x6a, 0x24, 0x62, 0x6b, 0x30, 0xe7, 0x89, 0x65, 0x89, 0xa1, 0x1d, 0x63, 0xcb, 0x34, 0xb0, 0xef,
+	0x78, 0xb4, 0x9a, 0x5e, 0x4e, 0xaf, 0x16, 0xd4, 0xd9, 0x50, 0x76, 0x3f, 0x14, 0x31, 0x0f, 0xfb,
+	0xa6, 0xad, 0xe9, 0xce, 0xc0, 0xf6, 0x65, 0x68, 0xf3, 0x7d, 0xd3, 0x6e, 0x31, 0x1a, 0xbd, 0x09,
+	0x15, 0x69, 0xa3, 0x25, 0xf2, 0xae, 0x2c, 0xb9, 0xef, 0x8b, 0xf4, 0x7b, 0x1d, 0x4a, 0x81, 0x1a,
+	0xcb, 0x3a, 0x99, 0x81, 0x45, 0xc9, 0xdb, 0x35, 0xfb, 0x04, 0x7d, 0x1d, 0x0a, 0xba, 0x65, 0x12,
+	0x9b, 0xbb, 0x9f, 0xe3, 0xf5, 0xb0, 0x34, 0x3c, 0xaf, 0xe5, 0x5b, 0x9c, 0xd9, 0xd9, 0x50, 0xf3,
+	0x42, 0xdc, 0x31, 0x50, 0x0b, 0x4a, 0x1e, 0x3e, 0xd1, 0xa4, 0x35, 0xad, 0xe6, 0x79, 0xf9, 0x58,
+	0xac, 0x27, 0x9b, 0x6f, 0x3d, 0xca, 0xe5, 0x66, 0x86, 0xd5, 0x0f, 0xb5, 0xe8, 0x85, 0x1c, 0x8a,
+	0x3e, 0x80, 0xa2, 0xd9, 0xd3, 0x35, 0xfd, 0x10, 0xdb, 0x36, 0xb1, 0xaa, 0x85, 0x65, 0x65, 0xdc,
+	0x37, 0x3a, 0xcd, 0x56, 0x4b, 0x68, 0x34, 0x2b, 0x2c, 0x27, 0x22, 0x5a, 0x05, 0xb3, 0xa7, 0xcb,
+	0xdf, 0xa8, 0xc6, 0x92, 0x88, 0xe8, 0x03, 0x9f, 0x68, 0x07, 0x98, 0x56, 0x81, 0x47, 0x09, 0x24,
+	0xeb, 0x7b, 0x98, 0xa2, 0xf7, 0xa1, 0xe8, 0x53, 0xaa, 0x11, 0x9b, 0xe5, 0x89, 0x57, 0x2d, 0x2e,
+	0x2b, 0xab, 0x95, 0xdb, 0x0b, 0xa3, 0xab, 0xb5, 0x85, 0x58, 0x2c, 0xb5, 0xdb, 0xed, 0x4a, 0x5a,
+	0x05, 0x9f, 0x52, 0xf9, 0x9b, 0x21, 0x39, 0x38, 0x25, 0xaf, 0x5a, 0xe2, 0xe0, 0x8f, 0x18, 0xe8,
+	0x10, 0x0a, 0xfb, 0x84, 0x68, 0x96, 0xd9, 0x37, 0xfd, 0x6a, 0xf9, 0xc5, 0x97, 0xd5, 0xfc, 0x3e,
+	0x21, 0x5b, 0xec, 0xe3, 0xe8, 0x3d, 0x91, 0x65, 0x3d, 0xac, 0x1f, 0x55, 0x2b, 0x3c, 0x78, 0xb5,
+	0x0b, 0x07, 0x20, 0xb6, 0xd5, 0x92, 0x6a, 0x6a, 0x68, 0x80, 0x6e, 0xc3, 0x2b, 0xc9, 0x94, 0x0f,
+	0xa0, 0x3b, 0xc5, 0x23, 0x37, 0xeb, 0x5c, 0x2c, 0xbc, 0x12, 0x38, 0x1d, 0x98, 0x1a, 0xf9, 0x2c,
+	0xab, 0x79, 0x7d, 0xc7, 0x18, 0x58, 0x44, 0x36, 0x74, 0x49, 0xb1, 0xca, 0xea, 0xe2, 0x33, 0xcb,
+	0xc1, 0x86, 0x84, 0x41, 0x40, 0xca, 0x4f, 0xfd, 0x33, 0x0b, 0xa5, 0xee, 0xa0, 0x17, 0x35, 0xf3,
+	0x55, 0x48, 0x85, 0xd0, 0xab, 0x0e, 0xcf, 0x6b, 0x29, 0x01, 0xb7, 0xb8, 0x4e, 0x67, 0x43, 0x4d,
+	0x99, 0xc6, 0x58, 0xc8, 0xa6, 0x5e, 0x10, 0x64, 0x47, 0x8b, 0xdc, 0xab, 0x50, 0xc0, 0xf4, 0x28,
+	0x89, 0x3f, 0x4c, 0x8f, 0x04, 0xfe, 0x12, 0xe0, 0x9c, 0x1c, 0x01, 0x67, 0x02, 0x52, 0xd9, 0xa7,
+	0x42, 0x2a, 0x91, 0x37, 0xb9, 0x97, 0x99, 0x37, 0x35, 0x28, 0xba, 0x1e, 0x71, 0xb1, 0x27, 0xa0,
+	0x92, 0x17, 0x50, 0x91, 0x2c, 0x06, 0x95, 0x11, 0x2c, 0x15, 0xae, 0xc2, 0x12, 0x5c, 0x1f, 0x4b,
+	0x6f, 0x42, 0xa5, 0x67, 0x39, 0xfa, 0x91, 0x66, 0xda, 0x3e, 0xf1, 0x8e, 0xb1, 0xc5, 0x81, 0x99,
+	0x51, 0xcb, 0x9c, 0xdb, 0x91, 0x4c, 0xf4, 0x15, 0x28, 0xb3, 0xaa, 0x15, 0x69, 0x95, 0xb8, 0x56,
+	0x89, 0x31, 0x43, 0xa5, 0xb7, 0x21, 0xcb, 0xe6, 0x42, 0xe7, 0xa4, 0x5a, 0xe6, 0x91, 0xae, 0xfe,
+	0xe1, 0x37, 0x6f, 0xcd, 0xc9, 0x08, 0xae, 0x1b, 0x86, 0x47, 0x28, 0xed, 0xfa, 0x9e, 0x69, 0x1f,
+	0xa8, 0x52, 0x0f, 0xd5, 0x83, 0x39, 0xb4, 0x72, 0x85, 0x81, 0x50, 0x63, 0x67, 0x6d, 0x52, 0x0d,
+	0xeb, 0xbe, 0x79, 0x4c, 0x38, 0x50, 0xf2, 0x6a, 0xde, 0xa4, 0xeb, 0x9c, 0x46, 0x9b, 0x30, 0x65,
+	0x61, 0xea, 0x07, 0x45, 0x91, 0x9d, 0xf8, 0x34, 0x4f, 0xc8, 0x25, 0x36, 0x54, 0x6e, 0x61, 0xea,
+	0x4b, 0xd8, 0xf0, 0x7c, 0x2c, 0x84, 0x84, 0x5a, 0xb6, 0x62, 0x32, 0x83, 0xb5, 0xd4, 0xc4, 0x77,
+	0x64, 0x55, 0x9f, 0xe1, 0x05, 0x7b, 0x26, 0xa6, 0x2b, 0x2b, 0xfb, 0x2d, 0x98, 0x49, 0xe8, 0xf3,
+	0xf2, 0x8e, 0xb8, 0xf6, 0x54, 0x4c, 0x9b, 0x95, 0x78, 0x09, 0xbb, 0x9f, 0x2b, 0x90, 0x95, 0xbd,
+	0xf7, 0x26, 0x14, 0xc2, 0x1e, 0x24, 0xc1, 0x1b, 0x31, 0xd8, 0xa7, 0x4d, 0x5b, 0xeb, 0x91, 0x7d,
+	0xc7, 0x23, 0x9a, 0x47, 0xa8, 0x63, 0x1d, 0x8b, 0x16, 0x9b, 0x57, 0xa7, 0x4c, 0xbb, 0xc9, 0xf9,
+	0xaa, 0x60, 0xa3, 0xef, 0x42, 0x51, 0xb4, 0x04, 0xf6, 0x5d, 0xd1, 0xce, 0x58, 0x06, 0x8f, 0xeb,
+	0x08, 0x4c, 0x43, 0x36, 0x04, 0xf0, 0x02, 0x06, 0x95, 0x9b, 0xfb, 0x6b, 0x1a, 0x16, 0x04, 0x56,
+	0xe5, 0xc6, 0x77, 0xb0, 0x7e, 0x44, 0x7c, 0x36, 0x9f, 0x24, 0xe1, 0xa4, 0x3c, 0x15, 0x4e, 0xff,
+	0x1b, 0xf5, 0x21, 0x01, 0xfa, 0xec, 0x97, 0x08, 0xfa, 0xdc, 0x55, 0xa0, 0xcf, 0x5f, 0x05, 0xfa,
+	0xc2, 0xb5, 0x41, 0x2f, 0x0f, 0x9a, 0xc0, 0xca, 0x98, 0x73, 0x5e, 0xd7, 0x8f, 0x6c, 0xe7, 0xc4,
+	0x22, 0xc6, 0x01, 0xe9, 0x13, 0xdb, 0x47, 0x77, 0x00, 0x62, 0x80, 0x12, 0x9d, 0x61, 0x71, 0x18,
+	0xc7, 0x4f, 0x12, 0x4c, 0x41, 0x27, 0xee, 0x04, 0x3d, 0xe6, 0x77, 0x29, 0xa8, 0x06, 0xeb, 0x50,
+	0xd7, 0xb1, 0x29, 0xb9, 0x5e, 0x42, 0x25, 0x37, 0x92, 0x7a, 0x86, 0x8d, 0xf0, 0xfc, 0xb0, 0xa9,
+	0x4c, 0x81, 0xb4, 0xcc, 0x0f, 0x9b, 0x8a, 0x14, 0x18, 0x1d, 0xcc, 0x32, 0x17, 0x07, 0x33, 0xae,
+	0xc2, 0x51, 0x26, 0x54, 0x26, 0x03, 0x15, 0xce, 0xe3, 0x2a, 0x1b, 0x50, 0x91, 0xa4, 0x46, 0x7d,
+	0xec, 0x0f, 0x28, 0xef, 0x36, 0x95, 0xdb, 0xaf, 0x5d, 0x9c, 0x08, 0xb8, 0x56, 0x97, 0x2b, 0xb1,
+	0x21, 0x31, 0x46, 0xb2, 0x3e, 0xee, 0x11, 0x3a, 0xb0, 0x7c, 0x9e, 0x1f, 0x25, 0x55, 0x52, 0x32,
+	0x92, 0x7f, 0x4a, 0xb3, 0xb2, 0xc1, 0x18, 0xff, 0x7f, 0x40, 0x4c, 0x9e, 0x6e, 0xf6, 0xda, 0xa7,
+	0x9b, 0xbb, 0xe2, 0x74, 0xf3, 0x57, 0x9f, 0x6e, 0xe1, 0x8b, 0x9c, 0x2e, 0x3c, 0xd7, 0xe9, 0x16,
+	0xc7, 0x9c, 0xee, 0x2f, 0x95, 0x70, 0xae, 0x93, 0xf3, 0x1e, 0xbd, 0x7c, 0x48, 0x54, 0x2e, 0x1d,
+	0x12, 0xd1, 0x0f, 0x61, 0x2e, 0x76, 0x35, 0x08, 0x2c, 0x68, 0x35, 0xc5, 0xab, 0xdb, 0xeb, 0x97,
+	0x5f, 0x11, 0xe4, 0x07, 0x64, 0x63, 0x40, 0xde, 0xa8, 0x20, 0x68, 0x10, 0x8f, 0x15, 0x98, 0xb9,
+	0x60, 0xf5, 0xdf, 0x72, 0x57, 0xbe, 0xe4, 0x3e, 0x9c, 0x7e, 0xfa, 0x7d, 0xf8, 0xf7, 0x0a, 0x94,
+	0xbb, 0xe6, 0x81, 0xcd, 0x46, 0x0d, 0x01, 0xb0, 0x0f, 0x01, 0xa8, 0x60, 0x44, 0xde, 0x7c, 0xc0,
+	0xf2, 0x51, 0xaa, 0xf1, 0x8d, 0xdc, 0x8d, 0xf5, 0x01, 0x16, 0x56, 0xfe, 0xfe, 0xa8, 0x3b, 0x56,
+	0x43, 0x3f, 0xc4, 0xa6, 0xdd, 0x38, 0x7e, 0xa7, 0x71, 0xca, 0xf9, 0x3e, 0xa5, 0xb2, 0x2b, 0x84,
+	0xd6, 0x6a, 0x41, 0x7e, 0xbe, 0x63, 0xa0, 0xaf, 0xc1, 0x14, 0xf1, 0x3c, 0xc7, 0xe3, 0x37, 0x68,
+	0xea, 0x62, 0x3d, 0x78, 0x82, 0xab, 0x70, 0x76, 0x2b, 0xe0, 0xa2, 0xd7, 0x00, 0x22, 0x45, 0xe9,
+	0x53, 0x21, 0xd4, 0x91, 0xbe, 0xb8, 0x30, 0x15, 0x5e, 0x5d, 0x65, 0xe2, 0x25, 0xc6, 0x26, 0x65,
+	0x64, 0x6c, 0xba, 0x0b, 0x93, 0xd4, 0xb4, 0xe5, 0x9a, 0xec, 0xfe, 0x27, 0x9e, 0x66, 0xeb, 0xc1,
+	0xd3, 0x6c, 0x7d, 0x37, 0x78, 0x24, 0x69, 0xe6, 0x59, 0x66, 0x3c, 0xfc, 0xbc, 0xa6, 0xa8, 0xc2,
+	0x44, 0xae, 0xb8, 0x0e, 0x53, 0xe2, 0x5b, 0xe1, 0xba, 0xec, 0xe2, 0x81, 0xc5, 0x00, 0x27, 0x87,
+	0x9a, 0x80, 0x64, 0x4f, 0x8f, 0xae, 0x73, 0x42, 0x3c, 0xf9, 0xd4, 0x23, 0x88, 0x95, 0x5f, 0xe4,
+	0x20, 0xbb, 0x83, 0x3d, 0xdc, 0xa7, 0x68, 0x0d, 0x5e, 0xe9, 0xe3, 0x53, 0x2d, 0x9e, 0xc3, 0x02,
+	0xda, 0x22, 0xe7, 0x51, 0x1f, 0x9f, 0x46, 0xd9, 0x27, 0x40, 0xbe, 0x02, 0x65, 0x66, 0x12, 0x95,
+	0x1e, 0xf1, 0xed, 0x62, 0x1f, 0x9f, 0xae, 0x07, 0xd5, 0xe7, 0x16, 0xcc, 0x30, 0x9d, 0xa0, 0x54,
+	0x69, 0xd4, 0x7c, 0x10, 0x84, 0x70, 0xaa, 0x8f, 0x4f, 0x5b, 0x92, 0xdf, 0x35, 0x1f, 0x10, 0xd4,
+	0x80, 0x39, 0xbe, 0x05, 0x3e, 0x17, 0x69, 0x91, 0xba, 0x7c, 0x55, 0x61, 0x3b, 0xe0, 0xa2, 0x8d,
+	0xc0, 0xe0, 0x9b, 0x30, 0x4f, 0x4e, 0x5d, 0xd3, 0xc3, 0xec, 0x82, 0xa4, 0x89, 0x81, 0x3a, 0x5e,
+	0xe7, 0xe6, 0x22, 0x69, 0x93, 0x09, 0xc5, 0x96, 0xde, 0x80, 0x0a, 0x9b, 0x31, 0x34, 0xe7, 0x04,
+	0xd3, 0x3e, 0x6f, 0xfa, 0x59, 0x31, 0x55, 0x33, 0xee, 0x36, 0x63, 0xb2, 0xb6, 0x7f, 0x07, 0x6e,
+	0xb8, 0xc4, 0x8b, 0x5e, 0x2a, 0xc2, 0xa8, 0x44, 0x63, 0xc4, 0xbc, 0x4b, 0xbc, 0x30, 0xf6, 0x32,
+	0x32, 0xcc, 0xf4, 0x1b, 0x80, 0x28, 0xee, 0xbb, 0x16, 0xcb, 0x62, 0xdf, 0x3b, 0x93, 0x5b, 0x12,
+	0x93, 0xc5, 0x74, 0x20, 0xd9, 0xf5, 0xce, 0xc4, 0x76, 0xde, 0x85, 0xaa, 0x2c, 0x36, 0x1e, 0x39,
+	0xc1, 0x9e, 0xa1, 0xb9, 0xc4, 0xd3, 0x89, 0xed, 0xe3, 0x03, 0x22, 0xaf, 0x20, 0xf3, 0x8e, 0xec,
+	0xe3, 0x4c, 0xbc, 0x13, 0x4a, 0xd1, 0x5d, 0xb8, 0x61, 0xda, 0x22, 0xbd, 0x34, 0x97, 0xd8, 0xd8,
+	0xf2, 0xcf, 0x34, 0x63, 0x20, 0xfc, 0x95, 0x2f, 0x01, 0x0b, 0x81, 0xc2, 0x8e, 0x90, 0x6f, 0x48,
+	0x31, 0x6a, 0xc3, 0x2c, 0x7b, 0x84, 0x08, 0x9c, 0x22, 0x36, 0xee, 0x59, 0xc4, 0xe0, 0x15, 0x32,
+	0xdf, 0x7c, 0x65, 0x78, 0x5e, 0x9b, 0xe9, 0x34, 0x5b, 0xd2, 0xa7, 0xb6, 0x10, 0xaa, 0x33, 0x66,
+	0x4f, 0x4f, 0xb2, 0xd0, 0x03, 0x58, 0x76, 0x3d, 0x53, 0x67, 0x7b, 0xdf, 0x27, 0x1e, 0xb1, 0x75,
+	0xa2, 0x5d, 0xe8, 0x7a, 0xfc, 0xce, 0xd2, 0xbc, 0x3d, 0x3c, 0xaf, 0xdd, 0xdc, 0x61, 0xba, 0x6a,
+	0xa0, 0x7a, 0x65, 0x0f, 0xbc, 0xe9, 0x5e, 0xae, 0x6f, 0xa0, 0x63, 0x98, 0xa6, 0xb1, 0x0b, 0xb2,
+	0xc6, 0xde, 0x73, 0x5f, 0xc2, 0xc3, 0xc3, 0x54, 0x7c, 0x91, 0x4d, 0x42, 0xd0, 0xdb, 0x22, 0x4d,
+	0x13, 0x6b, 0xb3, 0xa4, 0xa8, 0x84, 0x40, 0x89, 0xdf, 0xdb, 0x59, 0x42, 0xbc, 0x0b, 0x55, 0x66,
+	0x21, 0x8f, 0x2a, 0x6e, 0x48, 0xe5, 0xbb, 0xc3, 0x3c, 0xc3, 0x0c, 0x17, 0xc7, 0x6d, 0x83, 0xd2,
+	0xff, 0x1e, 0xa0, 0x1d, 0x62, 0x1b, 0xa2, 0x4c, 0xb2, 0xce, 0xb6, 0x65, 0x52, 0x3e, 0xda, 0x46,
+	0xbd, 0x9b, 0x01, 0x3e, 0xcd, 0x26, 0xd7, 0xb0, 0x41, 0x07, 0xc6, 0xdf, 0x87, 0xd8, 0xdb, 0x11,
+	0x5a, 0x80, 0x1c, 0x47, 0x57, 0x30, 0xbf, 0xa8, 0x59, 0x46, 0x76, 0x0c, 0x56, 0xe4, 0xe4, 0x8b,
+	0x54, 0xd0, 0x05, 0x0a, 0x6a, 0x41, 0x72, 0xc2, 0xa1, 0xf2, 0xb3, 0x14, 0xcc, 0x46, 0x0d, 0xc8,
+	0xdc, 0x37, 0x75, 0x91, 0x4d, 0x5f, 0x85, 0x3c, 0xaf, 0xbd, 0xd1, 0x58, 0x54, 0x1c, 0x9e, 0xd7,
+	0x72, 0x2d, 0xc6, 0xeb, 0x6c, 0xa8, 0x39, 0x2e, 0xec, 0x18, 0xc9, 0x6b, 0x57, 0x6a, 0xf4, 0xda,
+	0x95, 0x1c, 0x46, 0xd2, 0xcf, 0x32, 0x8c, 0x8c, 0xb4, 0xc1, 0xcc, 0x73, 0xb7, 0xc1, 0xc9, 0xeb,
+	0xb4, 0x41, 0x19, 0xa5, 0x5f, 0x2b, 0x50, 0x94, 0x99, 0xce, 0x9b, 0x1a, 0x7b, 0x32, 0x3f, 0xeb,
+	0xf7, 0x1c, 0x2b, 0x08, 0xb9, 0xa0, 0xd0, 0x12, 0x40, 0x7f, 0x60, 0xf9, 0xa6, 0x6b, 0x99, 0x61,
+	0x61, 0x8e, 0x71, 0x50, 0x05, 0x52, 0xee, 0xa9, 0x2c, 0x96, 0x29, 0xf7, 0x74, 0x24, 0x3e, 0x99,
+	0x67, 0x89, 0xcf, 0xd5, 0xa3, 0xf4, 0xca, 0x43, 0x05, 0x16, 0xc3, 0x0b, 0xc3, 0xc0, 0xf2, 0x59,
+	0xcf, 0xc4, 0xfe, 0xc0, 0x23, 0xdb, 0x1e, 0x7b, 0xb1, 0xb8, 0xfe, 0x85, 0x04, 0xad, 0x41, 0x2e,
+	0xb8, 0x3d, 0xa5, 0x9e, 0x7a, 0x7b, 0x52, 0x03, 0xbd, 0xbb, 0x99, 0x8f, 0x3e, 0xad, 0x4d, 0xdc,
+	0xfa, 0xb7, 0x02, 0xe5, 0xc4, 0x68, 0x87, 0xbe, 0x0d, 0x35, 0xb5, 0xdd, 0xdd, 0xde, 0xba, 0xdf,
+	0xd6, 0xba, 0xbb, 0xeb, 0xbb, 0x7b, 0x5d, 0x6d, 0x7b, 0xa7, 0x7d, 0x4f, 0xdb, 0xbb, 0xd7, 0xdd,
+	0x69, 0xb7, 0x3a, 0x9b, 0x9d, 0xf6, 0xc6, 0xf4, 0xc4, 0xe2, 0xc2, 0xc7, 0x9f, 0x2c, 0xcf, 0x8e,
+	0x51, 0x43, 0xdf, 0x82, 0xf9, 0x11, 0x76, 0x77, 0xaf, 0xd5, 0x6a, 0x77, 0xbb, 0xd3, 0xca, 0xe2,
+	0xe2, 0xc7, 0x9f, 0x2c, 0x5f, 0x22, 0x1d, 0x63, 0xb7, 0xb9, 0xde, 0xd9, 0xda, 0x53, 0xdb, 0xd3,
+	0xa9, 0xb1, 0x76, 0x52, 0x3a, 0xc6, 0xae, 0xfd, 0x83, 0x9d, 0x8e, 0xda, 0xde, 0x98, 0x4e, 0x8f,
+	0xb5, 0x93, 0xd2, 0xc5, 0xcc, 0x47, 0x9f, 0x2d, 0x4d, 0xdc, 0xfa, 0x10, 0x72, 0xc1, 0x73, 0xd1,
+	0x02, 0xcc, 0xb6, 0xef, 0xb5, 0xb6, 0x37, 0xda, 0x6a, 0xd2, 0x55, 0x34, 0x03, 0xe5, 0x40, 0xb0,
+	0xa3, 0x6e, 0xef, 0x6e, 0x4f, 0x2b, 0x68, 0x0e, 0xa6, 0x03, 0xd6, 0xe6, 0xde, 0xd6, 0x96, 0xb6,
+	0xde, 0xec, 0x4c, 0xa7, 0xe2, 0x5f, 0xd8, 0x59, 0x57, 0x77, 0x3b, 0xeb, 0x42, 0x90, 0x16, 0x6b,
+	0x35, 0x3b, 0x8f, 0x86, 0x4b, 0xca, 0xe3, 0xe1, 0x92, 0xf2, 0xe7, 0xe1, 0x92, 0xf2, 0xf0, 0xc9,
+	0xd2, 0xc4, 0xe3, 0x27, 0x4b, 0x13, 0x7f, 0x7c, 0xb2, 0x34, 0xf1, 0xa3, 0xc6, 0x17, 0x18, 0xb6,
+	0xe4, 0xff, 0xa4, 0x79, 0xd5, 0xec, 0x65, 0xb9, 0xc6, 0x3b, 0xff, 0x19, 0x00, 0x5c, 0x28, 0x75,
+	0x00, 0xaf, 0x1e, 0x00, 0x00,
 }
 
 func (this *DataSource) Equal(that interface{}) bool {
@@ -1910,6 +2624,9 @@ func (this *DataSource) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Version != that1.Version {
+		return false
+	}
 	return true
 }
 func (this *OracleScript) Equal(that interface{}) bool {
@@ -1949,6 +2666,95 @@ func (this *OracleScript) Equal(that interface{}) bool {
 	if this.SourceCodeURL != that1.SourceCodeURL {
 		return false
 	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (this *DataSourceVersion) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataSourceVersion)
+	if !ok {
+		that2, ok := that.(DataSourceVersion)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DataSourceID != that1.DataSourceID {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Filename != that1.Filename {
+		return false
+	}
+	if this.Treasury != that1.Treasury {
+		return false
+	}
+	if len(this.Fee) != len(that1.Fee) {
+		return false
+	}
+	for i := range this.Fee {
+		if !this.Fee[i].Equal(&that1.Fee[i]) {
+			return false
+		}
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	return true
+}
+func (this *OracleScriptVersion) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleScriptVersion)
+	if !ok {
+		that2, ok := that.(OracleScriptVersion)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Filename != that1.Filename {
+		return false
+	}
+	if this.Schema != that1.Schema {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
 	return true
 }
 func (this *RawRequest) Equal(that interface{}) bool {
@@ -1979,6 +2785,9 @@ func (this *RawRequest) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Calldata, that1.Calldata) {
 		return false
 	}
+	if this.DataSourceVersion != that1.DataSourceVersion {
+		return false
+	}
 	return true
 }
 func (this *RawReport) Equal(that interface{}) bool {
@@ -2084,16 +2893,22 @@ func (this *Request) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.Callback.Equal(that1.Callback) {
+		return false
+	}
+	if this.OracleScriptVersion != that1.OracleScriptVersion {
+		return false
+	}
 	return true
 }
-func (this *Report) Equal(that interface{}) bool {
+func (this *RequestCallback) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Report)
+	that1, ok := that.(*RequestCallback)
 	if !ok {
-		that2, ok := that.(Report)
+		that2, ok := that.(RequestCallback)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2105,30 +2920,22 @@ func (this *Report) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Validator != that1.Validator {
-		return false
-	}
-	if this.InBeforeResolve != that1.InBeforeResolve {
+	if this.Module != that1.Module {
 		return false
 	}
-	if len(this.RawReports) != len(that1.RawReports) {
+	if !bytes.Equal(this.Payload, that1.Payload) {
 		return false
 	}
-	for i := range this.RawReports {
-		if !this.RawReports[i].Equal(&that1.RawReports[i]) {
-			return false
-		}
-	}
 	return true
 }
-func (this *OracleRequestPacketData) Equal(that interface{}) bool {
+func (this *Subscription) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*OracleRequestPacketData)
+	that1, ok := that.(*Subscription)
 	if !ok {
-		that2, ok := that.(OracleRequestPacketData)
+		that2, ok := that.(Subscription)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2140,7 +2947,7 @@ func (this *OracleRequestPacketData) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ClientID != that1.ClientID {
+	if this.ID != that1.ID {
 		return false
 	}
 	if this.OracleScriptID != that1.OracleScriptID {
@@ -2155,6 +2962,9 @@ func (this *OracleRequestPacketData) Equal(that interface{}) bool {
 	if this.MinCount != that1.MinCount {
 		return false
 	}
+	if this.ClientID != that1.ClientID {
+		return false
+	}
 	if len(this.FeeLimit) != len(that1.FeeLimit) {
 		return false
 	}
@@ -2172,16 +2982,40 @@ func (this *OracleRequestPacketData) Equal(that interface{}) bool {
 	if this.TSSEncoder != that1.TSSEncoder {
 		return false
 	}
+	if this.BlockInterval != that1.BlockInterval {
+		return false
+	}
+	if this.TimeInterval != that1.TimeInterval {
+		return false
+	}
+	if this.Escrow != that1.Escrow {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.IsActive != that1.IsActive {
+		return false
+	}
+	if this.LastRequestID != that1.LastRequestID {
+		return false
+	}
+	if this.LastRequestHeight != that1.LastRequestHeight {
+		return false
+	}
+	if this.LastRequestTime != that1.LastRequestTime {
+		return false
+	}
 	return true
 }
-func (this *OracleRequestPacketAcknowledgement) Equal(that interface{}) bool {
+func (this *Report) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*OracleRequestPacketAcknowledgement)
+	that1, ok := that.(*Report)
 	if !ok {
-		that2, ok := that.(OracleRequestPacketAcknowledgement)
+		that2, ok := that.(Report)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2193,14 +3027,102 @@ func (this *OracleRequestPacketAcknowledgement) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.RequestID != that1.RequestID {
+	if this.Validator != that1.Validator {
 		return false
 	}
-	return true
-}
-func (this *OracleResponsePacketData) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if this.InBeforeResolve != that1.InBeforeResolve {
+		return false
+	}
+	if len(this.RawReports) != len(that1.RawReports) {
+		return false
+	}
+	for i := range this.RawReports {
+		if !this.RawReports[i].Equal(&that1.RawReports[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *OracleRequestPacketData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleRequestPacketData)
+	if !ok {
+		that2, ok := that.(OracleRequestPacketData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ClientID != that1.ClientID {
+		return false
+	}
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
+	}
+	if !bytes.Equal(this.Calldata, that1.Calldata) {
+		return false
+	}
+	if this.AskCount != that1.AskCount {
+		return false
+	}
+	if this.MinCount != that1.MinCount {
+		return false
+	}
+	if len(this.FeeLimit) != len(that1.FeeLimit) {
+		return false
+	}
+	for i := range this.FeeLimit {
+		if !this.FeeLimit[i].Equal(&that1.FeeLimit[i]) {
+			return false
+		}
+	}
+	if this.PrepareGas != that1.PrepareGas {
+		return false
+	}
+	if this.ExecuteGas != that1.ExecuteGas {
+		return false
+	}
+	if this.TSSEncoder != that1.TSSEncoder {
+		return false
+	}
+	return true
+}
+func (this *OracleRequestPacketAcknowledgement) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleRequestPacketAcknowledgement)
+	if !ok {
+		that2, ok := that.(OracleRequestPacketAcknowledgement)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RequestID != that1.RequestID {
+		return false
+	}
+	return true
+}
+func (this *OracleResponsePacketData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
 
 	that1, ok := that.(*OracleResponsePacketData)
@@ -2294,6 +3216,68 @@ func (this *Result) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RequestVersions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RequestVersions)
+	if !ok {
+		that2, ok := that.(RequestVersions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OracleScriptVersion != that1.OracleScriptVersion {
+		return false
+	}
+	if len(this.RawRequestVersions) != len(that1.RawRequestVersions) {
+		return false
+	}
+	for i := range this.RawRequestVersions {
+		if !this.RawRequestVersions[i].Equal(&that1.RawRequestVersions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RawRequestVersion) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RawRequestVersion)
+	if !ok {
+		that2, ok := that.(RawRequestVersion)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ExternalID != that1.ExternalID {
+		return false
+	}
+	if this.DataSourceID != that1.DataSourceID {
+		return false
+	}
+	if this.DataSourceVersion != that1.DataSourceVersion {
+		return false
+	}
+	return true
+}
 func (this *SigningResult) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2403,6 +3387,23 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.IBCRequestEnabled != that1.IBCRequestEnabled {
 		return false
 	}
+	if this.PriceReferenceOracleScriptID != that1.PriceReferenceOracleScriptID {
+		return false
+	}
+	if len(this.SubscriptionFee) != len(that1.SubscriptionFee) {
+		return false
+	}
+	for i := range this.SubscriptionFee {
+		if !this.SubscriptionFee[i].Equal(&that1.SubscriptionFee[i]) {
+			return false
+		}
+	}
+	if this.MaxSubscriptionGas != that1.MaxSubscriptionGas {
+		return false
+	}
+	if this.MaxActiveSubscriptions != that1.MaxActiveSubscriptions {
+		return false
+	}
 	return true
 }
 func (this *PendingResolveList) Equal(that interface{}) bool {
@@ -2517,6 +3518,11 @@ func (m *DataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Version != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.Fee) > 0 {
 		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2589,6 +3595,11 @@ func (m *OracleScript) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Version != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.SourceCodeURL) > 0 {
 		i -= len(m.SourceCodeURL)
 		copy(dAtA[i:], m.SourceCodeURL)
@@ -2634,7 +3645,7 @@ func (m *OracleScript) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RawRequest) Marshal() (dAtA []byte, err error) {
+func (m *DataSourceVersion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2644,37 +3655,68 @@ func (m *RawRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RawRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DataSourceVersion) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DataSourceVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Calldata) > 0 {
-		i -= len(m.Calldata)
-		copy(dAtA[i:], m.Calldata)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Calldata)))
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Filename)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.DataSourceID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceID))
+	if m.Version != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Version))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.ExternalID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ExternalID))
+	if m.DataSourceID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RawReport) Marshal() (dAtA []byte, err error) {
+func (m *OracleScriptVersion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2684,37 +3726,54 @@ func (m *RawReport) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RawReport) MarshalTo(dAtA []byte) (int, error) {
+func (m *OracleScriptVersion) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RawReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OracleScriptVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Data)))
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Filename)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.ExitCode != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ExitCode))
+	if m.Version != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Version))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.ExternalID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ExternalID))
+	if m.OracleScriptID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Request) Marshal() (dAtA []byte, err error) {
+func (m *RawRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2724,34 +3783,136 @@ func (m *Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *RawRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.FeeLimit) > 0 {
-		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6a
-		}
-	}
-	if len(m.Requester) > 0 {
-		i -= len(m.Requester)
-		copy(dAtA[i:], m.Requester)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Requester)))
+	if m.DataSourceVersion != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DataSourceID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExternalID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExternalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RawReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RawReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RawReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExitCode != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExitCode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExternalID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExternalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OracleScriptVersion != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptVersion))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Callback != nil {
+		{
+			size, err := m.Callback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.FeeLimit) > 0 {
+		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Requester)))
 		i--
 		dAtA[i] = 0x62
 	}
@@ -2837,6 +3998,184 @@ func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RequestCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Subscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Subscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Subscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastRequestTime != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastRequestTime))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.LastRequestHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastRequestHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.LastRequestID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastRequestID))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Escrow) > 0 {
+		i -= len(m.Escrow)
+		copy(dAtA[i:], m.Escrow)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Escrow)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.TimeInterval != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TimeInterval))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.BlockInterval != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.BlockInterval))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TSSEncoder != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TSSEncoder))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ExecuteGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExecuteGas))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PrepareGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PrepareGas))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.FeeLimit) > 0 {
+		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MinCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AskCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AskCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OracleScriptID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Report) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3146,7 +4485,7 @@ func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SigningResult) Marshal() (dAtA []byte, err error) {
+func (m *RequestVersions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3156,20 +4495,100 @@ func (m *SigningResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SigningResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestVersions) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SigningResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RequestVersions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ErrorCode != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ErrorCode))
-		i--
-		dAtA[i] = 0x18
+	if len(m.RawRequestVersions) > 0 {
+		for iNdEx := len(m.RawRequestVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RawRequestVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.OracleScriptVersion != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RawRequestVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RawRequestVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RawRequestVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DataSourceVersion != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DataSourceID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExternalID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExternalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ErrorCode != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ErrorCode))
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.ErrorCodespace) > 0 {
 		i -= len(m.ErrorCodespace)
@@ -3206,12 +4625,12 @@ func (m *ValidatorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Since, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Since):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Since, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Since):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintOracle(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x12
 	if m.IsActive {
@@ -3282,6 +4701,35 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxActiveSubscriptions != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxActiveSubscriptions))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxSubscriptionGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxSubscriptionGas))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.SubscriptionFee) > 0 {
+		for iNdEx := len(m.SubscriptionFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubscriptionFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.PriceReferenceOracleScriptID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PriceReferenceOracleScriptID))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.IBCRequestEnabled {
 		i--
 		if m.IBCRequestEnabled {
@@ -3366,20 +4814,20 @@ func (m *PendingResolveList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.RequestIds) > 0 {
-		dAtA4 := make([]byte, len(m.RequestIds)*10)
-		var j3 int
+		dAtA5 := make([]byte, len(m.RequestIds)*10)
+		var j4 int
 		for _, num := range m.RequestIds {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j4++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintOracle(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintOracle(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -3601,6 +5049,9 @@ func (m *DataSource) Size() (n int) {
 			n += 1 + l + sovOracle(uint64(l))
 		}
 	}
+	if m.Version != 0 {
+		n += 1 + sovOracle(uint64(m.Version))
+	}
 	return n
 }
 
@@ -3634,6 +5085,73 @@ func (m *OracleScript) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
+	if m.Version != 0 {
+		n += 1 + sovOracle(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *DataSourceVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataSourceID != 0 {
+		n += 1 + sovOracle(uint64(m.DataSourceID))
+	}
+	if m.Version != 0 {
+		n += 1 + sovOracle(uint64(m.Version))
+	}
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovOracle(uint64(m.Height))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *OracleScriptVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScriptID != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptID))
+	}
+	if m.Version != 0 {
+		n += 1 + sovOracle(uint64(m.Version))
+	}
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovOracle(uint64(m.Height))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
 	return n
 }
 
@@ -3653,6 +5171,9 @@ func (m *RawRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
+	if m.DataSourceVersion != 0 {
+		n += 1 + sovOracle(uint64(m.DataSourceVersion))
+	}
 	return n
 }
 
@@ -3733,6 +5254,100 @@ func (m *Request) Size() (n int) {
 			n += 1 + l + sovOracle(uint64(l))
 		}
 	}
+	if m.Callback != nil {
+		l = m.Callback.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.OracleScriptVersion != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptVersion))
+	}
+	return n
+}
+
+func (m *RequestCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *Subscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovOracle(uint64(m.ID))
+	}
+	if m.OracleScriptID != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptID))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.AskCount != 0 {
+		n += 1 + sovOracle(uint64(m.AskCount))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovOracle(uint64(m.MinCount))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.FeeLimit) > 0 {
+		for _, e := range m.FeeLimit {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.PrepareGas != 0 {
+		n += 1 + sovOracle(uint64(m.PrepareGas))
+	}
+	if m.ExecuteGas != 0 {
+		n += 1 + sovOracle(uint64(m.ExecuteGas))
+	}
+	if m.TSSEncoder != 0 {
+		n += 1 + sovOracle(uint64(m.TSSEncoder))
+	}
+	if m.BlockInterval != 0 {
+		n += 1 + sovOracle(uint64(m.BlockInterval))
+	}
+	if m.TimeInterval != 0 {
+		n += 1 + sovOracle(uint64(m.TimeInterval))
+	}
+	l = len(m.Escrow)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.IsActive {
+		n += 2
+	}
+	if m.LastRequestID != 0 {
+		n += 2 + sovOracle(uint64(m.LastRequestID))
+	}
+	if m.LastRequestHeight != 0 {
+		n += 2 + sovOracle(uint64(m.LastRequestHeight))
+	}
+	if m.LastRequestTime != 0 {
+		n += 2 + sovOracle(uint64(m.LastRequestTime))
+	}
 	return n
 }
 
@@ -3888,6 +5503,42 @@ func (m *Result) Size() (n int) {
 	return n
 }
 
+func (m *RequestVersions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScriptVersion != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptVersion))
+	}
+	if len(m.RawRequestVersions) > 0 {
+		for _, e := range m.RawRequestVersions {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RawRequestVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExternalID != 0 {
+		n += 1 + sovOracle(uint64(m.ExternalID))
+	}
+	if m.DataSourceID != 0 {
+		n += 1 + sovOracle(uint64(m.DataSourceID))
+	}
+	if m.DataSourceVersion != 0 {
+		n += 1 + sovOracle(uint64(m.DataSourceVersion))
+	}
+	return n
+}
+
 func (m *SigningResult) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3976,6 +5627,21 @@ func (m *Params) Size() (n int) {
 	if m.IBCRequestEnabled {
 		n += 2
 	}
+	if m.PriceReferenceOracleScriptID != 0 {
+		n += 1 + sovOracle(uint64(m.PriceReferenceOracleScriptID))
+	}
+	if len(m.SubscriptionFee) > 0 {
+		for _, e := range m.SubscriptionFee {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.MaxSubscriptionGas != 0 {
+		n += 1 + sovOracle(uint64(m.MaxSubscriptionGas))
+	}
+	if m.MaxActiveSubscriptions != 0 {
+		n += 1 + sovOracle(uint64(m.MaxActiveSubscriptions))
+	}
 	return n
 }
 
@@ -4307,11 +5973,30 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOracle
@@ -4361,7 +6046,1189 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataSourceVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataSourceVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataSourceVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
+			}
+			m.DataSourceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSourceID |= DataSourceID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleScriptVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleScriptVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleScriptVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			}
+			m.OracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
+			}
+			m.ExternalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalID |= ExternalID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
+			}
+			m.DataSourceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSourceID |= DataSourceID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceVersion", wireType)
+			}
+			m.DataSourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
+			}
+			m.ExternalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalID |= ExternalID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			}
+			m.OracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestedValidators = append(m.RequestedValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
+			}
+			m.RequestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+			}
+			m.RequestTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawRequests = append(m.RawRequests, RawRequest{})
+			if err := m.RawRequests[len(m.RawRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCChannel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IBCChannel == nil {
+				m.IBCChannel = &IBCChannel{}
+			}
+			if err := m.IBCChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+			}
+			m.ExecuteGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4371,29 +7238,16 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExecuteGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
 			}
-			var stringLen uint64
+			m.TSSEncoder = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4403,27 +7257,14 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TSSEncoder |= Encoder(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4451,13 +7292,13 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Requester = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4467,29 +7308,31 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filename = string(dAtA[iNdEx:postIndex])
+			m.FeeLimit = append(m.FeeLimit, types.Coin{})
+			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4499,29 +7342,33 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
+			if m.Callback == nil {
+				m.Callback = &RequestCallback{}
+			}
+			if err := m.Callback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
 			}
-			var stringLen uint64
+			m.OracleScriptVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4531,24 +7378,11 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OracleScriptVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -4570,7 +7404,7 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawRequest) Unmarshal(dAtA []byte) error {
+func (m *RequestCallback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4593,17 +7427,17 @@ func (m *RawRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestCallback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestCallback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 			}
-			m.ExternalID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4613,33 +7447,27 @@ func (m *RawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExternalID |= ExternalID(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.DataSourceID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataSourceID |= DataSourceID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4666,9 +7494,9 @@ func (m *RawRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Calldata == nil {
-				m.Calldata = []byte{}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4692,7 +7520,7 @@ func (m *RawRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawReport) Unmarshal(dAtA []byte) error {
+func (m *Subscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4715,17 +7543,17 @@ func (m *RawReport) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawReport: wiretype end group for non-group")
+			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawReport: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.ExternalID = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4735,16 +7563,16 @@ func (m *RawReport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExternalID |= ExternalID(b&0x7F) << shift
+				m.ID |= SubscriptionID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
 			}
-			m.ExitCode = 0
+			m.OracleScriptID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4754,14 +7582,14 @@ func (m *RawReport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExitCode |= uint32(b&0x7F) << shift
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4788,66 +7616,35 @@ func (m *RawReport) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Request) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
 			}
-			m.OracleScriptID = 0
+			m.MinCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4857,16 +7654,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				m.MinCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4876,31 +7673,29 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Calldata == nil {
-				m.Calldata = []byte{}
-			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4910,29 +7705,31 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestedValidators = append(m.RequestedValidators, string(dAtA[iNdEx:postIndex]))
+			m.FeeLimit = append(m.FeeLimit, types.Coin{})
+			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
 			}
-			m.MinCount = 0
+			m.PrepareGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4942,16 +7739,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinCount |= uint64(b&0x7F) << shift
+				m.PrepareGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
 			}
-			m.RequestHeight = 0
+			m.ExecuteGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4961,16 +7758,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestHeight |= int64(b&0x7F) << shift
+				m.ExecuteGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
 			}
-			m.RequestTime = 0
+			m.TSSEncoder = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4980,16 +7777,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestTime |= int64(b&0x7F) << shift
+				m.TSSEncoder |= Encoder(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInterval", wireType)
 			}
-			var stringLen uint64
+			m.BlockInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4999,29 +7796,35 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockInterval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInterval", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.TimeInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5031,31 +7834,29 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawRequests = append(m.RawRequests, RawRequest{})
-			if err := m.RawRequests[len(m.RawRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Escrow = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBCChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5065,33 +7866,29 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IBCChannel == nil {
-				m.IBCChannel = &IBCChannel{}
-			}
-			if err := m.IBCChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
 			}
-			m.ExecuteGas = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5101,16 +7898,17 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExecuteGas |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			m.IsActive = bool(v != 0)
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRequestID", wireType)
 			}
-			m.TSSEncoder = 0
+			m.LastRequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5120,16 +7918,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TSSEncoder |= Encoder(b&0x7F) << shift
+				m.LastRequestID |= RequestID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRequestHeight", wireType)
 			}
-			var stringLen uint64
+			m.LastRequestHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5139,29 +7937,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastRequestHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Requester = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRequestTime", wireType)
 			}
-			var msglen int
+			m.LastRequestTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5171,26 +7956,11 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastRequestTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FeeLimit = append(m.FeeLimit, types.Coin{})
-			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -6048,7 +8818,102 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			m.RequestID = 0
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= RequestID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
+			}
+			m.AnsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+			}
+			m.RequestTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+			}
+			m.ResolveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
+			}
+			m.ResolveStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveStatus |= ResolveStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6058,16 +8923,81 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestID |= RequestID(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestVersions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestVersions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestVersions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
 			}
-			m.AnsCount = 0
+			m.OracleScriptVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6077,16 +9007,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AnsCount |= uint64(b&0x7F) << shift
+				m.OracleScriptVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawRequestVersions", wireType)
 			}
-			m.RequestTime = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6096,16 +9026,81 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestTime |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawRequestVersions = append(m.RawRequestVersions, RawRequestVersion{})
+			if err := m.RawRequestVersions[len(m.RawRequestVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawRequestVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawRequestVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawRequestVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
 			}
-			m.ResolveTime = 0
+			m.ExternalID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6115,16 +9110,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResolveTime |= int64(b&0x7F) << shift
+				m.ExternalID |= ExternalID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
 			}
-			m.ResolveStatus = 0
+			m.DataSourceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6134,16 +9129,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResolveStatus |= ResolveStatus(b&0x7F) << shift
+				m.DataSourceID |= DataSourceID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceVersion", wireType)
 			}
-			var byteLen int
+			m.DataSourceVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6153,26 +9148,11 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.DataSourceVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
-			if m.Result == nil {
-				m.Result = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -6757,6 +9737,97 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IBCRequestEnabled = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceReferenceOracleScriptID", wireType)
+			}
+			m.PriceReferenceOracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceReferenceOracleScriptID |= OracleScriptID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscriptionFee = append(m.SubscriptionFee, types.Coin{})
+			if err := m.SubscriptionFee[len(m.SubscriptionFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSubscriptionGas", wireType)
+			}
+			m.MaxSubscriptionGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSubscriptionGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveSubscriptions", wireType)
+			}
+			m.MaxActiveSubscriptions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActiveSubscriptions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -21,3 +23,23 @@ func NewOracleScript(
 		SourceCodeURL: sourceCodeURL,
 	}
 }
+
+// NewOracleScriptVersion creates a version record of the given oracle script at the given version.
+func NewOracleScriptVersion(
+	id OracleScriptID, version uint64, oracleScript OracleScript, height int64, timestamp time.Time,
+) OracleScriptVersion {
+	return OracleScriptVersion{
+		OracleScriptID: id,
+		Version:        version,
+		Filename:       oracleScript.Filename,
+		Schema:         oracleScript.Schema,
+		Height:         height,
+		Timestamp:      timestamp.Unix(),
+	}
+}
+
+// IsChangedFrom returns whether the given oracle script differs from this version in any of
+// the fields that are recorded in the version history.
+func (v OracleScriptVersion) IsChangedFrom(oracleScript OracleScript) bool {
+	return v.Filename != oracleScript.Filename || v.Schema != oracleScript.Schema
+}
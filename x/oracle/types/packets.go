@@ -60,6 +60,18 @@ func (p OracleRequestPacketData) ValidateBasic() error {
 	return nil
 }
 
+// GetCallback returns nil as requests coming through IBC receive their results
+// via the response packet rather than a module callback.
+func (p OracleRequestPacketData) GetCallback() *RequestCallback {
+	return nil
+}
+
+// GetOracleScriptVersion returns zero as requests coming through IBC always use the latest
+// version of the oracle script.
+func (p OracleRequestPacketData) GetOracleScriptVersion() uint64 {
+	return 0
+}
+
 // GetBytes is a helper for serialising
 func (p OracleRequestPacketData) GetBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&p))
@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -19,13 +21,23 @@ const (
 	DefaultOracleRewardPercentage  = uint64(70)
 	DefaultInactivePenaltyDuration = uint64(10 * time.Minute)
 	DefaultIBCRequestEnabled       = true
+	// price indexing is disabled until the price reference oracle script is set by governance
+	DefaultPriceReferenceOracleScriptID = OracleScriptID(0)
+	DefaultMaxSubscriptionGas           = uint64(2000000)
+	DefaultMaxActiveSubscriptions       = uint64(100)
 )
 
+// DefaultSubscriptionFee is the default fee charged for each request made by a subscription.
+var DefaultSubscriptionFee = sdk.NewCoins(sdk.NewInt64Coin("uband", 10000))
+
 // NewParams creates a new parameter configuration for the oracle module
 func NewParams(
 	maxRawRequestCount, maxAskCount, maxCalldataSize, maxReportDataSize, expirationBlockCount, baseRequestGas, perValidatorRequestGas,
 	samplingTryCount, oracleRewardPercentage, inactivePenaltyDuration uint64,
 	ibcRequestEnabled bool,
+	priceReferenceOracleScriptID OracleScriptID,
+	subscriptionFee sdk.Coins,
+	maxSubscriptionGas, maxActiveSubscriptions uint64,
 ) Params {
 	return Params{
 		MaxRawRequestCount:      maxRawRequestCount,
@@ -39,6 +51,11 @@ func NewParams(
 		OracleRewardPercentage:  oracleRewardPercentage,
 		InactivePenaltyDuration: inactivePenaltyDuration,
 		IBCRequestEnabled:       ibcRequestEnabled,
+
+		PriceReferenceOracleScriptID: priceReferenceOracleScriptID,
+		SubscriptionFee:              subscriptionFee,
+		MaxSubscriptionGas:           maxSubscriptionGas,
+		MaxActiveSubscriptions:       maxActiveSubscriptions,
 	}
 }
 
@@ -56,6 +73,10 @@ func DefaultParams() Params {
 		DefaultOracleRewardPercentage,
 		DefaultInactivePenaltyDuration,
 		DefaultIBCRequestEnabled,
+		DefaultPriceReferenceOracleScriptID,
+		DefaultSubscriptionFee,
+		DefaultMaxSubscriptionGas,
+		DefaultMaxActiveSubscriptions,
 	)
 }
 
@@ -94,6 +115,15 @@ func (p Params) Validate() error {
 	if err := validateBool()(p.IBCRequestEnabled); err != nil {
 		return err
 	}
+	if err := p.SubscriptionFee.Validate(); err != nil {
+		return fmt.Errorf("invalid subscription fee: %w", err)
+	}
+	if err := validateUint64("max subscription gas", true)(p.MaxSubscriptionGas); err != nil {
+		return err
+	}
+	if err := validateUint64("max active subscriptions", false)(p.MaxActiveSubscriptions); err != nil {
+		return err
+	}
 
 	return nil
 }
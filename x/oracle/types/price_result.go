@@ -0,0 +1,117 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/bandprotocol/chain/v3/pkg/obi"
+)
+
+// PriceReferenceInput is the OBI-encoded calldata of the standard price reference oracle script.
+type PriceReferenceInput struct {
+	Symbols    []string `json:"symbols"`
+	Multiplier uint64   `json:"multiplier"`
+}
+
+// PriceReferenceOutput is the OBI-encoded result of the standard price reference oracle script.
+type PriceReferenceOutput struct {
+	Pxs []uint64 `json:"pxs"`
+}
+
+// NewPriceResult creates a new PriceResult instance.
+func NewPriceResult(symbol string, multiplier uint64, px uint64, requestID RequestID, resolveTime int64) PriceResult {
+	return PriceResult{
+		Symbol:      symbol,
+		Multiplier:  multiplier,
+		Px:          px,
+		RequestID:   requestID,
+		ResolveTime: resolveTime,
+	}
+}
+
+// DecodePriceResults decodes the calldata and the result of a request to the standard price reference
+// oracle script into a price result of each symbol.
+func DecodePriceResults(result Result) ([]PriceResult, error) {
+	input, err := decodePriceReferenceInput(result.Calldata)
+	if err != nil {
+		return nil, ErrOBIDecode.Wrapf("cannot decode calldata: %s", err)
+	}
+
+	output, err := decodePriceReferenceOutput(result.Result)
+	if err != nil {
+		return nil, ErrOBIDecode.Wrapf("cannot decode result: %s", err)
+	}
+
+	if len(input.Symbols) != len(output.Pxs) {
+		return nil, ErrOBIDecode.Wrapf(
+			"number of symbols (%d) and prices (%d) mismatch",
+			len(input.Symbols),
+			len(output.Pxs),
+		)
+	}
+
+	priceResults := make([]PriceResult, 0, len(input.Symbols))
+	for i, symbol := range input.Symbols {
+		priceResults = append(
+			priceResults,
+			NewPriceResult(symbol, input.Multiplier, output.Pxs[i], result.RequestID, result.ResolveTime),
+		)
+	}
+
+	return priceResults, nil
+}
+
+// decodePriceReferenceInput decodes the calldata of the price reference oracle script. Unlike obi.Decode,
+// it checks the number of symbols against the data length before allocating, as the data is untrusted.
+func decodePriceReferenceInput(data []byte) (PriceReferenceInput, error) {
+	length, rem, err := obi.DecodeUnsigned32(data)
+	if err != nil {
+		return PriceReferenceInput{}, err
+	}
+
+	// each symbol takes at least 4 bytes of its length prefix
+	if uint64(length)*4 > uint64(len(rem)) {
+		return PriceReferenceInput{}, fmt.Errorf("too many symbols: %d", length)
+	}
+
+	input := PriceReferenceInput{Symbols: make([]string, length)}
+	for i := range input.Symbols {
+		input.Symbols[i], rem, err = obi.DecodeString(rem)
+		if err != nil {
+			return PriceReferenceInput{}, err
+		}
+	}
+
+	input.Multiplier, rem, err = obi.DecodeUnsigned64(rem)
+	if err != nil {
+		return PriceReferenceInput{}, err
+	}
+
+	if len(rem) != 0 {
+		return PriceReferenceInput{}, fmt.Errorf("not all data was consumed while decoding")
+	}
+
+	return input, nil
+}
+
+// decodePriceReferenceOutput decodes the result of the price reference oracle script. Unlike obi.Decode,
+// it checks the number of prices against the data length before allocating, as the data is untrusted.
+func decodePriceReferenceOutput(data []byte) (PriceReferenceOutput, error) {
+	length, rem, err := obi.DecodeUnsigned32(data)
+	if err != nil {
+		return PriceReferenceOutput{}, err
+	}
+
+	if uint64(length)*8 != uint64(len(rem)) {
+		return PriceReferenceOutput{}, fmt.Errorf("invalid data length for %d prices: %d", length, len(rem))
+	}
+
+	output := PriceReferenceOutput{Pxs: make([]uint64, length)}
+	for i := range output.Pxs {
+		output.Pxs[i], rem, err = obi.DecodeUnsigned64(rem)
+		if err != nil {
+			return PriceReferenceOutput{}, err
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/pkg/obi"
+)
+
+func TestDecodePriceResults(t *testing.T) {
+	calldata := obi.MustEncode(PriceReferenceInput{Symbols: []string{"BTC", "ETH"}, Multiplier: 1000000})
+	output := obi.MustEncode(PriceReferenceOutput{Pxs: []uint64{60000000000, 3000000000}})
+
+	testCases := []struct {
+		name     string
+		calldata []byte
+		result   []byte
+		expected []PriceResult
+		expErr   bool
+	}{
+		{
+			"valid result",
+			calldata,
+			output,
+			[]PriceResult{
+				NewPriceResult("BTC", 1000000, 60000000000, 1, 1589535022),
+				NewPriceResult("ETH", 1000000, 3000000000, 1, 1589535022),
+			},
+			false,
+		},
+		{
+			"mismatched number of prices",
+			calldata,
+			obi.MustEncode(PriceReferenceOutput{Pxs: []uint64{60000000000}}),
+			nil,
+			true,
+		},
+		{
+			"invalid calldata",
+			[]byte("BASIC_CALLDATA"),
+			output,
+			nil,
+			true,
+		},
+		{
+			"too many symbols",
+			obi.EncodeUnsigned32(1 << 31),
+			output,
+			nil,
+			true,
+		},
+		{
+			"too many prices",
+			calldata,
+			[]byte("BASIC_RESULT"),
+			nil,
+			true,
+		},
+		{
+			"extra data",
+			append(calldata, 0x00),
+			output,
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			result := NewResult("", 1, tc.calldata, 2, 2, 1, 2, 1589535020, 1589535022, RESOLVE_STATUS_SUCCESS, tc.result)
+			priceResults, err := DecodePriceResults(result)
+
+			if tc.expErr {
+				require.ErrorIs(tt, err, ErrOBIDecode)
+			} else {
+				require.NoError(tt, err)
+				require.Equal(tt, tc.expected, priceResults)
+			}
+		})
+	}
+}
@@ -22,6 +22,8 @@ type RequestSpec interface {
 	GetExecuteGas() uint64
 	GetFeeLimit() sdk.Coins
 	GetTSSEncoder() Encoder
+	GetCallback() *RequestCallback
+	GetOracleScriptVersion() uint64
 }
 
 func NewRawRequest(
@@ -75,3 +77,28 @@ func NewRequest(
 		FeeLimit:            feeLimit,
 	}
 }
+
+// NewRawRequestVersion creates a new RawRequestVersion instance.
+func NewRawRequestVersion(externalID ExternalID, dataSourceID DataSourceID, dataSourceVersion uint64) RawRequestVersion {
+	return RawRequestVersion{
+		ExternalID:        externalID,
+		DataSourceID:      dataSourceID,
+		DataSourceVersion: dataSourceVersion,
+	}
+}
+
+// GetVersions returns the versions of the oracle script and data sources used by the request.
+func (r Request) GetVersions() RequestVersions {
+	rawRequestVersions := make([]RawRequestVersion, 0, len(r.RawRequests))
+	for _, rawReq := range r.RawRequests {
+		rawRequestVersions = append(
+			rawRequestVersions,
+			NewRawRequestVersion(rawReq.ExternalID, rawReq.DataSourceID, rawReq.DataSourceVersion),
+		)
+	}
+
+	return RequestVersions{
+		OracleScriptVersion: r.OracleScriptVersion,
+		RawRequestVersions:  rawRequestVersions,
+	}
+}
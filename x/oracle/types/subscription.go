@@ -0,0 +1,94 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSubscription creates a new Subscription instance.
+func NewSubscription(
+	id SubscriptionID,
+	oracleScriptID OracleScriptID,
+	calldata []byte,
+	askCount, minCount uint64,
+	clientID string,
+	feeLimit sdk.Coins,
+	prepareGas, executeGas uint64,
+	tssEncoder Encoder,
+	blockInterval, timeInterval uint64,
+	escrow string,
+	owner string,
+	isActive bool,
+) Subscription {
+	return Subscription{
+		ID:             id,
+		OracleScriptID: oracleScriptID,
+		Calldata:       calldata,
+		AskCount:       askCount,
+		MinCount:       minCount,
+		ClientID:       clientID,
+		FeeLimit:       feeLimit,
+		PrepareGas:     prepareGas,
+		ExecuteGas:     executeGas,
+		TSSEncoder:     tssEncoder,
+		BlockInterval:  blockInterval,
+		TimeInterval:   timeInterval,
+		Escrow:         escrow,
+		Owner:          owner,
+		IsActive:       isActive,
+	}
+}
+
+// ValidateSubscriptionInterval checks that exactly one of the block and time intervals is
+// set and that it is not shorter than the minimum cadence.
+func ValidateSubscriptionInterval(blockInterval, timeInterval uint64) error {
+	switch {
+	case blockInterval == 0 && timeInterval == 0:
+		return ErrInvalidInterval.Wrap("either block interval or time interval must be set")
+	case blockInterval != 0 && timeInterval != 0:
+		return ErrInvalidInterval.Wrap("only one of block interval and time interval can be set")
+	case blockInterval != 0 && blockInterval < MinSubscriptionBlockInterval:
+		return ErrInvalidInterval.Wrapf(
+			"block interval %d is less than %d",
+			blockInterval,
+			MinSubscriptionBlockInterval,
+		)
+	case timeInterval != 0 && timeInterval < MinSubscriptionTimeInterval:
+		return ErrInvalidInterval.Wrapf(
+			"time interval %d is less than %d",
+			timeInterval,
+			MinSubscriptionTimeInterval,
+		)
+	}
+	return nil
+}
+
+// IsDue returns whether the subscription should make a new request at the given block.
+// A subscription that has never made a request is always due.
+func (s Subscription) IsDue(blockHeight int64, blockTime time.Time) bool {
+	if s.LastRequestID == 0 {
+		return true
+	}
+	if s.BlockInterval != 0 {
+		return blockHeight >= s.LastRequestHeight+int64(s.BlockInterval)
+	}
+	return blockTime.Unix() >= s.LastRequestTime+int64(s.TimeInterval)
+}
+
+// NewRequestSpec returns the request to be made on behalf of the subscription with its
+// escrow as the sender.
+func (s Subscription) NewRequestSpec() *MsgRequestData {
+	return &MsgRequestData{
+		OracleScriptID: s.OracleScriptID,
+		Calldata:       s.Calldata,
+		AskCount:       s.AskCount,
+		MinCount:       s.MinCount,
+		ClientID:       s.ClientID,
+		FeeLimit:       s.FeeLimit,
+		PrepareGas:     s.PrepareGas,
+		ExecuteGas:     s.ExecuteGas,
+		Sender:         s.Escrow,
+		TSSEncoder:     s.TSSEncoder,
+	}
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSubscriptionInterval(t *testing.T) {
+	require.NoError(t, ValidateSubscriptionInterval(MinSubscriptionBlockInterval, 0))
+	require.NoError(t, ValidateSubscriptionInterval(0, MinSubscriptionTimeInterval))
+	require.ErrorIs(t, ValidateSubscriptionInterval(0, 0), ErrInvalidInterval)
+	require.ErrorIs(t, ValidateSubscriptionInterval(10, 60), ErrInvalidInterval)
+	require.ErrorIs(t, ValidateSubscriptionInterval(MinSubscriptionBlockInterval-1, 0), ErrInvalidInterval)
+	require.ErrorIs(t, ValidateSubscriptionInterval(0, MinSubscriptionTimeInterval-1), ErrInvalidInterval)
+}
+
+func TestSubscriptionIsDue(t *testing.T) {
+	blockTime := time.Unix(1000, 0)
+
+	// A subscription that has never made a request is always due.
+	subscription := Subscription{BlockInterval: 10}
+	require.True(t, subscription.IsDue(1, blockTime))
+
+	subscription.LastRequestID = 1
+	subscription.LastRequestHeight = 100
+	require.False(t, subscription.IsDue(109, blockTime))
+	require.True(t, subscription.IsDue(110, blockTime))
+
+	subscription = Subscription{TimeInterval: 60, LastRequestID: 1, LastRequestTime: 1000}
+	require.False(t, subscription.IsDue(200, time.Unix(1059, 0)))
+	require.True(t, subscription.IsDue(200, time.Unix(1060, 0)))
+}
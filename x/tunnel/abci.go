@@ -14,5 +14,15 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	// Produce packets for all tunnels that are active and have passed the interval time trigger
 	// or deviated from the last price to destination route.
 	// Error should not happen here since the tunnel is already validated.
-	return k.ProduceActiveTunnelPackets(ctx)
+	if err := k.ProduceActiveTunnelPackets(ctx); err != nil {
+		return err
+	}
+
+	// Sign the pending packets of batched TSS tunnels with one signing per destination chain.
+	k.SignTSSBatches(ctx)
+
+	// Prune the packets that are beyond the retention params.
+	k.PrunePackets(ctx)
+
+	return nil
 }
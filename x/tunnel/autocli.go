@@ -64,6 +64,35 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "sequence"},
 					},
 				},
+				{
+					RpcMethod: "TunnelFeeRunway",
+					Use:       "fee-runway [tunnel-id]",
+					Short:     "Estimate the number of packets that the fee payer of a tunnel can pay for",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "tunnel_id"},
+					},
+				},
+				{
+					RpcMethod: "TunnelOperators",
+					Use:       "operators [tunnel-id]",
+					Short:     "Query all operators of a tunnel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "tunnel_id"},
+					},
+				},
+				{
+					RpcMethod: "PacketRetention",
+					Use:       "packet-retention [tunnel-id]",
+					Short:     "Query the oldest and latest packet sequences of a tunnel kept in the state",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "tunnel_id"},
+					},
+				},
+				{
+					RpcMethod: "SimulateTunnel",
+					Use:       "simulate-tunnel",
+					Short:     "Simulate the packets and fees of a proposed tunnel configuration against a price history",
+				},
 				{
 					RpcMethod: "TotalFees",
 					Use:       "total-fees",
@@ -14,6 +14,12 @@ import (
 	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
+const (
+	flagScheduleCron         = "schedule-cron"
+	flagScheduleBlockHeights = "schedule-block-heights"
+	flagBatched              = "batched"
+)
+
 // GetTxCmd returns a root CLI command handler for all x/tunnel transaction commands.
 func GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -34,6 +40,11 @@ func GetTxCmd() *cobra.Command {
 		GetTxCmdTriggerTunnel(),
 		GetTxCmdDepositToTunnel(),
 		GetTxCmdWithdrawFromTunnel(),
+		GetTxCmdSetFeePayerTopUp(),
+		GetTxCmdRemoveFeePayerTopUp(),
+		GetTxCmdUpdateLowBalanceThreshold(),
+		GetTxCmdTransferTunnelOwnership(),
+		GetTxCmdSetTunnelOperator(),
 	)
 
 	return txCmd
@@ -51,6 +62,7 @@ func GetTxCmdCreateTunnel() *cobra.Command {
 	txCmd.AddCommand(
 		GetTxCmdCreateTSSTunnel(),
 		GetTxCmdCreateIBCTunnel(),
+		GetTxCmdCreateRouterTunnel(),
 	)
 
 	return txCmd
@@ -90,12 +102,24 @@ func GetTxCmdCreateTSSTunnel() *cobra.Command {
 				return err
 			}
 
+			schedule, err := parseSchedule(cmd)
+			if err != nil {
+				return err
+			}
+
+			batched, err := cmd.Flags().GetBool(flagBatched)
+			if err != nil {
+				return err
+			}
+
 			msg, err := types.NewMsgCreateTSSTunnel(
 				signalDeviations.ToSignalDeviations(),
 				interval,
+				schedule,
 				destChainID,
 				destContractAddr,
 				feedstypes.Encoder(encoder),
+				batched,
 				initialDeposit,
 				clientCtx.GetFromAddress().String(),
 			)
@@ -108,6 +132,8 @@ func GetTxCmdCreateTSSTunnel() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	addScheduleFlags(cmd)
+	cmd.Flags().Bool(flagBatched, false, "Sign packets together with other batched tunnels targeting the same chain")
 
 	return cmd
 }
@@ -138,9 +164,88 @@ func GetTxCmdCreateIBCTunnel() *cobra.Command {
 				return err
 			}
 
+			schedule, err := parseSchedule(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg, err := types.NewMsgCreateIBCTunnel(
 				signalInfos.ToSignalDeviations(),
 				interval,
+				schedule,
+				initialDeposit,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addScheduleFlags(cmd)
+	return cmd
+}
+
+func GetTxCmdCreateRouterTunnel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "router [channel-id] [bridge-contract-address] [destination-chain-id] [destination-contract-address] [destination-gas-limit] [fee] [encoder] [initial-deposit] [interval] [signalDeviations-json-file]",
+		Short: "Create a new Router tunnel",
+		Args:  cobra.ExactArgs(10),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			destGasLimit, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fee, err := sdk.ParseCoinNormalized(args[5])
+			if err != nil {
+				return err
+			}
+
+			encoder, err := strconv.ParseInt(args[6], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[7])
+			if err != nil {
+				return err
+			}
+
+			interval, err := strconv.ParseUint(args[8], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			signalDeviations, err := parseSignalDeviations(args[9])
+			if err != nil {
+				return err
+			}
+
+			schedule, err := parseSchedule(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgCreateRouterTunnel(
+				signalDeviations.ToSignalDeviations(),
+				interval,
+				schedule,
+				args[0],
+				args[1],
+				args[2],
+				args[3],
+				destGasLimit,
+				fee,
+				feedstypes.Encoder(encoder),
 				initialDeposit,
 				clientCtx.GetFromAddress().String(),
 			)
@@ -153,6 +258,8 @@ func GetTxCmdCreateIBCTunnel() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	addScheduleFlags(cmd)
+
 	return cmd
 }
 
@@ -167,6 +274,7 @@ func GetTxCmdUpdateRoute() *cobra.Command {
 	// add create tunnel subcommands
 	txCmd.AddCommand(
 		GetTxCmdUpdateIBCRoute(),
+		GetTxCmdUpdateRouterRoute(),
 	)
 
 	return txCmd
@@ -206,6 +314,61 @@ func GetTxCmdUpdateIBCRoute() *cobra.Command {
 	return cmd
 }
 
+func GetTxCmdUpdateRouterRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "router [tunnel-id] [channel-id] [bridge-contract-address] [destination-chain-id] [destination-contract-address] [destination-gas-limit] [fee] [encoder]",
+		Short: "Update Router route of a Router tunnel",
+		Args:  cobra.ExactArgs(8),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			destGasLimit, err := strconv.ParseUint(args[5], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fee, err := sdk.ParseCoinNormalized(args[6])
+			if err != nil {
+				return err
+			}
+
+			encoder, err := strconv.ParseInt(args[7], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgUpdateRouterRoute(
+				id,
+				args[1],
+				args[2],
+				args[3],
+				args[4],
+				destGasLimit,
+				fee,
+				feedstypes.Encoder(encoder),
+				clientCtx.GetFromAddress().String(),
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetTxCmdUpdateSignalsAndInterval() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-signals-and-interval [tunnel-id] [interval] [signalDeviations-json-file] ",
@@ -232,10 +395,16 @@ func GetTxCmdUpdateSignalsAndInterval() *cobra.Command {
 				return err
 			}
 
+			schedule, err := parseSchedule(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgUpdateSignalsAndInterval(
 				id,
 				signalDeviations.ToSignalDeviations(),
 				interval,
+				schedule,
 				clientCtx.GetFromAddress().String(),
 			)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -243,6 +412,7 @@ func GetTxCmdUpdateSignalsAndInterval() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	addScheduleFlags(cmd)
 
 	return cmd
 }
@@ -417,3 +587,159 @@ func GetTxCmdWithdrawFromTunnel() *cobra.Command {
 
 	return cmd
 }
+
+func GetTxCmdSetFeePayerTopUp() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-payer-top-up [tunnel-id] [funder] [target-balance] [cap]",
+		Short: "Authorize a funding account to refill the fee payer of a tunnel",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			targetBalance, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			topUpCap, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetFeePayerTopUp(
+				id,
+				args[1],
+				targetBalance,
+				topUpCap,
+				clientCtx.GetFromAddress().String(),
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetTxCmdRemoveFeePayerTopUp() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-fee-payer-top-up [tunnel-id]",
+		Short: "Remove the fee payer top-up authorization of a tunnel as its owner, operator or funder",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveFeePayerTopUp(id, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetTxCmdUpdateLowBalanceThreshold() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-low-balance-threshold [tunnel-id] [threshold]",
+		Short: "Update the fee payer balance below which a tunnel emits a low balance event",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			threshold, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateLowBalanceThreshold(id, threshold, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetTxCmdTransferTunnelOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-tunnel-ownership [tunnel-id] [new-owner]",
+		Short: "Transfer the ownership of a tunnel to a new owner, e.g. a multisig account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferTunnelOwnership(id, args[1], clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetTxCmdSetTunnelOperator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-tunnel-operator [tunnel-id] [operator] [roles]",
+		Short: "Set the roles (trigger, config, funds) of an operator of a tunnel, or remove it with empty roles",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			roles, err := parseOperatorRoles(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetTunnelOperator(id, args[1], roles, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
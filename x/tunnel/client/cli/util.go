@@ -3,7 +3,11 @@ package cli
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
 
 	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
@@ -15,8 +19,18 @@ type SignalDeviations struct {
 
 // SignalDeviation represents the signal information without soft deviation, which may be utilized in the future for deviation adjustments
 type SignalDeviation struct {
-	SignalID     string `json:"signal_id"`
-	DeviationBPS uint64 `json:"deviation_bps"`
+	SignalID     string            `json:"signal_id"`
+	DeviationBPS uint64            `json:"deviation_bps"`
+	Derivation   *SignalDerivation `json:"derivation,omitempty"`
+}
+
+// SignalDerivation represents how the price of a derived signal is computed in the file
+type SignalDerivation struct {
+	Type                  string `json:"type"`
+	BaseSignalID          string `json:"base_signal_id"`
+	QuoteSignalID         string `json:"quote_signal_id,omitempty"`
+	MultiplierNumerator   uint64 `json:"multiplier_numerator,omitempty"`
+	MultiplierDenominator uint64 `json:"multiplier_denominator,omitempty"`
 }
 
 // ToSignalDeviations converts signal information to types.SignalDeviation, excluding soft deviation.
@@ -29,6 +43,15 @@ func (ss SignalDeviations) ToSignalDeviations() []types.SignalDeviation {
 			SoftDeviationBPS: sd.DeviationBPS,
 			HardDeviationBPS: sd.DeviationBPS,
 		}
+		if sd.Derivation != nil {
+			signalDeviation.Derivation = types.NewSignalDerivation(
+				types.DerivationType(types.DerivationType_value[sd.Derivation.Type]),
+				sd.Derivation.BaseSignalID,
+				sd.Derivation.QuoteSignalID,
+				sd.Derivation.MultiplierNumerator,
+				sd.Derivation.MultiplierDenominator,
+			)
+		}
 		signalDeviations = append(signalDeviations, signalDeviation)
 	}
 	return signalDeviations
@@ -53,3 +76,51 @@ func parseSignalDeviations(signalDeviationsFile string) (SignalDeviations, error
 
 	return signalDeviations, nil
 }
+
+// addScheduleFlags adds the flags of the tunnel schedule to the command
+func addScheduleFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagScheduleCron, "", "UTC cron expression of the schedule, e.g. \"0 0,16 * * *\"")
+	cmd.Flags().UintSlice(flagScheduleBlockHeights, nil, "Block heights of the schedule, e.g. 1000,2000")
+}
+
+// parseSchedule parses the tunnel schedule from the flags of the command
+func parseSchedule(cmd *cobra.Command) (*types.Schedule, error) {
+	cron, err := cmd.Flags().GetString(flagScheduleCron)
+	if err != nil {
+		return nil, err
+	}
+
+	heights, err := cmd.Flags().GetUintSlice(flagScheduleBlockHeights)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHeights := make([]uint64, 0, len(heights))
+	for _, height := range heights {
+		blockHeights = append(blockHeights, uint64(height))
+	}
+
+	if cron == "" && len(blockHeights) == 0 {
+		return nil, nil
+	}
+
+	return types.NewSchedule(cron, blockHeights), nil
+}
+
+// parseOperatorRoles parses the comma-separated operator roles, e.g. "trigger,config"
+func parseOperatorRoles(rolesStr string) ([]types.OperatorRole, error) {
+	var roles []types.OperatorRole
+	for _, name := range strings.Split(rolesStr, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		role, ok := types.OperatorRole_value["OPERATOR_ROLE_"+strings.ToUpper(name)]
+		if !ok || role == int32(types.OPERATOR_ROLE_UNSPECIFIED) {
+			return nil, fmt.Errorf("unknown operator role: %s", name)
+		}
+		roles = append(roles, types.OperatorRole(role))
+	}
+	return roles, nil
+}
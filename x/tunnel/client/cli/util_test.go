@@ -6,12 +6,23 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
 func TestParseSignalDeviations(t *testing.T) {
 	signalDeviations := []SignalDeviation{
 		{SignalID: "CS:BTC-USD", DeviationBPS: 2000},
 		{SignalID: "CS:ETH-USD", DeviationBPS: 4000},
+		{
+			SignalID:     "CS:ETH-BTC",
+			DeviationBPS: 1000,
+			Derivation: &SignalDerivation{
+				Type:          "DERIVATION_TYPE_CROSS_RATE",
+				BaseSignalID:  "CS:ETH-USD",
+				QuoteSignalID: "CS:BTC-USD",
+			},
+		},
 	}
 	file, cleanup := createTempSignalDeviationFile(signalDeviations)
 	defer cleanup()
@@ -19,6 +30,10 @@ func TestParseSignalDeviations(t *testing.T) {
 	result, err := parseSignalDeviations(file)
 	require.NoError(t, err)
 	require.Equal(t, signalDeviations, result.SignalDeviations)
+
+	converted := result.ToSignalDeviations()
+	require.Nil(t, converted[0].Derivation)
+	require.Equal(t, types.NewCrossRateDerivation("CS:ETH-USD", "CS:BTC-USD"), converted[2].Derivation)
 }
 
 // Helper function to create a temporary file with signal info JSON content
@@ -182,8 +182,17 @@ func (im IBCModule) OnAcknowledgementPacket(
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
-	// do nothing for out-going packet
-	return nil
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.ErrUnknownRequest.Wrapf("cannot unmarshal tunnel packet acknowledgement: %v", err)
+	}
+
+	data, err := im.unmarshalTunnelPacketData(packet)
+	if err != nil {
+		return err
+	}
+
+	return im.keeper.OnAcknowledgementIBCPacket(ctx, data, packet.Sequence, ack)
 }
 
 // OnTimeoutPacket implements the IBCModule interface
@@ -192,8 +201,12 @@ func (im IBCModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	// do nothing for out-going packet
-	return nil
+	data, err := im.unmarshalTunnelPacketData(packet)
+	if err != nil {
+		return err
+	}
+
+	return im.keeper.OnTimeoutIBCPacket(ctx, data, packet.Sequence)
 }
 
 // OnChanUpgradeInit implements the IBCModule interface
@@ -265,6 +278,28 @@ func (IBCModule) UnmarshalPacketData(bz []byte) (interface{}, error) {
 	return packetData, nil
 }
 
+// unmarshalTunnelPacketData unmarshals the data of an out-going packet and checks
+// that it was sent from the port of its tunnel.
+func (im IBCModule) unmarshalTunnelPacketData(packet channeltypes.Packet) (types.TunnelPricesPacketData, error) {
+	var data types.TunnelPricesPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return types.TunnelPricesPacketData{}, sdkerrors.ErrUnknownRequest.Wrapf(
+			"cannot unmarshal tunnel packet data: %v",
+			err,
+		)
+	}
+
+	if packet.SourcePort != keeper.PortIDForTunnel(data.TunnelID) {
+		return types.TunnelPricesPacketData{}, types.ErrInvalidPortID.Wrapf(
+			"packet of tunnel %d is sent from port %s",
+			data.TunnelID,
+			packet.SourcePort,
+		)
+	}
+
+	return data, nil
+}
+
 // ValidateTunnelChannelParams does validation of a newly created tunnel channel. A tunnel
 // channel must be ORDERED, use the correct port (by default 'tunnel'), and use the current
 // supported version. Only 2^32 channels are allowed to be created.
@@ -26,6 +26,11 @@ func InitGenesis(ctx sdk.Context, k Keeper, data *types.GenesisState) {
 
 	// set tunnels
 	for _, t := range data.Tunnels {
+		// tunnels created before the owner was introduced are owned by their creator
+		if t.Owner == "" {
+			t.Owner = t.Creator
+		}
+
 		k.SetTunnel(ctx, t)
 		k.SetLatestPrices(ctx, types.NewLatestPrices(t.ID, []feedstypes.Price{}, 0))
 		if t.IsActive {
@@ -52,6 +57,16 @@ func InitGenesis(ctx sdk.Context, k Keeper, data *types.GenesisState) {
 		totalDeposits = totalDeposits.Add(deposit.Amount...)
 	}
 
+	// set the fee payer top-ups
+	for _, topUp := range data.FeePayerTopUps {
+		k.SetFeePayerTopUp(ctx, topUp)
+	}
+
+	// set the tunnel operators
+	for _, operator := range data.TunnelOperators {
+		k.SetTunnelOperator(ctx, operator)
+	}
+
 	// set the total fees
 	k.SetTotalFees(ctx, data.TotalFees)
 
@@ -70,10 +85,12 @@ func InitGenesis(ctx sdk.Context, k Keeper, data *types.GenesisState) {
 // ExportGenesis returns the module's exported genesis
 func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:      k.GetParams(ctx),
-		TunnelCount: k.GetTunnelCount(ctx),
-		Tunnels:     k.GetTunnels(ctx),
-		Deposits:    k.GetAllDeposits(ctx),
-		TotalFees:   k.GetTotalFees(ctx),
+		Params:          k.GetParams(ctx),
+		TunnelCount:     k.GetTunnelCount(ctx),
+		Tunnels:         k.GetTunnels(ctx),
+		Deposits:        k.GetAllDeposits(ctx),
+		TotalFees:       k.GetTotalFees(ctx),
+		FeePayerTopUps:  k.GetAllFeePayerTopUps(ctx),
+		TunnelOperators: k.GetAllTunnelOperators(ctx),
 	}
 }
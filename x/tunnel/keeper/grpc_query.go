@@ -122,6 +122,16 @@ func (q queryServer) Packets(c context.Context, req *types.QueryPacketsRequest)
 		store,
 		req.Pagination,
 		func(key []byte, p *types.Packet) (*types.Packet, error) {
+			if req.StatusFilter == types.PACKET_STATUS_UNSPECIFIED {
+				return p, nil
+			}
+
+			// only IBC packets have a delivery status
+			receipt, ok := p.Receipt.GetCachedValue().(*types.IBCPacketReceipt)
+			if !ok || receipt.Status != req.StatusFilter {
+				return nil, nil
+			}
+
 			return p, nil
 		}, func() *types.Packet {
 			return &types.Packet{}
@@ -144,6 +154,116 @@ func (q queryServer) Packet(c context.Context, req *types.QueryPacketRequest) (*
 	return &types.QueryPacketResponse{Packet: &packet}, nil
 }
 
+// TunnelFeeRunway estimates the number of packets that the fee payer of a tunnel can pay for.
+func (q queryServer) TunnelFeeRunway(
+	c context.Context,
+	req *types.QueryTunnelFeeRunwayRequest,
+) (*types.QueryTunnelFeeRunwayResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	tunnel, err := q.k.GetTunnel(ctx, req.TunnelId)
+	if err != nil {
+		return nil, err
+	}
+
+	packetFee, err := q.k.GetPacketFee(ctx, tunnel)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := q.k.bankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(tunnel.FeePayer))
+	remainingPackets := types.ComputeRemainingPackets(balance, packetFee)
+
+	res := &types.QueryTunnelFeeRunwayResponse{
+		FeePayerBalance:           balance,
+		PacketFee:                 packetFee,
+		RemainingPackets:          remainingPackets,
+		RemainingPacketsWithTopUp: remainingPackets,
+	}
+
+	topUp, err := q.k.GetFeePayerTopUp(ctx, tunnel.ID)
+	if err == nil {
+		funderBalance := q.k.bankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(topUp.Funder))
+		allowance := topUp.RemainingCap().Min(funderBalance)
+
+		res.TopUp = &topUp
+		res.TopUpAllowance = allowance
+		res.RemainingPacketsWithTopUp = types.ComputeRemainingPackets(balance.Add(allowance...), packetFee)
+	}
+
+	return res, nil
+}
+
+// SimulateTunnel replays a proposed tunnel configuration against a price history.
+func (q queryServer) SimulateTunnel(
+	c context.Context,
+	req *types.QuerySimulateTunnelRequest,
+) (*types.QuerySimulateTunnelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var route types.RouteI
+	if req.Route != nil {
+		r, err := req.GetRouteValue()
+		if err != nil {
+			return nil, err
+		}
+		route = r
+	}
+
+	packets, totalBasePacketFee, totalRouteFee, err := q.k.SimulateTunnel(
+		ctx,
+		req.SignalDeviations,
+		req.Interval,
+		req.Schedule,
+		route,
+		req.PriceSnapshots,
+		req.StartTime,
+		req.EndTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySimulateTunnelResponse{
+		Packets:            packets,
+		TotalBasePacketFee: totalBasePacketFee,
+		TotalRouteFee:      totalRouteFee,
+	}, nil
+}
+
+// TunnelOperators queries all operators of a tunnel.
+func (q queryServer) TunnelOperators(
+	c context.Context,
+	req *types.QueryTunnelOperatorsRequest,
+) (*types.QueryTunnelOperatorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, err := q.k.GetTunnel(ctx, req.TunnelId); err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTunnelOperatorsResponse{Operators: q.k.GetTunnelOperators(ctx, req.TunnelId)}, nil
+}
+
+// PacketRetention queries the range of packet sequences of a tunnel kept in the store.
+func (q queryServer) PacketRetention(
+	c context.Context,
+	req *types.QueryPacketRetentionRequest,
+) (*types.QueryPacketRetentionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	tunnel, err := q.k.GetTunnel(ctx, req.TunnelId)
+	if err != nil {
+		return nil, err
+	}
+
+	oldestSequence, _ := q.k.GetOldestPacketSequence(ctx, tunnel.ID)
+	return &types.QueryPacketRetentionResponse{
+		OldestSequence: oldestSequence,
+		LatestSequence: tunnel.Sequence,
+	}, nil
+}
+
 func (q queryServer) TotalFees(
 	c context.Context,
 	req *types.QueryTotalFeesRequest,
@@ -3,8 +3,10 @@ package keeper_test
 import (
 	sdkmath "cosmossdk.io/math"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
@@ -175,6 +177,47 @@ func (s *KeeperTestSuite) TestGRPCQueryPackets() {
 	s.Require().Equal(packet2, *resp.Packets[1])
 }
 
+func (s *KeeperTestSuite) TestGRPCQueryPacketsWithStatusFilter() {
+	ctx, k, q := s.ctx, s.keeper, s.queryServer
+
+	tunnel := types.Tunnel{
+		ID:       1,
+		Sequence: 3,
+	}
+	err := tunnel.SetRoute(types.NewIBCRoute("channel-0"))
+	s.Require().NoError(err)
+	k.SetTunnel(ctx, tunnel)
+
+	statuses := []types.PacketStatus{
+		types.PACKET_STATUS_ACKNOWLEDGED,
+		types.PACKET_STATUS_TIMED_OUT,
+		types.PACKET_STATUS_ACKNOWLEDGED,
+	}
+	packets := make([]types.Packet, len(statuses))
+	for i, status := range statuses {
+		packets[i] = types.Packet{TunnelID: 1, Sequence: uint64(i + 1)}
+		err = packets[i].SetReceipt(&types.IBCPacketReceipt{Sequence: uint64(i + 1), Status: status})
+		s.Require().NoError(err)
+		k.SetPacket(ctx, packets[i])
+	}
+
+	resp, err := q.Packets(ctx, &types.QueryPacketsRequest{
+		TunnelId:     1,
+		StatusFilter: types.PACKET_STATUS_ACKNOWLEDGED,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Packets, 2)
+	s.Require().Equal(packets[0], *resp.Packets[0])
+	s.Require().Equal(packets[2], *resp.Packets[1])
+
+	resp, err = q.Packets(ctx, &types.QueryPacketsRequest{
+		TunnelId:     1,
+		StatusFilter: types.PACKET_STATUS_ERROR_ACK,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Packets, 0)
+}
+
 func (s *KeeperTestSuite) TestGRPCQueryPacket() {
 	ctx, k, q := s.ctx, s.keeper, s.queryServer
 
@@ -218,6 +261,223 @@ func (s *KeeperTestSuite) TestGRPCQueryPacket() {
 	s.Require().Equal(packet1, *res.Packet)
 }
 
+func (s *KeeperTestSuite) TestGRPCQueryTunnelFeeRunway() {
+	ctx, k, q := s.ctx, s.keeper, s.queryServer
+
+	tunnel := s.AddSampleTunnel(false)
+	feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
+	funder := sdk.AccAddress([]byte("funder"))
+
+	params := k.GetParams(ctx)
+	params.BasePacketFee = sdk.NewCoins(sdk.NewInt64Coin("uband", 10))
+	s.Require().NoError(k.SetParams(ctx, params))
+
+	balance := sdk.NewCoins(sdk.NewInt64Coin("uband", 1000))
+	signingFee := sdk.NewCoins(sdk.NewInt64Coin("uband", 40))
+	s.bandtssKeeper.EXPECT().GetSigningFee(ctx).Return(signingFee, nil).Times(2)
+	s.bankKeeper.EXPECT().GetAllBalances(ctx, feePayer).Return(balance).Times(2)
+
+	// without top-up
+	res, err := q.TunnelFeeRunway(ctx, &types.QueryTunnelFeeRunwayRequest{TunnelId: tunnel.ID})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.QueryTunnelFeeRunwayResponse{
+		FeePayerBalance:           balance,
+		PacketFee:                 sdk.NewCoins(sdk.NewInt64Coin("uband", 50)),
+		RemainingPackets:          20,
+		RemainingPacketsWithTopUp: 20,
+	}, res)
+
+	// with top-up bounded by the funder balance
+	topUp := types.NewFeePayerTopUp(
+		tunnel.ID,
+		funder.String(),
+		balance,
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 5000)),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 1000)),
+	)
+	k.SetFeePayerTopUp(ctx, topUp)
+	s.bankKeeper.EXPECT().GetAllBalances(ctx, funder).Return(sdk.NewCoins(sdk.NewInt64Coin("uband", 2000)))
+
+	res, err = q.TunnelFeeRunway(ctx, &types.QueryTunnelFeeRunwayRequest{TunnelId: tunnel.ID})
+	s.Require().NoError(err)
+	s.Require().Equal(&topUp, res.TopUp)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uband", 2000)), res.TopUpAllowance)
+	s.Require().Equal(uint64(20), res.RemainingPackets)
+	s.Require().Equal(uint64(60), res.RemainingPacketsWithTopUp)
+}
+
+func (s *KeeperTestSuite) TestGRPCQuerySimulateTunnel() {
+	ctx, k, q := s.ctx, s.keeper, s.queryServer
+
+	params := k.GetParams(ctx)
+	params.BasePacketFee = sdk.NewCoins(sdk.NewInt64Coin("uband", 10))
+	s.Require().NoError(k.SetParams(ctx, params))
+
+	route := types.NewTSSRoute("chain-1", "0x1234567890abcdef", feedstypes.ENCODER_FIXED_POINT_ABI, false)
+	routeAny, err := codectypes.NewAnyWithValue(&route)
+	s.Require().NoError(err)
+
+	signalDeviations := []types.SignalDeviation{
+		{SignalID: "CS:BAND-USD", SoftDeviationBPS: 100, HardDeviationBPS: 100},
+	}
+	snapshot := func(timestamp int64, price uint64) types.PriceSnapshot {
+		return types.PriceSnapshot{
+			Timestamp: timestamp,
+			Prices: []feedstypes.Price{
+				feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", price, timestamp),
+			},
+		}
+	}
+
+	s.bandtssKeeper.EXPECT().GetSigningFee(ctx).Return(sdk.NewCoins(sdk.NewInt64Coin("uband", 20)), nil)
+
+	res, err := q.SimulateTunnel(ctx, &types.QuerySimulateTunnelRequest{
+		SignalDeviations: signalDeviations,
+		Interval:         60,
+		Route:            routeAny,
+		PriceSnapshots: []types.PriceSnapshot{
+			snapshot(1000, 1000), // interval
+			snapshot(1010, 1005), // below deviation
+			snapshot(1020, 1020), // over deviation
+			snapshot(1030, 1021), // below deviation
+			snapshot(1060, 1021), // interval
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.Packet{
+		types.NewPacket(0, 1, snapshot(1000, 1000).Prices, 1000),
+		types.NewPacket(0, 2, snapshot(1020, 1020).Prices, 1020),
+		types.NewPacket(0, 3, snapshot(1060, 1021).Prices, 1060),
+	}, res.Packets)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uband", 30)), res.TotalBasePacketFee)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uband", 60)), res.TotalRouteFee)
+
+	// the price history in the state within the time range is replayed without price snapshots
+	s.feedsKeeper.EXPECT().GetPriceHistory(ctx, "CS:BAND-USD").Return([]feedstypes.Price{
+		snapshot(1000, 1000).Prices[0],
+		snapshot(1010, 1020).Prices[0],
+		snapshot(1020, 1040).Prices[0],
+	})
+
+	res, err = q.SimulateTunnel(ctx, &types.QuerySimulateTunnelRequest{
+		SignalDeviations: signalDeviations,
+		Interval:         60,
+		StartTime:        1010,
+		EndTime:          1020,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.Packet{
+		types.NewPacket(0, 1, snapshot(1010, 1020).Prices, 1010),
+		types.NewPacket(0, 2, snapshot(1020, 1040).Prices, 1020),
+	}, res.Packets)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uband", 20)), res.TotalBasePacketFee)
+	s.Require().True(res.TotalRouteFee.IsZero())
+
+	// the cron of the schedule triggers packets even when the interval has not passed
+	// and the prices do not deviate; 1700000000 is 2023-11-14 22:13:20 UTC.
+	res, err = q.SimulateTunnel(ctx, &types.QuerySimulateTunnelRequest{
+		SignalDeviations: signalDeviations,
+		Interval:         3600,
+		Schedule:         types.NewSchedule("15 * * * *", nil),
+		PriceSnapshots: []types.PriceSnapshot{
+			snapshot(1700000000, 1000), // interval
+			snapshot(1700000060, 1000), // below deviation
+			snapshot(1700000100, 1000), // schedule at 22:15
+			snapshot(1700000160, 1000), // below deviation
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.Packet{
+		types.NewPacket(0, 1, snapshot(1700000000, 1000).Prices, 1700000000),
+		types.NewPacket(0, 2, snapshot(1700000100, 1000).Prices, 1700000100),
+	}, res.Packets)
+
+	// block heights of a schedule cannot be simulated
+	_, err = q.SimulateTunnel(ctx, &types.QuerySimulateTunnelRequest{
+		SignalDeviations: signalDeviations,
+		Interval:         3600,
+		Schedule:         types.NewSchedule("15 * * * *", []uint64{100}),
+		PriceSnapshots:   []types.PriceSnapshot{snapshot(1700000000, 1000)},
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidSchedule)
+
+	// an IBC route delivers the prices without an encoder
+	ibcRoute := types.NewIBCRoute("channel-0")
+	ibcRouteAny, err := codectypes.NewAnyWithValue(ibcRoute)
+	s.Require().NoError(err)
+
+	res, err = q.SimulateTunnel(ctx, &types.QuerySimulateTunnelRequest{
+		SignalDeviations: signalDeviations,
+		Interval:         60,
+		Route:            ibcRouteAny,
+		PriceSnapshots:   []types.PriceSnapshot{snapshot(1000, 1000)},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Packets, 1)
+	s.Require().True(res.TotalRouteFee.IsZero())
+
+	// snapshots must be in ascending order of timestamp
+	_, err = q.SimulateTunnel(ctx, &types.QuerySimulateTunnelRequest{
+		SignalDeviations: signalDeviations,
+		Interval:         60,
+		PriceSnapshots:   []types.PriceSnapshot{snapshot(1010, 1000), snapshot(1000, 1000)},
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidPriceSnapshots)
+
+	// the number of snapshots is limited
+	_, err = q.SimulateTunnel(ctx, &types.QuerySimulateTunnelRequest{
+		SignalDeviations: signalDeviations,
+		Interval:         60,
+		PriceSnapshots:   make([]types.PriceSnapshot, types.MaxSimulatePriceSnapshots+1),
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidPriceSnapshots)
+
+	// proposed config must satisfy the params
+	_, err = q.SimulateTunnel(ctx, &types.QuerySimulateTunnelRequest{
+		SignalDeviations: signalDeviations,
+		Interval:         1,
+	})
+	s.Require().ErrorIs(err, types.ErrIntervalOutOfRange)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryTunnelOperators() {
+	ctx, k, q := s.ctx, s.keeper, s.queryServer
+
+	tunnel := s.AddSampleTunnel(false)
+	operator := sdk.AccAddress([]byte("operator_address"))
+	roles := []types.OperatorRole{types.OPERATOR_ROLE_TRIGGER}
+
+	err := k.UpdateTunnelOperator(ctx, tunnel.ID, operator, roles)
+	s.Require().NoError(err)
+
+	res, err := q.TunnelOperators(ctx, &types.QueryTunnelOperatorsRequest{TunnelId: tunnel.ID})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.TunnelOperator{types.NewTunnelOperator(tunnel.ID, operator.String(), roles)}, res.Operators)
+
+	_, err = q.TunnelOperators(ctx, &types.QueryTunnelOperatorsRequest{TunnelId: 2})
+	s.Require().ErrorIs(err, types.ErrTunnelNotFound)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryPacketRetention() {
+	ctx, k, q := s.ctx, s.keeper, s.queryServer
+
+	tunnel := s.AddSampleTunnel(false)
+
+	res, err := q.PacketRetention(ctx, &types.QueryPacketRetentionRequest{TunnelId: tunnel.ID})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.QueryPacketRetentionResponse{}, res)
+
+	tunnel.Sequence = 3
+	k.SetTunnel(ctx, *tunnel)
+	for sequence := uint64(2); sequence <= 3; sequence++ {
+		k.SetPacket(ctx, types.NewPacket(tunnel.ID, sequence, nil, 0))
+	}
+
+	res, err = q.PacketRetention(ctx, &types.QueryPacketRetentionRequest{TunnelId: tunnel.ID})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.QueryPacketRetentionResponse{OldestSequence: 2, LatestSequence: 3}, res)
+}
+
 func (s *KeeperTestSuite) TestGRPCQueryTotalFees() {
 	ctx, k, q := s.ctx, s.keeper, s.queryServer
 
@@ -20,6 +20,7 @@ func CreatePricesMap(prices []feedstypes.Price) map[string]feedstypes.Price {
 }
 
 // GenerateNewPrices generates new prices based on the current prices and signal deviations.
+// Signals whose prices are halted by the feeds price guards are skipped.
 func GenerateNewPrices(
 	signalDeviations []types.SignalDeviation,
 	latestPricesMap map[string]feedstypes.Price,
@@ -36,9 +37,10 @@ func GenerateNewPrices(
 			oldPrice = sdkmath.NewIntFromUint64(latestPrices.Price)
 		}
 
-		feedPrice, ok := feedsPricesMap[sd.SignalID]
-		if !ok {
-			feedPrice = feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_IN_CURRENT_FEEDS, sd.SignalID, 0, timestamp)
+		// derived signals are computed from the feeds prices, so the deviation is checked on the derived value.
+		feedPrice := sd.ComputePrice(feedsPricesMap, timestamp)
+		if feedPrice.Status == feedstypes.PRICE_STATUS_HALTED {
+			continue
 		}
 
 		// calculate deviation between old price and new price and compare with the threshold.
@@ -60,6 +62,24 @@ func GenerateNewPrices(
 	}
 }
 
+// ComputeSignalPrices computes the prices of the given signals from the feeds prices.
+// Signals whose prices are halted by the feeds price guards are skipped.
+func ComputeSignalPrices(
+	signalDeviations []types.SignalDeviation,
+	feedsPricesMap map[string]feedstypes.Price,
+	timestamp int64,
+) []feedstypes.Price {
+	prices := make([]feedstypes.Price, 0, len(signalDeviations))
+	for _, sd := range signalDeviations {
+		price := sd.ComputePrice(feedsPricesMap, timestamp)
+		if price.Status == feedstypes.PRICE_STATUS_HALTED {
+			continue
+		}
+		prices = append(prices, price)
+	}
+	return prices
+}
+
 // calculateDeviationBPS calculates the deviation between the old price and
 // the new price in basis points, i.e., |(newPrice - oldPrice)| * 10000 / oldPrice
 func calculateDeviationBPS(oldPrice, newPrice sdkmath.Int) sdkmath.Int {
@@ -158,3 +158,86 @@ func (s *KeeperTestSuite) TestGeneratePricesNotMeetHardDeviation() {
 	)
 	s.Require().Len(newPrices, 0)
 }
+
+func (s *KeeperTestSuite) TestGeneratePricesDerivedSignal() {
+	pricesMap := map[string]feedstypes.Price{
+		"CS:BTC-USD": {
+			Status:    feedstypes.PRICE_STATUS_AVAILABLE,
+			SignalID:  "CS:BTC-USD",
+			Price:     50000_000000000,
+			Timestamp: 1733000000,
+		},
+		"CS:ETH-USD": {
+			Status:    feedstypes.PRICE_STATUS_AVAILABLE,
+			SignalID:  "CS:ETH-USD",
+			Price:     2500_000000000,
+			Timestamp: 1733000000,
+		},
+	}
+	signalDeviations := []types.SignalDeviation{
+		types.NewDerivedSignalDeviation(
+			"CS:ETH-BTC",
+			100,
+			300,
+			types.NewCrossRateDerivation("CS:ETH-USD", "CS:BTC-USD"),
+		),
+	}
+
+	// the derived price moves by 2%, which does not meet the hard deviation.
+	latestPricesMap := keeper.CreatePricesMap([]feedstypes.Price{
+		{
+			Status:    feedstypes.PRICE_STATUS_AVAILABLE,
+			SignalID:  "CS:ETH-BTC",
+			Price:     49_000000,
+			Timestamp: 1732000000,
+		},
+	})
+	newPrices := keeper.GenerateNewPrices(signalDeviations, latestPricesMap, pricesMap, 1733000000, false)
+	s.Require().Len(newPrices, 0)
+
+	// the derived price moves by 4%, which meets the hard deviation.
+	latestPricesMap = keeper.CreatePricesMap([]feedstypes.Price{
+		{
+			Status:    feedstypes.PRICE_STATUS_AVAILABLE,
+			SignalID:  "CS:ETH-BTC",
+			Price:     52_000000,
+			Timestamp: 1732000000,
+		},
+	})
+	newPrices = keeper.GenerateNewPrices(signalDeviations, latestPricesMap, pricesMap, 1733000000, false)
+	s.Require().Equal([]feedstypes.Price{
+		{
+			Status:    feedstypes.PRICE_STATUS_AVAILABLE,
+			SignalID:  "CS:ETH-BTC",
+			Price:     50_000000,
+			Timestamp: 1733000000,
+		},
+	}, newPrices)
+}
+
+func (s *KeeperTestSuite) TestGeneratePricesSkipHaltedSignal() {
+	pricesMap := map[string]feedstypes.Price{
+		"CS:BAND-USD": {
+			Status:    feedstypes.PRICE_STATUS_AVAILABLE,
+			SignalID:  "CS:BAND-USD",
+			Price:     50000,
+			Timestamp: 1733000000,
+		},
+		"CS:BTC-USD": {
+			Status:    feedstypes.PRICE_STATUS_HALTED,
+			SignalID:  "CS:BTC-USD",
+			Price:     50000_000000000,
+			Timestamp: 1732000000,
+		},
+	}
+	signalDeviations := []types.SignalDeviation{
+		{SignalID: "CS:BAND-USD", SoftDeviationBPS: 1000, HardDeviationBPS: 1000},
+		{SignalID: "CS:BTC-USD", SoftDeviationBPS: 1000, HardDeviationBPS: 1000},
+	}
+
+	newPrices := keeper.GenerateNewPrices(signalDeviations, map[string]feedstypes.Price{}, pricesMap, 1733000000, true)
+	s.Require().Equal([]feedstypes.Price{pricesMap["CS:BAND-USD"]}, newPrices)
+
+	prices := keeper.ComputeSignalPrices(signalDeviations, pricesMap, 1733000000)
+	s.Require().Equal([]feedstypes.Price{pricesMap["CS:BAND-USD"]}, prices)
+}
@@ -16,14 +16,15 @@ type Keeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
 
-	authKeeper    types.AccountKeeper
-	bankKeeper    types.BankKeeper
-	feedsKeeper   types.FeedsKeeper
-	bandtssKeeper types.BandtssKeeper
-	channelKeeper types.ChannelKeeper
-	ics4Wrapper   types.ICS4Wrapper
-	portKeeper    types.PortKeeper
-	scopedKeeper  types.ScopedKeeper
+	authKeeper     types.AccountKeeper
+	bankKeeper     types.BankKeeper
+	feedsKeeper    types.FeedsKeeper
+	bandtssKeeper  types.BandtssKeeper
+	channelKeeper  types.ChannelKeeper
+	ics4Wrapper    types.ICS4Wrapper
+	portKeeper     types.PortKeeper
+	scopedKeeper   types.ScopedKeeper
+	transferKeeper types.TransferKeeper
 
 	authority string
 }
@@ -40,6 +41,7 @@ func NewKeeper(
 	ics4Wrapper types.ICS4Wrapper,
 	portKeeper types.PortKeeper,
 	scopedKeeper types.ScopedKeeper,
+	transferKeeper types.TransferKeeper,
 	authority string,
 ) Keeper {
 	// ensure tunnel module account is set
@@ -53,17 +55,18 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:           cdc,
-		storeKey:      key,
-		authKeeper:    authKeeper,
-		bankKeeper:    bankKeeper,
-		feedsKeeper:   feedsKeeper,
-		bandtssKeeper: bandtssKeeper,
-		channelKeeper: channelKeeper,
-		ics4Wrapper:   ics4Wrapper,
-		portKeeper:    portKeeper,
-		scopedKeeper:  scopedKeeper,
-		authority:     authority,
+		cdc:            cdc,
+		storeKey:       key,
+		authKeeper:     authKeeper,
+		bankKeeper:     bankKeeper,
+		feedsKeeper:    feedsKeeper,
+		bandtssKeeper:  bandtssKeeper,
+		channelKeeper:  channelKeeper,
+		ics4Wrapper:    ics4Wrapper,
+		portKeeper:     portKeeper,
+		scopedKeeper:   scopedKeeper,
+		transferKeeper: transferKeeper,
+		authority:      authority,
 	}
 }
 
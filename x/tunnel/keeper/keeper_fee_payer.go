@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// SetFeePayerTopUp sets the fee payer top-up of a tunnel in the store
+func (k Keeper) SetFeePayerTopUp(ctx sdk.Context, topUp types.FeePayerTopUp) {
+	ctx.KVStore(k.storeKey).Set(types.FeePayerTopUpStoreKey(topUp.TunnelID), k.cdc.MustMarshal(&topUp))
+}
+
+// GetFeePayerTopUp retrieves the fee payer top-up of a tunnel from the store
+func (k Keeper) GetFeePayerTopUp(ctx sdk.Context, tunnelID uint64) (types.FeePayerTopUp, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.FeePayerTopUpStoreKey(tunnelID))
+	if bz == nil {
+		return types.FeePayerTopUp{}, types.ErrFeePayerTopUpNotFound.Wrapf("tunnelID: %d", tunnelID)
+	}
+
+	var topUp types.FeePayerTopUp
+	k.cdc.MustUnmarshal(bz, &topUp)
+	return topUp, nil
+}
+
+// DeleteFeePayerTopUp deletes the fee payer top-up of a tunnel from the store
+func (k Keeper) DeleteFeePayerTopUp(ctx sdk.Context, tunnelID uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.FeePayerTopUpStoreKey(tunnelID))
+}
+
+// GetAllFeePayerTopUps returns all fee payer top-ups from the store
+func (k Keeper) GetAllFeePayerTopUps(ctx sdk.Context) []types.FeePayerTopUp {
+	var topUps []types.FeePayerTopUp
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.FeePayerTopUpStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var topUp types.FeePayerTopUp
+		k.cdc.MustUnmarshal(iterator.Value(), &topUp)
+		topUps = append(topUps, topUp)
+	}
+	return topUps
+}
+
+// AddFeePayerTopUp authorizes the funder to refill the fee payer of a tunnel up to the target
+// balance, with at most the cap in total. It replaces the existing top-up of the tunnel, if any.
+func (k Keeper) AddFeePayerTopUp(
+	ctx sdk.Context,
+	tunnelID uint64,
+	funder sdk.AccAddress,
+	targetBalance sdk.Coins,
+	topUpCap sdk.Coins,
+) error {
+	if _, err := k.GetTunnel(ctx, tunnelID); err != nil {
+		return err
+	}
+
+	topUp := types.NewFeePayerTopUp(tunnelID, funder.String(), targetBalance, topUpCap, sdk.NewCoins())
+	k.SetFeePayerTopUp(ctx, topUp)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetFeePayerTopUp,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnelID)),
+		sdk.NewAttribute(types.AttributeKeyFunder, topUp.Funder),
+		sdk.NewAttribute(types.AttributeKeyTargetBalance, topUp.TargetBalance.String()),
+		sdk.NewAttribute(types.AttributeKeyCap, topUp.Cap.String()),
+	))
+
+	return nil
+}
+
+// RemoveFeePayerTopUp removes the fee payer top-up of a tunnel. Only the owner of the tunnel,
+// an operator with the funds role or the funder can remove it.
+func (k Keeper) RemoveFeePayerTopUp(ctx sdk.Context, tunnelID uint64, sender sdk.AccAddress) error {
+	tunnel, err := k.GetTunnel(ctx, tunnelID)
+	if err != nil {
+		return err
+	}
+
+	topUp, err := k.GetFeePayerTopUp(ctx, tunnelID)
+	if err != nil {
+		return err
+	}
+
+	if sender.String() != topUp.Funder &&
+		!k.HasTunnelPermission(ctx, tunnel, sender.String(), types.OPERATOR_ROLE_FUNDS) {
+		return types.ErrInvalidTopUpSender.Wrapf("sender %s, tunnelID %d", sender, tunnelID)
+	}
+
+	k.DeleteFeePayerTopUp(ctx, tunnelID)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveFeePayerTopUp,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnelID)),
+		sdk.NewAttribute(types.AttributeKeyFunder, topUp.Funder),
+	))
+
+	return nil
+}
+
+// TopUpFeePayer refills the fee payer of a tunnel from the funder of its top-up up to the target
+// balance, bounded by the remaining cap. It does nothing if the tunnel has no top-up.
+func (k Keeper) TopUpFeePayer(ctx sdk.Context, tunnel types.Tunnel) error {
+	topUp, err := k.GetFeePayerTopUp(ctx, tunnel.ID)
+	if err != nil {
+		return nil
+	}
+
+	feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
+	amount := topUp.ComputeTopUpAmount(k.bankKeeper.GetAllBalances(ctx, feePayer))
+	if amount.IsZero() {
+		return nil
+	}
+
+	funder := sdk.MustAccAddressFromBech32(topUp.Funder)
+	if err := k.bankKeeper.SendCoins(ctx, funder, feePayer, amount); err != nil {
+		return err
+	}
+
+	topUp.TotalToppedUp = topUp.TotalToppedUp.Add(amount...)
+	k.SetFeePayerTopUp(ctx, topUp)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTopUpFeePayer,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnel.ID)),
+		sdk.NewAttribute(types.AttributeKeyFunder, topUp.Funder),
+		sdk.NewAttribute(types.AttributeKeyFeePayer, tunnel.FeePayer),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+	))
+
+	return nil
+}
+
+// TryTopUpFeePayer refills the fee payer of a tunnel. If the refill fails, it emits an event
+// and leaves the state unchanged.
+func (k Keeper) TryTopUpFeePayer(ctx sdk.Context, tunnel types.Tunnel) {
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := k.TopUpFeePayer(cacheCtx, tunnel); err != nil {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeTopUpFeePayerFail,
+			sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnel.ID)),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		))
+		return
+	}
+
+	writeFn()
+}
+
+// UpdateLowBalanceThreshold updates the low balance threshold of a tunnel.
+func (k Keeper) UpdateLowBalanceThreshold(ctx sdk.Context, tunnelID uint64, threshold sdk.Coins) error {
+	tunnel, err := k.GetTunnel(ctx, tunnelID)
+	if err != nil {
+		return err
+	}
+
+	tunnel.LowBalanceThreshold = threshold
+	k.SetTunnel(ctx, tunnel)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateLowBalanceThreshold,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnelID)),
+		sdk.NewAttribute(types.AttributeKeyLowBalanceThreshold, threshold.String()),
+	))
+
+	return nil
+}
+
+// CheckFeePayerBalance emits a low balance event if the balance of the fee payer of a tunnel
+// is below its low balance threshold.
+func (k Keeper) CheckFeePayerBalance(ctx sdk.Context, tunnel types.Tunnel) {
+	if tunnel.LowBalanceThreshold.IsZero() {
+		return
+	}
+
+	balance := k.bankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(tunnel.FeePayer))
+	if balance.IsAllGTE(tunnel.LowBalanceThreshold) {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFeePayerLowBalance,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnel.ID)),
+		sdk.NewAttribute(types.AttributeKeyFeePayer, tunnel.FeePayer),
+		sdk.NewAttribute(types.AttributeKeyBalance, balance.String()),
+		sdk.NewAttribute(types.AttributeKeyLowBalanceThreshold, tunnel.LowBalanceThreshold.String()),
+	))
+}
+
+// GetPacketFee returns the fee charged to the fee payer of a tunnel for a packet, i.e. the base
+// packet fee plus the fee of the route.
+func (k Keeper) GetPacketFee(ctx sdk.Context, tunnel types.Tunnel) (sdk.Coins, error) {
+	route, err := tunnel.GetRouteValue()
+	if err != nil {
+		return nil, err
+	}
+
+	routeFee, err := k.GetRouteFee(ctx, route)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.GetParams(ctx).BasePacketFee.Add(routeFee...), nil
+}
+
+// GetRouteFee returns the fee charged by the given route for delivering a packet.
+func (k Keeper) GetRouteFee(ctx sdk.Context, route types.RouteI) (sdk.Coins, error) {
+	switch r := route.(type) {
+	case *types.TSSRoute:
+		return k.bandtssKeeper.GetSigningFee(ctx)
+	case *types.RouterRoute:
+		return sdk.NewCoins(r.Fee), nil
+	default:
+		return sdk.NewCoins(), nil
+	}
+}
@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func (s *KeeperTestSuite) TestAddAndRemoveFeePayerTopUp() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+	creator := sdk.MustAccAddressFromBech32(tunnel.Creator)
+	funder := sdk.AccAddress([]byte("funder"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uband", 1000))
+
+	err := k.AddFeePayerTopUp(ctx, tunnel.ID, funder, coins, coins)
+	s.Require().NoError(err)
+
+	topUp, err := k.GetFeePayerTopUp(ctx, tunnel.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewFeePayerTopUp(tunnel.ID, funder.String(), coins, coins, nil), topUp)
+	s.Require().Equal([]types.FeePayerTopUp{topUp}, k.GetAllFeePayerTopUps(ctx))
+
+	// only the owner, an operator with the funds role or the funder can remove the top-up
+	err = k.RemoveFeePayerTopUp(ctx, tunnel.ID, sdk.AccAddress([]byte("other")))
+	s.Require().ErrorIs(err, types.ErrInvalidTopUpSender)
+
+	operator := sdk.AccAddress([]byte("operator"))
+	err = k.UpdateTunnelOperator(ctx, tunnel.ID, operator, []types.OperatorRole{types.OPERATOR_ROLE_CONFIG})
+	s.Require().NoError(err)
+
+	err = k.RemoveFeePayerTopUp(ctx, tunnel.ID, operator)
+	s.Require().ErrorIs(err, types.ErrInvalidTopUpSender)
+
+	err = k.RemoveFeePayerTopUp(ctx, tunnel.ID, creator)
+	s.Require().NoError(err)
+
+	_, err = k.GetFeePayerTopUp(ctx, tunnel.ID)
+	s.Require().ErrorIs(err, types.ErrFeePayerTopUpNotFound)
+
+	// tunnel must exist
+	err = k.AddFeePayerTopUp(ctx, 2, funder, coins, coins)
+	s.Require().ErrorIs(err, types.ErrTunnelNotFound)
+}
+
+func (s *KeeperTestSuite) TestTopUpFeePayer() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+	feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
+	funder := sdk.AccAddress([]byte("funder"))
+
+	k.SetFeePayerTopUp(ctx, types.NewFeePayerTopUp(
+		tunnel.ID,
+		funder.String(),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 1000)),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 1500)),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 1200)),
+	))
+
+	// the shortfall of 700uband is bounded by the remaining cap of 300uband
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uband", 300))
+	s.bankKeeper.EXPECT().GetAllBalances(ctx, feePayer).Return(sdk.NewCoins(sdk.NewInt64Coin("uband", 300)))
+	s.bankKeeper.EXPECT().SendCoins(ctx, funder, feePayer, amount).Return(nil)
+
+	err := k.TopUpFeePayer(ctx, *tunnel)
+	s.Require().NoError(err)
+
+	topUp, err := k.GetFeePayerTopUp(ctx, tunnel.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uband", 1500)), topUp.TotalToppedUp)
+
+	// the cap is reached, so no more refill
+	s.bankKeeper.EXPECT().GetAllBalances(ctx, feePayer).Return(sdk.NewCoins())
+
+	err = k.TopUpFeePayer(ctx, *tunnel)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestTryTopUpFeePayerFail() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+	feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
+	funder := sdk.AccAddress([]byte("funder"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uband", 1000))
+
+	k.SetFeePayerTopUp(ctx, types.NewFeePayerTopUp(tunnel.ID, funder.String(), coins, coins, sdk.NewCoins()))
+
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feePayer).Return(sdk.NewCoins())
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), funder, feePayer, coins).Return(fmt.Errorf("insufficient funds"))
+
+	k.TryTopUpFeePayer(ctx, *tunnel)
+
+	// the total topped up is unchanged
+	topUp, err := k.GetFeePayerTopUp(ctx, tunnel.ID)
+	s.Require().NoError(err)
+	s.Require().True(topUp.TotalToppedUp.IsZero())
+
+	events := ctx.EventManager().Events()
+	s.Require().Equal(types.EventTypeTopUpFeePayerFail, events[len(events)-1].Type)
+}
+
+func (s *KeeperTestSuite) TestCheckFeePayerBalance() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnelID := s.AddSampleTunnel(false).ID
+
+	err := k.UpdateLowBalanceThreshold(ctx, tunnelID, sdk.NewCoins(sdk.NewInt64Coin("uband", 500)))
+	s.Require().NoError(err)
+
+	tunnel, err := k.GetTunnel(ctx, tunnelID)
+	s.Require().NoError(err)
+	feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
+
+	// balance above the threshold
+	s.bankKeeper.EXPECT().GetAllBalances(ctx, feePayer).Return(sdk.NewCoins(sdk.NewInt64Coin("uband", 500)))
+	numEvents := len(ctx.EventManager().Events())
+	k.CheckFeePayerBalance(ctx, tunnel)
+	s.Require().Len(ctx.EventManager().Events(), numEvents)
+
+	// balance below the threshold
+	s.bankKeeper.EXPECT().GetAllBalances(ctx, feePayer).Return(sdk.NewCoins(sdk.NewInt64Coin("uband", 499)))
+	k.CheckFeePayerBalance(ctx, tunnel)
+
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, numEvents+1)
+	s.Require().Equal(sdk.NewEvent(
+		types.EventTypeFeePayerLowBalance,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnel.ID)),
+		sdk.NewAttribute(types.AttributeKeyFeePayer, tunnel.FeePayer),
+		sdk.NewAttribute(types.AttributeKeyBalance, "499uband"),
+		sdk.NewAttribute(types.AttributeKeyLowBalanceThreshold, "500uband"),
+	), events[len(events)-1])
+}
@@ -26,6 +26,19 @@ func (k Keeper) GetLatestPrices(ctx sdk.Context, tunnelID uint64) (types.LatestP
 	return latestPrices, nil
 }
 
+// ResetLastScheduledAt sets the last scheduled time of the tunnel to the current block time,
+// so that its schedule only fires at the next matching time from now.
+func (k Keeper) ResetLastScheduledAt(ctx sdk.Context, tunnelID uint64) error {
+	latestPrices, err := k.GetLatestPrices(ctx, tunnelID)
+	if err != nil {
+		return err
+	}
+
+	latestPrices.LastScheduledAt = ctx.BlockTime().Unix()
+	k.SetLatestPrices(ctx, latestPrices)
+	return nil
+}
+
 // GetAllLatestPrices gets all the latest prices from the store
 func (k Keeper) GetAllLatestPrices(ctx sdk.Context) []types.LatestPrices {
 	var allLatestPrices []types.LatestPrices
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// SetTunnelOperator sets an operator of a tunnel in the store
+func (k Keeper) SetTunnelOperator(ctx sdk.Context, operator types.TunnelOperator) {
+	ctx.KVStore(k.storeKey).Set(
+		types.TunnelOperatorStoreKey(operator.TunnelID, sdk.MustAccAddressFromBech32(operator.Address)),
+		k.cdc.MustMarshal(&operator),
+	)
+}
+
+// GetTunnelOperator retrieves an operator of a tunnel from the store
+func (k Keeper) GetTunnelOperator(
+	ctx sdk.Context,
+	tunnelID uint64,
+	operator sdk.AccAddress,
+) (types.TunnelOperator, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.TunnelOperatorStoreKey(tunnelID, operator))
+	if bz == nil {
+		return types.TunnelOperator{}, false
+	}
+
+	var o types.TunnelOperator
+	k.cdc.MustUnmarshal(bz, &o)
+	return o, true
+}
+
+// DeleteTunnelOperator deletes an operator of a tunnel from the store
+func (k Keeper) DeleteTunnelOperator(ctx sdk.Context, tunnelID uint64, operator sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.TunnelOperatorStoreKey(tunnelID, operator))
+}
+
+// GetTunnelOperators returns all operators of a tunnel from the store
+func (k Keeper) GetTunnelOperators(ctx sdk.Context, tunnelID uint64) []types.TunnelOperator {
+	return k.getTunnelOperatorsByPrefix(ctx, types.TunnelOperatorsStoreKey(tunnelID))
+}
+
+// GetAllTunnelOperators returns all operators of all tunnels from the store
+func (k Keeper) GetAllTunnelOperators(ctx sdk.Context) []types.TunnelOperator {
+	return k.getTunnelOperatorsByPrefix(ctx, types.TunnelOperatorStoreKeyPrefix)
+}
+
+// getTunnelOperatorsByPrefix returns the operators whose keys start with the given prefix
+func (k Keeper) getTunnelOperatorsByPrefix(ctx sdk.Context, prefix []byte) []types.TunnelOperator {
+	var operators []types.TunnelOperator
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var o types.TunnelOperator
+		k.cdc.MustUnmarshal(iterator.Value(), &o)
+		operators = append(operators, o)
+	}
+	return operators
+}
+
+// UpdateTunnelOperator sets the roles of an operator of a tunnel. Empty roles remove the operator.
+func (k Keeper) UpdateTunnelOperator(
+	ctx sdk.Context,
+	tunnelID uint64,
+	operator sdk.AccAddress,
+	roles []types.OperatorRole,
+) error {
+	if _, err := k.GetTunnel(ctx, tunnelID); err != nil {
+		return err
+	}
+
+	if len(roles) == 0 {
+		k.DeleteTunnelOperator(ctx, tunnelID, operator)
+	} else {
+		k.SetTunnelOperator(ctx, types.NewTunnelOperator(tunnelID, operator.String(), roles))
+	}
+
+	event := sdk.NewEvent(
+		types.EventTypeSetTunnelOperator,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnelID)),
+		sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+	)
+	for _, role := range roles {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyRole, role.String()))
+	}
+	ctx.EventManager().EmitEvent(event)
+
+	return nil
+}
+
+// TransferTunnelOwnership transfers the ownership of a tunnel to the new owner. The operators
+// of the tunnel are kept.
+func (k Keeper) TransferTunnelOwnership(ctx sdk.Context, tunnelID uint64, newOwner sdk.AccAddress) error {
+	tunnel, err := k.GetTunnel(ctx, tunnelID)
+	if err != nil {
+		return err
+	}
+
+	previousOwner := tunnel.Owner
+	tunnel.Owner = newOwner.String()
+	k.SetTunnel(ctx, tunnel)
+
+	// the new owner has every permission, so it does not need to be an operator
+	k.DeleteTunnelOperator(ctx, tunnelID, newOwner)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTransferTunnelOwnership,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnelID)),
+		sdk.NewAttribute(types.AttributeKeyOwner, previousOwner),
+		sdk.NewAttribute(types.AttributeKeyNewOwner, tunnel.Owner),
+	))
+
+	return nil
+}
+
+// HasTunnelPermission returns true if the given address is the owner of the tunnel or an
+// operator of the tunnel with the given role.
+func (k Keeper) HasTunnelPermission(
+	ctx sdk.Context,
+	tunnel types.Tunnel,
+	addr string,
+	role types.OperatorRole,
+) bool {
+	if addr == tunnel.Owner {
+		return true
+	}
+
+	acc, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return false
+	}
+
+	operator, found := k.GetTunnelOperator(ctx, tunnel.ID, acc)
+	return found && operator.HasRole(role)
+}
+
+// ValidateTunnelPermission returns an error if the given address is neither the owner of the
+// tunnel nor an operator of the tunnel with the given role.
+func (k Keeper) ValidateTunnelPermission(
+	ctx sdk.Context,
+	tunnel types.Tunnel,
+	addr string,
+	role types.OperatorRole,
+) error {
+	if !k.HasTunnelPermission(ctx, tunnel, addr, role) {
+		return types.ErrNoTunnelPermission.Wrapf("address %s, role %s, tunnelID %d", addr, role, tunnel.ID)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateTunnelOperator() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+	operator := sdk.AccAddress([]byte("operator_address"))
+	roles := []types.OperatorRole{types.OPERATOR_ROLE_TRIGGER, types.OPERATOR_ROLE_FUNDS}
+
+	err := k.UpdateTunnelOperator(ctx, tunnel.ID, operator, roles)
+	s.Require().NoError(err)
+
+	o, found := k.GetTunnelOperator(ctx, tunnel.ID, operator)
+	s.Require().True(found)
+	s.Require().Equal(types.NewTunnelOperator(tunnel.ID, operator.String(), roles), o)
+	s.Require().Equal([]types.TunnelOperator{o}, k.GetTunnelOperators(ctx, tunnel.ID))
+	s.Require().Equal([]types.TunnelOperator{o}, k.GetAllTunnelOperators(ctx))
+
+	events := ctx.EventManager().Events()
+	s.Require().Equal(sdk.NewEvent(
+		types.EventTypeSetTunnelOperator,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnel.ID)),
+		sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+		sdk.NewAttribute(types.AttributeKeyRole, types.OPERATOR_ROLE_TRIGGER.String()),
+		sdk.NewAttribute(types.AttributeKeyRole, types.OPERATOR_ROLE_FUNDS.String()),
+	), events[len(events)-1])
+
+	// empty roles remove the operator
+	err = k.UpdateTunnelOperator(ctx, tunnel.ID, operator, nil)
+	s.Require().NoError(err)
+
+	_, found = k.GetTunnelOperator(ctx, tunnel.ID, operator)
+	s.Require().False(found)
+	s.Require().Empty(k.GetTunnelOperators(ctx, tunnel.ID))
+
+	// tunnel must exist
+	err = k.UpdateTunnelOperator(ctx, 2, operator, roles)
+	s.Require().ErrorIs(err, types.ErrTunnelNotFound)
+}
+
+func (s *KeeperTestSuite) TestTransferTunnelOwnership() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnelID := s.AddSampleTunnel(false).ID
+	operator := sdk.AccAddress([]byte("operator_address"))
+	newOwner := sdk.AccAddress([]byte("multisig_address"))
+
+	err := k.UpdateTunnelOperator(ctx, tunnelID, operator, []types.OperatorRole{types.OPERATOR_ROLE_TRIGGER})
+	s.Require().NoError(err)
+
+	err = k.TransferTunnelOwnership(ctx, tunnelID, newOwner)
+	s.Require().NoError(err)
+
+	tunnel, err := k.GetTunnel(ctx, tunnelID)
+	s.Require().NoError(err)
+	s.Require().Equal(newOwner.String(), tunnel.Owner)
+
+	// the operators are kept after the transfer
+	_, found := k.GetTunnelOperator(ctx, tunnelID, operator)
+	s.Require().True(found)
+
+	events := ctx.EventManager().Events()
+	s.Require().Equal(sdk.NewEvent(
+		types.EventTypeTransferTunnelOwnership,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnelID)),
+		sdk.NewAttribute(types.AttributeKeyOwner, tunnel.Creator),
+		sdk.NewAttribute(types.AttributeKeyNewOwner, newOwner.String()),
+	), events[len(events)-1])
+
+	// tunnel must exist
+	err = k.TransferTunnelOwnership(ctx, 2, newOwner)
+	s.Require().ErrorIs(err, types.ErrTunnelNotFound)
+}
+
+func (s *KeeperTestSuite) TestValidateTunnelPermission() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+	operator := sdk.AccAddress([]byte("operator_address"))
+
+	err := k.UpdateTunnelOperator(ctx, tunnel.ID, operator, []types.OperatorRole{types.OPERATOR_ROLE_TRIGGER})
+	s.Require().NoError(err)
+
+	// the owner has every role
+	s.Require().NoError(k.ValidateTunnelPermission(ctx, *tunnel, tunnel.Owner, types.OPERATOR_ROLE_TRIGGER))
+	s.Require().NoError(k.ValidateTunnelPermission(ctx, *tunnel, tunnel.Owner, types.OPERATOR_ROLE_CONFIG))
+	s.Require().NoError(k.ValidateTunnelPermission(ctx, *tunnel, tunnel.Owner, types.OPERATOR_ROLE_FUNDS))
+
+	// the operator only has its roles
+	s.Require().NoError(k.ValidateTunnelPermission(ctx, *tunnel, operator.String(), types.OPERATOR_ROLE_TRIGGER))
+	err = k.ValidateTunnelPermission(ctx, *tunnel, operator.String(), types.OPERATOR_ROLE_CONFIG)
+	s.Require().ErrorIs(err, types.ErrNoTunnelPermission)
+	err = k.ValidateTunnelPermission(ctx, *tunnel, operator.String(), types.OPERATOR_ROLE_FUNDS)
+	s.Require().ErrorIs(err, types.ErrNoTunnelPermission)
+
+	// other accounts have no role
+	other := sdk.AccAddress([]byte("other_address")).String()
+	err = k.ValidateTunnelPermission(ctx, *tunnel, other, types.OPERATOR_ROLE_TRIGGER)
+	s.Require().ErrorIs(err, types.ErrNoTunnelPermission)
+}
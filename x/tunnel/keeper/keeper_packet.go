@@ -96,7 +96,13 @@ func (k Keeper) ProducePacket(
 	latestPricesMap := CreatePricesMap(latestPrices.Prices)
 
 	// check if the interval has passed
-	sendAll := unixNow >= int64(tunnel.Interval)+latestPrices.LastInterval
+	intervalTriggered := unixNow >= int64(tunnel.Interval)+latestPrices.LastInterval
+
+	// check if the schedule is due
+	scheduleTriggered := tunnel.Schedule != nil &&
+		tunnel.Schedule.IsDue(latestPrices.LastScheduledAt, ctx.BlockHeight(), ctx.BlockTime())
+
+	sendAll := intervalTriggered || scheduleTriggered
 
 	// generate newPrices; if no newPrices, stop the process.
 	newPrices := GenerateNewPrices(
@@ -110,6 +116,9 @@ func (k Keeper) ProducePacket(
 		return nil
 	}
 
+	// refill the fee payer before charging the fees of the packet
+	k.TryTopUpFeePayer(ctx, tunnel)
+
 	// create a new packet
 	packet, err := k.CreatePacket(ctx, tunnel.ID, newPrices)
 	if err != nil {
@@ -123,9 +132,12 @@ func (k Keeper) ProducePacket(
 
 	// update latest price info.
 	latestPrices.UpdatePrices(newPrices)
-	if sendAll {
+	if intervalTriggered {
 		latestPrices.LastInterval = unixNow
 	}
+	if scheduleTriggered {
+		latestPrices.LastScheduledAt = unixNow
+	}
 	k.SetLatestPrices(ctx, latestPrices)
 
 	// emit an event
@@ -135,6 +147,8 @@ func (k Keeper) ProducePacket(
 		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
 	))
 
+	k.CheckFeePayerBalance(ctx, tunnel)
+
 	return nil
 }
 
@@ -208,6 +222,14 @@ func (k Keeper) SendPacket(ctx sdk.Context, packet types.Packet) (err error) {
 		)
 	case *types.IBCRoute:
 		receipt, err = k.SendIBCPacket(ctx, r, packet, tunnel.Interval)
+	case *types.RouterRoute:
+		receipt, err = k.SendRouterPacket(
+			ctx,
+			r,
+			packet,
+			sdk.MustAccAddressFromBech32(tunnel.FeePayer),
+			tunnel.Interval,
+		)
 	default:
 		return types.ErrInvalidRoute.Wrapf("no route found for tunnel ID: %d", tunnel.ID)
 	}
@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -52,3 +54,200 @@ func (k Keeper) SendIBCPacket(
 
 	return types.NewIBCPacketReceipt(sequence), nil
 }
+
+// OnAcknowledgementIBCPacket updates the delivery status of a tunnel packet from its IBC acknowledgement.
+func (k Keeper) OnAcknowledgementIBCPacket(
+	ctx sdk.Context,
+	data types.TunnelPricesPacketData,
+	ibcSequence uint64,
+	ack channeltypes.Acknowledgement,
+) error {
+	packet, receipt, found := k.getPendingIBCPacket(ctx, data.TunnelID, data.Sequence, ibcSequence)
+	if !found {
+		return nil
+	}
+
+	if ack.Success() {
+		k.SetFailureCount(ctx, data.TunnelID, 0)
+		return k.setIBCPacketStatus(ctx, packet, receipt, types.PACKET_STATUS_ACKNOWLEDGED)
+	}
+
+	if err := k.setIBCPacketStatus(ctx, packet, receipt, types.PACKET_STATUS_ERROR_ACK); err != nil {
+		return err
+	}
+
+	return k.handleDeliveryFailure(ctx, data.TunnelID)
+}
+
+// OnTimeoutIBCPacket marks a tunnel packet as timed out and re-sends it if the params allow.
+func (k Keeper) OnTimeoutIBCPacket(
+	ctx sdk.Context,
+	data types.TunnelPricesPacketData,
+	ibcSequence uint64,
+) error {
+	packet, receipt, found := k.getPendingIBCPacket(ctx, data.TunnelID, data.Sequence, ibcSequence)
+	if !found {
+		return nil
+	}
+
+	if err := k.setIBCPacketStatus(ctx, packet, receipt, types.PACKET_STATUS_TIMED_OUT); err != nil {
+		return err
+	}
+
+	if err := k.handleDeliveryFailure(ctx, data.TunnelID); err != nil {
+		return err
+	}
+
+	if !k.GetParams(ctx).ResendTimedOutPacket {
+		return nil
+	}
+
+	// re-sending is best effort; a failure must not revert the timeout itself.
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := k.ResendIBCPacket(cacheCtx, packet); err != nil {
+		k.Logger(ctx).Error(
+			"failed to re-send timed out packet",
+			"tunnel_id", data.TunnelID,
+			"sequence", data.Sequence,
+			"error", err,
+		)
+		return nil
+	}
+	writeFn()
+
+	return nil
+}
+
+// ResendIBCPacket re-sends a packet of an active IBC tunnel if it is still the latest packet of the tunnel.
+func (k Keeper) ResendIBCPacket(ctx sdk.Context, packet types.Packet) error {
+	tunnel, err := k.GetTunnel(ctx, packet.TunnelID)
+	if err != nil {
+		return err
+	}
+
+	// do not re-send stale prices once a newer packet has been produced
+	if !tunnel.IsActive || packet.Sequence != tunnel.Sequence {
+		return nil
+	}
+
+	route, err := tunnel.GetRouteValue()
+	if err != nil {
+		return err
+	}
+
+	ibcRoute, ok := route.(*types.IBCRoute)
+	if !ok {
+		return types.ErrInvalidRoute.Wrapf("tunnel %d is not an IBC tunnel", tunnel.ID)
+	}
+
+	receipt, err := k.SendIBCPacket(ctx, ibcRoute, packet, tunnel.Interval)
+	if err != nil {
+		return err
+	}
+
+	if err := packet.SetReceipt(receipt); err != nil {
+		return err
+	}
+	k.SetPacket(ctx, packet)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeResendPacket,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", packet.TunnelID)),
+		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+		sdk.NewAttribute(types.AttributeKeyIBCSequence, fmt.Sprintf("%d", receipt.(*types.IBCPacketReceipt).Sequence)),
+	))
+
+	return nil
+}
+
+// SetFailureCount sets the number of consecutive failed deliveries of a tunnel in the store
+func (k Keeper) SetFailureCount(ctx sdk.Context, tunnelID uint64, count uint64) {
+	ctx.KVStore(k.storeKey).Set(types.FailureCountStoreKey(tunnelID), sdk.Uint64ToBigEndian(count))
+}
+
+// GetFailureCount retrieves the number of consecutive failed deliveries of a tunnel from the store
+func (k Keeper) GetFailureCount(ctx sdk.Context, tunnelID uint64) uint64 {
+	return sdk.BigEndianToUint64(ctx.KVStore(k.storeKey).Get(types.FailureCountStoreKey(tunnelID)))
+}
+
+// handleDeliveryFailure counts a failed delivery of a tunnel and deactivates the tunnel
+// once the number of consecutive failures reaches the maximum.
+func (k Keeper) handleDeliveryFailure(ctx sdk.Context, tunnelID uint64) error {
+	count := k.GetFailureCount(ctx, tunnelID) + 1
+	k.SetFailureCount(ctx, tunnelID, count)
+
+	maxFailures := k.GetParams(ctx).MaxConsecutiveFailures
+	if maxFailures == 0 || count < maxFailures {
+		return nil
+	}
+
+	tunnel, err := k.GetTunnel(ctx, tunnelID)
+	if err != nil {
+		return err
+	}
+
+	if !tunnel.IsActive {
+		return nil
+	}
+
+	// reset the counter so that the tunnel starts over when it is activated again
+	k.SetFailureCount(ctx, tunnelID, 0)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeProducePacketFail,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnelID)),
+		sdk.NewAttribute(types.AttributeKeyReason, fmt.Sprintf("%d consecutive delivery failures", count)),
+	))
+
+	return k.DeactivateTunnel(ctx, tunnelID)
+}
+
+// getPendingIBCPacket returns the packet and its IBC receipt if the receipt still refers to the given IBC sequence.
+// Acknowledgements and timeouts of packets that have been re-sent or no longer exist are ignored.
+func (k Keeper) getPendingIBCPacket(
+	ctx sdk.Context,
+	tunnelID uint64,
+	sequence uint64,
+	ibcSequence uint64,
+) (types.Packet, *types.IBCPacketReceipt, bool) {
+	packet, err := k.GetPacket(ctx, tunnelID, sequence)
+	if err != nil {
+		return types.Packet{}, nil, false
+	}
+
+	r, err := packet.GetReceiptValue()
+	if err != nil {
+		return types.Packet{}, nil, false
+	}
+
+	receipt, ok := r.(*types.IBCPacketReceipt)
+	if !ok || receipt.Sequence != ibcSequence {
+		return types.Packet{}, nil, false
+	}
+
+	return packet, receipt, true
+}
+
+// setIBCPacketStatus updates the status in the IBC receipt of the packet and stores the packet.
+func (k Keeper) setIBCPacketStatus(
+	ctx sdk.Context,
+	packet types.Packet,
+	receipt *types.IBCPacketReceipt,
+	status types.PacketStatus,
+) error {
+	receipt.Status = status
+	if err := packet.SetReceipt(receipt); err != nil {
+		return err
+	}
+	k.SetPacket(ctx, packet)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdatePacketStatus,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", packet.TunnelID)),
+		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+		sdk.NewAttribute(types.AttributeKeyIBCSequence, fmt.Sprintf("%d", receipt.Sequence)),
+		sdk.NewAttribute(types.AttributeKeyStatus, status.String()),
+	))
+
+	return nil
+}
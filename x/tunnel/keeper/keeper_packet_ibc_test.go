@@ -7,6 +7,7 @@ import (
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 
 	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 	"github.com/bandprotocol/chain/v3/x/tunnel/types"
@@ -38,3 +39,110 @@ func (s *KeeperTestSuite) TestSendIBCPacket() {
 	s.Require().True(ok)
 	s.Require().Equal(uint64(1), packetReceipt.Sequence)
 }
+
+func (s *KeeperTestSuite) setupPendingIBCPacket() types.Packet {
+	ctx, k := s.ctx, s.keeper
+
+	s.AddSampleIBCTunnel(true)
+
+	tunnel, err := k.GetTunnel(ctx, 1)
+	s.Require().NoError(err)
+	err = tunnel.SetRoute(types.NewIBCRoute("channel-0"))
+	s.Require().NoError(err)
+	tunnel.Sequence = 1
+	k.SetTunnel(ctx, tunnel)
+
+	packet := types.NewPacket(1, 1, []feedstypes.Price{}, ctx.BlockTime().Unix())
+	err = packet.SetReceipt(types.NewIBCPacketReceipt(7))
+	s.Require().NoError(err)
+	k.SetPacket(ctx, packet)
+
+	return packet
+}
+
+func (s *KeeperTestSuite) requireIBCPacketStatus(sequence uint64, status types.PacketStatus) *types.IBCPacketReceipt {
+	packet, err := s.keeper.GetPacket(s.ctx, 1, sequence)
+	s.Require().NoError(err)
+
+	receipt, ok := packet.Receipt.GetCachedValue().(*types.IBCPacketReceipt)
+	s.Require().True(ok)
+	s.Require().Equal(status, receipt.Status)
+
+	return receipt
+}
+
+func (s *KeeperTestSuite) TestOnAcknowledgementIBCPacket() {
+	ctx, k := s.ctx, s.keeper
+
+	packet := s.setupPendingIBCPacket()
+	data := types.NewTunnelPricesPacketData(packet.TunnelID, packet.Sequence, packet.Prices, packet.CreatedAt)
+	k.SetFailureCount(ctx, 1, 2)
+
+	// acknowledgement of a stale IBC sequence is ignored
+	err := k.OnAcknowledgementIBCPacket(ctx, data, 6, channeltypes.NewResultAcknowledgement([]byte{0x01}))
+	s.Require().NoError(err)
+	s.requireIBCPacketStatus(1, types.PACKET_STATUS_PENDING)
+
+	err = k.OnAcknowledgementIBCPacket(ctx, data, 7, channeltypes.NewResultAcknowledgement([]byte{0x01}))
+	s.Require().NoError(err)
+	s.requireIBCPacketStatus(1, types.PACKET_STATUS_ACKNOWLEDGED)
+	s.Require().Equal(uint64(0), k.GetFailureCount(ctx, 1))
+}
+
+func (s *KeeperTestSuite) TestOnAcknowledgementIBCPacketErrorDeactivatesTunnel() {
+	ctx, k := s.ctx, s.keeper
+
+	params := k.GetParams(ctx)
+	params.MaxConsecutiveFailures = 3
+	err := k.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	packet := s.setupPendingIBCPacket()
+	data := types.NewTunnelPricesPacketData(packet.TunnelID, packet.Sequence, packet.Prices, packet.CreatedAt)
+	k.SetFailureCount(ctx, 1, 2)
+
+	err = k.OnAcknowledgementIBCPacket(ctx, data, 7, channeltypes.NewErrorAcknowledgement(types.ErrInvalidRoute))
+	s.Require().NoError(err)
+	s.requireIBCPacketStatus(1, types.PACKET_STATUS_ERROR_ACK)
+
+	tunnel, err := k.GetTunnel(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().False(tunnel.IsActive)
+	s.Require().Equal(uint64(0), k.GetFailureCount(ctx, 1))
+}
+
+func (s *KeeperTestSuite) TestOnTimeoutIBCPacket() {
+	ctx, k := s.ctx, s.keeper
+
+	packet := s.setupPendingIBCPacket()
+	data := types.NewTunnelPricesPacketData(packet.TunnelID, packet.Sequence, packet.Prices, packet.CreatedAt)
+
+	err := k.OnTimeoutIBCPacket(ctx, data, 7)
+	s.Require().NoError(err)
+	s.requireIBCPacketStatus(1, types.PACKET_STATUS_TIMED_OUT)
+	s.Require().Equal(uint64(1), k.GetFailureCount(ctx, 1))
+}
+
+func (s *KeeperTestSuite) TestOnTimeoutIBCPacketResend() {
+	ctx, k := s.ctx, s.keeper
+
+	params := k.GetParams(ctx)
+	params.ResendTimedOutPacket = true
+	err := k.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	packet := s.setupPendingIBCPacket()
+	data := types.NewTunnelPricesPacketData(packet.TunnelID, packet.Sequence, packet.Prices, packet.CreatedAt)
+
+	s.scopedKeeper.EXPECT().GetCapability(gomock.Any(), gomock.Any()).Return(&capabilitytypes.Capability{}, true)
+	s.icsWrapper.EXPECT().
+		SendPacket(gomock.Any(), gomock.Any(), "tunnel.1", "channel-0", clienttypes.NewHeight(0, 0), gomock.Any(), data.GetBytes()).
+		Return(uint64(8), nil)
+
+	err = k.OnTimeoutIBCPacket(ctx, data, 7)
+	s.Require().NoError(err)
+
+	receipt := s.requireIBCPacketStatus(1, types.PACKET_STATUS_PENDING)
+	s.Require().Equal(uint64(8), receipt.Sequence)
+	s.Require().Equal(uint64(1), k.GetFailureCount(ctx, 1))
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// GetOldestPacketSequence returns the sequence of the oldest packet of a tunnel kept in the store.
+func (k Keeper) GetOldestPacketSequence(ctx sdk.Context, tunnelID uint64) (uint64, bool) {
+	prefix := types.TunnelPacketsStoreKey(tunnelID)
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(iterator.Key()[len(prefix):]), true
+}
+
+// PrunePackets removes the packets of all tunnels that exceed the maximum number of retained packets
+// or the retention period, up to MaxPrunedPacketsPerBlock packets in total.
+func (k Keeper) PrunePackets(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.MaxRetainedPackets == 0 && params.PacketRetentionPeriod == 0 {
+		return
+	}
+
+	limit := uint64(types.MaxPrunedPacketsPerBlock)
+	for id := uint64(1); id <= k.GetTunnelCount(ctx) && limit > 0; id++ {
+		limit -= k.PruneTunnelPackets(ctx, id, params.MaxRetainedPackets, params.PacketRetentionPeriod, limit)
+	}
+}
+
+// PruneTunnelPackets removes the oldest packets of a tunnel, up to the given limit, while there are more
+// than maxRetained packets or the packet is older than the retention period. A packet that is still
+// waiting for its IBC acknowledgement stops the pruning. It emits an event with the hash of the
+// removed packets and returns the number of removed packets.
+func (k Keeper) PruneTunnelPackets(
+	ctx sdk.Context,
+	tunnelID uint64,
+	maxRetained uint64,
+	retentionPeriod uint64,
+	limit uint64,
+) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.TunnelPacketsStoreKey(tunnelID)
+
+	// the latest packet is the last one in the store
+	reverseIterator := storetypes.KVStoreReversePrefixIterator(store, prefix)
+	if !reverseIterator.Valid() {
+		reverseIterator.Close()
+		return 0
+	}
+	latestSequence := sdk.BigEndianToUint64(reverseIterator.Key()[len(prefix):])
+	reverseIterator.Close()
+
+	var (
+		keys           [][]byte
+		encodedPackets [][]byte
+		fromSequence   uint64
+		toSequence     uint64
+	)
+
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	for ; iterator.Valid() && uint64(len(keys)) < limit; iterator.Next() {
+		var packet types.Packet
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+
+		exceedsCount := maxRetained > 0 && latestSequence-packet.Sequence >= maxRetained
+		exceedsAge := retentionPeriod > 0 && packet.CreatedAt+int64(retentionPeriod) <= ctx.BlockTime().Unix()
+		if (!exceedsCount && !exceedsAge) || packet.IsPendingDelivery() {
+			break
+		}
+
+		if len(keys) == 0 {
+			fromSequence = packet.Sequence
+		}
+		toSequence = packet.Sequence
+
+		keys = append(keys, append([]byte(nil), iterator.Key()...))
+		encodedPackets = append(encodedPackets, append([]byte(nil), iterator.Value()...))
+	}
+	iterator.Close()
+
+	if len(keys) == 0 {
+		return 0
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePrunePackets,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnelID)),
+		sdk.NewAttribute(types.AttributeKeyFromSequence, fmt.Sprintf("%d", fromSequence)),
+		sdk.NewAttribute(types.AttributeKeyToSequence, fmt.Sprintf("%d", toSequence)),
+		sdk.NewAttribute(types.AttributeKeyPacketsHash, hex.EncodeToString(types.ComputePacketsHash(encodedPackets))),
+	))
+
+	return uint64(len(keys))
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// setSamplePackets stores packets with sequences 1 to n, where the packet with sequence i is
+// created (n-i+1)*100 seconds before the block time.
+func (s *KeeperTestSuite) setSamplePackets(tunnelID uint64, n uint64) []types.Packet {
+	ctx, k := s.ctx, s.keeper
+
+	var packets []types.Packet
+	for i := uint64(1); i <= n; i++ {
+		packet := types.NewPacket(tunnelID, i, nil, ctx.BlockTime().Unix()-int64(n-i+1)*100)
+		k.SetPacket(ctx, packet)
+		packets = append(packets, packet)
+	}
+	return packets
+}
+
+func (s *KeeperTestSuite) TestGetOldestPacketSequence() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+
+	_, found := k.GetOldestPacketSequence(ctx, tunnel.ID)
+	s.Require().False(found)
+
+	s.setSamplePackets(tunnel.ID, 3)
+
+	sequence, found := k.GetOldestPacketSequence(ctx, tunnel.ID)
+	s.Require().True(found)
+	s.Require().Equal(uint64(1), sequence)
+}
+
+func (s *KeeperTestSuite) TestPruneTunnelPacketsByCount() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+	packets := s.setSamplePackets(tunnel.ID, 5)
+
+	var encodedPackets [][]byte
+	for _, packet := range packets[:3] {
+		encodedPackets = append(
+			encodedPackets,
+			ctx.KVStore(s.storeKey).Get(types.TunnelPacketStoreKey(tunnel.ID, packet.Sequence)),
+		)
+	}
+
+	pruned := k.PruneTunnelPackets(ctx, tunnel.ID, 2, 0, types.MaxPrunedPacketsPerBlock)
+	s.Require().Equal(uint64(3), pruned)
+
+	sequence, found := k.GetOldestPacketSequence(ctx, tunnel.ID)
+	s.Require().True(found)
+	s.Require().Equal(uint64(4), sequence)
+
+	events := ctx.EventManager().Events()
+	s.Require().Equal(sdk.NewEvent(
+		types.EventTypePrunePackets,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnel.ID)),
+		sdk.NewAttribute(types.AttributeKeyFromSequence, "1"),
+		sdk.NewAttribute(types.AttributeKeyToSequence, "3"),
+		sdk.NewAttribute(types.AttributeKeyPacketsHash, hex.EncodeToString(types.ComputePacketsHash(encodedPackets))),
+	), events[len(events)-1])
+
+	// nothing more to prune
+	numEvents := len(ctx.EventManager().Events())
+	pruned = k.PruneTunnelPackets(ctx, tunnel.ID, 2, 0, types.MaxPrunedPacketsPerBlock)
+	s.Require().Zero(pruned)
+	s.Require().Len(ctx.EventManager().Events(), numEvents)
+}
+
+func (s *KeeperTestSuite) TestPruneTunnelPacketsByAge() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+	s.setSamplePackets(tunnel.ID, 5)
+
+	// packets created at least 300 seconds ago are pruned
+	pruned := k.PruneTunnelPackets(ctx, tunnel.ID, 0, 300, types.MaxPrunedPacketsPerBlock)
+	s.Require().Equal(uint64(3), pruned)
+
+	sequence, found := k.GetOldestPacketSequence(ctx, tunnel.ID)
+	s.Require().True(found)
+	s.Require().Equal(uint64(4), sequence)
+}
+
+func (s *KeeperTestSuite) TestPruneTunnelPacketsStopsAtPendingPacket() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+	packets := s.setSamplePackets(tunnel.ID, 5)
+
+	err := packets[1].SetReceipt(&types.IBCPacketReceipt{Sequence: 1, Status: types.PACKET_STATUS_PENDING})
+	s.Require().NoError(err)
+	k.SetPacket(ctx, packets[1])
+
+	pruned := k.PruneTunnelPackets(ctx, tunnel.ID, 1, 0, types.MaxPrunedPacketsPerBlock)
+	s.Require().Equal(uint64(1), pruned)
+
+	sequence, found := k.GetOldestPacketSequence(ctx, tunnel.ID)
+	s.Require().True(found)
+	s.Require().Equal(uint64(2), sequence)
+}
+
+func (s *KeeperTestSuite) TestPrunePackets() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+	s.setSamplePackets(tunnel.ID, 5)
+
+	// the pruning is disabled by default
+	k.PrunePackets(ctx)
+
+	sequence, _ := k.GetOldestPacketSequence(ctx, tunnel.ID)
+	s.Require().Equal(uint64(1), sequence)
+
+	params := k.GetParams(ctx)
+	params.MaxRetainedPackets = 4
+	s.Require().NoError(k.SetParams(ctx, params))
+
+	k.PrunePackets(ctx)
+
+	sequence, _ = k.GetOldestPacketSequence(ctx, tunnel.ID)
+	s.Require().Equal(uint64(2), sequence)
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"time"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// SendRouterPacket sends a packet to the bridge chain via an ICS-20 transfer that carries
+// the encoded packet in its memo.
+func (k Keeper) SendRouterPacket(
+	ctx sdk.Context,
+	route *types.RouterRoute,
+	packet types.Packet,
+	feePayer sdk.AccAddress,
+	interval uint64,
+) (types.PacketReceiptI, error) {
+	payload, err := types.EncodeTSS(
+		packet.Sequence,
+		packet.Prices,
+		packet.CreatedAt,
+		route.Encoder,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	memo, err := types.NewRouterMemo(
+		route.DestinationChainID,
+		route.DestinationContractAddress,
+		route.DestinationGasLimit,
+		payload,
+	).String()
+	if err != nil {
+		return nil, err
+	}
+
+	// send the fee to the bridge along with the memo; the fee payer is the sender.
+	res, err := k.transferKeeper.Transfer(ctx, ibctransfertypes.NewMsgTransfer(
+		ibctransfertypes.PortID,
+		route.ChannelID,
+		route.Fee,
+		feePayer.String(),
+		route.BridgeContractAddress,
+		clienttypes.NewHeight(0, 0),
+		uint64(ctx.BlockTime().UnixNano())+interval*uint64(time.Second)*2,
+		memo,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewRouterPacketReceipt(res.Sequence), nil
+}
+
+// validateRouterRouteChannel checks that the transfer channel of the router route exists and is open.
+func (k Keeper) validateRouterRouteChannel(ctx sdk.Context, route *types.RouterRoute) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, ibctransfertypes.PortID, route.ChannelID)
+	if !found {
+		return types.ErrInvalidChannelID.Wrapf("transfer channel %s not found", route.ChannelID)
+	}
+	if channel.State != channeltypes.OPEN {
+		return types.ErrInvalidChannelID.Wrapf("transfer channel %s is not open", route.ChannelID)
+	}
+
+	return nil
+}
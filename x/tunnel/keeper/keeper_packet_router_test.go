@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func (s *KeeperTestSuite) TestSendRouterPacket() {
+	ctx, k := s.ctx, s.keeper
+
+	route := types.NewRouterRoute(
+		"channel-1",
+		"bridge1contract",
+		"ethereum-1",
+		"0x1234567890abcdef",
+		300000,
+		sdk.NewInt64Coin("uband", 1000),
+		feedstypes.ENCODER_FIXED_POINT_ABI,
+	)
+	packet := types.Packet{
+		TunnelID:  1,
+		Sequence:  1,
+		Prices:    []feedstypes.Price{},
+		CreatedAt: 1730358471,
+	}
+	interval := uint64(60)
+
+	s.transferKeeper.EXPECT().Transfer(ctx, gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, msg *ibctransfertypes.MsgTransfer) (*ibctransfertypes.MsgTransferResponse, error) {
+			s.Require().Equal(ibctransfertypes.PortID, msg.SourcePort)
+			s.Require().Equal(route.ChannelID, msg.SourceChannel)
+			s.Require().Equal(route.Fee, msg.Token)
+			s.Require().Equal(bandtesting.Alice.Address.String(), msg.Sender)
+			s.Require().Equal(route.BridgeContractAddress, msg.Receiver)
+			s.Require().
+				Equal(uint64(ctx.BlockTime().UnixNano())+interval*uint64(time.Second)*2, msg.TimeoutTimestamp)
+			s.Require().Contains(msg.Memo, `"destination_chain_id":"ethereum-1"`)
+			s.Require().Contains(msg.Memo, `"destination_gas_limit":300000`)
+
+			return &ibctransfertypes.MsgTransferResponse{Sequence: 5}, nil
+		},
+	)
+
+	content, err := k.SendRouterPacket(ctx, route, packet, bandtesting.Alice.Address, interval)
+	s.Require().NoError(err)
+
+	packetReceipt, ok := content.(*types.RouterPacketReceipt)
+	s.Require().True(ok)
+	s.Require().Equal(uint64(5), packetReceipt.Sequence)
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	"go.uber.org/mock/gomock"
 
 	sdkmath "cosmossdk.io/math"
@@ -163,6 +165,83 @@ func (s *KeeperTestSuite) TestProducePacket() {
 	s.Require().NoError(err)
 }
 
+func (s *KeeperTestSuite) TestProducePacketBySchedule() {
+	ctx, k := s.ctx, s.keeper
+	ctx = ctx.WithBlockTime(time.Date(2024, 12, 1, 16, 0, 5, 0, time.UTC))
+	now := ctx.BlockTime().Unix()
+
+	tunnelID := uint64(1)
+	pricesMap := map[string]feedstypes.Price{
+		"CS:BAND-USD": {
+			Status:    feedstypes.PRICE_STATUS_AVAILABLE,
+			SignalID:  "CS:BAND-USD",
+			Price:     50000,
+			Timestamp: now,
+		},
+	}
+	feePayer := sdk.AccAddress([]byte("fee_payer_address"))
+	tunnel := types.Tunnel{
+		ID:       1,
+		FeePayer: feePayer.String(),
+		IsActive: true,
+		SignalDeviations: []types.SignalDeviation{
+			{SignalID: "CS:BAND-USD", SoftDeviationBPS: 1000, HardDeviationBPS: 1000},
+		},
+		Interval:  3600,
+		Schedule:  types.NewSchedule("0 0,16 * * *", nil),
+		CreatedAt: now,
+	}
+	route := &types.TSSRoute{
+		DestinationChainID:         "chain-1",
+		DestinationContractAddress: "0x1234567890abcdef",
+	}
+	err := tunnel.SetRoute(route)
+	s.Require().NoError(err)
+	k.SetTunnel(ctx, tunnel)
+
+	// the price does not deviate and the interval has not passed, but the fixing time at 16:00 is due.
+	k.SetLatestPrices(ctx, types.LatestPrices{
+		TunnelID: tunnelID,
+		Prices: []feedstypes.Price{
+			{Status: feedstypes.PRICE_STATUS_AVAILABLE, SignalID: "CS:BAND-USD", Price: 50000, Timestamp: now - 60},
+		},
+		LastInterval:    now - 60,
+		LastScheduledAt: now - 3600,
+	})
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(ctx, feePayer, types.ModuleName, k.GetParams(ctx).BasePacketFee).
+		Return(nil)
+	s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any()).Return(
+		sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))), nil,
+	)
+	s.bandtssKeeper.EXPECT().CreateTunnelSigningRequest(
+		gomock.Any(),
+		uint64(1),
+		"chain-1",
+		"0x1234567890abcdef",
+		gomock.Any(),
+		feePayer,
+		sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))),
+	).Return(bandtsstypes.SigningID(1), nil)
+
+	err = k.ProducePacket(ctx, tunnelID, pricesMap)
+	s.Require().NoError(err)
+
+	latestPrices, err := k.GetLatestPrices(ctx, tunnelID)
+	s.Require().NoError(err)
+	s.Require().Equal(now, latestPrices.LastScheduledAt)
+	s.Require().Equal(now-60, latestPrices.LastInterval)
+
+	// the next fixing time is not due yet, so no packet is produced.
+	err = k.ProducePacket(ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute)), tunnelID, pricesMap)
+	s.Require().NoError(err)
+
+	tunnel, err = k.GetTunnel(ctx, tunnelID)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), tunnel.Sequence)
+}
+
 func (s *KeeperTestSuite) TestProduceActiveTunnelPackets() {
 	ctx, k := s.ctx, s.keeper
 
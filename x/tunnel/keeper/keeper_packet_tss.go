@@ -13,6 +13,16 @@ func (k Keeper) SendTSSPacket(
 	packet types.Packet,
 	feePayer sdk.AccAddress,
 ) (receipt types.PacketReceiptI, err error) {
+	tssFee, err := k.bandtssKeeper.GetSigningFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// batched packets are signed together at the end of the block.
+	if route.Batched {
+		return k.AddPendingTSSBatchPacket(ctx, packet, feePayer, tssFee)
+	}
+
 	content := types.NewTunnelSignatureOrder(
 		packet.Sequence,
 		packet.Prices,
@@ -20,11 +30,6 @@ func (k Keeper) SendTSSPacket(
 		route.Encoder,
 	)
 
-	tssFee, err := k.bandtssKeeper.GetSigningFee(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	// try signing TSS packet, if success, write the context.
 	signingID, err := k.bandtssKeeper.CreateTunnelSigningRequest(
 		ctx,
@@ -0,0 +1,295 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+
+	sdkerrors "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// tssBatchPacket is a pending packet of a batch together with the information needed to sign it.
+type tssBatchPacket struct {
+	pending  types.PendingTSSBatchPacket
+	packet   types.Packet
+	route    *types.TSSRoute
+	feePayer sdk.AccAddress
+}
+
+// AddPendingTSSBatchPacket escrows the signing fee from the fee payer and queues the packet to be
+// signed as a part of a batch at the end of the block. The returned receipt is completed once the
+// batch is signed.
+func (k Keeper) AddPendingTSSBatchPacket(
+	ctx sdk.Context,
+	packet types.Packet,
+	feePayer sdk.AccAddress,
+	tssFee sdk.Coins,
+) (types.PacketReceiptI, error) {
+	if !tssFee.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, feePayer, types.GetTSSBatchEscrowAddress(), tssFee); err != nil {
+			return nil, err
+		}
+	}
+
+	k.SetPendingTSSBatchPacket(ctx, types.PendingTSSBatchPacket{
+		TunnelID:    packet.TunnelID,
+		Sequence:    packet.Sequence,
+		EscrowedFee: tssFee,
+	})
+
+	return types.NewTSSBatchPacketReceipt(0, nil, 0, 0, nil), nil
+}
+
+// SetPendingTSSBatchPacket sets a pending TSS batch packet in the store
+func (k Keeper) SetPendingTSSBatchPacket(ctx sdk.Context, pending types.PendingTSSBatchPacket) {
+	ctx.KVStore(k.storeKey).Set(
+		types.PendingTSSBatchPacketStoreKey(pending.TunnelID, pending.Sequence),
+		k.cdc.MustMarshal(&pending),
+	)
+}
+
+// DeletePendingTSSBatchPacket deletes a pending TSS batch packet from the store
+func (k Keeper) DeletePendingTSSBatchPacket(ctx sdk.Context, tunnelID uint64, sequence uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.PendingTSSBatchPacketStoreKey(tunnelID, sequence))
+}
+
+// GetAllPendingTSSBatchPackets returns all pending TSS batch packets ordered by tunnel ID and sequence
+func (k Keeper) GetAllPendingTSSBatchPackets(ctx sdk.Context) []types.PendingTSSBatchPacket {
+	var pendings []types.PendingTSSBatchPacket
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PendingTSSBatchStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pending types.PendingTSSBatchPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &pending)
+		pendings = append(pendings, pending)
+	}
+	return pendings
+}
+
+// SignTSSBatches signs the pending packets of batched TSS tunnels with one signing per destination
+// chain. Each batch is signed in its own cached context. If signing a batch fails, an event is
+// emitted, the escrowed fees are refunded, the packets get a failure receipt and the tunnels of the
+// batch are deactivated, without affecting the other batches.
+func (k Keeper) SignTSSBatches(ctx sdk.Context) {
+	var chainIDs []string
+	batches := make(map[string][]tssBatchPacket)
+	for _, pending := range k.GetAllPendingTSSBatchPackets(ctx) {
+		k.DeletePendingTSSBatchPacket(ctx, pending.TunnelID, pending.Sequence)
+
+		p, err := k.getTSSBatchPacket(ctx, pending)
+		if err != nil {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeSignTSSBatchPacketFail,
+				sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", pending.TunnelID)),
+				sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", pending.Sequence)),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			))
+		}
+
+		// the packet cannot be signed or the route is no longer a TSS route; give the escrowed fee back.
+		if err != nil || p.route == nil {
+			if p.feePayer != nil {
+				k.tryRefundTSSBatchFee(ctx, p.feePayer, pending)
+			}
+			continue
+		}
+
+		chainID := p.route.DestinationChainID
+		if _, found := batches[chainID]; !found {
+			chainIDs = append(chainIDs, chainID)
+		}
+		batches[chainID] = append(batches[chainID], p)
+	}
+
+	for _, chainID := range chainIDs {
+		batch := batches[chainID]
+
+		cacheCtx, writeFn := ctx.CacheContext()
+		if err := k.signTSSBatch(cacheCtx, chainID, batch); err != nil {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeSignTSSBatchFail,
+				sdk.NewAttribute(types.AttributeKeyDestinationChainID, chainID),
+				sdk.NewAttribute(types.AttributeKeyPacketCount, fmt.Sprintf("%d", len(batch))),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			))
+
+			for _, p := range batch {
+				k.failTSSBatchPacket(ctx, p, err.Error())
+			}
+			continue
+		}
+
+		writeFn()
+	}
+}
+
+// getTSSBatchPacket loads the packet, the fee payer and the TSS route of a pending packet. The
+// route is nil if the route of the tunnel is no longer a TSS route. The fee payer is set whenever
+// the tunnel is found, even if an error is returned.
+func (k Keeper) getTSSBatchPacket(ctx sdk.Context, pending types.PendingTSSBatchPacket) (tssBatchPacket, error) {
+	p := tssBatchPacket{pending: pending}
+
+	tunnel, err := k.GetTunnel(ctx, pending.TunnelID)
+	if err != nil {
+		return p, err
+	}
+	p.feePayer = sdk.MustAccAddressFromBech32(tunnel.FeePayer)
+
+	p.packet, err = k.GetPacket(ctx, pending.TunnelID, pending.Sequence)
+	if err != nil {
+		return p, err
+	}
+
+	route, err := tunnel.GetRouteValue()
+	if err != nil {
+		return p, err
+	}
+	p.route, _ = route.(*types.TSSRoute)
+
+	return p, nil
+}
+
+// failTSSBatchPacket refunds the escrowed fee of a packet whose batch could not be signed, records
+// the reason in the receipt of the packet and deactivates its tunnel.
+func (k Keeper) failTSSBatchPacket(ctx sdk.Context, p tssBatchPacket, reason string) {
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := k.refundTSSBatchFee(cacheCtx, p.feePayer, p.pending.EscrowedFee); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to refund TSS batch fee of tunnel %d: %s", p.packet.TunnelID, err))
+		return
+	}
+
+	if err := p.packet.SetReceipt(types.NewFailedTSSBatchPacketReceipt(reason)); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to set packet receipt of tunnel %d: %s", p.packet.TunnelID, err))
+		return
+	}
+	k.SetPacket(cacheCtx, p.packet)
+
+	tunnel, err := k.GetTunnel(cacheCtx, p.packet.TunnelID)
+	if err == nil && tunnel.IsActive {
+		err = k.DeactivateTunnel(cacheCtx, tunnel.ID)
+	}
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to deactivate tunnel %d: %s", p.packet.TunnelID, err))
+		return
+	}
+
+	writeFn()
+}
+
+// signTSSBatch aggregates the packets of a destination chain into a Merkle tree, requests a single
+// signing of its root, splits the signing fee among the packets and records the Merkle proof of
+// each packet in its receipt.
+func (k Keeper) signTSSBatch(ctx sdk.Context, chainID string, batch []tssBatchPacket) error {
+	leaves := make([][]byte, 0, len(batch))
+	for _, p := range batch {
+		encodedPacket, err := types.EncodeTSS(
+			p.packet.Sequence,
+			p.packet.Prices,
+			p.packet.CreatedAt,
+			p.route.Encoder,
+		)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to encode packet for tunnel %d", p.packet.TunnelID)
+		}
+
+		leaves = append(leaves, types.EncodeTSSBatchLeaf(
+			p.packet.TunnelID,
+			p.route.DestinationContractAddress,
+			encodedPacket,
+		))
+	}
+	root, proofs := merkle.ProofsFromByteSlices(leaves)
+
+	tssFee, err := k.bandtssKeeper.GetSigningFee(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the originator is built from the first tunnel of the batch the same way as the signing of a
+	// single packet, while each leaf identifies its own tunnel and destination contract.
+	content := types.NewTunnelBatchSignatureOrder(chainID, root, uint64(len(batch)), ctx.BlockTime().Unix())
+	signingID, err := k.bandtssKeeper.CreateTunnelSigningRequest(
+		ctx,
+		batch[0].packet.TunnelID,
+		chainID,
+		batch[0].route.DestinationContractAddress,
+		content,
+		types.GetTSSBatchEscrowAddress(),
+		tssFee,
+	)
+	if err != nil {
+		return err
+	}
+
+	shares := types.SplitTSSBatchFee(tssFee, len(batch))
+	for i, p := range batch {
+		refund, hasNeg := p.pending.EscrowedFee.SafeSub(shares[i]...)
+		if hasNeg {
+			return sdkerrors.Wrapf(
+				types.ErrInsufficientEscrowedFee,
+				"escrowed fee %s is less than the fee share %s of tunnel %d",
+				p.pending.EscrowedFee,
+				shares[i],
+				p.packet.TunnelID,
+			)
+		}
+
+		if err := k.refundTSSBatchFee(ctx, p.feePayer, refund); err != nil {
+			return err
+		}
+
+		receipt := types.NewTSSBatchPacketReceipt(
+			signingID,
+			root,
+			uint64(i),
+			uint64(len(batch)),
+			proofs[i].Aunts,
+		)
+		if err := p.packet.SetReceipt(receipt); err != nil {
+			return sdkerrors.Wrapf(
+				err,
+				"failed to set packet receipt for tunnel %d, sequence %d",
+				p.packet.TunnelID,
+				p.packet.Sequence,
+			)
+		}
+		k.SetPacket(ctx, p.packet)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSignTSSBatch,
+		sdk.NewAttribute(types.AttributeKeyDestinationChainID, chainID),
+		sdk.NewAttribute(types.AttributeKeySigningID, fmt.Sprintf("%d", signingID)),
+		sdk.NewAttribute(types.AttributeKeyMerkleRoot, hex.EncodeToString(root)),
+		sdk.NewAttribute(types.AttributeKeyPacketCount, fmt.Sprintf("%d", len(batch))),
+	))
+
+	return nil
+}
+
+// tryRefundTSSBatchFee refunds the escrowed fee of a pending packet that is not signed and logs
+// the error if the refund fails.
+func (k Keeper) tryRefundTSSBatchFee(ctx sdk.Context, feePayer sdk.AccAddress, pending types.PendingTSSBatchPacket) {
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := k.refundTSSBatchFee(cacheCtx, feePayer, pending.EscrowedFee); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to refund TSS batch fee of tunnel %d: %s", pending.TunnelID, err))
+		return
+	}
+
+	writeFn()
+}
+
+// refundTSSBatchFee sends the given amount of escrowed signing fee back to the fee payer
+func (k Keeper) refundTSSBatchFee(ctx sdk.Context, feePayer sdk.AccAddress, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoins(ctx, types.GetTSSBatchEscrowAddress(), feePayer, amount)
+}
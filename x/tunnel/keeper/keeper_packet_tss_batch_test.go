@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"errors"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// addBatchedTSSTunnel adds an active tunnel whose TSS route is batched to the given chain and stores
+// a pending packet of it with the given escrowed fee.
+func (s *KeeperTestSuite) addBatchedTSSTunnel(
+	chainID string,
+	contractAddress string,
+	escrowedFee sdk.Coins,
+) (*types.Tunnel, types.Packet) {
+	ctx, k := s.ctx, s.keeper
+
+	s.accountKeeper.EXPECT().GetAccount(ctx, gomock.Any()).Return(nil).Times(1)
+	s.accountKeeper.EXPECT().NewAccount(ctx, gomock.Any()).Times(1)
+	s.accountKeeper.EXPECT().SetAccount(ctx, gomock.Any()).Times(1)
+
+	route := types.NewTSSRoute(chainID, contractAddress, feedstypes.ENCODER_FIXED_POINT_ABI, true)
+	tunnel, err := k.AddTunnel(
+		ctx,
+		&route,
+		[]types.SignalDeviation{{SignalID: "CS:BAND-USD", SoftDeviationBPS: 100, HardDeviationBPS: 100}},
+		10,
+		nil,
+		sdk.AccAddress([]byte("creator_address")),
+	)
+	s.Require().NoError(err)
+
+	tunnel.TotalDeposit = k.GetParams(ctx).MinDeposit
+	k.SetTunnel(ctx, *tunnel)
+	s.Require().NoError(k.ActivateTunnel(ctx, tunnel.ID))
+
+	packet := types.NewPacket(tunnel.ID, 1, []feedstypes.Price{}, ctx.BlockTime().Unix())
+	k.SetPacket(ctx, packet)
+	k.SetPendingTSSBatchPacket(ctx, types.PendingTSSBatchPacket{
+		TunnelID:    tunnel.ID,
+		Sequence:    packet.Sequence,
+		EscrowedFee: escrowedFee,
+	})
+
+	return tunnel, packet
+}
+
+func (s *KeeperTestSuite) TestSignTSSBatches() {
+	ctx, k := s.ctx, s.keeper
+
+	escrowedFee := sdk.NewCoins(sdk.NewInt64Coin("uband", 20))
+	tunnel1, packet1 := s.addBatchedTSSTunnel("chain-1", "0x01", escrowedFee)
+	tunnel2, packet2 := s.addBatchedTSSTunnel("chain-1", "0x02", escrowedFee)
+
+	signingFee := sdk.NewCoins(sdk.NewInt64Coin("uband", 15))
+	s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any()).Return(signingFee, nil)
+	s.bandtssKeeper.EXPECT().
+		CreateTunnelSigningRequest(
+			gomock.Any(),
+			tunnel1.ID,
+			"chain-1",
+			"0x01",
+			gomock.Any(),
+			types.GetTSSBatchEscrowAddress(),
+			signingFee,
+		).
+		Return(bandtsstypes.SigningID(5), nil)
+	s.bankKeeper.EXPECT().SendCoins(
+		gomock.Any(),
+		types.GetTSSBatchEscrowAddress(),
+		sdk.MustAccAddressFromBech32(tunnel1.FeePayer),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 12)),
+	).Return(nil)
+	s.bankKeeper.EXPECT().SendCoins(
+		gomock.Any(),
+		types.GetTSSBatchEscrowAddress(),
+		sdk.MustAccAddressFromBech32(tunnel2.FeePayer),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 13)),
+	).Return(nil)
+
+	k.SignTSSBatches(ctx)
+	s.Require().Empty(k.GetAllPendingTSSBatchPackets(ctx))
+
+	var leaves [][]byte
+	var receipts []*types.TSSBatchPacketReceipt
+	for _, p := range []struct {
+		packet   types.Packet
+		contract string
+	}{{packet1, "0x01"}, {packet2, "0x02"}} {
+		packet, err := k.GetPacket(ctx, p.packet.TunnelID, p.packet.Sequence)
+		s.Require().NoError(err)
+
+		receipt, err := packet.GetReceiptValue()
+		s.Require().NoError(err)
+		batchReceipt, ok := receipt.(*types.TSSBatchPacketReceipt)
+		s.Require().True(ok)
+		receipts = append(receipts, batchReceipt)
+
+		encodedPacket, err := types.EncodeTSS(
+			packet.Sequence,
+			packet.Prices,
+			packet.CreatedAt,
+			feedstypes.ENCODER_FIXED_POINT_ABI,
+		)
+		s.Require().NoError(err)
+		leaves = append(leaves, types.EncodeTSSBatchLeaf(packet.TunnelID, p.contract, encodedPacket))
+	}
+
+	root, proofs := merkle.ProofsFromByteSlices(leaves)
+	for i, receipt := range receipts {
+		s.Require().Equal(
+			types.NewTSSBatchPacketReceipt(5, root, uint64(i), 2, proofs[i].Aunts),
+			receipt,
+		)
+		s.Require().NoError(proofs[i].Verify(receipt.MerkleRoot, leaves[i]))
+	}
+}
+
+func (s *KeeperTestSuite) TestSignTSSBatchesFail() {
+	ctx, k := s.ctx, s.keeper
+
+	escrowedFee := sdk.NewCoins(sdk.NewInt64Coin("uband", 20))
+	tunnel1, packet1 := s.addBatchedTSSTunnel("chain-1", "0x01", escrowedFee)
+	tunnel2, packet2 := s.addBatchedTSSTunnel("chain-2", "0x02", escrowedFee)
+	s.Require().Equal([]uint64{tunnel1.ID, tunnel2.ID}, k.GetActiveTunnelIDs(ctx))
+
+	// the batch of chain-1 fails to be signed while the batch of chain-2 is signed.
+	s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any()).Return(escrowedFee, nil).Times(2)
+	s.bandtssKeeper.EXPECT().
+		CreateTunnelSigningRequest(gomock.Any(), tunnel1.ID, "chain-1", "0x01", gomock.Any(), gomock.Any(), escrowedFee).
+		Return(bandtsstypes.SigningID(0), errors.New("no active group"))
+	s.bandtssKeeper.EXPECT().
+		CreateTunnelSigningRequest(gomock.Any(), tunnel2.ID, "chain-2", "0x02", gomock.Any(), gomock.Any(), escrowedFee).
+		Return(bandtsstypes.SigningID(6), nil)
+	s.bankKeeper.EXPECT().SendCoins(
+		gomock.Any(),
+		types.GetTSSBatchEscrowAddress(),
+		sdk.MustAccAddressFromBech32(tunnel1.FeePayer),
+		escrowedFee,
+	).Return(nil)
+
+	k.SignTSSBatches(ctx)
+	s.Require().Empty(k.GetAllPendingTSSBatchPackets(ctx))
+	s.Require().Equal([]uint64{tunnel2.ID}, k.GetActiveTunnelIDs(ctx))
+
+	packet1, err := k.GetPacket(ctx, packet1.TunnelID, packet1.Sequence)
+	s.Require().NoError(err)
+	receipt, err := packet1.GetReceiptValue()
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewFailedTSSBatchPacketReceipt("no active group"), receipt)
+
+	packet2, err = k.GetPacket(ctx, packet2.TunnelID, packet2.Sequence)
+	s.Require().NoError(err)
+	receipt, err = packet2.GetReceiptValue()
+	s.Require().NoError(err)
+	s.Require().Equal(bandtsstypes.SigningID(6), receipt.(*types.TSSBatchPacketReceipt).SigningID)
+}
+
+func (s *KeeperTestSuite) TestSignTSSBatchesRefundFail() {
+	ctx, k := s.ctx, s.keeper
+
+	escrowedFee := sdk.NewCoins(sdk.NewInt64Coin("uband", 20))
+	tunnel, packet := s.addBatchedTSSTunnel("chain-1", "0x01", escrowedFee)
+
+	// neither a failed signing nor a failed refund stops the end block.
+	s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any()).Return(escrowedFee, nil)
+	s.bandtssKeeper.EXPECT().
+		CreateTunnelSigningRequest(gomock.Any(), tunnel.ID, "chain-1", "0x01", gomock.Any(), gomock.Any(), escrowedFee).
+		Return(bandtsstypes.SigningID(0), errors.New("no active group"))
+	s.bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), types.GetTSSBatchEscrowAddress(), gomock.Any(), escrowedFee).
+		Return(errors.New("insufficient funds"))
+
+	k.SignTSSBatches(ctx)
+	s.Require().Empty(k.GetAllPendingTSSBatchPackets(ctx))
+
+	packet, err := k.GetPacket(ctx, packet.TunnelID, packet.Sequence)
+	s.Require().NoError(err)
+	s.Require().Nil(packet.Receipt)
+}
+
+func (s *KeeperTestSuite) TestSignTSSBatchesPacketNotFound() {
+	ctx, k := s.ctx, s.keeper
+
+	escrowedFee := sdk.NewCoins(sdk.NewInt64Coin("uband", 20))
+	tunnel, _ := s.addBatchedTSSTunnel("chain-1", "0x01", escrowedFee)
+	k.SetPendingTSSBatchPacket(ctx, types.PendingTSSBatchPacket{
+		TunnelID:    tunnel.ID,
+		Sequence:    2,
+		EscrowedFee: escrowedFee,
+	})
+	k.DeletePendingTSSBatchPacket(ctx, tunnel.ID, 1)
+
+	// the escrowed fee of a packet that cannot be found is refunded.
+	s.bankKeeper.EXPECT().SendCoins(
+		gomock.Any(),
+		types.GetTSSBatchEscrowAddress(),
+		sdk.MustAccAddressFromBech32(tunnel.FeePayer),
+		escrowedFee,
+	).Return(nil)
+
+	k.SignTSSBatches(ctx)
+	s.Require().Empty(k.GetAllPendingTSSBatchPackets(ctx))
+	s.Require().Equal([]uint64{tunnel.ID}, k.GetActiveTunnelIDs(ctx))
+}
@@ -56,3 +56,24 @@ func (s *KeeperTestSuite) TestSendTSSPacket() {
 	s.Require().True(ok)
 	s.Require().Equal(bandtsstypes.SigningID(1), packetReceipt.SigningID)
 }
+
+func (s *KeeperTestSuite) TestSendTSSPacketBatched() {
+	ctx, k := s.ctx, s.keeper
+
+	route := types.NewTSSRoute("chain-1", "0x1234567890abcdef", feedstypes.ENCODER_FIXED_POINT_ABI, true)
+	packet := types.NewPacket(1, 1, []feedstypes.Price{}, time.Now().Unix())
+	tssFee := sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20)))
+
+	s.bandtssKeeper.EXPECT().GetSigningFee(ctx).Return(tssFee, nil)
+	s.bankKeeper.EXPECT().
+		SendCoins(ctx, bandtesting.Alice.Address, types.GetTSSBatchEscrowAddress(), tssFee).
+		Return(nil)
+
+	receipt, err := k.SendTSSPacket(ctx, &route, packet, bandtesting.Alice.Address)
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewTSSBatchPacketReceipt(0, nil, 0, 0, nil), receipt)
+
+	s.Require().Equal([]types.PendingTSSBatchPacket{
+		{TunnelID: 1, Sequence: 1, EscrowedFee: tssFee},
+	}, k.GetAllPendingTSSBatchPackets(ctx))
+}
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// SimulateTunnel replays the given price snapshots against a proposed tunnel configuration as a
+// newly created tunnel and returns the packets that it would have produced, together with the total
+// base packet fee and route fee of the packets. If no snapshot is given, the price history in the state
+// between the start time and the end time is replayed. The packets are encoded with the encoder of the
+// route. The route may be nil, in which case no packet is encoded and no route fee is charged.
+func (k Keeper) SimulateTunnel(
+	ctx sdk.Context,
+	signalDeviations []types.SignalDeviation,
+	interval uint64,
+	schedule *types.Schedule,
+	route types.RouteI,
+	snapshots []types.PriceSnapshot,
+	startTime int64,
+	endTime int64,
+) (packets []types.Packet, totalBasePacketFee sdk.Coins, totalRouteFee sdk.Coins, err error) {
+	params := k.GetParams(ctx)
+	if err := types.ValidateSignalDeviations(
+		signalDeviations,
+		params.MaxSignals,
+		params.MaxDeviationBPS,
+		params.MinDeviationBPS,
+	); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err := types.ValidateInterval(interval, params.MaxInterval, params.MinInterval); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if schedule != nil {
+		if err := schedule.ValidateBasic(); err != nil {
+			return nil, nil, nil, err
+		}
+
+		// the price history has no block heights, so a schedule on block heights cannot be replayed.
+		if len(schedule.BlockHeights) > 0 {
+			return nil, nil, nil, types.ErrInvalidSchedule.Wrap("block heights of a schedule cannot be simulated")
+		}
+	}
+
+	if len(snapshots) > types.MaxSimulatePriceSnapshots {
+		return nil, nil, nil, types.ErrInvalidPriceSnapshots.Wrapf(
+			"max snapshots %d, got %d",
+			types.MaxSimulatePriceSnapshots,
+			len(snapshots),
+		)
+	}
+
+	if len(snapshots) == 0 {
+		snapshots, err = k.GetPriceHistorySnapshots(ctx, types.GetSourceSignalIDs(signalDeviations), startTime, endTime)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	routeFee := sdk.NewCoins()
+	if route != nil {
+		if err := route.ValidateBasic(); err != nil {
+			return nil, nil, nil, err
+		}
+
+		routeFee, err = k.GetRouteFee(ctx, route)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	packets = make([]types.Packet, 0)
+	totalBasePacketFee = sdk.NewCoins()
+	totalRouteFee = sdk.NewCoins()
+	latestPrices := types.NewLatestPrices(0, []feedstypes.Price{}, 0)
+	for i, snapshot := range snapshots {
+		if i == 0 {
+			// the schedule of a newly created tunnel starts counting from its creation time
+			latestPrices.LastScheduledAt = snapshot.Timestamp
+		} else if snapshot.Timestamp < snapshots[i-1].Timestamp {
+			return nil, nil, nil, types.ErrInvalidPriceSnapshots.Wrapf(
+				"snapshot %d is older than its previous snapshot",
+				i,
+			)
+		}
+
+		intervalTriggered := snapshot.Timestamp >= int64(interval)+latestPrices.LastInterval
+		scheduleTriggered := schedule != nil &&
+			schedule.IsDue(latestPrices.LastScheduledAt, 0, time.Unix(snapshot.Timestamp, 0).UTC())
+		newPrices := GenerateNewPrices(
+			signalDeviations,
+			CreatePricesMap(latestPrices.Prices),
+			CreatePricesMap(snapshot.Prices),
+			snapshot.Timestamp,
+			intervalTriggered || scheduleTriggered,
+		)
+		if len(newPrices) == 0 {
+			continue
+		}
+
+		packet := types.NewPacket(0, uint64(len(packets)+1), newPrices, snapshot.Timestamp)
+		if err := encodeSimulatedPacket(route, packet); err != nil {
+			return nil, nil, nil, sdkerrors.Wrapf(err, "failed to encode packet %d", packet.Sequence)
+		}
+
+		packets = append(packets, packet)
+		totalBasePacketFee = totalBasePacketFee.Add(params.BasePacketFee...)
+		totalRouteFee = totalRouteFee.Add(routeFee...)
+
+		latestPrices.UpdatePrices(newPrices)
+		if intervalTriggered {
+			latestPrices.LastInterval = snapshot.Timestamp
+		}
+		if scheduleTriggered {
+			latestPrices.LastScheduledAt = snapshot.Timestamp
+		}
+	}
+
+	return packets, totalBasePacketFee, totalRouteFee, nil
+}
+
+// GetPriceHistorySnapshots returns the price history of the given signal IDs between the start time and
+// the end time (inclusive), grouped into snapshots in ascending order of timestamp. If the end time is
+// zero, the price history up to the current block time is returned.
+func (k Keeper) GetPriceHistorySnapshots(
+	ctx sdk.Context,
+	signalIDs []string,
+	startTime int64,
+	endTime int64,
+) ([]types.PriceSnapshot, error) {
+	if endTime == 0 {
+		endTime = ctx.BlockTime().Unix()
+	}
+
+	pricesByTimestamp := make(map[int64][]feedstypes.Price)
+	for _, signalID := range signalIDs {
+		for _, price := range k.feedsKeeper.GetPriceHistory(ctx, signalID) {
+			if price.Timestamp < startTime || price.Timestamp > endTime {
+				continue
+			}
+			pricesByTimestamp[price.Timestamp] = append(pricesByTimestamp[price.Timestamp], price)
+		}
+	}
+
+	if len(pricesByTimestamp) > types.MaxSimulatePriceSnapshots {
+		return nil, types.ErrInvalidPriceSnapshots.Wrapf(
+			"max snapshots %d, got %d; narrow the time range",
+			types.MaxSimulatePriceSnapshots,
+			len(pricesByTimestamp),
+		)
+	}
+
+	snapshots := make([]types.PriceSnapshot, 0, len(pricesByTimestamp))
+	for timestamp, prices := range pricesByTimestamp {
+		snapshots = append(snapshots, types.PriceSnapshot{Timestamp: timestamp, Prices: prices})
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Timestamp < snapshots[j].Timestamp
+	})
+
+	return snapshots, nil
+}
+
+// encodeSimulatedPacket encodes the packet with the encoder of the route, so that a packet that
+// cannot be delivered through the route fails the simulation.
+func encodeSimulatedPacket(route types.RouteI, packet types.Packet) error {
+	var encoder feedstypes.Encoder
+	switch r := route.(type) {
+	case *types.TSSRoute:
+		encoder = r.Encoder
+	case *types.RouterRoute:
+		encoder = r.Encoder
+	default:
+		// the other routes deliver the prices as they are
+		return nil
+	}
+
+	_, err := types.EncodeTSS(packet.Sequence, packet.Prices, packet.CreatedAt, encoder)
+	return err
+}
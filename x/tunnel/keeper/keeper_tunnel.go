@@ -18,6 +18,7 @@ func (k Keeper) AddTunnel(
 	route types.RouteI,
 	signalDeviations []types.SignalDeviation,
 	interval uint64,
+	schedule *types.Schedule,
 	creator sdk.AccAddress,
 ) (*types.Tunnel, error) {
 	id := k.GetTunnelCount(ctx)
@@ -29,8 +30,10 @@ func (k Keeper) AddTunnel(
 		return nil, err
 	}
 
-	// set the prices info
-	k.SetLatestPrices(ctx, types.NewLatestPrices(newID, []feedstypes.Price{}, 0))
+	// set the prices info; the schedule starts counting from the creation time
+	latestPrices := types.NewLatestPrices(newID, []feedstypes.Price{}, 0)
+	latestPrices.LastScheduledAt = ctx.BlockTime().Unix()
+	k.SetLatestPrices(ctx, latestPrices)
 
 	// create a new tunnel
 	tunnel, err := types.NewTunnel(
@@ -40,6 +43,7 @@ func (k Keeper) AddTunnel(
 		feePayer.String(),
 		signalDeviations,
 		interval,
+		schedule,
 		sdk.NewCoins(),
 		false,
 		ctx.BlockTime().Unix(),
@@ -71,6 +75,7 @@ func (k Keeper) AddTunnel(
 			sdk.NewAttribute(types.AttributeKeyHardDeviationBPS, fmt.Sprintf("%d", sd.HardDeviationBPS)),
 		)
 	}
+	event = appendScheduleAttributes(event, tunnel.Schedule)
 	ctx.EventManager().EmitEvent(event)
 
 	return &tunnel, nil
@@ -82,6 +87,7 @@ func (k Keeper) UpdateSignalsAndInterval(
 	tunnelID uint64,
 	signalDeviations []types.SignalDeviation,
 	interval uint64,
+	schedule *types.Schedule,
 ) error {
 	tunnel, err := k.GetTunnel(ctx, tunnelID)
 	if err != nil {
@@ -91,10 +97,13 @@ func (k Keeper) UpdateSignalsAndInterval(
 	// edit the tunnel
 	tunnel.SignalDeviations = signalDeviations
 	tunnel.Interval = interval
+	tunnel.Schedule = schedule
 	k.SetTunnel(ctx, tunnel)
 
-	// edit the prices info
-	k.SetLatestPrices(ctx, types.NewLatestPrices(tunnelID, []feedstypes.Price{}, 0))
+	// edit the prices info; the new schedule starts counting from the update time
+	latestPrices := types.NewLatestPrices(tunnelID, []feedstypes.Price{}, 0)
+	latestPrices.LastScheduledAt = ctx.BlockTime().Unix()
+	k.SetLatestPrices(ctx, latestPrices)
 
 	event := sdk.NewEvent(
 		types.EventTypeUpdateSignalsAndInterval,
@@ -108,11 +117,28 @@ func (k Keeper) UpdateSignalsAndInterval(
 			sdk.NewAttribute(types.AttributeKeyHardDeviationBPS, fmt.Sprintf("%d", sd.HardDeviationBPS)),
 		)
 	}
+	event = appendScheduleAttributes(event, tunnel.Schedule)
 	ctx.EventManager().EmitEvent(event)
 
 	return nil
 }
 
+// appendScheduleAttributes appends the attributes of the given schedule to the event.
+func appendScheduleAttributes(event sdk.Event, schedule *types.Schedule) sdk.Event {
+	if schedule == nil {
+		return event
+	}
+
+	if schedule.Cron != "" {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyScheduleCron, schedule.Cron))
+	}
+	for _, height := range schedule.BlockHeights {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", height)))
+	}
+
+	return event
+}
+
 // SetTunnelCount sets the tunnel count in the store
 func (k Keeper) SetTunnelCount(ctx sdk.Context, count uint64) {
 	ctx.KVStore(k.storeKey).Set(types.TunnelCountStoreKey, sdk.Uint64ToBigEndian(count))
@@ -202,6 +228,13 @@ func (k Keeper) ActivateTunnel(ctx sdk.Context, tunnelID uint64) error {
 	// add the tunnel ID to the active tunnel IDs
 	k.SetActiveTunnelID(ctx, tunnelID)
 
+	// do not fire the schedule for the times missed while the tunnel was inactive
+	if tunnel.Schedule != nil {
+		if err := k.ResetLastScheduledAt(ctx, tunnelID); err != nil {
+			return err
+		}
+	}
+
 	// set the last interval timestamp to the current block time
 	tunnel.IsActive = true
 	k.SetTunnel(ctx, tunnel)
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	"go.uber.org/mock/gomock"
 
@@ -36,12 +37,14 @@ func (s *KeeperTestSuite) TestAddTunnel() {
 		IsActive:         false,
 		CreatedAt:        ctx.BlockTime().Unix(),
 		TotalDeposit:     sdk.NewCoins(),
+		Owner:            creator.String(),
 	}
 
 	expectedPrices := types.LatestPrices{
-		TunnelID:     1,
-		Prices:       []feedstypes.Price(nil),
-		LastInterval: 0,
+		TunnelID:        1,
+		Prices:          []feedstypes.Price(nil),
+		LastInterval:    0,
+		LastScheduledAt: ctx.BlockTime().Unix(),
 	}
 
 	s.accountKeeper.EXPECT().
@@ -50,7 +53,7 @@ func (s *KeeperTestSuite) TestAddTunnel() {
 	s.accountKeeper.EXPECT().NewAccount(ctx, gomock.Any()).Times(1)
 	s.accountKeeper.EXPECT().SetAccount(ctx, gomock.Any()).Times(1)
 
-	tunnel, err := k.AddTunnel(ctx, route, signalDeviations, interval, creator)
+	tunnel, err := k.AddTunnel(ctx, route, signalDeviations, interval, nil, creator)
 	s.Require().NoError(err)
 	s.Require().Equal(expectedTunnel, *tunnel)
 
@@ -86,6 +89,7 @@ func (s *KeeperTestSuite) TestUpdateSignalsAndInterval() {
 		initialRoute,
 		initialSignalDeviations,
 		initialInterval,
+		nil,
 		creator,
 	)
 	s.Require().NoError(err)
@@ -98,7 +102,7 @@ func (s *KeeperTestSuite) TestUpdateSignalsAndInterval() {
 	newInterval := uint64(20)
 
 	// call the UpdateSignalsAndInterval function
-	err = k.UpdateSignalsAndInterval(ctx, initialTunnel.ID, newSignalDeviations, newInterval)
+	err = k.UpdateSignalsAndInterval(ctx, initialTunnel.ID, newSignalDeviations, newInterval, nil)
 	s.Require().NoError(err)
 
 	// validate the edited tunnel
@@ -118,6 +122,56 @@ func (s *KeeperTestSuite) TestUpdateSignalsAndInterval() {
 	}
 }
 
+func (s *KeeperTestSuite) TestScheduleStartsFromSetTime() {
+	ctx, k := s.ctx, s.keeper
+	ctx = ctx.WithBlockTime(time.Date(2024, 12, 1, 16, 30, 0, 0, time.UTC))
+
+	// the schedule fires at the beginning of every hour
+	schedule := types.NewSchedule("0 * * * *", nil)
+	creator := sdk.AccAddress([]byte("creator_address"))
+
+	s.accountKeeper.EXPECT().
+		GetAccount(ctx, gomock.Any()).
+		Return(nil).Times(1)
+	s.accountKeeper.EXPECT().NewAccount(ctx, gomock.Any()).Times(1)
+	s.accountKeeper.EXPECT().SetAccount(ctx, gomock.Any()).Times(1)
+
+	tunnel, err := k.AddTunnel(ctx, &types.TSSRoute{}, []types.SignalDeviation{}, 3600, schedule, creator)
+	s.Require().NoError(err)
+
+	// a new tunnel is not due before the next matching time after its creation
+	latestPrices, err := k.GetLatestPrices(ctx, tunnel.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(ctx.BlockTime().Unix(), latestPrices.LastScheduledAt)
+	s.Require().False(schedule.IsDue(latestPrices.LastScheduledAt, ctx.BlockHeight(), ctx.BlockTime()))
+
+	// updating the schedule after missed fixing times does not fire it immediately
+	ctx = ctx.WithBlockTime(time.Date(2024, 12, 1, 20, 30, 0, 0, time.UTC))
+	err = k.UpdateSignalsAndInterval(ctx, tunnel.ID, []types.SignalDeviation{}, 3600, schedule)
+	s.Require().NoError(err)
+
+	latestPrices, err = k.GetLatestPrices(ctx, tunnel.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(ctx.BlockTime().Unix(), latestPrices.LastScheduledAt)
+	s.Require().False(schedule.IsDue(latestPrices.LastScheduledAt, ctx.BlockHeight(), ctx.BlockTime()))
+
+	// reactivating the tunnel does not fire the fixing times missed while it was inactive
+	ctx = ctx.WithBlockTime(time.Date(2024, 12, 2, 8, 30, 0, 0, time.UTC))
+	inactiveTunnel, err := k.GetTunnel(ctx, tunnel.ID)
+	s.Require().NoError(err)
+	inactiveTunnel.TotalDeposit = k.GetParams(ctx).MinDeposit
+	k.SetTunnel(ctx, inactiveTunnel)
+
+	err = k.ActivateTunnel(ctx, tunnel.ID)
+	s.Require().NoError(err)
+
+	latestPrices, err = k.GetLatestPrices(ctx, tunnel.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(ctx.BlockTime().Unix(), latestPrices.LastScheduledAt)
+	s.Require().False(schedule.IsDue(latestPrices.LastScheduledAt, ctx.BlockHeight(), ctx.BlockTime()))
+	s.Require().True(schedule.IsDue(latestPrices.LastScheduledAt, ctx.BlockHeight(), ctx.BlockTime().Add(30*time.Minute)))
+}
+
 func (s *KeeperTestSuite) TestGetSetTunnel() {
 	ctx, k := s.ctx, s.keeper
 
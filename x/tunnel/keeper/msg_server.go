@@ -60,12 +60,20 @@ func (k msgServer) CreateTunnel(
 		}
 	}
 
+	// Check the transfer channel of router route is open
+	if routerRoute, ok := route.(*types.RouterRoute); ok {
+		if err := k.Keeper.validateRouterRouteChannel(ctx, routerRoute); err != nil {
+			return nil, err
+		}
+	}
+
 	// add a new tunnel
 	tunnel, err := k.Keeper.AddTunnel(
 		ctx,
 		route,
 		msg.SignalDeviations,
 		msg.Interval,
+		msg.Schedule,
 		creator,
 	)
 	if err != nil {
@@ -104,8 +112,8 @@ func (k msgServer) UpdateRoute(
 		return nil, err
 	}
 
-	if msg.Creator != tunnel.Creator {
-		return nil, types.ErrInvalidTunnelCreator.Wrapf("creator %s, tunnelID %d", msg.Creator, msg.TunnelID)
+	if err := k.Keeper.ValidateTunnelPermission(ctx, tunnel, msg.Creator, types.OPERATOR_ROLE_CONFIG); err != nil {
+		return nil, err
 	}
 
 	if tunnel.Route.TypeUrl != msg.Route.TypeUrl {
@@ -125,12 +133,25 @@ func (k msgServer) UpdateRoute(
 		}
 		tunnel.Route = msg.Route
 
+	case *types.RouterRoute:
+		if err := k.Keeper.validateRouterRouteChannel(ctx, r); err != nil {
+			return nil, err
+		}
+		tunnel.Route = msg.Route
+
 	default:
 		return nil, types.ErrInvalidRoute.Wrap("cannot update route on this route type")
 	}
 
 	k.Keeper.SetTunnel(ctx, tunnel)
 
+	// the schedule of the new route starts counting from the update time
+	if tunnel.Schedule != nil {
+		if err := k.Keeper.ResetLastScheduledAt(ctx, tunnel.ID); err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.MsgUpdateRouteResponse{}, nil
 }
 
@@ -158,11 +179,17 @@ func (k msgServer) UpdateSignalsAndInterval(
 		return nil, err
 	}
 
-	if msg.Creator != tunnel.Creator {
-		return nil, types.ErrInvalidTunnelCreator.Wrapf("creator %s, tunnelID %d", msg.Creator, msg.TunnelID)
+	if err := k.Keeper.ValidateTunnelPermission(ctx, tunnel, msg.Creator, types.OPERATOR_ROLE_CONFIG); err != nil {
+		return nil, err
 	}
 
-	err = k.Keeper.UpdateSignalsAndInterval(ctx, msg.TunnelID, msg.SignalDeviations, msg.Interval)
+	err = k.Keeper.UpdateSignalsAndInterval(
+		ctx,
+		msg.TunnelID,
+		msg.SignalDeviations,
+		msg.Interval,
+		msg.Schedule,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -170,7 +197,7 @@ func (k msgServer) UpdateSignalsAndInterval(
 	return &types.MsgUpdateSignalsAndIntervalResponse{}, nil
 }
 
-// WithdrawFeePayerFunds withdraws the fee payer's funds from the tunnel to the creator.
+// WithdrawFeePayerFunds withdraws the fee payer's funds from the tunnel to the owner.
 func (k msgServer) WithdrawFeePayerFunds(
 	goCtx context.Context,
 	msg *types.MsgWithdrawFeePayerFunds,
@@ -182,8 +209,8 @@ func (k msgServer) WithdrawFeePayerFunds(
 		return nil, err
 	}
 
-	if msg.Creator != tunnel.Creator {
-		return nil, types.ErrInvalidTunnelCreator.Wrapf("creator %s, tunnelID %d", msg.Creator, msg.TunnelID)
+	if err := k.Keeper.ValidateTunnelPermission(ctx, tunnel, msg.Creator, types.OPERATOR_ROLE_FUNDS); err != nil {
+		return nil, err
 	}
 
 	feePayer, err := sdk.AccAddressFromBech32(tunnel.FeePayer)
@@ -191,16 +218,16 @@ func (k msgServer) WithdrawFeePayerFunds(
 		return nil, err
 	}
 
-	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	owner, err := sdk.AccAddressFromBech32(tunnel.Owner)
 	if err != nil {
 		return nil, err
 	}
 
-	// send coins from the fee payer to the creator
+	// send coins from the fee payer to the owner
 	if err := k.Keeper.bankKeeper.SendCoins(
 		ctx,
 		feePayer,
-		creator,
+		owner,
 		msg.Amount,
 	); err != nil {
 		return nil, err
@@ -221,9 +248,8 @@ func (k msgServer) ActivateTunnel(
 		return nil, err
 	}
 
-	// check if the creator is the same
-	if msg.Creator != tunnel.Creator {
-		return nil, types.ErrInvalidTunnelCreator.Wrapf("creator %s, tunnelID %d", msg.Creator, msg.TunnelID)
+	if err := k.Keeper.ValidateTunnelPermission(ctx, tunnel, msg.Creator, types.OPERATOR_ROLE_CONFIG); err != nil {
+		return nil, err
 	}
 
 	// check if the tunnel is already active
@@ -250,8 +276,8 @@ func (k msgServer) DeactivateTunnel(
 		return nil, err
 	}
 
-	if msg.Creator != tunnel.Creator {
-		return nil, types.ErrInvalidTunnelCreator.Wrapf("creator %s, tunnelID %d", msg.Creator, msg.TunnelID)
+	if err := k.Keeper.ValidateTunnelPermission(ctx, tunnel, msg.Creator, types.OPERATOR_ROLE_CONFIG); err != nil {
+		return nil, err
 	}
 
 	if !tunnel.IsActive {
@@ -277,20 +303,19 @@ func (k msgServer) TriggerTunnel(
 		return nil, err
 	}
 
-	if msg.Creator != tunnel.Creator {
-		return nil, types.ErrInvalidTunnelCreator.Wrapf(
-			"creator %s, tunnelID %d",
-			msg.Creator,
-			msg.TunnelID,
-		)
+	if err := k.Keeper.ValidateTunnelPermission(ctx, tunnel, msg.Creator, types.OPERATOR_ROLE_TRIGGER); err != nil {
+		return nil, err
 	}
 
 	if !tunnel.IsActive {
 		return nil, types.ErrInactiveTunnel.Wrapf("tunnelID %d", msg.TunnelID)
 	}
 
-	signalIDs := tunnel.GetSignalIDs()
-	prices := k.Keeper.feedsKeeper.GetPrices(ctx, signalIDs)
+	feedsPrices := k.Keeper.feedsKeeper.GetPrices(ctx, tunnel.GetSourceSignalIDs())
+	prices := ComputeSignalPrices(tunnel.SignalDeviations, CreatePricesMap(feedsPrices), ctx.BlockTime().Unix())
+
+	// refill the fee payer before charging the fees of the packet
+	k.Keeper.TryTopUpFeePayer(ctx, tunnel)
 
 	// create a new packet
 	packet, err := k.Keeper.CreatePacket(ctx, tunnel.ID, prices)
@@ -319,6 +344,8 @@ func (k msgServer) TriggerTunnel(
 		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
 	))
 
+	k.Keeper.CheckFeePayerBalance(ctx, tunnel)
+
 	return &types.MsgTriggerTunnelResponse{}, nil
 }
 
@@ -361,6 +388,132 @@ func (k msgServer) WithdrawFromTunnel(
 	return &types.MsgWithdrawFromTunnelResponse{}, nil
 }
 
+// SetFeePayerTopUp authorizes a funding account to refill the fee payer of a tunnel.
+func (k msgServer) SetFeePayerTopUp(
+	goCtx context.Context,
+	msg *types.MsgSetFeePayerTopUp,
+) (*types.MsgSetFeePayerTopUpResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tunnel, err := k.Keeper.GetTunnel(ctx, msg.TunnelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.ValidateTunnelPermission(ctx, tunnel, msg.Creator, types.OPERATOR_ROLE_FUNDS); err != nil {
+		return nil, err
+	}
+
+	funder, err := sdk.AccAddressFromBech32(msg.Funder)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.AddFeePayerTopUp(ctx, msg.TunnelID, funder, msg.TargetBalance, msg.Cap); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFeePayerTopUpResponse{}, nil
+}
+
+// RemoveFeePayerTopUp removes the fee payer top-up authorization of a tunnel.
+func (k msgServer) RemoveFeePayerTopUp(
+	goCtx context.Context,
+	msg *types.MsgRemoveFeePayerTopUp,
+) (*types.MsgRemoveFeePayerTopUpResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RemoveFeePayerTopUp(ctx, msg.TunnelID, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveFeePayerTopUpResponse{}, nil
+}
+
+// UpdateLowBalanceThreshold updates the low balance threshold of a tunnel.
+func (k msgServer) UpdateLowBalanceThreshold(
+	goCtx context.Context,
+	msg *types.MsgUpdateLowBalanceThreshold,
+) (*types.MsgUpdateLowBalanceThresholdResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tunnel, err := k.Keeper.GetTunnel(ctx, msg.TunnelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.ValidateTunnelPermission(ctx, tunnel, msg.Creator, types.OPERATOR_ROLE_CONFIG); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.UpdateLowBalanceThreshold(ctx, msg.TunnelID, msg.LowBalanceThreshold); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateLowBalanceThresholdResponse{}, nil
+}
+
+// TransferTunnelOwnership transfers the ownership of a tunnel to a new owner.
+func (k msgServer) TransferTunnelOwnership(
+	goCtx context.Context,
+	msg *types.MsgTransferTunnelOwnership,
+) (*types.MsgTransferTunnelOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tunnel, err := k.Keeper.GetTunnel(ctx, msg.TunnelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Owner != tunnel.Owner {
+		return nil, types.ErrNoTunnelPermission.Wrapf("owner %s, tunnelID %d", msg.Owner, msg.TunnelID)
+	}
+
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.TransferTunnelOwnership(ctx, msg.TunnelID, newOwner); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferTunnelOwnershipResponse{}, nil
+}
+
+// SetTunnelOperator sets the roles of an operator of a tunnel, or removes the operator if no role is given.
+func (k msgServer) SetTunnelOperator(
+	goCtx context.Context,
+	msg *types.MsgSetTunnelOperator,
+) (*types.MsgSetTunnelOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tunnel, err := k.Keeper.GetTunnel(ctx, msg.TunnelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Owner != tunnel.Owner {
+		return nil, types.ErrNoTunnelPermission.Wrapf("owner %s, tunnelID %d", msg.Owner, msg.TunnelID)
+	}
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.UpdateTunnelOperator(ctx, msg.TunnelID, operator, msg.Roles); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetTunnelOperatorResponse{}, nil
+}
+
 // UpdateParams updates the module params.
 func (k msgServer) UpdateParams(
 	goCtx context.Context,
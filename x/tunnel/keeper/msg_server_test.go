@@ -34,6 +34,15 @@ func (s *KeeperTestSuite) TestMsgCreateTunnel() {
 		DestinationContractAddress: "0x1234567890abcdef",
 		Encoder:                    feedstypes.ENCODER_FIXED_POINT_ABI,
 	}
+	routerRoute := types.NewRouterRoute(
+		"channel-1",
+		"bridge1contract",
+		"ethereum-1",
+		"0x1234567890abcdef",
+		300000,
+		sdk.NewInt64Coin("uband", 1000),
+		feedstypes.ENCODER_FIXED_POINT_ABI,
+	)
 
 	cases := map[string]struct {
 		preRun    func() (*types.MsgCreateTunnel, error)
@@ -49,6 +58,7 @@ func (s *KeeperTestSuite) TestMsgCreateTunnel() {
 				return types.NewMsgCreateTunnel(
 					signalDeviations,
 					60,
+					nil,
 					route,
 					sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(100))),
 					sdk.AccAddress([]byte("creator_address")).String(),
@@ -67,6 +77,7 @@ func (s *KeeperTestSuite) TestMsgCreateTunnel() {
 				return types.NewMsgCreateTunnel(
 					signalDeviations,
 					60,
+					nil,
 					route,
 					sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(100))),
 					sdk.AccAddress([]byte("creator_address")).String(),
@@ -84,6 +95,7 @@ func (s *KeeperTestSuite) TestMsgCreateTunnel() {
 				return types.NewMsgCreateTunnel(
 					signalDeviations,
 					1,
+					nil,
 					route,
 					sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(100))),
 					sdk.AccAddress([]byte("creator_address")).String(),
@@ -100,6 +112,7 @@ func (s *KeeperTestSuite) TestMsgCreateTunnel() {
 				return types.NewMsgCreateTunnel(
 					signalDeviations,
 					60,
+					nil,
 					types.NewIBCRoute("channel-0"),
 					depositAmount,
 					depositor.String(),
@@ -108,6 +121,65 @@ func (s *KeeperTestSuite) TestMsgCreateTunnel() {
 			expErr:    true,
 			expErrMsg: "channel id should be set after create tunnel",
 		},
+		"transfer channel of router route not found": {
+			preRun: func() (*types.MsgCreateTunnel, error) {
+				s.channelKeeper.EXPECT().
+					GetChannel(gomock.Any(), "transfer", "channel-1").
+					Return(channeltypes.Channel{}, false)
+
+				return types.NewMsgCreateTunnel(
+					signalDeviations,
+					60,
+					nil,
+					routerRoute,
+					sdk.NewCoins(),
+					sdk.AccAddress([]byte("creator_address")).String(),
+				)
+			},
+			expErr:    true,
+			expErrMsg: "transfer channel channel-1 not found",
+		},
+		"transfer channel of router route not open": {
+			preRun: func() (*types.MsgCreateTunnel, error) {
+				s.channelKeeper.EXPECT().
+					GetChannel(gomock.Any(), "transfer", "channel-1").
+					Return(channeltypes.Channel{State: channeltypes.CLOSED}, true)
+
+				return types.NewMsgCreateTunnel(
+					signalDeviations,
+					60,
+					nil,
+					routerRoute,
+					sdk.NewCoins(),
+					sdk.AccAddress([]byte("creator_address")).String(),
+				)
+			},
+			expErr:    true,
+			expErrMsg: "transfer channel channel-1 is not open",
+		},
+		"all good (router route)": {
+			preRun: func() (*types.MsgCreateTunnel, error) {
+				s.channelKeeper.EXPECT().
+					GetChannel(gomock.Any(), "transfer", "channel-1").
+					Return(channeltypes.Channel{State: channeltypes.OPEN}, true)
+				s.accountKeeper.EXPECT().
+					GetAccount(s.ctx, gomock.Any()).
+					Return(nil).Times(1)
+				s.accountKeeper.EXPECT().NewAccount(s.ctx, gomock.Any()).Times(1)
+				s.accountKeeper.EXPECT().SetAccount(s.ctx, gomock.Any()).Times(1)
+
+				return types.NewMsgCreateTunnel(
+					signalDeviations,
+					60,
+					nil,
+					routerRoute,
+					sdk.NewCoins(),
+					sdk.AccAddress([]byte("creator_address")).String(),
+				)
+			},
+			expErr:    false,
+			expErrMsg: "",
+		},
 		"all good (ibc route)": {
 			preRun: func() (*types.MsgCreateTunnel, error) {
 				s.accountKeeper.EXPECT().
@@ -122,6 +194,7 @@ func (s *KeeperTestSuite) TestMsgCreateTunnel() {
 				return types.NewMsgCreateTunnel(
 					signalDeviations,
 					60,
+					nil,
 					types.NewIBCRoute(""),
 					sdk.NewCoins(),
 					sdk.AccAddress([]byte("creator_address")).String(),
@@ -141,6 +214,7 @@ func (s *KeeperTestSuite) TestMsgCreateTunnel() {
 				return types.NewMsgCreateTunnel(
 					signalDeviations,
 					60,
+					nil,
 					route,
 					sdk.NewCoins(),
 					sdk.AccAddress([]byte("creator_address")).String(),
@@ -166,6 +240,7 @@ func (s *KeeperTestSuite) TestMsgCreateTunnel() {
 				return types.NewMsgCreateTunnel(
 					signalDeviations,
 					60,
+					nil,
 					route,
 					depositAmount,
 					depositor.String(),
@@ -195,6 +270,45 @@ func (s *KeeperTestSuite) TestMsgCreateTunnel() {
 }
 
 func (s *KeeperTestSuite) TestMsgUpdateRoute() {
+	addRouterTunnel := func() {
+		s.accountKeeper.EXPECT().
+			GetAccount(s.ctx, gomock.Any()).
+			Return(nil).Times(1)
+		s.accountKeeper.EXPECT().NewAccount(s.ctx, gomock.Any()).Times(1)
+		s.accountKeeper.EXPECT().SetAccount(s.ctx, gomock.Any()).Times(1)
+
+		_, err := s.keeper.AddTunnel(
+			s.ctx,
+			types.NewRouterRoute(
+				"channel-1",
+				"bridge1contract",
+				"ethereum-1",
+				"0x1234567890abcdef",
+				300000,
+				sdk.NewInt64Coin("uband", 1000),
+				feedstypes.ENCODER_FIXED_POINT_ABI,
+			),
+			[]types.SignalDeviation{{SignalID: "CS:BAND-USD", SoftDeviationBPS: 100, HardDeviationBPS: 100}},
+			10,
+			nil,
+			sdk.AccAddress([]byte("creator_address")),
+		)
+		s.Require().NoError(err)
+	}
+	newMsgUpdateRouterRoute := func(channelID string) (*types.MsgUpdateRoute, error) {
+		return types.NewMsgUpdateRouterRoute(
+			1,
+			channelID,
+			"bridge1contract",
+			"ethereum-1",
+			"0x1234567890abcdef",
+			300000,
+			sdk.NewInt64Coin("uband", 1000),
+			feedstypes.ENCODER_FIXED_POINT_ABI,
+			sdk.AccAddress([]byte("creator_address")).String(),
+		)
+	}
+
 	cases := map[string]struct {
 		preRun    func() (*types.MsgUpdateRoute, error)
 		expErr    bool
@@ -224,7 +338,7 @@ func (s *KeeperTestSuite) TestMsgUpdateRoute() {
 			expErr:    true,
 			expErrMsg: "cannot change route type",
 		},
-		"invalid creator of the tunnel": {
+		"no permission on the tunnel": {
 			preRun: func() (*types.MsgUpdateRoute, error) {
 				s.AddSampleTunnel(false)
 
@@ -235,7 +349,43 @@ func (s *KeeperTestSuite) TestMsgUpdateRoute() {
 				)
 			},
 			expErr:    true,
-			expErrMsg: "invalid creator of the tunnel",
+			expErrMsg: "no permission on the tunnel",
+		},
+		"transfer channel of router route not found": {
+			preRun: func() (*types.MsgUpdateRoute, error) {
+				addRouterTunnel()
+				s.channelKeeper.EXPECT().
+					GetChannel(gomock.Any(), "transfer", "channel-2").
+					Return(channeltypes.Channel{}, false)
+
+				return newMsgUpdateRouterRoute("channel-2")
+			},
+			expErr:    true,
+			expErrMsg: "transfer channel channel-2 not found",
+		},
+		"transfer channel of router route not open": {
+			preRun: func() (*types.MsgUpdateRoute, error) {
+				addRouterTunnel()
+				s.channelKeeper.EXPECT().
+					GetChannel(gomock.Any(), "transfer", "channel-2").
+					Return(channeltypes.Channel{State: channeltypes.INIT}, true)
+
+				return newMsgUpdateRouterRoute("channel-2")
+			},
+			expErr:    true,
+			expErrMsg: "transfer channel channel-2 is not open",
+		},
+		"all good (router route)": {
+			preRun: func() (*types.MsgUpdateRoute, error) {
+				addRouterTunnel()
+				s.channelKeeper.EXPECT().
+					GetChannel(gomock.Any(), "transfer", "channel-2").
+					Return(channeltypes.Channel{State: channeltypes.OPEN}, true)
+
+				return newMsgUpdateRouterRoute("channel-2")
+			},
+			expErr:    false,
+			expErrMsg: "",
 		},
 		"all good": {
 			preRun: func() (*types.MsgUpdateRoute, error) {
@@ -305,6 +455,7 @@ func (s *KeeperTestSuite) TestMsgUpdateSignalsAndInterval() {
 					1,
 					editedSignalDeviations,
 					60,
+					nil,
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
 			},
@@ -333,6 +484,7 @@ func (s *KeeperTestSuite) TestMsgUpdateSignalsAndInterval() {
 					1,
 					editedSignalDeviations,
 					60,
+					nil,
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
 			},
@@ -360,6 +512,7 @@ func (s *KeeperTestSuite) TestMsgUpdateSignalsAndInterval() {
 					1,
 					editedSignalDeviations,
 					1,
+					nil,
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
 			},
@@ -372,13 +525,14 @@ func (s *KeeperTestSuite) TestMsgUpdateSignalsAndInterval() {
 					1,
 					[]types.SignalDeviation{},
 					60,
+					nil,
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
 			},
 			expErr:    true,
 			expErrMsg: "tunnel not found",
 		},
-		"invalid creator of the tunnel": {
+		"no permission on the tunnel": {
 			preRun: func() *types.MsgUpdateSignalsAndInterval {
 				s.AddSampleTunnel(false)
 
@@ -386,11 +540,12 @@ func (s *KeeperTestSuite) TestMsgUpdateSignalsAndInterval() {
 					1,
 					[]types.SignalDeviation{},
 					60,
+					nil,
 					sdk.AccAddress([]byte("wrong_creator_address")).String(),
 				)
 			},
 			expErr:    true,
-			expErrMsg: "invalid creator of the tunnel",
+			expErrMsg: "no permission on the tunnel",
 		},
 		"all good": {
 			preRun: func() *types.MsgUpdateSignalsAndInterval {
@@ -408,6 +563,7 @@ func (s *KeeperTestSuite) TestMsgUpdateSignalsAndInterval() {
 					1,
 					editedSignalDeviations,
 					60,
+					nil,
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
 			},
@@ -462,7 +618,7 @@ func (s *KeeperTestSuite) TestWithdrawFeePayerFunds() {
 				}
 			},
 			expErr:    true,
-			expErrMsg: "creator invalid_creator, tunnelID 1",
+			expErrMsg: "address invalid_creator, role OPERATOR_ROLE_FUNDS, tunnelID 1",
 		},
 		"insufficient funds": {
 			preRun: func() *types.MsgWithdrawFeePayerFunds {
@@ -532,14 +688,27 @@ func (s *KeeperTestSuite) TestMsgActivateTunnel() {
 			expErr:    true,
 			expErrMsg: "tunnel not found",
 		},
-		"invalid creator of the tunnel": {
+		"no permission on the tunnel": {
 			preRun: func() *types.MsgActivateTunnel {
 				s.AddSampleTunnel(false)
 
 				return types.NewMsgActivateTunnel(1, sdk.AccAddress([]byte("wrong_creator_address")).String())
 			},
 			expErr:    true,
-			expErrMsg: "invalid creator of the tunnel",
+			expErrMsg: "no permission on the tunnel",
+		},
+		"operator without config role": {
+			preRun: func() *types.MsgActivateTunnel {
+				s.AddSampleTunnel(false)
+
+				operator := sdk.AccAddress([]byte("operator_address"))
+				err := s.keeper.UpdateTunnelOperator(s.ctx, 1, operator, []types.OperatorRole{types.OPERATOR_ROLE_TRIGGER})
+				s.Require().NoError(err)
+
+				return types.NewMsgActivateTunnel(1, operator.String())
+			},
+			expErr:    true,
+			expErrMsg: "no permission on the tunnel",
 		},
 		"already active": {
 			preRun: func() *types.MsgActivateTunnel {
@@ -607,14 +776,14 @@ func (s *KeeperTestSuite) TestMsgDeactivateTunnel() {
 			expErr:    true,
 			expErrMsg: "tunnel not found",
 		},
-		"invalid creator of the tunnel": {
+		"no permission on the tunnel": {
 			preRun: func() *types.MsgDeactivateTunnel {
 				s.AddSampleTunnel(true)
 
 				return types.NewMsgDeactivateTunnel(1, sdk.AccAddress([]byte("wrong_creator_address")).String())
 			},
 			expErr:    true,
-			expErrMsg: "invalid creator of the tunnel",
+			expErrMsg: "no permission on the tunnel",
 		},
 		"already inactive": {
 			preRun: func() *types.MsgDeactivateTunnel {
@@ -634,6 +803,19 @@ func (s *KeeperTestSuite) TestMsgDeactivateTunnel() {
 			expErr:    false,
 			expErrMsg: "",
 		},
+		"all good by config operator": {
+			preRun: func() *types.MsgDeactivateTunnel {
+				s.AddSampleTunnel(true)
+
+				operator := sdk.AccAddress([]byte("operator_address"))
+				err := s.keeper.UpdateTunnelOperator(s.ctx, 1, operator, []types.OperatorRole{types.OPERATOR_ROLE_CONFIG})
+				s.Require().NoError(err)
+
+				return types.NewMsgDeactivateTunnel(1, operator.String())
+			},
+			expErr:    false,
+			expErrMsg: "",
+		},
 	}
 
 	for name, tc := range cases {
@@ -665,14 +847,14 @@ func (s *KeeperTestSuite) TestMsgTriggerTunnel() {
 			expErr:    true,
 			expErrMsg: "tunnel not found",
 		},
-		"invalid creator of the tunnel": {
+		"no permission on the tunnel": {
 			preRun: func() *types.MsgTriggerTunnel {
 				s.AddSampleTunnel(true)
 
 				return types.NewMsgTriggerTunnel(1, sdk.AccAddress([]byte("wrong_creator_address")).String())
 			},
 			expErr:    true,
-			expErrMsg: "invalid creator of the tunnel",
+			expErrMsg: "no permission on the tunnel",
 		},
 		"inactive tunnel": {
 			preRun: func() *types.MsgTriggerTunnel {
@@ -929,6 +1111,126 @@ func (s *KeeperTestSuite) TestMsgWithdrawFromTunnel() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgTransferTunnelOwnership() {
+	owner := sdk.AccAddress([]byte("creator_address")).String()
+	newOwner := sdk.AccAddress([]byte("multisig_address")).String()
+
+	cases := map[string]struct {
+		preRun    func() *types.MsgTransferTunnelOwnership
+		expErr    bool
+		expErrMsg string
+	}{
+		"tunnel not found": {
+			preRun: func() *types.MsgTransferTunnelOwnership {
+				return types.NewMsgTransferTunnelOwnership(1, newOwner, owner)
+			},
+			expErr:    true,
+			expErrMsg: "tunnel not found",
+		},
+		"operator cannot transfer the ownership": {
+			preRun: func() *types.MsgTransferTunnelOwnership {
+				s.AddSampleTunnel(false)
+
+				operator := sdk.AccAddress([]byte("operator_address"))
+				err := s.keeper.UpdateTunnelOperator(s.ctx, 1, operator, []types.OperatorRole{
+					types.OPERATOR_ROLE_TRIGGER,
+					types.OPERATOR_ROLE_CONFIG,
+					types.OPERATOR_ROLE_FUNDS,
+				})
+				s.Require().NoError(err)
+
+				return types.NewMsgTransferTunnelOwnership(1, operator.String(), operator.String())
+			},
+			expErr:    true,
+			expErrMsg: "no permission on the tunnel",
+		},
+		"all good": {
+			preRun: func() *types.MsgTransferTunnelOwnership {
+				s.AddSampleTunnel(false)
+
+				return types.NewMsgTransferTunnelOwnership(1, newOwner, owner)
+			},
+			expErr: false,
+		},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			s.reset()
+			msg := tc.preRun()
+
+			_, err := s.msgServer.TransferTunnelOwnership(s.ctx, msg)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+
+				tunnel, err := s.keeper.GetTunnel(s.ctx, msg.TunnelID)
+				s.Require().NoError(err)
+				s.Require().Equal(newOwner, tunnel.Owner)
+				s.Require().Equal(owner, tunnel.Creator)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgSetTunnelOperator() {
+	owner := sdk.AccAddress([]byte("creator_address")).String()
+	operator := sdk.AccAddress([]byte("operator_address")).String()
+	roles := []types.OperatorRole{types.OPERATOR_ROLE_TRIGGER}
+
+	cases := map[string]struct {
+		preRun    func() *types.MsgSetTunnelOperator
+		expErr    bool
+		expErrMsg string
+	}{
+		"tunnel not found": {
+			preRun: func() *types.MsgSetTunnelOperator {
+				return types.NewMsgSetTunnelOperator(1, operator, roles, owner)
+			},
+			expErr:    true,
+			expErrMsg: "tunnel not found",
+		},
+		"not the owner": {
+			preRun: func() *types.MsgSetTunnelOperator {
+				s.AddSampleTunnel(false)
+
+				return types.NewMsgSetTunnelOperator(1, operator, roles, operator)
+			},
+			expErr:    true,
+			expErrMsg: "no permission on the tunnel",
+		},
+		"all good": {
+			preRun: func() *types.MsgSetTunnelOperator {
+				s.AddSampleTunnel(false)
+
+				return types.NewMsgSetTunnelOperator(1, operator, roles, owner)
+			},
+			expErr: false,
+		},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			s.reset()
+			msg := tc.preRun()
+
+			_, err := s.msgServer.SetTunnelOperator(s.ctx, msg)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+
+				o, found := s.keeper.GetTunnelOperator(s.ctx, msg.TunnelID, sdk.MustAccAddressFromBech32(operator))
+				s.Require().True(found)
+				s.Require().Equal(types.NewTunnelOperator(msg.TunnelID, operator, roles), o)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestMsgUpdateParams() {
 	params := types.DefaultParams()
 
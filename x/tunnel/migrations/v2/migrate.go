@@ -0,0 +1,39 @@
+package v2
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+const (
+	ModuleName = "tunnel"
+)
+
+// Migrate migrates the x/tunnel module state from the consensus version 1 to
+// version 2. Specifically, it sets the owner of every existing tunnel that has
+// no owner to its creator.
+func Migrate(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var tunnels []types.Tunnel
+	iterator := storetypes.KVStorePrefixIterator(store, types.TunnelStoreKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var tunnel types.Tunnel
+		cdc.MustUnmarshal(iterator.Value(), &tunnel)
+		tunnels = append(tunnels, tunnel)
+	}
+	iterator.Close()
+
+	for _, tunnel := range tunnels {
+		if tunnel.Owner != "" {
+			continue
+		}
+
+		tunnel.Owner = tunnel.Creator
+		store.Set(types.TunnelStoreKey(tunnel.ID), cdc.MustMarshal(&tunnel))
+	}
+
+	return nil
+}
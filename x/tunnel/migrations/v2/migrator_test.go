@@ -0,0 +1,45 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel"
+	v2 "github.com/bandprotocol/chain/v3/x/tunnel/migrations/v2"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(tunnel.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v2.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	creator := sdk.AccAddress([]byte("creator")).String()
+	owner := sdk.AccAddress([]byte("owner")).String()
+
+	// a tunnel created before ownership existed and a tunnel that already has an owner
+	legacyTunnel := types.Tunnel{ID: 1, Creator: creator}
+	ownedTunnel := types.Tunnel{ID: 2, Creator: creator, Owner: owner}
+	store.Set(types.TunnelStoreKey(1), cdc.MustMarshal(&legacyTunnel))
+	store.Set(types.TunnelStoreKey(2), cdc.MustMarshal(&ownedTunnel))
+
+	require.NoError(t, v2.Migrate(ctx, store, cdc))
+
+	var gotTunnel types.Tunnel
+	cdc.MustUnmarshal(store.Get(types.TunnelStoreKey(1)), &gotTunnel)
+	require.Equal(t, creator, gotTunnel.Owner)
+
+	cdc.MustUnmarshal(store.Get(types.TunnelStoreKey(2)), &gotTunnel)
+	require.Equal(t, owner, gotTunnel.Owner)
+}
@@ -18,8 +18,9 @@ import (
 	types1 "github.com/bandprotocol/chain/v3/x/tss/types"
 	types2 "github.com/cosmos/cosmos-sdk/types"
 	types3 "github.com/cosmos/ibc-go/modules/capability/types"
-	types4 "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
-	types5 "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	types4 "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	types5 "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	types6 "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -232,7 +233,7 @@ func (m *MockICS4Wrapper) EXPECT() *MockICS4WrapperMockRecorder {
 }
 
 // SendPacket mocks base method.
-func (m *MockICS4Wrapper) SendPacket(ctx types2.Context, chanCap *types3.Capability, sourcePort, sourceChannel string, timeoutHeight types4.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
+func (m *MockICS4Wrapper) SendPacket(ctx types2.Context, chanCap *types3.Capability, sourcePort, sourceChannel string, timeoutHeight types5.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendPacket", ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	ret0, _ := ret[0].(uint64)
@@ -246,6 +247,45 @@ func (mr *MockICS4WrapperMockRecorder) SendPacket(ctx, chanCap, sourcePort, sour
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPacket", reflect.TypeOf((*MockICS4Wrapper)(nil).SendPacket), ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 }
 
+// MockTransferKeeper is a mock of TransferKeeper interface.
+type MockTransferKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockTransferKeeperMockRecorder is the mock recorder for MockTransferKeeper.
+type MockTransferKeeperMockRecorder struct {
+	mock *MockTransferKeeper
+}
+
+// NewMockTransferKeeper creates a new mock instance.
+func NewMockTransferKeeper(ctrl *gomock.Controller) *MockTransferKeeper {
+	mock := &MockTransferKeeper{ctrl: ctrl}
+	mock.recorder = &MockTransferKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferKeeper) EXPECT() *MockTransferKeeperMockRecorder {
+	return m.recorder
+}
+
+// Transfer mocks base method.
+func (m *MockTransferKeeper) Transfer(ctx context.Context, msg *types4.MsgTransfer) (*types4.MsgTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfer", ctx, msg)
+	ret0, _ := ret[0].(*types4.MsgTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transfer indicates an expected call of Transfer.
+func (mr *MockTransferKeeperMockRecorder) Transfer(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockTransferKeeper)(nil).Transfer), ctx, msg)
+}
+
 // MockChannelKeeper is a mock of ChannelKeeper interface.
 type MockChannelKeeper struct {
 	ctrl     *gomock.Controller
@@ -271,10 +311,10 @@ func (m *MockChannelKeeper) EXPECT() *MockChannelKeeperMockRecorder {
 }
 
 // GetChannel mocks base method.
-func (m *MockChannelKeeper) GetChannel(ctx types2.Context, srcPort, srcChan string) (types5.Channel, bool) {
+func (m *MockChannelKeeper) GetChannel(ctx types2.Context, srcPort, srcChan string) (types6.Channel, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetChannel", ctx, srcPort, srcChan)
-	ret0, _ := ret[0].(types5.Channel)
+	ret0, _ := ret[0].(types6.Channel)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
@@ -428,6 +468,20 @@ func (mr *MockFeedsKeeperMockRecorder) GetAllPrices(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPrices", reflect.TypeOf((*MockFeedsKeeper)(nil).GetAllPrices), ctx)
 }
 
+// GetPriceHistory mocks base method.
+func (m *MockFeedsKeeper) GetPriceHistory(ctx types2.Context, signalID string) []types0.Price {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceHistory", ctx, signalID)
+	ret0, _ := ret[0].([]types0.Price)
+	return ret0
+}
+
+// GetPriceHistory indicates an expected call of GetPriceHistory.
+func (mr *MockFeedsKeeperMockRecorder) GetPriceHistory(ctx, signalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceHistory", reflect.TypeOf((*MockFeedsKeeper)(nil).GetPriceHistory), ctx, signalID)
+}
+
 // GetPrices mocks base method.
 func (m *MockFeedsKeeper) GetPrices(ctx types2.Context, signalIDs []string) []types0.Price {
 	m.ctrl.T.Helper()
@@ -466,6 +520,21 @@ func (m *MockBandtssKeeper) EXPECT() *MockBandtssKeeperMockRecorder {
 	return m.recorder
 }
 
+// CreateDirectSigningRequest mocks base method.
+func (m *MockBandtssKeeper) CreateDirectSigningRequest(ctx types2.Context, content types1.Content, memo string, sender types2.AccAddress, feeLimit types2.Coins) (types.SigningID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDirectSigningRequest", ctx, content, memo, sender, feeLimit)
+	ret0, _ := ret[0].(types.SigningID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDirectSigningRequest indicates an expected call of CreateDirectSigningRequest.
+func (mr *MockBandtssKeeperMockRecorder) CreateDirectSigningRequest(ctx, content, memo, sender, feeLimit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDirectSigningRequest", reflect.TypeOf((*MockBandtssKeeper)(nil).CreateDirectSigningRequest), ctx, content, memo, sender, feeLimit)
+}
+
 // CreateTunnelSigningRequest mocks base method.
 func (m *MockBandtssKeeper) CreateTunnelSigningRequest(ctx types2.Context, tunnelID uint64, destinationChainID, destinationContractAddr string, content types1.Content, sender types2.AccAddress, feeLimit types2.Coins) (types.SigningID, error) {
 	m.ctrl.T.Helper()
@@ -20,6 +20,13 @@ func NewSignatureOrderHandler(k keeper.Keeper) tsstypes.Handler {
 				c.CreatedAt,
 				c.Encoder,
 			)
+		case *types.TunnelBatchSignatureOrder:
+			return types.EncodeTSSBatch(
+				c.DestinationChainID,
+				c.MerkleRoot,
+				c.PacketCount,
+				c.CreatedAt,
+			), nil
 		default:
 			return nil, sdkerrors.ErrUnknownRequest.Wrapf(
 				"unrecognized tss request signature type: %s",
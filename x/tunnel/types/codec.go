@@ -27,15 +27,23 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgTriggerTunnel{}, "tunnel/MsgTriggerTunnel")
 	legacy.RegisterAminoMsg(cdc, &MsgDepositToTunnel{}, "tunnel/MsgDepositToTunnel")
 	legacy.RegisterAminoMsg(cdc, &MsgWithdrawFromTunnel{}, "tunnel/MsgWithdrawFromTunnel")
+	legacy.RegisterAminoMsg(cdc, &MsgSetFeePayerTopUp{}, "tunnel/MsgSetFeePayerTopUp")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveFeePayerTopUp{}, "tunnel/MsgRemoveFeePayerTopUp")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateLowBalanceThreshold{}, "tunnel/MsgUpdateLowBalanceThreshold")
+	legacy.RegisterAminoMsg(cdc, &MsgTransferTunnelOwnership{}, "tunnel/MsgTransferTunnelOwnership")
+	legacy.RegisterAminoMsg(cdc, &MsgSetTunnelOperator{}, "tunnel/MsgSetTunnelOperator")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "tunnel/MsgUpdateParams")
 
 	cdc.RegisterInterface((*RouteI)(nil), nil)
 	cdc.RegisterConcrete(&TSSRoute{}, "tunnel/TSSRoute", nil)
 	cdc.RegisterConcrete(&IBCRoute{}, "tunnel/IBCRoute", nil)
+	cdc.RegisterConcrete(&RouterRoute{}, "tunnel/RouterRoute", nil)
 
 	cdc.RegisterInterface((*PacketReceiptI)(nil), nil)
 	cdc.RegisterConcrete(&TSSPacketReceipt{}, "tunnel/TSSPacketReceipt", nil)
+	cdc.RegisterConcrete(&TSSBatchPacketReceipt{}, "tunnel/TSSBatchPacketReceipt", nil)
 	cdc.RegisterConcrete(&IBCPacketReceipt{}, "tunnel/IBCPacketReceipt", nil)
+	cdc.RegisterConcrete(&RouterPacketReceipt{}, "tunnel/RouterPacketReceipt", nil)
 
 	cdc.RegisterConcrete(Params{}, "tunnel/Params", nil)
 }
@@ -53,6 +61,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgTriggerTunnel{},
 		&MsgDepositToTunnel{},
 		&MsgWithdrawFromTunnel{},
+		&MsgSetFeePayerTopUp{},
+		&MsgRemoveFeePayerTopUp{},
+		&MsgUpdateLowBalanceThreshold{},
+		&MsgTransferTunnelOwnership{},
+		&MsgSetTunnelOperator{},
 		&MsgUpdateParams{},
 	)
 
@@ -61,13 +74,16 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*RouteI)(nil),
 		&TSSRoute{},
 		&IBCRoute{},
+		&RouterRoute{},
 	)
 
 	registry.RegisterInterface(
 		"tunnel.v1beta1.PacketReceiptI",
 		(*PacketReceiptI)(nil),
 		&TSSPacketReceipt{},
+		&TSSBatchPacketReceipt{},
 		&IBCPacketReceipt{},
+		&RouterPacketReceipt{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
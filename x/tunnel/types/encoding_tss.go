@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/binary"
+
 	"github.com/ethereum/go-ethereum/accounts/abi"
 
 	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
@@ -82,6 +84,42 @@ func EncodeTSS(
 		}
 
 		return append([]byte(feedstypes.EncoderTickABIPrefix), bz...), nil
+	case feedstypes.ENCODER_FIXED_POINT_BORSH:
+		relayPrices, err := feedstypes.ToRelayPrices(prices)
+		if err != nil {
+			return nil, err
+		}
+
+		bz := binary.LittleEndian.AppendUint64([]byte(feedstypes.EncoderFixedPointBorshPrefix), sequence)
+		bz = feedstypes.AppendBorshRelayPrices(bz, relayPrices)
+		return feedstypes.AppendBorshTimestamp(bz, createdAt), nil
+	case feedstypes.ENCODER_FIXED_POINT_BCS:
+		relayPrices, err := feedstypes.ToRelayPrices(prices)
+		if err != nil {
+			return nil, err
+		}
+
+		bz := binary.LittleEndian.AppendUint64([]byte(feedstypes.EncoderFixedPointBCSPrefix), sequence)
+		bz = feedstypes.AppendBCSRelayPrices(bz, relayPrices)
+		return feedstypes.AppendBCSTimestamp(bz, createdAt)
+	case feedstypes.ENCODER_FIXED_POINT_PROTOBUF:
+		relayPrices, err := feedstypes.ToRelayPrices(prices)
+		if err != nil {
+			return nil, err
+		}
+
+		tssPacket := EncodedTSSPacket{
+			Sequence:    sequence,
+			RelayPrices: feedstypes.ToEncodedRelayPrices(relayPrices),
+			CreatedAt:   createdAt,
+		}
+
+		bz, err := tssPacket.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		return append([]byte(feedstypes.EncoderFixedPointProtobufPrefix), bz...), nil
 	default:
 		return nil, ErrInvalidEncoder.Wrapf("invalid encoder mode: %s", encoder.String())
 	}
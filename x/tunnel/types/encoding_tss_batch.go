@@ -0,0 +1,66 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+)
+
+// TunnelBatchPrefix is the prefix of the message of a tunnel batch signing.
+const TunnelBatchPrefix = "\xd7\x30\xc2\x53" // tss.Hash([]byte("TunnelBatch"))[:4]
+
+// EncodeTSSBatchLeaf encodes a leaf of a tunnel batch Merkle tree; the leaf binds the TSS-encoded
+// packet to its tunnel ID and destination contract address.
+func EncodeTSSBatchLeaf(
+	tunnelID uint64,
+	destinationContractAddress string,
+	encodedPacket []byte,
+) []byte {
+	bz := binary.BigEndian.AppendUint64(nil, tunnelID)
+	bz = append(bz, tss.Hash([]byte(destinationContractAddress))...)
+	return append(bz, encodedPacket...)
+}
+
+// EncodeTSSBatch encodes the tunnel batch to tss message
+func EncodeTSSBatch(
+	destinationChainID string,
+	merkleRoot []byte,
+	packetCount uint64,
+	createdAt int64,
+) []byte {
+	bz := append([]byte(TunnelBatchPrefix), tss.Hash([]byte(destinationChainID))...)
+	bz = append(bz, merkleRoot...)
+	bz = binary.BigEndian.AppendUint64(bz, packetCount)
+	return binary.BigEndian.AppendUint64(bz, uint64(createdAt))
+}
+
+// SplitTSSBatchFee splits the signing fee of a batch into n shares. Each denom is divided evenly and
+// its remainder is spread one unit at a time over the first shares, so that the shares sum up to fee.
+func SplitTSSBatchFee(fee sdk.Coins, n int) []sdk.Coins {
+	shares := make([]sdk.Coins, n)
+	for i := range shares {
+		shares[i] = sdk.NewCoins()
+	}
+	if n == 0 {
+		return shares
+	}
+
+	count := sdkmath.NewInt(int64(n))
+	for _, coin := range fee {
+		quo := coin.Amount.Quo(count)
+		rem := coin.Amount.Mod(count).Int64()
+		for i := range shares {
+			amount := quo
+			if int64(i) < rem {
+				amount = amount.AddRaw(1)
+			}
+			shares[i] = shares[i].Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return shares
+}
@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func TestTunnelBatchPrefix(t *testing.T) {
+	require.Equal(t, []byte(types.TunnelBatchPrefix), tss.Hash([]byte("TunnelBatch"))[:4])
+}
+
+func TestEncodeTSSBatch(t *testing.T) {
+	root, _ := hex.DecodeString("0102030405060708091011121314151617181920212223242526272829303132")
+	bz := types.EncodeTSSBatch("chain-1", root, 2, 123)
+
+	require.Len(t, bz, 4+32+32+8+8)
+	require.Equal(t, []byte(types.TunnelBatchPrefix), bz[:4])
+	require.Equal(t, tss.Hash([]byte("chain-1")), bz[4:36])
+	require.Equal(t, root, bz[36:68])
+	require.Equal(t, "0000000000000002000000000000007b", hex.EncodeToString(bz[68:]))
+}
+
+func TestEncodeTSSBatchLeaf(t *testing.T) {
+	bz := types.EncodeTSSBatchLeaf(1, "0x01", []byte{0xaa})
+
+	require.Equal(t, "0000000000000001", hex.EncodeToString(bz[:8]))
+	require.Equal(t, tss.Hash([]byte("0x01")), bz[8:40])
+	require.Equal(t, []byte{0xaa}, bz[40:])
+}
+
+func TestSplitTSSBatchFee(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uband", 10), sdk.NewInt64Coin("uatom", 2))
+
+	shares := types.SplitTSSBatchFee(fee, 3)
+	require.Equal(t, []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 4), sdk.NewInt64Coin("uatom", 1)),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 3), sdk.NewInt64Coin("uatom", 1)),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 3)),
+	}, shares)
+
+	total := sdk.NewCoins()
+	for _, share := range shares {
+		total = total.Add(share...)
+	}
+	require.Equal(t, fee, total)
+
+	require.Empty(t, types.SplitTSSBatchFee(fee, 0))
+}
@@ -55,3 +55,73 @@ func TestEncodeTSSTick(t *testing.T) {
 
 	require.Equal(t, expectedMsg, hex.EncodeToString(msg))
 }
+
+func TestEncodeTSSBorsh(t *testing.T) {
+	expectedMsg := ("a84ea174" +
+		"0300000000000000" +
+		"01000000" +
+		"00000000000000000000000000000000000000000043533a42414e442d555344" +
+		"0200000000000000" +
+		"7b00000000000000")
+
+	msg, err := types.EncodeTSS(
+		3,
+		[]feedstypes.Price{
+			{SignalID: "CS:BAND-USD", Price: 2, Status: feedstypes.PRICE_STATUS_AVAILABLE},
+		},
+		123,
+		feedstypes.ENCODER_FIXED_POINT_BORSH,
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, expectedMsg, hex.EncodeToString(msg))
+}
+
+func TestEncodeTSSBCS(t *testing.T) {
+	expectedMsg := ("b7985e14" +
+		"0300000000000000" +
+		"01" +
+		"20" +
+		"00000000000000000000000000000000000000000043533a42414e442d555344" +
+		"0200000000000000" +
+		"7b00000000000000")
+
+	msg, err := types.EncodeTSS(
+		3,
+		[]feedstypes.Price{
+			{SignalID: "CS:BAND-USD", Price: 2, Status: feedstypes.PRICE_STATUS_AVAILABLE},
+		},
+		123,
+		feedstypes.ENCODER_FIXED_POINT_BCS,
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, expectedMsg, hex.EncodeToString(msg))
+}
+
+func TestEncodeTSSProtobuf(t *testing.T) {
+	expectedMsg := ("ac228fb2" +
+		"0803" +
+		"1224" +
+		"0a20" +
+		"00000000000000000000000000000000000000000043533a42414e442d555344" +
+		"1002" +
+		"187b")
+
+	msg, err := types.EncodeTSS(
+		3,
+		[]feedstypes.Price{
+			{SignalID: "CS:BAND-USD", Price: 2, Status: feedstypes.PRICE_STATUS_AVAILABLE},
+		},
+		123,
+		feedstypes.ENCODER_FIXED_POINT_PROTOBUF,
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, expectedMsg, hex.EncodeToString(msg))
+
+	var packet types.EncodedTSSPacket
+	require.NoError(t, packet.Unmarshal(msg[4:]))
+	require.Equal(t, uint64(3), packet.Sequence)
+	require.Equal(t, int64(123), packet.CreatedAt)
+}
@@ -31,4 +31,14 @@ var (
 	ErrSendPacketPanic           = errorsmod.Register(ModuleName, 24, "panic in sending packet")
 	ErrInvalidChannelID          = errorsmod.Register(ModuleName, 25, "invalid channel id")
 	ErrInvalidPortID             = errorsmod.Register(ModuleName, 26, "invalid port id")
+	ErrInvalidPacketReceipt      = errorsmod.Register(ModuleName, 27, "invalid packet receipt")
+	ErrInvalidSignalDerivation   = errorsmod.Register(ModuleName, 28, "invalid signal derivation")
+	ErrInvalidSchedule           = errorsmod.Register(ModuleName, 29, "invalid schedule")
+	ErrInvalidFeePayerTopUp      = errorsmod.Register(ModuleName, 30, "invalid fee payer top-up")
+	ErrFeePayerTopUpNotFound     = errorsmod.Register(ModuleName, 31, "fee payer top-up not found")
+	ErrInvalidTopUpSender        = errorsmod.Register(ModuleName, 32, "invalid sender of the fee payer top-up")
+	ErrNoTunnelPermission        = errorsmod.Register(ModuleName, 33, "no permission on the tunnel")
+	ErrInvalidTunnelOperator     = errorsmod.Register(ModuleName, 34, "invalid tunnel operator")
+	ErrInsufficientEscrowedFee   = errorsmod.Register(ModuleName, 35, "insufficient escrowed fee")
+	ErrInvalidPriceSnapshots     = errorsmod.Register(ModuleName, 36, "invalid price snapshots")
 )
@@ -2,33 +2,67 @@ package types
 
 // events
 const (
-	EventTypeUpdateParams             = "update_params"
-	EventTypeCreateTunnel             = "create_tunnel"
-	EventTypeUpdateSignalsAndInterval = "update_signals_and_interval"
-	EventTypeActivateTunnel           = "activate_tunnel"
-	EventTypeDeactivateTunnel         = "deactivate_tunnel"
-	EventTypeTriggerTunnel            = "trigger_tunnel"
-	EventTypeProducePacketFail        = "produce_packet_fail"
-	EventTypeProducePacketSuccess     = "produce_packet_success"
-	EventTypeDepositToTunnel          = "deposit_to_tunnel"
-	EventTypeWithdrawFromTunnel       = "withdraw_from_tunnel"
+	EventTypeUpdateParams              = "update_params"
+	EventTypeCreateTunnel              = "create_tunnel"
+	EventTypeUpdateSignalsAndInterval  = "update_signals_and_interval"
+	EventTypeActivateTunnel            = "activate_tunnel"
+	EventTypeDeactivateTunnel          = "deactivate_tunnel"
+	EventTypeTriggerTunnel             = "trigger_tunnel"
+	EventTypeProducePacketFail         = "produce_packet_fail"
+	EventTypeProducePacketSuccess      = "produce_packet_success"
+	EventTypeDepositToTunnel           = "deposit_to_tunnel"
+	EventTypeWithdrawFromTunnel        = "withdraw_from_tunnel"
+	EventTypeUpdatePacketStatus        = "update_packet_status"
+	EventTypeResendPacket              = "resend_packet"
+	EventTypeSetFeePayerTopUp          = "set_fee_payer_top_up"
+	EventTypeRemoveFeePayerTopUp       = "remove_fee_payer_top_up"
+	EventTypeUpdateLowBalanceThreshold = "update_low_balance_threshold"
+	EventTypeTopUpFeePayer             = "top_up_fee_payer"
+	EventTypeTopUpFeePayerFail         = "top_up_fee_payer_fail"
+	EventTypeFeePayerLowBalance        = "fee_payer_low_balance"
+	EventTypeTransferTunnelOwnership   = "transfer_tunnel_ownership"
+	EventTypeSetTunnelOperator         = "set_tunnel_operator"
+	EventTypePrunePackets              = "prune_packets"
+	EventTypeSignTSSBatch              = "sign_tss_batch"
+	EventTypeSignTSSBatchFail          = "sign_tss_batch_fail"
+	EventTypeSignTSSBatchPacketFail    = "sign_tss_batch_packet_fail"
 
-	AttributeKeyParams           = "params"
-	AttributeKeyTunnelID         = "tunnel_id"
-	AttributeKeySequence         = "sequence"
-	AttributeKeyInterval         = "interval"
-	AttributeKeyRoute            = "route"
-	AttributeKeyEncoder          = "encoder"
-	AttributeKeyInitialDeposit   = "initial_deposit"
-	AttributeKeyFeePayer         = "fee_payer"
-	AttributeKeySignalID         = "signal_id"
-	AttributeKeySoftDeviationBPS = "soft_deviation_bps"
-	AttributeKeyHardDeviationBPS = "hard_deviation_bps"
-	AttributeKeyIsActive         = "is_active"
-	AttributeKeyCreatedAt        = "created_at"
-	AttributeKeyCreator          = "creator"
-	AttributeKeyDepositor        = "depositor"
-	AttributeKeyWithdrawer       = "withdrawer"
-	AttributeKeyAmount           = "amount"
-	AttributeKeyReason           = "reason"
+	AttributeKeyParams              = "params"
+	AttributeKeyTunnelID            = "tunnel_id"
+	AttributeKeySequence            = "sequence"
+	AttributeKeyInterval            = "interval"
+	AttributeKeyRoute               = "route"
+	AttributeKeyEncoder             = "encoder"
+	AttributeKeyInitialDeposit      = "initial_deposit"
+	AttributeKeyFeePayer            = "fee_payer"
+	AttributeKeySignalID            = "signal_id"
+	AttributeKeySoftDeviationBPS    = "soft_deviation_bps"
+	AttributeKeyHardDeviationBPS    = "hard_deviation_bps"
+	AttributeKeyIsActive            = "is_active"
+	AttributeKeyCreatedAt           = "created_at"
+	AttributeKeyCreator             = "creator"
+	AttributeKeyDepositor           = "depositor"
+	AttributeKeyWithdrawer          = "withdrawer"
+	AttributeKeyAmount              = "amount"
+	AttributeKeyReason              = "reason"
+	AttributeKeyStatus              = "status"
+	AttributeKeyIBCSequence         = "ibc_sequence"
+	AttributeKeyScheduleCron        = "schedule_cron"
+	AttributeKeyBlockHeight         = "block_height"
+	AttributeKeyFunder              = "funder"
+	AttributeKeyTargetBalance       = "target_balance"
+	AttributeKeyCap                 = "cap"
+	AttributeKeyBalance             = "balance"
+	AttributeKeyLowBalanceThreshold = "low_balance_threshold"
+	AttributeKeyOwner               = "owner"
+	AttributeKeyNewOwner            = "new_owner"
+	AttributeKeyOperator            = "operator"
+	AttributeKeyRole                = "role"
+	AttributeKeyFromSequence        = "from_sequence"
+	AttributeKeyToSequence          = "to_sequence"
+	AttributeKeyPacketsHash         = "packets_hash"
+	AttributeKeyDestinationChainID  = "destination_chain_id"
+	AttributeKeySigningID           = "signing_id"
+	AttributeKeyMerkleRoot          = "merkle_root"
+	AttributeKeyPacketCount         = "packet_count"
 )
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 
@@ -54,6 +55,14 @@ type ICS4Wrapper interface {
 	) (sequence uint64, err error)
 }
 
+// TransferKeeper defines the expected IBC transfer keeper
+type TransferKeeper interface {
+	Transfer(
+		ctx context.Context,
+		msg *ibctransfertypes.MsgTransfer,
+	) (*ibctransfertypes.MsgTransferResponse, error)
+}
+
 // ChannelKeeper defines the expected IBC channel keeper
 type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
@@ -72,6 +81,7 @@ type ScopedKeeper interface {
 type FeedsKeeper interface {
 	GetAllPrices(ctx sdk.Context) (prices []feedstypes.Price)
 	GetPrices(ctx sdk.Context, signalIDs []string) (prices []feedstypes.Price)
+	GetPriceHistory(ctx sdk.Context, signalID string) (prices []feedstypes.Price)
 }
 
 type BandtssKeeper interface {
@@ -84,5 +94,12 @@ type BandtssKeeper interface {
 		sender sdk.AccAddress,
 		feeLimit sdk.Coins,
 	) (bandtsstypes.SigningID, error)
+	CreateDirectSigningRequest(
+		ctx sdk.Context,
+		content tsstypes.Content,
+		memo string,
+		sender sdk.AccAddress,
+		feeLimit sdk.Coins,
+	) (bandtsstypes.SigningID, error)
 	GetSigningFee(ctx sdk.Context) (sdk.Coins, error)
 }
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewFeePayerTopUp creates a new FeePayerTopUp instance.
+func NewFeePayerTopUp(
+	tunnelID uint64,
+	funder string,
+	targetBalance sdk.Coins,
+	topUpCap sdk.Coins,
+	totalToppedUp sdk.Coins,
+) FeePayerTopUp {
+	return FeePayerTopUp{
+		TunnelID:      tunnelID,
+		Funder:        funder,
+		TargetBalance: targetBalance,
+		Cap:           topUpCap,
+		TotalToppedUp: totalToppedUp,
+	}
+}
+
+// Validate validates the fee payer top-up.
+func (t FeePayerTopUp) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(t.Funder); err != nil {
+		return ErrInvalidFeePayerTopUp.Wrapf("invalid funder address: %s", err)
+	}
+
+	if t.TargetBalance.Empty() || !t.TargetBalance.IsValid() {
+		return ErrInvalidFeePayerTopUp.Wrapf("invalid target balance: %s", t.TargetBalance)
+	}
+
+	if t.Cap.Empty() || !t.Cap.IsValid() {
+		return ErrInvalidFeePayerTopUp.Wrapf("invalid cap: %s", t.Cap)
+	}
+
+	if !t.Cap.DenomsSubsetOf(t.TargetBalance) {
+		return ErrInvalidFeePayerTopUp.Wrapf("cap denoms %s must be in target balance %s", t.Cap, t.TargetBalance)
+	}
+
+	if !t.TotalToppedUp.IsValid() {
+		return ErrInvalidFeePayerTopUp.Wrapf("invalid total topped up: %s", t.TotalToppedUp)
+	}
+
+	return nil
+}
+
+// RemainingCap returns the amount that the funder can still transfer to the fee payer.
+func (t FeePayerTopUp) RemainingCap() sdk.Coins {
+	remaining := sdk.NewCoins()
+	for _, c := range t.Cap {
+		if amt := c.Amount.Sub(t.TotalToppedUp.AmountOf(c.Denom)); amt.IsPositive() {
+			remaining = remaining.Add(sdk.NewCoin(c.Denom, amt))
+		}
+	}
+	return remaining
+}
+
+// ComputeTopUpAmount returns the amount to refill the fee payer with the given balance, i.e. the
+// shortfall of the balance from the target balance bounded by the remaining cap.
+func (t FeePayerTopUp) ComputeTopUpAmount(balance sdk.Coins) sdk.Coins {
+	shortfall := sdk.NewCoins()
+	for _, target := range t.TargetBalance {
+		if amt := balance.AmountOf(target.Denom); amt.LT(target.Amount) {
+			shortfall = shortfall.Add(sdk.NewCoin(target.Denom, target.Amount.Sub(amt)))
+		}
+	}
+	return shortfall.Min(t.RemainingCap())
+}
+
+// ComputeRemainingPackets returns the number of packets that the given balance can pay for at the
+// given packet fee. It returns the maximum uint64 value if the packet fee is zero.
+func ComputeRemainingPackets(balance sdk.Coins, packetFee sdk.Coins) uint64 {
+	remaining := uint64(math.MaxUint64)
+	for _, fee := range packetFee {
+		if !fee.Amount.IsPositive() {
+			continue
+		}
+
+		count := balance.AmountOf(fee.Denom).Quo(fee.Amount)
+		if count.IsUint64() && count.Uint64() < remaining {
+			remaining = count.Uint64()
+		}
+	}
+	return remaining
+}
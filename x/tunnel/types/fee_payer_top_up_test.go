@@ -0,0 +1,144 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func TestFeePayerTopUp_Validate(t *testing.T) {
+	funder := sdk.AccAddress("funder").String()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uband", 100))
+
+	tests := []struct {
+		name      string
+		topUp     types.FeePayerTopUp
+		expErrMsg string
+	}{
+		{
+			name:  "valid top-up",
+			topUp: types.NewFeePayerTopUp(1, funder, coins, coins, sdk.NewCoins()),
+		},
+		{
+			name:      "invalid funder",
+			topUp:     types.NewFeePayerTopUp(1, "invalid", coins, coins, sdk.NewCoins()),
+			expErrMsg: "invalid funder address",
+		},
+		{
+			name:      "empty target balance",
+			topUp:     types.NewFeePayerTopUp(1, funder, sdk.NewCoins(), coins, sdk.NewCoins()),
+			expErrMsg: "invalid target balance",
+		},
+		{
+			name:      "empty cap",
+			topUp:     types.NewFeePayerTopUp(1, funder, coins, sdk.NewCoins(), sdk.NewCoins()),
+			expErrMsg: "invalid cap",
+		},
+		{
+			name: "cap denom not in target balance",
+			topUp: types.NewFeePayerTopUp(
+				1,
+				funder,
+				coins,
+				sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)),
+				sdk.NewCoins(),
+			),
+			expErrMsg: "cap denoms",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.topUp.Validate()
+			if tc.expErrMsg != "" {
+				require.ErrorIs(t, err, types.ErrInvalidFeePayerTopUp)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFeePayerTopUp_ComputeTopUpAmount(t *testing.T) {
+	topUp := types.NewFeePayerTopUp(
+		1,
+		sdk.AccAddress("funder").String(),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 1000)),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 2000)),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 1500)),
+	)
+
+	tests := []struct {
+		name      string
+		balance   sdk.Coins
+		expAmount sdk.Coins
+	}{
+		{
+			name:      "balance above target",
+			balance:   sdk.NewCoins(sdk.NewInt64Coin("uband", 1200)),
+			expAmount: sdk.NewCoins(),
+		},
+		{
+			name:      "shortfall within remaining cap",
+			balance:   sdk.NewCoins(sdk.NewInt64Coin("uband", 800)),
+			expAmount: sdk.NewCoins(sdk.NewInt64Coin("uband", 200)),
+		},
+		{
+			name:      "shortfall bounded by remaining cap",
+			balance:   sdk.NewCoins(sdk.NewInt64Coin("uatom", 800)),
+			expAmount: sdk.NewCoins(sdk.NewInt64Coin("uband", 500)),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expAmount, topUp.ComputeTopUpAmount(tc.balance))
+		})
+	}
+}
+
+func TestComputeRemainingPackets(t *testing.T) {
+	tests := []struct {
+		name      string
+		balance   sdk.Coins
+		packetFee sdk.Coins
+		expCount  uint64
+	}{
+		{
+			name:      "single denom",
+			balance:   sdk.NewCoins(sdk.NewInt64Coin("uband", 1050)),
+			packetFee: sdk.NewCoins(sdk.NewInt64Coin("uband", 100)),
+			expCount:  10,
+		},
+		{
+			name:      "multiple denoms take the minimum",
+			balance:   sdk.NewCoins(sdk.NewInt64Coin("uatom", 30), sdk.NewInt64Coin("uband", 1000)),
+			packetFee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10), sdk.NewInt64Coin("uband", 100)),
+			expCount:  3,
+		},
+		{
+			name:      "missing denom",
+			balance:   sdk.NewCoins(sdk.NewInt64Coin("uband", 1000)),
+			packetFee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)),
+			expCount:  0,
+		},
+		{
+			name:      "zero packet fee",
+			balance:   sdk.NewCoins(),
+			packetFee: sdk.NewCoins(),
+			expCount:  math.MaxUint64,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expCount, types.ComputeRemainingPackets(tc.balance, tc.packetFee))
+		})
+	}
+}
@@ -71,6 +71,42 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	// validate the fee payer top-ups
+	topUpTunnelIDs := make(map[uint64]bool)
+	for _, t := range data.FeePayerTopUps {
+		if _, ok := tunnelIDs[t.TunnelID]; !ok {
+			return ErrInvalidGenesis.Wrapf("fee payer top-up has non-existent tunnel id: %d", t.TunnelID)
+		}
+		if _, ok := topUpTunnelIDs[t.TunnelID]; ok {
+			return ErrInvalidGenesis.Wrapf("duplicate fee payer top-up for tunnel %d", t.TunnelID)
+		}
+		if err := t.Validate(); err != nil {
+			return ErrInvalidGenesis.Wrapf("invalid fee payer top-up: %s", err.Error())
+		}
+		topUpTunnelIDs[t.TunnelID] = true
+	}
+
+	// validate the tunnel operators
+	type operatorKey struct {
+		TunnelID uint64
+		Address  string
+	}
+	operators := make(map[operatorKey]bool)
+	for _, o := range data.TunnelOperators {
+		if _, ok := tunnelIDs[o.TunnelID]; !ok {
+			return ErrInvalidGenesis.Wrapf("tunnel operator has non-existent tunnel id: %d", o.TunnelID)
+		}
+
+		key := operatorKey{o.TunnelID, o.Address}
+		if operators[key] {
+			return ErrInvalidGenesis.Wrapf("duplicate tunnel operator: %v", o)
+		}
+		if err := o.Validate(); err != nil {
+			return ErrInvalidGenesis.Wrapf("invalid tunnel operator: %s", err.Error())
+		}
+		operators[key] = true
+	}
+
 	// validate the total fees
 	if err := data.TotalFees.Validate(); err != nil {
 		return ErrInvalidGenesis.Wrapf("invalid total fees: %s", err.Error())
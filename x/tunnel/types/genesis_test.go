@@ -112,6 +112,63 @@ func TestValidateGenesis(t *testing.T) {
 			expErr:    true,
 			expErrMsg: "deposits mismatch total deposit for tunnel",
 		},
+		"fee payer top-up has non-existent tunnel": {
+			genesisState: types.GenesisState{
+				Params:      types.DefaultParams(),
+				TunnelCount: 1,
+				Tunnels: []types.Tunnel{
+					{ID: 1},
+				},
+				FeePayerTopUps: []types.FeePayerTopUp{
+					types.NewFeePayerTopUp(
+						2,
+						sdk.AccAddress("funder").String(),
+						sdk.NewCoins(sdk.NewInt64Coin("uband", 100)),
+						sdk.NewCoins(sdk.NewInt64Coin("uband", 100)),
+						nil,
+					),
+				},
+			},
+			expErr:    true,
+			expErrMsg: "fee payer top-up has non-existent tunnel id",
+		},
+		"invalid fee payer top-up": {
+			genesisState: types.GenesisState{
+				Params:      types.DefaultParams(),
+				TunnelCount: 1,
+				Tunnels: []types.Tunnel{
+					{ID: 1},
+				},
+				FeePayerTopUps: []types.FeePayerTopUp{
+					types.NewFeePayerTopUp(1, "invalid", nil, nil, nil),
+				},
+			},
+			expErr:    true,
+			expErrMsg: "invalid fee payer top-up",
+		},
+		"duplicate tunnel operator": {
+			genesisState: types.GenesisState{
+				Params:      types.DefaultParams(),
+				TunnelCount: 1,
+				Tunnels: []types.Tunnel{
+					{ID: 1},
+				},
+				TunnelOperators: []types.TunnelOperator{
+					types.NewTunnelOperator(
+						1,
+						sdk.AccAddress("operator").String(),
+						[]types.OperatorRole{types.OPERATOR_ROLE_TRIGGER},
+					),
+					types.NewTunnelOperator(
+						1,
+						sdk.AccAddress("operator").String(),
+						[]types.OperatorRole{types.OPERATOR_ROLE_FUNDS},
+					),
+				},
+			},
+			expErr:    true,
+			expErrMsg: "duplicate tunnel operator",
+		},
 		"all good": {
 			genesisState: types.GenesisState{
 				Params:      types.DefaultParams(),
@@ -15,6 +15,9 @@ const (
 	// TunnelAccountsKey is used to store the key for the account
 	TunnelAccountsKey = "tunnel-accounts"
 
+	// TSSBatchEscrowKey is the key used to derive the account escrowing the signing fees of TSS batches
+	TSSBatchEscrowKey = "tss-batch-escrow"
+
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
 
@@ -31,16 +34,26 @@ var (
 	TotalFeeStoreKey    = []byte{0x01}
 
 	// store prefixes
-	ActiveTunnelIDStoreKeyPrefix = []byte{0x10}
-	TunnelStoreKeyPrefix         = []byte{0x11}
-	PacketStoreKeyPrefix         = []byte{0x12}
-	LatestPricesStoreKeyPrefix   = []byte{0x13}
-	DepositStoreKeyPrefix        = []byte{0x14}
+	ActiveTunnelIDStoreKeyPrefix  = []byte{0x10}
+	TunnelStoreKeyPrefix          = []byte{0x11}
+	PacketStoreKeyPrefix          = []byte{0x12}
+	LatestPricesStoreKeyPrefix    = []byte{0x13}
+	DepositStoreKeyPrefix         = []byte{0x14}
+	FailureCountStoreKeyPrefix    = []byte{0x15}
+	FeePayerTopUpStoreKeyPrefix   = []byte{0x16}
+	TunnelOperatorStoreKeyPrefix  = []byte{0x17}
+	PendingTSSBatchStoreKeyPrefix = []byte{0x18}
 
 	// params store keys
 	ParamsKey = []byte{0x90}
 )
 
+// GetTSSBatchEscrowAddress returns the address of the account that escrows the signing fees of
+// pending TSS batch packets, apart from the deposits held by the module account.
+func GetTSSBatchEscrowAddress() sdk.AccAddress {
+	return address.Module(ModuleName, []byte(TSSBatchEscrowKey))
+}
+
 // TunnelStoreKey returns the key to retrieve a specific tunnel from the store.
 func TunnelStoreKey(tunnelID uint64) []byte {
 	return append(TunnelStoreKeyPrefix, sdk.Uint64ToBigEndian(tunnelID)...)
@@ -75,3 +88,31 @@ func DepositsStoreKey(tunnelID uint64) []byte {
 func DepositStoreKey(tunnelID uint64, depositor sdk.AccAddress) []byte {
 	return append(DepositsStoreKey(tunnelID), address.MustLengthPrefix(depositor)...)
 }
+
+// FailureCountStoreKey returns the key to retrieve the consecutive delivery failure count of a tunnel from the store.
+func FailureCountStoreKey(tunnelID uint64) []byte {
+	return append(FailureCountStoreKeyPrefix, sdk.Uint64ToBigEndian(tunnelID)...)
+}
+
+// FeePayerTopUpStoreKey returns the key to retrieve the fee payer top-up of a tunnel from the store.
+func FeePayerTopUpStoreKey(tunnelID uint64) []byte {
+	return append(FeePayerTopUpStoreKeyPrefix, sdk.Uint64ToBigEndian(tunnelID)...)
+}
+
+// TunnelOperatorsStoreKey returns the key to retrieve all operators of a tunnel from the store.
+func TunnelOperatorsStoreKey(tunnelID uint64) []byte {
+	return append(TunnelOperatorStoreKeyPrefix, sdk.Uint64ToBigEndian(tunnelID)...)
+}
+
+// TunnelOperatorStoreKey returns the key to retrieve an operator of a tunnel from the store.
+func TunnelOperatorStoreKey(tunnelID uint64, operator sdk.AccAddress) []byte {
+	return append(TunnelOperatorsStoreKey(tunnelID), address.MustLengthPrefix(operator)...)
+}
+
+// PendingTSSBatchPacketStoreKey returns the key to retrieve a pending TSS batch packet from the store.
+func PendingTSSBatchPacketStoreKey(tunnelID uint64, sequence uint64) []byte {
+	return append(
+		append(PendingTSSBatchStoreKeyPrefix, sdk.Uint64ToBigEndian(tunnelID)...),
+		sdk.Uint64ToBigEndian(sequence)...,
+	)
+}
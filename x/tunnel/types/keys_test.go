@@ -47,6 +47,11 @@ func TestDepositStoreKey(t *testing.T) {
 	require.Equal(t, expect, types.DepositStoreKey(1, depositor))
 }
 
+func TestPendingTSSBatchPacketStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("1800000000000000010000000000000002")
+	require.Equal(t, expect, types.PendingTSSBatchPacketStoreKey(1, 2))
+}
+
 func TestParamsKey(t *testing.T) {
 	expect, _ := hex.DecodeString("90")
 	require.Equal(t, expect, types.ParamsKey)
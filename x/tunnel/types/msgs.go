@@ -11,15 +11,16 @@ import (
 )
 
 var (
-	_, _, _, _, _, _, _, _, _, _ sdk.Msg                       = &MsgCreateTunnel{}, &MsgUpdateRoute{}, &MsgUpdateSignalsAndInterval{}, &MsgWithdrawFeePayerFunds{}, &MsgActivateTunnel{}, &MsgDeactivateTunnel{}, &MsgTriggerTunnel{}, &MsgDepositToTunnel{}, &MsgWithdrawFromTunnel{}, &MsgUpdateParams{}
-	_, _, _, _, _, _, _, _, _    sdk.HasValidateBasic          = &MsgCreateTunnel{}, &MsgUpdateRoute{}, &MsgUpdateSignalsAndInterval{}, &MsgActivateTunnel{}, &MsgDeactivateTunnel{}, &MsgTriggerTunnel{}, &MsgDepositToTunnel{}, &MsgWithdrawFromTunnel{}, &MsgUpdateParams{}
-	_, _                         types.UnpackInterfacesMessage = &MsgCreateTunnel{}, &MsgUpdateRoute{}
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _ sdk.Msg                       = &MsgCreateTunnel{}, &MsgUpdateRoute{}, &MsgUpdateSignalsAndInterval{}, &MsgWithdrawFeePayerFunds{}, &MsgActivateTunnel{}, &MsgDeactivateTunnel{}, &MsgTriggerTunnel{}, &MsgDepositToTunnel{}, &MsgWithdrawFromTunnel{}, &MsgSetFeePayerTopUp{}, &MsgRemoveFeePayerTopUp{}, &MsgUpdateLowBalanceThreshold{}, &MsgTransferTunnelOwnership{}, &MsgSetTunnelOperator{}, &MsgUpdateParams{}
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _    sdk.HasValidateBasic          = &MsgCreateTunnel{}, &MsgUpdateRoute{}, &MsgUpdateSignalsAndInterval{}, &MsgActivateTunnel{}, &MsgDeactivateTunnel{}, &MsgTriggerTunnel{}, &MsgDepositToTunnel{}, &MsgWithdrawFromTunnel{}, &MsgSetFeePayerTopUp{}, &MsgRemoveFeePayerTopUp{}, &MsgUpdateLowBalanceThreshold{}, &MsgTransferTunnelOwnership{}, &MsgSetTunnelOperator{}, &MsgUpdateParams{}
+	_, _                                        types.UnpackInterfacesMessage = &MsgCreateTunnel{}, &MsgUpdateRoute{}
 )
 
 // NewMsgCreateTunnel creates a new MsgCreateTunnel instance.
 func NewMsgCreateTunnel(
 	signalDeviations []SignalDeviation,
 	interval uint64,
+	schedule *Schedule,
 	route RouteI,
 	initialDeposit sdk.Coins,
 	creator string,
@@ -39,6 +40,7 @@ func NewMsgCreateTunnel(
 		Route:            any,
 		InitialDeposit:   initialDeposit,
 		Creator:          creator,
+		Schedule:         schedule,
 	}, nil
 }
 
@@ -46,14 +48,16 @@ func NewMsgCreateTunnel(
 func NewMsgCreateTSSTunnel(
 	signalDeviations []SignalDeviation,
 	interval uint64,
+	schedule *Schedule,
 	destinationChainID string,
 	destinationContractAddress string,
 	encoder feedstypes.Encoder,
+	batched bool,
 	initialDeposit sdk.Coins,
 	creator string,
 ) (*MsgCreateTunnel, error) {
-	r := NewTSSRoute(destinationChainID, destinationContractAddress, encoder)
-	m, err := NewMsgCreateTunnel(signalDeviations, interval, &r, initialDeposit, creator)
+	r := NewTSSRoute(destinationChainID, destinationContractAddress, encoder, batched)
+	m, err := NewMsgCreateTunnel(signalDeviations, interval, schedule, &r, initialDeposit, creator)
 	if err != nil {
 		return nil, err
 	}
@@ -65,11 +69,44 @@ func NewMsgCreateTSSTunnel(
 func NewMsgCreateIBCTunnel(
 	signalDeviations []SignalDeviation,
 	interval uint64,
+	schedule *Schedule,
 	deposit sdk.Coins,
 	creator string,
 ) (*MsgCreateTunnel, error) {
 	r := NewIBCRoute("")
-	m, err := NewMsgCreateTunnel(signalDeviations, interval, r, deposit, creator)
+	m, err := NewMsgCreateTunnel(signalDeviations, interval, schedule, r, deposit, creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// NewMsgCreateRouterTunnel creates a new MsgCreateTunnel instance with Router route type.
+func NewMsgCreateRouterTunnel(
+	signalDeviations []SignalDeviation,
+	interval uint64,
+	schedule *Schedule,
+	channelID string,
+	bridgeContractAddress string,
+	destinationChainID string,
+	destinationContractAddress string,
+	destinationGasLimit uint64,
+	fee sdk.Coin,
+	encoder feedstypes.Encoder,
+	initialDeposit sdk.Coins,
+	creator string,
+) (*MsgCreateTunnel, error) {
+	r := NewRouterRoute(
+		channelID,
+		bridgeContractAddress,
+		destinationChainID,
+		destinationContractAddress,
+		destinationGasLimit,
+		fee,
+		encoder,
+	)
+	m, err := NewMsgCreateTunnel(signalDeviations, interval, schedule, r, initialDeposit, creator)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +141,18 @@ func (m MsgCreateTunnel) ValidateBasic() error {
 		return err
 	}
 
+	// signal derivations must be valid
+	if err := validateSignalDerivations(m.SignalDeviations); err != nil {
+		return err
+	}
+
+	// schedule must be valid if set
+	if m.Schedule != nil {
+		if err := m.Schedule.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	// route must be valid
 	r, err := m.GetRouteValue()
 	if err != nil {
@@ -159,6 +208,31 @@ func NewMsgUpdateIBCRoute(
 	return NewMsgUpdateRoute(tunnelID, NewIBCRoute(channelID), creator)
 }
 
+// NewMsgUpdateRouterRoute creates a new MsgUpdateRoute instance with Router route type.
+func NewMsgUpdateRouterRoute(
+	tunnelID uint64,
+	channelID string,
+	bridgeContractAddress string,
+	destinationChainID string,
+	destinationContractAddress string,
+	destinationGasLimit uint64,
+	fee sdk.Coin,
+	encoder feedstypes.Encoder,
+	creator string,
+) (*MsgUpdateRoute, error) {
+	r := NewRouterRoute(
+		channelID,
+		bridgeContractAddress,
+		destinationChainID,
+		destinationContractAddress,
+		destinationGasLimit,
+		fee,
+		encoder,
+	)
+
+	return NewMsgUpdateRoute(tunnelID, r, creator)
+}
+
 // GetRouteValue returns the route of the message.
 func (m MsgUpdateRoute) GetRouteValue() (RouteI, error) {
 	r, ok := m.Route.GetCachedValue().(RouteI)
@@ -199,6 +273,7 @@ func NewMsgUpdateSignalsAndInterval(
 	tunnelID uint64,
 	signalDeviations []SignalDeviation,
 	interval uint64,
+	schedule *Schedule,
 	creator string,
 ) *MsgUpdateSignalsAndInterval {
 	return &MsgUpdateSignalsAndInterval{
@@ -206,6 +281,7 @@ func NewMsgUpdateSignalsAndInterval(
 		SignalDeviations: signalDeviations,
 		Interval:         interval,
 		Creator:          creator,
+		Schedule:         schedule,
 	}
 }
 
@@ -225,6 +301,18 @@ func (m MsgUpdateSignalsAndInterval) ValidateBasic() error {
 		return err
 	}
 
+	// signal derivations must be valid
+	if err := validateSignalDerivations(m.SignalDeviations); err != nil {
+		return err
+	}
+
+	// schedule must be valid if set
+	if m.Schedule != nil {
+		if err := m.Schedule.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -364,6 +452,134 @@ func (m MsgWithdrawFromTunnel) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgSetFeePayerTopUp creates a new MsgSetFeePayerTopUp instance.
+func NewMsgSetFeePayerTopUp(
+	tunnelID uint64,
+	funder string,
+	targetBalance sdk.Coins,
+	topUpCap sdk.Coins,
+	creator string,
+) *MsgSetFeePayerTopUp {
+	return &MsgSetFeePayerTopUp{
+		TunnelID:      tunnelID,
+		Funder:        funder,
+		TargetBalance: targetBalance,
+		Cap:           topUpCap,
+		Creator:       creator,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgSetFeePayerTopUp) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return NewFeePayerTopUp(m.TunnelID, m.Funder, m.TargetBalance, m.Cap, sdk.NewCoins()).Validate()
+}
+
+// NewMsgRemoveFeePayerTopUp creates a new MsgRemoveFeePayerTopUp instance.
+func NewMsgRemoveFeePayerTopUp(tunnelID uint64, sender string) *MsgRemoveFeePayerTopUp {
+	return &MsgRemoveFeePayerTopUp{
+		TunnelID: tunnelID,
+		Sender:   sender,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgRemoveFeePayerTopUp) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgUpdateLowBalanceThreshold creates a new MsgUpdateLowBalanceThreshold instance.
+func NewMsgUpdateLowBalanceThreshold(
+	tunnelID uint64,
+	lowBalanceThreshold sdk.Coins,
+	creator string,
+) *MsgUpdateLowBalanceThreshold {
+	return &MsgUpdateLowBalanceThreshold{
+		TunnelID:            tunnelID,
+		LowBalanceThreshold: lowBalanceThreshold,
+		Creator:             creator,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgUpdateLowBalanceThreshold) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if !m.LowBalanceThreshold.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid low balance threshold: %s", m.LowBalanceThreshold)
+	}
+
+	return nil
+}
+
+// NewMsgTransferTunnelOwnership creates a new MsgTransferTunnelOwnership instance.
+func NewMsgTransferTunnelOwnership(tunnelID uint64, newOwner string, owner string) *MsgTransferTunnelOwnership {
+	return &MsgTransferTunnelOwnership{
+		TunnelID: tunnelID,
+		NewOwner: newOwner,
+		Owner:    owner,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgTransferTunnelOwnership) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.NewOwner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new owner address: %s", err)
+	}
+
+	if m.NewOwner == m.Owner {
+		return sdkerrors.ErrInvalidRequest.Wrap("new owner must differ from the current owner")
+	}
+
+	return nil
+}
+
+// NewMsgSetTunnelOperator creates a new MsgSetTunnelOperator instance.
+func NewMsgSetTunnelOperator(
+	tunnelID uint64,
+	operator string,
+	roles []OperatorRole,
+	owner string,
+) *MsgSetTunnelOperator {
+	return &MsgSetTunnelOperator{
+		TunnelID: tunnelID,
+		Operator: operator,
+		Roles:    roles,
+		Owner:    owner,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgSetTunnelOperator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address: %s", err)
+	}
+
+	if m.Operator == m.Owner {
+		return ErrInvalidTunnelOperator.Wrap("owner cannot be an operator")
+	}
+
+	// empty roles remove the operator
+	return ValidateOperatorRoles(m.Roles)
+}
+
 // NewMsgUpdateParams creates a new MsgUpdateParams instance.
 func NewMsgUpdateParams(
 	authority string,
@@ -402,3 +618,17 @@ func validateUniqueSignalIDs(signalDeviations []SignalDeviation) error {
 	}
 	return nil
 }
+
+// validateSignalDerivations checks if the derivations of the given signal deviations are valid
+func validateSignalDerivations(signalDeviations []SignalDeviation) error {
+	for _, sd := range signalDeviations {
+		if sd.Derivation == nil {
+			continue
+		}
+
+		if err := sd.Derivation.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
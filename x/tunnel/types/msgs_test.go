@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
@@ -28,10 +30,11 @@ func TestMsgCreateTunnel_ValidateBasic(t *testing.T) {
 	}
 	initialDeposit := sdk.NewCoins(sdk.NewInt64Coin("uband", 100))
 
-	route := types.NewTSSRoute("chain-1", "contract-1", feedstypes.ENCODER_FIXED_POINT_ABI)
+	route := types.NewTSSRoute("chain-1", "contract-1", feedstypes.ENCODER_FIXED_POINT_ABI, false)
 	msg, err := types.NewMsgCreateTunnel(
 		signalDeviations,
 		10,
+		nil,
 		&route,
 		initialDeposit,
 		sdk.AccAddress([]byte("creator1")).String(),
@@ -46,6 +49,14 @@ func TestMsgCreateTunnel_ValidateBasic(t *testing.T) {
 	msg.Creator = "invalidCreator"
 	err = msg.ValidateBasic()
 	require.Error(t, err)
+
+	// Invalid signal derivation
+	msg.Creator = validCreator.String()
+	msg.SignalDeviations = []types.SignalDeviation{
+		types.NewDerivedSignalDeviation("CS:ETH-BTC", 100, 100, types.NewCrossRateDerivation("CS:ETH-USD", "")),
+	}
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidSignalDerivation)
 }
 
 // ====================================
@@ -88,7 +99,7 @@ func TestMsgUpdateSignalsAndInterval_ValidateBasic(t *testing.T) {
 	signalDeviations := []types.SignalDeviation{
 		{SignalID: "signal1", SoftDeviationBPS: 5000, HardDeviationBPS: 1000},
 	}
-	msg := types.NewMsgUpdateSignalsAndInterval(1, signalDeviations, 10, validCreator.String())
+	msg := types.NewMsgUpdateSignalsAndInterval(1, signalDeviations, 10, nil, validCreator.String())
 
 	// Valid case
 	err := msg.ValidateBasic()
@@ -104,6 +115,12 @@ func TestMsgUpdateSignalsAndInterval_ValidateBasic(t *testing.T) {
 	msg.SignalDeviations = []types.SignalDeviation{}
 	err = msg.ValidateBasic()
 	require.Error(t, err)
+
+	// Invalid schedule
+	msg.SignalDeviations = signalDeviations
+	msg.Schedule = types.NewSchedule("0 0 * *", nil)
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidSchedule)
 }
 
 // ====================================
@@ -180,6 +197,139 @@ func TestMsgWithdrawFromTunnel_ValidateBasic(t *testing.T) {
 	require.Error(t, err)
 }
 
+// ====================================
+// MsgSetFeePayerTopUp
+// ====================================
+
+func TestMsgSetFeePayerTopUp_ValidateBasic(t *testing.T) {
+	// Valid message
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uband", 100))
+	msg := types.NewMsgSetFeePayerTopUp(1, validDepositor.String(), coins, coins, validCreator.String())
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid creator
+	msg.Creator = "invalidCreator"
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Invalid funder
+	msg.Creator = validCreator.String()
+	msg.Funder = "invalidFunder"
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidFeePayerTopUp)
+
+	// Empty cap
+	msg.Funder = validDepositor.String()
+	msg.Cap = sdk.Coins{}
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidFeePayerTopUp)
+}
+
+// ====================================
+// MsgRemoveFeePayerTopUp
+// ====================================
+
+func TestMsgRemoveFeePayerTopUp_ValidateBasic(t *testing.T) {
+	// Valid sender
+	msg := types.NewMsgRemoveFeePayerTopUp(1, validCreator.String())
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid sender
+	msg.Sender = "invalidSender"
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+}
+
+// ====================================
+// MsgUpdateLowBalanceThreshold
+// ====================================
+
+func TestMsgUpdateLowBalanceThreshold_ValidateBasic(t *testing.T) {
+	// Valid threshold
+	threshold := sdk.NewCoins(sdk.NewInt64Coin("uband", 100))
+	msg := types.NewMsgUpdateLowBalanceThreshold(1, threshold, validCreator.String())
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Empty threshold disables the event
+	msg.LowBalanceThreshold = sdk.Coins{}
+	err = msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid threshold
+	msg.LowBalanceThreshold = sdk.Coins{sdk.Coin{Denom: "uband", Amount: sdkmath.NewInt(-1)}}
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Invalid creator
+	msg.LowBalanceThreshold = threshold
+	msg.Creator = "invalidCreator"
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+}
+
+// ====================================
+// MsgTransferTunnelOwnership
+// ====================================
+
+func TestMsgTransferTunnelOwnership_ValidateBasic(t *testing.T) {
+	// Valid new owner
+	msg := types.NewMsgTransferTunnelOwnership(1, validDepositor.String(), validCreator.String())
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid new owner
+	msg.NewOwner = "invalidNewOwner"
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Same owner
+	msg.NewOwner = validCreator.String()
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Invalid owner
+	msg.NewOwner = validDepositor.String()
+	msg.Owner = "invalidOwner"
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+}
+
+// ====================================
+// MsgSetTunnelOperator
+// ====================================
+
+func TestMsgSetTunnelOperator_ValidateBasic(t *testing.T) {
+	// Valid roles
+	roles := []types.OperatorRole{types.OPERATOR_ROLE_TRIGGER, types.OPERATOR_ROLE_CONFIG}
+	msg := types.NewMsgSetTunnelOperator(1, validDepositor.String(), roles, validCreator.String())
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Empty roles remove the operator
+	msg.Roles = nil
+	err = msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Duplicate roles
+	msg.Roles = []types.OperatorRole{types.OPERATOR_ROLE_TRIGGER, types.OPERATOR_ROLE_TRIGGER}
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidTunnelOperator)
+
+	// Unspecified role
+	msg.Roles = []types.OperatorRole{types.OPERATOR_ROLE_UNSPECIFIED}
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidTunnelOperator)
+
+	// Owner as operator
+	msg.Roles = roles
+	msg.Operator = validCreator.String()
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidTunnelOperator)
+}
+
 // ====================================
 // MsgUpdateParams
 // ====================================
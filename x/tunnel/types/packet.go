@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"fmt"
 
 	proto "github.com/cosmos/gogoproto/proto"
@@ -11,6 +12,10 @@ import (
 	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 )
 
+// MaxPrunedPacketsPerBlock is the maximum number of packets pruned from the state in a block, so that
+// enabling the retention params on a chain with a long packet history does not overload a block.
+const MaxPrunedPacketsPerBlock = 1000
+
 var _ types.UnpackInterfacesMessage = Packet{}
 
 func NewPacket(
@@ -63,3 +68,25 @@ func (p Packet) GetReceiptValue() (PacketReceiptI, error) {
 
 	return r, nil
 }
+
+// IsPendingDelivery returns true if the packet is sent via IBC and is still waiting for its
+// acknowledgement or timeout.
+func (p Packet) IsPendingDelivery() bool {
+	if p.Receipt == nil {
+		return false
+	}
+
+	receipt, ok := p.Receipt.GetCachedValue().(*IBCPacketReceipt)
+	return ok && receipt.Status == PACKET_STATUS_PENDING
+}
+
+// ComputePacketsHash returns the SHA-256 hash of the concatenated SHA-256 hashes of the given
+// protobuf-encoded packets, in the given order.
+func ComputePacketsHash(encodedPackets [][]byte) []byte {
+	hasher := sha256.New()
+	for _, bz := range encodedPackets {
+		h := sha256.Sum256(bz)
+		hasher.Write(h[:])
+	}
+	return hasher.Sum(nil)
+}
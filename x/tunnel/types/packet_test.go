@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"crypto/sha256"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -33,3 +34,29 @@ func TestPacketUnpackInterfaces(t *testing.T) {
 	err = packet.UnpackInterfaces(unpacker)
 	require.NoError(t, err)
 }
+
+func TestPacketIsPendingDelivery(t *testing.T) {
+	packet := types.NewPacket(1, 1, nil, 0)
+	require.False(t, packet.IsPendingDelivery())
+
+	err := packet.SetReceipt(&types.TSSPacketReceipt{SigningID: 1})
+	require.NoError(t, err)
+	require.False(t, packet.IsPendingDelivery())
+
+	err = packet.SetReceipt(&types.IBCPacketReceipt{Sequence: 1, Status: types.PACKET_STATUS_PENDING})
+	require.NoError(t, err)
+	require.True(t, packet.IsPendingDelivery())
+
+	err = packet.SetReceipt(&types.IBCPacketReceipt{Sequence: 1, Status: types.PACKET_STATUS_ACKNOWLEDGED})
+	require.NoError(t, err)
+	require.False(t, packet.IsPendingDelivery())
+}
+
+func TestComputePacketsHash(t *testing.T) {
+	first := sha256.Sum256([]byte("packet1"))
+	second := sha256.Sum256([]byte("packet2"))
+	expected := sha256.Sum256(append(first[:], second[:]...))
+
+	require.Equal(t, expected[:], types.ComputePacketsHash([][]byte{[]byte("packet1"), []byte("packet2")}))
+	require.NotEqual(t, expected[:], types.ComputePacketsHash([][]byte{[]byte("packet2"), []byte("packet1")}))
+}
@@ -9,13 +9,17 @@ import (
 var (
 	// Each value below is the default value for each parameter when generating the default
 	// genesis file. See comments in types.proto for explanation for each parameter.
-	DefaultMinInterval     = uint64(60)
-	DefaultMaxInterval     = uint64(3600)
-	DefaultMinDeviationBPS = uint64(50)
-	DefaultMaxDeviationBPS = uint64(3000)
-	DefaultMinDeposit      = sdk.NewCoins(sdk.NewInt64Coin("uband", 1_000_000_000))
-	DefaultMaxSignals      = uint64(25)
-	DefaultBasePacketFee   = sdk.NewCoins(sdk.NewInt64Coin("uband", 500))
+	DefaultMinInterval            = uint64(60)
+	DefaultMaxInterval            = uint64(3600)
+	DefaultMinDeviationBPS        = uint64(50)
+	DefaultMaxDeviationBPS        = uint64(3000)
+	DefaultMinDeposit             = sdk.NewCoins(sdk.NewInt64Coin("uband", 1_000_000_000))
+	DefaultMaxSignals             = uint64(25)
+	DefaultBasePacketFee          = sdk.NewCoins(sdk.NewInt64Coin("uband", 500))
+	DefaultResendTimedOutPacket   = false
+	DefaultMaxConsecutiveFailures = uint64(0)
+	DefaultMaxRetainedPackets     = uint64(0)
+	DefaultPacketRetentionPeriod  = uint64(0)
 )
 
 // NewParams creates a new Params instance
@@ -27,15 +31,23 @@ func NewParams(
 	maxDeviationBPS uint64,
 	maxSignals uint64,
 	basePacketFee sdk.Coins,
+	resendTimedOutPacket bool,
+	maxConsecutiveFailures uint64,
+	maxRetainedPackets uint64,
+	packetRetentionPeriod uint64,
 ) Params {
 	return Params{
-		MinDeposit:      minDeposit,
-		MinInterval:     minInterval,
-		MaxInterval:     maxInterval,
-		MinDeviationBPS: minDeviationBPS,
-		MaxDeviationBPS: maxDeviationBPS,
-		MaxSignals:      maxSignals,
-		BasePacketFee:   basePacketFee,
+		MinDeposit:             minDeposit,
+		MinInterval:            minInterval,
+		MaxInterval:            maxInterval,
+		MinDeviationBPS:        minDeviationBPS,
+		MaxDeviationBPS:        maxDeviationBPS,
+		MaxSignals:             maxSignals,
+		BasePacketFee:          basePacketFee,
+		ResendTimedOutPacket:   resendTimedOutPacket,
+		MaxConsecutiveFailures: maxConsecutiveFailures,
+		MaxRetainedPackets:     maxRetainedPackets,
+		PacketRetentionPeriod:  packetRetentionPeriod,
 	}
 }
 
@@ -49,6 +61,10 @@ func DefaultParams() Params {
 		DefaultMaxDeviationBPS,
 		DefaultMaxSignals,
 		DefaultBasePacketFee,
+		DefaultResendTimedOutPacket,
+		DefaultMaxConsecutiveFailures,
+		DefaultMaxRetainedPackets,
+		DefaultPacketRetentionPeriod,
 	)
 }
 
@@ -41,6 +41,17 @@ type Params struct {
 	MaxSignals uint64 `protobuf:"varint,6,opt,name=max_signals,json=maxSignals,proto3" json:"max_signals,omitempty"`
 	// base_packet_fee is the base fee for each packet.
 	BasePacketFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=base_packet_fee,json=basePacketFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"base_packet_fee"`
+	// resend_timed_out_packet is the flag to re-send the latest packet of an IBC tunnel when it times out.
+	ResendTimedOutPacket bool `protobuf:"varint,8,opt,name=resend_timed_out_packet,json=resendTimedOutPacket,proto3" json:"resend_timed_out_packet,omitempty"`
+	// max_consecutive_failures is the number of consecutive failed IBC deliveries (error acknowledgements
+	// or timeouts) after which the tunnel is deactivated. Zero disables the deactivation.
+	MaxConsecutiveFailures uint64 `protobuf:"varint,9,opt,name=max_consecutive_failures,json=maxConsecutiveFailures,proto3" json:"max_consecutive_failures,omitempty"`
+	// max_retained_packets is the maximum number of packets kept in the state per tunnel. Older packets are
+	// pruned at the end block. Zero disables the pruning by count.
+	MaxRetainedPackets uint64 `protobuf:"varint,10,opt,name=max_retained_packets,json=maxRetainedPackets,proto3" json:"max_retained_packets,omitempty"`
+	// packet_retention_period is the duration in seconds for which a packet is kept in the state. Older packets
+	// are pruned at the end block. Zero disables the pruning by age.
+	PacketRetentionPeriod uint64 `protobuf:"varint,11,opt,name=packet_retention_period,json=packetRetentionPeriod,proto3" json:"packet_retention_period,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -125,6 +136,34 @@ func (m *Params) GetBasePacketFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Params) GetResendTimedOutPacket() bool {
+	if m != nil {
+		return m.ResendTimedOutPacket
+	}
+	return false
+}
+
+func (m *Params) GetMaxConsecutiveFailures() uint64 {
+	if m != nil {
+		return m.MaxConsecutiveFailures
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRetainedPackets() uint64 {
+	if m != nil {
+		return m.MaxRetainedPackets
+	}
+	return 0
+}
+
+func (m *Params) GetPacketRetentionPeriod() uint64 {
+	if m != nil {
+		return m.PacketRetentionPeriod
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "band.tunnel.v1beta1.Params")
 }
@@ -132,32 +171,39 @@ func init() {
 func init() { proto.RegisterFile("band/tunnel/v1beta1/params.proto", fileDescriptor_842b3bf03f22bf82) }
 
 var fileDescriptor_842b3bf03f22bf82 = []byte{
-	// 398 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x31, 0x8f, 0xda, 0x30,
-	0x14, 0xc7, 0x93, 0x42, 0xa9, 0x64, 0x5a, 0xa1, 0x86, 0x0e, 0x29, 0x43, 0x42, 0x3b, 0xb1, 0x34,
-	0x2e, 0x65, 0xeb, 0x52, 0x29, 0xad, 0x2a, 0x31, 0x54, 0x42, 0xb0, 0x75, 0x89, 0x9c, 0xe0, 0x06,
-	0x8b, 0xc4, 0xb6, 0xb0, 0x89, 0xd2, 0x6f, 0x71, 0x1f, 0xe1, 0xe6, 0xfb, 0x24, 0x8c, 0x8c, 0xa7,
-	0x1b, 0xb8, 0x53, 0x58, 0xee, 0x63, 0x9c, 0x6c, 0x07, 0x2e, 0x62, 0xbe, 0x29, 0xd1, 0x7b, 0x3f,
-	0xff, 0xfe, 0x4f, 0x4f, 0x0f, 0x0c, 0x63, 0x44, 0x97, 0x50, 0x6e, 0x29, 0xc5, 0x19, 0x2c, 0xc6,
-	0x31, 0x96, 0x68, 0x0c, 0x39, 0xda, 0xa0, 0x5c, 0x04, 0x7c, 0xc3, 0x24, 0x73, 0xfa, 0x8a, 0x08,
-	0x0c, 0x11, 0xd4, 0xc4, 0xe0, 0x43, 0xca, 0x52, 0xa6, 0xfb, 0x50, 0xfd, 0x19, 0x74, 0xe0, 0x25,
-	0x4c, 0xe4, 0x4c, 0xc0, 0x18, 0x09, 0x7c, 0x96, 0x25, 0x8c, 0x50, 0xd3, 0xff, 0x7c, 0xd7, 0x02,
-	0x9d, 0x99, 0x76, 0x3b, 0x19, 0xe8, 0xe6, 0x84, 0x46, 0x4b, 0xcc, 0x99, 0x20, 0xd2, 0xb5, 0x87,
-	0xad, 0x51, 0xf7, 0xdb, 0xc7, 0xc0, 0x08, 0x02, 0x25, 0x38, 0x65, 0x05, 0x3f, 0x19, 0xa1, 0xe1,
-	0xd7, 0xdd, 0xc1, 0xb7, 0x6e, 0xee, 0xfd, 0x51, 0x4a, 0xe4, 0x6a, 0x1b, 0x07, 0x09, 0xcb, 0x61,
-	0x9d, 0x66, 0x3e, 0x5f, 0xc4, 0x72, 0x0d, 0xe5, 0x7f, 0x8e, 0x85, 0x7e, 0x20, 0xe6, 0x20, 0x27,
-	0xf4, 0x97, 0xd1, 0x3b, 0x9f, 0xc0, 0x5b, 0x95, 0x46, 0xa8, 0xc4, 0x9b, 0x02, 0x65, 0xee, 0xab,
-	0xa1, 0x3d, 0x6a, 0xcf, 0xd5, 0x04, 0xd3, 0xba, 0xa4, 0x11, 0x54, 0x3e, 0x23, 0xad, 0x1a, 0x41,
-	0xe5, 0x19, 0xf9, 0x01, 0xde, 0x9b, 0x99, 0x0b, 0x82, 0x24, 0x61, 0x34, 0x8a, 0xb9, 0x70, 0xdb,
-	0x8a, 0x0b, 0xfb, 0xd5, 0xc1, 0xef, 0xfd, 0x51, 0x81, 0x75, 0x2f, 0x9c, 0x2d, 0xe6, 0xbd, 0xbc,
-	0x59, 0xe0, 0x42, 0x0b, 0x50, 0x79, 0x21, 0x78, 0xdd, 0x10, 0xa0, 0xf2, 0x42, 0xd0, 0x2c, 0x70,
-	0xe1, 0xf8, 0x40, 0x0d, 0x14, 0x09, 0x92, 0x52, 0x94, 0x09, 0xb7, 0xa3, 0x67, 0x04, 0x39, 0x2a,
-	0x17, 0xa6, 0xe2, 0x08, 0xd0, 0x53, 0xbb, 0x8b, 0x38, 0x4a, 0xd6, 0x58, 0x46, 0xff, 0x30, 0x76,
-	0xdf, 0xbc, 0xfc, 0x6a, 0xdf, 0x29, 0xc9, 0x4c, 0x47, 0xfc, 0xc6, 0xf8, 0x7b, 0xfb, 0xf1, 0xda,
-	0xb7, 0xc3, 0xe9, 0xae, 0xf2, 0xec, 0x7d, 0xe5, 0xd9, 0x0f, 0x95, 0x67, 0x5f, 0x1d, 0x3d, 0x6b,
-	0x7f, 0xf4, 0xac, 0xdb, 0xa3, 0x67, 0xfd, 0x85, 0x0d, 0xb1, 0x3a, 0x26, 0x7d, 0x0c, 0x09, 0xcb,
-	0x60, 0xb2, 0x42, 0x84, 0xc2, 0x62, 0x02, 0xcb, 0xd3, 0x05, 0xea, 0x94, 0xb8, 0xa3, 0x89, 0xc9,
-	0x53, 0x00, 0x00, 0x00, 0xff, 0xff, 0x57, 0x4c, 0x65, 0x68, 0x9d, 0x02, 0x00, 0x00,
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x63, 0xda, 0x86, 0x72, 0x01, 0x45, 0xb8, 0x81, 0x1e, 0x1d, 0x9c, 0xc0, 0x94, 0x05,
+	0x5f, 0x4b, 0x05, 0x42, 0x2c, 0x48, 0x29, 0xaa, 0xd4, 0x01, 0x11, 0xb9, 0x4c, 0x2c, 0xd6, 0xd9,
+	0x7e, 0x9b, 0x9e, 0xea, 0xbb, 0xb3, 0x7c, 0xe7, 0xc8, 0x7c, 0x0b, 0x3e, 0x02, 0x33, 0x3b, 0xdf,
+	0xa1, 0x63, 0x47, 0xa6, 0x82, 0x92, 0x85, 0x8f, 0x81, 0xee, 0x4f, 0xda, 0xa8, 0x33, 0x93, 0xad,
+	0xf7, 0xf7, 0xbc, 0xcf, 0xf3, 0xde, 0xab, 0x3b, 0x34, 0xca, 0xa8, 0x28, 0x88, 0x6e, 0x84, 0x80,
+	0x92, 0xcc, 0x0f, 0x32, 0xd0, 0xf4, 0x80, 0x54, 0xb4, 0xa6, 0x5c, 0xc5, 0x55, 0x2d, 0xb5, 0x0c,
+	0x77, 0x8c, 0x22, 0x76, 0x8a, 0xd8, 0x2b, 0xf6, 0x06, 0x33, 0x39, 0x93, 0x96, 0x13, 0xf3, 0xe7,
+	0xa4, 0x7b, 0x51, 0x2e, 0x15, 0x97, 0x8a, 0x64, 0x54, 0xc1, 0x8d, 0x59, 0x2e, 0x99, 0x70, 0xfc,
+	0xc5, 0xcf, 0x2d, 0xd4, 0x9d, 0x5a, 0xef, 0xb0, 0x44, 0x3d, 0xce, 0x44, 0x5a, 0x40, 0x25, 0x15,
+	0xd3, 0x38, 0x18, 0x6d, 0x8c, 0x7b, 0xaf, 0x9e, 0xc5, 0xce, 0x20, 0x36, 0x06, 0xab, 0xac, 0xf8,
+	0x48, 0x32, 0x31, 0xd9, 0xbf, 0xbc, 0x1e, 0x76, 0x7e, 0xfc, 0x1e, 0x8e, 0x67, 0x4c, 0x9f, 0x37,
+	0x59, 0x9c, 0x4b, 0x4e, 0x7c, 0x9a, 0xfb, 0xbc, 0x54, 0xc5, 0x05, 0xd1, 0x5f, 0x2b, 0x50, 0xb6,
+	0x41, 0x25, 0x88, 0x33, 0xf1, 0xc1, 0xd9, 0x87, 0xcf, 0xd1, 0x43, 0x93, 0xc6, 0x84, 0x86, 0x7a,
+	0x4e, 0x4b, 0x7c, 0x6f, 0x14, 0x8c, 0x37, 0x13, 0x33, 0xc1, 0x89, 0x2f, 0x59, 0x09, 0x6d, 0x6f,
+	0x25, 0x1b, 0x5e, 0x42, 0xdb, 0x1b, 0xc9, 0x7b, 0xf4, 0xd8, 0xcd, 0x3c, 0x67, 0x54, 0x33, 0x29,
+	0xd2, 0xac, 0x52, 0x78, 0xd3, 0xe8, 0x26, 0x3b, 0x8b, 0xeb, 0x61, 0xff, 0xa3, 0x09, 0xf4, 0x6c,
+	0x32, 0x3d, 0x4d, 0xfa, 0x7c, 0xbd, 0x50, 0x29, 0x6b, 0x40, 0xdb, 0x3b, 0x06, 0x5b, 0x6b, 0x06,
+	0xb4, 0xbd, 0x63, 0xb0, 0x5e, 0xa8, 0x54, 0x38, 0x44, 0x66, 0xa0, 0x54, 0xb1, 0x99, 0xa0, 0xa5,
+	0xc2, 0x5d, 0x3b, 0x23, 0xe2, 0xb4, 0x3d, 0x75, 0x95, 0x50, 0xa1, 0xbe, 0xd9, 0x5d, 0x5a, 0xd1,
+	0xfc, 0x02, 0x74, 0x7a, 0x06, 0x80, 0xef, 0xff, 0xff, 0xd5, 0x3e, 0x32, 0x26, 0x53, 0x1b, 0x71,
+	0x0c, 0x10, 0xbe, 0x46, 0xbb, 0x35, 0x28, 0x10, 0x45, 0xaa, 0x19, 0x87, 0x22, 0x95, 0x8d, 0xf6,
+	0x03, 0xe0, 0xed, 0x51, 0x30, 0xde, 0x4e, 0x06, 0x0e, 0x7f, 0x36, 0xf4, 0x53, 0xa3, 0x5d, 0x67,
+	0xf8, 0x16, 0x61, 0x73, 0x98, 0x5c, 0x0a, 0x05, 0x79, 0xa3, 0xd9, 0x1c, 0xd2, 0x33, 0xca, 0xca,
+	0xa6, 0x06, 0x85, 0x1f, 0xd8, 0x93, 0x3d, 0xe5, 0xb4, 0x3d, 0xba, 0xc5, 0xc7, 0x9e, 0x86, 0xfb,
+	0x68, 0x60, 0x3a, 0x6b, 0xd0, 0x94, 0x09, 0x28, 0x7c, 0x98, 0xc2, 0xc8, 0x76, 0x85, 0x9c, 0xb6,
+	0x89, 0x47, 0x2e, 0x4a, 0x85, 0x6f, 0xd0, 0xae, 0x5f, 0x49, 0x0d, 0x1a, 0x84, 0x5d, 0x7e, 0x05,
+	0x35, 0x93, 0x05, 0xee, 0xd9, 0xa6, 0x27, 0x0e, 0x27, 0x2b, 0x3a, 0xb5, 0xf0, 0xdd, 0xe6, 0xdf,
+	0xef, 0xc3, 0x60, 0x72, 0x72, 0xb9, 0x88, 0x82, 0xab, 0x45, 0x14, 0xfc, 0x59, 0x44, 0xc1, 0xb7,
+	0x65, 0xd4, 0xb9, 0x5a, 0x46, 0x9d, 0x5f, 0xcb, 0xa8, 0xf3, 0x85, 0xac, 0xed, 0xcc, 0xbc, 0x13,
+	0x7b, 0xcf, 0x73, 0x59, 0x92, 0xfc, 0x9c, 0x32, 0x41, 0xe6, 0x87, 0xa4, 0x5d, 0x3d, 0x2e, 0xbb,
+	0xc0, 0xac, 0x6b, 0x15, 0x87, 0xff, 0x06, 0x00, 0xb0, 0xf4, 0x56, 0x45, 0x78, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -210,6 +256,18 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.ResendTimedOutPacket != that1.ResendTimedOutPacket {
+		return false
+	}
+	if this.MaxConsecutiveFailures != that1.MaxConsecutiveFailures {
+		return false
+	}
+	if this.MaxRetainedPackets != that1.MaxRetainedPackets {
+		return false
+	}
+	if this.PacketRetentionPeriod != that1.PacketRetentionPeriod {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -232,6 +290,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PacketRetentionPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PacketRetentionPeriod))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxRetainedPackets != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRetainedPackets))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxConsecutiveFailures != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxConsecutiveFailures))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ResendTimedOutPacket {
+		i--
+		if m.ResendTimedOutPacket {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.BasePacketFee) > 0 {
 		for iNdEx := len(m.BasePacketFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -332,6 +415,18 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.ResendTimedOutPacket {
+		n += 2
+	}
+	if m.MaxConsecutiveFailures != 0 {
+		n += 1 + sovParams(uint64(m.MaxConsecutiveFailures))
+	}
+	if m.MaxRetainedPackets != 0 {
+		n += 1 + sovParams(uint64(m.MaxRetainedPackets))
+	}
+	if m.PacketRetentionPeriod != 0 {
+		n += 1 + sovParams(uint64(m.PacketRetentionPeriod))
+	}
 	return n
 }
 
@@ -533,6 +628,83 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResendTimedOutPacket", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResendTimedOutPacket = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsecutiveFailures", wireType)
+			}
+			m.MaxConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsecutiveFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetainedPackets", wireType)
+			}
+			m.MaxRetainedPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetainedPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketRetentionPeriod", wireType)
+			}
+			m.PacketRetentionPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketRetentionPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxSimulatePriceSnapshots is the maximum number of price snapshots replayed by a tunnel simulation.
+const MaxSimulatePriceSnapshots = 3600
+
+var _ types.UnpackInterfacesMessage = QuerySimulateTunnelRequest{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QuerySimulateTunnelRequest) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if r.Route == nil {
+		return nil
+	}
+
+	var route RouteI
+	return unpacker.UnpackAny(r.Route, &route)
+}
+
+// GetRouteValue returns the proposed route of the simulation.
+func (r QuerySimulateTunnelRequest) GetRouteValue() (RouteI, error) {
+	route, ok := r.Route.GetCachedValue().(RouteI)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf(
+			"expected %T, got %T",
+			(RouteI)(nil),
+			r.Route.GetCachedValue(),
+		)
+	}
+
+	return route, nil
+}
@@ -6,6 +6,11 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types1 "github.com/bandprotocol/chain/v3/x/feeds/types"
+	_ "github.com/cosmos/cosmos-proto"
+	types2 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -481,6 +486,8 @@ type QueryPacketsRequest struct {
 	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// status_filter is a flag to filter IBC packets by delivery status.
+	StatusFilter PacketStatus `protobuf:"varint,3,opt,name=status_filter,json=statusFilter,proto3,enum=band.tunnel.v1beta1.PacketStatus" json:"status_filter,omitempty"`
 }
 
 func (m *QueryPacketsRequest) Reset()         { *m = QueryPacketsRequest{} }
@@ -530,6 +537,13 @@ func (m *QueryPacketsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryPacketsRequest) GetStatusFilter() PacketStatus {
+	if m != nil {
+		return m.StatusFilter
+	}
+	return PACKET_STATUS_UNSPECIFIED
+}
+
 // QueryPacketsResponse is the response type for the Query/Packets RPC method.
 type QueryPacketsResponse struct {
 	// packets is a list of packets.
@@ -686,22 +700,24 @@ func (m *QueryPacketResponse) GetPacket() *Packet {
 	return nil
 }
 
-// QueryTotalFeesRequest is the request type for the Query/TotalFees RPC method.
-type QueryTotalFeesRequest struct {
+// QueryTunnelFeeRunwayRequest is the request type for the Query/TunnelFeeRunway RPC method.
+type QueryTunnelFeeRunwayRequest struct {
+	// tunnel_id is the ID of the tunnel to query.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
 }
 
-func (m *QueryTotalFeesRequest) Reset()         { *m = QueryTotalFeesRequest{} }
-func (m *QueryTotalFeesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalFeesRequest) ProtoMessage()    {}
-func (*QueryTotalFeesRequest) Descriptor() ([]byte, []int) {
+func (m *QueryTunnelFeeRunwayRequest) Reset()         { *m = QueryTunnelFeeRunwayRequest{} }
+func (m *QueryTunnelFeeRunwayRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTunnelFeeRunwayRequest) ProtoMessage()    {}
+func (*QueryTunnelFeeRunwayRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f80b85392d1440ac, []int{12}
 }
-func (m *QueryTotalFeesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTunnelFeeRunwayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTunnelFeeRunwayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalFeesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTunnelFeeRunwayRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -711,36 +727,55 @@ func (m *QueryTotalFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalFeesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalFeesRequest.Merge(m, src)
+func (m *QueryTunnelFeeRunwayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTunnelFeeRunwayRequest.Merge(m, src)
 }
-func (m *QueryTotalFeesRequest) XXX_Size() int {
+func (m *QueryTunnelFeeRunwayRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalFeesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalFeesRequest.DiscardUnknown(m)
+func (m *QueryTunnelFeeRunwayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTunnelFeeRunwayRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalFeesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTunnelFeeRunwayRequest proto.InternalMessageInfo
 
-// QueryTotalFeesResponse is the response type for the Query/TotalFees RPC method.
-type QueryTotalFeesResponse struct {
-	// total_fees is the total fees collected by the tunnel.
-	TotalFees TotalFees `protobuf:"bytes,1,opt,name=total_fees,json=totalFees,proto3" json:"total_fees"`
+func (m *QueryTunnelFeeRunwayRequest) GetTunnelId() uint64 {
+	if m != nil {
+		return m.TunnelId
+	}
+	return 0
 }
 
-func (m *QueryTotalFeesResponse) Reset()         { *m = QueryTotalFeesResponse{} }
-func (m *QueryTotalFeesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalFeesResponse) ProtoMessage()    {}
-func (*QueryTotalFeesResponse) Descriptor() ([]byte, []int) {
+// QueryTunnelFeeRunwayResponse is the response type for the Query/TunnelFeeRunway RPC method.
+type QueryTunnelFeeRunwayResponse struct {
+	// fee_payer_balance is the current balance of the fee payer.
+	FeePayerBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fee_payer_balance,json=feePayerBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_payer_balance"`
+	// packet_fee is the fee charged for a packet, i.e. the base packet fee plus the route fee.
+	PacketFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=packet_fee,json=packetFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"packet_fee"`
+	// remaining_packets is the number of packets that the fee payer balance covers. It is the maximum
+	// uint64 value if the packet fee is zero.
+	RemainingPackets uint64 `protobuf:"varint,3,opt,name=remaining_packets,json=remainingPackets,proto3" json:"remaining_packets,omitempty"`
+	// top_up is the fee payer top-up authorization of the tunnel, if any.
+	TopUp *FeePayerTopUp `protobuf:"bytes,4,opt,name=top_up,json=topUp,proto3" json:"top_up,omitempty"`
+	// top_up_allowance is the amount that can still be refilled by the top-up, bounded by the funder balance.
+	TopUpAllowance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=top_up_allowance,json=topUpAllowance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"top_up_allowance"`
+	// remaining_packets_with_top_up is the number of packets that the fee payer balance and the top-up
+	// allowance cover.
+	RemainingPacketsWithTopUp uint64 `protobuf:"varint,6,opt,name=remaining_packets_with_top_up,json=remainingPacketsWithTopUp,proto3" json:"remaining_packets_with_top_up,omitempty"`
+}
+
+func (m *QueryTunnelFeeRunwayResponse) Reset()         { *m = QueryTunnelFeeRunwayResponse{} }
+func (m *QueryTunnelFeeRunwayResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTunnelFeeRunwayResponse) ProtoMessage()    {}
+func (*QueryTunnelFeeRunwayResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f80b85392d1440ac, []int{13}
 }
-func (m *QueryTotalFeesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTunnelFeeRunwayResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTunnelFeeRunwayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalFeesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTunnelFeeRunwayResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -750,41 +785,78 @@ func (m *QueryTotalFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalFeesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalFeesResponse.Merge(m, src)
+func (m *QueryTunnelFeeRunwayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTunnelFeeRunwayResponse.Merge(m, src)
 }
-func (m *QueryTotalFeesResponse) XXX_Size() int {
+func (m *QueryTunnelFeeRunwayResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalFeesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalFeesResponse.DiscardUnknown(m)
+func (m *QueryTunnelFeeRunwayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTunnelFeeRunwayResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalFeesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTunnelFeeRunwayResponse proto.InternalMessageInfo
 
-func (m *QueryTotalFeesResponse) GetTotalFees() TotalFees {
+func (m *QueryTunnelFeeRunwayResponse) GetFeePayerBalance() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.TotalFees
+		return m.FeePayerBalance
 	}
-	return TotalFees{}
+	return nil
 }
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+func (m *QueryTunnelFeeRunwayResponse) GetPacketFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PacketFee
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryTunnelFeeRunwayResponse) GetRemainingPackets() uint64 {
+	if m != nil {
+		return m.RemainingPackets
+	}
+	return 0
+}
+
+func (m *QueryTunnelFeeRunwayResponse) GetTopUp() *FeePayerTopUp {
+	if m != nil {
+		return m.TopUp
+	}
+	return nil
+}
+
+func (m *QueryTunnelFeeRunwayResponse) GetTopUpAllowance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TopUpAllowance
+	}
+	return nil
+}
+
+func (m *QueryTunnelFeeRunwayResponse) GetRemainingPacketsWithTopUp() uint64 {
+	if m != nil {
+		return m.RemainingPacketsWithTopUp
+	}
+	return 0
+}
+
+// QueryTunnelOperatorsRequest is the request type for the Query/TunnelOperators RPC method.
+type QueryTunnelOperatorsRequest struct {
+	// tunnel_id is the ID of the tunnel to query operators.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+}
+
+func (m *QueryTunnelOperatorsRequest) Reset()         { *m = QueryTunnelOperatorsRequest{} }
+func (m *QueryTunnelOperatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTunnelOperatorsRequest) ProtoMessage()    {}
+func (*QueryTunnelOperatorsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f80b85392d1440ac, []int{14}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTunnelOperatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTunnelOperatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTunnelOperatorsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -794,36 +866,43 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryTunnelOperatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTunnelOperatorsRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryTunnelOperatorsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryTunnelOperatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTunnelOperatorsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTunnelOperatorsRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is the response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params is the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryTunnelOperatorsRequest) GetTunnelId() uint64 {
+	if m != nil {
+		return m.TunnelId
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+// QueryTunnelOperatorsResponse is the response type for the Query/TunnelOperators RPC method.
+type QueryTunnelOperatorsResponse struct {
+	// operators is the list of operators of the tunnel.
+	Operators []TunnelOperator `protobuf:"bytes,1,rep,name=operators,proto3" json:"operators"`
+}
+
+func (m *QueryTunnelOperatorsResponse) Reset()         { *m = QueryTunnelOperatorsResponse{} }
+func (m *QueryTunnelOperatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTunnelOperatorsResponse) ProtoMessage()    {}
+func (*QueryTunnelOperatorsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f80b85392d1440ac, []int{15}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTunnelOperatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTunnelOperatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTunnelOperatorsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -833,946 +912,1206 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryTunnelOperatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTunnelOperatorsResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryTunnelOperatorsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryTunnelOperatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTunnelOperatorsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTunnelOperatorsResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryTunnelOperatorsResponse) GetOperators() []TunnelOperator {
 	if m != nil {
-		return m.Params
+		return m.Operators
 	}
-	return Params{}
+	return nil
 }
 
-func init() {
-	proto.RegisterEnum("band.tunnel.v1beta1.TunnelStatusFilter", TunnelStatusFilter_name, TunnelStatusFilter_value)
-	proto.RegisterType((*QueryTunnelsRequest)(nil), "band.tunnel.v1beta1.QueryTunnelsRequest")
-	proto.RegisterType((*QueryTunnelsResponse)(nil), "band.tunnel.v1beta1.QueryTunnelsResponse")
-	proto.RegisterType((*QueryTunnelRequest)(nil), "band.tunnel.v1beta1.QueryTunnelRequest")
-	proto.RegisterType((*QueryTunnelResponse)(nil), "band.tunnel.v1beta1.QueryTunnelResponse")
-	proto.RegisterType((*QueryDepositsRequest)(nil), "band.tunnel.v1beta1.QueryDepositsRequest")
-	proto.RegisterType((*QueryDepositsResponse)(nil), "band.tunnel.v1beta1.QueryDepositsResponse")
-	proto.RegisterType((*QueryDepositRequest)(nil), "band.tunnel.v1beta1.QueryDepositRequest")
-	proto.RegisterType((*QueryDepositResponse)(nil), "band.tunnel.v1beta1.QueryDepositResponse")
-	proto.RegisterType((*QueryPacketsRequest)(nil), "band.tunnel.v1beta1.QueryPacketsRequest")
-	proto.RegisterType((*QueryPacketsResponse)(nil), "band.tunnel.v1beta1.QueryPacketsResponse")
-	proto.RegisterType((*QueryPacketRequest)(nil), "band.tunnel.v1beta1.QueryPacketRequest")
-	proto.RegisterType((*QueryPacketResponse)(nil), "band.tunnel.v1beta1.QueryPacketResponse")
-	proto.RegisterType((*QueryTotalFeesRequest)(nil), "band.tunnel.v1beta1.QueryTotalFeesRequest")
-	proto.RegisterType((*QueryTotalFeesResponse)(nil), "band.tunnel.v1beta1.QueryTotalFeesResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "band.tunnel.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "band.tunnel.v1beta1.QueryParamsResponse")
+// QueryPacketRetentionRequest is the request type for the Query/PacketRetention RPC method.
+type QueryPacketRetentionRequest struct {
+	// tunnel_id is the ID of the tunnel to query.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("band/tunnel/v1beta1/query.proto", fileDescriptor_f80b85392d1440ac) }
-
-var fileDescriptor_f80b85392d1440ac = []byte{
-	// 944 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x18, 0xf5, 0x84, 0x60, 0xc7, 0x53, 0x40, 0xd5, 0x34, 0xb4, 0x61, 0x13, 0x36, 0x66, 0xf9, 0x11,
-	0x37, 0x81, 0x1d, 0x25, 0x01, 0x14, 0x10, 0x42, 0xb4, 0xa9, 0x8d, 0x8c, 0x42, 0x64, 0x36, 0x0e,
-	0x07, 0x24, 0x64, 0xad, 0x9d, 0xa9, 0xbb, 0xc2, 0xd9, 0xd9, 0x7a, 0xd6, 0x15, 0xc5, 0x8a, 0x90,
-	0x10, 0x87, 0x1e, 0x38, 0x20, 0x55, 0x02, 0x09, 0x2e, 0x48, 0x1c, 0xf9, 0x47, 0x7a, 0xac, 0xc4,
-	0x85, 0x13, 0x42, 0x09, 0x7f, 0x08, 0xda, 0x99, 0x6f, 0xd6, 0x5e, 0x77, 0x59, 0x6f, 0xa4, 0xa8,
-	0x37, 0x7b, 0xf6, 0x7d, 0xf3, 0xde, 0xf7, 0xbe, 0x99, 0xb7, 0x8b, 0x57, 0x3b, 0xae, 0x7f, 0x44,
-	0xc3, 0xa1, 0xef, 0xb3, 0x3e, 0xbd, 0xb7, 0xd9, 0x61, 0xa1, 0xbb, 0x49, 0xef, 0x0e, 0xd9, 0xe0,
-	0xbe, 0x1d, 0x0c, 0x78, 0xc8, 0xc9, 0x95, 0x08, 0x60, 0x2b, 0x80, 0x0d, 0x00, 0x63, 0xb1, 0xc7,
-	0x7b, 0x5c, 0x3e, 0xa7, 0xd1, 0x2f, 0x05, 0x35, 0xd6, 0xbb, 0x5c, 0x1c, 0x73, 0x41, 0x3b, 0xae,
-	0x60, 0x6a, 0x8f, 0x78, 0xc7, 0xc0, 0xed, 0x79, 0xbe, 0x1b, 0x7a, 0xdc, 0x07, 0xec, 0x4a, 0x8f,
-	0xf3, 0x5e, 0x9f, 0x51, 0x37, 0xf0, 0xa8, 0xeb, 0xfb, 0x3c, 0x94, 0x0f, 0x05, 0x3c, 0xad, 0xa4,
-	0xa9, 0x0a, 0xdc, 0x81, 0x7b, 0x9c, 0x89, 0x00, 0x95, 0x12, 0x61, 0xfd, 0x81, 0xf0, 0x95, 0xcf,
-	0x22, 0x11, 0x2d, 0xb9, 0x2a, 0x1c, 0x76, 0x77, 0xc8, 0x44, 0x48, 0xf6, 0xf0, 0xf3, 0x22, 0x74,
-	0xc3, 0xa1, 0x68, 0xdf, 0xf6, 0xfa, 0x21, 0x1b, 0x2c, 0xa1, 0x0a, 0xaa, 0xbe, 0xb0, 0xb5, 0x66,
-	0xa7, 0x34, 0x6a, 0xab, 0xda, 0x03, 0x89, 0xaf, 0x4b, 0xb8, 0xf3, 0x9c, 0x98, 0xf8, 0x47, 0xea,
-	0x18, 0x8f, 0x7b, 0x5b, 0x9a, 0xab, 0xa0, 0xea, 0xa5, 0xad, 0x37, 0x6c, 0x65, 0x84, 0x1d, 0x19,
-	0x61, 0x2b, 0x33, 0xf5, 0x86, 0x4d, 0xb7, 0xc7, 0x40, 0x89, 0x33, 0x51, 0x69, 0xfd, 0x84, 0xf0,
-	0x62, 0x52, 0xad, 0x08, 0xb8, 0x2f, 0x18, 0x79, 0x07, 0x97, 0x94, 0x26, 0xb1, 0x84, 0x2a, 0xcf,
-	0x54, 0x2f, 0x6d, 0x2d, 0x67, 0x08, 0x75, 0x34, 0x96, 0x7c, 0x9c, 0xa2, 0x6b, 0x6d, 0xa6, 0x2e,
-	0xc5, 0x99, 0x10, 0xb6, 0x89, 0xc9, 0x84, 0x2e, 0x6d, 0xe2, 0x32, 0x2e, 0x2b, 0xa6, 0xb6, 0x77,
-	0x24, 0x0d, 0x9c, 0x77, 0x16, 0xd4, 0x42, 0xe3, 0xc8, 0x6a, 0x26, 0x8c, 0x8f, 0x3b, 0x79, 0x0f,
-	0x17, 0x15, 0x44, 0x16, 0x64, 0x37, 0x72, 0x73, 0xfe, 0xd1, 0xdf, 0xab, 0x05, 0x07, 0x0a, 0xac,
-	0x11, 0x98, 0x73, 0x8b, 0x05, 0x5c, 0x78, 0xa1, 0xc8, 0x23, 0xe3, 0xc2, 0x46, 0xf3, 0x0b, 0xc2,
-	0x2f, 0x4e, 0xb1, 0x43, 0x47, 0x3b, 0x78, 0xe1, 0x08, 0xd6, 0x60, 0x38, 0x2b, 0xa9, 0x3d, 0x41,
-	0xa1, 0x13, 0xa3, 0x2f, 0x6e, 0x3c, 0xda, 0x6b, 0x4d, 0x91, 0xc7, 0x98, 0x15, 0x5c, 0x06, 0x21,
-	0x7c, 0x20, 0xb9, 0xcb, 0xce, 0x78, 0xc1, 0x6a, 0x25, 0xbd, 0x8e, 0x9b, 0xfd, 0x00, 0x97, 0x00,
-	0x04, 0xf3, 0xcb, 0xec, 0x15, 0x06, 0xa8, 0x4b, 0xac, 0x6f, 0x40, 0x67, 0xd3, 0xed, 0x7e, 0xc5,
-	0x9e, 0xf2, 0x00, 0xe3, 0xbb, 0x15, 0x93, 0x8f, 0xef, 0x56, 0xa0, 0x96, 0x32, 0xef, 0x96, 0x2a,
-	0x73, 0x34, 0xf6, 0xe2, 0x86, 0xf7, 0x29, 0xdc, 0x2d, 0x20, 0xc8, 0xe3, 0x89, 0x81, 0x17, 0x44,
-	0x84, 0xf3, 0xbb, 0x4c, 0x32, 0xcf, 0x3b, 0xf1, 0x7f, 0xeb, 0x93, 0x84, 0xc7, 0x71, 0x97, 0xdb,
-	0xb8, 0xa8, 0x94, 0x67, 0xde, 0x3b, 0x28, 0x02, 0xa8, 0x75, 0x0d, 0xce, 0x7c, 0x8b, 0x87, 0x6e,
-	0xbf, 0xce, 0x98, 0x9e, 0x98, 0xf5, 0x25, 0xbe, 0x3a, 0xfd, 0x00, 0x78, 0x76, 0x31, 0x0e, 0xa3,
-	0xc5, 0xf6, 0x6d, 0xc6, 0x04, 0x70, 0x99, 0xe9, 0x77, 0x5c, 0xd7, 0xc2, 0x29, 0x29, 0x87, 0x7a,
-	0xc1, 0x5a, 0x8c, 0x2d, 0x89, 0xc2, 0x5e, 0x93, 0x36, 0xe3, 0xce, 0xd4, 0xea, 0x38, 0x51, 0xd4,
-	0x4b, 0x61, 0x46, 0x67, 0x11, 0x44, 0x27, 0x8a, 0x2a, 0x58, 0xff, 0x1e, 0x61, 0xf2, 0x64, 0xb8,
-	0x93, 0xd7, 0x70, 0xa5, 0x75, 0xb8, 0xbf, 0x5f, 0xdb, 0x6b, 0x1f, 0xb4, 0x6e, 0xb4, 0x0e, 0x0f,
-	0xda, 0xf5, 0xc6, 0x5e, 0xab, 0xe6, 0xb4, 0x0f, 0xf7, 0x0f, 0x9a, 0xb5, 0xdd, 0x46, 0xbd, 0x51,
-	0xbb, 0x75, 0xb9, 0x40, 0x56, 0xf1, 0x72, 0x2a, 0xea, 0xc6, 0x6e, 0xab, 0xf1, 0x79, 0xed, 0x32,
-	0x22, 0xaf, 0xe0, 0x97, 0x53, 0x01, 0x8d, 0x7d, 0x80, 0xcc, 0x19, 0xf3, 0x0f, 0x7e, 0x37, 0x0b,
-	0x5b, 0x3f, 0x94, 0xf1, 0xb3, 0xb2, 0x33, 0xf2, 0x2d, 0x2e, 0x41, 0xf4, 0x93, 0x6a, 0x6a, 0x1b,
-	0x29, 0xef, 0x32, 0xe3, 0x7a, 0x0e, 0xa4, 0xf2, 0xca, 0x5a, 0xfd, 0xee, 0xcf, 0x7f, 0x1f, 0xce,
-	0xbd, 0x44, 0xae, 0xa5, 0xbf, 0x34, 0x05, 0x79, 0x80, 0x70, 0x51, 0x15, 0x91, 0xb5, 0x59, 0xdb,
-	0x6a, 0xfe, 0xea, 0x6c, 0x20, 0xd0, 0x6f, 0x48, 0xfa, 0xd7, 0xc9, 0xab, 0xff, 0x43, 0x4f, 0x47,
-	0xf1, 0x99, 0x3f, 0x21, 0x3f, 0x23, 0xbc, 0xa0, 0xc3, 0x96, 0x64, 0xf4, 0x38, 0xf5, 0x3a, 0x30,
-	0xd6, 0xf3, 0x40, 0x41, 0xd0, 0xdb, 0x52, 0x90, 0x4d, 0xde, 0xcc, 0x21, 0x88, 0xc6, 0xb9, 0xfd,
-	0x1b, 0xc2, 0x25, 0xd8, 0x2a, 0x6b, 0x4c, 0xc9, 0x34, 0x36, 0xae, 0xe7, 0x40, 0x82, 0xac, 0x8f,
-	0xa4, 0xac, 0xf7, 0xc9, 0xce, 0x79, 0x64, 0xd1, 0x51, 0x1c, 0xdf, 0x27, 0xe4, 0x21, 0xc2, 0x25,
-	0x08, 0xba, 0x2c, 0x89, 0xc9, 0x20, 0xce, 0x92, 0x38, 0x95, 0x9a, 0xd6, 0xb6, 0x94, 0xf8, 0x16,
-	0xd9, 0xc8, 0x23, 0x51, 0x67, 0xe6, 0xaf, 0x08, 0x17, 0xd5, 0x46, 0x59, 0xa7, 0x2b, 0x11, 0x84,
-	0x46, 0x75, 0x36, 0x10, 0x24, 0x7d, 0x28, 0x25, 0xed, 0x90, 0x77, 0xcf, 0x21, 0x89, 0x8e, 0x74,
-	0x70, 0x9e, 0x44, 0x67, 0xbf, 0x1c, 0x87, 0x12, 0xc9, 0x38, 0x46, 0xd3, 0x71, 0x68, 0x6c, 0xe4,
-	0xc2, 0x82, 0x4c, 0x4b, 0xca, 0x5c, 0x21, 0xc6, 0x13, 0x32, 0xe3, 0xdc, 0x24, 0xa3, 0xc8, 0xa7,
-	0x28, 0xa2, 0xb2, 0x7d, 0x9a, 0x48, 0xc7, 0x6c, 0x9f, 0x26, 0x03, 0xd3, 0x32, 0xa5, 0x80, 0x25,
-	0x72, 0x35, 0xfd, 0xdb, 0xfa, 0x66, 0xe3, 0xd1, 0xa9, 0x89, 0x1e, 0x9f, 0x9a, 0xe8, 0x9f, 0x53,
-	0x13, 0xfd, 0x78, 0x66, 0x16, 0x1e, 0x9f, 0x99, 0x85, 0xbf, 0xce, 0xcc, 0xc2, 0x17, 0xb4, 0xe7,
-	0x85, 0x77, 0x86, 0x1d, 0xbb, 0xcb, 0x8f, 0x69, 0xc4, 0x26, 0xbf, 0xb1, 0xbb, 0xbc, 0x4f, 0xbb,
-	0x77, 0x5c, 0xcf, 0xa7, 0xf7, 0xb6, 0xe9, 0xd7, 0x7a, 0xcf, 0xf0, 0x7e, 0xc0, 0x44, 0xa7, 0x28,
-	0x11, 0xdb, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x75, 0xeb, 0x1c, 0x48, 0x61, 0x0c, 0x00, 0x00,
+func (m *QueryPacketRetentionRequest) Reset()         { *m = QueryPacketRetentionRequest{} }
+func (m *QueryPacketRetentionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketRetentionRequest) ProtoMessage()    {}
+func (*QueryPacketRetentionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{16}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Tunnels is a RPC method that returns all tunnels.
-	Tunnels(ctx context.Context, in *QueryTunnelsRequest, opts ...grpc.CallOption) (*QueryTunnelsResponse, error)
-	// Tunnel is a RPC method that returns a tunnel by its ID.
-	Tunnel(ctx context.Context, in *QueryTunnelRequest, opts ...grpc.CallOption) (*QueryTunnelResponse, error)
-	// Deposits queries all deposits of a single tunnel.
-	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
-	// Deposit queries single deposit information based tunnelID, depositAddr.
-	Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error)
-	// Packets is a RPC method that returns all packets of a tunnel.
-	Packets(ctx context.Context, in *QueryPacketsRequest, opts ...grpc.CallOption) (*QueryPacketsResponse, error)
-	// Packet is a RPC method that returns a packet by its tunnel ID and sequence.
-	Packet(ctx context.Context, in *QueryPacketRequest, opts ...grpc.CallOption) (*QueryPacketResponse, error)
-	// TotalFees is a RPC method that returns the total fees collected by the tunnel
-	TotalFees(ctx context.Context, in *QueryTotalFeesRequest, opts ...grpc.CallOption) (*QueryTotalFeesResponse, error)
-	// Params is a RPC method that returns all parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryPacketRetentionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPacketRetentionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketRetentionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPacketRetentionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketRetentionRequest.Merge(m, src)
 }
-
-func (c *queryClient) Tunnels(ctx context.Context, in *QueryTunnelsRequest, opts ...grpc.CallOption) (*QueryTunnelsResponse, error) {
-	out := new(QueryTunnelsResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Tunnels", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPacketRetentionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketRetentionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketRetentionRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Tunnel(ctx context.Context, in *QueryTunnelRequest, opts ...grpc.CallOption) (*QueryTunnelResponse, error) {
-	out := new(QueryTunnelResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Tunnel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPacketRetentionRequest proto.InternalMessageInfo
+
+func (m *QueryPacketRetentionRequest) GetTunnelId() uint64 {
+	if m != nil {
+		return m.TunnelId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
-	out := new(QueryDepositsResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Deposits", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryPacketRetentionResponse is the response type for the Query/PacketRetention RPC method.
+type QueryPacketRetentionResponse struct {
+	// oldest_sequence is the sequence of the oldest packet of the tunnel kept in the state.
+	// It is zero if the tunnel has no packet in the state.
+	OldestSequence uint64 `protobuf:"varint,1,opt,name=oldest_sequence,json=oldestSequence,proto3" json:"oldest_sequence,omitempty"`
+	// latest_sequence is the sequence of the latest packet produced by the tunnel.
+	LatestSequence uint64 `protobuf:"varint,2,opt,name=latest_sequence,json=latestSequence,proto3" json:"latest_sequence,omitempty"`
 }
 
-func (c *queryClient) Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error) {
-	out := new(QueryDepositResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Deposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPacketRetentionResponse) Reset()         { *m = QueryPacketRetentionResponse{} }
+func (m *QueryPacketRetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketRetentionResponse) ProtoMessage()    {}
+func (*QueryPacketRetentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{17}
+}
+func (m *QueryPacketRetentionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketRetentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketRetentionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPacketRetentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketRetentionResponse.Merge(m, src)
+}
+func (m *QueryPacketRetentionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketRetentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketRetentionResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Packets(ctx context.Context, in *QueryPacketsRequest, opts ...grpc.CallOption) (*QueryPacketsResponse, error) {
-	out := new(QueryPacketsResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Packets", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPacketRetentionResponse proto.InternalMessageInfo
+
+func (m *QueryPacketRetentionResponse) GetOldestSequence() uint64 {
+	if m != nil {
+		return m.OldestSequence
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Packet(ctx context.Context, in *QueryPacketRequest, opts ...grpc.CallOption) (*QueryPacketResponse, error) {
-	out := new(QueryPacketResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Packet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPacketRetentionResponse) GetLatestSequence() uint64 {
+	if m != nil {
+		return m.LatestSequence
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) TotalFees(ctx context.Context, in *QueryTotalFeesRequest, opts ...grpc.CallOption) (*QueryTotalFeesResponse, error) {
-	out := new(QueryTotalFeesResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/TotalFees", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// PriceSnapshot is the set of signal prices observed at a point in time.
+type PriceSnapshot struct {
+	// timestamp is the unix timestamp of the snapshot
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// prices is the list of signal prices at the timestamp
+	Prices []types1.Price `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PriceSnapshot) Reset()         { *m = PriceSnapshot{} }
+func (m *PriceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*PriceSnapshot) ProtoMessage()    {}
+func (*PriceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{18}
+}
+func (m *PriceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Tunnels is a RPC method that returns all tunnels.
-	Tunnels(context.Context, *QueryTunnelsRequest) (*QueryTunnelsResponse, error)
-	// Tunnel is a RPC method that returns a tunnel by its ID.
-	Tunnel(context.Context, *QueryTunnelRequest) (*QueryTunnelResponse, error)
-	// Deposits queries all deposits of a single tunnel.
-	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
-	// Deposit queries single deposit information based tunnelID, depositAddr.
-	Deposit(context.Context, *QueryDepositRequest) (*QueryDepositResponse, error)
-	// Packets is a RPC method that returns all packets of a tunnel.
-	Packets(context.Context, *QueryPacketsRequest) (*QueryPacketsResponse, error)
-	// Packet is a RPC method that returns a packet by its tunnel ID and sequence.
-	Packet(context.Context, *QueryPacketRequest) (*QueryPacketResponse, error)
-	// TotalFees is a RPC method that returns the total fees collected by the tunnel
-	TotalFees(context.Context, *QueryTotalFeesRequest) (*QueryTotalFeesResponse, error)
-	// Params is a RPC method that returns all parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (m *PriceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceSnapshot.Merge(m, src)
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *PriceSnapshot) XXX_Size() int {
+	return m.Size()
 }
-
-func (*UnimplementedQueryServer) Tunnels(ctx context.Context, req *QueryTunnelsRequest) (*QueryTunnelsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Tunnels not implemented")
+func (m *PriceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceSnapshot.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Tunnel(ctx context.Context, req *QueryTunnelRequest) (*QueryTunnelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Tunnel not implemented")
+
+var xxx_messageInfo_PriceSnapshot proto.InternalMessageInfo
+
+func (m *PriceSnapshot) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
+
+func (m *PriceSnapshot) GetPrices() []types1.Price {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) Deposit(ctx context.Context, req *QueryDepositRequest) (*QueryDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+
+// QuerySimulateTunnelRequest is the request type for the Query/SimulateTunnel RPC method.
+type QuerySimulateTunnelRequest struct {
+	// signal_deviations is the list of proposed signal deviations.
+	SignalDeviations []SignalDeviation `protobuf:"bytes,1,rep,name=signal_deviations,json=signalDeviations,proto3" json:"signal_deviations"`
+	// interval is the proposed interval for delivering the signal prices.
+	Interval uint64 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// route is the proposed route. Packets are encoded with the encoder of the route and charged its fee;
+	// no packet is encoded and no route fee is charged if it is empty.
+	Route *types2.Any `protobuf:"bytes,4,opt,name=route,proto3" json:"route,omitempty"`
+	// price_snapshots is the price history to replay in ascending order of timestamp. The price history
+	// of the feeds module between start_time and end_time is replayed if it is empty.
+	PriceSnapshots []PriceSnapshot `protobuf:"bytes,5,rep,name=price_snapshots,json=priceSnapshots,proto3" json:"price_snapshots"`
+	// schedule is the proposed schedule. Only a cron is supported; a schedule with block heights is rejected, as the
+	// price history has no block heights.
+	Schedule *Schedule `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// start_time is the unix timestamp of the beginning of the replayed price history (inclusive).
+	StartTime int64 `protobuf:"varint,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the unix timestamp of the end of the replayed price history (inclusive). If it is zero,
+	// the price history is replayed up to the current block time.
+	EndTime int64 `protobuf:"varint,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *QuerySimulateTunnelRequest) Reset()         { *m = QuerySimulateTunnelRequest{} }
+func (m *QuerySimulateTunnelRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateTunnelRequest) ProtoMessage()    {}
+func (*QuerySimulateTunnelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{19}
+}
+func (m *QuerySimulateTunnelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Packets(ctx context.Context, req *QueryPacketsRequest) (*QueryPacketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Packets not implemented")
+func (m *QuerySimulateTunnelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateTunnelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Packet(ctx context.Context, req *QueryPacketRequest) (*QueryPacketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Packet not implemented")
+func (m *QuerySimulateTunnelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateTunnelRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) TotalFees(ctx context.Context, req *QueryTotalFeesRequest) (*QueryTotalFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalFees not implemented")
+func (m *QuerySimulateTunnelRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QuerySimulateTunnelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateTunnelRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QuerySimulateTunnelRequest proto.InternalMessageInfo
 
-func _Query_Tunnels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTunnelsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Tunnels(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Tunnels",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Tunnels(ctx, req.(*QueryTunnelsRequest))
+func (m *QuerySimulateTunnelRequest) GetSignalDeviations() []SignalDeviation {
+	if m != nil {
+		return m.SignalDeviations
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Tunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTunnelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Tunnel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Tunnel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Tunnel(ctx, req.(*QueryTunnelRequest))
+func (m *QuerySimulateTunnelRequest) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDepositsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Deposits(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Deposits",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Deposits(ctx, req.(*QueryDepositsRequest))
+func (m *QuerySimulateTunnelRequest) GetRoute() *types2.Any {
+	if m != nil {
+		return m.Route
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDepositRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Deposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Deposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Deposit(ctx, req.(*QueryDepositRequest))
+func (m *QuerySimulateTunnelRequest) GetPriceSnapshots() []PriceSnapshot {
+	if m != nil {
+		return m.PriceSnapshots
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Packets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPacketsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Packets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Packets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Packets(ctx, req.(*QueryPacketsRequest))
+func (m *QuerySimulateTunnelRequest) GetSchedule() *Schedule {
+	if m != nil {
+		return m.Schedule
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Packet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPacketRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Packet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Packet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Packet(ctx, req.(*QueryPacketRequest))
+func (m *QuerySimulateTunnelRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_TotalFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalFeesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/TotalFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalFees(ctx, req.(*QueryTotalFeesRequest))
+func (m *QuerySimulateTunnelRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// QuerySimulateTunnelResponse is the response type for the Query/SimulateTunnel RPC method.
+type QuerySimulateTunnelResponse struct {
+	// packets is the list of packets that the tunnel would have produced.
+	Packets []Packet `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	// total_base_packet_fee is the total base packet fee of the packets.
+	TotalBasePacketFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_base_packet_fee,json=totalBasePacketFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_base_packet_fee"`
+	// total_route_fee is the total route fee of the packets.
+	TotalRouteFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_route_fee,json=totalRouteFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_route_fee"`
+}
+
+func (m *QuerySimulateTunnelResponse) Reset()         { *m = QuerySimulateTunnelResponse{} }
+func (m *QuerySimulateTunnelResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateTunnelResponse) ProtoMessage()    {}
+func (*QuerySimulateTunnelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{20}
+}
+func (m *QuerySimulateTunnelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateTunnelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateTunnelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+}
+func (m *QuerySimulateTunnelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateTunnelResponse.Merge(m, src)
+}
+func (m *QuerySimulateTunnelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateTunnelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateTunnelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateTunnelResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateTunnelResponse) GetPackets() []Packet {
+	if m != nil {
+		return m.Packets
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Params",
+	return nil
+}
+
+func (m *QuerySimulateTunnelResponse) GetTotalBasePacketFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalBasePacketFee
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return nil
+}
+
+func (m *QuerySimulateTunnelResponse) GetTotalRouteFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalRouteFee
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "band.tunnel.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Tunnels",
-			Handler:    _Query_Tunnels_Handler,
-		},
-		{
-			MethodName: "Tunnel",
-			Handler:    _Query_Tunnel_Handler,
-		},
-		{
-			MethodName: "Deposits",
-			Handler:    _Query_Deposits_Handler,
-		},
-		{
-			MethodName: "Deposit",
-			Handler:    _Query_Deposit_Handler,
-		},
-		{
-			MethodName: "Packets",
-			Handler:    _Query_Packets_Handler,
-		},
-		{
-			MethodName: "Packet",
-			Handler:    _Query_Packet_Handler,
-		},
-		{
-			MethodName: "TotalFees",
-			Handler:    _Query_TotalFees_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "band/tunnel/v1beta1/query.proto",
+// QueryTotalFeesRequest is the request type for the Query/TotalFees RPC method.
+type QueryTotalFeesRequest struct {
 }
 
-func (m *QueryTunnelsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTotalFeesRequest) Reset()         { *m = QueryTotalFeesRequest{} }
+func (m *QueryTotalFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalFeesRequest) ProtoMessage()    {}
+func (*QueryTotalFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{21}
 }
-
-func (m *QueryTunnelsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTotalFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryTunnelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTotalFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.StatusFilter != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StatusFilter))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTunnelsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTotalFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalFeesRequest.Merge(m, src)
+}
+func (m *QueryTotalFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalFeesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTunnelsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryTotalFeesRequest proto.InternalMessageInfo
+
+// QueryTotalFeesResponse is the response type for the Query/TotalFees RPC method.
+type QueryTotalFeesResponse struct {
+	// total_fees is the total fees collected by the tunnel.
+	TotalFees TotalFees `protobuf:"bytes,1,opt,name=total_fees,json=totalFees,proto3" json:"total_fees"`
 }
 
-func (m *QueryTunnelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Tunnels) > 0 {
-		for iNdEx := len(m.Tunnels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tunnels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryTotalFeesResponse) Reset()         { *m = QueryTotalFeesResponse{} }
+func (m *QueryTotalFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalFeesResponse) ProtoMessage()    {}
+func (*QueryTotalFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{22}
+}
+func (m *QueryTotalFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTunnelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTotalFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalFeesResponse.Merge(m, src)
 }
-
-func (m *QueryTunnelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTotalFeesResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryTunnelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TunnelId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryTotalFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalFeesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTunnelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTotalFeesResponse proto.InternalMessageInfo
+
+func (m *QueryTotalFeesResponse) GetTotalFees() TotalFees {
+	if m != nil {
+		return m.TotalFees
 	}
-	return dAtA[:n], nil
+	return TotalFees{}
 }
 
-func (m *QueryTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
 }
 
-func (m *QueryTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{23}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDepositsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params is the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{24}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.TunnelId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return len(dAtA) - i, nil
+	return Params{}
 }
 
-func (m *QueryDepositsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterEnum("band.tunnel.v1beta1.TunnelStatusFilter", TunnelStatusFilter_name, TunnelStatusFilter_value)
+	proto.RegisterType((*QueryTunnelsRequest)(nil), "band.tunnel.v1beta1.QueryTunnelsRequest")
+	proto.RegisterType((*QueryTunnelsResponse)(nil), "band.tunnel.v1beta1.QueryTunnelsResponse")
+	proto.RegisterType((*QueryTunnelRequest)(nil), "band.tunnel.v1beta1.QueryTunnelRequest")
+	proto.RegisterType((*QueryTunnelResponse)(nil), "band.tunnel.v1beta1.QueryTunnelResponse")
+	proto.RegisterType((*QueryDepositsRequest)(nil), "band.tunnel.v1beta1.QueryDepositsRequest")
+	proto.RegisterType((*QueryDepositsResponse)(nil), "band.tunnel.v1beta1.QueryDepositsResponse")
+	proto.RegisterType((*QueryDepositRequest)(nil), "band.tunnel.v1beta1.QueryDepositRequest")
+	proto.RegisterType((*QueryDepositResponse)(nil), "band.tunnel.v1beta1.QueryDepositResponse")
+	proto.RegisterType((*QueryPacketsRequest)(nil), "band.tunnel.v1beta1.QueryPacketsRequest")
+	proto.RegisterType((*QueryPacketsResponse)(nil), "band.tunnel.v1beta1.QueryPacketsResponse")
+	proto.RegisterType((*QueryPacketRequest)(nil), "band.tunnel.v1beta1.QueryPacketRequest")
+	proto.RegisterType((*QueryPacketResponse)(nil), "band.tunnel.v1beta1.QueryPacketResponse")
+	proto.RegisterType((*QueryTunnelFeeRunwayRequest)(nil), "band.tunnel.v1beta1.QueryTunnelFeeRunwayRequest")
+	proto.RegisterType((*QueryTunnelFeeRunwayResponse)(nil), "band.tunnel.v1beta1.QueryTunnelFeeRunwayResponse")
+	proto.RegisterType((*QueryTunnelOperatorsRequest)(nil), "band.tunnel.v1beta1.QueryTunnelOperatorsRequest")
+	proto.RegisterType((*QueryTunnelOperatorsResponse)(nil), "band.tunnel.v1beta1.QueryTunnelOperatorsResponse")
+	proto.RegisterType((*QueryPacketRetentionRequest)(nil), "band.tunnel.v1beta1.QueryPacketRetentionRequest")
+	proto.RegisterType((*QueryPacketRetentionResponse)(nil), "band.tunnel.v1beta1.QueryPacketRetentionResponse")
+	proto.RegisterType((*PriceSnapshot)(nil), "band.tunnel.v1beta1.PriceSnapshot")
+	proto.RegisterType((*QuerySimulateTunnelRequest)(nil), "band.tunnel.v1beta1.QuerySimulateTunnelRequest")
+	proto.RegisterType((*QuerySimulateTunnelResponse)(nil), "band.tunnel.v1beta1.QuerySimulateTunnelResponse")
+	proto.RegisterType((*QueryTotalFeesRequest)(nil), "band.tunnel.v1beta1.QueryTotalFeesRequest")
+	proto.RegisterType((*QueryTotalFeesResponse)(nil), "band.tunnel.v1beta1.QueryTotalFeesResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "band.tunnel.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "band.tunnel.v1beta1.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("band/tunnel/v1beta1/query.proto", fileDescriptor_f80b85392d1440ac) }
+
+var fileDescriptor_f80b85392d1440ac = []byte{
+	// 1698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x26, 0x8e, 0xe3, 0x4c, 0x69, 0x92, 0x4e, 0xd3, 0x36, 0xd9, 0x24, 0x4e, 0xba, 0x2d,
+	0xc4, 0x4d, 0x88, 0x37, 0x1f, 0x6d, 0x28, 0xa5, 0x42, 0x4d, 0xd2, 0xb8, 0x72, 0x55, 0x82, 0xbb,
+	0x76, 0xa8, 0x84, 0x84, 0x56, 0x6b, 0x7b, 0xec, 0x2c, 0xb5, 0x77, 0xb7, 0x3b, 0xeb, 0x84, 0x28,
+	0x2a, 0x48, 0x88, 0x43, 0x8f, 0x48, 0x95, 0x00, 0xc1, 0xa5, 0x12, 0x9c, 0x40, 0x70, 0xea, 0x1d,
+	0x89, 0x53, 0xc5, 0x85, 0x4a, 0x5c, 0x38, 0x01, 0x6a, 0xf9, 0x43, 0xd0, 0x7c, 0xad, 0xbd, 0xee,
+	0xd6, 0xde, 0x48, 0x81, 0x93, 0x33, 0x6f, 0x7f, 0x6f, 0xde, 0xef, 0xbd, 0x37, 0xef, 0xcd, 0x9b,
+	0x80, 0xe9, 0xa2, 0x61, 0x95, 0x55, 0xaf, 0x61, 0x59, 0xa8, 0xa6, 0xee, 0x2e, 0x15, 0x91, 0x67,
+	0x2c, 0xa9, 0xf7, 0x1a, 0xc8, 0xdd, 0x4f, 0x3b, 0xae, 0xed, 0xd9, 0xf0, 0x24, 0x01, 0xa4, 0x19,
+	0x20, 0xcd, 0x01, 0xf2, 0x78, 0xc9, 0xc6, 0x75, 0x1b, 0xeb, 0x14, 0xa2, 0xb2, 0x05, 0xc3, 0xcb,
+	0xa3, 0x55, 0xbb, 0x6a, 0x33, 0x39, 0xf9, 0x8b, 0x4b, 0xe7, 0x18, 0x46, 0x2d, 0x1a, 0x18, 0xb1,
+	0xed, 0x7d, 0x63, 0x8e, 0x51, 0x35, 0x2d, 0xc3, 0x33, 0x6d, 0x8b, 0x63, 0x93, 0xad, 0x58, 0x81,
+	0x2a, 0xd9, 0xa6, 0xf8, 0x3e, 0x59, 0xb5, 0xed, 0x6a, 0x0d, 0xa9, 0x86, 0x63, 0xaa, 0x86, 0x65,
+	0xd9, 0x1e, 0x55, 0x16, 0xf6, 0xc7, 0xf9, 0x57, 0xba, 0x2a, 0x36, 0x2a, 0xaa, 0x61, 0xed, 0x8b,
+	0x8d, 0xa9, 0xaf, 0x15, 0x84, 0xca, 0xd8, 0xdf, 0x97, 0xae, 0xf8, 0xf7, 0x99, 0xb0, 0x58, 0x38,
+	0x86, 0x6b, 0xd4, 0x05, 0x22, 0x34, 0x5a, 0xae, 0xdd, 0xf0, 0x50, 0xa7, 0x2d, 0xd8, 0x92, 0x21,
+	0x94, 0x1f, 0x24, 0x70, 0xf2, 0x36, 0x09, 0x40, 0x81, 0x4a, 0xb1, 0x86, 0xee, 0x35, 0x10, 0xf6,
+	0xe0, 0x2d, 0x70, 0x1c, 0x7b, 0x86, 0xd7, 0xc0, 0x7a, 0xc5, 0xac, 0x79, 0xc8, 0x1d, 0x93, 0x66,
+	0xa4, 0xd4, 0xd0, 0xf2, 0x6c, 0x3a, 0x24, 0xfe, 0x69, 0xa6, 0x9b, 0xa7, 0xf8, 0x0c, 0x85, 0x6b,
+	0xaf, 0xe0, 0x96, 0x15, 0xcc, 0x00, 0xd0, 0x8c, 0xeb, 0x58, 0xef, 0x8c, 0x94, 0x3a, 0xb6, 0xfc,
+	0x5a, 0x9a, 0x27, 0x8a, 0x04, 0x36, 0xcd, 0x72, 0x2c, 0x36, 0xcc, 0x19, 0x55, 0xc4, 0x99, 0x68,
+	0x2d, 0x9a, 0xca, 0x17, 0x12, 0x18, 0x0d, 0xb2, 0xc5, 0x8e, 0x6d, 0x61, 0x04, 0x2f, 0x81, 0x01,
+	0xc6, 0x09, 0x8f, 0x49, 0x33, 0x7d, 0xa9, 0x63, 0xcb, 0x13, 0x1d, 0x88, 0x6a, 0x02, 0x0b, 0x6f,
+	0x84, 0xf0, 0x9a, 0xed, 0xca, 0x8b, 0xd9, 0x0c, 0x10, 0x5b, 0x02, 0xb0, 0x85, 0x97, 0x08, 0xe2,
+	0x04, 0x18, 0x64, 0x96, 0x74, 0xb3, 0x4c, 0x03, 0x18, 0xd3, 0x12, 0x4c, 0x90, 0x2d, 0x2b, 0xb9,
+	0x40, 0xe0, 0x7d, 0x4f, 0xde, 0x04, 0x71, 0x06, 0xa1, 0x0a, 0x9d, 0x1d, 0x59, 0x8f, 0x3d, 0xf9,
+	0x73, 0xba, 0x47, 0xe3, 0x0a, 0xca, 0x01, 0x0f, 0xce, 0x75, 0xe4, 0xd8, 0xd8, 0xf4, 0x70, 0x14,
+	0x1a, 0x47, 0x96, 0x9a, 0xaf, 0x25, 0x70, 0xaa, 0xcd, 0x3a, 0xf7, 0xe8, 0x32, 0x48, 0x94, 0xb9,
+	0x8c, 0x27, 0x67, 0x32, 0xd4, 0x27, 0xae, 0xa8, 0xf9, 0xe8, 0xa3, 0x4b, 0x8f, 0x88, 0xb5, 0x30,
+	0x11, 0x25, 0x30, 0x93, 0x60, 0x90, 0x13, 0xb1, 0x5d, 0x6a, 0x7b, 0x50, 0x6b, 0x0a, 0x94, 0x42,
+	0x30, 0xd6, 0xbe, 0xb3, 0x57, 0xc1, 0x00, 0x07, 0xf1, 0xfc, 0x75, 0xf4, 0x95, 0x27, 0x50, 0xa8,
+	0x28, 0xbf, 0x88, 0x6a, 0xcc, 0x19, 0xa5, 0xbb, 0xe8, 0xff, 0xcd, 0x20, 0xcc, 0xb4, 0x97, 0x7c,
+	0x1f, 0x2d, 0xf9, 0xb3, 0xa1, 0x0e, 0x30, 0x82, 0xac, 0xe4, 0x83, 0xc5, 0xde, 0x2c, 0x52, 0xdf,
+	0x89, 0x66, 0x91, 0x3a, 0x4c, 0xd4, 0xb1, 0x48, 0x99, 0x9a, 0x26, 0xb0, 0x47, 0x77, 0x0a, 0xde,
+	0xe1, 0x45, 0xca, 0x0d, 0x44, 0x89, 0xad, 0x0c, 0x12, 0x98, 0xe0, 0xac, 0x12, 0xa2, 0x96, 0x63,
+	0x9a, 0xbf, 0x56, 0x6e, 0x06, 0x72, 0xe5, 0x7b, 0xb9, 0x02, 0xe2, 0x8c, 0x79, 0xc7, 0x02, 0xe6,
+	0x4a, 0x1c, 0xaa, 0x5c, 0x01, 0x13, 0x2d, 0xcd, 0x20, 0x83, 0x90, 0xd6, 0xb0, 0xf6, 0x8c, 0xfd,
+	0x48, 0x8d, 0xe4, 0xbb, 0x18, 0x98, 0x0c, 0x57, 0xe6, 0x8c, 0xf6, 0xc0, 0x89, 0x0a, 0x42, 0xba,
+	0x63, 0xec, 0x23, 0x57, 0x2f, 0x1a, 0x35, 0x83, 0x78, 0xc3, 0x32, 0x30, 0x1e, 0x88, 0xa3, 0x20,
+	0xb7, 0x61, 0x9b, 0xd6, 0xfa, 0x22, 0x39, 0x9a, 0xdf, 0xff, 0x35, 0x9d, 0xaa, 0x9a, 0xde, 0x4e,
+	0xa3, 0x98, 0x2e, 0xd9, 0x75, 0x7e, 0xb5, 0xf2, 0x9f, 0x05, 0x5c, 0xbe, 0xab, 0x7a, 0xfb, 0x0e,
+	0xc2, 0x54, 0x01, 0x6b, 0xc3, 0x15, 0x84, 0x72, 0xc4, 0xc8, 0x3a, 0xb3, 0x01, 0x3f, 0x04, 0x80,
+	0xf9, 0xa7, 0x57, 0x10, 0x89, 0xdf, 0x91, 0x5b, 0x1c, 0x64, 0xdb, 0x67, 0x10, 0x82, 0xf3, 0xe0,
+	0x84, 0x8b, 0xea, 0x86, 0x69, 0x99, 0x56, 0x55, 0x17, 0xc7, 0xac, 0x8f, 0x86, 0x6a, 0xc4, 0xff,
+	0xc0, 0x4f, 0x24, 0x6d, 0xb2, 0xb6, 0xa3, 0x37, 0x9c, 0xb1, 0x18, 0xcd, 0x91, 0x12, 0x9a, 0xa3,
+	0x0c, 0x77, 0xa7, 0x60, 0x3b, 0xdb, 0x8e, 0xd6, 0xef, 0x91, 0x1f, 0xd8, 0x00, 0x23, 0x4c, 0x55,
+	0x37, 0x6a, 0x35, 0x7b, 0x8f, 0xc6, 0xb2, 0xff, 0xe8, 0x3d, 0x1b, 0xa2, 0xd6, 0xd6, 0x84, 0x09,
+	0x78, 0x0d, 0x4c, 0xbd, 0xe0, 0x9e, 0xbe, 0x67, 0x7a, 0x3b, 0x3a, 0x77, 0x24, 0x4e, 0x5d, 0x1d,
+	0x6f, 0x77, 0xf5, 0x8e, 0xe9, 0xed, 0x50, 0xfe, 0x6d, 0x47, 0xec, 0x5d, 0x07, 0xb9, 0x86, 0x67,
+	0xbb, 0x91, 0x5a, 0x8c, 0x52, 0x05, 0x93, 0xe1, 0xba, 0xfc, 0x84, 0xdd, 0x00, 0x83, 0xb6, 0x10,
+	0xf2, 0x93, 0x75, 0xae, 0xc3, 0xbd, 0x25, 0x36, 0xe0, 0xed, 0xaf, 0xa9, 0xeb, 0x93, 0x14, 0x35,
+	0xe5, 0x21, 0x8b, 0x94, 0x6e, 0x24, 0x92, 0x0e, 0x98, 0x0c, 0xd7, 0xe5, 0x24, 0x67, 0xc1, 0xb0,
+	0x5d, 0x2b, 0x23, 0xec, 0xe9, 0x7e, 0x49, 0xb3, 0x2d, 0x86, 0x98, 0x38, 0xcf, 0xa5, 0x04, 0x58,
+	0x33, 0xbc, 0x00, 0x90, 0xd5, 0xfe, 0x10, 0x13, 0x0b, 0xa0, 0x52, 0x01, 0xc7, 0x73, 0xae, 0x59,
+	0x42, 0x79, 0xcb, 0x70, 0xf0, 0x8e, 0xed, 0x91, 0x3b, 0xc3, 0x33, 0xeb, 0x08, 0x7b, 0x46, 0xdd,
+	0xa1, 0x9b, 0xf7, 0x69, 0x4d, 0x01, 0x7c, 0x03, 0xc4, 0x1d, 0x02, 0xc7, 0x7e, 0x29, 0xd0, 0x10,
+	0xb1, 0x99, 0xcf, 0x6f, 0x0c, 0x04, 0x21, 0x2e, 0x76, 0x06, 0x57, 0x1e, 0xf5, 0x01, 0x99, 0xba,
+	0x96, 0x37, 0xeb, 0x0d, 0xc2, 0x21, 0x38, 0x66, 0xdc, 0x01, 0x27, 0xb0, 0x59, 0xb5, 0x8c, 0x9a,
+	0x5e, 0x46, 0xbb, 0x26, 0x1b, 0x3f, 0x79, 0x16, 0xce, 0x87, 0x66, 0x21, 0x4f, 0xd1, 0xd7, 0x05,
+	0x98, 0x5b, 0x1b, 0xc1, 0x41, 0x31, 0x26, 0xdd, 0xcf, 0xb4, 0x3c, 0xe4, 0xee, 0x1a, 0x35, 0xd1,
+	0xfd, 0xc4, 0x1a, 0xae, 0x82, 0x7e, 0x3a, 0x69, 0xf2, 0x0a, 0x1a, 0x4d, 0xb3, 0x41, 0x37, 0x2d,
+	0x06, 0xdd, 0xf4, 0x9a, 0xb5, 0xbf, 0x0e, 0x7e, 0x7d, 0xbc, 0x10, 0xd7, 0x08, 0x2c, 0xab, 0x31,
+	0x38, 0xbc, 0x0d, 0x86, 0xa9, 0x57, 0x3a, 0xe6, 0x41, 0xc3, 0xbc, 0x7c, 0xc2, 0x6b, 0x30, 0x10,
+	0x5f, 0x4e, 0x74, 0xc8, 0x69, 0x15, 0x92, 0x6a, 0x4e, 0xe0, 0xd2, 0x0e, 0x2a, 0x37, 0x6a, 0x88,
+	0x96, 0xc1, 0xb1, 0xe5, 0xa9, 0x70, 0xb7, 0x39, 0x48, 0xf3, 0xe1, 0x70, 0x0a, 0x00, 0xec, 0x19,
+	0xae, 0xa7, 0x93, 0x2c, 0x8d, 0x0d, 0xb0, 0x8c, 0x51, 0x49, 0xc1, 0xac, 0x23, 0x38, 0x0e, 0x12,
+	0xc8, 0x2a, 0xb3, 0x8f, 0x09, 0xfa, 0x71, 0x00, 0x59, 0x65, 0xf2, 0xe9, 0x66, 0x2c, 0xd1, 0x37,
+	0x12, 0x23, 0xcb, 0x92, 0x5d, 0x46, 0xae, 0xf2, 0x5b, 0x2f, 0x98, 0x08, 0x4d, 0x11, 0x3f, 0x7c,
+	0x6f, 0x1d, 0xe6, 0xee, 0x13, 0x63, 0x81, 0xb8, 0x01, 0x3f, 0x06, 0xa7, 0x3c, 0xdb, 0x33, 0x6a,
+	0x7a, 0xd1, 0xc0, 0x48, 0xff, 0x6f, 0x5b, 0x2a, 0xa4, 0x96, 0xd6, 0x0d, 0x8c, 0x72, 0x7e, 0x6f,
+	0xc5, 0x60, 0x98, 0xd9, 0xa7, 0x29, 0xa4, 0x96, 0xfb, 0x8e, 0xde, 0xf2, 0x71, 0x6a, 0x83, 0x9e,
+	0x96, 0x0c, 0x42, 0xca, 0x19, 0x3e, 0x4f, 0x16, 0x88, 0x34, 0x83, 0x90, 0xe8, 0x54, 0xca, 0x07,
+	0xe0, 0x74, 0xfb, 0x07, 0x1e, 0xe4, 0x0d, 0x00, 0x18, 0xcf, 0x0a, 0x42, 0x98, 0x5f, 0xbf, 0xc9,
+	0xf0, 0x3e, 0x24, 0x74, 0x45, 0x0b, 0xf2, 0x84, 0x40, 0x19, 0xf5, 0xa7, 0x04, 0xf2, 0xd2, 0x12,
+	0x46, 0x73, 0xe0, 0x64, 0x40, 0xda, 0x9c, 0xd6, 0xd9, 0x8b, 0xac, 0xcb, 0x65, 0x4f, 0x20, 0x7e,
+	0x51, 0xd3, 0xd5, 0xdc, 0x67, 0x12, 0x80, 0x2f, 0x3e, 0x9c, 0xe0, 0x79, 0x30, 0x53, 0xd8, 0xde,
+	0xda, 0xda, 0xbc, 0xa5, 0xe7, 0x0b, 0x6b, 0x85, 0xed, 0xbc, 0x9e, 0xc9, 0xde, 0x2a, 0x6c, 0x6a,
+	0xfa, 0xf6, 0x56, 0x3e, 0xb7, 0xb9, 0x91, 0xcd, 0x64, 0x37, 0xaf, 0x8f, 0xf4, 0xc0, 0x69, 0x30,
+	0x11, 0x8a, 0x5a, 0xdb, 0x28, 0x64, 0xdf, 0xdb, 0x1c, 0x91, 0xe0, 0x59, 0x30, 0x15, 0x0a, 0xc8,
+	0x6e, 0x71, 0x48, 0xaf, 0x1c, 0x7b, 0xf0, 0x6d, 0xb2, 0x67, 0xf9, 0xe7, 0x21, 0xd0, 0x4f, 0x3d,
+	0x83, 0x9f, 0x80, 0x81, 0x02, 0x7f, 0x17, 0xa5, 0x42, 0xdd, 0x08, 0x79, 0x27, 0xca, 0x17, 0x22,
+	0x20, 0x59, 0xac, 0x94, 0xe9, 0x4f, 0x7f, 0xff, 0xe7, 0x61, 0xef, 0x38, 0x3c, 0x13, 0xfe, 0x20,
+	0xc5, 0xf0, 0x81, 0x04, 0xe2, 0x4c, 0x09, 0xce, 0x76, 0xdb, 0x56, 0xd8, 0x4f, 0x75, 0x07, 0x72,
+	0xf3, 0xf3, 0xd4, 0xfc, 0xab, 0xf0, 0xdc, 0x4b, 0xcc, 0xab, 0x07, 0xfe, 0xd5, 0x72, 0x1f, 0x7e,
+	0x29, 0x81, 0x84, 0x78, 0xc8, 0xc0, 0x0e, 0x3e, 0xb6, 0x3d, 0xb5, 0xe4, 0xb9, 0x28, 0x50, 0x4e,
+	0xe8, 0x22, 0x25, 0x94, 0x86, 0xaf, 0x47, 0x20, 0xa4, 0xfa, 0x6f, 0xa2, 0x47, 0x12, 0x18, 0xe0,
+	0x5b, 0x75, 0x4a, 0x53, 0xf0, 0xa5, 0x23, 0x5f, 0x88, 0x80, 0xe4, 0xb4, 0xae, 0x51, 0x5a, 0x57,
+	0xe0, 0xe5, 0xc3, 0xd0, 0x52, 0x0f, 0xfc, 0xa7, 0xd1, 0x7d, 0xf8, 0x50, 0x02, 0x03, 0x62, 0xd2,
+	0xea, 0x40, 0x31, 0xf8, 0xc6, 0x91, 0x2f, 0x44, 0x40, 0x72, 0x8a, 0x2b, 0x94, 0xe2, 0x02, 0x9c,
+	0x8f, 0x42, 0x51, 0x34, 0xd1, 0x6f, 0x24, 0x10, 0x67, 0x1b, 0x75, 0x3a, 0x5d, 0x81, 0xb7, 0x81,
+	0x9c, 0xea, 0x0e, 0xe4, 0x94, 0xde, 0xa6, 0x94, 0x2e, 0xc3, 0xd5, 0x43, 0x50, 0x52, 0x0f, 0xc4,
+	0x7c, 0x71, 0x1f, 0xfe, 0x24, 0x81, 0xe1, 0xb6, 0xf9, 0x1d, 0x2e, 0x76, 0x3b, 0xdb, 0xed, 0xef,
+	0x04, 0x79, 0xe9, 0x10, 0x1a, 0x9c, 0xf8, 0x2a, 0x25, 0xbe, 0x08, 0xd3, 0x51, 0x88, 0x93, 0x67,
+	0x84, 0xcb, 0xc8, 0xfd, 0xe8, 0x13, 0xf6, 0xc7, 0xc1, 0xee, 0x84, 0xdb, 0xa7, 0x4e, 0x79, 0xe9,
+	0x10, 0x1a, 0x9c, 0xf0, 0x25, 0x4a, 0x58, 0x85, 0x0b, 0x51, 0x08, 0xfb, 0x93, 0x25, 0x7c, 0x2c,
+	0x81, 0xe1, 0xb6, 0xc9, 0xb0, 0x13, 0xdf, 0xf0, 0x01, 0x54, 0x5e, 0x3a, 0x84, 0x06, 0xe7, 0x7b,
+	0x95, 0xf2, 0x5d, 0x85, 0x17, 0xa3, 0x9f, 0x0c, 0xdd, 0xf5, 0x29, 0x7e, 0x25, 0x81, 0xa1, 0xe0,
+	0x48, 0x01, 0xd5, 0x97, 0x73, 0x08, 0x9d, 0x0f, 0xe5, 0xc5, 0xe8, 0x0a, 0x9c, 0xf3, 0x39, 0xca,
+	0x79, 0xea, 0x8a, 0x34, 0xa7, 0x8c, 0xb5, 0xd3, 0xc6, 0x5c, 0x85, 0xb4, 0xeb, 0x41, 0xff, 0x1e,
+	0x85, 0x1d, 0x3a, 0x5f, 0xfb, 0x0d, 0x2e, 0xcf, 0x47, 0xc2, 0x72, 0x2e, 0x0a, 0xe5, 0x32, 0x09,
+	0xe5, 0x17, 0xe2, 0xe7, 0x5f, 0xf5, 0xf0, 0x80, 0x94, 0x36, 0xb9, 0x55, 0x3b, 0x97, 0x76, 0xcb,
+	0x85, 0x2e, 0xa7, 0xba, 0x03, 0x39, 0x81, 0x24, 0x25, 0x30, 0x06, 0x4f, 0x87, 0xff, 0x2f, 0x76,
+	0x3d, 0xfb, 0xe4, 0x59, 0x52, 0x7a, 0xfa, 0x2c, 0x29, 0xfd, 0xfd, 0x2c, 0x29, 0x7d, 0xfe, 0x3c,
+	0xd9, 0xf3, 0xf4, 0x79, 0xb2, 0xe7, 0x8f, 0xe7, 0xc9, 0x9e, 0xf7, 0xd5, 0x96, 0xd9, 0x87, 0x58,
+	0xa3, 0xb3, 0x71, 0xc9, 0xae, 0xa9, 0xa5, 0x1d, 0xc3, 0xb4, 0xd4, 0xdd, 0x15, 0xf5, 0x23, 0xb1,
+	0x27, 0x1d, 0x84, 0x8a, 0x71, 0x8a, 0x58, 0xf9, 0x77, 0x00, 0xc5, 0x03, 0x27, 0xa8, 0x07, 0x17,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Tunnels is a RPC method that returns all tunnels.
+	Tunnels(ctx context.Context, in *QueryTunnelsRequest, opts ...grpc.CallOption) (*QueryTunnelsResponse, error)
+	// Tunnel is a RPC method that returns a tunnel by its ID.
+	Tunnel(ctx context.Context, in *QueryTunnelRequest, opts ...grpc.CallOption) (*QueryTunnelResponse, error)
+	// Deposits queries all deposits of a single tunnel.
+	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
+	// Deposit queries single deposit information based tunnelID, depositAddr.
+	Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error)
+	// Packets is a RPC method that returns all packets of a tunnel.
+	Packets(ctx context.Context, in *QueryPacketsRequest, opts ...grpc.CallOption) (*QueryPacketsResponse, error)
+	// Packet is a RPC method that returns a packet by its tunnel ID and sequence.
+	Packet(ctx context.Context, in *QueryPacketRequest, opts ...grpc.CallOption) (*QueryPacketResponse, error)
+	// TunnelFeeRunway is a RPC method that estimates the number of packets that the fee payer of a tunnel
+	// can still pay for at the current fee rates.
+	TunnelFeeRunway(ctx context.Context, in *QueryTunnelFeeRunwayRequest, opts ...grpc.CallOption) (*QueryTunnelFeeRunwayResponse, error)
+	// TunnelOperators is a RPC method that returns all operators of a tunnel.
+	TunnelOperators(ctx context.Context, in *QueryTunnelOperatorsRequest, opts ...grpc.CallOption) (*QueryTunnelOperatorsResponse, error)
+	// PacketRetention is a RPC method that returns the range of packet sequences of a tunnel that are
+	// still kept in the state.
+	PacketRetention(ctx context.Context, in *QueryPacketRetentionRequest, opts ...grpc.CallOption) (*QueryPacketRetentionResponse, error)
+	// SimulateTunnel is a RPC method that replays a proposed tunnel configuration against a price
+	// history and returns the packets that the tunnel would have produced along with their fees.
+	SimulateTunnel(ctx context.Context, in *QuerySimulateTunnelRequest, opts ...grpc.CallOption) (*QuerySimulateTunnelResponse, error)
+	// TotalFees is a RPC method that returns the total fees collected by the tunnel
+	TotalFees(ctx context.Context, in *QueryTotalFeesRequest, opts ...grpc.CallOption) (*QueryTotalFeesResponse, error)
+	// Params is a RPC method that returns all parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Tunnels(ctx context.Context, in *QueryTunnelsRequest, opts ...grpc.CallOption) (*QueryTunnelsResponse, error) {
+	out := new(QueryTunnelsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Tunnels", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Tunnel(ctx context.Context, in *QueryTunnelRequest, opts ...grpc.CallOption) (*QueryTunnelResponse, error) {
+	out := new(QueryTunnelResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Tunnel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Deposits) > 0 {
-		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
+	out := new(QueryDepositsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Deposits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryDepositRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error) {
+	out := new(QueryDepositResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Deposit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryDepositRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Packets(ctx context.Context, in *QueryPacketsRequest, opts ...grpc.CallOption) (*QueryPacketsResponse, error) {
+	out := new(QueryPacketsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Packets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) Packet(ctx context.Context, in *QueryPacketRequest, opts ...grpc.CallOption) (*QueryPacketResponse, error) {
+	out := new(QueryPacketResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Packet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.TunnelId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *queryClient) TunnelFeeRunway(ctx context.Context, in *QueryTunnelFeeRunwayRequest, opts ...grpc.CallOption) (*QueryTunnelFeeRunwayResponse, error) {
+	out := new(QueryTunnelFeeRunwayResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/TunnelFeeRunway", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TunnelOperators(ctx context.Context, in *QueryTunnelOperatorsRequest, opts ...grpc.CallOption) (*QueryTunnelOperatorsResponse, error) {
+	out := new(QueryTunnelOperatorsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/TunnelOperators", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PacketRetention(ctx context.Context, in *QueryPacketRetentionRequest, opts ...grpc.CallOption) (*QueryPacketRetentionResponse, error) {
+	out := new(QueryPacketRetentionResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/PacketRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) SimulateTunnel(ctx context.Context, in *QuerySimulateTunnelRequest, opts ...grpc.CallOption) (*QuerySimulateTunnelResponse, error) {
+	out := new(QuerySimulateTunnelResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/SimulateTunnel", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPacketsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TotalFees(ctx context.Context, in *QueryTotalFeesRequest, opts ...grpc.CallOption) (*QueryTotalFeesResponse, error) {
+	out := new(QueryTotalFeesResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/TotalFees", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TunnelId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryPacketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Tunnels is a RPC method that returns all tunnels.
+	Tunnels(context.Context, *QueryTunnelsRequest) (*QueryTunnelsResponse, error)
+	// Tunnel is a RPC method that returns a tunnel by its ID.
+	Tunnel(context.Context, *QueryTunnelRequest) (*QueryTunnelResponse, error)
+	// Deposits queries all deposits of a single tunnel.
+	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
+	// Deposit queries single deposit information based tunnelID, depositAddr.
+	Deposit(context.Context, *QueryDepositRequest) (*QueryDepositResponse, error)
+	// Packets is a RPC method that returns all packets of a tunnel.
+	Packets(context.Context, *QueryPacketsRequest) (*QueryPacketsResponse, error)
+	// Packet is a RPC method that returns a packet by its tunnel ID and sequence.
+	Packet(context.Context, *QueryPacketRequest) (*QueryPacketResponse, error)
+	// TunnelFeeRunway is a RPC method that estimates the number of packets that the fee payer of a tunnel
+	// can still pay for at the current fee rates.
+	TunnelFeeRunway(context.Context, *QueryTunnelFeeRunwayRequest) (*QueryTunnelFeeRunwayResponse, error)
+	// TunnelOperators is a RPC method that returns all operators of a tunnel.
+	TunnelOperators(context.Context, *QueryTunnelOperatorsRequest) (*QueryTunnelOperatorsResponse, error)
+	// PacketRetention is a RPC method that returns the range of packet sequences of a tunnel that are
+	// still kept in the state.
+	PacketRetention(context.Context, *QueryPacketRetentionRequest) (*QueryPacketRetentionResponse, error)
+	// SimulateTunnel is a RPC method that replays a proposed tunnel configuration against a price
+	// history and returns the packets that the tunnel would have produced along with their fees.
+	SimulateTunnel(context.Context, *QuerySimulateTunnelRequest) (*QuerySimulateTunnelResponse, error)
+	// TotalFees is a RPC method that returns the total fees collected by the tunnel
+	TotalFees(context.Context, *QueryTotalFeesRequest) (*QueryTotalFeesResponse, error)
+	// Params is a RPC method that returns all parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
-func (m *QueryPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Packets) > 0 {
-		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryPacketRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Tunnels(ctx context.Context, req *QueryTunnelsRequest) (*QueryTunnelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tunnels not implemented")
+}
+func (*UnimplementedQueryServer) Tunnel(ctx context.Context, req *QueryTunnelRequest) (*QueryTunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tunnel not implemented")
+}
+func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
+}
+func (*UnimplementedQueryServer) Deposit(ctx context.Context, req *QueryDepositRequest) (*QueryDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (*UnimplementedQueryServer) Packets(ctx context.Context, req *QueryPacketsRequest) (*QueryPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Packets not implemented")
+}
+func (*UnimplementedQueryServer) Packet(ctx context.Context, req *QueryPacketRequest) (*QueryPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Packet not implemented")
+}
+func (*UnimplementedQueryServer) TunnelFeeRunway(ctx context.Context, req *QueryTunnelFeeRunwayRequest) (*QueryTunnelFeeRunwayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TunnelFeeRunway not implemented")
+}
+func (*UnimplementedQueryServer) TunnelOperators(ctx context.Context, req *QueryTunnelOperatorsRequest) (*QueryTunnelOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TunnelOperators not implemented")
+}
+func (*UnimplementedQueryServer) PacketRetention(ctx context.Context, req *QueryPacketRetentionRequest) (*QueryPacketRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PacketRetention not implemented")
+}
+func (*UnimplementedQueryServer) SimulateTunnel(ctx context.Context, req *QuerySimulateTunnelRequest) (*QuerySimulateTunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTunnel not implemented")
+}
+func (*UnimplementedQueryServer) TotalFees(ctx context.Context, req *QueryTotalFeesRequest) (*QueryTotalFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalFees not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
 
-func (m *QueryPacketRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x10
+func _Query_Tunnels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTunnelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TunnelId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).Tunnels(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Tunnels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tunnels(ctx, req.(*QueryTunnelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPacketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Tunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTunnelRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Tunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Tunnel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tunnel(ctx, req.(*QueryTunnelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPacketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Deposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Deposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Deposits(ctx, req.(*QueryDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Packet != nil {
-		{
-			size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Deposit(ctx, req.(*QueryDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalFeesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Packets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Packets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Packets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Packets(ctx, req.(*QueryPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalFeesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Packet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Packet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Packet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Packet(ctx, req.(*QueryPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func _Query_TunnelFeeRunway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTunnelFeeRunwayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TunnelFeeRunway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/TunnelFeeRunway",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TunnelFeeRunway(ctx, req.(*QueryTunnelFeeRunwayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TunnelOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTunnelOperatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TunnelOperators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/TunnelOperators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TunnelOperators(ctx, req.(*QueryTunnelOperatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PacketRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PacketRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/PacketRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PacketRetention(ctx, req.(*QueryPacketRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateTunnelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateTunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/SimulateTunnel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateTunnel(ctx, req.(*QuerySimulateTunnelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/TotalFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalFees(ctx, req.(*QueryTotalFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "band.tunnel.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Tunnels",
+			Handler:    _Query_Tunnels_Handler,
+		},
+		{
+			MethodName: "Tunnel",
+			Handler:    _Query_Tunnel_Handler,
+		},
+		{
+			MethodName: "Deposits",
+			Handler:    _Query_Deposits_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _Query_Deposit_Handler,
+		},
+		{
+			MethodName: "Packets",
+			Handler:    _Query_Packets_Handler,
+		},
+		{
+			MethodName: "Packet",
+			Handler:    _Query_Packet_Handler,
+		},
+		{
+			MethodName: "TunnelFeeRunway",
+			Handler:    _Query_TunnelFeeRunway_Handler,
+		},
+		{
+			MethodName: "TunnelOperators",
+			Handler:    _Query_TunnelOperators_Handler,
+		},
+		{
+			MethodName: "PacketRetention",
+			Handler:    _Query_PacketRetention_Handler,
+		},
+		{
+			MethodName: "SimulateTunnel",
+			Handler:    _Query_SimulateTunnel_Handler,
+		},
+		{
+			MethodName: "TotalFees",
+			Handler:    _Query_TotalFees_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "band/tunnel/v1beta1/query.proto",
+}
+
+func (m *QueryTunnelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTunnelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTunnelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StatusFilter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StatusFilter))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalFeesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTunnelsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1782,30 +2121,46 @@ func (m *QueryTotalFeesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTunnelsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTunnelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.TotalFees.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tunnels) > 0 {
+		for iNdEx := len(m.Tunnels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tunnels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTunnelRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1815,20 +2170,25 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTunnelRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTunnelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTunnelResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1838,18 +2198,18 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1861,247 +2221,2091 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryTunnelsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StatusFilter != 0 {
-		n += 1 + sovQuery(uint64(m.StatusFilter))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDepositsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTunnelsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Tunnels) > 0 {
-		for _, e := range m.Tunnels {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTunnelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TunnelId != 0 {
-		n += 1 + sovQuery(uint64(m.TunnelId))
+func (m *QueryDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTunnelResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Tunnel.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDepositsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TunnelId != 0 {
-		n += 1 + sovQuery(uint64(m.TunnelId))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryDepositsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
 	if len(m.Deposits) > 0 {
-		for _, e := range m.Deposits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDepositRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TunnelId != 0 {
-		n += 1 + sovQuery(uint64(m.TunnelId))
-	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Deposit.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPacketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TunnelId != 0 {
-		n += 1 + sovQuery(uint64(m.TunnelId))
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPacketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Packets) > 0 {
-		for _, e := range m.Packets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPacketRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TunnelId != 0 {
-		n += 1 + sovQuery(uint64(m.TunnelId))
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	if m.StatusFilter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StatusFilter))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPacketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPacketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Packet != nil {
-		l = m.Packet.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTunnelFeeRunwayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTunnelFeeRunwayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTunnelFeeRunwayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTunnelFeeRunwayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTunnelFeeRunwayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTunnelFeeRunwayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TotalFees.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.RemainingPacketsWithTopUp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingPacketsWithTopUp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TopUpAllowance) > 0 {
+		for iNdEx := len(m.TopUpAllowance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopUpAllowance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.TopUp != nil {
+		{
+			size, err := m.TopUp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RemainingPackets != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingPackets))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PacketFee) > 0 {
+		for iNdEx := len(m.PacketFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FeePayerBalance) > 0 {
+		for iNdEx := len(m.FeePayerBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeePayerBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTunnelOperatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTunnelOperatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTunnelOperatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTunnelOperatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTunnelOperatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTunnelOperatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operators) > 0 {
+		for iNdEx := len(m.Operators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPacketRetentionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketRetentionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketRetentionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPacketRetentionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketRetentionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketRetentionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LatestSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatestSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldestSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OldestSequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateTunnelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateTunnelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateTunnelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PriceSnapshots) > 0 {
+		for iNdEx := len(m.PriceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Route != nil {
+		{
+			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Interval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalDeviations) > 0 {
+		for iNdEx := len(m.SignalDeviations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalDeviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateTunnelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalRouteFee) > 0 {
+		for iNdEx := len(m.TotalRouteFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRouteFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TotalBasePacketFee) > 0 {
+		for iNdEx := len(m.TotalBasePacketFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalBasePacketFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTunnelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StatusFilter != 0 {
+		n += 1 + sovQuery(uint64(m.StatusFilter))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTunnelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tunnels) > 0 {
+		for _, e := range m.Tunnels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTunnelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	return n
+}
+
+func (m *QueryTunnelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tunnel.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Deposit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StatusFilter != 0 {
+		n += 1 + sovQuery(uint64(m.StatusFilter))
+	}
+	return n
+}
+
+func (m *QueryPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		l = m.Packet.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTunnelFeeRunwayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	return n
+}
+
+func (m *QueryTunnelFeeRunwayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeePayerBalance) > 0 {
+		for _, e := range m.FeePayerBalance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PacketFee) > 0 {
+		for _, e := range m.PacketFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.RemainingPackets != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingPackets))
+	}
+	if m.TopUp != nil {
+		l = m.TopUp.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.TopUpAllowance) > 0 {
+		for _, e := range m.TopUpAllowance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.RemainingPacketsWithTopUp != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingPacketsWithTopUp))
+	}
+	return n
+}
+
+func (m *QueryTunnelOperatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	return n
+}
+
+func (m *QueryTunnelOperatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operators) > 0 {
+		for _, e := range m.Operators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPacketRetentionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	return n
+}
+
+func (m *QueryPacketRetentionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldestSequence != 0 {
+		n += 1 + sovQuery(uint64(m.OldestSequence))
+	}
+	if m.LatestSequence != 0 {
+		n += 1 + sovQuery(uint64(m.LatestSequence))
+	}
+	return n
+}
+
+func (m *PriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateTunnelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignalDeviations) > 0 {
+		for _, e := range m.SignalDeviations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Interval != 0 {
+		n += 1 + sovQuery(uint64(m.Interval))
+	}
+	if m.Route != nil {
+		l = m.Route.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PriceSnapshots) > 0 {
+		for _, e := range m.PriceSnapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *QuerySimulateTunnelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalBasePacketFee) > 0 {
+		for _, e := range m.TotalBasePacketFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalRouteFee) > 0 {
+		for _, e := range m.TotalRouteFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTunnelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTunnelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTunnelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusFilter", wireType)
+			}
+			m.StatusFilter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatusFilter |= TunnelStatusFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTunnelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTunnelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTunnelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tunnels = append(m.Tunnels, &Tunnel{})
+			if err := m.Tunnels[len(m.Tunnels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTunnelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTunnelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTunnelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+			}
+			m.TunnelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTunnelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTunnelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+			}
+			m.TunnelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, &Deposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+			}
+			m.TunnelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryTunnelsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPacketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2124,17 +4328,17 @@ func (m *QueryTunnelsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTunnelsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPacketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTunnelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
 			}
-			m.StatusFilter = 0
+			m.TunnelId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2144,7 +4348,7 @@ func (m *QueryTunnelsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StatusFilter |= TunnelStatusFilter(b&0x7F) << shift
+				m.TunnelId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2185,6 +4389,25 @@ func (m *QueryTunnelsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusFilter", wireType)
+			}
+			m.StatusFilter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatusFilter |= PacketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2206,7 +4429,7 @@ func (m *QueryTunnelsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTunnelsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPacketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2229,15 +4452,49 @@ func (m *QueryTunnelsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTunnelsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPacketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTunnelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tunnels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, &Packet{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2261,17 +4518,157 @@ func (m *QueryTunnelsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+			}
+			m.TunnelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tunnels = append(m.Tunnels, &Tunnel{})
-			if err := m.Tunnels[len(m.Tunnels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2298,10 +4695,10 @@ func (m *QueryTunnelsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Packet == nil {
+				m.Packet = &Packet{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2326,7 +4723,7 @@ func (m *QueryTunnelsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTunnelRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTunnelFeeRunwayRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2349,10 +4746,10 @@ func (m *QueryTunnelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTunnelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTunnelFeeRunwayRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTunnelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTunnelFeeRunwayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2395,7 +4792,7 @@ func (m *QueryTunnelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTunnelResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTunnelFeeRunwayResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2418,15 +4815,15 @@ func (m *QueryTunnelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTunnelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTunnelFeeRunwayResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTunnelFeeRunwayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayerBalance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2453,65 +4850,50 @@ func (m *QueryTunnelResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeePayerBalance = append(m.FeePayerBalance, types.Coin{})
+			if err := m.FeePayerBalance[len(m.FeePayerBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFee", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PacketFee = append(m.PacketFee, types.Coin{})
+			if err := m.PacketFee[len(m.PacketFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingPackets", wireType)
 			}
-			m.TunnelId = 0
+			m.RemainingPackets = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2521,14 +4903,14 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TunnelId |= uint64(b&0x7F) << shift
+				m.RemainingPackets |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2555,13 +4937,66 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.TopUp == nil {
+				m.TopUp = &FeePayerTopUp{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TopUp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpAllowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopUpAllowance = append(m.TopUpAllowance, types.Coin{})
+			if err := m.TopUpAllowance[len(m.TopUpAllowance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingPacketsWithTopUp", wireType)
+			}
+			m.RemainingPacketsWithTopUp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingPacketsWithTopUp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2583,7 +5018,7 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTunnelOperatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2606,17 +5041,17 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTunnelOperatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTunnelOperatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
 			}
-			var msglen int
+			m.TunnelId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2626,29 +5061,64 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TunnelId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposits = append(m.Deposits, &Deposit{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTunnelOperatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTunnelOperatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTunnelOperatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2675,10 +5145,8 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Operators = append(m.Operators, TunnelOperator{})
+			if err := m.Operators[len(m.Operators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2703,7 +5171,7 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPacketRetentionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2726,10 +5194,10 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPacketRetentionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPacketRetentionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2751,38 +5219,6 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2804,7 +5240,7 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPacketRetentionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2827,17 +5263,17 @@ func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPacketRetentionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPacketRetentionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestSequence", wireType)
 			}
-			var msglen int
+			m.OldestSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2847,25 +5283,30 @@ func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OldestSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestSequence", wireType)
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LatestSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2887,7 +5328,7 @@ func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPacketsRequest) Unmarshal(dAtA []byte) error {
+func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2910,17 +5351,17 @@ func (m *QueryPacketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPacketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.TunnelId = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2930,14 +5371,14 @@ func (m *QueryPacketsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TunnelId |= uint64(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2964,10 +5405,8 @@ func (m *QueryPacketsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prices = append(m.Prices, types1.Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2992,7 +5431,7 @@ func (m *QueryPacketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPacketsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateTunnelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3015,15 +5454,15 @@ func (m *QueryPacketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPacketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateTunnelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateTunnelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalDeviations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3050,14 +5489,33 @@ func (m *QueryPacketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Packets = append(m.Packets, &Packet{})
-			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SignalDeviations = append(m.SignalDeviations, SignalDeviation{})
+			if err := m.SignalDeviations[len(m.SignalDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3084,68 +5542,88 @@ func (m *QueryPacketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Route == nil {
+				m.Route = &types2.Any{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshots", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPacketRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.PriceSnapshots = append(m.PriceSnapshots, PriceSnapshot{})
+			if err := m.PriceSnapshots[len(m.PriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Schedule == nil {
+				m.Schedule = &Schedule{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPacketRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			m.TunnelId = 0
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3155,16 +5633,16 @@ func (m *QueryPacketRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TunnelId |= uint64(b&0x7F) << shift
+				m.StartTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			m.Sequence = 0
+			m.EndTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3174,7 +5652,7 @@ func (m *QueryPacketRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.EndTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3200,7 +5678,7 @@ func (m *QueryPacketRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPacketResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateTunnelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3223,15 +5701,15 @@ func (m *QueryPacketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPacketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateTunnelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3258,10 +5736,76 @@ func (m *QueryPacketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Packet == nil {
-				m.Packet = &Packet{}
+			m.Packets = append(m.Packets, Packet{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBasePacketFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBasePacketFee = append(m.TotalBasePacketFee, types.Coin{})
+			if err := m.TotalBasePacketFee[len(m.TotalBasePacketFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRouteFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalRouteFee = append(m.TotalRouteFee, types.Coin{})
+			if err := m.TotalRouteFee[len(m.TotalRouteFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
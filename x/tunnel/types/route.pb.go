@@ -4,10 +4,12 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	github_com_bandprotocol_chain_v3_x_bandtss_types "github.com/bandprotocol/chain/v3/x/bandtss/types"
 	types "github.com/bandprotocol/chain/v3/x/feeds/types"
 	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -26,6 +28,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PacketStatus defines the delivery status of a packet sent through an IBC channel.
+type PacketStatus int32
+
+const (
+	// PACKET_STATUS_UNSPECIFIED defines an unspecified status.
+	PACKET_STATUS_UNSPECIFIED PacketStatus = 0
+	// PACKET_STATUS_PENDING defines a packet that is sent but not yet acknowledged.
+	PACKET_STATUS_PENDING PacketStatus = 1
+	// PACKET_STATUS_ACKNOWLEDGED defines a packet that is acknowledged successfully.
+	PACKET_STATUS_ACKNOWLEDGED PacketStatus = 2
+	// PACKET_STATUS_ERROR_ACK defines a packet that is acknowledged with an error.
+	PACKET_STATUS_ERROR_ACK PacketStatus = 3
+	// PACKET_STATUS_TIMED_OUT defines a packet that is timed out.
+	PACKET_STATUS_TIMED_OUT PacketStatus = 4
+)
+
+var PacketStatus_name = map[int32]string{
+	0: "PACKET_STATUS_UNSPECIFIED",
+	1: "PACKET_STATUS_PENDING",
+	2: "PACKET_STATUS_ACKNOWLEDGED",
+	3: "PACKET_STATUS_ERROR_ACK",
+	4: "PACKET_STATUS_TIMED_OUT",
+}
+
+var PacketStatus_value = map[string]int32{
+	"PACKET_STATUS_UNSPECIFIED":  0,
+	"PACKET_STATUS_PENDING":      1,
+	"PACKET_STATUS_ACKNOWLEDGED": 2,
+	"PACKET_STATUS_ERROR_ACK":    3,
+	"PACKET_STATUS_TIMED_OUT":    4,
+}
+
+func (x PacketStatus) String() string {
+	return proto.EnumName(PacketStatus_name, int32(x))
+}
+
+func (PacketStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_543238289d94b7a6, []int{0}
+}
+
 // TSSRoute represents a route for TSS packets and implements the RouteI interface.
 type TSSRoute struct {
 	// destination_chain_id is the destination chain ID
@@ -34,6 +76,9 @@ type TSSRoute struct {
 	DestinationContractAddress string `protobuf:"bytes,2,opt,name=destination_contract_address,json=destinationContractAddress,proto3" json:"destination_contract_address,omitempty"`
 	// encoder is the mode of encoding packet data.
 	Encoder types.Encoder `protobuf:"varint,3,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
+	// batched is a flag to aggregate the packet with the packets of other batched tunnels targeting
+	// the same destination chain in the same block into a single signing.
+	Batched bool `protobuf:"varint,4,opt,name=batched,proto3" json:"batched,omitempty"`
 }
 
 func (m *TSSRoute) Reset()         { *m = TSSRoute{} }
@@ -90,6 +135,13 @@ func (m *TSSRoute) GetEncoder() types.Encoder {
 	return types.ENCODER_UNSPECIFIED
 }
 
+func (m *TSSRoute) GetBatched() bool {
+	if m != nil {
+		return m.Batched
+	}
+	return false
+}
+
 // TSSPacketReceipt represents a receipt for a TSS packet and implements the PacketReceiptI interface.
 type TSSPacketReceipt struct {
 	// signing_id is the signing ID
@@ -136,6 +188,98 @@ func (m *TSSPacketReceipt) GetSigningID() github_com_bandprotocol_chain_v3_x_ban
 	return 0
 }
 
+// TSSBatchPacketReceipt represents a receipt for a TSS packet that is signed as a leaf of a batch
+// and implements the PacketReceiptI interface.
+type TSSBatchPacketReceipt struct {
+	// signing_id is the signing ID of the batch; zero while the batch is not yet signed.
+	SigningID github_com_bandprotocol_chain_v3_x_bandtss_types.SigningID `protobuf:"varint,1,opt,name=signing_id,json=signingId,proto3,casttype=github.com/bandprotocol/chain/v3/x/bandtss/types.SigningID" json:"signing_id,omitempty"`
+	// merkle_root is the root of the Merkle tree of the batch
+	MerkleRoot []byte `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// leaf_index is the index of the packet in the batch
+	LeafIndex uint64 `protobuf:"varint,3,opt,name=leaf_index,json=leafIndex,proto3" json:"leaf_index,omitempty"`
+	// total is the number of packets in the batch
+	Total uint64 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	// proof is the list of sibling hashes from the leaf up to the root of the Merkle tree
+	Proof [][]byte `protobuf:"bytes,5,rep,name=proof,proto3" json:"proof,omitempty"`
+	// failure_reason is the reason why the batch could not be signed; empty unless the signing failed.
+	FailureReason string `protobuf:"bytes,6,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+}
+
+func (m *TSSBatchPacketReceipt) Reset()         { *m = TSSBatchPacketReceipt{} }
+func (m *TSSBatchPacketReceipt) String() string { return proto.CompactTextString(m) }
+func (*TSSBatchPacketReceipt) ProtoMessage()    {}
+func (*TSSBatchPacketReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_543238289d94b7a6, []int{2}
+}
+func (m *TSSBatchPacketReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TSSBatchPacketReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TSSBatchPacketReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TSSBatchPacketReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TSSBatchPacketReceipt.Merge(m, src)
+}
+func (m *TSSBatchPacketReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *TSSBatchPacketReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_TSSBatchPacketReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TSSBatchPacketReceipt proto.InternalMessageInfo
+
+func (m *TSSBatchPacketReceipt) GetSigningID() github_com_bandprotocol_chain_v3_x_bandtss_types.SigningID {
+	if m != nil {
+		return m.SigningID
+	}
+	return 0
+}
+
+func (m *TSSBatchPacketReceipt) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *TSSBatchPacketReceipt) GetLeafIndex() uint64 {
+	if m != nil {
+		return m.LeafIndex
+	}
+	return 0
+}
+
+func (m *TSSBatchPacketReceipt) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *TSSBatchPacketReceipt) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *TSSBatchPacketReceipt) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
 // IBCRoute represents a route for IBC packets and implements the RouteI interface.
 type IBCRoute struct {
 	// channel_id is the IBC channel ID
@@ -146,7 +290,7 @@ func (m *IBCRoute) Reset()         { *m = IBCRoute{} }
 func (m *IBCRoute) String() string { return proto.CompactTextString(m) }
 func (*IBCRoute) ProtoMessage()    {}
 func (*IBCRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_543238289d94b7a6, []int{2}
+	return fileDescriptor_543238289d94b7a6, []int{3}
 }
 func (m *IBCRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -186,13 +330,15 @@ func (m *IBCRoute) GetChannelID() string {
 type IBCPacketReceipt struct {
 	// sequence is representing the sequence of the IBC packet.
 	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// status is the delivery status of the IBC packet.
+	Status PacketStatus `protobuf:"varint,2,opt,name=status,proto3,enum=band.tunnel.v1beta1.PacketStatus" json:"status,omitempty"`
 }
 
 func (m *IBCPacketReceipt) Reset()         { *m = IBCPacketReceipt{} }
 func (m *IBCPacketReceipt) String() string { return proto.CompactTextString(m) }
 func (*IBCPacketReceipt) ProtoMessage()    {}
 func (*IBCPacketReceipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_543238289d94b7a6, []int{3}
+	return fileDescriptor_543238289d94b7a6, []int{4}
 }
 func (m *IBCPacketReceipt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,6 +374,160 @@ func (m *IBCPacketReceipt) GetSequence() uint64 {
 	return 0
 }
 
+func (m *IBCPacketReceipt) GetStatus() PacketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PACKET_STATUS_UNSPECIFIED
+}
+
+// RouterRoute represents a route for delivering packets to an EVM contract through an IBC general
+// message passing bridge and implements the RouteI interface.
+type RouterRoute struct {
+	// channel_id is the ICS-20 transfer channel ID to the bridge chain
+	ChannelID string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// bridge_contract_address is the address on the bridge chain that receives the transfer and its memo
+	BridgeContractAddress string `protobuf:"bytes,2,opt,name=bridge_contract_address,json=bridgeContractAddress,proto3" json:"bridge_contract_address,omitempty"`
+	// destination_chain_id is the destination chain ID
+	DestinationChainID string `protobuf:"bytes,3,opt,name=destination_chain_id,json=destinationChainId,proto3" json:"destination_chain_id,omitempty"`
+	// destination_contract_address is the destination contract address
+	DestinationContractAddress string `protobuf:"bytes,4,opt,name=destination_contract_address,json=destinationContractAddress,proto3" json:"destination_contract_address,omitempty"`
+	// destination_gas_limit is the gas limit used when calling the destination contract
+	DestinationGasLimit uint64 `protobuf:"varint,5,opt,name=destination_gas_limit,json=destinationGasLimit,proto3" json:"destination_gas_limit,omitempty"`
+	// fee is the amount transferred to the bridge to pay for delivering each packet
+	Fee types1.Coin `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee"`
+	// encoder is the mode of encoding packet data.
+	Encoder types.Encoder `protobuf:"varint,7,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
+}
+
+func (m *RouterRoute) Reset()         { *m = RouterRoute{} }
+func (m *RouterRoute) String() string { return proto.CompactTextString(m) }
+func (*RouterRoute) ProtoMessage()    {}
+func (*RouterRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_543238289d94b7a6, []int{5}
+}
+func (m *RouterRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouterRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouterRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RouterRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouterRoute.Merge(m, src)
+}
+func (m *RouterRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouterRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouterRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouterRoute proto.InternalMessageInfo
+
+func (m *RouterRoute) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *RouterRoute) GetBridgeContractAddress() string {
+	if m != nil {
+		return m.BridgeContractAddress
+	}
+	return ""
+}
+
+func (m *RouterRoute) GetDestinationChainID() string {
+	if m != nil {
+		return m.DestinationChainID
+	}
+	return ""
+}
+
+func (m *RouterRoute) GetDestinationContractAddress() string {
+	if m != nil {
+		return m.DestinationContractAddress
+	}
+	return ""
+}
+
+func (m *RouterRoute) GetDestinationGasLimit() uint64 {
+	if m != nil {
+		return m.DestinationGasLimit
+	}
+	return 0
+}
+
+func (m *RouterRoute) GetFee() types1.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types1.Coin{}
+}
+
+func (m *RouterRoute) GetEncoder() types.Encoder {
+	if m != nil {
+		return m.Encoder
+	}
+	return types.ENCODER_UNSPECIFIED
+}
+
+// RouterPacketReceipt represents a receipt for a Router packet and implements the PacketReceiptI interface.
+type RouterPacketReceipt struct {
+	// sequence is representing the sequence of the ICS-20 transfer packet sent to the bridge.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *RouterPacketReceipt) Reset()         { *m = RouterPacketReceipt{} }
+func (m *RouterPacketReceipt) String() string { return proto.CompactTextString(m) }
+func (*RouterPacketReceipt) ProtoMessage()    {}
+func (*RouterPacketReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_543238289d94b7a6, []int{6}
+}
+func (m *RouterPacketReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouterPacketReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouterPacketReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RouterPacketReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouterPacketReceipt.Merge(m, src)
+}
+func (m *RouterPacketReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouterPacketReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouterPacketReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouterPacketReceipt proto.InternalMessageInfo
+
+func (m *RouterPacketReceipt) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 // TunnelPricesPacketData represents the IBC packet payload for the tunnel packet.
 type TunnelPricesPacketData struct {
 	// tunnel_id is the tunnel ID
@@ -244,7 +544,7 @@ func (m *TunnelPricesPacketData) Reset()         { *m = TunnelPricesPacketData{}
 func (m *TunnelPricesPacketData) String() string { return proto.CompactTextString(m) }
 func (*TunnelPricesPacketData) ProtoMessage()    {}
 func (*TunnelPricesPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_543238289d94b7a6, []int{4}
+	return fileDescriptor_543238289d94b7a6, []int{7}
 }
 func (m *TunnelPricesPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,51 +602,78 @@ func (m *TunnelPricesPacketData) GetCreatedAt() int64 {
 }
 
 func init() {
+	proto.RegisterEnum("band.tunnel.v1beta1.PacketStatus", PacketStatus_name, PacketStatus_value)
 	proto.RegisterType((*TSSRoute)(nil), "band.tunnel.v1beta1.TSSRoute")
 	proto.RegisterType((*TSSPacketReceipt)(nil), "band.tunnel.v1beta1.TSSPacketReceipt")
+	proto.RegisterType((*TSSBatchPacketReceipt)(nil), "band.tunnel.v1beta1.TSSBatchPacketReceipt")
 	proto.RegisterType((*IBCRoute)(nil), "band.tunnel.v1beta1.IBCRoute")
 	proto.RegisterType((*IBCPacketReceipt)(nil), "band.tunnel.v1beta1.IBCPacketReceipt")
+	proto.RegisterType((*RouterRoute)(nil), "band.tunnel.v1beta1.RouterRoute")
+	proto.RegisterType((*RouterPacketReceipt)(nil), "band.tunnel.v1beta1.RouterPacketReceipt")
 	proto.RegisterType((*TunnelPricesPacketData)(nil), "band.tunnel.v1beta1.TunnelPricesPacketData")
 }
 
 func init() { proto.RegisterFile("band/tunnel/v1beta1/route.proto", fileDescriptor_543238289d94b7a6) }
 
 var fileDescriptor_543238289d94b7a6 = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0xeb, 0xb5, 0x2a, 0xad, 0x81, 0x69, 0x32, 0xd3, 0xd4, 0x15, 0x48, 0xaa, 0x9e, 0x8a,
-	0xc4, 0x12, 0x6d, 0x13, 0x42, 0xea, 0x89, 0xa5, 0x41, 0x22, 0x42, 0x48, 0x53, 0xda, 0x13, 0x97,
-	0xca, 0xb5, 0x4d, 0x1b, 0xd8, 0xec, 0x12, 0xbb, 0x13, 0xbc, 0x05, 0xe2, 0x29, 0x78, 0x80, 0x49,
-	0xbc, 0xc2, 0xb4, 0xd3, 0x8e, 0x70, 0x89, 0x50, 0xfa, 0x16, 0x9c, 0x50, 0x6c, 0xaf, 0x25, 0xa8,
-	0x07, 0x6e, 0xf5, 0xff, 0xfb, 0xe5, 0xfb, 0x7f, 0xff, 0xcf, 0x2e, 0x74, 0x27, 0x98, 0x53, 0x5f,
-	0x2d, 0x38, 0x67, 0x67, 0xfe, 0xc5, 0xe1, 0x84, 0x29, 0x7c, 0xe8, 0xa7, 0x62, 0xa1, 0x98, 0x37,
-	0x4f, 0x85, 0x12, 0xe8, 0x41, 0x01, 0x78, 0x06, 0xf0, 0x2c, 0xd0, 0xde, 0x27, 0x42, 0x9e, 0x0b,
-	0x39, 0xd6, 0x88, 0x6f, 0x0e, 0x86, 0x6f, 0xef, 0x4e, 0xc5, 0x54, 0x18, 0xbd, 0xf8, 0x65, 0xd5,
-	0x8e, 0xb6, 0x79, 0xc7, 0x18, 0x95, 0x2b, 0x17, 0xc6, 0x89, 0xa0, 0x2c, 0xb5, 0x84, 0xb3, 0x81,
-	0xd0, 0x27, 0x53, 0xef, 0xfe, 0x04, 0xb0, 0x31, 0x1a, 0x0e, 0xe3, 0x62, 0x34, 0xf4, 0x0a, 0xee,
-	0x52, 0x26, 0x55, 0xc2, 0xb1, 0x4a, 0x04, 0x1f, 0x93, 0x19, 0x4e, 0xf8, 0x38, 0xa1, 0x2d, 0xd0,
-	0x01, 0xbd, 0x66, 0xb0, 0x97, 0x67, 0x2e, 0x0a, 0xd7, 0xf5, 0x41, 0x51, 0x8e, 0xc2, 0x18, 0xd1,
-	0x7f, 0x35, 0x8a, 0x5e, 0xc0, 0x47, 0xa5, 0x4e, 0x82, 0xab, 0x14, 0x13, 0x35, 0xc6, 0x94, 0xa6,
-	0x4c, 0xca, 0xd6, 0x56, 0xd1, 0x31, 0x6e, 0xff, 0xfd, 0xa5, 0x45, 0x4e, 0x0c, 0x81, 0x9e, 0xc1,
-	0x3b, 0x36, 0x49, 0xab, 0xda, 0x01, 0xbd, 0xed, 0xa3, 0x87, 0x9e, 0x5e, 0x99, 0x19, 0xde, 0x46,
-	0xf1, 0x5e, 0x1a, 0x24, 0xbe, 0x65, 0xfb, 0xf0, 0xfa, 0xf2, 0xa0, 0xae, 0xd3, 0x44, 0xdd, 0xaf,
-	0x00, 0xee, 0x8c, 0x86, 0xc3, 0x53, 0x4c, 0x3e, 0x30, 0x15, 0x33, 0xc2, 0x92, 0xb9, 0x42, 0xef,
-	0x21, 0x94, 0xc9, 0x94, 0x27, 0x7c, 0x7a, 0x9b, 0xac, 0x16, 0xbc, 0xce, 0x33, 0xb7, 0x39, 0x34,
-	0x6a, 0x14, 0xfe, 0xce, 0xdc, 0xfe, 0x34, 0x51, 0xb3, 0xc5, 0xc4, 0x23, 0xe2, 0xdc, 0x2f, 0x5c,
-	0xf5, 0xae, 0x88, 0x38, 0xf3, 0xf5, 0x4a, 0xfc, 0x8b, 0x63, 0xff, 0x93, 0xd6, 0x95, 0x94, 0xbe,
-	0xfa, 0x3c, 0x67, 0xd2, 0x5b, 0x7d, 0x1d, 0x37, 0x6d, 0xfb, 0x88, 0xf6, 0xd1, 0xf5, 0xe5, 0xc1,
-	0x76, 0xc9, 0x3e, 0xea, 0x86, 0xb0, 0x11, 0x05, 0x03, 0xb3, 0xef, 0xa7, 0x10, 0x92, 0x19, 0x2e,
-	0x9e, 0xc0, 0x7a, 0xcb, 0xf7, 0x8b, 0x59, 0x06, 0x46, 0x2d, 0xba, 0x59, 0x20, 0xa2, 0xa5, 0x68,
-	0x01, 0xdc, 0x89, 0x82, 0x41, 0x39, 0x59, 0x1b, 0x36, 0x24, 0xfb, 0xb8, 0x60, 0x9c, 0x30, 0x93,
-	0x2b, 0x5e, 0x9d, 0x37, 0x4e, 0xf2, 0x1d, 0xc0, 0xbd, 0x91, 0x7e, 0x80, 0xa7, 0x69, 0x42, 0x98,
-	0x34, 0xe5, 0x10, 0x2b, 0x8c, 0x9e, 0xc0, 0xa6, 0x79, 0x9a, 0xeb, 0x1d, 0xdd, 0xcb, 0x33, 0xb7,
-	0x61, 0xf0, 0x28, 0x8c, 0x1b, 0xa6, 0x1c, 0xd1, 0x92, 0xeb, 0x56, 0xd9, 0x15, 0x3d, 0x87, 0xf5,
-	0xb9, 0x6e, 0xdd, 0xaa, 0x76, 0xaa, 0xbd, 0xbb, 0x47, 0xfb, 0x9b, 0xae, 0x50, 0x9b, 0x07, 0xb5,
-	0xab, 0xcc, 0xad, 0xc4, 0x16, 0x47, 0x8f, 0x21, 0x24, 0x29, 0xc3, 0x8a, 0xd1, 0x31, 0x56, 0xad,
-	0x5a, 0x07, 0xf4, 0xaa, 0x71, 0xd3, 0x2a, 0x27, 0x2a, 0x78, 0xf3, 0x2d, 0x77, 0xc0, 0x55, 0xee,
-	0x80, 0x9b, 0xdc, 0x01, 0xbf, 0x72, 0x07, 0x7c, 0x59, 0x3a, 0x95, 0x9b, 0xa5, 0x53, 0xf9, 0xb1,
-	0x74, 0x2a, 0x6f, 0xfd, 0xff, 0xb8, 0x3c, 0xfb, 0xcf, 0xd4, 0x77, 0x37, 0xa9, 0x6b, 0xe2, 0xf8,
-	0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xce, 0xc1, 0x97, 0x14, 0xb5, 0x03, 0x00, 0x00,
+	// 904 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x9b, 0xb4, 0x4d, 0x5e, 0xbb, 0x55, 0x34, 0x6d, 0x77, 0xd3, 0x2c, 0xeb, 0x84, 0x4a,
+	0x48, 0x05, 0xb1, 0xb6, 0xda, 0x15, 0x20, 0x7a, 0x22, 0x89, 0x4d, 0xb1, 0xba, 0xdb, 0x46, 0x63,
+	0x57, 0x48, 0x5c, 0xac, 0x89, 0x3d, 0x49, 0xcd, 0xa6, 0x9e, 0x60, 0x4f, 0x56, 0xcb, 0x81, 0x3b,
+	0x47, 0xc4, 0x5f, 0xe0, 0xb2, 0x70, 0x5e, 0x89, 0xbf, 0xb0, 0xda, 0xd3, 0x1e, 0x39, 0x45, 0x28,
+	0xfd, 0x09, 0xdc, 0x38, 0xa1, 0x99, 0x71, 0xd3, 0xb8, 0x64, 0x45, 0x85, 0x90, 0xb8, 0x65, 0xbe,
+	0xef, 0xf3, 0x9b, 0xf7, 0xde, 0x7c, 0xef, 0x05, 0x1a, 0x3d, 0x12, 0x87, 0x26, 0x1f, 0xc7, 0x31,
+	0x1d, 0x9a, 0xcf, 0xf6, 0x7b, 0x94, 0x93, 0x7d, 0x33, 0x61, 0x63, 0x4e, 0x8d, 0x51, 0xc2, 0x38,
+	0x43, 0x9b, 0x42, 0x60, 0x28, 0x81, 0x91, 0x09, 0xea, 0x3b, 0x01, 0x4b, 0x2f, 0x58, 0xea, 0x4b,
+	0x89, 0xa9, 0x0e, 0x4a, 0x5f, 0xdf, 0x1a, 0xb0, 0x01, 0x53, 0xb8, 0xf8, 0x95, 0xa1, 0xba, 0xd2,
+	0x98, 0x3d, 0x92, 0xd2, 0xd9, 0x35, 0x01, 0x8b, 0xe2, 0x8c, 0x6f, 0xca, 0x34, 0xfa, 0x94, 0x86,
+	0xe9, 0x8c, 0xa6, 0x71, 0xc0, 0x42, 0x9a, 0x5c, 0x45, 0x58, 0xa0, 0x90, 0x27, 0xc5, 0xef, 0xfe,
+	0xa1, 0x41, 0xd9, 0x73, 0x5d, 0x2c, 0x52, 0x47, 0x5f, 0xc0, 0x56, 0x48, 0x53, 0x1e, 0xc5, 0x84,
+	0x47, 0x2c, 0xf6, 0x83, 0x73, 0x12, 0xc5, 0x7e, 0x14, 0xd6, 0xb4, 0xa6, 0xb6, 0x57, 0x69, 0xdf,
+	0x9d, 0x4e, 0x1a, 0xc8, 0xba, 0xe6, 0x3b, 0x82, 0x76, 0x2c, 0x8c, 0xc2, 0x9b, 0x58, 0x88, 0x3e,
+	0x83, 0x77, 0x72, 0x91, 0x58, 0xcc, 0x13, 0x12, 0x70, 0x9f, 0x84, 0x61, 0x42, 0xd3, 0xb4, 0xb6,
+	0x24, 0x22, 0xe2, 0xfa, 0xfc, 0x97, 0x99, 0xa4, 0xa5, 0x14, 0xe8, 0x23, 0x58, 0xcd, 0x2a, 0xa9,
+	0x15, 0x9b, 0xda, 0xde, 0xc6, 0xc1, 0x7d, 0x43, 0xb6, 0x54, 0x25, 0x9f, 0x95, 0x62, 0xd8, 0x4a,
+	0x82, 0xaf, 0xb4, 0xa8, 0x06, 0xab, 0x3d, 0xc2, 0x83, 0x73, 0x1a, 0xd6, 0x4a, 0x4d, 0x6d, 0xaf,
+	0x8c, 0xaf, 0x8e, 0x87, 0xf0, 0xfa, 0xe5, 0xc3, 0x15, 0x59, 0xa7, 0xb3, 0xfb, 0xa3, 0x06, 0x55,
+	0xcf, 0x75, 0xbb, 0x24, 0x78, 0x4a, 0x39, 0xa6, 0x01, 0x8d, 0x46, 0x1c, 0x7d, 0x0d, 0x90, 0x46,
+	0x83, 0x38, 0x8a, 0x07, 0x57, 0x35, 0x97, 0xda, 0xc7, 0xd3, 0x49, 0xa3, 0xe2, 0x2a, 0xd4, 0xb1,
+	0xfe, 0x9c, 0x34, 0x0e, 0x07, 0x11, 0x3f, 0x1f, 0xf7, 0x8c, 0x80, 0x5d, 0x98, 0x22, 0x1f, 0xd9,
+	0xc5, 0x80, 0x0d, 0x4d, 0xd9, 0x2c, 0xf3, 0xd9, 0x23, 0xf3, 0xb9, 0xc4, 0x79, 0x9a, 0x9a, 0xfc,
+	0xdb, 0x11, 0x4d, 0x8d, 0xd9, 0xd7, 0xb8, 0x92, 0x85, 0x77, 0xc2, 0x43, 0xf4, 0xfa, 0xe5, 0xc3,
+	0x8d, 0xdc, 0xf5, 0xce, 0xee, 0x2f, 0x4b, 0xb0, 0xed, 0xb9, 0x6e, 0x5b, 0xe4, 0xfb, 0xbf, 0x65,
+	0x86, 0x1a, 0xb0, 0x76, 0x41, 0x93, 0xa7, 0x43, 0xea, 0x27, 0x8c, 0x71, 0xf9, 0x50, 0xeb, 0x18,
+	0x14, 0x84, 0x19, 0xe3, 0xe8, 0x01, 0xc0, 0x90, 0x92, 0xbe, 0x1f, 0xc5, 0x21, 0x7d, 0x2e, 0xdf,
+	0xa6, 0x84, 0x2b, 0x02, 0x71, 0x04, 0x80, 0xb6, 0x60, 0x99, 0x33, 0x4e, 0x86, 0xb2, 0xfd, 0x25,
+	0xac, 0x0e, 0x02, 0x1d, 0x25, 0x8c, 0xf5, 0x6b, 0xcb, 0xcd, 0xe2, 0xde, 0x3a, 0x56, 0x07, 0xf4,
+	0x1e, 0x6c, 0xf4, 0x49, 0x34, 0x1c, 0x27, 0xd4, 0x4f, 0x28, 0x49, 0x59, 0x5c, 0x5b, 0x91, 0xbe,
+	0xb8, 0x93, 0xa1, 0x58, 0x82, 0x0b, 0x9b, 0x65, 0x41, 0xd9, 0x69, 0x77, 0x94, 0x6d, 0x3f, 0x04,
+	0x08, 0xce, 0x89, 0x98, 0xb4, 0x6b, 0xb3, 0xde, 0x11, 0xed, 0xe9, 0x28, 0x54, 0x14, 0x98, 0x09,
+	0x9c, 0xbc, 0x0f, 0xbe, 0x83, 0xaa, 0xd3, 0xee, 0xe4, 0x9b, 0x5d, 0x87, 0x72, 0x4a, 0xbf, 0x19,
+	0xd3, 0x38, 0xa0, 0xaa, 0xd5, 0x78, 0x76, 0x46, 0x9f, 0xc2, 0x4a, 0xca, 0x09, 0x1f, 0x2b, 0x03,
+	0x6f, 0x1c, 0xbc, 0x6b, 0x2c, 0x18, 0x73, 0x43, 0xc5, 0x73, 0xa5, 0x10, 0x67, 0x1f, 0x2c, 0x2c,
+	0xe2, 0xe7, 0x22, 0xac, 0xc9, 0x4c, 0x92, 0x7f, 0x51, 0x08, 0xfa, 0x18, 0xee, 0xf5, 0x92, 0x28,
+	0x1c, 0xd0, 0xb7, 0x8d, 0xd7, 0xb6, 0xa2, 0x6f, 0x4e, 0xd6, 0xdb, 0xa6, 0xbc, 0xf8, 0x9f, 0x4f,
+	0x79, 0xe9, 0x1f, 0xa7, 0xfc, 0x00, 0xb6, 0xe7, 0x23, 0x0c, 0x48, 0xea, 0x0f, 0xa3, 0x8b, 0x88,
+	0xd7, 0x96, 0x65, 0xe7, 0x37, 0xe7, 0xc8, 0x23, 0x92, 0x3e, 0x16, 0x14, 0xda, 0x87, 0x62, 0x9f,
+	0x52, 0x69, 0x95, 0xb5, 0x83, 0x1d, 0x23, 0x5b, 0xa3, 0x62, 0x45, 0xce, 0x5e, 0xa0, 0xc3, 0xa2,
+	0xb8, 0x5d, 0x7a, 0x35, 0x69, 0x14, 0xb0, 0xd0, 0xce, 0x2f, 0x93, 0xd5, 0xdb, 0x2f, 0x93, 0x9c,
+	0x55, 0x6c, 0xd8, 0x94, 0xbf, 0x92, 0x5b, 0xbb, 0x65, 0xe1, 0x93, 0xff, 0xaa, 0xc1, 0x5d, 0x4f,
+	0xda, 0xa5, 0x9b, 0x44, 0x01, 0x4d, 0x15, 0x6d, 0x11, 0x4e, 0xd0, 0xfb, 0x50, 0x51, 0x46, 0xba,
+	0x1e, 0xf2, 0xf5, 0xe9, 0xa4, 0x51, 0x56, 0x72, 0xc7, 0xc2, 0x65, 0x45, 0x3b, 0x61, 0xee, 0xd6,
+	0xa5, 0x1b, 0x1e, 0xfd, 0x04, 0x56, 0x46, 0x32, 0x74, 0xad, 0xd8, 0x2c, 0xca, 0x0e, 0x2d, 0x28,
+	0x55, 0x5e, 0x9e, 0x75, 0x28, 0x93, 0x8b, 0xc1, 0x0e, 0x12, 0x4a, 0x38, 0x0d, 0x7d, 0xc2, 0xe5,
+	0xdb, 0x15, 0x71, 0x25, 0x43, 0x5a, 0xfc, 0x83, 0x17, 0x1a, 0xac, 0xcf, 0x3b, 0x1b, 0x3d, 0x80,
+	0x9d, 0x6e, 0xab, 0x73, 0x6c, 0x7b, 0xbe, 0xeb, 0xb5, 0xbc, 0x33, 0xd7, 0x3f, 0x3b, 0x71, 0xbb,
+	0x76, 0xc7, 0xf9, 0xdc, 0xb1, 0xad, 0x6a, 0x01, 0xed, 0xc0, 0x76, 0x9e, 0xee, 0xda, 0x27, 0x96,
+	0x73, 0x72, 0x54, 0xd5, 0x90, 0x0e, 0xf5, 0x3c, 0xd5, 0xea, 0x1c, 0x9f, 0x9c, 0x7e, 0xf9, 0xd8,
+	0xb6, 0x8e, 0x6c, 0xab, 0xba, 0x84, 0xee, 0xc3, 0xbd, 0x3c, 0x6f, 0x63, 0x7c, 0x8a, 0x85, 0xaa,
+	0x5a, 0xfc, 0x3b, 0xe9, 0x39, 0x4f, 0x6c, 0xcb, 0x3f, 0x3d, 0xf3, 0xaa, 0xa5, 0x7a, 0xe9, 0xfb,
+	0x9f, 0xf4, 0x42, 0xfb, 0xc9, 0x8b, 0xa9, 0xae, 0xbd, 0x9a, 0xea, 0xda, 0x9b, 0xa9, 0xae, 0xfd,
+	0x3e, 0xd5, 0xb5, 0x1f, 0x2e, 0xf5, 0xc2, 0x9b, 0x4b, 0xbd, 0xf0, 0xdb, 0xa5, 0x5e, 0xf8, 0xca,
+	0xbc, 0xc5, 0xa2, 0xcc, 0xfe, 0xd9, 0xe5, 0x9e, 0xec, 0xad, 0x48, 0xc5, 0xa3, 0xbf, 0x06, 0x00,
+	0xd4, 0xd1, 0xe7, 0xb6, 0xf5, 0x07, 0x00, 0x00,
 }
 
 func (this *TSSRoute) Equal(that interface{}) bool {
@@ -377,6 +704,9 @@ func (this *TSSRoute) Equal(that interface{}) bool {
 	if this.Encoder != that1.Encoder {
 		return false
 	}
+	if this.Batched != that1.Batched {
+		return false
+	}
 	return true
 }
 func (this *TSSPacketReceipt) Equal(that interface{}) bool {
@@ -403,6 +733,50 @@ func (this *TSSPacketReceipt) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TSSBatchPacketReceipt) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TSSBatchPacketReceipt)
+	if !ok {
+		that2, ok := that.(TSSBatchPacketReceipt)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SigningID != that1.SigningID {
+		return false
+	}
+	if !bytes.Equal(this.MerkleRoot, that1.MerkleRoot) {
+		return false
+	}
+	if this.LeafIndex != that1.LeafIndex {
+		return false
+	}
+	if this.Total != that1.Total {
+		return false
+	}
+	if len(this.Proof) != len(that1.Proof) {
+		return false
+	}
+	for i := range this.Proof {
+		if !bytes.Equal(this.Proof[i], that1.Proof[i]) {
+			return false
+		}
+	}
+	if this.FailureReason != that1.FailureReason {
+		return false
+	}
+	return true
+}
 func (this *IBCRoute) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -449,16 +823,19 @@ func (this *IBCPacketReceipt) Equal(that interface{}) bool {
 	if this.Sequence != that1.Sequence {
 		return false
 	}
+	if this.Status != that1.Status {
+		return false
+	}
 	return true
 }
-func (this *TunnelPricesPacketData) Equal(that interface{}) bool {
+func (this *RouterRoute) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*TunnelPricesPacketData)
+	that1, ok := that.(*RouterRoute)
 	if !ok {
-		that2, ok := that.(TunnelPricesPacketData)
+		that2, ok := that.(RouterRoute)
 		if ok {
 			that1 = &that2
 		} else {
@@ -470,38 +847,104 @@ func (this *TunnelPricesPacketData) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.TunnelID != that1.TunnelID {
+	if this.ChannelID != that1.ChannelID {
 		return false
 	}
-	if this.Sequence != that1.Sequence {
+	if this.BridgeContractAddress != that1.BridgeContractAddress {
 		return false
 	}
-	if len(this.Prices) != len(that1.Prices) {
+	if this.DestinationChainID != that1.DestinationChainID {
 		return false
 	}
-	for i := range this.Prices {
-		if !this.Prices[i].Equal(&that1.Prices[i]) {
+	if this.DestinationContractAddress != that1.DestinationContractAddress {
+		return false
+	}
+	if this.DestinationGasLimit != that1.DestinationGasLimit {
+		return false
+	}
+	if !this.Fee.Equal(&that1.Fee) {
+		return false
+	}
+	if this.Encoder != that1.Encoder {
+		return false
+	}
+	return true
+}
+func (this *RouterPacketReceipt) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RouterPacketReceipt)
+	if !ok {
+		that2, ok := that.(RouterPacketReceipt)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if this.CreatedAt != that1.CreatedAt {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Sequence != that1.Sequence {
 		return false
 	}
 	return true
 }
-func (m *TSSRoute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TunnelPricesPacketData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *TSSRoute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*TunnelPricesPacketData)
+	if !ok {
+		that2, ok := that.(TunnelPricesPacketData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TunnelID != that1.TunnelID {
+		return false
+	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	if len(this.Prices) != len(that1.Prices) {
+		return false
+	}
+	for i := range this.Prices {
+		if !this.Prices[i].Equal(&that1.Prices[i]) {
+			return false
+		}
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	return true
+}
+func (m *TSSRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TSSRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
 func (m *TSSRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
@@ -509,6 +952,16 @@ func (m *TSSRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Batched {
+		i--
+		if m.Batched {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Encoder != 0 {
 		i = encodeVarintRoute(dAtA, i, uint64(m.Encoder))
 		i--
@@ -559,6 +1012,67 @@ func (m *TSSPacketReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TSSBatchPacketReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TSSBatchPacketReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TSSBatchPacketReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintRoute(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintRoute(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Total != 0 {
+		i = encodeVarintRoute(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LeafIndex != 0 {
+		i = encodeVarintRoute(dAtA, i, uint64(m.LeafIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintRoute(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SigningID != 0 {
+		i = encodeVarintRoute(dAtA, i, uint64(m.SigningID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *IBCRoute) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -605,6 +1119,110 @@ func (m *IBCPacketReceipt) MarshalTo(dAtA []byte) (int, error) {
 }
 
 func (m *IBCPacketReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintRoute(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintRoute(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RouterRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouterRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouterRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Encoder != 0 {
+		i = encodeVarintRoute(dAtA, i, uint64(m.Encoder))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.DestinationGasLimit != 0 {
+		i = encodeVarintRoute(dAtA, i, uint64(m.DestinationGasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DestinationContractAddress) > 0 {
+		i -= len(m.DestinationContractAddress)
+		copy(dAtA[i:], m.DestinationContractAddress)
+		i = encodeVarintRoute(dAtA, i, uint64(len(m.DestinationContractAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DestinationChainID) > 0 {
+		i -= len(m.DestinationChainID)
+		copy(dAtA[i:], m.DestinationChainID)
+		i = encodeVarintRoute(dAtA, i, uint64(len(m.DestinationChainID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BridgeContractAddress) > 0 {
+		i -= len(m.BridgeContractAddress)
+		copy(dAtA[i:], m.BridgeContractAddress)
+		i = encodeVarintRoute(dAtA, i, uint64(len(m.BridgeContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintRoute(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RouterPacketReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouterPacketReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouterPacketReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -697,6 +1315,9 @@ func (m *TSSRoute) Size() (n int) {
 	if m.Encoder != 0 {
 		n += 1 + sovRoute(uint64(m.Encoder))
 	}
+	if m.Batched {
+		n += 2
+	}
 	return n
 }
 
@@ -712,6 +1333,38 @@ func (m *TSSPacketReceipt) Size() (n int) {
 	return n
 }
 
+func (m *TSSBatchPacketReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SigningID != 0 {
+		n += 1 + sovRoute(uint64(m.SigningID))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovRoute(uint64(l))
+	}
+	if m.LeafIndex != 0 {
+		n += 1 + sovRoute(uint64(m.LeafIndex))
+	}
+	if m.Total != 0 {
+		n += 1 + sovRoute(uint64(m.Total))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovRoute(uint64(l))
+		}
+	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovRoute(uint64(l))
+	}
+	return n
+}
+
 func (m *IBCRoute) Size() (n int) {
 	if m == nil {
 		return 0
@@ -726,6 +1379,54 @@ func (m *IBCRoute) Size() (n int) {
 }
 
 func (m *IBCPacketReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovRoute(uint64(m.Sequence))
+	}
+	if m.Status != 0 {
+		n += 1 + sovRoute(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *RouterRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovRoute(uint64(l))
+	}
+	l = len(m.BridgeContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRoute(uint64(l))
+	}
+	l = len(m.DestinationChainID)
+	if l > 0 {
+		n += 1 + l + sovRoute(uint64(l))
+	}
+	l = len(m.DestinationContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRoute(uint64(l))
+	}
+	if m.DestinationGasLimit != 0 {
+		n += 1 + sovRoute(uint64(m.DestinationGasLimit))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovRoute(uint64(l))
+	if m.Encoder != 0 {
+		n += 1 + sovRoute(uint64(m.Encoder))
+	}
+	return n
+}
+
+func (m *RouterPacketReceipt) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -879,6 +1580,26 @@ func (m *TSSRoute) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batched", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Batched = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRoute(dAtA[iNdEx:])
@@ -969,7 +1690,7 @@ func (m *TSSPacketReceipt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IBCRoute) Unmarshal(dAtA []byte) error {
+func (m *TSSBatchPacketReceipt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -992,17 +1713,222 @@ func (m *IBCRoute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IBCRoute: wiretype end group for non-group")
+			return fmt.Errorf("proto: TSSBatchPacketReceipt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IBCRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TSSBatchPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningID", wireType)
 			}
-			var stringLen uint64
+			m.SigningID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigningID |= github_com_bandprotocol_chain_v3_x_bandtss_types.SigningID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafIndex", wireType)
+			}
+			m.LeafIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRoute
@@ -1080,6 +2006,343 @@ func (m *IBCPacketReceipt) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: IBCPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PacketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouterRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouterRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouterRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationGasLimit", wireType)
+			}
+			m.DestinationGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+			}
+			m.Encoder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Encoder |= types.Encoder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouterPacketReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouterPacketReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouterPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
@@ -29,10 +29,11 @@ func (r *IBCRoute) ValidateBasic() error {
 	return nil
 }
 
-// NewIBCPacketReceipt creates a new IBCPacketReceipt instance.
+// NewIBCPacketReceipt creates a new IBCPacketReceipt instance of a pending IBC packet.
 func NewIBCPacketReceipt(sequence uint64) *IBCPacketReceipt {
 	return &IBCPacketReceipt{
 		Sequence: sequence,
+		Status:   PACKET_STATUS_PENDING,
 	}
 }
 
@@ -14,7 +14,15 @@ func TestGetByteTunnelPricesPacketData(t *testing.T) {
 		1,
 		2,
 		[]feedstypes.Price{
-			{Status: feedstypes.PRICE_STATUS_AVAILABLE, SignalID: "CS:BAND-USD", Price: 50000, Timestamp: 1733000000},
+			{
+				Status:                  feedstypes.PRICE_STATUS_AVAILABLE,
+				SignalID:                "CS:BAND-USD",
+				Price:                   50000,
+				Timestamp:               1733000000,
+				InterquartileRange:      100,
+				ParticipationBasisPoint: 8000,
+				ReporterCount:           10,
+			},
 		},
 		1633024800,
 	)
@@ -22,7 +30,7 @@ func TestGetByteTunnelPricesPacketData(t *testing.T) {
 	require.Equal(
 		t,
 		[]byte(
-			`{"created_at":"1633024800","prices":[{"price":"50000","signal_id":"CS:BAND-USD","status":"PRICE_STATUS_AVAILABLE","timestamp":"1733000000"}],"sequence":"2","tunnel_id":"1"}`,
+			`{"created_at":"1633024800","prices":[{"interquartile_range":"100","participation_basis_point":"8000","price":"50000","reporter_count":"10","signal_id":"CS:BAND-USD","status":"PRICE_STATUS_AVAILABLE","timestamp":"1733000000"}],"sequence":"2","tunnel_id":"1"}`,
 		),
 		packet.GetBytes(),
 	)
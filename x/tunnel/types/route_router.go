@@ -0,0 +1,113 @@
+package types
+
+import (
+	"encoding/json"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// RouterRoute defines the Router route for the tunnel module
+var _ RouteI = &RouterRoute{}
+
+// NewRouterRoute creates a new RouterRoute instance.
+func NewRouterRoute(
+	channelID string,
+	bridgeContractAddress string,
+	destinationChainID string,
+	destinationContractAddress string,
+	destinationGasLimit uint64,
+	fee sdk.Coin,
+	encoder feedstypes.Encoder,
+) *RouterRoute {
+	return &RouterRoute{
+		ChannelID:                  channelID,
+		BridgeContractAddress:      bridgeContractAddress,
+		DestinationChainID:         destinationChainID,
+		DestinationContractAddress: destinationContractAddress,
+		DestinationGasLimit:        destinationGasLimit,
+		Fee:                        fee,
+		Encoder:                    encoder,
+	}
+}
+
+// ValidateBasic validates the RouterRoute
+func (r *RouterRoute) ValidateBasic() error {
+	if !channeltypes.IsChannelIDFormat(r.ChannelID) {
+		return ErrInvalidRoute.Wrapf("channel identifier is not in the format: `channel-{N}`")
+	}
+
+	if r.BridgeContractAddress == "" {
+		return ErrInvalidRoute.Wrapf("bridge contract address cannot be empty")
+	}
+
+	if r.DestinationChainID == "" {
+		return ErrInvalidRoute.Wrapf("destination chain ID cannot be empty")
+	}
+
+	if r.DestinationContractAddress == "" {
+		return ErrInvalidRoute.Wrapf("destination contract address cannot be empty")
+	}
+
+	if r.DestinationGasLimit == 0 {
+		return ErrInvalidRoute.Wrapf("destination gas limit must be positive")
+	}
+
+	if err := r.Fee.Validate(); err != nil {
+		return ErrInvalidRoute.Wrapf("invalid fee: %s", err)
+	}
+
+	if !r.Fee.IsPositive() {
+		return ErrInvalidRoute.Wrapf("fee must be positive: %s", r.Fee)
+	}
+
+	if err := feedstypes.ValidateEncoder(r.Encoder); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewRouterPacketReceipt creates a new RouterPacketReceipt instance.
+func NewRouterPacketReceipt(sequence uint64) *RouterPacketReceipt {
+	return &RouterPacketReceipt{
+		Sequence: sequence,
+	}
+}
+
+// RouterMemo is the general message passing payload attached to the memo of the ICS-20
+// transfer sent to the bridge. The bridge forwards the payload to the destination contract.
+type RouterMemo struct {
+	DestinationChainID         string `json:"destination_chain_id"`
+	DestinationContractAddress string `json:"destination_contract_address"`
+	DestinationGasLimit        uint64 `json:"destination_gas_limit"`
+	Payload                    []byte `json:"payload"`
+}
+
+// NewRouterMemo creates a new RouterMemo instance.
+func NewRouterMemo(
+	destinationChainID string,
+	destinationContractAddress string,
+	destinationGasLimit uint64,
+	payload []byte,
+) RouterMemo {
+	return RouterMemo{
+		DestinationChainID:         destinationChainID,
+		DestinationContractAddress: destinationContractAddress,
+		DestinationGasLimit:        destinationGasLimit,
+		Payload:                    payload,
+	}
+}
+
+// String returns the JSON string of the memo
+func (m RouterMemo) String() (string, error) {
+	bz, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
@@ -0,0 +1,121 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func TestRouterRoute_ValidateBasic(t *testing.T) {
+	validRoute := func() *types.RouterRoute {
+		return types.NewRouterRoute(
+			"channel-1",
+			"bridge1contract",
+			"ethereum-1",
+			"0x1234567890abcdef",
+			300000,
+			sdk.NewInt64Coin("uband", 1000),
+			feedstypes.ENCODER_FIXED_POINT_ABI,
+		)
+	}
+
+	cases := map[string]struct {
+		route     func() *types.RouterRoute
+		expErr    bool
+		expErrMsg string
+	}{
+		"valid route": {
+			route:  validRoute,
+			expErr: false,
+		},
+		"invalid channel id": {
+			route: func() *types.RouterRoute {
+				r := validRoute()
+				r.ChannelID = ""
+				return r
+			},
+			expErr:    true,
+			expErrMsg: "channel identifier is not in the format",
+		},
+		"empty bridge contract address": {
+			route: func() *types.RouterRoute {
+				r := validRoute()
+				r.BridgeContractAddress = ""
+				return r
+			},
+			expErr:    true,
+			expErrMsg: "bridge contract address cannot be empty",
+		},
+		"empty destination chain id": {
+			route: func() *types.RouterRoute {
+				r := validRoute()
+				r.DestinationChainID = ""
+				return r
+			},
+			expErr:    true,
+			expErrMsg: "destination chain ID cannot be empty",
+		},
+		"empty destination contract address": {
+			route: func() *types.RouterRoute {
+				r := validRoute()
+				r.DestinationContractAddress = ""
+				return r
+			},
+			expErr:    true,
+			expErrMsg: "destination contract address cannot be empty",
+		},
+		"zero destination gas limit": {
+			route: func() *types.RouterRoute {
+				r := validRoute()
+				r.DestinationGasLimit = 0
+				return r
+			},
+			expErr:    true,
+			expErrMsg: "destination gas limit must be positive",
+		},
+		"zero fee": {
+			route: func() *types.RouterRoute {
+				r := validRoute()
+				r.Fee = sdk.NewInt64Coin("uband", 0)
+				return r
+			},
+			expErr:    true,
+			expErrMsg: "fee must be positive",
+		},
+		"invalid encoder": {
+			route: func() *types.RouterRoute {
+				r := validRoute()
+				r.Encoder = feedstypes.ENCODER_UNSPECIFIED
+				return r
+			},
+			expErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.route().ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRouterMemo_String(t *testing.T) {
+	memo, err := types.NewRouterMemo("ethereum-1", "0x1234567890abcdef", 300000, []byte{0x01, 0x02}).String()
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`{"destination_chain_id":"ethereum-1","destination_contract_address":"0x1234567890abcdef","destination_gas_limit":300000,"payload":"AQI="}`,
+		memo,
+	)
+}
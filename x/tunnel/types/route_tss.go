@@ -12,11 +12,13 @@ func NewTSSRoute(
 	destinationChainID string,
 	destinationContractAddress string,
 	encoder feedstypes.Encoder,
+	batched bool,
 ) TSSRoute {
 	return TSSRoute{
 		DestinationChainID:         destinationChainID,
 		DestinationContractAddress: destinationContractAddress,
 		Encoder:                    encoder,
+		Batched:                    batched,
 	}
 }
 
@@ -43,3 +45,28 @@ func NewTSSPacketReceipt(signingID bandtsstypes.SigningID) *TSSPacketReceipt {
 		SigningID: signingID,
 	}
 }
+
+// NewTSSBatchPacketReceipt creates a new TSSBatchPacketReceipt instance.
+func NewTSSBatchPacketReceipt(
+	signingID bandtsstypes.SigningID,
+	merkleRoot []byte,
+	leafIndex uint64,
+	total uint64,
+	proof [][]byte,
+) *TSSBatchPacketReceipt {
+	return &TSSBatchPacketReceipt{
+		SigningID:  signingID,
+		MerkleRoot: merkleRoot,
+		LeafIndex:  leafIndex,
+		Total:      total,
+		Proof:      proof,
+	}
+}
+
+// NewFailedTSSBatchPacketReceipt creates a new TSSBatchPacketReceipt instance of a packet whose
+// batch could not be signed.
+func NewFailedTSSBatchPacketReceipt(reason string) *TSSBatchPacketReceipt {
+	return &TSSBatchPacketReceipt{
+		FailureReason: reason,
+	}
+}
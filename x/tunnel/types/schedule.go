@@ -0,0 +1,239 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxScheduleBlockHeights is the maximum number of block heights in a schedule.
+const MaxScheduleBlockHeights = 100
+
+// maxCronSearchYears bounds the search of the next time matching a cron expression.
+const maxCronSearchYears = 5
+
+// NewSchedule creates a new Schedule instance.
+func NewSchedule(cron string, blockHeights []uint64) *Schedule {
+	return &Schedule{
+		Cron:         cron,
+		BlockHeights: blockHeights,
+	}
+}
+
+// ValidateBasic validates the schedule.
+func (s Schedule) ValidateBasic() error {
+	if s.Cron == "" && len(s.BlockHeights) == 0 {
+		return ErrInvalidSchedule.Wrap("either cron or block heights must be set")
+	}
+
+	if s.Cron != "" {
+		cron, err := ParseCron(s.Cron)
+		if err != nil {
+			return ErrInvalidSchedule.Wrapf("invalid cron %q: %s", s.Cron, err)
+		}
+
+		if cron.Next(time.Unix(0, 0).UTC()).IsZero() {
+			return ErrInvalidSchedule.Wrapf("cron %q never matches", s.Cron)
+		}
+	}
+
+	if len(s.BlockHeights) > MaxScheduleBlockHeights {
+		return ErrInvalidSchedule.Wrapf(
+			"max block heights %d, got %d",
+			MaxScheduleBlockHeights,
+			len(s.BlockHeights),
+		)
+	}
+
+	for i, height := range s.BlockHeights {
+		if height == 0 {
+			return ErrInvalidSchedule.Wrap("block height must be positive")
+		}
+
+		if i > 0 && height <= s.BlockHeights[i-1] {
+			return ErrInvalidSchedule.Wrap("block heights must be in strictly ascending order")
+		}
+	}
+
+	return nil
+}
+
+// IsDue returns true if the schedule has a block height equal to the given height or
+// a cron time after the last scheduled time that is not after the given time.
+func (s Schedule) IsDue(lastScheduledAt int64, height int64, now time.Time) bool {
+	for _, h := range s.BlockHeights {
+		if int64(h) == height {
+			return true
+		}
+	}
+
+	if s.Cron == "" {
+		return false
+	}
+
+	cron, err := ParseCron(s.Cron)
+	if err != nil {
+		return false
+	}
+
+	next := cron.Next(time.Unix(lastScheduledAt, 0).UTC())
+	return !next.IsZero() && !next.After(now)
+}
+
+// Cron is a parsed cron expression. Each field is a bit set of the matching values.
+type Cron struct {
+	Minute     uint64
+	Hour       uint64
+	DayOfMonth uint64
+	Month      uint64
+	DayOfWeek  uint64
+
+	// restricted day fields follow the standard cron rule: if both day of month and
+	// day of week are restricted, a day matches if either field matches.
+	dayOfMonthRestricted bool
+	dayOfWeekRestricted  bool
+}
+
+// cronField defines the range of a cron field.
+type cronField struct {
+	name string
+	min  uint64
+	max  uint64
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7},
+}
+
+// ParseCron parses a cron expression with five fields: minute, hour, day of month, month and
+// day of week. Each field supports `*`, values, ranges `a-b`, steps `*/n` or `a-b/n`, and
+// comma-separated lists. Day of week is 0-7 where both 0 and 7 are Sunday.
+func ParseCron(expr string) (Cron, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return Cron{}, fmt.Errorf("expected %d fields, got %d", len(cronFields), len(fields))
+	}
+
+	bits := make([]uint64, len(fields))
+	for i, field := range fields {
+		b, err := parseCronField(field, cronFields[i])
+		if err != nil {
+			return Cron{}, err
+		}
+		bits[i] = b
+	}
+
+	// Sunday can be either 0 or 7
+	dayOfWeek := bits[4]
+	if dayOfWeek&(1<<7) != 0 {
+		dayOfWeek = (dayOfWeek | 1) &^ (1 << 7)
+	}
+
+	return Cron{
+		Minute:               bits[0],
+		Hour:                 bits[1],
+		DayOfMonth:           bits[2],
+		Month:                bits[3],
+		DayOfWeek:            dayOfWeek,
+		dayOfMonthRestricted: !strings.HasPrefix(fields[2], "*"),
+		dayOfWeekRestricted:  !strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+// parseCronField parses a field of a cron expression to a bit set of the matching values.
+func parseCronField(field string, f cronField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, uint64(1)
+		if i := strings.Index(part, "/"); i >= 0 {
+			s, err := strconv.ParseUint(part[i+1:], 10, 64)
+			if err != nil || s == 0 {
+				return 0, fmt.Errorf("invalid step in %s field: %q", f.name, part)
+			}
+			rangePart, step = part[:i], s
+		}
+
+		var start, end uint64
+		switch {
+		case rangePart == "*":
+			start, end = f.min, f.max
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			s, err1 := strconv.ParseUint(bounds[0], 10, 64)
+			e, err2 := strconv.ParseUint(bounds[1], 10, 64)
+			if err1 != nil || err2 != nil || s > e {
+				return 0, fmt.Errorf("invalid range in %s field: %q", f.name, part)
+			}
+			start, end = s, e
+		default:
+			v, err := strconv.ParseUint(rangePart, 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value in %s field: %q", f.name, part)
+			}
+			start, end = v, v
+			if step != 1 {
+				end = f.max
+			}
+		}
+
+		if start < f.min || end > f.max {
+			return 0, fmt.Errorf("%s field out of range [%d, %d]: %q", f.name, f.min, f.max, part)
+		}
+
+		for v := start; v <= end; v += step {
+			bits |= 1 << v
+		}
+	}
+
+	return bits, nil
+}
+
+// Next returns the first time after the given time that matches the cron expression, or
+// the zero time if there is no match within a bounded number of years.
+func (c Cron) Next(after time.Time) time.Time {
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(maxCronSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if c.Month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if !c.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if c.Hour&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+
+		if c.Minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+// matchDay returns true if the day of the given time matches the cron expression.
+func (c Cron) matchDay(t time.Time) bool {
+	domMatch := c.DayOfMonth&(1<<uint(t.Day())) != 0
+	dowMatch := c.DayOfWeek&(1<<uint(t.Weekday())) != 0
+
+	if c.dayOfMonthRestricted && c.dayOfWeekRestricted {
+		return domMatch || dowMatch
+	}
+
+	return domMatch && dowMatch
+}
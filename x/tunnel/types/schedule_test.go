@@ -0,0 +1,115 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func TestSchedule_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name      string
+		schedule  *types.Schedule
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:     "valid cron",
+			schedule: types.NewSchedule("0 0,16 * * *", nil),
+			expErr:   false,
+		},
+		{
+			name:     "valid block heights",
+			schedule: types.NewSchedule("", []uint64{100, 200}),
+			expErr:   false,
+		},
+		{
+			name:      "empty schedule",
+			schedule:  types.NewSchedule("", nil),
+			expErr:    true,
+			expErrMsg: "either cron or block heights must be set",
+		},
+		{
+			name:      "invalid cron field count",
+			schedule:  types.NewSchedule("0 0 * *", nil),
+			expErr:    true,
+			expErrMsg: "expected 5 fields, got 4",
+		},
+		{
+			name:      "cron value out of range",
+			schedule:  types.NewSchedule("0 24 * * *", nil),
+			expErr:    true,
+			expErrMsg: "hour field out of range",
+		},
+		{
+			name:      "cron never matches",
+			schedule:  types.NewSchedule("0 0 31 2 *", nil),
+			expErr:    true,
+			expErrMsg: "never matches",
+		},
+		{
+			name:      "zero block height",
+			schedule:  types.NewSchedule("", []uint64{0}),
+			expErr:    true,
+			expErrMsg: "block height must be positive",
+		},
+		{
+			name:      "unordered block heights",
+			schedule:  types.NewSchedule("", []uint64{200, 100}),
+			expErr:    true,
+			expErrMsg: "strictly ascending order",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schedule.ValidateBasic()
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidSchedule)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCron_Next(t *testing.T) {
+	after := time.Date(2024, 12, 1, 10, 30, 0, 0, time.UTC) // Sunday
+
+	tests := []struct {
+		expr string
+		exp  time.Time
+	}{
+		{expr: "0 0,16 * * *", exp: time.Date(2024, 12, 1, 16, 0, 0, 0, time.UTC)},
+		{expr: "*/15 * * * *", exp: time.Date(2024, 12, 1, 10, 45, 0, 0, time.UTC)},
+		{expr: "0 9 * * 1-5", exp: time.Date(2024, 12, 2, 9, 0, 0, 0, time.UTC)},
+		{expr: "0 0 1 * *", exp: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{expr: "30 10 * * 7", exp: time.Date(2024, 12, 8, 10, 30, 0, 0, time.UTC)},
+		{expr: "0 12 15 * 1", exp: time.Date(2024, 12, 2, 12, 0, 0, 0, time.UTC)},
+		{expr: "0 0 29 2 *", exp: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			cron, err := types.ParseCron(tc.expr)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, cron.Next(after))
+		})
+	}
+}
+
+func TestSchedule_IsDue(t *testing.T) {
+	now := time.Date(2024, 12, 1, 16, 0, 5, 0, time.UTC)
+	schedule := types.NewSchedule("0 0,16 * * *", []uint64{500})
+
+	// fixing time at 16:00 has passed since the last scheduled time
+	require.True(t, schedule.IsDue(now.Add(-time.Hour).Unix(), 100, now))
+	// fixing time at 16:00 is already handled
+	require.False(t, schedule.IsDue(now.Unix(), 100, now.Add(time.Minute)))
+	// block height of the schedule
+	require.True(t, schedule.IsDue(now.Unix(), 500, now.Add(time.Minute)))
+}
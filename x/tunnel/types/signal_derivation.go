@@ -0,0 +1,145 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// PricePrecision is the fixed-point precision of the prices from the feeds module.
+var PricePrecision = sdkmath.NewInt(1_000_000_000)
+
+// NewSignalDerivation creates a new SignalDerivation instance.
+func NewSignalDerivation(
+	derivationType DerivationType,
+	baseSignalID string,
+	quoteSignalID string,
+	multiplierNumerator uint64,
+	multiplierDenominator uint64,
+) *SignalDerivation {
+	return &SignalDerivation{
+		Type:                  derivationType,
+		BaseSignalID:          baseSignalID,
+		QuoteSignalID:         quoteSignalID,
+		MultiplierNumerator:   multiplierNumerator,
+		MultiplierDenominator: multiplierDenominator,
+	}
+}
+
+// NewCrossRateDerivation creates a derivation that divides the base price by the quote price.
+func NewCrossRateDerivation(baseSignalID string, quoteSignalID string) *SignalDerivation {
+	return NewSignalDerivation(DERIVATION_TYPE_CROSS_RATE, baseSignalID, quoteSignalID, 0, 0)
+}
+
+// NewInverseDerivation creates a derivation that inverts the base price.
+func NewInverseDerivation(baseSignalID string) *SignalDerivation {
+	return NewSignalDerivation(DERIVATION_TYPE_INVERSE, baseSignalID, "", 0, 0)
+}
+
+// NewRescaleDerivation creates a derivation that multiplies the base price by numerator / denominator.
+func NewRescaleDerivation(baseSignalID string, numerator uint64, denominator uint64) *SignalDerivation {
+	return NewSignalDerivation(DERIVATION_TYPE_RESCALE, baseSignalID, "", numerator, denominator)
+}
+
+// ValidateBasic validates the signal derivation.
+func (d SignalDerivation) ValidateBasic() error {
+	if d.BaseSignalID == "" {
+		return ErrInvalidSignalDerivation.Wrap("base signal ID cannot be empty")
+	}
+
+	switch d.Type {
+	case DERIVATION_TYPE_CROSS_RATE:
+		if d.QuoteSignalID == "" {
+			return ErrInvalidSignalDerivation.Wrap("quote signal ID cannot be empty for cross rate")
+		}
+		if d.QuoteSignalID == d.BaseSignalID {
+			return ErrInvalidSignalDerivation.Wrap("quote signal ID must differ from base signal ID")
+		}
+		if d.MultiplierNumerator != 0 || d.MultiplierDenominator != 0 {
+			return ErrInvalidSignalDerivation.Wrap("multiplier must not be set for cross rate")
+		}
+	case DERIVATION_TYPE_INVERSE:
+		if d.QuoteSignalID != "" {
+			return ErrInvalidSignalDerivation.Wrap("quote signal ID must not be set for inverse")
+		}
+		if d.MultiplierNumerator != 0 || d.MultiplierDenominator != 0 {
+			return ErrInvalidSignalDerivation.Wrap("multiplier must not be set for inverse")
+		}
+	case DERIVATION_TYPE_RESCALE:
+		if d.QuoteSignalID != "" {
+			return ErrInvalidSignalDerivation.Wrap("quote signal ID must not be set for rescale")
+		}
+		if d.MultiplierNumerator == 0 || d.MultiplierDenominator == 0 {
+			return ErrInvalidSignalDerivation.Wrap("multiplier numerator and denominator must be positive")
+		}
+	default:
+		return ErrInvalidSignalDerivation.Wrapf("unsupported derivation type %s", d.Type)
+	}
+
+	return nil
+}
+
+// GetSourceSignalIDs returns the signal IDs of the feeds prices used by the derivation.
+func (d SignalDerivation) GetSourceSignalIDs() []string {
+	if d.Type == DERIVATION_TYPE_CROSS_RATE {
+		return []string{d.BaseSignalID, d.QuoteSignalID}
+	}
+	return []string{d.BaseSignalID}
+}
+
+// DerivePrice computes the price of the given signal ID from the feeds prices. The derived price
+// is available only if all source prices are available; its timestamp is the oldest timestamp
+// of the source prices.
+func (d SignalDerivation) DerivePrice(
+	signalID string,
+	feedsPricesMap map[string]feedstypes.Price,
+	timestamp int64,
+) feedstypes.Price {
+	sources := make([]sdkmath.Int, 0, 2)
+	sourceTimestamp := timestamp
+	for i, sourceID := range d.GetSourceSignalIDs() {
+		source, ok := feedsPricesMap[sourceID]
+		if !ok {
+			return feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_IN_CURRENT_FEEDS, signalID, 0, timestamp)
+		}
+
+		if source.Status != feedstypes.PRICE_STATUS_AVAILABLE {
+			return feedstypes.NewPrice(source.Status, signalID, 0, source.Timestamp)
+		}
+
+		if i == 0 || source.Timestamp < sourceTimestamp {
+			sourceTimestamp = source.Timestamp
+		}
+		sources = append(sources, sdkmath.NewIntFromUint64(source.Price))
+	}
+
+	var price sdkmath.Int
+	switch d.Type {
+	case DERIVATION_TYPE_CROSS_RATE:
+		if sources[1].IsZero() {
+			return feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, signalID, 0, sourceTimestamp)
+		}
+		price = sources[0].Mul(PricePrecision).Quo(sources[1])
+	case DERIVATION_TYPE_INVERSE:
+		if sources[0].IsZero() {
+			return feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, signalID, 0, sourceTimestamp)
+		}
+		price = PricePrecision.Mul(PricePrecision).Quo(sources[0])
+	case DERIVATION_TYPE_RESCALE:
+		if d.MultiplierDenominator == 0 {
+			return feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, signalID, 0, sourceTimestamp)
+		}
+		price = sources[0].
+			Mul(sdkmath.NewIntFromUint64(d.MultiplierNumerator)).
+			Quo(sdkmath.NewIntFromUint64(d.MultiplierDenominator))
+	default:
+		return feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, signalID, 0, sourceTimestamp)
+	}
+
+	// the derived price must be representable in the packet
+	if !price.IsUint64() {
+		return feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, signalID, 0, sourceTimestamp)
+	}
+
+	return feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, signalID, price.Uint64(), sourceTimestamp)
+}
@@ -0,0 +1,164 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func TestSignalDerivation_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name       string
+		derivation *types.SignalDerivation
+		expErr     bool
+		expErrMsg  string
+	}{
+		{
+			name:       "valid cross rate",
+			derivation: types.NewCrossRateDerivation("CS:ETH-USD", "CS:BTC-USD"),
+			expErr:     false,
+		},
+		{
+			name:       "valid inverse",
+			derivation: types.NewInverseDerivation("CS:BTC-USD"),
+			expErr:     false,
+		},
+		{
+			name:       "valid rescale",
+			derivation: types.NewRescaleDerivation("CS:BTC-USD", 1, 1000),
+			expErr:     false,
+		},
+		{
+			name:       "empty base signal ID",
+			derivation: types.NewInverseDerivation(""),
+			expErr:     true,
+			expErrMsg:  "base signal ID cannot be empty",
+		},
+		{
+			name:       "unspecified type",
+			derivation: types.NewSignalDerivation(types.DERIVATION_TYPE_UNSPECIFIED, "CS:BTC-USD", "", 0, 0),
+			expErr:     true,
+			expErrMsg:  "unsupported derivation type",
+		},
+		{
+			name:       "cross rate without quote",
+			derivation: types.NewCrossRateDerivation("CS:ETH-USD", ""),
+			expErr:     true,
+			expErrMsg:  "quote signal ID cannot be empty",
+		},
+		{
+			name:       "cross rate with same base and quote",
+			derivation: types.NewCrossRateDerivation("CS:ETH-USD", "CS:ETH-USD"),
+			expErr:     true,
+			expErrMsg:  "quote signal ID must differ from base signal ID",
+		},
+		{
+			name:       "inverse with quote",
+			derivation: types.NewSignalDerivation(types.DERIVATION_TYPE_INVERSE, "CS:BTC-USD", "CS:ETH-USD", 0, 0),
+			expErr:     true,
+			expErrMsg:  "quote signal ID must not be set",
+		},
+		{
+			name:       "rescale with zero denominator",
+			derivation: types.NewRescaleDerivation("CS:BTC-USD", 1, 0),
+			expErr:     true,
+			expErrMsg:  "multiplier numerator and denominator must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.derivation.ValidateBasic()
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidSignalDerivation)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSignalDerivation_DerivePrice(t *testing.T) {
+	feedsPricesMap := map[string]feedstypes.Price{
+		"CS:BTC-USD":  feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BTC-USD", 50000_000000000, 1733000010),
+		"CS:ETH-USD":  feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:ETH-USD", 2500_000000000, 1733000000),
+		"CS:ZERO-USD": feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:ZERO-USD", 0, 1733000000),
+		"CS:MAX-USD": feedstypes.NewPrice(
+			feedstypes.PRICE_STATUS_AVAILABLE,
+			"CS:MAX-USD",
+			math.MaxUint64,
+			1733000000,
+		),
+		"CS:BAND-USD": feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, "CS:BAND-USD", 0, 1733000005),
+	}
+
+	tests := []struct {
+		name       string
+		derivation *types.SignalDerivation
+		expPrice   feedstypes.Price
+	}{
+		{
+			name:       "cross rate",
+			derivation: types.NewCrossRateDerivation("CS:ETH-USD", "CS:BTC-USD"),
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "DERIVED", 50000000, 1733000000),
+		},
+		{
+			name:       "inverse",
+			derivation: types.NewInverseDerivation("CS:ETH-USD"),
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "DERIVED", 400000, 1733000000),
+		},
+		{
+			name:       "rescale",
+			derivation: types.NewRescaleDerivation("CS:BTC-USD", 1, 1000),
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "DERIVED", 50_000000000, 1733000010),
+		},
+		{
+			name:       "source not in current feeds",
+			derivation: types.NewCrossRateDerivation("CS:ETH-USD", "CS:ATOM-USD"),
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_IN_CURRENT_FEEDS, "DERIVED", 0, 1733000100),
+		},
+		{
+			name:       "source not available",
+			derivation: types.NewInverseDerivation("CS:BAND-USD"),
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, "DERIVED", 0, 1733000005),
+		},
+		{
+			name:       "division by zero",
+			derivation: types.NewCrossRateDerivation("CS:ETH-USD", "CS:ZERO-USD"),
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, "DERIVED", 0, 1733000000),
+		},
+		{
+			name:       "overflow",
+			derivation: types.NewRescaleDerivation("CS:MAX-USD", 2, 1),
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, "DERIVED", 0, 1733000000),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			price := tc.derivation.DerivePrice("DERIVED", feedsPricesMap, 1733000100)
+			require.Equal(t, tc.expPrice, price)
+		})
+	}
+}
+
+func TestTunnel_GetSourceSignalIDs(t *testing.T) {
+	tunnel := types.Tunnel{
+		SignalDeviations: []types.SignalDeviation{
+			types.NewSignalDeviation("CS:BTC-USD", 100, 100),
+			types.NewDerivedSignalDeviation(
+				"CS:ETH-BTC",
+				100,
+				100,
+				types.NewCrossRateDerivation("CS:ETH-USD", "CS:BTC-USD"),
+			),
+		},
+	}
+
+	require.Equal(t, []string{"CS:BTC-USD", "CS:ETH-USD"}, tunnel.GetSourceSignalIDs())
+}
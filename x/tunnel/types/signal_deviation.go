@@ -1,5 +1,9 @@
 package types
 
+import (
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
 // NewSignalDeviation creates a new SignalDeviation instance.
 func NewSignalDeviation(
 	signalID string,
@@ -13,6 +17,58 @@ func NewSignalDeviation(
 	}
 }
 
+// NewDerivedSignalDeviation creates a new SignalDeviation instance whose price is derived from the feeds prices.
+func NewDerivedSignalDeviation(
+	signalID string,
+	softDeviationBPS uint64,
+	hardDeviationBPS uint64,
+	derivation *SignalDerivation,
+) SignalDeviation {
+	return SignalDeviation{
+		SignalID:         signalID,
+		SoftDeviationBPS: softDeviationBPS,
+		HardDeviationBPS: hardDeviationBPS,
+		Derivation:       derivation,
+	}
+}
+
+// GetSourceSignalIDs returns the signal IDs of the feeds prices needed to compute the signal price.
+func (sd SignalDeviation) GetSourceSignalIDs() []string {
+	if sd.Derivation == nil {
+		return []string{sd.SignalID}
+	}
+	return sd.Derivation.GetSourceSignalIDs()
+}
+
+// GetSourceSignalIDs returns the unique signal IDs of the feeds prices needed to compute the prices of
+// the given signals.
+func GetSourceSignalIDs(signalDeviations []SignalDeviation) []string {
+	seen := make(map[string]bool)
+	signalIDs := make([]string, 0, len(signalDeviations))
+	for _, sd := range signalDeviations {
+		for _, signalID := range sd.GetSourceSignalIDs() {
+			if !seen[signalID] {
+				seen[signalID] = true
+				signalIDs = append(signalIDs, signalID)
+			}
+		}
+	}
+	return signalIDs
+}
+
+// ComputePrice returns the price of the signal from the feeds prices, applying the derivation if any.
+func (sd SignalDeviation) ComputePrice(feedsPricesMap map[string]feedstypes.Price, timestamp int64) feedstypes.Price {
+	if sd.Derivation != nil {
+		return sd.Derivation.DerivePrice(sd.SignalID, feedsPricesMap, timestamp)
+	}
+
+	feedPrice, ok := feedsPricesMap[sd.SignalID]
+	if !ok {
+		return feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_IN_CURRENT_FEEDS, sd.SignalID, 0, timestamp)
+	}
+	return feedPrice
+}
+
 // ValidateSignalDeviations validates the signal deviations with the given params.
 func ValidateSignalDeviations(
 	signalDeviations []SignalDeviation,
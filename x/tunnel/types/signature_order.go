@@ -6,7 +6,10 @@ import (
 )
 
 // signature order types
-const SignatureOrderTypeTunnel = "tunnel"
+const (
+	SignatureOrderTypeTunnel      = "tunnel"
+	SignatureOrderTypeTunnelBatch = "tunnel_batch"
+)
 
 // Implements Content Interface
 var _ tsstypes.Content = &TunnelSignatureOrder{}
@@ -39,3 +42,35 @@ func (ts *TunnelSignatureOrder) IsInternal() bool { return true }
 
 // ValidateBasic validates the request's title and description of the request signature
 func (ts *TunnelSignatureOrder) ValidateBasic() error { return nil }
+
+// Implements Content Interface
+var _ tsstypes.Content = &TunnelBatchSignatureOrder{}
+
+// NewTunnelBatchSignatureOrder returns a new TunnelBatchSignatureOrder object
+func NewTunnelBatchSignatureOrder(
+	destinationChainID string,
+	merkleRoot []byte,
+	packetCount uint64,
+	createdAt int64,
+) *TunnelBatchSignatureOrder {
+	return &TunnelBatchSignatureOrder{
+		DestinationChainID: destinationChainID,
+		MerkleRoot:         merkleRoot,
+		PacketCount:        packetCount,
+		CreatedAt:          createdAt,
+	}
+}
+
+// OrderRoute returns the order router key
+func (ts *TunnelBatchSignatureOrder) OrderRoute() string { return RouterKey }
+
+// OrderType returns type of signature order that should be "tunnel_batch"
+func (ts *TunnelBatchSignatureOrder) OrderType() string {
+	return SignatureOrderTypeTunnelBatch
+}
+
+// IsInternal returns true for TunnelBatchSignatureOrder (internal module-based request signature).
+func (ts *TunnelBatchSignatureOrder) IsInternal() bool { return true }
+
+// ValidateBasic validates the request's title and description of the request signature
+func (ts *TunnelBatchSignatureOrder) ValidateBasic() error { return nil }
@@ -20,6 +20,7 @@ func NewTunnel(
 	feePayer string,
 	signalDeviations []SignalDeviation,
 	interval uint64,
+	schedule *Schedule,
 	totalDeposit []sdk.Coin,
 	isActive bool,
 	createdAt int64,
@@ -41,10 +42,12 @@ func NewTunnel(
 		FeePayer:         feePayer,
 		SignalDeviations: signalDeviations,
 		Interval:         interval,
+		Schedule:         schedule,
 		TotalDeposit:     totalDeposit,
 		IsActive:         isActive,
 		CreatedAt:        createdAt,
 		Creator:          creator,
+		Owner:            creator,
 	}, nil
 }
 
@@ -102,6 +105,11 @@ func (t Tunnel) GetSignalIDs() []string {
 	return signalIDs
 }
 
+// GetSourceSignalIDs returns the unique signal IDs of the feeds prices needed to compute the tunnel prices.
+func (t Tunnel) GetSourceSignalIDs() []string {
+	return GetSourceSignalIDs(t.SignalDeviations)
+}
+
 // ValidateInterval validates the interval of the tunnel.
 func ValidateInterval(interval, maxInterval, minInterval uint64) error {
 	if interval < minInterval || interval > maxInterval {
@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	types2 "github.com/bandprotocol/chain/v3/x/feeds/types"
 	_ "github.com/cosmos/cosmos-proto"
@@ -29,6 +30,81 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// OperatorRole defines a permission that the owner of a tunnel grants to an operator.
+type OperatorRole int32
+
+const (
+	// OPERATOR_ROLE_UNSPECIFIED defines an unspecified role.
+	OPERATOR_ROLE_UNSPECIFIED OperatorRole = 0
+	// OPERATOR_ROLE_TRIGGER allows the operator to trigger the tunnel.
+	OPERATOR_ROLE_TRIGGER OperatorRole = 1
+	// OPERATOR_ROLE_CONFIG allows the operator to update the route, signals, interval and low balance
+	// threshold of the tunnel and to activate or deactivate it.
+	OPERATOR_ROLE_CONFIG OperatorRole = 2
+	// OPERATOR_ROLE_FUNDS allows the operator to withdraw the fee payer funds to the owner and to manage
+	// the fee payer top-up of the tunnel.
+	OPERATOR_ROLE_FUNDS OperatorRole = 3
+)
+
+var OperatorRole_name = map[int32]string{
+	0: "OPERATOR_ROLE_UNSPECIFIED",
+	1: "OPERATOR_ROLE_TRIGGER",
+	2: "OPERATOR_ROLE_CONFIG",
+	3: "OPERATOR_ROLE_FUNDS",
+}
+
+var OperatorRole_value = map[string]int32{
+	"OPERATOR_ROLE_UNSPECIFIED": 0,
+	"OPERATOR_ROLE_TRIGGER":     1,
+	"OPERATOR_ROLE_CONFIG":      2,
+	"OPERATOR_ROLE_FUNDS":       3,
+}
+
+func (x OperatorRole) String() string {
+	return proto.EnumName(OperatorRole_name, int32(x))
+}
+
+func (OperatorRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{0}
+}
+
+// DerivationType defines the operation used to derive a signal price from the feeds prices.
+type DerivationType int32
+
+const (
+	// DERIVATION_TYPE_UNSPECIFIED defines an unspecified derivation.
+	DERIVATION_TYPE_UNSPECIFIED DerivationType = 0
+	// DERIVATION_TYPE_CROSS_RATE defines a cross rate, i.e. the price of the base signal divided by
+	// the price of the quote signal.
+	DERIVATION_TYPE_CROSS_RATE DerivationType = 1
+	// DERIVATION_TYPE_INVERSE defines the inverse of the price of the base signal.
+	DERIVATION_TYPE_INVERSE DerivationType = 2
+	// DERIVATION_TYPE_RESCALE defines the price of the base signal multiplied by a fixed ratio.
+	DERIVATION_TYPE_RESCALE DerivationType = 3
+)
+
+var DerivationType_name = map[int32]string{
+	0: "DERIVATION_TYPE_UNSPECIFIED",
+	1: "DERIVATION_TYPE_CROSS_RATE",
+	2: "DERIVATION_TYPE_INVERSE",
+	3: "DERIVATION_TYPE_RESCALE",
+}
+
+var DerivationType_value = map[string]int32{
+	"DERIVATION_TYPE_UNSPECIFIED": 0,
+	"DERIVATION_TYPE_CROSS_RATE":  1,
+	"DERIVATION_TYPE_INVERSE":     2,
+	"DERIVATION_TYPE_RESCALE":     3,
+}
+
+func (x DerivationType) String() string {
+	return proto.EnumName(DerivationType_name, int32(x))
+}
+
+func (DerivationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{1}
+}
+
 // Tunnel contains the information of the tunnel that is created by the user
 type Tunnel struct {
 	// id is the tunnel ID
@@ -51,6 +127,13 @@ type Tunnel struct {
 	CreatedAt int64 `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// creator is the address of the creator
 	Creator string `protobuf:"bytes,10,opt,name=creator,proto3" json:"creator,omitempty"`
+	// schedule is the schedule for delivering the signal prices in addition to the interval and deviations
+	Schedule *Schedule `protobuf:"bytes,11,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// low_balance_threshold is the fee payer balance below which a low balance event is emitted
+	LowBalanceThreshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=low_balance_threshold,json=lowBalanceThreshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"low_balance_threshold"`
+	// owner is the address of the account that controls the tunnel. It can be any account, e.g. a multisig
+	// account or a module account.
+	Owner string `protobuf:"bytes,13,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
 func (m *Tunnel) Reset()         { *m = Tunnel{} }
@@ -156,6 +239,147 @@ func (m *Tunnel) GetCreator() string {
 	return ""
 }
 
+func (m *Tunnel) GetSchedule() *Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (m *Tunnel) GetLowBalanceThreshold() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LowBalanceThreshold
+	}
+	return nil
+}
+
+func (m *Tunnel) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// TunnelOperator is an account that the owner of a tunnel allows to manage the tunnel with the given roles.
+type TunnelOperator struct {
+	// tunnel_id is the ID of the tunnel.
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// address is the address of the operator.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// roles is the list of roles of the operator.
+	Roles []OperatorRole `protobuf:"varint,3,rep,packed,name=roles,proto3,enum=band.tunnel.v1beta1.OperatorRole" json:"roles,omitempty"`
+}
+
+func (m *TunnelOperator) Reset()         { *m = TunnelOperator{} }
+func (m *TunnelOperator) String() string { return proto.CompactTextString(m) }
+func (*TunnelOperator) ProtoMessage()    {}
+func (*TunnelOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{1}
+}
+func (m *TunnelOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TunnelOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TunnelOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TunnelOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TunnelOperator.Merge(m, src)
+}
+func (m *TunnelOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *TunnelOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_TunnelOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TunnelOperator proto.InternalMessageInfo
+
+func (m *TunnelOperator) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
+	}
+	return 0
+}
+
+func (m *TunnelOperator) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TunnelOperator) GetRoles() []OperatorRole {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+// Schedule defines the fixed times at which a tunnel delivers all of its signal prices.
+type Schedule struct {
+	// cron is a UTC cron expression with five fields (minute, hour, day of month, month and day of week),
+	// e.g. "0 0,16 * * *" for every day at 00:00 and 16:00.
+	Cron string `protobuf:"bytes,1,opt,name=cron,proto3" json:"cron,omitempty"`
+	// block_heights is the list of block heights in ascending order.
+	BlockHeights []uint64 `protobuf:"varint,2,rep,packed,name=block_heights,json=blockHeights,proto3" json:"block_heights,omitempty"`
+}
+
+func (m *Schedule) Reset()         { *m = Schedule{} }
+func (m *Schedule) String() string { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()    {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{2}
+}
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
+func (m *Schedule) GetCron() string {
+	if m != nil {
+		return m.Cron
+	}
+	return ""
+}
+
+func (m *Schedule) GetBlockHeights() []uint64 {
+	if m != nil {
+		return m.BlockHeights
+	}
+	return nil
+}
+
 // LatestPrices is the type for prices that tunnel produces
 type LatestPrices struct {
 	// tunnel_id is the tunnel ID
@@ -164,13 +388,15 @@ type LatestPrices struct {
 	Prices []types2.Price `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices"`
 	// last_interval is the last interval when the signal prices are produced by interval trigger
 	LastInterval int64 `protobuf:"varint,3,opt,name=last_interval,json=lastInterval,proto3" json:"last_interval,omitempty"`
+	// last_scheduled_at is the last time when the signal prices are produced by schedule trigger
+	LastScheduledAt int64 `protobuf:"varint,4,opt,name=last_scheduled_at,json=lastScheduledAt,proto3" json:"last_scheduled_at,omitempty"`
 }
 
 func (m *LatestPrices) Reset()         { *m = LatestPrices{} }
 func (m *LatestPrices) String() string { return proto.CompactTextString(m) }
 func (*LatestPrices) ProtoMessage()    {}
 func (*LatestPrices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bb6151451ba2f25, []int{1}
+	return fileDescriptor_6bb6151451ba2f25, []int{3}
 }
 func (m *LatestPrices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -220,6 +446,13 @@ func (m *LatestPrices) GetLastInterval() int64 {
 	return 0
 }
 
+func (m *LatestPrices) GetLastScheduledAt() int64 {
+	if m != nil {
+		return m.LastScheduledAt
+	}
+	return 0
+}
+
 // TotalFees is the type for the total fees collected by the tunnel
 type TotalFees struct {
 	// total_base_packet_fee is the total base packet fee collected by the tunnel
@@ -230,7 +463,7 @@ func (m *TotalFees) Reset()         { *m = TotalFees{} }
 func (m *TotalFees) String() string { return proto.CompactTextString(m) }
 func (*TotalFees) ProtoMessage()    {}
 func (*TotalFees) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bb6151451ba2f25, []int{2}
+	return fileDescriptor_6bb6151451ba2f25, []int{4}
 }
 func (m *TotalFees) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -284,7 +517,7 @@ func (m *Packet) Reset()         { *m = Packet{} }
 func (m *Packet) String() string { return proto.CompactTextString(m) }
 func (*Packet) ProtoMessage()    {}
 func (*Packet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bb6151451ba2f25, []int{3}
+	return fileDescriptor_6bb6151451ba2f25, []int{5}
 }
 func (m *Packet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -362,7 +595,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bb6151451ba2f25, []int{4}
+	return fileDescriptor_6bb6151451ba2f25, []int{6}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -412,6 +645,88 @@ func (m *Deposit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// FeePayerTopUp is the authorization of a funding account to refill the fee payer of a tunnel.
+type FeePayerTopUp struct {
+	// tunnel_id is the ID of the tunnel whose fee payer is refilled.
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// funder is the address of the account that funds the refills.
+	Funder string `protobuf:"bytes,2,opt,name=funder,proto3" json:"funder,omitempty"`
+	// target_balance is the balance that the fee payer is refilled up to.
+	TargetBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=target_balance,json=targetBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"target_balance"`
+	// cap is the maximum total amount that the funder transfers to the fee payer.
+	Cap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=cap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"cap"`
+	// total_topped_up is the total amount that the funder has transferred to the fee payer.
+	TotalToppedUp github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_topped_up,json=totalToppedUp,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_topped_up"`
+}
+
+func (m *FeePayerTopUp) Reset()         { *m = FeePayerTopUp{} }
+func (m *FeePayerTopUp) String() string { return proto.CompactTextString(m) }
+func (*FeePayerTopUp) ProtoMessage()    {}
+func (*FeePayerTopUp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{7}
+}
+func (m *FeePayerTopUp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeePayerTopUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeePayerTopUp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeePayerTopUp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePayerTopUp.Merge(m, src)
+}
+func (m *FeePayerTopUp) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeePayerTopUp) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePayerTopUp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePayerTopUp proto.InternalMessageInfo
+
+func (m *FeePayerTopUp) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
+	}
+	return 0
+}
+
+func (m *FeePayerTopUp) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *FeePayerTopUp) GetTargetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TargetBalance
+	}
+	return nil
+}
+
+func (m *FeePayerTopUp) GetCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Cap
+	}
+	return nil
+}
+
+func (m *FeePayerTopUp) GetTotalToppedUp() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalToppedUp
+	}
+	return nil
+}
+
 // SignalDeviation is the type for a signal with soft and hard deviation
 type SignalDeviation struct {
 	// signal_id is the signal ID
@@ -420,13 +735,16 @@ type SignalDeviation struct {
 	SoftDeviationBPS uint64 `protobuf:"varint,2,opt,name=soft_deviation_bps,json=softDeviationBps,proto3" json:"soft_deviation_bps,omitempty"`
 	// hard_deviation_bps is the hard deviation in basis points
 	HardDeviationBPS uint64 `protobuf:"varint,3,opt,name=hard_deviation_bps,json=hardDeviationBps,proto3" json:"hard_deviation_bps,omitempty"`
+	// derivation defines how the price of the signal is derived from the feeds prices. If it is not set,
+	// the price of the signal is taken from the feeds module as is.
+	Derivation *SignalDerivation `protobuf:"bytes,4,opt,name=derivation,proto3" json:"derivation,omitempty"`
 }
 
 func (m *SignalDeviation) Reset()         { *m = SignalDeviation{} }
 func (m *SignalDeviation) String() string { return proto.CompactTextString(m) }
 func (*SignalDeviation) ProtoMessage()    {}
 func (*SignalDeviation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bb6151451ba2f25, []int{5}
+	return fileDescriptor_6bb6151451ba2f25, []int{8}
 }
 func (m *SignalDeviation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -476,6 +794,95 @@ func (m *SignalDeviation) GetHardDeviationBPS() uint64 {
 	return 0
 }
 
+func (m *SignalDeviation) GetDerivation() *SignalDerivation {
+	if m != nil {
+		return m.Derivation
+	}
+	return nil
+}
+
+// SignalDerivation defines how the price of a derived signal is computed from the feeds prices.
+type SignalDerivation struct {
+	// type is the operation used to derive the price.
+	Type DerivationType `protobuf:"varint,1,opt,name=type,proto3,enum=band.tunnel.v1beta1.DerivationType" json:"type,omitempty"`
+	// base_signal_id is the signal ID of the feeds price that the derived price is based on.
+	BaseSignalID string `protobuf:"bytes,2,opt,name=base_signal_id,json=baseSignalId,proto3" json:"base_signal_id,omitempty"`
+	// quote_signal_id is the signal ID of the feeds price that divides the base price of a cross rate.
+	QuoteSignalID string `protobuf:"bytes,3,opt,name=quote_signal_id,json=quoteSignalId,proto3" json:"quote_signal_id,omitempty"`
+	// multiplier_numerator is the numerator of the ratio applied to the base price of a rescale.
+	MultiplierNumerator uint64 `protobuf:"varint,4,opt,name=multiplier_numerator,json=multiplierNumerator,proto3" json:"multiplier_numerator,omitempty"`
+	// multiplier_denominator is the denominator of the ratio applied to the base price of a rescale.
+	MultiplierDenominator uint64 `protobuf:"varint,5,opt,name=multiplier_denominator,json=multiplierDenominator,proto3" json:"multiplier_denominator,omitempty"`
+}
+
+func (m *SignalDerivation) Reset()         { *m = SignalDerivation{} }
+func (m *SignalDerivation) String() string { return proto.CompactTextString(m) }
+func (*SignalDerivation) ProtoMessage()    {}
+func (*SignalDerivation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{9}
+}
+func (m *SignalDerivation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalDerivation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalDerivation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalDerivation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalDerivation.Merge(m, src)
+}
+func (m *SignalDerivation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalDerivation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalDerivation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalDerivation proto.InternalMessageInfo
+
+func (m *SignalDerivation) GetType() DerivationType {
+	if m != nil {
+		return m.Type
+	}
+	return DERIVATION_TYPE_UNSPECIFIED
+}
+
+func (m *SignalDerivation) GetBaseSignalID() string {
+	if m != nil {
+		return m.BaseSignalID
+	}
+	return ""
+}
+
+func (m *SignalDerivation) GetQuoteSignalID() string {
+	if m != nil {
+		return m.QuoteSignalID
+	}
+	return ""
+}
+
+func (m *SignalDerivation) GetMultiplierNumerator() uint64 {
+	if m != nil {
+		return m.MultiplierNumerator
+	}
+	return 0
+}
+
+func (m *SignalDerivation) GetMultiplierDenominator() uint64 {
+	if m != nil {
+		return m.MultiplierDenominator
+	}
+	return 0
+}
+
 // TunnelSignatureOrder defines a general signature order for sending signature to tss group.
 type TunnelSignatureOrder struct {
 	// sequence is the sequence of the packet
@@ -492,7 +899,7 @@ func (m *TunnelSignatureOrder) Reset()         { *m = TunnelSignatureOrder{} }
 func (m *TunnelSignatureOrder) String() string { return proto.CompactTextString(m) }
 func (*TunnelSignatureOrder) ProtoMessage()    {}
 func (*TunnelSignatureOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bb6151451ba2f25, []int{6}
+	return fileDescriptor_6bb6151451ba2f25, []int{10}
 }
 func (m *TunnelSignatureOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,91 +928,318 @@ func (m *TunnelSignatureOrder) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TunnelSignatureOrder proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Tunnel)(nil), "band.tunnel.v1beta1.Tunnel")
-	proto.RegisterType((*LatestPrices)(nil), "band.tunnel.v1beta1.LatestPrices")
-	proto.RegisterType((*TotalFees)(nil), "band.tunnel.v1beta1.TotalFees")
-	proto.RegisterType((*Packet)(nil), "band.tunnel.v1beta1.Packet")
-	proto.RegisterType((*Deposit)(nil), "band.tunnel.v1beta1.Deposit")
-	proto.RegisterType((*SignalDeviation)(nil), "band.tunnel.v1beta1.SignalDeviation")
-	proto.RegisterType((*TunnelSignatureOrder)(nil), "band.tunnel.v1beta1.TunnelSignatureOrder")
+// TunnelBatchSignatureOrder defines a signature order for signing the Merkle root of a batch of
+// tunnel packets targeting the same destination chain.
+type TunnelBatchSignatureOrder struct {
+	// destination_chain_id is the destination chain ID of every packet in the batch
+	DestinationChainID string `protobuf:"bytes,1,opt,name=destination_chain_id,json=destinationChainId,proto3" json:"destination_chain_id,omitempty"`
+	// merkle_root is the root of the Merkle tree of the encoded packets
+	MerkleRoot []byte `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// packet_count is the number of packets in the batch
+	PacketCount uint64 `protobuf:"varint,3,opt,name=packet_count,json=packetCount,proto3" json:"packet_count,omitempty"`
+	// created_at is the timestamp when the batch is created
+	CreatedAt int64 `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func init() { proto.RegisterFile("band/tunnel/v1beta1/tunnel.proto", fileDescriptor_6bb6151451ba2f25) }
-
-var fileDescriptor_6bb6151451ba2f25 = []byte{
-	// 898 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xd8, 0x8e, 0xed, 0x9d, 0xa6, 0x25, 0x1d, 0x0c, 0xda, 0xa6, 0xc2, 0xb6, 0x02, 0x07,
-	0x83, 0x94, 0x5d, 0x9a, 0x2a, 0x45, 0xea, 0x2d, 0x4b, 0xa8, 0xb0, 0x04, 0x22, 0xda, 0x54, 0x42,
-	0xe2, 0xb2, 0x1a, 0xef, 0x3e, 0xdb, 0xa3, 0x3a, 0x3b, 0xcb, 0xcc, 0xd8, 0x22, 0x17, 0xce, 0x5c,
-	0x90, 0xe0, 0x03, 0x20, 0xf5, 0x58, 0x71, 0xe2, 0x90, 0xef, 0x40, 0x95, 0x53, 0xc5, 0x89, 0x03,
-	0x0a, 0xc8, 0x39, 0x80, 0xc4, 0x97, 0x40, 0x3b, 0x33, 0x6b, 0x63, 0x2b, 0x6d, 0x64, 0xa9, 0x97,
-	0xc4, 0xef, 0xbd, 0xdf, 0xbc, 0x7f, 0xbf, 0xdf, 0xcc, 0xe2, 0x4e, 0x9f, 0xa6, 0x89, 0xaf, 0x26,
-	0x69, 0x0a, 0x63, 0x7f, 0x7a, 0xaf, 0x0f, 0x8a, 0xde, 0xb3, 0xa6, 0x97, 0x09, 0xae, 0x38, 0x79,
-	0x33, 0x47, 0x78, 0xd6, 0x65, 0x11, 0xdb, 0xb7, 0xe9, 0x09, 0x4b, 0xb9, 0xaf, 0xff, 0x1a, 0xdc,
-	0x76, 0x2b, 0xe6, 0xf2, 0x84, 0x4b, 0xbf, 0x4f, 0x25, 0xcc, 0x33, 0xc5, 0x9c, 0xa5, 0x36, 0x7e,
-	0xc7, 0xc4, 0x23, 0x6d, 0xf9, 0xc6, 0xb0, 0xa1, 0xe6, 0x90, 0x0f, 0xb9, 0xf1, 0xe7, 0xbf, 0x8a,
-	0x03, 0x43, 0xce, 0x87, 0x63, 0xf0, 0xb5, 0xd5, 0x9f, 0x0c, 0x7c, 0x9a, 0x9e, 0xda, 0x90, 0xe9,
-	0x7a, 0x00, 0x90, 0xc8, 0x79, 0x29, 0x48, 0x63, 0x9e, 0x80, 0x28, 0xba, 0xb9, 0x02, 0xa1, 0x2d,
-	0x13, 0xdf, 0xf9, 0xbe, 0x8a, 0x6b, 0x8f, 0xf5, 0x4c, 0xe4, 0x6d, 0x5c, 0x66, 0x89, 0x8b, 0x3a,
-	0xa8, 0x5b, 0x0d, 0x6a, 0xb3, 0x8b, 0x76, 0xb9, 0x77, 0x18, 0x96, 0x59, 0x42, 0xb6, 0x71, 0x43,
-	0xc2, 0xd7, 0x13, 0x48, 0x63, 0x70, 0xcb, 0x79, 0x34, 0x9c, 0xdb, 0xe4, 0x01, 0xde, 0x10, 0x7c,
-	0xa2, 0xc0, 0xad, 0x74, 0x50, 0xf7, 0xc6, 0x5e, 0xd3, 0x33, 0xbd, 0x7a, 0x45, 0xaf, 0xde, 0x41,
-	0x7a, 0x1a, 0xe0, 0xf3, 0xb3, 0xdd, 0x5a, 0x98, 0xc3, 0x7a, 0xa1, 0x81, 0x93, 0x7d, 0xec, 0x0c,
-	0x00, 0xa2, 0x8c, 0x9e, 0x82, 0x70, 0xab, 0x1d, 0xd4, 0x75, 0x02, 0xf7, 0xb7, 0xb3, 0xdd, 0xa6,
-	0x5d, 0xc7, 0x41, 0x92, 0x08, 0x90, 0xf2, 0x58, 0x09, 0x96, 0x0e, 0xc3, 0xc6, 0x00, 0xe0, 0x28,
-	0x47, 0x92, 0x2f, 0xf1, 0x6d, 0xc9, 0x86, 0x29, 0x1d, 0x47, 0x09, 0x4c, 0x19, 0x55, 0x8c, 0xa7,
-	0xd2, 0xdd, 0xe8, 0x54, 0xba, 0x37, 0xf6, 0xde, 0xf3, 0xae, 0xe0, 0xc7, 0x3b, 0xd6, 0xe8, 0xc3,
-	0x02, 0x1c, 0x54, 0x9f, 0x5f, 0xb4, 0x4b, 0xe1, 0x96, 0x5c, 0x76, 0xcb, 0x7c, 0x46, 0x96, 0x2a,
-	0x10, 0x53, 0x3a, 0x76, 0x6b, 0x66, 0xc6, 0xc2, 0x26, 0x13, 0x7c, 0x53, 0x71, 0xa5, 0x6b, 0x66,
-	0x5c, 0x32, 0xe5, 0xd6, 0x75, 0xc1, 0x3b, 0x9e, 0x6d, 0x36, 0x27, 0x7a, 0x5e, 0xf0, 0x63, 0xce,
-	0xd2, 0x60, 0x3f, 0xaf, 0xf2, 0xf3, 0x9f, 0xed, 0xee, 0x90, 0xa9, 0xd1, 0xa4, 0xef, 0xc5, 0xfc,
-	0xc4, 0x12, 0x6d, 0xff, 0xed, 0xca, 0xe4, 0x89, 0xaf, 0x4e, 0x33, 0x90, 0xfa, 0x80, 0x7c, 0xf6,
-	0xf7, 0x2f, 0x1f, 0xa0, 0x70, 0x53, 0x97, 0x39, 0x34, 0x55, 0xc8, 0x5d, 0xec, 0x30, 0x19, 0xd1,
-	0x58, 0xb1, 0x29, 0xb8, 0x8d, 0x0e, 0xea, 0x36, 0xc2, 0x06, 0x93, 0x07, 0xda, 0x26, 0xef, 0x60,
-	0x1c, 0x0b, 0xa0, 0x0a, 0x92, 0x88, 0x2a, 0xd7, 0xe9, 0xa0, 0x6e, 0x25, 0x74, 0xac, 0xe7, 0x40,
-	0x91, 0x3d, 0x5c, 0xd7, 0x06, 0x17, 0x2e, 0xbe, 0x66, 0xb9, 0x05, 0xf0, 0x61, 0xf5, 0x9f, 0xa7,
-	0x6d, 0xb4, 0xf3, 0x13, 0xc2, 0x9b, 0x9f, 0x51, 0x05, 0x52, 0x1d, 0x09, 0x16, 0x83, 0x24, 0xef,
-	0x63, 0xc7, 0xec, 0x34, 0x9a, 0x8b, 0x63, 0x73, 0x76, 0xd1, 0x6e, 0x18, 0xd1, 0xf4, 0x0e, 0xc3,
-	0x86, 0x09, 0xf7, 0x12, 0xf2, 0x11, 0xae, 0x65, 0xfa, 0x90, 0x5b, 0xb6, 0x1b, 0xd2, 0x94, 0x18,
-	0xb9, 0x15, 0x0b, 0xd2, 0x69, 0x2d, 0x0f, 0x16, 0x4e, 0xde, 0xc5, 0x37, 0xc7, 0x54, 0xaa, 0x68,
-	0x4e, 0x41, 0x45, 0x0f, 0xb4, 0x99, 0x3b, 0x7b, 0xd6, 0x67, 0xfb, 0xfb, 0x11, 0x61, 0xe7, 0x71,
-	0xbe, 0xa6, 0x47, 0x00, 0x92, 0x7c, 0x8b, 0xdf, 0x32, 0xd4, 0xe4, 0x1c, 0x44, 0x19, 0x8d, 0x9f,
-	0x80, 0x8a, 0x06, 0x00, 0x2e, 0xba, 0x8e, 0xa2, 0x0f, 0xd7, 0xa5, 0x28, 0x24, 0xba, 0x52, 0x40,
-	0x25, 0x1c, 0xe9, 0x3a, 0x8f, 0x00, 0x6c, 0x4f, 0xff, 0x22, 0x5c, 0x33, 0xbe, 0x75, 0xb6, 0xf5,
-	0xaa, 0x6b, 0xb5, 0xd8, 0x64, 0x65, 0xbd, 0x4d, 0x06, 0xb8, 0x2e, 0x20, 0x06, 0x96, 0x29, 0x7d,
-	0xab, 0x5e, 0x76, 0x23, 0xc9, 0xf9, 0xd9, 0xee, 0x2d, 0xd3, 0x72, 0x68, 0xe0, 0xbd, 0xb0, 0x38,
-	0xb8, 0xa2, 0xad, 0x8d, 0x15, 0x6d, 0xed, 0xfc, 0x81, 0x70, 0xbd, 0xd0, 0xe8, 0x1a, 0xe3, 0x3e,
-	0xc0, 0x8e, 0xbd, 0x3f, 0x5c, 0xe8, 0x79, 0x5f, 0x25, 0xca, 0x05, 0x94, 0x8c, 0x70, 0x8d, 0x9e,
-	0xf0, 0x49, 0xaa, 0xe6, 0xab, 0x78, 0xdd, 0xd7, 0xce, 0xe6, 0xb7, 0x64, 0x9e, 0x23, 0xfc, 0xc6,
-	0xca, 0xab, 0x91, 0x8f, 0x69, 0x9f, 0x1d, 0x3b, 0xa6, 0x63, 0xc6, 0x34, 0xb8, 0x7c, 0x4c, 0x13,
-	0xee, 0x25, 0x24, 0xc0, 0x44, 0xf2, 0x81, 0x5a, 0xbc, 0x4f, 0x51, 0x3f, 0x93, 0x86, 0xdf, 0xa0,
-	0x39, 0xbb, 0x68, 0x6f, 0x1d, 0xf3, 0x81, 0x5a, 0xbc, 0x47, 0x47, 0xc7, 0xe1, 0x96, 0x5c, 0xf2,
-	0x64, 0x39, 0x89, 0x64, 0x44, 0x45, 0xb2, 0x92, 0xa3, 0xb2, 0xc8, 0xf1, 0x29, 0x15, 0xc9, 0x72,
-	0x8e, 0xd1, 0x92, 0x27, 0x93, 0x76, 0x98, 0x5f, 0x11, 0x6e, 0x1a, 0x2e, 0x74, 0xab, 0x6a, 0x22,
-	0xe0, 0x0b, 0x91, 0x80, 0x58, 0x12, 0x1f, 0x7a, 0xa9, 0xf8, 0xd6, 0xbc, 0xc6, 0xcb, 0xc2, 0xa9,
-	0xac, 0x3e, 0x4a, 0xfb, 0xb8, 0x6e, 0xbf, 0x4d, 0x5a, 0x9b, 0xb7, 0xf6, 0xee, 0x5e, 0x95, 0xf8,
-	0x13, 0x03, 0x09, 0x0b, 0xec, 0xc3, 0xea, 0x77, 0x4f, 0xdb, 0xa5, 0xe0, 0xf3, 0x67, 0xb3, 0x16,
-	0x7a, 0x3e, 0x6b, 0xa1, 0x17, 0xb3, 0x16, 0xfa, 0x6b, 0xd6, 0x42, 0x3f, 0x5c, 0xb6, 0x4a, 0x2f,
-	0x2e, 0x5b, 0xa5, 0xdf, 0x2f, 0x5b, 0xa5, 0xaf, 0xfc, 0xff, 0x31, 0x9e, 0xe7, 0xd4, 0x62, 0x8f,
-	0xf9, 0xd8, 0x8f, 0x47, 0x94, 0xa5, 0xfe, 0xf4, 0xbe, 0xff, 0x4d, 0xf1, 0x6d, 0xd7, 0xf4, 0xf7,
-	0x6b, 0x1a, 0x71, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x38, 0x1b, 0xc5, 0xfa, 0xf7, 0x07,
-	0x00, 0x00,
+func (m *TunnelBatchSignatureOrder) Reset()         { *m = TunnelBatchSignatureOrder{} }
+func (m *TunnelBatchSignatureOrder) String() string { return proto.CompactTextString(m) }
+func (*TunnelBatchSignatureOrder) ProtoMessage()    {}
+func (*TunnelBatchSignatureOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{11}
 }
-
-func (this *Tunnel) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*Tunnel)
-	if !ok {
-		that2, ok := that.(Tunnel)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *TunnelBatchSignatureOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TunnelBatchSignatureOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TunnelBatchSignatureOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TunnelBatchSignatureOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TunnelBatchSignatureOrder.Merge(m, src)
+}
+func (m *TunnelBatchSignatureOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *TunnelBatchSignatureOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TunnelBatchSignatureOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TunnelBatchSignatureOrder proto.InternalMessageInfo
+
+// PendingTSSBatchPacket defines a packet waiting to be signed as a part of a batch at the end of the block.
+type PendingTSSBatchPacket struct {
+	// tunnel_id is the ID of the tunnel
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// sequence is the sequence of the packet
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// escrowed_fee is the signing fee escrowed from the fee payer of the tunnel
+	EscrowedFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=escrowed_fee,json=escrowedFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrowed_fee"`
+}
+
+func (m *PendingTSSBatchPacket) Reset()         { *m = PendingTSSBatchPacket{} }
+func (m *PendingTSSBatchPacket) String() string { return proto.CompactTextString(m) }
+func (*PendingTSSBatchPacket) ProtoMessage()    {}
+func (*PendingTSSBatchPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{12}
+}
+func (m *PendingTSSBatchPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTSSBatchPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTSSBatchPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingTSSBatchPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTSSBatchPacket.Merge(m, src)
+}
+func (m *PendingTSSBatchPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTSSBatchPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTSSBatchPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTSSBatchPacket proto.InternalMessageInfo
+
+func (m *PendingTSSBatchPacket) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
+	}
+	return 0
+}
+
+func (m *PendingTSSBatchPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingTSSBatchPacket) GetEscrowedFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EscrowedFee
+	}
+	return nil
+}
+
+// EncodedTSSPacket is the protobuf layout of a tunnel packet encoded by ENCODER_FIXED_POINT_PROTOBUF.
+type EncodedTSSPacket struct {
+	// sequence is the sequence of the packet
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// relay_prices is the list of relay prices of the packet.
+	RelayPrices []types2.EncodedRelayPrice `protobuf:"bytes,2,rep,name=relay_prices,json=relayPrices,proto3" json:"relay_prices"`
+	// created_at is the timestamp when the packet is created
+	CreatedAt int64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (m *EncodedTSSPacket) Reset()         { *m = EncodedTSSPacket{} }
+func (m *EncodedTSSPacket) String() string { return proto.CompactTextString(m) }
+func (*EncodedTSSPacket) ProtoMessage()    {}
+func (*EncodedTSSPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{13}
+}
+func (m *EncodedTSSPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncodedTSSPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncodedTSSPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncodedTSSPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncodedTSSPacket.Merge(m, src)
+}
+func (m *EncodedTSSPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncodedTSSPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncodedTSSPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncodedTSSPacket proto.InternalMessageInfo
+
+func (m *EncodedTSSPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *EncodedTSSPacket) GetRelayPrices() []types2.EncodedRelayPrice {
+	if m != nil {
+		return m.RelayPrices
+	}
+	return nil
+}
+
+func (m *EncodedTSSPacket) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("band.tunnel.v1beta1.OperatorRole", OperatorRole_name, OperatorRole_value)
+	proto.RegisterEnum("band.tunnel.v1beta1.DerivationType", DerivationType_name, DerivationType_value)
+	proto.RegisterType((*Tunnel)(nil), "band.tunnel.v1beta1.Tunnel")
+	proto.RegisterType((*TunnelOperator)(nil), "band.tunnel.v1beta1.TunnelOperator")
+	proto.RegisterType((*Schedule)(nil), "band.tunnel.v1beta1.Schedule")
+	proto.RegisterType((*LatestPrices)(nil), "band.tunnel.v1beta1.LatestPrices")
+	proto.RegisterType((*TotalFees)(nil), "band.tunnel.v1beta1.TotalFees")
+	proto.RegisterType((*Packet)(nil), "band.tunnel.v1beta1.Packet")
+	proto.RegisterType((*Deposit)(nil), "band.tunnel.v1beta1.Deposit")
+	proto.RegisterType((*FeePayerTopUp)(nil), "band.tunnel.v1beta1.FeePayerTopUp")
+	proto.RegisterType((*SignalDeviation)(nil), "band.tunnel.v1beta1.SignalDeviation")
+	proto.RegisterType((*SignalDerivation)(nil), "band.tunnel.v1beta1.SignalDerivation")
+	proto.RegisterType((*TunnelSignatureOrder)(nil), "band.tunnel.v1beta1.TunnelSignatureOrder")
+	proto.RegisterType((*TunnelBatchSignatureOrder)(nil), "band.tunnel.v1beta1.TunnelBatchSignatureOrder")
+	proto.RegisterType((*PendingTSSBatchPacket)(nil), "band.tunnel.v1beta1.PendingTSSBatchPacket")
+	proto.RegisterType((*EncodedTSSPacket)(nil), "band.tunnel.v1beta1.EncodedTSSPacket")
+}
+
+func init() { proto.RegisterFile("band/tunnel/v1beta1/tunnel.proto", fileDescriptor_6bb6151451ba2f25) }
+
+var fileDescriptor_6bb6151451ba2f25 = []byte{
+	// 1612 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x92, 0x14, 0x45, 0x3e, 0x51, 0x34, 0x3d, 0xa6, 0x9c, 0x95, 0x0c, 0x93, 0x8c, 0xd2,
+	0x00, 0xac, 0x01, 0x93, 0xb1, 0x02, 0xd9, 0x48, 0x6e, 0xa4, 0x48, 0xd9, 0x0b, 0xb8, 0x22, 0x3b,
+	0xa4, 0x52, 0xb4, 0x97, 0xc5, 0x72, 0x77, 0x48, 0x2e, 0xb4, 0xdc, 0x59, 0xef, 0x0e, 0xa5, 0xea,
+	0xd2, 0xa2, 0xb7, 0x1c, 0x9b, 0x0f, 0x50, 0x20, 0x40, 0x2f, 0x41, 0x0f, 0x45, 0x0f, 0xfe, 0x08,
+	0x05, 0x9a, 0xe6, 0x50, 0x04, 0x3d, 0xf5, 0x50, 0xa8, 0x05, 0x7d, 0x68, 0x81, 0x7e, 0x82, 0xde,
+	0x82, 0x9d, 0x99, 0xe5, 0x3f, 0xc8, 0x26, 0x08, 0xc8, 0x17, 0x9b, 0xf3, 0xde, 0xef, 0xcd, 0x7b,
+	0xef, 0xf7, 0xfe, 0xec, 0x40, 0x50, 0xea, 0x19, 0xae, 0x55, 0x65, 0x63, 0xd7, 0x25, 0x4e, 0xf5,
+	0xe2, 0x49, 0x8f, 0x30, 0xe3, 0x89, 0x3c, 0x56, 0x3c, 0x9f, 0x32, 0x8a, 0xee, 0x85, 0x88, 0x8a,
+	0x14, 0x49, 0xc4, 0xfe, 0x5d, 0x63, 0x64, 0xbb, 0xb4, 0xca, 0xff, 0x15, 0xb8, 0xfd, 0x82, 0x49,
+	0x83, 0x11, 0x0d, 0xaa, 0x3d, 0x23, 0x20, 0xd3, 0x9b, 0x4c, 0x6a, 0xbb, 0x52, 0xbf, 0x27, 0xf4,
+	0x3a, 0x3f, 0x55, 0xc5, 0x41, 0xaa, 0xf2, 0x03, 0x3a, 0xa0, 0x42, 0x1e, 0xfe, 0x8a, 0x0c, 0x06,
+	0x94, 0x0e, 0x1c, 0x52, 0xe5, 0xa7, 0xde, 0xb8, 0x5f, 0x35, 0xdc, 0x2b, 0xa9, 0x12, 0x51, 0xf7,
+	0x09, 0xb1, 0x82, 0xa9, 0x2b, 0xe2, 0x9a, 0xd4, 0x22, 0x7e, 0x14, 0xcd, 0x0d, 0x08, 0x7e, 0x12,
+	0xfa, 0x83, 0xff, 0x6f, 0x42, 0xb2, 0xcb, 0x73, 0x42, 0xf7, 0x21, 0x66, 0x5b, 0xaa, 0x52, 0x52,
+	0xca, 0x89, 0x7a, 0x72, 0x72, 0x5d, 0x8c, 0x69, 0x0d, 0x1c, 0xb3, 0x2d, 0xb4, 0x0f, 0xa9, 0x80,
+	0xbc, 0x1a, 0x13, 0xd7, 0x24, 0x6a, 0x2c, 0xd4, 0xe2, 0xe9, 0x19, 0x3d, 0x85, 0x4d, 0x9f, 0x8e,
+	0x19, 0x51, 0xe3, 0x25, 0xa5, 0xbc, 0x7d, 0x98, 0xaf, 0x88, 0x58, 0x2b, 0x51, 0xac, 0x95, 0x9a,
+	0x7b, 0x55, 0x87, 0xef, 0x5e, 0x3f, 0x4e, 0xe2, 0x10, 0xa6, 0x61, 0x01, 0x47, 0x47, 0x90, 0xee,
+	0x13, 0xa2, 0x7b, 0xc6, 0x15, 0xf1, 0xd5, 0x44, 0x49, 0x29, 0xa7, 0xeb, 0xea, 0xdf, 0x5f, 0x3f,
+	0xce, 0x4b, 0x3a, 0x6a, 0x96, 0xe5, 0x93, 0x20, 0xe8, 0x30, 0xdf, 0x76, 0x07, 0x38, 0xd5, 0x27,
+	0xa4, 0x1d, 0x22, 0xd1, 0xcf, 0xe0, 0x6e, 0x60, 0x0f, 0x5c, 0xc3, 0xd1, 0x2d, 0x72, 0x61, 0x1b,
+	0xcc, 0xa6, 0x6e, 0xa0, 0x6e, 0x96, 0xe2, 0xe5, 0xed, 0xc3, 0x1f, 0x55, 0x6e, 0xa8, 0x4f, 0xa5,
+	0xc3, 0xd1, 0x8d, 0x08, 0x5c, 0x4f, 0x7c, 0x7b, 0x5d, 0xdc, 0xc0, 0xb9, 0x60, 0x51, 0x1c, 0x84,
+	0x39, 0xda, 0x2e, 0x23, 0xfe, 0x85, 0xe1, 0xa8, 0x49, 0x91, 0x63, 0x74, 0x46, 0x63, 0xd8, 0x61,
+	0x94, 0x71, 0x9f, 0x1e, 0x0d, 0x6c, 0xa6, 0x6e, 0x71, 0x87, 0x7b, 0x15, 0x19, 0x6c, 0x58, 0xe8,
+	0xa9, 0xc3, 0x63, 0x6a, 0xbb, 0xf5, 0xa3, 0xd0, 0xcb, 0x1f, 0xfe, 0x55, 0x2c, 0x0f, 0x6c, 0x36,
+	0x1c, 0xf7, 0x2a, 0x26, 0x1d, 0xc9, 0x42, 0xcb, 0xff, 0x1e, 0x07, 0xd6, 0x79, 0x95, 0x5d, 0x79,
+	0x24, 0xe0, 0x06, 0xc1, 0x37, 0xff, 0xf9, 0xd3, 0x23, 0x05, 0x67, 0xb8, 0x9b, 0x86, 0xf0, 0x82,
+	0x1e, 0x40, 0xda, 0x0e, 0x74, 0xc3, 0x64, 0xf6, 0x05, 0x51, 0x53, 0x25, 0xa5, 0x9c, 0xc2, 0x29,
+	0x3b, 0xa8, 0xf1, 0x33, 0x7a, 0x08, 0x60, 0xfa, 0xc4, 0x60, 0xc4, 0xd2, 0x0d, 0xa6, 0xa6, 0x4b,
+	0x4a, 0x39, 0x8e, 0xd3, 0x52, 0x52, 0x63, 0xe8, 0x10, 0xb6, 0xf8, 0x81, 0xfa, 0x2a, 0xac, 0x20,
+	0x37, 0x02, 0xa2, 0xcf, 0x20, 0x15, 0x98, 0x43, 0x62, 0x8d, 0x1d, 0xa2, 0x6e, 0xf3, 0x6a, 0x3e,
+	0xbc, 0x99, 0x52, 0x09, 0xc2, 0x53, 0x38, 0xfa, 0x35, 0xec, 0x3a, 0xf4, 0x52, 0xef, 0x19, 0x8e,
+	0xe1, 0x9a, 0x44, 0x67, 0x43, 0x9f, 0x04, 0x43, 0xea, 0x58, 0x6a, 0x66, 0x15, 0x53, 0x9f, 0xac,
+	0xcb, 0x14, 0xbe, 0xe7, 0xd0, 0xcb, 0xba, 0x70, 0xd4, 0x8d, 0xfc, 0xa0, 0x0a, 0x6c, 0xd2, 0x4b,
+	0x97, 0xf8, 0xea, 0xce, 0x8a, 0x6c, 0x05, 0xec, 0xf3, 0xc4, 0x7f, 0xbf, 0x2e, 0x2a, 0x07, 0x7f,
+	0x54, 0x20, 0x2b, 0x7a, 0xbf, 0xe5, 0x11, 0x9f, 0x93, 0xf0, 0x63, 0x48, 0x8b, 0x74, 0xf5, 0xe9,
+	0x28, 0x64, 0x26, 0xd7, 0xc5, 0x94, 0x80, 0x69, 0x0d, 0x9c, 0x12, 0x6a, 0xcd, 0x0a, 0x39, 0x36,
+	0xc4, 0xdd, 0x6a, 0x6c, 0x85, 0xd7, 0x08, 0x88, 0x9e, 0x85, 0xe3, 0xe2, 0x90, 0x40, 0x8d, 0x97,
+	0xe2, 0xe5, 0xec, 0xe1, 0x87, 0x37, 0x12, 0x1c, 0x05, 0x83, 0xa9, 0x43, 0xb0, 0xc0, 0xcb, 0x80,
+	0x35, 0x48, 0x45, 0xec, 0x23, 0x04, 0x09, 0xd3, 0xa7, 0x2e, 0x0f, 0x32, 0x8d, 0xf9, 0x6f, 0xf4,
+	0x11, 0xec, 0xf4, 0x1c, 0x6a, 0x9e, 0xeb, 0x43, 0x62, 0x0f, 0x86, 0x2c, 0x0c, 0x2c, 0x5e, 0x4e,
+	0xe0, 0x0c, 0x17, 0xbe, 0x10, 0x32, 0x79, 0xd5, 0x5f, 0x15, 0xc8, 0xbc, 0x34, 0x18, 0x09, 0x58,
+	0xdb, 0xb7, 0x4d, 0x12, 0xac, 0x93, 0xf9, 0x33, 0x48, 0x7a, 0xdc, 0x48, 0x8d, 0xc9, 0xfa, 0xf2,
+	0x34, 0xc4, 0x5a, 0x89, 0xb2, 0xe0, 0xd7, 0xca, 0x79, 0x93, 0xf0, 0x30, 0x3e, 0xc7, 0x08, 0x98,
+	0x3e, 0x1d, 0xb5, 0x38, 0x6f, 0xdc, 0x4c, 0x28, 0xd4, 0xa2, 0x71, 0x7b, 0x04, 0x77, 0x39, 0x28,
+	0xea, 0x2e, 0xde, 0xe1, 0x09, 0x0e, 0xbc, 0x13, 0x2a, 0x22, 0x06, 0xac, 0x1a, 0x93, 0xb9, 0x7c,
+	0xa5, 0x40, 0xba, 0x1b, 0x8e, 0xce, 0x09, 0x21, 0x01, 0xfa, 0x15, 0xec, 0x8a, 0x71, 0x0d, 0xbb,
+	0x4d, 0xf7, 0x0c, 0xf3, 0x9c, 0x30, 0xbd, 0x4f, 0x88, 0xaa, 0xdc, 0x7e, 0x33, 0x22, 0xee, 0xa9,
+	0x6e, 0x04, 0xa4, 0xcd, 0xfd, 0x9c, 0x10, 0x22, 0x63, 0xfa, 0x9f, 0x02, 0x49, 0x21, 0x5b, 0x87,
+	0xd9, 0x77, 0xad, 0xda, 0x19, 0xeb, 0xf1, 0xf5, 0x58, 0xaf, 0xc3, 0x96, 0x4f, 0x4c, 0x62, 0x7b,
+	0x82, 0xc6, 0xb7, 0x6d, 0x69, 0xf4, 0xdd, 0xeb, 0xc7, 0x59, 0x11, 0x32, 0x16, 0x70, 0x0d, 0x47,
+	0x86, 0x4b, 0xfb, 0x66, 0x73, 0x69, 0xdf, 0x1c, 0xfc, 0x53, 0x81, 0xad, 0x68, 0x6f, 0xad, 0x91,
+	0xee, 0x53, 0x48, 0xcb, 0x9d, 0x4a, 0xfd, 0x95, 0x43, 0x34, 0x83, 0xa2, 0x21, 0x24, 0x8d, 0x11,
+	0x1d, 0xbb, 0x6c, 0x4a, 0xc5, 0x6d, 0xaf, 0x62, 0x79, 0xbf, 0x2c, 0xe6, 0x9f, 0xe3, 0xb0, 0x73,
+	0x22, 0xbf, 0x41, 0x5d, 0xea, 0x9d, 0x79, 0xeb, 0x24, 0xf9, 0x09, 0x24, 0xfb, 0x63, 0xd7, 0x22,
+	0xab, 0x33, 0x94, 0x38, 0x74, 0x09, 0x59, 0x66, 0xf8, 0x03, 0xc2, 0xa2, 0x8d, 0xfa, 0xde, 0xd2,
+	0xdc, 0x11, 0x7e, 0xe4, 0x3e, 0x45, 0x3d, 0x88, 0x9b, 0x86, 0xa7, 0x26, 0xde, 0x93, 0xb7, 0xf0,
+	0x72, 0x14, 0xc0, 0x1d, 0x31, 0x9e, 0x8c, 0x7a, 0x1e, 0xb1, 0xf4, 0xb1, 0xa7, 0x6e, 0xae, 0xf2,
+	0xb7, 0xfe, 0x60, 0x8a, 0x2f, 0x76, 0x97, 0xbb, 0x38, 0xf3, 0xa2, 0x3d, 0x11, 0x83, 0x3b, 0x4b,
+	0x0f, 0x82, 0xb0, 0x90, 0xf2, 0x45, 0x21, 0x0b, 0x99, 0x16, 0x85, 0x14, 0xb8, 0xb0, 0x90, 0x42,
+	0xad, 0x59, 0xa8, 0x0e, 0x28, 0xa0, 0x7d, 0x36, 0x7b, 0x7a, 0xe8, 0x3d, 0x4f, 0xec, 0xfe, 0x44,
+	0x3d, 0x3f, 0xb9, 0x2e, 0xe6, 0x3a, 0xb4, 0xcf, 0x66, 0x4f, 0x8d, 0x76, 0x07, 0xe7, 0x82, 0x05,
+	0x89, 0x17, 0xce, 0x22, 0x1a, 0x1a, 0xbe, 0xb5, 0x74, 0x47, 0x7c, 0x76, 0xc7, 0x0b, 0xc3, 0xb7,
+	0x16, 0xef, 0x18, 0x2e, 0x48, 0xbc, 0x00, 0x35, 0x01, 0x2c, 0xe2, 0xdb, 0x17, 0x5c, 0x20, 0x47,
+	0xfa, 0xe3, 0x77, 0xbe, 0x7e, 0x22, 0x30, 0x9e, 0x33, 0x8c, 0xbe, 0x81, 0x31, 0xc8, 0x2d, 0xc3,
+	0xd0, 0x33, 0x48, 0x84, 0x64, 0x72, 0x3e, 0xb2, 0x87, 0x1f, 0xdd, 0x78, 0xf7, 0x0c, 0xde, 0xbd,
+	0xf2, 0x08, 0xe6, 0x06, 0xe8, 0x29, 0x64, 0xf9, 0xd6, 0x9d, 0x51, 0x2a, 0x7a, 0x3e, 0x37, 0xb9,
+	0x2e, 0x66, 0xc2, 0x35, 0x39, 0xa5, 0x35, 0xd3, 0x9b, 0x9d, 0x2c, 0xf4, 0x19, 0xdc, 0x79, 0x35,
+	0xa6, 0x6c, 0xde, 0x30, 0xce, 0x0d, 0xef, 0x4e, 0xae, 0x8b, 0x3b, 0x3f, 0x0d, 0x55, 0x53, 0xcb,
+	0x9d, 0x57, 0x73, 0x47, 0x0b, 0x3d, 0x81, 0xfc, 0x68, 0xec, 0x30, 0xdb, 0x73, 0x6c, 0xe2, 0xeb,
+	0xee, 0x78, 0x24, 0x3e, 0x9e, 0x9c, 0x97, 0x04, 0xbe, 0x37, 0xd3, 0x9d, 0x46, 0x2a, 0x74, 0x04,
+	0xf7, 0xe7, 0x4c, 0x2c, 0xe2, 0xd2, 0x91, 0xed, 0x72, 0xa3, 0x4d, 0x6e, 0xb4, 0x3b, 0xd3, 0x36,
+	0x66, 0x4a, 0x49, 0xd8, 0x5f, 0x14, 0xc8, 0x8b, 0x29, 0xe7, 0x21, 0xb0, 0xb1, 0x4f, 0x5a, 0x7e,
+	0x38, 0xb5, 0xf3, 0xbb, 0x5b, 0x79, 0xeb, 0xee, 0x5e, 0xf3, 0x8b, 0xb9, 0xb8, 0x77, 0xe3, 0xcb,
+	0xef, 0xbc, 0x23, 0xd8, 0x92, 0xcf, 0x7d, 0x9e, 0x6f, 0xf6, 0xf0, 0xc1, 0x4d, 0x17, 0x37, 0x05,
+	0x04, 0x47, 0xd8, 0xcf, 0x13, 0x5f, 0x7e, 0x5d, 0xdc, 0x38, 0xf8, 0x9b, 0x02, 0x7b, 0x22, 0x93,
+	0xba, 0xc1, 0xcc, 0xe1, 0x52, 0x3a, 0x2f, 0x20, 0x6f, 0x91, 0x80, 0xd9, 0xae, 0x68, 0x53, 0x73,
+	0x68, 0xd8, 0xee, 0x6c, 0x46, 0xee, 0x4f, 0xae, 0x8b, 0xa8, 0x31, 0xd3, 0x1f, 0x87, 0x6a, 0xad,
+	0x81, 0x91, 0xb5, 0x2c, 0xb3, 0x50, 0x11, 0xb6, 0x47, 0xc4, 0x3f, 0x77, 0x88, 0xee, 0x53, 0xca,
+	0x78, 0x47, 0x64, 0x30, 0x08, 0x11, 0xa6, 0x94, 0xa1, 0x0f, 0x21, 0x23, 0x3f, 0xd3, 0xa6, 0x5c,
+	0xea, 0x21, 0x7b, 0xdb, 0x42, 0x76, 0x1c, 0x8a, 0x96, 0x78, 0x48, 0x2c, 0xf1, 0x30, 0x4b, 0x68,
+	0xb7, 0x4d, 0x5c, 0xcb, 0x76, 0x07, 0xdd, 0x4e, 0x87, 0x27, 0x75, 0xbb, 0x9f, 0x60, 0x17, 0x32,
+	0x24, 0x30, 0x7d, 0x7a, 0x49, 0x2c, 0xfe, 0xa2, 0x88, 0xdf, 0xfe, 0xe2, 0xda, 0x8e, 0x1c, 0x9c,
+	0x10, 0x72, 0xf0, 0x3b, 0x05, 0x72, 0xa2, 0x78, 0x56, 0xb7, 0xd3, 0x91, 0xb9, 0xbc, 0xab, 0xcf,
+	0x4e, 0x21, 0xe3, 0x13, 0xc7, 0xb8, 0xd2, 0x17, 0xba, 0xed, 0xe3, 0xb7, 0x37, 0x85, 0x85, 0x43,
+	0xf8, 0x7c, 0xe7, 0x6d, 0xfb, 0x53, 0xc9, 0xaa, 0xf6, 0x7b, 0xf4, 0x1b, 0x05, 0x32, 0xf3, 0xaf,
+	0x55, 0xf4, 0x10, 0xf6, 0x5a, 0xed, 0x26, 0xae, 0x75, 0x5b, 0x58, 0xc7, 0xad, 0x97, 0x4d, 0xfd,
+	0xec, 0xb4, 0xd3, 0x6e, 0x1e, 0x6b, 0x27, 0x5a, 0xb3, 0x91, 0xdb, 0x40, 0x7b, 0xb0, 0xbb, 0xa8,
+	0xee, 0x62, 0xed, 0xf9, 0xf3, 0x26, 0xce, 0x29, 0x48, 0x85, 0xfc, 0xa2, 0xea, 0xb8, 0x75, 0x7a,
+	0xa2, 0x3d, 0xcf, 0xc5, 0xd0, 0x07, 0x70, 0x6f, 0x51, 0x73, 0x72, 0x76, 0xda, 0xe8, 0xe4, 0xe2,
+	0xfb, 0x89, 0x2f, 0x7f, 0x5f, 0xd8, 0x78, 0xf4, 0x95, 0x02, 0xd9, 0xc5, 0x5d, 0x84, 0x8a, 0xf0,
+	0xa0, 0xd1, 0xc4, 0xda, 0x17, 0xb5, 0xae, 0xd6, 0x3a, 0xd5, 0xbb, 0x3f, 0x6f, 0x2f, 0xc7, 0x51,
+	0x80, 0xfd, 0x65, 0xc0, 0x31, 0x6e, 0x75, 0x3a, 0x3a, 0xae, 0x75, 0x9b, 0x39, 0x05, 0x3d, 0x80,
+	0x0f, 0x96, 0xf5, 0xda, 0xe9, 0x17, 0x4d, 0xdc, 0x69, 0xe6, 0x62, 0x37, 0x29, 0x71, 0xb3, 0x73,
+	0x5c, 0x7b, 0xd9, 0x8c, 0x62, 0xaa, 0xff, 0xe4, 0x9b, 0x49, 0x41, 0xf9, 0x76, 0x52, 0x50, 0xbe,
+	0x9f, 0x14, 0x94, 0x7f, 0x4f, 0x0a, 0xca, 0x6f, 0xdf, 0x14, 0x36, 0xbe, 0x7f, 0x53, 0xd8, 0xf8,
+	0xc7, 0x9b, 0xc2, 0xc6, 0x2f, 0xaa, 0x73, 0xcd, 0x10, 0x16, 0x86, 0xbf, 0xc2, 0x4c, 0xea, 0x54,
+	0xf9, 0x8c, 0x55, 0x2f, 0x3e, 0xad, 0xfe, 0x32, 0xfa, 0x43, 0x04, 0xef, 0x8c, 0x5e, 0x92, 0x23,
+	0x3e, 0xfd, 0x61, 0x00, 0x66, 0x80, 0x87, 0x2a, 0xa4, 0x10, 0x00, 0x00,
+}
+
+func (this *Tunnel) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Tunnel)
+	if !ok {
+		that2, ok := that.(Tunnel)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
 	if that1 == nil {
@@ -653,6 +1287,87 @@ func (this *Tunnel) Equal(that interface{}) bool {
 	if this.Creator != that1.Creator {
 		return false
 	}
+	if !this.Schedule.Equal(that1.Schedule) {
+		return false
+	}
+	if len(this.LowBalanceThreshold) != len(that1.LowBalanceThreshold) {
+		return false
+	}
+	for i := range this.LowBalanceThreshold {
+		if !this.LowBalanceThreshold[i].Equal(&that1.LowBalanceThreshold[i]) {
+			return false
+		}
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	return true
+}
+func (this *TunnelOperator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TunnelOperator)
+	if !ok {
+		that2, ok := that.(TunnelOperator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TunnelID != that1.TunnelID {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if len(this.Roles) != len(that1.Roles) {
+		return false
+	}
+	for i := range this.Roles {
+		if this.Roles[i] != that1.Roles[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *Schedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Schedule)
+	if !ok {
+		that2, ok := that.(Schedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Cron != that1.Cron {
+		return false
+	}
+	if len(this.BlockHeights) != len(that1.BlockHeights) {
+		return false
+	}
+	for i := range this.BlockHeights {
+		if this.BlockHeights[i] != that1.BlockHeights[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *LatestPrices) Equal(that interface{}) bool {
@@ -688,6 +1403,9 @@ func (this *LatestPrices) Equal(that interface{}) bool {
 	if this.LastInterval != that1.LastInterval {
 		return false
 	}
+	if this.LastScheduledAt != that1.LastScheduledAt {
+		return false
+	}
 	return true
 }
 func (this *TotalFees) Equal(that interface{}) bool {
@@ -795,6 +1513,57 @@ func (this *Deposit) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *FeePayerTopUp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeePayerTopUp)
+	if !ok {
+		that2, ok := that.(FeePayerTopUp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TunnelID != that1.TunnelID {
+		return false
+	}
+	if this.Funder != that1.Funder {
+		return false
+	}
+	if len(this.TargetBalance) != len(that1.TargetBalance) {
+		return false
+	}
+	for i := range this.TargetBalance {
+		if !this.TargetBalance[i].Equal(&that1.TargetBalance[i]) {
+			return false
+		}
+	}
+	if len(this.Cap) != len(that1.Cap) {
+		return false
+	}
+	for i := range this.Cap {
+		if !this.Cap[i].Equal(&that1.Cap[i]) {
+			return false
+		}
+	}
+	if len(this.TotalToppedUp) != len(that1.TotalToppedUp) {
+		return false
+	}
+	for i := range this.TotalToppedUp {
+		if !this.TotalToppedUp[i].Equal(&that1.TotalToppedUp[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *SignalDeviation) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -823,6 +1592,45 @@ func (this *SignalDeviation) Equal(that interface{}) bool {
 	if this.HardDeviationBPS != that1.HardDeviationBPS {
 		return false
 	}
+	if !this.Derivation.Equal(that1.Derivation) {
+		return false
+	}
+	return true
+}
+func (this *SignalDerivation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SignalDerivation)
+	if !ok {
+		that2, ok := that.(SignalDerivation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.BaseSignalID != that1.BaseSignalID {
+		return false
+	}
+	if this.QuoteSignalID != that1.QuoteSignalID {
+		return false
+	}
+	if this.MultiplierNumerator != that1.MultiplierNumerator {
+		return false
+	}
+	if this.MultiplierDenominator != that1.MultiplierDenominator {
+		return false
+	}
 	return true
 }
 func (this *TunnelSignatureOrder) Equal(that interface{}) bool {
@@ -863,11 +1671,114 @@ func (this *TunnelSignatureOrder) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (m *Tunnel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (this *TunnelBatchSignatureOrder) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TunnelBatchSignatureOrder)
+	if !ok {
+		that2, ok := that.(TunnelBatchSignatureOrder)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DestinationChainID != that1.DestinationChainID {
+		return false
+	}
+	if !bytes.Equal(this.MerkleRoot, that1.MerkleRoot) {
+		return false
+	}
+	if this.PacketCount != that1.PacketCount {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	return true
+}
+func (this *PendingTSSBatchPacket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingTSSBatchPacket)
+	if !ok {
+		that2, ok := that.(PendingTSSBatchPacket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TunnelID != that1.TunnelID {
+		return false
+	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	if len(this.EscrowedFee) != len(that1.EscrowedFee) {
+		return false
+	}
+	for i := range this.EscrowedFee {
+		if !this.EscrowedFee[i].Equal(&that1.EscrowedFee[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *EncodedTSSPacket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EncodedTSSPacket)
+	if !ok {
+		that2, ok := that.(EncodedTSSPacket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	if len(this.RelayPrices) != len(that1.RelayPrices) {
+		return false
+	}
+	for i := range this.RelayPrices {
+		if !this.RelayPrices[i].Equal(&that1.RelayPrices[i]) {
+			return false
+		}
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	return true
+}
+func (m *Tunnel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
@@ -883,6 +1794,39 @@ func (m *Tunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.LowBalanceThreshold) > 0 {
+		for iNdEx := len(m.LowBalanceThreshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LowBalanceThreshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTunnel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTunnel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -970,6 +1914,107 @@ func (m *Tunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TunnelOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TunnelOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TunnelOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		dAtA4 := make([]byte, len(m.Roles)*10)
+		var j3 int
+		for _, num := range m.Roles {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTunnel(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockHeights) > 0 {
+		dAtA6 := make([]byte, len(m.BlockHeights)*10)
+		var j5 int
+		for _, num := range m.BlockHeights {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTunnel(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Cron) > 0 {
+		i -= len(m.Cron)
+		copy(dAtA[i:], m.Cron)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.Cron)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LatestPrices) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -990,6 +2035,11 @@ func (m *LatestPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastScheduledAt != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.LastScheduledAt))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.LastInterval != 0 {
 		i = encodeVarintTunnel(dAtA, i, uint64(m.LastInterval))
 		i--
@@ -1167,6 +2217,83 @@ func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeePayerTopUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePayerTopUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePayerTopUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalToppedUp) > 0 {
+		for iNdEx := len(m.TotalToppedUp) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalToppedUp[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTunnel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Cap) > 0 {
+		for iNdEx := len(m.Cap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTunnel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TargetBalance) > 0 {
+		for iNdEx := len(m.TargetBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TargetBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTunnel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SignalDeviation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1187,6 +2314,18 @@ func (m *SignalDeviation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Derivation != nil {
+		{
+			size, err := m.Derivation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTunnel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.HardDeviationBPS != 0 {
 		i = encodeVarintTunnel(dAtA, i, uint64(m.HardDeviationBPS))
 		i--
@@ -1207,7 +2346,7 @@ func (m *SignalDeviation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TunnelSignatureOrder) Marshal() (dAtA []byte, err error) {
+func (m *SignalDerivation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1217,27 +2356,79 @@ func (m *TunnelSignatureOrder) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TunnelSignatureOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignalDerivation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TunnelSignatureOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignalDerivation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Encoder != 0 {
-		i = encodeVarintTunnel(dAtA, i, uint64(m.Encoder))
+	if m.MultiplierDenominator != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.MultiplierDenominator))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MultiplierNumerator != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.MultiplierNumerator))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.CreatedAt != 0 {
-		i = encodeVarintTunnel(dAtA, i, uint64(m.CreatedAt))
+	if len(m.QuoteSignalID) > 0 {
+		i -= len(m.QuoteSignalID)
+		copy(dAtA[i:], m.QuoteSignalID)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.QuoteSignalID)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if len(m.Prices) > 0 {
+	if len(m.BaseSignalID) > 0 {
+		i -= len(m.BaseSignalID)
+		copy(dAtA[i:], m.BaseSignalID)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.BaseSignalID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TunnelSignatureOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TunnelSignatureOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TunnelSignatureOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Encoder != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.Encoder))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Prices) > 0 {
 		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
 			{
 				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
@@ -1259,6 +2450,147 @@ func (m *TunnelSignatureOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TunnelBatchSignatureOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TunnelBatchSignatureOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TunnelBatchSignatureOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PacketCount != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.PacketCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DestinationChainID) > 0 {
+		i -= len(m.DestinationChainID)
+		copy(dAtA[i:], m.DestinationChainID)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.DestinationChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingTSSBatchPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTSSBatchPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTSSBatchPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EscrowedFee) > 0 {
+		for iNdEx := len(m.EscrowedFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowedFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTunnel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncodedTSSPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncodedTSSPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncodedTSSPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RelayPrices) > 0 {
+		for iNdEx := len(m.RelayPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTunnel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTunnel(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTunnel(v)
 	base := offset
@@ -1315,6 +2647,63 @@ func (m *Tunnel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTunnel(uint64(l))
 	}
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovTunnel(uint64(l))
+	}
+	if len(m.LowBalanceThreshold) > 0 {
+		for _, e := range m.LowBalanceThreshold {
+			l = e.Size()
+			n += 1 + l + sovTunnel(uint64(l))
+		}
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTunnel(uint64(l))
+	}
+	return n
+}
+
+func (m *TunnelOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTunnel(uint64(m.TunnelID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTunnel(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		l = 0
+		for _, e := range m.Roles {
+			l += sovTunnel(uint64(e))
+		}
+		n += 1 + sovTunnel(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cron)
+	if l > 0 {
+		n += 1 + l + sovTunnel(uint64(l))
+	}
+	if len(m.BlockHeights) > 0 {
+		l = 0
+		for _, e := range m.BlockHeights {
+			l += sovTunnel(uint64(e))
+		}
+		n += 1 + sovTunnel(uint64(l)) + l
+	}
 	return n
 }
 
@@ -1336,6 +2725,9 @@ func (m *LatestPrices) Size() (n int) {
 	if m.LastInterval != 0 {
 		n += 1 + sovTunnel(uint64(m.LastInterval))
 	}
+	if m.LastScheduledAt != 0 {
+		n += 1 + sovTunnel(uint64(m.LastScheduledAt))
+	}
 	return n
 }
 
@@ -1404,6 +2796,40 @@ func (m *Deposit) Size() (n int) {
 	return n
 }
 
+func (m *FeePayerTopUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTunnel(uint64(m.TunnelID))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovTunnel(uint64(l))
+	}
+	if len(m.TargetBalance) > 0 {
+		for _, e := range m.TargetBalance {
+			l = e.Size()
+			n += 1 + l + sovTunnel(uint64(l))
+		}
+	}
+	if len(m.Cap) > 0 {
+		for _, e := range m.Cap {
+			l = e.Size()
+			n += 1 + l + sovTunnel(uint64(l))
+		}
+	}
+	if len(m.TotalToppedUp) > 0 {
+		for _, e := range m.TotalToppedUp {
+			l = e.Size()
+			n += 1 + l + sovTunnel(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *SignalDeviation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1420,6 +2846,36 @@ func (m *SignalDeviation) Size() (n int) {
 	if m.HardDeviationBPS != 0 {
 		n += 1 + sovTunnel(uint64(m.HardDeviationBPS))
 	}
+	if m.Derivation != nil {
+		l = m.Derivation.Size()
+		n += 1 + l + sovTunnel(uint64(l))
+	}
+	return n
+}
+
+func (m *SignalDerivation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTunnel(uint64(m.Type))
+	}
+	l = len(m.BaseSignalID)
+	if l > 0 {
+		n += 1 + l + sovTunnel(uint64(l))
+	}
+	l = len(m.QuoteSignalID)
+	if l > 0 {
+		n += 1 + l + sovTunnel(uint64(l))
+	}
+	if m.MultiplierNumerator != 0 {
+		n += 1 + sovTunnel(uint64(m.MultiplierNumerator))
+	}
+	if m.MultiplierDenominator != 0 {
+		n += 1 + sovTunnel(uint64(m.MultiplierDenominator))
+	}
 	return n
 }
 
@@ -1447,22 +2903,87 @@ func (m *TunnelSignatureOrder) Size() (n int) {
 	return n
 }
 
-func sovTunnel(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTunnel(x uint64) (n int) {
-	return sovTunnel(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Tunnel) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTunnel
-			}
+func (m *TunnelBatchSignatureOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DestinationChainID)
+	if l > 0 {
+		n += 1 + l + sovTunnel(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTunnel(uint64(l))
+	}
+	if m.PacketCount != 0 {
+		n += 1 + sovTunnel(uint64(m.PacketCount))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovTunnel(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *PendingTSSBatchPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTunnel(uint64(m.TunnelID))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTunnel(uint64(m.Sequence))
+	}
+	if len(m.EscrowedFee) > 0 {
+		for _, e := range m.EscrowedFee {
+			l = e.Size()
+			n += 1 + l + sovTunnel(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EncodedTSSPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTunnel(uint64(m.Sequence))
+	}
+	if len(m.RelayPrices) > 0 {
+		for _, e := range m.RelayPrices {
+			l = e.Size()
+			n += 1 + l + sovTunnel(uint64(l))
+		}
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovTunnel(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func sovTunnel(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTunnel(x uint64) (n int) {
+	return sovTunnel(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Tunnel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTunnel
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1496,16 +3017,1297 @@ func (m *Tunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Route == nil {
+				m.Route = &types.Any{}
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalDeviations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalDeviations = append(m.SignalDeviations, SignalDeviation{})
+			if err := m.SignalDeviations[len(m.SignalDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalDeposit = append(m.TotalDeposit, types1.Coin{})
+			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &Schedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowBalanceThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LowBalanceThreshold = append(m.LowBalanceThreshold, types1.Coin{})
+			if err := m.LowBalanceThreshold[len(m.LowBalanceThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTunnel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TunnelOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTunnel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TunnelOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TunnelOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v OperatorRole
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTunnel
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= OperatorRole(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Roles = append(m.Roles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTunnel
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTunnel
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTunnel
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Roles) == 0 {
+					m.Roles = make([]OperatorRole, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v OperatorRole
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTunnel
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= OperatorRole(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Roles = append(m.Roles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTunnel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Schedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTunnel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cron = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTunnel
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlockHeights = append(m.BlockHeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTunnel
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTunnel
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTunnel
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlockHeights) == 0 {
+					m.BlockHeights = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTunnel
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlockHeights = append(m.BlockHeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeights", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTunnel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTunnel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, types2.Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInterval", wireType)
+			}
+			m.LastInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledAt", wireType)
+			}
+			m.LastScheduledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastScheduledAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTunnel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTunnel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBasePacketFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBasePacketFee = append(m.TotalBasePacketFee, types1.Coin{})
+			if err := m.TotalBasePacketFee[len(m.TotalBasePacketFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTunnel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Packet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTunnel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Packet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Packet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, types2.Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Receipt == nil {
+				m.Receipt = &types.Any{}
+			}
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTunnel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTunnel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTunnel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeePayerTopUp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTunnel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePayerTopUp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePayerTopUp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
 			}
-			m.Sequence = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -1515,14 +4317,27 @@ func (m *Tunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBalance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1549,18 +4364,16 @@ func (m *Tunnel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Route == nil {
-				m.Route = &types.Any{}
-			}
-			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TargetBalance = append(m.TargetBalance, types1.Coin{})
+			if err := m.TargetBalance[len(m.TargetBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -1570,27 +4383,29 @@ func (m *Tunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTunnel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTunnel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			m.Cap = append(m.Cap, types1.Coin{})
+			if err := m.Cap[len(m.Cap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalDeviations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalToppedUp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1617,35 +4432,66 @@ func (m *Tunnel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalDeviations = append(m.SignalDeviations, SignalDeviation{})
-			if err := m.SignalDeviations[len(m.SignalDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalToppedUp = append(m.TotalToppedUp, types1.Coin{})
+			if err := m.TotalToppedUp[len(m.TotalToppedUp)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTunnel(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Interval = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTunnel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Interval |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTunnel
 			}
-		case 7:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalDeviation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTunnel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalDeviation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -1655,31 +4501,29 @@ func (m *Tunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTunnel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTunnel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDeposit = append(m.TotalDeposit, types1.Coin{})
-			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SignalID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftDeviationBPS", wireType)
 			}
-			var v int
+			m.SoftDeviationBPS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -1689,17 +4533,16 @@ func (m *Tunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SoftDeviationBPS |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsActive = bool(v != 0)
-		case 9:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HardDeviationBPS", wireType)
 			}
-			m.CreatedAt = 0
+			m.HardDeviationBPS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -1709,16 +4552,16 @@ func (m *Tunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				m.HardDeviationBPS |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Derivation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -1728,23 +4571,27 @@ func (m *Tunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTunnel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTunnel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if m.Derivation == nil {
+				m.Derivation = &SignalDerivation{}
+			}
+			if err := m.Derivation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1767,7 +4614,7 @@ func (m *Tunnel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LatestPrices) Unmarshal(dAtA []byte) error {
+func (m *SignalDerivation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1790,17 +4637,17 @@ func (m *LatestPrices) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LatestPrices: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalDerivation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LatestPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalDerivation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.TunnelID = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -1810,50 +4657,16 @@ func (m *LatestPrices) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TunnelID |= uint64(b&0x7F) << shift
+				m.Type |= DerivationType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTunnel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTunnel
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTunnel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Prices = append(m.Prices, types2.Price{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseSignalID", wireType)
 			}
-			m.LastInterval = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -1863,66 +4676,29 @@ func (m *LatestPrices) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastInterval |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTunnel(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTunnel
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TotalFees) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTunnel
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TotalFees: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalFees: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseSignalID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBasePacketFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteSignalID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -1932,26 +4708,62 @@ func (m *TotalFees) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTunnel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTunnel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalBasePacketFee = append(m.TotalBasePacketFee, types1.Coin{})
-			if err := m.TotalBasePacketFee[len(m.TotalBasePacketFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.QuoteSignalID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiplierNumerator", wireType)
+			}
+			m.MultiplierNumerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MultiplierNumerator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiplierDenominator", wireType)
+			}
+			m.MultiplierDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MultiplierDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTunnel(dAtA[iNdEx:])
@@ -1973,7 +4785,7 @@ func (m *TotalFees) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Packet) Unmarshal(dAtA []byte) error {
+func (m *TunnelSignatureOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1996,32 +4808,13 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Packet: wiretype end group for non-group")
+			return fmt.Errorf("proto: TunnelSignatureOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Packet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TunnelSignatureOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
-			}
-			m.TunnelID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTunnel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TunnelID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
@@ -2040,7 +4833,7 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
@@ -2074,11 +4867,11 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var msglen int
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -2088,33 +4881,16 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CreatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTunnel
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTunnel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Receipt == nil {
-				m.Receipt = &types.Any{}
-			}
-			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
 			}
-			m.CreatedAt = 0
+			m.Encoder = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -2124,7 +4900,7 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				m.Encoder |= types2.Encoder(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2150,7 +4926,7 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deposit) Unmarshal(dAtA []byte) error {
+func (m *TunnelBatchSignatureOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2173,17 +4949,17 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: TunnelBatchSignatureOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TunnelBatchSignatureOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChainID", wireType)
 			}
-			m.TunnelID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -2193,16 +4969,29 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TunnelID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -2212,29 +5001,31 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTunnel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTunnel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketCount", wireType)
 			}
-			var msglen int
+			m.PacketCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -2244,26 +5035,30 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PacketCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTunnel
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTunnel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTunnel(dAtA[iNdEx:])
@@ -2285,7 +5080,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalDeviation) Unmarshal(dAtA []byte) error {
+func (m *PendingTSSBatchPacket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2308,17 +5103,17 @@ func (m *SignalDeviation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalDeviation: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingTSSBatchPacket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingTSSBatchPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
 			}
-			var stringLen uint64
+			m.TunnelID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -2328,29 +5123,16 @@ func (m *SignalDeviation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TunnelID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTunnel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTunnel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SignalID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SoftDeviationBPS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			m.SoftDeviationBPS = 0
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -2360,16 +5142,16 @@ func (m *SignalDeviation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SoftDeviationBPS |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HardDeviationBPS", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedFee", wireType)
 			}
-			m.HardDeviationBPS = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -2379,11 +5161,26 @@ func (m *SignalDeviation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HardDeviationBPS |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowedFee = append(m.EscrowedFee, types1.Coin{})
+			if err := m.EscrowedFee[len(m.EscrowedFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTunnel(dAtA[iNdEx:])
@@ -2405,7 +5202,7 @@ func (m *SignalDeviation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TunnelSignatureOrder) Unmarshal(dAtA []byte) error {
+func (m *EncodedTSSPacket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2428,10 +5225,10 @@ func (m *TunnelSignatureOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TunnelSignatureOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: EncodedTSSPacket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TunnelSignatureOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EncodedTSSPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2455,7 +5252,7 @@ func (m *TunnelSignatureOrder) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayPrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2482,8 +5279,8 @@ func (m *TunnelSignatureOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, types2.Price{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RelayPrices = append(m.RelayPrices, types2.EncodedRelayPrice{})
+			if err := m.RelayPrices[len(m.RelayPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2506,25 +5303,6 @@ func (m *TunnelSignatureOrder) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
-			}
-			m.Encoder = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTunnel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Encoder |= types2.Encoder(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTunnel(dAtA[iNdEx:])
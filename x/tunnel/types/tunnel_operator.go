@@ -0,0 +1,51 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// NewTunnelOperator creates a new TunnelOperator instance.
+func NewTunnelOperator(tunnelID uint64, address string, roles []OperatorRole) TunnelOperator {
+	return TunnelOperator{
+		TunnelID: tunnelID,
+		Address:  address,
+		Roles:    roles,
+	}
+}
+
+// Validate validates the tunnel operator.
+func (o TunnelOperator) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(o.Address); err != nil {
+		return ErrInvalidTunnelOperator.Wrapf("invalid operator address: %s", err)
+	}
+
+	if len(o.Roles) == 0 {
+		return ErrInvalidTunnelOperator.Wrap("roles cannot be empty")
+	}
+
+	return ValidateOperatorRoles(o.Roles)
+}
+
+// HasRole returns true if the operator has the given role.
+func (o TunnelOperator) HasRole(role OperatorRole) bool {
+	for _, r := range o.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateOperatorRoles validates that the roles are known and not duplicated.
+func ValidateOperatorRoles(roles []OperatorRole) error {
+	seen := make(map[OperatorRole]bool)
+	for _, role := range roles {
+		if _, ok := OperatorRole_name[int32(role)]; !ok || role == OPERATOR_ROLE_UNSPECIFIED {
+			return ErrInvalidTunnelOperator.Wrapf("invalid role: %s", role)
+		}
+		if seen[role] {
+			return ErrInvalidTunnelOperator.Wrapf("duplicate role: %s", role)
+		}
+		seen[role] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func TestTunnelOperator_Validate(t *testing.T) {
+	operator := sdk.AccAddress("operator").String()
+
+	tests := []struct {
+		name      string
+		operator  types.TunnelOperator
+		expErrMsg string
+	}{
+		{
+			name: "valid operator",
+			operator: types.NewTunnelOperator(1, operator, []types.OperatorRole{
+				types.OPERATOR_ROLE_TRIGGER,
+				types.OPERATOR_ROLE_FUNDS,
+			}),
+		},
+		{
+			name:      "invalid address",
+			operator:  types.NewTunnelOperator(1, "invalid", []types.OperatorRole{types.OPERATOR_ROLE_TRIGGER}),
+			expErrMsg: "invalid operator address",
+		},
+		{
+			name:      "empty roles",
+			operator:  types.NewTunnelOperator(1, operator, nil),
+			expErrMsg: "roles cannot be empty",
+		},
+		{
+			name:      "unknown role",
+			operator:  types.NewTunnelOperator(1, operator, []types.OperatorRole{types.OperatorRole(100)}),
+			expErrMsg: "invalid role",
+		},
+		{
+			name: "duplicate role",
+			operator: types.NewTunnelOperator(1, operator, []types.OperatorRole{
+				types.OPERATOR_ROLE_CONFIG,
+				types.OPERATOR_ROLE_CONFIG,
+			}),
+			expErrMsg: "duplicate role",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.operator.Validate()
+			if tc.expErrMsg != "" {
+				require.ErrorIs(t, err, types.ErrInvalidTunnelOperator)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTunnelOperator_HasRole(t *testing.T) {
+	operator := types.NewTunnelOperator(
+		1,
+		sdk.AccAddress("operator").String(),
+		[]types.OperatorRole{types.OPERATOR_ROLE_TRIGGER},
+	)
+
+	require.True(t, operator.HasRole(types.OPERATOR_ROLE_TRIGGER))
+	require.False(t, operator.HasRole(types.OPERATOR_ROLE_CONFIG))
+	require.False(t, operator.HasRole(types.OPERATOR_ROLE_FUNDS))
+}
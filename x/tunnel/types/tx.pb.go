@@ -46,6 +46,8 @@ type MsgCreateTunnel struct {
 	InitialDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=initial_deposit,json=initialDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_deposit"`
 	// creator is the address of the creator.
 	Creator string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	// schedule is the optional schedule for delivering the signal prices.
+	Schedule *Schedule `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule,omitempty"`
 }
 
 func (m *MsgCreateTunnel) Reset()         { *m = MsgCreateTunnel{} }
@@ -116,6 +118,13 @@ func (m *MsgCreateTunnel) GetCreator() string {
 	return ""
 }
 
+func (m *MsgCreateTunnel) GetSchedule() *Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
 // MsgCreateTunnelResponse is the response type for the Msg/CreateTunnel RPC method.
 type MsgCreateTunnelResponse struct {
 	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
@@ -167,7 +176,7 @@ type MsgUpdateRoute struct {
 	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
 	// route is the route for delivering the signal prices
 	Route *types.Any `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
-	// creator is the address of the creator.
+	// creator is the address of the owner or an operator of the tunnel.
 	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
@@ -270,8 +279,10 @@ type MsgUpdateSignalsAndInterval struct {
 	SignalDeviations []SignalDeviation `protobuf:"bytes,2,rep,name=signal_deviations,json=signalDeviations,proto3" json:"signal_deviations"`
 	// interval is the interval for delivering the signal prices.
 	Interval uint64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
-	// creator is the address of the creator.
+	// creator is the address of the owner or an operator of the tunnel.
 	Creator string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	// schedule is the optional schedule for delivering the signal prices.
+	Schedule *Schedule `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"`
 }
 
 func (m *MsgUpdateSignalsAndInterval) Reset()         { *m = MsgUpdateSignalsAndInterval{} }
@@ -335,6 +346,13 @@ func (m *MsgUpdateSignalsAndInterval) GetCreator() string {
 	return ""
 }
 
+func (m *MsgUpdateSignalsAndInterval) GetSchedule() *Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
 // MsgUpdateSignalsAndIntervalResponse is the response type for the Msg/UpdateSignalsAndInterval RPC method.
 type MsgUpdateSignalsAndIntervalResponse struct {
 }
@@ -378,7 +396,7 @@ type MsgWithdrawFeePayerFunds struct {
 	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
 	// amount is the coins to withdraw.
 	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
-	// creator is the address of the creator.
+	// creator is the address of the owner or an operator of the tunnel.
 	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
@@ -477,7 +495,7 @@ var xxx_messageInfo_MsgWithdrawFeePayerFundsResponse proto.InternalMessageInfo
 type MsgActivateTunnel struct {
 	// tunnel_id is the ID of the tunnel to activate.
 	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
-	// creator is the address of the creator.
+	// creator is the address of the owner or an operator of the tunnel.
 	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
@@ -569,7 +587,7 @@ var xxx_messageInfo_MsgActivateTunnelResponse proto.InternalMessageInfo
 type MsgDeactivateTunnel struct {
 	// tunnel_id is the ID of the tunnel to deactivate.
 	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
-	// creator is the address of the creator.
+	// creator is the address of the owner or an operator of the tunnel.
 	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
@@ -661,7 +679,7 @@ var xxx_messageInfo_MsgDeactivateTunnelResponse proto.InternalMessageInfo
 type MsgTriggerTunnel struct {
 	// tunnel_id is the ID of the tunnel to manually trigger.
 	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
-	// creator is the address of the creator.
+	// creator is the address of the owner or an operator of the tunnel.
 	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
@@ -951,26 +969,34 @@ func (m *MsgWithdrawFromTunnelResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawFromTunnelResponse proto.InternalMessageInfo
 
-// MsgUpdateParams is the transaction message to update parameters.
-type MsgUpdateParams struct {
-	// authority is the address of the governance account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params is the x/tunnel parameters to update.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+// MsgSetFeePayerTopUp is the transaction message to authorize a funding account to refill the fee payer
+// of a tunnel. It must be signed by both the funder and the owner of the tunnel or an operator with the
+// funds role.
+type MsgSetFeePayerTopUp struct {
+	// tunnel_id is the ID of the tunnel whose fee payer is refilled.
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// funder is the address of the account that funds the refills.
+	Funder string `protobuf:"bytes,2,opt,name=funder,proto3" json:"funder,omitempty"`
+	// target_balance is the balance that the fee payer is refilled up to.
+	TargetBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=target_balance,json=targetBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"target_balance"`
+	// cap is the maximum total amount that the funder transfers to the fee payer.
+	Cap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=cap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"cap"`
+	// creator is the address of the owner or an operator of the tunnel.
+	Creator string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+func (m *MsgSetFeePayerTopUp) Reset()         { *m = MsgSetFeePayerTopUp{} }
+func (m *MsgSetFeePayerTopUp) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeePayerTopUp) ProtoMessage()    {}
+func (*MsgSetFeePayerTopUp) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d18351d83b4705d0, []int{18}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetFeePayerTopUp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetFeePayerTopUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetFeePayerTopUp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -980,48 +1006,69 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgSetFeePayerTopUp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeePayerTopUp.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgSetFeePayerTopUp) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgSetFeePayerTopUp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeePayerTopUp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetFeePayerTopUp proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgSetFeePayerTopUp) GetTunnelID() uint64 {
 	if m != nil {
-		return m.Authority
+		return m.TunnelID
+	}
+	return 0
+}
+
+func (m *MsgSetFeePayerTopUp) GetFunder() string {
+	if m != nil {
+		return m.Funder
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgSetFeePayerTopUp) GetTargetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Params
+		return m.TargetBalance
 	}
-	return Params{}
+	return nil
 }
 
-// MsgUpdateParamsResponse is the response type for the Msg/UpdateParams RPC method.
-type MsgUpdateParamsResponse struct {
+func (m *MsgSetFeePayerTopUp) GetCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Cap
+	}
+	return nil
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSetFeePayerTopUp) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// MsgSetFeePayerTopUpResponse is the response type for the Msg/SetFeePayerTopUp RPC method.
+type MsgSetFeePayerTopUpResponse struct {
+}
+
+func (m *MsgSetFeePayerTopUpResponse) Reset()         { *m = MsgSetFeePayerTopUpResponse{} }
+func (m *MsgSetFeePayerTopUpResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeePayerTopUpResponse) ProtoMessage()    {}
+func (*MsgSetFeePayerTopUpResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d18351d83b4705d0, []int{19}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetFeePayerTopUpResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetFeePayerTopUpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetFeePayerTopUpResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1031,1182 +1078,1268 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgSetFeePayerTopUpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeePayerTopUpResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgSetFeePayerTopUpResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgSetFeePayerTopUpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeePayerTopUpResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetFeePayerTopUpResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateTunnel)(nil), "band.tunnel.v1beta1.MsgCreateTunnel")
-	proto.RegisterType((*MsgCreateTunnelResponse)(nil), "band.tunnel.v1beta1.MsgCreateTunnelResponse")
-	proto.RegisterType((*MsgUpdateRoute)(nil), "band.tunnel.v1beta1.MsgUpdateRoute")
-	proto.RegisterType((*MsgUpdateRouteResponse)(nil), "band.tunnel.v1beta1.MsgUpdateRouteResponse")
-	proto.RegisterType((*MsgUpdateSignalsAndInterval)(nil), "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval")
-	proto.RegisterType((*MsgUpdateSignalsAndIntervalResponse)(nil), "band.tunnel.v1beta1.MsgUpdateSignalsAndIntervalResponse")
-	proto.RegisterType((*MsgWithdrawFeePayerFunds)(nil), "band.tunnel.v1beta1.MsgWithdrawFeePayerFunds")
-	proto.RegisterType((*MsgWithdrawFeePayerFundsResponse)(nil), "band.tunnel.v1beta1.MsgWithdrawFeePayerFundsResponse")
-	proto.RegisterType((*MsgActivateTunnel)(nil), "band.tunnel.v1beta1.MsgActivateTunnel")
-	proto.RegisterType((*MsgActivateTunnelResponse)(nil), "band.tunnel.v1beta1.MsgActivateTunnelResponse")
-	proto.RegisterType((*MsgDeactivateTunnel)(nil), "band.tunnel.v1beta1.MsgDeactivateTunnel")
-	proto.RegisterType((*MsgDeactivateTunnelResponse)(nil), "band.tunnel.v1beta1.MsgDeactivateTunnelResponse")
-	proto.RegisterType((*MsgTriggerTunnel)(nil), "band.tunnel.v1beta1.MsgTriggerTunnel")
-	proto.RegisterType((*MsgTriggerTunnelResponse)(nil), "band.tunnel.v1beta1.MsgTriggerTunnelResponse")
-	proto.RegisterType((*MsgDepositToTunnel)(nil), "band.tunnel.v1beta1.MsgDepositToTunnel")
-	proto.RegisterType((*MsgDepositToTunnelResponse)(nil), "band.tunnel.v1beta1.MsgDepositToTunnelResponse")
-	proto.RegisterType((*MsgWithdrawFromTunnel)(nil), "band.tunnel.v1beta1.MsgWithdrawFromTunnel")
-	proto.RegisterType((*MsgWithdrawFromTunnelResponse)(nil), "band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "band.tunnel.v1beta1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "band.tunnel.v1beta1.MsgUpdateParamsResponse")
+// MsgRemoveFeePayerTopUp is the transaction message to remove the fee payer top-up authorization of a tunnel.
+type MsgRemoveFeePayerTopUp struct {
+	// tunnel_id is the ID of the tunnel to remove the top-up authorization.
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// sender is the address of either the funder, the owner of the tunnel or an operator
+	// with the funds role.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func init() { proto.RegisterFile("band/tunnel/v1beta1/tx.proto", fileDescriptor_d18351d83b4705d0) }
-
-var fileDescriptor_d18351d83b4705d0 = []byte{
-	// 1117 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xbd, 0x6f, 0xdb, 0x46,
-	0x14, 0x37, 0x25, 0xdb, 0xb5, 0x9f, 0x5d, 0x7f, 0x30, 0x76, 0x4c, 0xd1, 0x8e, 0x2c, 0x30, 0x5f,
-	0x8a, 0x13, 0x93, 0xfe, 0x40, 0x82, 0x34, 0x9b, 0x15, 0x23, 0x80, 0x07, 0x01, 0x81, 0xe2, 0x22,
-	0x40, 0x17, 0x83, 0x12, 0xaf, 0x14, 0x11, 0x8b, 0x27, 0xf0, 0x4e, 0x4a, 0x34, 0x14, 0x28, 0x3a,
-	0x74, 0xe8, 0xd2, 0x2e, 0x2d, 0x0a, 0x14, 0x1d, 0xba, 0x14, 0x45, 0x97, 0x7a, 0xc8, 0x1f, 0x11,
-	0x74, 0x69, 0xd0, 0xa9, 0x53, 0xda, 0xda, 0x83, 0xff, 0x8d, 0x82, 0xc7, 0xd3, 0xf1, 0xc3, 0x94,
-	0x43, 0x15, 0x81, 0x91, 0x45, 0x12, 0xdf, 0xfb, 0xdd, 0xbd, 0xdf, 0xfb, 0x1d, 0xdf, 0xbd, 0x27,
-	0x58, 0xa9, 0x9b, 0xae, 0x65, 0xd0, 0x8e, 0xeb, 0xa2, 0x43, 0xa3, 0xbb, 0x59, 0x47, 0xd4, 0xdc,
-	0x34, 0xe8, 0x0b, 0xbd, 0xed, 0x61, 0x8a, 0xe5, 0x4b, 0xbe, 0x57, 0x0f, 0xbc, 0x3a, 0xf7, 0xaa,
-	0x05, 0x1b, 0x63, 0xfb, 0x10, 0x19, 0x0c, 0x52, 0xef, 0x7c, 0x6a, 0x98, 0x6e, 0x2f, 0xc0, 0xab,
-	0x0b, 0x36, 0xb6, 0x31, 0xfb, 0x69, 0xf8, 0xbf, 0xb8, 0xb5, 0xd0, 0xc0, 0xa4, 0x85, 0xc9, 0x41,
-	0xe0, 0x08, 0x1e, 0xb8, 0xab, 0x18, 0x3c, 0x19, 0x75, 0x93, 0x20, 0x11, 0xbe, 0x81, 0x1d, 0x97,
-	0xfb, 0x97, 0xb8, 0xbf, 0x45, 0x6c, 0xa3, 0xbb, 0xe9, 0x7f, 0x71, 0xc7, 0xbc, 0xd9, 0x72, 0x5c,
-	0x6c, 0xb0, 0x4f, 0x6e, 0x2a, 0xa5, 0xa5, 0xd2, 0x36, 0x3d, 0xb3, 0x45, 0xce, 0x43, 0xf0, 0xec,
-	0x18, 0x42, 0xfb, 0x2e, 0x0f, 0xb3, 0x55, 0x62, 0x3f, 0xf4, 0x90, 0x49, 0xd1, 0x3e, 0xf3, 0xc8,
-	0x4f, 0x61, 0x9e, 0x38, 0xb6, 0x6b, 0x1e, 0x1e, 0x58, 0xa8, 0xeb, 0x98, 0xd4, 0xc1, 0x2e, 0x51,
-	0xa4, 0x52, 0xbe, 0x3c, 0xb5, 0x75, 0x4d, 0x4f, 0x11, 0x48, 0x7f, 0xc2, 0xd0, 0xbb, 0x7d, 0x70,
-	0x65, 0xf4, 0xd5, 0x9b, 0xd5, 0x91, 0xda, 0x1c, 0x89, 0x9b, 0x89, 0xac, 0xc2, 0x84, 0xe3, 0x52,
-	0xe4, 0x75, 0xcd, 0x43, 0x25, 0x57, 0x92, 0xca, 0xa3, 0x35, 0xf1, 0x2c, 0xdf, 0x83, 0x31, 0x0f,
-	0x77, 0x28, 0x52, 0xf2, 0x25, 0xa9, 0x3c, 0xb5, 0xb5, 0xa0, 0x07, 0xa2, 0xeb, 0x7d, 0xd1, 0xf5,
-	0x1d, 0xb7, 0x57, 0x81, 0xdf, 0x5f, 0xae, 0x8f, 0xd7, 0x7c, 0xd8, 0x5e, 0x2d, 0x80, 0xcb, 0x3d,
-	0x98, 0x75, 0x5c, 0x87, 0x3a, 0x8c, 0x6d, 0x1b, 0x13, 0x87, 0x2a, 0xa3, 0x8c, 0x6a, 0x41, 0xe7,
-	0xc2, 0xfb, 0x52, 0x0b, 0xaa, 0x0f, 0xb1, 0xe3, 0x56, 0xee, 0xfa, 0xfc, 0x7e, 0xfd, 0x7b, 0xb5,
-	0x6c, 0x3b, 0xb4, 0xd9, 0xa9, 0xeb, 0x0d, 0xdc, 0xe2, 0xa7, 0xc4, 0xbf, 0xd6, 0x89, 0xf5, 0xcc,
-	0xa0, 0xbd, 0x36, 0x22, 0x6c, 0x01, 0xf9, 0xe5, 0xf4, 0x68, 0x4d, 0xaa, 0xcd, 0xf0, 0x40, 0xbb,
-	0x41, 0x1c, 0x79, 0x0b, 0x3e, 0x68, 0xf8, 0xba, 0x61, 0x4f, 0x19, 0x2b, 0x49, 0xe5, 0xc9, 0x8a,
-	0xf2, 0xe7, 0xcb, 0xf5, 0x05, 0x1e, 0x75, 0xc7, 0xb2, 0x3c, 0x44, 0xc8, 0x13, 0xea, 0x39, 0xae,
-	0x5d, 0xeb, 0x03, 0x1f, 0xdc, 0xfc, 0xe2, 0xf4, 0x68, 0xad, 0xff, 0xf4, 0xd5, 0xe9, 0xd1, 0xda,
-	0x65, 0x7e, 0x3a, 0x89, 0x43, 0xd0, 0x76, 0x61, 0x29, 0x61, 0xaa, 0x21, 0xd2, 0xc6, 0x2e, 0x41,
-	0xf2, 0x2d, 0x98, 0x0c, 0x16, 0x1d, 0x38, 0x96, 0x22, 0xf9, 0x3a, 0x56, 0xa6, 0x8f, 0xdf, 0xac,
-	0x4e, 0x04, 0xb0, 0xbd, 0xdd, 0xda, 0x44, 0xe0, 0xde, 0xb3, 0xb4, 0x3f, 0x24, 0x98, 0xa9, 0x12,
-	0xfb, 0xe3, 0xb6, 0x65, 0x52, 0xc4, 0x84, 0x1b, 0x62, 0x75, 0x78, 0x26, 0xb9, 0xe1, 0xce, 0x24,
-	0x22, 0x4c, 0x3e, 0xab, 0x30, 0x37, 0x92, 0xc2, 0x2c, 0x86, 0xc2, 0x44, 0xe8, 0x6b, 0x0a, 0x5c,
-	0x8e, 0x5b, 0xfa, 0xb2, 0x68, 0x3f, 0xe7, 0x60, 0x59, 0xb8, 0x82, 0x57, 0x92, 0xec, 0xb8, 0xd6,
-	0x5e, 0xff, 0x0d, 0x1b, 0x22, 0xf1, 0xd4, 0x0a, 0xc8, 0xbd, 0xe3, 0x0a, 0xc8, 0x27, 0x2a, 0x20,
-	0xa2, 0xda, 0x68, 0x56, 0xd5, 0xb6, 0x93, 0xaa, 0x69, 0x49, 0xd5, 0xce, 0x0a, 0xa1, 0x5d, 0x87,
-	0xab, 0xe7, 0xb8, 0x85, 0x9e, 0xdf, 0xe6, 0x40, 0xa9, 0x12, 0xfb, 0xa9, 0x43, 0x9b, 0x96, 0x67,
-	0x3e, 0x7f, 0x84, 0xd0, 0x63, 0xb3, 0x87, 0xbc, 0x47, 0x1d, 0xd7, 0x22, 0xc3, 0x88, 0xd9, 0x84,
-	0x71, 0xb3, 0x85, 0x3b, 0x2e, 0xe5, 0x0a, 0xbe, 0xfb, 0xc2, 0xe4, 0xfb, 0xff, 0xaf, 0xf7, 0x6e,
-	0x23, 0xa9, 0xe0, 0x6a, 0xa8, 0x60, 0x6a, 0xea, 0x9a, 0x06, 0xa5, 0x41, 0x3e, 0xa1, 0xdd, 0x8f,
-	0x12, 0xcc, 0x57, 0x89, 0xbd, 0xd3, 0xa0, 0x4e, 0x37, 0xbc, 0x58, 0x87, 0x10, 0x2d, 0x92, 0x4a,
-	0x2e, 0x6b, 0x2a, 0xb7, 0x92, 0xa9, 0x28, 0x61, 0x2a, 0x71, 0x26, 0xda, 0x32, 0x14, 0xce, 0x18,
-	0x05, 0xf9, 0x9f, 0x24, 0xb8, 0x54, 0x25, 0xf6, 0x2e, 0x32, 0x2f, 0x94, 0xfe, 0xed, 0x24, 0x7d,
-	0x35, 0xa4, 0x9f, 0xe4, 0xa2, 0x5d, 0x61, 0xb5, 0x9e, 0x34, 0x8b, 0x14, 0x7e, 0x90, 0x60, 0xae,
-	0x4a, 0xec, 0x7d, 0xcf, 0xb1, 0x6d, 0xe4, 0x5d, 0x0c, 0xff, 0x72, 0x92, 0xff, 0x52, 0xc8, 0x3f,
-	0x46, 0x44, 0x53, 0x59, 0x61, 0xc5, 0x6c, 0x82, 0xf9, 0xd7, 0x39, 0x90, 0x59, 0x66, 0xac, 0xc7,
-	0xec, 0xe3, 0xe1, 0xb9, 0x5f, 0x5c, 0xbd, 0xdd, 0x83, 0x49, 0xde, 0x73, 0x33, 0x54, 0x5c, 0x08,
-	0x7d, 0x70, 0xc7, 0x57, 0x2a, 0x7c, 0xf6, 0xb5, 0x2a, 0x44, 0xcf, 0x3a, 0x96, 0xba, 0xb6, 0x02,
-	0xea, 0x59, 0xab, 0xd0, 0xeb, 0xfb, 0x1c, 0x2c, 0x46, 0xcb, 0xd1, 0xc3, 0xad, 0xf7, 0x59, 0xb2,
-	0xfb, 0x00, 0xcf, 0x39, 0x55, 0xf4, 0x76, 0xcd, 0x22, 0xd8, 0xe0, 0xa2, 0x8a, 0x18, 0x7c, 0xd5,
-	0x56, 0x52, 0xee, 0x2a, 0x21, 0x80, 0xb6, 0x0a, 0x57, 0x52, 0x1d, 0x42, 0xbb, 0xdf, 0x24, 0x36,
-	0xfc, 0x05, 0x9d, 0xe0, 0x31, 0x1b, 0x1c, 0xfd, 0x33, 0x35, 0x3b, 0xb4, 0x89, 0x3d, 0x87, 0xf6,
-	0x98, 0x6a, 0xe7, 0x9e, 0xa9, 0x80, 0xca, 0x1f, 0xc1, 0x78, 0x30, 0x7a, 0xf2, 0x61, 0x61, 0x39,
-	0xb5, 0x4f, 0x06, 0x41, 0x78, 0x7b, 0xe4, 0x0b, 0x82, 0x7b, 0x2b, 0xdc, 0x2a, 0x31, 0x15, 0x45,
-	0xd9, 0x69, 0x05, 0x36, 0x15, 0x45, 0x4d, 0xfd, 0x64, 0xb6, 0xfe, 0x9d, 0x80, 0x7c, 0x95, 0xd8,
-	0x72, 0x1d, 0xa6, 0x63, 0xd3, 0x6c, 0x7a, 0xc3, 0x4e, 0xcc, 0x56, 0xea, 0x9d, 0x2c, 0x28, 0x31,
-	0x81, 0x1d, 0xc0, 0x54, 0x74, 0xa4, 0xba, 0x3a, 0x68, 0x71, 0x04, 0xa4, 0xde, 0xce, 0x00, 0x12,
-	0x01, 0xbe, 0x94, 0x40, 0x19, 0x38, 0xc8, 0x6c, 0x9c, 0xbf, 0xd3, 0xd9, 0x15, 0xea, 0xfd, 0x61,
-	0x57, 0x08, 0x22, 0x9f, 0xc1, 0x62, 0xfa, 0x00, 0xb0, 0x3e, 0x68, 0xcb, 0x54, 0xb8, 0x7a, 0x77,
-	0x28, 0xb8, 0x08, 0xdf, 0x84, 0x99, 0x44, 0x0f, 0xbd, 0x31, 0x68, 0xa3, 0x38, 0x4e, 0xd5, 0xb3,
-	0xe1, 0x44, 0x24, 0x17, 0xe6, 0xce, 0x34, 0xbc, 0xf2, 0xa0, 0x3d, 0x92, 0x48, 0x75, 0x23, 0x2b,
-	0x52, 0xc4, 0x43, 0xf0, 0x61, 0xbc, 0x3b, 0x5d, 0x1f, 0xb4, 0x45, 0x0c, 0xa6, 0xae, 0x67, 0x82,
-	0x89, 0x30, 0xcf, 0x60, 0x36, 0xd9, 0x4a, 0x6e, 0x0e, 0xe6, 0x1a, 0x03, 0xaa, 0x46, 0x46, 0xa0,
-	0x08, 0x46, 0x41, 0x4e, 0xb9, 0x87, 0xd7, 0xde, 0x7a, 0xf4, 0x02, 0xab, 0x6e, 0x65, 0xc7, 0x8a,
-	0xa8, 0x75, 0x98, 0x8e, 0xdd, 0x60, 0xd7, 0xce, 0x7f, 0xd9, 0x03, 0xd4, 0xe0, 0x82, 0x4f, 0xbb,
-	0x5c, 0xd4, 0xb1, 0xcf, 0xfd, 0x5b, 0xbc, 0xb2, 0xf7, 0xea, 0xb8, 0x28, 0xbd, 0x3e, 0x2e, 0x4a,
-	0xff, 0x1c, 0x17, 0xa5, 0x6f, 0x4e, 0x8a, 0x23, 0xaf, 0x4f, 0x8a, 0x23, 0x7f, 0x9d, 0x14, 0x47,
-	0x3e, 0x31, 0x22, 0xed, 0xc0, 0xdf, 0x98, 0xfd, 0x45, 0x6a, 0xe0, 0x43, 0xa3, 0xd1, 0x34, 0x1d,
-	0xd7, 0xe8, 0x6e, 0x1b, 0x2f, 0xfa, 0xff, 0xc3, 0x59, 0x6f, 0xa8, 0x8f, 0x33, 0xc4, 0xf6, 0x7f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x8c, 0xdd, 0x4e, 0xda, 0x90, 0x10, 0x00, 0x00,
+func (m *MsgRemoveFeePayerTopUp) Reset()         { *m = MsgRemoveFeePayerTopUp{} }
+func (m *MsgRemoveFeePayerTopUp) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeePayerTopUp) ProtoMessage()    {}
+func (*MsgRemoveFeePayerTopUp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{20}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateTunnel is a RPC method to create a new tunnel.
-	CreateTunnel(ctx context.Context, in *MsgCreateTunnel, opts ...grpc.CallOption) (*MsgCreateTunnelResponse, error)
-	// UpdateRoute is a RPC method to update a route information of the tunnel.
-	UpdateRoute(ctx context.Context, in *MsgUpdateRoute, opts ...grpc.CallOption) (*MsgUpdateRouteResponse, error)
-	// UpdateSignalsAndInterval is a RPC method to update a signals and interval of the tunnel.
-	UpdateSignalsAndInterval(ctx context.Context, in *MsgUpdateSignalsAndInterval, opts ...grpc.CallOption) (*MsgUpdateSignalsAndIntervalResponse, error)
-	// WithdrawFeePayerFunds is a RPC method to withdraw fee payer funds to creator.
-	WithdrawFeePayerFunds(ctx context.Context, in *MsgWithdrawFeePayerFunds, opts ...grpc.CallOption) (*MsgWithdrawFeePayerFundsResponse, error)
-	// ActivateTunnel is a RPC method to activate a tunnel.
-	ActivateTunnel(ctx context.Context, in *MsgActivateTunnel, opts ...grpc.CallOption) (*MsgActivateTunnelResponse, error)
-	// DeactivateTunnel is a RPC method to deactivate a tunnel.
-	DeactivateTunnel(ctx context.Context, in *MsgDeactivateTunnel, opts ...grpc.CallOption) (*MsgDeactivateTunnelResponse, error)
-	// TriggerTunnel is a RPC method to manually trigger a tunnel.
-	TriggerTunnel(ctx context.Context, in *MsgTriggerTunnel, opts ...grpc.CallOption) (*MsgTriggerTunnelResponse, error)
-	// DepositToTunnel is a RPC method to deposit to an existing tunnel.
-	DepositToTunnel(ctx context.Context, in *MsgDepositToTunnel, opts ...grpc.CallOption) (*MsgDepositToTunnelResponse, error)
-	// WithdrawFromTunnel is a RPC method to withdraw a deposit from an existing tunnel.
-	WithdrawFromTunnel(ctx context.Context, in *MsgWithdrawFromTunnel, opts ...grpc.CallOption) (*MsgWithdrawFromTunnelResponse, error)
-	// UpdateParams is a RPC method to update parameters
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgRemoveFeePayerTopUp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRemoveFeePayerTopUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeePayerTopUp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRemoveFeePayerTopUp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeePayerTopUp.Merge(m, src)
+}
+func (m *MsgRemoveFeePayerTopUp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFeePayerTopUp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeePayerTopUp.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateTunnel(ctx context.Context, in *MsgCreateTunnel, opts ...grpc.CallOption) (*MsgCreateTunnelResponse, error) {
-	out := new(MsgCreateTunnelResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/CreateTunnel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveFeePayerTopUp proto.InternalMessageInfo
+
+func (m *MsgRemoveFeePayerTopUp) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateRoute(ctx context.Context, in *MsgUpdateRoute, opts ...grpc.CallOption) (*MsgUpdateRouteResponse, error) {
-	out := new(MsgUpdateRouteResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/UpdateRoute", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveFeePayerTopUp) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateSignalsAndInterval(ctx context.Context, in *MsgUpdateSignalsAndInterval, opts ...grpc.CallOption) (*MsgUpdateSignalsAndIntervalResponse, error) {
-	out := new(MsgUpdateSignalsAndIntervalResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/UpdateSignalsAndInterval", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgRemoveFeePayerTopUpResponse is the response type for the Msg/RemoveFeePayerTopUp RPC method.
+type MsgRemoveFeePayerTopUpResponse struct {
 }
 
-func (c *msgClient) WithdrawFeePayerFunds(ctx context.Context, in *MsgWithdrawFeePayerFunds, opts ...grpc.CallOption) (*MsgWithdrawFeePayerFundsResponse, error) {
-	out := new(MsgWithdrawFeePayerFundsResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/WithdrawFeePayerFunds", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveFeePayerTopUpResponse) Reset()         { *m = MsgRemoveFeePayerTopUpResponse{} }
+func (m *MsgRemoveFeePayerTopUpResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeePayerTopUpResponse) ProtoMessage()    {}
+func (*MsgRemoveFeePayerTopUpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{21}
+}
+func (m *MsgRemoveFeePayerTopUpResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFeePayerTopUpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeePayerTopUpResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRemoveFeePayerTopUpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeePayerTopUpResponse.Merge(m, src)
+}
+func (m *MsgRemoveFeePayerTopUpResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFeePayerTopUpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeePayerTopUpResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) ActivateTunnel(ctx context.Context, in *MsgActivateTunnel, opts ...grpc.CallOption) (*MsgActivateTunnelResponse, error) {
-	out := new(MsgActivateTunnelResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/ActivateTunnel", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRemoveFeePayerTopUpResponse proto.InternalMessageInfo
+
+// MsgUpdateLowBalanceThreshold is the transaction message to update the low balance threshold of a tunnel.
+type MsgUpdateLowBalanceThreshold struct {
+	// tunnel_id is the ID of the tunnel to update.
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// low_balance_threshold is the fee payer balance below which a low balance event is emitted.
+	// An empty threshold disables the event.
+	LowBalanceThreshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=low_balance_threshold,json=lowBalanceThreshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"low_balance_threshold"`
+	// creator is the address of the owner or an operator of the tunnel.
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func (c *msgClient) DeactivateTunnel(ctx context.Context, in *MsgDeactivateTunnel, opts ...grpc.CallOption) (*MsgDeactivateTunnelResponse, error) {
-	out := new(MsgDeactivateTunnelResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/DeactivateTunnel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateLowBalanceThreshold) Reset()         { *m = MsgUpdateLowBalanceThreshold{} }
+func (m *MsgUpdateLowBalanceThreshold) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLowBalanceThreshold) ProtoMessage()    {}
+func (*MsgUpdateLowBalanceThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{22}
+}
+func (m *MsgUpdateLowBalanceThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateLowBalanceThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateLowBalanceThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateLowBalanceThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLowBalanceThreshold.Merge(m, src)
+}
+func (m *MsgUpdateLowBalanceThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateLowBalanceThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLowBalanceThreshold.DiscardUnknown(m)
 }
 
-func (c *msgClient) TriggerTunnel(ctx context.Context, in *MsgTriggerTunnel, opts ...grpc.CallOption) (*MsgTriggerTunnelResponse, error) {
-	out := new(MsgTriggerTunnelResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/TriggerTunnel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateLowBalanceThreshold proto.InternalMessageInfo
+
+func (m *MsgUpdateLowBalanceThreshold) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) DepositToTunnel(ctx context.Context, in *MsgDepositToTunnel, opts ...grpc.CallOption) (*MsgDepositToTunnelResponse, error) {
-	out := new(MsgDepositToTunnelResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/DepositToTunnel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateLowBalanceThreshold) GetLowBalanceThreshold() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LowBalanceThreshold
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) WithdrawFromTunnel(ctx context.Context, in *MsgWithdrawFromTunnel, opts ...grpc.CallOption) (*MsgWithdrawFromTunnelResponse, error) {
-	out := new(MsgWithdrawFromTunnelResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/WithdrawFromTunnel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateLowBalanceThreshold) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgUpdateLowBalanceThresholdResponse is the response type for the Msg/UpdateLowBalanceThreshold RPC method.
+type MsgUpdateLowBalanceThresholdResponse struct {
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateTunnel is a RPC method to create a new tunnel.
-	CreateTunnel(context.Context, *MsgCreateTunnel) (*MsgCreateTunnelResponse, error)
-	// UpdateRoute is a RPC method to update a route information of the tunnel.
-	UpdateRoute(context.Context, *MsgUpdateRoute) (*MsgUpdateRouteResponse, error)
-	// UpdateSignalsAndInterval is a RPC method to update a signals and interval of the tunnel.
-	UpdateSignalsAndInterval(context.Context, *MsgUpdateSignalsAndInterval) (*MsgUpdateSignalsAndIntervalResponse, error)
-	// WithdrawFeePayerFunds is a RPC method to withdraw fee payer funds to creator.
-	WithdrawFeePayerFunds(context.Context, *MsgWithdrawFeePayerFunds) (*MsgWithdrawFeePayerFundsResponse, error)
-	// ActivateTunnel is a RPC method to activate a tunnel.
-	ActivateTunnel(context.Context, *MsgActivateTunnel) (*MsgActivateTunnelResponse, error)
-	// DeactivateTunnel is a RPC method to deactivate a tunnel.
-	DeactivateTunnel(context.Context, *MsgDeactivateTunnel) (*MsgDeactivateTunnelResponse, error)
-	// TriggerTunnel is a RPC method to manually trigger a tunnel.
-	TriggerTunnel(context.Context, *MsgTriggerTunnel) (*MsgTriggerTunnelResponse, error)
-	// DepositToTunnel is a RPC method to deposit to an existing tunnel.
-	DepositToTunnel(context.Context, *MsgDepositToTunnel) (*MsgDepositToTunnelResponse, error)
-	// WithdrawFromTunnel is a RPC method to withdraw a deposit from an existing tunnel.
-	WithdrawFromTunnel(context.Context, *MsgWithdrawFromTunnel) (*MsgWithdrawFromTunnelResponse, error)
-	// UpdateParams is a RPC method to update parameters
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgUpdateLowBalanceThresholdResponse) Reset()         { *m = MsgUpdateLowBalanceThresholdResponse{} }
+func (m *MsgUpdateLowBalanceThresholdResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLowBalanceThresholdResponse) ProtoMessage()    {}
+func (*MsgUpdateLowBalanceThresholdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{23}
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgUpdateLowBalanceThresholdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedMsgServer) CreateTunnel(ctx context.Context, req *MsgCreateTunnel) (*MsgCreateTunnelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTunnel not implemented")
+func (m *MsgUpdateLowBalanceThresholdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateLowBalanceThresholdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateRoute(ctx context.Context, req *MsgUpdateRoute) (*MsgUpdateRouteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoute not implemented")
+func (m *MsgUpdateLowBalanceThresholdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLowBalanceThresholdResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateSignalsAndInterval(ctx context.Context, req *MsgUpdateSignalsAndInterval) (*MsgUpdateSignalsAndIntervalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSignalsAndInterval not implemented")
+func (m *MsgUpdateLowBalanceThresholdResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) WithdrawFeePayerFunds(ctx context.Context, req *MsgWithdrawFeePayerFunds) (*MsgWithdrawFeePayerFundsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFeePayerFunds not implemented")
+func (m *MsgUpdateLowBalanceThresholdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLowBalanceThresholdResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) ActivateTunnel(ctx context.Context, req *MsgActivateTunnel) (*MsgActivateTunnelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActivateTunnel not implemented")
+
+var xxx_messageInfo_MsgUpdateLowBalanceThresholdResponse proto.InternalMessageInfo
+
+// MsgTransferTunnelOwnership is the transaction message to transfer the ownership of a tunnel.
+type MsgTransferTunnelOwnership struct {
+	// tunnel_id is the ID of the tunnel to transfer.
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// new_owner is the address of the new owner.
+	NewOwner string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	// owner is the address of the current owner.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
 }
-func (*UnimplementedMsgServer) DeactivateTunnel(ctx context.Context, req *MsgDeactivateTunnel) (*MsgDeactivateTunnelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeactivateTunnel not implemented")
+
+func (m *MsgTransferTunnelOwnership) Reset()         { *m = MsgTransferTunnelOwnership{} }
+func (m *MsgTransferTunnelOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferTunnelOwnership) ProtoMessage()    {}
+func (*MsgTransferTunnelOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{24}
 }
-func (*UnimplementedMsgServer) TriggerTunnel(ctx context.Context, req *MsgTriggerTunnel) (*MsgTriggerTunnelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TriggerTunnel not implemented")
+func (m *MsgTransferTunnelOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DepositToTunnel(ctx context.Context, req *MsgDepositToTunnel) (*MsgDepositToTunnelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DepositToTunnel not implemented")
+func (m *MsgTransferTunnelOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferTunnelOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) WithdrawFromTunnel(ctx context.Context, req *MsgWithdrawFromTunnel) (*MsgWithdrawFromTunnelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromTunnel not implemented")
+func (m *MsgTransferTunnelOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferTunnelOwnership.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgTransferTunnelOwnership) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgTransferTunnelOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferTunnelOwnership.DiscardUnknown(m)
 }
 
-func _Msg_CreateTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateTunnel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateTunnel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Msg/CreateTunnel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateTunnel(ctx, req.(*MsgCreateTunnel))
+var xxx_messageInfo_MsgTransferTunnelOwnership proto.InternalMessageInfo
+
+func (m *MsgTransferTunnelOwnership) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateRoute)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateRoute(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Msg/UpdateRoute",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateRoute(ctx, req.(*MsgUpdateRoute))
+func (m *MsgTransferTunnelOwnership) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateSignalsAndInterval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateSignalsAndInterval)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateSignalsAndInterval(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Msg/UpdateSignalsAndInterval",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateSignalsAndInterval(ctx, req.(*MsgUpdateSignalsAndInterval))
+func (m *MsgTransferTunnelOwnership) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_WithdrawFeePayerFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawFeePayerFunds)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawFeePayerFunds(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Msg/WithdrawFeePayerFunds",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawFeePayerFunds(ctx, req.(*MsgWithdrawFeePayerFunds))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgTransferTunnelOwnershipResponse is the response type for the Msg/TransferTunnelOwnership RPC method.
+type MsgTransferTunnelOwnershipResponse struct {
 }
 
-func _Msg_ActivateTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgActivateTunnel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ActivateTunnel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Msg/ActivateTunnel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ActivateTunnel(ctx, req.(*MsgActivateTunnel))
+func (m *MsgTransferTunnelOwnershipResponse) Reset()         { *m = MsgTransferTunnelOwnershipResponse{} }
+func (m *MsgTransferTunnelOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferTunnelOwnershipResponse) ProtoMessage()    {}
+func (*MsgTransferTunnelOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{25}
+}
+func (m *MsgTransferTunnelOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferTunnelOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferTunnelOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgTransferTunnelOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferTunnelOwnershipResponse.Merge(m, src)
+}
+func (m *MsgTransferTunnelOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferTunnelOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferTunnelOwnershipResponse.DiscardUnknown(m)
 }
 
-func _Msg_DeactivateTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeactivateTunnel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeactivateTunnel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Msg/DeactivateTunnel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeactivateTunnel(ctx, req.(*MsgDeactivateTunnel))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgTransferTunnelOwnershipResponse proto.InternalMessageInfo
+
+// MsgSetTunnelOperator is the transaction message to set the roles of an operator of a tunnel.
+type MsgSetTunnelOperator struct {
+	// tunnel_id is the ID of the tunnel.
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// operator is the address of the operator.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// roles is the list of roles of the operator. An empty list removes the operator.
+	Roles []OperatorRole `protobuf:"varint,3,rep,packed,name=roles,proto3,enum=band.tunnel.v1beta1.OperatorRole" json:"roles,omitempty"`
+	// owner is the address of the owner.
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func _Msg_TriggerTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTriggerTunnel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).TriggerTunnel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Msg/TriggerTunnel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TriggerTunnel(ctx, req.(*MsgTriggerTunnel))
+func (m *MsgSetTunnelOperator) Reset()         { *m = MsgSetTunnelOperator{} }
+func (m *MsgSetTunnelOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTunnelOperator) ProtoMessage()    {}
+func (*MsgSetTunnelOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{26}
+}
+func (m *MsgSetTunnelOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTunnelOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTunnelOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetTunnelOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTunnelOperator.Merge(m, src)
+}
+func (m *MsgSetTunnelOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTunnelOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTunnelOperator.DiscardUnknown(m)
 }
 
-func _Msg_DepositToTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDepositToTunnel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DepositToTunnel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Msg/DepositToTunnel",
+var xxx_messageInfo_MsgSetTunnelOperator proto.InternalMessageInfo
+
+func (m *MsgSetTunnelOperator) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DepositToTunnel(ctx, req.(*MsgDepositToTunnel))
+	return 0
+}
+
+func (m *MsgSetTunnelOperator) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_WithdrawFromTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawFromTunnel)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSetTunnelOperator) GetRoles() []OperatorRole {
+	if m != nil {
+		return m.Roles
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawFromTunnel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Msg/WithdrawFromTunnel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawFromTunnel(ctx, req.(*MsgWithdrawFromTunnel))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgSetTunnelOperator) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "band.tunnel.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateTunnel",
-			Handler:    _Msg_CreateTunnel_Handler,
-		},
-		{
-			MethodName: "UpdateRoute",
-			Handler:    _Msg_UpdateRoute_Handler,
-		},
-		{
-			MethodName: "UpdateSignalsAndInterval",
-			Handler:    _Msg_UpdateSignalsAndInterval_Handler,
-		},
-		{
-			MethodName: "WithdrawFeePayerFunds",
-			Handler:    _Msg_WithdrawFeePayerFunds_Handler,
-		},
-		{
-			MethodName: "ActivateTunnel",
-			Handler:    _Msg_ActivateTunnel_Handler,
-		},
-		{
-			MethodName: "DeactivateTunnel",
-			Handler:    _Msg_DeactivateTunnel_Handler,
-		},
-		{
-			MethodName: "TriggerTunnel",
-			Handler:    _Msg_TriggerTunnel_Handler,
-		},
-		{
-			MethodName: "DepositToTunnel",
-			Handler:    _Msg_DepositToTunnel_Handler,
-		},
-		{
-			MethodName: "WithdrawFromTunnel",
-			Handler:    _Msg_WithdrawFromTunnel_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "band/tunnel/v1beta1/tx.proto",
+// MsgSetTunnelOperatorResponse is the response type for the Msg/SetTunnelOperator RPC method.
+type MsgSetTunnelOperatorResponse struct {
 }
 
-func (m *MsgCreateTunnel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetTunnelOperatorResponse) Reset()         { *m = MsgSetTunnelOperatorResponse{} }
+func (m *MsgSetTunnelOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTunnelOperatorResponse) ProtoMessage()    {}
+func (*MsgSetTunnelOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{27}
+}
+func (m *MsgSetTunnelOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTunnelOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTunnelOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetTunnelOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTunnelOperatorResponse.Merge(m, src)
+}
+func (m *MsgSetTunnelOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTunnelOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTunnelOperatorResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateTunnel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetTunnelOperatorResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the transaction message to update parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params is the x/tunnel parameters to update.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgCreateTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.InitialDeposit) > 0 {
-		for iNdEx := len(m.InitialDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InitialDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.Route != nil {
-		{
-			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Interval != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.SignalDeviations) > 0 {
-		for iNdEx := len(m.SignalDeviations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SignalDeviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{28}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateTunnelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
 }
-
-func (m *MsgCreateTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgCreateTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TunnelID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateRoute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *MsgUpdateRoute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateParamsResponse is the response type for the Msg/UpdateParams RPC method.
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgUpdateRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Route != nil {
-		{
-			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TunnelID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{29}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateRouteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateTunnel)(nil), "band.tunnel.v1beta1.MsgCreateTunnel")
+	proto.RegisterType((*MsgCreateTunnelResponse)(nil), "band.tunnel.v1beta1.MsgCreateTunnelResponse")
+	proto.RegisterType((*MsgUpdateRoute)(nil), "band.tunnel.v1beta1.MsgUpdateRoute")
+	proto.RegisterType((*MsgUpdateRouteResponse)(nil), "band.tunnel.v1beta1.MsgUpdateRouteResponse")
+	proto.RegisterType((*MsgUpdateSignalsAndInterval)(nil), "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval")
+	proto.RegisterType((*MsgUpdateSignalsAndIntervalResponse)(nil), "band.tunnel.v1beta1.MsgUpdateSignalsAndIntervalResponse")
+	proto.RegisterType((*MsgWithdrawFeePayerFunds)(nil), "band.tunnel.v1beta1.MsgWithdrawFeePayerFunds")
+	proto.RegisterType((*MsgWithdrawFeePayerFundsResponse)(nil), "band.tunnel.v1beta1.MsgWithdrawFeePayerFundsResponse")
+	proto.RegisterType((*MsgActivateTunnel)(nil), "band.tunnel.v1beta1.MsgActivateTunnel")
+	proto.RegisterType((*MsgActivateTunnelResponse)(nil), "band.tunnel.v1beta1.MsgActivateTunnelResponse")
+	proto.RegisterType((*MsgDeactivateTunnel)(nil), "band.tunnel.v1beta1.MsgDeactivateTunnel")
+	proto.RegisterType((*MsgDeactivateTunnelResponse)(nil), "band.tunnel.v1beta1.MsgDeactivateTunnelResponse")
+	proto.RegisterType((*MsgTriggerTunnel)(nil), "band.tunnel.v1beta1.MsgTriggerTunnel")
+	proto.RegisterType((*MsgTriggerTunnelResponse)(nil), "band.tunnel.v1beta1.MsgTriggerTunnelResponse")
+	proto.RegisterType((*MsgDepositToTunnel)(nil), "band.tunnel.v1beta1.MsgDepositToTunnel")
+	proto.RegisterType((*MsgDepositToTunnelResponse)(nil), "band.tunnel.v1beta1.MsgDepositToTunnelResponse")
+	proto.RegisterType((*MsgWithdrawFromTunnel)(nil), "band.tunnel.v1beta1.MsgWithdrawFromTunnel")
+	proto.RegisterType((*MsgWithdrawFromTunnelResponse)(nil), "band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse")
+	proto.RegisterType((*MsgSetFeePayerTopUp)(nil), "band.tunnel.v1beta1.MsgSetFeePayerTopUp")
+	proto.RegisterType((*MsgSetFeePayerTopUpResponse)(nil), "band.tunnel.v1beta1.MsgSetFeePayerTopUpResponse")
+	proto.RegisterType((*MsgRemoveFeePayerTopUp)(nil), "band.tunnel.v1beta1.MsgRemoveFeePayerTopUp")
+	proto.RegisterType((*MsgRemoveFeePayerTopUpResponse)(nil), "band.tunnel.v1beta1.MsgRemoveFeePayerTopUpResponse")
+	proto.RegisterType((*MsgUpdateLowBalanceThreshold)(nil), "band.tunnel.v1beta1.MsgUpdateLowBalanceThreshold")
+	proto.RegisterType((*MsgUpdateLowBalanceThresholdResponse)(nil), "band.tunnel.v1beta1.MsgUpdateLowBalanceThresholdResponse")
+	proto.RegisterType((*MsgTransferTunnelOwnership)(nil), "band.tunnel.v1beta1.MsgTransferTunnelOwnership")
+	proto.RegisterType((*MsgTransferTunnelOwnershipResponse)(nil), "band.tunnel.v1beta1.MsgTransferTunnelOwnershipResponse")
+	proto.RegisterType((*MsgSetTunnelOperator)(nil), "band.tunnel.v1beta1.MsgSetTunnelOperator")
+	proto.RegisterType((*MsgSetTunnelOperatorResponse)(nil), "band.tunnel.v1beta1.MsgSetTunnelOperatorResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "band.tunnel.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "band.tunnel.v1beta1.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("band/tunnel/v1beta1/tx.proto", fileDescriptor_d18351d83b4705d0) }
+
+var fileDescriptor_d18351d83b4705d0 = []byte{
+	// 1544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x4f, 0xdc, 0xc6,
+	0x17, 0xc7, 0xbb, 0xc0, 0x17, 0x5e, 0x12, 0x08, 0x06, 0x82, 0x31, 0xb0, 0x10, 0x87, 0x24, 0x84,
+	0x84, 0x35, 0x3f, 0xf2, 0x93, 0x1b, 0x1b, 0x14, 0x09, 0xe9, 0xbb, 0x6a, 0xb4, 0x21, 0x8a, 0xd4,
+	0xcb, 0xca, 0xbb, 0x9e, 0x78, 0xad, 0xec, 0x7a, 0xb6, 0x1e, 0xef, 0x6e, 0x38, 0x54, 0xaa, 0xda,
+	0xaa, 0x52, 0xab, 0x4a, 0xed, 0xa5, 0x52, 0xa5, 0xaa, 0x87, 0x4a, 0x3d, 0x54, 0xbd, 0x34, 0x87,
+	0x9c, 0xda, 0x7f, 0x20, 0xea, 0x25, 0x69, 0x4f, 0x3d, 0xa5, 0x29, 0x39, 0xe4, 0xdf, 0xa8, 0x6c,
+	0x8f, 0x67, 0x6d, 0x63, 0x2f, 0x36, 0x2d, 0x51, 0x2f, 0xac, 0x3d, 0xef, 0x33, 0xf3, 0x3e, 0xef,
+	0xf3, 0xe6, 0xc7, 0x1b, 0x03, 0xb3, 0x15, 0xc5, 0x50, 0x65, 0xab, 0x65, 0x18, 0xa8, 0x2e, 0xb7,
+	0xd7, 0x2a, 0xc8, 0x52, 0xd6, 0x64, 0xeb, 0x71, 0xbe, 0x69, 0x62, 0x0b, 0xf3, 0xe3, 0xb6, 0x35,
+	0xef, 0x5a, 0xf3, 0xd4, 0x2a, 0x4e, 0x6b, 0x18, 0x6b, 0x75, 0x24, 0x3b, 0x90, 0x4a, 0xeb, 0xa1,
+	0xac, 0x18, 0x7b, 0x2e, 0x5e, 0x9c, 0xd0, 0xb0, 0x86, 0x9d, 0x47, 0xd9, 0x7e, 0xa2, 0xad, 0xd3,
+	0x55, 0x4c, 0x1a, 0x98, 0x94, 0x5d, 0x83, 0xfb, 0x42, 0x4d, 0x39, 0xf7, 0x4d, 0xae, 0x28, 0x04,
+	0x31, 0xf7, 0x55, 0xac, 0x1b, 0xd4, 0x3e, 0x45, 0xed, 0x0d, 0xa2, 0xc9, 0xed, 0x35, 0xfb, 0x87,
+	0x1a, 0xc6, 0x94, 0x86, 0x6e, 0x60, 0xd9, 0xf9, 0x4b, 0x9b, 0x16, 0xa2, 0x42, 0x69, 0x2a, 0xa6,
+	0xd2, 0x20, 0xbd, 0x10, 0x34, 0x3a, 0x07, 0x21, 0xfd, 0x96, 0x85, 0xd1, 0x22, 0xd1, 0x6e, 0x9b,
+	0x48, 0xb1, 0xd0, 0xae, 0x63, 0xe1, 0x1f, 0xc0, 0x18, 0xd1, 0x35, 0x43, 0xa9, 0x97, 0x55, 0xd4,
+	0xd6, 0x15, 0x4b, 0xc7, 0x06, 0x11, 0xb8, 0x85, 0xec, 0xd2, 0x89, 0xf5, 0xc5, 0x7c, 0x84, 0x40,
+	0xf9, 0x7b, 0x0e, 0x7a, 0xdb, 0x03, 0x17, 0xfa, 0x9f, 0xbd, 0x9c, 0xef, 0x2b, 0x9d, 0x26, 0xc1,
+	0x66, 0xc2, 0x8b, 0x30, 0xa4, 0x1b, 0x16, 0x32, 0xdb, 0x4a, 0x5d, 0xc8, 0x2c, 0x70, 0x4b, 0xfd,
+	0x25, 0xf6, 0xce, 0x5f, 0x87, 0x01, 0x13, 0xb7, 0x2c, 0x24, 0x64, 0x17, 0xb8, 0xa5, 0x13, 0xeb,
+	0x13, 0x79, 0x57, 0xf4, 0xbc, 0x27, 0x7a, 0x7e, 0xcb, 0xd8, 0x2b, 0xc0, 0xaf, 0x4f, 0x57, 0x06,
+	0x4b, 0x36, 0x6c, 0xa7, 0xe4, 0xc2, 0xf9, 0x3d, 0x18, 0xd5, 0x0d, 0xdd, 0xd2, 0x1d, 0xb6, 0x4d,
+	0x4c, 0x74, 0x4b, 0xe8, 0x77, 0xa8, 0x4e, 0xe7, 0xa9, 0xf0, 0xb6, 0xd4, 0x8c, 0xea, 0x6d, 0xac,
+	0x1b, 0x85, 0x6b, 0x36, 0xbf, 0x1f, 0xff, 0x9c, 0x5f, 0xd2, 0x74, 0xab, 0xd6, 0xaa, 0xe4, 0xab,
+	0xb8, 0x41, 0xb3, 0x44, 0x7f, 0x56, 0x88, 0xfa, 0x48, 0xb6, 0xf6, 0x9a, 0x88, 0x38, 0x1d, 0xc8,
+	0x0f, 0x6f, 0x9e, 0x2c, 0x73, 0xa5, 0x11, 0xea, 0x68, 0xdb, 0xf5, 0xc3, 0xaf, 0xc3, 0xff, 0xaa,
+	0xb6, 0x6e, 0xd8, 0x14, 0x06, 0x16, 0xb8, 0xa5, 0xe1, 0x82, 0xf0, 0xfb, 0xd3, 0x95, 0x09, 0xea,
+	0x75, 0x4b, 0x55, 0x4d, 0x44, 0xc8, 0x3d, 0xcb, 0xd4, 0x0d, 0xad, 0xe4, 0x01, 0xf9, 0x5b, 0x30,
+	0x44, 0xaa, 0x35, 0xa4, 0xb6, 0xea, 0x48, 0x18, 0x74, 0x22, 0x9d, 0x8b, 0x96, 0x94, 0x82, 0x4a,
+	0x0c, 0xbe, 0x79, 0xf1, 0xc3, 0x37, 0x4f, 0x96, 0xbd, 0x81, 0x3e, 0x7b, 0xf3, 0x64, 0xf9, 0x0c,
+	0x4d, 0x6c, 0x28, 0x7f, 0xd2, 0x36, 0x4c, 0x85, 0x9a, 0x4a, 0x88, 0x34, 0xb1, 0x41, 0x10, 0x7f,
+	0x09, 0x86, 0xdd, 0x4e, 0x65, 0x5d, 0x15, 0x38, 0x3b, 0x05, 0x85, 0x93, 0xfb, 0x2f, 0xe7, 0x87,
+	0x5c, 0xd8, 0xce, 0x76, 0x69, 0xc8, 0x35, 0xef, 0xa8, 0xd2, 0x73, 0x0e, 0x46, 0x8a, 0x44, 0xbb,
+	0xdf, 0x54, 0x15, 0x0b, 0x39, 0x9a, 0xa7, 0xe8, 0xdd, 0x4d, 0x67, 0x26, 0x5d, 0x3a, 0x7d, 0x9a,
+	0x66, 0x13, 0x6a, 0xba, 0x79, 0x21, 0x2c, 0xcc, 0x64, 0x57, 0x18, 0x1f, 0x7d, 0x49, 0x80, 0x33,
+	0xc1, 0x16, 0x4f, 0x16, 0xe9, 0xaf, 0x0c, 0xcc, 0x30, 0x93, 0x3b, 0x9b, 0xc9, 0x96, 0xa1, 0xee,
+	0x78, 0x93, 0x33, 0x45, 0xe0, 0x91, 0x8b, 0x27, 0xf3, 0x2f, 0x2f, 0x9e, 0x6c, 0x68, 0xf1, 0xf8,
+	0x54, 0xeb, 0x3f, 0xca, 0x4c, 0x1c, 0x48, 0x37, 0x13, 0x37, 0xc2, 0x82, 0x4b, 0x61, 0xc1, 0x0f,
+	0x6a, 0x28, 0x9d, 0x87, 0x73, 0x3d, 0xcc, 0x2c, 0x15, 0x5f, 0x65, 0x40, 0x28, 0x12, 0xed, 0x81,
+	0x6e, 0xd5, 0x54, 0x53, 0xe9, 0xdc, 0x41, 0xe8, 0xae, 0xb2, 0x87, 0xcc, 0x3b, 0x2d, 0x43, 0x25,
+	0x69, 0xf2, 0x50, 0x83, 0x41, 0xa5, 0x81, 0x5b, 0x86, 0x25, 0x64, 0x8e, 0x69, 0x3b, 0xa0, 0xe3,
+	0x1f, 0x69, 0xca, 0xae, 0x86, 0x15, 0x9c, 0xef, 0x2a, 0x18, 0x19, 0xba, 0x24, 0xc1, 0x42, 0x9c,
+	0x8d, 0x69, 0xf7, 0x2d, 0x07, 0x63, 0x45, 0xa2, 0x6d, 0x55, 0x2d, 0xbd, 0xdd, 0xdd, 0xce, 0x53,
+	0x88, 0xe6, 0x0b, 0x25, 0x93, 0x34, 0x94, 0x4b, 0xe1, 0x50, 0x84, 0x6e, 0x28, 0x41, 0x26, 0xd2,
+	0x0c, 0x4c, 0x1f, 0x68, 0x64, 0xe4, 0xbf, 0xe3, 0x60, 0xbc, 0x48, 0xb4, 0x6d, 0xa4, 0xbc, 0x55,
+	0xfa, 0x97, 0xc3, 0xf4, 0xc5, 0x2e, 0xfd, 0x30, 0x17, 0x69, 0x0e, 0x66, 0x22, 0x9a, 0x59, 0x08,
+	0xdf, 0x70, 0x70, 0xba, 0x48, 0xb4, 0x5d, 0x53, 0xd7, 0x34, 0x64, 0xbe, 0x1d, 0xfe, 0x4b, 0x61,
+	0xfe, 0x53, 0x5d, 0xfe, 0x01, 0x22, 0x92, 0x08, 0x42, 0xb8, 0x8d, 0x31, 0xff, 0x22, 0x03, 0xbc,
+	0x13, 0x99, 0x73, 0xb2, 0xed, 0xe2, 0xf4, 0xdc, 0xdf, 0xde, 0x7a, 0xbb, 0x0e, 0xc3, 0xf4, 0xa4,
+	0x4f, 0xb0, 0xe2, 0xba, 0xd0, 0xcd, 0x2b, 0xb6, 0x52, 0xdd, 0x77, 0x5b, 0xab, 0x69, 0x7f, 0xae,
+	0x03, 0xa1, 0x4b, 0xb3, 0x20, 0x1e, 0x6c, 0x65, 0x7a, 0x7d, 0x9d, 0x81, 0x49, 0xff, 0x72, 0x34,
+	0x71, 0xe3, 0xbf, 0x2c, 0xd9, 0x4d, 0x80, 0x0e, 0xa5, 0x8a, 0x0e, 0xd7, 0xcc, 0x87, 0x75, 0x37,
+	0x2a, 0x5f, 0x83, 0xad, 0xda, 0x6c, 0xc4, 0x5e, 0xc5, 0x04, 0x90, 0xe6, 0x61, 0x2e, 0xd2, 0xc0,
+	0xb4, 0xfb, 0x25, 0xeb, 0x2c, 0xf4, 0x7b, 0xc8, 0xf2, 0x76, 0xb1, 0x5d, 0xdc, 0xbc, 0xdf, 0x4c,
+	0xa3, 0xdc, 0x2a, 0x0c, 0x3e, 0x6c, 0x19, 0x2a, 0x3a, 0x7c, 0x9d, 0x50, 0x1c, 0xdf, 0x81, 0x11,
+	0x4b, 0x31, 0x35, 0x64, 0x95, 0x2b, 0x4a, 0x5d, 0x31, 0xaa, 0x76, 0x9d, 0x79, 0x3c, 0x9a, 0x9f,
+	0x72, 0xfd, 0x14, 0x5c, 0x37, 0x7c, 0x05, 0xb2, 0x55, 0xa5, 0x79, 0x6c, 0x35, 0xa9, 0x3d, 0xf8,
+	0x51, 0x0a, 0xd1, 0xcd, 0xeb, 0xfe, 0x7d, 0xc3, 0x7e, 0xa6, 0x4a, 0x85, 0xb6, 0xc0, 0x70, 0x96,
+	0xe8, 0x16, 0x18, 0x6e, 0x66, 0xc9, 0xfd, 0x9e, 0x73, 0x8a, 0xac, 0x12, 0x6a, 0xe0, 0x36, 0xfa,
+	0x27, 0xf9, 0x25, 0x28, 0x59, 0x7e, 0x5d, 0xdc, 0xe6, 0x8a, 0x13, 0x02, 0x41, 0x5e, 0x08, 0x73,
+	0xdd, 0x10, 0x22, 0xb8, 0x48, 0x0b, 0x90, 0x8b, 0xb6, 0xb0, 0x40, 0x7e, 0xce, 0xc0, 0x2c, 0xab,
+	0x57, 0xfe, 0x8f, 0x3b, 0x34, 0x9f, 0xbb, 0x35, 0x13, 0x91, 0x1a, 0xae, 0xab, 0x69, 0xc2, 0xf9,
+	0x98, 0x83, 0xc9, 0x3a, 0xee, 0x78, 0x53, 0xaf, 0x6c, 0x79, 0x83, 0x1c, 0xdb, 0xc2, 0x1f, 0xaf,
+	0x47, 0x30, 0x3e, 0x4a, 0xa1, 0x72, 0x35, 0x7c, 0xbc, 0x9c, 0x0b, 0x97, 0x7a, 0x11, 0xda, 0x48,
+	0x17, 0x60, 0xb1, 0x97, 0x9d, 0x89, 0xfc, 0x8a, 0x73, 0x76, 0xd9, 0x5d, 0x53, 0x31, 0xc8, 0x43,
+	0xef, 0x50, 0x7a, 0xa7, 0x63, 0x20, 0x93, 0xd4, 0xf4, 0x54, 0x33, 0xe6, 0x1a, 0x0c, 0x1b, 0xa8,
+	0x53, 0xc6, 0x1d, 0x23, 0xc1, 0xa4, 0x19, 0x32, 0x50, 0xc7, 0xf1, 0xc2, 0xe7, 0x61, 0xc0, 0xed,
+	0x72, 0x98, 0x20, 0x2e, 0xcc, 0xdd, 0x0e, 0xdd, 0x67, 0x5b, 0x8c, 0xb3, 0xfe, 0xb3, 0x36, 0x32,
+	0x06, 0x69, 0x11, 0xa4, 0x78, 0x2b, 0x13, 0xe2, 0xf3, 0x0c, 0x4c, 0xb8, 0xcb, 0x8a, 0x22, 0x9a,
+	0xc8, 0x74, 0xaa, 0xf4, 0x14, 0x12, 0x5c, 0x85, 0x21, 0x4c, 0xbb, 0x1d, 0xae, 0x80, 0x87, 0xe4,
+	0x6f, 0xd8, 0x17, 0xb5, 0x3a, 0x22, 0xce, 0x7e, 0x38, 0xb2, 0x7e, 0x36, 0xf2, 0x0e, 0xe0, 0xd1,
+	0x29, 0xe1, 0x3a, 0x2a, 0xb9, 0xf8, 0xae, 0x74, 0xfd, 0xc9, 0xa4, 0x5b, 0x0e, 0x4a, 0x37, 0x13,
+	0xd8, 0x63, 0x82, 0x51, 0x4b, 0x39, 0x98, 0x8d, 0x6a, 0x67, 0x72, 0xfd, 0xc4, 0xc1, 0x28, 0x9b,
+	0x60, 0x77, 0x9d, 0x2f, 0x1e, 0x76, 0x59, 0xa0, 0xb4, 0xac, 0x1a, 0x36, 0x75, 0x6b, 0x4f, 0xe0,
+	0x0e, 0xe1, 0xd4, 0x85, 0xf2, 0xb7, 0x60, 0xd0, 0xfd, 0x66, 0x42, 0xaf, 0xaa, 0x33, 0x91, 0x0a,
+	0xb8, 0x4e, 0xe8, 0xe5, 0x8c, 0x76, 0x70, 0x4b, 0xdf, 0xee, 0x50, 0xa1, 0x3b, 0xb9, 0x9f, 0x9d,
+	0x34, 0x0d, 0x53, 0xa1, 0x26, 0x2f, 0x98, 0xf5, 0xe7, 0xa7, 0x20, 0x5b, 0x24, 0x1a, 0x5f, 0x81,
+	0x93, 0x81, 0xcf, 0x30, 0xd1, 0xd7, 0xc5, 0xd0, 0xcd, 0x5e, 0xbc, 0x92, 0x04, 0xe5, 0xf9, 0xe2,
+	0xcb, 0x70, 0xc2, 0x7f, 0xa1, 0x3f, 0x17, 0xd7, 0xd9, 0x07, 0x12, 0x2f, 0x27, 0x00, 0x31, 0x07,
+	0x9f, 0x70, 0x20, 0xc4, 0x5e, 0xa3, 0x57, 0x7b, 0x8f, 0x74, 0xb0, 0x87, 0x78, 0x33, 0x6d, 0x0f,
+	0x46, 0xe4, 0x7d, 0x98, 0x8c, 0xbe, 0x43, 0xae, 0xc4, 0x0d, 0x19, 0x09, 0x17, 0xaf, 0xa5, 0x82,
+	0x33, 0xf7, 0x35, 0x18, 0x09, 0x5d, 0xc3, 0x2e, 0xc4, 0x0d, 0x14, 0xc4, 0x89, 0xf9, 0x64, 0x38,
+	0xe6, 0xc9, 0x80, 0xd3, 0x07, 0xee, 0x4c, 0x4b, 0x71, 0x63, 0x84, 0x91, 0xe2, 0x6a, 0x52, 0x24,
+	0xf3, 0x87, 0xe0, 0x54, 0xf0, 0x82, 0x73, 0x3e, 0x6e, 0x88, 0x00, 0x4c, 0x5c, 0x49, 0x04, 0x63,
+	0x6e, 0x1e, 0xc1, 0x68, 0xf8, 0x36, 0x72, 0x31, 0x9e, 0x6b, 0x00, 0x28, 0xca, 0x09, 0x81, 0xcc,
+	0x99, 0x05, 0x7c, 0x44, 0x29, 0xbf, 0x7c, 0x68, 0xea, 0x19, 0x56, 0x5c, 0x4f, 0x8e, 0xf5, 0x67,
+	0xee, 0x40, 0x11, 0x1c, 0x9b, 0xb9, 0x30, 0x52, 0x5c, 0x4d, 0x8a, 0x64, 0xfe, 0x3a, 0x30, 0x1e,
+	0x55, 0x97, 0xc5, 0xae, 0xef, 0x08, 0xb0, 0xb8, 0x91, 0x02, 0xcc, 0x1c, 0x7f, 0xca, 0xc1, 0x74,
+	0x7c, 0x21, 0xb5, 0xd6, 0x7b, 0x8d, 0x47, 0x74, 0x11, 0x6f, 0xa5, 0xee, 0xc2, 0xb8, 0x7c, 0xc4,
+	0xc1, 0x54, 0x5c, 0xbd, 0x21, 0xc7, 0x4f, 0xd1, 0xc8, 0x0e, 0xe2, 0x8d, 0x94, 0x1d, 0x18, 0x8b,
+	0xf7, 0x60, 0x2c, 0xe2, 0xac, 0xef, 0x91, 0xd1, 0x20, 0x54, 0x5c, 0x4b, 0x0c, 0x65, 0x2e, 0x2b,
+	0x70, 0x32, 0x70, 0x5e, 0x2e, 0xf6, 0xd6, 0xd0, 0x45, 0x89, 0x57, 0x92, 0xa0, 0x3c, 0x1f, 0xe2,
+	0xc0, 0x07, 0x76, 0xf5, 0x59, 0xd8, 0x79, 0xb6, 0x9f, 0xe3, 0x5e, 0xec, 0xe7, 0xb8, 0x57, 0xfb,
+	0x39, 0xee, 0xcb, 0xd7, 0xb9, 0xbe, 0x17, 0xaf, 0x73, 0x7d, 0x7f, 0xbc, 0xce, 0xf5, 0xbd, 0x2b,
+	0xfb, 0xca, 0x58, 0x7b, 0x60, 0xe7, 0x73, 0x70, 0x15, 0xd7, 0xe5, 0x6a, 0x4d, 0xd1, 0x0d, 0xb9,
+	0xbd, 0x21, 0x3f, 0xf6, 0xfe, 0x5d, 0xe1, 0xd4, 0xb4, 0x95, 0x41, 0x07, 0xb1, 0xf1, 0xf7, 0x00,
+	0x2c, 0xff, 0x9d, 0x51, 0xb7, 0x19, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateTunnel is a RPC method to create a new tunnel.
+	CreateTunnel(ctx context.Context, in *MsgCreateTunnel, opts ...grpc.CallOption) (*MsgCreateTunnelResponse, error)
+	// UpdateRoute is a RPC method to update a route information of the tunnel.
+	UpdateRoute(ctx context.Context, in *MsgUpdateRoute, opts ...grpc.CallOption) (*MsgUpdateRouteResponse, error)
+	// UpdateSignalsAndInterval is a RPC method to update a signals and interval of the tunnel.
+	UpdateSignalsAndInterval(ctx context.Context, in *MsgUpdateSignalsAndInterval, opts ...grpc.CallOption) (*MsgUpdateSignalsAndIntervalResponse, error)
+	// WithdrawFeePayerFunds is a RPC method to withdraw fee payer funds to creator.
+	WithdrawFeePayerFunds(ctx context.Context, in *MsgWithdrawFeePayerFunds, opts ...grpc.CallOption) (*MsgWithdrawFeePayerFundsResponse, error)
+	// ActivateTunnel is a RPC method to activate a tunnel.
+	ActivateTunnel(ctx context.Context, in *MsgActivateTunnel, opts ...grpc.CallOption) (*MsgActivateTunnelResponse, error)
+	// DeactivateTunnel is a RPC method to deactivate a tunnel.
+	DeactivateTunnel(ctx context.Context, in *MsgDeactivateTunnel, opts ...grpc.CallOption) (*MsgDeactivateTunnelResponse, error)
+	// TriggerTunnel is a RPC method to manually trigger a tunnel.
+	TriggerTunnel(ctx context.Context, in *MsgTriggerTunnel, opts ...grpc.CallOption) (*MsgTriggerTunnelResponse, error)
+	// DepositToTunnel is a RPC method to deposit to an existing tunnel.
+	DepositToTunnel(ctx context.Context, in *MsgDepositToTunnel, opts ...grpc.CallOption) (*MsgDepositToTunnelResponse, error)
+	// WithdrawFromTunnel is a RPC method to withdraw a deposit from an existing tunnel.
+	WithdrawFromTunnel(ctx context.Context, in *MsgWithdrawFromTunnel, opts ...grpc.CallOption) (*MsgWithdrawFromTunnelResponse, error)
+	// SetFeePayerTopUp is a RPC method to authorize a funding account to refill the fee payer of a tunnel.
+	SetFeePayerTopUp(ctx context.Context, in *MsgSetFeePayerTopUp, opts ...grpc.CallOption) (*MsgSetFeePayerTopUpResponse, error)
+	// RemoveFeePayerTopUp is a RPC method to remove the fee payer top-up authorization of a tunnel.
+	RemoveFeePayerTopUp(ctx context.Context, in *MsgRemoveFeePayerTopUp, opts ...grpc.CallOption) (*MsgRemoveFeePayerTopUpResponse, error)
+	// UpdateLowBalanceThreshold is a RPC method to update the low balance threshold of a tunnel.
+	UpdateLowBalanceThreshold(ctx context.Context, in *MsgUpdateLowBalanceThreshold, opts ...grpc.CallOption) (*MsgUpdateLowBalanceThresholdResponse, error)
+	// TransferTunnelOwnership is a RPC method to transfer the ownership of a tunnel.
+	TransferTunnelOwnership(ctx context.Context, in *MsgTransferTunnelOwnership, opts ...grpc.CallOption) (*MsgTransferTunnelOwnershipResponse, error)
+	// SetTunnelOperator is a RPC method to set the roles of an operator of a tunnel.
+	SetTunnelOperator(ctx context.Context, in *MsgSetTunnelOperator, opts ...grpc.CallOption) (*MsgSetTunnelOperatorResponse, error)
+	// UpdateParams is a RPC method to update parameters
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateTunnel(ctx context.Context, in *MsgCreateTunnel, opts ...grpc.CallOption) (*MsgCreateTunnelResponse, error) {
+	out := new(MsgCreateTunnelResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/CreateTunnel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateRouteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateRoute(ctx context.Context, in *MsgUpdateRoute, opts ...grpc.CallOption) (*MsgUpdateRouteResponse, error) {
+	out := new(MsgUpdateRouteResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/UpdateRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) UpdateSignalsAndInterval(ctx context.Context, in *MsgUpdateSignalsAndInterval, opts ...grpc.CallOption) (*MsgUpdateSignalsAndIntervalResponse, error) {
+	out := new(MsgUpdateSignalsAndIntervalResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/UpdateSignalsAndInterval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateSignalsAndInterval) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WithdrawFeePayerFunds(ctx context.Context, in *MsgWithdrawFeePayerFunds, opts ...grpc.CallOption) (*MsgWithdrawFeePayerFundsResponse, error) {
+	out := new(MsgWithdrawFeePayerFundsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/WithdrawFeePayerFunds", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateSignalsAndInterval) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ActivateTunnel(ctx context.Context, in *MsgActivateTunnel, opts ...grpc.CallOption) (*MsgActivateTunnelResponse, error) {
+	out := new(MsgActivateTunnelResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/ActivateTunnel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateSignalsAndInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x22
+func (c *msgClient) DeactivateTunnel(ctx context.Context, in *MsgDeactivateTunnel, opts ...grpc.CallOption) (*MsgDeactivateTunnelResponse, error) {
+	out := new(MsgDeactivateTunnelResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/DeactivateTunnel", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Interval != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
-		i--
-		dAtA[i] = 0x18
+	return out, nil
+}
+
+func (c *msgClient) TriggerTunnel(ctx context.Context, in *MsgTriggerTunnel, opts ...grpc.CallOption) (*MsgTriggerTunnelResponse, error) {
+	out := new(MsgTriggerTunnelResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/TriggerTunnel", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.SignalDeviations) > 0 {
-		for iNdEx := len(m.SignalDeviations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SignalDeviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return out, nil
+}
+
+func (c *msgClient) DepositToTunnel(ctx context.Context, in *MsgDepositToTunnel, opts ...grpc.CallOption) (*MsgDepositToTunnelResponse, error) {
+	out := new(MsgDepositToTunnelResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/DepositToTunnel", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.TunnelID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *msgClient) WithdrawFromTunnel(ctx context.Context, in *MsgWithdrawFromTunnel, opts ...grpc.CallOption) (*MsgWithdrawFromTunnelResponse, error) {
+	out := new(MsgWithdrawFromTunnelResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/WithdrawFromTunnel", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateSignalsAndIntervalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetFeePayerTopUp(ctx context.Context, in *MsgSetFeePayerTopUp, opts ...grpc.CallOption) (*MsgSetFeePayerTopUpResponse, error) {
+	out := new(MsgSetFeePayerTopUpResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/SetFeePayerTopUp", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateSignalsAndIntervalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveFeePayerTopUp(ctx context.Context, in *MsgRemoveFeePayerTopUp, opts ...grpc.CallOption) (*MsgRemoveFeePayerTopUpResponse, error) {
+	out := new(MsgRemoveFeePayerTopUpResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/RemoveFeePayerTopUp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateSignalsAndIntervalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) UpdateLowBalanceThreshold(ctx context.Context, in *MsgUpdateLowBalanceThreshold, opts ...grpc.CallOption) (*MsgUpdateLowBalanceThresholdResponse, error) {
+	out := new(MsgUpdateLowBalanceThresholdResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/UpdateLowBalanceThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWithdrawFeePayerFunds) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TransferTunnelOwnership(ctx context.Context, in *MsgTransferTunnelOwnership, opts ...grpc.CallOption) (*MsgTransferTunnelOwnershipResponse, error) {
+	out := new(MsgTransferTunnelOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/TransferTunnelOwnership", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWithdrawFeePayerFunds) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetTunnelOperator(ctx context.Context, in *MsgSetTunnelOperator, opts ...grpc.CallOption) (*MsgSetTunnelOperatorResponse, error) {
+	out := new(MsgSetTunnelOperatorResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/SetTunnelOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWithdrawFeePayerFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.TunnelID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgWithdrawFeePayerFundsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWithdrawFeePayerFundsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateTunnel is a RPC method to create a new tunnel.
+	CreateTunnel(context.Context, *MsgCreateTunnel) (*MsgCreateTunnelResponse, error)
+	// UpdateRoute is a RPC method to update a route information of the tunnel.
+	UpdateRoute(context.Context, *MsgUpdateRoute) (*MsgUpdateRouteResponse, error)
+	// UpdateSignalsAndInterval is a RPC method to update a signals and interval of the tunnel.
+	UpdateSignalsAndInterval(context.Context, *MsgUpdateSignalsAndInterval) (*MsgUpdateSignalsAndIntervalResponse, error)
+	// WithdrawFeePayerFunds is a RPC method to withdraw fee payer funds to creator.
+	WithdrawFeePayerFunds(context.Context, *MsgWithdrawFeePayerFunds) (*MsgWithdrawFeePayerFundsResponse, error)
+	// ActivateTunnel is a RPC method to activate a tunnel.
+	ActivateTunnel(context.Context, *MsgActivateTunnel) (*MsgActivateTunnelResponse, error)
+	// DeactivateTunnel is a RPC method to deactivate a tunnel.
+	DeactivateTunnel(context.Context, *MsgDeactivateTunnel) (*MsgDeactivateTunnelResponse, error)
+	// TriggerTunnel is a RPC method to manually trigger a tunnel.
+	TriggerTunnel(context.Context, *MsgTriggerTunnel) (*MsgTriggerTunnelResponse, error)
+	// DepositToTunnel is a RPC method to deposit to an existing tunnel.
+	DepositToTunnel(context.Context, *MsgDepositToTunnel) (*MsgDepositToTunnelResponse, error)
+	// WithdrawFromTunnel is a RPC method to withdraw a deposit from an existing tunnel.
+	WithdrawFromTunnel(context.Context, *MsgWithdrawFromTunnel) (*MsgWithdrawFromTunnelResponse, error)
+	// SetFeePayerTopUp is a RPC method to authorize a funding account to refill the fee payer of a tunnel.
+	SetFeePayerTopUp(context.Context, *MsgSetFeePayerTopUp) (*MsgSetFeePayerTopUpResponse, error)
+	// RemoveFeePayerTopUp is a RPC method to remove the fee payer top-up authorization of a tunnel.
+	RemoveFeePayerTopUp(context.Context, *MsgRemoveFeePayerTopUp) (*MsgRemoveFeePayerTopUpResponse, error)
+	// UpdateLowBalanceThreshold is a RPC method to update the low balance threshold of a tunnel.
+	UpdateLowBalanceThreshold(context.Context, *MsgUpdateLowBalanceThreshold) (*MsgUpdateLowBalanceThresholdResponse, error)
+	// TransferTunnelOwnership is a RPC method to transfer the ownership of a tunnel.
+	TransferTunnelOwnership(context.Context, *MsgTransferTunnelOwnership) (*MsgTransferTunnelOwnershipResponse, error)
+	// SetTunnelOperator is a RPC method to set the roles of an operator of a tunnel.
+	SetTunnelOperator(context.Context, *MsgSetTunnelOperator) (*MsgSetTunnelOperatorResponse, error)
+	// UpdateParams is a RPC method to update parameters
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgWithdrawFeePayerFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgActivateTunnel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CreateTunnel(ctx context.Context, req *MsgCreateTunnel) (*MsgCreateTunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTunnel not implemented")
 }
-
-func (m *MsgActivateTunnel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) UpdateRoute(ctx context.Context, req *MsgUpdateRoute) (*MsgUpdateRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoute not implemented")
 }
-
-func (m *MsgActivateTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TunnelID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) UpdateSignalsAndInterval(ctx context.Context, req *MsgUpdateSignalsAndInterval) (*MsgUpdateSignalsAndIntervalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSignalsAndInterval not implemented")
 }
-
-func (m *MsgActivateTunnelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) WithdrawFeePayerFunds(ctx context.Context, req *MsgWithdrawFeePayerFunds) (*MsgWithdrawFeePayerFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFeePayerFunds not implemented")
 }
-
-func (m *MsgActivateTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) ActivateTunnel(ctx context.Context, req *MsgActivateTunnel) (*MsgActivateTunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateTunnel not implemented")
 }
-
-func (m *MsgActivateTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) DeactivateTunnel(ctx context.Context, req *MsgDeactivateTunnel) (*MsgDeactivateTunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateTunnel not implemented")
 }
-
-func (m *MsgDeactivateTunnel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) TriggerTunnel(ctx context.Context, req *MsgTriggerTunnel) (*MsgTriggerTunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerTunnel not implemented")
 }
-
-func (m *MsgDeactivateTunnel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) DepositToTunnel(ctx context.Context, req *MsgDepositToTunnel) (*MsgDepositToTunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositToTunnel not implemented")
 }
-
-func (m *MsgDeactivateTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TunnelID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) WithdrawFromTunnel(ctx context.Context, req *MsgWithdrawFromTunnel) (*MsgWithdrawFromTunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromTunnel not implemented")
 }
-
-func (m *MsgDeactivateTunnelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) SetFeePayerTopUp(ctx context.Context, req *MsgSetFeePayerTopUp) (*MsgSetFeePayerTopUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeePayerTopUp not implemented")
 }
-
-func (m *MsgDeactivateTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) RemoveFeePayerTopUp(ctx context.Context, req *MsgRemoveFeePayerTopUp) (*MsgRemoveFeePayerTopUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFeePayerTopUp not implemented")
 }
-
-func (m *MsgDeactivateTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) UpdateLowBalanceThreshold(ctx context.Context, req *MsgUpdateLowBalanceThreshold) (*MsgUpdateLowBalanceThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLowBalanceThreshold not implemented")
 }
-
-func (m *MsgTriggerTunnel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) TransferTunnelOwnership(ctx context.Context, req *MsgTransferTunnelOwnership) (*MsgTransferTunnelOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTunnelOwnership not implemented")
+}
+func (*UnimplementedMsgServer) SetTunnelOperator(ctx context.Context, req *MsgSetTunnelOperator) (*MsgSetTunnelOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTunnelOperator not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
 
-func (m *MsgTriggerTunnel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgTriggerTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
+func _Msg_CreateTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTunnel)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TunnelID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTunnel(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/CreateTunnel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTunnel(ctx, req.(*MsgCreateTunnel))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgTriggerTunnelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRoute)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgTriggerTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgTriggerTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/UpdateRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRoute(ctx, req.(*MsgUpdateRoute))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDepositToTunnel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateSignalsAndInterval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSignalsAndInterval)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDepositToTunnel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSignalsAndInterval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/UpdateSignalsAndInterval",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSignalsAndInterval(ctx, req.(*MsgUpdateSignalsAndInterval))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDepositToTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_WithdrawFeePayerFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFeePayerFunds)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFeePayerFunds(ctx, in)
 	}
-	if m.TunnelID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/WithdrawFeePayerFunds",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFeePayerFunds(ctx, req.(*MsgWithdrawFeePayerFunds))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDepositToTunnelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ActivateTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgActivateTunnel)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ActivateTunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/ActivateTunnel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ActivateTunnel(ctx, req.(*MsgActivateTunnel))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDepositToTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_DeactivateTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeactivateTunnel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeactivateTunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/DeactivateTunnel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeactivateTunnel(ctx, req.(*MsgDeactivateTunnel))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDepositToTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_TriggerTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTriggerTunnel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TriggerTunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/TriggerTunnel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TriggerTunnel(ctx, req.(*MsgTriggerTunnel))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawFromTunnel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DepositToTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositToTunnel)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DepositToTunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/DepositToTunnel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositToTunnel(ctx, req.(*MsgDepositToTunnel))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawFromTunnel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_WithdrawFromTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFromTunnel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFromTunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/WithdrawFromTunnel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFromTunnel(ctx, req.(*MsgWithdrawFromTunnel))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawFromTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Withdrawer) > 0 {
-		i -= len(m.Withdrawer)
-		copy(dAtA[i:], m.Withdrawer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Withdrawer)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_SetFeePayerTopUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeePayerTopUp)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeePayerTopUp(ctx, in)
 	}
-	if m.TunnelID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/SetFeePayerTopUp",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeePayerTopUp(ctx, req.(*MsgSetFeePayerTopUp))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawFromTunnelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RemoveFeePayerTopUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFeePayerTopUp)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFeePayerTopUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/RemoveFeePayerTopUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFeePayerTopUp(ctx, req.(*MsgRemoveFeePayerTopUp))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawFromTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_UpdateLowBalanceThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateLowBalanceThreshold)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateLowBalanceThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/UpdateLowBalanceThreshold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateLowBalanceThreshold(ctx, req.(*MsgUpdateLowBalanceThreshold))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawFromTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_TransferTunnelOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferTunnelOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferTunnelOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/TransferTunnelOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferTunnelOwnership(ctx, req.(*MsgTransferTunnelOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func _Msg_SetTunnelOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTunnelOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTunnelOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/SetTunnelOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTunnelOperator(ctx, req.(*MsgSetTunnelOperator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "band.tunnel.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTunnel",
+			Handler:    _Msg_CreateTunnel_Handler,
+		},
+		{
+			MethodName: "UpdateRoute",
+			Handler:    _Msg_UpdateRoute_Handler,
+		},
+		{
+			MethodName: "UpdateSignalsAndInterval",
+			Handler:    _Msg_UpdateSignalsAndInterval_Handler,
+		},
+		{
+			MethodName: "WithdrawFeePayerFunds",
+			Handler:    _Msg_WithdrawFeePayerFunds_Handler,
+		},
+		{
+			MethodName: "ActivateTunnel",
+			Handler:    _Msg_ActivateTunnel_Handler,
+		},
+		{
+			MethodName: "DeactivateTunnel",
+			Handler:    _Msg_DeactivateTunnel_Handler,
+		},
+		{
+			MethodName: "TriggerTunnel",
+			Handler:    _Msg_TriggerTunnel_Handler,
+		},
+		{
+			MethodName: "DepositToTunnel",
+			Handler:    _Msg_DepositToTunnel_Handler,
+		},
+		{
+			MethodName: "WithdrawFromTunnel",
+			Handler:    _Msg_WithdrawFromTunnel_Handler,
+		},
+		{
+			MethodName: "SetFeePayerTopUp",
+			Handler:    _Msg_SetFeePayerTopUp_Handler,
+		},
+		{
+			MethodName: "RemoveFeePayerTopUp",
+			Handler:    _Msg_RemoveFeePayerTopUp_Handler,
+		},
+		{
+			MethodName: "UpdateLowBalanceThreshold",
+			Handler:    _Msg_UpdateLowBalanceThreshold_Handler,
+		},
+		{
+			MethodName: "TransferTunnelOwnership",
+			Handler:    _Msg_TransferTunnelOwnership_Handler,
+		},
+		{
+			MethodName: "SetTunnelOperator",
+			Handler:    _Msg_SetTunnelOperator_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "band/tunnel/v1beta1/tx.proto",
+}
+
+func (m *MsgCreateTunnel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2216,37 +2349,84 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateTunnel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x32
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2a
+	}
+	if len(m.InitialDeposit) > 0 {
+		for iNdEx := len(m.InitialDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitialDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Route != nil {
+		{
+			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalDeviations) > 0 {
+		for iNdEx := len(m.SignalDeviations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalDeviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateTunnelResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2256,336 +2436,2416 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateTunnel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SignalDeviations) > 0 {
-		for _, e := range m.SignalDeviations {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.Interval != 0 {
-		n += 1 + sovTx(uint64(m.Interval))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.Route != nil {
-		l = m.Route.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.InitialDeposit) > 0 {
-		for _, e := range m.InitialDeposit {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		{
+			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgCreateTunnelResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
 	if m.TunnelID != 0 {
-		n += 1 + sovTx(uint64(m.TunnelID))
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateRoute) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TunnelID != 0 {
-		n += 1 + sovTx(uint64(m.TunnelID))
-	}
-	if m.Route != nil {
-		l = m.Route.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateRouteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateSignalsAndInterval) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateSignalsAndInterval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSignalsAndInterval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSignalsAndInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TunnelID != 0 {
-		n += 1 + sovTx(uint64(m.TunnelID))
-	}
-	if len(m.SignalDeviations) > 0 {
-		for _, e := range m.SignalDeviations {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.Interval != 0 {
-		n += 1 + sovTx(uint64(m.Interval))
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SignalDeviations) > 0 {
+		for iNdEx := len(m.SignalDeviations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalDeviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateSignalsAndIntervalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateSignalsAndIntervalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSignalsAndIntervalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSignalsAndIntervalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawFeePayerFunds) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawFeePayerFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFeePayerFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFeePayerFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TunnelID != 0 {
-		n += 1 + sovTx(uint64(m.TunnelID))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawFeePayerFundsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawFeePayerFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgActivateTunnel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TunnelID != 0 {
-		n += 1 + sovTx(uint64(m.TunnelID))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgWithdrawFeePayerFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgActivateTunnelResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWithdrawFeePayerFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeactivateTunnel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TunnelID != 0 {
-		n += 1 + sovTx(uint64(m.TunnelID))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgActivateTunnel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeactivateTunnelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgActivateTunnel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivateTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
 	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgActivateTunnelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgActivateTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivateTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTriggerTunnel) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeactivateTunnel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeactivateTunnel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeactivateTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TunnelID != 0 {
-		n += 1 + sovTx(uint64(m.TunnelID))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTriggerTunnelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeactivateTunnelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeactivateTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeactivateTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDepositToTunnel) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTriggerTunnel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTriggerTunnel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTriggerTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.TunnelID != 0 {
-		n += 1 + sovTx(uint64(m.TunnelID))
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTriggerTunnelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTriggerTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTriggerTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositToTunnel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositToTunnel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositToTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositToTunnelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositToTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositToTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromTunnel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromTunnel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Withdrawer) > 0 {
+		i -= len(m.Withdrawer)
+		copy(dAtA[i:], m.Withdrawer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Withdrawer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromTunnelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeePayerTopUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeePayerTopUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeePayerTopUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Cap) > 0 {
+		for iNdEx := len(m.Cap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TargetBalance) > 0 {
+		for iNdEx := len(m.TargetBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TargetBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeePayerTopUpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeePayerTopUpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeePayerTopUpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeePayerTopUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeePayerTopUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeePayerTopUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeePayerTopUpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeePayerTopUpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeePayerTopUpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLowBalanceThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLowBalanceThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLowBalanceThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LowBalanceThreshold) > 0 {
+		for iNdEx := len(m.LowBalanceThreshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LowBalanceThreshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLowBalanceThresholdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLowBalanceThresholdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLowBalanceThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferTunnelOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferTunnelOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferTunnelOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferTunnelOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferTunnelOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferTunnelOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTunnelOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTunnelOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTunnelOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Roles) > 0 {
+		dAtA6 := make([]byte, len(m.Roles)*10)
+		var j5 int
+		for _, num := range m.Roles {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTunnelOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTunnelOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTunnelOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateTunnel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignalDeviations) > 0 {
+		for _, e := range m.SignalDeviations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if m.Route != nil {
+		l = m.Route.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.InitialDeposit) > 0 {
+		for _, e := range m.InitialDeposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateTunnelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	return n
+}
+
+func (m *MsgUpdateRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	if m.Route != nil {
+		l = m.Route.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSignalsAndInterval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	if len(m.SignalDeviations) > 0 {
+		for _, e := range m.SignalDeviations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateSignalsAndIntervalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawFeePayerFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawFeePayerFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgActivateTunnel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgActivateTunnelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeactivateTunnel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeactivateTunnelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTriggerTunnel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTriggerTunnelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositToTunnel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDepositToTunnelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawFromTunnel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Withdrawer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawFromTunnelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFeePayerTopUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TargetBalance) > 0 {
+		for _, e := range m.TargetBalance {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Cap) > 0 {
+		for _, e := range m.Cap {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetFeePayerTopUpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveFeePayerTopUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveFeePayerTopUpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateLowBalanceThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	if len(m.LowBalanceThreshold) > 0 {
+		for _, e := range m.LowBalanceThreshold {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateLowBalanceThresholdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferTunnelOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferTunnelOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTunnelOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		l = 0
+		for _, e := range m.Roles {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetTunnelOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateTunnel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTunnel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalDeviations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalDeviations = append(m.SignalDeviations, SignalDeviation{})
+			if err := m.SignalDeviations[len(m.SignalDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Route == nil {
+				m.Route = &types.Any{}
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialDeposit = append(m.InitialDeposit, types1.Coin{})
+			if err := m.InitialDeposit[len(m.InitialDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &Schedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTunnelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTunnelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Route == nil {
+				m.Route = &types.Any{}
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSignalsAndInterval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSignalsAndInterval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSignalsAndInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalDeviations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalDeviations = append(m.SignalDeviations, SignalDeviation{})
+			if err := m.SignalDeviations[len(m.SignalDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &Schedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSignalsAndIntervalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSignalsAndIntervalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSignalsAndIntervalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgDepositToTunnelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgWithdrawFromTunnel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TunnelID != 0 {
-		n += 1 + sovTx(uint64(m.TunnelID))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgWithdrawFeePayerFunds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFeePayerFunds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFeePayerFunds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Withdrawer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgWithdrawFromTunnelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgWithdrawFeePayerFundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFeePayerFundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFeePayerFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateTunnel) Unmarshal(dAtA []byte) error {
+func (m *MsgActivateTunnel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2608,51 +4868,17 @@ func (m *MsgCreateTunnel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTunnel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgActivateTunnel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgActivateTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalDeviations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SignalDeviations = append(m.SignalDeviations, SignalDeviation{})
-			if err := m.SignalDeviations[len(m.SignalDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
 			}
-			m.Interval = 0
+			m.TunnelID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2662,16 +4888,16 @@ func (m *MsgCreateTunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Interval |= uint64(b&0x7F) << shift
+				m.TunnelID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2681,33 +4907,129 @@ func (m *MsgCreateTunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Route == nil {
-				m.Route = &types.Any{}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateTunnelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateTunnelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-			var msglen int
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeactivateTunnel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeactivateTunnel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeactivateTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2717,27 +5039,12 @@ func (m *MsgCreateTunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TunnelID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InitialDeposit = append(m.InitialDeposit, types1.Coin{})
-			if err := m.InitialDeposit[len(m.InitialDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
@@ -2790,7 +5097,7 @@ func (m *MsgCreateTunnel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTunnelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeactivateTunnelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2813,31 +5120,12 @@ func (m *MsgCreateTunnelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTunnelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeactivateTunnelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeactivateTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
-			}
-			m.TunnelID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TunnelID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2859,7 +5147,7 @@ func (m *MsgCreateTunnelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRoute) Unmarshal(dAtA []byte) error {
+func (m *MsgTriggerTunnel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2882,10 +5170,10 @@ func (m *MsgUpdateRoute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRoute: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTriggerTunnel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTriggerTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2908,42 +5196,6 @@ func (m *MsgUpdateRoute) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Route == nil {
-				m.Route = &types.Any{}
-			}
-			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
@@ -2996,7 +5248,7 @@ func (m *MsgUpdateRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRouteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTriggerTunnelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3019,10 +5271,10 @@ func (m *MsgUpdateRouteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRouteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTriggerTunnelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTriggerTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3046,7 +5298,7 @@ func (m *MsgUpdateRouteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSignalsAndInterval) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositToTunnel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3069,10 +5321,10 @@ func (m *MsgUpdateSignalsAndInterval) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSignalsAndInterval: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositToTunnel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSignalsAndInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositToTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3096,7 +5348,7 @@ func (m *MsgUpdateSignalsAndInterval) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalDeviations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3123,33 +5375,14 @@ func (m *MsgUpdateSignalsAndInterval) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalDeviations = append(m.SignalDeviations, SignalDeviation{})
-			if err := m.SignalDeviations[len(m.SignalDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
-			}
-			m.Interval = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Interval |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3177,7 +5410,7 @@ func (m *MsgUpdateSignalsAndInterval) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3200,7 +5433,7 @@ func (m *MsgUpdateSignalsAndInterval) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSignalsAndIntervalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositToTunnelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3223,10 +5456,10 @@ func (m *MsgUpdateSignalsAndIntervalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSignalsAndIntervalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositToTunnelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSignalsAndIntervalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositToTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3250,7 +5483,7 @@ func (m *MsgUpdateSignalsAndIntervalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawFeePayerFunds) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawFromTunnel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3273,10 +5506,10 @@ func (m *MsgWithdrawFeePayerFunds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawFeePayerFunds: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawFromTunnel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawFeePayerFunds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawFromTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3334,7 +5567,7 @@ func (m *MsgWithdrawFeePayerFunds) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3362,7 +5595,7 @@ func (m *MsgWithdrawFeePayerFunds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Withdrawer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3385,7 +5618,7 @@ func (m *MsgWithdrawFeePayerFunds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawFeePayerFundsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawFromTunnelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3408,10 +5641,10 @@ func (m *MsgWithdrawFeePayerFundsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawFeePayerFundsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawFromTunnelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawFeePayerFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawFromTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3435,7 +5668,7 @@ func (m *MsgWithdrawFeePayerFundsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateTunnel) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeePayerTopUp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3458,10 +5691,10 @@ func (m *MsgActivateTunnel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateTunnel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeePayerTopUp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeePayerTopUp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3484,6 +5717,106 @@ func (m *MsgActivateTunnel) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetBalance = append(m.TargetBalance, types1.Coin{})
+			if err := m.TargetBalance[len(m.TargetBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cap = append(m.Cap, types1.Coin{})
+			if err := m.Cap[len(m.Cap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
@@ -3536,7 +5869,7 @@ func (m *MsgActivateTunnel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateTunnelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeePayerTopUpResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3559,10 +5892,10 @@ func (m *MsgActivateTunnelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateTunnelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeePayerTopUpResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeePayerTopUpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3586,7 +5919,7 @@ func (m *MsgActivateTunnelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeactivateTunnel) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFeePayerTopUp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3609,10 +5942,10 @@ func (m *MsgDeactivateTunnel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeactivateTunnel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFeePayerTopUp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeactivateTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFeePayerTopUp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3636,7 +5969,7 @@ func (m *MsgDeactivateTunnel) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3664,7 +5997,7 @@ func (m *MsgDeactivateTunnel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3687,7 +6020,7 @@ func (m *MsgDeactivateTunnel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeactivateTunnelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFeePayerTopUpResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3710,10 +6043,10 @@ func (m *MsgDeactivateTunnelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeactivateTunnelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFeePayerTopUpResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeactivateTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFeePayerTopUpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3737,7 +6070,7 @@ func (m *MsgDeactivateTunnelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTriggerTunnel) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateLowBalanceThreshold) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3760,10 +6093,10 @@ func (m *MsgTriggerTunnel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTriggerTunnel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateLowBalanceThreshold: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTriggerTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateLowBalanceThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3786,6 +6119,40 @@ func (m *MsgTriggerTunnel) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowBalanceThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LowBalanceThreshold = append(m.LowBalanceThreshold, types1.Coin{})
+			if err := m.LowBalanceThreshold[len(m.LowBalanceThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
@@ -3838,7 +6205,7 @@ func (m *MsgTriggerTunnel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTriggerTunnelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateLowBalanceThresholdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3861,10 +6228,10 @@ func (m *MsgTriggerTunnelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTriggerTunnelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateLowBalanceThresholdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTriggerTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateLowBalanceThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3888,7 +6255,7 @@ func (m *MsgTriggerTunnelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositToTunnel) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferTunnelOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3911,10 +6278,10 @@ func (m *MsgDepositToTunnel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositToTunnel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferTunnelOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositToTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferTunnelOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3938,9 +6305,9 @@ func (m *MsgDepositToTunnel) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3950,29 +6317,27 @@ func (m *MsgDepositToTunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4000,7 +6365,7 @@ func (m *MsgDepositToTunnel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4023,7 +6388,7 @@ func (m *MsgDepositToTunnel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositToTunnelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferTunnelOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4046,10 +6411,10 @@ func (m *MsgDepositToTunnelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositToTunnelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferTunnelOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositToTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferTunnelOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4073,7 +6438,7 @@ func (m *MsgDepositToTunnelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawFromTunnel) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTunnelOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4096,10 +6461,10 @@ func (m *MsgWithdrawFromTunnel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawFromTunnel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTunnelOperator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawFromTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTunnelOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4123,9 +6488,9 @@ func (m *MsgWithdrawFromTunnel) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4135,29 +6500,96 @@ func (m *MsgWithdrawFromTunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType == 0 {
+				var v OperatorRole
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= OperatorRole(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Roles = append(m.Roles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Roles) == 0 {
+					m.Roles = make([]OperatorRole, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v OperatorRole
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= OperatorRole(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Roles = append(m.Roles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4185,7 +6617,7 @@ func (m *MsgWithdrawFromTunnel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Withdrawer = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4208,7 +6640,7 @@ func (m *MsgWithdrawFromTunnel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawFromTunnelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTunnelOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4231,10 +6663,10 @@ func (m *MsgWithdrawFromTunnelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawFromTunnelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTunnelOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawFromTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTunnelOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -227,8 +227,13 @@ func GetExecutable(c *Context, l *Logger, hash string) ([]byte, error) {
 	return resValue, nil
 }
 
-// GetDataSourceHash fetches data source hash by id
-func GetDataSourceHash(c *Context, l *Logger, id types.DataSourceID) (string, error) {
+// GetDataSourceHash fetches data source hash by id and version. The current data source is used if the
+// version is not recorded (zero).
+func GetDataSourceHash(c *Context, l *Logger, id types.DataSourceID, version uint64) (string, error) {
+	if version != 0 {
+		return getDataSourceVersionHash(c, l, id, version)
+	}
+
 	res, err := abciQuery(c, l, fmt.Sprintf("/store/%s/key", types.StoreKey), types.DataSourceStoreKey(id))
 	if err != nil {
 		l.Error(":skull: Failed to get data source with error: %s", c, err.Error())
@@ -241,6 +246,28 @@ func GetDataSourceHash(c *Context, l *Logger, id types.DataSourceID) (string, er
 	return d.Filename, nil
 }
 
+// getDataSourceVersionHash fetches data source hash of the given version of the data source
+func getDataSourceVersionHash(c *Context, l *Logger, id types.DataSourceID, version uint64) (string, error) {
+	res, err := abciQuery(
+		c,
+		l,
+		fmt.Sprintf("/store/%s/key", types.StoreKey),
+		types.DataSourceVersionStoreKey(id, version),
+	)
+	if err != nil {
+		l.Error(":skull: Failed to get data source version with error: %s", c, err.Error())
+		return "", err
+	}
+	if len(res.Response.Value) == 0 {
+		return "", fmt.Errorf("data source %d version %d not found", id, version)
+	}
+
+	var d types.DataSourceVersion
+	c.encodingConfig.Codec.MustUnmarshal(res.Response.Value, &d)
+
+	return d.Filename, nil
+}
+
 // GetRequest fetches request by id
 func GetRequest(c *Context, l *Logger, id types.RequestID) (types.Request, error) {
 	res, err := abciQuery(c, l, fmt.Sprintf("/store/%s/key", types.StoreKey), types.RequestStoreKey(id))
@@ -83,7 +83,7 @@ func handleRequest(c *Context, l *Logger, id types.RequestID) {
 
 	// prepare raw requests
 	for _, raw := range req.RawRequests {
-		hash, err := GetDataSourceHash(c, l, raw.DataSourceID)
+		hash, err := GetDataSourceHash(c, l, raw.DataSourceID, raw.DataSourceVersion)
 		if err != nil {
 			l.Error(":skull: Failed to get data source hash with error: %s", c, err.Error())
 			return